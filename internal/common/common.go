@@ -73,6 +73,10 @@ const (
 
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
+
+	// ChannelCheckpointFallbackPath storage path const for the channel checkpoints persisted by datanodes
+	// while they fail to be updated to datacoord.
+	ChannelCheckpointFallbackPath = `channel_checkpoint`
 )
 
 const (
@@ -80,12 +84,48 @@ const (
 	IndexTypeKey   = "index_type"
 	MetricTypeKey  = "metric_type"
 	DimKey         = "dim"
+
+	// CompositeFieldsKey lists the other field names covered by a composite scalar index, separated by comma.
+	CompositeFieldsKey = "composite_fields"
+	// CompositeFieldIDsKey persists the field ids covered by a composite scalar index, the leading field comes first.
+	CompositeFieldIDsKey = "composite_field_ids"
+	// BitmapCardinalityLimitKey is the max number of distinct values a bitmap scalar index accepts.
+	BitmapCardinalityLimitKey = "bitmap_cardinality_limit"
+	// IndexBuildSeedKey is the random seed of an index build, set by the IndexNodes in reproducibility mode unless given.
+	IndexBuildSeedKey = "seed"
+
+	// NullableKey is the field type param allowing the inserted rows to omit the field, it is filled with the zero value.
+	NullableKey = "nullable"
+	// DefaultValueKey is the field type param of the value filled in the inserted rows omitting the field.
+	DefaultValueKey = "default_value"
+	// EmbeddingSourceKey is the float vector field type param naming the varchar field the proxy generates the vectors
+	// from, when the inserted rows omit the vector field.
+	EmbeddingSourceKey = "embedding_source"
+	// EmbeddingModelKey is the float vector field type param of the model asked to the embedding service.
+	EmbeddingModelKey = "embedding_model"
 )
 
 //  Collection properties key
 
 const (
 	CollectionTTLConfigKey = "collection.ttl.seconds"
+
+	// CollectionPartitionRolloverPeriodKey makes a collection time-partitioned, hourly or daily.
+	CollectionPartitionRolloverPeriodKey = "collection.partitionRollover.period"
+	// CollectionPartitionRolloverPrefixKey is the name prefix of the partitions created by the rollover, p_ by default.
+	CollectionPartitionRolloverPrefixKey = "collection.partitionRollover.partitionPrefix"
+	// CollectionPartitionRolloverPrecreateKey is the number of the upcoming partitions created ahead, 0 by default.
+	CollectionPartitionRolloverPrecreateKey = "collection.partitionRollover.precreateNum"
+	// CollectionPartitionRolloverRetentionKey is the number of the past periods whose partitions created by the rollover
+	// are kept, 0 keeps all of them. The partitions created by the users are never dropped.
+	CollectionPartitionRolloverRetentionKey = "collection.partitionRollover.retentionPeriods"
+)
+
+// DDL request keys
+
+const (
+	// DryRunKey marks a DDL request to be validated only, nothing is persisted.
+	DryRunKey = "dry_run"
 )
 
 const (
@@ -0,0 +1,49 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatCompositeFieldIDs encodes the field ids of a composite index into an index param value.
+func FormatCompositeFieldIDs(fieldIDs []int64) string {
+	strs := make([]string, 0, len(fieldIDs))
+	for _, fieldID := range fieldIDs {
+		strs = append(strs, strconv.FormatInt(fieldID, 10))
+	}
+	return strings.Join(strs, ",")
+}
+
+// ParseCompositeFieldIDs decodes the field ids of a composite index from an index param value.
+func ParseCompositeFieldIDs(value string) ([]int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	strs := strings.Split(value, ",")
+	fieldIDs := make([]int64, 0, len(strs))
+	for _, str := range strs {
+		fieldID, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", CompositeFieldIDsKey, value)
+		}
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	return fieldIDs, nil
+}
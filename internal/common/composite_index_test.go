@@ -0,0 +1,39 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositeFieldIDs(t *testing.T) {
+	value := FormatCompositeFieldIDs([]int64{101, 100})
+	assert.Equal(t, "101,100", value)
+
+	fieldIDs, err := ParseCompositeFieldIDs(value)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{101, 100}, fieldIDs)
+
+	fieldIDs, err = ParseCompositeFieldIDs("")
+	assert.NoError(t, err)
+	assert.Empty(t, fieldIDs)
+
+	_, err = ParseCompositeFieldIDs("101,abc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexFailureClass classifies the failures of index builds, so that the retry policies can differ by class.
+type IndexFailureClass string
+
+const (
+	IndexFailureUnknown       IndexFailureClass = "unknown"
+	IndexFailureOOM           IndexFailureClass = "oom"
+	IndexFailureStorageIO     IndexFailureClass = "storage_io"
+	IndexFailureCorruptBinlog IndexFailureClass = "corrupt_binlog"
+	IndexFailureInvalidParams IndexFailureClass = "invalid_params"
+	IndexFailureCancelled     IndexFailureClass = "cancelled"
+	IndexFailureEngine        IndexFailureClass = "engine_error"
+)
+
+// indexFailReasonPrefix leads the fail reasons of the classified index build failures,
+// the fail reasons without it are reported by the older index nodes.
+const indexFailReasonPrefix = "class: "
+
+// Retryable returns whether an index build failed by the class may succeed when built again.
+func (c IndexFailureClass) Retryable() bool {
+	switch c {
+	case IndexFailureInvalidParams, IndexFailureCorruptBinlog, IndexFailureCancelled:
+		return false
+	default:
+		return true
+	}
+}
+
+// IndexFailure is a classified index build failure, Code is the engine error code of the engine errors.
+type IndexFailure struct {
+	Class IndexFailureClass
+	Code  string
+	Err   error
+}
+
+// NewIndexFailure returns an IndexFailure of the class wrapping err.
+func NewIndexFailure(class IndexFailureClass, code string, err error) *IndexFailure {
+	return &IndexFailure{Class: class, Code: code, Err: err}
+}
+
+// Error returns the fail reason of the failure, which ParseIndexFailReason parses back.
+func (f *IndexFailure) Error() string {
+	return fmt.Sprintf("%s%s, code: %s, reason: %v", indexFailReasonPrefix, f.Class, f.Code, f.Err)
+}
+
+func (f *IndexFailure) Unwrap() error {
+	return f.Err
+}
+
+// ParseIndexFailReason parses the class, the engine error code and the original reason from the fail reason
+// of an index build. The fail reasons not classified are of IndexFailureUnknown.
+func ParseIndexFailReason(failReason string) (IndexFailureClass, string, string) {
+	if !strings.HasPrefix(failReason, indexFailReasonPrefix) {
+		return IndexFailureUnknown, "", failReason
+	}
+	parts := strings.SplitN(strings.TrimPrefix(failReason, indexFailReasonPrefix), ", ", 3)
+	if len(parts) != 3 || !strings.HasPrefix(parts[1], "code: ") || !strings.HasPrefix(parts[2], "reason: ") {
+		return IndexFailureUnknown, "", failReason
+	}
+	return IndexFailureClass(parts[0]), strings.TrimPrefix(parts[1], "code: "), strings.TrimPrefix(parts[2], "reason: ")
+}
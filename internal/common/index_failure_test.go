@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexFailure(t *testing.T) {
+	cause := errors.New("[IllegalArgument] nlist out of range, reason: bad")
+	failure := NewIndexFailure(IndexFailureEngine, "IllegalArgument", cause)
+	assert.True(t, errors.Is(failure, cause))
+
+	class, code, reason := ParseIndexFailReason(failure.Error())
+	assert.Equal(t, IndexFailureEngine, class)
+	assert.Equal(t, "IllegalArgument", code)
+	assert.Equal(t, cause.Error(), reason)
+
+	class, code, reason = ParseIndexFailReason(NewIndexFailure(IndexFailureStorageIO, "", cause).Error())
+	assert.Equal(t, IndexFailureStorageIO, class)
+	assert.Equal(t, "", code)
+	assert.Equal(t, cause.Error(), reason)
+
+	// not classified
+	class, code, reason = ParseIndexFailReason("auth failed")
+	assert.Equal(t, IndexFailureUnknown, class)
+	assert.Equal(t, "", code)
+	assert.Equal(t, "auth failed", reason)
+	class, _, reason = ParseIndexFailReason("class: oom")
+	assert.Equal(t, IndexFailureUnknown, class)
+	assert.Equal(t, "class: oom", reason)
+
+	assert.True(t, IndexFailureOOM.Retryable())
+	assert.True(t, IndexFailureStorageIO.Retryable())
+	assert.True(t, IndexFailureEngine.Retryable())
+	assert.True(t, IndexFailureUnknown.Retryable())
+	assert.False(t, IndexFailureInvalidParams.Retryable())
+	assert.False(t, IndexFailureCorruptBinlog.Retryable())
+	assert.False(t, IndexFailureCancelled.Retryable())
+}
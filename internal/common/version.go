@@ -5,6 +5,14 @@ import "github.com/blang/semver/v4"
 // Version current versiong for session
 var Version semver.Version
 
+// KnowhereVersion is the version of the knowhere library the indexes are built with. It's set by the build with
+// -ldflags from the version internal/core/thirdparty/knowhere fetches, and is unknown in the builds without it.
+var KnowhereVersion = "unknown"
+
+// IndexFileFormatVersion is the version of the index file format written by the current release,
+// it's bumped once the layout of the index files changes incompatibly.
+const IndexFileFormatVersion int32 = 1
+
 func init() {
 	Version, _ = semver.Parse("2.2.0-pre+dev")
 }
@@ -0,0 +1,280 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package config
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// FeatureFlagPrefix is the key prefix of the feature flags, the key of a flag is featureFlags.<component>.<name>
+const FeatureFlagPrefix = "featureFlags"
+
+// FeatureFlagCommonComponent is the component of the flags shared by all the components
+const FeatureFlagCommonComponent = "common"
+
+// FeatureFlagType is the type of feature flag
+type FeatureFlagType int
+
+const (
+	// BoolFlag turns a feature on or off
+	BoolFlag FeatureFlagType = iota
+	// PercentFlag turns a feature on for a percentage (0-100) of the requests, collections or nodes
+	PercentFlag
+)
+
+func (t FeatureFlagType) String() string {
+	switch t {
+	case BoolFlag:
+		return "bool"
+	case PercentFlag:
+		return "percent"
+	default:
+		return "unknown"
+	}
+}
+
+// FeatureFlag is a runtime switch of a risky feature, e.g. a new reduce path or a new GC
+type FeatureFlag struct {
+	Component    string
+	Name         string
+	Type         FeatureFlagType
+	DefaultValue string
+	Doc          string
+}
+
+// Key returns the config key of the flag
+func (f *FeatureFlag) Key() string {
+	return FeatureFlagPrefix + "." + f.Component + "." + f.Name
+}
+
+// parse returns the percentage of the flag value, a bool flag is either 0 or 100
+func (f *FeatureFlag) parse(value string) (int, error) {
+	switch f.Type {
+	case BoolFlag:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value %s of bool flag %s", value, f.Key())
+		}
+		if enabled {
+			return 100, nil
+		}
+		return 0, nil
+	case PercentFlag:
+		percent, err := strconv.Atoi(value)
+		if err != nil || percent < 0 || percent > 100 {
+			return 0, fmt.Errorf("invalid value %s of percent flag %s, expect 0-100", value, f.Key())
+		}
+		return percent, nil
+	default:
+		return 0, fmt.Errorf("unknown type %d of flag %s", f.Type, f.Key())
+	}
+}
+
+// FeatureFlagRegistry is the registry of the feature flags layered on the Manager,
+// the flags are read from the config sources, so they can be toggled at runtime through etcd.
+type FeatureFlagRegistry struct {
+	mgr *Manager
+
+	mu          sync.RWMutex
+	flags       map[string]*FeatureFlag        // formatted key -> flag
+	subscribers map[string][]func(percent int) // formatted key -> callbacks
+}
+
+// NewFeatureFlagRegistry creates a feature flag registry reading the flags from the manager
+func NewFeatureFlagRegistry(mgr *Manager) *FeatureFlagRegistry {
+	return &FeatureFlagRegistry{
+		mgr:         mgr,
+		flags:       make(map[string]*FeatureFlag),
+		subscribers: make(map[string][]func(percent int)),
+	}
+}
+
+// Register registers a flag, a flag can't be registered twice
+func (r *FeatureFlagRegistry) Register(flag *FeatureFlag) error {
+	if flag.Component == "" || flag.Name == "" {
+		return fmt.Errorf("invalid flag %s, component and name are required", flag.Key())
+	}
+	if _, err := flag.parse(flag.DefaultValue); err != nil {
+		return err
+	}
+	key := formatKey(flag.Key())
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.flags[key]; ok {
+		return fmt.Errorf("duplicated flag %s", flag.Key())
+	}
+	r.flags[key] = flag
+	return nil
+}
+
+func (r *FeatureFlagRegistry) getFlag(key string) (*FeatureFlag, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	flag, ok := r.flags[formatKey(key)]
+	return flag, ok
+}
+
+// getValue returns the raw value of the flag, the default value is returned if the flag is not configured
+func (r *FeatureFlagRegistry) getValue(flag *FeatureFlag) string {
+	value, err := r.mgr.GetConfig(flag.Key())
+	if err != nil {
+		return flag.DefaultValue
+	}
+	return value
+}
+
+// getPercent returns the percentage of the flag, an invalid value falls back to the default one
+func (r *FeatureFlagRegistry) getPercent(flag *FeatureFlag) int {
+	value := r.getValue(flag)
+	percent, err := flag.parse(value)
+	if err != nil {
+		log.Warn("invalid feature flag value, use the default one", zap.String("key", flag.Key()), zap.Error(err))
+		percent, _ = flag.parse(flag.DefaultValue)
+	}
+	return percent
+}
+
+// Percent returns the percentage of the flag, a bool flag is either 0 or 100, an unregistered flag is 0
+func (r *FeatureFlagRegistry) Percent(key string) int {
+	flag, ok := r.getFlag(key)
+	if !ok {
+		return 0
+	}
+	return r.getPercent(flag)
+}
+
+// IsEnabled returns whether the feature is enabled for all, an unregistered flag is disabled
+func (r *FeatureFlagRegistry) IsEnabled(key string) bool {
+	return r.Percent(key) >= 100
+}
+
+// IsEnabledFor returns whether the feature is enabled for the id, e.g. a collection or a node.
+// The ids are bucketed by the hash of the flag key and the id, so the same ids stay enabled
+// while the percentage of the flag grows.
+func (r *FeatureFlagRegistry) IsEnabledFor(key string, id string) bool {
+	percent := r.Percent(key)
+	if percent <= 0 {
+		return false
+	}
+	if percent >= 100 {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(formatKey(key) + "/" + id))
+	return int(h.Sum32()%100) < percent
+}
+
+// Set overrides the flag value on this node, and notifies the subscribers
+func (r *FeatureFlagRegistry) Set(key, value string) error {
+	flag, ok := r.getFlag(key)
+	if !ok {
+		return fmt.Errorf("flag %s not registered", key)
+	}
+	if _, err := flag.parse(value); err != nil {
+		return err
+	}
+	r.mgr.SetConfig(flag.Key(), value)
+	r.notify(flag)
+	return nil
+}
+
+// Reset removes the override of the flag value on this node, and notifies the subscribers
+func (r *FeatureFlagRegistry) Reset(key string) error {
+	flag, ok := r.getFlag(key)
+	if !ok {
+		return fmt.Errorf("flag %s not registered", key)
+	}
+	r.mgr.ResetConfig(flag.Key())
+	r.notify(flag)
+	return nil
+}
+
+// Subscribe registers the callback called with the percentage of the flag once the flag changes,
+// a bool flag is either 0 or 100.
+func (r *FeatureFlagRegistry) Subscribe(key string, callback func(percent int)) error {
+	flag, ok := r.getFlag(key)
+	if !ok {
+		return fmt.Errorf("flag %s not registered", key)
+	}
+	formatted := formatKey(flag.Key())
+	r.mu.Lock()
+	subscribed := len(r.subscribers[formatted]) > 0
+	r.subscribers[formatted] = append(r.subscribers[formatted], callback)
+	r.mu.Unlock()
+
+	if !subscribed {
+		// the dispatcher is guarded by the lock of the manager
+		r.mgr.Lock()
+		r.mgr.Dispatcher.Register(formatted, &featureFlagHandler{registry: r, flag: flag})
+		r.mgr.Unlock()
+	}
+	return nil
+}
+
+func (r *FeatureFlagRegistry) notify(flag *FeatureFlag) {
+	r.mu.RLock()
+	callbacks := append([]func(int){}, r.subscribers[formatKey(flag.Key())]...)
+	r.mu.RUnlock()
+	if len(callbacks) == 0 {
+		return
+	}
+	percent := r.getPercent(flag)
+	log.Info("feature flag changed", zap.String("key", flag.Key()), zap.Int("percent", percent))
+	for _, callback := range callbacks {
+		callback(percent)
+	}
+}
+
+// List returns the values of the flags of the component and the common flags, keyed by the flag keys
+func (r *FeatureFlagRegistry) List(component string) map[string]string {
+	r.mu.RLock()
+	flags := make([]*FeatureFlag, 0, len(r.flags))
+	for _, flag := range r.flags {
+		if strings.EqualFold(flag.Component, component) || strings.EqualFold(flag.Component, FeatureFlagCommonComponent) {
+			flags = append(flags, flag)
+		}
+	}
+	r.mu.RUnlock()
+
+	ret := make(map[string]string, len(flags))
+	for _, flag := range flags {
+		ret[flag.Key()] = r.getValue(flag)
+	}
+	return ret
+}
+
+// featureFlagHandler notifies the subscribers of a flag once the config sources change the flag
+type featureFlagHandler struct {
+	registry *FeatureFlagRegistry
+	flag     *FeatureFlag
+}
+
+// OnEvent is called with the lock of the manager held, the subscribers are notified asynchronously
+// so that they can read the configs.
+func (h *featureFlagHandler) OnEvent(event *Event) {
+	go h.registry.notify(h.flag)
+}
+
+func (h *featureFlagHandler) GetIdentifier() string {
+	return "FeatureFlag-" + h.flag.Key()
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatureFlagRegistry(t *testing.T) {
+	mgr := NewManager()
+	registry := NewFeatureFlagRegistry(mgr)
+
+	newReduce := &FeatureFlag{Component: "queryNode", Name: "newReduce", Type: BoolFlag, DefaultValue: "false"}
+	newGC := &FeatureFlag{Component: "dataCoord", Name: "newGC", Type: PercentFlag, DefaultValue: "0"}
+	tracing := &FeatureFlag{Component: FeatureFlagCommonComponent, Name: "tracing", Type: BoolFlag, DefaultValue: "true"}
+
+	t.Run("register", func(t *testing.T) {
+		assert.NoError(t, registry.Register(newReduce))
+		assert.NoError(t, registry.Register(newGC))
+		assert.NoError(t, registry.Register(tracing))
+		assert.Error(t, registry.Register(newReduce))
+		assert.Error(t, registry.Register(&FeatureFlag{Component: "queryNode", Name: "bad", Type: BoolFlag, DefaultValue: "maybe"}))
+		assert.Error(t, registry.Register(&FeatureFlag{Component: "queryNode", Name: "bad", Type: PercentFlag, DefaultValue: "101"}))
+		assert.Error(t, registry.Register(&FeatureFlag{Name: "bad", Type: BoolFlag, DefaultValue: "true"}))
+	})
+
+	t.Run("bool flag", func(t *testing.T) {
+		assert.Equal(t, "featureFlags.queryNode.newReduce", newReduce.Key())
+		assert.False(t, registry.IsEnabled(newReduce.Key()))
+		assert.Equal(t, 0, registry.Percent(newReduce.Key()))
+
+		assert.NoError(t, registry.Set(newReduce.Key(), "true"))
+		assert.True(t, registry.IsEnabled(newReduce.Key()))
+		assert.True(t, registry.IsEnabledFor(newReduce.Key(), "1"))
+		assert.Equal(t, 100, registry.Percent("featureflags.querynode.newreduce"))
+
+		assert.Error(t, registry.Set(newReduce.Key(), "maybe"))
+		assert.NoError(t, registry.Reset(newReduce.Key()))
+		assert.False(t, registry.IsEnabled(newReduce.Key()))
+
+		// an invalid value from the config sources falls back to the default one
+		mgr.SetConfig(newReduce.Key(), "maybe")
+		assert.False(t, registry.IsEnabled(newReduce.Key()))
+		mgr.ResetConfig(newReduce.Key())
+	})
+
+	t.Run("percent flag", func(t *testing.T) {
+		assert.NoError(t, registry.Set(newGC.Key(), "30"))
+		defer registry.Reset(newGC.Key())
+		assert.Equal(t, 30, registry.Percent(newGC.Key()))
+		assert.False(t, registry.IsEnabled(newGC.Key()))
+
+		enabled := make(map[string]struct{})
+		for i := 0; i < 1000; i++ {
+			id := fmt.Sprint(i)
+			if registry.IsEnabledFor(newGC.Key(), id) {
+				enabled[id] = struct{}{}
+			}
+		}
+		assert.InDelta(t, 300, len(enabled), 60)
+
+		// the enabled ids stay enabled while the percentage grows
+		assert.NoError(t, registry.Set(newGC.Key(), "60"))
+		for id := range enabled {
+			assert.True(t, registry.IsEnabledFor(newGC.Key(), id))
+		}
+
+		assert.NoError(t, registry.Set(newGC.Key(), "0"))
+		assert.False(t, registry.IsEnabledFor(newGC.Key(), "1"))
+		assert.Error(t, registry.Set(newGC.Key(), "-1"))
+	})
+
+	t.Run("unregistered flag", func(t *testing.T) {
+		assert.False(t, registry.IsEnabled("featureFlags.queryNode.unknown"))
+		assert.False(t, registry.IsEnabledFor("featureFlags.queryNode.unknown", "1"))
+		assert.Error(t, registry.Set("featureFlags.queryNode.unknown", "true"))
+		assert.Error(t, registry.Reset("featureFlags.queryNode.unknown"))
+		assert.Error(t, registry.Subscribe("featureFlags.queryNode.unknown", func(int) {}))
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		ch := make(chan int, 10)
+		assert.NoError(t, registry.Subscribe(newReduce.Key(), func(percent int) { ch <- percent }))
+
+		assert.NoError(t, registry.Set(newReduce.Key(), "true"))
+		assert.Equal(t, 100, <-ch)
+		assert.NoError(t, registry.Reset(newReduce.Key()))
+		assert.Equal(t, 0, <-ch)
+
+		// changed by the config sources
+		mgr.SetConfig(newReduce.Key(), "true")
+		defer mgr.ResetConfig(newReduce.Key())
+		mgr.OnEvent(&Event{EventSource: "test", EventType: CreateType, Key: formatKey(newReduce.Key()), Value: "true"})
+		select {
+		case percent := <-ch:
+			assert.Equal(t, 100, percent)
+		case <-time.After(time.Second):
+			assert.Fail(t, "subscriber not notified")
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		flags := registry.List("querynode")
+		assert.Equal(t, 2, len(flags))
+		assert.Contains(t, flags, newReduce.Key())
+		assert.Equal(t, "true", flags[tracing.Key()])
+
+		flags = registry.List("dataCoord")
+		assert.Equal(t, 2, len(flags))
+		assert.Equal(t, "0", flags[newGC.Key()])
+	})
+}
@@ -29,6 +29,23 @@ const (
 	TombValue = "TOMB_VAULE"
 )
 
+// the kinds of the config sources reported with the configs
+const (
+	SourceKindFile     = "file"
+	SourceKindEnv      = "env"
+	SourceKindEtcd     = "etcd"
+	SourceKindOverride = "override"
+	SourceKindDefault  = "default"
+)
+
+// ConfigValue is the effective value of a config with the kind of the source it comes from,
+// Updated is true if the config is changed dynamically since the start
+type ConfigValue struct {
+	Value   string
+	Source  string
+	Updated bool
+}
+
 type Filter func(key string) (string, bool)
 
 func WithSubstr(substring string) Filter {
@@ -83,6 +100,8 @@ type Manager struct {
 	sources        map[string]Source
 	keySourceMap   map[string]string
 	overlayConfigs map[string]string
+	// the formatted keys changed by the events or overridden since the start
+	updatedKeys map[string]struct{}
 }
 
 func NewManager() *Manager {
@@ -91,6 +110,7 @@ func NewManager() *Manager {
 		sources:        make(map[string]Source),
 		keySourceMap:   make(map[string]string),
 		overlayConfigs: make(map[string]string),
+		updatedKeys:    make(map[string]struct{}),
 	}
 }
 
@@ -132,6 +152,68 @@ func (m *Manager) GetBy(filters ...Filter) map[string]string {
 	return matchedConfig
 }
 
+// GetByWithSource is GetBy with the source of every config, an overridden config reports the overriding value
+func (m *Manager) GetByWithSource(filters ...Filter) map[string]ConfigValue {
+	m.RLock()
+	defer m.RUnlock()
+	matchedConfig := make(map[string]ConfigValue)
+
+	for key, sourceName := range m.keySourceMap {
+		newkey, ok := filterate(key, filters...)
+		if !ok {
+			continue
+		}
+		value, err := m.getConfigValue(key, sourceName)
+		if err != nil {
+			continue
+		}
+		matchedConfig[newkey] = value
+	}
+
+	return matchedConfig
+}
+
+// GetConfigWithSource returns the effective value of the key with its source
+func (m *Manager) GetConfigWithSource(key string) (ConfigValue, error) {
+	m.RLock()
+	defer m.RUnlock()
+	realKey := formatKey(key)
+	return m.getConfigValue(realKey, m.keySourceMap[realKey])
+}
+
+func (m *Manager) getConfigValue(key, sourceName string) (ConfigValue, error) {
+	realKey := formatKey(key)
+	_, updated := m.updatedKeys[realKey]
+	if v, ok := m.overlayConfigs[realKey]; ok {
+		if v == TombValue {
+			return ConfigValue{}, fmt.Errorf("key not found %s", key)
+		}
+		return ConfigValue{Value: v, Source: SourceKindOverride, Updated: updated}, nil
+	}
+	if sourceName == "" {
+		return ConfigValue{}, fmt.Errorf("key not found: %s", key)
+	}
+	v, err := m.getConfigValueBySource(key, sourceName)
+	if err != nil {
+		return ConfigValue{}, err
+	}
+	return ConfigValue{Value: v, Source: sourceKind(sourceName), Updated: updated}, nil
+}
+
+// sourceKind returns the kind of the source reported with the configs
+func sourceKind(sourceName string) string {
+	switch sourceName {
+	case "FileSource":
+		return SourceKindFile
+	case "EnvironmentSource":
+		return SourceKindEnv
+	case "EtcdSource":
+		return SourceKindEtcd
+	default:
+		return sourceName
+	}
+}
+
 // GetConfigs returns all the key values
 func (m *Manager) GetConfigs() map[string]string {
 	m.RLock()
@@ -183,6 +265,7 @@ func (m *Manager) SetConfig(key, value string) {
 	m.Lock()
 	defer m.Unlock()
 	m.overlayConfigs[formatKey(key)] = value
+	m.updatedKeys[formatKey(key)] = struct{}{}
 }
 
 // For compatible reason, only visiable for Test
@@ -190,12 +273,14 @@ func (m *Manager) DeleteConfig(key string) {
 	m.Lock()
 	defer m.Unlock()
 	m.overlayConfigs[formatKey(key)] = TombValue
+	m.updatedKeys[formatKey(key)] = struct{}{}
 }
 
 func (m *Manager) ResetConfig(key string) {
 	m.Lock()
 	defer m.Unlock()
 	delete(m.overlayConfigs, formatKey(key))
+	m.updatedKeys[formatKey(key)] = struct{}{}
 }
 
 // Do not use it directly, only used when add source and unittests.
@@ -301,6 +386,7 @@ func (m *Manager) OnEvent(event *Event) {
 		log.Warn("failed in updating event with error", zap.Error(err), zap.Any("event", event))
 		return
 	}
+	m.updatedKeys[formatKey(event.Key)] = struct{}{}
 
 	m.Dispatcher.Dispatch(event)
 }
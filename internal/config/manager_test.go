@@ -47,6 +47,35 @@ func TestAllDupliateSource(t *testing.T) {
 	assert.Error(t, err, "invalid source or source not added")
 }
 
+func TestConfigWithSource(t *testing.T) {
+	t.Setenv("TEST_CONFIG_SOURCE_KEY", "env")
+	mgr, _ := Init(WithEnvSource(formatKey))
+
+	value, err := mgr.GetConfigWithSource("test.config.source.key")
+	assert.NoError(t, err)
+	assert.Equal(t, ConfigValue{Value: "env", Source: SourceKindEnv}, value)
+
+	mgr.OnEvent(newEvent("EnvironmentSource", UpdateType, "testconfigsourcekey", "env"))
+	value, err = mgr.GetConfigWithSource("test.config.source.key")
+	assert.NoError(t, err)
+	assert.True(t, value.Updated)
+
+	mgr.SetConfig("test.config.source.key", "override")
+	value, err = mgr.GetConfigWithSource("test.config.source.key")
+	assert.NoError(t, err)
+	assert.Equal(t, ConfigValue{Value: "override", Source: SourceKindOverride, Updated: true}, value)
+	configs := mgr.GetByWithSource(WithPrefix("testconfigsource"))
+	assert.Equal(t, "override", configs["testconfigsourcekey"].Value)
+
+	mgr.DeleteConfig("test.config.source.key")
+	_, err = mgr.GetConfigWithSource("test.config.source.key")
+	assert.Error(t, err)
+	assert.NotContains(t, mgr.GetByWithSource(WithPrefix("testconfigsource")), "testconfigsourcekey")
+
+	_, err = mgr.GetConfigWithSource("not.exist.key")
+	assert.Error(t, err)
+}
+
 type ErrSource struct {
 }
 
@@ -9,6 +9,7 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <omp.h>
 #include <string>
 
 #ifdef __linux__
@@ -193,3 +194,10 @@ CleanLocalData(CIndex index) {
     }
     return status;
 }
+
+int
+SetBuildThreadNum(int num_threads) {
+    auto prev = omp_get_max_threads();
+    omp_set_num_threads(num_threads);
+    return prev;
+}
@@ -53,6 +53,10 @@ LoadIndexFromBinarySet(CIndex index, CBinarySet c_binary_set);
 CStatus
 CleanLocalData(CIndex index);
 
+// set the number of the openmp threads of the builds called by the current thread, return the previous number
+int
+SetBuildThreadNum(int num_threads);
+
 #ifdef __cplusplus
 };
 #endif
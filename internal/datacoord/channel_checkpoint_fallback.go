@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// startChannelCheckpointReconcileLoop applies the channel checkpoints persisted to object storage by the DataNodes
+// failed to update them to DataCoord, once before the channels are watched and then periodically.
+func (s *Server) startChannelCheckpointReconcileLoop(ctx context.Context) {
+	interval := Params.DataCoordCfg.ChannelCheckpointFallbackInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		return
+	}
+	s.reconcileChannelCheckpoints(ctx)
+
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("channel checkpoint reconcile loop shutdown")
+				return
+			case <-ticker.C:
+				s.reconcileChannelCheckpoints(ctx)
+			}
+		}
+	}()
+}
+
+// reconcileChannelCheckpoints applies the persisted checkpoints newer than the ones in meta and removes them.
+// The checkpoints of the vchannels without a checkpoint in meta are removed only, the vchannels are dropped.
+func (s *Server) reconcileChannelCheckpoints(ctx context.Context) {
+	cli := s.meta.chunkManager
+	if cli == nil {
+		return
+	}
+	prefix := path.Join(cli.RootPath(), common.ChannelCheckpointFallbackPath) + "/"
+	keys, _, err := cli.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		log.Warn("failed to list persisted channel checkpoints", zap.String("prefix", prefix), zap.Error(err))
+		return
+	}
+
+	applied := 0
+	for _, key := range keys {
+		vChannel := path.Base(key)
+		if err := s.reconcileChannelCheckpoint(ctx, vChannel, key); err != nil {
+			log.Warn("failed to reconcile persisted channel checkpoint", zap.String("channel", vChannel),
+				zap.String("key", key), zap.Error(err))
+			continue
+		}
+		applied++
+	}
+	if len(keys) > 0 {
+		log.Info("persisted channel checkpoints reconciled", zap.Int("num", len(keys)), zap.Int("reconciledNum", applied))
+	}
+}
+
+func (s *Server) reconcileChannelCheckpoint(ctx context.Context, vChannel string, key string) error {
+	cli := s.meta.chunkManager
+	value, err := cli.Read(ctx, key)
+	if err != nil {
+		return err
+	}
+	position := &internalpb.MsgPosition{}
+	if err := proto.Unmarshal(value, position); err != nil {
+		log.Warn("drop corrupted persisted channel checkpoint", zap.String("channel", vChannel), zap.Error(err))
+		return cli.Remove(ctx, key)
+	}
+
+	if current := s.meta.GetChannelCheckpoint(vChannel); current != nil && current.GetTimestamp() < position.GetTimestamp() {
+		if err := s.meta.UpdateChannelCheckpoint(vChannel, position); err != nil {
+			return err
+		}
+		channelCPTs, _ := tsoutil.ParseTS(position.GetTimestamp())
+		log.Info("persisted channel checkpoint applied", zap.String("channel", vChannel), zap.Time("channelCPTs", channelCPTs))
+	}
+	return cli.Remove(ctx, key)
+}
@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestServer_ReconcileChannelCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	cli := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	m, err := newMeta(ctx, memkv.NewMemoryKV(), "", cli)
+	require.NoError(t, err)
+	svr := &Server{meta: m}
+
+	persist := func(vChannel string, value []byte) string {
+		key := path.Join(cli.RootPath(), common.ChannelCheckpointFallbackPath, vChannel)
+		require.NoError(t, cli.Write(ctx, key, value))
+		return key
+	}
+	marshal := func(ts uint64) []byte {
+		value, err := proto.Marshal(&internalpb.MsgPosition{ChannelName: "ch", Timestamp: ts})
+		require.NoError(t, err)
+		return value
+	}
+
+	require.NoError(t, m.UpdateChannelCheckpoint("ch-1", &internalpb.MsgPosition{Timestamp: 100}))
+	require.NoError(t, m.UpdateChannelCheckpoint("ch-2", &internalpb.MsgPosition{Timestamp: 300}))
+	keys := []string{
+		// newer, applied
+		persist("ch-1", marshal(200)),
+		// older, ignored
+		persist("ch-2", marshal(200)),
+		// dropped channel
+		persist("ch-3", marshal(200)),
+		// corrupted
+		persist("ch-4", []byte("corrupted")),
+	}
+
+	svr.reconcileChannelCheckpoints(ctx)
+	assert.Equal(t, uint64(200), m.GetChannelCheckpoint("ch-1").GetTimestamp())
+	assert.Equal(t, uint64(300), m.GetChannelCheckpoint("ch-2").GetTimestamp())
+	assert.Nil(t, m.GetChannelCheckpoint("ch-3"))
+	for _, key := range keys {
+		exist, err := cli.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.False(t, exist, key)
+	}
+
+	// nothing persisted
+	svr.reconcileChannelCheckpoints(ctx)
+
+	// no chunk manager
+	svr = &Server{meta: &meta{}}
+	svr.reconcileChannelCheckpoints(ctx)
+}
@@ -0,0 +1,295 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// collectionPauseManager keeps the collections whose compaction or binlog gc is paused by the admin,
+// the pauses are written through to the catalog so they survive DataCoord restarts until resumed.
+type collectionPauseManager struct {
+	catalog metastore.DataCoordCatalog
+	now     func() time.Time
+
+	mu     sync.RWMutex
+	pauses map[UniqueID]*model.CollectionPause
+}
+
+func newCollectionPauseManager(catalog metastore.DataCoordCatalog) *collectionPauseManager {
+	return &collectionPauseManager{
+		catalog: catalog,
+		now:     time.Now,
+		pauses:  make(map[UniqueID]*model.CollectionPause),
+	}
+}
+
+// load reloads the pauses from the catalog.
+func (m *collectionPauseManager) load() error {
+	pauses, err := m.catalog.ListCollectionPauses(context.TODO())
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, pause := range pauses {
+		m.pauses[pause.CollectionID] = pause
+	}
+	return nil
+}
+
+// save persists the pause of the collection, it is removed if nothing is paused anymore.
+func (m *collectionPauseManager) save(pause *model.CollectionPause) error {
+	if !pause.Compaction && !pause.GC {
+		return m.catalog.DropCollectionPause(context.TODO(), pause.CollectionID)
+	}
+	return m.catalog.SaveCollectionPause(context.TODO(), pause)
+}
+
+// Pause pauses the compaction and/or the gc of the collection, the scopes already paused are kept.
+func (m *collectionPauseManager) Pause(collectionID UniqueID, compaction, gc bool, reason string) (*model.CollectionPause, error) {
+	if !compaction && !gc {
+		return nil, errors.New("nothing to pause, either compaction or gc should be paused")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pause := &model.CollectionPause{CollectionID: collectionID}
+	if old, ok := m.pauses[collectionID]; ok {
+		*pause = *old
+	}
+	pause.Compaction = pause.Compaction || compaction
+	pause.GC = pause.GC || gc
+	pause.Reason = reason
+	pause.PausedAt = m.now()
+	if err := m.save(pause); err != nil {
+		return nil, err
+	}
+	m.pauses[collectionID] = pause
+	log.Info("collection maintenance paused", zap.Int64("collectionID", collectionID),
+		zap.Bool("compaction", pause.Compaction), zap.Bool("gc", pause.GC), zap.String("reason", reason))
+	return pause, nil
+}
+
+// Resume resumes the compaction and/or the gc of the collection, returns false if they are not paused.
+func (m *collectionPauseManager) Resume(collectionID UniqueID, compaction, gc bool) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	old, ok := m.pauses[collectionID]
+	if !ok || !(compaction && old.Compaction || gc && old.GC) {
+		return false, nil
+	}
+	pause := *old
+	pause.Compaction = pause.Compaction && !compaction
+	pause.GC = pause.GC && !gc
+	if err := m.save(&pause); err != nil {
+		return false, err
+	}
+	if pause.Compaction || pause.GC {
+		m.pauses[collectionID] = &pause
+	} else {
+		delete(m.pauses, collectionID)
+	}
+	log.Info("collection maintenance resumed", zap.Int64("collectionID", collectionID),
+		zap.Bool("compaction", compaction), zap.Bool("gc", gc))
+	return true, nil
+}
+
+// IsCompactionPaused returns whether the compaction of the collection is paused.
+func (m *collectionPauseManager) IsCompactionPaused(collectionID UniqueID) bool {
+	if m == nil {
+		return false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	pause, ok := m.pauses[collectionID]
+	return ok && pause.Compaction
+}
+
+// IsGCPaused returns whether the binlog gc of the collection is paused.
+func (m *collectionPauseManager) IsGCPaused(collectionID UniqueID) bool {
+	if m == nil {
+		return false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	pause, ok := m.pauses[collectionID]
+	return ok && pause.GC
+}
+
+// List returns the pauses sorted by collection.
+func (m *collectionPauseManager) List() []*model.CollectionPause {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*model.CollectionPause, 0, len(m.pauses))
+	for _, pause := range m.pauses {
+		ret = append(ret, pause)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].CollectionID < ret[j].CollectionID })
+	return ret
+}
+
+// parseCollectionIDByBinlog parses the collection id of the binlog path "[log_type]/collID/partID/segID/...".
+func parseCollectionIDByBinlog(rootPath, binlogPath string) (UniqueID, error) {
+	if !strings.HasPrefix(binlogPath, rootPath) {
+		return 0, fmt.Errorf("path \"%s\" does not contains rootPath \"%s\"", binlogPath, rootPath)
+	}
+	keyStr := strings.Split(strings.TrimLeft(binlogPath[len(rootPath):], "/"), "/")
+	if len(keyStr) < 2 {
+		return 0, fmt.Errorf("%s is not a valid binlog path", binlogPath)
+	}
+	return strconv.ParseInt(keyStr[1], 10, 64)
+}
+
+// parsePauseScope returns whether the scope covers the compaction and the gc.
+func parsePauseScope(scope datapb.MaintenanceScope) (compaction bool, gc bool, err error) {
+	switch scope {
+	case datapb.MaintenanceScope_MaintenanceCompaction:
+		return true, false, nil
+	case datapb.MaintenanceScope_MaintenanceGC:
+		return false, true, nil
+	case datapb.MaintenanceScope_MaintenanceAll:
+		return true, true, nil
+	default:
+		return false, false, fmt.Errorf("invalid maintenance scope %d", scope)
+	}
+}
+
+// collectionPauseToProto converts the pause to the proto returned by the maintenance pause rpcs.
+func collectionPauseToProto(p *model.CollectionPause) *datapb.CollectionMaintenancePause {
+	return &datapb.CollectionMaintenancePause{
+		CollectionID: p.CollectionID,
+		Compaction:   p.Compaction,
+		Gc:           p.GC,
+		Reason:       p.Reason,
+		PausedAt:     p.PausedAt.UnixMilli(),
+	}
+}
+
+// PauseCollectionMaintenance pauses the compaction and/or the binlog gc of the collection, the scopes already paused
+// are kept.
+func (s *Server) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	if s.isClosed() {
+		return &datapb.PauseCollectionMaintenanceResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	compaction, gc, err := parsePauseScope(req.GetScope())
+	if err != nil {
+		return &datapb.PauseCollectionMaintenanceResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	pause, err := s.meta.collectionPauses.Pause(req.GetCollectionID(), compaction, gc, req.GetReason())
+	if err != nil {
+		log.Warn("failed to pause collection maintenance", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return &datapb.PauseCollectionMaintenanceResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &datapb.PauseCollectionMaintenanceResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Pause: collectionPauseToProto(pause),
+	}, nil
+}
+
+// ResumeCollectionMaintenance resumes the compaction and/or the binlog gc of the collection, IllegalArgument is
+// returned if they are not paused.
+func (s *Server) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	if s.isClosed() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	compaction, gc, err := parsePauseScope(req.GetScope())
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	resumed, err := s.meta.collectionPauses.Resume(req.GetCollectionID(), compaction, gc)
+	if err != nil {
+		log.Warn("failed to resume collection maintenance", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if !resumed {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    fmt.Sprintf("collection %d is not paused", req.GetCollectionID()),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// GetCollectionMaintenancePauses returns the paused collections sorted by collection.
+func (s *Server) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetCollectionMaintenancePausesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	pauses := s.meta.collectionPauses.List()
+	ret := make([]*datapb.CollectionMaintenancePause, 0, len(pauses))
+	for _, pause := range pauses {
+		ret = append(ret, collectionPauseToProto(pause))
+	}
+	return &datapb.GetCollectionMaintenancePausesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Pauses: ret,
+	}, nil
+}
@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestCollectionPauseManager(t *testing.T) {
+	catalog := &datacoord.Catalog{Txn: memkv.NewMemoryKV()}
+	m := newCollectionPauseManager(catalog)
+
+	_, err := m.Pause(1, false, false, "")
+	assert.Error(t, err)
+
+	_, err = m.Pause(1, true, false, "backup")
+	require.NoError(t, err)
+	assert.True(t, m.IsCompactionPaused(1))
+	assert.False(t, m.IsGCPaused(1))
+	pause, err := m.Pause(1, false, true, "debug")
+	require.NoError(t, err)
+	assert.True(t, pause.Compaction)
+	assert.True(t, pause.GC)
+	assert.Equal(t, "debug", pause.Reason)
+	_, err = m.Pause(2, false, true, "")
+	require.NoError(t, err)
+
+	t.Run("reload", func(t *testing.T) {
+		reloaded := newCollectionPauseManager(catalog)
+		require.NoError(t, reloaded.load())
+		pauses := reloaded.List()
+		require.Equal(t, 2, len(pauses))
+		assert.Equal(t, UniqueID(1), pauses[0].CollectionID)
+		assert.True(t, reloaded.IsCompactionPaused(1))
+		assert.True(t, reloaded.IsGCPaused(2))
+		assert.False(t, reloaded.IsCompactionPaused(2))
+	})
+
+	resumed, err := m.Resume(1, true, false)
+	require.NoError(t, err)
+	assert.True(t, resumed)
+	assert.False(t, m.IsCompactionPaused(1))
+	assert.True(t, m.IsGCPaused(1))
+	resumed, err = m.Resume(1, true, false)
+	require.NoError(t, err)
+	assert.False(t, resumed)
+	resumed, err = m.Resume(1, true, true)
+	require.NoError(t, err)
+	assert.True(t, resumed)
+	assert.False(t, m.IsGCPaused(1))
+	resumed, err = m.Resume(3, true, true)
+	require.NoError(t, err)
+	assert.False(t, resumed)
+
+	pauses, err := catalog.ListCollectionPauses(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(pauses))
+
+	var nilManager *collectionPauseManager
+	assert.False(t, nilManager.IsCompactionPaused(1))
+	assert.False(t, nilManager.IsGCPaused(1))
+	assert.Nil(t, nilManager.List())
+}
+
+func TestParseCollectionIDByBinlog(t *testing.T) {
+	collectionID, err := parseCollectionIDByBinlog("files", "files/insert_log/100/10/1/0/1")
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(100), collectionID)
+	_, err = parseCollectionIDByBinlog("files", "other/insert_log/100/10/1/0/1")
+	assert.Error(t, err)
+	_, err = parseCollectionIDByBinlog("files", "files/insert_log")
+	assert.Error(t, err)
+}
+
+func TestGarbageCollector_CollectionGCPaused(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	segment := buildSegment(1, 10, 100, "ch", false)
+	segment.State = commonpb.SegmentState_Dropped
+	segment.DroppedAt = uint64(time.Now().Add(-time.Hour).UnixNano())
+	require.NoError(t, meta.AddSegment(segment))
+	_, err = meta.collectionPauses.Pause(1, false, true, "")
+	require.NoError(t, err)
+
+	gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{dropTolerance: 0})
+	gc.clearEtcd()
+	assert.NotNil(t, meta.GetSegmentUnsafe(100))
+
+	_, err = meta.collectionPauses.Resume(1, false, true)
+	require.NoError(t, err)
+	gc.clearEtcd()
+	assert.Nil(t, meta.GetSegmentUnsafe(100))
+}
+
+func TestServer_CollectionMaintenancePause(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	s := &Server{
+		meta:          meta,
+		handler:       newMockHandler(),
+		freezeManager: newFreezeManager(),
+		session:       &sessionutil.Session{ServerID: 1},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	pauseResp, err := s.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{
+		CollectionID: 1,
+		Scope:        datapb.MaintenanceScope_MaintenanceCompaction,
+		Reason:       "backup",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, pauseResp.GetStatus().GetErrorCode())
+	assert.True(t, pauseResp.GetPause().GetCompaction())
+	assert.Equal(t, "backup", pauseResp.GetPause().GetReason())
+	assert.True(t, meta.collectionPauses.IsCompactionPaused(1))
+	assert.False(t, meta.collectionPauses.IsGCPaused(1))
+	pauseResp, err = s.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, pauseResp.GetStatus().GetErrorCode())
+	assert.True(t, meta.collectionPauses.IsGCPaused(2))
+
+	listResp, err := s.GetCollectionMaintenancePauses(ctx, &datapb.GetCollectionMaintenancePausesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	require.Equal(t, 2, len(listResp.GetPauses()))
+	assert.Equal(t, int64(1), listResp.GetPauses()[0].GetCollectionID())
+	assert.NotZero(t, listResp.GetPauses()[0].GetPausedAt())
+
+	compactResp, err := s.ManualCompaction(ctx, &milvuspb.ManualCompactionRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, compactResp.GetStatus().GetErrorCode())
+
+	pauseResp, err = s.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{CollectionID: 1, Scope: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, pauseResp.GetStatus().GetErrorCode())
+
+	status, err := s.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{
+		CollectionID: 2,
+		Scope:        datapb.MaintenanceScope_MaintenanceGC,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.False(t, meta.collectionPauses.IsGCPaused(2))
+	status, err = s.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{
+		CollectionID: 1,
+		Scope:        datapb.MaintenanceScope_MaintenanceGC,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = s.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.False(t, meta.collectionPauses.IsCompactionPaused(1))
+	status, err = s.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{CollectionID: 1, Scope: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	pauseResp, err = s.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, pauseResp.GetStatus().GetErrorCode())
+	status, err = s.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	listResp, err = s.GetCollectionMaintenancePauses(ctx, &datapb.GetCollectionMaintenancePausesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+}
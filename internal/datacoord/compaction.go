@@ -214,9 +214,9 @@ func (c *compactionPlanHandler) setSegmentsCompacting(plan *datapb.CompactionPla
 	}
 }
 
-// complete a compaction task
+// complete a compaction task, splits are the results of the other compactedTo segments if DataNode split the merged rows
 // not threadsafe, only can be used internally
-func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResult) error {
+func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResult, splits ...*datapb.CompactionResult) error {
 	planID := result.PlanID
 	if _, ok := c.plans[planID]; !ok {
 		return fmt.Errorf("plan %d is not found", planID)
@@ -229,7 +229,7 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	plan := c.plans[planID].plan
 	switch plan.GetType() {
 	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
-		if err := c.handleMergeCompactionResult(plan, result); err != nil {
+		if err := c.handleMergeCompactionResult(plan, result, splits...); err != nil {
 			return err
 		}
 	default:
@@ -240,6 +240,9 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
 		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
 		c.flushCh <- result.GetSegmentID()
+		for _, split := range splits {
+			c.flushCh <- split.GetSegmentID()
+		}
 	}
 	// TODO: when to clean task list
 
@@ -248,37 +251,51 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	return nil
 }
 
-func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult, splits ...*datapb.CompactionResult) error {
 	// Also prepare metric updates.
-	oldSegments, modSegments, newSegment, metricMutation, err := c.meta.PrepareCompleteCompactionMutation(plan.GetSegmentBinlogs(), result)
+	results := append([]*datapb.CompactionResult{result}, splits...)
+	oldSegments, modSegments, newSegments, metricMutation, err := c.meta.PrepareCompleteSplitCompactionMutation(plan.GetSegmentBinlogs(), results...)
 	if err != nil {
 		return err
 	}
 	log := log.With(zap.Int64("planID", plan.GetPlanID()))
 
-	log.Info("handleCompactionResult: altering metastore after compaction")
-	if err := c.meta.alterMetaStoreAfterCompaction(modSegments, newSegment); err != nil {
+	log.Info("handleCompactionResult: altering metastore after compaction", zap.Int("compactedTo segments", len(newSegments)))
+	if err := c.meta.alterMetaStoreAfterCompaction(modSegments, newSegments...); err != nil {
 		log.Warn("handleCompactionResult: fail to alter metastore after compaction", zap.Error(err))
 		return fmt.Errorf("fail to alter metastore after compaction, err=%w", err)
 	}
 
+	// DataNode merges all the compactedTo segments of a split plan with the first sync, so only a failure of
+	// the first sync is reverted, the rest ones are sent for the DataNodes not keeping the split results.
 	var nodeID = c.plans[plan.GetPlanID()].dataNodeID
-	req := &datapb.SyncSegmentsRequest{
-		PlanID:        plan.PlanID,
-		CompactedTo:   newSegment.GetID(),
-		CompactedFrom: newSegment.GetCompactionFrom(),
-		NumOfRows:     newSegment.GetNumOfRows(),
-		StatsLogs:     newSegment.GetStatslogs(),
-	}
+	for i, newSegment := range newSegments {
+		req := &datapb.SyncSegmentsRequest{
+			PlanID:        plan.PlanID,
+			CompactedTo:   newSegment.GetID(),
+			CompactedFrom: newSegment.GetCompactionFrom(),
+			NumOfRows:     newSegment.GetNumOfRows(),
+			StatsLogs:     newSegment.GetStatslogs(),
+		}
 
-	log.Info("handleCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID))
-	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
-		log.Warn("handleCompactionResult: fail to sync segments with node, reverting metastore",
-			zap.Int64("nodeID", nodeID), zap.String("reason", err.Error()))
-		return c.meta.revertAlterMetaStoreAfterCompaction(oldSegments, newSegment)
+		log.Info("handleCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID), zap.Int64("compactedTo", newSegment.GetID()))
+		if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+			if i > 0 {
+				log.Warn("handleCompactionResult: fail to sync split segment with node",
+					zap.Int64("nodeID", nodeID), zap.Int64("compactedTo", newSegment.GetID()), zap.Error(err))
+				continue
+			}
+			log.Warn("handleCompactionResult: fail to sync segments with node, reverting metastore",
+				zap.Int64("nodeID", nodeID), zap.String("reason", err.Error()))
+			return c.meta.revertAlterMetaStoreAfterCompaction(oldSegments, newSegments...)
+		}
 	}
 	// Apply metrics after successful meta update.
 	metricMutation.commit()
+	if len(newSegments) > 0 {
+		c.meta.UpdateCompactionTravelWatermark(newSegments[0].GetCollectionID(), plan.GetTimetravel())
+		c.meta.CompactDeleteSLAMarkers(newSegments[0].GetCompactionFrom(), newSegments[0].GetID(), plan.GetTimetravel())
+	}
 
 	log.Info("handleCompactionResult: success to handle merge compaction result")
 	return nil
@@ -301,7 +318,11 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 
 	tasks := c.getExecutingCompactions()
 	for _, task := range tasks {
-		stateResult, ok := planStates[task.plan.PlanID]
+		stateResults, ok := planStates[task.plan.PlanID]
+		var stateResult *datapb.CompactionStateResult
+		if ok {
+			stateResult = stateResults[0]
+		}
 		state := stateResult.GetState()
 		planID := task.plan.PlanID
 		startTime := task.plan.GetStartTime()
@@ -314,7 +335,11 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 		if ok {
 			if state == commonpb.CompactionState_Completed {
 				log.Info("compaction completed", zap.Int64("planID", planID), zap.Int64("nodeID", task.dataNodeID))
-				c.completeCompaction(stateResult.GetResult())
+				splits := make([]*datapb.CompactionResult, 0, len(stateResults)-1)
+				for _, split := range stateResults[1:] {
+					splits = append(splits, split.GetResult())
+				}
+				c.completeCompaction(stateResult.GetResult(), splits...)
 				continue
 			}
 			// check wether the CompactionPlan is timeout
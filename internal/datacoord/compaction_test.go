@@ -276,7 +276,8 @@ func TestCompactionPlanHandler_handleMergeCompactionResult(t *testing.T) {
 				Deltalogs:           seg2.GetDeltalogs(),
 			},
 		},
-		Type: datapb.CompactionType_MergeCompaction,
+		Type:       datapb.CompactionType_MergeCompaction,
+		Timetravel: 1000,
 	}
 
 	sessions := &SessionManager{
@@ -361,9 +362,11 @@ func TestCompactionPlanHandler_handleMergeCompactionResult(t *testing.T) {
 
 	err = c.handleMergeCompactionResult(plan, compactionResult2)
 	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID]Timestamp{0: 1000}, c.meta.GetCompactionTravelWatermarks())
 
 	err = c2.handleMergeCompactionResult(plan, compactionResult2)
 	assert.Error(t, err)
+	assert.Empty(t, c2.meta.GetCompactionTravelWatermarks())
 
 	has, err = c.meta.HasSegments([]UniqueID{1, 2, 3})
 	require.NoError(t, err)
@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -49,6 +50,10 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64) (UniqueID, error)
+	// forceTriggerSegmentsCompaction force to compact the given segments into one
+	forceTriggerSegmentsCompaction(segmentIDs []int64) (UniqueID, error)
+	// triggerCollectionCompaction triggers a compaction of the collection if any compaction condition satisfy
+	triggerCollectionCompaction(collectionID int64) (UniqueID, error)
 }
 
 type compactionSignal struct {
@@ -77,6 +82,8 @@ type compactionTrigger struct {
 	//indexCoord                   types.IndexCoord
 	estimateNonDiskSegmentPolicy calUpperLimitPolicy
 	estimateDiskSegmentPolicy    calUpperLimitPolicy
+	// compaction of the collections in freeze windows is skipped
+	freezeManager *freezeManager
 	// A sloopy hack, so we can test with different segment row count without worrying that
 	// they are re-calculated in every compaction.
 	testingOnly bool
@@ -240,6 +247,23 @@ func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, s
 	return nil
 }
 
+// triggerCollectionCompaction triggers a compaction of the collection like the global compaction, e.g. to merge its
+// small segments, invoked by the segment anomaly detector
+func (t *compactionTrigger) triggerCollectionCompaction(collectionID int64) (UniqueID, error) {
+	id, err := t.allocSignalID()
+	if err != nil {
+		return -1, err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      false,
+		isGlobal:     true,
+		collectionID: collectionID,
+	}
+	t.signals <- signal
+	return id, nil
+}
+
 // forceTriggerCompaction force to start a compaction
 // invoked by user `ManualCompaction` operation
 func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID, error) {
@@ -257,6 +281,105 @@ func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID
 	return id, nil
 }
 
+// forceTriggerSegmentsCompaction force to compact the given segments into one,
+// invoked by the segment compaction admin api
+func (t *compactionTrigger) forceTriggerSegmentsCompaction(segmentIDs []int64) (UniqueID, error) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	segments, err := t.getSegmentsToCompact(segmentIDs)
+	if err != nil {
+		return -1, err
+	}
+	collectionID := segments[0].GetCollectionID()
+	id, err := t.allocSignalID()
+	if err != nil {
+		return -1, err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      true,
+		collectionID: collectionID,
+		partitionID:  segments[0].GetPartitionID(),
+		channel:      segments[0].GetInsertChannel(),
+	}
+
+	if _, err := t.updateSegmentMaxSize(segments); err != nil {
+		return -1, err
+	}
+	ts, err := t.allocTs()
+	if err != nil {
+		return -1, err
+	}
+	ct, err := t.getCompactTime(ts, collectionID)
+	if err != nil {
+		return -1, err
+	}
+	plan := segmentsToPlan(segments, ct)
+	if err := t.fillOriginPlan(plan); err != nil {
+		return -1, err
+	}
+	if err := t.compactionHandler.execCompactionPlan(signal, plan); err != nil {
+		return -1, err
+	}
+	log.Info("segments compaction triggered",
+		zap.Int64("signalID", id),
+		zap.Int64("planID", plan.GetPlanID()),
+		zap.Int64("collectionID", collectionID),
+		zap.String("channel", signal.channel),
+		zap.Int64("partitionID", signal.partitionID),
+		zap.Int64s("segment IDs", segmentIDs))
+	return id, nil
+}
+
+// getSegmentsToCompact returns the segments of the ids if they are flushed segments of the same channel and partition,
+// and can be compacted now.
+func (t *compactionTrigger) getSegmentsToCompact(segmentIDs []int64) ([]*SegmentInfo, error) {
+	if len(segmentIDs) == 0 {
+		return nil, errors.New("no segment to compact")
+	}
+	if maxNum := Params.DataCoordCfg.MaxSegmentToMerge.GetAsInt(); len(segmentIDs) > maxNum {
+		return nil, fmt.Errorf("at most %d segments can be compacted together, but %d are given", maxNum, len(segmentIDs))
+	}
+	segments := make([]*SegmentInfo, 0, len(segmentIDs))
+	seen := make(map[int64]struct{}, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		if _, ok := seen[segmentID]; ok {
+			return nil, fmt.Errorf("segment %d is duplicated", segmentID)
+		}
+		seen[segmentID] = struct{}{}
+
+		segment := t.meta.GetSegment(segmentID)
+		switch {
+		case segment == nil || !isSegmentHealthy(segment):
+			return nil, fmt.Errorf("segment %d not found", segmentID)
+		case !isFlush(segment):
+			return nil, fmt.Errorf("segment %d is not flushed, state: %s", segmentID, segment.GetState())
+		case segment.isCompacting:
+			return nil, fmt.Errorf("segment %d is compacting", segmentID)
+		case segment.GetIsImporting():
+			return nil, fmt.Errorf("segment %d is importing", segmentID)
+		}
+		if len(segments) > 0 {
+			first := segments[0]
+			if segment.GetCollectionID() != first.GetCollectionID() ||
+				segment.GetPartitionID() != first.GetPartitionID() ||
+				segment.GetInsertChannel() != first.GetInsertChannel() {
+				return nil, fmt.Errorf("segment %d and segment %d don't belong to the same channel and partition",
+					segmentID, first.GetID())
+			}
+		}
+		segments = append(segments, segment.ShadowClone())
+	}
+	if t.freezeManager.IsFrozen(segments[0].GetCollectionID()) {
+		return nil, fmt.Errorf("compaction of collection %d is frozen", segments[0].GetCollectionID())
+	}
+	if t.meta.collectionPauses.IsCompactionPaused(segments[0].GetCollectionID()) {
+		return nil, fmt.Errorf("compaction of collection %d is paused", segments[0].GetCollectionID())
+	}
+	return segments, nil
+}
+
 func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -332,7 +455,9 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() // not importing now
+			!segment.GetIsImporting() && // not importing now
+			!t.freezeManager.IsFrozen(segment.CollectionID) && // not frozen for maintenance
+			!t.meta.collectionPauses.IsCompactionPaused(segment.CollectionID) // not paused by the admin
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 
 	if len(m) == 0 {
@@ -429,6 +554,16 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 		log.Warn("segment in compaction signal not found in meta", zap.Int64("segmentID", signal.segmentID))
 		return
 	}
+	if t.freezeManager.IsFrozen(segment.GetCollectionID()) {
+		log.Info("compaction of collection is frozen, skip the signal", zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", signal.segmentID))
+		return
+	}
+	if t.meta.collectionPauses.IsCompactionPaused(segment.GetCollectionID()) {
+		log.Info("compaction of collection is paused, skip the signal", zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", signal.segmentID))
+		return
+	}
 
 	channel := segment.GetInsertChannel()
 	partitionID := segment.GetPartitionID()
@@ -493,6 +628,7 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 	var prioritizedCandidates []*SegmentInfo
 	var smallCandidates []*SegmentInfo
 	var nonPlannedSegments []*SegmentInfo
+	var binlogMergeCandidates []*SegmentInfo
 
 	// TODO, currently we lack of the measurement of data distribution, there should be another compaction help on redistributing segment based on scalar/vector field distribution
 	for _, segment := range segments {
@@ -502,6 +638,8 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 			prioritizedCandidates = append(prioritizedCandidates, segment)
 		} else if t.isSmallSegment(segment) {
 			smallCandidates = append(smallCandidates, segment)
+		} else if t.ShouldMergeBinlogs(segment) {
+			binlogMergeCandidates = append(binlogMergeCandidates, segment)
 		} else {
 			nonPlannedSegments = append(nonPlannedSegments, segment)
 		}
@@ -664,9 +802,62 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 			)
 		}
 	}
+	// the segments large enough but with too many small binlogs are rewritten alone
+	for _, segment := range binlogMergeCandidates {
+		plan := segmentToBinlogMergePlan(segment)
+		log.Info("generate a plan to merge small binlogs", zap.Int64("segmentID", segment.GetID()),
+			zap.Int("binlog number", getInsertBinlogNum(segment)))
+		plans = append(plans, plan)
+	}
 	return plans
 }
 
+// segmentToBinlogMergePlan returns a plan rewriting the binlogs of the segment into fewer larger ones,
+// neither deletes are applied nor expired entities are dropped, all the deltalogs are carried over,
+// so the logical content of the segment is unchanged.
+func segmentToBinlogMergePlan(segment *SegmentInfo) *datapb.CompactionPlan {
+	return &datapb.CompactionPlan{
+		Type:      datapb.CompactionType_MergeCompaction,
+		Channel:   segment.GetInsertChannel(),
+		TotalRows: segment.GetNumOfRows(),
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{
+			SegmentID:           segment.GetID(),
+			FieldBinlogs:        segment.GetBinlogs(),
+			Field2StatslogPaths: segment.GetStatslogs(),
+			Deltalogs:           segment.GetDeltalogs(),
+		}},
+	}
+}
+
+// getInsertBinlogNum returns the number of insert binlogs of the segment.
+func getInsertBinlogNum(segment *SegmentInfo) int {
+	var num int
+	for _, binlogs := range segment.GetBinlogs() {
+		num += len(binlogs.GetBinlogs())
+	}
+	return num
+}
+
+// ShouldMergeBinlogs returns whether the insert binlogs of the segment are too many and too small,
+// high-frequency flushes leave a segment with thousands of small binlogs which slow down its loading.
+func (t *compactionTrigger) ShouldMergeBinlogs(segment *SegmentInfo) bool {
+	if !Params.DataCoordCfg.BinlogMergeEnabled.GetAsBool() || len(segment.GetBinlogs()) == 0 {
+		return false
+	}
+	binlogNum := getInsertBinlogNum(segment)
+	if binlogNum < Params.DataCoordCfg.BinlogMergeMinBinlogNum.GetAsInt()*len(segment.GetBinlogs()) {
+		return false
+	}
+	var size int64
+	for _, binlogs := range segment.GetBinlogs() {
+		for _, binlog := range binlogs.GetBinlogs() {
+			size += binlog.GetLogSize()
+		}
+	}
+	smallSize := Params.DataCoordCfg.BinlogMergeSmallBinlogProportion.GetAsFloat() * Params.DataNodeCfg.BinLogMaxSize.GetAsFloat()
+	return float64(size)/float64(binlogNum) < smallSize
+}
+
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
 	plan := &datapb.CompactionPlan{
 		Timetravel:    compactTime.travelTime,
@@ -1653,6 +1653,61 @@ func Test_compactionTrigger_shouldDoSingleCompaction(t *testing.T) {
 	assert.True(t, couldDo)
 }
 
+func Test_compactionTrigger_shouldMergeBinlogs(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.BinlogMergeEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.BinlogMergeEnabled.Key)
+
+	trigger := newCompactionTrigger(&meta{}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler())
+
+	genSegment := func(binlogNum int, logSize int64) *SegmentInfo {
+		var binlogs []*datapb.FieldBinlog
+		for fieldID := int64(100); fieldID < 102; fieldID++ {
+			fieldBinlog := &datapb.FieldBinlog{FieldID: fieldID}
+			for i := 0; i < binlogNum; i++ {
+				fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, &datapb.Binlog{EntriesNum: 10, LogPath: "log", LogSize: logSize})
+			}
+			binlogs = append(binlogs, fieldBinlog)
+		}
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:             1,
+				CollectionID:   2,
+				PartitionID:    1,
+				LastExpireTime: 100,
+				NumOfRows:      int64(10 * binlogNum),
+				MaxRowNum:      300,
+				InsertChannel:  "ch1",
+				State:          commonpb.SegmentState_Flushed,
+				Binlogs:        binlogs,
+				Deltalogs: []*datapb.FieldBinlog{{
+					Binlogs: []*datapb.Binlog{{EntriesNum: 1, LogPath: "deltalog"}},
+				}},
+			},
+		}
+	}
+
+	// too many small binlogs
+	segment := genSegment(32, 1024)
+	assert.True(t, trigger.ShouldMergeBinlogs(segment))
+	// not enough binlogs
+	assert.False(t, trigger.ShouldMergeBinlogs(genSegment(31, 1024)))
+	// binlogs large enough
+	assert.False(t, trigger.ShouldMergeBinlogs(genSegment(32, Params.DataNodeCfg.BinLogMaxSize.GetAsInt64())))
+
+	// the plan carries over the deltalogs without applying them
+	plans := trigger.generatePlans([]*SegmentInfo{segment}, false, false, &compactTime{travelTime: 200, expireTime: 0, collectionTTL: time.Hour})
+	assert.Equal(t, 1, len(plans))
+	assert.Equal(t, datapb.CompactionType_MergeCompaction, plans[0].GetType())
+	assert.Equal(t, Timestamp(0), plans[0].GetTimetravel())
+	assert.Equal(t, int64(0), plans[0].GetCollectionTtl())
+	assert.Equal(t, 1, len(plans[0].GetSegmentBinlogs()))
+	assert.Equal(t, segment.GetDeltalogs(), plans[0].GetSegmentBinlogs()[0].GetDeltalogs())
+
+	paramtable.Get().Save(Params.DataCoordCfg.BinlogMergeEnabled.Key, "false")
+	assert.False(t, trigger.ShouldMergeBinlogs(segment))
+}
+
 func Test_newCompactionTrigger(t *testing.T) {
 	type args struct {
 		meta              *meta
@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// DecommissionDataNode forwards the request to the DataNode of the node ID, which drains its vchannels so that they
+// are reassigned to the other DataNodes, IllegalArgument is returned if the DataNode is not online.
+func (s *Server) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	if s.isClosed() {
+		return &datapb.DecommissionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()), zap.Bool("start", req.GetStart()))
+	if !lo.Contains(s.sessionManager.getLiveNodeIDs(), req.GetNodeID()) {
+		return &datapb.DecommissionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    fmt.Sprintf("DataNode %d not found", req.GetNodeID()),
+			},
+		}, nil
+	}
+	resp, err := s.sessionManager.Decommission(ctx, req.GetNodeID(), req)
+	if err != nil {
+		log.Warn("failed to decommission DataNode", zap.Error(err))
+		return &datapb.DecommissionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if req.GetStart() {
+		log.Info("DataNode decommission requested", zap.String("state", resp.GetState().String()))
+	}
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestServer_DecommissionDataNode(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{
+		sessionManager: NewSessionManager(withSessionCreator(func(ctx context.Context, addr string) (types.DataNode, error) {
+			return newMockDataNodeClient(1, nil)
+		})),
+		session: &sessionutil.Session{ServerID: 1},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := s.DecommissionDataNode(ctx, &datapb.DecommissionRequest{NodeID: 1, Start: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	s.sessionManager.AddSession(&NodeInfo{NodeID: 1, Address: "localhost:8080"})
+	resp, err = s.DecommissionDataNode(ctx, &datapb.DecommissionRequest{NodeID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, datapb.DecommissionState_DecommissionNone, resp.GetState())
+	resp, err = s.DecommissionDataNode(ctx, &datapb.DecommissionRequest{NodeID: 1, Start: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, datapb.DecommissionState_DecommissionDraining, resp.GetState())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.DecommissionDataNode(ctx, &datapb.DecommissionRequest{NodeID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
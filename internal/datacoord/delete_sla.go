@@ -0,0 +1,394 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// the stages a sampled delete goes through after being produced by Proxy
+const (
+	// the deltalog holding the delete is saved by DataNode
+	deleteStagePersisted = "persisted"
+	// the checkpoint of the channel passes the delete, the delete is consumed by all the DataNodes and
+	// any replica loading the segment reads it from the deltalog
+	deleteStageCheckpointed = "checkpointed"
+	// a compaction applies the delete to the insert data of the segment, the deleted entities are removed
+	deleteStageCompacted = "compacted"
+)
+
+// deleteSLATracker samples one in every dataCoord.deleteSLA.sampleInterval deltalogs saved of a channel as markers,
+// and records the time each marker reaches the stages, so that the time the deletes take from being produced
+// to being removed from storage can be proved. The produced time is the physical time of the delete timestamp,
+// allocated by Proxy when producing the delete. The markers are written through to the catalog, at most
+// dataCoord.deleteSLA.maxCompletedMarkers completed markers are kept. The markers are best effort,
+// a failure to persist them never fails the segment meta update.
+type deleteSLATracker struct {
+	catalog metastore.DataCoordCatalog
+	now     func() time.Time
+
+	mu        sync.RWMutex
+	markers   map[string]*model.DeleteSLAMarker
+	completed []string         // IDs of the completed markers, the oldest first
+	saved     map[string]int64 // channel -> number of the deltalogs saved since started
+}
+
+func newDeleteSLATracker(catalog metastore.DataCoordCatalog) *deleteSLATracker {
+	return &deleteSLATracker{
+		catalog: catalog,
+		now:     time.Now,
+		markers: make(map[string]*model.DeleteSLAMarker),
+		saved:   make(map[string]int64),
+	}
+}
+
+// load reloads the markers from the catalog.
+func (t *deleteSLATracker) load() error {
+	markers, err := t.catalog.ListDeleteSLAMarkers(context.TODO())
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, marker := range markers {
+		t.markers[marker.ID] = marker
+		if marker.Completed() {
+			t.completed = append(t.completed, marker.ID)
+		}
+	}
+	sort.Slice(t.completed, func(i, j int) bool {
+		return t.markers[t.completed[i]].CompletedTime().Before(t.markers[t.completed[j]].CompletedTime())
+	})
+	t.evictLocked()
+	return nil
+}
+
+// save writes the marker through to the catalog, it must be called with the lock held.
+func (t *deleteSLATracker) save(marker *model.DeleteSLAMarker) {
+	if err := t.catalog.SaveDeleteSLAMarker(context.TODO(), marker); err != nil {
+		log.Warn("DataCoord failed to save delete sla marker", zap.String("marker", marker.ID), zap.Error(err))
+	}
+}
+
+// remove removes the marker, it must be called with the lock held.
+func (t *deleteSLATracker) remove(markerID string) {
+	delete(t.markers, markerID)
+	if err := t.catalog.DropDeleteSLAMarker(context.TODO(), markerID); err != nil {
+		log.Warn("DataCoord failed to remove delete sla marker", zap.String("marker", markerID), zap.Error(err))
+	}
+}
+
+// reach records the marker reaching the stage now, it must be called with the lock held.
+func (t *deleteSLATracker) reach(marker *model.DeleteSLAMarker, stage string) {
+	now := t.now()
+	switch stage {
+	case deleteStagePersisted:
+		marker.PersistedTime = now
+	case deleteStageCheckpointed:
+		marker.CheckpointedTime = &now
+	case deleteStageCompacted:
+		marker.CompactedTime = &now
+	}
+	metrics.DataCoordDeleteSLALatency.WithLabelValues(stage).Observe(now.Sub(marker.ProducedTime).Seconds())
+	if stage != deleteStagePersisted && marker.Completed() {
+		t.completed = append(t.completed, marker.ID)
+		t.evictLocked()
+	}
+}
+
+// evictLocked removes the oldest completed markers beyond the limit, it must be called with the lock held.
+func (t *deleteSLATracker) evictLocked() {
+	maxCompleted := Params.DataCoordCfg.DeleteSLAMaxCompletedMarkers.GetAsInt()
+	if maxCompleted < 0 || len(t.completed) <= maxCompleted {
+		return
+	}
+	evicted := len(t.completed) - maxCompleted
+	for _, markerID := range t.completed[:evicted] {
+		t.remove(markerID)
+	}
+	t.completed = append([]string{}, t.completed[evicted:]...)
+}
+
+// sample samples the deltalogs saved of the segment, checkpointTs is the current checkpoint of its channel.
+func (t *deleteSLATracker) sample(segment *SegmentInfo, deltalogs []*datapb.FieldBinlog, checkpointTs Timestamp) {
+	if t == nil {
+		return
+	}
+	interval := Params.DataCoordCfg.DeleteSLASampleInterval.GetAsInt64()
+	if interval <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	channel := segment.GetInsertChannel()
+	for _, fieldBinlog := range deltalogs {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			saved := t.saved[channel]
+			t.saved[channel]++
+			if saved%interval != 0 || binlog.GetTimestampTo() == 0 {
+				continue
+			}
+			marker := &model.DeleteSLAMarker{
+				ID:           fmt.Sprintf("%d-%d", segment.GetID(), binlog.GetTimestampTo()),
+				CollectionID: segment.GetCollectionID(),
+				PartitionID:  segment.GetPartitionID(),
+				SegmentID:    segment.GetID(),
+				Channel:      channel,
+				DeleteTs:     binlog.GetTimestampTo(),
+			}
+			if _, ok := t.markers[marker.ID]; ok {
+				continue
+			}
+			marker.ProducedTime, _ = tsoutil.ParseTS(marker.DeleteTs)
+			t.markers[marker.ID] = marker
+			t.reach(marker, deleteStagePersisted)
+			if checkpointTs >= marker.DeleteTs {
+				t.reach(marker, deleteStageCheckpointed)
+			}
+			t.save(marker)
+		}
+	}
+}
+
+// checkpoint records the markers of the channel passed by the checkpoint.
+func (t *deleteSLATracker) checkpoint(channel string, checkpointTs Timestamp) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, marker := range t.markers {
+		if marker.Channel != channel || marker.CheckpointedTime != nil || marker.DeleteTs > checkpointTs {
+			continue
+		}
+		t.reach(marker, deleteStageCheckpointed)
+		if _, ok := t.markers[marker.ID]; ok {
+			t.save(marker)
+		}
+	}
+}
+
+// compact records the markers of the compacted segments applied by the compaction with the travel timestamp,
+// the markers not applied follow their deltalogs to the segment compacted to.
+func (t *deleteSLATracker) compact(compactedFrom []UniqueID, compactedTo UniqueID, travelTs Timestamp) {
+	if t == nil {
+		return
+	}
+	from := make(map[UniqueID]struct{}, len(compactedFrom))
+	for _, segmentID := range compactedFrom {
+		from[segmentID] = struct{}{}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, marker := range t.markers {
+		if _, ok := from[marker.SegmentID]; !ok || marker.CompactedTime != nil {
+			continue
+		}
+		if marker.DeleteTs <= travelTs {
+			t.reach(marker, deleteStageCompacted)
+		} else {
+			marker.SegmentID = compactedTo
+		}
+		if _, ok := t.markers[marker.ID]; ok {
+			t.save(marker)
+		}
+	}
+}
+
+// removeSegment removes the markers not compacted yet of the segment, the segment is dropped without a compaction,
+// e.g. its collection is dropped.
+func (t *deleteSLATracker) removeSegment(segmentID UniqueID) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for markerID, marker := range t.markers {
+		if marker.SegmentID == segmentID && marker.CompactedTime == nil {
+			t.remove(markerID)
+		}
+	}
+}
+
+// list returns copies of the markers of the collection, all the collections if collectionID is 0,
+// the earliest produced first.
+func (t *deleteSLATracker) list(collectionID UniqueID) []*model.DeleteSLAMarker {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ret := make([]*model.DeleteSLAMarker, 0, len(t.markers))
+	for _, marker := range t.markers {
+		if collectionID != 0 && marker.CollectionID != collectionID {
+			continue
+		}
+		ret = append(ret, marker.Clone())
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].DeleteTs != ret[j].DeleteTs {
+			return ret[i].DeleteTs < ret[j].DeleteTs
+		}
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
+// deleteSLAStageSummary is the latencies of the markers reaching a stage, from the produced time.
+type deleteSLAStageSummary struct {
+	Reached    int
+	AvgSeconds float64
+	MaxSeconds float64
+	// the markers not reaching the stage yet, and the age of the oldest one
+	Pending                 int
+	OldestPendingAgeSeconds float64
+}
+
+// deleteSLAReport is the markers with the latency summaries of the stages.
+type deleteSLAReport struct {
+	Stages  map[string]*deleteSLAStageSummary
+	Markers []*model.DeleteSLAMarker
+}
+
+func newDeleteSLAReport(markers []*model.DeleteSLAMarker, now time.Time) *deleteSLAReport {
+	report := &deleteSLAReport{
+		Stages:  make(map[string]*deleteSLAStageSummary),
+		Markers: markers,
+	}
+	observe := func(stage string, produced time.Time, reached *time.Time) {
+		summary, ok := report.Stages[stage]
+		if !ok {
+			summary = &deleteSLAStageSummary{}
+			report.Stages[stage] = summary
+		}
+		if reached == nil {
+			summary.Pending++
+			if age := now.Sub(produced).Seconds(); age > summary.OldestPendingAgeSeconds {
+				summary.OldestPendingAgeSeconds = age
+			}
+			return
+		}
+		latency := reached.Sub(produced).Seconds()
+		summary.AvgSeconds = (summary.AvgSeconds*float64(summary.Reached) + latency) / float64(summary.Reached+1)
+		summary.Reached++
+		if latency > summary.MaxSeconds {
+			summary.MaxSeconds = latency
+		}
+	}
+	for _, marker := range markers {
+		persisted := marker.PersistedTime
+		observe(deleteStagePersisted, marker.ProducedTime, &persisted)
+		observe(deleteStageCheckpointed, marker.ProducedTime, marker.CheckpointedTime)
+		observe(deleteStageCompacted, marker.ProducedTime, marker.CompactedTime)
+	}
+	return report
+}
+
+// SampleDeleteSLAMarkers samples the deltalogs saved of the segment as delete sla markers.
+func (m *meta) SampleDeleteSLAMarkers(segment *SegmentInfo, deltalogs []*datapb.FieldBinlog) {
+	var checkpointTs Timestamp
+	if position := m.GetChannelCheckpoint(segment.GetInsertChannel()); position != nil {
+		checkpointTs = position.GetTimestamp()
+	}
+	m.deleteSLA.sample(segment, deltalogs, checkpointTs)
+}
+
+// CompactDeleteSLAMarkers records the delete sla markers of the segments compacted with the travel timestamp.
+func (m *meta) CompactDeleteSLAMarkers(compactedFrom []UniqueID, compactedTo UniqueID, travelTs Timestamp) {
+	m.deleteSLA.compact(compactedFrom, compactedTo, travelTs)
+}
+
+// ListDeleteSLAMarkers returns the delete sla markers of the collection, all the collections if collectionID is 0.
+func (m *meta) ListDeleteSLAMarkers(collectionID UniqueID) []*model.DeleteSLAMarker {
+	return m.deleteSLA.list(collectionID)
+}
+
+func deleteSLAMarkerToProto(marker *model.DeleteSLAMarker) *datapb.DeleteSLAMarker {
+	ret := &datapb.DeleteSLAMarker{
+		Id:            marker.ID,
+		CollectionID:  marker.CollectionID,
+		PartitionID:   marker.PartitionID,
+		SegmentID:     marker.SegmentID,
+		Channel:       marker.Channel,
+		DeleteTs:      marker.DeleteTs,
+		ProducedTime:  marker.ProducedTime.UnixMilli(),
+		PersistedTime: marker.PersistedTime.UnixMilli(),
+	}
+	if marker.CheckpointedTime != nil {
+		ret.CheckpointedTime = marker.CheckpointedTime.UnixMilli()
+	}
+	if marker.CompactedTime != nil {
+		ret.CompactedTime = marker.CompactedTime.UnixMilli()
+	}
+	return ret
+}
+
+func (report *deleteSLAReport) toProto() *datapb.GetDeleteSLAResponse {
+	resp := &datapb.GetDeleteSLAResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Markers: make([]*datapb.DeleteSLAMarker, 0, len(report.Markers)),
+	}
+	for _, stage := range []string{deleteStagePersisted, deleteStageCheckpointed, deleteStageCompacted} {
+		summary, ok := report.Stages[stage]
+		if !ok {
+			continue
+		}
+		resp.Stages = append(resp.Stages, &datapb.DeleteSLAStage{
+			Stage:                   stage,
+			Reached:                 int64(summary.Reached),
+			AvgSeconds:              summary.AvgSeconds,
+			MaxSeconds:              summary.MaxSeconds,
+			Pending:                 int64(summary.Pending),
+			OldestPendingAgeSeconds: summary.OldestPendingAgeSeconds,
+		})
+	}
+	for _, marker := range report.Markers {
+		resp.Markers = append(resp.Markers, deleteSLAMarkerToProto(marker))
+	}
+	return resp
+}
+
+// GetDeleteSLA returns the delete sla markers of the collection, of all the collections if the collectionID is 0,
+// with the latency summaries of the stages.
+func (s *Server) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetDeleteSLAResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	return newDeleteSLAReport(s.meta.ListDeleteSLAMarkers(req.GetCollectionID()), time.Now()).toProto(), nil
+}
@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestMeta_DeleteSLA(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.DeleteSLASampleInterval.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.DeleteSLASampleInterval.Key)
+
+	ctx := context.Background()
+	kv := memkv.NewMemoryKV()
+	m, err := newMeta(ctx, kv, "", nil)
+	require.NoError(t, err)
+
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+	})
+	require.NoError(t, m.AddSegment(segment))
+
+	produced := time.Now().Add(-time.Minute)
+	deleteTs := func(offset time.Duration) Timestamp {
+		return tsoutil.ComposeTSByTime(produced.Add(offset), 0)
+	}
+	require.NoError(t, m.UpdateChannelCheckpoint("ch1", &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: deleteTs(0)}))
+
+	// the first and the third deltalogs are sampled, the first one is passed by the checkpoint already
+	m.SampleDeleteSLAMarkers(segment, []*datapb.FieldBinlog{{
+		Binlogs: []*datapb.Binlog{
+			{TimestampTo: deleteTs(0)},
+			{TimestampTo: deleteTs(time.Second)},
+			{TimestampTo: deleteTs(2 * time.Second)},
+		},
+	}})
+	markers := m.ListDeleteSLAMarkers(0)
+	require.Equal(t, 2, len(markers))
+	assert.Equal(t, deleteTs(0), markers[0].DeleteTs)
+	assert.Equal(t, int64(100), markers[0].CollectionID)
+	assert.Equal(t, "ch1", markers[0].Channel)
+	assert.Equal(t, produced.UnixMilli(), markers[0].ProducedTime.UnixMilli())
+	assert.False(t, markers[0].PersistedTime.IsZero())
+	assert.NotNil(t, markers[0].CheckpointedTime)
+	assert.Equal(t, deleteTs(2*time.Second), markers[1].DeleteTs)
+	assert.Nil(t, markers[1].CheckpointedTime)
+	assert.Nil(t, markers[1].CompactedTime)
+	assert.Empty(t, m.ListDeleteSLAMarkers(101))
+
+	t.Run("checkpoint", func(t *testing.T) {
+		require.NoError(t, m.UpdateChannelCheckpoints(map[string]*internalpb.MsgPosition{
+			"ch1": {ChannelName: "ch1", Timestamp: deleteTs(3 * time.Second)},
+		}))
+		for _, marker := range m.ListDeleteSLAMarkers(100) {
+			assert.NotNil(t, marker.CheckpointedTime)
+		}
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		// the second marker is not applied by the compaction, and follows its deltalog
+		m.CompactDeleteSLAMarkers([]UniqueID{1}, 2, deleteTs(time.Second))
+		markers := m.ListDeleteSLAMarkers(100)
+		require.Equal(t, 2, len(markers))
+		assert.NotNil(t, markers[0].CompactedTime)
+		assert.Equal(t, UniqueID(1), markers[0].SegmentID)
+		assert.Nil(t, markers[1].CompactedTime)
+		assert.Equal(t, UniqueID(2), markers[1].SegmentID)
+
+		m.CompactDeleteSLAMarkers([]UniqueID{2}, 3, deleteTs(3*time.Second))
+		markers = m.ListDeleteSLAMarkers(100)
+		assert.NotNil(t, markers[1].CompactedTime)
+		assert.Equal(t, UniqueID(2), markers[1].SegmentID)
+	})
+
+	t.Run("report", func(t *testing.T) {
+		report := newDeleteSLAReport(m.ListDeleteSLAMarkers(100), time.Now())
+		for _, stage := range []string{deleteStagePersisted, deleteStageCheckpointed, deleteStageCompacted} {
+			assert.Equal(t, 2, report.Stages[stage].Reached)
+			assert.Equal(t, 0, report.Stages[stage].Pending)
+			assert.Greater(t, report.Stages[stage].MaxSeconds, float64(50))
+		}
+
+		pending := &model.DeleteSLAMarker{ProducedTime: time.Now().Add(-time.Hour), PersistedTime: time.Now()}
+		report = newDeleteSLAReport([]*model.DeleteSLAMarker{pending}, time.Now())
+		assert.Equal(t, 1, report.Stages[deleteStageCompacted].Pending)
+		assert.Greater(t, report.Stages[deleteStageCompacted].OldestPendingAgeSeconds, float64(3599))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		reloaded, err := newMeta(ctx, kv, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(reloaded.ListDeleteSLAMarkers(100)))
+	})
+
+	t.Run("get delete sla", func(t *testing.T) {
+		s := &Server{meta: m, session: &sessionutil.Session{ServerID: 1}}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.GetDeleteSLA(ctx, &datapb.GetDeleteSLARequest{CollectionID: 100})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		require.Equal(t, 2, len(resp.GetMarkers()))
+		assert.NotZero(t, resp.GetMarkers()[0].GetCompactedTime())
+		require.Equal(t, 3, len(resp.GetStages()))
+		for i, stage := range []string{deleteStagePersisted, deleteStageCheckpointed, deleteStageCompacted} {
+			assert.Equal(t, stage, resp.GetStages()[i].GetStage())
+			assert.Equal(t, int64(2), resp.GetStages()[i].GetReached())
+		}
+
+		resp, err = s.GetDeleteSLA(ctx, &datapb.GetDeleteSLARequest{CollectionID: 101})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetMarkers())
+		assert.Empty(t, resp.GetStages())
+
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err = s.GetDeleteSLA(ctx, &datapb.GetDeleteSLARequest{})
+		require.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("max completed markers", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.DeleteSLAMaxCompletedMarkers.Key, "1")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.DeleteSLAMaxCompletedMarkers.Key)
+		reloaded, err := newMeta(ctx, kv, "", nil)
+		require.NoError(t, err)
+		markers := reloaded.ListDeleteSLAMarkers(100)
+		require.Equal(t, 1, len(markers))
+		assert.Equal(t, deleteTs(2*time.Second), markers[0].DeleteTs)
+		markers, err = reloaded.catalog.ListDeleteSLAMarkers(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(markers))
+	})
+
+	t.Run("drop segment", func(t *testing.T) {
+		segment := NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            4,
+			CollectionID:  100,
+			PartitionID:   10,
+			InsertChannel: "ch2",
+			State:         commonpb.SegmentState_Flushed,
+		})
+		require.NoError(t, m.AddSegment(segment))
+		m.SampleDeleteSLAMarkers(segment, []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{TimestampTo: deleteTs(0)}}}})
+		assert.Equal(t, 3, len(m.ListDeleteSLAMarkers(100)))
+		require.NoError(t, m.DropSegment(4))
+		assert.Equal(t, 2, len(m.ListDeleteSLAMarkers(100)))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.DeleteSLASampleInterval.Key, "0")
+		m.SampleDeleteSLAMarkers(segment, []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{TimestampTo: deleteTs(4 * time.Second)}}}})
+		assert.Equal(t, 2, len(m.ListDeleteSLAMarkers(100)))
+	})
+}
@@ -0,0 +1,355 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// clusterFreezeID is the collection ID of the cluster-wide freeze window.
+const clusterFreezeID UniqueID = 0
+
+var errInvalidFreezeTTL = errors.New("invalid freeze ttl")
+
+// freezeWindow freezes the index completion driven handoffs and the compaction of a collection, or the whole cluster,
+// segments not indexed when frozen are handed off as unindexed ones until the window expires or is unfrozen.
+type freezeWindow struct {
+	CollectionID UniqueID
+	FrozenAt     time.Time
+	ExpireAt     time.Time
+
+	indexedSegments typeutil.UniqueSet
+}
+
+func (w *freezeWindow) expired(now time.Time) bool {
+	return !now.Before(w.ExpireAt)
+}
+
+// freezeManager keeps the freeze windows, they are written through to the catalog once it is set,
+// so that a DataCoord restart or failover does not thaw the frozen collections before the windows expire.
+type freezeManager struct {
+	mu      sync.RWMutex
+	catalog metastore.DataCoordCatalog
+	windows map[UniqueID]*freezeWindow
+	now     func() time.Time
+}
+
+func newFreezeManager() *freezeManager {
+	return &freezeManager{
+		windows: make(map[UniqueID]*freezeWindow),
+		now:     time.Now,
+	}
+}
+
+// init sets the catalog the windows are persisted to and reloads the windows not expired from it.
+func (m *freezeManager) init(catalog metastore.DataCoordCatalog) error {
+	windowMetas, err := catalog.ListFreezeWindows(context.TODO())
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.catalog = catalog
+	now := m.now()
+	for _, windowMeta := range windowMetas {
+		window := &freezeWindow{
+			CollectionID:    windowMeta.CollectionID,
+			FrozenAt:        windowMeta.FrozenAt,
+			ExpireAt:        windowMeta.ExpireAt,
+			indexedSegments: typeutil.NewUniqueSet(windowMeta.IndexedSegments...),
+		}
+		if window.expired(now) {
+			m.removeLocked(window.CollectionID)
+			continue
+		}
+		m.windows[window.CollectionID] = window
+	}
+	return nil
+}
+
+// save persists the window, it is a no-op before the catalog is set.
+func (m *freezeManager) save(window *freezeWindow) error {
+	if m.catalog == nil {
+		return nil
+	}
+	return m.catalog.SaveFreezeWindow(context.TODO(), &model.FreezeWindow{
+		CollectionID:    window.CollectionID,
+		FrozenAt:        window.FrozenAt,
+		ExpireAt:        window.ExpireAt,
+		IndexedSegments: window.indexedSegments.Collect(),
+	})
+}
+
+// removeLocked drops the window of the collection from memory and the catalog, a failed removal is retried
+// when the window is found expired on the next reload.
+func (m *freezeManager) removeLocked(collectionID UniqueID) {
+	delete(m.windows, collectionID)
+	if m.catalog == nil {
+		return
+	}
+	if err := m.catalog.DropFreezeWindow(context.TODO(), collectionID); err != nil {
+		log.Warn("failed to remove freeze window", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+}
+
+// Freeze opens or replaces the freeze window of the collection, clusterFreezeID freezes the whole cluster.
+func (m *freezeManager) Freeze(collectionID UniqueID, ttl time.Duration, indexedSegments []UniqueID) (*freezeWindow, error) {
+	maxTTL := Params.DataCoordCfg.FreezeWindowMaxTTL.GetAsDuration(time.Second)
+	if ttl <= 0 {
+		ttl = maxTTL
+	}
+	if ttl > maxTTL {
+		return nil, fmt.Errorf("%w: %s exceeds the max ttl %s", errInvalidFreezeTTL, ttl, maxTTL)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	window := &freezeWindow{
+		CollectionID:    collectionID,
+		FrozenAt:        now,
+		ExpireAt:        now.Add(ttl),
+		indexedSegments: typeutil.NewUniqueSet(indexedSegments...),
+	}
+	if err := m.save(window); err != nil {
+		return nil, err
+	}
+	m.windows[collectionID] = window
+	log.Info("handoffs and compaction frozen", zap.Int64("collectionID", collectionID),
+		zap.Time("expireAt", window.ExpireAt), zap.Int("indexedSegments", len(indexedSegments)))
+	return window, nil
+}
+
+// Unfreeze closes the freeze window of the collection, returns false if the collection is not frozen.
+func (m *freezeManager) Unfreeze(collectionID UniqueID) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.windows[collectionID]; !ok {
+		return false, nil
+	}
+	if m.catalog != nil {
+		if err := m.catalog.DropFreezeWindow(context.TODO(), collectionID); err != nil {
+			return false, err
+		}
+	}
+	delete(m.windows, collectionID)
+	log.Info("handoffs and compaction unfrozen", zap.Int64("collectionID", collectionID))
+	return true, nil
+}
+
+// activeWindows returns the windows not expired covering the collection.
+func (m *freezeManager) activeWindows(collectionID UniqueID) []*freezeWindow {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := m.now()
+	var ret []*freezeWindow
+	for _, id := range []UniqueID{collectionID, clusterFreezeID} {
+		if window, ok := m.windows[id]; ok && !window.expired(now) {
+			ret = append(ret, window)
+		}
+		if collectionID == clusterFreezeID {
+			break
+		}
+	}
+	return ret
+}
+
+// IsFrozen returns whether the collection is covered by any freeze window not expired.
+func (m *freezeManager) IsFrozen(collectionID UniqueID) bool {
+	return len(m.activeWindows(collectionID)) > 0
+}
+
+// FilterIndexed removes the segments indexed after the collection is frozen from the indexed ones,
+// so that they are not handed off to replace their unindexed view during the freeze window.
+func (m *freezeManager) FilterIndexed(collectionID UniqueID, indexed typeutil.UniqueSet) typeutil.UniqueSet {
+	windows := m.activeWindows(collectionID)
+	if len(windows) == 0 {
+		return indexed
+	}
+	ret := make(typeutil.UniqueSet, len(indexed))
+	for segmentID := range indexed {
+		frozen := false
+		for _, window := range windows {
+			if !window.indexedSegments.Contain(segmentID) {
+				frozen = true
+				break
+			}
+		}
+		if !frozen {
+			ret.Insert(segmentID)
+		}
+	}
+	return ret
+}
+
+// List removes the expired windows and returns the active ones sorted by collection.
+func (m *freezeManager) List() []*freezeWindow {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	ret := make([]*freezeWindow, 0, len(m.windows))
+	for id, window := range m.windows {
+		if window.expired(now) {
+			log.Info("freeze window expired", zap.Int64("collectionID", id), zap.Time("expireAt", window.ExpireAt))
+			m.removeLocked(id)
+			continue
+		}
+		ret = append(ret, window)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].CollectionID < ret[j].CollectionID })
+	return ret
+}
+
+// freezeCollection freezes the collection with the segments indexed now, clusterFreezeID freezes all the collections.
+func (s *Server) freezeCollection(collectionID UniqueID, ttl time.Duration) (*freezeWindow, error) {
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return (collectionID == clusterFreezeID || segment.GetCollectionID() == collectionID) && isSegmentHealthy(segment)
+	})
+	indexed := FilterInIndexedSegments(s.handler, s.meta, segments...)
+	indexedIDs := make([]UniqueID, 0, len(indexed))
+	for _, segment := range indexed {
+		indexedIDs = append(indexedIDs, segment.GetID())
+	}
+	return s.freezeManager.Freeze(collectionID, ttl, indexedIDs)
+}
+
+// freezeWindowReasons describes the active freeze windows for CheckHealth.
+func (s *Server) freezeWindowReasons() []string {
+	windows := s.freezeManager.List()
+	reasons := make([]string, 0, len(windows))
+	for _, window := range windows {
+		target := fmt.Sprintf("collection %d", window.CollectionID)
+		if window.CollectionID == clusterFreezeID {
+			target = "cluster"
+		}
+		reasons = append(reasons, fmt.Sprintf("datacoord handoffs and compaction of %s are frozen until %s",
+			target, window.ExpireAt.Format(time.RFC3339)))
+	}
+	return reasons
+}
+
+func freezeWindowToProto(window *freezeWindow) *datapb.FreezeWindow {
+	return &datapb.FreezeWindow{
+		CollectionID: window.CollectionID,
+		FrozenAt:     window.FrozenAt.UnixMilli(),
+		ExpireAt:     window.ExpireAt.UnixMilli(),
+	}
+}
+
+// FreezeHandoffs freezes the index completion driven handoffs and the compaction of the collection, or the whole
+// cluster if the collectionID is 0, until the window expires or is unfrozen.
+func (s *Server) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	if s.isClosed() {
+		return &datapb.FreezeHandoffsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	window, err := s.freezeCollection(req.GetCollectionID(), time.Duration(req.GetTtlSeconds())*time.Second)
+	if err != nil {
+		log.Warn("failed to freeze handoffs", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		errCode := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, errInvalidFreezeTTL) {
+			errCode = commonpb.ErrorCode_IllegalArgument
+		}
+		return &datapb.FreezeHandoffsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errCode,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &datapb.FreezeHandoffsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Window: freezeWindowToProto(window),
+	}, nil
+}
+
+// UnfreezeHandoffs closes the freeze window of the collection, IllegalArgument is returned if it's not frozen.
+func (s *Server) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	if s.isClosed() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	unfrozen, err := s.freezeManager.Unfreeze(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to unfreeze handoffs", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if !unfrozen {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    fmt.Sprintf("collection %d is not frozen", req.GetCollectionID()),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// GetFreezeWindows returns the freeze windows not expired sorted by collection.
+func (s *Server) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetFreezeWindowsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	windows := s.freezeManager.List()
+	ret := make([]*datapb.FreezeWindow, 0, len(windows))
+	for _, window := range windows {
+		ret = append(ret, freezeWindowToProto(window))
+	}
+	return &datapb.GetFreezeWindowsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Windows: ret,
+	}, nil
+}
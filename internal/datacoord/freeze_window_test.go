@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestFreezeManager(t *testing.T) {
+	Params.Init()
+
+	now := time.Now()
+	m := newFreezeManager()
+	m.now = func() time.Time { return now }
+
+	t.Run("freeze collection", func(t *testing.T) {
+		_, err := m.Freeze(1, time.Minute, []UniqueID{100, 101})
+		assert.NoError(t, err)
+		assert.True(t, m.IsFrozen(1))
+		assert.False(t, m.IsFrozen(2))
+
+		indexed := m.FilterIndexed(1, typeutil.NewUniqueSet(100, 101, 102))
+		assert.ElementsMatch(t, []UniqueID{100, 101}, indexed.Collect())
+		indexed = m.FilterIndexed(2, typeutil.NewUniqueSet(200))
+		assert.ElementsMatch(t, []UniqueID{200}, indexed.Collect())
+
+		unfrozen, err := m.Unfreeze(1)
+		assert.NoError(t, err)
+		assert.True(t, unfrozen)
+		unfrozen, err = m.Unfreeze(1)
+		assert.NoError(t, err)
+		assert.False(t, unfrozen)
+		assert.False(t, m.IsFrozen(1))
+	})
+
+	t.Run("freeze cluster", func(t *testing.T) {
+		_, err := m.Freeze(clusterFreezeID, 0, []UniqueID{100})
+		assert.NoError(t, err)
+		assert.True(t, m.IsFrozen(1))
+		assert.True(t, m.IsFrozen(2))
+		indexed := m.FilterIndexed(2, typeutil.NewUniqueSet(100, 200))
+		assert.ElementsMatch(t, []UniqueID{100}, indexed.Collect())
+		unfrozen, err := m.Unfreeze(clusterFreezeID)
+		assert.NoError(t, err)
+		assert.True(t, unfrozen)
+	})
+
+	t.Run("auto expiry", func(t *testing.T) {
+		_, err := m.Freeze(1, time.Minute, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(m.List()))
+
+		now = now.Add(time.Minute)
+		assert.False(t, m.IsFrozen(1))
+		assert.Empty(t, m.List())
+	})
+
+	t.Run("ttl exceeds max", func(t *testing.T) {
+		_, err := m.Freeze(1, Params.DataCoordCfg.FreezeWindowMaxTTL.GetAsDuration(time.Second)+time.Second, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		catalog := &datacoord.Catalog{Txn: memkv.NewMemoryKV()}
+		m := newFreezeManager()
+		m.now = func() time.Time { return now }
+		require.NoError(t, m.init(catalog))
+		_, err := m.Freeze(1, time.Minute, []UniqueID{100})
+		require.NoError(t, err)
+		_, err = m.Freeze(2, time.Second, nil)
+		require.NoError(t, err)
+
+		reloaded := newFreezeManager()
+		reloaded.now = func() time.Time { return now.Add(time.Second) }
+		require.NoError(t, reloaded.init(catalog))
+		assert.True(t, reloaded.IsFrozen(1))
+		assert.False(t, reloaded.IsFrozen(2))
+		indexed := reloaded.FilterIndexed(1, typeutil.NewUniqueSet(100, 101))
+		assert.ElementsMatch(t, []UniqueID{100}, indexed.Collect())
+		windows, err := catalog.ListFreezeWindows(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, len(windows))
+		assert.Equal(t, UniqueID(1), windows[0].CollectionID)
+
+		unfrozen, err := reloaded.Unfreeze(1)
+		require.NoError(t, err)
+		assert.True(t, unfrozen)
+		windows, err = catalog.ListFreezeWindows(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, windows)
+	})
+
+	t.Run("nil manager", func(t *testing.T) {
+		var m *freezeManager
+		assert.False(t, m.IsFrozen(1))
+		assert.Empty(t, m.List())
+	})
+}
+
+func TestServer_FreezeHandoffs(t *testing.T) {
+	Params.Init()
+	meta, err := newMemoryMeta()
+	assert.NoError(t, err)
+	s := &Server{
+		meta:          meta,
+		handler:       newMockHandler(),
+		freezeManager: newFreezeManager(),
+		session:       &sessionutil.Session{ServerID: 1},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	s.sessionManager = NewSessionManager()
+	ctx := context.Background()
+
+	freezeResp, err := s.FreezeHandoffs(ctx, &datapb.FreezeHandoffsRequest{CollectionID: 1, TtlSeconds: 600})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, freezeResp.GetStatus().GetErrorCode())
+	assert.Equal(t, UniqueID(1), freezeResp.GetWindow().GetCollectionID())
+	assert.Equal(t, int64(600*1000), freezeResp.GetWindow().GetExpireAt()-freezeResp.GetWindow().GetFrozenAt())
+	assert.True(t, s.freezeManager.IsFrozen(1))
+
+	listResp, err := s.GetFreezeWindows(ctx, &datapb.GetFreezeWindowsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(listResp.GetWindows()))
+	assert.Equal(t, UniqueID(1), listResp.GetWindows()[0].GetCollectionID())
+
+	resp, err := s.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetIsHealthy())
+	assert.Equal(t, 1, len(resp.GetReasons()))
+
+	compactResp, err := s.ManualCompaction(ctx, &milvuspb.ManualCompactionRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, compactResp.GetStatus().GetErrorCode())
+
+	freezeResp, err = s.FreezeHandoffs(ctx, &datapb.FreezeHandoffsRequest{CollectionID: 1, TtlSeconds: 86400})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, freezeResp.GetStatus().GetErrorCode())
+
+	status, err := s.UnfreezeHandoffs(ctx, &datapb.UnfreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.False(t, s.freezeManager.IsFrozen(1))
+	status, err = s.UnfreezeHandoffs(ctx, &datapb.UnfreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	freezeResp, err = s.FreezeHandoffs(ctx, &datapb.FreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, freezeResp.GetStatus().GetErrorCode())
+	status, err = s.UnfreezeHandoffs(ctx, &datapb.UnfreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	listResp, err = s.GetFreezeWindows(ctx, &datapb.GetFreezeWindowsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+}
@@ -55,9 +55,10 @@ type GcOption struct {
 // garbageCollector handles garbage files in object storage
 // which could be dropped collection remanent or data node failure traces
 type garbageCollector struct {
-	option  GcOption
-	meta    *meta
-	handler Handler
+	option       GcOption
+	meta         *meta
+	handler      Handler
+	segmentLocks *segmentLockManager
 
 	startOnce sync.Once
 	stopOnce  sync.Once
@@ -66,14 +67,15 @@ type garbageCollector struct {
 }
 
 // newGarbageCollector create garbage collector with meta and option
-func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageCollector {
+func newGarbageCollector(meta *meta, handler Handler, segmentLocks *segmentLockManager, opt GcOption) *garbageCollector {
 	log.Info("GC with option", zap.Bool("enabled", opt.enabled), zap.Duration("interval", opt.checkInterval),
 		zap.Duration("missingTolerance", opt.missingTolerance), zap.Duration("dropTolerance", opt.dropTolerance))
 	return &garbageCollector{
-		meta:    meta,
-		handler: handler,
-		option:  opt,
-		closeCh: make(chan struct{}),
+		meta:         meta,
+		handler:      handler,
+		segmentLocks: segmentLocks,
+		option:       opt,
+		closeCh:      make(chan struct{}),
 	}
 }
 
@@ -177,6 +179,12 @@ func (gc *garbageCollector) scan() {
 				continue
 			}
 
+			// the files of the collections whose gc is paused are kept
+			if collectionID, err := parseCollectionIDByBinlog(gc.option.cli.RootPath(), infoKey); err == nil &&
+				gc.meta.collectionPauses.IsGCPaused(collectionID) {
+				continue
+			}
+
 			// not found in meta, check last modified time exceeds tolerance duration
 			if time.Since(modTimes[i]) > gc.option.missingTolerance {
 				// ignore error since it could be cleaned up next time
@@ -234,6 +242,16 @@ func (gc *garbageCollector) clearEtcd() {
 		if to, ok := compactTo[segment.GetID()]; ok && !indexedSet.Contain(to.GetID()) {
 			continue
 		}
+		// segments referenced by running tasks are recycled after the locks are released or expired
+		if gc.segmentLocks.IsLocked(segment.GetID()) {
+			log.Info("skip GC segment locked by running tasks", zap.Int64("segmentID", segment.GetID()))
+			continue
+		}
+		if gc.meta.collectionPauses.IsGCPaused(segment.GetCollectionID()) {
+			log.Info("skip GC segment of collection whose gc is paused", zap.Int64("collectionID", segment.GetCollectionID()),
+				zap.Int64("segmentID", segment.GetID()))
+			continue
+		}
 		logs := getLogs(segment)
 		log.Info("GC segment",
 			zap.Int64("segmentID", segment.GetID()))
@@ -352,10 +370,16 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 			}
 			continue
 		}
+		indexFilePaths := metautil.BuildSegmentIndexFilePaths(gc.option.cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+		if _, err := storage.ResolveIndexFilePaths(ctx, gc.option.cli, indexFilePaths); err != nil {
+			// the files of a build without its manifest are kept, they may be all that is left of the index
+			log.Warn("garbageCollector recycleUnusedIndexFiles check index manifest failed",
+				zap.Int64("buildID", buildID), zap.Error(err))
+			continue
+		}
 		filesMap := make(map[string]struct{})
-		for _, fileID := range segIdx.IndexFileKeys {
-			filepath := metautil.BuildSegmentIndexFilePath(gc.option.cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
-				segIdx.PartitionID, segIdx.SegmentID, fileID)
+		for _, filepath := range indexFilePaths {
 			filesMap[filepath] = struct{}{}
 		}
 		files, _, err := gc.option.cli.ListWithPrefix(ctx, key, true)
@@ -59,7 +59,7 @@ func Test_garbageCollector_basic(t *testing.T) {
 	assert.Nil(t, err)
 
 	t.Run("normal gc", func(t *testing.T) {
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Millisecond * 10,
@@ -75,7 +75,7 @@ func Test_garbageCollector_basic(t *testing.T) {
 	})
 
 	t.Run("with nil cli", func(t *testing.T) {
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              nil,
 			enabled:          true,
 			checkInterval:    time.Millisecond * 10,
@@ -112,7 +112,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 	assert.Nil(t, err)
 
 	t.Run("key is reference", func(t *testing.T) {
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
@@ -129,7 +129,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 	})
 
 	t.Run("missing all but save tolerance", func(t *testing.T) {
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
@@ -154,7 +154,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 		err = meta.AddSegment(segment)
 		require.NoError(t, err)
 
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
@@ -182,7 +182,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 		err = meta.AddSegment(segment)
 		require.NoError(t, err)
 
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
@@ -198,7 +198,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 		gc.close()
 	})
 	t.Run("missing gc all", func(t *testing.T) {
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
@@ -219,7 +219,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 	})
 
 	t.Run("list object with error", func(t *testing.T) {
-		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		gc := newGarbageCollector(meta, newMockHandler(), newSegmentLockManager(), GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
@@ -926,8 +926,9 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 			cli:           &mocks.ChunkManager{},
 			dropTolerance: 1,
 		},
-		meta:    m,
-		handler: newMockHandlerWithMeta(m),
+		meta:         m,
+		handler:      newMockHandlerWithMeta(m),
+		segmentLocks: newSegmentLockManager(),
 	}
 	gc.clearEtcd()
 
@@ -961,6 +962,9 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
+	// segment locked by a running task is not recycled
+	lockOwner := segmentLockOwner{NodeID: 1, TaskID: buildID + 3}
+	gc.segmentLocks.Acquire(lockOwner, segID+3)
 	gc.clearEtcd()
 	//segA := gc.meta.GetSegmentUnsafe(segID)
 	//assert.NotNil(t, segA)
@@ -969,13 +973,16 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	segC = gc.meta.GetSegmentUnsafe(segID + 2)
 	assert.Nil(t, segC)
 	segD = gc.meta.GetSegmentUnsafe(segID + 3)
-	assert.Nil(t, segD)
+	assert.NotNil(t, segD)
 	segE = gc.meta.GetSegmentUnsafe(segID + 4)
 	assert.NotNil(t, segE)
 
+	gc.segmentLocks.Release(lockOwner)
 	gc.clearEtcd()
 	segA = gc.meta.GetSegmentUnsafe(segID)
 	assert.Nil(t, segA)
 	segB = gc.meta.GetSegmentUnsafe(segID + 1)
 	assert.Nil(t, segB)
+	segD = gc.meta.GetSegmentUnsafe(segID + 3)
+	assert.Nil(t, segD)
 }
@@ -109,6 +109,10 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionID Uni
 	for _, segment := range indexedSegments {
 		indexed.Insert(segment.GetID())
 	}
+	// flushed segments waited too long for the index are handed off without index
+	indexed = h.s.handoffGate.Release(segments, indexed)
+	// segments indexed during the freeze window are not handed off
+	indexed = h.s.freezeManager.FilterIndexed(channel.CollectionID, indexed)
 	log.Info("GetQueryVChanPositions",
 		zap.Int64("collectionID", channel.CollectionID),
 		zap.String("channel", channel.Name),
@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// handoffGateIndexed means the segment is handed off with its index
+	handoffGateIndexed = "indexed"
+	// handoffGateWaiting means the segment is held back until its index is built
+	handoffGateWaiting = "waiting"
+	// handoffGateReleased means the segment is handed off without index, the gate is disabled or the max wait is passed
+	handoffGateReleased = "released"
+	// handoffGateFrozen means the segment is indexed but not handed off during a freeze window
+	handoffGateFrozen = "frozen"
+)
+
+// handoffGate holds back the handoff of the flushed segments without index, QueryCoord keeps them as growing
+// ones until they are indexed or waited longer than the max wait.
+type handoffGate struct {
+	now func() time.Time
+}
+
+func newHandoffGate() *handoffGate {
+	return &handoffGate{now: time.Now}
+}
+
+// flushedAt returns the time the segment stops taking new rows, the time of its dml position.
+func flushedAt(segment *SegmentInfo) time.Time {
+	position := segment.GetDmlPosition()
+	if position == nil {
+		position = segment.GetStartPosition()
+	}
+	if position == nil {
+		return time.Time{}
+	}
+	return tsoutil.PhysicalTime(position.GetTimestamp())
+}
+
+// decide returns the gating state of a flushed segment and the time it has waited for its index.
+func (g *handoffGate) decide(segment *SegmentInfo, indexed bool) (string, time.Duration) {
+	if indexed {
+		return handoffGateIndexed, 0
+	}
+	now := time.Now
+	if g != nil && g.now != nil {
+		now = g.now
+	}
+	var waited time.Duration
+	if at := flushedAt(segment); !at.IsZero() {
+		waited = now().Sub(at)
+	}
+	if waited < 0 {
+		waited = 0
+	}
+	if segment.GetState() != commonpb.SegmentState_Flushed {
+		return handoffGateWaiting, waited
+	}
+	if !Params.DataCoordCfg.HandoffGateEnabled.GetAsBool() {
+		return handoffGateReleased, waited
+	}
+	maxWait := Params.DataCoordCfg.HandoffGateMaxWait.GetAsDuration(time.Second)
+	if maxWait > 0 && waited >= maxWait {
+		return handoffGateReleased, waited
+	}
+	return handoffGateWaiting, waited
+}
+
+// Release returns the indexed segments together with the flushed segments released by the gate,
+// so that they are handed off to QueryCoord.
+func (g *handoffGate) Release(segments []*SegmentInfo, indexed typeutil.UniqueSet) typeutil.UniqueSet {
+	if g == nil {
+		return indexed
+	}
+	ret := make(typeutil.UniqueSet, len(indexed))
+	for segmentID := range indexed {
+		ret.Insert(segmentID)
+	}
+	for _, segment := range segments {
+		if indexed.Contain(segment.GetID()) || segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		state, waited := g.decide(segment, false)
+		if state != handoffGateReleased {
+			continue
+		}
+		ret.Insert(segment.GetID())
+		log.RatedInfo(60, "segment handed off without index", zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segment.GetID()), zap.Duration("waited", waited))
+	}
+	return ret
+}
+
+// handoffGateReport returns the gating states of the flushing and flushed segments of the collection,
+// or of all the collections if the collectionID is 0.
+func (s *Server) handoffGateReport(collectionID UniqueID) *datapb.GetHandoffGateResponse {
+	maxWait := Params.DataCoordCfg.HandoffGateMaxWait.GetAsDuration(time.Second)
+	report := &datapb.GetHandoffGateResponse{
+		Enabled:        Params.DataCoordCfg.HandoffGateEnabled.GetAsBool(),
+		MaxWaitSeconds: int64(maxWait / time.Second),
+		Segments:       make([]*datapb.HandoffGateSegment, 0),
+	}
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return (collectionID == 0 || segment.GetCollectionID() == collectionID) &&
+			!segment.GetIsImporting() && !segment.GetIsFake() &&
+			(segment.GetState() == commonpb.SegmentState_Flushing || segment.GetState() == commonpb.SegmentState_Flushed)
+	})
+	collectionSegments := make(map[UniqueID][]*SegmentInfo)
+	for _, segment := range segments {
+		collectionSegments[segment.GetCollectionID()] = append(collectionSegments[segment.GetCollectionID()], segment)
+	}
+
+	for collID, segments := range collectionSegments {
+		indexed := make(typeutil.UniqueSet)
+		for _, segment := range FilterInIndexedSegments(s.handler, s.meta, segments...) {
+			indexed.Insert(segment.GetID())
+		}
+		states := make(map[UniqueID]string, len(segments))
+		waits := make(map[UniqueID]time.Duration, len(segments))
+		handedOff := make(typeutil.UniqueSet)
+		for _, segment := range segments {
+			state, waited := s.handoffGate.decide(segment, indexed.Contain(segment.GetID()))
+			states[segment.GetID()], waits[segment.GetID()] = state, waited
+			if state != handoffGateWaiting {
+				handedOff.Insert(segment.GetID())
+			}
+		}
+		// the handoffs during a freeze window are held back whether the segments are indexed or released
+		handedOff = s.freezeManager.FilterIndexed(collID, handedOff)
+		for _, segment := range segments {
+			state, waited := states[segment.GetID()], waits[segment.GetID()]
+			if state != handoffGateWaiting && !handedOff.Contain(segment.GetID()) {
+				state = handoffGateFrozen
+			}
+			entry := &datapb.HandoffGateSegment{
+				SegmentID:     segment.GetID(),
+				CollectionID:  segment.GetCollectionID(),
+				PartitionID:   segment.GetPartitionID(),
+				Channel:       segment.GetInsertChannel(),
+				NumRows:       segment.GetNumOfRows(),
+				State:         state,
+				WaitedSeconds: waited.Seconds(),
+			}
+			if at := flushedAt(segment); !at.IsZero() {
+				entry.FlushedAt = at.UnixMilli()
+			}
+			if state == handoffGateWaiting && report.Enabled && maxWait > 0 {
+				entry.RemainingSeconds = (maxWait - waited).Seconds()
+			}
+			report.Segments = append(report.Segments, entry)
+		}
+	}
+	sort.Slice(report.Segments, func(i, j int) bool {
+		if report.Segments[i].CollectionID != report.Segments[j].CollectionID {
+			return report.Segments[i].CollectionID < report.Segments[j].CollectionID
+		}
+		return report.Segments[i].SegmentID < report.Segments[j].SegmentID
+	})
+	return report
+}
+
+// GetHandoffGate returns the handoff gating states of the flushing and flushed segments of the collection, or of all
+// the collections if the collectionID is 0.
+func (s *Server) GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetHandoffGateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	resp := s.handoffGateReport(req.GetCollectionID())
+	resp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	return resp, nil
+}
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newHandoffGateSegment(id UniqueID, state commonpb.SegmentState, flushedAt time.Time) *SegmentInfo {
+	return NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   2,
+		InsertChannel: "ch1",
+		State:         state,
+		NumOfRows:     100,
+		DmlPosition: &internalpb.MsgPosition{
+			ChannelName: "ch1",
+			Timestamp:   tsoutil.ComposeTSByTime(flushedAt, 0),
+		},
+	})
+}
+
+func TestHandoffGate(t *testing.T) {
+	Params.Init()
+	now := time.Now().Truncate(time.Millisecond)
+	g := newHandoffGate()
+	g.now = func() time.Time { return now }
+
+	segments := []*SegmentInfo{
+		newHandoffGateSegment(1, commonpb.SegmentState_Flushed, now.Add(-2*time.Hour)),
+		newHandoffGateSegment(2, commonpb.SegmentState_Flushed, now.Add(-time.Minute)),
+		newHandoffGateSegment(3, commonpb.SegmentState_Flushing, now.Add(-2*time.Hour)),
+		newHandoffGateSegment(4, commonpb.SegmentState_Flushed, now.Add(-time.Minute)),
+	}
+	indexed := typeutil.NewUniqueSet(4)
+
+	t.Run("no max wait", func(t *testing.T) {
+		ret := g.Release(segments, indexed)
+		assert.ElementsMatch(t, []UniqueID{4}, ret.Collect())
+		state, waited := g.decide(segments[0], false)
+		assert.Equal(t, handoffGateWaiting, state)
+		assert.Equal(t, 2*time.Hour, waited)
+	})
+
+	t.Run("max wait", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.HandoffGateMaxWait.Key, "3600")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.HandoffGateMaxWait.Key)
+		ret := g.Release(segments, indexed)
+		assert.ElementsMatch(t, []UniqueID{1, 4}, ret.Collect())
+		assert.ElementsMatch(t, []UniqueID{4}, indexed.Collect())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.HandoffGateEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.HandoffGateEnabled.Key)
+		ret := g.Release(segments, indexed)
+		assert.ElementsMatch(t, []UniqueID{1, 2, 4}, ret.Collect())
+	})
+
+	t.Run("nil gate", func(t *testing.T) {
+		var g *handoffGate
+		ret := g.Release(segments, indexed)
+		assert.ElementsMatch(t, []UniqueID{4}, ret.Collect())
+	})
+}
+
+func TestServer_GetHandoffGate(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.HandoffGateMaxWait.Key, "3600")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.HandoffGateMaxWait.Key)
+
+	meta, err := newMemoryMeta()
+	assert.NoError(t, err)
+	now := time.Now().Truncate(time.Millisecond)
+	s := &Server{
+		meta:          meta,
+		handler:       newMockHandler(),
+		freezeManager: newFreezeManager(),
+		handoffGate:   newHandoffGate(),
+		session:       &sessionutil.Session{ServerID: 1},
+	}
+	s.handoffGate.now = func() time.Time { return now }
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	assert.NoError(t, meta.AddSegment(newHandoffGateSegment(1, commonpb.SegmentState_Flushed, now.Add(-2*time.Hour))))
+	assert.NoError(t, meta.AddSegment(newHandoffGateSegment(2, commonpb.SegmentState_Flushed, now.Add(-time.Minute))))
+	assert.NoError(t, meta.AddSegment(newHandoffGateSegment(3, commonpb.SegmentState_Growing, now)))
+
+	ctx := context.Background()
+	resp, err := s.GetHandoffGate(ctx, &datapb.GetHandoffGateRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.True(t, resp.GetEnabled())
+	assert.Equal(t, int64(3600), resp.GetMaxWaitSeconds())
+	assert.Equal(t, 2, len(resp.GetSegments()))
+	assert.Equal(t, UniqueID(1), resp.GetSegments()[0].GetSegmentID())
+	assert.Equal(t, handoffGateReleased, resp.GetSegments()[0].GetState())
+	assert.Equal(t, now.Add(-2*time.Hour).UnixMilli(), resp.GetSegments()[0].GetFlushedAt())
+	assert.Equal(t, UniqueID(2), resp.GetSegments()[1].GetSegmentID())
+	assert.Equal(t, handoffGateWaiting, resp.GetSegments()[1].GetState())
+	assert.InDelta(t, 3540, resp.GetSegments()[1].GetRemainingSeconds(), 1)
+
+	resp, err = s.GetHandoffGate(ctx, &datapb.GetHandoffGateRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(resp.GetSegments()))
+
+	// released segments are held back during a freeze window
+	_, err = s.freezeManager.Freeze(1, time.Minute, nil)
+	assert.NoError(t, err)
+	report := s.handoffGateReport(0)
+	assert.Equal(t, 2, len(report.GetSegments()))
+	assert.Equal(t, handoffGateFrozen, report.GetSegments()[0].GetState())
+	assert.Equal(t, handoffGateWaiting, report.GetSegments()[1].GetState())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.GetHandoffGate(ctx, &datapb.GetHandoffGateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// preImport checks the files of an import against the collection before any import task is scheduled,
+// an error is returned only if the check can't be done, e.g. the collection can't be described.
+func (s *Server) preImport(ctx context.Context, collectionID int64, files []string,
+	options []*commonpb.KeyValuePair) (*importutil.PreImportReport, error) {
+	if s.meta.chunkManager == nil {
+		return nil, errors.New("chunk manager is not initialized")
+	}
+	resp, err := s.rootCoordClient.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err = VerifyResponse(resp, err); err != nil {
+		return nil, err
+	}
+	segmentSize := Params.DataCoordCfg.SegmentMaxSize.GetAsInt64() * 1024 * 1024
+	return importutil.PreImportCheck(ctx, s.meta.chunkManager, resp.GetSchema(), resp.GetShardsNum(),
+		segmentSize, files, options), nil
+}
+
+// PreImport checks the files of an import against the collection before the import tasks are created, the report
+// is returned whether the check passes or not.
+func (s *Server) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		log.Warn("failed to check the import files for closed DataCoord service")
+		return &datapb.PreImportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	report, err := s.preImport(ctx, req.GetCollectionID(), req.GetFiles(), req.GetOptions())
+	if err != nil {
+		log.Warn("failed to check the import files", zap.Error(err))
+		return &datapb.PreImportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Info("import files checked", zap.Bool("passed", report.Passed()),
+		zap.Int64("estimated rows", report.EstimatedRows), zap.Int64("estimated segments", report.EstimatedSegments))
+
+	files := make([]*datapb.PreImportFileReport, 0, len(report.Files))
+	for _, file := range report.Files {
+		files = append(files, &datapb.PreImportFileReport{
+			Path:          file.Path,
+			Size:          file.Size,
+			EstimatedRows: file.EstimatedRows,
+			Error:         file.Error,
+		})
+	}
+	return &datapb.PreImportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		RowBased:          report.RowBased,
+		Backup:            report.Backup,
+		Files:             files,
+		EstimatedRows:     report.EstimatedRows,
+		EstimatedBytes:    report.EstimatedBytes,
+		EstimatedSegments: report.EstimatedSegments,
+		Errors:            report.Errors,
+	}, nil
+}
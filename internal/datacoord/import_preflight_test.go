@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestServer_PreImport(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	meta, err := newMeta(ctx, memkv.NewMemoryKV(), "", cm)
+	require.NoError(t, err)
+	s := &Server{
+		meta:            meta,
+		rootCoordClient: newMockRootCoordService(),
+		session:         &sessionutil.Session{ServerID: 1},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	// the mocked collection has no field, any row fails the check
+	filePath := path.Join(cm.RootPath(), "rows.json")
+	require.NoError(t, cm.Write(ctx, filePath, []byte(`{"rows": [{"dummy": 1}]}`)))
+
+	resp, err := s.PreImport(ctx, &datapb.PreImportRequest{CollectionID: 1314, Files: []string{filePath}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.NotEmpty(t, resp.GetErrors())
+	require.Equal(t, 1, len(resp.GetFiles()))
+	assert.Equal(t, filePath, resp.GetFiles()[0].GetPath())
+	assert.Equal(t, int64(len(`{"rows": [{"dummy": 1}]}`)), resp.GetFiles()[0].GetSize())
+
+	resp, err = s.PreImport(ctx, &datapb.PreImportRequest{CollectionID: 1314, Files: []string{"/dummy/dummy.json"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.NotEmpty(t, resp.GetErrors())
+	assert.True(t, strings.Contains(resp.GetErrors()[0], "dummy.json"))
+
+	resp, err = s.PreImport(ctx, &datapb.PreImportRequest{CollectionID: 1314})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"no file to import"}, resp.GetErrors())
+
+	s.meta.chunkManager = nil
+	resp, err = s.PreImport(ctx, &datapb.PreImportRequest{CollectionID: 1314, Files: []string{filePath}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.PreImport(ctx, &datapb.PreImportRequest{CollectionID: 1314, Files: []string{filePath}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -86,9 +86,11 @@ type indexBuilder struct {
 	policy       buildIndexPolicy
 	nodeManager  *IndexNodeManager
 	chunkManager storage.ChunkManager
+	segmentLocks *segmentLockManager
 }
 
-func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNodeManager, chunkManager storage.ChunkManager) *indexBuilder {
+func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNodeManager, chunkManager storage.ChunkManager,
+	segmentLocks *segmentLockManager) *indexBuilder {
 	ctx, cancel := context.WithCancel(ctx)
 
 	ib := &indexBuilder{
@@ -101,6 +103,7 @@ func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNod
 		policy:           defaultBuildIndexPolicy,
 		nodeManager:      nodeManager,
 		chunkManager:     chunkManager,
+		segmentLocks:     segmentLocks,
 	}
 	ib.reloadFromKV()
 	return ib
@@ -124,6 +127,7 @@ func (ib *indexBuilder) reloadFromKV() {
 				ib.tasks[segIndex.BuildID] = indexTaskInit
 			} else if segIndex.IndexState == commonpb.IndexState_InProgress {
 				ib.tasks[segIndex.BuildID] = indexTaskInProgress
+				ib.segmentLocks.Acquire(segmentLockOwner{NodeID: segIndex.NodeID, TaskID: segIndex.BuildID}, segment.GetID())
 			}
 		}
 	}
@@ -239,6 +243,8 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 				log.Ctx(ib.ctx).Warn("IndexCoord update index state fail", zap.Int64("buildID", buildID), zap.Error(err))
 				return false
 			}
+			// no index files are built, the segment is searched by brute force of the knowhere linked
+			ib.recordIndexVersion(buildID, common.KnowhereVersion)
 			updateStateFunc(buildID, indexTaskDone)
 			return true
 		}
@@ -256,13 +262,15 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		}
 
 		binLogs := make([]string, 0)
-		fieldID := ib.meta.GetFieldIDByIndexID(meta.CollectionID, meta.IndexID)
-		for _, fieldBinLog := range segment.GetBinlogs() {
-			if fieldBinLog.GetFieldID() == fieldID {
-				for _, binLog := range fieldBinLog.GetBinlogs() {
-					binLogs = append(binLogs, binLog.LogPath)
+		// composite indexes need the binlogs of all the covered fields
+		for _, fieldID := range ib.meta.GetFieldIDsByIndexID(meta.CollectionID, meta.IndexID) {
+			for _, fieldBinLog := range segment.GetBinlogs() {
+				if fieldBinLog.GetFieldID() == fieldID {
+					for _, binLog := range fieldBinLog.GetBinlogs() {
+						binLogs = append(binLogs, binLog.LogPath)
+					}
+					break
 				}
-				break
 			}
 		}
 
@@ -307,6 +315,8 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		}
 		log.Ctx(ib.ctx).Info("index task assigned successfully", zap.Int64("buildID", buildID),
 			zap.Int64("segID", meta.SegmentID), zap.Int64("nodeID", nodeID))
+		// lock the segment so its binlogs are not recycled while the IndexNode is reading them
+		ib.segmentLocks.Acquire(segmentLockOwner{NodeID: nodeID, TaskID: buildID}, meta.SegmentID)
 		// update index meta state to InProgress
 		if err := ib.meta.BuildIndex(buildID); err != nil {
 			// need to release lock then reassign, so set task state to retry
@@ -321,23 +331,41 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		if !ib.dropIndexTask(buildID, meta.NodeID) {
 			return true
 		}
+		ib.segmentLocks.Release(segmentLockOwner{NodeID: meta.NodeID, TaskID: buildID})
 		deleteFunc(buildID)
 	case indexTaskRetry:
 		if !ib.dropIndexTask(buildID, meta.NodeID) {
 			return true
 		}
+		ib.segmentLocks.Release(segmentLockOwner{NodeID: meta.NodeID, TaskID: buildID})
 		updateStateFunc(buildID, indexTaskInit)
 
 	case indexTaskDeleted:
+		ib.segmentLocks.Release(segmentLockOwner{NodeID: meta.NodeID, TaskID: buildID})
 		deleteFunc(buildID)
 
 	default:
 		// state: in_progress
-		updateStateFunc(buildID, ib.getTaskState(buildID, meta.NodeID))
+		state := ib.getTaskState(buildID, meta.NodeID)
+		if state == indexTaskInProgress {
+			ib.renewSegmentLock(buildID, meta.NodeID, meta.SegmentID)
+		}
+		updateStateFunc(buildID, state)
 	}
 	return true
 }
 
+// renewSegmentLock extends the lease of the segment lock held by the running task,
+// the lock is acquired again if the lease has expired.
+func (ib *indexBuilder) renewSegmentLock(buildID, nodeID, segmentID UniqueID) {
+	owner := segmentLockOwner{NodeID: nodeID, TaskID: buildID}
+	if err := ib.segmentLocks.Renew(owner); err != nil {
+		log.Ctx(ib.ctx).Warn("renew segment lock failed, acquire again", zap.Int64("buildID", buildID),
+			zap.Int64("nodeID", nodeID), zap.Int64("segmentID", segmentID), zap.Error(err))
+		ib.segmentLocks.Acquire(owner, segmentID)
+	}
+}
+
 func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 	client, exist := ib.nodeManager.GetClientByID(nodeID)
 	if exist {
@@ -369,6 +397,9 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 							zap.String("index state", info.State.String()), zap.Error(err))
 						return indexTaskInProgress
 					}
+					if info.State == commonpb.IndexState_Finished {
+						ib.recordIndexVersion(buildID, info.GetEngineVersion())
+					}
 					return indexTaskDone
 				} else if info.State == commonpb.IndexState_Retry || info.State == commonpb.IndexState_IndexStateNone {
 					log.Ctx(ib.ctx).Info("this task should be retry", zap.Int64("buildID", buildID), zap.String("fail reason", info.FailReason))
@@ -436,6 +467,8 @@ func (ib *indexBuilder) assignTask(builderClient types.IndexNode, req *indexpb.C
 func (ib *indexBuilder) nodeDown(nodeID UniqueID) {
 	defer ib.notify()
 
+	ib.segmentLocks.ReleaseNode(nodeID)
+
 	metas := ib.meta.GetMetasByNodeID(nodeID)
 
 	ib.taskMutex.Lock()
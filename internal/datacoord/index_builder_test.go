@@ -611,7 +611,7 @@ func TestIndexBuilder(t *testing.T) {
 	chunkManager := &mocks.ChunkManager{}
 	chunkManager.EXPECT().RootPath().Return("root")
 
-	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager)
+	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager, newSegmentLockManager())
 
 	assert.Equal(t, 7, len(ib.tasks))
 	assert.Equal(t, indexTaskInit, ib.tasks[buildID])
@@ -674,6 +674,7 @@ func TestIndexBuilder_Error(t *testing.T) {
 		meta: createMetaTable(&datacoord.Catalog{
 			Txn: &saveFailKV{}}),
 		chunkManager: chunkManager,
+		segmentLocks: newSegmentLockManager(),
 	}
 
 	t.Run("meta not exist", func(t *testing.T) {
@@ -98,75 +98,21 @@ func (m *meta) updateIndexTasksMetrics() {
 	}
 }
 
-func checkParams(fieldIndex *model.Index, req *datapb.CreateIndexRequest) bool {
-	if len(fieldIndex.TypeParams) != len(req.TypeParams) {
-		return false
-	}
-	notEq := false
-	for _, param1 := range fieldIndex.TypeParams {
-		exist := false
-		for _, param2 := range req.TypeParams {
-			if param2.Key == param1.Key && param2.Value == param1.Value {
-				exist = true
-			}
-		}
-		if !exist {
-			notEq = true
-			break
-		}
-	}
-	if notEq {
-		return false
-	}
-	if len(fieldIndex.IndexParams) != len(req.IndexParams) {
-		return false
-	}
-	for _, param1 := range fieldIndex.IndexParams {
-		exist := false
-		for _, param2 := range req.IndexParams {
-			if param2.Key == param1.Key && param2.Value == param1.Value {
-				exist = true
-			}
-		}
-		if !exist {
-			notEq = true
-			break
-		}
-	}
-
-	return !notEq
-}
-
 func (m *meta) CanCreateIndex(req *datapb.CreateIndexRequest) (UniqueID, error) {
 	m.RLock()
 	defer m.RUnlock()
 
-	indexes, ok := m.indexes[req.CollectionID]
-	if !ok {
-		return 0, nil
+	indexes := make([]*model.Index, 0, len(m.indexes[req.CollectionID]))
+	for _, index := range m.indexes[req.CollectionID] {
+		indexes = append(indexes, index)
 	}
-	for _, index := range indexes {
-		if index.IsDeleted {
-			continue
-		}
-		if req.IndexName == index.IndexName {
-			if req.FieldID == index.FieldID && checkParams(index, req) {
-				return index.IndexID, nil
-			}
-			errMsg := "at most one distinct index is allowed per field"
-			log.Warn(errMsg,
-				zap.String("source index", fmt.Sprintf("{index_name: %s, field_id: %d, index_params: %v, type_params: %v}", index.IndexName, index.FieldID, index.IndexParams, index.TypeParams)),
-				zap.String("current index", fmt.Sprintf("{index_name: %s, field_id: %d, index_params: %v, type_params: %v}", req.GetIndexName(), req.GetFieldID(), req.GetIndexParams(), req.GetTypeParams())))
-			return 0, fmt.Errorf("CreateIndex failed: %s", errMsg)
-		}
-		if req.FieldID == index.FieldID {
-			// creating multiple indexes on same field is not supported
-			errMsg := "CreateIndex failed: creating multiple indexes on same field is not supported"
-			log.Warn(errMsg)
-			return 0, fmt.Errorf(errMsg)
-		}
+	indexID, err := model.CheckCreateIndex(indexes, req.GetFieldID(), req.GetIndexName(), req.GetTypeParams(), req.GetIndexParams())
+	if err != nil {
+		log.Warn("can not create index", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.String("current index", fmt.Sprintf("{index_name: %s, field_id: %d, index_params: %v, type_params: %v}", req.GetIndexName(), req.GetFieldID(), req.GetIndexParams(), req.GetTypeParams())),
+			zap.Error(err))
 	}
-	return 0, nil
+	return indexID, err
 }
 
 // HasSameReq determine whether there are same indexing tasks.
@@ -181,7 +127,7 @@ func (m *meta) HasSameReq(req *datapb.CreateIndexRequest) (bool, UniqueID) {
 		if fieldIndex.FieldID != req.FieldID || fieldIndex.IndexName != req.IndexName {
 			continue
 		}
-		if !checkParams(fieldIndex, req) {
+		if !fieldIndex.SameParams(req.GetTypeParams(), req.GetIndexParams()) {
 			continue
 		}
 		log.Debug("has same index", zap.Int64("collectionID", req.CollectionID),
@@ -418,6 +364,19 @@ func (m *meta) GetFieldIDByIndexID(collID, indexID UniqueID) UniqueID {
 	return 0
 }
 
+// GetFieldIDsByIndexID returns all the fields covered by the index, the leading field comes first.
+func (m *meta) GetFieldIDsByIndexID(collID, indexID UniqueID) []UniqueID {
+	m.RLock()
+	defer m.RUnlock()
+
+	if fieldIndexes, ok := m.indexes[collID]; ok {
+		if index, ok := fieldIndexes[indexID]; ok {
+			return index.FieldIDs()
+		}
+	}
+	return nil
+}
+
 func (m *meta) GetIndexNameByID(collID, indexID UniqueID) string {
 	m.RLock()
 	defer m.RUnlock()
@@ -590,6 +549,13 @@ func (m *meta) RemoveSegmentIndex(collID, partID, segID, indexID, buildID Unique
 	m.Lock()
 	defer m.Unlock()
 
+	if _, ok := m.indexVersions[buildID]; ok {
+		if err := m.catalog.DropSegmentIndexVersion(m.ctx, buildID); err != nil {
+			return err
+		}
+		delete(m.indexVersions, buildID)
+	}
+
 	err := m.catalog.DropSegmentIndex(m.ctx, collID, partID, segID, buildID)
 	if err != nil {
 		return err
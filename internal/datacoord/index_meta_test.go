@@ -26,10 +26,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 )
 
 func TestMeta_CanCreateIndex(t *testing.T) {
@@ -751,6 +753,35 @@ func TestMeta_GetFieldIDByIndexID(t *testing.T) {
 	})
 }
 
+func TestMeta_GetFieldIDsByIndexID(t *testing.T) {
+	m := &meta{
+		indexes: map[UniqueID]map[UniqueID]*model.Index{
+			collID: {
+				indexID: {
+					CollectionID: collID,
+					FieldID:      fieldID,
+					IndexID:      indexID,
+					IndexName:    indexName,
+				},
+				indexID + 1: {
+					CollectionID: collID,
+					FieldID:      fieldID + 1,
+					IndexID:      indexID + 1,
+					IndexName:    indexName + "_composite",
+					IndexParams: []*commonpb.KeyValuePair{
+						{Key: common.IndexTypeKey, Value: indexparamcheck.IndexComposite},
+						{Key: common.CompositeFieldIDsKey, Value: common.FormatCompositeFieldIDs([]int64{fieldID + 1, fieldID})},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []UniqueID{fieldID}, m.GetFieldIDsByIndexID(collID, indexID))
+	assert.Equal(t, []UniqueID{fieldID + 1, fieldID}, m.GetFieldIDsByIndexID(collID, indexID+1))
+	assert.Nil(t, m.GetFieldIDsByIndexID(collID, indexID+2))
+}
+
 func TestMeta_GetIndexNameByID(t *testing.T) {
 	m := &meta{
 		indexes: map[UniqueID]map[UniqueID]*model.Index{
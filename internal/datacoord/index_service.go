@@ -62,6 +62,12 @@ func (s *Server) createIndexForSegment(segment *SegmentInfo, indexID UniqueID) e
 }
 
 func (s *Server) createIndexesForSegment(segment *SegmentInfo) error {
+	s.createIndexMu.Lock()
+	defer s.createIndexMu.Unlock()
+	// the segment indexes may be created since the segment was fetched
+	if latest := s.meta.GetSegmentUnsafe(segment.GetID()); latest != nil {
+		segment = latest
+	}
 	indexes := s.meta.GetIndexesForCollection(segment.CollectionID, "")
 	for _, index := range indexes {
 		if _, ok := segment.segmentIndexes[index.IndexID]; !ok {
@@ -120,6 +126,28 @@ func (s *Server) createIndexForSegmentLoop(ctx context.Context) {
 	}
 }
 
+// createIndexesForImportSegment enqueues the index builds of a segment imported by bulk load as soon as it's saved.
+// An imported segment has all its binlogs once saved, so it doesn't wait for the DataNode to flush it
+// and for the flushed segment notification to be indexed.
+func (s *Server) createIndexesForImportSegment(segmentID UniqueID) {
+	if !Params.DataCoordCfg.ImportBuildIndexOnSave.GetAsBool() {
+		return
+	}
+	segment := s.meta.GetSegmentUnsafe(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) {
+		log.Warn("imported segment not found, wait for the flushed segment notification to build index",
+			zap.Int64("segID", segmentID))
+		return
+	}
+	if len(s.meta.GetIndexesForCollection(segment.CollectionID, "")) == 0 {
+		return
+	}
+	log.Info("create indexes for imported segment", zap.Int64("segID", segmentID))
+	if err := s.createIndexesForSegment(segment); err != nil {
+		log.Warn("create index for imported segment fail, wait for retry", zap.Int64("segID", segmentID), zap.Error(err))
+	}
+}
+
 // CreateIndex create an index on collection.
 // Index building is asynchronous, so when an index building request comes, an IndexID is assigned to the task and
 // will get all flushed segments from DataCoord and record tasks with these segments. The background process
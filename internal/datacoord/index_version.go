@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func (m *meta) updateIndexVersion(version *model.SegmentIndexVersion) {
+	if m.indexVersions == nil {
+		m.indexVersions = make(map[UniqueID]*model.SegmentIndexVersion)
+	}
+	m.indexVersions[version.BuildID] = version
+}
+
+// SaveIndexVersion records the engine and file format versions producing the segment index.
+func (m *meta) SaveIndexVersion(version *model.SegmentIndexVersion) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.buildID2SegmentIndex[version.BuildID]; !ok {
+		return fmt.Errorf("there is no index with buildID: %d", version.BuildID)
+	}
+	if err := m.catalog.SaveSegmentIndexVersion(m.ctx, version); err != nil {
+		return err
+	}
+	m.updateIndexVersion(version)
+	return nil
+}
+
+// GetIndexVersion returns the versions producing the segment index, false if not recorded.
+func (m *meta) GetIndexVersion(buildID UniqueID) (*model.SegmentIndexVersion, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	version, ok := m.indexVersions[buildID]
+	return version, ok
+}
+
+// GetDeprecatedSegmentIndexes returns the finished segment indexes of the healthy segments built by an engine older
+// than minEngineVersion or in a file format older than minFormatVersion, collectionID 0 means all the collections.
+// The indexes without recorded versions, e.g. built before the versions are tracked, are treated as deprecated
+// once any limit is set.
+func (m *meta) GetDeprecatedSegmentIndexes(collectionID UniqueID, minEngineVersion string, minFormatVersion int32) ([]*datapb.DeprecatedSegmentIndex, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	ret := make([]*datapb.DeprecatedSegmentIndex, 0)
+	for buildID, segIdx := range m.buildID2SegmentIndex {
+		if segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_Finished {
+			continue
+		}
+		if collectionID != 0 && segIdx.CollectionID != collectionID {
+			continue
+		}
+		if !isSegmentHealthy(m.segments.GetSegment(segIdx.SegmentID)) {
+			continue
+		}
+		version, ok := m.indexVersions[buildID]
+		if !ok {
+			version = &model.SegmentIndexVersion{BuildID: buildID}
+		}
+		deprecated, err := version.IsDeprecated(minEngineVersion, minFormatVersion)
+		if err != nil {
+			return nil, err
+		}
+		if !deprecated {
+			continue
+		}
+		ret = append(ret, &datapb.DeprecatedSegmentIndex{
+			CollectionID:  segIdx.CollectionID,
+			PartitionID:   segIdx.PartitionID,
+			SegmentID:     segIdx.SegmentID,
+			IndexID:       segIdx.IndexID,
+			BuildID:       buildID,
+			EngineVersion: version.EngineVersion,
+			FormatVersion: version.FormatVersion,
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].BuildID < ret[j].BuildID })
+	return ret, nil
+}
+
+// ResetSegmentIndex sets the finished segment index to be Unissued, so that it's built again by the index builder,
+// the version records of the old index files are dropped.
+func (m *meta) ResetSegmentIndex(buildID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+	if segIdx.IndexState != commonpb.IndexState_Finished {
+		return fmt.Errorf("the index with buildID %d is not finished, state: %s", buildID, segIdx.IndexState.String())
+	}
+
+	if _, ok := m.indexVersions[buildID]; ok {
+		if err := m.catalog.DropSegmentIndexVersion(m.ctx, buildID); err != nil {
+			return err
+		}
+		delete(m.indexVersions, buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.IndexState = commonpb.IndexState_Unissued
+		segIdx.FailReason = ""
+		segIdx.IndexFileKeys = nil
+		segIdx.IndexSize = 0
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
+		return err
+	}
+
+	log.Info("reset segment index to rebuild", zap.Int64("buildID", buildID), zap.Int64("segID", segIdx.SegmentID))
+	m.updateIndexTasksMetrics()
+	return nil
+}
+
+// recordIndexVersion records the versions producing the finished index, an index without version record is
+// reported as deprecated, so the failure doesn't break the index task.
+func (ib *indexBuilder) recordIndexVersion(buildID UniqueID, engineVersion string) {
+	err := ib.meta.SaveIndexVersion(&model.SegmentIndexVersion{
+		BuildID:       buildID,
+		EngineVersion: engineVersion,
+		FormatVersion: common.IndexFileFormatVersion,
+	})
+	if err != nil {
+		log.Ctx(ib.ctx).Warn("failed to record segment index version", zap.Int64("buildID", buildID),
+			zap.String("engineVersion", engineVersion), zap.Error(err))
+	}
+}
+
+// hasTask returns whether the index task is still tracked by the index builder.
+func (ib *indexBuilder) hasTask(buildID UniqueID) bool {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+	_, ok := ib.tasks[buildID]
+	return ok
+}
+
+// rebuildDeprecatedIndexes resets at most limit deprecated segment indexes and enqueues them into the index builder,
+// a non-positive limit means no limit. The indexes with running tasks are skipped, they can be rebuilt next time.
+func (s *Server) rebuildDeprecatedIndexes(indexes []*datapb.DeprecatedSegmentIndex, limit int) []UniqueID {
+	rebuilt := make([]UniqueID, 0)
+	for _, index := range indexes {
+		if limit > 0 && len(rebuilt) >= limit {
+			break
+		}
+		if s.indexBuilder.hasTask(index.BuildID) {
+			continue
+		}
+		if err := s.meta.ResetSegmentIndex(index.BuildID); err != nil {
+			log.Warn("failed to reset deprecated segment index", zap.Int64("buildID", index.BuildID), zap.Error(err))
+			continue
+		}
+		s.indexBuilder.enqueue(index.BuildID)
+		rebuilt = append(rebuilt, index.BuildID)
+	}
+	log.Info("rebuild deprecated segment indexes", zap.Int("deprecated", len(indexes)), zap.Int64s("rebuilt", rebuilt))
+	return rebuilt
+}
+
+// RebuildDeprecatedIndexes lists the segment indexes built by the engine or file format versions older than the
+// configured limits or the ones of the request, and resets and enqueues them to rebuild in background unless it's
+// a dry run.
+func (s *Server) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	if s.isClosed() {
+		return &datapb.RebuildDeprecatedIndexesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+
+	minEngineVersion := Params.DataCoordCfg.IndexMinEngineVersion.GetValue()
+	if req.GetMinEngineVersion() != "" {
+		minEngineVersion = req.GetMinEngineVersion()
+	}
+	minFormatVersion := int32(Params.DataCoordCfg.IndexMinFormatVersion.GetAsInt64())
+	if req.GetMinFormatVersion() > 0 {
+		minFormatVersion = req.GetMinFormatVersion()
+	}
+	indexes, err := s.meta.GetDeprecatedSegmentIndexes(req.GetCollectionID(), minEngineVersion, minFormatVersion)
+	if err != nil {
+		return &datapb.RebuildDeprecatedIndexesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	resp := &datapb.RebuildDeprecatedIndexesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Deprecated: indexes,
+	}
+	if !req.GetDryRun() {
+		resp.RebuiltBuildIDs = s.rebuildDeprecatedIndexes(indexes, int(req.GetLimit()))
+	}
+	return resp, nil
+}
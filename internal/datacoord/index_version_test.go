@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// prepareFinishedSegmentIndexes creates the finished segment indexes of segments [1, n] with the build IDs [101, 100+n].
+func prepareFinishedSegmentIndexes(t *testing.T, m *meta, n int) {
+	require.NoError(t, m.CreateIndex(&model.Index{CollectionID: 1, FieldID: 100, IndexID: 10, IndexName: "idx"}))
+	for i := 1; i <= n; i++ {
+		segmentID := UniqueID(i)
+		require.NoError(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: 1,
+			PartitionID:  2,
+			State:        commonpb.SegmentState_Flushed,
+			NumOfRows:    2048,
+		})))
+		require.NoError(t, m.AddSegmentIndex(&model.SegmentIndex{
+			SegmentID:    segmentID,
+			CollectionID: 1,
+			PartitionID:  2,
+			NumRows:      2048,
+			IndexID:      10,
+			BuildID:      100 + segmentID,
+		}))
+		require.NoError(t, m.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID:       100 + segmentID,
+			State:         commonpb.IndexState_Finished,
+			IndexFileKeys: []string{"file"},
+		}))
+	}
+}
+
+func TestMeta_IndexVersion(t *testing.T) {
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	prepareFinishedSegmentIndexes(t, m, 3)
+
+	assert.NoError(t, m.SaveIndexVersion(&model.SegmentIndexVersion{BuildID: 101, EngineVersion: "v1.3.4", FormatVersion: 1}))
+	assert.NoError(t, m.SaveIndexVersion(&model.SegmentIndexVersion{BuildID: 102, EngineVersion: "v1.3.6", FormatVersion: 1}))
+	assert.Error(t, m.SaveIndexVersion(&model.SegmentIndexVersion{BuildID: 200}))
+	version, ok := m.GetIndexVersion(101)
+	assert.True(t, ok)
+	assert.Equal(t, "v1.3.4", version.EngineVersion)
+
+	// no limit
+	indexes, err := m.GetDeprecatedSegmentIndexes(0, "", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, indexes)
+
+	// the index without version record is deprecated
+	indexes, err = m.GetDeprecatedSegmentIndexes(0, "v1.3.5", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(indexes))
+	assert.Equal(t, UniqueID(101), indexes[0].BuildID)
+	assert.Equal(t, "v1.3.4", indexes[0].EngineVersion)
+	assert.Equal(t, UniqueID(103), indexes[1].BuildID)
+
+	indexes, err = m.GetDeprecatedSegmentIndexes(0, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(indexes))
+	indexes, err = m.GetDeprecatedSegmentIndexes(2, "", 2)
+	assert.NoError(t, err)
+	assert.Empty(t, indexes)
+	_, err = m.GetDeprecatedSegmentIndexes(0, "invalid", 0)
+	assert.Error(t, err)
+
+	// the versions are reloaded
+	reloaded, err := newMeta(context.TODO(), m.catalog.(*datacoord.Catalog).Txn, "", nil)
+	assert.NoError(t, err)
+	_, ok = reloaded.GetIndexVersion(102)
+	assert.True(t, ok)
+
+	t.Run("reset", func(t *testing.T) {
+		assert.NoError(t, m.ResetSegmentIndex(101))
+		_, ok := m.GetIndexVersion(101)
+		assert.False(t, ok)
+		segIdx, ok := m.GetIndexJob(101)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+		assert.Empty(t, segIdx.IndexFileKeys)
+
+		assert.Error(t, m.ResetSegmentIndex(101))
+		assert.Error(t, m.ResetSegmentIndex(200))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		assert.NoError(t, m.RemoveSegmentIndex(1, 2, 2, 10, 102))
+		_, ok := m.GetIndexVersion(102)
+		assert.False(t, ok)
+		versions, err := m.catalog.ListSegmentIndexVersions(context.TODO())
+		assert.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		catalog := m.catalog
+		defer func() { m.catalog = catalog }()
+		assert.NoError(t, m.SaveIndexVersion(&model.SegmentIndexVersion{BuildID: 103, EngineVersion: "v1.3.4"}))
+		m.catalog = &datacoord.Catalog{Txn: &saveFailKV{}}
+		assert.Error(t, m.SaveIndexVersion(&model.SegmentIndexVersion{BuildID: 103}))
+		m.catalog = &datacoord.Catalog{Txn: &removeFailKV{}}
+		assert.Error(t, m.ResetSegmentIndex(103))
+		assert.Error(t, m.RemoveSegmentIndex(1, 2, 3, 10, 103))
+		_, ok := m.GetIndexVersion(103)
+		assert.True(t, ok)
+	})
+}
+
+func TestServer_RebuildDeprecatedIndexes(t *testing.T) {
+	Params.Init()
+	ctx := context.TODO()
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	prepareFinishedSegmentIndexes(t, m, 3)
+	s := &Server{
+		meta:         m,
+		indexBuilder: newIndexBuilder(ctx, m, NewNodeManager(ctx), nil, newSegmentLockManager()),
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	s.indexBuilder.recordIndexVersion(101, "v1.3.6")
+	s.indexBuilder.recordIndexVersion(102, "v1.3.4")
+	version, ok := m.GetIndexVersion(101)
+	assert.True(t, ok)
+	assert.Equal(t, common.IndexFileFormatVersion, version.FormatVersion)
+	// the running task is not rebuilt
+	s.indexBuilder.enqueue(103)
+
+	resp, err := s.RebuildDeprecatedIndexes(ctx, &datapb.RebuildDeprecatedIndexesRequest{DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Empty(t, resp.GetDeprecated())
+
+	resp, err = s.RebuildDeprecatedIndexes(ctx, &datapb.RebuildDeprecatedIndexesRequest{
+		CollectionID:     1,
+		MinEngineVersion: "v1.3.5",
+		DryRun:           true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resp.GetDeprecated()))
+	assert.Empty(t, resp.GetRebuiltBuildIDs())
+
+	resp, err = s.RebuildDeprecatedIndexes(ctx, &datapb.RebuildDeprecatedIndexesRequest{MinEngineVersion: "invalid"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	resp, err = s.RebuildDeprecatedIndexes(ctx, &datapb.RebuildDeprecatedIndexesRequest{MinFormatVersion: 2, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(resp.GetDeprecated()))
+	assert.Equal(t, []UniqueID{101, 102}, resp.GetRebuiltBuildIDs())
+	assert.True(t, s.indexBuilder.hasTask(101))
+	segIdx, ok := m.GetIndexJob(102)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.RebuildDeprecatedIndexes(ctx, &datapb.RebuildDeprecatedIndexesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -62,6 +62,20 @@ type meta struct {
 	// buildID2Meta records the meta information of the segment
 	// buildID -> segmentIndex
 	buildID2SegmentIndex map[UniqueID]*model.SegmentIndex
+	// indexVersions records the engine and file format versions producing the finished segment indexes
+	// buildID -> version
+	indexVersions map[UniqueID]*model.SegmentIndexVersion
+	// segmentHistory records the state transitions of the segments
+	segmentHistory *segmentHistoryRecorder
+	// deleteSLA records the time the sampled deletes reach the stages
+	deleteSLA *deleteSLATracker
+	// collectionPauses records the collections whose compaction or gc is paused by the admin
+	collectionPauses *collectionPauseManager
+	// segmentPKIndex caches the pk ranges of the flushed segments
+	segmentPKIndex *segmentPKIndex
+	// compactionTravelWatermarks records the max travel timestamps of the compactions completed since started
+	// collID -> travel timestamp
+	compactionTravelWatermarks map[UniqueID]Timestamp
 }
 
 // A local cache of segment metric update. Must call commit() to take effect.
@@ -90,11 +104,29 @@ func newMeta(ctx context.Context, kv kv.TxnKV, chunkManagerRootPath string, chun
 		chunkManager:         chunkManager,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+		indexVersions:        make(map[UniqueID]*model.SegmentIndexVersion),
+		segmentPKIndex:       newSegmentPKIndex(chunkManager),
+
+		compactionTravelWatermarks: make(map[UniqueID]Timestamp),
 	}
+	mt.segmentHistory = newSegmentHistoryRecorder(mt.catalog)
+	mt.deleteSLA = newDeleteSLATracker(mt.catalog)
+	mt.collectionPauses = newCollectionPauseManager(mt.catalog)
 	err := mt.reloadFromKV()
 	if err != nil {
 		return nil, err
 	}
+	// the paused collections must be loaded before any compaction or gc runs
+	if err := mt.collectionPauses.load(); err != nil {
+		return nil, err
+	}
+	// the segment histories are for debugging only, and must never block DataCoord from starting
+	if err := mt.segmentHistory.load(); err != nil {
+		log.Warn("failed to load segment histories", zap.Error(err))
+	}
+	if err := mt.deleteSLA.load(); err != nil {
+		log.Warn("failed to load delete sla markers", zap.Error(err))
+	}
 	return mt, nil
 }
 
@@ -148,6 +180,14 @@ func (m *meta) reloadFromKV() error {
 	for _, segIdx := range segmentIndexes {
 		m.updateSegmentIndex(segIdx)
 	}
+	indexVersions, err := m.catalog.ListSegmentIndexVersions(m.ctx)
+	if err != nil {
+		log.Error("DataCoord meta reloadFromKV load segment index versions fail", zap.Error(err))
+		return err
+	}
+	for _, version := range indexVersions {
+		m.updateIndexVersion(version)
+	}
 
 	record.Record("meta reloadFromKV")
 	return nil
@@ -282,6 +322,11 @@ func (m *meta) AddSegment(segment *SegmentInfo) error {
 			zap.Error(err))
 		return err
 	}
+	cause := segmentCauseAllocation
+	if segment.GetIsImporting() {
+		cause = segmentCauseImport
+	}
+	m.recordSegmentStates(cause, segment)
 	m.segments.SetSegment(segment.GetID(), segment)
 	metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String()).Inc()
 	log.Info("meta update: adding segment - complete",
@@ -309,6 +354,8 @@ func (m *meta) DropSegment(segmentID UniqueID) error {
 	}
 	metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String()).Dec()
 	m.segments.DropSegment(segmentID)
+	m.segmentHistory.remove(segmentID)
+	m.deleteSLA.removeSegment(segmentID)
 	log.Info("meta update: dropping segment - complete",
 		zap.Int64("segment ID", segmentID))
 	return nil
@@ -342,8 +389,8 @@ func (m *meta) GetAllSegmentsUnsafe() []*SegmentInfo {
 	return m.segments.GetSegments()
 }
 
-// SetState setting segment with provided ID state
-func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) error {
+// SetState setting segment with provided ID state, the cause is recorded in the state history of the segment
+func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState, cause string) error {
 	log.Info("meta update: setting segment state",
 		zap.Int64("segment ID", segmentID),
 		zap.Any("target state", targetState))
@@ -376,6 +423,7 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 		metricMutation.commit()
 	}
 	// Update in-memory meta.
+	m.recordSegmentStates(cause, clonedSegment)
 	m.segments.SetState(segmentID, targetState)
 	log.Info("meta update: setting segment state - complete",
 		zap.Int64("segment ID", segmentID),
@@ -555,8 +603,15 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	}
 	// Apply metric mutation after a successful meta update.
 	metricMutation.commit()
+	cause := segmentCauseDataNodeFlush
+	if importing {
+		cause = segmentCauseImport
+	} else if dropped {
+		cause = segmentCauseDataNodeDrop
+	}
 	// update memory status
 	for id, s := range modSegments {
+		m.recordSegmentStates(cause, s)
 		m.segments.SetSegment(id, s)
 	}
 	log.Info("meta update: update flush segments info - update flush segments info successfully",
@@ -705,6 +760,7 @@ func (m *meta) batchSaveDropSegments(channel string, modSegments map[int64]*Segm
 
 	// update memory info
 	for id, segment := range modSegments {
+		m.recordSegmentStates(segmentCauseChannelDrop, segment)
 		m.segments.SetSegment(id, segment)
 	}
 
@@ -930,7 +986,19 @@ func (m *meta) SetSegmentCompacting(segmentID UniqueID, compacting bool) {
 // The compactedTo segment could contain 0 numRows
 func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
 	result *datapb.CompactionResult) ([]*SegmentInfo, []*SegmentInfo, *SegmentInfo, *segMetricMutation, error) {
-	log.Info("meta update: prepare for complete compaction mutation")
+	oldSegments, modSegments, newSegments, metricMutation, err := m.PrepareCompleteSplitCompactionMutation(compactionLogs, result)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return oldSegments, modSegments, newSegments[0], metricMutation, nil
+}
+
+// PrepareCompleteSplitCompactionMutation is PrepareCompleteCompactionMutation for the compactions that split the merged
+// rows into several compactedTo segments, each of them is compacted from all the compactedFrom segments and gets a copy
+// of the delta logs added to the compactedFrom segments during compaction.
+func (m *meta) PrepareCompleteSplitCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
+	results ...*datapb.CompactionResult) ([]*SegmentInfo, []*SegmentInfo, []*SegmentInfo, *segMetricMutation, error) {
+	log.Info("meta update: prepare for complete compaction mutation", zap.Int("compactedTo segments", len(results)))
 	m.Lock()
 	defer m.Unlock()
 
@@ -978,43 +1046,48 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 	}
 
 	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
-	copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, result.GetSegmentID())
-	if err != nil {
-		return nil, nil, nil, nil, err
-	}
-	deltalogs := append(result.GetDeltalogs(), copiedDeltalogs...)
 
 	compactionFrom := make([]UniqueID, 0, len(modSegments))
 	for _, s := range modSegments {
 		compactionFrom = append(compactionFrom, s.GetID())
 	}
 
-	segmentInfo := &datapb.SegmentInfo{
-		ID:                  result.GetSegmentID(),
-		CollectionID:        modSegments[0].CollectionID,
-		PartitionID:         modSegments[0].PartitionID,
-		InsertChannel:       modSegments[0].InsertChannel,
-		NumOfRows:           result.NumOfRows,
-		State:               commonpb.SegmentState_Flushing,
-		MaxRowNum:           modSegments[0].MaxRowNum,
-		Binlogs:             result.GetInsertLogs(),
-		Statslogs:           result.GetField2StatslogPaths(),
-		Deltalogs:           deltalogs,
-		StartPosition:       startPosition,
-		DmlPosition:         dmlPosition,
-		CreatedByCompaction: true,
-		CompactionFrom:      compactionFrom,
-	}
-	segment := NewSegmentInfo(segmentInfo)
-	metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
-	log.Info("meta update: prepare for complete compaction mutation - complete",
-		zap.Int64("collection ID", segment.GetCollectionID()),
-		zap.Int64("partition ID", segment.GetPartitionID()),
-		zap.Int64("new segment ID", segment.GetID()),
-		zap.Int64("new segment num of rows", segment.GetNumOfRows()),
-		zap.Any("compacted from", segment.GetCompactionFrom()))
-
-	return oldSegments, modSegments, segment, metricMutation, nil
+	newSegments := make([]*SegmentInfo, 0, len(results))
+	for _, result := range results {
+		copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, result.GetSegmentID())
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		deltalogs := append(result.GetDeltalogs(), copiedDeltalogs...)
+
+		segmentInfo := &datapb.SegmentInfo{
+			ID:                  result.GetSegmentID(),
+			CollectionID:        modSegments[0].CollectionID,
+			PartitionID:         modSegments[0].PartitionID,
+			InsertChannel:       modSegments[0].InsertChannel,
+			NumOfRows:           result.NumOfRows,
+			State:               commonpb.SegmentState_Flushing,
+			MaxRowNum:           modSegments[0].MaxRowNum,
+			Binlogs:             result.GetInsertLogs(),
+			Statslogs:           result.GetField2StatslogPaths(),
+			Deltalogs:           deltalogs,
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+		}
+		segment := NewSegmentInfo(segmentInfo)
+		metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
+		log.Info("meta update: prepare for complete compaction mutation - complete",
+			zap.Int64("collection ID", segment.GetCollectionID()),
+			zap.Int64("partition ID", segment.GetPartitionID()),
+			zap.Int64("new segment ID", segment.GetID()),
+			zap.Int64("new segment num of rows", segment.GetNumOfRows()),
+			zap.Any("compacted from", segment.GetCompactionFrom()))
+		newSegments = append(newSegments, segment)
+	}
+
+	return oldSegments, modSegments, newSegments, metricMutation, nil
 }
 
 func (m *meta) copyDeltaFiles(binlogs []*datapb.FieldBinlog, collectionID, partitionID, targetSegmentID int64) ([]*datapb.FieldBinlog, error) {
@@ -1039,18 +1112,20 @@ func (m *meta) copyDeltaFiles(binlogs []*datapb.FieldBinlog, collectionID, parti
 	return ret, nil
 }
 
-func (m *meta) alterMetaStoreAfterCompaction(modSegments []*SegmentInfo, newSegment *SegmentInfo) error {
+func (m *meta) alterMetaStoreAfterCompaction(modSegments []*SegmentInfo, newSegments ...*SegmentInfo) error {
 	var modSegIDs []int64
 	for _, seg := range modSegments {
 		modSegIDs = append(modSegIDs, seg.GetID())
 	}
-	log.Info("meta update: alter meta store for compaction updates",
-		zap.Int64s("compact from segments (segments to be updated as dropped)", modSegIDs),
-		zap.Int64("new segmentId", newSegment.GetID()),
-		zap.Int("binlog", len(newSegment.GetBinlogs())),
-		zap.Int("stats log", len(newSegment.GetStatslogs())),
-		zap.Int("delta logs", len(newSegment.GetDeltalogs())),
-		zap.Int64("compact to segment", newSegment.GetID()))
+	for _, newSegment := range newSegments {
+		log.Info("meta update: alter meta store for compaction updates",
+			zap.Int64s("compact from segments (segments to be updated as dropped)", modSegIDs),
+			zap.Int64("new segmentId", newSegment.GetID()),
+			zap.Int("binlog", len(newSegment.GetBinlogs())),
+			zap.Int("stats log", len(newSegment.GetStatslogs())),
+			zap.Int("delta logs", len(newSegment.GetDeltalogs())),
+			zap.Int64("compact to segment", newSegment.GetID()))
+	}
 
 	m.Lock()
 	defer m.Unlock()
@@ -1058,29 +1133,38 @@ func (m *meta) alterMetaStoreAfterCompaction(modSegments []*SegmentInfo, newSegm
 	modInfos := lo.Map(modSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
 		return item.SegmentInfo
 	})
+	newInfos := lo.Map(newSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return item.SegmentInfo
+	})
 
-	if err := m.catalog.AlterSegmentsAndAddNewSegment(m.ctx, modInfos, newSegment.SegmentInfo); err != nil {
+	if err := m.catalog.AlterSegmentsAndAddNewSegment(m.ctx, modInfos, newInfos...); err != nil {
 		return err
 	}
 
 	for _, s := range modSegments {
+		m.recordSegmentStates(segmentCauseCompaction, s)
 		m.segments.SetSegment(s.GetID(), s)
 	}
 
-	if newSegment.GetNumOfRows() > 0 {
-		m.segments.SetSegment(newSegment.GetID(), newSegment)
+	for _, newSegment := range newSegments {
+		if newSegment.GetNumOfRows() > 0 {
+			m.recordSegmentStates(segmentCauseCompaction, newSegment)
+			m.segments.SetSegment(newSegment.GetID(), newSegment)
+		}
 	}
 
 	return nil
 }
 
-func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, removalSegment *SegmentInfo) error {
-	log.Info("meta update: revert metastore after compaction failure",
-		zap.Int64("collectionID", removalSegment.CollectionID),
-		zap.Int64("partitionID", removalSegment.PartitionID),
-		zap.Int64("compactedTo (segment to remove)", removalSegment.ID),
-		zap.Int64s("compactedFrom (segments to add back)", removalSegment.GetCompactionFrom()),
-	)
+func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, removalSegments ...*SegmentInfo) error {
+	for _, removalSegment := range removalSegments {
+		log.Info("meta update: revert metastore after compaction failure",
+			zap.Int64("collectionID", removalSegment.CollectionID),
+			zap.Int64("partitionID", removalSegment.PartitionID),
+			zap.Int64("compactedTo (segment to remove)", removalSegment.ID),
+			zap.Int64s("compactedFrom (segments to add back)", removalSegment.GetCompactionFrom()),
+		)
+	}
 
 	m.Lock()
 	defer m.Unlock()
@@ -1088,17 +1172,24 @@ func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, r
 	oldSegmentInfos := lo.Map(oldSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
 		return item.SegmentInfo
 	})
+	removalInfos := lo.Map(removalSegments, func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return item.SegmentInfo
+	})
 
-	if err := m.catalog.RevertAlterSegmentsAndAddNewSegment(m.ctx, oldSegmentInfos, removalSegment.SegmentInfo); err != nil {
+	if err := m.catalog.RevertAlterSegmentsAndAddNewSegment(m.ctx, oldSegmentInfos, removalInfos...); err != nil {
 		return err
 	}
 
 	for _, s := range oldSegments {
+		m.recordSegmentStates(segmentCauseCompactionRevert, s)
 		m.segments.SetSegment(s.GetID(), s)
 	}
 
-	if removalSegment.GetNumOfRows() > 0 {
-		m.segments.DropSegment(removalSegment.GetID())
+	for _, removalSegment := range removalSegments {
+		if removalSegment.GetNumOfRows() > 0 {
+			m.segments.DropSegment(removalSegment.GetID())
+			m.segmentHistory.remove(removalSegment.GetID())
+		}
 	}
 	return nil
 }
@@ -1244,12 +1335,42 @@ func (m *meta) UpdateChannelCheckpoint(vChannel string, pos *internalpb.MsgPosit
 			return err
 		}
 		m.channelCPs[vChannel] = pos
+		m.deleteSLA.checkpoint(vChannel, pos.Timestamp)
 		ts, _ := tsoutil.ParseTS(pos.Timestamp)
 		log.Debug("UpdateChannelCheckpoint done", zap.String("vChannel", vChannel), zap.Time("time", ts))
 	}
 	return nil
 }
 
+// UpdateChannelCheckpoints updates and saves the checkpoints of several vchannels in one transaction,
+// the checkpoints not newer than the current ones are skipped.
+func (m *meta) UpdateChannelCheckpoints(positions map[string]*internalpb.MsgPosition) error {
+	m.Lock()
+	defer m.Unlock()
+
+	updated := make(map[string]*internalpb.MsgPosition, len(positions))
+	for vChannel, pos := range positions {
+		if pos == nil {
+			return fmt.Errorf("channelCP is nil, vChannel=%s", vChannel)
+		}
+		if oldPosition, ok := m.channelCPs[vChannel]; !ok || oldPosition.Timestamp < pos.Timestamp {
+			updated[vChannel] = pos
+		}
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+	if err := m.catalog.SaveChannelCheckpoints(m.ctx, updated); err != nil {
+		return err
+	}
+	for vChannel, pos := range updated {
+		m.channelCPs[vChannel] = pos
+		m.deleteSLA.checkpoint(vChannel, pos.Timestamp)
+	}
+	log.Debug("UpdateChannelCheckpoints done", zap.Int("channelNum", len(positions)), zap.Int("updatedNum", len(updated)))
+	return nil
+}
+
 func (m *meta) GetChannelCheckpoint(vChannel string) *internalpb.MsgPosition {
 	m.RLock()
 	defer m.RUnlock()
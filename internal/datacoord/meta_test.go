@@ -96,6 +96,8 @@ func (mek *mockEtcdKv) LoadWithPrefix(key string) ([]string, []string, error) {
 			WriteHandoff:  false,
 		}
 		val, _ = proto.Marshal(segIndex)
+	case strings.Contains(key, util.IndexVersionPrefix):
+		val = []byte(`{"build_id":0,"engine_version":"2.2.3","format_version":1}`)
 
 	default:
 		return nil, nil, fmt.Errorf("invalid key")
@@ -419,9 +421,9 @@ func TestMeta_Basic(t *testing.T) {
 		assert.EqualValues(t, 1, len(segIDs))
 		assert.Contains(t, segIDs, segID1_1)
 
-		err = meta.SetState(segID0_0, commonpb.SegmentState_Sealed)
+		err = meta.SetState(segID0_0, commonpb.SegmentState_Sealed, segmentCauseSealPolicy)
 		assert.Nil(t, err)
-		err = meta.SetState(segID0_0, commonpb.SegmentState_Flushed)
+		err = meta.SetState(segID0_0, commonpb.SegmentState_Flushed, segmentCauseFlushDone)
 		assert.Nil(t, err)
 
 		info0_0 = meta.GetSegment(segID0_0)
@@ -836,6 +838,64 @@ func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 	assert.NotZero(t, newSegment.lastFlushTime)
 }
 
+func TestMeta_CompleteSplitCompactionMutation(t *testing.T) {
+	m := &meta{
+		catalog: &datacoord.Catalog{Txn: memkv.NewMemoryKV()},
+		segments: &SegmentsInfo{
+			map[UniqueID]*SegmentInfo{
+				1: {SegmentInfo: &datapb.SegmentInfo{
+					ID:           1,
+					CollectionID: 100,
+					PartitionID:  10,
+					State:        commonpb.SegmentState_Flushed,
+					Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")},
+					NumOfRows:    3,
+				}},
+				2: {SegmentInfo: &datapb.SegmentInfo{
+					ID:           2,
+					CollectionID: 100,
+					PartitionID:  10,
+					State:        commonpb.SegmentState_Flushed,
+					Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log2")},
+					NumOfRows:    3,
+				}},
+			},
+		},
+	}
+
+	inCompactionLogs := []*datapb.CompactionSegmentBinlogs{
+		{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")}},
+		{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log2")}},
+	}
+	results := []*datapb.CompactionResult{
+		{SegmentID: 3, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3")}, NumOfRows: 4},
+		{SegmentID: 4, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log4")}, NumOfRows: 2},
+	}
+	beforeCompact, afterCompact, newSegments, metricMutation, err := m.PrepareCompleteSplitCompactionMutation(inCompactionLogs, results...)
+	assert.NoError(t, err)
+	require.Equal(t, 2, len(newSegments))
+	assert.Equal(t, int64(6), metricMutation.rowCountAccChange)
+	for i, newSegment := range newSegments {
+		assert.Equal(t, results[i].GetSegmentID(), newSegment.GetID())
+		assert.Equal(t, results[i].GetNumOfRows(), newSegment.GetNumOfRows())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, newSegment.GetCompactionFrom())
+		assert.True(t, newSegment.GetCreatedByCompaction())
+	}
+
+	err = m.alterMetaStoreAfterCompaction(afterCompact, newSegments...)
+	assert.NoError(t, err)
+	assert.NotNil(t, m.GetSegment(3))
+	assert.NotNil(t, m.GetSegment(4))
+	assert.Nil(t, m.GetSegment(1))
+
+	err = m.revertAlterMetaStoreAfterCompaction(beforeCompact, newSegments...)
+	assert.NoError(t, err)
+	assert.Nil(t, m.GetSegment(3))
+	assert.Nil(t, m.GetSegment(4))
+	assert.NotNil(t, m.GetSegment(1))
+	assert.NotNil(t, m.GetSegment(2))
+}
+
 func Test_meta_SetSegmentCompacting(t *testing.T) {
 	type fields struct {
 		client   kv.TxnKV
@@ -1097,6 +1157,23 @@ func TestChannelCP(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("UpdateChannelCheckpoints", func(t *testing.T) {
+		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "", nil)
+		assert.NoError(t, err)
+
+		// nil position
+		err = meta.UpdateChannelCheckpoints(map[string]*internalpb.MsgPosition{mockVChannel: nil})
+		assert.Error(t, err)
+
+		err = meta.UpdateChannelCheckpoints(map[string]*internalpb.MsgPosition{
+			mockVChannel:       pos,
+			mockVChannel + "1": pos,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, meta.GetChannelCheckpoint(mockVChannel))
+		assert.NotNil(t, meta.GetChannelCheckpoint(mockVChannel+"1"))
+	})
+
 	t.Run("GetChannelCheckpoint", func(t *testing.T) {
 		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "", nil)
 		assert.NoError(t, err)
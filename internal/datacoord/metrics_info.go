@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"go.uber.org/zap"
@@ -32,6 +33,28 @@ import (
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 )
 
+// getSegmentLocksMetrics returns the segment lock leases not expired yet in json.
+func (s *Server) getSegmentLocksMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.DataCoordRole, paramtable.GetNodeID())
+	resp, err := json.Marshal(s.segmentLocks.Inspect())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}
+}
+
 // getQuotaMetrics returns DataCoordQuotaMetrics.
 func (s *Server) getQuotaMetrics() *metricsinfo.DataCoordQuotaMetrics {
 	return &metricsinfo.DataCoordQuotaMetrics{
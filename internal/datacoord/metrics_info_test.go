@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -112,3 +113,18 @@ func TestGetDataNodeMetrics(t *testing.T) {
 	assert.True(t, info.HasError)
 
 }
+
+func TestGetSegmentLocksMetrics(t *testing.T) {
+	svr := &Server{segmentLocks: newSegmentLockManager()}
+	svr.segmentLocks.Acquire(segmentLockOwner{NodeID: 1, TaskID: 100}, 1000, 1001)
+
+	resp := svr.getSegmentLocksMetrics()
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	var leases []*segmentLease
+	err := json.Unmarshal([]byte(resp.GetResponse()), &leases)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(leases))
+	assert.Equal(t, segmentLockOwner{NodeID: 1, TaskID: 100}, leases[0].Owner)
+	assert.ElementsMatch(t, []UniqueID{1000, 1001}, leases[0].SegmentIDs)
+}
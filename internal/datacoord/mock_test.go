@@ -266,6 +266,19 @@ func (c *mockDataNodeClient) AddImportSegment(ctx context.Context, req *datapb.A
 	return c.addImportSegmentResp, nil
 }
 
+func (c *mockDataNodeClient) Decommission(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	state := datapb.DecommissionState_DecommissionNone
+	if req.GetStart() {
+		state = datapb.DecommissionState_DecommissionDraining
+	}
+	return &datapb.DecommissionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		State: state,
+	}, nil
+}
+
 func (c *mockDataNodeClient) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
@@ -672,6 +685,26 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64) (Uniq
 	panic("not implemented")
 }
 
+// forceTriggerSegmentsCompaction force to compact the given segments into one
+func (t *mockCompactionTrigger) forceTriggerSegmentsCompaction(segmentIDs []int64) (UniqueID, error) {
+	if f, ok := t.methods["forceTriggerSegmentsCompaction"]; ok {
+		if ff, ok := f.(func(segmentIDs []int64) (UniqueID, error)); ok {
+			return ff(segmentIDs)
+		}
+	}
+	panic("not implemented")
+}
+
+// triggerCollectionCompaction triggers a compaction of the collection
+func (t *mockCompactionTrigger) triggerCollectionCompaction(collectionID int64) (UniqueID, error) {
+	if f, ok := t.methods["triggerCollectionCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64) (UniqueID, error)); ok {
+			return ff(collectionID)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -744,6 +777,46 @@ func (m *mockRootCoordService) ListPolicy(ctx context.Context, in *internalpb.Li
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordService) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	return &proxypb.ListRoleQuotasResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockRootCoordService) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockRootCoordService) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockRootCoordService) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return &proxypb.ListDatabaseQuotasResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockRootCoordService) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockRootCoordService) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockRootCoordService) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	return &proxypb.ListReadOnlyModesResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockRootCoordService) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockRootCoordService) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockRootCoordService) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	return &proxypb.GetDdlOperationStateResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
 type mockHandler struct {
 	meta *meta
 }
@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// recoveryInfoCache caches the binlogs of the flushed segments answered by GetRecoveryInfo per partition, so that
+// the binlog lists of the unchanged segments are not assembled from the meta again for every call. The segment meta
+// is copy-on-write, a cached segment is valid as long as the meta of the segment is the object it is built from, any
+// change of the segment meta invalidates it.
+// Each cached segment has the version it is built at, a client knowing the response of a version is answered with
+// the segments built after it only. The versions are counted by the cache of a DataCoord session, the session server
+// id is the identity of the versions, so the versions of another DataCoord, a restarted or a standby one taking over,
+// are never taken as known ones.
+type recoveryInfoCache struct {
+	mu         sync.Mutex
+	serverID   UniqueID
+	version    int64
+	partitions map[recoveryInfoKey]*partitionRecoveryInfo
+	now        func() time.Time
+}
+
+type recoveryInfoKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+}
+
+type partitionRecoveryInfo struct {
+	mu         sync.Mutex
+	segments   map[UniqueID]*cachedSegmentBinlogs
+	lastAccess time.Time
+}
+
+type cachedSegmentBinlogs struct {
+	source  *datapb.SegmentInfo
+	binlogs *datapb.SegmentBinlogs // nil if the segment is not answered
+	version int64
+}
+
+func newRecoveryInfoCache(serverID UniqueID) *recoveryInfoCache {
+	return &recoveryInfoCache{
+		serverID:   serverID,
+		partitions: make(map[recoveryInfoKey]*partitionRecoveryInfo),
+		now:        time.Now,
+	}
+}
+
+// partition returns the cache of the partition, and drops the caches of the partitions idle longer than the ttl.
+func (c *recoveryInfoCache) partition(collectionID, partitionID UniqueID) *partitionRecoveryInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	ttl := Params.DataCoordCfg.RecoveryInfoCacheIdleTTL.GetAsDuration(time.Second)
+	for key, p := range c.partitions {
+		if ttl > 0 && now.Sub(p.lastAccess) > ttl {
+			delete(c.partitions, key)
+		}
+	}
+	key := recoveryInfoKey{collectionID: collectionID, partitionID: partitionID}
+	p, ok := c.partitions[key]
+	if !ok {
+		p = &partitionRecoveryInfo{segments: make(map[UniqueID]*cachedSegmentBinlogs)}
+		c.partitions[key] = p
+	}
+	p.lastAccess = now
+	return p
+}
+
+func (c *recoveryInfoCache) nextVersion() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.version++
+	return c.version
+}
+
+func (c *recoveryInfoCache) currentVersion() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.version
+}
+
+// knows returns true if since is a version answered by this cache, serverID is the DataCoord the version is from.
+func (c *recoveryInfoCache) knows(serverID UniqueID, since int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return serverID == c.serverID && since >= 0 && since <= c.version
+}
+
+// get returns the binlogs of the segments of the partition and the version of the answer, the segments changed since
+// the last call are built again by build, build returns nil for a segment not to answer. Only the segments changed
+// after since are returned if delta is true.
+func (c *recoveryInfoCache) get(collectionID, partitionID UniqueID, segments []*SegmentInfo, since int64, delta bool,
+	build func(segment *SegmentInfo) *datapb.SegmentBinlogs) ([]*datapb.SegmentBinlogs, int64) {
+	p := c.partition(collectionID, partitionID)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cached := make(map[UniqueID]*cachedSegmentBinlogs, len(segments))
+	for _, segment := range segments {
+		entry, ok := p.segments[segment.GetID()]
+		if !ok || entry.source != segment.SegmentInfo {
+			entry = &cachedSegmentBinlogs{
+				source:  segment.SegmentInfo,
+				binlogs: build(segment),
+				version: c.nextVersion(),
+			}
+		}
+		cached[segment.GetID()] = entry
+	}
+	// the segments not flushed any more are dropped
+	p.segments = cached
+	version := c.currentVersion()
+
+	binlogs := make([]*datapb.SegmentBinlogs, 0, len(cached))
+	for _, entry := range cached {
+		if entry.binlogs == nil || (delta && entry.version <= since) {
+			continue
+		}
+		binlogs = append(binlogs, entry.binlogs)
+	}
+	return binlogs, version
+}
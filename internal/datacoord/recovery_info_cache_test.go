@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newRecoveryInfoSegment(id UniqueID, rows int64, binlogs ...string) *SegmentInfo {
+	fieldBinlogs := make([]*datapb.FieldBinlog, 0)
+	if len(binlogs) > 0 {
+		fieldBinlog := &datapb.FieldBinlog{FieldID: 100}
+		for _, path := range binlogs {
+			fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, &datapb.Binlog{EntriesNum: rows, LogPath: path})
+		}
+		fieldBinlogs = append(fieldBinlogs, fieldBinlog)
+	}
+	return NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   2,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     rows * int64(len(binlogs)),
+		Binlogs:       fieldBinlogs,
+	})
+}
+
+func TestBuildSegmentBinlogs(t *testing.T) {
+	segment := newRecoveryInfoSegment(1, 10, "a", "b")
+	sbl := buildSegmentBinlogs(segment)
+	assert.Equal(t, UniqueID(1), sbl.GetSegmentID())
+	assert.Equal(t, int64(20), sbl.GetNumOfRows())
+	assert.Equal(t, "ch1", sbl.GetInsertChannel())
+	assert.Equal(t, 2, len(sbl.GetFieldBinlogs()[0].GetBinlogs()))
+
+	// the row count is corrected by the binlogs
+	segment = segment.Clone(SetRowCount(1))
+	assert.Equal(t, int64(20), buildSegmentBinlogs(segment).GetNumOfRows())
+
+	// no binlogs
+	assert.Nil(t, buildSegmentBinlogs(newRecoveryInfoSegment(2, 10)))
+
+	// growing and importing segments are answered without binlogs
+	segment = newRecoveryInfoSegment(3, 10, "a").Clone(SetState(commonpb.SegmentState_Growing))
+	sbl = buildSegmentBinlogs(segment)
+	assert.Equal(t, UniqueID(3), sbl.GetSegmentID())
+	assert.Empty(t, sbl.GetFieldBinlogs())
+	segment = newRecoveryInfoSegment(4, 10, "a").Clone(SetIsImporting(true))
+	assert.Empty(t, buildSegmentBinlogs(segment).GetFieldBinlogs())
+}
+
+func TestRecoveryInfoCache(t *testing.T) {
+	paramtable.Init()
+	c := newRecoveryInfoCache(100)
+	built := 0
+	build := func(segment *SegmentInfo) *datapb.SegmentBinlogs {
+		built++
+		return buildSegmentBinlogs(segment)
+	}
+	ids := func(binlogs []*datapb.SegmentBinlogs) []UniqueID {
+		ret := make([]UniqueID, 0, len(binlogs))
+		for _, sbl := range binlogs {
+			ret = append(ret, sbl.GetSegmentID())
+		}
+		return ret
+	}
+
+	segments := []*SegmentInfo{
+		newRecoveryInfoSegment(1, 10, "a"),
+		newRecoveryInfoSegment(2, 10, "b"),
+		newRecoveryInfoSegment(3, 10),
+	}
+	assert.True(t, c.knows(100, 0))
+	binlogs, v1 := c.get(1, 2, segments, 0, false, build)
+	assert.ElementsMatch(t, []UniqueID{1, 2}, ids(binlogs))
+	assert.Equal(t, 3, built)
+	assert.True(t, c.knows(100, v1))
+
+	// nothing changed
+	binlogs, v2 := c.get(1, 2, segments, 0, false, build)
+	assert.ElementsMatch(t, []UniqueID{1, 2}, ids(binlogs))
+	assert.Equal(t, 3, built)
+	assert.Equal(t, v1, v2)
+	binlogs, _ = c.get(1, 2, segments, v1, true, build)
+	assert.Empty(t, binlogs)
+
+	// the meta of segment 2 is changed, segment 3 is dropped and segment 4 is flushed
+	segments = []*SegmentInfo{
+		segments[0],
+		segments[1].Clone(AddAllocation(&Allocation{})),
+		newRecoveryInfoSegment(4, 10, "d"),
+	}
+	binlogs, v3 := c.get(1, 2, segments, v1, true, build)
+	assert.ElementsMatch(t, []UniqueID{2, 4}, ids(binlogs))
+	assert.Equal(t, 5, built)
+	assert.Greater(t, v3, v1)
+	binlogs, _ = c.get(1, 2, segments, v3, false, build)
+	assert.ElementsMatch(t, []UniqueID{1, 2, 4}, ids(binlogs))
+
+	// the versions not answered by the cache
+	assert.False(t, c.knows(100, v3+1))
+	assert.False(t, c.knows(100, -1))
+	// the versions of another DataCoord
+	assert.False(t, c.knows(101, v1))
+
+	// other partitions are cached separately
+	binlogs, _ = c.get(1, 3, segments, 0, false, build)
+	assert.Equal(t, 3, len(binlogs))
+	assert.Equal(t, 8, built)
+
+	// the idle partitions are dropped
+	now := time.Now()
+	c.now = func() time.Time { return now.Add(time.Hour) }
+	c.partition(1, 3)
+	assert.Equal(t, 1, len(c.partitions))
+}
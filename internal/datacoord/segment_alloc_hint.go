@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// channelAllocHint is the allocation hint of a vchannel for the new segments of a partition.
+type channelAllocHint struct {
+	Channel string
+	NumRows int64
+	// Skew is the rows of the vchannel over the mean rows of the partition's vchannels
+	Skew float64
+	// RowLimitRatio is the ratio applied to the max rows of the new segments of the vchannel
+	RowLimitRatio float64
+}
+
+// partitionAllocHints is the row distribution of a partition over the vchannels of its collection. The proxies
+// route the rows by the hash of their primary keys, so a skewed primary key pattern piles the rows on a few
+// vchannels, whose growing segments are all served by the shard leaders of those vchannels.
+type partitionAllocHints struct {
+	CollectionID UniqueID
+	PartitionID  UniqueID
+	NumRows      int64
+	Channels     []*channelAllocHint
+	ComputeTime  time.Time
+}
+
+func (h *partitionAllocHints) rowLimitRatio(channel string) float64 {
+	for _, hint := range h.Channels {
+		if hint.Channel == channel {
+			return hint.RowLimitRatio
+		}
+	}
+	return 1
+}
+
+func (h *partitionAllocHints) hasChannel(channel string) bool {
+	for _, hint := range h.Channels {
+		if hint.Channel == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// computePartitionAllocHints computes the row distribution of the partition from the healthy segments.
+// The vchannels are the ones with any segment of the collection, and the requested one. The max rows of
+// the new segments of a vchannel are capped only if it holds more than a full segment and its skew exceeds
+// dataCoord.segment.allocHint.skewThreshold.
+func computePartitionAllocHints(m *meta, collectionID, partitionID UniqueID, channel string, maxRowNum int64) *partitionAllocHints {
+	rows := make(map[string]int64)
+	if channel != "" {
+		rows[channel] = 0
+	}
+	segments := m.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID && isSegmentHealthy(segment)
+	})
+	var total int64
+	for _, segment := range segments {
+		if segment.GetPartitionID() != partitionID {
+			if _, ok := rows[segment.GetInsertChannel()]; !ok {
+				rows[segment.GetInsertChannel()] = 0
+			}
+			continue
+		}
+		rows[segment.GetInsertChannel()] += segment.currRows
+		total += segment.currRows
+	}
+
+	hints := &partitionAllocHints{
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		NumRows:      total,
+		Channels:     make([]*channelAllocHint, 0, len(rows)),
+		ComputeTime:  time.Now(),
+	}
+	threshold := Params.DataCoordCfg.SegmentAllocHintSkewThreshold.GetAsFloat()
+	minRatio := math.Min(math.Max(Params.DataCoordCfg.SegmentAllocHintMinRowRatio.GetAsFloat(), 0), 1)
+	mean := float64(total) / float64(len(rows))
+	for ch, numRows := range rows {
+		hint := &channelAllocHint{
+			Channel:       ch,
+			NumRows:       numRows,
+			RowLimitRatio: 1,
+		}
+		if mean > 0 {
+			hint.Skew = float64(numRows) / mean
+		}
+		if hint.Skew > threshold && numRows > maxRowNum {
+			hint.RowLimitRatio = math.Max(1/hint.Skew, minRatio)
+		}
+		hints.Channels = append(hints.Channels, hint)
+	}
+	sort.Slice(hints.Channels, func(i, j int) bool { return hints.Channels[i].Channel < hints.Channels[j].Channel })
+	return hints
+}
+
+type partitionKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+}
+
+// segmentAllocHints caches the allocation hints of the partitions, the hints of a partition are recomputed
+// at most once per dataCoord.segment.allocHint.refreshInterval.
+type segmentAllocHints struct {
+	meta *meta
+
+	mu    sync.Mutex
+	cache map[partitionKey]*partitionAllocHints
+}
+
+func newSegmentAllocHints(meta *meta) *segmentAllocHints {
+	return &segmentAllocHints{
+		meta:  meta,
+		cache: make(map[partitionKey]*partitionAllocHints),
+	}
+}
+
+func (h *segmentAllocHints) get(collectionID, partitionID UniqueID, channel string, maxRowNum int64) *partitionAllocHints {
+	key := partitionKey{collectionID: collectionID, partitionID: partitionID}
+	interval := Params.DataCoordCfg.SegmentAllocHintRefreshInterval.GetAsDuration(time.Second)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	hints, ok := h.cache[key]
+	if ok && time.Since(hints.ComputeTime) < interval && hints.hasChannel(channel) {
+		return hints
+	}
+	// drop the hints of the partitions no longer allocated, e.g. dropped
+	for k, v := range h.cache {
+		if time.Since(v.ComputeTime) >= 10*interval {
+			delete(h.cache, k)
+		}
+	}
+	hints = computePartitionAllocHints(h.meta, collectionID, partitionID, channel, maxRowNum)
+	h.cache[key] = hints
+	return hints
+}
+
+// rowLimit returns the max rows of the new segments of the partition on the vchannel.
+func (h *segmentAllocHints) rowLimit(collectionID, partitionID UniqueID, channel string, maxRowNum int64) int64 {
+	if h == nil || !Params.DataCoordCfg.SegmentAllocHintEnabled.GetAsBool() {
+		return maxRowNum
+	}
+	ratio := h.get(collectionID, partitionID, channel, maxRowNum).rowLimitRatio(channel)
+	limit := int64(float64(maxRowNum) * ratio)
+	if limit < 1 {
+		limit = 1
+	}
+	return limit
+}
+
+func partitionAllocHintsToProto(hints *partitionAllocHints) *datapb.PartitionAllocHints {
+	ret := &datapb.PartitionAllocHints{
+		CollectionID: hints.CollectionID,
+		PartitionID:  hints.PartitionID,
+		NumRows:      hints.NumRows,
+		Channels:     make([]*datapb.ChannelAllocHint, 0, len(hints.Channels)),
+		ComputeTime:  hints.ComputeTime.UnixMilli(),
+	}
+	for _, hint := range hints.Channels {
+		ret.Channels = append(ret.Channels, &datapb.ChannelAllocHint{
+			Channel:       hint.Channel,
+			NumRows:       hint.NumRows,
+			Skew:          hint.Skew,
+			RowLimitRatio: hint.RowLimitRatio,
+		})
+	}
+	return ret
+}
+
+// GetSegmentAllocHints returns the allocation hints of a partition, or of all the partitions of the collection if the
+// partitionID is not set.
+func (s *Server) GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetSegmentAllocHintsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	collection := s.meta.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		return &datapb.GetSegmentAllocHintsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+				Reason:    fmt.Sprintf("collection %d not found", req.GetCollectionID()),
+			},
+		}, nil
+	}
+	partitionIDs := collection.Partitions
+	if req.GetPartitionID() != 0 {
+		partitionIDs = []UniqueID{req.GetPartitionID()}
+	}
+	ret := make([]*datapb.PartitionAllocHints, 0, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		hints, err := s.segmentManager.AllocHints(req.GetCollectionID(), partitionID)
+		if err != nil {
+			log.Warn("failed to get segment allocation hints", zap.Int64("collectionID", req.GetCollectionID()),
+				zap.Int64("partitionID", partitionID), zap.Error(err))
+			return &datapb.GetSegmentAllocHintsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+		ret = append(ret, partitionAllocHintsToProto(hints))
+	}
+	return &datapb.GetSegmentAllocHintsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Partitions: ret,
+	}, nil
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestSegmentAllocHints(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	segmentManager := newSegmentManager(meta, mockAllocator, nil, withCalUpperLimitPolicy(func(schema *schemapb.CollectionSchema) (int, error) {
+		return 1000, nil
+	}))
+
+	collID, err := mockAllocator.allocID(ctx)
+	require.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: collID, Schema: newTestSchema(), Partitions: []UniqueID{100, 200}})
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: collID, PartitionID: 100, InsertChannel: "c1", State: commonpb.SegmentState_Flushed, NumOfRows: 5000},
+		{ID: 2, CollectionID: collID, PartitionID: 100, InsertChannel: "c1", State: commonpb.SegmentState_Growing, NumOfRows: 4000},
+		{ID: 3, CollectionID: collID, PartitionID: 100, InsertChannel: "c2", State: commonpb.SegmentState_Flushed, NumOfRows: 1000},
+		{ID: 4, CollectionID: collID, PartitionID: 100, InsertChannel: "c2", State: commonpb.SegmentState_Dropped, NumOfRows: 9000},
+		// c3 has no rows of partition 100
+		{ID: 5, CollectionID: collID, PartitionID: 200, InsertChannel: "c3", State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+	}
+	for _, segment := range segments {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+
+	hints, err := segmentManager.AllocHints(collID, 100)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10000, hints.NumRows)
+	require.Equal(t, 3, len(hints.Channels))
+	assert.Equal(t, "c1", hints.Channels[0].Channel)
+	assert.EqualValues(t, 9000, hints.Channels[0].NumRows)
+	assert.InDelta(t, 2.7, hints.Channels[0].Skew, 0.01)
+	assert.InDelta(t, 1/2.7, hints.Channels[0].RowLimitRatio, 0.01)
+	assert.Equal(t, 1.0, hints.Channels[1].RowLimitRatio)
+	assert.Equal(t, "c3", hints.Channels[2].Channel)
+	assert.Equal(t, 0.0, hints.Channels[2].Skew)
+	assert.Equal(t, 1.0, hints.Channels[2].RowLimitRatio)
+
+	// disabled by default
+	assert.EqualValues(t, 1000, segmentManager.allocHints.rowLimit(collID, 100, "c1", 1000))
+
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAllocHintEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAllocHintEnabled.Key)
+	assert.EqualValues(t, 370, segmentManager.allocHints.rowLimit(collID, 100, "c1", 1000))
+	assert.EqualValues(t, 1000, segmentManager.allocHints.rowLimit(collID, 100, "c2", 1000))
+	// a vchannel without a full segment of rows isn't capped
+	assert.Equal(t, 1.0, computePartitionAllocHints(meta, collID, 100, "c1", 10000).rowLimitRatio("c1"))
+	// the new vchannel is counted in
+	assert.EqualValues(t, 1000, segmentManager.allocHints.rowLimit(collID, 100, "c4", 1000))
+
+	// bounded by the min row ratio
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAllocHintMinRowRatio.Key, "0.5")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAllocHintMinRowRatio.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAllocHintRefreshInterval.Key, "0")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAllocHintRefreshInterval.Key)
+	assert.EqualValues(t, 500, segmentManager.allocHints.rowLimit(collID, 100, "c1", 1000))
+
+	// the new segments of the skewed vchannel are capped
+	allocations, err := segmentManager.AllocSegment(ctx, collID, 100, "c1", 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(allocations))
+	assert.EqualValues(t, 500, meta.GetSegment(allocations[0].SegmentID).GetMaxRowNum())
+	allocations, err = segmentManager.AllocSegment(ctx, collID, 100, "c2", 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(allocations))
+	assert.EqualValues(t, 1000, meta.GetSegment(allocations[0].SegmentID).GetMaxRowNum())
+
+	_, err = segmentManager.AllocHints(collID+1, 100)
+	assert.Error(t, err)
+}
+
+func TestServer_GetSegmentAllocHints(t *testing.T) {
+	Params.Init()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	s := &Server{
+		meta:           meta,
+		segmentManager: newSegmentManager(meta, newMockAllocator(), nil),
+		session:        &sessionutil.Session{ServerID: 1},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	meta.AddCollection(&collectionInfo{ID: 1, Schema: newTestSchema(), Partitions: []UniqueID{100, 200}})
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 100,
+		InsertChannel: "c1", State: commonpb.SegmentState_Flushed, NumOfRows: 5000})))
+
+	ctx := context.Background()
+	resp, err := s.GetSegmentAllocHints(ctx, &datapb.GetSegmentAllocHintsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 2, len(resp.GetPartitions()))
+	assert.Equal(t, UniqueID(100), resp.GetPartitions()[0].GetPartitionID())
+	assert.EqualValues(t, 5000, resp.GetPartitions()[0].GetNumRows())
+	require.Equal(t, 1, len(resp.GetPartitions()[0].GetChannels()))
+	assert.Equal(t, "c1", resp.GetPartitions()[0].GetChannels()[0].GetChannel())
+	assert.Equal(t, 1.0, resp.GetPartitions()[0].GetChannels()[0].GetSkew())
+	assert.NotZero(t, resp.GetPartitions()[0].GetComputeTime())
+	assert.EqualValues(t, 0, resp.GetPartitions()[1].GetNumRows())
+
+	resp, err = s.GetSegmentAllocHints(ctx, &datapb.GetSegmentAllocHintsRequest{CollectionID: 1, PartitionID: 200})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(resp.GetPartitions()))
+	assert.Equal(t, UniqueID(200), resp.GetPartitions()[0].GetPartitionID())
+
+	resp, err = s.GetSegmentAllocHints(ctx, &datapb.GetSegmentAllocHintsRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists, resp.GetStatus().GetErrorCode())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.GetSegmentAllocHints(ctx, &datapb.GetSegmentAllocHintsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
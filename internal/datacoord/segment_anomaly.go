@@ -0,0 +1,300 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// the kinds of the segment anomalies of a collection
+const (
+	segmentAnomalyTiny          = "tiny_segments"
+	segmentAnomalyGiant         = "giant_segments"
+	segmentAnomalyStalledSealed = "stalled_sealing"
+)
+
+// segmentAnomalyMaxSampleIDs is the max number of segment ids listed in an anomaly.
+const segmentAnomalyMaxSampleIDs = 10
+
+// segmentAnomaly is an abnormal pattern of the segments of a collection.
+type segmentAnomaly struct {
+	Kind           string
+	NumSegments    int
+	SegmentIDs     []UniqueID // at most segmentAnomalyMaxSampleIDs of the segments, the smallest ids first
+	Recommendation string
+}
+
+// collectionSegmentReport is the segment count and size of a collection with the anomalies detected.
+type collectionSegmentReport struct {
+	CollectionID UniqueID
+	NumSegments  int
+	NumFlushed   int
+	NumRows      int64
+	BinlogSize   int64
+	Anomalies    []*segmentAnomaly
+}
+
+func (r *collectionSegmentReport) hasAnomaly(kind string) bool {
+	for _, anomaly := range r.Anomalies {
+		if anomaly.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func newSegmentAnomaly(kind string, segmentIDs []UniqueID, recommendation string) *segmentAnomaly {
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	anomaly := &segmentAnomaly{
+		Kind:           kind,
+		NumSegments:    len(segmentIDs),
+		SegmentIDs:     segmentIDs,
+		Recommendation: recommendation,
+	}
+	if len(segmentIDs) > segmentAnomalyMaxSampleIDs {
+		anomaly.SegmentIDs = segmentIDs[:segmentAnomalyMaxSampleIDs]
+	}
+	return anomaly
+}
+
+// getSealedTime returns the time the segment became sealed by its state history, zero if unknown.
+func (m *meta) getSealedTime(segmentID UniqueID) time.Time {
+	history := m.GetSegmentHistory(segmentID)
+	if history == nil {
+		return time.Time{}
+	}
+	for i := len(history.Events) - 1; i >= 0; i-- {
+		if history.Events[i].To == commonpb.SegmentState_Sealed.String() {
+			return history.Events[i].Time
+		}
+	}
+	return time.Time{}
+}
+
+// DetectSegmentAnomalies returns the segment reports of the collections sorted by collection id, a collection is abnormal if
+//  1. it has at least dataCoord.segmentAnomaly.tinyNumThreshold flushed segments with fewer rows than tinyProportion of the max rows
+//  2. any of its segments has more rows than giantProportion of the max rows
+//  3. any of its segments is sealed longer than sealingTimeout without being flushed
+func (m *meta) DetectSegmentAnomalies(now time.Time) []*collectionSegmentReport {
+	segments := m.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment)
+	})
+
+	tinyProportion := Params.DataCoordCfg.SegmentAnomalyTinyProportion.GetAsFloat()
+	giantProportion := Params.DataCoordCfg.SegmentAnomalyGiantProportion.GetAsFloat()
+	sealingTimeout := Params.DataCoordCfg.SegmentAnomalySealingTimeout.GetAsDuration(time.Second)
+
+	reports := make(map[UniqueID]*collectionSegmentReport)
+	tiny := make(map[UniqueID][]UniqueID)
+	giant := make(map[UniqueID][]UniqueID)
+	stalled := make(map[UniqueID][]UniqueID)
+	for _, segment := range segments {
+		collectionID := segment.GetCollectionID()
+		report, ok := reports[collectionID]
+		if !ok {
+			report = &collectionSegmentReport{CollectionID: collectionID, Anomalies: make([]*segmentAnomaly, 0)}
+			reports[collectionID] = report
+		}
+		report.NumSegments++
+		report.NumRows += segment.GetNumOfRows()
+		report.BinlogSize += sumLogSize(segment.GetBinlogs())
+
+		maxRows := float64(segment.GetMaxRowNum())
+		if isFlush(segment) {
+			report.NumFlushed++
+			if float64(segment.GetNumOfRows()) < maxRows*tinyProportion {
+				tiny[collectionID] = append(tiny[collectionID], segment.GetID())
+			}
+		}
+		if maxRows > 0 && float64(segment.GetNumOfRows()) > maxRows*giantProportion {
+			giant[collectionID] = append(giant[collectionID], segment.GetID())
+		}
+		if segment.GetState() == commonpb.SegmentState_Sealed && sealingTimeout > 0 {
+			if sealedAt := m.getSealedTime(segment.GetID()); !sealedAt.IsZero() && now.Sub(sealedAt) > sealingTimeout {
+				stalled[collectionID] = append(stalled[collectionID], segment.GetID())
+			}
+		}
+	}
+
+	ret := make([]*collectionSegmentReport, 0, len(reports))
+	for collectionID, report := range reports {
+		if ids := tiny[collectionID]; len(ids) >= Params.DataCoordCfg.SegmentAnomalyTinyNumThreshold.GetAsInt() && len(ids) > 0 {
+			report.Anomalies = append(report.Anomalies, newSegmentAnomaly(segmentAnomalyTiny, ids,
+				"merge the tiny segments by compaction, and check whether the collection is flushed too often or written in tiny batches"))
+		}
+		if ids := giant[collectionID]; len(ids) > 0 {
+			report.Anomalies = append(report.Anomalies, newSegmentAnomaly(segmentAnomalyGiant, ids,
+				"the segments are loaded and indexed as a whole, check the import files and the segment max size, and re-import the data in smaller files"))
+		}
+		if ids := stalled[collectionID]; len(ids) > 0 {
+			report.Anomalies = append(report.Anomalies, newSegmentAnomaly(segmentAnomalyStalledSealed, ids,
+				"check the datanodes watching the channels of the segments, the channel checkpoints and the flush errors in their logs"))
+		}
+		ret = append(ret, report)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].CollectionID < ret[j].CollectionID })
+	return ret
+}
+
+// mergeTinySegments triggers a compaction of the collection which merges its small segments.
+func (s *Server) mergeTinySegments(collectionID UniqueID) (UniqueID, error) {
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() || s.compactionTrigger == nil {
+		return -1, errors.New("compaction disabled")
+	}
+	if s.meta.collectionPauses.IsCompactionPaused(collectionID) {
+		return -1, fmt.Errorf("compaction of collection %d is paused", collectionID)
+	}
+	return s.compactionTrigger.triggerCollectionCompaction(collectionID)
+}
+
+// startSegmentAnomalyDetectLoop detects the collections with abnormal segments periodically, the small segments of
+// the collections with too many tiny segments are merged if dataCoord.segmentAnomaly.autoMergeTinySegments is enabled.
+func (s *Server) startSegmentAnomalyDetectLoop(ctx context.Context) {
+	interval := Params.DataCoordCfg.SegmentAnomalyCheckInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("segment anomaly detect loop shutdown")
+				return
+			case <-ticker.C:
+				s.detectSegmentAnomalies()
+			}
+		}
+	}()
+}
+
+func (s *Server) detectSegmentAnomalies() {
+	autoMerge := Params.DataCoordCfg.SegmentAnomalyAutoMergeTinySegments.GetAsBool()
+	for _, report := range s.meta.DetectSegmentAnomalies(time.Now()) {
+		if len(report.Anomalies) == 0 {
+			continue
+		}
+		for _, anomaly := range report.Anomalies {
+			log.Warn("abnormal segments detected", zap.Int64("collectionID", report.CollectionID),
+				zap.String("kind", anomaly.Kind), zap.Int("num", anomaly.NumSegments),
+				zap.Int64s("segmentIDs", anomaly.SegmentIDs), zap.String("recommendation", anomaly.Recommendation))
+		}
+		if autoMerge && report.hasAnomaly(segmentAnomalyTiny) {
+			id, err := s.mergeTinySegments(report.CollectionID)
+			if err != nil {
+				log.Warn("failed to merge tiny segments", zap.Int64("collectionID", report.CollectionID), zap.Error(err))
+				continue
+			}
+			log.Info("merge tiny segments triggered", zap.Int64("collectionID", report.CollectionID), zap.Int64("signalID", id))
+		}
+	}
+}
+
+// collectionSegmentReportToProto converts the report to the proto returned by GetSegmentAnomalies.
+func collectionSegmentReportToProto(report *collectionSegmentReport) *datapb.CollectionSegmentReport {
+	ret := &datapb.CollectionSegmentReport{
+		CollectionID: report.CollectionID,
+		NumSegments:  int64(report.NumSegments),
+		NumFlushed:   int64(report.NumFlushed),
+		NumRows:      report.NumRows,
+		BinlogSize:   report.BinlogSize,
+		Anomalies:    make([]*datapb.SegmentAnomaly, 0, len(report.Anomalies)),
+	}
+	for _, anomaly := range report.Anomalies {
+		ret.Anomalies = append(ret.Anomalies, &datapb.SegmentAnomaly{
+			Kind:           anomaly.Kind,
+			NumSegments:    int64(anomaly.NumSegments),
+			SegmentIDs:     anomaly.SegmentIDs,
+			Recommendation: anomaly.Recommendation,
+		})
+	}
+	return ret
+}
+
+// GetSegmentAnomalies returns the segment reports of the collections with anomalies sorted by collection id, or the
+// report of the collection if the collectionID is set, no report is returned if the collection has no segment.
+func (s *Server) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetSegmentAnomaliesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	ret := make([]*datapb.CollectionSegmentReport, 0)
+	for _, report := range s.meta.DetectSegmentAnomalies(time.Now()) {
+		if req.GetCollectionID() != 0 && report.CollectionID != req.GetCollectionID() ||
+			req.GetCollectionID() == 0 && len(report.Anomalies) == 0 {
+			continue
+		}
+		ret = append(ret, collectionSegmentReportToProto(report))
+	}
+	return &datapb.GetSegmentAnomaliesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Reports: ret,
+	}, nil
+}
+
+// MergeTinySegments triggers a compaction of the collection which merges its small segments, the compactionID of the
+// response is the id of the trigger.
+func (s *Server) MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	if s.isClosed() {
+		return &milvuspb.ManualCompactionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	id, err := s.mergeTinySegments(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to merge tiny segments", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return &milvuspb.ManualCompactionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Info("merge tiny segments triggered", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64("signalID", id))
+	return &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CompactionID: id,
+	}, nil
+}
@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func addAnomalySegment(t *testing.T, m *meta, collectionID, segmentID UniqueID, state commonpb.SegmentState, numRows int64) {
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  collectionID,
+		PartitionID:   10,
+		InsertChannel: "ch",
+		State:         state,
+		NumOfRows:     numRows,
+		MaxRowNum:     1000,
+		Binlogs:       []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: numRows}}}},
+	})
+	require.NoError(t, m.AddSegment(segment))
+}
+
+func TestMeta_DetectSegmentAnomalies(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAnomalyTinyNumThreshold.Key, "3")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAnomalyTinyNumThreshold.Key)
+
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	now := time.Now()
+	// collection 1 has 3 tiny segments and a giant one, the growing segment is never tiny
+	addAnomalySegment(t, m, 1, 101, commonpb.SegmentState_Flushed, 10)
+	addAnomalySegment(t, m, 1, 102, commonpb.SegmentState_Flushed, 20)
+	addAnomalySegment(t, m, 1, 103, commonpb.SegmentState_Flushing, 30)
+	addAnomalySegment(t, m, 1, 104, commonpb.SegmentState_Flushed, 3000)
+	addAnomalySegment(t, m, 1, 105, commonpb.SegmentState_Growing, 1)
+	addAnomalySegment(t, m, 1, 106, commonpb.SegmentState_Dropped, 1)
+	// collection 2 has a segment sealed 2 hours ago and another one just sealed
+	m.segmentHistory.now = func() time.Time { return now.Add(-2 * time.Hour) }
+	addAnomalySegment(t, m, 2, 201, commonpb.SegmentState_Sealed, 500)
+	m.segmentHistory.now = time.Now
+	addAnomalySegment(t, m, 2, 202, commonpb.SegmentState_Sealed, 500)
+	// collection 3 is healthy
+	addAnomalySegment(t, m, 3, 301, commonpb.SegmentState_Flushed, 10)
+	addAnomalySegment(t, m, 3, 302, commonpb.SegmentState_Flushed, 900)
+
+	reports := m.DetectSegmentAnomalies(now)
+	require.Equal(t, 3, len(reports))
+
+	report := reports[0]
+	assert.Equal(t, UniqueID(1), report.CollectionID)
+	assert.Equal(t, 5, report.NumSegments)
+	assert.Equal(t, 4, report.NumFlushed)
+	assert.Equal(t, int64(3061), report.NumRows)
+	assert.Equal(t, int64(3061), report.BinlogSize)
+	require.Equal(t, 2, len(report.Anomalies))
+	assert.Equal(t, segmentAnomalyTiny, report.Anomalies[0].Kind)
+	assert.Equal(t, []UniqueID{101, 102, 103}, report.Anomalies[0].SegmentIDs)
+	assert.Equal(t, segmentAnomalyGiant, report.Anomalies[1].Kind)
+	assert.Equal(t, []UniqueID{104}, report.Anomalies[1].SegmentIDs)
+	assert.True(t, report.hasAnomaly(segmentAnomalyTiny))
+
+	report = reports[1]
+	require.Equal(t, 1, len(report.Anomalies))
+	assert.Equal(t, segmentAnomalyStalledSealed, report.Anomalies[0].Kind)
+	assert.Equal(t, []UniqueID{201}, report.Anomalies[0].SegmentIDs)
+	assert.False(t, report.hasAnomaly(segmentAnomalyTiny))
+
+	assert.Equal(t, 0, len(reports[2].Anomalies))
+
+	t.Run("sample ids", func(t *testing.T) {
+		ids := make([]UniqueID, 0)
+		for i := 20; i > 0; i-- {
+			ids = append(ids, UniqueID(i))
+		}
+		anomaly := newSegmentAnomaly(segmentAnomalyTiny, ids, "")
+		assert.Equal(t, 20, anomaly.NumSegments)
+		assert.Equal(t, segmentAnomalyMaxSampleIDs, len(anomaly.SegmentIDs))
+		assert.Equal(t, UniqueID(1), anomaly.SegmentIDs[0])
+	})
+}
+
+func TestServer_SegmentAnomalies(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAnomalyTinyNumThreshold.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAnomalyTinyNumThreshold.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAnomalyAutoMergeTinySegments.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAnomalyAutoMergeTinySegments.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.EnableCompaction.Key)
+
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	addAnomalySegment(t, m, 1, 101, commonpb.SegmentState_Flushed, 10)
+	addAnomalySegment(t, m, 1, 102, commonpb.SegmentState_Flushed, 20)
+	addAnomalySegment(t, m, 2, 201, commonpb.SegmentState_Flushed, 900)
+
+	var merged []UniqueID
+	s := &Server{
+		meta:    m,
+		session: &sessionutil.Session{ServerID: 1},
+		compactionTrigger: &mockCompactionTrigger{methods: map[string]interface{}{
+			"triggerCollectionCompaction": func(collectionID int64) (UniqueID, error) {
+				merged = append(merged, collectionID)
+				return 1000, nil
+			},
+		}},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	ctx := context.Background()
+	resp, err := s.GetSegmentAnomalies(ctx, &datapb.GetSegmentAnomaliesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(resp.GetReports()))
+	assert.Equal(t, UniqueID(1), resp.GetReports()[0].GetCollectionID())
+	require.Equal(t, 1, len(resp.GetReports()[0].GetAnomalies()))
+	assert.Equal(t, segmentAnomalyTiny, resp.GetReports()[0].GetAnomalies()[0].GetKind())
+	assert.Equal(t, []int64{101, 102}, resp.GetReports()[0].GetAnomalies()[0].GetSegmentIDs())
+
+	resp, err = s.GetSegmentAnomalies(ctx, &datapb.GetSegmentAnomaliesRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	require.Equal(t, 1, len(resp.GetReports()))
+	assert.Equal(t, UniqueID(2), resp.GetReports()[0].GetCollectionID())
+	assert.Equal(t, int64(900), resp.GetReports()[0].GetNumRows())
+	assert.Equal(t, 0, len(resp.GetReports()[0].GetAnomalies()))
+	resp, err = s.GetSegmentAnomalies(ctx, &datapb.GetSegmentAnomaliesRequest{CollectionID: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 0, len(resp.GetReports()))
+
+	// the detection merges the tiny segments of the abnormal collections only
+	s.detectSegmentAnomalies()
+	assert.Equal(t, []UniqueID{1}, merged)
+
+	mergeResp, err := s.MergeTinySegments(ctx, &datapb.MergeTinySegmentsRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, mergeResp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(1000), mergeResp.GetCompactionID())
+	assert.Equal(t, []UniqueID{1, 2}, merged)
+	_, err = m.collectionPauses.Pause(2, true, false, "")
+	require.NoError(t, err)
+	mergeResp, err = s.MergeTinySegments(ctx, &datapb.MergeTinySegmentsRequest{CollectionID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, mergeResp.GetStatus().GetErrorCode())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.GetSegmentAnomalies(ctx, &datapb.GetSegmentAnomaliesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	mergeResp, err = s.MergeTinySegments(ctx, &datapb.MergeTinySegmentsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, mergeResp.GetStatus().GetErrorCode())
+}
+
+func TestCompactionTrigger_triggerCollectionCompaction(t *testing.T) {
+	trigger := &compactionTrigger{
+		allocator: newMockAllocator(),
+		signals:   make(chan *compactionSignal, 1),
+	}
+	id, err := trigger.triggerCollectionCompaction(1)
+	require.NoError(t, err)
+	signal := <-trigger.signals
+	assert.Equal(t, id, signal.id)
+	assert.Equal(t, UniqueID(1), signal.collectionID)
+	assert.True(t, signal.isGlobal)
+	assert.False(t, signal.isForce)
+}
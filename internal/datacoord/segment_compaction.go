@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// CompactSegments triggers a compaction of the given segments of the collection only, e.g. to purge the deletes of a
+// delete-heavy segment. It's checked like ManualCompaction, and the returned compaction id works with
+// GetCompactionState and GetCompactionStateWithPlans.
+func (s *Server) CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	log.Info("received segments compaction")
+
+	resp := &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+	if err := s.checkManualCompaction(req.GetCollectionID()); err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	for _, segmentID := range req.GetSegmentIDs() {
+		if segment := s.meta.GetSegment(segmentID); segment != nil && segment.GetCollectionID() != req.GetCollectionID() {
+			resp.Status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+			resp.Status.Reason = fmt.Sprintf("segment %d doesn't belong to collection %d", segmentID, req.GetCollectionID())
+			return resp, nil
+		}
+	}
+
+	id, err := s.compactionTrigger.forceTriggerSegmentsCompaction(req.GetSegmentIDs())
+	if err != nil {
+		log.Warn("failed to trigger segments compaction", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Info("success to trigger segments compaction", zap.Int64("compactionID", id))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.CompactionID = id
+	return resp, nil
+}
@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func Test_compactionTrigger_forceTriggerSegmentsCompaction(t *testing.T) {
+	Params.Init()
+	genSeg := func(segID, partitionID int64, channel string, state commonpb.SegmentState) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:             segID,
+				CollectionID:   2,
+				PartitionID:    partitionID,
+				LastExpireTime: 100,
+				NumOfRows:      50,
+				MaxRowNum:      300,
+				InsertChannel:  channel,
+				State:          state,
+				Binlogs: []*datapb.FieldBinlog{
+					{Binlogs: []*datapb.Binlog{{EntriesNum: 5, LogPath: "log1", LogSize: 100}}},
+				},
+			},
+			lastFlushTime: time.Now(),
+		}
+	}
+	m := &meta{
+		segments: &SegmentsInfo{
+			map[int64]*SegmentInfo{
+				1: genSeg(1, 1, "ch1", commonpb.SegmentState_Flushed),
+				2: genSeg(2, 1, "ch1", commonpb.SegmentState_Flushed),
+				3: genSeg(3, 1, "ch2", commonpb.SegmentState_Flushed),
+				4: genSeg(4, 2, "ch1", commonpb.SegmentState_Flushed),
+				5: genSeg(5, 1, "ch1", commonpb.SegmentState_Growing),
+				6: genSeg(6, 1, "ch1", commonpb.SegmentState_Dropped),
+			},
+		},
+		collections: map[int64]*collectionInfo{
+			2: {
+				ID: 2,
+				Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{{FieldID: 201, DataType: schemapb.DataType_FloatVector}},
+				},
+			},
+		},
+	}
+	spy := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 1)}
+	tr := &compactionTrigger{
+		meta:                         m,
+		handler:                      newMockHandlerWithMeta(m),
+		allocator:                    newMockAllocator(),
+		compactionHandler:            spy,
+		estimateDiskSegmentPolicy:    calBySchemaPolicyWithDiskIndex,
+		estimateNonDiskSegmentPolicy: calBySchemaPolicy,
+		freezeManager:                newFreezeManager(),
+		testingOnly:                  true,
+	}
+
+	invalids := [][]int64{
+		{},
+		{1, 1},
+		{1, 3},
+		{1, 4},
+		{1, 5},
+		{1, 6},
+		{1, 100},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31},
+	}
+	for _, segmentIDs := range invalids {
+		_, err := tr.forceTriggerSegmentsCompaction(segmentIDs)
+		assert.Error(t, err, segmentIDs)
+	}
+
+	id, err := tr.forceTriggerSegmentsCompaction([]int64{2, 1})
+	require.NoError(t, err)
+	assert.NotEqual(t, int64(-1), id)
+	select {
+	case plan := <-spy.spyChan:
+		assert.ElementsMatch(t, []int64{1, 2}, fetchSegIDs(plan.GetSegmentBinlogs()))
+		assert.Equal(t, "ch1", plan.GetChannel())
+		assert.Equal(t, int64(100), plan.GetTotalRows())
+	default:
+		assert.Fail(t, "no compaction plan is executed")
+	}
+
+	_, err = tr.freezeManager.Freeze(2, time.Minute, nil)
+	require.NoError(t, err)
+	_, err = tr.forceTriggerSegmentsCompaction([]int64{1})
+	assert.Error(t, err)
+}
+
+func TestServer_CompactSegments(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	meta, err := newMeta(ctx, memkv.NewMemoryKV(), "", nil)
+	require.NoError(t, err)
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 10})))
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 2, CollectionID: 10})))
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 3, CollectionID: 20})))
+	trigger := &mockCompactionTrigger{methods: map[string]interface{}{
+		"forceTriggerSegmentsCompaction": func(segmentIDs []int64) (UniqueID, error) {
+			if len(segmentIDs) == 0 {
+				return -1, errors.New("no segment to compact")
+			}
+			return 100, nil
+		},
+	}}
+	s := &Server{
+		meta:              meta,
+		compactionTrigger: trigger,
+		session:           &sessionutil.Session{ServerID: 1},
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := s.CompactSegments(ctx, &datapb.CompactSegmentsRequest{CollectionID: 10, SegmentIDs: []int64{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(100), resp.GetCompactionID())
+
+	resp, err = s.CompactSegments(ctx, &datapb.CompactSegmentsRequest{CollectionID: 10})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	// the segments of other collections are rejected
+	resp, err = s.CompactSegments(ctx, &datapb.CompactSegmentsRequest{CollectionID: 10, SegmentIDs: []int64{1, 3}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	// checked like ManualCompaction
+	_, err = s.meta.collectionPauses.Pause(10, true, false, "test")
+	require.NoError(t, err)
+	resp, err = s.CompactSegments(ctx, &datapb.CompactSegmentsRequest{CollectionID: 10, SegmentIDs: []int64{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	assert.Contains(t, resp.GetStatus().GetReason(), "paused")
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.CompactSegments(ctx, &datapb.CompactSegmentsRequest{CollectionID: 10, SegmentIDs: []int64{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
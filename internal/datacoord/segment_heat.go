@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// segmentHeatPruneInterval is the interval to drop the heats of the segments gone or cooled down
+	segmentHeatPruneInterval = 10 * time.Minute
+
+	// minSegmentHeat is the heat under which a segment is taken as cold
+	minSegmentHeat = 0.01
+
+	// maxSegmentHeatInheritDepth is the max number of the compactions a segment inherits the heats through
+	maxSegmentHeatInheritDepth = 8
+)
+
+// decayHeat returns the heat decayed by half every halfLife over elapsed, a non-positive halfLife doesn't decay.
+func decayHeat(heat float64, elapsed time.Duration, halfLife time.Duration) float64 {
+	if halfLife <= 0 || elapsed <= 0 {
+		return heat
+	}
+	return heat * math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+}
+
+// nodeSegmentHeat is the heat of a segment last reported by a QueryNode.
+type nodeSegmentHeat struct {
+	heat       float64
+	reportTime time.Time
+}
+
+// segmentHeatTracker keeps the query heats of the segments reported by the QueryNodes. The heat of a segment is the
+// sum of the heats reported by the QueryNodes serving it, each keeps decaying by half every dataCoord.segmentHeat.halfLife
+// since reported, so the heats of the released segments fade out slowly instead of being lost.
+type segmentHeatTracker struct {
+	mu       sync.RWMutex
+	segments map[UniqueID]map[UniqueID]nodeSegmentHeat // segment id -> node id -> heat
+}
+
+func newSegmentHeatTracker() *segmentHeatTracker {
+	return &segmentHeatTracker{
+		segments: make(map[UniqueID]map[UniqueID]nodeSegmentHeat),
+	}
+}
+
+// report records the heats of the segments served by the QueryNode, they replace the ones the node reported before.
+func (t *segmentHeatTracker) report(nodeID UniqueID, heats []*datapb.SegmentHeat, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, heat := range heats {
+		if heat.GetHeat() <= 0 || math.IsNaN(heat.GetHeat()) || math.IsInf(heat.GetHeat(), 0) {
+			continue
+		}
+		nodes, ok := t.segments[heat.GetSegmentID()]
+		if !ok {
+			nodes = make(map[UniqueID]nodeSegmentHeat)
+			t.segments[heat.GetSegmentID()] = nodes
+		}
+		nodes[nodeID] = nodeSegmentHeat{heat: heat.GetHeat(), reportTime: now}
+	}
+}
+
+// get returns the heat of the segment at now, 0 if it's never reported.
+func (t *segmentHeatTracker) get(segmentID UniqueID, now time.Time, halfLife time.Duration) float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	heat := 0.0
+	for _, nodeHeat := range t.segments[segmentID] {
+		heat += decayHeat(nodeHeat.heat, now.Sub(nodeHeat.reportTime), halfLife)
+	}
+	return heat
+}
+
+// prune drops the heats of the segments not in the meta anymore, and the reports cooled down under minSegmentHeat.
+func (t *segmentHeatTracker) prune(exist func(segmentID UniqueID) bool, now time.Time, halfLife time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for segmentID, nodes := range t.segments {
+		if !exist(segmentID) {
+			delete(t.segments, segmentID)
+			continue
+		}
+		for nodeID, nodeHeat := range nodes {
+			if decayHeat(nodeHeat.heat, now.Sub(nodeHeat.reportTime), halfLife) < minSegmentHeat {
+				delete(nodes, nodeID)
+			}
+		}
+		if len(nodes) == 0 {
+			delete(t.segments, segmentID)
+		}
+	}
+}
+
+// segmentHeat returns the heat of the segment, with the heats of the segments it's compacted from,
+// so the segments don't turn cold by compaction.
+func (s *Server) segmentHeat(segment *SegmentInfo, now time.Time, halfLife time.Duration, depth int) float64 {
+	heat := s.segmentHeats.get(segment.GetID(), now, halfLife)
+	if depth >= maxSegmentHeatInheritDepth {
+		return heat
+	}
+	for _, fromID := range segment.GetCompactionFrom() {
+		if from := s.meta.GetSegmentUnsafe(fromID); from != nil && !isSegmentHealthy(from) {
+			heat += s.segmentHeat(from, now, halfLife, depth+1)
+		}
+	}
+	return heat
+}
+
+// listSegmentHeats returns the heats of the healthy segments of the collection, of all the collections if
+// collectionID is 0, from the hottest. The cold segments are left out.
+func (s *Server) listSegmentHeats(collectionID UniqueID) []metricsinfo.SegmentHeat {
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return (collectionID == 0 || segment.GetCollectionID() == collectionID) && isSegmentHealthy(segment)
+	})
+	now := time.Now()
+	halfLife := Params.DataCoordCfg.SegmentHeatHalfLife.GetAsDuration(time.Second)
+	heats := make([]metricsinfo.SegmentHeat, 0)
+	for _, segment := range segments {
+		heat := s.segmentHeat(segment, now, halfLife, 0)
+		if heat < minSegmentHeat {
+			continue
+		}
+		heats = append(heats, metricsinfo.SegmentHeat{
+			SegmentID:    segment.GetID(),
+			CollectionID: segment.GetCollectionID(),
+			Heat:         heat,
+		})
+	}
+	sort.Slice(heats, func(i, j int) bool {
+		if heats[i].Heat != heats[j].Heat {
+			return heats[i].Heat > heats[j].Heat
+		}
+		return heats[i].SegmentID < heats[j].SegmentID
+	})
+	return heats
+}
+
+// startSegmentHeatPruneLoop drops the heats of the segments garbage collected or cooled down periodically.
+func (s *Server) startSegmentHeatPruneLoop(ctx context.Context) {
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(segmentHeatPruneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("segment heat prune loop shutdown")
+				return
+			case <-ticker.C:
+				s.segmentHeats.prune(func(segmentID UniqueID) bool {
+					return s.meta.GetSegmentUnsafe(segmentID) != nil
+				}, time.Now(), Params.DataCoordCfg.SegmentHeatHalfLife.GetAsDuration(time.Second))
+			}
+		}
+	}()
+}
+
+// getSegmentHeatMetrics returns the heats of the segments of the collection given by the request.
+func (s *Server) getSegmentHeatMetrics(req *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.DataCoordRole, paramtable.GetNodeID())
+	failed := func(err error) *milvuspb.GetMetricsResponse {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}
+	}
+
+	var heatReq metricsinfo.SegmentHeatRequest
+	if err := json.Unmarshal([]byte(req.GetRequest()), &heatReq); err != nil {
+		return failed(fmt.Errorf("invalid segment heat request: %w", err))
+	}
+	resp, err := json.Marshal(s.listSegmentHeats(heatReq.CollectionID))
+	if err != nil {
+		return failed(err)
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}
+}
+
+// ReportSegmentHeats records the heats of the sealed segments reported by a QueryNode.
+func (s *Server) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	if s.isClosed() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	nodeID := req.GetBase().GetSourceID()
+	s.segmentHeats.report(nodeID, req.GetSegments(), time.Now())
+	log.RatedDebug(60, "segment heats reported", zap.Int64("nodeID", nodeID), zap.Int("segmentNum", len(req.GetSegments())))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// GetSegmentHeats returns the query heats of the healthy segments of the collection, of all the collections if the
+// collectionID is 0, from the hottest. The cold segments are left out.
+func (s *Server) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetSegmentHeatsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	heats := s.listSegmentHeats(req.GetCollectionID())
+	segments := make([]*datapb.SegmentHeat, 0, len(heats))
+	for _, heat := range heats {
+		segments = append(segments, &datapb.SegmentHeat{
+			SegmentID:    heat.SegmentID,
+			CollectionID: heat.CollectionID,
+			Heat:         heat.Heat,
+		})
+	}
+	return &datapb.GetSegmentHeatsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Segments: segments,
+	}, nil
+}
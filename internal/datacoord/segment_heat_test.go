@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestDecayHeat(t *testing.T) {
+	assert.Equal(t, 8.0, decayHeat(8, 0, time.Minute))
+	assert.Equal(t, 8.0, decayHeat(8, time.Hour, 0))
+	assert.InDelta(t, 4.0, decayHeat(8, time.Minute, time.Minute), 1e-9)
+	assert.InDelta(t, 2.0, decayHeat(8, 2*time.Minute, time.Minute), 1e-9)
+}
+
+func TestSegmentHeatTracker(t *testing.T) {
+	tracker := newSegmentHeatTracker()
+	now := time.Now()
+	tracker.report(1, []*datapb.SegmentHeat{{SegmentID: 100, Heat: 4}, {SegmentID: 101, Heat: 0}}, now)
+	tracker.report(2, []*datapb.SegmentHeat{{SegmentID: 100, Heat: 2}}, now)
+	assert.InDelta(t, 6.0, tracker.get(100, now, time.Minute), 1e-9)
+	assert.Equal(t, 0.0, tracker.get(101, now, time.Minute))
+
+	// a new report of the node replaces the old one
+	tracker.report(1, []*datapb.SegmentHeat{{SegmentID: 100, Heat: 1}}, now)
+	assert.InDelta(t, 3.0, tracker.get(100, now, time.Minute), 1e-9)
+	assert.InDelta(t, 1.5, tracker.get(100, now.Add(time.Minute), time.Minute), 1e-9)
+
+	tracker.report(1, []*datapb.SegmentHeat{{SegmentID: 102, Heat: 1}}, now)
+	tracker.prune(func(segmentID UniqueID) bool { return segmentID != 102 }, now, time.Minute)
+	assert.Equal(t, 0.0, tracker.get(102, now, time.Minute))
+	assert.InDelta(t, 3.0, tracker.get(100, now, time.Minute), 1e-9)
+	// cooled down
+	tracker.prune(func(segmentID UniqueID) bool { return true }, now.Add(time.Hour), time.Minute)
+	assert.Empty(t, tracker.segments)
+}
+
+func TestServer_listSegmentHeats(t *testing.T) {
+	Params.Init()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	for _, segment := range []*SegmentInfo{
+		buildSegment(1, 10, 100, "ch", false),
+		buildSegment(1, 10, 101, "ch", false),
+		buildSegment(1, 10, 102, "ch", false),
+		buildSegment(1, 10, 103, "ch", false),
+		buildSegment(2, 20, 200, "ch", false),
+	} {
+		switch segment.GetID() {
+		case 101, 102:
+			segment.State = commonpb.SegmentState_Dropped
+		case 103:
+			segment.CompactionFrom = []int64{101, 102}
+		}
+		require.NoError(t, meta.AddSegment(segment))
+	}
+	s := &Server{meta: meta, segmentHeats: newSegmentHeatTracker()}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	now := time.Now()
+	s.segmentHeats.report(1, []*datapb.SegmentHeat{
+		{SegmentID: 100, CollectionID: 1, Heat: 3},
+		{SegmentID: 101, CollectionID: 1, Heat: 2},
+		{SegmentID: 102, CollectionID: 1, Heat: 2},
+		{SegmentID: 200, CollectionID: 2, Heat: 1},
+	}, now)
+
+	// the compacted segment inherits the heats of its sources
+	heats := s.listSegmentHeats(1)
+	assert.Equal(t, 2, len(heats))
+	assert.Equal(t, int64(103), heats[0].SegmentID)
+	assert.InDelta(t, 4.0, heats[0].Heat, 1e-6)
+	assert.Equal(t, int64(100), heats[1].SegmentID)
+	assert.Equal(t, 3, len(s.listSegmentHeats(0)))
+
+	status, err := s.ReportSegmentHeats(context.TODO(), &datapb.ReportSegmentHeatsRequest{
+		Base:     commonpbutil.NewMsgBase(commonpbutil.WithSourceID(2)),
+		Segments: []*datapb.SegmentHeat{{SegmentID: 200, CollectionID: 2, Heat: 5}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	body, err := json.Marshal(&metricsinfo.SegmentHeatRequest{MetricType: metricsinfo.SegmentHeatMetrics, CollectionID: 2})
+	require.NoError(t, err)
+	resp := s.getSegmentHeatMetrics(&milvuspb.GetMetricsRequest{Request: string(body)})
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	heats = make([]metricsinfo.SegmentHeat, 0)
+	require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &heats))
+	assert.Equal(t, 1, len(heats))
+	assert.InDelta(t, 6.0, heats[0].Heat, 1e-6)
+
+	heatsResp, err := s.GetSegmentHeats(context.TODO(), &datapb.GetSegmentHeatsRequest{CollectionID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, heatsResp.GetStatus().GetErrorCode())
+	require.Equal(t, 2, len(heatsResp.GetSegments()))
+	assert.Equal(t, int64(103), heatsResp.GetSegments()[0].GetSegmentID())
+	assert.InDelta(t, 4.0, heatsResp.GetSegments()[0].GetHeat(), 1e-6)
+	heatsResp, err = s.GetSegmentHeats(context.TODO(), &datapb.GetSegmentHeatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(heatsResp.GetSegments()))
+	assert.Equal(t, int64(200), heatsResp.GetSegments()[0].GetSegmentID())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	heatsResp, err = s.GetSegmentHeats(context.TODO(), &datapb.GetSegmentHeatsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, heatsResp.GetStatus().GetErrorCode())
+	status, err = s.ReportSegmentHeats(context.TODO(), &datapb.ReportSegmentHeatsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
@@ -0,0 +1,283 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// segmentHistoryFlushInterval is the interval to persist the segment state histories changed since the last flush
+const segmentHistoryFlushInterval = time.Second
+
+// the causes of the segment state transitions
+const (
+	segmentCauseAllocation       = "allocation"
+	segmentCauseImport           = "import"
+	segmentCauseFlushRPC         = "flush rpc"
+	segmentCauseSealPolicy       = "seal policy"
+	segmentCauseEmptySealed      = "empty sealed segment"
+	segmentCauseDataNodeFlush    = "datanode flush"
+	segmentCauseDataNodeDrop     = "datanode drop"
+	segmentCauseFlushDone        = "flush done"
+	segmentCauseChannelDrop      = "channel drop"
+	segmentCauseCompaction       = "compaction"
+	segmentCauseCompactionRevert = "compaction revert"
+	segmentCauseSetSegmentState  = "set segment state rpc"
+	segmentCauseMarkSegmentsDrop = "mark segments dropped rpc"
+)
+
+// segmentHistoryRecorder keeps the state transitions of the segments, at most dataCoord.segment.historyMaxEvents
+// transitions are kept per segment. The transitions are recorded in memory under the meta lock, and the histories
+// changed are persisted through the catalog in batches by flush, off the meta lock. The history is removed with the
+// segment meta, when the dropped segment is collected.
+// The history is best effort, a failure to persist it never fails the segment meta update.
+type segmentHistoryRecorder struct {
+	catalog metastore.DataCoordCatalog
+	now     func() time.Time
+
+	mu        sync.RWMutex
+	histories map[UniqueID]*model.SegmentHistory
+	dirty     typeutil.UniqueSet // the segments whose histories are to be saved
+	removed   typeutil.UniqueSet // the segments whose histories are to be dropped
+
+	flushMu sync.Mutex // serializes the flushes, so an older snapshot never overwrites a newer one
+}
+
+func newSegmentHistoryRecorder(catalog metastore.DataCoordCatalog) *segmentHistoryRecorder {
+	return &segmentHistoryRecorder{
+		catalog:   catalog,
+		now:       time.Now,
+		histories: make(map[UniqueID]*model.SegmentHistory),
+		dirty:     typeutil.NewUniqueSet(),
+		removed:   typeutil.NewUniqueSet(),
+	}
+}
+
+// load reloads the histories from the catalog.
+func (r *segmentHistoryRecorder) load() error {
+	histories, err := r.catalog.ListSegmentHistories(context.TODO())
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, history := range histories {
+		r.histories[history.SegmentID] = history
+	}
+	return nil
+}
+
+// record appends the transition of the segment from the state from to its current state, nothing is recorded
+// if the state doesn't change.
+func (r *segmentHistoryRecorder) record(segment *SegmentInfo, from commonpb.SegmentState, cause string) {
+	if r == nil || segment.GetState() == from {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	history, ok := r.histories[segment.GetID()]
+	if !ok {
+		history = &model.SegmentHistory{
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			SegmentID:    segment.GetID(),
+			Channel:      segment.GetInsertChannel(),
+		}
+		r.histories[segment.GetID()] = history
+	}
+	history.Events = append(history.Events, &model.SegmentStateEvent{
+		From:  from.String(),
+		To:    segment.GetState().String(),
+		Cause: cause,
+		Time:  r.now(),
+	})
+	if maxEvents := Params.DataCoordCfg.SegmentHistoryMaxEvents.GetAsInt(); maxEvents > 0 && len(history.Events) > maxEvents {
+		history.Events = history.Events[len(history.Events)-maxEvents:]
+	}
+	r.dirty.Insert(segment.GetID())
+	r.removed.Remove(segment.GetID())
+}
+
+// remove removes the history of the segment.
+func (r *segmentHistoryRecorder) remove(segmentID UniqueID) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.histories[segmentID]; !ok {
+		return
+	}
+	delete(r.histories, segmentID)
+	r.dirty.Remove(segmentID)
+	r.removed.Insert(segmentID)
+}
+
+// flush persists the histories changed since the last flush, the ones failed to persist are retried by the next flush.
+func (r *segmentHistoryRecorder) flush(ctx context.Context) {
+	if r == nil {
+		return
+	}
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	saves := make([]*model.SegmentHistory, 0, len(r.dirty))
+	for segmentID := range r.dirty {
+		saves = append(saves, r.histories[segmentID].Clone())
+	}
+	drops := r.removed.Collect()
+	r.dirty = typeutil.NewUniqueSet()
+	r.removed = typeutil.NewUniqueSet()
+	r.mu.Unlock()
+
+	if len(saves) > 0 {
+		if err := r.catalog.SaveSegmentHistories(ctx, saves); err != nil {
+			log.Warn("DataCoord failed to save segment histories", zap.Int("count", len(saves)), zap.Error(err))
+			r.mu.Lock()
+			for _, history := range saves {
+				if _, ok := r.histories[history.SegmentID]; ok {
+					r.dirty.Insert(history.SegmentID)
+				}
+			}
+			r.mu.Unlock()
+		}
+	}
+	if len(drops) > 0 {
+		if err := r.catalog.DropSegmentHistories(ctx, drops); err != nil {
+			log.Warn("DataCoord failed to drop segment histories", zap.Int64s("segment IDs", drops), zap.Error(err))
+			r.mu.Lock()
+			for _, segmentID := range drops {
+				if _, ok := r.histories[segmentID]; !ok {
+					r.removed.Insert(segmentID)
+				}
+			}
+			r.mu.Unlock()
+		}
+	}
+}
+
+// get returns a copy of the history of the segment, nil if there is none.
+func (r *segmentHistoryRecorder) get(segmentID UniqueID) *model.SegmentHistory {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	history, ok := r.histories[segmentID]
+	if !ok {
+		return nil
+	}
+	return history.Clone()
+}
+
+// recordSegmentStates records the state transitions of the segments about to be set in memory,
+// it must be called with the meta lock held and before the in-memory segments are updated.
+func (m *meta) recordSegmentStates(cause string, segments ...*SegmentInfo) {
+	for _, segment := range segments {
+		from := commonpb.SegmentState_SegmentStateNone
+		if current := m.segments.GetSegment(segment.GetID()); current != nil {
+			from = current.GetState()
+		}
+		m.segmentHistory.record(segment, from, cause)
+	}
+}
+
+// GetSegmentHistory returns the state transitions of the segment, nil if there is none.
+func (m *meta) GetSegmentHistory(segmentID UniqueID) *model.SegmentHistory {
+	return m.segmentHistory.get(segmentID)
+}
+
+// startSegmentHistoryFlushLoop persists the segment state histories changed periodically, and once more on shutdown.
+func (s *Server) startSegmentHistoryFlushLoop(ctx context.Context) {
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(segmentHistoryFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				s.meta.segmentHistory.flush(context.Background())
+				log.Info("segment history flush loop shutdown")
+				return
+			case <-ticker.C:
+				s.meta.segmentHistory.flush(ctx)
+			}
+		}
+	}()
+}
+
+// segmentHistoryToProto converts the history to the GetSegmentHistory response.
+func segmentHistoryToProto(h *model.SegmentHistory) *datapb.GetSegmentHistoryResponse {
+	events := make([]*datapb.SegmentStateEvent, 0, len(h.Events))
+	for _, event := range h.Events {
+		events = append(events, &datapb.SegmentStateEvent{
+			From:      commonpb.SegmentState(commonpb.SegmentState_value[event.From]),
+			To:        commonpb.SegmentState(commonpb.SegmentState_value[event.To]),
+			Cause:     event.Cause,
+			Timestamp: event.Time.UnixMilli(),
+		})
+	}
+	return &datapb.GetSegmentHistoryResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CollectionID: h.CollectionID,
+		PartitionID:  h.PartitionID,
+		SegmentID:    h.SegmentID,
+		Channel:      h.Channel,
+		Events:       events,
+	}
+}
+
+// GetSegmentHistory returns the state transitions of the segment, IllegalArgument if the segment has no history.
+func (s *Server) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetSegmentHistoryResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	history := s.meta.GetSegmentHistory(req.GetSegmentID())
+	if history == nil {
+		return &datapb.GetSegmentHistoryResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    fmt.Sprintf("no history of segment %d", req.GetSegmentID()),
+			},
+		}, nil
+	}
+	return segmentHistoryToProto(history), nil
+}
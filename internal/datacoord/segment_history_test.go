@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestMeta_SegmentHistory(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	kv := memkv.NewMemoryKV()
+	m, err := newMeta(ctx, kv, "", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Growing,
+	})))
+	require.NoError(t, m.SetState(1, commonpb.SegmentState_Sealed, segmentCauseSealPolicy))
+	// no transition
+	require.NoError(t, m.SetState(1, commonpb.SegmentState_Sealed, segmentCauseFlushRPC))
+	require.NoError(t, m.UpdateFlushSegmentsInfo(1, true, false, false, nil, nil, nil, nil, nil))
+	require.NoError(t, m.SetState(1, commonpb.SegmentState_Flushed, segmentCauseFlushDone))
+
+	history := m.GetSegmentHistory(1)
+	require.NotNil(t, history)
+	assert.Equal(t, int64(100), history.CollectionID)
+	assert.Equal(t, "ch1", history.Channel)
+	require.Equal(t, 4, len(history.Events))
+	expected := []model.SegmentStateEvent{
+		{From: commonpb.SegmentState_SegmentStateNone.String(), To: commonpb.SegmentState_Growing.String(), Cause: segmentCauseAllocation},
+		{From: commonpb.SegmentState_Growing.String(), To: commonpb.SegmentState_Sealed.String(), Cause: segmentCauseSealPolicy},
+		{From: commonpb.SegmentState_Sealed.String(), To: commonpb.SegmentState_Flushing.String(), Cause: segmentCauseDataNodeFlush},
+		{From: commonpb.SegmentState_Flushing.String(), To: commonpb.SegmentState_Flushed.String(), Cause: segmentCauseFlushDone},
+	}
+	for i, event := range history.Events {
+		assert.Equal(t, expected[i].From, event.From)
+		assert.Equal(t, expected[i].To, event.To)
+		assert.Equal(t, expected[i].Cause, event.Cause)
+		assert.False(t, event.Time.IsZero())
+	}
+	assert.Nil(t, m.GetSegmentHistory(2))
+
+	t.Run("reload", func(t *testing.T) {
+		// nothing is persisted until flushed
+		reloaded, err := newMeta(ctx, kv, "", nil)
+		require.NoError(t, err)
+		assert.Nil(t, reloaded.GetSegmentHistory(1))
+
+		m.segmentHistory.flush(ctx)
+		reloaded, err = newMeta(ctx, kv, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 4, len(reloaded.GetSegmentHistory(1).Events))
+	})
+
+	t.Run("flush failed", func(t *testing.T) {
+		recorder := newSegmentHistoryRecorder(&datacoord.Catalog{Txn: &saveFailKV{TxnKV: memkv.NewMemoryKV()}})
+		segment := NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing})
+		recorder.record(segment, commonpb.SegmentState_SegmentStateNone, segmentCauseAllocation)
+		recorder.flush(ctx)
+		assert.True(t, recorder.dirty.Contain(1))
+
+		recorder.catalog = &datacoord.Catalog{Txn: memkv.NewMemoryKV()}
+		recorder.flush(ctx)
+		assert.Equal(t, 0, len(recorder.dirty))
+		histories, err := recorder.catalog.ListSegmentHistories(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(histories))
+	})
+
+	t.Run("max events", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.SegmentHistoryMaxEvents.Key, "2")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentHistoryMaxEvents.Key)
+		require.NoError(t, m.SetState(1, commonpb.SegmentState_Dropped, segmentCauseMarkSegmentsDrop))
+		events := m.GetSegmentHistory(1).Events
+		require.Equal(t, 2, len(events))
+		assert.Equal(t, segmentCauseFlushDone, events[0].Cause)
+		assert.Equal(t, segmentCauseMarkSegmentsDrop, events[1].Cause)
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		s := &Server{meta: m, session: &sessionutil.Session{ServerID: 1}}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.GetSegmentHistory(ctx, &datapb.GetSegmentHistoryRequest{SegmentID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(1), resp.GetSegmentID())
+		assert.Equal(t, int64(100), resp.GetCollectionID())
+		require.Equal(t, 2, len(resp.GetEvents()))
+		assert.Equal(t, commonpb.SegmentState_Flushed, resp.GetEvents()[1].GetFrom())
+		assert.Equal(t, commonpb.SegmentState_Dropped, resp.GetEvents()[1].GetTo())
+		assert.Equal(t, segmentCauseMarkSegmentsDrop, resp.GetEvents()[1].GetCause())
+		assert.NotZero(t, resp.GetEvents()[1].GetTimestamp())
+
+		resp, err = s.GetSegmentHistory(ctx, &datapb.GetSegmentHistoryRequest{SegmentID: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err = s.GetSegmentHistory(ctx, &datapb.GetSegmentHistoryRequest{SegmentID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("drop segment", func(t *testing.T) {
+		require.NoError(t, m.DropSegment(1))
+		assert.Nil(t, m.GetSegmentHistory(1))
+		m.segmentHistory.flush(ctx)
+		histories, err := m.catalog.ListSegmentHistories(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(histories))
+	})
+}
@@ -0,0 +1,300 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// segmentLockOwner identifies the holder of segment locks, e.g. the IndexNode and the build ID of an index task.
+type segmentLockOwner struct {
+	NodeID UniqueID `json:"node_id"`
+	TaskID UniqueID `json:"task_id"`
+}
+
+func (o segmentLockOwner) String() string {
+	return fmt.Sprintf("%d-%d", o.NodeID, o.TaskID)
+}
+
+// segmentLease is a set of segment locks held by one owner, the locks are released automatically once the lease expires.
+type segmentLease struct {
+	Owner      segmentLockOwner `json:"owner"`
+	SegmentIDs []UniqueID       `json:"segment_ids"`
+	AcquiredAt time.Time        `json:"acquired_at"`
+	ExpireAt   time.Time        `json:"expire_at"`
+}
+
+func (l *segmentLease) expired(now time.Time) bool {
+	return !now.Before(l.ExpireAt)
+}
+
+// segmentLockManager keeps the segments referenced by running tasks so that they are not recycled by GC.
+// Locks are shared and acquiring never blocks, GC skips the locked segments instead of waiting for them,
+// and a lease not renewed by its owner expires, so a crashed owner never leaks the locks forever.
+type segmentLockManager struct {
+	mu     sync.RWMutex
+	leases map[segmentLockOwner]*segmentLease
+	now    func() time.Time
+}
+
+func newSegmentLockManager() *segmentLockManager {
+	return &segmentLockManager{
+		leases: make(map[segmentLockOwner]*segmentLease),
+		now:    time.Now,
+	}
+}
+
+func (m *segmentLockManager) leaseTTL() time.Duration {
+	return Params.DataCoordCfg.SegmentLockLeaseTTL.GetAsDuration(time.Second)
+}
+
+// Acquire locks the segments for the owner, the segments are merged into the lease if the owner already holds one,
+// and the lease is renewed.
+func (m *segmentLockManager) Acquire(owner segmentLockOwner, segmentIDs ...UniqueID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	lease, ok := m.leases[owner]
+	if !ok || lease.expired(now) {
+		lease = &segmentLease{
+			Owner:      owner,
+			AcquiredAt: now,
+		}
+		m.leases[owner] = lease
+	}
+	segments := typeutil.NewUniqueSet(lease.SegmentIDs...)
+	segments.Insert(segmentIDs...)
+	lease.SegmentIDs = segments.Collect()
+	sort.Slice(lease.SegmentIDs, func(i, j int) bool { return lease.SegmentIDs[i] < lease.SegmentIDs[j] })
+	lease.ExpireAt = now.Add(m.leaseTTL())
+	log.Info("segment locks acquired", zap.Stringer("owner", owner), zap.Int64s("segmentIDs", segmentIDs),
+		zap.Time("expireAt", lease.ExpireAt))
+}
+
+// Renew extends the lease of the owner, it fails if the owner holds no lease or the lease has expired.
+func (m *segmentLockManager) Renew(owner segmentLockOwner) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	lease, ok := m.leases[owner]
+	if !ok || lease.expired(now) {
+		delete(m.leases, owner)
+		return fmt.Errorf("segment lock lease of owner %s not found or expired", owner)
+	}
+	lease.ExpireAt = now.Add(m.leaseTTL())
+	return nil
+}
+
+// Release releases the given segment locks held by the owner, or all of them if no segment is given.
+// The lease is removed once it holds no segment anymore.
+func (m *segmentLockManager) Release(owner segmentLockOwner, segmentIDs ...UniqueID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lease, ok := m.leases[owner]
+	if !ok {
+		return
+	}
+	if len(segmentIDs) == 0 {
+		delete(m.leases, owner)
+		log.Info("segment locks released", zap.Stringer("owner", owner), zap.Int64s("segmentIDs", lease.SegmentIDs))
+		return
+	}
+	released := typeutil.NewUniqueSet(segmentIDs...)
+	remained := make([]UniqueID, 0, len(lease.SegmentIDs))
+	for _, id := range lease.SegmentIDs {
+		if !released.Contain(id) {
+			remained = append(remained, id)
+		}
+	}
+	lease.SegmentIDs = remained
+	if len(remained) == 0 {
+		delete(m.leases, owner)
+	}
+	log.Info("segment locks released", zap.Stringer("owner", owner), zap.Int64s("segmentIDs", segmentIDs))
+}
+
+// ReleaseNode releases the segment locks held by all the tasks on the node, called when the node goes down.
+func (m *segmentLockManager) ReleaseNode(nodeID UniqueID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for owner := range m.leases {
+		if owner.NodeID == nodeID {
+			delete(m.leases, owner)
+		}
+	}
+	log.Info("segment locks of node released", zap.Int64("nodeID", nodeID))
+}
+
+// IsLocked returns whether the segment is referenced by any lease not expired yet.
+func (m *segmentLockManager) IsLocked(segmentID UniqueID) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := m.now()
+	for _, lease := range m.leases {
+		if lease.expired(now) {
+			continue
+		}
+		for _, id := range lease.SegmentIDs {
+			if id == segmentID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// expire removes the expired leases and returns the number of them.
+func (m *segmentLockManager) expire() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	expired := 0
+	for owner, lease := range m.leases {
+		if lease.expired(now) {
+			log.Warn("segment lock lease expired", zap.Stringer("owner", owner),
+				zap.Int64s("segmentIDs", lease.SegmentIDs), zap.Time("expireAt", lease.ExpireAt))
+			delete(m.leases, owner)
+			expired++
+		}
+	}
+	return expired
+}
+
+// Inspect returns the leases not expired yet, sorted by owner.
+func (m *segmentLockManager) Inspect() []*segmentLease {
+	m.expire()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ret := make([]*segmentLease, 0, len(m.leases))
+	for _, lease := range m.leases {
+		cloned := *lease
+		cloned.SegmentIDs = append([]UniqueID{}, lease.SegmentIDs...)
+		ret = append(ret, &cloned)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Owner.NodeID != ret[j].Owner.NodeID {
+			return ret[i].Owner.NodeID < ret[j].Owner.NodeID
+		}
+		return ret[i].Owner.TaskID < ret[j].Owner.TaskID
+	})
+	return ret
+}
+
+// AcquireSegmentLock locks the segments for the task of the node so that they are not recycled by GC, the segments
+// are merged into the lease if the task holds one already. The lease expires if it's not renewed in time.
+func (s *Server) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
+	if s.isClosed() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	if len(req.GetSegmentIDs()) == 0 {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "no segment to lock",
+		}, nil
+	}
+	s.segmentLocks.Acquire(segmentLockOwner{NodeID: req.GetNodeID(), TaskID: req.GetTaskID()}, req.GetSegmentIDs()...)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// RenewSegmentLock extends the lease of the segment locks held by the task of the node, the holder has to acquire
+// the locks again if the lease has expired.
+func (s *Server) RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest) (*commonpb.Status, error) {
+	if s.isClosed() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	if err := s.segmentLocks.Renew(segmentLockOwner{NodeID: req.GetNodeID(), TaskID: req.GetTaskID()}); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ReleaseSegmentLock releases the given segment locks held by the task of the node, or all of them if no segment is
+// given.
+func (s *Server) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	if s.isClosed() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	s.segmentLocks.Release(segmentLockOwner{NodeID: req.GetNodeID(), TaskID: req.GetTaskID()}, req.GetSegmentIDs()...)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// InspectSegmentLocks returns the segment lock leases not expired yet, sorted by node and task.
+func (s *Server) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	if s.isClosed() {
+		return &datapb.InspectSegmentLocksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	leases := s.segmentLocks.Inspect()
+	ret := make([]*datapb.SegmentLockLease, 0, len(leases))
+	for _, lease := range leases {
+		ret = append(ret, &datapb.SegmentLockLease{
+			NodeID:     lease.Owner.NodeID,
+			TaskID:     lease.Owner.TaskID,
+			SegmentIDs: lease.SegmentIDs,
+			AcquiredAt: lease.AcquiredAt.UnixMilli(),
+			ExpireAt:   lease.ExpireAt.UnixMilli(),
+		})
+	}
+	return &datapb.InspectSegmentLocksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Leases: ret,
+	}, nil
+}
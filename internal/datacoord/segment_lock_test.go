@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestSegmentLockManager(t *testing.T) {
+	Params.Init()
+
+	now := time.Now()
+	m := newSegmentLockManager()
+	m.now = func() time.Time { return now }
+	ttl := Params.DataCoordCfg.SegmentLockLeaseTTL.GetAsDuration(time.Second)
+
+	owner1 := segmentLockOwner{NodeID: 1, TaskID: 100}
+	owner2 := segmentLockOwner{NodeID: 2, TaskID: 200}
+
+	t.Run("acquire and release", func(t *testing.T) {
+		m.Acquire(owner1, 1000)
+		m.Acquire(owner1, 1001, 1000)
+		m.Acquire(owner2, 1001)
+		assert.True(t, m.IsLocked(1000))
+		assert.True(t, m.IsLocked(1001))
+		assert.False(t, m.IsLocked(1002))
+
+		leases := m.Inspect()
+		assert.Equal(t, 2, len(leases))
+		assert.Equal(t, owner1, leases[0].Owner)
+		assert.Equal(t, []UniqueID{1000, 1001}, leases[0].SegmentIDs)
+		assert.Equal(t, owner2, leases[1].Owner)
+
+		m.Release(owner1, 1001)
+		assert.True(t, m.IsLocked(1000))
+		leases = m.Inspect()
+		assert.Equal(t, []UniqueID{1000}, leases[0].SegmentIDs)
+
+		m.Release(owner1)
+		assert.False(t, m.IsLocked(1000))
+		assert.True(t, m.IsLocked(1001))
+
+		m.ReleaseNode(owner2.NodeID)
+		assert.False(t, m.IsLocked(1001))
+		assert.Empty(t, m.Inspect())
+	})
+
+	t.Run("lease expiry and renew", func(t *testing.T) {
+		m.Acquire(owner1, 1000)
+		m.Acquire(owner2, 1001)
+
+		now = now.Add(ttl / 2)
+		assert.NoError(t, m.Renew(owner1))
+
+		now = now.Add(ttl / 2)
+		assert.True(t, m.IsLocked(1000))
+		assert.False(t, m.IsLocked(1001))
+		assert.Error(t, m.Renew(owner2))
+
+		assert.Equal(t, 0, m.expire())
+		leases := m.Inspect()
+		assert.Equal(t, 1, len(leases))
+		assert.Equal(t, owner1, leases[0].Owner)
+
+		now = now.Add(ttl)
+		assert.Equal(t, 1, m.expire())
+		assert.False(t, m.IsLocked(1000))
+		assert.Error(t, m.Renew(owner1))
+	})
+}
+
+func TestServer_SegmentLock(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	s := &Server{segmentLocks: newSegmentLockManager()}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	status, err := s.AcquireSegmentLock(ctx, &datapb.AcquireSegmentLockRequest{NodeID: 1, TaskID: 100, SegmentIDs: []int64{1000, 1001}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = s.AcquireSegmentLock(ctx, &datapb.AcquireSegmentLockRequest{NodeID: 1, TaskID: 100})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	status, err = s.RenewSegmentLock(ctx, &datapb.RenewSegmentLockRequest{NodeID: 1, TaskID: 100})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = s.RenewSegmentLock(ctx, &datapb.RenewSegmentLockRequest{NodeID: 2, TaskID: 100})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	resp, err := s.InspectSegmentLocks(ctx, &datapb.InspectSegmentLocksRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(resp.GetLeases()))
+	assert.Equal(t, int64(1), resp.GetLeases()[0].GetNodeID())
+	assert.Equal(t, int64(100), resp.GetLeases()[0].GetTaskID())
+	assert.Equal(t, []int64{1000, 1001}, resp.GetLeases()[0].GetSegmentIDs())
+	assert.Greater(t, resp.GetLeases()[0].GetExpireAt(), resp.GetLeases()[0].GetAcquiredAt())
+
+	status, err = s.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{NodeID: 1, TaskID: 100, SegmentIDs: []int64{1000}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.False(t, s.segmentLocks.IsLocked(1000))
+	assert.True(t, s.segmentLocks.IsLocked(1001))
+	status, err = s.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{NodeID: 1, TaskID: 100})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	resp, err = s.InspectSegmentLocks(ctx, &datapb.InspectSegmentLocksRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetLeases())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	status, err = s.AcquireSegmentLock(ctx, &datapb.AcquireSegmentLockRequest{NodeID: 1, TaskID: 100, SegmentIDs: []int64{1000}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	status, err = s.RenewSegmentLock(ctx, &datapb.RenewSegmentLockRequest{NodeID: 1, TaskID: 100})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	status, err = s.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{NodeID: 1, TaskID: 100})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	resp, err = s.InspectSegmentLocks(ctx, &datapb.InspectSegmentLocksRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -83,6 +83,8 @@ type Manager interface {
 	ExpireAllocations(channel string, ts Timestamp) error
 	// DropSegmentsOfChannel drops all segments in a channel
 	DropSegmentsOfChannel(ctx context.Context, channel string)
+	// AllocHints returns the row distribution of the partition over the vchannels and the allocation hints of them.
+	AllocHints(collectionID, partitionID UniqueID) (*partitionAllocHints, error)
 }
 
 // Allocation records the allocation info
@@ -112,6 +114,7 @@ type SegmentManager struct {
 	segmentSealPolicies []segmentSealPolicy
 	channelSealPolicies []channelSealPolicy
 	flushPolicy         flushPolicy
+	allocHints          *segmentAllocHints
 	rcc                 types.RootCoord
 }
 
@@ -208,6 +211,7 @@ func newSegmentManager(meta *meta, allocator allocator, rcc types.RootCoord, opt
 		segmentSealPolicies: defaultSegmentSealPolicy(), // default only segment size policy
 		channelSealPolicies: []channelSealPolicy{},      // no default channel seal policy
 		flushPolicy:         defaultFlushPolicy(),
+		allocHints:          newSegmentAllocHints(meta),
 		rcc:                 rcc,
 	}
 	for _, opt := range opts {
@@ -254,8 +258,10 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	if err != nil {
 		return nil, err
 	}
+	// the new segments of a skewed vchannel are capped, so that its rows are sealed into more segments
+	rowLimit := s.allocHints.rowLimit(collectionID, partitionID, channelName, int64(maxCountPerSegment))
 	newSegmentAllocations, existedSegmentAllocations := s.allocPolicy(segments,
-		requestRows, int64(maxCountPerSegment))
+		requestRows, rowLimit)
 
 	// create new segments and add allocations
 	expireTs, err := s.genExpireTs(ctx)
@@ -263,7 +269,7 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		return nil, err
 	}
 	for _, allocation := range newSegmentAllocations {
-		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, commonpb.SegmentState_Growing)
+		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, commonpb.SegmentState_Growing, rowLimit)
 		if err != nil {
 			return nil, err
 		}
@@ -298,7 +304,12 @@ func (s *SegmentManager) allocSegmentForImport(ctx context.Context, collectionID
 		return nil, err
 	}
 
-	segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, commonpb.SegmentState_Importing)
+	maxNumOfRows, err := s.estimateMaxNumOfRows(collectionID)
+	if err != nil {
+		log.Error("failed to open new segment while estimateMaxNumOfRows", zap.Error(err))
+		return nil, err
+	}
+	segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, commonpb.SegmentState_Importing, int64(maxNumOfRows))
 	if err != nil {
 		return nil, err
 	}
@@ -338,7 +349,7 @@ func (s *SegmentManager) genExpireTs(ctx context.Context) (Timestamp, error) {
 }
 
 func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID,
-	channelName string, segmentState commonpb.SegmentState) (*SegmentInfo, error) {
+	channelName string, segmentState commonpb.SegmentState, maxNumOfRows int64) (*SegmentInfo, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	id, err := s.allocator.allocID(ctx)
@@ -346,11 +357,6 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 		log.Error("failed to open new segment while allocID", zap.Error(err))
 		return nil, err
 	}
-	maxNumOfRows, err := s.estimateMaxNumOfRows(collectionID)
-	if err != nil {
-		log.Error("failed to open new segment while estimateMaxNumOfRows", zap.Error(err))
-		return nil, err
-	}
 
 	segmentInfo := &datapb.SegmentInfo{
 		ID:             id,
@@ -359,7 +365,7 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 		InsertChannel:  channelName,
 		NumOfRows:      0,
 		State:          segmentState,
-		MaxRowNum:      int64(maxNumOfRows),
+		MaxRowNum:      maxNumOfRows,
 		LastExpireTime: 0,
 	}
 	if segmentState == commonpb.SegmentState_Importing {
@@ -374,12 +380,21 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 	log.Info("datacoord: estimateTotalRows: ",
 		zap.Int64("CollectionID", segmentInfo.CollectionID),
 		zap.Int64("SegmentID", segmentInfo.ID),
-		zap.Int("Rows", maxNumOfRows),
+		zap.Int64("Rows", maxNumOfRows),
 		zap.String("Channel", segmentInfo.InsertChannel))
 
 	return segment, s.helper.afterCreateSegment(segmentInfo)
 }
 
+// AllocHints computes the allocation hints of the partition from the current segments.
+func (s *SegmentManager) AllocHints(collectionID, partitionID UniqueID) (*partitionAllocHints, error) {
+	maxNumOfRows, err := s.estimateMaxNumOfRows(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return computePartitionAllocHints(s.meta, collectionID, partitionID, "", int64(maxNumOfRows)), nil
+}
+
 func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error) {
 	// it's ok to use meta.GetCollection here, since collection meta is set before using segmentManager
 	collMeta := s.meta.GetCollection(collectionID)
@@ -436,7 +451,7 @@ func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID Uniqu
 			ret = append(ret, id)
 			continue
 		}
-		if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
+		if err := s.meta.SetState(id, commonpb.SegmentState_Sealed, segmentCauseFlushRPC); err != nil {
 			return nil, err
 		}
 		ret = append(ret, id)
@@ -505,7 +520,7 @@ func (s *SegmentManager) dropEmptySealedSegment(ts Timestamp, channel string) {
 
 		if isEmptySealedSegment(segment, ts) {
 			log.Info("remove empty sealed segment", zap.Any("segment", id))
-			s.meta.SetState(id, commonpb.SegmentState_Dropped)
+			s.meta.SetState(id, commonpb.SegmentState_Dropped, segmentCauseEmptySealed)
 			continue
 		}
 		valids = append(valids, id)
@@ -532,7 +547,7 @@ func (s *SegmentManager) tryToSealSegment(ts Timestamp, channel string) error {
 		// change shouldSeal to segment seal policy logic
 		for _, policy := range s.segmentSealPolicies {
 			if policy(info, ts) {
-				if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
+				if err := s.meta.SetState(id, commonpb.SegmentState_Sealed, segmentCauseSealPolicy); err != nil {
 					return err
 				}
 				break
@@ -546,7 +561,7 @@ func (s *SegmentManager) tryToSealSegment(ts Timestamp, channel string) error {
 				if info.State == commonpb.SegmentState_Sealed {
 					continue
 				}
-				if err := s.meta.SetState(info.GetID(), commonpb.SegmentState_Sealed); err != nil {
+				if err := s.meta.SetState(info.GetID(), commonpb.SegmentState_Sealed, segmentCauseSealPolicy); err != nil {
 					return err
 				}
 			}
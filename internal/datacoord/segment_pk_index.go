@@ -0,0 +1,301 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// segmentPKIndexPruneInterval is the interval to drop the pk ranges of the segments no longer healthy
+const segmentPKIndexPruneInterval = 10 * time.Minute
+
+// segmentPKRange is the pk ranges of a flushed segment, one per stats log, loaded from its stats logs.
+// The bloom filters of the stats logs are kept only if dataCoord.segmentPKIndex.bloomFilter is enabled.
+type segmentPKRange struct {
+	collectionID UniqueID
+	stats        []*storage.PkStatistics
+}
+
+// mayContain returns whether the pk is inside the range of any stats log and not ruled out by its bloom filter.
+func (r *segmentPKRange) mayContain(pk storage.PrimaryKey) bool {
+	for _, stats := range r.stats {
+		if stats.MinPK.GT(pk) || stats.MaxPK.LT(pk) {
+			continue
+		}
+		if stats.PkFilter == nil || stats.PkExist(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+// segmentPKIndex caches the pk ranges of the flushed segments, they are loaded once the segments are flushed,
+// or on the first lookup after DataCoord restarts. All the methods are no-op on a nil index.
+type segmentPKIndex struct {
+	chunkManager storage.ChunkManager
+	flushed      chan *SegmentInfo
+
+	mu     sync.RWMutex
+	ranges map[UniqueID]*segmentPKRange // segment id -> pk range
+}
+
+func newSegmentPKIndex(chunkManager storage.ChunkManager) *segmentPKIndex {
+	return &segmentPKIndex{
+		chunkManager: chunkManager,
+		flushed:      make(chan *SegmentInfo, 1024),
+		ranges:       make(map[UniqueID]*segmentPKRange),
+	}
+}
+
+// notifyFlushed queues the segment to load its pk range, the segment is left to the lookups if the queue is full.
+func (idx *segmentPKIndex) notifyFlushed(segment *SegmentInfo) {
+	if idx == nil || segment == nil {
+		return
+	}
+	select {
+	case idx.flushed <- segment:
+	default:
+	}
+}
+
+// get returns the pk range of the flushed segment, loading it from the stats logs if not cached yet.
+func (idx *segmentPKIndex) get(ctx context.Context, segment *SegmentInfo) (*segmentPKRange, error) {
+	if idx == nil {
+		return nil, errors.New("segment pk index not initialized")
+	}
+	idx.mu.RLock()
+	r, ok := idx.ranges[segment.GetID()]
+	idx.mu.RUnlock()
+	if ok {
+		return r, nil
+	}
+	r, err := idx.load(ctx, segment)
+	if err != nil {
+		return nil, err
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.ranges[segment.GetID()] = r
+	return r, nil
+}
+
+// load reads the pk range of the segment from its stats logs.
+func (idx *segmentPKIndex) load(ctx context.Context, segment *SegmentInfo) (*segmentPKRange, error) {
+	var paths []string
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	r := &segmentPKRange{collectionID: segment.GetCollectionID()}
+	if len(paths) == 0 {
+		return r, nil
+	}
+	values, err := idx.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(values))
+	for i, value := range values {
+		blobs = append(blobs, &storage.Blob{Key: paths[i], Value: value})
+	}
+	pkStats, err := storage.DeserializeStats(blobs)
+	if err != nil {
+		return nil, err
+	}
+	keepFilter := Params.DataCoordCfg.SegmentPKIndexBloomFilter.GetAsBool()
+	for _, stats := range pkStats {
+		// the stats logs of the empty syncs have no pk
+		if stats.MinPk == nil || stats.MaxPk == nil {
+			continue
+		}
+		pkStatistics := &storage.PkStatistics{MinPK: stats.MinPk, MaxPK: stats.MaxPk}
+		if keepFilter {
+			pkStatistics.PkFilter = stats.BF
+		}
+		r.stats = append(r.stats, pkStatistics)
+	}
+	return r, nil
+}
+
+// prune drops the pk ranges of the segments which are not alive anymore.
+func (idx *segmentPKIndex) prune(alive func(segmentID UniqueID) bool) {
+	if idx == nil {
+		return
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for segmentID := range idx.ranges {
+		if !alive(segmentID) {
+			delete(idx.ranges, segmentID)
+		}
+	}
+}
+
+// startSegmentPKIndexLoop loads the pk ranges of the segments once they are flushed,
+// and drops the ones of the segments compacted or dropped periodically.
+func (s *Server) startSegmentPKIndexLoop(ctx context.Context) {
+	idx := s.meta.segmentPKIndex
+	if idx == nil {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(segmentPKIndexPruneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("segment pk index loop shutdown")
+				return
+			case segment := <-idx.flushed:
+				if _, err := idx.get(ctx, segment); err != nil {
+					log.Warn("failed to load the pk range of the flushed segment",
+						zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+				}
+			case <-ticker.C:
+				idx.prune(func(segmentID UniqueID) bool {
+					return isSegmentHealthy(s.meta.GetSegment(segmentID))
+				})
+			}
+		}
+	}()
+}
+
+// parsePrimaryKeys parses the pks in the data type of the primary field.
+func parsePrimaryKeys(pkField *schemapb.FieldSchema, values []string) ([]storage.PrimaryKey, error) {
+	pks := make([]storage.PrimaryKey, 0, len(values))
+	for _, value := range values {
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			v, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pk %s of int64 primary field %s", value, pkField.GetName())
+			}
+			pks = append(pks, storage.NewInt64PrimaryKey(v))
+		case schemapb.DataType_VarChar:
+			pks = append(pks, storage.NewVarCharPrimaryKey(value))
+		default:
+			return nil, fmt.Errorf("unsupported primary field type %s", pkField.GetDataType())
+		}
+	}
+	return pks, nil
+}
+
+// locatePrimaryKeys returns the segments of the collection which may contain the pks. The flushed segments
+// whose pk range can't be loaded are kept as candidates.
+func (s *Server) locatePrimaryKeys(ctx context.Context, collectionID UniqueID, values []string) (*datapb.LocatePrimaryKeysResponse, error) {
+	coll, err := s.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, fmt.Errorf("collection %d not found", collectionID)
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(coll.Schema)
+	if err != nil {
+		return nil, err
+	}
+	pks, err := parsePrimaryKeys(pkField, values)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID && isSegmentHealthy(segment)
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	result := &datapb.LocatePrimaryKeysResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CollectionID:        collectionID,
+		Locations:           make([]*datapb.PrimaryKeyLocation, len(pks)),
+		UnflushedSegmentIDs: make([]UniqueID, 0),
+	}
+	for i := range pks {
+		result.Locations[i] = &datapb.PrimaryKeyLocation{Pk: values[i], SegmentIDs: make([]UniqueID, 0)}
+	}
+	for _, segment := range segments {
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			result.UnflushedSegmentIDs = append(result.UnflushedSegmentIDs, segment.GetID())
+			continue
+		}
+		r, err := s.meta.segmentPKIndex.get(ctx, segment)
+		if err != nil {
+			log.Warn("failed to load the pk range of the segment, take it as a candidate",
+				zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+		for i, pk := range pks {
+			if r == nil || r.mayContain(pk) {
+				result.Locations[i].SegmentIDs = append(result.Locations[i].SegmentIDs, segment.GetID())
+			}
+		}
+	}
+	return result, nil
+}
+
+// LocatePrimaryKeys returns the segments of the collection which may contain the pks, IllegalArgument if the pks
+// can't be parsed in the data type of the primary field.
+func (s *Server) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	if s.isClosed() {
+		return &datapb.LocatePrimaryKeysResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	if len(req.GetPks()) == 0 {
+		return &datapb.LocatePrimaryKeysResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "pks are required",
+			},
+		}, nil
+	}
+	resp, err := s.locatePrimaryKeys(ctx, req.GetCollectionID(), req.GetPks())
+	if err != nil {
+		log.Warn("failed to locate the primary keys", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return &datapb.LocatePrimaryKeysResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+// writeTestStatslog writes the pk stats log of the pks and returns its binlog.
+func writeTestStatslog(t *testing.T, cm storage.ChunkManager, name string, pks ...int64) *datapb.Binlog {
+	sw := &storage.StatsWriter{}
+	require.NoError(t, sw.GeneratePrimaryKeyStats(100, schemapb.DataType_Int64, &storage.Int64FieldData{Data: pks}))
+	logPath := path.Join(cm.RootPath(), "stats_log", name)
+	require.NoError(t, cm.Write(context.Background(), logPath, sw.GetBuffer()))
+	return &datapb.Binlog{LogPath: logPath}
+}
+
+func TestSegmentPKIndex_Load(t *testing.T) {
+	Params.Init()
+	defer Params.Save(Params.DataCoordCfg.SegmentPKIndexBloomFilter.Key, "false")
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           1,
+		CollectionID: 10,
+		Statslogs: []*datapb.FieldBinlog{{
+			FieldID: 100,
+			Binlogs: []*datapb.Binlog{
+				writeTestStatslog(t, cm, "1", 1, 3, 5),
+				writeTestStatslog(t, cm, "2", 100, 200),
+			},
+		}},
+	})
+
+	idx := newSegmentPKIndex(cm)
+	r, err := idx.load(ctx, segment)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), r.collectionID)
+	assert.Equal(t, 2, len(r.stats))
+	// the ranges only, the pks between the ranges are ruled out
+	assert.True(t, r.mayContain(storage.NewInt64PrimaryKey(2)))
+	assert.True(t, r.mayContain(storage.NewInt64PrimaryKey(150)))
+	assert.False(t, r.mayContain(storage.NewInt64PrimaryKey(50)))
+	assert.False(t, r.mayContain(storage.NewInt64PrimaryKey(300)))
+
+	// the bloom filters rule out the pks inside the ranges
+	Params.Save(Params.DataCoordCfg.SegmentPKIndexBloomFilter.Key, "true")
+	r, err = idx.load(ctx, segment)
+	require.NoError(t, err)
+	assert.True(t, r.mayContain(storage.NewInt64PrimaryKey(3)))
+	assert.False(t, r.mayContain(storage.NewInt64PrimaryKey(2)))
+
+	// no stats log, no pk
+	r, err = idx.load(ctx, NewSegmentInfo(&datapb.SegmentInfo{ID: 2}))
+	require.NoError(t, err)
+	assert.False(t, r.mayContain(storage.NewInt64PrimaryKey(1)))
+
+	_, err = idx.load(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:        3,
+		Statslogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: path.Join(cm.RootPath(), "absent")}}}},
+	}))
+	assert.Error(t, err)
+}
+
+func TestSegmentPKIndex_GetAndPrune(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:        1,
+		Statslogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{writeTestStatslog(t, cm, "1", 1, 2)}}},
+	})
+	idx := newSegmentPKIndex(cm)
+	r, err := idx.get(ctx, segment)
+	require.NoError(t, err)
+	// cached, the stats log is not read again
+	require.NoError(t, cm.Remove(ctx, segment.GetStatslogs()[0].GetBinlogs()[0].GetLogPath()))
+	cached, err := idx.get(ctx, segment)
+	require.NoError(t, err)
+	assert.Same(t, r, cached)
+
+	idx.prune(func(segmentID UniqueID) bool { return false })
+	_, err = idx.get(ctx, segment)
+	assert.Error(t, err)
+
+	var nilIndex *segmentPKIndex
+	nilIndex.notifyFlushed(segment)
+	nilIndex.prune(func(segmentID UniqueID) bool { return false })
+	_, err = nilIndex.get(ctx, segment)
+	assert.Error(t, err)
+}
+
+func TestServer_LocatePrimaryKeys(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	meta, err := newMeta(ctx, memkv.NewMemoryKV(), "", cm)
+	require.NoError(t, err)
+	s := &Server{
+		meta:    meta,
+		session: &sessionutil.Session{ServerID: 1},
+	}
+	s.handler = newServerHandler(s)
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	meta.AddCollection(&collectionInfo{
+		ID: 10,
+		Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		}},
+	})
+	addSegment := func(id UniqueID, state commonpb.SegmentState, binlogs ...*datapb.Binlog) {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: 10,
+			State:        state,
+			Statslogs:    []*datapb.FieldBinlog{{FieldID: 100, Binlogs: binlogs}},
+		})))
+	}
+	addSegment(1, commonpb.SegmentState_Flushed, writeTestStatslog(t, cm, "1", 1, 10))
+	addSegment(2, commonpb.SegmentState_Flushed, writeTestStatslog(t, cm, "2", 5, 20))
+	addSegment(3, commonpb.SegmentState_Growing)
+	addSegment(4, commonpb.SegmentState_Dropped, writeTestStatslog(t, cm, "4", 1, 10))
+	// the stats log is lost, the segment is kept as a candidate
+	addSegment(5, commonpb.SegmentState_Flushed, &datapb.Binlog{LogPath: path.Join(cm.RootPath(), "absent")})
+
+	resp, err := s.LocatePrimaryKeys(ctx, &datapb.LocatePrimaryKeysRequest{CollectionID: 10, Pks: []string{"3", "15", "30"}})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, []UniqueID{3}, resp.GetUnflushedSegmentIDs())
+	require.Equal(t, 3, len(resp.GetLocations()))
+	assert.Equal(t, "3", resp.GetLocations()[0].GetPk())
+	assert.Equal(t, []UniqueID{1, 2, 5}, resp.GetLocations()[0].GetSegmentIDs())
+	assert.Equal(t, []UniqueID{2, 5}, resp.GetLocations()[1].GetSegmentIDs())
+	assert.Equal(t, []UniqueID{5}, resp.GetLocations()[2].GetSegmentIDs())
+
+	for _, req := range []*datapb.LocatePrimaryKeysRequest{
+		{CollectionID: 10, Pks: []string{"abc"}},
+		{CollectionID: 10},
+		{CollectionID: 11, Pks: []string{"1"}},
+	} {
+		resp, err = s.LocatePrimaryKeys(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	}
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.LocatePrimaryKeys(ctx, &datapb.LocatePrimaryKeysRequest{CollectionID: 10, Pks: []string{"3"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
+
+func TestParsePrimaryKeys(t *testing.T) {
+	pks, err := parsePrimaryKeys(&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}, []string{"a", "b"})
+	require.NoError(t, err)
+	assert.True(t, pks[0].EQ(storage.NewVarCharPrimaryKey("a")))
+
+	_, err = parsePrimaryKeys(&schemapb.FieldSchema{DataType: schemapb.DataType_Int64}, []string{"a"})
+	assert.Error(t, err)
+	_, err = parsePrimaryKeys(&schemapb.FieldSchema{DataType: schemapb.DataType_Float}, []string{"1"})
+	assert.Error(t, err)
+}
@@ -119,6 +119,9 @@ type Server struct {
 	buildIndexCh    chan UniqueID
 	notifyIndexChan chan UniqueID
 	factory         dependency.Factory
+	// createIndexMu serializes creating the segment indexes, so that a segment enqueued by both
+	// the import fast path and the flushed segment watcher is built once
+	createIndexMu sync.Mutex
 
 	session   *sessionutil.Session
 	dnEventCh <-chan *sessionutil.SessionEvent
@@ -133,8 +136,14 @@ type Server struct {
 	//indexCoord             types.IndexCoord
 
 	//segReferManager  *SegmentReferenceManager
-	indexBuilder     *indexBuilder
-	indexNodeManager *IndexNodeManager
+	segmentLocks      *segmentLockManager
+	freezeManager     *freezeManager
+	handoffGate       *handoffGate
+	recoveryInfoCache *recoveryInfoCache
+	storageUsage      *storageUsageCache
+	segmentHeats      *segmentHeatTracker
+	indexBuilder      *indexBuilder
+	indexNodeManager  *IndexNodeManager
 }
 
 // ServerHelper datacoord server injection helper
@@ -201,6 +210,10 @@ func CreateServer(ctx context.Context, factory dependency.Factory, opts ...Optio
 		helper:                 defaultServerHelper(),
 		metricsCacheManager:    metricsinfo.NewMetricsCacheManager(),
 		enableActiveStandBy:    Params.DataCoordCfg.EnableActiveStandby.GetAsBool(),
+		segmentLocks:           newSegmentLockManager(),
+		freezeManager:          newFreezeManager(),
+		handoffGate:            newHandoffGate(),
+		segmentHeats:           newSegmentHeatTracker(),
 	}
 
 	for _, opt := range opts {
@@ -250,6 +263,8 @@ func (s *Server) initSession() error {
 	}
 	s.session.Init(typeutil.DataCoordRole, s.address, true, true)
 	s.session.SetEnableActiveStandBy(s.enableActiveStandBy)
+	// the recovery info versions are known by the clients together with the server id of the session
+	s.recoveryInfoCache = newRecoveryInfoCache(s.session.ServerID)
 	return nil
 }
 
@@ -373,7 +388,9 @@ func (s *Server) stopCompactionHandler() {
 }
 
 func (s *Server) createCompactionTrigger() {
-	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler)
+	trigger := newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler)
+	trigger.freezeManager = s.freezeManager
+	s.compactionTrigger = trigger
 }
 
 func (s *Server) stopCompactionTrigger() {
@@ -391,7 +408,7 @@ func (s *Server) newChunkManagerFactory() (storage.ChunkManager, error) {
 }
 
 func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
-	s.garbageCollector = newGarbageCollector(s.meta, s.handler, GcOption{
+	s.garbageCollector = newGarbageCollector(s.meta, s.handler, s.segmentLocks, GcOption{
 		cli:              cli,
 		enabled:          Params.DataCoordCfg.EnableGarbageCollection.GetAsBool(),
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
@@ -411,6 +428,11 @@ func (s *Server) initServiceDiscovery() error {
 
 	datanodes := make([]*NodeInfo, 0, len(sessions))
 	for _, session := range sessions {
+		// the vchannels of a decommissioning DataNode are reassigned to the others
+		if session.Stopping {
+			log.Info("DataCoord skip the stopping DataNode", zap.Int64("serverID", session.ServerID))
+			continue
+		}
 		info := &NodeInfo{
 			NodeID:  session.ServerID,
 			Address: session.Address,
@@ -464,14 +486,15 @@ func (s *Server) initMeta(chunkManagerRootPath string, chunkManager storage.Chun
 		if err != nil {
 			return err
 		}
-		return nil
+		s.storageUsage = newStorageUsageCache(s.meta)
+		return s.freezeManager.init(s.meta.catalog)
 	}
 	return retry.Do(s.ctx, reloadEtcdFn, retry.Attempts(connEtcdMaxRetryTime))
 }
 
 func (s *Server) initIndexBuilder(manager storage.ChunkManager) {
 	if s.indexBuilder == nil {
-		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager)
+		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager, s.segmentLocks)
 	}
 }
 
@@ -485,9 +508,14 @@ func (s *Server) startServerLoop() {
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 	s.serverLoopWg.Add(3)
 	s.startDataNodeTtLoop(s.serverLoopCtx)
+	s.startChannelCheckpointReconcileLoop(s.serverLoopCtx)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
 	s.startIndexService(s.serverLoopCtx)
+	s.startSegmentAnomalyDetectLoop(s.serverLoopCtx)
+	s.startSegmentPKIndexLoop(s.serverLoopCtx)
+	s.startSegmentHeatPruneLoop(s.serverLoopCtx)
+	s.startSegmentHistoryFlushLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
 }
 
@@ -726,6 +754,13 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			}
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
 		case sessionutil.SessionDelEvent:
+			if event.Session.Stopping {
+				// the node is unregistered when it turned stopping
+				log.Info("received stopping datanode unregister, skip",
+					zap.String("address", info.Address),
+					zap.Int64("serverID", info.Version))
+				return nil
+			}
 			log.Info("received datanode unregister",
 				zap.String("address", info.Address),
 				zap.Int64("serverID", info.Version))
@@ -734,6 +769,19 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				return err
 			}
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
+		case sessionutil.SessionUpdateEvent:
+			if !event.Session.Stopping {
+				return nil
+			}
+			// the datanode is decommissioned after draining its vchannels, reassign them to the other nodes
+			log.Info("received datanode stopping, unregister it",
+				zap.String("address", info.Address),
+				zap.Int64("serverID", info.Version))
+			if err := s.cluster.UnRegister(node); err != nil {
+				log.Warn("failed to deregister stopping node", zap.Int64("id", node.NodeID), zap.String("address", node.Address), zap.Error(err))
+				return err
+			}
+			s.metricsCacheManager.InvalidateSystemInfoMetrics()
 		default:
 			log.Warn("receive unknown service event type",
 				zap.Any("type", event.EventType))
@@ -744,7 +792,10 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			log.Info("received indexnode register",
 				zap.String("address", event.Session.Address),
 				zap.Int64("serverID", event.Session.ServerID))
-			return s.indexNodeManager.AddNode(event.Session.ServerID, event.Session.Address)
+			if err := s.indexNodeManager.AddNode(event.Session.ServerID, event.Session.Address); err != nil {
+				return err
+			}
+			return nil
 		case sessionutil.SessionDelEvent:
 			log.Info("received indexnode unregister",
 				zap.String("address", event.Session.Address),
@@ -800,7 +851,7 @@ func (s *Server) postFlush(ctx context.Context, segmentID UniqueID) error {
 		return errors.New("segment not found, might be a faked segemnt, ignore post flush")
 	}
 	// set segment to SegmentState_Flushed
-	if err := s.meta.SetState(segmentID, commonpb.SegmentState_Flushed); err != nil {
+	if err := s.meta.SetState(segmentID, commonpb.SegmentState_Flushed, segmentCauseFlushDone); err != nil {
 		log.Error("flush segment complete failed", zap.Error(err))
 		return err
 	}
@@ -751,6 +751,34 @@ func TestGetFlushedSegments(t *testing.T) {
 		}
 	})
 
+	t.Run("paged", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		for _, id := range []int64{5, 3, 1, 4, 2} {
+			assert.Nil(t, svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:           id,
+				CollectionID: 1,
+				PartitionID:  1,
+				State:        commonpb.SegmentState_Flushed,
+			})))
+		}
+		pages := [][]int64{{1, 2}, {3, 4}, {5}}
+		cursor := int64(0)
+		for i, page := range pages {
+			resp, err := svr.GetFlushedSegments(context.Background(), &datapb.GetFlushedSegmentsRequest{
+				CollectionID: 1,
+				PartitionID:  -1,
+				PageSize:     2,
+				Cursor:       cursor,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+			assert.Equal(t, page, resp.GetSegments())
+			assert.Equal(t, i < len(pages)-1, resp.GetHasMore())
+			cursor = page[len(page)-1] + 1
+		}
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		t.Run("with closed server", func(t *testing.T) {
 			svr := newTestServer(t, nil)
@@ -1182,6 +1210,11 @@ func (s *spySegmentManager) DropSegmentsOfChannel(ctx context.Context, channel s
 	s.spyCh <- struct{}{}
 }
 
+// AllocHints returns the row distribution of the partition over the vchannels and the allocation hints of them.
+func (s *spySegmentManager) AllocHints(collectionID, partitionID UniqueID) (*partitionAllocHints, error) {
+	return &partitionAllocHints{CollectionID: collectionID, PartitionID: partitionID}, nil
+}
+
 func TestSaveBinlogPaths(t *testing.T) {
 	t.Run("Normal SaveRequest", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -2256,6 +2289,43 @@ func TestGetRecoveryInfo(t *testing.T) {
 		assert.Nil(t, resp.GetChannels()[0].SeekPosition)
 	})
 
+	t.Run("test get recovery info since a version", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.RecoveryInfoCacheEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.RecoveryInfoCacheEnabled.Key)
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.rootCoordClientCreator = func(ctx context.Context, metaRootPath string, etcdCli *clientv3.Client) (types.RootCoord, error) {
+			return newMockRootCoordService(), nil
+		}
+
+		resp, err := svr.GetRecoveryInfo(context.TODO(), &datapb.GetRecoveryInfoRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.False(t, resp.GetDelta())
+		assert.Equal(t, svr.session.ServerID, resp.GetVersion().GetServerID())
+
+		resp, err = svr.GetRecoveryInfo(context.TODO(), &datapb.GetRecoveryInfoRequest{Since: resp.GetVersion()})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.True(t, resp.GetDelta())
+
+		// the versions of another DataCoord are unknown
+		resp, err = svr.GetRecoveryInfo(context.TODO(), &datapb.GetRecoveryInfoRequest{
+			Since: &datapb.RecoveryInfoVersion{ServerID: svr.session.ServerID + 1, Version: resp.GetVersion().GetVersion()},
+		})
+		assert.Nil(t, err)
+		assert.False(t, resp.GetDelta())
+
+		// the full recovery info without a version is answered if the cache is disabled
+		paramtable.Get().Save(Params.DataCoordCfg.RecoveryInfoCacheEnabled.Key, "false")
+		resp, err = svr.GetRecoveryInfo(context.TODO(), &datapb.GetRecoveryInfoRequest{Since: resp.GetVersion()})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.False(t, resp.GetDelta())
+		assert.Nil(t, resp.GetVersion())
+	})
+
 	createSegment := func(id, collectionID, partitionID, numOfRows int64, posTs uint64,
 		channel string, state commonpb.SegmentState) *datapb.SegmentInfo {
 		return &datapb.SegmentInfo{
@@ -2964,6 +3034,41 @@ func TestHandleSessionEvent(t *testing.T) {
 		assert.EqualValues(t, 0, len(dataNodes))
 	})
 
+	t.Run("handle stopping datanode", func(t *testing.T) {
+		session := &sessionutil.Session{
+			ServerID:   102,
+			ServerName: "DN102",
+			Address:    "DN127.0.0.102",
+		}
+		err = svr.handleSessionEvent(context.Background(), typeutil.DataNodeRole,
+			&sessionutil.SessionEvent{EventType: sessionutil.SessionAddEvent, Session: session})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(svr.cluster.GetSessions()))
+
+		// update event of a non-stopping session is ignored
+		err = svr.handleSessionEvent(context.Background(), typeutil.DataNodeRole,
+			&sessionutil.SessionEvent{EventType: sessionutil.SessionUpdateEvent, Session: session})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(svr.cluster.GetSessions()))
+
+		stopping := &sessionutil.Session{
+			ServerID:   102,
+			ServerName: "DN102",
+			Address:    "DN127.0.0.102",
+			Stopping:   true,
+		}
+		err = svr.handleSessionEvent(context.Background(), typeutil.DataNodeRole,
+			&sessionutil.SessionEvent{EventType: sessionutil.SessionUpdateEvent, Session: stopping})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 0, len(svr.cluster.GetSessions()))
+
+		// the stopping node is already unregistered
+		err = svr.handleSessionEvent(context.Background(), typeutil.DataNodeRole,
+			&sessionutil.SessionEvent{EventType: sessionutil.SessionDelEvent, Session: stopping})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 0, len(svr.cluster.GetSessions()))
+	})
+
 	t.Run("nil evt", func(t *testing.T) {
 		assert.NotPanics(t, func() {
 			err = svr.handleSessionEvent(context.Background(), typeutil.DataNodeRole, nil)
@@ -3339,6 +3444,69 @@ func TestDataCoord_SaveImportSegment(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
 	})
 
+	t.Run("test add segment builds index on save", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&collectionInfo{
+			ID: 100,
+		})
+		err := svr.meta.CreateIndex(&model.Index{
+			CollectionID: 100,
+			FieldID:      101,
+			IndexID:      1,
+			IndexName:    "idx",
+		})
+		assert.NoError(t, err)
+		err = svr.channelManager.AddNode(110)
+		assert.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 100})
+		assert.Nil(t, err)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  110,
+			Address: "localhost:8080",
+		})
+
+		saveImportSegment := func(segmentID UniqueID) {
+			seg := buildSegment(100, 100, segmentID, "ch1", true)
+			seg.currRows = 10
+			err := svr.meta.AddSegment(seg)
+			assert.NoError(t, err)
+			status, err := svr.SaveImportSegment(context.TODO(), &datapb.SaveImportSegmentRequest{
+				SegmentId:    segmentID,
+				ChannelName:  "ch1",
+				CollectionId: 100,
+				PartitionId:  100,
+				RowNum:       10,
+				SaveBinlogPathReq: &datapb.SaveBinlogPathsRequest{
+					SegmentID:    segmentID,
+					CollectionID: 100,
+					Importing:    true,
+					StartPositions: []*datapb.SegmentStartPosition{
+						{
+							StartPosition: &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 1},
+							SegmentID:     segmentID,
+						},
+					},
+				},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		}
+
+		saveImportSegment(100)
+		segIndexes := svr.meta.GetSegmentIndexes(100)
+		assert.Equal(t, 1, len(segIndexes))
+		assert.Equal(t, int64(10), segIndexes[0].NumRows)
+		// the flushed segment notification doesn't build the segment again
+		assert.NoError(t, svr.createIndexesForSegment(svr.meta.GetSegment(100)))
+		assert.Equal(t, 1, len(svr.meta.GetSegmentIndexes(100)))
+
+		paramtable.Get().Save(Params.DataCoordCfg.ImportBuildIndexOnSave.Key, "false")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.ImportBuildIndexOnSave.Key)
+		saveImportSegment(101)
+		assert.Empty(t, svr.meta.GetSegmentIndexes(101))
+	})
+
 	t.Run("test add segment w/ bad channel name", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		defer closeTestServer(t, svr)
@@ -3442,6 +3610,55 @@ func TestDataCoordServer_UpdateChannelCheckpoint(t *testing.T) {
 		assert.NoError(t, err)
 		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
 	})
+
+	t.Run("UpdateChannelCheckpoints", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		newReq := func(vChannel string, ts uint64) *datapb.UpdateChannelCheckpointRequest {
+			return &datapb.UpdateChannelCheckpointRequest{
+				VChannel: vChannel,
+				Position: &internalpb.MsgPosition{
+					ChannelName: mockPChannel,
+					MsgID:       []byte{},
+					Timestamp:   ts,
+				},
+			}
+		}
+		reqs := []*datapb.UpdateChannelCheckpointRequest{
+			newReq(mockVChannel, 1000),
+			newReq(mockVChannel, 2000),
+			{VChannel: mockVChannel + "-invalid"},
+			newReq("", 1000),
+		}
+		for i := 0; i < maxChannelCheckpointsPerTxn; i++ {
+			reqs = append(reqs, newReq(fmt.Sprintf("%s-%d", mockVChannel, i), 1000))
+		}
+
+		resp, err := svr.UpdateChannelCheckpoints(context.TODO(), &datapb.UpdateChannelCheckpointsRequest{Checkpoints: reqs})
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		statuses := resp.GetChannelStatuses()
+		assert.Equal(t, maxChannelCheckpointsPerTxn+2, len(statuses))
+		assert.EqualValues(t, commonpb.ErrorCode_Success, statuses[mockVChannel].GetErrorCode())
+		assert.EqualValues(t, commonpb.ErrorCode_IllegalArgument, statuses[mockVChannel+"-invalid"].GetErrorCode())
+		assert.EqualValues(t, 2000, svr.meta.GetChannelCheckpoint(mockVChannel).GetTimestamp())
+		assert.NotNil(t, svr.meta.GetChannelCheckpoint(mockVChannel+"-0"))
+
+		// an older checkpoint doesn't roll back the current one
+		resp, err = svr.UpdateChannelCheckpoints(context.TODO(), &datapb.UpdateChannelCheckpointsRequest{
+			Checkpoints: []*datapb.UpdateChannelCheckpointRequest{newReq(mockVChannel, 1500)},
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetChannelStatuses()[mockVChannel].GetErrorCode())
+		assert.EqualValues(t, 2000, svr.meta.GetChannelCheckpoint(mockVChannel).GetTimestamp())
+
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err = svr.UpdateChannelCheckpoints(context.TODO(), &datapb.UpdateChannelCheckpointsRequest{Checkpoints: reqs})
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+	})
 }
 
 // https://github.com/milvus-io/milvus/issues/15659
@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -90,6 +91,12 @@ func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.F
 		return resp, nil
 	}
 
+	if status := s.checkStorageQuota(req.GetCollectionID()); status != nil {
+		log.Warn("flush denied", zap.Int64("collectionID", req.GetCollectionID()), zap.String("reason", status.GetReason()))
+		resp.Status = status
+		return resp, nil
+	}
+
 	// generate a timestamp timeOfSeal, all data before timeOfSeal is guaranteed to be sealed or flushed
 	ts, err := s.allocator.allocTimestamp(ctx)
 	if err != nil {
@@ -155,6 +162,18 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 			zap.Bool("isImport", r.GetIsImport()),
 			zap.Int64("import task ID", r.GetImportTaskID()))
 
+		if status := s.checkStorageQuota(r.GetCollectionID()); status != nil {
+			log.Warn("segment assignment denied", zap.Int64("collectionID", r.GetCollectionID()),
+				zap.String("reason", status.GetReason()))
+			assigns = append(assigns, &datapb.SegmentIDAssignment{
+				ChannelName:  r.GetChannelName(),
+				CollectionID: r.GetCollectionID(),
+				PartitionID:  r.GetPartitionID(),
+				Status:       status,
+			})
+			continue
+		}
+
 		// Load the collection info from Root Coordinator, if it is not found in server meta.
 		// Note: this request wouldn't be received if collection didn't exist.
 		_, err := s.handler.GetCollection(ctx, r.GetCollectionID())
@@ -454,10 +473,12 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 
 	log.Info("flush segment with meta", zap.Int64("segment id", req.SegmentID),
 		zap.Any("meta", req.GetField2BinlogPaths()))
+	s.meta.SampleDeleteSLAMarkers(segment, req.GetDeltalogs())
 
 	if req.GetFlushed() {
 		s.segmentManager.DropSegment(ctx, req.SegmentID)
 		s.flushCh <- req.SegmentID
+		s.meta.segmentPKIndex.notifyFlushed(s.meta.GetSegment(segmentID))
 
 		if !req.Importing && Params.DataCoordCfg.EnableCompaction.GetAsBool() {
 			err = s.compactionTrigger.triggerSingleCompaction(segment.GetCollectionID(), segment.GetPartitionID(),
@@ -545,7 +566,7 @@ func (s *Server) SetSegmentState(ctx context.Context, req *datapb.SetSegmentStat
 			},
 		}, nil
 	}
-	err := s.meta.SetState(req.GetSegmentId(), req.GetNewState())
+	err := s.meta.SetState(req.GetSegmentId(), req.GetNewState(), segmentCauseSetSegmentState)
 	if err != nil {
 		log.Error("failed to updated segment state in dataCoord meta",
 			zap.Int64("segment ID", req.SegmentId),
@@ -640,11 +661,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		flushedIDs.Insert(channelInfo.GetFlushedSegmentIds()...)
 	}
 
-	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
-	segment2StatsBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
-	segment2DeltaBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
-	segment2InsertChannel := make(map[UniqueID]string)
-	segmentsNumOfRows := make(map[UniqueID]int64)
+	segments := make([]*SegmentInfo, 0, len(flushedIDs))
 	for id := range flushedIDs {
 		segment := s.meta.GetSegmentUnsafe(id)
 		if segment == nil {
@@ -653,85 +670,98 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			resp.Status.Reason = errMsg
 			return resp, nil
 		}
-		// Skip non-flushing, non-flushed and dropped segments.
-		if segment.State != commonpb.SegmentState_Flushed && segment.State != commonpb.SegmentState_Flushing && segment.State != commonpb.SegmentState_Dropped {
-			continue
-		}
-		// Also skip bulk insert & fake segments.
-		if segment.GetIsImporting() || segment.GetIsFake() {
-			continue
-		}
-		segment2InsertChannel[segment.ID] = segment.InsertChannel
-		binlogs := segment.GetBinlogs()
-
-		if len(binlogs) == 0 {
-			flushedIDs.Remove(id)
-			continue
-		}
+		segments = append(segments, segment)
+	}
 
-		field2Binlog := make(map[UniqueID][]*datapb.Binlog)
-		for _, field := range binlogs {
-			field2Binlog[field.GetFieldID()] = append(field2Binlog[field.GetFieldID()], field.GetBinlogs()...)
+	var binlogs []*datapb.SegmentBinlogs
+	if Params.DataCoordCfg.RecoveryInfoCacheEnabled.GetAsBool() && s.recoveryInfoCache != nil {
+		// a client knowing the version of a previous response is answered with the segments changed after it only
+		since := req.GetSince()
+		delta := since != nil && s.recoveryInfoCache.knows(since.GetServerID(), since.GetVersion())
+		var version int64
+		binlogs, version = s.recoveryInfoCache.get(collectionID, partitionID, segments, since.GetVersion(), delta, buildSegmentBinlogs)
+		resp.Version = &datapb.RecoveryInfoVersion{
+			ServerID: s.recoveryInfoCache.serverID,
+			Version:  version,
 		}
-
-		for f, paths := range field2Binlog {
-			fieldBinlogs := &datapb.FieldBinlog{
-				FieldID: f,
-				Binlogs: paths,
+		resp.Delta = delta
+		log.Info("get recovery info from cache", zap.Int("segments", len(segments)),
+			zap.Int("answered", len(binlogs)), zap.Bool("delta", delta), zap.Int64("version", version))
+	} else {
+		binlogs = make([]*datapb.SegmentBinlogs, 0, len(segments))
+		for _, segment := range segments {
+			if sbl := buildSegmentBinlogs(segment); sbl != nil {
+				binlogs = append(binlogs, sbl)
 			}
-			segment2Binlogs[id] = append(segment2Binlogs[id], fieldBinlogs)
 		}
+	}
 
-		if newCount := segmentutil.CalcRowCountFromBinLog(segment.SegmentInfo); newCount != segment.NumOfRows {
-			log.Warn("segment row number meta inconsistent with bin log row count and will be corrected",
-				zap.Int64("segment ID", segment.GetID()),
-				zap.Int64("segment meta row count (wrong)", segment.GetNumOfRows()),
-				zap.Int64("segment bin log row count (correct)", newCount))
-			segmentsNumOfRows[id] = newCount
-		} else {
-			segmentsNumOfRows[id] = segment.NumOfRows
-		}
+	resp.Channels = channelInfos
+	resp.Binlogs = binlogs
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
 
-		statsBinlogs := segment.GetStatslogs()
-		field2StatsBinlog := make(map[UniqueID][]*datapb.Binlog)
-		for _, field := range statsBinlogs {
-			field2StatsBinlog[field.GetFieldID()] = append(field2StatsBinlog[field.GetFieldID()], field.GetBinlogs()...)
-		}
+// buildSegmentBinlogs assembles the binlogs of a flushed segment answered by GetRecoveryInfo, returns nil if the
+// segment has no binlogs. The non-flushing, non-flushed and dropped segments, the bulk insert and the fake ones are
+// answered without binlogs.
+func buildSegmentBinlogs(segment *SegmentInfo) *datapb.SegmentBinlogs {
+	sbl := &datapb.SegmentBinlogs{SegmentID: segment.GetID()}
+	// Skip non-flushing, non-flushed and dropped segments.
+	if segment.State != commonpb.SegmentState_Flushed && segment.State != commonpb.SegmentState_Flushing && segment.State != commonpb.SegmentState_Dropped {
+		return sbl
+	}
+	// Also skip bulk insert & fake segments.
+	if segment.GetIsImporting() || segment.GetIsFake() {
+		return sbl
+	}
+	binlogs := segment.GetBinlogs()
+	if len(binlogs) == 0 {
+		return nil
+	}
+	sbl.InsertChannel = segment.InsertChannel
 
-		for f, paths := range field2StatsBinlog {
-			fieldBinlogs := &datapb.FieldBinlog{
-				FieldID: f,
-				Binlogs: paths,
-			}
-			segment2StatsBinlogs[id] = append(segment2StatsBinlogs[id], fieldBinlogs)
-		}
+	field2Binlog := make(map[UniqueID][]*datapb.Binlog)
+	for _, field := range binlogs {
+		field2Binlog[field.GetFieldID()] = append(field2Binlog[field.GetFieldID()], field.GetBinlogs()...)
+	}
+	for f, paths := range field2Binlog {
+		sbl.FieldBinlogs = append(sbl.FieldBinlogs, &datapb.FieldBinlog{
+			FieldID: f,
+			Binlogs: paths,
+		})
+	}
 
-		if len(segment.GetDeltalogs()) > 0 {
-			segment2DeltaBinlogs[id] = append(segment2DeltaBinlogs[id], segment.GetDeltalogs()...)
-		}
+	if newCount := segmentutil.CalcRowCountFromBinLog(segment.SegmentInfo); newCount != segment.NumOfRows {
+		log.Warn("segment row number meta inconsistent with bin log row count and will be corrected",
+			zap.Int64("segment ID", segment.GetID()),
+			zap.Int64("segment meta row count (wrong)", segment.GetNumOfRows()),
+			zap.Int64("segment bin log row count (correct)", newCount))
+		sbl.NumOfRows = newCount
+	} else {
+		sbl.NumOfRows = segment.NumOfRows
 	}
 
-	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
-	for segmentID := range flushedIDs {
-		sbl := &datapb.SegmentBinlogs{
-			SegmentID:     segmentID,
-			NumOfRows:     segmentsNumOfRows[segmentID],
-			FieldBinlogs:  segment2Binlogs[segmentID],
-			Statslogs:     segment2StatsBinlogs[segmentID],
-			Deltalogs:     segment2DeltaBinlogs[segmentID],
-			InsertChannel: segment2InsertChannel[segmentID],
-		}
-		binlogs = append(binlogs, sbl)
+	field2StatsBinlog := make(map[UniqueID][]*datapb.Binlog)
+	for _, field := range segment.GetStatslogs() {
+		field2StatsBinlog[field.GetFieldID()] = append(field2StatsBinlog[field.GetFieldID()], field.GetBinlogs()...)
+	}
+	for f, paths := range field2StatsBinlog {
+		sbl.Statslogs = append(sbl.Statslogs, &datapb.FieldBinlog{
+			FieldID: f,
+			Binlogs: paths,
+		})
 	}
 
-	resp.Channels = channelInfos
-	resp.Binlogs = binlogs
-	resp.Status.ErrorCode = commonpb.ErrorCode_Success
-	return resp, nil
+	if len(segment.GetDeltalogs()) > 0 {
+		sbl.Deltalogs = append(sbl.Deltalogs, segment.GetDeltalogs()...)
+	}
+	return sbl
 }
 
 // GetFlushedSegments returns all segment matches provided criterion and in state Flushed or Dropped (compacted but not GCed yet)
 // If requested partition id < 0, ignores the partition id filter
+// If the request asks for a page, only the page of the segment ids from the cursor is returned
 func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	resp := &datapb.GetFlushedSegmentsResponse{
 		Status: &commonpb.Status{
@@ -743,6 +773,8 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	log.Info("received get flushed segments request",
 		zap.Int64("collectionID", collectionID),
 		zap.Int64("partitionID", partitionID),
+		zap.Int64("pageSize", req.GetPageSize()),
+		zap.Int64("cursor", req.GetCursor()),
 	)
 	if s.isClosed() {
 		resp.Status.Reason = serverNotServingErrMsg
@@ -769,6 +801,9 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 		}
 		ret = append(ret, id)
 	}
+	if req.GetPageSize() > 0 {
+		ret, resp.HasMore = segmentutil.PageSegmentIDs(ret, int(req.GetPageSize()), req.GetCursor())
+	}
 
 	resp.Segments = ret
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
@@ -836,14 +871,7 @@ func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowCon
 		}, nil
 	}
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "datacoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "datacoord", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -912,6 +940,18 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.SegmentLocksMetrics {
+		return s.getSegmentLocksMetrics(), nil
+	}
+
+	if metricType == metricsinfo.StorageUsageMetrics {
+		return s.getStorageUsageMetrics(), nil
+	}
+
+	if metricType == metricsinfo.SegmentHeatMetrics {
+		return s.getSegmentHeatMetrics(req), nil
+	}
+
 	log.RatedWarn(60.0, "DataCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
@@ -927,6 +967,20 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 	}, nil
 }
 
+// checkManualCompaction returns the reason why the collection can't be compacted manually now, or nil.
+func (s *Server) checkManualCompaction(collectionID UniqueID) error {
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return errors.New("compaction disabled")
+	}
+	if s.freezeManager.IsFrozen(collectionID) {
+		return fmt.Errorf("compaction of collection %d is frozen", collectionID)
+	}
+	if s.meta.collectionPauses.IsCompactionPaused(collectionID) {
+		return fmt.Errorf("compaction of collection %d is paused", collectionID)
+	}
+	return nil
+}
+
 // ManualCompaction triggers a compaction for a collection
 func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	log.Info("received manual compaction", zap.Int64("collectionID", req.GetCollectionID()))
@@ -944,8 +998,8 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		return resp, nil
 	}
 
-	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
-		resp.Status.Reason = "compaction disabled"
+	if err := s.checkManualCompaction(req.GetCollectionID()); err != nil {
+		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
 
@@ -1162,6 +1216,13 @@ func (s *Server) Import(ctx context.Context, itr *datapb.ImportTaskRequest) (*da
 		return resp, nil
 	}
 
+	if status := s.checkStorageQuota(itr.GetImportTask().GetCollectionId()); status != nil {
+		log.Warn("import denied", zap.Int64("collectionID", itr.GetImportTask().GetCollectionId()),
+			zap.String("reason", status.GetReason()))
+		resp.Status = status
+		return resp, nil
+	}
+
 	nodes := s.sessionManager.getLiveNodeIDs()
 	if len(nodes) == 0 {
 		log.Error("import failed as all DataNodes are offline")
@@ -1229,6 +1290,77 @@ func (s *Server) UpdateChannelCheckpoint(ctx context.Context, req *datapb.Update
 	}, nil
 }
 
+// maxChannelCheckpointsPerTxn is the max number of channel checkpoints saved in one etcd transaction.
+const maxChannelCheckpointsPerTxn = 128
+
+// UpdateChannelCheckpoints updates the checkpoints of several vchannels, the valid checkpoints are
+// persisted in one etcd transaction per maxChannelCheckpointsPerTxn vchannels. The returned statuses
+// report the result of each vchannel, so a caller can retry only the failed ones.
+func (s *Server) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	resp := &datapb.UpdateChannelCheckpointsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		log.Warn("failed to update channel positions for closed server")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	statuses := make(map[string]*commonpb.Status, len(req.GetCheckpoints()))
+	positions := make(map[string]*internalpb.MsgPosition, len(req.GetCheckpoints()))
+	for _, cp := range req.GetCheckpoints() {
+		vChannel := cp.GetVChannel()
+		switch {
+		case vChannel == "":
+			log.Warn("skip channel checkpoint without vChannel", zap.Any("position", cp.GetPosition()))
+			continue
+		case cp.GetPosition() == nil || cp.GetPosition().GetMsgID() == nil:
+			statuses[vChannel] = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    fmt.Sprintf("invalid channel checkpoint, vChannel=%s", vChannel),
+			}
+			delete(positions, vChannel)
+			continue
+		}
+		if _, ok := statuses[vChannel]; ok {
+			// an invalid checkpoint of the vchannel fails the whole vchannel
+			continue
+		}
+		// keep the latest one if a vchannel shows up more than once
+		if old, ok := positions[vChannel]; !ok || old.GetTimestamp() < cp.GetPosition().GetTimestamp() {
+			positions[vChannel] = cp.GetPosition()
+		}
+	}
+
+	batch := make(map[string]*internalpb.MsgPosition, maxChannelCheckpointsPerTxn)
+	saveBatch := func() {
+		if len(batch) == 0 {
+			return
+		}
+		status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+		if err := s.meta.UpdateChannelCheckpoints(batch); err != nil {
+			log.Warn("failed to UpdateChannelCheckpoints", zap.Int("channelNum", len(batch)), zap.Error(err))
+			status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()}
+		}
+		for vChannel := range batch {
+			statuses[vChannel] = status
+		}
+		batch = make(map[string]*internalpb.MsgPosition, maxChannelCheckpointsPerTxn)
+	}
+	for vChannel, pos := range positions {
+		batch[vChannel] = pos
+		if len(batch) >= maxChannelCheckpointsPerTxn {
+			saveBatch()
+		}
+	}
+	saveBatch()
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.ChannelStatuses = statuses
+	return resp, nil
+}
+
 // getDiff returns the difference of base and remove. i.e. all items that are in `base` but not in `remove`.
 func getDiff(base, remove []int64) []int64 {
 	mb := make(map[int64]struct{}, len(remove))
@@ -1310,6 +1442,7 @@ func (s *Server) SaveImportSegment(ctx context.Context, req *datapb.SaveImportSe
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
 		}, nil
 	}
+	s.createIndexesForImportSegment(req.GetSegmentId())
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
@@ -1345,7 +1478,7 @@ func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 		zap.Int64s("segments", req.GetSegmentIds()))
 	failure := false
 	for _, segID := range req.GetSegmentIds() {
-		if err := s.meta.SetState(segID, commonpb.SegmentState_Dropped); err != nil {
+		if err := s.meta.SetState(segID, commonpb.SegmentState_Dropped, segmentCauseMarkSegmentsDrop); err != nil {
 			// Fail-open.
 			log.Error("failed to set segment state as dropped", zap.Int64("segment ID", segID))
 			failure = true
@@ -1437,9 +1570,11 @@ func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthReque
 	}
 
 	err := group.Wait()
+	// frozen windows are reported but don't make datacoord unhealthy
+	freezeReasons := s.freezeWindowReasons()
 	if err != nil || len(errReasons) != 0 {
-		return &milvuspb.CheckHealthResponse{IsHealthy: false, Reasons: errReasons}, nil
+		return &milvuspb.CheckHealthResponse{IsHealthy: false, Reasons: append(errReasons, freezeReasons...)}, nil
 	}
 
-	return &milvuspb.CheckHealthResponse{IsHealthy: true, Reasons: errReasons}, nil
+	return &milvuspb.CheckHealthResponse{IsHealthy: true, Reasons: append(errReasons, freezeReasons...)}, nil
 }
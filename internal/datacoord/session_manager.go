@@ -183,6 +183,16 @@ func (c *SessionManager) SyncSegments(nodeID int64, req *datapb.SyncSegmentsRequ
 	return nil
 }
 
+// Decommission is a grpc interface. It will send request to DataNode with provided `nodeID` synchronously.
+func (c *SessionManager) Decommission(ctx context.Context, nodeID int64, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return nil, err
+	}
+	return cli.Decommission(ctx, req)
+}
+
 // Import is a grpc interface. It will send request to DataNode with provided `nodeID` asynchronously.
 func (c *SessionManager) Import(ctx context.Context, nodeID int64, itr *datapb.ImportTaskRequest) {
 	go c.execImport(ctx, nodeID, itr)
@@ -235,11 +245,16 @@ func (c *SessionManager) execReCollectSegmentStats(ctx context.Context, nodeID i
 	}
 }
 
-func (c *SessionManager) GetCompactionState() map[int64]*datapb.CompactionStateResult {
+// GetCompactionState returns the compaction states of all DataNodes by plan ID, a completed plan whose merged
+// rows are split has a result for each of its compactedTo segments.
+func (c *SessionManager) GetCompactionState() map[int64][]*datapb.CompactionStateResult {
 	wg := sync.WaitGroup{}
 	ctx := context.Background()
 
-	plans := sync.Map{}
+	var (
+		mu    sync.Mutex
+		plans = make(map[int64][]*datapb.CompactionStateResult)
+	)
 	c.sessions.RLock()
 	for nodeID, s := range c.sessions.data {
 		wg.Add(1)
@@ -267,21 +282,17 @@ func (c *SessionManager) GetCompactionState() map[int64]*datapb.CompactionStateR
 				log.Info("Get State failed", zap.String("Reason", resp.GetStatus().GetReason()))
 				return
 			}
+			mu.Lock()
+			defer mu.Unlock()
 			for _, rst := range resp.GetResults() {
-				plans.Store(rst.PlanID, rst)
+				plans[rst.PlanID] = append(plans[rst.PlanID], rst)
 			}
 		}(nodeID, s)
 	}
 	c.sessions.RUnlock()
 	wg.Wait()
 
-	rst := make(map[int64]*datapb.CompactionStateResult)
-	plans.Range(func(key, value any) bool {
-		rst[key.(int64)] = value.(*datapb.CompactionStateResult)
-		return true
-	})
-
-	return rst
+	return plans
 }
 
 func (c *SessionManager) getClient(ctx context.Context, nodeID int64) (types.DataNode, error) {
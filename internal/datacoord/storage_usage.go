@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// storageUsageRefreshInterval is the max staleness of the storage usages checked against the collection quota.
+var storageUsageRefreshInterval = 10 * time.Second
+
+// collectionStorageUsage is the object storage bytes used by a collection, the segments compacted or dropped
+// are accounted until they are garbage collected.
+type collectionStorageUsage struct {
+	CollectionID  UniqueID `json:"collection_id"`
+	BinlogSize    int64    `json:"binlog_size"`
+	StatslogSize  int64    `json:"statslog_size"`
+	DeltalogSize  int64    `json:"deltalog_size"`
+	IndexSize     int64    `json:"index_size"`
+	TotalSize     int64    `json:"total_size"`
+	QuotaExceeded bool     `json:"quota_exceeded"`
+}
+
+func sumLogSize(fieldBinlogs []*datapb.FieldBinlog) int64 {
+	var size int64
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			size += binlog.GetLogSize()
+		}
+	}
+	return size
+}
+
+// GetCollectionStorageUsages returns the object storage usages of all the collections in meta.
+func (m *meta) GetCollectionStorageUsages() map[UniqueID]*collectionStorageUsage {
+	m.RLock()
+	defer m.RUnlock()
+	usages := make(map[UniqueID]*collectionStorageUsage)
+	for _, segment := range m.segments.GetSegments() {
+		usage, ok := usages[segment.GetCollectionID()]
+		if !ok {
+			usage = &collectionStorageUsage{CollectionID: segment.GetCollectionID()}
+			usages[segment.GetCollectionID()] = usage
+		}
+		usage.BinlogSize += sumLogSize(segment.GetBinlogs())
+		usage.StatslogSize += sumLogSize(segment.GetStatslogs())
+		usage.DeltalogSize += sumLogSize(segment.GetDeltalogs())
+		for _, segIdx := range segment.segmentIndexes {
+			usage.IndexSize += int64(segIdx.IndexSize)
+		}
+	}
+	quota := Params.QuotaConfig.DiskQuotaPerCollection.GetAsFloat()
+	for _, usage := range usages {
+		usage.TotalSize = usage.BinlogSize + usage.StatslogSize + usage.DeltalogSize + usage.IndexSize
+		usage.QuotaExceeded = float64(usage.TotalSize) >= quota
+	}
+	return usages
+}
+
+// storageUsageCache caches the storage usages of the collections for the quota checks on the write paths,
+// the usages are accounted again once they are older than storageUsageRefreshInterval.
+type storageUsageCache struct {
+	meta *meta
+
+	mu        sync.Mutex
+	usages    map[UniqueID]*collectionStorageUsage
+	updatedAt time.Time
+}
+
+func newStorageUsageCache(meta *meta) *storageUsageCache {
+	return &storageUsageCache{meta: meta}
+}
+
+func (c *storageUsageCache) get(collectionID UniqueID) *collectionStorageUsage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.usages == nil || time.Since(c.updatedAt) >= storageUsageRefreshInterval {
+		c.usages = c.meta.GetCollectionStorageUsages()
+		c.updatedAt = time.Now()
+	}
+	return c.usages[collectionID]
+}
+
+// checkStorageQuota returns a ForceDeny status if the collection uses more object storage than
+// quotaAndLimits.limitWriting.diskProtection.diskQuotaPerCollection, nil otherwise.
+func (s *Server) checkStorageQuota(collectionID UniqueID) *commonpb.Status {
+	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() || s.storageUsage == nil {
+		return nil
+	}
+	usage := s.storageUsage.get(collectionID)
+	if usage == nil || !usage.QuotaExceeded {
+		return nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_ForceDeny,
+		Reason: fmt.Sprintf("deny to write, reason: storage quota of collection %d exceeded, used %d bytes, quota %.0f bytes",
+			collectionID, usage.TotalSize, Params.QuotaConfig.DiskQuotaPerCollection.GetAsFloat()),
+	}
+}
+
+// getStorageUsageMetrics returns the object storage usages of the collections in json.
+func (s *Server) getStorageUsageMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.DataCoordRole, paramtable.GetNodeID())
+	usages := s.meta.GetCollectionStorageUsages()
+	ret := make([]*collectionStorageUsage, 0, len(usages))
+	for _, usage := range usages {
+		ret = append(ret, usage)
+	}
+	resp, err := json.Marshal(ret)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newStorageUsageTestMeta(t *testing.T) *meta {
+	m, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "", nil)
+	require.NoError(t, err)
+	logs := func(size int64) []*datapb.FieldBinlog {
+		return []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: size}}}}
+	}
+	seg1 := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           1,
+		CollectionID: 10,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      logs(1 << 20),
+		Statslogs:    logs(100),
+		Deltalogs:    logs(200),
+	})
+	seg1.segmentIndexes[1000] = &model.SegmentIndex{SegmentID: 1, IndexID: 1000, IndexSize: 300}
+	// dropped but not garbage collected yet
+	seg2 := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           2,
+		CollectionID: 10,
+		State:        commonpb.SegmentState_Dropped,
+		Binlogs:      logs(400),
+	})
+	seg3 := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           3,
+		CollectionID: 20,
+		State:        commonpb.SegmentState_Growing,
+		Binlogs:      logs(500),
+	})
+	for _, seg := range []*SegmentInfo{seg1, seg2, seg3} {
+		require.NoError(t, m.AddSegment(seg))
+	}
+	return m
+}
+
+func TestMeta_GetCollectionStorageUsages(t *testing.T) {
+	paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerCollection.Key, "1")
+	defer paramtable.Get().Reset(Params.QuotaConfig.DiskQuotaPerCollection.Key)
+
+	usages := newStorageUsageTestMeta(t).GetCollectionStorageUsages()
+	assert.Equal(t, 2, len(usages))
+	assert.Equal(t, &collectionStorageUsage{
+		CollectionID:  10,
+		BinlogSize:    1<<20 + 400,
+		StatslogSize:  100,
+		DeltalogSize:  200,
+		IndexSize:     300,
+		TotalSize:     1<<20 + 1000,
+		QuotaExceeded: true,
+	}, usages[10])
+	assert.Equal(t, int64(500), usages[20].TotalSize)
+	assert.False(t, usages[20].QuotaExceeded)
+}
+
+func TestServer_CheckStorageQuota(t *testing.T) {
+	m := newStorageUsageTestMeta(t)
+	svr := &Server{meta: m, storageUsage: newStorageUsageCache(m)}
+	paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerCollection.Key, "1")
+	defer paramtable.Get().Reset(Params.QuotaConfig.DiskQuotaPerCollection.Key)
+
+	// quota and limits disabled
+	assert.Nil(t, svr.checkStorageQuota(10))
+
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+	status := svr.checkStorageQuota(10)
+	require.NotNil(t, status)
+	assert.Equal(t, commonpb.ErrorCode_ForceDeny, status.GetErrorCode())
+	assert.Nil(t, svr.checkStorageQuota(20))
+	assert.Nil(t, svr.checkStorageQuota(30))
+
+	// the cached usages are refreshed after the interval
+	require.NoError(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           4,
+		CollectionID: 20,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 1 << 20}}}},
+	})))
+	assert.Nil(t, svr.checkStorageQuota(20))
+	svr.storageUsage.updatedAt = svr.storageUsage.updatedAt.Add(-storageUsageRefreshInterval)
+	assert.NotNil(t, svr.checkStorageQuota(20))
+
+	t.Run("AssignSegmentID denied", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.storageUsage = newStorageUsageCache(m)
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			SegmentIDRequests: []*datapb.SegmentIDRequest{{CollectionID: 10, PartitionID: 1, ChannelName: "ch", Count: 1}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		require.Equal(t, 1, len(resp.GetSegIDAssignments()))
+		assert.Equal(t, commonpb.ErrorCode_ForceDeny, resp.GetSegIDAssignments()[0].GetStatus().GetErrorCode())
+
+		flushResp, err := svr.Flush(context.TODO(), &datapb.FlushRequest{CollectionID: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_ForceDeny, flushResp.GetStatus().GetErrorCode())
+
+		importResp, err := svr.Import(context.TODO(), &datapb.ImportTaskRequest{ImportTask: &datapb.ImportTask{CollectionId: 10}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_ForceDeny, importResp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestGetStorageUsageMetrics(t *testing.T) {
+	svr := &Server{meta: newStorageUsageTestMeta(t)}
+	resp := svr.getStorageUsageMetrics()
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	var usages []*collectionStorageUsage
+	require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &usages))
+	assert.Equal(t, 2, len(usages))
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// UpdateCompactionTravelWatermark raises the watermark of the collection to the travel timestamp of a completed compaction,
+// the history older than the travel timestamp is merged by the compaction and can't be travelled back to any more.
+func (m *meta) UpdateCompactionTravelWatermark(collectionID UniqueID, travelTs Timestamp) {
+	m.Lock()
+	defer m.Unlock()
+	if m.compactionTravelWatermarks == nil {
+		m.compactionTravelWatermarks = make(map[UniqueID]Timestamp)
+	}
+	if travelTs > m.compactionTravelWatermarks[collectionID] {
+		m.compactionTravelWatermarks[collectionID] = travelTs
+	}
+}
+
+// GetCompactionTravelWatermarks returns the compaction travel watermarks of the collections.
+func (m *meta) GetCompactionTravelWatermarks() map[UniqueID]Timestamp {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make(map[UniqueID]Timestamp, len(m.compactionTravelWatermarks))
+	for collectionID, ts := range m.compactionTravelWatermarks {
+		ret[collectionID] = ts
+	}
+	return ret
+}
+
+// GetTimeTravelWatermarks returns the retention duration and the compaction travel watermarks of the collections,
+// Proxy checks the travel timestamps of search and query against them.
+func (s *Server) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	if s.isClosed() {
+		return &datapb.GetTimeTravelWatermarksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	return &datapb.GetTimeTravelWatermarksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		RetentionSeconds:     Params.CommonCfg.RetentionDuration.GetAsInt64(),
+		CompactionWatermarks: s.meta.GetCompactionTravelWatermarks(),
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestMeta_CompactionTravelWatermarks(t *testing.T) {
+	m := &meta{}
+	assert.Empty(t, m.GetCompactionTravelWatermarks())
+
+	m.UpdateCompactionTravelWatermark(1, 100)
+	m.UpdateCompactionTravelWatermark(1, 50)
+	m.UpdateCompactionTravelWatermark(2, 10)
+	assert.Equal(t, map[UniqueID]Timestamp{1: 100, 2: 10}, m.GetCompactionTravelWatermarks())
+}
+
+func TestServer_GetTimeTravelWatermarks(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.UpdateCompactionTravelWatermark(1, 100)
+
+		resp, err := svr.GetTimeTravelWatermarks(context.TODO(), &datapb.GetTimeTravelWatermarksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, Params.CommonCfg.RetentionDuration.GetAsInt64(), resp.GetRetentionSeconds())
+		assert.Equal(t, map[int64]uint64{1: 100}, resp.GetCompactionWatermarks())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.GetTimeTravelWatermarks(context.TODO(), &datapb.GetTimeTravelWatermarksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
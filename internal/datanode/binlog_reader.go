@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+type binlogReadResult struct {
+	blobs []*Blob
+	err   error
+}
+
+// binlogReader reads groups of binlogs in order. With a positive readahead, at most readahead groups are
+// downloaded in parallel ahead of the group being read, so the merging of a group overlaps the downloading
+// of the following ones instead of waiting for the object storage after each group.
+type binlogReader struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	downloader downloader
+	paths      [][]string
+	next       int
+
+	// results[i] receives the download result of paths[i], slots bounds the groups downloaded but not read yet.
+	results []chan binlogReadResult
+	slots   chan struct{}
+}
+
+func newBinlogReader(ctx context.Context, d downloader, paths [][]string, readahead int) *binlogReader {
+	ctx, cancel := context.WithCancel(ctx)
+	r := &binlogReader{
+		ctx:        ctx,
+		cancel:     cancel,
+		downloader: d,
+		paths:      paths,
+	}
+	if readahead <= 0 {
+		return r
+	}
+
+	r.results = make([]chan binlogReadResult, len(paths))
+	for i := range r.results {
+		r.results[i] = make(chan binlogReadResult, 1)
+	}
+	r.slots = make(chan struct{}, readahead)
+	r.wg.Add(1)
+	go r.prefetch()
+	return r
+}
+
+func (r *binlogReader) prefetch() {
+	defer r.wg.Done()
+	for i := range r.paths {
+		select {
+		case <-r.ctx.Done():
+			return
+		case r.slots <- struct{}{}:
+		}
+
+		r.wg.Add(1)
+		go func(i int) {
+			defer r.wg.Done()
+			blobs, err := r.downloader.download(r.ctx, r.paths[i])
+			r.results[i] <- binlogReadResult{blobs: blobs, err: err}
+		}(i)
+	}
+}
+
+// Next returns the blobs of the next group of binlogs, io.EOF is returned after all the groups are read.
+func (r *binlogReader) Next() ([]*Blob, error) {
+	if r.next >= len(r.paths) {
+		return nil, io.EOF
+	}
+	i := r.next
+	r.next++
+
+	if r.slots == nil {
+		return r.downloader.download(r.ctx, r.paths[i])
+	}
+	select {
+	case <-r.ctx.Done():
+		return nil, errDownloadFromBlobStorage
+	case result := <-r.results[i]:
+		<-r.slots
+		return result.blobs, result.err
+	}
+}
+
+// Close stops the prefetching and waits for the inflight downloads to quit.
+func (r *binlogReader) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+type slowDownloader struct {
+	delay    time.Duration
+	failPath string
+
+	inflight    atomic.Int32
+	maxInflight atomic.Int32
+	mu          sync.Mutex
+	downloaded  []string
+}
+
+func (d *slowDownloader) download(ctx context.Context, paths []string) ([]*Blob, error) {
+	n := d.inflight.Inc()
+	defer d.inflight.Dec()
+	for {
+		max := d.maxInflight.Load()
+		if n <= max || d.maxInflight.CAS(max, n) {
+			break
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, errDownloadFromBlobStorage
+	case <-time.After(d.delay):
+	}
+	d.mu.Lock()
+	d.downloaded = append(d.downloaded, paths...)
+	d.mu.Unlock()
+	if strings.Join(paths, ",") == d.failPath {
+		return nil, errors.New("mock download error")
+	}
+	blobs := make([]*Blob, 0, len(paths))
+	for _, p := range paths {
+		blobs = append(blobs, &Blob{Key: p, Value: []byte(p)})
+	}
+	return blobs, nil
+}
+
+func TestBinlogReader(t *testing.T) {
+	paths := [][]string{{"a1", "a2"}, {"b1", "b2"}, {"c1", "c2"}, {"d1", "d2"}, {"e1", "e2"}}
+
+	readAll := func(r *binlogReader) []string {
+		var keys []string
+		for {
+			blobs, err := r.Next()
+			if err == io.EOF {
+				return keys
+			}
+			require.NoError(t, err)
+			for _, b := range blobs {
+				keys = append(keys, b.Key)
+			}
+		}
+	}
+
+	t.Run("no readahead", func(t *testing.T) {
+		d := &slowDownloader{}
+		r := newBinlogReader(context.Background(), d, paths, 0)
+		defer r.Close()
+		assert.Equal(t, []string{"a1", "a2", "b1", "b2", "c1", "c2", "d1", "d2", "e1", "e2"}, readAll(r))
+		assert.Equal(t, int32(1), d.maxInflight.Load())
+	})
+
+	t.Run("readahead in order", func(t *testing.T) {
+		d := &slowDownloader{delay: 10 * time.Millisecond}
+		r := newBinlogReader(context.Background(), d, paths, 3)
+		defer r.Close()
+		assert.Equal(t, []string{"a1", "a2", "b1", "b2", "c1", "c2", "d1", "d2", "e1", "e2"}, readAll(r))
+		assert.LessOrEqual(t, d.maxInflight.Load(), int32(3))
+		assert.Greater(t, d.maxInflight.Load(), int32(1))
+	})
+
+	t.Run("readahead bounded by reading", func(t *testing.T) {
+		d := &slowDownloader{}
+		r := newBinlogReader(context.Background(), d, paths, 2)
+		defer r.Close()
+		assert.Eventually(t, func() bool {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			return len(d.downloaded) == 4
+		}, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		d.mu.Lock()
+		assert.Equal(t, 4, len(d.downloaded))
+		d.mu.Unlock()
+
+		_, err := r.Next()
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			return len(d.downloaded) == 6
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("download error", func(t *testing.T) {
+		d := &slowDownloader{failPath: "b1,b2"}
+		r := newBinlogReader(context.Background(), d, paths, 2)
+		defer r.Close()
+		_, err := r.Next()
+		assert.NoError(t, err)
+		_, err = r.Next()
+		assert.Error(t, err)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		d := &slowDownloader{delay: time.Minute}
+		r := newBinlogReader(ctx, d, paths, 2)
+		cancel()
+		_, err := r.Next()
+		assert.ErrorIs(t, err, errDownloadFromBlobStorage)
+		r.Close()
+		assert.Equal(t, int32(0), d.inflight.Load())
+	})
+}
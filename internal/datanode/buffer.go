@@ -163,6 +163,36 @@ func (bm *DelBufferManager) CompactSegBuf(compactedToSegID UniqueID, compactedFr
 	}
 }
 
+// CopySegBuf copies the delete buffers of the compacted segments to a split target of the compaction,
+// unlike CompactSegBuf the copied deletes are added to the memory size as they are flushed once more.
+func (bm *DelBufferManager) CopySegBuf(splitToSegID UniqueID, compactedFromSegIDs []UniqueID) {
+	splitToDelBuff, loaded := bm.Load(splitToSegID)
+	if !loaded {
+		splitToDelBuff = newDelDataBuf()
+		splitToDelBuff.item.segmentID = splitToSegID
+	}
+
+	var copiedSize int64
+	for _, segID := range compactedFromSegIDs {
+		if delDataBuf, loaded := bm.Load(segID); loaded {
+			splitToDelBuff.mergeDelDataBuf(delDataBuf)
+			copiedSize += delDataBuf.item.memorySize
+		}
+	}
+	if splitToDelBuff.EntriesNum == 0 {
+		return
+	}
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	if loaded {
+		bm.delBufHeap.update(splitToDelBuff.item, splitToDelBuff.item.memorySize)
+	} else {
+		heap.Push(bm.delBufHeap, splitToDelBuff.item)
+	}
+	bm.delMemorySize += copiedSize
+	bm.channel.setCurDeleteBuffer(splitToSegID, splitToDelBuff)
+}
+
 func (bm *DelBufferManager) ShouldFlushSegments() []UniqueID {
 	bm.mu.Lock()
 	defer bm.mu.Unlock()
@@ -199,3 +199,38 @@ func Test_CompactSegBuff(t *testing.T) {
 	})
 	assert.Equal(t, Timestamp(200), cp.Timestamp) // evict all buffer, use ttPos as cp
 }
+
+func Test_CopySegBuf(t *testing.T) {
+	channelSegments := make(map[UniqueID]*Segment)
+	delBufferManager := &DelBufferManager{
+		channel: &ChannelMeta{
+			segments: channelSegments,
+		},
+		delMemorySize: 0,
+		delBufHeap:    &PriorityQueue{},
+	}
+	var segID1 UniqueID = 1111
+	var splitToSegID UniqueID = 3333
+	channelSegments[segID1] = &Segment{}
+	channelSegments[splitToSegID] = &Segment{}
+
+	delDataBuf1 := newDelDataBuf()
+	delDataBuf1.EntriesNum++
+	delDataBuf1.item.memorySize = 16
+	delDataBuf1.updateStartAndEndPosition(&internalpb.MsgPosition{Timestamp: 10}, &internalpb.MsgPosition{Timestamp: 50})
+	delBufferManager.Store(segID1, delDataBuf1)
+	heap.Push(delBufferManager.delBufHeap, delDataBuf1.item)
+	delBufferManager.delMemorySize = 16
+
+	// the deletes are copied to the split target and kept in the compacted segment
+	delBufferManager.CopySegBuf(splitToSegID, []UniqueID{segID1})
+	assert.Equal(t, int64(1), delBufferManager.GetEntriesNum(segID1))
+	assert.Equal(t, int64(1), delBufferManager.GetEntriesNum(splitToSegID))
+	assert.Equal(t, int64(16), delBufferManager.GetSegDelBufMemSize(splitToSegID))
+	assert.Equal(t, int64(32), delBufferManager.delMemorySize)
+
+	// nothing to copy
+	delBufferManager.CopySegBuf(4444, []UniqueID{5555})
+	_, ok := delBufferManager.Load(4444)
+	assert.False(t, ok)
+}
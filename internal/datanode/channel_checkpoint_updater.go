@@ -0,0 +1,302 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// channelCheckpointUpdater aggregates the channel checkpoint updates of all the vchannels on the node,
+// the updates within a batch window are sent to DataCoord in one request, and only the latest position
+// of each vchannel is sent, so that a node with hundreds of vchannels doesn't flood DataCoord.
+type channelCheckpointUpdater struct {
+	dn *DataNode
+
+	mu      sync.Mutex
+	pending map[string]*internalpb.MsgPosition // vchannel name -> latest checkpoint
+	// lastFallback is the last time the checkpoint of each vchannel is persisted to object storage
+	lastFallback map[string]time.Time
+	// released are the vchannels removed since the current flush started, their failed checkpoints are dropped
+	released typeutil.Set[string]
+	// batchUnsupported is set if DataCoord doesn't support UpdateChannelCheckpoints, which is an older version
+	batchUnsupported atomic.Bool
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newChannelCheckpointUpdater(dn *DataNode) *channelCheckpointUpdater {
+	return &channelCheckpointUpdater{
+		dn:           dn,
+		pending:      make(map[string]*internalpb.MsgPosition),
+		lastFallback: make(map[string]time.Time),
+		released:     typeutil.NewSet[string](),
+		closeCh:      make(chan struct{}),
+	}
+}
+
+func (u *channelCheckpointUpdater) start() {
+	u.wg.Add(1)
+	go func() {
+		defer u.wg.Done()
+		window := Params.DataNodeCfg.ChannelCheckpointBatchWindow.GetAsDuration(time.Millisecond)
+		if window <= 0 {
+			window = time.Second
+		}
+		ticker := time.NewTicker(window)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-u.closeCh:
+				log.Info("channel checkpoint updater quit")
+				return
+			case <-ticker.C:
+				u.flush()
+			}
+		}
+	}()
+}
+
+// addTask queues the checkpoint of the vchannel, a queued older checkpoint of the same vchannel is replaced.
+func (u *channelCheckpointUpdater) addTask(vChannel string, position *internalpb.MsgPosition) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.queue(vChannel, position)
+}
+
+// queue must be called with u.mu held.
+func (u *channelCheckpointUpdater) queue(vChannel string, position *internalpb.MsgPosition) {
+	if old, ok := u.pending[vChannel]; ok && old.GetTimestamp() > position.GetTimestamp() {
+		return
+	}
+	u.pending[vChannel] = position
+}
+
+// removeTask drops the queued checkpoint of the vchannel, called when the vchannel is released.
+func (u *channelCheckpointUpdater) removeTask(vChannel string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.pending, vChannel)
+	delete(u.lastFallback, vChannel)
+	u.released.Insert(vChannel)
+}
+
+func (u *channelCheckpointUpdater) taskNum() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.pending)
+}
+
+// flush sends the queued checkpoints to DataCoord, the failed ones are queued again
+// unless a newer checkpoint of the vchannel arrives or the vchannel is released meanwhile.
+func (u *channelCheckpointUpdater) flush() {
+	u.mu.Lock()
+	batch := u.pending
+	u.pending = make(map[string]*internalpb.MsgPosition)
+	u.released = typeutil.NewSet[string]()
+	u.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+
+	failed := u.updateChannelCPs(batch)
+	u.mu.Lock()
+	for vChannel, position := range failed {
+		if u.released.Contain(vChannel) {
+			delete(failed, vChannel)
+			continue
+		}
+		u.queue(vChannel, position)
+	}
+	u.mu.Unlock()
+	u.persistFallback(failed)
+	log.Info("channel checkpoint updater flushed", zap.Int("channelNum", len(batch)), zap.Int("failedNum", len(failed)))
+}
+
+// updateChannelCPs sends the checkpoints to DataCoord in one request, or one by one if DataCoord doesn't support the
+// batched request yet, returns the checkpoints failed to be updated.
+func (u *channelCheckpointUpdater) updateChannelCPs(batch map[string]*internalpb.MsgPosition) map[string]*internalpb.MsgPosition {
+	if !u.batchUnsupported.Load() {
+		failed, err := u.batchUpdateChannelCPs(batch)
+		if !funcutil.IsGrpcUnimplemented(err) {
+			return failed
+		}
+		log.Warn("DataCoord doesn't support UpdateChannelCheckpoints, update the channel checkpoints one by one", zap.Error(err))
+		u.batchUnsupported.Store(true)
+	}
+	return u.updateChannelCPsOneByOne(batch)
+}
+
+func (u *channelCheckpointUpdater) batchUpdateChannelCPs(batch map[string]*internalpb.MsgPosition) (map[string]*internalpb.MsgPosition, error) {
+	checkpoints := make([]*datapb.UpdateChannelCheckpointRequest, 0, len(batch))
+	for vChannel, position := range batch {
+		checkpoints = append(checkpoints, &datapb.UpdateChannelCheckpointRequest{
+			VChannel: vChannel,
+			Position: position,
+		})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), updateChanCPTimeout)
+	defer cancel()
+	resp, err := u.dn.dataCoord.UpdateChannelCheckpoints(ctx, &datapb.UpdateChannelCheckpointsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		Checkpoints: checkpoints,
+	})
+	if err = funcutil.VerifyResponse(resp, err); err != nil {
+		log.Warn("UpdateChannelCheckpoints failed", zap.Int("channelNum", len(batch)), zap.Error(err))
+		return batch, err
+	}
+
+	failed := make(map[string]*internalpb.MsgPosition)
+	for vChannel, position := range batch {
+		status := resp.GetChannelStatuses()[vChannel]
+		switch status.GetErrorCode() {
+		case commonpb.ErrorCode_Success:
+		case commonpb.ErrorCode_IllegalArgument:
+			// retrying doesn't help
+			log.Warn("invalid channel checkpoint dropped", zap.String("channel", vChannel), zap.String("reason", status.GetReason()))
+		default:
+			log.Warn("UpdateChannelCheckpoint failed", zap.String("channel", vChannel), zap.String("reason", status.GetReason()))
+			failed[vChannel] = position
+		}
+	}
+	return failed, nil
+}
+
+func (u *channelCheckpointUpdater) updateChannelCPsOneByOne(batch map[string]*internalpb.MsgPosition) map[string]*internalpb.MsgPosition {
+	parallelism := Params.DataNodeCfg.ChannelCheckpointUpdateParallelism.GetAsInt()
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+	sem := make(chan struct{}, parallelism)
+	wg := &sync.WaitGroup{}
+	failedMut := &sync.Mutex{}
+	failed := make(map[string]*internalpb.MsgPosition)
+	for vChannel, position := range batch {
+		vChannel, position := vChannel, position
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := u.updateChannelCP(vChannel, position); err != nil {
+				failedMut.Lock()
+				failed[vChannel] = position
+				failedMut.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return failed
+}
+
+func (u *channelCheckpointUpdater) updateChannelCP(vChannel string, position *internalpb.MsgPosition) error {
+	channelCPTs, _ := tsoutil.ParseTS(position.GetTimestamp())
+	ctx, cancel := context.WithTimeout(context.Background(), updateChanCPTimeout)
+	defer cancel()
+	resp, err := u.dn.dataCoord.UpdateChannelCheckpoint(ctx, &datapb.UpdateChannelCheckpointRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		VChannel: vChannel,
+		Position: position,
+	})
+	if err = funcutil.VerifyResponse(resp, err); err != nil {
+		log.Warn("UpdateChannelCheckpoint failed", zap.String("channel", vChannel),
+			zap.Time("channelCPTs", channelCPTs), zap.Error(err))
+		return err
+	}
+	log.Debug("UpdateChannelCheckpoint success", zap.String("channel", vChannel), zap.Time("channelCPTs", channelCPTs))
+	return nil
+}
+
+// persistFallback writes the checkpoints failed to be updated to object storage, DataCoord applies them when it
+// recovers, so that the vchannels don't replay from the stale checkpoints after restarts during a DataCoord outage.
+// The checkpoint of a vchannel is written at most once per dataNode.channelCheckpoint.fallbackInterval.
+func (u *channelCheckpointUpdater) persistFallback(failed map[string]*internalpb.MsgPosition) {
+	interval := Params.DataNodeCfg.ChannelCheckpointFallbackInterval.GetAsDuration(time.Second)
+	if interval <= 0 || len(failed) == 0 || u.dn.chunkManager == nil {
+		return
+	}
+	now := time.Now()
+	toPersist := make(map[string]*internalpb.MsgPosition)
+	u.mu.Lock()
+	for vChannel, position := range failed {
+		if u.released.Contain(vChannel) {
+			continue
+		}
+		if last, ok := u.lastFallback[vChannel]; !ok || now.Sub(last) >= interval {
+			toPersist[vChannel] = position
+		}
+	}
+	u.mu.Unlock()
+
+	for vChannel, position := range toPersist {
+		value, err := proto.Marshal(position)
+		if err != nil {
+			log.Warn("failed to marshal channel checkpoint", zap.String("channel", vChannel), zap.Error(err))
+			continue
+		}
+		key := path.Join(u.dn.chunkManager.RootPath(), common.ChannelCheckpointFallbackPath, vChannel)
+		ctx, cancel := context.WithTimeout(context.Background(), updateChanCPTimeout)
+		err = u.dn.chunkManager.Write(ctx, key, value)
+		cancel()
+		if err != nil {
+			log.Warn("failed to persist channel checkpoint to object storage", zap.String("channel", vChannel), zap.Error(err))
+			continue
+		}
+		u.mu.Lock()
+		if !u.released.Contain(vChannel) {
+			u.lastFallback[vChannel] = now
+		}
+		u.mu.Unlock()
+		channelCPTs, _ := tsoutil.ParseTS(position.GetTimestamp())
+		log.Info("channel checkpoint persisted to object storage", zap.String("channel", vChannel),
+			zap.String("key", key), zap.Time("channelCPTs", channelCPTs))
+	}
+}
+
+// close stops the updater, the queued checkpoints are flushed before quit.
+func (u *channelCheckpointUpdater) close() {
+	u.closeOnce.Do(func() {
+		close(u.closeCh)
+		u.wg.Wait()
+		u.flush()
+	})
+}
@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type checkpointRecorder struct {
+	DataCoordFactory
+	mu      sync.Mutex
+	updated map[string]uint64
+	// the number of UpdateChannelCheckpoints requests
+	batchRequests int
+	// UpdateChannelCheckpoints is unimplemented, like an older DataCoord
+	batchUnimplemented bool
+	// beforeBatch is called while the UpdateChannelCheckpoints request is in flight
+	beforeBatch func(req *datapb.UpdateChannelCheckpointsRequest)
+}
+
+func (r *checkpointRecorder) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	if r.beforeBatch != nil {
+		r.beforeBatch(req)
+	}
+	if r.batchUnimplemented {
+		return nil, fmt.Errorf("err: %w", status.Error(codes.Unimplemented, "unknown method UpdateChannelCheckpoints"))
+	}
+	if r.UpdateChannelCheckpointError {
+		return r.DataCoordFactory.UpdateChannelCheckpoints(ctx, req)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batchRequests++
+	statuses := make(map[string]*commonpb.Status)
+	for _, cp := range req.GetCheckpoints() {
+		if cp.GetVChannel() == "invalid" {
+			statuses[cp.GetVChannel()] = &commonpb.Status{ErrorCode: commonpb.ErrorCode_IllegalArgument}
+			continue
+		}
+		r.updated[cp.GetVChannel()] = cp.GetPosition().GetTimestamp()
+		statuses[cp.GetVChannel()] = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	return &datapb.UpdateChannelCheckpointsResponse{
+		Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ChannelStatuses: statuses,
+	}, nil
+}
+
+func (r *checkpointRecorder) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	if r.UpdateChannelCheckpointError {
+		return r.DataCoordFactory.UpdateChannelCheckpoint(ctx, req)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updated[req.GetVChannel()] = req.GetPosition().GetTimestamp()
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func TestChannelCheckpointUpdater(t *testing.T) {
+	dc := &checkpointRecorder{updated: make(map[string]uint64)}
+	updater := newChannelCheckpointUpdater(&DataNode{dataCoord: dc})
+
+	// only the latest checkpoint of a vchannel is sent
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 100})
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 300})
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 200})
+	updater.addTask("ch-2", &internalpb.MsgPosition{ChannelName: "ch-2", Timestamp: 100})
+	updater.addTask("ch-3", &internalpb.MsgPosition{ChannelName: "ch-3", Timestamp: 100})
+	updater.removeTask("ch-3")
+	assert.Equal(t, 2, updater.taskNum())
+
+	updater.flush()
+	assert.Equal(t, 0, updater.taskNum())
+	assert.Equal(t, map[string]uint64{"ch-1": 300, "ch-2": 100}, dc.updated)
+	// the checkpoints are sent in one request
+	assert.Equal(t, 1, dc.batchRequests)
+
+	// the invalid checkpoints are not retried
+	updater.addTask("invalid", &internalpb.MsgPosition{ChannelName: "invalid", Timestamp: 100})
+	updater.flush()
+	assert.Equal(t, 0, updater.taskNum())
+
+	// the failed checkpoints are queued again, unless a newer one arrives
+	dc.UpdateChannelCheckpointError = true
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 400})
+	updater.flush()
+	assert.Equal(t, 1, updater.taskNum())
+
+	dc.UpdateChannelCheckpointError = false
+	updater.start()
+	updater.close()
+	assert.Equal(t, 0, updater.taskNum())
+	assert.Equal(t, uint64(400), dc.updated["ch-1"])
+}
+
+func TestChannelCheckpointUpdater_BatchUnimplemented(t *testing.T) {
+	dc := &checkpointRecorder{updated: make(map[string]uint64), batchUnimplemented: true}
+	updater := newChannelCheckpointUpdater(&DataNode{dataCoord: dc})
+
+	// the checkpoints are updated one by one if DataCoord doesn't support the batched update
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 100})
+	updater.addTask("ch-2", &internalpb.MsgPosition{ChannelName: "ch-2", Timestamp: 200})
+	updater.flush()
+	assert.Equal(t, 0, updater.taskNum())
+	assert.Equal(t, map[string]uint64{"ch-1": 100, "ch-2": 200}, dc.updated)
+	assert.True(t, updater.batchUnsupported.Load())
+	assert.Equal(t, 0, dc.batchRequests)
+}
+
+func TestChannelCheckpointUpdater_PersistFallback(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	dc := &checkpointRecorder{updated: make(map[string]uint64)}
+	dc.UpdateChannelCheckpointError = true
+	updater := newChannelCheckpointUpdater(&DataNode{dataCoord: dc, chunkManager: cm})
+	key := path.Join(cm.RootPath(), common.ChannelCheckpointFallbackPath, "ch-1")
+	persisted := func() uint64 {
+		value, err := cm.Read(ctx, key)
+		require.NoError(t, err)
+		position := &internalpb.MsgPosition{}
+		require.NoError(t, proto.Unmarshal(value, position))
+		return position.GetTimestamp()
+	}
+
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 100})
+	updater.flush()
+	assert.Equal(t, uint64(100), persisted())
+
+	// throttled by the fallback interval
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 200})
+	updater.flush()
+	assert.Equal(t, uint64(100), persisted())
+
+	paramtable.Get().Save(Params.DataNodeCfg.ChannelCheckpointFallbackInterval.Key, "0")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.ChannelCheckpointFallbackInterval.Key)
+	updater.removeTask("ch-1")
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 300})
+	updater.flush()
+	assert.Equal(t, uint64(100), persisted())
+}
+
+func TestChannelCheckpointUpdater_RemoveDuringFlush(t *testing.T) {
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	dc := &checkpointRecorder{updated: make(map[string]uint64)}
+	dc.UpdateChannelCheckpointError = true
+	updater := newChannelCheckpointUpdater(&DataNode{dataCoord: dc, chunkManager: cm})
+	dc.beforeBatch = func(req *datapb.UpdateChannelCheckpointsRequest) {
+		assert.Equal(t, 2, len(req.GetCheckpoints()))
+		// ch-1 is released while the update is in flight
+		updater.removeTask("ch-1")
+	}
+
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 100})
+	updater.addTask("ch-2", &internalpb.MsgPosition{ChannelName: "ch-2", Timestamp: 100})
+	updater.flush()
+
+	// the failed checkpoint of the released ch-1 is dropped, the one of ch-2 is queued again
+	assert.Equal(t, 1, updater.taskNum())
+	updater.mu.Lock()
+	_, ok := updater.pending["ch-1"]
+	assert.False(t, ok)
+	_, ok = updater.lastFallback["ch-1"]
+	assert.False(t, ok)
+	_, ok = updater.pending["ch-2"]
+	assert.True(t, ok)
+	updater.mu.Unlock()
+	exist, err := cm.Exist(context.Background(), path.Join(cm.RootPath(), common.ChannelCheckpointFallbackPath, "ch-1"))
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	// the vchannel is tracked only during the flush it's released in
+	dc.beforeBatch = nil
+	updater.addTask("ch-1", &internalpb.MsgPosition{ChannelName: "ch-1", Timestamp: 200})
+	updater.flush()
+	assert.Equal(t, 2, updater.taskNum())
+}
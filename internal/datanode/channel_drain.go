@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	drainStateNone     = "none"
+	drainStateSyncing  = "syncing"
+	drainStateUpdating = "updating"
+	drainStateDrained  = "drained"
+	drainStateFailed   = "failed"
+)
+
+// channelDrain tracks the draining of a vchannel for decommission, it's shared by the insertBufferNode and
+// the ttNode of the flowgraph:
+//  1. insertBufferNode syncs all the buffered segments on the first tick after the drain is requested,
+//     and records the start position of the tick as the drain position, the deletes of the tick may be
+//     buffered by deleteNode after the sync;
+//  2. ttNode waits for the channel checkpoint to reach the drain position, i.e. all the synced buffers are
+//     flushed, and sends the checkpoint to DataCoord right away;
+//  3. after drained, the flowgraph neither buffers the data consumed nor updates the checkpoint any more,
+//     the data after the drain position is consumed again by the node the vchannel is reassigned to.
+type channelDrain struct {
+	mu         sync.Mutex
+	state      string
+	position   *internalpb.MsgPosition
+	checkpoint *internalpb.MsgPosition
+	err        error
+	doneCh     chan struct{}
+}
+
+func newChannelDrain() *channelDrain {
+	return &channelDrain{
+		state:  drainStateNone,
+		doneCh: make(chan struct{}),
+	}
+}
+
+// request starts the draining, a failed draining is started again. It returns the channel closed when
+// the draining is finished or failed.
+func (d *channelDrain) request() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch d.state {
+	case drainStateNone:
+		d.state = drainStateSyncing
+	case drainStateFailed:
+		d.state = drainStateSyncing
+		d.position, d.checkpoint, d.err = nil, nil, nil
+		d.doneCh = make(chan struct{})
+	}
+	return d.doneCh
+}
+
+// syncing returns whether the buffered segments shall be synced on the current tick.
+func (d *channelDrain) syncing() bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state == drainStateSyncing
+}
+
+// synced records the start position of the tick on which the buffered segments are synced.
+func (d *channelDrain) synced(position *internalpb.MsgPosition) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.state == drainStateSyncing {
+		d.state = drainStateUpdating
+		d.position = position
+	}
+}
+
+// updating returns the drain position if the checkpoint is waited to reach it.
+func (d *channelDrain) updating() (*internalpb.MsgPosition, bool) {
+	if d == nil {
+		return nil, false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.position, d.state == drainStateUpdating
+}
+
+// finish ends the draining with the checkpoint sent to DataCoord, or the error sending it.
+func (d *channelDrain) finish(checkpoint *internalpb.MsgPosition, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.state != drainStateUpdating {
+		return
+	}
+	d.checkpoint = checkpoint
+	d.err = err
+	if err != nil {
+		d.state = drainStateFailed
+	} else {
+		d.state = drainStateDrained
+	}
+	close(d.doneCh)
+}
+
+// drained returns whether the flowgraph shall stop buffering and updating the checkpoint.
+func (d *channelDrain) drained() bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state == drainStateDrained
+}
+
+func (d *channelDrain) status() (state string, checkpoint *internalpb.MsgPosition, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state, d.checkpoint, d.err
+}
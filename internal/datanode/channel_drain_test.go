@@ -0,0 +1,158 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestChannelDrain(t *testing.T) {
+	var nilDrain *channelDrain
+	assert.False(t, nilDrain.syncing())
+	assert.False(t, nilDrain.drained())
+	_, ok := nilDrain.updating()
+	assert.False(t, ok)
+
+	drain := newChannelDrain()
+	assert.False(t, drain.syncing())
+	doneCh := drain.request()
+	assert.True(t, drain.syncing())
+	assert.Equal(t, doneCh, drain.request())
+
+	// finish is ignored before the buffers are synced
+	drain.finish(&internalpb.MsgPosition{Timestamp: 100}, nil)
+	assert.True(t, drain.syncing())
+
+	drain.synced(&internalpb.MsgPosition{Timestamp: 100})
+	assert.False(t, drain.syncing())
+	position, ok := drain.updating()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(100), position.GetTimestamp())
+
+	drain.finish(nil, assert.AnError)
+	<-doneCh
+	state, _, err := drain.status()
+	assert.Equal(t, drainStateFailed, state)
+	assert.Error(t, err)
+	assert.False(t, drain.drained())
+
+	// a failed drain is started again
+	doneCh = drain.request()
+	assert.True(t, drain.syncing())
+	drain.synced(&internalpb.MsgPosition{Timestamp: 200})
+	drain.finish(&internalpb.MsgPosition{Timestamp: 200}, nil)
+	<-doneCh
+	state, checkpoint, err := drain.status()
+	assert.Equal(t, drainStateDrained, state)
+	assert.Equal(t, uint64(200), checkpoint.GetTimestamp())
+	assert.NoError(t, err)
+	assert.True(t, drain.drained())
+
+	// a drained vchannel stays drained
+	<-drain.request()
+	assert.True(t, drain.drained())
+}
+
+func TestTTNode_Drain(t *testing.T) {
+	dc := &checkpointRecorder{updated: make(map[string]uint64)}
+	cpUpdater := newChannelCheckpointUpdater(&DataNode{dataCoord: dc})
+	channel := newChannel("ch-1", 1, nil, nil, nil)
+	drain := newChannelDrain()
+	ttn, err := newTTNode(&nodeConfig{vChannelName: "ch-1", channel: channel, drain: drain}, cpUpdater)
+	assert.NoError(t, err)
+
+	genMsg := func(ts uint64) []Msg {
+		return []Msg{&flowGraphMsg{
+			timeRange:    TimeRange{timestampMin: ts, timestampMax: ts},
+			endPositions: []*internalpb.MsgPosition{{ChannelName: "ch-1", MsgID: []byte{1}, Timestamp: ts}},
+		}}
+	}
+
+	doneCh := drain.request()
+	drain.synced(&internalpb.MsgPosition{Timestamp: 100})
+
+	// the checkpoint hasn't reached the drain position
+	ttn.Operate(genMsg(50))
+	_, ok := drain.updating()
+	assert.True(t, ok)
+	assert.Empty(t, dc.updated)
+
+	ttn.Operate(genMsg(150))
+	select {
+	case <-doneCh:
+	case <-time.After(time.Second):
+		t.Fatal("drain not finished")
+	}
+	assert.True(t, drain.drained())
+	assert.Equal(t, uint64(150), dc.updated["ch-1"])
+
+	// the checkpoint isn't updated after drained
+	ttn.Operate(genMsg(200))
+	assert.Equal(t, 0, cpUpdater.taskNum())
+}
+
+func TestDataNode_Decommission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node := &DataNode{
+		ctx:              ctx,
+		flowgraphManager: newFlowgraphManager(),
+		decommissioner:   newDecommissioner(),
+	}
+	drain := newChannelDrain()
+	node.flowgraphManager.flowgraphs.Store("ch-1", &dataSyncService{vchannelName: "ch-1", drain: drain})
+
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := node.Decommission(ctx, &datapb.DecommissionRequest{Start: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	assert.False(t, node.decommissioner.active())
+
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = node.Decommission(ctx, &datapb.DecommissionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, datapb.DecommissionState_DecommissionNone, resp.GetState())
+	assert.Zero(t, resp.GetStartTime())
+	assert.False(t, node.decommissioner.active())
+
+	go func() {
+		assert.Eventually(t, drain.syncing, time.Second, 10*time.Millisecond)
+		drain.synced(&internalpb.MsgPosition{Timestamp: 100})
+		drain.finish(&internalpb.MsgPosition{Timestamp: 100}, nil)
+	}()
+	resp, err = node.Decommission(ctx, &datapb.DecommissionRequest{Start: true, Wait: true})
+	assert.NoError(t, err)
+	assert.True(t, node.decommissioner.active())
+
+	// the session isn't initialized, the node can't notify DataCoord
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, datapb.DecommissionState_DecommissionFailed, resp.GetState())
+	assert.NotZero(t, resp.GetStartTime())
+	assert.NotEmpty(t, resp.GetError())
+	assert.Equal(t, 1, len(resp.GetChannels()))
+	assert.Equal(t, drainStateDrained, resp.GetChannels()[0].GetState())
+	assert.Equal(t, uint64(100), resp.GetChannels()[0].GetCheckpointTs())
+}
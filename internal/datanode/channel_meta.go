@@ -62,10 +62,11 @@ type Channel interface {
 	listNewSegmentsStartPositions() []*datapb.SegmentStartPosition
 	transferNewSegments(segmentIDs []UniqueID)
 	updateSegmentPKRange(segID UniqueID, ids storage.FieldData)
-	mergeFlushedSegments(seg *Segment, planID UniqueID, compactedFrom []UniqueID) error
+	mergeFlushedSegments(seg *Segment, planID UniqueID, compactedFrom []UniqueID, splits ...*Segment) error
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
+	listSplitSegmentIDs(compactedTo UniqueID) []UniqueID
 	listSegmentIDsToSync(ts Timestamp) []UniqueID
 	setSegmentLastSyncTs(segID UniqueID, ts Timestamp)
 
@@ -247,6 +248,18 @@ func (c *ChannelMeta) listCompactedSegmentIDs() map[UniqueID][]UniqueID {
 	return compactedTo2From
 }
 
+// listSplitSegmentIDs returns the other target segments of the split compaction compactedTo is the first target of.
+func (c *ChannelMeta) listSplitSegmentIDs(compactedTo UniqueID) []UniqueID {
+	c.segMu.RLock()
+	defer c.segMu.RUnlock()
+
+	seg, ok := c.segments[compactedTo]
+	if !ok {
+		return nil
+	}
+	return seg.splitTo
+}
+
 func (c *ChannelMeta) listSegmentIDsToSync(ts Timestamp) []UniqueID {
 	c.segMu.RLock()
 	defer c.segMu.RUnlock()
@@ -528,7 +541,9 @@ func (c *ChannelMeta) validCollection(collID UniqueID) bool {
 	return collID == c.collectionID
 }
 
-func (c *ChannelMeta) mergeFlushedSegments(seg *Segment, planID UniqueID, compactedFrom []UniqueID) error {
+// mergeFlushedSegments marks the segments compactedFrom as compacted to seg, splits are the other target segments
+// if the compaction split the merged rows, the deletes of compactedFrom are copied to them.
+func (c *ChannelMeta) mergeFlushedSegments(seg *Segment, planID UniqueID, compactedFrom []UniqueID, splits ...*Segment) error {
 
 	log := log.With(
 		zap.Int64("segment ID", seg.segmentID),
@@ -571,6 +586,13 @@ func (c *ChannelMeta) mergeFlushedSegments(seg *Segment, planID UniqueID, compac
 		seg.setType(datapb.SegmentType_Flushed)
 		c.segments[seg.segmentID] = seg
 	}
+	for _, split := range splits {
+		if split.numRows > 0 {
+			split.setType(datapb.SegmentType_Flushed)
+			c.segments[split.segmentID] = split
+			seg.splitTo = append(seg.splitTo, split.segmentID)
+		}
+	}
 
 	return nil
 }
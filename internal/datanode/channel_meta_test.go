@@ -693,6 +693,29 @@ func TestChannelMeta_InterfaceMethod(t *testing.T) {
 		}
 	})
 
+	t.Run("Test_mergeFlushedSegments with splits", func(t *testing.T) {
+		channel := newChannel("channel", 1, nil, rc, cm)
+		primaryKeyData := &storage.Int64FieldData{
+			Data: []UniqueID{1},
+		}
+		channel.addFlushedSegmentWithPKs(1, 1, 0, 10, primaryKeyData)
+		channel.addFlushedSegmentWithPKs(2, 1, 0, 10, primaryKeyData)
+
+		seg := &Segment{segmentID: 3, collectionID: 1, numRows: 15}
+		splits := []*Segment{
+			{segmentID: 4, collectionID: 1, numRows: 5},
+			{segmentID: 5, collectionID: 1, numRows: 0},
+		}
+		err := channel.mergeFlushedSegments(seg, 100, []UniqueID{1, 2}, splits...)
+		assert.NoError(t, err)
+		assert.True(t, channel.hasSegment(3, true))
+		assert.True(t, channel.hasSegment(4, true))
+		assert.False(t, channel.hasSegment(5, true))
+		assert.ElementsMatch(t, []UniqueID{1, 2}, channel.listCompactedSegmentIDs()[3])
+		assert.Equal(t, []UniqueID{4}, channel.listSplitSegmentIDs(3))
+		assert.Empty(t, channel.listSplitSegmentIDs(4))
+	})
+
 }
 func TestChannelMeta_UpdatePKRange(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// clockSkewRole is the role of the subcomponent reporting the clock skew in the component states.
+	clockSkewRole = "ClockSkew"
+	// clockSkewCheckTimeout is the timeout of allocating the TSO compared with the local clock.
+	clockSkewCheckTimeout = 5 * time.Second
+)
+
+// clockSkewChecker compares the local clocks with TSO periodically. The skew is the local wall clock at the middle
+// of the TSO allocation minus the physical time of the TSO, so it is accurate within half of the round trip.
+// Between two checks, the wall clock elapsed is compared with the monotonic clock elapsed to catch the jumps of
+// the wall clock, e.g. stepped by NTP. The datanode keeps serving, but reports degraded health by the ClockSkew
+// subcomponent once the skew or a jump exceeds dataNode.clockSkew.threshold.
+type clockSkewChecker struct {
+	allocTimestamp func(ctx context.Context) (Timestamp, error)
+
+	mu        sync.RWMutex
+	checked   bool
+	skew      time.Duration
+	jump      time.Duration
+	lastCheck time.Time
+	reason    string // the reason of the degraded health, empty if healthy
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newClockSkewChecker(dn *DataNode) *clockSkewChecker {
+	return &clockSkewChecker{
+		allocTimestamp: func(ctx context.Context) (Timestamp, error) {
+			resp, err := dn.rootCoord.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_RequestTSO),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				Count: 1,
+			})
+			if err = funcutil.VerifyResponse(resp, err); err != nil {
+				return 0, err
+			}
+			return resp.GetTimestamp(), nil
+		},
+		closeCh: make(chan struct{}),
+	}
+}
+
+// enabled returns false if the checker is nil or dataNode.clockSkew.checkInterval is 0.
+func (c *clockSkewChecker) enabled() bool {
+	return c != nil && Params.DataNodeCfg.ClockSkewCheckInterval.GetAsInt64() > 0
+}
+
+func (c *clockSkewChecker) start() {
+	if !c.enabled() {
+		return
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(Params.DataNodeCfg.ClockSkewCheckInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.closeCh:
+				log.Info("clock skew checker quit")
+				return
+			case <-ticker.C:
+				c.check()
+			}
+		}
+	}()
+}
+
+// check allocates a TSO and compares it with the local clock, the health is unchanged if the allocation fails.
+func (c *clockSkewChecker) check() {
+	ctx, cancel := context.WithTimeout(context.Background(), clockSkewCheckTimeout)
+	defer cancel()
+	start := time.Now()
+	ts, err := c.allocTimestamp(ctx)
+	end := time.Now()
+	if err != nil {
+		log.Warn("failed to allocate timestamp to check the clock skew", zap.Error(err))
+		return
+	}
+	physical, _ := tsoutil.ParseTS(ts)
+	rtt := end.Sub(start)
+	c.update(start.Add(rtt/2), physical, rtt, end)
+}
+
+// update records the skew of the local time to the physical time of TSO, the round trip bounds its error,
+// end is the local time the check ends.
+func (c *clockSkewChecker) update(local time.Time, physical time.Time, rtt time.Duration, end time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Round(0) strips the monotonic reading, so the wall clocks are compared
+	c.skew = local.Round(0).Sub(physical)
+	c.jump = 0
+	if !c.lastCheck.IsZero() {
+		c.jump = end.Round(0).Sub(c.lastCheck.Round(0)) - end.Sub(c.lastCheck)
+	}
+	c.lastCheck = end
+	c.checked = true
+
+	c.reason = ""
+	threshold := Params.DataNodeCfg.ClockSkewThreshold.GetAsDuration(time.Millisecond)
+	if threshold > 0 {
+		if absDuration(c.skew)-rtt/2 > threshold {
+			c.reason = fmt.Sprintf("local clock skews %v from TSO, exceeds %v", c.skew, threshold)
+		} else if absDuration(c.jump) > threshold {
+			c.reason = fmt.Sprintf("wall clock jumped %v since the last check, exceeds %v", c.jump, threshold)
+		}
+	}
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.DataNodeClockSkew.WithLabelValues(nodeID).Set(float64(c.skew.Milliseconds()))
+	degraded := 0.0
+	if c.reason != "" {
+		degraded = 1
+		log.Warn("datanode health degraded by clock skew", zap.String("reason", c.reason),
+			zap.Duration("skew", c.skew), zap.Duration("jump", c.jump), zap.Duration("rtt", rtt))
+	}
+	metrics.DataNodeClockSkewDegraded.WithLabelValues(nodeID).Set(degraded)
+}
+
+// componentInfo returns the state of the ClockSkew subcomponent, nil if not checked yet.
+func (c *clockSkewChecker) componentInfo(nodeID UniqueID) *milvuspb.ComponentInfo {
+	if c == nil {
+		return nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.checked {
+		return nil
+	}
+	info := &milvuspb.ComponentInfo{
+		NodeID:    nodeID,
+		Role:      clockSkewRole,
+		StateCode: commonpb.StateCode_Healthy,
+		ExtraInfo: []*commonpb.KeyValuePair{
+			{Key: "skew_ms", Value: strconv.FormatInt(c.skew.Milliseconds(), 10)},
+			{Key: "jump_ms", Value: strconv.FormatInt(c.jump.Milliseconds(), 10)},
+		},
+	}
+	if c.reason != "" {
+		info.StateCode = commonpb.StateCode_Abnormal
+		info.ExtraInfo = append(info.ExtraInfo, &commonpb.KeyValuePair{Key: "reason", Value: c.reason})
+	}
+	return info
+}
+
+func (c *clockSkewChecker) close() {
+	c.closeOnce.Do(func() {
+		close(c.closeCh)
+		c.wg.Wait()
+	})
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
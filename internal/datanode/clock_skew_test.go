@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestClockSkewChecker(t *testing.T) {
+	var nilChecker *clockSkewChecker
+	assert.False(t, nilChecker.enabled())
+	assert.Nil(t, nilChecker.componentInfo(1))
+
+	checker := &clockSkewChecker{closeCh: make(chan struct{})}
+	assert.True(t, checker.enabled())
+	assert.Nil(t, checker.componentInfo(1))
+
+	// the skew within the threshold is healthy
+	physical := time.Unix(1000, 0)
+	checker.update(physical.Add(200*time.Millisecond), physical, 10*time.Millisecond, physical)
+	info := checker.componentInfo(1)
+	assert.Equal(t, clockSkewRole, info.GetRole())
+	assert.Equal(t, commonpb.StateCode_Healthy, info.GetStateCode())
+	assert.Equal(t, "200", info.GetExtraInfo()[0].GetValue())
+
+	// the skew beyond the threshold plus half of the round trip degrades the health
+	checker.update(physical.Add(-1500*time.Millisecond), physical, time.Second, physical.Add(time.Second))
+	assert.Equal(t, commonpb.StateCode_Healthy, checker.componentInfo(1).GetStateCode())
+	checker.update(physical.Add(-1500*time.Millisecond), physical, 10*time.Millisecond, physical.Add(2*time.Second))
+	info = checker.componentInfo(1)
+	assert.Equal(t, commonpb.StateCode_Abnormal, info.GetStateCode())
+	assert.Equal(t, "-1500", info.GetExtraInfo()[0].GetValue())
+	assert.Equal(t, "reason", info.GetExtraInfo()[2].GetKey())
+
+	// the health recovers with the clock
+	checker.update(physical, physical, 10*time.Millisecond, physical.Add(3*time.Second))
+	assert.Equal(t, commonpb.StateCode_Healthy, checker.componentInfo(1).GetStateCode())
+
+	// the threshold 0 never degrades the health
+	paramtable.Get().Save(Params.DataNodeCfg.ClockSkewThreshold.Key, "0")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.ClockSkewThreshold.Key)
+	checker.update(physical.Add(time.Hour), physical, 10*time.Millisecond, physical.Add(4*time.Second))
+	assert.Equal(t, commonpb.StateCode_Healthy, checker.componentInfo(1).GetStateCode())
+}
+
+func TestClockSkewChecker_Check(t *testing.T) {
+	checker := &clockSkewChecker{
+		allocTimestamp: func(ctx context.Context) (Timestamp, error) {
+			return 0, errors.New("mock error")
+		},
+		closeCh: make(chan struct{}),
+	}
+	// the failed allocation leaves the health unchanged
+	checker.check()
+	assert.Nil(t, checker.componentInfo(1))
+
+	checker.allocTimestamp = func(ctx context.Context) (Timestamp, error) {
+		return tsoutil.ComposeTSByTime(time.Now().Add(-time.Hour), 0), nil
+	}
+	checker.check()
+	assert.Equal(t, commonpb.StateCode_Abnormal, checker.componentInfo(1).GetStateCode())
+
+	checker.allocTimestamp = func(ctx context.Context) (Timestamp, error) {
+		return tsoutil.ComposeTSByTime(time.Now(), 0), nil
+	}
+	checker.check()
+	assert.Equal(t, commonpb.StateCode_Healthy, checker.componentInfo(1).GetStateCode())
+
+	checker.start()
+	checker.close()
+	checker.close()
+}
@@ -32,7 +32,8 @@ const (
 
 type compactionExecutor struct {
 	executing sync.Map // planID to compactor
-	completed sync.Map // planID to CompactionResult
+	completed sync.Map // planID to CompactionResults
+	split     sync.Map // planID to CompactionResults of the split plans waiting for SyncSegments
 	taskCh    chan compactor
 	dropped   sync.Map // vchannel dropped
 }
@@ -82,14 +83,17 @@ func (c *compactionExecutor) executeTask(task compactor) {
 
 	log.Info("start to execute compaction", zap.Int64("planID", task.getPlanID()))
 
-	result, err := task.compact()
+	results, err := task.compact()
 	if err != nil {
 		log.Warn("compaction task failed",
 			zap.Int64("planID", task.getPlanID()),
 			zap.Error(err),
 		)
 	} else {
-		c.completed.Store(task.getPlanID(), result)
+		c.completed.Store(task.getPlanID(), results)
+		if len(results) > 1 {
+			c.split.Store(task.getPlanID(), results)
+		}
 	}
 
 	log.Info("end to execute compaction", zap.Int64("planID", task.getPlanID()))
@@ -119,7 +123,7 @@ func (c *compactionExecutor) stopExecutingtaskByVChannelName(vChannelName string
 	})
 	// remove all completed plans for vChannelName
 	c.completed.Range(func(key interface{}, value interface{}) bool {
-		if value.(*datapb.CompactionResult).GetChannel() == vChannelName {
+		if results := value.([]*datapb.CompactionResult); len(results) > 0 && results[0].GetChannel() == vChannelName {
 			c.completed.Delete(key.(UniqueID))
 			log.Info("remove compaction results for dropped channel",
 				zap.String("channel", vChannelName),
@@ -127,4 +131,24 @@ func (c *compactionExecutor) stopExecutingtaskByVChannelName(vChannelName string
 		}
 		return true
 	})
+	c.split.Range(func(key interface{}, value interface{}) bool {
+		if value.([]*datapb.CompactionResult)[0].GetChannel() == vChannelName {
+			c.split.Delete(key.(UniqueID))
+		}
+		return true
+	})
+}
+
+// getSplitResults returns the results of planID if the merged rows are split into several target segments.
+func (c *compactionExecutor) getSplitResults(planID UniqueID) ([]*datapb.CompactionResult, bool) {
+	results, ok := c.split.Load(planID)
+	if !ok {
+		return nil, false
+	}
+	return results.([]*datapb.CompactionResult), true
+}
+
+// removeSplitResults forgets the split results of planID once all its targets are synced.
+func (c *compactionExecutor) removeSplitResults(planID UniqueID) {
+	c.split.Delete(planID)
 }
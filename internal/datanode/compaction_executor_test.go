@@ -143,7 +143,7 @@ func (mc *mockCompactor) complete() {
 	mc.wg.Done()
 }
 
-func (mc *mockCompactor) compact() (*datapb.CompactionResult, error) {
+func (mc *mockCompactor) compact() ([]*datapb.CompactionResult, error) {
 	if !mc.isvalid {
 		return nil, errStart
 	}
@@ -58,7 +58,7 @@ type iterator = storage.Iterator
 type compactor interface {
 	start()
 	complete()
-	compact() (*datapb.CompactionResult, error)
+	compact() ([]*datapb.CompactionResult, error)
 	stop()
 	getPlanID() UniqueID
 	getCollection() UniqueID
@@ -167,7 +167,10 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 			ts := dData.Tss[i]
 
 			if timetravelTs != Timestamp(0) && dData.Tss[i] <= timetravelTs {
-				pk2ts[pk.GetValue()] = ts
+				// the latest delete hides all the rows of the pk inserted before it
+				if ts > pk2ts[pk.GetValue()] {
+					pk2ts[pk.GetValue()] = ts
+				}
 				continue
 			}
 
@@ -229,6 +232,15 @@ func (t *compactionTask) uploadSingleInsertLog(
 	return inPaths, statPaths, nil
 }
 
+// compactionTarget is a result segment of a compaction, the merged rows are split into several targets
+// when they exceed the max segment size.
+type compactionTarget struct {
+	segmentID   UniqueID
+	insertPaths []*datapb.FieldBinlog
+	statPaths   []*datapb.FieldBinlog
+	numRows     int64
+}
+
 func (t *compactionTask) merge(
 	ctxTimeout context.Context,
 	unMergedInsertlogs [][]string,
@@ -236,14 +248,34 @@ func (t *compactionTask) merge(
 	partID UniqueID,
 	meta *etcdpb.CollectionMeta,
 	delta map[interface{}]Timestamp) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, int64, error) {
+	targets, err := t.mergeToTargets(ctxTimeout, unMergedInsertlogs, targetSegID, partID, meta, delta, nil, false)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return targets[0].insertPaths, targets[0].statPaths, targets[0].numRows, nil
+}
+
+// mergeToTargets merges the insert logs into targetSegID, if split is true, a new target segment is allocated
+// whenever the rows of the current one reach dataCoord.segment.maxSize. The kept rows are observed by collapser if not nil.
+func (t *compactionTask) mergeToTargets(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	targetSegID UniqueID,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	delta map[interface{}]Timestamp,
+	collapser *deleteCollapser,
+	split bool) ([]*compactionTarget, error) {
 	log := log.With(zap.Int64("planID", t.getPlanID()))
 	mergeStart := time.Now()
 
 	var (
-		maxRowsPerBinlog int   // maximum rows populating one binlog
-		numBinlogs       int   // binlog number
-		numRows          int64 // the number of rows uploaded
-		expired          int64 // the number of expired entity
+		maxRowsPerBinlog  int   // maximum rows populating one binlog
+		maxRowsPerSegment int64 // maximum rows populating one target segment
+		numBinlogs        int   // binlog number
+		numRows           int64 // the number of rows uploaded to the current target
+		totalRows         int64 // the number of rows uploaded to all the targets
+		expired           int64 // the number of expired entity
 
 		// statslog generation
 		pkID   UniqueID
@@ -253,10 +285,10 @@ func (t *compactionTask) merge(
 		fID2Content = make(map[UniqueID][]interface{})
 
 		insertField2Path = make(map[UniqueID]*datapb.FieldBinlog)
-		insertPaths      = make([]*datapb.FieldBinlog, 0)
+		statField2Path   = make(map[UniqueID]*datapb.FieldBinlog)
 
-		statField2Path = make(map[UniqueID]*datapb.FieldBinlog)
-		statPaths      = make([]*datapb.FieldBinlog, 0)
+		segID   = targetSegID
+		targets = make([]*compactionTarget, 0, 1)
 	)
 
 	isDeletedValue := func(v *storage.Value) bool {
@@ -291,6 +323,26 @@ func (t *compactionTask) merge(
 		}
 	}
 
+	closeTarget := func() {
+		target := &compactionTarget{
+			segmentID:   segID,
+			insertPaths: make([]*datapb.FieldBinlog, 0, len(insertField2Path)),
+			statPaths:   make([]*datapb.FieldBinlog, 0, len(statField2Path)),
+			numRows:     numRows,
+		}
+		for _, path := range insertField2Path {
+			target.insertPaths = append(target.insertPaths, path)
+		}
+		for _, path := range statField2Path {
+			target.statPaths = append(target.statPaths, path)
+		}
+		targets = append(targets, target)
+		insertField2Path = make(map[UniqueID]*datapb.FieldBinlog)
+		statField2Path = make(map[UniqueID]*datapb.FieldBinlog)
+		totalRows += numRows
+		numRows = 0
+	}
+
 	// get pkID, pkType, dim
 	for _, fs := range meta.GetSchema().GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
@@ -305,7 +357,7 @@ func (t *compactionTask) merge(
 	size, err := typeutil.EstimateSizePerRecord(meta.GetSchema())
 	if err != nil {
 		log.Warn("failed to estimate size per record", zap.Error(err))
-		return nil, nil, 0, err
+		return nil, err
 	}
 
 	maxRowsPerBinlog = int(Params.DataNodeCfg.BinLogMaxSize.GetAsInt64() / int64(size))
@@ -313,37 +365,66 @@ func (t *compactionTask) merge(
 		maxRowsPerBinlog++
 	}
 
+	// estimate Rows per segment the same way as the max row num of the segments allocated by DataCoord
+	maxRowsPerSegment = math.MaxInt64
+	if split {
+		maxRowsPerSegment = int64(Params.DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024 / float64(size))
+		if maxRowsPerSegment < 1 {
+			maxRowsPerSegment = 1
+		}
+	}
+
 	expired = 0
-	numRows = 0
 	numBinlogs = 0
 	currentTs := t.GetCurrentTime()
 	currentRows := 0
 	downloadTimeCost := time.Duration(0)
 	uploadInsertTimeCost := time.Duration(0)
 
-	for _, path := range unMergedInsertlogs {
+	uploadCurrentRows := func() error {
+		uploadInsertStart := time.Now()
+		inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, segID, partID, meta, fID2Content, fID2Type)
+		if err != nil {
+			log.Warn("failed to upload single insert log", zap.Error(err))
+			return err
+		}
+		uploadInsertTimeCost += time.Since(uploadInsertStart)
+		addInsertFieldPath(inPaths)
+		addStatFieldPath(statsPaths)
+
+		fID2Content = make(map[int64][]interface{})
+		numRows += int64(currentRows)
+		currentRows = 0
+		numBinlogs++
+		return nil
+	}
+
+	reader := newBinlogReader(ctxTimeout, t.downloader, unMergedInsertlogs, Params.DataNodeCfg.CompactionReadahead.GetAsInt())
+	defer reader.Close()
+	for range unMergedInsertlogs {
 		downloadStart := time.Now()
-		data, err := t.download(ctxTimeout, path)
+		data, err := reader.Next()
 		if err != nil {
 			log.Warn("download insertlogs wrong", zap.Error(err))
-			return nil, nil, 0, err
+			return nil, err
 		}
 		downloadTimeCost += time.Since(downloadStart)
 
 		iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
 		if err != nil {
 			log.Warn("new insert binlogs Itr wrong", zap.Error(err))
-			return nil, nil, 0, err
+			return nil, err
 		}
-		for iter.HasNext() {
+		deletedRows := locateDeletedRows(data, delta)
+		for offset := 0; iter.HasNext(); offset++ {
 			vInter, _ := iter.Next()
 			v, ok := vInter.(*storage.Value)
 			if !ok {
 				log.Warn("transfer interface to Value wrong")
-				return nil, nil, 0, errors.New("unexpected error")
+				return nil, errors.New("unexpected error")
 			}
 
-			if isDeletedValue(v) {
+			if (deletedRows == nil || deletedRows.MayContain(offset)) && isDeletedValue(v) {
 				continue
 			}
 
@@ -357,7 +438,18 @@ func (t *compactionTask) merge(
 			row, ok := v.Value.(map[UniqueID]interface{})
 			if !ok {
 				log.Warn("transfer interface to map wrong")
-				return nil, nil, 0, errors.New("unexpected error")
+				return nil, errors.New("unexpected error")
+			}
+			collapser.observe(v.PK.GetValue(), ts)
+
+			// the current target is full, the rest rows go to a new one
+			if numRows >= maxRowsPerSegment {
+				closeTarget()
+				segID, err = t.allocID()
+				if err != nil {
+					log.Warn("failed to alloc split target segment", zap.Error(err))
+					return nil, err
+				}
 			}
 
 			for fID, vInter := range row {
@@ -368,58 +460,72 @@ func (t *compactionTask) merge(
 			}
 
 			currentRows++
-			if currentRows >= maxRowsPerBinlog {
-				uploadInsertStart := time.Now()
-				inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, targetSegID, partID, meta, fID2Content, fID2Type)
-				if err != nil {
-					log.Warn("failed to upload single insert log", zap.Error(err))
-					return nil, nil, 0, err
+			if currentRows >= maxRowsPerBinlog || numRows+int64(currentRows) >= maxRowsPerSegment {
+				if err := uploadCurrentRows(); err != nil {
+					return nil, err
 				}
-				uploadInsertTimeCost += time.Since(uploadInsertStart)
-				addInsertFieldPath(inPaths)
-				addStatFieldPath(statsPaths)
-
-				fID2Content = make(map[int64][]interface{})
-				currentRows = 0
-				numRows += int64(maxRowsPerBinlog)
-				numBinlogs++
 			}
 		}
 	}
 	if currentRows != 0 {
-		uploadInsertStart := time.Now()
-		inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, targetSegID, partID, meta, fID2Content, fID2Type)
-		if err != nil {
-			log.Warn("failed to upload single insert log", zap.Error(err))
-			return nil, nil, 0, err
+		if err := uploadCurrentRows(); err != nil {
+			return nil, err
 		}
-		uploadInsertTimeCost += time.Since(uploadInsertStart)
-
-		addInsertFieldPath(inPaths)
-		addStatFieldPath(statsPaths)
-
-		numRows += int64(currentRows)
-		numBinlogs++
-	}
-
-	for _, path := range insertField2Path {
-		insertPaths = append(insertPaths, path)
 	}
+	closeTarget()
 
-	for _, path := range statField2Path {
-		statPaths = append(statPaths, path)
-	}
-
-	log.Info("merge end", zap.Int64("remaining insert numRows", numRows),
+	log.Info("merge end", zap.Int64("remaining insert numRows", totalRows),
 		zap.Int64("expired entities", expired), zap.Int("binlog file number", numBinlogs),
+		zap.Int("target segment number", len(targets)),
 		zap.Float64("download insert log elapse in ms", nano2Milli(downloadTimeCost)),
 		zap.Float64("upload insert log elapse in ms", nano2Milli(uploadInsertTimeCost)),
 		zap.Float64("merge elapse in ms", nano2Milli(time.Since(mergeStart))))
 
-	return insertPaths, statPaths, numRows, nil
+	return targets, nil
+}
+
+// locateDeletedRows returns the filter of the rows which may be deleted if the binlogs are sorted by primary key,
+// so that only the rows located by the primary key index are looked up in the deletes. nil means every row has to be
+// looked up, which is the case of the binlogs not sorted, or of the deletes more than the keys sampled by the index.
+func locateDeletedRows(blobs []*Blob, delta map[interface{}]Timestamp) *storage.RowRangeFilter {
+	if len(delta) == 0 || len(blobs) == 0 {
+		return nil
+	}
+	if sorted, err := storage.IsBinlogSortedByPK(blobs[0]); err != nil || !sorted {
+		return nil
+	}
+	for _, blob := range blobs {
+		index, err := storage.GetPKIndex(blob)
+		if err != nil {
+			log.Warn("failed to read primary key index", zap.String("key", blob.GetKey()), zap.Error(err))
+			return nil
+		}
+		if index == nil {
+			// not the binlog of the primary key field
+			continue
+		}
+		if len(delta) > len(index.PKs) {
+			return nil
+		}
+		pks := make([]primaryKey, 0, len(delta))
+		for pk := range delta {
+			switch pk := pk.(type) {
+			case int64:
+				pks = append(pks, storage.NewInt64PrimaryKey(pk))
+			case string:
+				pks = append(pks, storage.NewVarCharPrimaryKey(pk))
+			default:
+				return nil
+			}
+		}
+		return index.LocateAll(pks)
+	}
+	return nil
 }
 
-func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
+// compact merges the segments of the plan, it returns several results when the merged rows are split
+// into several target segments, the first result is the segment the flushes injected during compaction go to.
+func (t *compactionTask) compact() ([]*datapb.CompactionResult, error) {
 	compactStart := time.Now()
 	if ok := funcutil.CheckCtxValid(t.ctx); !ok {
 		log.Warn("compact wrong, task context done or timeout")
@@ -635,38 +741,55 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		return nil, err
 	}
 
-	inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
-	if err != nil {
-		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
-		return nil, err
+	var collapser *deleteCollapser
+	if Params.DataNodeCfg.CompactionCollapseDeletes.GetAsBool() {
+		collapser = newDeleteCollapser(deltaBuf.delData)
 	}
 
-	uploadDeltaStart := time.Now()
-	deltaInfo, err := t.uploadDeltaLog(ctxTimeout, targetSegID, partID, deltaBuf.delData, meta)
+	targets, err := t.mergeToTargets(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts, collapser,
+		Params.DataNodeCfg.CompactionSplitOutput.GetAsBool())
 	if err != nil {
 		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
 		return nil, err
 	}
-	log.Info("upload delta log elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(uploadDeltaStart))))
 
-	for _, fbl := range deltaInfo {
-		for _, deltaLogInfo := range fbl.GetBinlogs() {
-			deltaLogInfo.LogSize = deltaBuf.GetLogSize()
-			deltaLogInfo.TimestampFrom = deltaBuf.GetTimestampFrom()
-			deltaLogInfo.TimestampTo = deltaBuf.GetTimestampTo()
-			deltaLogInfo.EntriesNum = deltaBuf.GetEntriesNum()
-		}
+	if collapser != nil {
+		entriesNum := deltaBuf.GetEntriesNum()
+		deltaBuf = collapser.collapse(deltaBuf)
+		log.Info("repeated deletes collapsed", zap.Int64("planID", t.plan.GetPlanID()),
+			zap.Int64("deletes", entriesNum), zap.Int64("remaining deletes", deltaBuf.GetEntriesNum()))
 	}
 
-	pack := &datapb.CompactionResult{
-		PlanID:              t.plan.GetPlanID(),
-		SegmentID:           targetSegID,
-		InsertLogs:          inPaths,
-		Field2StatslogPaths: statsPaths,
-		Deltalogs:           deltaInfo,
-		NumOfRows:           numRows,
-		Channel:             t.plan.GetChannel(),
+	// the deletes of the compacted segments may hit any of the targets, so every target gets the merged deltalog
+	uploadDeltaStart := time.Now()
+	results := make([]*datapb.CompactionResult, 0, len(targets))
+	for _, target := range targets {
+		deltaInfo, err := t.uploadDeltaLog(ctxTimeout, target.segmentID, partID, deltaBuf.delData, meta)
+		if err != nil {
+			log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
+
+		for _, fbl := range deltaInfo {
+			for _, deltaLogInfo := range fbl.GetBinlogs() {
+				deltaLogInfo.LogSize = deltaBuf.GetLogSize()
+				deltaLogInfo.TimestampFrom = deltaBuf.GetTimestampFrom()
+				deltaLogInfo.TimestampTo = deltaBuf.GetTimestampTo()
+				deltaLogInfo.EntriesNum = deltaBuf.GetEntriesNum()
+			}
+		}
+
+		results = append(results, &datapb.CompactionResult{
+			PlanID:              t.plan.GetPlanID(),
+			SegmentID:           target.segmentID,
+			InsertLogs:          target.insertPaths,
+			Field2StatslogPaths: target.statPaths,
+			Deltalogs:           deltaInfo,
+			NumOfRows:           target.numRows,
+			Channel:             t.plan.GetChannel(),
+		})
 	}
+	log.Info("upload delta log elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(uploadDeltaStart))))
 
 	uninjectStart := time.Now()
 	ti.injectDone(true)
@@ -675,19 +798,21 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		log.Info("uninject elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(uninjectEnd.Sub(uninjectStart))))
 	}()
 
-	log.Info("compaction done",
-		zap.Int64("planID", t.plan.GetPlanID()),
-		zap.Int64("targetSegmentID", targetSegID),
-		zap.Int64s("compactedFrom", segIDs),
-		zap.Int("num of binlog paths", len(inPaths)),
-		zap.Int("num of stats paths", len(statsPaths)),
-		zap.Int("num of delta paths", len(deltaInfo)),
-	)
+	for _, result := range results {
+		log.Info("compaction done",
+			zap.Int64("planID", t.plan.GetPlanID()),
+			zap.Int64("targetSegmentID", result.GetSegmentID()),
+			zap.Int64s("compactedFrom", segIDs),
+			zap.Int("num of binlog paths", len(result.GetInsertLogs())),
+			zap.Int("num of stats paths", len(result.GetField2StatslogPaths())),
+			zap.Int("num of delta paths", len(result.GetDeltalogs())),
+		)
+	}
 
 	log.Info("overall elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(compactStart))))
 	metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
 
-	return pack, nil
+	return results, nil
 }
 
 // TODO copy maybe expensive, but this seems to be the only convinent way.
@@ -341,6 +341,84 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.Equal(t, 2, len(statsPaths[0].GetBinlogs()))
 		})
 
+		t.Run("Merge sorted by pk", func(t *testing.T) {
+			alloc := NewAllocatorFactory(1)
+			mockbIO := &binlogIO{cm, alloc}
+			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+			paramtable.Get().Save(Params.DataNodeCfg.FlushSortByPK.Key, "true")
+			defer paramtable.Get().Reset(Params.DataNodeCfg.FlushSortByPK.Key)
+			iData := genInsertDataWithExpiredTS()
+
+			blobs, _, err := storage.NewInsertCodec(meta).Serialize(0, 1, iData)
+			require.NoError(t, err)
+			assert.Nil(t, locateDeletedRows(blobs, map[interface{}]Timestamp{int64(1): math.MaxUint64}))
+			sortedCodec := storage.NewInsertCodec(meta)
+			sortedCodec.SortByPK = true
+			blobs, _, err = sortedCodec.Serialize(0, 1, iData)
+			require.NoError(t, err)
+			deletedRows := locateDeletedRows(blobs, map[interface{}]Timestamp{int64(1): math.MaxUint64})
+			require.NotNil(t, deletedRows)
+			assert.True(t, deletedRows.MayContain(0))
+
+			var allPaths [][]string
+			inpath, _, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, iData, meta)
+			assert.NoError(t, err)
+			for idx := 0; idx < len(inpath[0].GetBinlogs()); idx++ {
+				var ps []string
+				for _, path := range inpath {
+					ps = append(ps, path.GetBinlogs()[idx].GetLogPath())
+				}
+				allPaths = append(allPaths, ps)
+			}
+
+			dm := map[interface{}]Timestamp{
+				int64(1): math.MaxUint64,
+			}
+			ct := &compactionTask{Channel: channel, downloader: mockbIO, uploader: mockbIO}
+			_, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), numOfRow)
+		})
+
+		t.Run("Merge with split", func(t *testing.T) {
+			alloc := NewAllocatorFactory(1)
+			mockbIO := &binlogIO{cm, alloc}
+			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+			// less than the size of a row, so every row goes to a new target
+			paramtable.Get().Save(Params.DataCoordCfg.SegmentMaxSize.Key, "0.000001")
+			defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentMaxSize.Key)
+			iData := genInsertDataWithExpiredTS()
+
+			var allPaths [][]string
+			inpath, _, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, iData, meta)
+			assert.NoError(t, err)
+			for idx := 0; idx < len(inpath[0].GetBinlogs()); idx++ {
+				var ps []string
+				for _, path := range inpath {
+					ps = append(ps, path.GetBinlogs()[idx].GetLogPath())
+				}
+				allPaths = append(allPaths, ps)
+			}
+
+			ct := &compactionTask{Channel: channel, downloader: mockbIO, uploader: mockbIO, allocatorInterface: alloc}
+			targets, err := ct.mergeToTargets(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{}, nil, true)
+			assert.NoError(t, err)
+			require.Equal(t, 2, len(targets))
+			assert.Equal(t, UniqueID(2), targets[0].segmentID)
+			assert.NotEqual(t, UniqueID(2), targets[1].segmentID)
+			for _, target := range targets {
+				assert.Equal(t, int64(1), target.numRows)
+				assert.Equal(t, 1, len(target.insertPaths[0].GetBinlogs()))
+				assert.Equal(t, 1, len(target.statPaths))
+			}
+
+			// not split without the option
+			targets, err = ct.mergeToTargets(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{}, nil, false)
+			assert.NoError(t, err)
+			require.Equal(t, 1, len(targets))
+			assert.Equal(t, int64(2), targets[0].numRows)
+		})
+
 		t.Run("Merge with expiration", func(t *testing.T) {
 			alloc := NewAllocatorFactory(1)
 			mockbIO := &binlogIO{cm, alloc}
@@ -693,9 +771,10 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 
 			alloc.random = false // generated ID = 19530
 			task := newCompactionTask(context.TODO(), mockbIO, mockbIO, channel, mockfm, alloc, plan, nil)
-			result, err := task.compact()
+			results, err := task.compact()
 			assert.NoError(t, err)
-			assert.NotNil(t, result)
+			require.Equal(t, 1, len(results))
+			result := results[0]
 
 			assert.Equal(t, plan.GetPlanID(), result.GetPlanID())
 			assert.Equal(t, UniqueID(19530), result.GetSegmentID())
@@ -717,9 +796,10 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 			require.True(t, channel.hasSegment(c.segID2, true))
 			require.False(t, channel.hasSegment(19530, true))
 
-			result, err = task.compact()
+			results, err = task.compact()
 			assert.NoError(t, err)
-			assert.NotNil(t, result)
+			require.Equal(t, 1, len(results))
+			result = results[0]
 
 			assert.Equal(t, plan.GetPlanID(), result.GetPlanID())
 			assert.Equal(t, UniqueID(19530), result.GetSegmentID())
@@ -741,9 +821,10 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 			require.True(t, channel.hasSegment(c.segID2, true))
 			require.False(t, channel.hasSegment(19530, true))
 
-			result, err = task.compact()
+			results, err = task.compact()
 			assert.NoError(t, err)
-			assert.NotNil(t, result)
+			require.Equal(t, 1, len(results))
+			result = results[0]
 
 			assert.Equal(t, plan.GetPlanID(), result.GetPlanID())
 			assert.Equal(t, UniqueID(19530), result.GetSegmentID())
@@ -824,9 +905,10 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 
 		alloc.random = false // generated ID = 19530
 		task := newCompactionTask(context.TODO(), mockbIO, mockbIO, channel, mockfm, alloc, plan, nil)
-		result, err := task.compact()
+		results, err := task.compact()
 		assert.NoError(t, err)
-		assert.NotNil(t, result)
+		require.Equal(t, 1, len(results))
+		result := results[0]
 
 		assert.Equal(t, plan.GetPlanID(), result.GetPlanID())
 		assert.Equal(t, UniqueID(19530), result.GetSegmentID())
@@ -111,6 +111,11 @@ type DataNode struct {
 	clearSignal        chan string // vchannel name
 	segmentCache       *Cache
 	compactionExecutor *compactionExecutor
+	cpUpdater          *channelCheckpointUpdater
+	statsAggregator    *segmentStatsAggregator
+	clockSkewChecker   *clockSkewChecker
+	importTracker      *importTracker
+	decommissioner     *decommissioner
 
 	etcdCli   *clientv3.Client
 	address   string
@@ -141,10 +146,15 @@ func NewDataNode(ctx context.Context, factory dependency.Factory) *DataNode {
 		factory:            factory,
 		segmentCache:       newCache(),
 		compactionExecutor: newCompactionExecutor(),
+		importTracker:      newImportTracker(),
+		decommissioner:     newDecommissioner(),
 
 		flowgraphManager: newFlowgraphManager(),
 		clearSignal:      make(chan string, 100),
 	}
+	node.cpUpdater = newChannelCheckpointUpdater(node)
+	node.statsAggregator = newSegmentStatsAggregator(node)
+	node.clockSkewChecker = newClockSkewChecker(node)
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 	return node
 }
@@ -395,6 +405,12 @@ func (node *DataNode) handlePutEvent(watchInfo *datapb.ChannelWatchInfo, version
 	vChanName := watchInfo.GetVchan().GetChannelName()
 	switch watchInfo.State {
 	case datapb.ChannelWatchState_Uncomplete, datapb.ChannelWatchState_ToWatch:
+		if node.decommissioner.active() {
+			// DataCoord releases the vchannel and reassigns it to another node on the failure
+			log.Warn("handle put event: reject the vchannel for decommission", zap.String("vChanName", vChanName))
+			watchInfo.State = datapb.ChannelWatchState_WatchFailure
+			break
+		}
 		if err := node.flowgraphManager.addAndStart(node, watchInfo.GetVchan(), watchInfo.GetSchema()); err != nil {
 			return fmt.Errorf("fail to add and start flowgraph for vChanName: %s, err: %v", vChanName, err)
 		}
@@ -505,6 +521,10 @@ func (node *DataNode) Start() error {
 
 	go node.compactionExecutor.start(node.ctx)
 
+	node.cpUpdater.start()
+	node.statsAggregator.start()
+	node.clockSkewChecker.start()
+
 	// Start node watch node
 	go node.StartWatchChannels(node.ctx)
 
@@ -541,6 +561,9 @@ func (node *DataNode) Stop() error {
 
 	node.cancel()
 	node.flowgraphManager.dropAll()
+	node.cpUpdater.close()
+	node.statsAggregator.close()
+	node.clockSkewChecker.close()
 
 	if node.rowIDAllocator != nil {
 		log.Info("close id allocator", zap.String("role", typeutil.DataNodeRole))
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -35,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/concurrency"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 )
@@ -59,6 +62,9 @@ type dataSyncService struct {
 	flushManager     flushManager // flush manager handles flush process
 	chunkManager     storage.ChunkManager
 	compactor        *compactionExecutor // reference to compaction executor
+	cpUpdater        *channelCheckpointUpdater
+	statsAggregator  *segmentStatsAggregator
+	drain            *channelDrain // drain tracks the draining of the vchannel for decommission
 }
 
 func newDataSyncService(ctx context.Context,
@@ -73,6 +79,8 @@ func newDataSyncService(ctx context.Context,
 	flushingSegCache *Cache,
 	chunkManager storage.ChunkManager,
 	compactor *compactionExecutor,
+	cpUpdater *channelCheckpointUpdater,
+	statsAggregator *segmentStatsAggregator,
 ) (*dataSyncService, error) {
 
 	if channel == nil {
@@ -104,6 +112,9 @@ func newDataSyncService(ctx context.Context,
 		flushingSegCache: flushingSegCache,
 		chunkManager:     chunkManager,
 		compactor:        compactor,
+		cpUpdater:        cpUpdater,
+		statsAggregator:  statsAggregator,
+		drain:            newChannelDrain(),
 	}
 
 	if err := service.initNodes(vchan); err != nil {
@@ -124,6 +135,11 @@ type nodeConfig struct {
 	channel      Channel // Channel info
 	allocator    allocatorInterface
 
+	// statsAggregator sends the segment statistics to DataCoord if enabled, instead of the time ticks
+	statsAggregator *segmentStatsAggregator
+	// drain is shared by insertBufferNode and ttNode to drain the vchannel for decommission
+	drain *channelDrain
+
 	// defaults
 	parallelConfig
 }
@@ -151,14 +167,63 @@ func (dsService *dataSyncService) close() {
 		dsService.fg.Close()
 		metrics.DataNodeNumConsumers.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Dec()
 		metrics.DataNodeNumProducers.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Sub(2) // timeTickChannel + deltaChannel
+		for _, stats := range dsService.fg.NodeStats() {
+			stage := flowGraphNodeStage(stats.Name)
+			metrics.DataNodeFlowGraphNodeLatency.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), dsService.vchannelName, stage)
+			metrics.DataNodeFlowGraphNodeQueueLength.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), dsService.vchannelName, stage)
+		}
+		metrics.CleanupDataNodeDeleteValidationMetrics(paramtable.GetNodeID(), dsService.vchannelName)
 	}
 
 	dsService.clearGlobalFlushingCache()
+	// the queued checkpoint of a released vchannel shall not be sent
+	if dsService.cpUpdater != nil {
+		dsService.cpUpdater.removeTask(dsService.vchannelName)
+	}
 
 	dsService.cancelFn()
 	dsService.flushManager.close()
 }
 
+// flowGraphNodeStage returns the stage of the flowgraph node without the channel suffix, e.g. ibNode.
+func flowGraphNodeStage(nodeName string) string {
+	return strings.SplitN(nodeName, "-", 2)[0]
+}
+
+// observeFlowGraphNode exports the processing latency and the queue length of the flowgraph nodes.
+func (dsService *dataSyncService) observeFlowGraphNode(nodeName string, latency time.Duration, queueLength int) {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	stage := flowGraphNodeStage(nodeName)
+	metrics.DataNodeFlowGraphNodeLatency.WithLabelValues(nodeID, dsService.vchannelName, stage).
+		Observe(float64(latency.Milliseconds()))
+	metrics.DataNodeFlowGraphNodeQueueLength.WithLabelValues(nodeID, dsService.vchannelName, stage).
+		Set(float64(queueLength))
+}
+
+// flowGraphNodeMetrics returns the statistics of the flowgraph nodes in pipeline order.
+func (dsService *dataSyncService) flowGraphNodeMetrics() []metricsinfo.FlowGraphNodeMetrics {
+	if dsService.fg == nil {
+		return nil
+	}
+	toMs := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+	nodeStats := dsService.fg.NodeStats()
+	ret := make([]metricsinfo.FlowGraphNodeMetrics, 0, len(nodeStats))
+	for _, stats := range nodeStats {
+		ret = append(ret, metricsinfo.FlowGraphNodeMetrics{
+			Name:          stats.Name,
+			QueueLength:   stats.QueueLength,
+			QueueCapacity: stats.QueueCapacity,
+			OperateCount:  stats.OperateCount,
+			LastLatencyMs: toMs(stats.LastLatency),
+			AvgLatencyMs:  toMs(stats.AvgLatency),
+			MaxLatencyMs:  toMs(stats.MaxLatency),
+		})
+	}
+	return ret
+}
+
 func (dsService *dataSyncService) clearGlobalFlushingCache() {
 	segments := dsService.channel.listAllSegmentIDs()
 	dsService.flushingSegCache.Remove(segments...)
@@ -167,6 +232,7 @@ func (dsService *dataSyncService) clearGlobalFlushingCache() {
 // initNodes inits a TimetickedFlowGraph
 func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) error {
 	dsService.fg = flowgraph.NewTimeTickedFlowGraph(dsService.ctx)
+	dsService.fg.SetOperateObserver(dsService.observeFlowGraphNode)
 	// initialize flush manager for DataSync Service
 	dsService.flushManager = NewRendezvousFlushManager(dsService.idAllocator, dsService.chunkManager, dsService.channel,
 		flushNotifyFunc(dsService, retry.Attempts(50)), dropVirtualChannelFunc(dsService))
@@ -261,6 +327,11 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		return err
 	}
 
+	pipeline, err := getPipeline(dmlChannelType)
+	if err != nil {
+		return err
+	}
+
 	c := &nodeConfig{
 		msFactory:    dsService.msFactory,
 		collectionID: vchanInfo.GetCollectionID(),
@@ -268,6 +339,9 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		channel:      dsService.channel,
 		allocator:    dsService.idAllocator,
 
+		statsAggregator: dsService.statsAggregator,
+		drain:           dsService.drain,
+
 		parallelConfig: newParallelConfig(),
 	}
 
@@ -313,61 +387,45 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 	}
 
 	var ttNode Node
-	ttNode, err = newTTNode(c, dsService.dataCoord)
+	ttNode, err = newTTNode(c, dsService.cpUpdater)
 	if err != nil {
 		return err
 	}
 
-	dsService.fg.AddNode(dmStreamNode)
-	dsService.fg.AddNode(ddNode)
-	dsService.fg.AddNode(insertBufferNode)
-	dsService.fg.AddNode(deleteNode)
-	dsService.fg.AddNode(ttNode)
-
-	// ddStreamNode
-	err = dsService.fg.SetEdges(dmStreamNode.Name(),
-		[]string{ddNode.Name()},
-	)
-	if err != nil {
-		log.Error("set edges failed in node", zap.String("name", dmStreamNode.Name()), zap.Error(err))
-		return err
+	builtinNodes := map[string]Node{
+		pipelineInputNode:        dmStreamNode,
+		pipelineDDNode:           ddNode,
+		pipelineInsertBufferNode: insertBufferNode,
+		pipelineDeleteNode:       deleteNode,
+		pipelineTTNode:           ttNode,
 	}
-
-	// ddNode
-	err = dsService.fg.SetEdges(ddNode.Name(),
-		[]string{insertBufferNode.Name()},
-	)
-	if err != nil {
-		log.Error("set edges failed in node", zap.String("name", ddNode.Name()), zap.Error(err))
-		return err
-	}
-
-	// insertBufferNode
-	err = dsService.fg.SetEdges(insertBufferNode.Name(),
-		[]string{deleteNode.Name()},
-	)
-	if err != nil {
-		log.Error("set edges failed in node", zap.String("name", insertBufferNode.Name()), zap.Error(err))
-		return err
-	}
-
-	//deleteNode
-	err = dsService.fg.SetEdges(deleteNode.Name(),
-		[]string{ttNode.Name()},
-	)
-	if err != nil {
-		log.Error("set edges failed in node", zap.String("name", deleteNode.Name()), zap.Error(err))
-		return err
+	nodes := make([]Node, 0, len(pipeline))
+	for _, name := range pipeline {
+		node, ok := builtinNodes[name]
+		if !ok {
+			node, err = optionalPipelineNodes[name](c)
+			if err != nil {
+				return err
+			}
+		}
+		nodes = append(nodes, node)
+		dsService.fg.AddNode(node)
 	}
 
-	// ttNode
-	err = dsService.fg.SetEdges(ttNode.Name(),
-		[]string{},
-	)
-	if err != nil {
-		log.Error("set edges failed in node", zap.String("name", ttNode.Name()), zap.Error(err))
-		return err
+	// chain the nodes in the order of the pipeline
+	for i, node := range nodes {
+		nextNodes := []string{}
+		if i+1 < len(nodes) {
+			nextNodes = append(nextNodes, nodes[i+1].Name())
+		}
+		err = dsService.fg.SetEdges(node.Name(), nextNodes)
+		if err != nil {
+			log.Error("set edges failed in node", zap.String("name", node.Name()), zap.Error(err))
+			return err
+		}
 	}
+	log.Info("flowgraph pipeline constructed", zap.String("vChannelName", vchanInfo.GetChannelName()),
+		zap.Strings("pipeline", pipeline))
 	return nil
 }
 
@@ -170,6 +170,8 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 				newCache(),
 				cm,
 				newCompactionExecutor(),
+				newChannelCheckpointUpdater(&DataNode{dataCoord: df}),
+				nil,
 			)
 
 			if !test.isValidCase {
@@ -251,7 +253,7 @@ func TestDataSyncService_Start(t *testing.T) {
 	}
 
 	signalCh := make(chan string, 100)
-	sync, err := newDataSyncService(ctx, flushChan, resendTTChan, channel, allocFactory, factory, vchan, signalCh, &DataCoordFactory{}, newCache(), cm, newCompactionExecutor())
+	sync, err := newDataSyncService(ctx, flushChan, resendTTChan, channel, allocFactory, factory, vchan, signalCh, &DataCoordFactory{}, newCache(), cm, newCompactionExecutor(), newChannelCheckpointUpdater(&DataNode{dataCoord: &DataCoordFactory{}}), nil)
 
 	assert.Nil(t, err)
 	// sync.channel.addCollection(collMeta.ID, collMeta.Schema)
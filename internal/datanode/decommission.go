@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// decommissioner keeps the decommission state of the node. While decommissioning, the node rejects
+// the vchannels newly assigned to it.
+type decommissioner struct {
+	mu        sync.Mutex
+	state     datapb.DecommissionState
+	startTime time.Time
+	err       error
+	released  int
+	// channels keeps the drain states of the vchannels, they're kept after the flowgraphs are released
+	channels map[string]*channelDrain
+	doneCh   chan struct{}
+}
+
+func newDecommissioner() *decommissioner {
+	return &decommissioner{
+		state:    datapb.DecommissionState_DecommissionNone,
+		channels: make(map[string]*channelDrain),
+	}
+}
+
+// active returns whether the decommission is started, the node doesn't accept new vchannels since then.
+func (d *decommissioner) active() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state != datapb.DecommissionState_DecommissionNone
+}
+
+// startDecommission drains all the vchannels of the node: every flowgraph stops buffering new data after its buffers
+// are flushed and its checkpoint is sent to DataCoord. Then the session is marked stopping, so that DataCoord
+// reassigns the vchannels, and the flowgraphs are released. A failed decommission is started again by the next call.
+// It returns the channel closed when the decommission is finished or failed.
+func (node *DataNode) startDecommission() <-chan struct{} {
+	d := node.decommissioner
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.state == datapb.DecommissionState_DecommissionDraining || d.state == datapb.DecommissionState_DecommissionReady {
+		return d.doneCh
+	}
+	d.state = datapb.DecommissionState_DecommissionDraining
+	d.startTime = time.Now()
+	d.err = nil
+	d.doneCh = make(chan struct{})
+	go node.decommission(d.doneCh)
+	log.Info("DataNode start decommission")
+	return d.doneCh
+}
+
+func (node *DataNode) decommission(doneCh chan struct{}) {
+	d := node.decommissioner
+	err := node.drainChannels()
+	if err == nil {
+		// DataCoord reassigns the vchannels of the node once its session is stopping
+		err = node.session.GoingStop()
+	}
+
+	released := 0
+	if err == nil {
+		d.mu.Lock()
+		vChannels := make([]string, 0, len(d.channels))
+		for vChannel := range d.channels {
+			vChannels = append(vChannels, vChannel)
+		}
+		d.mu.Unlock()
+		for _, vChannel := range vChannels {
+			node.tryToReleaseFlowgraph(vChannel)
+			released++
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.released += released
+	if err != nil {
+		log.Warn("DataNode decommission failed", zap.Error(err))
+		d.state = datapb.DecommissionState_DecommissionFailed
+		d.err = err
+	} else {
+		log.Info("DataNode decommission done, ready to terminate", zap.Int("releasedFlowgraphs", released),
+			zap.Duration("elapsed", time.Since(d.startTime)))
+		d.state = datapb.DecommissionState_DecommissionReady
+	}
+	close(doneCh)
+}
+
+// drainChannels requests all the flowgraphs to drain and waits for them within dataNode.decommission.timeout.
+func (node *DataNode) drainChannels() error {
+	d := node.decommissioner
+	waits := make(map[string]<-chan struct{})
+	node.flowgraphManager.flowgraphs.Range(func(key, value interface{}) bool {
+		ds := value.(*dataSyncService)
+		d.mu.Lock()
+		d.channels[ds.vchannelName] = ds.drain
+		d.mu.Unlock()
+		waits[ds.vchannelName] = ds.drain.request()
+		return true
+	})
+	log.Info("DataNode draining vchannels", zap.Int("channelNum", len(waits)))
+
+	timeout := Params.DataNodeCfg.DecommissionTimeout.GetAsDuration(time.Second)
+	ctx, cancel := context.WithTimeout(node.ctx, timeout)
+	defer cancel()
+	for vChannel, doneCh := range waits {
+		select {
+		case <-doneCh:
+		case <-ctx.Done():
+			return fmt.Errorf("drain vchannel %s timeout after %v: %w", vChannel, timeout, ctx.Err())
+		}
+	}
+
+	var failed []string
+	d.mu.Lock()
+	for vChannel := range waits {
+		if state, _, _ := d.channels[vChannel].status(); state != drainStateDrained {
+			failed = append(failed, vChannel)
+		}
+	}
+	d.mu.Unlock()
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("failed to drain vchannels %v", failed)
+	}
+	return nil
+}
+
+func (node *DataNode) decommissionState() *datapb.DecommissionResponse {
+	d := node.decommissioner
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	resp := &datapb.DecommissionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		State:              d.state,
+		Channels:           make([]*datapb.ChannelDecommission, 0, len(d.channels)),
+		ReleasedFlowgraphs: int64(d.released),
+	}
+	if d.state != datapb.DecommissionState_DecommissionNone {
+		resp.StartTime = d.startTime.UnixMilli()
+	}
+	if d.err != nil {
+		resp.Error = d.err.Error()
+	}
+	for vChannel, drain := range d.channels {
+		state, checkpoint, err := drain.status()
+		channel := &datapb.ChannelDecommission{
+			Channel:      vChannel,
+			State:        state,
+			CheckpointTs: checkpoint.GetTimestamp(),
+		}
+		if err != nil {
+			channel.Error = err.Error()
+		}
+		resp.Channels = append(resp.Channels, channel)
+	}
+	sort.Slice(resp.Channels, func(i, j int) bool { return resp.Channels[i].Channel < resp.Channels[j].Channel })
+	return resp
+}
+
+// Decommission starts the decommission of the node if the request asks to, and returns the decommission state.
+// With wait, it returns once the decommission is finished or failed, or the request is cancelled.
+func (node *DataNode) Decommission(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	if req.GetStart() {
+		if !node.isHealthy() {
+			log.Warn("DataNode.Decommission failed", zap.Error(errDataNodeIsUnhealthy(paramtable.GetNodeID())))
+			return &datapb.DecommissionResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    msgDataNodeIsUnhealthy(paramtable.GetNodeID()),
+				},
+			}, nil
+		}
+		doneCh := node.startDecommission()
+		if req.GetWait() {
+			select {
+			case <-doneCh:
+			case <-ctx.Done():
+			}
+		}
+	}
+	return node.decommissionState(), nil
+}
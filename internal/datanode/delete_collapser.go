@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"math"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// deleteCollapser drops the repeated deletes of a primary key from the merged delta log of a compaction.
+// A delete hides the rows of its primary key inserted before it from the reads at or after it, so for every
+// row kept by the compaction only the earliest delete after the row is needed, the later deletes of the
+// primary key hide nothing more, not even from the time travel reads. The latest delete is kept if no row of
+// the primary key is kept. The primary keys deleted only once are not tracked.
+type deleteCollapser struct {
+	pk2tss map[interface{}][]Timestamp
+	needed map[interface{}]map[Timestamp]struct{}
+}
+
+// newDeleteCollapser returns nil if no primary key of delData is deleted more than once.
+func newDeleteCollapser(delData *DeleteData) *deleteCollapser {
+	counts := make(map[interface{}]int)
+	for _, pk := range delData.Pks {
+		counts[pk.GetValue()]++
+	}
+	pk2tss := make(map[interface{}][]Timestamp)
+	for i, pk := range delData.Pks {
+		if counts[pk.GetValue()] > 1 {
+			pk2tss[pk.GetValue()] = append(pk2tss[pk.GetValue()], delData.Tss[i])
+		}
+	}
+	if len(pk2tss) == 0 {
+		return nil
+	}
+	for _, tss := range pk2tss {
+		sort.Slice(tss, func(i, j int) bool { return tss[i] < tss[j] })
+	}
+	return &deleteCollapser{
+		pk2tss: pk2tss,
+		needed: make(map[interface{}]map[Timestamp]struct{}),
+	}
+}
+
+// observe records the delete needed by a row kept by the compaction.
+func (c *deleteCollapser) observe(pk interface{}, ts Timestamp) {
+	if c == nil {
+		return
+	}
+	tss, ok := c.pk2tss[pk]
+	if !ok {
+		return
+	}
+	idx := sort.Search(len(tss), func(i int) bool { return tss[i] > ts })
+	if idx == len(tss) {
+		return
+	}
+	if _, ok := c.needed[pk]; !ok {
+		c.needed[pk] = make(map[Timestamp]struct{})
+	}
+	c.needed[pk][tss[idx]] = struct{}{}
+}
+
+// collapse returns the delta buffer without the repeated deletes, dbuff itself if c is nil.
+func (c *deleteCollapser) collapse(dbuff *DelDataBuf) *DelDataBuf {
+	if c == nil {
+		return dbuff
+	}
+	collapsed := &DelDataBuf{
+		delData: &DeleteData{
+			Pks: make([]primaryKey, 0),
+			Tss: make([]Timestamp, 0)},
+		Binlog: datapb.Binlog{
+			TimestampFrom: math.MaxUint64,
+			TimestampTo:   0,
+		},
+	}
+	kept := make(map[interface{}]map[Timestamp]struct{})
+	for i, pk := range dbuff.delData.Pks {
+		ts := dbuff.delData.Tss[i]
+		if tss, ok := c.pk2tss[pk.GetValue()]; ok {
+			needed, ok := c.needed[pk.GetValue()]
+			if !ok {
+				needed = map[Timestamp]struct{}{tss[len(tss)-1]: {}}
+				c.needed[pk.GetValue()] = needed
+			}
+			if _, ok := needed[ts]; !ok {
+				continue
+			}
+			if _, ok := kept[pk.GetValue()]; !ok {
+				kept[pk.GetValue()] = make(map[Timestamp]struct{})
+			}
+			if _, ok := kept[pk.GetValue()][ts]; ok {
+				continue
+			}
+			kept[pk.GetValue()][ts] = struct{}{}
+		}
+
+		collapsed.delData.Append(pk, ts)
+		if ts < collapsed.TimestampFrom {
+			collapsed.TimestampFrom = ts
+		}
+		if ts > collapsed.TimestampTo {
+			collapsed.TimestampTo = ts
+		}
+	}
+	collapsed.accumulateEntriesNum(collapsed.delData.RowCount)
+	return collapsed
+}
@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCollapser(t *testing.T) {
+	newDelDataBuf := func(pks []int64, tss []Timestamp) *DelDataBuf {
+		dbuff := &DelDataBuf{delData: &DeleteData{}}
+		for i, pk := range pks {
+			dbuff.delData.Append(newInt64PrimaryKey(pk), tss[i])
+		}
+		dbuff.accumulateEntriesNum(dbuff.delData.RowCount)
+		return dbuff
+	}
+
+	t.Run("no repeated delete", func(t *testing.T) {
+		dbuff := newDelDataBuf([]int64{1, 2}, []Timestamp{100, 100})
+		c := newDeleteCollapser(dbuff.delData)
+		assert.Nil(t, c)
+		c.observe(int64(1), 50)
+		assert.Same(t, dbuff, c.collapse(dbuff))
+	})
+
+	t.Run("collapse", func(t *testing.T) {
+		dbuff := newDelDataBuf(
+			[]int64{1, 2, 3, 4, 1, 2, 3, 5, 1, 5},
+			[]Timestamp{300, 100, 100, 100, 100, 200, 200, 100, 200, 100})
+		c := newDeleteCollapser(dbuff.delData)
+		require.NotNil(t, c)
+
+		// pk 1 is inserted before all of its deletes, pk 2 is inserted again between its deletes
+		c.observe(int64(1), 50)
+		c.observe(int64(2), 50)
+		c.observe(int64(2), 150)
+		c.observe(int64(4), 50)
+		// inserted after all of the deletes
+		c.observe(int64(5), 150)
+
+		collapsed := c.collapse(dbuff)
+		// the latest delete of pk 3 and pk 5 is kept, the duplicated delete of pk 5 is dropped
+		assert.Equal(t, int64(6), collapsed.GetEntriesNum())
+		assert.Equal(t, int64(6), collapsed.delData.RowCount)
+		assert.Equal(t, []primaryKey{newInt64PrimaryKey(2), newInt64PrimaryKey(4), newInt64PrimaryKey(1),
+			newInt64PrimaryKey(2), newInt64PrimaryKey(3), newInt64PrimaryKey(5)}, collapsed.delData.Pks)
+		assert.Equal(t, []Timestamp{100, 100, 100, 200, 200, 100}, collapsed.delData.Tss)
+		assert.Equal(t, Timestamp(100), collapsed.GetTimestampFrom())
+		assert.Equal(t, Timestamp(200), collapsed.GetTimestampTo())
+	})
+}
+
+func TestMergeDeltalogs_LatestDeleteBeforeTimetravel(t *testing.T) {
+	blobs, err := getInt64DeltaBlobs(100, []UniqueID{1, 1, 1}, []Timestamp{20000, 30000, 10000})
+	require.NoError(t, err)
+
+	task := &compactionTask{}
+	pk2ts, db, err := task.mergeDeltalogs(map[UniqueID][]*Blob{100: blobs}, 40000)
+	require.NoError(t, err)
+	assert.Equal(t, map[interface{}]Timestamp{int64(1): 30000}, pk2ts)
+	assert.Equal(t, int64(0), db.GetEntriesNum())
+}
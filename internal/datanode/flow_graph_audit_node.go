@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+)
+
+// pipelineAuditNode is the name of the audit node in the pipeline descriptors.
+const pipelineAuditNode = "audit"
+
+// make sure auditNode implements flowgraph.Node
+var _ flowgraph.Node = (*auditNode)(nil)
+
+// auditNode logs the data changes carried by the flowgraph messages passing through it, it is an optional node.
+type auditNode struct {
+	BaseNode
+	vChannelName string
+}
+
+// Name returns node name, implementing flowgraph.Node
+func (an *auditNode) Name() string {
+	return fmt.Sprintf("auditNode-%s", an.vChannelName)
+}
+
+// Operate handles input messages, implementing flowgraph.Node
+func (an *auditNode) Operate(in []Msg) []Msg {
+	if len(in) != 1 {
+		log.Warn("Invalid operate message input in auditNode", zap.Int("input length", len(in)))
+		return []Msg{}
+	}
+
+	fgMsg, ok := in[0].(*flowGraphMsg)
+	if !ok {
+		log.Warn("type assertion failed for flowGraphMsg", zap.String("name", reflect.TypeOf(in[0]).Name()))
+		return []Msg{}
+	}
+
+	var insertRows, deleteRows int64
+	for _, msg := range fgMsg.insertMessages {
+		insertRows += int64(msg.NRows())
+	}
+	for _, msg := range fgMsg.deleteMessages {
+		deleteRows += msg.GetNumRows()
+	}
+	if insertRows > 0 || deleteRows > 0 || fgMsg.dropCollection || len(fgMsg.dropPartitions) > 0 {
+		log.Info("audit data changes",
+			zap.String("vChannelName", an.vChannelName),
+			zap.Uint64("timestampMin", fgMsg.timeRange.timestampMin),
+			zap.Uint64("timestampMax", fgMsg.timeRange.timestampMax),
+			zap.Int("insertMessages", len(fgMsg.insertMessages)),
+			zap.Int64("insertRows", insertRows),
+			zap.Int("deleteMessages", len(fgMsg.deleteMessages)),
+			zap.Int64("deleteRows", deleteRows),
+			zap.Bool("dropCollection", fgMsg.dropCollection),
+			zap.Int64s("dropPartitions", fgMsg.dropPartitions))
+	}
+	return in
+}
+
+func newAuditNode(config *nodeConfig) *auditNode {
+	baseNode := BaseNode{}
+	baseNode.SetMaxQueueLength(Params.DataNodeCfg.FlowGraphMaxQueueLength.GetAsInt32())
+	baseNode.SetMaxParallelism(Params.DataNodeCfg.FlowGraphMaxParallelism.GetAsInt32())
+
+	return &auditNode{
+		BaseNode:     baseNode,
+		vChannelName: config.vChannelName,
+	}
+}
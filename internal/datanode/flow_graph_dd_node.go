@@ -26,6 +26,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/opentracing/opentracing-go"
+	oplog "github.com/opentracing/opentracing-go/log"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -107,6 +108,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 	var spans []opentracing.Span
 	for _, msg := range msMsg.TsMessages() {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		sp.LogFields(oplog.String("node name", ddn.Name()))
 		spans = append(spans, sp)
 		msg.SetTraceCtx(ctx)
 	}
@@ -145,6 +147,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 
 				pChan := funcutil.ToPhysicalChannel(ddn.vChannelName)
 				metrics.CleanupDataNodeCollectionMetrics(paramtable.GetNodeID(), ddn.collectionID, pChan)
+				flushStats.removeCollection(ddn.collectionID)
 			}
 
 		case commonpb.MsgType_DropPartition:
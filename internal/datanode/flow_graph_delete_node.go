@@ -22,12 +22,15 @@ import (
 	"reflect"
 
 	"github.com/opentracing/opentracing-go"
+	oplog "github.com/opentracing/opentracing-go/log"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -44,6 +47,11 @@ type deleteNode struct {
 	flushManager     flushManager
 
 	clearSignal chan<- string
+
+	// delete rows checked against segment bloom filters since the node started,
+	// only counted when delete validation is enabled
+	validatedDeleteRows int64
+	unmatchedDeleteRows int64
 }
 
 func (dn *deleteNode) Name() string {
@@ -88,6 +96,7 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 	var spans []opentracing.Span
 	for _, msg := range fgMsg.deleteMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		sp.LogFields(oplog.String("node name", dn.Name()))
 		spans = append(spans, sp)
 		msg.SetTraceCtx(ctx)
 	}
@@ -170,6 +179,10 @@ func (dn *deleteNode) updateCompactedSegments() {
 			continue
 		}
 
+		// the deletes of the compacted segments may hit any target of a split compaction
+		for _, splitTo := range dn.channel.listSplitSegmentIDs(compactedTo) {
+			dn.delBufferManager.CopySegBuf(splitTo, compactedFrom)
+		}
 		dn.delBufferManager.CompactSegBuf(compactedTo, compactedFrom)
 		log.Info("update delBuf for compacted segments",
 			zap.Int64("compactedTo segmentID", compactedTo),
@@ -207,19 +220,59 @@ func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []primaryKey, tss [
 	segID2Pks := make(map[UniqueID][]primaryKey)
 	segID2Tss := make(map[UniqueID][]uint64)
 	segments := dn.channel.filterSegments(partID)
+	var unmatched int64
 	for index, pk := range pks {
+		matched := false
 		for _, segment := range segments {
 			segmentID := segment.segmentID
 			if segment.isPKExist(pk) {
+				matched = true
 				segID2Pks[segmentID] = append(segID2Pks[segmentID], pk)
 				segID2Tss[segmentID] = append(segID2Tss[segmentID], tss[index])
 			}
 		}
+		if !matched {
+			unmatched++
+		}
+	}
+
+	if Params.DataNodeCfg.DeleteValidationEnabled.GetAsBool() {
+		dn.recordDeleteValidation(int64(len(pks)), unmatched)
 	}
 
 	return segID2Pks, segID2Tss
 }
 
+// recordDeleteValidation accumulates the delete rows which match no segment bloom filter and exports the metrics.
+// Bloom filters may report false positives, so the unmatched count is a lower bound of the deletes on non-existent keys.
+func (dn *deleteNode) recordDeleteValidation(total, unmatched int64) {
+	if total == 0 {
+		return
+	}
+	dn.validatedDeleteRows += total
+	dn.unmatchedDeleteRows += unmatched
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.DataNodeValidatedDeleteRowsCount.WithLabelValues(nodeID, dn.channelName, metrics.MatchedDeleteLabel).Add(float64(total - unmatched))
+	metrics.DataNodeValidatedDeleteRowsCount.WithLabelValues(nodeID, dn.channelName, metrics.UnmatchedDeleteLabel).Add(float64(unmatched))
+	metrics.DataNodeUnmatchedDeleteRatio.WithLabelValues(nodeID, dn.channelName).Set(dn.unmatchedDeleteRatio())
+
+	if unmatched > 0 {
+		log.Debug("delete rows match no segment",
+			zap.String("vChannelName", dn.channelName),
+			zap.Int64("unmatched", unmatched),
+			zap.Int64("total", total))
+	}
+}
+
+// unmatchedDeleteRatio returns the fraction of validated delete rows which match no segment.
+func (dn *deleteNode) unmatchedDeleteRatio() float64 {
+	if dn.validatedDeleteRows == 0 {
+		return 0
+	}
+	return float64(dn.unmatchedDeleteRows) / float64(dn.validatedDeleteRows)
+}
+
 func newDeleteNode(ctx context.Context, fm flushManager, delBufManager *DelBufferManager, sig chan<- string, config *nodeConfig) (*deleteNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxQueueLength(config.maxQueueLength)
@@ -251,6 +251,39 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		}
 	})
 
+	t.Run("Test validate deletes against bloom filters", func(te *testing.T) {
+		paramtable.Get().Save(Params.DataNodeCfg.DeleteValidationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.DataNodeCfg.DeleteValidationEnabled.Key)
+
+		c := &nodeConfig{
+			channel:      channel,
+			allocator:    &allocator{},
+			vChannelName: chanName,
+		}
+		delBufManager := &DelBufferManager{
+			channel:       channel,
+			delMemorySize: 0,
+			delBufHeap:    &PriorityQueue{},
+		}
+		dn, err := newDeleteNode(context.Background(), fm, delBufManager, make(chan string, 1), c)
+		assert.Nil(te, err)
+		assert.Equal(te, float64(0), dn.unmatchedDeleteRatio())
+
+		dn.filterSegmentByPK(0, int64Pks, tss)
+		assert.Equal(te, int64(5), dn.validatedDeleteRows)
+		assert.Equal(te, int64(0), dn.unmatchedDeleteRows)
+
+		missingPks := []primaryKey{newInt64PrimaryKey(-1), newInt64PrimaryKey(100000)}
+		dn.filterSegmentByPK(0, missingPks, []uint64{1, 1})
+		assert.Equal(te, int64(7), dn.validatedDeleteRows)
+		assert.Equal(te, int64(2), dn.unmatchedDeleteRows)
+		assert.InDelta(te, 2.0/7.0, dn.unmatchedDeleteRatio(), 1e-9)
+
+		paramtable.Get().Save(Params.DataNodeCfg.DeleteValidationEnabled.Key, "false")
+		dn.filterSegmentByPK(0, missingPks, []uint64{1, 1})
+		assert.Equal(te, int64(7), dn.validatedDeleteRows)
+	})
+
 	t.Run("Test deleteNode Operate valid Msg with failure", func(te *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/opentracing/opentracing-go"
+	oplog "github.com/opentracing/opentracing-go/log"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -62,6 +63,9 @@ type insertBufferNode struct {
 	ttLogger       *timeTickLogger
 	ttMerger       *mergedTimeTickerSender
 
+	statsAggregator *segmentStatsAggregator
+	drain           *channelDrain
+
 	syncPolicies  []segmentSyncPolicy
 	lastTimestamp Timestamp
 }
@@ -116,6 +120,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 	var spans []opentracing.Span
 	for _, msg := range fgMsg.insertMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		sp.LogFields(oplog.String("node name", ibNode.Name()))
 		spans = append(spans, sp)
 		msg.SetTraceCtx(ctx)
 	}
@@ -136,6 +141,18 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 	}
 	fgMsg.endPositions = endPositions
 
+	// the vchannel is drained for decommission, the data consumed is left to the node it's reassigned to
+	if ibNode.drain.drained() {
+		for _, sp := range spans {
+			sp.Finish()
+		}
+		return []Msg{&flowGraphMsg{
+			timeRange:      fgMsg.timeRange,
+			startPositions: fgMsg.startPositions,
+			endPositions:   fgMsg.endPositions,
+		}}
+	}
+
 	if startPositions[0].Timestamp < ibNode.lastTimestamp {
 		// message stream should guarantee that this should not happen
 		err := fmt.Errorf("insert buffer node consumed old messages, channel = %s, timestamp = %d, lastTimestamp = %d",
@@ -168,7 +185,11 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 
 	ibNode.DisplayStatistics(seg2Upload)
 
+	fgMsg.drain = ibNode.drain.syncing()
 	segmentsToSync := ibNode.Sync(fgMsg, seg2Upload, endPositions[0])
+	if fgMsg.drain {
+		ibNode.drain.synced(startPositions[0])
+	}
 
 	ibNode.WriteTimeTick(fgMsg.timeRange.timestampMax, seg2Upload)
 
@@ -386,6 +407,21 @@ func (ibNode *insertBufferNode) FillInSyncTasks(fgMsg *flowGraphMsg, seg2Upload
 	})
 	mergeSyncTask(syncSegmentIDs, syncTasks, func(task *syncTask) {})
 
+	if fgMsg.drain {
+		// sync all the segments with buffered data for decommission
+		var segmentIDs []UniqueID
+		for _, segID := range ibNode.channel.listAllSegmentIDs() {
+			if ibNode.GetBuffer(segID) != nil || ibNode.delBufferManager.GetEntriesNum(segID) > 0 {
+				segmentIDs = append(segmentIDs, segID)
+			}
+		}
+		log.Info("(Drain) syncing all the buffered segments",
+			zap.Int64s("segmentIDs", segmentIDs),
+			zap.String("channel", ibNode.channelName),
+		)
+		mergeSyncTask(segmentIDs, syncTasks, func(task *syncTask) {})
+	}
+
 	// process drop partition
 	for _, partitionDrop := range fgMsg.dropPartitions {
 		segmentIDs := ibNode.channel.listPartitionSegments(partitionDrop)
@@ -406,6 +442,7 @@ func (ibNode *insertBufferNode) FillInSyncTasks(fgMsg *flowGraphMsg, seg2Upload
 func (ibNode *insertBufferNode) Sync(fgMsg *flowGraphMsg, seg2Upload []UniqueID, endPosition *internalpb.MsgPosition) []UniqueID {
 	syncTasks := ibNode.FillInSyncTasks(fgMsg, seg2Upload)
 	segmentsToSync := make([]UniqueID, 0, len(syncTasks))
+	ibNode.reportSealedSegmentStats(syncTasks)
 
 	for _, task := range syncTasks {
 		log.Info("insertBufferNode syncing BufferData",
@@ -458,6 +495,27 @@ func (ibNode *insertBufferNode) Sync(fgMsg *flowGraphMsg, seg2Upload []UniqueID,
 	return segmentsToSync
 }
 
+// reportSealedSegmentStats sends the row counts of the segments being sealed to DataCoord right away through
+// the statistics aggregator, instead of waiting for the next aggregation interval.
+func (ibNode *insertBufferNode) reportSealedSegmentStats(syncTasks map[UniqueID]*syncTask) {
+	if !ibNode.statsAggregator.enabled() {
+		return
+	}
+	stats := make([]*datapb.SegmentStats, 0)
+	for _, task := range syncTasks {
+		if !task.flushed || task.dropped {
+			continue
+		}
+		stat, err := ibNode.channel.getSegmentStatisticsUpdates(task.segmentID)
+		if err != nil {
+			log.Warn("failed to get segment statistics info", zap.Int64("segmentID", task.segmentID), zap.Error(err))
+			continue
+		}
+		stats = append(stats, stat)
+	}
+	ibNode.statsAggregator.seal(stats)
+}
+
 // updateSegmentStates updates statistics in channel meta for the segments in insertMsgs.
 //
 //	If the segment doesn't exist, a new segment will be created.
@@ -634,6 +692,11 @@ func newInsertBufferNode(ctx context.Context, collID UniqueID, delBufManager *De
 			}
 			stats = append(stats, stat)
 		}
+		// the statistics are coalesced with the ones of the other vchannels and sent to DataCoord by the aggregator
+		if config.statsAggregator.enabled() {
+			config.statsAggregator.add(stats)
+			stats = nil
+		}
 		msgPack := msgstream.MsgPack{}
 		timeTickMsg := msgstream.DataNodeTtMsg{
 			BaseMsg: msgstream.BaseMsg{
@@ -679,5 +742,7 @@ func newInsertBufferNode(ctx context.Context, collID UniqueID, delBufManager *De
 		channelName:      config.vChannelName,
 		ttMerger:         mt,
 		ttLogger:         &timeTickLogger{vChannelName: config.vChannelName},
+		statsAggregator:  config.statsAggregator,
+		drain:            config.drain,
 	}, nil
 }
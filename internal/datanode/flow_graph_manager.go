@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 
 	"go.uber.org/zap"
@@ -48,7 +49,7 @@ func (fm *flowgraphManager) addAndStart(dn *DataNode, vchan *datapb.VchannelInfo
 	var alloc allocatorInterface = newAllocator(dn.rootCoord)
 
 	dataSyncService, err := newDataSyncService(dn.ctx, make(chan flushMsg, 100), make(chan resendTTMsg, 100), channel,
-		alloc, dn.factory, vchan, dn.clearSignal, dn.dataCoord, dn.segmentCache, dn.chunkManager, dn.compactionExecutor)
+		alloc, dn.factory, vchan, dn.clearSignal, dn.dataCoord, dn.segmentCache, dn.chunkManager, dn.compactionExecutor, dn.cpUpdater, dn.statsAggregator)
 	if err != nil {
 		log.Warn("new data sync service fail", zap.String("vChannelName", vchan.GetChannelName()), zap.Error(err))
 		return err
@@ -154,6 +155,16 @@ func (fm *flowgraphManager) getFlowGraphNum() int {
 	return length
 }
 
+// getFlowGraphNodeMetrics returns the node statistics of all the flow graphs by virtual channel.
+func (fm *flowgraphManager) getFlowGraphNodeMetrics() map[string][]metricsinfo.FlowGraphNodeMetrics {
+	ret := make(map[string][]metricsinfo.FlowGraphNodeMetrics)
+	fm.flowgraphs.Range(func(key, value interface{}) bool {
+		ret[key.(string)] = value.(*dataSyncService).flowGraphNodeMetrics()
+		return true
+	})
+	return ret
+}
+
 func (fm *flowgraphManager) dropAll() {
 	log.Info("start drop all flowgraph resources in DataNode")
 	fm.flowgraphs.Range(func(key, value interface{}) bool {
@@ -71,6 +71,27 @@ func TestFlowGraphManager(t *testing.T) {
 		fm.dropAll()
 	})
 
+	t.Run("Test getFlowGraphNodeMetrics", func(t *testing.T) {
+		vchanName := "by-dev-rootcoord-dml-test-flowgraphmanager-nodeMetrics"
+		vchan := &datapb.VchannelInfo{
+			CollectionID: 1,
+			ChannelName:  vchanName,
+		}
+		require.False(t, fm.exist(vchanName))
+
+		err := fm.addAndStart(node, vchan, nil)
+		assert.NoError(t, err)
+
+		nodeMetrics := fm.getFlowGraphNodeMetrics()
+		require.Contains(t, nodeMetrics, vchanName)
+		stages := make([]string, 0, len(nodeMetrics[vchanName]))
+		for _, m := range nodeMetrics[vchanName] {
+			stages = append(stages, flowGraphNodeStage(m.Name))
+		}
+		assert.Equal(t, []string{"dmInputNode", "ddNode", "ibNode", "deleteNode", "ttNode"}, stages)
+		fm.dropAll()
+	})
+
 	t.Run("Test Release", func(t *testing.T) {
 		vchanName := "by-dev-rootcoord-dml-test-flowgraphmanager-Release"
 		vchan := &datapb.VchannelInfo{
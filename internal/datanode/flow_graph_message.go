@@ -50,6 +50,8 @@ type flowGraphMsg struct {
 	segmentsToSync []UniqueID
 	dropCollection bool
 	dropPartitions []UniqueID
+	// drain is set by insertBufferNode to sync all the buffered segments for decommission
+	drain bool
 }
 
 func (fgMsg *flowGraphMsg) TimeTick() Timestamp {
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// dmlChannelType is the channel type of the virtual channels of the collections.
+const dmlChannelType = "dml"
+
+// the built-in nodes of the flowgraph pipelines
+const (
+	pipelineInputNode        = "input"
+	pipelineDDNode           = "dd"
+	pipelineInsertBufferNode = "insertBuffer"
+	pipelineDeleteNode       = "delete"
+	pipelineTTNode           = "tt"
+)
+
+// builtinPipelineNodes are the nodes every pipeline is made of in this order. The optional nodes consume and produce
+// flowGraphMsg, so they are placed after the dd node, which turns the stream messages into flowGraphMsg,
+// and before the tt node, which consumes the messages.
+var builtinPipelineNodes = []string{
+	pipelineInputNode,
+	pipelineDDNode,
+	pipelineInsertBufferNode,
+	pipelineDeleteNode,
+	pipelineTTNode,
+}
+
+// optionalNodeBuilder builds an optional node of the flowgraph of a channel.
+type optionalNodeBuilder func(config *nodeConfig) (Node, error)
+
+// optionalPipelineNodes are the optional nodes which can be enabled in the pipelines by name.
+var optionalPipelineNodes = map[string]optionalNodeBuilder{
+	pipelineAuditNode: func(config *nodeConfig) (Node, error) {
+		return newAuditNode(config), nil
+	},
+}
+
+// pipelineParams are the pipeline descriptors by channel type.
+var pipelineParams = map[string]*paramtable.ParamItem{
+	dmlChannelType: &Params.DataNodeCfg.FlowGraphDMLPipeline,
+}
+
+// getPipeline returns the nodes of the flowgraph of the channel type in order, the built-in nodes only if the pipeline
+// of the channel type is not configured.
+func getPipeline(channelType string) ([]string, error) {
+	param, ok := pipelineParams[channelType]
+	if !ok {
+		return nil, fmt.Errorf("unknown channel type %s", channelType)
+	}
+	value := strings.TrimSpace(param.GetValue())
+	if value == "" {
+		return builtinPipelineNodes, nil
+	}
+	pipeline := make([]string, 0)
+	for _, name := range strings.Split(value, ",") {
+		pipeline = append(pipeline, strings.TrimSpace(name))
+	}
+	if err := validatePipeline(pipeline); err != nil {
+		return nil, fmt.Errorf("invalid pipeline %s of %s channels: %w", param.Key, channelType, err)
+	}
+	return pipeline, nil
+}
+
+// validatePipeline checks the built-in nodes are in order and the optional nodes are known and placed between the dd node
+// and the tt node.
+func validatePipeline(pipeline []string) error {
+	seen := make(map[string]struct{}, len(pipeline))
+	builtin := 0
+	for i, name := range pipeline {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("node %s is duplicated", name)
+		}
+		seen[name] = struct{}{}
+		if builtin < len(builtinPipelineNodes) && name == builtinPipelineNodes[builtin] {
+			builtin++
+			continue
+		}
+		if funcutil.SliceContain(builtinPipelineNodes, name) {
+			return fmt.Errorf("built-in node %s is out of order", name)
+		}
+		if _, ok := optionalPipelineNodes[name]; !ok {
+			return fmt.Errorf("unknown node %s at position %d", name, i)
+		}
+		if builtin < 2 || builtin == len(builtinPipelineNodes) {
+			return fmt.Errorf("optional node %s must be placed between %s and %s", name, pipelineDDNode, pipelineTTNode)
+		}
+	}
+	if builtin != len(builtinPipelineNodes) {
+		return fmt.Errorf("the built-in nodes %s are required in order", strings.Join(builtinPipelineNodes, ","))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestGetPipeline(t *testing.T) {
+	pipeline, err := getPipeline(dmlChannelType)
+	require.NoError(t, err)
+	assert.Equal(t, builtinPipelineNodes, pipeline)
+
+	_, err = getPipeline("unknown")
+	assert.Error(t, err)
+
+	paramtable.Get().Save(Params.DataNodeCfg.FlowGraphDMLPipeline.Key, "input, dd, audit, insertBuffer, delete, tt")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.FlowGraphDMLPipeline.Key)
+	pipeline, err = getPipeline(dmlChannelType)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"input", "dd", "audit", "insertBuffer", "delete", "tt"}, pipeline)
+
+	paramtable.Get().Save(Params.DataNodeCfg.FlowGraphDMLPipeline.Key, "input,dd,insertBuffer,tt")
+	_, err = getPipeline(dmlChannelType)
+	assert.Error(t, err)
+}
+
+func TestValidatePipeline(t *testing.T) {
+	valids := [][]string{
+		{"input", "dd", "insertBuffer", "delete", "tt"},
+		{"input", "dd", "audit", "insertBuffer", "delete", "tt"},
+		{"input", "dd", "insertBuffer", "delete", "audit", "tt"},
+	}
+	for _, pipeline := range valids {
+		assert.NoError(t, validatePipeline(pipeline), pipeline)
+	}
+
+	invalids := [][]string{
+		{},
+		{"input", "dd", "insertBuffer", "delete"},
+		{"input", "dd", "delete", "insertBuffer", "tt"},
+		{"input", "audit", "dd", "insertBuffer", "delete", "tt"},
+		{"input", "dd", "insertBuffer", "delete", "tt", "audit"},
+		{"input", "dd", "audit", "insertBuffer", "audit", "delete", "tt"},
+		{"input", "dd", "dedup", "insertBuffer", "delete", "tt"},
+	}
+	for _, pipeline := range invalids {
+		assert.Error(t, validatePipeline(pipeline), pipeline)
+	}
+}
+
+func TestAuditNode(t *testing.T) {
+	node := newAuditNode(&nodeConfig{vChannelName: "vchan"})
+	assert.Equal(t, "auditNode-vchan", node.Name())
+
+	fgMsg := &flowGraphMsg{
+		insertMessages: []*msgstream.InsertMsg{{InsertRequest: internalpb.InsertRequest{NumRows: 10}}},
+		deleteMessages: []*msgstream.DeleteMsg{{DeleteRequest: internalpb.DeleteRequest{NumRows: 2}}},
+		timeRange:      TimeRange{timestampMin: 1, timestampMax: 2},
+	}
+	out := node.Operate([]Msg{fgMsg})
+	require.Equal(t, 1, len(out))
+	assert.Equal(t, fgMsg, out[0])
+
+	assert.Equal(t, 0, len(node.Operate([]Msg{})))
+	assert.Equal(t, 0, len(node.Operate([]Msg{&flowgraph.MsgStreamMsg{}})))
+}
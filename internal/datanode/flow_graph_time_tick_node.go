@@ -17,21 +17,18 @@
 package datanode
 
 import (
-	"context"
 	"fmt"
 	"reflect"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
+	oplog "github.com/opentracing/opentracing-go/log"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
-	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
-	"github.com/milvus-io/milvus/internal/types"
-	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
-	"github.com/milvus-io/milvus/internal/util/funcutil"
-	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
@@ -48,7 +45,8 @@ type ttNode struct {
 	vChannelName   string
 	channel        Channel
 	lastUpdateTime time.Time
-	dataCoord      types.DataCoord
+	cpUpdater      *channelCheckpointUpdater
+	drain          *channelDrain
 }
 
 // Name returns node name, implementing flowgraph.Node
@@ -74,6 +72,34 @@ func (ttn *ttNode) Operate(in []Msg) []Msg {
 		return []Msg{}
 	}
 
+	var spans []opentracing.Span
+	for _, msg := range fgMsg.insertMessages {
+		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		sp.LogFields(oplog.String("node name", ttn.Name()))
+		spans = append(spans, sp)
+		msg.SetTraceCtx(ctx)
+	}
+	for _, msg := range fgMsg.deleteMessages {
+		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		sp.LogFields(oplog.String("node name", ttn.Name()))
+		spans = append(spans, sp)
+		msg.SetTraceCtx(ctx)
+	}
+	defer func() {
+		for _, sp := range spans {
+			sp.Finish()
+		}
+	}()
+
+	// the checkpoint of a drained vchannel is owned by the node it's reassigned to
+	if ttn.drain.drained() {
+		return []Msg{}
+	}
+	if drainPos, ok := ttn.drain.updating(); ok {
+		ttn.updateDrainCP(fgMsg.endPositions[0], drainPos)
+		return []Msg{}
+	}
+
 	curTs, _ := tsoutil.ParseTS(fgMsg.timeRange.timestampMax)
 	if curTs.Sub(ttn.lastUpdateTime) >= updateChanCPInterval {
 		ttn.updateChannelCP(fgMsg.endPositions[0])
@@ -89,27 +115,30 @@ func (ttn *ttNode) updateChannelCP(ttPos *internalpb.MsgPosition) {
 		log.Warn("updateChannelCP failed, get nil check point", zap.String("vChannel", ttn.vChannelName))
 		return
 	}
-	channelCPTs, _ := tsoutil.ParseTS(channelPos.Timestamp)
-
-	ctx, cancel := context.WithTimeout(context.Background(), updateChanCPTimeout)
-	defer cancel()
-	resp, err := ttn.dataCoord.UpdateChannelCheckpoint(ctx, &datapb.UpdateChannelCheckpointRequest{
-		Base: commonpbutil.NewMsgBase(
-			commonpbutil.WithSourceID(paramtable.GetNodeID()),
-		),
-		VChannel: ttn.vChannelName,
-		Position: channelPos,
-	})
-	if err = funcutil.VerifyResponse(resp, err); err != nil {
-		log.Warn("UpdateChannelCheckpoint failed", zap.String("channel", ttn.vChannelName),
-			zap.Time("channelCPTs", channelCPTs), zap.Error(err))
+	// the checkpoints of all vchannels on the node are sent to datacoord in batches
+	ttn.cpUpdater.addTask(ttn.vChannelName, channelPos)
+}
+
+// updateDrainCP sends the channel checkpoint to DataCoord right away once it reaches the drain position,
+// i.e. all the buffers synced for decommission are flushed.
+func (ttn *ttNode) updateDrainCP(ttPos, drainPos *internalpb.MsgPosition) {
+	channelPos := ttn.channel.getChannelCheckpoint(ttPos)
+	if channelPos == nil || channelPos.MsgID == nil || channelPos.GetTimestamp() < drainPos.GetTimestamp() {
 		return
 	}
-
-	log.Info("UpdateChannelCheckpoint success", zap.String("channel", ttn.vChannelName), zap.Time("channelCPTs", channelCPTs))
+	// the queued checkpoint is older than the one sent here
+	ttn.cpUpdater.removeTask(ttn.vChannelName)
+	err := ttn.cpUpdater.updateChannelCP(ttn.vChannelName, channelPos)
+	if err != nil {
+		log.Warn("update channel checkpoint for drain failed", zap.String("vChannel", ttn.vChannelName), zap.Error(err))
+	} else {
+		log.Info("vchannel drained", zap.String("vChannel", ttn.vChannelName),
+			zap.Uint64("checkpointTs", channelPos.GetTimestamp()))
+	}
+	ttn.drain.finish(channelPos, err)
 }
 
-func newTTNode(config *nodeConfig, dc types.DataCoord) (*ttNode, error) {
+func newTTNode(config *nodeConfig, cpUpdater *channelCheckpointUpdater) (*ttNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxQueueLength(Params.DataNodeCfg.FlowGraphMaxQueueLength.GetAsInt32())
 	baseNode.SetMaxParallelism(Params.DataNodeCfg.FlowGraphMaxParallelism.GetAsInt32())
@@ -119,7 +148,8 @@ func newTTNode(config *nodeConfig, dc types.DataCoord) (*ttNode, error) {
 		vChannelName:   config.vChannelName,
 		channel:        config.channel,
 		lastUpdateTime: time.Time{}, // set to Zero to update channel checkpoint immediately after fg started
-		dataCoord:      dc,
+		cpUpdater:      cpUpdater,
+		drain:          config.drain,
 	}
 
 	return tt, nil
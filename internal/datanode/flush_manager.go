@@ -363,6 +363,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 
 	// encode data and convert output data
 	inCodec := storage.NewInsertCodec(meta)
+	inCodec.SortByPK = Params.DataNodeCfg.FlushSortByPK.GetAsBool()
 
 	binLogs, statsBinlogs, err := inCodec.Serialize(partID, segmentID, data.buffer)
 	if err != nil {
@@ -426,6 +427,18 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		}
 	}
 
+	// the segments flushed by dropping the collection are not worth tuning for
+	if !dropped {
+		var bufferedBytes, writtenBytes int64
+		for _, size := range fieldMemorySize {
+			bufferedBytes += int64(size)
+		}
+		for _, value := range kvs {
+			writtenBytes += int64(len(value))
+		}
+		flushStats.record(collID, segmentID, data.size, bufferedBytes, writtenBytes, int64(len(kvs)))
+	}
+
 	m.handleInsertTask(segmentID, &flushBufferInsertTask{
 		ChunkManager: m.ChunkManager,
 		data:         kvs,
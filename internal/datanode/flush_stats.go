@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// maxFlushStatsSegments is the max number of the recently flushed segments kept for the metrics
+const maxFlushStatsSegments = 1024
+
+// flushStats is the global flushStatsTracker in DataNode.
+var flushStats = newFlushStatsTracker()
+
+// flushCounter accumulates the data buffered and the binlogs written by flushes.
+type flushCounter struct {
+	flushes       int64
+	bufferedRows  int64
+	bufferedBytes int64
+	writtenBytes  int64
+	binlogs       int64
+}
+
+func (c *flushCounter) add(rows, bufferedBytes, writtenBytes, binlogs int64) {
+	c.flushes++
+	c.bufferedRows += rows
+	c.bufferedBytes += bufferedBytes
+	c.writtenBytes += writtenBytes
+	c.binlogs += binlogs
+}
+
+func (c *flushCounter) metrics() metricsinfo.FlushEfficiencyMetrics {
+	ret := metricsinfo.FlushEfficiencyMetrics{
+		FlushCount:    c.flushes,
+		BufferedRows:  c.bufferedRows,
+		BufferedBytes: c.bufferedBytes,
+		WrittenBytes:  c.writtenBytes,
+		BinlogCount:   c.binlogs,
+	}
+	if c.bufferedBytes > 0 {
+		ret.WriteAmplification = float64(c.writtenBytes) / float64(c.bufferedBytes)
+	}
+	if c.flushes > 0 {
+		ret.AvgRowsPerFlush = float64(c.bufferedRows) / float64(c.flushes)
+	}
+	if c.binlogs > 0 {
+		ret.AvgBytesPerBinlog = float64(c.writtenBytes) / float64(c.binlogs)
+	}
+	return ret
+}
+
+type segmentFlushCounter struct {
+	flushCounter
+	collectionID UniqueID
+	lastFlush    time.Time
+}
+
+// flushStatsTracker tracks the flush efficiency of the recently flushed segments and of the collections,
+// all the methods are no-op on a nil tracker.
+type flushStatsTracker struct {
+	mu          sync.RWMutex
+	segments    map[UniqueID]*segmentFlushCounter // segment id -> counter
+	collections map[UniqueID]*flushCounter        // collection id -> counter
+}
+
+func newFlushStatsTracker() *flushStatsTracker {
+	return &flushStatsTracker{
+		segments:    make(map[UniqueID]*segmentFlushCounter),
+		collections: make(map[UniqueID]*flushCounter),
+	}
+}
+
+// record adds a flush of the segment, the least recently flushed segments are dropped once more than
+// maxFlushStatsSegments are kept, the collection aggregation keeps them.
+func (t *flushStatsTracker) record(collectionID, segmentID UniqueID, rows, bufferedBytes, writtenBytes, binlogs int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	segment, ok := t.segments[segmentID]
+	if !ok {
+		if len(t.segments) >= maxFlushStatsSegments {
+			t.evictOldest()
+		}
+		segment = &segmentFlushCounter{collectionID: collectionID}
+		t.segments[segmentID] = segment
+	}
+	segment.add(rows, bufferedBytes, writtenBytes, binlogs)
+	segment.lastFlush = time.Now()
+
+	collection, ok := t.collections[collectionID]
+	if !ok {
+		collection = &flushCounter{}
+		t.collections[collectionID] = collection
+	}
+	collection.add(rows, bufferedBytes, writtenBytes, binlogs)
+}
+
+func (t *flushStatsTracker) evictOldest() {
+	var oldestID UniqueID
+	var oldest *segmentFlushCounter
+	for id, segment := range t.segments {
+		if oldest == nil || segment.lastFlush.Before(oldest.lastFlush) {
+			oldestID, oldest = id, segment
+		}
+	}
+	delete(t.segments, oldestID)
+}
+
+// removeCollection drops the flush efficiency of the collection and its segments.
+func (t *flushStatsTracker) removeCollection(collectionID UniqueID) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.collections, collectionID)
+	for id, segment := range t.segments {
+		if segment.collectionID == collectionID {
+			delete(t.segments, id)
+		}
+	}
+}
+
+// segmentMetrics returns the flush efficiency of the tracked segments sorted by segment id.
+func (t *flushStatsTracker) segmentMetrics() []metricsinfo.SegmentFlushMetrics {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ret := make([]metricsinfo.SegmentFlushMetrics, 0, len(t.segments))
+	for id, segment := range t.segments {
+		ret = append(ret, metricsinfo.SegmentFlushMetrics{
+			SegmentID:              id,
+			CollectionID:           segment.collectionID,
+			FlushEfficiencyMetrics: segment.metrics(),
+			LastFlushTime:          segment.lastFlush.String(),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].SegmentID < ret[j].SegmentID })
+	return ret
+}
+
+// collectionMetrics returns the flush efficiency aggregated by collection.
+func (t *flushStatsTracker) collectionMetrics() map[int64]metricsinfo.FlushEfficiencyMetrics {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ret := make(map[int64]metricsinfo.FlushEfficiencyMetrics, len(t.collections))
+	for id, collection := range t.collections {
+		ret[id] = collection.metrics()
+	}
+	return ret
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlushStatsTracker(t *testing.T) {
+	tracker := newFlushStatsTracker()
+	tracker.record(100, 1, 10, 1000, 500, 4)
+	tracker.record(100, 1, 30, 3000, 2500, 4)
+	tracker.record(100, 2, 20, 1000, 1000, 2)
+	tracker.record(200, 3, 5, 0, 0, 0)
+
+	segments := tracker.segmentMetrics()
+	assert.Equal(t, 3, len(segments))
+	assert.Equal(t, int64(1), segments[0].SegmentID)
+	assert.Equal(t, int64(100), segments[0].CollectionID)
+	assert.Equal(t, int64(2), segments[0].FlushCount)
+	assert.Equal(t, int64(40), segments[0].BufferedRows)
+	assert.Equal(t, int64(4000), segments[0].BufferedBytes)
+	assert.Equal(t, int64(3000), segments[0].WrittenBytes)
+	assert.Equal(t, int64(8), segments[0].BinlogCount)
+	assert.InDelta(t, 0.75, segments[0].WriteAmplification, 1e-9)
+	assert.InDelta(t, 20, segments[0].AvgRowsPerFlush, 1e-9)
+	assert.InDelta(t, 375, segments[0].AvgBytesPerBinlog, 1e-9)
+	// nothing buffered or written
+	assert.Equal(t, float64(0), segments[2].WriteAmplification)
+	assert.Equal(t, float64(0), segments[2].AvgBytesPerBinlog)
+
+	collections := tracker.collectionMetrics()
+	assert.Equal(t, 2, len(collections))
+	assert.Equal(t, int64(3), collections[100].FlushCount)
+	assert.Equal(t, int64(60), collections[100].BufferedRows)
+	assert.InDelta(t, 0.8, collections[100].WriteAmplification, 1e-9)
+
+	tracker.removeCollection(100)
+	assert.Equal(t, 1, len(tracker.segmentMetrics()))
+	assert.Equal(t, 1, len(tracker.collectionMetrics()))
+
+	var nilTracker *flushStatsTracker
+	nilTracker.record(100, 1, 10, 1000, 500, 4)
+	assert.Nil(t, nilTracker.segmentMetrics())
+	assert.Nil(t, nilTracker.collectionMetrics())
+}
+
+func TestFlushStatsTracker_Evict(t *testing.T) {
+	tracker := newFlushStatsTracker()
+	for i := 0; i < maxFlushStatsSegments+1; i++ {
+		tracker.record(100, UniqueID(i), 1, 1, 1, 1)
+	}
+	segments := tracker.segmentMetrics()
+	assert.Equal(t, maxFlushStatsSegments, len(segments))
+	// the collection aggregation keeps the evicted segment
+	assert.Equal(t, int64(maxFlushStatsSegments+1), tracker.collectionMetrics()[100].FlushCount)
+}
@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// the phases of an import task in DataNode
+const (
+	importPhasePreparing = "preparing"
+	importPhaseImporting = "importing"
+	importPhasePersisted = "persisted"
+	importPhaseFailed    = "failed"
+)
+
+// maxEndedImportTasks is the max number of the ended import tasks kept for the metrics
+const maxEndedImportTasks = 32
+
+// importTaskProgress records the progress of an import task, it's safe to be updated by the parse workers concurrently,
+// all the methods are no-op on a nil progress.
+type importTaskProgress struct {
+	mu        sync.Mutex
+	metrics   metricsinfo.ImportTaskMetrics
+	startTime time.Time
+	endTime   time.Time
+}
+
+func (p *importTaskProgress) setPhase(phase string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.Phase = phase
+}
+
+func (p *importTaskProgress) fileDone(filePath string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.CompletedFiles++
+}
+
+func (p *importTaskProgress) addRows(rows int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.RowsParsed += int64(rows)
+}
+
+func (p *importTaskProgress) addSegment() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.SegmentsCreated++
+}
+
+// end marks the task ended by the state of the import result.
+func (p *importTaskProgress) end(result *rootcoordpb.ImportResult) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.endTime = time.Now()
+	if result.GetState() == commonpb.ImportState_ImportPersisted {
+		p.metrics.Phase = importPhasePersisted
+		// the files of a backup import are not reported one by one
+		p.metrics.CompletedFiles = p.metrics.TotalFiles
+		return
+	}
+	p.metrics.Phase = importPhaseFailed
+	if reason, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.FailedReason, result.GetInfos()); err == nil {
+		p.metrics.FailedReason = reason
+	}
+}
+
+func (p *importTaskProgress) snapshot(now time.Time) metricsinfo.ImportTaskMetrics {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ret := p.metrics
+	if !p.endTime.IsZero() {
+		now = p.endTime
+	}
+	elapsed := now.Sub(p.startTime).Seconds()
+	ret.StartTime = p.startTime.String()
+	ret.ElapsedSeconds = elapsed
+	if elapsed > 0 {
+		ret.RowsPerSecond = float64(ret.RowsParsed) / elapsed
+	}
+	return ret
+}
+
+// importTracker tracks the running import tasks and the recently ended ones of DataNode.
+type importTracker struct {
+	mu    sync.RWMutex
+	tasks map[int64]*importTaskProgress // task id -> progress
+	ended []int64                       // the ended tasks in the order of end
+}
+
+func newImportTracker() *importTracker {
+	return &importTracker{
+		tasks: make(map[int64]*importTaskProgress),
+	}
+}
+
+// start begins to track the import task, a task imported again replaces the ended one.
+func (t *importTracker) start(task *datapb.ImportTask) *importTaskProgress {
+	if t == nil {
+		return nil
+	}
+	p := &importTaskProgress{
+		metrics: metricsinfo.ImportTaskMetrics{
+			TaskID:       task.GetTaskId(),
+			CollectionID: task.GetCollectionId(),
+			PartitionID:  task.GetPartitionId(),
+			Phase:        importPhasePreparing,
+			TotalFiles:   len(task.GetFiles()),
+		},
+		startTime: time.Now(),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.removeEnded(task.GetTaskId())
+	t.tasks[task.GetTaskId()] = p
+	return p
+}
+
+// end marks the task ended, the oldest ended tasks are dropped once more than maxEndedImportTasks are kept.
+func (t *importTracker) end(p *importTaskProgress, result *rootcoordpb.ImportResult) {
+	if t == nil || p == nil {
+		return
+	}
+	p.end(result)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	taskID := result.GetTaskId()
+	if t.tasks[taskID] != p {
+		return
+	}
+	t.removeEnded(taskID)
+	t.ended = append(t.ended, taskID)
+	for len(t.ended) > maxEndedImportTasks {
+		delete(t.tasks, t.ended[0])
+		t.ended = t.ended[1:]
+	}
+}
+
+func (t *importTracker) removeEnded(taskID int64) {
+	for i, id := range t.ended {
+		if id == taskID {
+			t.ended = append(t.ended[:i], t.ended[i+1:]...)
+			return
+		}
+	}
+}
+
+// list returns the progress of the tracked tasks sorted by task id.
+func (t *importTracker) list() []metricsinfo.ImportTaskMetrics {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	now := time.Now()
+	ret := make([]metricsinfo.ImportTaskMetrics, 0, len(t.tasks))
+	for _, p := range t.tasks {
+		ret = append(ret, p.snapshot(now))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].TaskID < ret[j].TaskID })
+	return ret
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+)
+
+func TestImportTracker(t *testing.T) {
+	tracker := newImportTracker()
+	p := tracker.start(&datapb.ImportTask{TaskId: 2, CollectionId: 100, PartitionId: 101, Files: []string{"a.npy", "b.npy"}})
+	p.setPhase(importPhaseImporting)
+	p.fileDone("a.npy")
+	p.addSegment()
+	p.addRows(10)
+	p.addRows(20)
+
+	tasks := tracker.list()
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, int64(2), tasks[0].TaskID)
+	assert.Equal(t, int64(100), tasks[0].CollectionID)
+	assert.Equal(t, importPhaseImporting, tasks[0].Phase)
+	assert.Equal(t, 2, tasks[0].TotalFiles)
+	assert.Equal(t, 1, tasks[0].CompletedFiles)
+	assert.Equal(t, int64(30), tasks[0].RowsParsed)
+	assert.Equal(t, 1, tasks[0].SegmentsCreated)
+
+	tracker.end(p, &rootcoordpb.ImportResult{TaskId: 2, State: commonpb.ImportState_ImportPersisted})
+	tasks = tracker.list()
+	assert.Equal(t, importPhasePersisted, tasks[0].Phase)
+	assert.Equal(t, 2, tasks[0].CompletedFiles)
+	elapsed := tasks[0].ElapsedSeconds
+	assert.Equal(t, elapsed, tracker.list()[0].ElapsedSeconds)
+
+	p = tracker.start(&datapb.ImportTask{TaskId: 1})
+	tracker.end(p, &rootcoordpb.ImportResult{
+		TaskId: 1,
+		State:  commonpb.ImportState_ImportFailed,
+		Infos:  []*commonpb.KeyValuePair{{Key: importutil.FailedReason, Value: "bad file"}},
+	})
+	tasks = tracker.list()
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, importPhaseFailed, tasks[0].Phase)
+	assert.Equal(t, "bad file", tasks[0].FailedReason)
+
+	// the oldest ended tasks are dropped
+	for i := 0; i < maxEndedImportTasks; i++ {
+		p = tracker.start(&datapb.ImportTask{TaskId: int64(10 + i)})
+		tracker.end(p, &rootcoordpb.ImportResult{TaskId: int64(10 + i), State: commonpb.ImportState_ImportPersisted})
+	}
+	tracker.start(&datapb.ImportTask{TaskId: 3})
+	tasks = tracker.list()
+	assert.Equal(t, maxEndedImportTasks+1, len(tasks))
+	assert.Equal(t, int64(3), tasks[0].TaskID)
+	assert.Equal(t, importPhasePreparing, tasks[0].Phase)
+
+	// nil tracker and progress are no-op
+	var nilTracker *importTracker
+	nilProgress := nilTracker.start(&datapb.ImportTask{TaskId: 1})
+	nilProgress.addRows(1)
+	nilTracker.end(nilProgress, &rootcoordpb.ImportResult{})
+	assert.Empty(t, nilTracker.list())
+}
@@ -18,6 +18,7 @@ package datanode
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
@@ -100,6 +101,11 @@ func (node *DataNode) getSystemInfoMetrics(ctx context.Context, req *milvuspb.Ge
 			FlushInsertBufferSize: Params.DataNodeCfg.FlushInsertBufferSize.GetAsInt64(),
 		},
 		QuotaMetrics: quotaMetrics,
+		FlowGraphs:   node.flowgraphManager.getFlowGraphNodeMetrics(),
+		ImportTasks:  node.importTracker.list(),
+
+		SegmentFlushes:    flushStats.segmentMetrics(),
+		CollectionFlushes: flushStats.collectionMetrics(),
 	}
 
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
@@ -125,3 +131,25 @@ func (node *DataNode) getSystemInfoMetrics(ctx context.Context, req *milvuspb.Ge
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataNodeRole, paramtable.GetNodeID()),
 	}, nil
 }
+
+// getImportTasksMetrics returns the progress of the running and the recently ended import tasks in json.
+func (node *DataNode) getImportTasksMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.DataNodeRole, paramtable.GetNodeID())
+	resp, err := json.Marshal(node.importTracker.list())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}
+}
@@ -210,6 +210,8 @@ type DataCoordFactory struct {
 
 	AddSegmentError      bool
 	AddSegmentNotSuccess bool
+
+	UpdateChannelCheckpointError bool
 }
 
 func (ds *DataCoordFactory) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
@@ -261,11 +263,38 @@ func (ds *DataCoordFactory) UpdateSegmentStatistics(ctx context.Context, req *da
 }
 
 func (ds *DataCoordFactory) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	if ds.UpdateChannelCheckpointError {
+		return nil, errors.New("error")
+	}
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
 
+func (ds *DataCoordFactory) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	if ds.UpdateChannelCheckpointError {
+		return nil, errors.New("error")
+	}
+	statuses := make(map[string]*commonpb.Status, len(req.GetCheckpoints()))
+	for _, cp := range req.GetCheckpoints() {
+		statuses[cp.GetVChannel()] = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	return &datapb.UpdateChannelCheckpointsResponse{
+		Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ChannelStatuses: statuses,
+	}, nil
+}
+
+func (ds *DataCoordFactory) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	return &datapb.GetTimeTravelWatermarksResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (ds *DataCoordFactory) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (ds *DataCoordFactory) SaveImportSegment(ctx context.Context, req *datapb.SaveImportSegmentRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
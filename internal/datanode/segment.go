@@ -40,6 +40,7 @@ type Segment struct {
 	numRows     int64
 	memorySize  int64
 	compactedTo UniqueID
+	splitTo     []UniqueID // the other targets of the split compaction the segment is the first target of
 
 	curInsertBuf     *BufferData
 	curDeleteBuf     *DelDataBuf
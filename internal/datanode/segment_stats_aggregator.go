@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// updateSegmentStatsTimeout is the timeout of a batch of segment statistics sent to DataCoord.
+const updateSegmentStatsTimeout = 10 * time.Second
+
+// segmentStatsAggregator coalesces the row count updates of the segments of all the vchannels on the node,
+// only the latest row count of each segment within an interval is sent to DataCoord, with a single
+// UpdateSegmentStatistics call instead of the time tick of every vchannel. The row counts of the segments
+// being sealed are sent right away.
+type segmentStatsAggregator struct {
+	dn *DataNode
+
+	mu      sync.Mutex
+	pending map[UniqueID]*datapb.SegmentStats // segment id -> latest row count
+
+	flushCh   chan struct{}
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newSegmentStatsAggregator(dn *DataNode) *segmentStatsAggregator {
+	return &segmentStatsAggregator{
+		dn:      dn,
+		pending: make(map[UniqueID]*datapb.SegmentStats),
+		flushCh: make(chan struct{}, 1),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// enabled returns false if the aggregator is nil or dataNode.segmentStats.aggregateInterval is 0,
+// the segment statistics are sent with the time ticks of the vchannels then.
+func (a *segmentStatsAggregator) enabled() bool {
+	return a != nil && Params.DataNodeCfg.SegmentStatsAggregateInterval.GetAsInt64() > 0
+}
+
+func (a *segmentStatsAggregator) start() {
+	if !a.enabled() {
+		return
+	}
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		ticker := time.NewTicker(Params.DataNodeCfg.SegmentStatsAggregateInterval.GetAsDuration(time.Millisecond))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-a.closeCh:
+				log.Info("segment stats aggregator quit")
+				return
+			case <-ticker.C:
+				a.flush()
+			case <-a.flushCh:
+				a.flush()
+			}
+		}
+	}()
+}
+
+// add queues the row counts of the segments, a queued older row count of the same segment is replaced.
+func (a *segmentStatsAggregator) add(stats []*datapb.SegmentStats) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, stat := range stats {
+		a.pending[stat.GetSegmentID()] = stat
+	}
+}
+
+// seal queues the row counts of the segments being sealed, and sends the queued row counts right away.
+func (a *segmentStatsAggregator) seal(stats []*datapb.SegmentStats) {
+	if len(stats) == 0 {
+		return
+	}
+	a.add(stats)
+	select {
+	case a.flushCh <- struct{}{}:
+	default:
+	}
+}
+
+func (a *segmentStatsAggregator) taskNum() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.pending)
+}
+
+// flush sends the queued row counts to DataCoord in one batch, they are queued again on failure
+// unless a newer row count of the segment arrives meanwhile.
+func (a *segmentStatsAggregator) flush() {
+	a.mu.Lock()
+	batch := a.pending
+	a.pending = make(map[UniqueID]*datapb.SegmentStats)
+	a.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+
+	stats := make([]*datapb.SegmentStats, 0, len(batch))
+	for _, stat := range batch {
+		stats = append(stats, stat)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), updateSegmentStatsTimeout)
+	defer cancel()
+	resp, err := a.dn.dataCoord.UpdateSegmentStatistics(ctx, &datapb.UpdateSegmentStatisticsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SegmentStatistics),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		Stats: stats,
+	})
+	if err = funcutil.VerifyResponse(resp, err); err != nil {
+		log.Warn("UpdateSegmentStatistics failed, requeue the segment stats", zap.Int("segmentNum", len(stats)), zap.Error(err))
+		a.mu.Lock()
+		for segmentID, stat := range batch {
+			if _, ok := a.pending[segmentID]; !ok {
+				a.pending[segmentID] = stat
+			}
+		}
+		a.mu.Unlock()
+		return
+	}
+	log.Debug("UpdateSegmentStatistics success", zap.Int("segmentNum", len(stats)))
+}
+
+// close stops the aggregator, the queued row counts are flushed before quit.
+func (a *segmentStatsAggregator) close() {
+	a.closeOnce.Do(func() {
+		close(a.closeCh)
+		a.wg.Wait()
+		if a.enabled() {
+			a.flush()
+		}
+	})
+}
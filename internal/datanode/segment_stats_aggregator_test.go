@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type segmentStatsRecorder struct {
+	DataCoordFactory
+	mu      sync.Mutex
+	fail    bool
+	calls   int
+	updated map[UniqueID]int64
+}
+
+func (r *segmentStatsRecorder) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail {
+		return nil, errors.New("mock error")
+	}
+	r.calls++
+	for _, stat := range req.GetStats() {
+		r.updated[stat.GetSegmentID()] = stat.GetNumRows()
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (r *segmentStatsRecorder) getCalls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func TestSegmentStatsAggregator(t *testing.T) {
+	dc := &segmentStatsRecorder{updated: make(map[UniqueID]int64)}
+	aggregator := newSegmentStatsAggregator(&DataNode{dataCoord: dc})
+	assert.True(t, aggregator.enabled())
+
+	// only the latest row count of a segment is sent, the segments of all vchannels in one call
+	aggregator.add([]*datapb.SegmentStats{{SegmentID: 1, NumRows: 10}, {SegmentID: 2, NumRows: 5}})
+	aggregator.add([]*datapb.SegmentStats{{SegmentID: 1, NumRows: 20}})
+	aggregator.add([]*datapb.SegmentStats{{SegmentID: 3, NumRows: 1}})
+	assert.Equal(t, 3, aggregator.taskNum())
+	aggregator.flush()
+	assert.Equal(t, 0, aggregator.taskNum())
+	assert.Equal(t, 1, dc.getCalls())
+	assert.Equal(t, map[UniqueID]int64{1: 20, 2: 5, 3: 1}, dc.updated)
+
+	// nothing is sent without updates
+	aggregator.flush()
+	assert.Equal(t, 1, dc.getCalls())
+
+	// the failed row counts are queued again, unless a newer one arrives
+	dc.fail = true
+	aggregator.add([]*datapb.SegmentStats{{SegmentID: 1, NumRows: 30}, {SegmentID: 2, NumRows: 6}})
+	aggregator.flush()
+	assert.Equal(t, 2, aggregator.taskNum())
+	aggregator.add([]*datapb.SegmentStats{{SegmentID: 1, NumRows: 40}})
+	dc.fail = false
+	aggregator.flush()
+	assert.Equal(t, map[UniqueID]int64{1: 40, 2: 6, 3: 1}, dc.updated)
+
+	t.Run("seal", func(t *testing.T) {
+		params := paramtable.Get()
+		params.Save(params.DataNodeCfg.SegmentStatsAggregateInterval.Key, "3600000")
+		defer params.Reset(params.DataNodeCfg.SegmentStatsAggregateInterval.Key)
+
+		aggregator := newSegmentStatsAggregator(&DataNode{dataCoord: dc})
+		aggregator.start()
+		defer aggregator.close()
+		aggregator.add([]*datapb.SegmentStats{{SegmentID: 4, NumRows: 1}})
+		aggregator.seal(nil)
+		aggregator.seal([]*datapb.SegmentStats{{SegmentID: 5, NumRows: 2}})
+		assert.Eventually(t, func() bool {
+			return aggregator.taskNum() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+		dc.mu.Lock()
+		defer dc.mu.Unlock()
+		assert.Equal(t, int64(1), dc.updated[4])
+		assert.Equal(t, int64(2), dc.updated[5])
+	})
+
+	t.Run("close", func(t *testing.T) {
+		aggregator := newSegmentStatsAggregator(&DataNode{dataCoord: dc})
+		aggregator.start()
+		aggregator.add([]*datapb.SegmentStats{{SegmentID: 6, NumRows: 3}})
+		aggregator.close()
+		assert.Equal(t, 0, aggregator.taskNum())
+		assert.Equal(t, int64(3), dc.updated[6])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var nilAggregator *segmentStatsAggregator
+		assert.False(t, nilAggregator.enabled())
+
+		params := paramtable.Get()
+		params.Save(params.DataNodeCfg.SegmentStatsAggregateInterval.Key, "0")
+		defer params.Reset(params.DataNodeCfg.SegmentStatsAggregateInterval.Key)
+		assert.False(t, aggregator.enabled())
+	})
+}
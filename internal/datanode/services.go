@@ -78,6 +78,9 @@ func (node *DataNode) GetComponentStates(ctx context.Context) (*milvuspb.Compone
 		SubcomponentStates: make([]*milvuspb.ComponentInfo, 0),
 		Status:             &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
 	}
+	if info := node.clockSkewChecker.componentInfo(nodeID); info != nil {
+		states.SubcomponentStates = append(states.SubcomponentStates, info)
+	}
 	return states, nil
 }
 
@@ -218,14 +221,7 @@ func (node *DataNode) ShowConfigurations(ctx context.Context, req *internalpb.Sh
 			Configuations: nil,
 		}, nil
 	}
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "datanode", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "datanode", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -282,6 +278,10 @@ func (node *DataNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 		return systemInfoMetrics, nil
 	}
 
+	if metricType == metricsinfo.ImportTasksMetrics {
+		return node.getImportTasksMetrics(), nil
+	}
+
 	log.RatedWarn(60, "DataNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
@@ -352,12 +352,15 @@ func (node *DataNode) GetCompactionState(ctx context.Context, req *datapb.Compac
 		})
 		return true
 	})
+	// a split plan has a completed state result for each of its target segments
 	node.compactionExecutor.completed.Range(func(k, v any) bool {
-		results = append(results, &datapb.CompactionStateResult{
-			State:  commonpb.CompactionState_Completed,
-			PlanID: k.(UniqueID),
-			Result: v.(*datapb.CompactionResult),
-		})
+		for _, result := range v.([]*datapb.CompactionResult) {
+			results = append(results, &datapb.CompactionStateResult{
+				State:  commonpb.CompactionState_Completed,
+				PlanID: k.(UniqueID),
+				Result: result,
+			})
+		}
 		node.compactionExecutor.completed.Delete(k)
 		return true
 	})
@@ -430,13 +433,35 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 		return status, nil
 	}
 
+	// all the targets of a split plan are merged by the first sync while the compacted segments are still in the channel,
+	// the syncs of the other targets find no available channel then.
+	splitResults, _ := node.compactionExecutor.getSplitResults(req.GetPlanID())
+	splits := make([]*Segment, 0, len(splitResults))
+	for _, result := range splitResults {
+		if result.GetSegmentID() == req.GetCompactedTo() {
+			continue
+		}
+		splitSeg := &Segment{
+			collectionID: collID,
+			partitionID:  partID,
+			segmentID:    result.GetSegmentID(),
+			numRows:      result.GetNumOfRows(),
+		}
+		if err := channel.InitPKstats(ctx, splitSeg, result.GetField2StatslogPaths(), tsoutil.GetCurrentTime()); err != nil {
+			status.Reason = fmt.Sprintf("init pk stats of split segment %d fail, err=%s", result.GetSegmentID(), err.Error())
+			return status, nil
+		}
+		splits = append(splits, splitSeg)
+	}
+
 	// block all flow graph so it's safe to remove segment
 	ds.fg.Blockall()
 	defer ds.fg.Unblock()
-	if err := channel.mergeFlushedSegments(targetSeg, req.GetPlanID(), req.GetCompactedFrom()); err != nil {
+	if err := channel.mergeFlushedSegments(targetSeg, req.GetPlanID(), req.GetCompactedFrom(), splits...); err != nil {
 		status.Reason = err.Error()
 		return status, nil
 	}
+	node.compactionExecutor.removeSplitResults(req.GetPlanID())
 
 	status.ErrorCode = commonpb.ErrorCode_Success
 	return status, nil
@@ -495,6 +520,9 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		}, nil
 	}
 
+	progress := node.importTracker.start(req.GetImportTask())
+	defer node.importTracker.end(progress, importResult)
+
 	// get a timestamp for all the rows
 	// Ignore cancellation from parent context.
 	rep, err := node.rootCoord.AllocTimestamp(newCtx, &rootcoordpb.AllocTimestampRequest{
@@ -564,18 +592,27 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	segmentSize := Params.DataCoordCfg.SegmentMaxSize.GetAsInt64() * 1024 * 1024
 	importWrapper := importutil.NewImportWrapper(newCtx, colInfo.GetSchema(), colInfo.GetShardsNum(), segmentSize, node.rowIDAllocator,
 		node.chunkManager, importResult, reportFunc)
-	importWrapper.SetCallbackFunctions(assignSegmentFunc(node, req),
-		createBinLogsFunc(node, req, colInfo.GetSchema(), ts),
+	importWrapper.SetCallbackFunctions(assignSegmentFunc(node, req, progress),
+		createBinLogsFunc(node, req, colInfo.GetSchema(), ts, progress),
 		saveSegmentFunc(node, req, importResult, ts))
+	importWrapper.SetParseParallelism(Params.DataNodeCfg.ImportParseParallelism.GetAsInt())
+	importWrapper.SetMQBrokerAllowlist(Params.DataNodeCfg.ImportMQBrokerAllowlist.GetAsStrings())
+	importWrapper.SetFileDoneFunc(progress.fileDone)
 	// todo: pass tsStart and tsStart after import_wrapper support
 	tsStart, tsEnd, err := importutil.ParseTSFromOptions(req.GetImportTask().GetInfos())
 	isBackup := importutil.IsBackup(req.GetImportTask().GetInfos())
 	if err != nil {
 		return returnFailFunc(err)
 	}
+	csvOptions, err := importutil.ParseCSVOptions(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
 	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
+	progress.setPhase(importPhaseImporting)
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup, CSV: csvOptions,
+			DeleteList: importutil.GetDeleteList(req.GetImportTask().GetInfos())})
 	if err != nil {
 		return returnFailFunc(err)
 	}
@@ -675,7 +712,7 @@ func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImpor
 	}, nil
 }
 
-func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil.AssignSegmentFunc {
+func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest, progress *importTaskProgress) importutil.AssignSegmentFunc {
 	return func(shardID int) (int64, string, error) {
 		chNames := req.GetImportTask().GetChannelNames()
 		importTaskID := req.GetImportTask().GetTaskId()
@@ -708,6 +745,7 @@ func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil
 			return 0, "", fmt.Errorf("syncSegmentID Failed:%s", resp.Status.Reason)
 		}
 		segmentID := resp.SegIDAssignments[0].SegID
+		progress.addSegment()
 		log.Info("new segment assigned",
 			zap.Int64("task ID", importTaskID),
 			zap.Int64("segmentID", segmentID),
@@ -717,7 +755,8 @@ func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil
 	}
 }
 
-func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *schemapb.CollectionSchema, ts Timestamp) importutil.CreateBinlogsFunc {
+func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *schemapb.CollectionSchema, ts Timestamp,
+	progress *importTaskProgress) importutil.CreateBinlogsFunc {
 	return func(fields map[storage.FieldID]storage.FieldData, segmentID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
 		var rowNum int
 		for _, field := range fields {
@@ -750,6 +789,7 @@ func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *sc
 			return nil, nil, err
 		}
 
+		progress.addRows(rowNum)
 		log.Info("new binlog created",
 			zap.Int64("task ID", importTaskID),
 			zap.Int64("segmentID", segmentID),
@@ -18,6 +18,7 @@ package datanode
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"math/rand"
 	"path/filepath"
@@ -134,10 +135,10 @@ func (s *DataNodeServicesSuite) TestGetCompactionState() {
 	s.Run("success", func() {
 		s.node.compactionExecutor.executing.Store(int64(3), 0)
 		s.node.compactionExecutor.executing.Store(int64(2), 0)
-		s.node.compactionExecutor.completed.Store(int64(1), &datapb.CompactionResult{
+		s.node.compactionExecutor.completed.Store(int64(1), []*datapb.CompactionResult{{
 			PlanID:    1,
 			SegmentID: 10,
-		})
+		}})
 		stat, err := s.node.GetCompactionState(s.ctx, nil)
 		s.Assert().NoError(err)
 		s.Assert().Equal(3, len(stat.GetResults()))
@@ -366,6 +367,19 @@ func (s *DataNodeServicesSuite) TestGetMetrics() {
 	log.Info("Test DataNode.GetMetrics",
 		zap.String("name", resp.ComponentName),
 		zap.String("response", resp.Response))
+
+	// import tasks
+	node.importTracker = newImportTracker()
+	node.importTracker.start(&datapb.ImportTask{TaskId: 1, Files: []string{"a.json"}})
+	req, err = metricsinfo.ConstructRequestByMetricType(metricsinfo.ImportTasksMetrics)
+	s.Assert().NoError(err)
+	resp, err = node.GetMetrics(node.ctx, req)
+	s.Assert().NoError(err)
+	s.Assert().Equal(commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	var tasks []metricsinfo.ImportTaskMetrics
+	s.Assert().NoError(json.Unmarshal([]byte(resp.Response), &tasks))
+	s.Assert().Equal(1, len(tasks))
+	s.Assert().Equal(importPhasePreparing, tasks[0].Phase)
 }
 
 func (s *DataNodeServicesSuite) TestImport() {
@@ -349,6 +349,11 @@ func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 	return s.dataCoord.Import(ctx, req)
 }
 
+// PreImport checks the files of an import before the import tasks are created.
+func (s *Server) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return s.dataCoord.PreImport(ctx, req)
+}
+
 // UpdateSegmentStatistics is the dataCoord service caller of UpdateSegmentStatistics.
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return s.dataCoord.UpdateSegmentStatistics(ctx, req)
@@ -359,6 +364,121 @@ func (s *Server) UpdateChannelCheckpoint(ctx context.Context, req *datapb.Update
 	return s.dataCoord.UpdateChannelCheckpoint(ctx, req)
 }
 
+// UpdateChannelCheckpoints updates the checkpoints of several vchannels in dataCoord.
+func (s *Server) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	return s.dataCoord.UpdateChannelCheckpoints(ctx, req)
+}
+
+// GetTimeTravelWatermarks returns the time travel watermarks of the collections in dataCoord.
+func (s *Server) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	return s.dataCoord.GetTimeTravelWatermarks(ctx, req)
+}
+
+// ReportSegmentHeats reports the query heats of the sealed segments served by a QueryNode to dataCoord.
+func (s *Server) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReportSegmentHeats(ctx, req)
+}
+
+// GetSegmentHistory returns the state transitions of a segment.
+func (s *Server) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	return s.dataCoord.GetSegmentHistory(ctx, req)
+}
+
+// PauseCollectionMaintenance pauses the compaction and/or the binlog gc of a collection.
+func (s *Server) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return s.dataCoord.PauseCollectionMaintenance(ctx, req)
+}
+
+// ResumeCollectionMaintenance resumes the compaction and/or the binlog gc of a collection.
+func (s *Server) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ResumeCollectionMaintenance(ctx, req)
+}
+
+// GetCollectionMaintenancePauses returns the collections whose compaction or binlog gc is paused.
+func (s *Server) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return s.dataCoord.GetCollectionMaintenancePauses(ctx, req)
+}
+
+// DecommissionDataNode forwards the decommission request to a DataNode.
+func (s *Server) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return s.dataCoord.DecommissionDataNode(ctx, req)
+}
+
+// LocatePrimaryKeys returns the segments of a collection which may contain the primary keys.
+func (s *Server) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	return s.dataCoord.LocatePrimaryKeys(ctx, req)
+}
+
+// AcquireSegmentLock locks the segments for the task of the node with a lease.
+func (s *Server) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
+	return s.dataCoord.AcquireSegmentLock(ctx, req)
+}
+
+// RenewSegmentLock extends the lease of the segment locks held by the task of the node.
+func (s *Server) RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest) (*commonpb.Status, error) {
+	return s.dataCoord.RenewSegmentLock(ctx, req)
+}
+
+// ReleaseSegmentLock releases the segment locks held by the task of the node.
+func (s *Server) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReleaseSegmentLock(ctx, req)
+}
+
+// InspectSegmentLocks returns the segment lock leases not expired yet.
+func (s *Server) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	return s.dataCoord.InspectSegmentLocks(ctx, req)
+}
+
+// CompactSegments triggers a manual compaction of the given segments of a collection.
+func (s *Server) CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.dataCoord.CompactSegments(ctx, req)
+}
+
+// GetHandoffGate returns the handoff gating states of the flushing and flushed segments.
+func (s *Server) GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	return s.dataCoord.GetHandoffGate(ctx, req)
+}
+
+// GetSegmentAnomalies returns the segment reports of the collections with the anomalies detected.
+func (s *Server) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return s.dataCoord.GetSegmentAnomalies(ctx, req)
+}
+
+// MergeTinySegments triggers a compaction of a collection which merges its small segments.
+func (s *Server) MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.dataCoord.MergeTinySegments(ctx, req)
+}
+
+// GetSegmentAllocHints returns the row distribution of the partitions over the vchannels and the allocation hints.
+func (s *Server) GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return s.dataCoord.GetSegmentAllocHints(ctx, req)
+}
+
+// GetSegmentHeats returns the query heats of the healthy segments from the hottest.
+func (s *Server) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	return s.dataCoord.GetSegmentHeats(ctx, req)
+}
+
+// FreezeHandoffs freezes the handoffs and the compaction of a collection or the whole cluster.
+func (s *Server) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	return s.dataCoord.FreezeHandoffs(ctx, req)
+}
+
+// UnfreezeHandoffs closes the freeze window of a collection or the whole cluster.
+func (s *Server) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UnfreezeHandoffs(ctx, req)
+}
+
+// GetFreezeWindows returns the freeze windows not expired.
+func (s *Server) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	return s.dataCoord.GetFreezeWindows(ctx, req)
+}
+
+// GetDeleteSLA returns the delete sla markers with the latency summaries of the stages.
+func (s *Server) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	return s.dataCoord.GetDeleteSLA(ctx, req)
+}
+
 // SaveImportSegment saves the import segment binlog paths data and then looks for the right DataNode to add the
 // segment to that DataNode.
 func (s *Server) SaveImportSegment(ctx context.Context, request *datapb.SaveImportSegmentRequest) (*commonpb.Status, error) {
@@ -417,3 +537,8 @@ func (s *Server) DropIndex(ctx context.Context, request *datapb.DropIndexRequest
 func (s *Server) GetIndexBuildProgress(ctx context.Context, req *datapb.GetIndexBuildProgressRequest) (*datapb.GetIndexBuildProgressResponse, error) {
 	return s.dataCoord.GetIndexBuildProgress(ctx, req)
 }
+
+// RebuildDeprecatedIndexes lists the segment indexes built by deprecated engine or file format versions and rebuilds them.
+func (s *Server) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return s.dataCoord.RebuildDeprecatedIndexes(ctx, req)
+}
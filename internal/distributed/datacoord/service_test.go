@@ -63,6 +63,9 @@ type MockDataCoord struct {
 	importResp                *datapb.ImportTaskResponse
 	updateSegStatResp         *commonpb.Status
 	updateChanPos             *commonpb.Status
+	updateChanPoses           *datapb.UpdateChannelCheckpointsResponse
+	watermarksResp            *datapb.GetTimeTravelWatermarksResponse
+	reportHeatsResp           *commonpb.Status
 	addSegmentResp            *commonpb.Status
 	unsetIsImportingStateResp *commonpb.Status
 	markSegmentsDroppedResp   *commonpb.Status
@@ -206,6 +209,10 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return m.importResp, m.err
 }
 
+func (m *MockDataCoord) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return &datapb.PreImportResponse{}, m.err
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return m.updateSegStatResp, m.err
 }
@@ -214,6 +221,98 @@ func (m *MockDataCoord) UpdateChannelCheckpoint(ctx context.Context, req *datapb
 	return m.updateChanPos, m.err
 }
 
+func (m *MockDataCoord) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	return m.updateChanPoses, m.err
+}
+
+func (m *MockDataCoord) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	return m.watermarksResp, m.err
+}
+
+func (m *MockDataCoord) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	return m.reportHeatsResp, m.err
+}
+
+func (m *MockDataCoord) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	return &datapb.GetSegmentHistoryResponse{}, m.err
+}
+
+func (m *MockDataCoord) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return &datapb.PauseCollectionMaintenanceResponse{}, m.err
+}
+
+func (m *MockDataCoord) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoord) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return &datapb.GetCollectionMaintenancePausesResponse{}, m.err
+}
+
+func (m *MockDataCoord) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return &datapb.DecommissionResponse{}, m.err
+}
+
+func (m *MockDataCoord) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	return &datapb.LocatePrimaryKeysResponse{}, m.err
+}
+
+func (m *MockDataCoord) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoord) RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoord) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoord) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	return &datapb.InspectSegmentLocksResponse{}, m.err
+}
+
+func (m *MockDataCoord) CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.err
+}
+
+func (m *MockDataCoord) GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	return &datapb.GetHandoffGateResponse{}, m.err
+}
+
+func (m *MockDataCoord) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return &datapb.GetSegmentAnomaliesResponse{}, m.err
+}
+
+func (m *MockDataCoord) MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.err
+}
+
+func (m *MockDataCoord) GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return &datapb.GetSegmentAllocHintsResponse{}, m.err
+}
+
+func (m *MockDataCoord) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	return &datapb.GetSegmentHeatsResponse{}, m.err
+}
+
+func (m *MockDataCoord) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	return &datapb.FreezeHandoffsResponse{}, m.err
+}
+
+func (m *MockDataCoord) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoord) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	return &datapb.GetFreezeWindowsResponse{}, m.err
+}
+
+func (m *MockDataCoord) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	return &datapb.GetDeleteSLAResponse{}, m.err
+}
+
 func (m *MockDataCoord) SaveImportSegment(ctx context.Context, req *datapb.SaveImportSegmentRequest) (*commonpb.Status, error) {
 	return m.addSegmentResp, m.err
 }
@@ -256,6 +355,10 @@ func (m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *datapb.G
 	return m.getIndexBuildProgressResp, m.err
 }
 
+func (m *MockDataCoord) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return &datapb.RebuildDeprecatedIndexesResponse{}, m.err
+}
+
 func (m *MockDataCoord) GetSegmentIndexState(ctx context.Context, req *datapb.GetSegmentIndexStateRequest) (*datapb.GetSegmentIndexStateResponse, error) {
 	return m.getSegmentIndexStateResp, m.err
 }
@@ -499,6 +602,13 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("PreImport", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.PreImport(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
 	t.Run("update seg stat", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			updateSegStatResp: &commonpb.Status{
@@ -521,6 +631,183 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("UpdateChannelCheckpoints", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			updateChanPoses: &datapb.UpdateChannelCheckpointsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.UpdateChannelCheckpoints(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetTimeTravelWatermarks", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			watermarksResp: &datapb.GetTimeTravelWatermarksResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.GetTimeTravelWatermarks(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReportSegmentHeats", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			reportHeatsResp: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}
+		resp, err := server.ReportSegmentHeats(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetSegmentHistory", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetSegmentHistory(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("PauseCollectionMaintenance", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.PauseCollectionMaintenance(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("ResumeCollectionMaintenance", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.ResumeCollectionMaintenance(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetCollectionMaintenancePauses", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetCollectionMaintenancePauses(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("DecommissionDataNode", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.DecommissionDataNode(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("LocatePrimaryKeys", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.LocatePrimaryKeys(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("AcquireSegmentLock", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.AcquireSegmentLock(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("RenewSegmentLock", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.RenewSegmentLock(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("ReleaseSegmentLock", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.ReleaseSegmentLock(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("InspectSegmentLocks", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.InspectSegmentLocks(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("CompactSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.CompactSegments(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetHandoffGate", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetHandoffGate(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetSegmentAnomalies", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetSegmentAnomalies(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("MergeTinySegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.MergeTinySegments(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetSegmentAllocHints", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetSegmentAllocHints(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetSegmentHeats", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetSegmentHeats(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("FreezeHandoffs", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.FreezeHandoffs(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("UnfreezeHandoffs", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.UnfreezeHandoffs(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetFreezeWindows", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetFreezeWindows(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetDeleteSLA", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.GetDeleteSLA(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
 	t.Run("save import segment", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			addSegmentResp: &commonpb.Status{
@@ -615,6 +902,13 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, ret)
 	})
 
+	t.Run("RebuildDeprecatedIndexes", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{}
+		ret, err := server.RebuildDeprecatedIndexes(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
 	t.Run("GetSegmentIndexState", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			getSegmentIndexStateResp: &datapb.GetSegmentIndexStateResponse{},
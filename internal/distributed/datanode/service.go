@@ -385,6 +385,11 @@ func (s *Server) AddImportSegment(ctx context.Context, request *datapb.AddImport
 	return s.datanode.AddImportSegment(ctx, request)
 }
 
+// Decommission starts the decommission of the DataNode and returns its decommission state.
+func (s *Server) Decommission(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return s.datanode.Decommission(ctx, req)
+}
+
 func (s *Server) SyncSegments(ctx context.Context, request *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return s.datanode.SyncSegments(ctx, request)
 }
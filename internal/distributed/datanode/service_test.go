@@ -139,6 +139,10 @@ func (m *MockDataNode) AddImportSegment(ctx context.Context, req *datapb.AddImpo
 	return m.addImportSegmentResp, m.err
 }
 
+func (m *MockDataNode) Decommission(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return m.status, m.err
 }
@@ -320,6 +324,12 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("Decommission", func(t *testing.T) {
+		server.datanode = &MockDataNode{}
+		_, err := server.Decommission(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
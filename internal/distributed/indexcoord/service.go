@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/indexcoord"
 	ic "github.com/milvus-io/milvus/internal/indexcoord"
@@ -67,8 +68,9 @@ type Server struct {
 
 	etcdCli *clientv3.Client
 
-	dataCoord types.DataCoord
-	rootCoord types.RootCoord
+	dataCoord  types.DataCoord
+	rootCoord  types.RootCoord
+	queryCoord types.QueryCoord
 
 	closer io.Closer
 }
@@ -179,6 +181,26 @@ func (s *Server) init() error {
 		panic(err)
 	}
 
+	// --- QueryCoord ---
+	// QueryCoord is only queried for the index usage, IndexCoord does not wait for it to be ready, and takes
+	// all the indexes as in use while it's unreachable
+	if s.queryCoord == nil {
+		s.queryCoord, err = qcc.NewClient(s.loopCtx, ic.Params.EtcdCfg.MetaRootPath.GetValue(), s.etcdCli)
+		if err != nil {
+			log.Warn("IndexCoord try to new QueryCoord client failed", zap.Error(err))
+			return err
+		}
+	}
+	if err = s.queryCoord.Init(); err != nil {
+		log.Warn("IndexCoord QueryCoordClient Init failed, the index usage is unknown until it's reachable", zap.Error(err))
+	}
+	if err = s.queryCoord.Start(); err != nil {
+		log.Warn("IndexCoord QueryCoordClient Start failed, the index usage is unknown until it's reachable", zap.Error(err))
+	}
+	if err := s.SetQueryCoord(s.queryCoord); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -239,6 +261,12 @@ func (s *Server) SetRootCoord(d types.RootCoord) error {
 	return s.indexcoord.SetRootCoord(d)
 }
 
+// SetQueryCoord sets the QueryCoord's client for IndexCoord component.
+func (s *Server) SetQueryCoord(d types.QueryCoord) error {
+	s.queryCoord = d
+	return s.indexcoord.SetQueryCoord(d)
+}
+
 // GetComponentStates gets the component states of IndexCoord.
 func (s *Server) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	return s.indexcoord.GetComponentStates(ctx)
@@ -298,6 +326,46 @@ func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthR
 	return s.indexcoord.CheckHealth(ctx, request)
 }
 
+// CheckIndexConsistency checks the index meta against the segments and the index files.
+func (s *Server) CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error) {
+	return s.indexcoord.CheckIndexConsistency(ctx, req)
+}
+
+// GetIndexStatistics returns the daily build statistics of the indexes.
+func (s *Server) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return s.indexcoord.GetIndexStatistics(ctx, req)
+}
+
+// CordonIndexNode cordons or uncordons an IndexNode.
+func (s *Server) CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+	return s.indexcoord.CordonIndexNode(ctx, req)
+}
+
+// TriggerGC runs the gc passes of a scope right away.
+func (s *Server) TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error) {
+	return s.indexcoord.TriggerGC(ctx, req)
+}
+
+// ListIndexTTLs returns the ttl states of the indexes.
+func (s *Server) ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+	return s.indexcoord.ListIndexTTLs(ctx, req)
+}
+
+// SetIndexTTL sets the ttl of an index.
+func (s *Server) SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+	return s.indexcoord.SetIndexTTL(ctx, req)
+}
+
+// CheckIndexTTL checks the usage of the indexes with ttl right away.
+func (s *Server) CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+	return s.indexcoord.CheckIndexTTL(ctx, req)
+}
+
+// VerifyIndexBuild rebuilds an index recorded in reproducibility mode on an IndexNode.
+func (s *Server) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	return s.indexcoord.VerifyIndexBuild(ctx, req)
+}
+
 // startGrpcLoop starts the grep loop of IndexCoord component.
 func (s *Server) startGrpcLoop(grpcPort int) {
 	defer s.loopWg.Done()
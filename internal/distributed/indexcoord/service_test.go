@@ -48,6 +48,8 @@ func TestIndexCoordinateServer(t *testing.T) {
 	server.rootCoord = rcm
 	dcm := indexcoord.NewDataCoordMock()
 	server.dataCoord = dcm
+	qcm := indexcoord.NewQueryCoordMock()
+	server.queryCoord = qcm
 	err = server.Run()
 	assert.NoError(t, err)
 
@@ -183,6 +183,20 @@ func (c *Client) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReques
 	return ret.(*indexpb.GetJobStatsResponse), err
 }
 
+// VerifyIndexBuild rebuilds an index recorded in reproducibility mode and compares the checksums of the index files.
+func (c *Client) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.VerifyIndexBuild(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.VerifyIndexBuildResponse), err
+}
+
 // ShowConfigurations gets specified configurations para of IndexNode
 func (c *Client) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	req = typeutil.Clone(req)
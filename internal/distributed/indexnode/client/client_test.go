@@ -218,6 +218,13 @@ func TestIndexNodeClient(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("VerifyIndexBuild", func(t *testing.T) {
+		req := &indexpb.VerifyIndexBuildRequest{}
+		resp, err := inc.VerifyIndexBuild(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	err = ins.Stop()
 	assert.Nil(t, err)
 
@@ -256,6 +256,11 @@ func (s *Server) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReques
 	return s.indexnode.GetJobStats(ctx, req)
 }
 
+// VerifyIndexBuild rebuilds an index recorded in reproducibility mode and compares the checksums of the index files.
+func (s *Server) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	return s.indexnode.VerifyIndexBuild(ctx, req)
+}
+
 // ShowConfigurations gets specified configurations para of IndexNode
 func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	return s.indexnode.ShowConfigurations(ctx, req)
@@ -125,6 +125,13 @@ func TestIndexNodeServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("VerifyIndexBuild", func(t *testing.T) {
+		req := &indexpb.VerifyIndexBuildRequest{}
+		resp, err := server.VerifyIndexBuild(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
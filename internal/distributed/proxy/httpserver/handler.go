@@ -56,6 +56,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST("/entities", wrapHandler(h.handleInsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
+	router.POST("/search/multi", wrapHandler(h.handleSearchMulti))
 	router.POST("/query", wrapHandler(h.handleQuery))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
@@ -344,25 +345,16 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	req := milvuspb.SearchRequest{
-		Base:               wrappedReq.Base,
-		DbName:             wrappedReq.DbName,
-		CollectionName:     wrappedReq.CollectionName,
-		PartitionNames:     wrappedReq.PartitionNames,
-		Dsl:                wrappedReq.Dsl,
-		DslType:            wrappedReq.DslType,
-		OutputFields:       wrappedReq.OutputFields,
-		SearchParams:       wrappedReq.SearchParams,
-		TravelTimestamp:    wrappedReq.TravelTimestamp,
-		GuaranteeTimestamp: wrappedReq.GuaranteeTimestamp,
-		Nq:                 wrappedReq.Nq,
-	}
-	if len(wrappedReq.BinaryVectors) > 0 {
-		req.PlaceholderGroup = binaryVector2Bytes(wrappedReq.BinaryVectors)
-	} else {
-		req.PlaceholderGroup = vector2Bytes(wrappedReq.Vectors)
+	return h.proxy.Search(c, wrappedReq.AsSearchRequest())
+}
+
+func (h *Handlers) handleSearchMulti(c *gin.Context) (interface{}, error) {
+	wrappedReq := SearchMultiRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Search(c, &req)
+	return h.proxy.SearchMulti(c, wrappedReq.CollectionNames, wrappedReq.AsSearchRequest())
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
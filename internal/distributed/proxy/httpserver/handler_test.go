@@ -169,6 +169,18 @@ func (m *mockProxyComponent) Search(ctx context.Context, request *milvuspb.Searc
 	return &searchResult, nil
 }
 
+var searchMultiResult = []*milvuspb.SearchResults{
+	{CollectionName: "c1", Results: &schemapb.SearchResultData{TopK: 10}},
+	{CollectionName: "c2", Results: &schemapb.SearchResultData{TopK: 10}},
+}
+
+func (m *mockProxyComponent) SearchMulti(ctx context.Context, collectionNames []string, request *milvuspb.SearchRequest) ([]*milvuspb.SearchResults, error) {
+	if len(collectionNames) != 2 || request.Dsl == "" || len(request.PlaceholderGroup) == 0 {
+		return nil, errors.New("body parse err")
+	}
+	return searchMultiResult, nil
+}
+
 var queryResult = milvuspb.QueryResults{
 	CollectionName: "test",
 }
@@ -446,6 +458,13 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/search", milvuspb.SearchRequest{Dsl: "some dsl"},
 			http.StatusOK, &searchResult,
 		},
+		{
+			http.MethodPost, "/search/multi", SearchMultiRequest{
+				SearchRequest:   SearchRequest{Dsl: "some dsl", Vectors: [][]float32{{1, 2}}},
+				CollectionNames: []string{"c1", "c2"},
+			},
+			http.StatusOK, searchMultiResult,
+		},
 		{
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
@@ -250,6 +250,36 @@ type SearchRequest struct {
 	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
 }
 
+// AsSearchRequest converts the RESTful request body to the search request, the vectors are encoded as the placeholder group
+func (req *SearchRequest) AsSearchRequest() *milvuspb.SearchRequest {
+	ret := &milvuspb.SearchRequest{
+		Base:               req.Base,
+		DbName:             req.DbName,
+		CollectionName:     req.CollectionName,
+		PartitionNames:     req.PartitionNames,
+		Dsl:                req.Dsl,
+		DslType:            req.DslType,
+		OutputFields:       req.OutputFields,
+		SearchParams:       req.SearchParams,
+		TravelTimestamp:    req.TravelTimestamp,
+		GuaranteeTimestamp: req.GuaranteeTimestamp,
+		Nq:                 req.Nq,
+	}
+	if len(req.BinaryVectors) > 0 {
+		ret.PlaceholderGroup = binaryVector2Bytes(req.BinaryVectors)
+	} else {
+		ret.PlaceholderGroup = vector2Bytes(req.Vectors)
+	}
+	return ret
+}
+
+// SearchMultiRequest is the RESTful request body for the same search on several collections,
+// the collection name and partition names of the search are ignored
+type SearchMultiRequest struct {
+	SearchRequest
+	CollectionNames []string `json:"collection_names,omitempty"`
+}
+
 func binaryVector2Bytes(vectors [][]byte) []byte {
 	ph := &commonpb.PlaceholderValue{
 		Tag:    "$0",
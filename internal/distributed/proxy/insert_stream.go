@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+)
+
+const (
+	// InsertStreamServiceName is the grpc service of the streaming insert, served beside MilvusService
+	InsertStreamServiceName = "milvus.proto.milvus.MilvusStreamingService"
+	// InsertStreamMethod is the full method of the streaming insert
+	InsertStreamMethod = "/" + InsertStreamServiceName + "/Insert"
+
+	insertFullMethod = "/milvus.proto.milvus.MilvusService/Insert"
+)
+
+// insertStreamServer serves the streaming insert.
+type insertStreamServer interface {
+	InsertStream(stream grpc.ServerStream) error
+}
+
+// InsertStreamDesc describes the client streaming insert. It reuses the messages of Insert, the client sends the rows
+// of one insert in milvuspb.InsertRequest chunks, closes the send direction and receives one milvuspb.MutationResult.
+var InsertStreamDesc = grpc.StreamDesc{
+	StreamName:    "Insert",
+	Handler:       insertStreamHandler,
+	ClientStreams: true,
+}
+
+var insertStreamServiceDesc = grpc.ServiceDesc{
+	ServiceName: InsertStreamServiceName,
+	HandlerType: (*insertStreamServer)(nil),
+	Streams:     []grpc.StreamDesc{InsertStreamDesc},
+	Metadata:    "milvus.proto",
+}
+
+func insertStreamHandler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(insertStreamServer).InsertStream(stream)
+}
+
+// InsertStream assembles the chunks of a streaming insert and inserts them as one Insert request, which goes through
+// the interceptors of the external grpc server like an Insert. The caller is authenticated before receiving the chunks.
+func (s *Server) InsertStream(stream grpc.ServerStream) error {
+	ctx := stream.Context()
+	if _, err := proxy.AuthenticationInterceptor(ctx); err != nil {
+		return err
+	}
+
+	request, err := proxy.AssembleInsertStream(func() (*milvuspb.InsertRequest, error) {
+		chunk := &milvuspb.InsertRequest{}
+		if err := stream.RecvMsg(chunk); err != nil {
+			return nil, err
+		}
+		return chunk, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return stream.SendMsg(&milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		})
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.Insert(ctx, req.(*milvuspb.InsertRequest))
+	}
+	var resp interface{}
+	if s.unaryInterceptor == nil {
+		resp, err = handler(ctx, request)
+	} else {
+		resp, err = s.unaryInterceptor(ctx, request, &grpc.UnaryServerInfo{Server: s, FullMethod: insertFullMethod}, handler)
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendMsg(resp)
+}
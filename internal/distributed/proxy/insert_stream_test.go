@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestServer_InsertStream(t *testing.T) {
+	paramtable.Init()
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(&insertStreamServiceDesc, &Server{proxy: &MockProxy{}})
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := conn.NewStream(ctx, &InsertStreamDesc, InsertStreamMethod)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&milvuspb.InsertRequest{CollectionName: "c", NumRows: 1}))
+	require.NoError(t, stream.CloseSend())
+	// the proxy is not ready to authenticate the caller
+	assert.Error(t, stream.RecvMsg(&milvuspb.MutationResult{}))
+}
@@ -40,10 +40,13 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	icc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
@@ -81,11 +84,14 @@ type Server struct {
 	proxy              types.ProxyComponent
 	grpcInternalServer *grpc.Server
 	grpcExternalServer *grpc.Server
+	// unaryInterceptor chains the interceptors of the external grpc server
+	unaryInterceptor grpc.UnaryServerInterceptor
 
 	etcdCli          *clientv3.Client
 	rootCoordClient  types.RootCoord
 	dataCoordClient  types.DataCoord
 	queryCoordClient types.QueryCoord
+	indexCoordClient types.IndexCoord
 
 	tracer opentracing.Tracer
 	closer io.Closer
@@ -165,20 +171,22 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	log.Debug("Get proxy rate limiter done", zap.Int("port", grpcPort))
 
 	opts := trace.GetInterceptorOpts()
+	// kept for the streaming insert, whose assembled request goes through the interceptors of Insert
+	s.unaryInterceptor = grpc_middleware.ChainUnaryServer(
+		ot.UnaryServerInterceptor(opts...),
+		grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
+		proxy.UnaryServerHookInterceptor(),
+		proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
+		logutil.UnaryTraceLoggerInterceptor,
+		proxy.RateLimitInterceptor(limiter),
+		accesslog.UnaryAccessLoggerInterceptor,
+	)
 	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			ot.UnaryServerInterceptor(opts...),
-			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
-			proxy.UnaryServerHookInterceptor(),
-			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
-			logutil.UnaryTraceLoggerInterceptor,
-			proxy.RateLimitInterceptor(limiter),
-			accesslog.UnaryAccessLoggerInterceptor,
-		)),
+		grpc.UnaryInterceptor(s.unaryInterceptor),
 	}
 
 	if Params.TLSMode.GetAsInt() == 1 {
@@ -220,6 +228,8 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	}
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	proxypb.RegisterMilvusExtServiceServer(s.grpcExternalServer, s)
+	s.grpcExternalServer.RegisterService(&insertStreamServiceDesc, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -446,6 +456,30 @@ func (s *Server) init() error {
 	s.proxy.SetQueryCoordClient(s.queryCoordClient)
 	log.Debug("set QueryCoord client for Proxy done")
 
+	// IndexCoord is only deployed with ENABLE_INDEX_COORD, so the proxy doesn't wait for it to be healthy, and the
+	// requests forwarded to it fail until it's up.
+	if s.indexCoordClient == nil {
+		var err error
+		log.Debug("create IndexCoord client for Proxy")
+		s.indexCoordClient, err = icc.NewClient(s.ctx, proxy.Params.EtcdCfg.MetaRootPath.GetValue(), etcdCli)
+		if err != nil {
+			log.Warn("failed to create IndexCoord client for Proxy", zap.Error(err))
+			return err
+		}
+		log.Debug("create IndexCoord client for Proxy done")
+	}
+
+	log.Debug("init IndexCoord client for Proxy")
+	if err := s.indexCoordClient.Init(); err != nil {
+		log.Warn("failed to init IndexCoord client for Proxy", zap.Error(err))
+		return err
+	}
+	log.Debug("init IndexCoord client for Proxy done")
+
+	log.Debug("set IndexCoord client for Proxy")
+	s.proxy.SetIndexCoordClient(s.indexCoordClient)
+	log.Debug("set IndexCoord client for Proxy done")
+
 	log.Debug(fmt.Sprintf("update Proxy's state to %s", commonpb.StateCode_Initializing.String()))
 	s.proxy.UpdateStateCode(commonpb.StateCode_Initializing)
 
@@ -854,6 +888,21 @@ func (s *Server) SetRates(ctx context.Context, request *proxypb.SetRatesRequest)
 	return s.proxy.SetRates(ctx, request)
 }
 
+// RefreshRoleQuota notifies Proxy to refresh the rate limiting quota of a role.
+func (s *Server) RefreshRoleQuota(ctx context.Context, req *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshRoleQuota(ctx, req)
+}
+
+// RefreshDatabaseQuota notifies Proxy to refresh the rate limiting quota of a database.
+func (s *Server) RefreshDatabaseQuota(ctx context.Context, req *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshDatabaseQuota(ctx, req)
+}
+
+// RefreshReadOnlyMode notifies Proxy to enter or leave the read-only mode of the cluster or of a database.
+func (s *Server) RefreshReadOnlyMode(ctx context.Context, req *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshReadOnlyMode(ctx, req)
+}
+
 // GetProxyMetrics gets the metrics of proxy.
 func (s *Server) GetProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.proxy.GetProxyMetrics(ctx, request)
@@ -873,3 +922,241 @@ func (s *Server) GetVersion(ctx context.Context, request *milvuspb.GetVersionReq
 func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.proxy.CheckHealth(ctx, request)
 }
+
+// DryRunCreateCollection validates a CreateCollection request and returns the collection that would be created
+func (s *Server) DryRunCreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*proxypb.DryRunCreateCollectionResponse, error) {
+	return s.proxy.DryRunCreateCollection(ctx, request)
+}
+
+// DryRunCreateIndex validates a CreateIndex request and returns the index that would be created
+func (s *Server) DryRunCreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*proxypb.DryRunCreateIndexResponse, error) {
+	return s.proxy.DryRunCreateIndex(ctx, request)
+}
+
+// RebuildDeprecatedIndexes lists the segment indexes built by deprecated engine or file format versions and rebuilds them.
+func (s *Server) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return s.proxy.RebuildDeprecatedIndexes(ctx, req)
+}
+
+func (s *Server) SearchReducedPrecision(ctx context.Context, req *milvuspb.SearchRequest) (*proxypb.ReducedPrecisionSearchResults, error) {
+	return s.proxy.SearchReducedPrecision(ctx, req)
+}
+
+func (s *Server) QueryReducedPrecision(ctx context.Context, req *milvuspb.QueryRequest) (*proxypb.ReducedPrecisionQueryResults, error) {
+	return s.proxy.QueryReducedPrecision(ctx, req)
+}
+
+// ListRoleQuotas lists the rate limiting quotas of roles in RootCoord.
+func (s *Server) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	return s.proxy.ListRoleQuotas(ctx, req)
+}
+
+// SaveRoleQuota saves the rate limiting quota of a role in RootCoord.
+func (s *Server) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.SaveRoleQuota(ctx, req)
+}
+
+// DropRoleQuota drops the rate limiting quota of a role in RootCoord.
+func (s *Server) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRoleQuota(ctx, req)
+}
+
+// ListDatabaseQuotas lists the rate limiting quotas of databases in RootCoord.
+func (s *Server) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return s.proxy.ListDatabaseQuotas(ctx, req)
+}
+
+// SaveDatabaseQuota saves the rate limiting quota of a database in RootCoord.
+func (s *Server) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.SaveDatabaseQuota(ctx, req)
+}
+
+// DropDatabaseQuota drops the rate limiting quota of a database in RootCoord.
+func (s *Server) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabaseQuota(ctx, req)
+}
+
+// ListReadOnlyModes lists the read-only modes entered in RootCoord.
+func (s *Server) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	return s.proxy.ListReadOnlyModes(ctx, req)
+}
+
+// EnterReadOnly enters the read-only mode of the cluster or of a database in RootCoord.
+func (s *Server) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return s.proxy.EnterReadOnly(ctx, req)
+}
+
+// LeaveReadOnly leaves the read-only mode of the cluster or of a database in RootCoord.
+func (s *Server) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return s.proxy.LeaveReadOnly(ctx, req)
+}
+
+// GetSegmentLifecycle returns the merged view of the segments of a collection.
+func (s *Server) GetSegmentLifecycle(ctx context.Context, req *proxypb.GetSegmentLifecycleRequest) (*proxypb.GetSegmentLifecycleResponse, error) {
+	return s.proxy.GetSegmentLifecycle(ctx, req)
+}
+
+// CheckIndexConsistency checks the index consistency in IndexCoord.
+func (s *Server) CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error) {
+	return s.proxy.CheckIndexConsistency(ctx, req)
+}
+
+// GetDeleteTombstones reports the delete tombstones of a primary key.
+func (s *Server) GetDeleteTombstones(ctx context.Context, req *proxypb.GetDeleteTombstonesRequest) (*proxypb.GetDeleteTombstonesResponse, error) {
+	return s.proxy.GetDeleteTombstones(ctx, req)
+}
+
+// GetDdlOperationState returns the ddl operations in the journal of RootCoord.
+func (s *Server) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	return s.proxy.GetDdlOperationState(ctx, req)
+}
+
+// GetIndexStatistics returns the daily build statistics of the indexes in IndexCoord.
+func (s *Server) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return s.proxy.GetIndexStatistics(ctx, req)
+}
+
+// PreImport checks the files of an import in DataCoord.
+func (s *Server) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return s.proxy.PreImport(ctx, req)
+}
+
+// CordonIndexNode cordons or uncordons an IndexNode in IndexCoord.
+func (s *Server) CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+	return s.proxy.CordonIndexNode(ctx, req)
+}
+
+// GetSegmentHistory returns the state transitions of a segment in DataCoord.
+func (s *Server) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	return s.proxy.GetSegmentHistory(ctx, req)
+}
+
+// PauseCollectionMaintenance pauses the compaction and/or the binlog gc of a collection in DataCoord.
+func (s *Server) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return s.proxy.PauseCollectionMaintenance(ctx, req)
+}
+
+// ResumeCollectionMaintenance resumes the compaction and/or the binlog gc of a collection in DataCoord.
+func (s *Server) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return s.proxy.ResumeCollectionMaintenance(ctx, req)
+}
+
+// GetCollectionMaintenancePauses returns the paused collections in DataCoord.
+func (s *Server) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return s.proxy.GetCollectionMaintenancePauses(ctx, req)
+}
+
+// ListProxyTasks lists the in-flight tasks of the proxy.
+func (s *Server) ListProxyTasks(ctx context.Context, req *proxypb.ListProxyTasksRequest) (*proxypb.ListProxyTasksResponse, error) {
+	return s.proxy.ListProxyTasks(ctx, req)
+}
+
+// DrainProxy stops or resumes accepting new dml and dql requests in the proxy.
+func (s *Server) DrainProxy(ctx context.Context, req *proxypb.DrainProxyRequest) (*proxypb.ListProxyTasksResponse, error) {
+	return s.proxy.DrainProxy(ctx, req)
+}
+
+// TriggerGC runs the gc passes of a scope in IndexCoord.
+func (s *Server) TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error) {
+	return s.proxy.TriggerGC(ctx, req)
+}
+
+// ListIndexTTLs lists the ttl states of the indexes in IndexCoord.
+func (s *Server) ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+	return s.proxy.ListIndexTTLs(ctx, req)
+}
+
+// SetIndexTTL sets the ttl of an index in IndexCoord.
+func (s *Server) SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+	return s.proxy.SetIndexTTL(ctx, req)
+}
+
+// CheckIndexTTL checks the usage of the indexes with ttl in IndexCoord right away.
+func (s *Server) CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+	return s.proxy.CheckIndexTTL(ctx, req)
+}
+
+// VerifyIndexBuild rebuilds an index recorded in reproducibility mode on an IndexNode through IndexCoord.
+func (s *Server) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	return s.proxy.VerifyIndexBuild(ctx, req)
+}
+
+// GetSegmentAllocHints returns the row distribution of the partitions of a collection over the vchannels and the allocation hints.
+func (s *Server) GetSegmentAllocHints(ctx context.Context, req *proxypb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return s.proxy.GetSegmentAllocHints(ctx, req)
+}
+
+// GetSegmentHeats returns the query heats of the segments in DataCoord.
+func (s *Server) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	return s.proxy.GetSegmentHeats(ctx, req)
+}
+
+// FreezeHandoffs freezes the handoffs and the compaction of a collection or the whole cluster in DataCoord.
+func (s *Server) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	return s.proxy.FreezeHandoffs(ctx, req)
+}
+
+// UnfreezeHandoffs closes the freeze window of a collection or the whole cluster in DataCoord.
+func (s *Server) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return s.proxy.UnfreezeHandoffs(ctx, req)
+}
+
+// GetFreezeWindows returns the freeze windows not expired in DataCoord.
+func (s *Server) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	return s.proxy.GetFreezeWindows(ctx, req)
+}
+
+// GetDeleteSLA returns the delete sla markers in DataCoord.
+func (s *Server) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	return s.proxy.GetDeleteSLA(ctx, req)
+}
+
+// DecommissionDataNode decommissions a DataNode through DataCoord.
+func (s *Server) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return s.proxy.DecommissionDataNode(ctx, req)
+}
+
+// LocatePrimaryKeys returns the segments of a collection in DataCoord which may contain the primary keys.
+func (s *Server) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	return s.proxy.LocatePrimaryKeys(ctx, req)
+}
+
+// InspectSegmentLocks returns the segment lock leases in DataCoord.
+func (s *Server) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	return s.proxy.InspectSegmentLocks(ctx, req)
+}
+
+// GetLoadProgressDetail returns the load progress of a collection along with the progress of the segments being loaded.
+func (s *Server) GetLoadProgressDetail(ctx context.Context, req *proxypb.GetLoadProgressDetailRequest) (*proxypb.GetLoadProgressDetailResponse, error) {
+	return s.proxy.GetLoadProgressDetail(ctx, req)
+}
+
+// CompactSegments triggers a manual compaction of the given segments of a collection.
+func (s *Server) CompactSegments(ctx context.Context, req *proxypb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.proxy.CompactSegments(ctx, req)
+}
+
+// GetSearchCalibration returns the latency calibration curves of the search params of a collection.
+func (s *Server) GetSearchCalibration(ctx context.Context, req *proxypb.GetSearchCalibrationRequest) (*proxypb.GetSearchCalibrationResponse, error) {
+	return s.proxy.GetSearchCalibration(ctx, req)
+}
+
+// SetSearchRecalls sets the recalls of the search param values measured by an offline calibration.
+func (s *Server) SetSearchRecalls(ctx context.Context, req *proxypb.SetSearchRecallsRequest) (*commonpb.Status, error) {
+	return s.proxy.SetSearchRecalls(ctx, req)
+}
+
+// GetHandoffGate returns the handoff gating states of the flushed segments of a collection.
+func (s *Server) GetHandoffGate(ctx context.Context, req *proxypb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	return s.proxy.GetHandoffGate(ctx, req)
+}
+
+// GetSegmentAnomalies returns the segment reports of the abnormal collections in DataCoord.
+func (s *Server) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return s.proxy.GetSegmentAnomalies(ctx, req)
+}
+
+// MergeTinySegments triggers a compaction of a collection which merges its small segments.
+func (s *Server) MergeTinySegments(ctx context.Context, req *proxypb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.proxy.MergeTinySegments(ctx, req)
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -281,6 +282,46 @@ func (m *MockRootCoord) ListPolicy(ctx context.Context, in *internalpb.ListPolic
 	return nil, nil
 }
 
+func (m *MockRootCoord) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -536,6 +577,10 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return nil, nil
 }
 
+func (m *MockDataCoord) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -544,10 +589,94 @@ func (m *MockDataCoord) UpdateChannelCheckpoint(ctx context.Context, req *datapb
 	return nil, nil
 }
 
+func (m *MockDataCoord) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
 
+func (m *MockDataCoord) RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -588,6 +717,21 @@ func (m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *datapb.G
 	return nil, nil
 }
 
+func (m *MockDataCoord) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return nil, nil
+}
+
+// /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// MockIndexCoord only initializes, IndexCoord isn't waited for by the proxy.
+type MockIndexCoord struct {
+	types.IndexCoord
+	initErr error
+}
+
+func (m *MockIndexCoord) Init() error {
+	return m.initErr
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockProxy struct {
 	MockBase
@@ -727,6 +871,10 @@ func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) SearchMulti(ctx context.Context, collectionNames []string, request *milvuspb.SearchRequest) ([]*milvuspb.SearchResults, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return nil, nil
 }
@@ -783,6 +931,18 @@ func (m *MockProxy) SetRates(ctx context.Context, request *proxypb.SetRatesReque
 	return nil, nil
 }
 
+func (m *MockProxy) RefreshRoleQuota(ctx context.Context, req *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RefreshDatabaseQuota(ctx context.Context, req *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RefreshReadOnlyMode(ctx context.Context, req *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, nil
 }
@@ -909,6 +1069,198 @@ func (m *MockProxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHeal
 	return nil, nil
 }
 
+func (m *MockProxy) DryRunCreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*proxypb.DryRunCreateCollectionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DryRunCreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*proxypb.DryRunCreateIndexResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SearchReducedPrecision(ctx context.Context, req *milvuspb.SearchRequest) (*proxypb.ReducedPrecisionSearchResults, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) QueryReducedPrecision(ctx context.Context, req *milvuspb.QueryRequest) (*proxypb.ReducedPrecisionQueryResults, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetSegmentLifecycle(ctx context.Context, req *proxypb.GetSegmentLifecycleRequest) (*proxypb.GetSegmentLifecycleResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetDeleteTombstones(ctx context.Context, req *proxypb.GetDeleteTombstonesRequest) (*proxypb.GetDeleteTombstonesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListProxyTasks(ctx context.Context, req *proxypb.ListProxyTasksRequest) (*proxypb.ListProxyTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DrainProxy(ctx context.Context, req *proxypb.DrainProxyRequest) (*proxypb.ListProxyTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetSegmentAllocHints(ctx context.Context, req *proxypb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetLoadProgressDetail(ctx context.Context, req *proxypb.GetLoadProgressDetailRequest) (*proxypb.GetLoadProgressDetailResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CompactSegments(ctx context.Context, req *proxypb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetSearchCalibration(ctx context.Context, req *proxypb.GetSearchCalibrationRequest) (*proxypb.GetSearchCalibrationResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SetSearchRecalls(ctx context.Context, req *proxypb.SetSearchRecallsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetHandoffGate(ctx context.Context, req *proxypb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) MergeTinySegments(ctx context.Context, req *proxypb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type WaitOption struct {
@@ -1040,6 +1392,7 @@ func Test_NewServer(t *testing.T) {
 	server.rootCoordClient = &MockRootCoord{}
 	server.queryCoordClient = &MockQueryCoord{}
 	server.dataCoordClient = &MockDataCoord{}
+	server.indexCoordClient = &MockIndexCoord{}
 
 	t.Run("Run", func(t *testing.T) {
 		err = runAndWaitForServerReady(server)
@@ -1341,6 +1694,246 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("DryRunCreateCollection", func(t *testing.T) {
+		_, err := server.DryRunCreateCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DryRunCreateIndex", func(t *testing.T) {
+		_, err := server.DryRunCreateIndex(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RebuildDeprecatedIndexes", func(t *testing.T) {
+		_, err := server.RebuildDeprecatedIndexes(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SearchReducedPrecision", func(t *testing.T) {
+		_, err := server.SearchReducedPrecision(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("QueryReducedPrecision", func(t *testing.T) {
+		_, err := server.QueryReducedPrecision(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListRoleQuotas", func(t *testing.T) {
+		_, err := server.ListRoleQuotas(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SaveRoleQuota", func(t *testing.T) {
+		_, err := server.SaveRoleQuota(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropRoleQuota", func(t *testing.T) {
+		_, err := server.DropRoleQuota(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListDatabaseQuotas", func(t *testing.T) {
+		_, err := server.ListDatabaseQuotas(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SaveDatabaseQuota", func(t *testing.T) {
+		_, err := server.SaveDatabaseQuota(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropDatabaseQuota", func(t *testing.T) {
+		_, err := server.DropDatabaseQuota(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListReadOnlyModes", func(t *testing.T) {
+		_, err := server.ListReadOnlyModes(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("EnterReadOnly", func(t *testing.T) {
+		_, err := server.EnterReadOnly(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("LeaveReadOnly", func(t *testing.T) {
+		_, err := server.LeaveReadOnly(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetSegmentLifecycle", func(t *testing.T) {
+		_, err := server.GetSegmentLifecycle(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CheckIndexConsistency", func(t *testing.T) {
+		_, err := server.CheckIndexConsistency(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetDeleteTombstones", func(t *testing.T) {
+		_, err := server.GetDeleteTombstones(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetDdlOperationState", func(t *testing.T) {
+		_, err := server.GetDdlOperationState(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetIndexStatistics", func(t *testing.T) {
+		_, err := server.GetIndexStatistics(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("PreImport", func(t *testing.T) {
+		_, err := server.PreImport(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CordonIndexNode", func(t *testing.T) {
+		_, err := server.CordonIndexNode(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetSegmentHistory", func(t *testing.T) {
+		_, err := server.GetSegmentHistory(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("PauseCollectionMaintenance", func(t *testing.T) {
+		_, err := server.PauseCollectionMaintenance(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ResumeCollectionMaintenance", func(t *testing.T) {
+		_, err := server.ResumeCollectionMaintenance(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetCollectionMaintenancePauses", func(t *testing.T) {
+		_, err := server.GetCollectionMaintenancePauses(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListProxyTasks", func(t *testing.T) {
+		_, err := server.ListProxyTasks(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DrainProxy", func(t *testing.T) {
+		_, err := server.DrainProxy(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("TriggerGC", func(t *testing.T) {
+		_, err := server.TriggerGC(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListIndexTTLs", func(t *testing.T) {
+		_, err := server.ListIndexTTLs(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SetIndexTTL", func(t *testing.T) {
+		_, err := server.SetIndexTTL(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CheckIndexTTL", func(t *testing.T) {
+		_, err := server.CheckIndexTTL(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("VerifyIndexBuild", func(t *testing.T) {
+		_, err := server.VerifyIndexBuild(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetSegmentAllocHints", func(t *testing.T) {
+		_, err := server.GetSegmentAllocHints(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetSegmentHeats", func(t *testing.T) {
+		_, err := server.GetSegmentHeats(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("FreezeHandoffs", func(t *testing.T) {
+		_, err := server.FreezeHandoffs(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("UnfreezeHandoffs", func(t *testing.T) {
+		_, err := server.UnfreezeHandoffs(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetFreezeWindows", func(t *testing.T) {
+		_, err := server.GetFreezeWindows(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetDeleteSLA", func(t *testing.T) {
+		_, err := server.GetDeleteSLA(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DecommissionDataNode", func(t *testing.T) {
+		_, err := server.DecommissionDataNode(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("LocatePrimaryKeys", func(t *testing.T) {
+		_, err := server.LocatePrimaryKeys(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("InspectSegmentLocks", func(t *testing.T) {
+		_, err := server.InspectSegmentLocks(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetLoadProgressDetail", func(t *testing.T) {
+		_, err := server.GetLoadProgressDetail(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CompactSegments", func(t *testing.T) {
+		_, err := server.CompactSegments(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetSearchCalibration", func(t *testing.T) {
+		_, err := server.GetSearchCalibration(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SetSearchRecalls", func(t *testing.T) {
+		_, err := server.SetSearchRecalls(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetHandoffGate", func(t *testing.T) {
+		_, err := server.GetHandoffGate(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetSegmentAnomalies", func(t *testing.T) {
+		_, err := server.GetSegmentAnomalies(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("MergeTinySegments", func(t *testing.T) {
+		_, err := server.MergeTinySegments(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 
@@ -1364,6 +1957,7 @@ func TestServer_Check(t *testing.T) {
 	server.rootCoordClient = &MockRootCoord{}
 	server.queryCoordClient = &MockQueryCoord{}
 	server.dataCoordClient = &MockDataCoord{}
+	server.indexCoordClient = &MockIndexCoord{}
 
 	req := &grpc_health_v1.HealthCheckRequest{Service: ""}
 	ret, err := server.Check(ctx, req)
@@ -1419,6 +2013,7 @@ func TestServer_Watch(t *testing.T) {
 	server.rootCoordClient = &MockRootCoord{}
 	server.queryCoordClient = &MockQueryCoord{}
 	server.dataCoordClient = &MockDataCoord{}
+	server.indexCoordClient = &MockIndexCoord{}
 
 	watchServer := milvusmock.NewGrpcHealthWatchServer()
 	resultChan := watchServer.Chan()
@@ -1483,6 +2078,7 @@ func Test_NewServer_HTTPServer_Enabled(t *testing.T) {
 	server.rootCoordClient = &MockRootCoord{}
 	server.queryCoordClient = &MockQueryCoord{}
 	server.dataCoordClient = &MockDataCoord{}
+	server.indexCoordClient = &MockIndexCoord{}
 
 	paramtable.Get().Save(proxy.Params.HTTPCfg.Enabled.Key, "true")
 
@@ -1511,6 +2107,7 @@ func getServer(t *testing.T) *Server {
 	server.rootCoordClient = &MockRootCoord{}
 	server.queryCoordClient = &MockQueryCoord{}
 	server.dataCoordClient = &MockDataCoord{}
+	server.indexCoordClient = &MockIndexCoord{}
 	return server
 }
 
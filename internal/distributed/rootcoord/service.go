@@ -472,6 +472,56 @@ func (s *Server) ListPolicy(ctx context.Context, request *internalpb.ListPolicyR
 	return s.rootCoord.ListPolicy(ctx, request)
 }
 
+// ListRoleQuotas lists the rate limiting quotas of roles.
+func (s *Server) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	return s.rootCoord.ListRoleQuotas(ctx, req)
+}
+
+// SaveRoleQuota saves the rate limiting quota of a role.
+func (s *Server) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return s.rootCoord.SaveRoleQuota(ctx, req)
+}
+
+// DropRoleQuota drops the rate limiting quota of a role.
+func (s *Server) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRoleQuota(ctx, req)
+}
+
+// ListDatabaseQuotas lists the rate limiting quotas of databases.
+func (s *Server) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return s.rootCoord.ListDatabaseQuotas(ctx, req)
+}
+
+// SaveDatabaseQuota saves the rate limiting quota of a database.
+func (s *Server) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return s.rootCoord.SaveDatabaseQuota(ctx, req)
+}
+
+// DropDatabaseQuota drops the rate limiting quota of a database.
+func (s *Server) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabaseQuota(ctx, req)
+}
+
+// ListReadOnlyModes lists the read-only modes entered.
+func (s *Server) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	return s.rootCoord.ListReadOnlyModes(ctx, req)
+}
+
+// EnterReadOnly enters the read-only mode of the cluster or of a database.
+func (s *Server) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.EnterReadOnly(ctx, req)
+}
+
+// LeaveReadOnly leaves the read-only mode of the cluster or of a database.
+func (s *Server) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.LeaveReadOnly(ctx, req)
+}
+
+// GetDdlOperationState returns the ddl operations in the journal.
+func (s *Server) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	return s.rootCoord.GetDdlOperationState(ctx, req)
+}
+
 func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.AlterCollection(ctx, request)
 }
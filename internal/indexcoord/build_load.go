@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+const (
+	// defaultIndexBuildCostFactor is the factor of the index types not listed in indexBuildCostFactors
+	defaultIndexBuildCostFactor = 1.0
+	// scalarBuildRowSize is the estimated bytes per row of the scalar fields
+	scalarBuildRowSize = 64
+)
+
+// indexBuildCostFactors are the relative costs of building the index types per byte of the raw vectors.
+var indexBuildCostFactors = map[indexparamcheck.IndexType]float64{
+	indexparamcheck.IndexFaissIvfFlat:    1.0,
+	indexparamcheck.IndexFaissBinIvfFlat: 1.0,
+	indexparamcheck.IndexFaissIvfSQ8:     1.0,
+	indexparamcheck.IndexFaissIvfSQ8H:    1.0,
+	indexparamcheck.IndexFaissIvfPQ:      1.5,
+	indexparamcheck.IndexANNOY:           2.0,
+	indexparamcheck.IndexHNSW:            3.0,
+	indexparamcheck.IndexRHNSWFlat:       3.0,
+	indexparamcheck.IndexRHNSWPQ:         3.0,
+	indexparamcheck.IndexRHNSWSQ:         3.0,
+	indexparamcheck.IndexNSG:             4.0,
+	indexparamcheck.IndexNGTPANNG:        4.0,
+	indexparamcheck.IndexNGTONNG:         4.0,
+	indexparamcheck.IndexDISKANN:         4.0,
+}
+
+// estimateBuildLoad estimates the load of an index build by rows × vector bytes × index type cost factor.
+func estimateBuildLoad(numRows int64, typeParams, indexParams []*commonpb.KeyValuePair) int64 {
+	if numRows <= 0 {
+		return 0
+	}
+	indexType := getIndexType(indexParams)
+	rowSize := float64(scalarBuildRowSize)
+	if dimStr, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DimKey, typeParams); err == nil {
+		dim, err := strconv.ParseInt(dimStr, 10, 64)
+		if err == nil && dim > 0 {
+			if strings.HasPrefix(indexType, "BIN_") {
+				rowSize = float64(dim) / 8
+			} else {
+				rowSize = float64(dim) * 4
+			}
+		}
+	}
+	factor, ok := indexBuildCostFactors[indexparamcheck.IndexType(indexType)]
+	if !ok {
+		factor = defaultIndexBuildCostFactor
+	}
+	return int64(float64(numRows) * rowSize * factor)
+}
+
+// buildLoad returns the estimated load of the segment index build.
+func (ib *indexBuilder) buildLoad(segIdx *model.SegmentIndex) int64 {
+	return estimateBuildLoad(segIdx.NumRows,
+		ib.meta.GetTypeParams(segIdx.CollectionID, segIdx.IndexID),
+		ib.meta.GetIndexParams(segIdx.CollectionID, segIdx.IndexID))
+}
+
+// rebalance moves the builds queued on the loaded IndexNodes to the IndexNode just joined.
+// IndexNode runs its jobs in the order they are assigned, so the latest EnqueueJobNum builds assigned to a node
+// are the ones not started yet. The moved builds are retried, i.e. dropped from the old node and assigned again to
+// the node with the least pending load, a build is only moved if it makes the loads of both nodes closer.
+func (ib *indexBuilder) rebalance(nodeID UniqueID) {
+	if !Params.IndexCoordCfg.BuildLoadBalanceEnabled.GetAsBool() {
+		return
+	}
+	nm := ib.ic.nodeManager
+	allClients := nm.GetAllClients()
+	if _, ok := allClients[nodeID]; !ok || len(allClients) < 2 {
+		return
+	}
+
+	loads := nm.getPendingLoads()
+	queued := make(map[UniqueID][]*pendingBuild)
+	for srcID, client := range allClients {
+		if srcID == nodeID {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(ib.ctx, reqTimeoutInterval)
+		resp, err := client.GetJobStats(ctx, &indexpb.GetJobStatsRequest{})
+		cancel()
+		if err != nil || resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Ctx(ib.ctx).Warn("get IndexNode job stats failed, skip rebalancing its builds", zap.Int64("nodeID", srcID),
+				zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+			continue
+		}
+		if builds := nm.getLatestBuilds(srcID, int(resp.GetEnqueueJobNum())); len(builds) > 0 {
+			queued[srcID] = builds
+		}
+	}
+
+	moved := make([]UniqueID, 0)
+	for {
+		// move the latest queued build of the most loaded node
+		srcID, maxLoad := UniqueID(0), int64(0)
+		for id, builds := range queued {
+			if len(builds) > 0 && (loads[id] > maxLoad || (loads[id] == maxLoad && id < srcID)) {
+				srcID, maxLoad = id, loads[id]
+			}
+		}
+		if srcID == 0 {
+			break
+		}
+		build := queued[srcID][0]
+		if loads[nodeID]+build.load >= loads[srcID] {
+			delete(queued, srcID)
+			continue
+		}
+		queued[srcID] = queued[srcID][1:]
+		loads[srcID] -= build.load
+		loads[nodeID] += build.load
+		moved = append(moved, build.buildID)
+	}
+	if len(moved) == 0 {
+		return
+	}
+
+	ib.taskMutex.Lock()
+	for _, buildID := range moved {
+		if ib.tasks[buildID] == indexTaskInProgress {
+			ib.tasks[buildID] = indexTaskRetry
+		}
+	}
+	ib.taskMutex.Unlock()
+	log.Ctx(ib.ctx).Info("rebalance the queued index builds to the new IndexNode", zap.Int64("nodeID", nodeID),
+		zap.Int64s("buildIDs", moved))
+	ib.notify()
+}
+
+// pendingBuild is an index build assigned to an IndexNode and not finished yet.
+type pendingBuild struct {
+	buildID UniqueID
+	load    int64
+	seq     uint64
+}
+
+// addPendingBuild records the build assigned to the node.
+func (nm *NodeManager) addPendingBuild(nodeID, buildID UniqueID, load int64) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if nm.pendingBuilds == nil {
+		nm.pendingBuilds = make(map[UniqueID]map[UniqueID]*pendingBuild)
+	}
+	if _, ok := nm.pendingBuilds[nodeID]; !ok {
+		nm.pendingBuilds[nodeID] = make(map[UniqueID]*pendingBuild)
+	}
+	nm.assignSeq++
+	nm.pendingBuilds[nodeID][buildID] = &pendingBuild{
+		buildID: buildID,
+		load:    load,
+		seq:     nm.assignSeq,
+	}
+}
+
+// removePendingBuild removes the build once it is finished, failed or dropped from the node.
+func (nm *NodeManager) removePendingBuild(nodeID, buildID UniqueID) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	builds, ok := nm.pendingBuilds[nodeID]
+	if !ok {
+		return
+	}
+	delete(builds, buildID)
+	if len(builds) == 0 {
+		delete(nm.pendingBuilds, nodeID)
+	}
+}
+
+// getPendingLoads returns the sum of the loads of the pending builds of each node.
+func (nm *NodeManager) getPendingLoads() map[UniqueID]int64 {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+	loads := make(map[UniqueID]int64, len(nm.nodeClients))
+	for nodeID := range nm.nodeClients {
+		loads[nodeID] = 0
+	}
+	for nodeID, builds := range nm.pendingBuilds {
+		for _, build := range builds {
+			loads[nodeID] += build.load
+		}
+	}
+	return loads
+}
+
+// getLatestBuilds returns at most n builds latest assigned to the node, the latest first.
+func (nm *NodeManager) getLatestBuilds(nodeID UniqueID, n int) []*pendingBuild {
+	nm.lock.RLock()
+	builds := make([]*pendingBuild, 0, len(nm.pendingBuilds[nodeID]))
+	for _, build := range nm.pendingBuilds[nodeID] {
+		builds = append(builds, build)
+	}
+	nm.lock.RUnlock()
+
+	sort.Slice(builds, func(i, j int) bool { return builds[i].seq > builds[j].seq })
+	if n < len(builds) {
+		builds = builds[:n]
+	}
+	return builds
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newJobStatsMock(slots, enqueued int64) *indexnode.Mock {
+	return &indexnode.Mock{
+		CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+			return &indexpb.GetJobStatsResponse{
+				Status:        &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				TaskSlots:     slots,
+				EnqueueJobNum: enqueued,
+			}, nil
+		},
+	}
+}
+
+func TestEstimateBuildLoad(t *testing.T) {
+	typeParams := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}}
+	indexParams := func(indexType string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexType}}
+	}
+
+	assert.Equal(t, int64(0), estimateBuildLoad(0, typeParams, indexParams("HNSW")))
+	assert.Equal(t, int64(1000*128*4), estimateBuildLoad(1000, typeParams, indexParams("IVF_FLAT")))
+	assert.Equal(t, int64(1000*128*4*3), estimateBuildLoad(1000, typeParams, indexParams("HNSW")))
+	assert.Equal(t, int64(1000*128/8), estimateBuildLoad(1000, typeParams, indexParams("BIN_IVF_FLAT")))
+	// unknown index type and no dim
+	assert.Equal(t, int64(1000*scalarBuildRowSize), estimateBuildLoad(1000, nil, indexParams("STL_SORT")))
+}
+
+func TestNodeManager_PendingBuilds(t *testing.T) {
+	nm := NewNodeManager(context.Background())
+	nm.nodeClients[1] = newJobStatsMock(1, 0)
+	nm.nodeClients[2] = newJobStatsMock(1, 0)
+
+	nm.addPendingBuild(1, 100, 10)
+	nm.addPendingBuild(1, 101, 20)
+	nm.addPendingBuild(1, 102, 30)
+	assert.Equal(t, map[UniqueID]int64{1: 60, 2: 0}, nm.getPendingLoads())
+
+	builds := nm.getLatestBuilds(1, 2)
+	assert.Equal(t, 2, len(builds))
+	assert.Equal(t, UniqueID(102), builds[0].buildID)
+	assert.Equal(t, UniqueID(101), builds[1].buildID)
+	assert.Equal(t, 0, len(nm.getLatestBuilds(1, 0)))
+	assert.Equal(t, 0, len(nm.getLatestBuilds(2, 1)))
+
+	nm.removePendingBuild(1, 101)
+	nm.removePendingBuild(2, 101)
+	assert.Equal(t, int64(40), nm.getPendingLoads()[1])
+
+	nm.RemoveNode(1)
+	assert.Equal(t, map[UniqueID]int64{2: 0}, nm.getPendingLoads())
+}
+
+func TestNodeManager_PeekClientByLoad(t *testing.T) {
+	paramtable.Get().Save(Params.IndexCoordCfg.BuildLoadBalanceEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.IndexCoordCfg.BuildLoadBalanceEnabled.Key)
+	nm := NewNodeManager(context.Background())
+	nm.nodeClients[1] = newJobStatsMock(1, 0)
+	nm.nodeClients[2] = newJobStatsMock(1, 0)
+	nm.nodeClients[3] = newJobStatsMock(0, 2)
+	nm.nodeClients[4] = &indexnode.Mock{
+		CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+			return nil, errors.New("error")
+		},
+	}
+
+	nm.addPendingBuild(1, 100, 50)
+	nm.addPendingBuild(2, 101, 10)
+	nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(2), nodeID)
+
+	nm.addPendingBuild(2, 102, 60)
+	nodeID, client = nm.PeekClient(&model.SegmentIndex{})
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(1), nodeID)
+
+	t.Run("no slots", func(t *testing.T) {
+		nm := NewNodeManager(context.Background())
+		nm.nodeClients[1] = newJobStatsMock(0, 1)
+		nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+		assert.Nil(t, client)
+		assert.Equal(t, UniqueID(0), nodeID)
+	})
+}
+
+func TestIndexBuilder_Rebalance(t *testing.T) {
+	paramtable.Get().Save(Params.IndexCoordCfg.BuildLoadBalanceEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.IndexCoordCfg.BuildLoadBalanceEnabled.Key)
+	newBuilder := func() *indexBuilder {
+		nm := NewNodeManager(context.Background())
+		nm.nodeClients = map[UniqueID]types.IndexNode{
+			1: newJobStatsMock(0, 2),
+			2: newJobStatsMock(0, 1),
+			3: newJobStatsMock(2, 0),
+		}
+		// node 1 runs 100 and queues 101, 102, node 2 runs 200 and queues 201
+		nm.addPendingBuild(1, 100, 40)
+		nm.addPendingBuild(2, 200, 40)
+		nm.addPendingBuild(1, 101, 30)
+		nm.addPendingBuild(2, 201, 10)
+		nm.addPendingBuild(1, 102, 30)
+		return &indexBuilder{
+			ctx: context.Background(),
+			tasks: map[int64]indexTaskState{
+				100: indexTaskInProgress,
+				101: indexTaskInProgress,
+				102: indexTaskInProgress,
+				200: indexTaskInProgress,
+				201: indexTaskInProgress,
+			},
+			notifyChan: make(chan struct{}, 1),
+			ic:         &IndexCoord{nodeManager: nm},
+		}
+	}
+
+	t.Run("move queued builds", func(t *testing.T) {
+		ib := newBuilder()
+		ib.rebalance(3)
+		// node 1: 100 -> 40 after moving 102 and 101, node 3: 0 -> 60
+		assert.Equal(t, indexTaskInProgress, ib.tasks[100])
+		assert.Equal(t, indexTaskRetry, ib.tasks[101])
+		assert.Equal(t, indexTaskRetry, ib.tasks[102])
+		assert.Equal(t, indexTaskInProgress, ib.tasks[200])
+		assert.Equal(t, indexTaskInProgress, ib.tasks[201])
+		assert.Equal(t, 1, len(ib.notifyChan))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.IndexCoordCfg.BuildLoadBalanceEnabled.Key, "false")
+		defer paramtable.Get().Save(Params.IndexCoordCfg.BuildLoadBalanceEnabled.Key, "true")
+		ib := newBuilder()
+		ib.rebalance(3)
+		for buildID, state := range ib.tasks {
+			assert.Equal(t, indexTaskInProgress, state, buildID)
+		}
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		ib := newBuilder()
+		ib.rebalance(4)
+		for buildID, state := range ib.tasks {
+			assert.Equal(t, indexTaskInProgress, state, buildID)
+		}
+		assert.Equal(t, 0, len(ib.notifyChan))
+	})
+}
@@ -29,4 +29,8 @@ const (
 	invalidIndex = "invalid"
 
 	reqTimeoutInterval = time.Second * 10
+
+	// the backoff between the restarts of a failed etcd watch
+	watchMinBackoff = time.Millisecond * 100
+	watchMaxBackoff = time.Second * 10
 )
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// resumableWatch watches a prefix of etcd and restarts the watch when it fails instead of exiting.
+// The watch is resumed from the revision following the last event handled, if the revision is compacted
+// the prefix is re-listed and watched from the revision of the listing. The restarts are delayed by
+// a backoff bounded by watchMaxBackoff, which is reset once events are received again.
+type resumableWatch struct {
+	ctx    context.Context
+	kv     kv.MetaKv
+	prefix string
+	// revision is the revision to watch from
+	revision int64
+	// relist loads the prefix again, and returns the revision of the listing
+	relist func() (int64, error)
+	handle func(event *clientv3.Event)
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newResumableWatch(ctx context.Context, kv kv.MetaKv, prefix string, revision int64,
+	relist func() (int64, error), handle func(event *clientv3.Event)) *resumableWatch {
+	return &resumableWatch{
+		ctx:        ctx,
+		kv:         kv,
+		prefix:     prefix,
+		revision:   revision,
+		relist:     relist,
+		handle:     handle,
+		minBackoff: watchMinBackoff,
+		maxBackoff: watchMaxBackoff,
+	}
+}
+
+// run watches until the context is done.
+func (w *resumableWatch) run() {
+	backoff := w.minBackoff
+	needRelist := false
+	for {
+		var reason string
+		var err error
+		if needRelist {
+			var revision int64
+			revision, err = w.relist()
+			if err == nil {
+				w.revision = revision + 1
+				needRelist = false
+			} else {
+				reason = metrics.WatchRelistFailedLabel
+			}
+		}
+		if !needRelist {
+			var handled bool
+			handled, reason, err = w.watch()
+			if handled {
+				backoff = w.minBackoff
+			}
+			needRelist = reason == metrics.WatchCompactedLabel
+		}
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		metrics.IndexCoordWatchRestartCounter.WithLabelValues(reason).Inc()
+		log.Warn("IndexCoord etcd watch failed, restart it", zap.String("prefix", w.prefix),
+			zap.String("reason", reason), zap.Int64("revision", w.revision), zap.Duration("backoff", backoff), zap.Error(err))
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > w.maxBackoff {
+			backoff = w.maxBackoff
+		}
+	}
+}
+
+// watch watches from the revision until the watch fails, it returns whether any event is handled
+// and the reason of the failure.
+func (w *resumableWatch) watch() (bool, string, error) {
+	handled := false
+	watchChan := w.kv.WatchWithRevision(w.prefix, w.revision)
+	for {
+		select {
+		case <-w.ctx.Done():
+			return handled, "", w.ctx.Err()
+		case resp, ok := <-watchChan:
+			if !ok {
+				return handled, metrics.WatchClosedLabel, errors.New("watch channel closed")
+			}
+			if err := resp.Err(); err != nil {
+				if errors.Is(err, v3rpc.ErrCompacted) {
+					return handled, metrics.WatchCompactedLabel, err
+				}
+				return handled, metrics.WatchErrorLabel, err
+			}
+			for _, event := range resp.Events {
+				w.handle(event)
+				w.revision = event.Kv.ModRevision + 1
+				handled = true
+			}
+		}
+	}
+}
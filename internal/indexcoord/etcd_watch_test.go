@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestResumableWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var watchRevisions []int64
+	watchChans := make(chan chan clientv3.WatchResponse, 10)
+	kv := NewMockEtcdKV()
+	kv.watchWithRevision = func(prefix string, revision int64) clientv3.WatchChan {
+		mu.Lock()
+		defer mu.Unlock()
+		watchRevisions = append(watchRevisions, revision)
+		ch := make(chan clientv3.WatchResponse, 10)
+		watchChans <- ch
+		return ch
+	}
+	relistErrs := []error{errors.New("etcd unavailable"), nil}
+	relistCount := 0
+	relist := func() (int64, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		err := relistErrs[relistCount]
+		relistCount++
+		return 20, err
+	}
+	var handled []int64
+	handle := func(event *clientv3.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		handled = append(handled, event.Kv.ModRevision)
+	}
+
+	w := newResumableWatch(ctx, kv, "prefix", 5, relist, handle)
+	w.minBackoff = time.Millisecond
+	w.maxBackoff = 2 * time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		w.run()
+		close(done)
+	}()
+
+	putEvent := func(revision int64) *clientv3.Event {
+		return &clientv3.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{ModRevision: revision}}
+	}
+
+	// the closed watch is resumed after the last event handled
+	ch := <-watchChans
+	ch <- clientv3.WatchResponse{Events: []*clientv3.Event{putEvent(6), putEvent(8)}}
+	close(ch)
+
+	// the canceled watch is resumed as well
+	ch = <-watchChans
+	ch <- clientv3.WatchResponse{Canceled: true}
+
+	// the compacted watch re-lists, and the failed re-listing is retried
+	ch = <-watchChans
+	ch <- clientv3.WatchResponse{CompactRevision: 15}
+
+	ch = <-watchChans
+	ch <- clientv3.WatchResponse{Events: []*clientv3.Event{putEvent(21)}}
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(handled) == 3
+	}, time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []int64{6, 8, 21}, handled)
+	assert.Equal(t, []int64{5, 9, 9, 21}, watchRevisions)
+	assert.Equal(t, 2, relistCount)
+}
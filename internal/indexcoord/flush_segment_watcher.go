@@ -79,6 +79,7 @@ func newFlushSegmentWatcher(ctx context.Context, kv kv.MetaKv, meta *metaTable,
 		builder:           builder,
 		handoff:           handoff,
 		ic:                ic,
+		internalTasks:     make(map[UniqueID]*internalTask),
 	}
 	err := fsw.reloadFromKV()
 	if err != nil {
@@ -87,10 +88,11 @@ func newFlushSegmentWatcher(ctx context.Context, kv kv.MetaKv, meta *metaTable,
 	return fsw, nil
 }
 
+// reloadFromKV lists the flushed segments and enqueues them, it's called again to re-list the segments
+// when the watch revision is compacted, the segments known already keep their task states.
 func (fsw *flushedSegmentWatcher) reloadFromKV() error {
 	record := timerecord.NewTimeRecorder("indexcoord")
 	log.Ctx(fsw.ctx).Info("flushSegmentWatcher reloadFromKV")
-	fsw.internalTasks = make(map[UniqueID]*internalTask)
 	_, values, version, err := fsw.kvClient.LoadWithRevision(util.FlushedSegmentPrefix)
 	if err != nil {
 		log.Ctx(fsw.ctx).Error("flushSegmentWatcher reloadFromKV fail", zap.String("prefix", util.FlushedSegmentPrefix), zap.Error(err))
@@ -18,7 +18,10 @@ package indexcoord
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path"
+	"sort"
 	"sync"
 	"time"
 
@@ -26,14 +29,31 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
 )
 
+const (
+	gcScopeIndexes = "indexes"
+	gcScopeMeta    = "meta"
+	gcScopeFiles   = "files"
+	gcScopeAll     = "all"
+
+	gcTriggerInterval = "interval"
+	gcTriggerWindow   = "window"
+	gcTriggerManual   = "manual"
+)
+
+// gcCandidatesLimit is the max number of candidates kept in the summary of a gc pass.
+const gcCandidatesLimit = 100
+
+var errGCRunning = errors.New("another gc pass is running")
+
 type garbageCollector struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -45,6 +65,12 @@ type garbageCollector struct {
 	metaTable        *metaTable
 	chunkManager     storage.ChunkManager
 	indexCoordClient *IndexCoord
+
+	now func() time.Time
+	// runMu keeps the gc passes from overlapping
+	runMu    sync.Mutex
+	lastMu   sync.RWMutex
+	lastRuns map[string]metricsinfo.GCRunMetrics
 }
 
 func newGarbageCollector(ctx context.Context, meta *metaTable, chunkManager storage.ChunkManager, ic *IndexCoord) *garbageCollector {
@@ -57,6 +83,8 @@ func newGarbageCollector(ctx context.Context, meta *metaTable, chunkManager stor
 		metaTable:        meta,
 		chunkManager:     chunkManager,
 		indexCoordClient: ic,
+		now:              time.Now,
+		lastRuns:         make(map[string]metricsinfo.GCRunMetrics),
 	}
 }
 
@@ -69,6 +97,9 @@ func (gc *garbageCollector) Start() {
 	//
 	//gc.wg.Add(1)
 	//go gc.recycleUnusedIndexFiles()
+
+	gc.wg.Add(1)
+	go gc.scheduleLoop()
 }
 
 func (gc *garbageCollector) Stop() {
@@ -76,6 +107,83 @@ func (gc *garbageCollector) Stop() {
 	gc.wg.Wait()
 }
 
+func validGCScope(scope string) bool {
+	switch scope {
+	case gcScopeIndexes, gcScopeMeta, gcScopeFiles, gcScopeAll:
+		return true
+	}
+	return false
+}
+
+func addGCCandidate(run *metricsinfo.GCRunMetrics, candidate string) {
+	if len(run.Candidates) < gcCandidatesLimit {
+		run.Candidates = append(run.Candidates, candidate)
+	}
+}
+
+// run runs the gc passes of the scope, nothing is removed in a dry run. It returns errGCRunning without
+// waiting if another pass is running, the summary of every pass is kept as its last run.
+func (gc *garbageCollector) run(scope, trigger string, dryRun bool) ([]metricsinfo.GCRunMetrics, error) {
+	if !validGCScope(scope) {
+		return nil, fmt.Errorf("invalid gc scope %q", scope)
+	}
+	if !gc.runMu.TryLock() {
+		return nil, errGCRunning
+	}
+	defer gc.runMu.Unlock()
+
+	scopes := []string{scope}
+	if scope == gcScopeAll {
+		scopes = []string{gcScopeIndexes, gcScopeMeta, gcScopeFiles}
+	}
+	ret := make([]metricsinfo.GCRunMetrics, 0, len(scopes))
+	for _, s := range scopes {
+		if gc.ctx.Err() != nil {
+			break
+		}
+		start := gc.now()
+		run := metricsinfo.GCRunMetrics{
+			Scope:     s,
+			Trigger:   trigger,
+			DryRun:    dryRun,
+			StartTime: start.String(),
+		}
+		switch s {
+		case gcScopeIndexes:
+			gc.recycleIndexesPass(&run, dryRun)
+		case gcScopeMeta:
+			gc.recycleSegIndexesPass(&run, dryRun)
+		case gcScopeFiles:
+			gc.recycleIndexFilesPass(&run, dryRun)
+		}
+		run.ElapsedMs = gc.now().Sub(start).Milliseconds()
+		log.Ctx(gc.ctx).Info("IndexCoord garbageCollector pass done", zap.String("scope", s),
+			zap.String("trigger", trigger), zap.Bool("dryRun", dryRun), zap.Int("scanned", run.Scanned),
+			zap.Int("recycled", run.Recycled), zap.Int("failed", run.Failed), zap.String("error", run.Error))
+
+		gc.lastMu.Lock()
+		gc.lastRuns[s] = run
+		gc.lastMu.Unlock()
+		ret = append(ret, run)
+	}
+	return ret, nil
+}
+
+// LastRuns returns the summaries of the last run of every gc scope.
+func (gc *garbageCollector) LastRuns() []metricsinfo.GCRunMetrics {
+	if gc == nil {
+		return nil
+	}
+	gc.lastMu.RLock()
+	defer gc.lastMu.RUnlock()
+	ret := make([]metricsinfo.GCRunMetrics, 0, len(gc.lastRuns))
+	for _, run := range gc.lastRuns {
+		ret = append(ret, run)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Scope < ret[j].Scope })
+	return ret
+}
+
 func (gc *garbageCollector) recycleUnusedIndexes() {
 	defer gc.wg.Done()
 	log.Ctx(gc.ctx).Info("IndexCoord garbageCollector recycleUnusedIndexes start")
@@ -88,46 +196,72 @@ func (gc *garbageCollector) recycleUnusedIndexes() {
 			log.Ctx(gc.ctx).Info("IndexCoord garbageCollector recycleUnusedMetaLoop context has done")
 			return
 		case <-ticker.C:
-			deletedIndexes := gc.metaTable.GetDeletedIndexes()
-			for _, index := range deletedIndexes {
-				buildIDs := gc.metaTable.GetBuildIDsFromIndexID(index.IndexID)
-				if len(buildIDs) == 0 {
-					if err := gc.metaTable.RemoveIndex(index.CollectionID, index.IndexID); err != nil {
-						log.Ctx(gc.ctx).Warn("IndexCoord remove index on collection fail", zap.Int64("collID", index.CollectionID),
-							zap.Int64("indexID", index.IndexID), zap.Error(err))
-						continue
-					}
-				} else {
-					for _, buildID := range buildIDs {
-						segIdx, ok := gc.metaTable.GetMeta(buildID)
-						if !ok {
-							log.Ctx(gc.ctx).Debug("IndexCoord get segment index is not exist", zap.Int64("buildID", buildID))
-							continue
-						}
-						if segIdx.NodeID != 0 {
-							// wait for releasing reference lock
-							continue
-						}
-						if err := gc.metaTable.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.BuildID); err != nil {
-							log.Ctx(gc.ctx).Warn("delete index meta from etcd failed, wait to retry", zap.Int64("buildID", segIdx.BuildID),
-								zap.Int64("nodeID", segIdx.NodeID), zap.Error(err))
-							continue
-						}
-						log.Ctx(gc.ctx).Info("IndexCoord remove segment index meta success", zap.Int64("buildID", segIdx.BuildID),
-							zap.Int64("nodeID", segIdx.NodeID))
-					}
-					log.Ctx(gc.ctx).Info("garbageCollector remove index success", zap.Int64("collID", index.CollectionID),
-						zap.Int64("indexID", index.IndexID))
+			_, _ = gc.run(gcScopeIndexes, gcTriggerInterval, false)
+		}
+	}
+}
+
+// recycleIndexesPass removes the meta of the deleted indexes, and the segment indexes of them first.
+func (gc *garbageCollector) recycleIndexesPass(run *metricsinfo.GCRunMetrics, dryRun bool) {
+	deletedIndexes := gc.metaTable.GetDeletedIndexes()
+	run.Scanned = len(deletedIndexes)
+	for _, index := range deletedIndexes {
+		buildIDs := gc.metaTable.GetBuildIDsFromIndexID(index.IndexID)
+		if len(buildIDs) == 0 {
+			addGCCandidate(run, fmt.Sprintf("index %d/%d", index.CollectionID, index.IndexID))
+			if dryRun {
+				continue
+			}
+			if err := gc.metaTable.RemoveIndex(index.CollectionID, index.IndexID); err != nil {
+				log.Ctx(gc.ctx).Warn("IndexCoord remove index on collection fail", zap.Int64("collID", index.CollectionID),
+					zap.Int64("indexID", index.IndexID), zap.Error(err))
+				run.Failed++
+				continue
+			}
+			run.Recycled++
+		} else {
+			for _, buildID := range buildIDs {
+				segIdx, ok := gc.metaTable.GetMeta(buildID)
+				if !ok {
+					log.Ctx(gc.ctx).Debug("IndexCoord get segment index is not exist", zap.Int64("buildID", buildID))
+					continue
+				}
+				if segIdx.NodeID != 0 {
+					// wait for releasing reference lock
+					continue
+				}
+				addGCCandidate(run, fmt.Sprintf("segment index %d", segIdx.BuildID))
+				if dryRun {
+					continue
+				}
+				if err := gc.metaTable.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.BuildID); err != nil {
+					log.Ctx(gc.ctx).Warn("delete index meta from etcd failed, wait to retry", zap.Int64("buildID", segIdx.BuildID),
+						zap.Int64("nodeID", segIdx.NodeID), zap.Error(err))
+					run.Failed++
+					continue
 				}
+				run.Recycled++
+				log.Ctx(gc.ctx).Info("IndexCoord remove segment index meta success", zap.Int64("buildID", segIdx.BuildID),
+					zap.Int64("nodeID", segIdx.NodeID))
 			}
+			log.Ctx(gc.ctx).Info("garbageCollector remove index success", zap.Int64("collID", index.CollectionID),
+				zap.Int64("indexID", index.IndexID))
 		}
 	}
 }
 
 func (gc *garbageCollector) recycleSegIndexesMeta() {
+	_, _ = gc.run(gcScopeMeta, gcTriggerInterval, false)
+}
+
+// recycleSegIndexesPass marks the segment indexes of the segments no longer flushed in DataCoord as deleted,
+// and removes the meta of the deleted segment indexes. In a dry run the segments are not marked but
+// reported as candidates.
+func (gc *garbageCollector) recycleSegIndexesPass(run *metricsinfo.GCRunMetrics, dryRun bool) {
 	gc.indexCoordClient.indexGCLock.Lock()
 	segIndexes := gc.metaTable.GetAllSegIndexes()
 	gc.indexCoordClient.indexGCLock.Unlock()
+	run.Scanned = len(segIndexes)
 
 	collID2segID := make(map[int64]map[int64]struct{})
 	for segID, segIdx := range segIndexes {
@@ -136,31 +270,35 @@ func (gc *garbageCollector) recycleSegIndexesMeta() {
 		}
 		collID2segID[segIdx.CollectionID][segID] = struct{}{}
 	}
+	// the segments which would be marked deleted in a dry run
+	unflushed := make(map[int64]struct{})
 	for collID, segIDs := range collID2segID {
-		resp, err := gc.indexCoordClient.dataCoordClient.GetFlushedSegments(gc.ctx, &datapb.GetFlushedSegmentsRequest{
+		flushedSegments := make(map[int64]struct{})
+		err := segmentutil.WalkFlushedSegments(gc.ctx, gc.indexCoordClient.dataCoordClient, &datapb.GetFlushedSegmentsRequest{
 			CollectionID:     collID,
 			PartitionID:      -1,
 			IncludeUnhealthy: true,
+		}, segmentutil.DefaultFlushedSegmentsPageSize, func(segmentIDs []int64) error {
+			for _, segID := range segmentIDs {
+				flushedSegments[segID] = struct{}{}
+			}
+			return nil
 		})
 		if err != nil {
 			log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector get flushed segments from DataCoord fail",
 				zap.Int64("collID", collID), zap.Error(err))
+			run.Error = err.Error()
 			return
 		}
-		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
-			log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector get flushed segments from DataCoord fail", zap.Int64("collID", collID),
-				zap.String("fail reason", resp.Status.Reason))
-			return
-		}
-		flushedSegments := make(map[int64]struct{})
-		for _, segID := range resp.Segments {
-			flushedSegments[segID] = struct{}{}
-		}
 		for segID := range segIDs {
 			if segIndexes[segID].IsDeleted {
 				continue
 			}
 			if _, ok := flushedSegments[segID]; !ok {
+				if dryRun {
+					unflushed[segID] = struct{}{}
+					continue
+				}
 				log.Ctx(gc.ctx).Info("segment is already not exist, mark it deleted", zap.Int64("collID", collID),
 					zap.Int64("segID", segID))
 				if err := gc.metaTable.MarkSegmentsIndexAsDeleted(func(segIndex *model.SegmentIndex) bool {
@@ -172,17 +310,24 @@ func (gc *garbageCollector) recycleSegIndexesMeta() {
 		}
 	}
 	//segIndexes := gc.metaTable.GetDeletedSegmentIndexes()
-	for _, meta := range segIndexes {
-		if meta.IsDeleted || gc.metaTable.IsIndexDeleted(meta.CollectionID, meta.IndexID) {
+	for segID, meta := range segIndexes {
+		_, isUnflushed := unflushed[segID]
+		if isUnflushed || meta.IsDeleted || gc.metaTable.IsIndexDeleted(meta.CollectionID, meta.IndexID) {
 			if meta.NodeID != 0 {
 				// wait for releasing reference lock
 				continue
 			}
+			addGCCandidate(run, fmt.Sprintf("segment index %d", meta.BuildID))
+			if dryRun {
+				continue
+			}
 			if err := gc.metaTable.RemoveSegmentIndex(meta.CollectionID, meta.PartitionID, meta.SegmentID, meta.BuildID); err != nil {
 				log.Ctx(gc.ctx).Warn("delete index meta from etcd failed, wait to retry", zap.Int64("buildID", meta.BuildID),
 					zap.Int64("nodeID", meta.NodeID), zap.Error(err))
+				run.Failed++
 				continue
 			}
+			run.Recycled++
 			log.Ctx(gc.ctx).Info("index meta recycle success", zap.Int64("buildID", meta.BuildID),
 				zap.Int64("segID", meta.SegmentID))
 		}
@@ -220,69 +365,96 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 		case <-gc.ctx.Done():
 			return
 		case <-ticker.C:
-			prefix := path.Join(gc.chunkManager.RootPath(), common.SegmentIndexPath) + "/"
-			// list dir first
-			keys, _, err := gc.chunkManager.ListWithPrefix(gc.ctx, prefix, false)
+			_, _ = gc.run(gcScopeFiles, gcTriggerInterval, false)
+		}
+	}
+}
+
+// recycleIndexFilesPass removes the index files of the builds no longer in the meta, and the files of a
+// build not listed in its meta.
+func (gc *garbageCollector) recycleIndexFilesPass(run *metricsinfo.GCRunMetrics, dryRun bool) {
+	prefix := path.Join(gc.chunkManager.RootPath(), common.SegmentIndexPath) + "/"
+	// list dir first
+	keys, _, err := gc.chunkManager.ListWithPrefix(gc.ctx, prefix, false)
+	if err != nil {
+		log.Ctx(gc.ctx).Error("IndexCoord garbageCollector recycleUnusedIndexFiles list keys from chunk manager failed", zap.Error(err))
+		run.Error = err.Error()
+		return
+	}
+	run.Scanned = len(keys)
+	for _, key := range keys {
+		log.Ctx(gc.ctx).Debug("indexFiles keys", zap.String("key", key))
+		buildID, err := parseBuildIDFromFilePath(key)
+		if err != nil {
+			log.Ctx(gc.ctx).Error("IndexCoord garbageCollector recycleUnusedIndexFiles parseIndexFileKey", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		log.Ctx(gc.ctx).Info("IndexCoord garbageCollector will recycle index files", zap.Int64("buildID", buildID))
+		if !gc.metaTable.HasBuildID(buildID) {
+			// buildID no longer exists in meta, remove all index files
+			addGCCandidate(run, key)
+			if dryRun {
+				continue
+			}
+			log.Ctx(gc.ctx).Info("IndexCoord garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files",
+				zap.Int64("buildID", buildID))
+			err = gc.chunkManager.RemoveWithPrefix(gc.ctx, key)
 			if err != nil {
-				log.Ctx(gc.ctx).Error("IndexCoord garbageCollector recycleUnusedIndexFiles list keys from chunk manager failed", zap.Error(err))
+				log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles remove index files failed",
+					zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
+				run.Failed++
 				continue
 			}
-			for _, key := range keys {
-				log.Ctx(gc.ctx).Debug("indexFiles keys", zap.String("key", key))
-				buildID, err := parseBuildIDFromFilePath(key)
-				if err != nil {
-					log.Ctx(gc.ctx).Error("IndexCoord garbageCollector recycleUnusedIndexFiles parseIndexFileKey", zap.String("key", key), zap.Error(err))
-					continue
-				}
-				log.Ctx(gc.ctx).Info("IndexCoord garbageCollector will recycle index files", zap.Int64("buildID", buildID))
-				if !gc.metaTable.HasBuildID(buildID) {
-					// buildID no longer exists in meta, remove all index files
-					log.Ctx(gc.ctx).Info("IndexCoord garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files",
-						zap.Int64("buildID", buildID))
-					err = gc.chunkManager.RemoveWithPrefix(gc.ctx, key)
-					if err != nil {
-						log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles remove index files failed",
-							zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
-						continue
-					}
-					continue
-				}
-				log.Ctx(gc.ctx).Info("index meta can be recycled, recycle index files", zap.Int64("buildID", buildID))
-				canRecycle, segIdx := gc.metaTable.GetSegmentIndexByBuildID(buildID)
-				if !canRecycle {
-					// Even if the index is marked as deleted, the index file will not be recycled, wait for the next gc,
-					// and delete all index files about the buildID at one time.
-					log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector can not recycle index files", zap.Int64("buildID", buildID))
+			run.Recycled++
+			continue
+		}
+		log.Ctx(gc.ctx).Info("index meta can be recycled, recycle index files", zap.Int64("buildID", buildID))
+		canRecycle, segIdx := gc.metaTable.GetSegmentIndexByBuildID(buildID)
+		if !canRecycle {
+			// Even if the index is marked as deleted, the index file will not be recycled, wait for the next gc,
+			// and delete all index files about the buildID at one time.
+			log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector can not recycle index files", zap.Int64("buildID", buildID))
+			continue
+		}
+		indexFilePaths := metautil.BuildSegmentIndexFilePaths(gc.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+		if _, err := storage.ResolveIndexFilePaths(gc.ctx, gc.chunkManager, indexFilePaths); err != nil {
+			// the files of a build without its manifest are kept, they may be all that is left of the index
+			log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles check index manifest failed",
+				zap.Int64("buildID", buildID), zap.Error(err))
+			continue
+		}
+		filesMap := make(map[string]struct{})
+		for _, filepath := range indexFilePaths {
+			filesMap[filepath] = struct{}{}
+		}
+		files, _, err := gc.chunkManager.ListWithPrefix(gc.ctx, key, true)
+		if err != nil {
+			log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles list files failed",
+				zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
+			run.Failed++
+			continue
+		}
+		log.Ctx(gc.ctx).Info("recycle index files", zap.Int64("buildID", buildID), zap.Int("meta files num", len(filesMap)),
+			zap.Int("chunkManager files num", len(files)))
+		deletedFilesNum := 0
+		for _, file := range files {
+			if _, ok := filesMap[file]; !ok {
+				addGCCandidate(run, file)
+				if dryRun {
 					continue
 				}
-				filesMap := make(map[string]struct{})
-				for _, fileID := range segIdx.IndexFileKeys {
-					filepath := metautil.BuildSegmentIndexFilePath(gc.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
-						segIdx.PartitionID, segIdx.SegmentID, fileID)
-					filesMap[filepath] = struct{}{}
-				}
-				files, _, err := gc.chunkManager.ListWithPrefix(gc.ctx, key, true)
-				if err != nil {
-					log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles list files failed",
-						zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
+				if err = gc.chunkManager.Remove(gc.ctx, file); err != nil {
+					log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles remove file failed",
+						zap.Int64("buildID", buildID), zap.String("file", file), zap.Error(err))
+					run.Failed++
 					continue
 				}
-				log.Ctx(gc.ctx).Info("recycle index files", zap.Int64("buildID", buildID), zap.Int("meta files num", len(filesMap)),
-					zap.Int("chunkManager files num", len(files)))
-				deletedFilesNum := 0
-				for _, file := range files {
-					if _, ok := filesMap[file]; !ok {
-						if err = gc.chunkManager.Remove(gc.ctx, file); err != nil {
-							log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector recycleUnusedIndexFiles remove file failed",
-								zap.Int64("buildID", buildID), zap.String("file", file), zap.Error(err))
-							continue
-						}
-						deletedFilesNum++
-					}
-				}
-				log.Ctx(gc.ctx).Info("index files recycle success", zap.Int64("buildID", buildID),
-					zap.Int("delete index files num", deletedFilesNum))
+				deletedFilesNum++
+				run.Recycled++
 			}
 		}
+		log.Ctx(gc.ctx).Info("index files recycle success", zap.Int64("buildID", buildID),
+			zap.Int("delete index files num", deletedFilesNum))
 	}
 }
@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// gcWindow is an off-peak window of the day in the local time of the server, the window wraps
+// around midnight if its end is before its start.
+type gcWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func parseGCClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expect HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// parseGCWindows parses the comma separated windows like "02:00-05:00,23:30-00:30".
+func parseGCWindows(value string) ([]gcWindow, error) {
+	var windows []gcWindow
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		bounds := strings.Split(item, "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid gc window %q, expect HH:MM-HH:MM", item)
+		}
+		start, err := parseGCClock(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseGCClock(bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		if start == end {
+			return nil, fmt.Errorf("invalid gc window %q, the window is empty", item)
+		}
+		windows = append(windows, gcWindow{start: start, end: end})
+	}
+	return windows, nil
+}
+
+func inGCWindows(windows []gcWindow, t time.Time) bool {
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	for _, w := range windows {
+		if w.start < w.end && offset >= w.start && offset < w.end {
+			return true
+		}
+		if w.start > w.end && (offset >= w.start || offset < w.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleLoop runs all the gc passes inside the windows of indexCoord.gc.windows, at most once every
+// indexCoord.gc.interval. Nothing is scheduled if no window is configured.
+func (gc *garbageCollector) scheduleLoop() {
+	defer gc.wg.Done()
+	log.Ctx(gc.ctx).Info("IndexCoord garbageCollector scheduleLoop start")
+
+	ticker := time.NewTicker(gc.gcMetaDuration)
+	defer ticker.Stop()
+	var lastRun time.Time
+	for {
+		select {
+		case <-gc.ctx.Done():
+			log.Ctx(gc.ctx).Info("IndexCoord garbageCollector scheduleLoop exit")
+			return
+		case <-ticker.C:
+			if gc.scheduleTick(lastRun) {
+				lastRun = gc.now()
+			}
+		}
+	}
+}
+
+// scheduleTick runs the passes if now is inside a window and no scheduled passes ran since lastRun
+// within the interval, it returns whether the passes ran.
+func (gc *garbageCollector) scheduleTick(lastRun time.Time) bool {
+	value := Params.IndexCoordCfg.GCWindows.GetValue()
+	if value == "" {
+		return false
+	}
+	windows, err := parseGCWindows(value)
+	if err != nil {
+		log.Ctx(gc.ctx).Warn("IndexCoord garbageCollector skip scheduled gc, invalid windows",
+			zap.String("windows", value), zap.Error(err))
+		return false
+	}
+	now := gc.now()
+	if !inGCWindows(windows, now) || now.Sub(lastRun) < gc.gcFileDuration {
+		return false
+	}
+	if _, err := gc.run(gcScopeAll, gcTriggerWindow, false); err != nil {
+		log.Ctx(gc.ctx).Info("IndexCoord garbageCollector skip scheduled gc", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func parseGCScope(scope indexpb.GCScope) (string, error) {
+	switch scope {
+	case indexpb.GCScope_GCIndexes:
+		return gcScopeIndexes, nil
+	case indexpb.GCScope_GCMeta:
+		return gcScopeMeta, nil
+	case indexpb.GCScope_GCFiles:
+		return gcScopeFiles, nil
+	case indexpb.GCScope_GCAll:
+		return gcScopeAll, nil
+	default:
+		return "", fmt.Errorf("invalid gc scope %d", scope)
+	}
+}
+
+func gcRunToProto(run metricsinfo.GCRunMetrics) *indexpb.GCRun {
+	return &indexpb.GCRun{
+		Scope:      run.Scope,
+		Trigger:    run.Trigger,
+		DryRun:     run.DryRun,
+		StartTime:  run.StartTime,
+		ElapsedMs:  run.ElapsedMs,
+		Scanned:    int64(run.Scanned),
+		Recycled:   int64(run.Recycled),
+		Failed:     int64(run.Failed),
+		Error:      run.Error,
+		Candidates: run.Candidates,
+	}
+}
+
+// TriggerGC runs the gc passes of the scope right away and returns their summaries, the summaries of the last
+// passes are reported by GetMetrics as well.
+func (i *IndexCoord) TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.TriggerGCResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	scope, err := parseGCScope(req.GetScope())
+	if err != nil {
+		return &indexpb.TriggerGCResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	runs, err := i.garbageCollector.run(scope, gcTriggerManual, req.GetDryRun())
+	if err != nil {
+		log.Warn("IndexCoord failed to trigger gc", zap.String("scope", scope), zap.Error(err))
+		return &indexpb.TriggerGCResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	ret := make([]*indexpb.GCRun, 0, len(runs))
+	for _, run := range runs {
+		ret = append(ret, gcRunToProto(run))
+	}
+	return &indexpb.TriggerGCResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Runs: ret,
+	}, nil
+}
@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestParseGCWindows(t *testing.T) {
+	windows, err := parseGCWindows("02:00-05:00, 23:30-00:30")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(windows))
+	assert.Equal(t, 2*time.Hour, windows[0].start)
+	assert.Equal(t, 30*time.Minute, windows[1].end)
+
+	day := time.Date(2023, 1, 1, 0, 0, 0, 0, time.Local)
+	assert.True(t, inGCWindows(windows, day.Add(2*time.Hour)))
+	assert.True(t, inGCWindows(windows, day.Add(4*time.Hour+59*time.Minute)))
+	assert.False(t, inGCWindows(windows, day.Add(5*time.Hour)))
+	assert.False(t, inGCWindows(windows, day.Add(12*time.Hour)))
+	assert.True(t, inGCWindows(windows, day.Add(23*time.Hour+45*time.Minute)))
+	assert.True(t, inGCWindows(windows, day.Add(10*time.Minute)))
+
+	windows, err = parseGCWindows("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(windows))
+
+	for _, value := range []string{"02:00", "02:00-25:00", "ab-05:00", "02:00-02:00"} {
+		_, err = parseGCWindows(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func newGCTestCollector(chunkManager *chunkManagerMock) (*garbageCollector, *DataCoordMock) {
+	dcm := NewDataCoordMock()
+	ic := &IndexCoord{dataCoordClient: dcm}
+	meta := createGarbageCollectorMetaTable(&indexcoord.Catalog{Txn: NewMockEtcdKV()})
+	return newGarbageCollector(context.Background(), meta, chunkManager, ic), dcm
+}
+
+func TestGarbageCollector_Run(t *testing.T) {
+	removed := 0
+	cm := &chunkManagerMock{
+		listWithPrefix: func(prefix string, recursive bool) ([]string, []time.Time, error) {
+			return []string{"index_files/999/"}, nil, nil
+		},
+		removeWithPrefix: func(prefix string) error {
+			removed++
+			return nil
+		},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		gc, _ := newGCTestCollector(cm)
+		runs, err := gc.run(gcScopeAll, gcTriggerManual, true)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(runs))
+
+		assert.Equal(t, gcScopeIndexes, runs[0].Scope)
+		assert.Equal(t, 2, runs[0].Scanned)
+		assert.Equal(t, 3, len(runs[0].Candidates))
+		// all the segments are not flushed in DataCoord
+		assert.Equal(t, gcScopeMeta, runs[1].Scope)
+		assert.Equal(t, 5, runs[1].Scanned)
+		assert.Equal(t, 5, len(runs[1].Candidates))
+		assert.Equal(t, gcScopeFiles, runs[2].Scope)
+		assert.Equal(t, []string{"index_files/999/"}, runs[2].Candidates)
+		for _, run := range runs {
+			assert.True(t, run.DryRun)
+			assert.Equal(t, gcTriggerManual, run.Trigger)
+			assert.Equal(t, 0, run.Recycled)
+		}
+		assert.Equal(t, 0, removed)
+		assert.True(t, gc.metaTable.HasBuildID(buildID))
+		lastRuns := gc.LastRuns()
+		require.Equal(t, 3, len(lastRuns))
+		assert.Equal(t, runs[2], lastRuns[0])
+	})
+
+	t.Run("recycle", func(t *testing.T) {
+		gc, _ := newGCTestCollector(cm)
+		runs, err := gc.run(gcScopeIndexes, gcTriggerManual, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(runs))
+		assert.Equal(t, 3, runs[0].Recycled)
+		assert.False(t, gc.metaTable.HasBuildID(buildID))
+
+		runs, err = gc.run(gcScopeFiles, gcTriggerManual, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, runs[0].Recycled)
+		assert.Equal(t, 1, removed)
+		assert.Equal(t, 2, len(gc.LastRuns()))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		gc, dcm := newGCTestCollector(&chunkManagerMock{
+			listWithPrefix: func(prefix string, recursive bool) ([]string, []time.Time, error) {
+				return nil, nil, errors.New("mock error")
+			},
+		})
+		dcm.CallGetFlushedSegment = func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+			return nil, errors.New("mock error")
+		}
+		runs, err := gc.run(gcScopeAll, gcTriggerManual, false)
+		require.NoError(t, err)
+		assert.Equal(t, "", runs[0].Error)
+		assert.NotEqual(t, "", runs[1].Error)
+		assert.NotEqual(t, "", runs[2].Error)
+
+		_, err = gc.run("unknown", gcTriggerManual, false)
+		assert.Error(t, err)
+
+		gc.runMu.Lock()
+		_, err = gc.run(gcScopeAll, gcTriggerManual, false)
+		assert.ErrorIs(t, err, errGCRunning)
+		gc.runMu.Unlock()
+	})
+}
+
+func TestGarbageCollector_ScheduleTick(t *testing.T) {
+	gc, _ := newGCTestCollector(&chunkManagerMock{
+		listWithPrefix: func(prefix string, recursive bool) ([]string, []time.Time, error) {
+			return nil, nil, nil
+		},
+	})
+	gc.gcFileDuration = time.Hour
+	now := time.Date(2023, 1, 1, 3, 0, 0, 0, time.Local)
+	gc.now = func() time.Time { return now }
+
+	// nothing is scheduled without windows
+	assert.False(t, gc.scheduleTick(time.Time{}))
+
+	Params.BaseTable.Save(Params.IndexCoordCfg.GCWindows.Key, "02:00-05:00")
+	defer Params.BaseTable.Reset(Params.IndexCoordCfg.GCWindows.Key)
+	assert.True(t, gc.scheduleTick(time.Time{}))
+	assert.Equal(t, 3, len(gc.LastRuns()))
+	assert.Equal(t, gcTriggerWindow, gc.LastRuns()[0].Trigger)
+	// at most once every interval
+	assert.False(t, gc.scheduleTick(now.Add(-time.Minute)))
+	assert.True(t, gc.scheduleTick(now.Add(-time.Hour)))
+
+	now = now.Add(3 * time.Hour)
+	assert.False(t, gc.scheduleTick(time.Time{}))
+
+	Params.BaseTable.Save(Params.IndexCoordCfg.GCWindows.Key, "invalid")
+	assert.False(t, gc.scheduleTick(time.Time{}))
+}
+
+func TestIndexCoord_TriggerGC(t *testing.T) {
+	ctx := context.Background()
+	gc, _ := newGCTestCollector(&chunkManagerMock{
+		listWithPrefix: func(prefix string, recursive bool) ([]string, []time.Time, error) {
+			return nil, nil, nil
+		},
+	})
+	ic := &IndexCoord{
+		session:          &sessionutil.Session{ServerID: 1},
+		garbageCollector: gc,
+	}
+	ic.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := ic.TriggerGC(ctx, &indexpb.TriggerGCRequest{Scope: indexpb.GCScope_GCIndexes, DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(resp.GetRuns()))
+	assert.Equal(t, gcScopeIndexes, resp.GetRuns()[0].GetScope())
+	assert.Equal(t, gcTriggerManual, resp.GetRuns()[0].GetTrigger())
+	assert.True(t, resp.GetRuns()[0].GetDryRun())
+	assert.Equal(t, 3, len(resp.GetRuns()[0].GetCandidates()))
+	require.Equal(t, 1, len(gc.LastRuns()))
+	assert.Equal(t, gcScopeIndexes, gc.LastRuns()[0].Scope)
+
+	resp, err = ic.TriggerGC(ctx, &indexpb.TriggerGCRequest{Scope: indexpb.GCScope(100)})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	gc.runMu.Lock()
+	resp, err = ic.TriggerGC(ctx, &indexpb.TriggerGCRequest{Scope: indexpb.GCScope_GCAll})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	gc.runMu.Unlock()
+
+	ic.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = ic.TriggerGC(ctx, &indexpb.TriggerGCRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -25,7 +25,6 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 
-	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -122,6 +121,8 @@ func (hd *handoff) scheduler() {
 	log.Ctx(hd.ctx).Info("IndexCoord handoff start...")
 	defer hd.wg.Done()
 
+	hd.cleanHandoffRecords()
+
 	ticker := time.NewTicker(hd.scheduleDuration)
 	defer ticker.Stop()
 	for {
@@ -251,6 +252,8 @@ func (hd *handoff) process(segID UniqueID) {
 					IndexID:     indexInfo.IndexID,
 					BuildID:     indexInfo.BuildID,
 					IndexParams: hd.meta.GetIndexParams(info.CollectionID, indexInfo.IndexID),
+					// the consumer can tell the versions of the indexes handed off
+					IndexVersion: indexInfo.IndexVersion,
 					//IndexFileKeys: nil,
 					//IndexSize:      0,
 				})
@@ -310,23 +313,3 @@ func (hd *handoff) allParentsDone(segIDs []UniqueID) bool {
 	}
 	return true
 }
-
-func (hd *handoff) writeHandoffSegment(info *querypb.SegmentInfo) error {
-	key := buildHandoffKey(info.CollectionID, info.PartitionID, info.SegmentID)
-	value, err := proto.Marshal(info)
-	if err != nil {
-		log.Error("IndexCoord marshal handoff task fail", zap.Int64("collID", info.CollectionID),
-			zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID), zap.Error(err))
-		return err
-	}
-	err = hd.kvClient.Save(key, string(value))
-	if err != nil {
-		log.Error("IndexCoord save handoff task fail", zap.Int64("collID", info.CollectionID),
-			zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID), zap.Error(err))
-		return err
-	}
-
-	log.Info("IndexCoord write handoff task success", zap.Int64("collID", info.CollectionID),
-		zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID))
-	return nil
-}
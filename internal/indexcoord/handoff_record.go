@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// handoffIndexVersion identifies a segment index built for the handoff event.
+type handoffIndexVersion struct {
+	IndexID      UniqueID `json:"index_id"`
+	BuildID      UniqueID `json:"build_id"`
+	IndexVersion int64    `json:"index_version"`
+}
+
+// handoffRecord is the version of the handoff event written for a segment. The event and its record are saved
+// in one transaction, so a retry or a new IndexCoord after failover finds the record and doesn't write the same
+// event again, even if the consumer has acked the event by removing it. A rebuilt index is a new version and
+// is handed off again.
+type handoffRecord struct {
+	SegmentID UniqueID              `json:"segment_id"`
+	IsFake    bool                  `json:"is_fake,omitempty"`
+	Indexes   []handoffIndexVersion `json:"indexes,omitempty"`
+}
+
+func buildHandoffRecordKey(segID UniqueID) string {
+	return fmt.Sprintf("%s/%d", util.HandoffRecordPrefix, segID)
+}
+
+// newHandoffRecord returns the record of the handoff event, the indexes are sorted by index id.
+func newHandoffRecord(info *querypb.SegmentInfo) *handoffRecord {
+	record := &handoffRecord{
+		SegmentID: info.GetSegmentID(),
+		IsFake:    info.GetIsFake(),
+	}
+	for _, indexInfo := range info.GetIndexInfos() {
+		record.Indexes = append(record.Indexes, handoffIndexVersion{
+			IndexID:      indexInfo.GetIndexID(),
+			BuildID:      indexInfo.GetBuildID(),
+			IndexVersion: indexInfo.GetIndexVersion(),
+		})
+	}
+	sort.Slice(record.Indexes, func(i, j int) bool {
+		return record.Indexes[i].IndexID < record.Indexes[j].IndexID
+	})
+	return record
+}
+
+// loadHandoffRecord returns the json encoded record written for the segment, empty if there is none.
+func (hd *handoff) loadHandoffRecord(segID UniqueID) (string, error) {
+	value, err := hd.kvClient.Load(buildHandoffRecordKey(segID))
+	if err != nil {
+		if common.IsKeyNotExistError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return value, nil
+}
+
+// handoffAcked returns true if the consumer has acked the handoff event of the segment by removing it.
+func (hd *handoff) handoffAcked(info *querypb.SegmentInfo) (bool, error) {
+	_, err := hd.kvClient.Load(buildHandoffKey(info.GetCollectionID(), info.GetPartitionID(), info.GetSegmentID()))
+	if err != nil {
+		if common.IsKeyNotExistError(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// writeHandoffSegment writes the handoff event of the segment with its record, it's a no-op if the same version
+// has been written.
+func (hd *handoff) writeHandoffSegment(info *querypb.SegmentInfo) error {
+	record, err := json.Marshal(newHandoffRecord(info))
+	if err != nil {
+		return err
+	}
+	written, err := hd.loadHandoffRecord(info.GetSegmentID())
+	if err != nil {
+		log.Warn("IndexCoord load handoff record fail", zap.Int64("segID", info.GetSegmentID()), zap.Error(err))
+		return err
+	}
+	if written == string(record) {
+		acked, err := hd.handoffAcked(info)
+		log.Info("IndexCoord handoff task of the version has been written, skip", zap.Int64("collID", info.CollectionID),
+			zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID), zap.String("record", written),
+			zap.Bool("acked", acked), zap.Error(err))
+		return nil
+	}
+
+	key := buildHandoffKey(info.CollectionID, info.PartitionID, info.SegmentID)
+	value, err := proto.Marshal(info)
+	if err != nil {
+		log.Error("IndexCoord marshal handoff task fail", zap.Int64("collID", info.CollectionID),
+			zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID), zap.Error(err))
+		return err
+	}
+	err = hd.kvClient.MultiSave(map[string]string{
+		key: string(value),
+		buildHandoffRecordKey(info.GetSegmentID()): string(record),
+	})
+	if err != nil {
+		log.Error("IndexCoord save handoff task fail", zap.Int64("collID", info.CollectionID),
+			zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID), zap.Error(err))
+		return err
+	}
+
+	log.Info("IndexCoord write handoff task success", zap.Int64("collID", info.CollectionID),
+		zap.Int64("partID", info.PartitionID), zap.Int64("segID", info.SegmentID), zap.String("record", string(record)))
+	return nil
+}
+
+// cleanHandoffRecords removes the records of the segments without indexes, such as the dropped segments.
+func (hd *handoff) cleanHandoffRecords() {
+	_, values, err := hd.kvClient.LoadWithPrefix(util.HandoffRecordPrefix)
+	if err != nil {
+		log.Warn("IndexCoord load handoff records fail", zap.Error(err))
+		return
+	}
+	allSegIndexes := hd.meta.GetAllSegIndexes()
+	for _, value := range values {
+		record := &handoffRecord{}
+		if err := json.Unmarshal([]byte(value), record); err != nil {
+			log.Warn("IndexCoord skip invalid handoff record", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		if _, ok := allSegIndexes[record.SegmentID]; ok {
+			continue
+		}
+		if err := hd.kvClient.Remove(buildHandoffRecordKey(record.SegmentID)); err != nil {
+			log.Warn("IndexCoord remove handoff record fail", zap.Int64("segID", record.SegmentID), zap.Error(err))
+		}
+	}
+}
@@ -19,6 +19,7 @@ package indexcoord
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -26,11 +27,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
 func createMetaForHandoff(catalog metastore.IndexCoordCatalog) *metaTable {
@@ -190,11 +193,7 @@ func Test_process(t *testing.T) {
 				},
 			},
 			taskMutex: sync.RWMutex{},
-			kvClient: &mockETCDKV{
-				save: func(s string, s2 string) error {
-					return nil
-				},
-			},
+			kvClient:  NewMockEtcdKV(),
 		}
 
 		hd.process(segID)
@@ -377,7 +376,10 @@ func Test_handoff_error(t *testing.T) {
 			notifyChan:       make(chan struct{}, 1),
 			scheduleDuration: 0,
 			kvClient: &mockETCDKV{
-				save: func(s string, s2 string) error {
+				load: func(s string) (string, error) {
+					return "", common.NewKeyNotExistError(s)
+				},
+				multiSave: func(m map[string]string) error {
 					return errors.New("error")
 				},
 			},
@@ -401,7 +403,10 @@ func Test_handoff_error(t *testing.T) {
 			},
 			taskMutex: sync.RWMutex{},
 			kvClient: &mockETCDKV{
-				save: func(s string, s2 string) error {
+				load: func(s string) (string, error) {
+					return "", common.NewKeyNotExistError(s)
+				},
+				multiSave: func(m map[string]string) error {
 					return errors.New("error")
 				},
 			},
@@ -481,3 +486,88 @@ func Test_handoff_allParentsDone(t *testing.T) {
 		assert.False(t, done)
 	})
 }
+
+func Test_handoff_writeHandoffSegment(t *testing.T) {
+	saved := make(map[string]string)
+	multiSaveCount := 0
+	kvClient := &mockETCDKV{
+		load: func(key string) (string, error) {
+			value, ok := saved[key]
+			if !ok {
+				return "", common.NewKeyNotExistError(key)
+			}
+			return value, nil
+		},
+		multiSave: func(kvs map[string]string) error {
+			multiSaveCount++
+			for k, v := range kvs {
+				saved[k] = v
+			}
+			return nil
+		},
+		loadWithPrefix: func(prefix string) ([]string, []string, error) {
+			keys, values := make([]string, 0), make([]string, 0)
+			for k, v := range saved {
+				if strings.HasPrefix(k, prefix) {
+					keys = append(keys, k)
+					values = append(values, v)
+				}
+			}
+			return keys, values, nil
+		},
+		remove: func(key string) error {
+			delete(saved, key)
+			return nil
+		},
+	}
+	hd := &handoff{
+		ctx:      context.Background(),
+		kvClient: kvClient,
+		meta:     createMetaForHandoff(&indexcoord.Catalog{Txn: NewMockEtcdKV()}),
+	}
+	info := &querypb.SegmentInfo{
+		SegmentID:    segID,
+		CollectionID: collID,
+		PartitionID:  partID,
+		IndexInfos: []*querypb.FieldIndexInfo{
+			{IndexID: indexID + 1, BuildID: buildID + 1, IndexVersion: 1},
+			{IndexID: indexID, BuildID: buildID, IndexVersion: 1},
+		},
+	}
+	eventKey := buildHandoffKey(collID, partID, segID)
+
+	assert.NoError(t, hd.writeHandoffSegment(info))
+	assert.Equal(t, 1, multiSaveCount)
+	assert.Contains(t, saved, eventKey)
+	assert.Contains(t, saved, buildHandoffRecordKey(segID))
+	acked, err := hd.handoffAcked(info)
+	assert.NoError(t, err)
+	assert.False(t, acked)
+
+	// the retries don't write the same version again, even if the consumer has acked the event
+	assert.NoError(t, hd.writeHandoffSegment(info))
+	delete(saved, eventKey)
+	acked, err = hd.handoffAcked(info)
+	assert.NoError(t, err)
+	assert.True(t, acked)
+	assert.NoError(t, hd.writeHandoffSegment(info))
+	assert.Equal(t, 1, multiSaveCount)
+	assert.NotContains(t, saved, eventKey)
+
+	// a rebuilt index is a new version
+	info.IndexInfos[0].BuildID = buildID + 10
+	assert.NoError(t, hd.writeHandoffSegment(info))
+	assert.Equal(t, 2, multiSaveCount)
+	assert.Contains(t, saved, eventKey)
+
+	// the records of the segments without indexes are cleaned
+	assert.NoError(t, hd.writeHandoffSegment(&querypb.SegmentInfo{SegmentID: segID + 100, IsFake: true}))
+	hd.cleanHandoffRecords()
+	assert.Contains(t, saved, buildHandoffRecordKey(segID))
+	assert.NotContains(t, saved, buildHandoffRecordKey(segID+100))
+
+	kvClient.load = func(key string) (string, error) {
+		return "", errors.New("error")
+	}
+	assert.Error(t, hd.writeHandoffSegment(&querypb.SegmentInfo{SegmentID: segID + 1}))
+}
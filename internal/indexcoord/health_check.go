@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// healthProbeKeyPrefix is the etcd key prefix written and read back by the health check.
+	healthProbeKeyPrefix = "indexcoord-health-probe"
+	// maxStuckBuildIDsInReason is the max number of stuck build ids shown in the unhealthy reason.
+	maxStuckBuildIDsInReason = 10
+)
+
+// probeEtcd writes a probe key to etcd and reads it back, returns the round trip latency.
+func (i *IndexCoord) probeEtcd() (time.Duration, error) {
+	key := path.Join(healthProbeKeyPrefix, strconv.FormatInt(i.session.ServerID, 10))
+	value := strconv.FormatInt(time.Now().UnixNano(), 10)
+	start := time.Now()
+	if err := i.etcdKV.Save(key, value); err != nil {
+		return 0, fmt.Errorf("failed to write etcd: %w", err)
+	}
+	loaded, err := i.etcdKV.Load(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read etcd: %w", err)
+	}
+	if loaded != value {
+		return 0, fmt.Errorf("etcd read back %s, expect %s", loaded, value)
+	}
+	return time.Since(start), nil
+}
+
+// probeStorage checks the object storage is reachable by a HEAD on the index file prefix,
+// the prefix may not exist as an object, only the error matters.
+func (i *IndexCoord) probeStorage(ctx context.Context) error {
+	prefix := path.Join(i.chunkManager.RootPath(), common.SegmentIndexPath)
+	if _, err := i.chunkManager.Exist(ctx, prefix); err != nil {
+		return fmt.Errorf("failed to access object storage: %w", err)
+	}
+	return nil
+}
+
+// getStuckBuilds returns the build ids of the segment indexes staying in progress longer than the sla,
+// a non-positive sla disables the check.
+func (i *IndexCoord) getStuckBuilds(sla time.Duration) []UniqueID {
+	stuck := make([]UniqueID, 0)
+	if sla <= 0 {
+		return stuck
+	}
+	now := time.Now()
+	for buildID, segIdx := range i.metaTable.GetAllIndexMeta() {
+		if segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_InProgress {
+			continue
+		}
+		createTime, _ := tsoutil.ParseTS(segIdx.CreateTime)
+		if now.Sub(createTime) > sla {
+			stuck = append(stuck, buildID)
+		}
+	}
+	sort.Slice(stuck, func(x, y int) bool { return stuck[x] < stuck[y] })
+	return stuck
+}
+
+// probeHealth actively probes etcd, the object storage and the meta consistency,
+// returns the reasons of the degraded health.
+func (i *IndexCoord) probeHealth(ctx context.Context) []string {
+	reasons := make([]string, 0)
+	unhealthy := func(reason string) {
+		reasons = append(reasons, errorutil.UnHealthReason("indexcoord", i.session.ServerID, reason))
+	}
+
+	if i.etcdKV != nil {
+		latency, err := i.probeEtcd()
+		threshold := Params.IndexCoordCfg.HealthCheckEtcdLatencyThreshold.GetAsDuration(time.Millisecond)
+		if err != nil {
+			unhealthy(err.Error())
+		} else if threshold > 0 && latency > threshold {
+			unhealthy(fmt.Sprintf("etcd write and read latency %v exceeds %v", latency, threshold))
+		}
+	}
+
+	if i.chunkManager != nil {
+		if err := i.probeStorage(ctx); err != nil {
+			unhealthy(err.Error())
+		}
+	}
+
+	if i.metaTable != nil {
+		sla := Params.IndexCoordCfg.HealthCheckBuildInProgressSLA.GetAsDuration(time.Second)
+		if stuck := i.getStuckBuilds(sla); len(stuck) > 0 {
+			shown := stuck
+			if len(shown) > maxStuckBuildIDsInReason {
+				shown = shown[:maxStuckBuildIDsInReason]
+			}
+			unhealthy(fmt.Sprintf("%d index builds in progress longer than %v, buildIDs: %v", len(stuck), sla, shown))
+		}
+	}
+	return reasons
+}
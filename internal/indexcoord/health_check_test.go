@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestIndexCoord_ProbeHealth(t *testing.T) {
+	ctx := context.Background()
+	newIndexCoord := func() *IndexCoord {
+		saved := make(map[string]string)
+		etcdKV := NewMockEtcdKV()
+		etcdKV.save = func(key string, value string) error {
+			saved[key] = value
+			return nil
+		}
+		etcdKV.load = func(key string) (string, error) {
+			return saved[key], nil
+		}
+		ic := &IndexCoord{
+			session:     &sessionutil.Session{ServerID: 1},
+			nodeManager: NewNodeManager(ctx),
+			etcdKV:      etcdKV,
+			chunkManager: &chunkManagerMock{
+				exist: func(s string) (bool, error) {
+					return false, nil
+				},
+			},
+			metaTable: constructMetaTable(&indexcoord.Catalog{}),
+		}
+		ic.stateCode.Store(commonpb.StateCode_Healthy)
+		return ic
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		ic := newIndexCoord()
+		resp, err := ic.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.True(t, resp.GetIsHealthy())
+		assert.Empty(t, resp.GetReasons())
+	})
+
+	t.Run("etcd failed", func(t *testing.T) {
+		ic := newIndexCoord()
+		ic.etcdKV.(*mockETCDKV).save = func(key string, value string) error {
+			return errors.New("mock error")
+		}
+		reasons := ic.probeHealth(ctx)
+		assert.Equal(t, 1, len(reasons))
+		assert.Contains(t, reasons[0], "failed to write etcd")
+
+		ic.etcdKV.(*mockETCDKV).save = func(key string, value string) error {
+			return nil
+		}
+		reasons = ic.probeHealth(ctx)
+		assert.Equal(t, 1, len(reasons))
+		assert.Contains(t, reasons[0], "etcd read back")
+	})
+
+	t.Run("etcd slow", func(t *testing.T) {
+		paramtable.Get().Save(Params.IndexCoordCfg.HealthCheckEtcdLatencyThreshold.Key, "1")
+		defer paramtable.Get().Reset(Params.IndexCoordCfg.HealthCheckEtcdLatencyThreshold.Key)
+		ic := newIndexCoord()
+		load := ic.etcdKV.(*mockETCDKV).load
+		ic.etcdKV.(*mockETCDKV).load = func(key string) (string, error) {
+			time.Sleep(5 * time.Millisecond)
+			return load(key)
+		}
+		resp, err := ic.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.False(t, resp.GetIsHealthy())
+		assert.Equal(t, 1, len(resp.GetReasons()))
+		assert.Contains(t, resp.GetReasons()[0], "latency")
+	})
+
+	t.Run("storage failed", func(t *testing.T) {
+		ic := newIndexCoord()
+		ic.chunkManager = &chunkManagerMock{
+			exist: func(s string) (bool, error) {
+				return false, errors.New("mock error")
+			},
+		}
+		resp, err := ic.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.False(t, resp.GetIsHealthy())
+		assert.Equal(t, 1, len(resp.GetReasons()))
+		assert.Contains(t, resp.GetReasons()[0], "object storage")
+	})
+
+	t.Run("build stuck", func(t *testing.T) {
+		paramtable.Get().Save(Params.IndexCoordCfg.HealthCheckBuildInProgressSLA.Key, "60")
+		defer paramtable.Get().Reset(Params.IndexCoordCfg.HealthCheckBuildInProgressSLA.Key)
+		ic := newIndexCoord()
+		segIdx := ic.metaTable.buildID2SegmentIndex[buildID]
+		segIdx.IndexState = commonpb.IndexState_InProgress
+		segIdx.CreateTime = tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+		assert.Empty(t, ic.probeHealth(ctx))
+
+		segIdx.CreateTime = tsoutil.ComposeTSByTime(time.Now().Add(-time.Hour), 0)
+		assert.Equal(t, []UniqueID{buildID}, ic.getStuckBuilds(time.Minute))
+		reasons := ic.probeHealth(ctx)
+		assert.Equal(t, 1, len(reasons))
+		assert.Contains(t, reasons[0], "index builds in progress")
+
+		paramtable.Get().Save(Params.IndexCoordCfg.HealthCheckBuildInProgressSLA.Key, "0")
+		assert.Empty(t, ic.probeHealth(ctx))
+	})
+}
@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// VerifyIndexBuild forwards the request to the IndexNode given by the nodeID, which rebuilds the index recorded in
+// reproducibility mode and compares the checksums of the index files. The cordoned IndexNodes verify the builds too.
+func (i *IndexCoord) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.VerifyIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	client, ok := i.nodeManager.GetClientByID(req.GetNodeID())
+	if !ok {
+		return &indexpb.VerifyIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    fmt.Sprintf("%s: %d", errNodeNotFound.Error(), req.GetNodeID()),
+			},
+		}, nil
+	}
+	log.Ctx(ctx).Info("IndexCoord verify index build", zap.Int64("nodeID", req.GetNodeID()),
+		zap.String("manifestPath", req.GetManifestPath()))
+	resp, err := client.VerifyIndexBuild(ctx, req)
+	if err != nil {
+		log.Ctx(ctx).Warn("IndexCoord failed to verify index build", zap.Int64("nodeID", req.GetNodeID()),
+			zap.String("manifestPath", req.GetManifestPath()), zap.Error(err))
+		return &indexpb.VerifyIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
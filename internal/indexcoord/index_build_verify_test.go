@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestIndexCoord_VerifyIndexBuild(t *testing.T) {
+	ctx := context.Background()
+	ic := &IndexCoord{
+		session:     &sessionutil.Session{ServerID: 1},
+		nodeManager: NewNodeManager(context.Background()),
+	}
+	ic.stateCode.Store(commonpb.StateCode_Healthy)
+	ic.nodeManager.setClient(1, &indexnode.Mock{
+		CallVerifyIndexBuild: func(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+			assert.Equal(t, "manifest", req.GetManifestPath())
+			return &indexpb.VerifyIndexBuildResponse{
+				Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				BuildID: 10,
+				Match:   true,
+			}, nil
+		},
+	})
+	ic.nodeManager.setClient(2, &indexnode.Mock{
+		CallVerifyIndexBuild: func(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+			return nil, errors.New("mock")
+		},
+	})
+
+	resp, err := ic.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{NodeID: 1, ManifestPath: "manifest"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(10), resp.GetBuildID())
+	assert.True(t, resp.GetMatch())
+
+	resp, err = ic.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{NodeID: 2, ManifestPath: "manifest"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	resp, err = ic.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{NodeID: 3, ManifestPath: "manifest"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	ic.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = ic.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{NodeID: 1, ManifestPath: "manifest"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
 )
 
 type indexBuilder struct {
@@ -105,6 +107,9 @@ func (ib *indexBuilder) reloadFromKV(aliveNodes []UniqueID) {
 			} else {
 				// in_progress, nothing to do
 				ib.tasks[build] = indexTaskInProgress
+				if ib.ic != nil && ib.ic.nodeManager != nil {
+					ib.ic.nodeManager.addPendingBuild(indexMeta.NodeID, build, ib.buildLoad(indexMeta))
+				}
 			}
 		} else if indexMeta.IndexState == commonpb.IndexState_Finished || indexMeta.IndexState == commonpb.IndexState_Failed {
 			if indexMeta.NodeID != 0 {
@@ -231,7 +236,10 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		}
 		// peek client
 		// if all IndexNodes are executing task, wait for one of them to finish the task.
-		nodeID, client := ib.ic.nodeManager.PeekClient(meta)
+		nodeID, client := ib.peekBundleClient(meta)
+		if client == nil {
+			nodeID, client = ib.ic.nodeManager.PeekClient(meta)
+		}
 		if client == nil {
 			log.Ctx(ib.ctx).RatedInfo(5, "index builder peek client error, there is no available")
 			return false
@@ -312,6 +320,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		}
 		log.Ctx(ib.ctx).Info("index task assigned successfully", zap.Int64("buildID", buildID),
 			zap.Int64("segID", meta.SegmentID), zap.Int64("nodeID", nodeID))
+		ib.ic.nodeManager.addPendingBuild(nodeID, buildID, ib.buildLoad(meta))
 		// update index meta state to InProgress
 		if err := ib.meta.BuildIndex(buildID); err != nil {
 			// need to release lock then reassign, so set task state to retry
@@ -323,6 +332,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		updateStateFunc(buildID, indexTaskInProgress)
 
 	case indexTaskDone:
+		ib.ic.nodeManager.removePendingBuild(meta.NodeID, buildID)
 		if !ib.meta.NeedIndex(meta.CollectionID, meta.IndexID) {
 			log.Ctx(ib.ctx).Info("task is no need to build index, remove it", zap.Int64("buildID", buildID),
 				zap.Int64("segID", meta.SegmentID))
@@ -339,6 +349,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		}
 		deleteFunc(buildID)
 	case indexTaskRetry:
+		ib.ic.nodeManager.removePendingBuild(meta.NodeID, buildID)
 		if !ib.meta.NeedIndex(meta.CollectionID, meta.IndexID) {
 			log.Ctx(ib.ctx).Info("task is no need to build index, remove it", zap.Int64("buildID", buildID))
 			updateStateFunc(buildID, indexTaskDeleted)
@@ -359,6 +370,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			return false
 		}
 		if meta.NodeID != 0 {
+			ib.ic.nodeManager.removePendingBuild(meta.NodeID, buildID)
 			if !ib.dropIndexTask(buildID, meta.NodeID) {
 				log.Ctx(ib.ctx).Warn("index task state is deleted and drop index job for node fail", zap.Int64("build", buildID),
 					zap.Int64("nodeID", meta.NodeID))
@@ -384,6 +396,25 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 	return true
 }
 
+// peekBundleClient peeks the IndexNode building another index of the same segment, so the IndexNode downloads the
+// binlogs shared by the builds once.
+func (ib *indexBuilder) peekBundleClient(meta *model.SegmentIndex) (UniqueID, types.IndexNode) {
+	if !Params.IndexCoordCfg.BundleSegmentBuildsEnabled.GetAsBool() {
+		return 0, nil
+	}
+	for _, segIdx := range ib.meta.GetSegmentIndexes(meta.SegmentID) {
+		if segIdx.BuildID == meta.BuildID || segIdx.NodeID == 0 || segIdx.IndexState != commonpb.IndexState_InProgress {
+			continue
+		}
+		if client, ok := ib.ic.nodeManager.GetClientByID(segIdx.NodeID); ok {
+			log.Ctx(ib.ctx).Info("index builder bundles the build with another build of the segment", zap.Int64("buildID", meta.BuildID),
+				zap.Int64("segID", meta.SegmentID), zap.Int64("bundledBuildID", segIdx.BuildID), zap.Int64("nodeID", segIdx.NodeID))
+			return segIdx.NodeID, client
+		}
+	}
+	return 0, nil
+}
+
 func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 	client, exist := ib.ic.nodeManager.GetClientByID(nodeID)
 	if exist {
@@ -417,7 +448,9 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 					}
 					return indexTaskDone
 				} else if info.State == commonpb.IndexState_Retry || info.State == commonpb.IndexState_IndexStateNone {
-					log.Ctx(ib.ctx).Info("this task should be retry", zap.Int64("buildID", buildID), zap.String("fail reason", info.FailReason))
+					failClass, _, _ := common.ParseIndexFailReason(info.FailReason)
+					log.Ctx(ib.ctx).Info("this task should be retry", zap.Int64("buildID", buildID),
+						zap.String("fail class", string(failClass)), zap.String("fail reason", info.FailReason))
 					return indexTaskRetry
 				}
 				return indexTaskInProgress
@@ -1153,6 +1153,53 @@ func Test_indexBuilder_getTaskState(t *testing.T) {
 //	wg.Wait()
 //}
 
+func Test_indexBuilder_peekBundleClient(t *testing.T) {
+	Params.Init()
+	meta := createMetaTable(&indexcoord.Catalog{Txn: NewMockEtcdKV()})
+	// a scalar index of the segment with a vector index in progress on nodeID
+	scalarIndex := &model.SegmentIndex{
+		SegmentID:    segID + 1,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      1026,
+		IndexID:      indexID + 1,
+		BuildID:      buildID + 100,
+		IndexState:   commonpb.IndexState_Unissued,
+	}
+	meta.segmentIndexes[segID+1][indexID+1] = scalarIndex
+	ib := &indexBuilder{
+		ctx:  context.Background(),
+		meta: meta,
+		ic: &IndexCoord{
+			nodeManager: &NodeManager{
+				ctx: context.Background(),
+				nodeClients: map[UniqueID]types.IndexNode{
+					nodeID: indexnode.NewIndexNodeMock(),
+				},
+			},
+		},
+	}
+
+	// disabled
+	_, client := ib.peekBundleClient(scalarIndex)
+	assert.Nil(t, client)
+
+	paramtable.Get().Save(Params.IndexCoordCfg.BundleSegmentBuildsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.IndexCoordCfg.BundleSegmentBuildsEnabled.Key)
+	peekNodeID, client := ib.peekBundleClient(scalarIndex)
+	assert.NotNil(t, client)
+	assert.Equal(t, nodeID, peekNodeID)
+
+	// no other build of the segment in progress
+	_, client = ib.peekBundleClient(&model.SegmentIndex{SegmentID: segID, BuildID: buildID})
+	assert.Nil(t, client)
+
+	// the IndexNode is offline
+	ib.ic.nodeManager.nodeClients = map[UniqueID]types.IndexNode{}
+	_, client = ib.peekBundleClient(scalarIndex)
+	assert.Nil(t, client)
+}
+
 //func Test_indexBuilder_releaseLockAndResetTask_error(t *testing.T) {
 //	Params.Init()
 //	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,237 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// inconsistencyMetaWithoutFiles means a finished segment index misses some of its index files in object storage.
+	inconsistencyMetaWithoutFiles = "meta_without_files"
+	// inconsistencyFilesWithoutMeta means the index files in object storage belong to no segment index.
+	inconsistencyFilesWithoutMeta = "files_without_meta"
+	// inconsistencyRowCountDrift means the row count of a finished segment index differs from the segment in DataCoord.
+	inconsistencyRowCountDrift = "row_count_drift"
+)
+
+// checkIndexFiles returns the inconsistencies of the finished segment indexes missing index files.
+func (i *IndexCoord) checkIndexFiles(ctx context.Context, segIdxes []*model.SegmentIndex) ([]*indexpb.IndexInconsistency, error) {
+	ret := make([]*indexpb.IndexInconsistency, 0)
+	for _, segIdx := range segIdxes {
+		missing := 0
+		for _, fileKey := range segIdx.IndexFileKeys {
+			filePath := metautil.BuildSegmentIndexFilePath(i.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+				segIdx.PartitionID, segIdx.SegmentID, fileKey)
+			exist, err := i.chunkManager.Exist(ctx, filePath)
+			if err != nil {
+				return nil, err
+			}
+			if !exist {
+				missing++
+			}
+		}
+		if missing > 0 {
+			ret = append(ret, &indexpb.IndexInconsistency{
+				Type:         inconsistencyMetaWithoutFiles,
+				CollectionID: segIdx.CollectionID,
+				SegmentID:    segIdx.SegmentID,
+				BuildID:      segIdx.BuildID,
+				Detail:       fmt.Sprintf("%d of %d index files missing", missing, len(segIdx.IndexFileKeys)),
+			})
+		}
+	}
+	return ret, nil
+}
+
+// checkRowCounts returns the inconsistencies of the finished segment indexes whose row counts differ from the segments,
+// the segments not found in DataCoord are left to the garbage collector.
+func (i *IndexCoord) checkRowCounts(ctx context.Context, segIdxes []*model.SegmentIndex) ([]*indexpb.IndexInconsistency, error) {
+	ret := make([]*indexpb.IndexInconsistency, 0)
+	if len(segIdxes) == 0 {
+		return ret, nil
+	}
+	segIDs := make([]UniqueID, 0, len(segIdxes))
+	for _, segIdx := range segIdxes {
+		segIDs = append(segIDs, segIdx.SegmentID)
+	}
+	resp, err := i.dataCoordClient.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		SegmentIDs:       segIDs,
+		IncludeUnHealthy: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	numRows := make(map[UniqueID]int64, len(resp.GetInfos()))
+	for _, info := range resp.GetInfos() {
+		if info.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		numRows[info.GetID()] = info.GetNumOfRows()
+	}
+	for _, segIdx := range segIdxes {
+		rows, ok := numRows[segIdx.SegmentID]
+		if !ok || rows == segIdx.NumRows {
+			continue
+		}
+		ret = append(ret, &indexpb.IndexInconsistency{
+			Type:         inconsistencyRowCountDrift,
+			CollectionID: segIdx.CollectionID,
+			SegmentID:    segIdx.SegmentID,
+			BuildID:      segIdx.BuildID,
+			Detail:       fmt.Sprintf("index rows %d, segment rows %d", segIdx.NumRows, rows),
+		})
+	}
+	return ret, nil
+}
+
+// checkOrphanIndexFiles returns the inconsistencies of the index files belonging to no segment index.
+func (i *IndexCoord) checkOrphanIndexFiles(ctx context.Context) ([]*indexpb.IndexInconsistency, error) {
+	ret := make([]*indexpb.IndexInconsistency, 0)
+	prefix := path.Join(i.chunkManager.RootPath(), common.SegmentIndexPath) + "/"
+	keys, _, err := i.chunkManager.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		buildID, err := parseBuildIDFromFilePath(key)
+		if err != nil {
+			log.Warn("failed to parse build id of index files", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if !i.metaTable.HasBuildID(buildID) {
+			ret = append(ret, &indexpb.IndexInconsistency{
+				Type:    inconsistencyFilesWithoutMeta,
+				BuildID: buildID,
+				Detail:  key,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// checkIndexConsistency reconciles the finished segment indexes with the segments of DataCoord and the index files,
+// collectionID 0 means all the collections, the orphan index files are only checked for all the collections
+// since they can't be attributed to a collection.
+func (i *IndexCoord) checkIndexConsistency(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInconsistency, error) {
+	segIdxes := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range i.metaTable.GetAllIndexMeta() {
+		if segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_Finished {
+			continue
+		}
+		if collectionID != 0 && segIdx.CollectionID != collectionID {
+			continue
+		}
+		segIdxes = append(segIdxes, segIdx)
+	}
+	sort.Slice(segIdxes, func(x, y int) bool { return segIdxes[x].BuildID < segIdxes[y].BuildID })
+
+	ret, err := i.checkIndexFiles(ctx, segIdxes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check index files: %w", err)
+	}
+	drifts, err := i.checkRowCounts(ctx, segIdxes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check row counts: %w", err)
+	}
+	ret = append(ret, drifts...)
+	if collectionID == 0 {
+		orphans, err := i.checkOrphanIndexFiles(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check orphan index files: %w", err)
+		}
+		ret = append(ret, orphans...)
+	}
+	return ret, nil
+}
+
+// repairIndexInconsistencies rebuilds the segment indexes missing files or with drifted row counts,
+// and removes the orphan index files. A failed repair is logged and left for the next check.
+func (i *IndexCoord) repairIndexInconsistencies(ctx context.Context, inconsistencies []*indexpb.IndexInconsistency) {
+	for _, inconsistency := range inconsistencies {
+		var err error
+		switch inconsistency.Type {
+		case inconsistencyMetaWithoutFiles, inconsistencyRowCountDrift:
+			if err = i.metaTable.ResetMeta(inconsistency.BuildID); err == nil {
+				i.indexBuilder.enqueue(inconsistency.BuildID)
+			}
+		case inconsistencyFilesWithoutMeta:
+			err = i.chunkManager.RemoveWithPrefix(ctx, inconsistency.Detail)
+		}
+		if err != nil {
+			log.Warn("failed to repair index inconsistency", zap.String("type", inconsistency.Type),
+				zap.Int64("buildID", inconsistency.BuildID), zap.Error(err))
+			continue
+		}
+		inconsistency.Repaired = true
+	}
+	log.Info("index inconsistencies repaired", zap.Int("num", len(inconsistencies)))
+}
+
+// CheckIndexConsistency checks the finished segment indexes against the segments of DataCoord and the index files,
+// and repairs the inconsistencies found if asked.
+func (i *IndexCoord) CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error) {
+	log.Info("IndexCoord CheckIndexConsistency", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Bool("repair", req.GetRepair()))
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.CheckIndexConsistencyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+
+	inconsistencies, err := i.checkIndexConsistency(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("IndexCoord CheckIndexConsistency failed", zap.Error(err))
+		return &indexpb.CheckIndexConsistencyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if req.GetRepair() {
+		i.repairIndexInconsistencies(ctx, inconsistencies)
+	}
+	return &indexpb.CheckIndexConsistencyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Inconsistencies: inconsistencies,
+	}, nil
+}
@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestIndexCoord_IndexConsistency(t *testing.T) {
+	ctx := context.Background()
+	orphanKey := "index_files/600/"
+	newIndexCoord := func() (*IndexCoord, map[string]struct{}) {
+		removed := make(map[string]struct{})
+		ic := &IndexCoord{
+			session:   &sessionutil.Session{ServerID: 1},
+			metaTable: constructMetaTable(&indexcoord.Catalog{Txn: NewMockEtcdKV()}),
+			chunkManager: &chunkManagerMock{
+				exist: func(s string) (bool, error) {
+					return !strings.HasSuffix(s, "file2"), nil
+				},
+				listWithPrefix: func(s string, b bool) ([]string, []time.Time, error) {
+					return []string{"index_files/500/", orphanKey}, nil, nil
+				},
+				removeWithPrefix: func(s string) error {
+					removed[s] = struct{}{}
+					return nil
+				},
+			},
+			dataCoordClient: NewDataCoordMock(),
+		}
+		ic.indexBuilder = newIndexBuilder(ctx, ic, ic.metaTable, nil)
+		ic.stateCode.Store(commonpb.StateCode_Healthy)
+		return ic, removed
+	}
+
+	t.Run("check", func(t *testing.T) {
+		ic, _ := newIndexCoord()
+		inconsistencies, err := ic.checkIndexConsistency(ctx, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(inconsistencies))
+		assert.Equal(t, inconsistencyMetaWithoutFiles, inconsistencies[0].Type)
+		assert.Equal(t, buildID, inconsistencies[0].BuildID)
+		// the segment has 1026 rows in the datacoord mock
+		assert.Equal(t, inconsistencyRowCountDrift, inconsistencies[1].Type)
+		assert.Equal(t, segID, inconsistencies[1].SegmentID)
+		assert.Equal(t, inconsistencyFilesWithoutMeta, inconsistencies[2].Type)
+		assert.Equal(t, UniqueID(600), inconsistencies[2].BuildID)
+
+		// the orphan files are not checked for a collection
+		inconsistencies, err = ic.checkIndexConsistency(ctx, collID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(inconsistencies))
+		inconsistencies, err = ic.checkIndexConsistency(ctx, collID+1)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(inconsistencies))
+	})
+
+	t.Run("check failed", func(t *testing.T) {
+		ic, _ := newIndexCoord()
+		ic.chunkManager.(*chunkManagerMock).exist = func(s string) (bool, error) {
+			return false, errors.New("mock error")
+		}
+		_, err := ic.checkIndexConsistency(ctx, 0)
+		assert.Error(t, err)
+
+		ic, _ = newIndexCoord()
+		ic.dataCoordClient.(*DataCoordMock).CallGetSegmentInfo = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+			return &datapb.GetSegmentInfoResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock error"},
+			}, nil
+		}
+		_, err = ic.checkIndexConsistency(ctx, 0)
+		assert.Error(t, err)
+
+		ic, _ = newIndexCoord()
+		ic.chunkManager.(*chunkManagerMock).listWithPrefix = func(s string, b bool) ([]string, []time.Time, error) {
+			return nil, nil, errors.New("mock error")
+		}
+		_, err = ic.checkIndexConsistency(ctx, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		ic, removed := newIndexCoord()
+		resp, err := ic.CheckIndexConsistency(ctx, &indexpb.CheckIndexConsistencyRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetInconsistencies()))
+		assert.False(t, resp.GetInconsistencies()[0].GetRepaired())
+
+		resp, err = ic.CheckIndexConsistency(ctx, &indexpb.CheckIndexConsistencyRequest{Repair: true})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 3, len(resp.GetInconsistencies()))
+		for _, inconsistency := range resp.GetInconsistencies() {
+			assert.True(t, inconsistency.GetRepaired())
+		}
+		segIdx, _ := ic.metaTable.GetMeta(buildID)
+		assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+		_, ok := ic.indexBuilder.tasks[buildID]
+		assert.True(t, ok)
+		assert.Contains(t, removed, orphanKey)
+
+		ic.chunkManager.(*chunkManagerMock).listWithPrefix = func(s string, b bool) ([]string, []time.Time, error) {
+			return nil, nil, errors.New("mock error")
+		}
+		resp, err = ic.CheckIndexConsistency(ctx, &indexpb.CheckIndexConsistencyRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		ic.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err = ic.CheckIndexConsistency(ctx, &indexpb.CheckIndexConsistencyRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
@@ -40,7 +40,6 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
@@ -60,6 +59,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -107,8 +107,11 @@ type IndexCoord struct {
 
 	reqTimeoutInterval time.Duration
 
-	dataCoordClient types.DataCoord
-	rootCoordClient types.RootCoord
+	dataCoordClient  types.DataCoord
+	rootCoordClient  types.RootCoord
+	queryCoordClient types.QueryCoord
+
+	indexTTL *indexTTLReaper
 
 	enableActiveStandBy bool
 	activateFunc        func()
@@ -199,6 +202,15 @@ func (i *IndexCoord) Init() error {
 
 		log.Info("IndexCoord try to connect etcd success")
 		i.nodeManager = NewNodeManager(i.loopCtx)
+		if err = i.nodeManager.loadCordonedNodes(i.etcdKV); err != nil {
+			log.Error("IndexCoord load cordoned IndexNodes failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		i.indexTTL = newIndexTTLReaper(i.etcdKV, i.metaTable)
+		if err = i.indexTTL.load(); err != nil {
+			log.Warn("IndexCoord load index ttl records failed", zap.Error(err))
+		}
 
 		sessions, revision, err := i.session.GetSessions(typeutil.IndexNodeRole)
 		log.Info("IndexCoord", zap.Int("session number", len(sessions)), zap.Int64("revision", revision))
@@ -282,6 +294,12 @@ func (i *IndexCoord) Start() error {
 		i.loopWg.Add(1)
 		go i.watchFlushedSegmentLoop()
 
+		i.loopWg.Add(1)
+		go i.indexTTLLoop()
+
+		i.loopWg.Add(1)
+		go i.indexCoverageLoop()
+
 		startErr = i.sched.Start()
 
 		i.indexBuilder.Start()
@@ -367,6 +385,16 @@ func (i *IndexCoord) SetDataCoord(dataCoord types.DataCoord) error {
 	return nil
 }
 
+// SetQueryCoord sets query coordinator's client, the loaded collections are queried to track the index usage
+func (i *IndexCoord) SetQueryCoord(queryCoord types.QueryCoord) error {
+	if queryCoord == nil {
+		return errors.New("null QueryCoord interface")
+	}
+
+	i.queryCoordClient = queryCoord
+	return nil
+}
+
 // SetRootCoord sets data coordinator's client
 func (i *IndexCoord) SetRootCoord(rootCoord types.RootCoord) error {
 	if rootCoord == nil {
@@ -641,36 +669,6 @@ func (i *IndexCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.Get
 		}, nil
 	}
 
-	flushSegments, err := i.dataCoordClient.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
-		CollectionID:     req.CollectionID,
-		PartitionID:      -1,
-		IncludeUnhealthy: false,
-	})
-	if err != nil {
-		return &indexpb.GetIndexBuildProgressResponse{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			},
-		}, err
-	}
-
-	resp, err := i.dataCoordClient.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
-		SegmentIDs:       flushSegments.Segments,
-		IncludeUnHealthy: true,
-	})
-	if err != nil {
-		return &indexpb.GetIndexBuildProgressResponse{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			},
-		}, err
-	}
-	totalRows, indexRows := int64(0), int64(0)
-
-	for _, seg := range resp.Infos {
-		totalRows += seg.NumOfRows
-	}
-
 	indexID2CreateTs := i.metaTable.GetIndexIDByName(req.CollectionID, req.IndexName)
 	if len(indexID2CreateTs) == 0 {
 		errMsg := fmt.Sprintf("there is no index on collection: %d with the index name: %s", req.CollectionID, req.IndexName)
@@ -683,15 +681,43 @@ func (i *IndexCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.Get
 			},
 		}, nil
 	}
-
-	for indexID := range indexID2CreateTs {
-		indexRows = i.metaTable.GetIndexBuildProgress(indexID, flushSegments.Segments)
+	var indexID UniqueID
+	for indexID = range indexID2CreateTs {
 		break
 	}
 
+	// sum the rows of the flushed segments page by page
+	totalRows, indexRows, segNum := int64(0), int64(0), 0
+	err := segmentutil.WalkFlushedSegments(ctx, i.dataCoordClient, &datapb.GetFlushedSegmentsRequest{
+		CollectionID:     req.CollectionID,
+		PartitionID:      -1,
+		IncludeUnhealthy: false,
+	}, segmentutil.DefaultFlushedSegmentsPageSize, func(flushSegments []int64) error {
+		resp, err := i.dataCoordClient.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			SegmentIDs:       flushSegments,
+			IncludeUnHealthy: true,
+		})
+		if err != nil {
+			return err
+		}
+		for _, seg := range resp.Infos {
+			totalRows += seg.NumOfRows
+		}
+		indexRows += i.metaTable.GetIndexBuildProgress(indexID, flushSegments)
+		segNum += len(flushSegments)
+		return nil
+	})
+	if err != nil {
+		return &indexpb.GetIndexBuildProgressResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, err
+	}
+
 	log.RatedInfo(5, "IndexCoord get index build progress success", zap.Int64("collID", req.CollectionID),
 		zap.Int64("totalRows", totalRows), zap.Int64("indexRows", indexRows),
-		zap.Int("seg num", len(flushSegments.Segments)))
+		zap.Int("seg num", segNum))
 	return &indexpb.GetIndexBuildProgressResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -948,14 +974,7 @@ func (i *IndexCoord) ShowConfigurations(ctx context.Context, req *internalpb.Sho
 		}, nil
 	}
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "indexcoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "indexcoord", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -1039,14 +1058,14 @@ func (i *IndexCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthR
 	}
 
 	mu := &sync.Mutex{}
-	group, ctx := errgroup.WithContext(ctx)
+	group, groupCtx := errgroup.WithContext(ctx)
 	errReasons := make([]string, 0, len(i.nodeManager.GetAllClients()))
 
 	for nodeID, indexClient := range i.nodeManager.GetAllClients() {
 		nodeID := nodeID
 		indexClient := indexClient
 		group.Go(func() error {
-			sta, err := indexClient.GetComponentStates(ctx)
+			sta, err := indexClient.GetComponentStates(groupCtx)
 			isHealthy, reason := errorutil.UnHealthReasonWithComponentStatesOrErr("indexnode", nodeID, sta, err)
 			if !isHealthy {
 				mu.Lock()
@@ -1058,6 +1077,7 @@ func (i *IndexCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthR
 	}
 
 	err := group.Wait()
+	errReasons = append(errReasons, i.probeHealth(ctx)...)
 	if err != nil || len(errReasons) != 0 {
 		log.RatedInfo(5, "IndexCoord CheckHealth successfully", zap.Bool("isHealthy", false))
 		return &milvuspb.CheckHealthResponse{IsHealthy: false, Reasons: errReasons}, nil
@@ -1107,7 +1127,14 @@ func (i *IndexCoord) watchNodeLoop() {
 					err := i.nodeManager.AddNode(serverID, event.Session.Address)
 					if err != nil {
 						log.Error("IndexCoord", zap.Any("Add IndexNode err", err))
+						return
+					}
+					// a cordoned node receives no builds, neither new nor rebalanced ones
+					if i.nodeManager.isCordoned(serverID) {
+						log.Info("IndexCoord the added IndexNode is cordoned", zap.Int64("serverID", serverID))
+						return
 					}
+					i.indexBuilder.rebalance(serverID)
 				}()
 				i.metricsCacheManager.InvalidateSystemInfoMetrics()
 			case sessionutil.SessionUpdateEvent:
@@ -1250,58 +1277,38 @@ func (i *IndexCoord) watchFlushedSegmentLoop() {
 	log.Info("IndexCoord watchFlushedSegmentLoop start...")
 	defer i.loopWg.Done()
 
-	watchChan := i.etcdKV.WatchWithRevision(util.FlushedSegmentPrefix, i.flushedSegmentWatcher.etcdRevision+1)
-	for {
-		select {
-		case <-i.loopCtx.Done():
-			log.Warn("IndexCoord context done, exit...")
-			return
-		case resp, ok := <-watchChan:
-			if !ok {
-				log.Warn("IndexCoord watch flush segments loop failed because watch channel closed")
-				return
-			}
-			if err := resp.Err(); err != nil {
-				log.Warn("IndexCoord watchFlushedSegmentLoo receive etcd compacted error")
-				if err == v3rpc.ErrCompacted {
-					err = i.flushedSegmentWatcher.reloadFromKV()
-					if err != nil {
-						log.Error("Constructing flushed segment watcher fails when etcd has a compaction error",
-							zap.String("etcd error", err.Error()), zap.Error(err))
-						panic("failed to handle etcd request, exit..")
-					}
-					i.loopWg.Add(1)
-					go i.watchFlushedSegmentLoop()
-					return
-				}
-				log.Error("received error event from flushed segment watcher",
-					zap.String("prefix", util.FlushedSegmentPrefix), zap.Error(err))
-				panic("failed to handle etcd request, exit..")
-			}
-			events := resp.Events
-			for _, event := range events {
-				switch event.Type {
-				case mvccpb.PUT:
-					segmentInfo := &datapb.SegmentInfo{}
-					if err := proto.Unmarshal(event.Kv.Value, segmentInfo); err != nil {
-						// just for  backward compatibility
-						segID, err := strconv.ParseInt(string(event.Kv.Value), 10, 64)
-						if err != nil {
-							log.Error("watchFlushedSegmentLoop unmarshal fail", zap.String("value", string(event.Kv.Value)), zap.Error(err))
-							continue
-						}
-						segmentInfo.ID = segID
-					}
+	relist := func() (int64, error) {
+		if err := i.flushedSegmentWatcher.reloadFromKV(); err != nil {
+			return 0, err
+		}
+		return i.flushedSegmentWatcher.etcdRevision, nil
+	}
+	watch := newResumableWatch(i.loopCtx, i.etcdKV, util.FlushedSegmentPrefix, i.flushedSegmentWatcher.etcdRevision+1,
+		relist, i.handleFlushedSegmentEvent)
+	watch.run()
+	log.Warn("IndexCoord context done, exit...")
+}
 
-					log.Info("watchFlushedSegmentLoop watch event",
-						zap.Int64("segID", segmentInfo.GetID()),
-						zap.Any("isFake", segmentInfo.GetIsFake()))
-					i.flushedSegmentWatcher.enqueueInternalTask(segmentInfo)
-				case mvccpb.DELETE:
-					log.Info("the segment info has been deleted", zap.String("key", string(event.Kv.Key)))
-				}
+func (i *IndexCoord) handleFlushedSegmentEvent(event *clientv3.Event) {
+	switch event.Type {
+	case mvccpb.PUT:
+		segmentInfo := &datapb.SegmentInfo{}
+		if err := proto.Unmarshal(event.Kv.Value, segmentInfo); err != nil {
+			// just for  backward compatibility
+			segID, err := strconv.ParseInt(string(event.Kv.Value), 10, 64)
+			if err != nil {
+				log.Error("watchFlushedSegmentLoop unmarshal fail", zap.String("value", string(event.Kv.Value)), zap.Error(err))
+				return
 			}
+			segmentInfo.ID = segID
 		}
+
+		log.Info("watchFlushedSegmentLoop watch event",
+			zap.Int64("segID", segmentInfo.GetID()),
+			zap.Any("isFake", segmentInfo.GetIsFake()))
+		i.flushedSegmentWatcher.enqueueInternalTask(segmentInfo)
+	case mvccpb.DELETE:
+		log.Info("the segment info has been deleted", zap.String("key", string(event.Kv.Key)))
 	}
 }
 
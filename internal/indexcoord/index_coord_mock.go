@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -51,6 +52,7 @@ type Mock struct {
 	CallSetEtcdClient   func(etcdClient *clientv3.Client)
 	CallSetDataCoord    func(dataCoord types.DataCoord) error
 	CallSetRootCoord    func(rootCoord types.RootCoord) error
+	CallSetQueryCoord   func(queryCoord types.QueryCoord) error
 	CallUpdateStateCode func(stateCode commonpb.StateCode)
 
 	CallCreateIndex           func(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
@@ -100,6 +102,10 @@ func (m *Mock) SetRootCoord(rootCoord types.RootCoord) error {
 	return m.CallSetRootCoord(rootCoord)
 }
 
+func (m *Mock) SetQueryCoord(queryCoord types.QueryCoord) error {
+	return m.CallSetQueryCoord(queryCoord)
+}
+
 func (m *Mock) UpdateStateCode(stateCode commonpb.StateCode) {
 	m.CallUpdateStateCode(stateCode)
 }
@@ -180,6 +186,9 @@ func NewIndexCoordMock() *Mock {
 		CallSetRootCoord: func(rootCoord types.RootCoord) error {
 			return nil
 		},
+		CallSetQueryCoord: func(queryCoord types.QueryCoord) error {
+			return nil
+		},
 		CallGetComponentStates: func(ctx context.Context) (*milvuspb.ComponentStates, error) {
 			return &milvuspb.ComponentStates{
 				State: &milvuspb.ComponentInfo{
@@ -506,6 +515,45 @@ func NewDataCoordMock() *DataCoordMock {
 	}
 }
 
+type QueryCoordMock struct {
+	types.QueryCoord
+
+	CallInit  func() error
+	CallStart func() error
+
+	CallShowCollections func(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error)
+}
+
+func (qcm *QueryCoordMock) Init() error {
+	return qcm.CallInit()
+}
+
+func (qcm *QueryCoordMock) Start() error {
+	return qcm.CallStart()
+}
+
+func (qcm *QueryCoordMock) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+	return qcm.CallShowCollections(ctx, req)
+}
+
+func NewQueryCoordMock() *QueryCoordMock {
+	return &QueryCoordMock{
+		CallInit: func() error {
+			return nil
+		},
+		CallStart: func() error {
+			return nil
+		},
+		CallShowCollections: func(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+			return &querypb.ShowCollectionsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			}, nil
+		},
+	}
+}
+
 type mockETCDKV struct {
 	kv.MetaKv
 
@@ -543,6 +591,9 @@ func NewMockEtcdKV() *mockETCDKV {
 		compareVersionAndSwap: func(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
 			return true, nil
 		},
+		loadWithPrefix: func(key string) ([]string, []string, error) {
+			return []string{}, []string{}, nil
+		},
 		loadWithPrefix2: func(key string) ([]string, []string, []int64, error) {
 			return []string{}, []string{}, []int64{}, nil
 		},
@@ -647,6 +698,7 @@ type chunkManagerMock struct {
 	removeWithPrefix func(string) error
 	listWithPrefix   func(string, bool) ([]string, []time.Time, error)
 	remove           func(string) error
+	exist            func(string) (bool, error)
 }
 
 func (cmm *chunkManagerMock) RootPath() string {
@@ -664,3 +716,7 @@ func (cmm *chunkManagerMock) ListWithPrefix(ctx context.Context, prefix string,
 func (cmm *chunkManagerMock) Remove(ctx context.Context, key string) error {
 	return cmm.remove(key)
 }
+
+func (cmm *chunkManagerMock) Exist(ctx context.Context, filePath string) (bool, error) {
+	return cmm.exist(filePath)
+}
@@ -281,10 +281,16 @@ func testIndexCoord(t *testing.T) {
 	}
 
 	t.Run("DescribeIndex NotExist", func(t *testing.T) {
-		indexs := ic.metaTable.collectionIndexes
-		ic.metaTable.collectionIndexes = make(map[UniqueID]map[UniqueID]*model.Index)
+		updateCollectionIndexes := func(i map[UniqueID]map[UniqueID]*model.Index) {
+			ic.metaTable.indexLock.Lock()
+			ic.metaTable.collectionIndexes = i
+			ic.metaTable.publishIndexSnapshot()
+			ic.metaTable.indexLock.Unlock()
+		}
+		indexs := ic.metaTable.loadIndexSnapshot().collectionIndexes
+		updateCollectionIndexes(make(map[UniqueID]map[UniqueID]*model.Index))
 		defer func() {
-			ic.metaTable.collectionIndexes = indexs
+			updateCollectionIndexes(indexs)
 		}()
 
 		resp, err := ic.DescribeIndex(ctx, getReq())
@@ -302,14 +308,15 @@ func testIndexCoord(t *testing.T) {
 		}
 
 		updateSegmentIndexes := func(i map[UniqueID]map[UniqueID]*model.SegmentIndex) {
-			ic.metaTable.indexLock.Lock()
+			ic.metaTable.segmentIndexLock.Lock()
 			ic.metaTable.segmentIndexes = i
-			ic.metaTable.indexLock.Unlock()
+			ic.metaTable.publishSegmentIndexSnapshot()
+			ic.metaTable.segmentIndexLock.Unlock()
 		}
 
 		getSegmentIndexes := func() map[UniqueID]map[UniqueID]*model.SegmentIndex {
-			ic.metaTable.indexLock.RLock()
-			defer ic.metaTable.indexLock.RUnlock()
+			ic.metaTable.segmentIndexLock.RLock()
+			defer ic.metaTable.segmentIndexLock.RUnlock()
 			return ic.metaTable.segmentIndexes
 		}
 
@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// indexCoverage is how far the flushed segments of a collection are covered by its indexes.
+// The segments flushed but not yet seen by the flushed segment watcher have no segment index and are not counted.
+type indexCoverage struct {
+	// TotalRows and IndexedRows sum the rows of the segment indexes, a segment is counted once per index
+	TotalRows   int64
+	IndexedRows int64
+	// UnindexedSegments is the number of the segments with a segment index not finished
+	UnindexedSegments int
+	// OldestUnindexed is the create time of the oldest segment index not finished, zero if all finished
+	OldestUnindexed time.Time
+}
+
+// IndexedRowsRatio returns the ratio of the rows indexed, 1 if there are no rows to index.
+func (c *indexCoverage) IndexedRowsRatio() float64 {
+	if c.TotalRows == 0 {
+		return 1
+	}
+	return float64(c.IndexedRows) / float64(c.TotalRows)
+}
+
+// GetIndexCoverage returns the index coverage of every collection with indexes not deleted.
+func (mt *metaTable) GetIndexCoverage() map[UniqueID]*indexCoverage {
+	coverages := make(map[UniqueID]*indexCoverage)
+	indexes := make(map[UniqueID]struct{})
+	for collID, fieldIndexes := range mt.loadIndexSnapshot().collectionIndexes {
+		for indexID, index := range fieldIndexes {
+			if index.IsDeleted {
+				continue
+			}
+			indexes[indexID] = struct{}{}
+			coverages[collID] = &indexCoverage{}
+		}
+	}
+
+	snapshot := mt.loadSegmentIndexSnapshot()
+	for _, shard := range snapshot.segmentIndexes {
+		for _, segIndexes := range shard {
+			unindexed := false
+			var coverage *indexCoverage
+			var oldest time.Time
+			for indexID, segIdx := range segIndexes {
+				if _, ok := indexes[indexID]; !ok || segIdx.IsDeleted {
+					continue
+				}
+				if coverage = coverages[segIdx.CollectionID]; coverage == nil {
+					continue
+				}
+				coverage.TotalRows += segIdx.NumRows
+				if segIdx.IndexState == commonpb.IndexState_Finished {
+					coverage.IndexedRows += segIdx.NumRows
+					continue
+				}
+				unindexed = true
+				if segIdx.CreateTime == 0 {
+					continue
+				}
+				if createTime, _ := tsoutil.ParseTS(segIdx.CreateTime); oldest.IsZero() || createTime.Before(oldest) {
+					oldest = createTime
+				}
+			}
+			if !unindexed {
+				continue
+			}
+			coverage.UnindexedSegments++
+			if !oldest.IsZero() && (coverage.OldestUnindexed.IsZero() || oldest.Before(coverage.OldestUnindexed)) {
+				coverage.OldestUnindexed = oldest
+			}
+		}
+	}
+	return coverages
+}
+
+// updateIndexCoverageMetrics sets the index coverage gauges of the collections with indexes.
+func (mt *metaTable) updateIndexCoverageMetrics(now time.Time) {
+	for collID, coverage := range mt.GetIndexCoverage() {
+		collection := strconv.FormatInt(collID, 10)
+		metrics.IndexCoordIndexedRowsRatio.WithLabelValues(collection).Set(coverage.IndexedRowsRatio())
+		metrics.IndexCoordUnindexedSegmentNum.WithLabelValues(collection).Set(float64(coverage.UnindexedSegments))
+		var age float64
+		if !coverage.OldestUnindexed.IsZero() && now.After(coverage.OldestUnindexed) {
+			age = now.Sub(coverage.OldestUnindexed).Seconds()
+		}
+		metrics.IndexCoordOldestUnindexedSegmentAge.WithLabelValues(collection).Set(age)
+	}
+}
+
+// deleteIndexCoverageMetrics removes the index coverage gauges of the collection without indexes.
+func deleteIndexCoverageMetrics(collID UniqueID) {
+	collection := strconv.FormatInt(collID, 10)
+	metrics.IndexCoordIndexedRowsRatio.DeleteLabelValues(collection)
+	metrics.IndexCoordUnindexedSegmentNum.DeleteLabelValues(collection)
+	metrics.IndexCoordOldestUnindexedSegmentAge.DeleteLabelValues(collection)
+}
+
+// indexCoverageLoop refreshes the index coverage metrics periodically, so that the age of the oldest segment
+// not indexed keeps growing while the meta does not change.
+func (i *IndexCoord) indexCoverageLoop() {
+	defer i.loopWg.Done()
+	log.Info("IndexCoord indexCoverageLoop start")
+
+	ticker := time.NewTicker(Params.IndexCoordCfg.CoverageMetricsInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-i.loopCtx.Done():
+			log.Info("IndexCoord indexCoverageLoop exit")
+			return
+		case <-ticker.C:
+			if i.isHealthy() {
+				i.metaTable.updateIndexCoverageMetrics(time.Now())
+			}
+		}
+	}
+}
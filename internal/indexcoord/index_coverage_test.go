@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestMetaTable_IndexCoverage(t *testing.T) {
+	now := time.Now()
+	segIndex := func(segmentID, indexID, numRows int64, state commonpb.IndexState, created time.Time) *model.SegmentIndex {
+		return &model.SegmentIndex{
+			SegmentID:    segmentID,
+			CollectionID: collID,
+			IndexID:      indexID,
+			NumRows:      numRows,
+			IndexState:   state,
+			CreateTime:   tsoutil.ComposeTSByTime(created, 0),
+		}
+	}
+	deleted := segIndex(segID+3, indexID, 1000, commonpb.IndexState_Unissued, now.Add(-2*time.Hour))
+	deleted.IsDeleted = true
+	mt := &metaTable{
+		collectionIndexes: map[UniqueID]map[UniqueID]*model.Index{
+			collID: {
+				indexID:     {CollectionID: collID, IndexID: indexID},
+				indexID + 1: {CollectionID: collID, IndexID: indexID + 1, IsDeleted: true},
+			},
+			collID + 1: {
+				indexID + 2: {CollectionID: collID + 1, IndexID: indexID + 2},
+			},
+		},
+		segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
+			segID: {
+				indexID:     segIndex(segID, indexID, 1000, commonpb.IndexState_Finished, now.Add(-3*time.Hour)),
+				indexID + 1: segIndex(segID, indexID+1, 1000, commonpb.IndexState_InProgress, now.Add(-3*time.Hour)),
+			},
+			segID + 1: {
+				indexID: segIndex(segID+1, indexID, 2000, commonpb.IndexState_InProgress, now.Add(-time.Hour)),
+			},
+			segID + 2: {
+				indexID: segIndex(segID+2, indexID, 1000, commonpb.IndexState_Failed, now.Add(-10*time.Minute)),
+			},
+			segID + 3: {
+				indexID: deleted,
+			},
+		},
+	}
+
+	coverages := mt.GetIndexCoverage()
+	require.Equal(t, 2, len(coverages))
+	coverage := coverages[collID]
+	assert.Equal(t, int64(4000), coverage.TotalRows)
+	assert.Equal(t, int64(1000), coverage.IndexedRows)
+	assert.Equal(t, 0.25, coverage.IndexedRowsRatio())
+	assert.Equal(t, 2, coverage.UnindexedSegments)
+	assert.Equal(t, now.Add(-time.Hour).UnixMilli(), coverage.OldestUnindexed.UnixMilli())
+	assert.Equal(t, &indexCoverage{}, coverages[collID+1])
+	assert.Equal(t, 1.0, coverages[collID+1].IndexedRowsRatio())
+
+	mt.updateIndexCoverageMetrics(now)
+	collection := strconv.FormatInt(collID, 10)
+	assert.Equal(t, 0.25, testutil.ToFloat64(metrics.IndexCoordIndexedRowsRatio.WithLabelValues(collection)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.IndexCoordUnindexedSegmentNum.WithLabelValues(collection)))
+	assert.InDelta(t, time.Hour.Seconds(), testutil.ToFloat64(metrics.IndexCoordOldestUnindexedSegmentAge.WithLabelValues(collection)), 1)
+	empty := strconv.FormatInt(collID+1, 10)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.IndexCoordIndexedRowsRatio.WithLabelValues(empty)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.IndexCoordOldestUnindexedSegmentAge.WithLabelValues(empty)))
+
+	deleteIndexCoverageMetrics(collID)
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.IndexCoordIndexedRowsRatio))
+}
@@ -0,0 +1,292 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// indexBuildStatisticsPrefix is the etcd prefix of the daily build statistics, one key per index.
+// It must not share a prefix with util.SegmentIndexPrefix or util.FieldIndexPrefix.
+const indexBuildStatisticsPrefix = "indexcoord-build-statistics"
+
+const statisticsDay = 24 * time.Hour
+
+// buildStatisticsBucket counts the index builds finished in a day (UTC).
+type buildStatisticsBucket struct {
+	// Day is the unix seconds of the start of the day
+	Day            int64  `json:"day"`
+	Completed      int64  `json:"completed"`
+	Failed         int64  `json:"failed"`
+	TotalLatencyMs int64  `json:"total_latency_ms"`
+	LatencySamples int64  `json:"latency_samples"`
+	IndexBytes     uint64 `json:"index_bytes"`
+}
+
+// indexBuildStatistics is the daily build buckets of an index, sorted by day.
+type indexBuildStatistics struct {
+	CollectionID UniqueID                 `json:"collection_id"`
+	IndexID      UniqueID                 `json:"index_id"`
+	Buckets      []*buildStatisticsBucket `json:"buckets"`
+}
+
+func buildStatisticsKey(collectionID, indexID UniqueID) string {
+	return path.Join(indexBuildStatisticsPrefix, strconv.FormatInt(collectionID, 10), strconv.FormatInt(indexID, 10))
+}
+
+func statisticsDayOf(t time.Time) int64 {
+	return t.UTC().Truncate(statisticsDay).Unix()
+}
+
+// buildStatisticsRecorder keeps the daily build buckets of the indexes, the buckets of an index are written
+// through to etcd whenever a build of the index finishes, and the buckets older than
+// indexCoord.statistics.retentionDays are dropped.
+// The build latency is measured from the build dispatched to an IndexNode in this IndexCoord,
+// the builds in progress when IndexCoord restarts are counted without latency.
+type buildStatisticsRecorder struct {
+	kv kv.MetaKv
+
+	mu         sync.Mutex
+	stats      map[UniqueID]*indexBuildStatistics
+	buildStart map[UniqueID]time.Time
+}
+
+func newBuildStatisticsRecorder(kv kv.MetaKv) *buildStatisticsRecorder {
+	return &buildStatisticsRecorder{
+		kv:         kv,
+		stats:      make(map[UniqueID]*indexBuildStatistics),
+		buildStart: make(map[UniqueID]time.Time),
+	}
+}
+
+// load reloads the build statistics from etcd, the invalid records are skipped,
+// the statistics are best effort and must never block IndexCoord from starting.
+func (r *buildStatisticsRecorder) load() error {
+	keys, values, err := r.kv.LoadWithPrefix(indexBuildStatisticsPrefix)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, value := range values {
+		stats := &indexBuildStatistics{}
+		if err := json.Unmarshal([]byte(value), stats); err != nil {
+			log.Warn("IndexCoord skip invalid index build statistics", zap.String("key", keys[i]), zap.Error(err))
+			continue
+		}
+		r.stats[stats.IndexID] = stats
+	}
+	return nil
+}
+
+// recordBuildStart records the time the build is dispatched to an IndexNode.
+func (r *buildStatisticsRecorder) recordBuildStart(buildID UniqueID, now time.Time) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.buildStart[buildID] = now
+}
+
+// recordBuildFinish counts the build finished or failed in the bucket of the day and persists the index statistics.
+func (r *buildStatisticsRecorder) recordBuildFinish(segIdx *model.SegmentIndex, state commonpb.IndexState, indexSize uint64, now time.Time) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	start, hasStart := r.buildStart[segIdx.BuildID]
+	delete(r.buildStart, segIdx.BuildID)
+
+	stats, ok := r.stats[segIdx.IndexID]
+	if !ok {
+		stats = &indexBuildStatistics{CollectionID: segIdx.CollectionID, IndexID: segIdx.IndexID}
+		r.stats[segIdx.IndexID] = stats
+	}
+	day := statisticsDayOf(now)
+	var bucket *buildStatisticsBucket
+	for i := len(stats.Buckets) - 1; i >= 0 && bucket == nil; i-- {
+		if stats.Buckets[i].Day == day {
+			bucket = stats.Buckets[i]
+		}
+	}
+	if bucket == nil {
+		bucket = &buildStatisticsBucket{Day: day}
+		stats.Buckets = append(stats.Buckets, bucket)
+		sort.Slice(stats.Buckets, func(i, j int) bool { return stats.Buckets[i].Day < stats.Buckets[j].Day })
+	}
+
+	switch state {
+	case commonpb.IndexState_Finished:
+		bucket.Completed++
+		bucket.IndexBytes += indexSize
+		if hasStart {
+			bucket.TotalLatencyMs += now.Sub(start).Milliseconds()
+			bucket.LatencySamples++
+		}
+	case commonpb.IndexState_Failed:
+		bucket.Failed++
+	}
+
+	oldest := day - int64(Params.IndexCoordCfg.StatisticsRetentionDays.GetAsInt()-1)*int64(statisticsDay.Seconds())
+	for len(stats.Buckets) > 0 && stats.Buckets[0].Day < oldest {
+		stats.Buckets = stats.Buckets[1:]
+	}
+
+	value, err := json.Marshal(stats)
+	if err != nil {
+		log.Warn("IndexCoord marshal index build statistics fail", zap.Int64("indexID", segIdx.IndexID), zap.Error(err))
+		return
+	}
+	if err := r.kv.Save(buildStatisticsKey(stats.CollectionID, stats.IndexID), string(value)); err != nil {
+		log.Warn("IndexCoord save index build statistics fail", zap.Int64("indexID", segIdx.IndexID), zap.Error(err))
+	}
+}
+
+// get returns a copy of the buckets of the index since the day.
+func (r *buildStatisticsRecorder) get(indexID UniqueID, since int64) []*buildStatisticsBucket {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats, ok := r.stats[indexID]
+	if !ok {
+		return nil
+	}
+	buckets := make([]*buildStatisticsBucket, 0, len(stats.Buckets))
+	for _, bucket := range stats.Buckets {
+		if bucket.Day >= since {
+			b := *bucket
+			buckets = append(buckets, &b)
+		}
+	}
+	return buckets
+}
+
+func ratio(a, b int64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+// GetIndexStatistics returns the build statistics of the indexes not deleted over the last days,
+// zero collectionID or indexID means all.
+func (mt *metaTable) GetIndexStatistics(collectionID, indexID UniqueID, days int, now time.Time) []*indexpb.IndexStatistics {
+	since := statisticsDayOf(now) - int64(days-1)*int64(statisticsDay.Seconds())
+
+	indexSizes := make(map[UniqueID]uint64)
+	for _, shard := range mt.loadSegmentIndexSnapshot().segmentIndexes {
+		for _, segIndexes := range shard {
+			for _, segIdx := range segIndexes {
+				if !segIdx.IsDeleted && segIdx.IndexState == commonpb.IndexState_Finished {
+					indexSizes[segIdx.IndexID] += segIdx.IndexSize
+				}
+			}
+		}
+	}
+
+	ret := make([]*indexpb.IndexStatistics, 0)
+	for collID, indexes := range mt.loadIndexSnapshot().collectionIndexes {
+		if collectionID != 0 && collID != collectionID {
+			continue
+		}
+		for _, index := range indexes {
+			if index.IsDeleted || (indexID != 0 && index.IndexID != indexID) {
+				continue
+			}
+			stats := &indexpb.IndexStatistics{
+				CollectionID:    collID,
+				IndexID:         index.IndexID,
+				IndexName:       index.IndexName,
+				TotalIndexBytes: indexSizes[index.IndexID],
+				Daily:           make([]*indexpb.DailyIndexStatistics, 0),
+			}
+			var latencyMs, samples int64
+			for _, bucket := range mt.buildStatistics.get(index.IndexID, since) {
+				stats.Completed += bucket.Completed
+				stats.Failed += bucket.Failed
+				latencyMs += bucket.TotalLatencyMs
+				samples += bucket.LatencySamples
+				stats.Daily = append(stats.Daily, &indexpb.DailyIndexStatistics{
+					Date:              time.Unix(bucket.Day, 0).UTC().Format("2006-01-02"),
+					Completed:         bucket.Completed,
+					Failed:            bucket.Failed,
+					FailureRate:       ratio(bucket.Failed, bucket.Completed+bucket.Failed),
+					AvgBuildLatencyMs: ratio(bucket.TotalLatencyMs, bucket.LatencySamples),
+					IndexBytes:        bucket.IndexBytes,
+				})
+			}
+			stats.FailureRate = ratio(stats.Failed, stats.Completed+stats.Failed)
+			stats.AvgBuildLatencyMs = ratio(latencyMs, samples)
+			ret = append(ret, stats)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].IndexID < ret[j].IndexID })
+	return ret
+}
+
+// GetIndexStatistics returns the build statistics of the indexes over the last days, indexCoord.statistics.retentionDays
+// by default.
+func (i *IndexCoord) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.GetIndexStatisticsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	days := int(req.GetDays())
+	if days < 0 {
+		return &indexpb.GetIndexStatisticsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    fmt.Sprintf("invalid days: %d", days),
+			},
+		}, nil
+	}
+	if days == 0 {
+		days = Params.IndexCoordCfg.StatisticsRetentionDays.GetAsInt()
+	}
+	return &indexpb.GetIndexStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Statistics: i.metaTable.GetIndexStatistics(req.GetCollectionID(), req.GetIndexID(), days, time.Now()),
+	}, nil
+}
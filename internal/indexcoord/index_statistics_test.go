@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func newStatisticsTestKV() (*mockETCDKV, map[string]string) {
+	saved := make(map[string]string)
+	kv := NewMockEtcdKV()
+	kv.save = func(key string, value string) error {
+		saved[key] = value
+		return nil
+	}
+	kv.loadWithPrefix = func(prefix string) ([]string, []string, error) {
+		keys, values := make([]string, 0), make([]string, 0)
+		for k, v := range saved {
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+		return keys, values, nil
+	}
+	return kv, saved
+}
+
+func TestBuildStatisticsRecorder(t *testing.T) {
+	kv, saved := newStatisticsTestKV()
+	r := newBuildStatisticsRecorder(kv)
+	segIdx := &model.SegmentIndex{CollectionID: collID, IndexID: indexID, BuildID: buildID}
+
+	day := time.Date(2023, 1, 10, 8, 0, 0, 0, time.UTC)
+	r.recordBuildStart(buildID, day)
+	r.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 100, day.Add(2*time.Second))
+	r.recordBuildFinish(segIdx, commonpb.IndexState_Failed, 0, day.Add(time.Hour))
+	// no start time recorded
+	r.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 50, day.Add(2*time.Hour))
+	r.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 10, day.Add(statisticsDay))
+
+	buckets := r.get(indexID, 0)
+	require.Equal(t, 2, len(buckets))
+	assert.Equal(t, &buildStatisticsBucket{
+		Day:            statisticsDayOf(day),
+		Completed:      2,
+		Failed:         1,
+		TotalLatencyMs: 2000,
+		LatencySamples: 1,
+		IndexBytes:     150,
+	}, buckets[0])
+	assert.Equal(t, int64(1), buckets[1].Completed)
+	assert.Equal(t, 1, len(r.get(indexID, statisticsDayOf(day.Add(statisticsDay)))))
+	assert.Nil(t, r.get(indexID+1, 0))
+	assert.Contains(t, saved, buildStatisticsKey(collID, indexID))
+
+	t.Run("reload", func(t *testing.T) {
+		saved["indexcoord-build-statistics/invalid"] = "invalid"
+		reloaded := newBuildStatisticsRecorder(kv)
+		require.NoError(t, reloaded.load())
+		assert.Equal(t, buckets, reloaded.get(indexID, 0))
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		paramtable.Get().Save(Params.IndexCoordCfg.StatisticsRetentionDays.Key, "2")
+		defer paramtable.Get().Reset(Params.IndexCoordCfg.StatisticsRetentionDays.Key)
+		r.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 10, day.Add(2*statisticsDay))
+		buckets := r.get(indexID, 0)
+		require.Equal(t, 2, len(buckets))
+		assert.Equal(t, statisticsDayOf(day.Add(statisticsDay)), buckets[0].Day)
+	})
+
+	t.Run("nil recorder", func(t *testing.T) {
+		var r *buildStatisticsRecorder
+		r.recordBuildStart(buildID, day)
+		r.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 10, day)
+		assert.Nil(t, r.get(indexID, 0))
+	})
+}
+
+func TestMetaTable_IndexStatistics(t *testing.T) {
+	kv, _ := newStatisticsTestKV()
+	mt := constructMetaTable(&indexcoord.Catalog{Txn: kv})
+	mt.buildStatistics = newBuildStatisticsRecorder(kv)
+
+	// the build of a flat index is not dispatched to an IndexNode
+	err := mt.FinishTask(&indexpb.IndexTaskInfo{BuildID: buildID, State: commonpb.IndexState_Finished, SerializedSize: 1024})
+	assert.NoError(t, err)
+	assert.Nil(t, mt.buildStatistics.get(indexID, 0))
+
+	now := time.Now()
+	segIdx := &model.SegmentIndex{CollectionID: collID, IndexID: indexID, BuildID: buildID + 1}
+	mt.buildStatistics.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 2048, now)
+	mt.buildStatistics.recordBuildFinish(segIdx, commonpb.IndexState_Failed, 0, now)
+	mt.buildStatistics.recordBuildFinish(segIdx, commonpb.IndexState_Finished, 2048, now.Add(-2*statisticsDay))
+
+	stats := mt.GetIndexStatistics(0, 0, 30, now)
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, indexName, stats[0].IndexName)
+	assert.Equal(t, uint64(1024), stats[0].TotalIndexBytes)
+	assert.Equal(t, int64(2), stats[0].Completed)
+	assert.Equal(t, int64(1), stats[0].Failed)
+	assert.InDelta(t, 1.0/3, stats[0].FailureRate, 1e-9)
+	assert.Equal(t, 2, len(stats[0].Daily))
+	assert.Equal(t, 0.5, stats[0].Daily[1].FailureRate)
+
+	stats = mt.GetIndexStatistics(collID, indexID, 1, now)
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, int64(1), stats[0].Completed)
+	assert.Equal(t, 0, len(mt.GetIndexStatistics(collID+1, 0, 1, now)))
+	assert.Equal(t, 0, len(mt.GetIndexStatistics(0, indexID+1, 1, now)))
+
+	t.Run("rpc", func(t *testing.T) {
+		ctx := context.Background()
+		ic := &IndexCoord{
+			session:   &sessionutil.Session{ServerID: 1},
+			metaTable: mt,
+		}
+		ic.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := ic.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{CollectionID: collID, Days: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		require.Equal(t, 1, len(resp.GetStatistics()))
+		assert.Equal(t, 1, len(resp.GetStatistics()[0].GetDaily()))
+
+		// the retention days by default
+		resp, err = ic.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{})
+		assert.NoError(t, err)
+		require.Equal(t, 1, len(resp.GetStatistics()))
+		assert.Equal(t, 2, len(resp.GetStatistics()[0].GetDaily()))
+
+		resp, err = ic.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{Days: -1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		ic.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err = ic.GetIndexStatistics(ctx, &indexpb.GetIndexStatisticsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
@@ -0,0 +1,446 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// indexTTLPrefix is the etcd prefix of the ttl records of the indexes, one key per index.
+// It must not share a prefix with util.SegmentIndexPrefix or util.FieldIndexPrefix.
+const indexTTLPrefix = "indexcoord-index-ttl"
+
+// indexUsageWriteInterval is the granularity of the last used time written to etcd,
+// so that the indexes of the loaded collections are not written in every check.
+const indexUsageWriteInterval = time.Hour
+
+const (
+	indexTTLActive    = "active"
+	indexTTLCandidate = "candidate"
+	indexTTLDropped   = "dropped"
+)
+
+var errIndexNotFound = errors.New("index not found")
+
+// indexTTLRecord is the ttl property of an index, the index is unused while its collection is not loaded
+// in QueryCoord, and becomes a drop candidate after unused for TTLDays.
+type indexTTLRecord struct {
+	CollectionID   UniqueID   `json:"collection_id"`
+	IndexID        UniqueID   `json:"index_id"`
+	TTLDays        int64      `json:"ttl_days"`
+	LastUsedAt     time.Time  `json:"last_used_at"`
+	CandidateSince *time.Time `json:"candidate_since,omitempty"`
+}
+
+func indexTTLKey(collectionID, indexID UniqueID) string {
+	return path.Join(indexTTLPrefix, strconv.FormatInt(collectionID, 10), strconv.FormatInt(indexID, 10))
+}
+
+// indexTTLState is the ttl state of an index reported by the index ttl rpcs.
+type indexTTLState struct {
+	CollectionID   UniqueID
+	IndexID        UniqueID
+	IndexName      string
+	TTLDays        int64
+	LastUsedAt     time.Time
+	IdleDays       float64
+	State          string
+	CandidateSince *time.Time
+}
+
+// indexTTLReaper flags the indexes unused longer than their ttl as candidates, and drops the candidates
+// reported in a previous check unless indexCoord.indexTTL.dryRun is set.
+type indexTTLReaper struct {
+	kv  kv.MetaKv
+	mt  *metaTable
+	now func() time.Time
+
+	mu      sync.Mutex
+	records map[UniqueID]*indexTTLRecord
+}
+
+func newIndexTTLReaper(kv kv.MetaKv, mt *metaTable) *indexTTLReaper {
+	return &indexTTLReaper{
+		kv:      kv,
+		mt:      mt,
+		now:     time.Now,
+		records: make(map[UniqueID]*indexTTLRecord),
+	}
+}
+
+// load reloads the ttl records from etcd, the invalid records are skipped.
+func (r *indexTTLReaper) load() error {
+	keys, values, err := r.kv.LoadWithPrefix(indexTTLPrefix)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, value := range values {
+		record := &indexTTLRecord{}
+		if err := json.Unmarshal([]byte(value), record); err != nil {
+			log.Warn("IndexCoord skip invalid index ttl record", zap.String("key", keys[i]), zap.Error(err))
+			continue
+		}
+		r.records[record.IndexID] = record
+	}
+	return nil
+}
+
+func (r *indexTTLReaper) saveLocked(record *indexTTLRecord) error {
+	bs, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if err := r.kv.Save(indexTTLKey(record.CollectionID, record.IndexID), string(bs)); err != nil {
+		return err
+	}
+	r.records[record.IndexID] = record
+	return nil
+}
+
+func (r *indexTTLReaper) removeLocked(record *indexTTLRecord) error {
+	if err := r.kv.Remove(indexTTLKey(record.CollectionID, record.IndexID)); err != nil {
+		return err
+	}
+	delete(r.records, record.IndexID)
+	return nil
+}
+
+// SetTTL sets the ttl of the index, 0 removes the ttl. The index is regarded as used when its ttl is set.
+func (r *indexTTLReaper) SetTTL(collectionID, indexID UniqueID, ttlDays int64) error {
+	if ttlDays < 0 {
+		return fmt.Errorf("invalid ttl days %d", ttlDays)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if ttlDays == 0 {
+		record, ok := r.records[indexID]
+		if !ok {
+			return nil
+		}
+		return r.removeLocked(record)
+	}
+	if r.mt.IsIndexDeleted(collectionID, indexID) {
+		return fmt.Errorf("%w: collection %d, index %d", errIndexNotFound, collectionID, indexID)
+	}
+	record := &indexTTLRecord{
+		CollectionID: collectionID,
+		IndexID:      indexID,
+		TTLDays:      ttlDays,
+		LastUsedAt:   r.now(),
+	}
+	log.Info("IndexCoord set index ttl", zap.Int64("collectionID", collectionID), zap.Int64("indexID", indexID),
+		zap.Int64("ttlDays", ttlDays))
+	return r.saveLocked(record)
+}
+
+// MarkUsed updates the last used time of the indexes of the loaded collections, and revokes their candidacy.
+func (r *indexTTLReaper) MarkUsed(loaded typeutil.UniqueSet) {
+	r.markUsed(func(collectionID UniqueID) bool { return loaded.Contain(collectionID) })
+}
+
+// MarkAllUsed updates the last used time of all the indexes, and revokes their candidacy,
+// the indexes are taken as in use while their usage is unknown.
+func (r *indexTTLReaper) MarkAllUsed() {
+	r.markUsed(func(UniqueID) bool { return true })
+}
+
+func (r *indexTTLReaper) markUsed(used func(collectionID UniqueID) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	for _, record := range r.records {
+		if !used(record.CollectionID) {
+			continue
+		}
+		if record.CandidateSince == nil && now.Sub(record.LastUsedAt) < indexUsageWriteInterval {
+			continue
+		}
+		updated := *record
+		updated.LastUsedAt = now
+		updated.CandidateSince = nil
+		if err := r.saveLocked(&updated); err != nil {
+			log.Warn("IndexCoord update index last used time fail", zap.Int64("indexID", record.IndexID), zap.Error(err))
+		}
+	}
+}
+
+func (r *indexTTLReaper) stateLocked(record *indexTTLRecord, now time.Time) *indexTTLState {
+	state := &indexTTLState{
+		CollectionID:   record.CollectionID,
+		IndexID:        record.IndexID,
+		IndexName:      r.mt.GetIndexNameByID(record.CollectionID, record.IndexID),
+		TTLDays:        record.TTLDays,
+		LastUsedAt:     record.LastUsedAt,
+		IdleDays:       now.Sub(record.LastUsedAt).Hours() / 24,
+		State:          indexTTLActive,
+		CandidateSince: record.CandidateSince,
+	}
+	if record.CandidateSince != nil {
+		state.State = indexTTLCandidate
+	}
+	return state
+}
+
+// Reap flags the indexes unused longer than their ttl as candidates, and drops the candidates flagged
+// at least an interval ago unless dryRun, the records of the indexes dropped by users are removed.
+// It returns the candidates and the indexes dropped in this check.
+func (r *indexTTLReaper) Reap(dryRun bool, interval time.Duration) []*indexTTLState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	ret := make([]*indexTTLState, 0)
+	for _, record := range r.records {
+		if r.mt.IsIndexDeleted(record.CollectionID, record.IndexID) {
+			if err := r.removeLocked(record); err != nil {
+				log.Warn("IndexCoord remove index ttl record fail", zap.Int64("indexID", record.IndexID), zap.Error(err))
+			}
+			continue
+		}
+		if now.Sub(record.LastUsedAt) < time.Duration(record.TTLDays)*24*time.Hour {
+			continue
+		}
+
+		if record.CandidateSince == nil {
+			updated := *record
+			updated.CandidateSince = &now
+			if err := r.saveLocked(&updated); err != nil {
+				log.Warn("IndexCoord flag unused index fail", zap.Int64("indexID", record.IndexID), zap.Error(err))
+				continue
+			}
+			log.Info("IndexCoord flag unused index as drop candidate", zap.Int64("collectionID", record.CollectionID),
+				zap.Int64("indexID", record.IndexID), zap.Time("lastUsedAt", record.LastUsedAt), zap.Bool("dryRun", dryRun))
+			ret = append(ret, r.stateLocked(&updated, now))
+			continue
+		}
+
+		state := r.stateLocked(record, now)
+		if dryRun || now.Sub(*record.CandidateSince) < interval {
+			ret = append(ret, state)
+			continue
+		}
+		// the index is reclaimed by the garbage collector after marked as deleted
+		if err := r.mt.MarkIndexAsDeleted(record.CollectionID, []UniqueID{record.IndexID}); err != nil {
+			log.Warn("IndexCoord drop unused index fail", zap.Int64("indexID", record.IndexID), zap.Error(err))
+			ret = append(ret, state)
+			continue
+		}
+		log.Info("IndexCoord drop unused index", zap.Int64("collectionID", record.CollectionID),
+			zap.Int64("indexID", record.IndexID), zap.Time("lastUsedAt", record.LastUsedAt))
+		if err := r.removeLocked(record); err != nil {
+			log.Warn("IndexCoord remove index ttl record fail", zap.Int64("indexID", record.IndexID), zap.Error(err))
+		}
+		state.State = indexTTLDropped
+		ret = append(ret, state)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].IndexID < ret[j].IndexID })
+	return ret
+}
+
+// List returns the ttl states of the indexes of the collection, or of all the collections if the collectionID is 0.
+func (r *indexTTLReaper) List(collectionID UniqueID) []*indexTTLState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	ret := make([]*indexTTLState, 0, len(r.records))
+	for _, record := range r.records {
+		if collectionID == 0 || record.CollectionID == collectionID {
+			ret = append(ret, r.stateLocked(record, now))
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].IndexID < ret[j].IndexID })
+	return ret
+}
+
+// loadedCollections returns the collections loaded in QueryCoord, their indexes are in use.
+func (i *IndexCoord) loadedCollections(ctx context.Context) (typeutil.UniqueSet, error) {
+	if i.queryCoordClient == nil {
+		return nil, errors.New("QueryCoord client is not set")
+	}
+	resp, err := i.queryCoordClient.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return typeutil.NewUniqueSet(resp.GetCollectionIDs()...), nil
+}
+
+// checkIndexTTL refreshes the usage of the indexes from QueryCoord and reaps the unused ones. If QueryCoord is
+// unreachable all the indexes are taken as in use, so that none of them is flagged or dropped by mistake.
+func (i *IndexCoord) checkIndexTTL(ctx context.Context) ([]*indexTTLState, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+	defer cancel()
+	loaded, err := i.loadedCollections(ctx)
+	if err != nil {
+		log.Warn("IndexCoord skip index ttl check and take all the indexes as in use, failed to get the loaded collections",
+			zap.Error(err))
+		i.indexTTL.MarkAllUsed()
+		return nil, fmt.Errorf("failed to get the loaded collections from QueryCoord: %w", err)
+	}
+	i.indexTTL.MarkUsed(loaded)
+	return i.indexTTL.Reap(Params.IndexCoordCfg.IndexTTLDryRun.GetAsBool(),
+		Params.IndexCoordCfg.IndexTTLCheckInterval.GetAsDuration(time.Second)), nil
+}
+
+func (i *IndexCoord) indexTTLLoop() {
+	defer i.loopWg.Done()
+	log.Info("IndexCoord indexTTLLoop start")
+
+	ticker := time.NewTicker(Params.IndexCoordCfg.IndexTTLCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-i.loopCtx.Done():
+			log.Info("IndexCoord indexTTLLoop exit")
+			return
+		case <-ticker.C:
+			if i.isHealthy() {
+				_, _ = i.checkIndexTTL(i.loopCtx)
+			}
+		}
+	}
+}
+
+// indexTTLStateToProto converts the state to the proto returned by the index ttl rpcs.
+func indexTTLStateToProto(state *indexTTLState) *indexpb.IndexTTLState {
+	ret := &indexpb.IndexTTLState{
+		CollectionID: state.CollectionID,
+		IndexID:      state.IndexID,
+		IndexName:    state.IndexName,
+		TtlDays:      state.TTLDays,
+		LastUsedAt:   state.LastUsedAt.UnixMilli(),
+		IdleDays:     state.IdleDays,
+		State:        state.State,
+	}
+	if state.CandidateSince != nil {
+		ret.CandidateSince = state.CandidateSince.UnixMilli()
+	}
+	return ret
+}
+
+func indexTTLStatesToProto(states []*indexTTLState) []*indexpb.IndexTTLState {
+	ret := make([]*indexpb.IndexTTLState, 0, len(states))
+	for _, state := range states {
+		ret = append(ret, indexTTLStateToProto(state))
+	}
+	return ret
+}
+
+// ListIndexTTLs returns the ttl states of the indexes of the collection, or of all the collections if the
+// collectionID is 0.
+func (i *IndexCoord) ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.ListIndexTTLsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	return &indexpb.ListIndexTTLsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		States: indexTTLStatesToProto(i.indexTTL.List(req.GetCollectionID())),
+	}, nil
+}
+
+// SetIndexTTL sets the ttl of the index, 0 removes the ttl. IndexNotExist is returned if the index is dropped.
+func (i *IndexCoord) SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	err := i.indexTTL.SetTTL(req.GetCollectionID(), req.GetIndexID(), req.GetTtlDays())
+	if errors.Is(err, errIndexNotFound) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IndexNotExist,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err != nil {
+		log.Warn("IndexCoord failed to set index ttl", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("indexID", req.GetIndexID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// CheckIndexTTL refreshes the usage of the indexes from QueryCoord and reaps the unused ones right away, it returns
+// the drop candidates and the indexes dropped in the check.
+func (i *IndexCoord) CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.CheckIndexTTLResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	states, err := i.checkIndexTTL(ctx)
+	if err != nil {
+		return &indexpb.CheckIndexTTLResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &indexpb.CheckIndexTTLResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		States: indexTTLStatesToProto(states),
+	}, nil
+}
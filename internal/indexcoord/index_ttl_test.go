@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/indexcoord"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newIndexTTLTestReaper(now *time.Time) (*indexTTLReaper, map[string]string) {
+	kv, saved := newStatisticsTestKV()
+	kv.remove = func(key string) error {
+		delete(saved, key)
+		return nil
+	}
+	r := newIndexTTLReaper(kv, constructMetaTable(&indexcoord.Catalog{Txn: kv}))
+	r.now = func() time.Time { return *now }
+	return r, saved
+}
+
+func TestIndexTTLReaper(t *testing.T) {
+	now := time.Date(2023, 1, 10, 8, 0, 0, 0, time.UTC)
+	r, saved := newIndexTTLTestReaper(&now)
+
+	assert.Error(t, r.SetTTL(collID, indexID, -1))
+	assert.ErrorIs(t, r.SetTTL(collID, indexID+1, 1), errIndexNotFound)
+	require.NoError(t, r.SetTTL(collID, indexID, 2))
+	assert.Contains(t, saved, indexTTLKey(collID, indexID))
+	states := r.List(0)
+	require.Equal(t, 1, len(states))
+	assert.Equal(t, indexName, states[0].IndexName)
+	assert.Equal(t, indexTTLActive, states[0].State)
+	assert.Equal(t, 0, len(r.List(collID+1)))
+
+	// the index is in use while its collection is loaded
+	now = now.Add(3 * 24 * time.Hour)
+	r.MarkUsed(typeutil.NewUniqueSet(collID))
+	assert.Equal(t, 0, len(r.Reap(false, time.Hour)))
+
+	now = now.Add(3 * 24 * time.Hour)
+	r.MarkUsed(typeutil.NewUniqueSet())
+	states = r.Reap(false, time.Hour)
+	require.Equal(t, 1, len(states))
+	assert.Equal(t, indexTTLCandidate, states[0].State)
+	assert.InDelta(t, 3.0, states[0].IdleDays, 1e-9)
+
+	t.Run("reload", func(t *testing.T) {
+		saved[indexTTLPrefix+"/invalid"] = "invalid"
+		reloaded := newIndexTTLReaper(r.kv, r.mt)
+		reloaded.now = r.now
+		require.NoError(t, reloaded.load())
+		assert.Equal(t, r.List(0), reloaded.List(0))
+		delete(saved, indexTTLPrefix+"/invalid")
+	})
+
+	// the candidate is dropped no earlier than the next check
+	now = now.Add(time.Minute)
+	states = r.Reap(false, time.Hour)
+	require.Equal(t, 1, len(states))
+	assert.Equal(t, indexTTLCandidate, states[0].State)
+
+	// the candidate is kept in dry run
+	now = now.Add(time.Hour)
+	states = r.Reap(true, time.Hour)
+	require.Equal(t, 1, len(states))
+	assert.Equal(t, indexTTLCandidate, states[0].State)
+	assert.False(t, r.mt.IsIndexDeleted(collID, indexID))
+
+	// loading the collection revokes the candidacy
+	r.MarkUsed(typeutil.NewUniqueSet(collID))
+	assert.Equal(t, indexTTLActive, r.List(0)[0].State)
+
+	now = now.Add(3 * 24 * time.Hour)
+	r.Reap(false, time.Hour)
+	now = now.Add(time.Hour)
+	states = r.Reap(false, time.Hour)
+	require.Equal(t, 1, len(states))
+	assert.Equal(t, indexTTLDropped, states[0].State)
+	assert.True(t, r.mt.IsIndexDeleted(collID, indexID))
+	assert.NotContains(t, saved, indexTTLKey(collID, indexID))
+	assert.Equal(t, 0, len(r.List(0)))
+
+	t.Run("index dropped by user", func(t *testing.T) {
+		r, saved := newIndexTTLTestReaper(&now)
+		require.NoError(t, r.SetTTL(collID, indexID, 1))
+		require.NoError(t, r.SetTTL(collID, indexID, 0))
+		assert.NotContains(t, saved, indexTTLKey(collID, indexID))
+
+		require.NoError(t, r.SetTTL(collID, indexID, 1))
+		require.NoError(t, r.mt.MarkIndexAsDeleted(collID, []UniqueID{indexID}))
+		assert.Equal(t, 0, len(r.Reap(false, time.Hour)))
+		assert.NotContains(t, saved, indexTTLKey(collID, indexID))
+	})
+}
+
+func TestIndexCoord_IndexTTL(t *testing.T) {
+	now := time.Now()
+	r, _ := newIndexTTLTestReaper(&now)
+	qcm := NewQueryCoordMock()
+	ic := &IndexCoord{
+		session:            &sessionutil.Session{ServerID: 1},
+		queryCoordClient:   qcm,
+		indexTTL:           r,
+		reqTimeoutInterval: time.Second,
+	}
+	ic.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	status, err := ic.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{CollectionID: 100, IndexID: 400, TtlDays: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = ic.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{CollectionID: 100, IndexID: 401, TtlDays: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IndexNotExist, status.GetErrorCode())
+	status, err = ic.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{CollectionID: 100, IndexID: 400, TtlDays: -1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	listResp, err := ic.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{CollectionID: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(listResp.GetStates()))
+	assert.Equal(t, int64(1), listResp.GetStates()[0].GetTtlDays())
+	assert.Equal(t, now.UnixMilli(), listResp.GetStates()[0].GetLastUsedAt())
+	assert.Equal(t, indexTTLActive, listResp.GetStates()[0].GetState())
+	assert.Equal(t, int64(0), listResp.GetStates()[0].GetCandidateSince())
+
+	now = now.Add(2 * 24 * time.Hour)
+	checkResp, err := ic.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, checkResp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(checkResp.GetStates()))
+	assert.Equal(t, indexTTLCandidate, checkResp.GetStates()[0].GetState())
+	assert.Equal(t, now.UnixMilli(), checkResp.GetStates()[0].GetCandidateSince())
+
+	// all the indexes are taken as in use if QueryCoord is unreachable, the candidacy is revoked
+	qcm.CallShowCollections = func(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+		return nil, errors.New("mock error")
+	}
+	now = now.Add(2 * 24 * time.Hour)
+	checkResp, err = ic.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, checkResp.GetStatus().GetErrorCode())
+	listResp, err = ic.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{CollectionID: 100})
+	assert.NoError(t, err)
+	require.Equal(t, 1, len(listResp.GetStates()))
+	assert.Equal(t, indexTTLActive, listResp.GetStates()[0].GetState())
+	assert.Equal(t, now.UnixMilli(), listResp.GetStates()[0].GetLastUsedAt())
+
+	ic.queryCoordClient = nil
+	now = now.Add(2 * 24 * time.Hour)
+	checkResp, err = ic.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, checkResp.GetStatus().GetErrorCode())
+	listResp, err = ic.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{CollectionID: 100})
+	assert.NoError(t, err)
+	require.Equal(t, 1, len(listResp.GetStates()))
+	assert.Equal(t, indexTTLActive, listResp.GetStates()[0].GetState())
+	assert.Equal(t, now.UnixMilli(), listResp.GetStates()[0].GetLastUsedAt())
+
+	ic.stateCode.Store(commonpb.StateCode_Abnormal)
+	listResp, err = ic.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+	status, err = ic.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	checkResp, err = ic.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, checkResp.GetStatus().GetErrorCode())
+}
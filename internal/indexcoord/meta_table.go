@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 
@@ -41,7 +43,12 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 )
 
-// metaTable maintains index-related information
+// metaTable maintains index-related information.
+// The index meta is published as immutable snapshots, readers load the latest snapshot without lock,
+// writers are serialized by the locks, they copy the changed parts of the maps and publish new snapshots,
+// so the maps referenced by the snapshots must never be modified in place.
+// The segment indexes are too many to be copied on every write, the writers update them in place under
+// segmentIndexLock, and the snapshots shard them so that a write copies the shards it changes only.
 type metaTable struct {
 	catalog          metastore.IndexCoordCatalog
 	indexLock        sync.RWMutex
@@ -56,6 +63,102 @@ type metaTable struct {
 	// buildID2Meta records the meta information of the segment
 	// buildID -> segmentIndex
 	buildID2SegmentIndex map[UniqueID]*model.SegmentIndex
+
+	// indexes stores the *indexSnapshot published last
+	indexes atomic.Value
+	// segIndexes stores the *segmentIndexSnapshot published last
+	segIndexes atomic.Value
+
+	// buildStatistics records the daily build statistics of the indexes, nil means not recorded
+	buildStatistics *buildStatisticsRecorder
+}
+
+// indexSnapshot is an immutable view of the collection indexes.
+type indexSnapshot struct {
+	collectionIndexes map[UniqueID]map[UniqueID]*model.Index
+}
+
+// segmentIndexShardNum is the number of the shards of a segment index snapshot.
+const segmentIndexShardNum = 256
+
+// segmentIndexSnapshot is an immutable view of the segment indexes,
+// sharded by the segment id and the build id respectively.
+type segmentIndexSnapshot struct {
+	segmentIndexes       [segmentIndexShardNum]map[UniqueID]map[UniqueID]*model.SegmentIndex
+	buildID2SegmentIndex [segmentIndexShardNum]map[UniqueID]*model.SegmentIndex
+}
+
+func segmentIndexShard(id UniqueID) int {
+	return int(uint64(id) % segmentIndexShardNum)
+}
+
+// getSegmentIndexes returns the indexes on the segment, indexID -> segmentIndex.
+func (s *segmentIndexSnapshot) getSegmentIndexes(segID UniqueID) (map[UniqueID]*model.SegmentIndex, bool) {
+	segIdxes, ok := s.segmentIndexes[segmentIndexShard(segID)][segID]
+	return segIdxes, ok
+}
+
+// getSegmentIndex returns the segment index of the build.
+func (s *segmentIndexSnapshot) getSegmentIndex(buildID UniqueID) (*model.SegmentIndex, bool) {
+	segIdx, ok := s.buildID2SegmentIndex[segmentIndexShard(buildID)][buildID]
+	return segIdx, ok
+}
+
+// newSegmentIndexSnapshot returns the snapshot of the segment indexes.
+func newSegmentIndexSnapshot(segmentIndexes map[UniqueID]map[UniqueID]*model.SegmentIndex,
+	buildID2SegmentIndex map[UniqueID]*model.SegmentIndex) *segmentIndexSnapshot {
+	snapshot := &segmentIndexSnapshot{}
+	for i := 0; i < segmentIndexShardNum; i++ {
+		snapshot.segmentIndexes[i] = make(map[UniqueID]map[UniqueID]*model.SegmentIndex)
+		snapshot.buildID2SegmentIndex[i] = make(map[UniqueID]*model.SegmentIndex)
+	}
+	for segID, segIdxes := range segmentIndexes {
+		snapshot.segmentIndexes[segmentIndexShard(segID)][segID] = copySegIdxes(segIdxes)
+	}
+	for buildID, segIdx := range buildID2SegmentIndex {
+		snapshot.buildID2SegmentIndex[segmentIndexShard(buildID)][buildID] = segIdx
+	}
+	return snapshot
+}
+
+// withSegmentIndexes returns a new snapshot in which the segment indexes are updated by updated and removed,
+// the shards and the segments changed are copied, others are shared with s.
+func (s *segmentIndexSnapshot) withSegmentIndexes(updated []*model.SegmentIndex, removed []*model.SegmentIndex) *segmentIndexSnapshot {
+	snapshot := *s
+	copiedShards := make(map[int]struct{})
+	copiedBuildShards := make(map[int]struct{})
+	copiedSegments := make(map[UniqueID]struct{})
+	prepare := func(segIdx *model.SegmentIndex) (map[UniqueID]*model.SegmentIndex, map[UniqueID]*model.SegmentIndex) {
+		shard := segmentIndexShard(segIdx.SegmentID)
+		if _, ok := copiedShards[shard]; !ok {
+			snapshot.segmentIndexes[shard] = copySegmentIndexes(snapshot.segmentIndexes[shard])
+			copiedShards[shard] = struct{}{}
+		}
+		if _, ok := copiedSegments[segIdx.SegmentID]; !ok {
+			snapshot.segmentIndexes[shard][segIdx.SegmentID] = copySegIdxes(snapshot.segmentIndexes[shard][segIdx.SegmentID])
+			copiedSegments[segIdx.SegmentID] = struct{}{}
+		}
+		buildShard := segmentIndexShard(segIdx.BuildID)
+		if _, ok := copiedBuildShards[buildShard]; !ok {
+			snapshot.buildID2SegmentIndex[buildShard] = copyBuildID2SegmentIndex(snapshot.buildID2SegmentIndex[buildShard])
+			copiedBuildShards[buildShard] = struct{}{}
+		}
+		return snapshot.segmentIndexes[shard][segIdx.SegmentID], snapshot.buildID2SegmentIndex[buildShard]
+	}
+	for _, segIdx := range updated {
+		segIdxes, buildID2SegmentIndex := prepare(segIdx)
+		segIdxes[segIdx.IndexID] = segIdx
+		buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	}
+	for _, segIdx := range removed {
+		segIdxes, buildID2SegmentIndex := prepare(segIdx)
+		delete(segIdxes, segIdx.IndexID)
+		if len(segIdxes) == 0 {
+			delete(snapshot.segmentIndexes[segmentIndexShard(segIdx.SegmentID)], segIdx.SegmentID)
+		}
+		delete(buildID2SegmentIndex, segIdx.BuildID)
+	}
+	return &snapshot
 }
 
 // NewMetaTable is used to create a new meta table.
@@ -66,28 +169,142 @@ func NewMetaTable(kv kv.MetaKv) (*metaTable, error) {
 		},
 		indexLock:        sync.RWMutex{},
 		segmentIndexLock: sync.RWMutex{},
+		buildStatistics:  newBuildStatisticsRecorder(kv),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
 		return nil, err
 	}
+	if err := mt.buildStatistics.load(); err != nil {
+		log.Warn("IndexCoord metaTable load index build statistics fail", zap.Error(err))
+	}
 
 	return mt, nil
 }
 
-func (mt *metaTable) updateCollectionIndex(index *model.Index) {
-	if _, ok := mt.collectionIndexes[index.CollectionID]; !ok {
-		mt.collectionIndexes[index.CollectionID] = make(map[UniqueID]*model.Index)
+// publishIndexSnapshot publishes the current collection indexes, mt.indexLock.Lock() before call this function.
+func (mt *metaTable) publishIndexSnapshot() *indexSnapshot {
+	snapshot := &indexSnapshot{
+		collectionIndexes: mt.collectionIndexes,
 	}
-	mt.collectionIndexes[index.CollectionID][index.IndexID] = index
+	mt.indexes.Store(snapshot)
+	return snapshot
 }
 
-func (mt *metaTable) updateSegmentIndex(segIdx *model.SegmentIndex) {
-	if _, ok := mt.segmentIndexes[segIdx.SegmentID]; !ok {
-		mt.segmentIndexes[segIdx.SegmentID] = make(map[UniqueID]*model.SegmentIndex)
+// publishSegmentIndexSnapshot publishes all the current segment indexes, mt.segmentIndexLock.Lock() before call this function.
+func (mt *metaTable) publishSegmentIndexSnapshot() *segmentIndexSnapshot {
+	snapshot := newSegmentIndexSnapshot(mt.segmentIndexes, mt.buildID2SegmentIndex)
+	mt.segIndexes.Store(snapshot)
+	return snapshot
+}
+
+// loadIndexSnapshot returns the latest collection indexes, which must not be modified.
+func (mt *metaTable) loadIndexSnapshot() *indexSnapshot {
+	if snapshot, ok := mt.indexes.Load().(*indexSnapshot); ok {
+		return snapshot
 	}
-	mt.segmentIndexes[segIdx.SegmentID][segIdx.IndexID] = segIdx
-	mt.buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	// nothing published yet, e.g. the meta table is not created by NewMetaTable
+	mt.indexLock.Lock()
+	defer mt.indexLock.Unlock()
+	return mt.publishIndexSnapshot()
+}
+
+// loadSegmentIndexSnapshot returns the latest segment indexes, which must not be modified.
+func (mt *metaTable) loadSegmentIndexSnapshot() *segmentIndexSnapshot {
+	if snapshot, ok := mt.segIndexes.Load().(*segmentIndexSnapshot); ok {
+		return snapshot
+	}
+	// nothing published yet, e.g. the meta table is not created by NewMetaTable
+	mt.segmentIndexLock.Lock()
+	defer mt.segmentIndexLock.Unlock()
+	if snapshot, ok := mt.segIndexes.Load().(*segmentIndexSnapshot); ok {
+		return snapshot
+	}
+	return mt.publishSegmentIndexSnapshot()
+}
+
+func copyCollectionIndexes(collectionIndexes map[UniqueID]map[UniqueID]*model.Index) map[UniqueID]map[UniqueID]*model.Index {
+	ret := make(map[UniqueID]map[UniqueID]*model.Index, len(collectionIndexes)+1)
+	for collID, fieldIndexes := range collectionIndexes {
+		ret[collID] = fieldIndexes
+	}
+	return ret
+}
+
+func copyFieldIndexes(fieldIndexes map[UniqueID]*model.Index) map[UniqueID]*model.Index {
+	ret := make(map[UniqueID]*model.Index, len(fieldIndexes)+1)
+	for indexID, index := range fieldIndexes {
+		ret[indexID] = index
+	}
+	return ret
+}
+
+func copySegmentIndexes(segmentIndexes map[UniqueID]map[UniqueID]*model.SegmentIndex) map[UniqueID]map[UniqueID]*model.SegmentIndex {
+	ret := make(map[UniqueID]map[UniqueID]*model.SegmentIndex, len(segmentIndexes)+1)
+	for segID, segIdxes := range segmentIndexes {
+		ret[segID] = segIdxes
+	}
+	return ret
+}
+
+func copySegIdxes(segIdxes map[UniqueID]*model.SegmentIndex) map[UniqueID]*model.SegmentIndex {
+	ret := make(map[UniqueID]*model.SegmentIndex, len(segIdxes)+1)
+	for indexID, segIdx := range segIdxes {
+		ret[indexID] = segIdx
+	}
+	return ret
+}
+
+func copyBuildID2SegmentIndex(buildID2SegmentIndex map[UniqueID]*model.SegmentIndex) map[UniqueID]*model.SegmentIndex {
+	ret := make(map[UniqueID]*model.SegmentIndex, len(buildID2SegmentIndex)+1)
+	for buildID, segIdx := range buildID2SegmentIndex {
+		ret[buildID] = segIdx
+	}
+	return ret
+}
+
+// updateCollectionIndexes copies the collection indexes changed and publishes them.
+func (mt *metaTable) updateCollectionIndexes(indexes ...*model.Index) {
+	collectionIndexes := copyCollectionIndexes(mt.collectionIndexes)
+	copied := make(map[UniqueID]struct{})
+	for _, index := range indexes {
+		if _, ok := copied[index.CollectionID]; !ok {
+			collectionIndexes[index.CollectionID] = copyFieldIndexes(collectionIndexes[index.CollectionID])
+			copied[index.CollectionID] = struct{}{}
+		}
+		collectionIndexes[index.CollectionID][index.IndexID] = index
+	}
+	mt.collectionIndexes = collectionIndexes
+	mt.publishIndexSnapshot()
+}
+
+// setSegmentIndexes updates the segment indexes in place, mt.segmentIndexLock.Lock() before call this function.
+func (mt *metaTable) setSegmentIndexes(segIdxes ...*model.SegmentIndex) {
+	for _, segIdx := range segIdxes {
+		if _, ok := mt.segmentIndexes[segIdx.SegmentID]; !ok {
+			mt.segmentIndexes[segIdx.SegmentID] = make(map[UniqueID]*model.SegmentIndex)
+		}
+		mt.segmentIndexes[segIdx.SegmentID][segIdx.IndexID] = segIdx
+		mt.buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	}
+}
+
+// updateSegmentIndexes updates the segment indexes in place and publishes the shards changed,
+// mt.segmentIndexLock.Lock() before call this function.
+func (mt *metaTable) updateSegmentIndexes(segIdxes ...*model.SegmentIndex) {
+	mt.setSegmentIndexes(segIdxes...)
+	mt.publishSegmentIndexChanges(segIdxes, nil)
+}
+
+// publishSegmentIndexChanges publishes the segment indexes updated and removed in place by copying the shards changed
+// of the latest snapshot, mt.segmentIndexLock.Lock() before call this function.
+func (mt *metaTable) publishSegmentIndexChanges(updated []*model.SegmentIndex, removed []*model.SegmentIndex) {
+	snapshot, ok := mt.segIndexes.Load().(*segmentIndexSnapshot)
+	if !ok {
+		mt.publishSegmentIndexSnapshot()
+		return
+	}
+	mt.segIndexes.Store(snapshot.withSegmentIndexes(updated, removed))
 }
 
 // reloadFromKV reloads the index meta from ETCD.
@@ -110,12 +327,9 @@ func (mt *metaTable) reloadFromKV() error {
 		return err
 	}
 
-	for _, fieldIndex := range fieldIndexes {
-		mt.updateCollectionIndex(fieldIndex)
-	}
-	for _, segIdx := range segmentIndxes {
-		mt.updateSegmentIndex(segIdx)
-	}
+	mt.updateCollectionIndexes(fieldIndexes...)
+	mt.setSegmentIndexes(segmentIndxes...)
+	mt.publishSegmentIndexSnapshot()
 
 	log.Info("IndexCoord metaTable reloadFromKV success")
 	record.Record("metaTable reloadFromKV")
@@ -130,7 +344,7 @@ func (mt *metaTable) saveFieldIndexMeta(index *model.Index) error {
 		return err
 	}
 
-	mt.updateCollectionIndex(index)
+	mt.updateCollectionIndexes(index)
 	return nil
 }
 
@@ -140,9 +354,7 @@ func (mt *metaTable) alterIndexes(indexes []*model.Index) error {
 		log.Error("failed to alter index meta in meta store", zap.Int("indexes num", len(indexes)), zap.Error(err))
 		return err
 	}
-	for _, index := range indexes {
-		mt.updateCollectionIndex(index)
-	}
+	mt.updateCollectionIndexes(indexes...)
 	return nil
 }
 
@@ -153,9 +365,7 @@ func (mt *metaTable) alterSegmentIndexes(segIdxes []*model.SegmentIndex) error {
 			zap.Error(err))
 		return err
 	}
-	for _, segIdx := range segIdxes {
-		mt.updateSegmentIndex(segIdx)
-	}
+	mt.updateSegmentIndexes(segIdxes...)
 	return nil
 }
 
@@ -168,7 +378,7 @@ func (mt *metaTable) saveSegmentIndexMeta(segIdx *model.SegmentIndex) error {
 		return err
 	}
 
-	mt.updateSegmentIndex(segIdx)
+	mt.updateSegmentIndexes(segIdx)
 	log.Info("IndexCoord metaTable saveIndexMeta success", zap.Int64("buildID", segIdx.BuildID))
 	return nil
 }
@@ -213,22 +423,22 @@ func (mt *metaTable) updateIndexTasksMetrics() {
 }
 
 func (mt *metaTable) GetAllIndexMeta() map[int64]*model.SegmentIndex {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	metas := map[int64]*model.SegmentIndex{}
-	for buildID, segIdx := range mt.buildID2SegmentIndex {
-		metas[buildID] = model.CloneSegmentIndex(segIdx)
+	for _, shard := range snapshot.buildID2SegmentIndex {
+		for buildID, segIdx := range shard {
+			metas[buildID] = model.CloneSegmentIndex(segIdx)
+		}
 	}
 
 	return metas
 }
 
 func (mt *metaTable) GetMeta(buildID UniqueID) (*model.SegmentIndex, bool) {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
-	segIdx, ok := mt.buildID2SegmentIndex[buildID]
+	segIdx, ok := snapshot.getSegmentIndex(buildID)
 	if ok && !segIdx.IsDeleted {
 		return model.CloneSegmentIndex(segIdx), true
 	}
@@ -237,10 +447,9 @@ func (mt *metaTable) GetMeta(buildID UniqueID) (*model.SegmentIndex, bool) {
 }
 
 func (mt *metaTable) GetTypeParams(collID, indexID UniqueID) []*commonpb.KeyValuePair {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
-	fieldIndexes, ok := mt.collectionIndexes[collID]
+	fieldIndexes, ok := snapshot.collectionIndexes[collID]
 	if !ok {
 		return nil
 	}
@@ -258,10 +467,9 @@ func (mt *metaTable) GetTypeParams(collID, indexID UniqueID) []*commonpb.KeyValu
 }
 
 func (mt *metaTable) GetIndexParams(collID, indexID UniqueID) []*commonpb.KeyValuePair {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
-	fieldIndexes, ok := mt.collectionIndexes[collID]
+	fieldIndexes, ok := snapshot.collectionIndexes[collID]
 	if !ok {
 		return nil
 	}
@@ -326,10 +534,9 @@ func (mt *metaTable) AddIndex(segIndex *model.SegmentIndex) error {
 }
 
 func (mt *metaTable) NeedIndex(collID, indexID UniqueID) bool {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
-	fieldIndexes, ok := mt.collectionIndexes[collID]
+	fieldIndexes, ok := snapshot.collectionIndexes[collID]
 	if !ok {
 		return false
 	}
@@ -416,6 +623,7 @@ func (mt *metaTable) BuildIndex(buildID UniqueID) error {
 	if err := mt.updateSegIndexMeta(segIdx, updateFunc); err != nil {
 		return err
 	}
+	mt.buildStatistics.recordBuildStart(buildID, time.Now())
 
 	mt.updateIndexTasksMetrics()
 	return nil
@@ -423,11 +631,10 @@ func (mt *metaTable) BuildIndex(buildID UniqueID) error {
 
 // GetIndexesForCollection gets all indexes info with the specified collection.
 func (mt *metaTable) GetIndexesForCollection(collID UniqueID, indexName string) []*model.Index {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
 	indexInfos := make([]*model.Index, 0)
-	for _, index := range mt.collectionIndexes[collID] {
+	for _, index := range snapshot.collectionIndexes[collID] {
 		if index.IsDeleted {
 			continue
 		}
@@ -439,10 +646,9 @@ func (mt *metaTable) GetIndexesForCollection(collID UniqueID, indexName string)
 }
 
 func (mt *metaTable) CanCreateIndex(req *indexpb.CreateIndexRequest) (bool, error) {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
-	indexes, ok := mt.collectionIndexes[req.CollectionID]
+	indexes, ok := snapshot.collectionIndexes[req.CollectionID]
 	if !ok {
 		return true, nil
 	}
@@ -517,10 +723,9 @@ func (mt *metaTable) checkParams(fieldIndex *model.Index, req *indexpb.CreateInd
 
 // HasSameReq determine whether there are same indexing tasks.
 func (mt *metaTable) HasSameReq(req *indexpb.CreateIndexRequest) (bool, UniqueID) {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
-	for _, fieldIndex := range mt.collectionIndexes[req.CollectionID] {
+	for _, fieldIndex := range snapshot.collectionIndexes[req.CollectionID] {
 		if fieldIndex.IsDeleted {
 			continue
 		}
@@ -537,14 +742,14 @@ func (mt *metaTable) HasSameReq(req *indexpb.CreateIndexRequest) (bool, UniqueID
 }
 
 func (mt *metaTable) HasSameIndex(segmentID, indexID UniqueID) (bool, UniqueID) {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
-	if _, ok := mt.segmentIndexes[segmentID]; !ok {
+	segIdxes, ok := snapshot.getSegmentIndexes(segmentID)
+	if !ok {
 		return false, 0
 	}
 
-	if index, ok := mt.segmentIndexes[segmentID][indexID]; ok {
+	if index, ok := segIdxes[indexID]; ok {
 		return true, index.BuildID
 	}
 
@@ -552,11 +757,10 @@ func (mt *metaTable) HasSameIndex(segmentID, indexID UniqueID) (bool, UniqueID)
 }
 
 func (mt *metaTable) GetIndexIDByName(collID int64, indexName string) map[int64]uint64 {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 	indexID2CreateTs := make(map[int64]uint64)
 
-	fieldIndexes, ok := mt.collectionIndexes[collID]
+	fieldIndexes, ok := snapshot.collectionIndexes[collID]
 	if !ok {
 		return indexID2CreateTs
 	}
@@ -570,10 +774,9 @@ func (mt *metaTable) GetIndexIDByName(collID int64, indexName string) map[int64]
 }
 
 func (mt *metaTable) GetFieldIDByIndexID(collID, indexID UniqueID) UniqueID {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
-	if fieldIndexes, ok := mt.collectionIndexes[collID]; ok {
+	if fieldIndexes, ok := snapshot.collectionIndexes[collID]; ok {
 		if index, ok := fieldIndexes[indexID]; ok {
 			return index.FieldID
 		}
@@ -582,9 +785,8 @@ func (mt *metaTable) GetFieldIDByIndexID(collID, indexID UniqueID) UniqueID {
 }
 
 func (mt *metaTable) GetIndexNameByID(collID, indexID UniqueID) string {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
-	if fieldIndexes, ok := mt.collectionIndexes[collID]; ok {
+	snapshot := mt.loadIndexSnapshot()
+	if fieldIndexes, ok := snapshot.collectionIndexes[collID]; ok {
 		if index, ok := fieldIndexes[indexID]; ok {
 			return index.IndexName
 		}
@@ -608,8 +810,7 @@ type IndexStateCnt struct {
 
 // GetIndexStates gets the index states for indexID from meta table.
 func (mt *metaTable) GetIndexStates(indexID int64, createTs uint64) ([]*IndexState, IndexStateCnt) {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	segIndexStates := make([]*IndexState, 0)
 	var (
@@ -621,35 +822,37 @@ func (mt *metaTable) GetIndexStates(indexID int64, createTs uint64) ([]*IndexSta
 		failReason    string
 	)
 
-	for _, indexID2SegIdx := range mt.segmentIndexes {
-		segIdx, ok := indexID2SegIdx[indexID]
-		if !ok {
-			continue
-		}
-		if segIdx.CreateTime > createTs {
-			continue
-		}
-		if segIdx.IsDeleted {
-			// skip deleted index, deleted by compaction
-			continue
-		}
-		switch segIdx.IndexState {
-		case commonpb.IndexState_IndexStateNone:
-			cntNone++
-		case commonpb.IndexState_Unissued:
-			cntUnissued++
-		case commonpb.IndexState_InProgress:
-			cntInProgress++
-		case commonpb.IndexState_Finished:
-			cntFinished++
-		case commonpb.IndexState_Failed:
-			cntFailed++
-			failReason += fmt.Sprintf("%d: %s;", segIdx.SegmentID, segIdx.FailReason)
+	for _, shard := range snapshot.segmentIndexes {
+		for _, indexID2SegIdx := range shard {
+			segIdx, ok := indexID2SegIdx[indexID]
+			if !ok {
+				continue
+			}
+			if segIdx.CreateTime > createTs {
+				continue
+			}
+			if segIdx.IsDeleted {
+				// skip deleted index, deleted by compaction
+				continue
+			}
+			switch segIdx.IndexState {
+			case commonpb.IndexState_IndexStateNone:
+				cntNone++
+			case commonpb.IndexState_Unissued:
+				cntUnissued++
+			case commonpb.IndexState_InProgress:
+				cntInProgress++
+			case commonpb.IndexState_Finished:
+				cntFinished++
+			case commonpb.IndexState_Failed:
+				cntFailed++
+				failReason += fmt.Sprintf("%d: %s;", segIdx.SegmentID, segIdx.FailReason)
+			}
+			segIndexStates = append(segIndexStates, &IndexState{
+				state:      segIdx.IndexState,
+				failReason: segIdx.FailReason,
+			})
 		}
-		segIndexStates = append(segIndexStates, &IndexState{
-			state:      segIdx.IndexState,
-			failReason: segIdx.FailReason,
-		})
 	}
 
 	log.Debug("IndexCoord get index states success", zap.Int64("indexID", indexID),
@@ -667,11 +870,10 @@ func (mt *metaTable) GetIndexStates(indexID int64, createTs uint64) ([]*IndexSta
 }
 
 func (mt *metaTable) GetSegmentIndexes(segID UniqueID) []*model.SegmentIndex {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	segIndexInfos := make([]*model.SegmentIndex, 0)
-	if segIndexes, ok := mt.segmentIndexes[segID]; ok {
+	if segIndexes, ok := snapshot.getSegmentIndexes(segID); ok {
 		for _, segIdx := range segIndexes {
 			if segIdx.IsDeleted {
 				continue
@@ -683,14 +885,13 @@ func (mt *metaTable) GetSegmentIndexes(segID UniqueID) []*model.SegmentIndex {
 }
 
 func (mt *metaTable) GetSegmentIndexState(segmentID UniqueID) IndexState {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	state := IndexState{
 		state:      commonpb.IndexState_Finished,
 		failReason: "",
 	}
-	if segIdxes, ok := mt.segmentIndexes[segmentID]; ok {
+	if segIdxes, ok := snapshot.getSegmentIndexes(segmentID); ok {
 		for _, segIdx := range segIdxes {
 			if segIdx.IsDeleted {
 				continue
@@ -707,13 +908,12 @@ func (mt *metaTable) GetSegmentIndexState(segmentID UniqueID) IndexState {
 
 // GetIndexBuildProgress gets the index progress for indexID from meta table.
 func (mt *metaTable) GetIndexBuildProgress(indexID int64, segIDs []UniqueID) int64 {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	indexRows := int64(0)
 
 	for _, segID := range segIDs {
-		segIndexes, ok := mt.segmentIndexes[segID]
+		segIndexes, ok := snapshot.getSegmentIndexes(segID)
 		if !ok {
 			continue
 		}
@@ -802,11 +1002,10 @@ func (mt *metaTable) MarkSegmentsIndexAsDeleted(selector func(index *model.Segme
 }
 
 func (mt *metaTable) GetSegmentIndexByBuildID(buildID UniqueID) (bool, *model.SegmentIndex) {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 	log.Debug("IndexCoord get index file path from meta table", zap.Int64("buildID", buildID))
 
-	segIdx, ok := mt.buildID2SegmentIndex[buildID]
+	segIdx, ok := snapshot.getSegmentIndex(buildID)
 	if !ok || segIdx.IsDeleted {
 		return false, nil
 	}
@@ -821,9 +1020,8 @@ func (mt *metaTable) GetSegmentIndexByBuildID(buildID UniqueID) (bool, *model.Se
 }
 
 func (mt *metaTable) IsIndexDeleted(collID, indexID UniqueID) bool {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
-	fieldIndexes, ok := mt.collectionIndexes[collID]
+	snapshot := mt.loadIndexSnapshot()
+	fieldIndexes, ok := snapshot.collectionIndexes[collID]
 	if !ok {
 		return true
 	}
@@ -834,48 +1032,48 @@ func (mt *metaTable) IsIndexDeleted(collID, indexID UniqueID) bool {
 }
 
 func (mt *metaTable) IsSegIndexDeleted(buildID UniqueID) bool {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
-	if segIdx, ok := mt.buildID2SegmentIndex[buildID]; !ok || segIdx.IsDeleted {
+	if segIdx, ok := snapshot.getSegmentIndex(buildID); !ok || segIdx.IsDeleted {
 		return true
 	}
 	return false
 }
 
 func (mt *metaTable) GetMetasByNodeID(nodeID UniqueID) []*model.SegmentIndex {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	metas := make([]*model.SegmentIndex, 0)
-	for _, meta := range mt.buildID2SegmentIndex {
-		if meta.IsDeleted {
-			continue
-		}
-		if nodeID == meta.NodeID {
-			metas = append(metas, model.CloneSegmentIndex(meta))
+	for _, shard := range snapshot.buildID2SegmentIndex {
+		for _, meta := range shard {
+			if meta.IsDeleted {
+				continue
+			}
+			if nodeID == meta.NodeID {
+				metas = append(metas, model.CloneSegmentIndex(meta))
+			}
 		}
 	}
 	return metas
 }
 
 func (mt *metaTable) GetAllSegIndexes() map[int64]*model.SegmentIndex {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	segIndexes := make(map[int64]*model.SegmentIndex)
-	for _, meta := range mt.buildID2SegmentIndex {
-		segIndexes[meta.SegmentID] = model.CloneSegmentIndex(meta)
+	for _, shard := range snapshot.buildID2SegmentIndex {
+		for _, meta := range shard {
+			segIndexes[meta.SegmentID] = model.CloneSegmentIndex(meta)
+		}
 	}
 	return segIndexes
 }
 
 func (mt *metaTable) GetDeletedIndexes() []*model.Index {
-	mt.indexLock.RLock()
-	defer mt.indexLock.RUnlock()
+	snapshot := mt.loadIndexSnapshot()
 
 	var indexes []*model.Index
-	for _, fieldIndexes := range mt.collectionIndexes {
+	for _, fieldIndexes := range snapshot.collectionIndexes {
 		for _, index := range fieldIndexes {
 			if index.IsDeleted {
 				indexes = append(indexes, model.CloneIndex(index))
@@ -886,38 +1084,39 @@ func (mt *metaTable) GetDeletedIndexes() []*model.Index {
 }
 
 func (mt *metaTable) GetDeletedSegmentIndexes() []*model.SegmentIndex {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	segIndexes := make([]*model.SegmentIndex, 0)
-	for _, segIdx := range mt.buildID2SegmentIndex {
-		if segIdx.IsDeleted {
-			segIndexes = append(segIndexes, segIdx)
+	for _, shard := range snapshot.buildID2SegmentIndex {
+		for _, segIdx := range shard {
+			if segIdx.IsDeleted {
+				segIndexes = append(segIndexes, segIdx)
+			}
 		}
 	}
 	return segIndexes
 }
 
 func (mt *metaTable) GetBuildIDsFromIndexID(indexID UniqueID) []UniqueID {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	buildIDs := make([]UniqueID, 0)
-	for buildID, segIdx := range mt.buildID2SegmentIndex {
-		if segIdx.IndexID == indexID {
-			buildIDs = append(buildIDs, buildID)
+	for _, shard := range snapshot.buildID2SegmentIndex {
+		for buildID, segIdx := range shard {
+			if segIdx.IndexID == indexID {
+				buildIDs = append(buildIDs, buildID)
+			}
 		}
 	}
 	return buildIDs
 }
 
 func (mt *metaTable) GetBuildIDsFromSegIDs(segIDs []UniqueID) []UniqueID {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
 	buildIDs := make([]UniqueID, 0)
 	for _, segID := range segIDs {
-		if segIdxes, ok := mt.segmentIndexes[segID]; ok {
+		if segIdxes, ok := snapshot.getSegmentIndexes(segID); ok {
 			for _, segIdx := range segIdxes {
 				buildIDs = append(buildIDs, segIdx.BuildID)
 			}
@@ -939,14 +1138,20 @@ func (mt *metaTable) RemoveIndex(collID, indexID UniqueID) error {
 		return err
 	}
 
-	delete(mt.collectionIndexes[collID], indexID)
-	if len(mt.collectionIndexes[collID]) == 0 {
-		delete(mt.collectionIndexes, collID)
+	collectionIndexes := copyCollectionIndexes(mt.collectionIndexes)
+	fieldIndexes := copyFieldIndexes(collectionIndexes[collID])
+	delete(fieldIndexes, indexID)
+	collectionIndexes[collID] = fieldIndexes
+	if len(fieldIndexes) == 0 {
+		delete(collectionIndexes, collID)
 		metrics.IndexCoordIndexTaskNum.Delete(prometheus.Labels{"collection_id": strconv.FormatInt(collID, 10), "index_task_status": metrics.UnissuedIndexTaskLabel})
 		metrics.IndexCoordIndexTaskNum.Delete(prometheus.Labels{"collection_id": strconv.FormatInt(collID, 10), "index_task_status": metrics.InProgressIndexTaskLabel})
 		metrics.IndexCoordIndexTaskNum.Delete(prometheus.Labels{"collection_id": strconv.FormatInt(collID, 10), "index_task_status": metrics.FinishedIndexTaskLabel})
 		metrics.IndexCoordIndexTaskNum.Delete(prometheus.Labels{"collection_id": strconv.FormatInt(collID, 10), "index_task_status": metrics.FailedIndexTaskLabel})
+		deleteIndexCoverageMetrics(collID)
 	}
+	mt.collectionIndexes = collectionIndexes
+	mt.publishIndexSnapshot()
 	log.Info("IndexCoord meta table remove index success", zap.Int64("collID", collID), zap.Int64("indexID", indexID))
 	return nil
 }
@@ -966,20 +1171,20 @@ func (mt *metaTable) RemoveSegmentIndex(collID, partID, segID, buildID UniqueID)
 		return nil
 	}
 	delete(mt.segmentIndexes[segID], segIdx.IndexID)
-	delete(mt.buildID2SegmentIndex, buildID)
 	if len(mt.segmentIndexes[segID]) == 0 {
 		delete(mt.segmentIndexes, segID)
 	}
+	delete(mt.buildID2SegmentIndex, buildID)
+	mt.publishSegmentIndexChanges(nil, []*model.SegmentIndex{segIdx})
 
 	return nil
 }
 
 // HasBuildID checks if there is an index corresponding the buildID in the meta table.
 func (mt *metaTable) HasBuildID(buildID UniqueID) bool {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
-	_, ok := mt.buildID2SegmentIndex[buildID]
+	_, ok := snapshot.getSegmentIndex(buildID)
 	return ok
 }
 
@@ -1040,6 +1245,10 @@ func (mt *metaTable) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 	if err := mt.updateSegIndexMeta(segIdx, updateFunc); err != nil {
 		return err
 	}
+	// the builds finished without dispatched to an IndexNode, e.g. flat indexes, are not counted
+	if segIdx.NodeID != 0 {
+		mt.buildStatistics.recordBuildFinish(segIdx, taskInfo.GetState(), taskInfo.GetSerializedSize(), time.Now())
+	}
 
 	mt.updateIndexTasksMetrics()
 	log.Info("finish index task success", zap.Int64("buildID", taskInfo.BuildID),
@@ -1092,10 +1301,9 @@ func (mt *metaTable) MarkSegmentWriteHandoff(segID UniqueID) error {
 }
 
 func (mt *metaTable) AlreadyWrittenHandoff(segID UniqueID) bool {
-	mt.segmentIndexLock.RLock()
-	defer mt.segmentIndexLock.RUnlock()
+	snapshot := mt.loadSegmentIndexSnapshot()
 
-	if segIndexes, ok := mt.segmentIndexes[segID]; ok {
+	if segIndexes, ok := snapshot.getSegmentIndexes(segID); ok {
 		for _, segIdx := range segIndexes {
 			if !segIdx.WriteHandoff {
 				return false
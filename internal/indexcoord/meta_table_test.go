@@ -18,6 +18,7 @@ package indexcoord
 
 import (
 	"errors"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -1215,3 +1217,136 @@ func TestMetaTable_GetDeletedSegmentIndexes(t *testing.T) {
 	segIndexes := mt.GetDeletedSegmentIndexes()
 	assert.Equal(t, 1, len(segIndexes))
 }
+
+func TestMetaTable_Snapshot(t *testing.T) {
+	mt := constructMetaTable(&indexcoord.Catalog{
+		Txn: &mockETCDKV{
+			save: func(s string, s2 string) error {
+				return nil
+			},
+			multiSave: func(m map[string]string) error {
+				return nil
+			},
+			remove: func(s string) error {
+				return nil
+			},
+		},
+	})
+
+	indexSnapshot := mt.loadIndexSnapshot()
+	segIdxSnapshot := mt.loadSegmentIndexSnapshot()
+	assert.Same(t, indexSnapshot, mt.loadIndexSnapshot())
+	assert.Same(t, segIdxSnapshot, mt.loadSegmentIndexSnapshot())
+
+	err := mt.CreateIndex(&model.Index{
+		CollectionID: collID,
+		FieldID:      fieldID + 1,
+		IndexID:      indexID + 1,
+		IndexName:    "index2",
+	})
+	assert.NoError(t, err)
+	err = mt.FinishTask(&indexpb.IndexTaskInfo{
+		BuildID: buildID,
+		State:   commonpb.IndexState_Failed,
+	})
+	assert.NoError(t, err)
+
+	// the snapshots loaded before are never modified
+	assert.Equal(t, 1, len(indexSnapshot.collectionIndexes[collID]))
+	segIdx, ok := segIdxSnapshot.getSegmentIndex(buildID)
+	require.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Finished, segIdx.IndexState)
+	assert.Equal(t, 2, len(mt.loadIndexSnapshot().collectionIndexes[collID]))
+	segIdx, ok = mt.loadSegmentIndexSnapshot().getSegmentIndex(buildID)
+	require.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Failed, segIdx.IndexState)
+	assert.Equal(t, commonpb.IndexState_Failed, mt.GetSegmentIndexes(segID)[0].IndexState)
+
+	// only the shards changed are copied
+	latest := mt.loadSegmentIndexSnapshot()
+	for i := 0; i < segmentIndexShardNum; i++ {
+		if i == segmentIndexShard(segID) {
+			assert.NotEqual(t, reflect.ValueOf(segIdxSnapshot.segmentIndexes[i]).Pointer(), reflect.ValueOf(latest.segmentIndexes[i]).Pointer())
+			continue
+		}
+		assert.Equal(t, reflect.ValueOf(segIdxSnapshot.segmentIndexes[i]).Pointer(), reflect.ValueOf(latest.segmentIndexes[i]).Pointer())
+	}
+
+	err = mt.RemoveSegmentIndex(collID, partID, segID, buildID)
+	assert.NoError(t, err)
+	_, ok = segIdxSnapshot.getSegmentIndexes(segID)
+	assert.True(t, ok)
+	assert.False(t, mt.HasBuildID(buildID))
+	assert.Empty(t, mt.GetSegmentIndexes(segID))
+
+	err = mt.RemoveIndex(collID, indexID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(indexSnapshot.collectionIndexes[collID]))
+	assert.Equal(t, "", mt.GetIndexNameByID(collID, indexID))
+	assert.Equal(t, "index2", mt.GetIndexNameByID(collID, indexID+1))
+}
+
+func benchmarkMetaTable(b *testing.B) *metaTable {
+	mt := constructMetaTable(&indexcoord.Catalog{
+		Txn: &mockETCDKV{
+			multiSave: func(m map[string]string) error {
+				return nil
+			},
+		},
+	})
+	segIdxes := make([]*model.SegmentIndex, 0, 10000)
+	for i := 0; i < 10000; i++ {
+		segIdxes = append(segIdxes, &model.SegmentIndex{
+			CollectionID: collID,
+			PartitionID:  partID,
+			SegmentID:    segID + UniqueID(i) + 1,
+			IndexID:      indexID,
+			BuildID:      buildID + UniqueID(i) + 1,
+			IndexState:   commonpb.IndexState_Finished,
+		})
+	}
+	mt.segmentIndexLock.Lock()
+	mt.updateSegmentIndexes(segIdxes...)
+	mt.segmentIndexLock.Unlock()
+	b.ResetTimer()
+	return mt
+}
+
+func BenchmarkMetaTable_GetSegmentIndexes(b *testing.B) {
+	mt := benchmarkMetaTable(b)
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			mt.GetSegmentIndexes(segID + UniqueID(i%10000) + 1)
+			mt.GetIndexIDByName(collID, indexName)
+			i++
+		}
+	})
+}
+
+func BenchmarkMetaTable_GetSegmentIndexesWithWrites(b *testing.B) {
+	mt := benchmarkMetaTable(b)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+				_ = mt.FinishTask(&indexpb.IndexTaskInfo{
+					BuildID: buildID + UniqueID(i%10000) + 1,
+					State:   commonpb.IndexState_Finished,
+				})
+			}
+		}
+	}()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			mt.GetSegmentIndexes(segID + UniqueID(i%10000) + 1)
+			mt.GetIndexIDByName(collID, indexName)
+			i++
+		}
+	})
+}
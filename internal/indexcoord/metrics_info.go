@@ -60,6 +60,7 @@ func getSystemInfoMetrics(
 			SystemConfigurations: metricsinfo.IndexCoordConfiguration{
 				MinioBucketName: Params.MinioCfg.BucketName.GetValue(),
 			},
+			GCRuns: coord.garbageCollector.LastRuns(),
 		},
 		ConnectedNodes: make([]metricsinfo.IndexNodeInfos, 0),
 	}
@@ -113,6 +114,7 @@ func getSystemInfoMetrics(
 			})
 			continue
 		}
+		infos.Cordoned = coord.nodeManager.isCordoned(infos.ID)
 		clusterTopology.ConnectedNodes = append(clusterTopology.ConnectedNodes, infos)
 	}
 
@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// cordonedNodePrefix is the etcd prefix of the cordoned IndexNodes, one key per node.
+// It must not share a prefix with util.SegmentIndexPrefix or util.FieldIndexPrefix.
+const cordonedNodePrefix = "indexcoord-cordoned-node"
+
+var errNodeNotFound = errors.New("IndexNode not found")
+
+func cordonedNodeKey(nodeID UniqueID) string {
+	return path.Join(cordonedNodePrefix, strconv.FormatInt(nodeID, 10))
+}
+
+// loadCordonedNodes reloads the cordoned IndexNodes from the kv, the later cordons are persisted to it as well.
+// The nodes are cordoned by ID, a node cordoned before IndexCoord restarts stays cordoned once it's added again.
+func (nm *NodeManager) loadCordonedNodes(kv kv.MetaKv) error {
+	keys, _, err := kv.LoadWithPrefix(cordonedNodePrefix)
+	if err != nil {
+		return err
+	}
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	nm.cordonKV = kv
+	for _, key := range keys {
+		nodeID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("IndexCoord skip invalid cordoned IndexNode", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		nm.cordonedNodes[nodeID] = struct{}{}
+	}
+	log.Info("IndexCoord load cordoned IndexNodes done", zap.Int("num", len(nm.cordonedNodes)))
+	return nil
+}
+
+// CordonNode stops assigning new builds to the IndexNode, the builds assigned already run to the end.
+func (nm *NodeManager) CordonNode(nodeID UniqueID) error {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if _, ok := nm.nodeClients[nodeID]; !ok {
+		return fmt.Errorf("%w: %d", errNodeNotFound, nodeID)
+	}
+	if _, ok := nm.cordonedNodes[nodeID]; ok {
+		return nil
+	}
+	if nm.cordonKV != nil {
+		if err := nm.cordonKV.Save(cordonedNodeKey(nodeID), ""); err != nil {
+			return err
+		}
+	}
+	nm.cordonedNodes[nodeID] = struct{}{}
+	log.Info("IndexCoord cordon IndexNode", zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+// UncordonNode assigns new builds to the IndexNode again.
+func (nm *NodeManager) UncordonNode(nodeID UniqueID) error {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if _, ok := nm.cordonedNodes[nodeID]; !ok {
+		return nil
+	}
+	if nm.cordonKV != nil {
+		if err := nm.cordonKV.Remove(cordonedNodeKey(nodeID)); err != nil {
+			return err
+		}
+	}
+	delete(nm.cordonedNodes, nodeID)
+	log.Info("IndexCoord uncordon IndexNode", zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+// dropCordon forgets the cordon of the IndexNode gone offline.
+func (nm *NodeManager) dropCordon(nodeID UniqueID) {
+	if err := nm.UncordonNode(nodeID); err != nil {
+		log.Warn("IndexCoord failed to drop the cordon of the offline IndexNode", zap.Int64("nodeID", nodeID), zap.Error(err))
+	}
+}
+
+// isCordoned returns true if the IndexNode is cordoned.
+func (nm *NodeManager) isCordoned(nodeID UniqueID) bool {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+	_, ok := nm.cordonedNodes[nodeID]
+	return ok
+}
+
+// getNodeStates returns the maintenance states of the online IndexNodes, sorted by node ID.
+func (nm *NodeManager) getNodeStates() []*indexpb.IndexNodeState {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+	states := make([]*indexpb.IndexNodeState, 0, len(nm.nodeClients))
+	for nodeID := range nm.nodeClients {
+		_, cordoned := nm.cordonedNodes[nodeID]
+		_, stopping := nm.stoppingNodes[nodeID]
+		states = append(states, &indexpb.IndexNodeState{NodeID: nodeID, Cordoned: cordoned, Stopping: stopping})
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].NodeID < states[j].NodeID
+	})
+	return states
+}
+
+// CordonIndexNode cordons or uncordons an IndexNode, the states of the online IndexNodes are returned.
+func (i *IndexCoord) CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+	if !i.isHealthy() {
+		log.Warn(msgIndexCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.CordonIndexNodeResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	var err error
+	if req.GetCordon() {
+		err = i.nodeManager.CordonNode(req.GetNodeID())
+	} else {
+		err = i.nodeManager.UncordonNode(req.GetNodeID())
+	}
+	if err != nil {
+		log.Warn("IndexCoord failed to change the cordon of IndexNode", zap.Int64("nodeID", req.GetNodeID()),
+			zap.Bool("cordon", req.GetCordon()), zap.Error(err))
+		errCode := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, errNodeNotFound) {
+			errCode = commonpb.ErrorCode_IllegalArgument
+		}
+		return &indexpb.CordonIndexNodeResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errCode,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	i.metricsCacheManager.InvalidateSystemInfoMetrics()
+	return &indexpb.CordonIndexNodeResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		States: i.nodeManager.getNodeStates(),
+	}, nil
+}
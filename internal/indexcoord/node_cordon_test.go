@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestNodeManager_Cordon(t *testing.T) {
+	kv, saved := newStatisticsTestKV()
+	kv.remove = func(key string) error {
+		delete(saved, key)
+		return nil
+	}
+	saved[cordonedNodePrefix+"/invalid"] = ""
+	saved[cordonedNodeKey(3)] = ""
+
+	nm := NewNodeManager(context.Background())
+	require.NoError(t, nm.loadCordonedNodes(kv))
+	nm.setClient(1, &indexnode.Mock{})
+	nm.setClient(2, &indexnode.Mock{})
+	nm.setClient(3, &indexnode.Mock{})
+	assert.True(t, nm.isCordoned(3))
+	assert.Equal(t, 2, len(nm.GetAllClients()))
+
+	require.NoError(t, nm.CordonNode(1))
+	require.NoError(t, nm.CordonNode(1))
+	assert.ErrorIs(t, nm.CordonNode(4), errNodeNotFound)
+	assert.Contains(t, saved, cordonedNodeKey(1))
+	allClients := nm.GetAllClients()
+	assert.Equal(t, 1, len(allClients))
+	assert.Contains(t, allClients, UniqueID(2))
+	// the cordoned node still serves the builds assigned to it
+	_, ok := nm.GetClientByID(1)
+	assert.True(t, ok)
+
+	nm.StoppingNode(2)
+	assert.Equal(t, []*indexpb.IndexNodeState{
+		{NodeID: 1, Cordoned: true},
+		{NodeID: 2, Stopping: true},
+		{NodeID: 3, Cordoned: true},
+	}, nm.getNodeStates())
+
+	require.NoError(t, nm.UncordonNode(1))
+	require.NoError(t, nm.UncordonNode(4))
+	assert.False(t, nm.isCordoned(1))
+	assert.NotContains(t, saved, cordonedNodeKey(1))
+
+	nm.RemoveNode(3)
+	assert.False(t, nm.isCordoned(3))
+	assert.NotContains(t, saved, cordonedNodeKey(3))
+}
+
+func TestIndexCoord_CordonIndexNode(t *testing.T) {
+	ctx := context.Background()
+	ic := &IndexCoord{
+		session:             &sessionutil.Session{ServerID: 1},
+		nodeManager:         NewNodeManager(context.Background()),
+		metricsCacheManager: metricsinfo.NewMetricsCacheManager(),
+	}
+	ic.nodeManager.setClient(1, &indexnode.Mock{})
+	ic.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := ic.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 1, Cordon: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.True(t, ic.nodeManager.isCordoned(1))
+	assert.Equal(t, []*indexpb.IndexNodeState{{NodeID: 1, Cordoned: true}}, resp.GetStates())
+
+	resp, err = ic.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.False(t, ic.nodeManager.isCordoned(1))
+
+	resp, err = ic.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 2, Cordon: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	ic.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = ic.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 1, Cordon: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -18,6 +18,7 @@ package indexcoord
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"go.uber.org/zap"
@@ -25,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	grpcindexnodeclient "github.com/milvus-io/milvus/internal/distributed/indexnode/client"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -39,6 +41,14 @@ type NodeManager struct {
 	pq            *PriorityQueue
 	lock          sync.RWMutex
 	ctx           context.Context
+
+	// pendingBuilds are the builds assigned to each node and not finished yet
+	pendingBuilds map[UniqueID]map[UniqueID]*pendingBuild
+	assignSeq     uint64
+
+	// cordonedNodes receive no new builds, cordonKV persists them if not nil
+	cordonedNodes map[UniqueID]struct{}
+	cordonKV      kv.MetaKv
 }
 
 // NewNodeManager is used to create a new NodeManager.
@@ -49,8 +59,10 @@ func NewNodeManager(ctx context.Context) *NodeManager {
 		pq: &PriorityQueue{
 			policy: PeekClientV1,
 		},
-		lock: sync.RWMutex{},
-		ctx:  ctx,
+		lock:          sync.RWMutex{},
+		ctx:           ctx,
+		pendingBuilds: make(map[UniqueID]map[UniqueID]*pendingBuild),
+		cordonedNodes: make(map[UniqueID]struct{}),
 	}
 }
 
@@ -76,7 +88,10 @@ func (nm *NodeManager) RemoveNode(nodeID UniqueID) {
 	nm.lock.Lock()
 	delete(nm.nodeClients, nodeID)
 	delete(nm.stoppingNodes, nodeID)
+	delete(nm.pendingBuilds, nodeID)
 	nm.lock.Unlock()
+	// the node never comes back with the same ID
+	nm.dropCordon(nodeID)
 	nm.pq.Remove(nodeID)
 	metrics.IndexCoordIndexNodeNum.WithLabelValues().Dec()
 }
@@ -124,6 +139,9 @@ func (nm *NodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, types.Ind
 		log.Error("there is no IndexNode online")
 		return -1, nil
 	}
+	if Params.IndexCoordCfg.BuildLoadBalanceEnabled.GetAsBool() {
+		return nm.peekClientByLoad(allClients)
+	}
 
 	// Note: In order to quickly end other goroutines, an error is returned when the client is successfully selected
 	ctx, cancel := context.WithCancel(nm.ctx)
@@ -173,6 +191,55 @@ func (nm *NodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, types.Ind
 	return 0, nil
 }
 
+// peekClientByLoad peeks the client with free slots and the least pending build load.
+func (nm *NodeManager) peekClientByLoad(allClients map[UniqueID]types.IndexNode) (UniqueID, types.IndexNode) {
+	var (
+		available = make([]UniqueID, 0, len(allClients))
+		nodeMutex = sync.Mutex{}
+		wg        = sync.WaitGroup{}
+	)
+	for nodeID, client := range allClients {
+		nodeID := nodeID
+		client := client
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.GetJobStats(nm.ctx, &indexpb.GetJobStatsRequest{})
+			if err != nil {
+				log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+				return
+			}
+			if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+				log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID),
+					zap.String("reason", resp.Status.Reason))
+				return
+			}
+			if resp.TaskSlots > 0 {
+				nodeMutex.Lock()
+				defer nodeMutex.Unlock()
+				available = append(available, nodeID)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(available) == 0 {
+		log.RatedDebug(30, "IndexCoord peek client fail")
+		return 0, nil
+	}
+
+	loads := nm.getPendingLoads()
+	sort.Slice(available, func(i, j int) bool {
+		if loads[available[i]] != loads[available[j]] {
+			return loads[available[i]] < loads[available[j]]
+		}
+		return available[i] < available[j]
+	})
+	peekNodeID := available[0]
+	log.Info("IndexCoord peek client with the least pending load success", zap.Int64("nodeID", peekNodeID),
+		zap.Int64("pendingLoad", loads[peekNodeID]))
+	return peekNodeID, allClients[peekNodeID]
+}
+
 func (nm *NodeManager) ClientSupportDisk() bool {
 	log.Info("IndexCoord check if client support disk index")
 	allClients := nm.GetAllClients()
@@ -228,13 +295,16 @@ func (nm *NodeManager) ClientSupportDisk() bool {
 	return false
 }
 
+// GetAllClients returns the clients of the IndexNodes accepting new builds, the stopping and cordoned nodes are excluded.
 func (nm *NodeManager) GetAllClients() map[UniqueID]types.IndexNode {
 	nm.lock.RLock()
 	defer nm.lock.RUnlock()
 
 	allClients := make(map[UniqueID]types.IndexNode, len(nm.nodeClients))
 	for nodeID, client := range nm.nodeClients {
-		if _, ok := nm.stoppingNodes[nodeID]; !ok {
+		_, stopping := nm.stoppingNodes[nodeID]
+		_, cordoned := nm.cordonedNodes[nodeID]
+		if !stopping && !cordoned {
 			allClients[nodeID] = client
 		}
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
 )
 
 type task interface {
@@ -135,33 +136,42 @@ func (cit *CreateIndexTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
-func (cit *CreateIndexTask) createIndexAtomic(index *model.Index, segmentsInfo []*datapb.SegmentInfo) ([]UniqueID, []*datapb.SegmentInfo, error) {
+// createIndexAtomic creates the segment indexes of the flushed segments walked page by page, and then the index, so
+// that the index is never seen without the segment indexes of the segments flushed before it.
+func (cit *CreateIndexTask) createIndexAtomic(index *model.Index,
+	walk func(fn func(segmentsInfo []*datapb.SegmentInfo) error) error) ([]UniqueID, []*datapb.SegmentInfo, error) {
 	buildIDs := make([]UniqueID, 0)
 	segments := make([]*datapb.SegmentInfo, 0)
-	for _, segmentInfo := range segmentsInfo {
-		segIdx := &model.SegmentIndex{
-			SegmentID:    segmentInfo.ID,
-			CollectionID: segmentInfo.CollectionID,
-			PartitionID:  segmentInfo.PartitionID,
-			NumRows:      segmentInfo.NumOfRows,
-			IndexID:      cit.indexID,
-			CreateTime:   cit.req.GetTimestamp(),
+	err := walk(func(segmentsInfo []*datapb.SegmentInfo) error {
+		for _, segmentInfo := range segmentsInfo {
+			segIdx := &model.SegmentIndex{
+				SegmentID:    segmentInfo.ID,
+				CollectionID: segmentInfo.CollectionID,
+				PartitionID:  segmentInfo.PartitionID,
+				NumRows:      segmentInfo.NumOfRows,
+				IndexID:      cit.indexID,
+				CreateTime:   cit.req.GetTimestamp(),
+			}
+			have, buildID, err := cit.indexCoordClient.createIndexForSegment(segIdx)
+			if err != nil {
+				log.Error("IndexCoord create index on segment fail", zap.Int64("collectionID", cit.req.CollectionID),
+					zap.Int64("fieldID", cit.req.FieldID), zap.String("indexName", cit.req.IndexName),
+					zap.Int64("segmentID", segIdx.SegmentID), zap.Error(err))
+				return err
+			}
+			if have || buildID == 0 {
+				continue
+			}
+			segments = append(segments, segmentInfo)
+			buildIDs = append(buildIDs, buildID)
 		}
-		have, buildID, err := cit.indexCoordClient.createIndexForSegment(segIdx)
-		if err != nil {
-			log.Error("IndexCoord create index on segment fail", zap.Int64("collectionID", cit.req.CollectionID),
-				zap.Int64("fieldID", cit.req.FieldID), zap.String("indexName", cit.req.IndexName),
-				zap.Int64("segmentID", segIdx.SegmentID), zap.Error(err))
-			return nil, nil, err
-		}
-		if have || buildID == 0 {
-			continue
-		}
-		segments = append(segments, segmentInfo)
-		buildIDs = append(buildIDs, buildID)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	err := cit.table.CreateIndex(index)
+	err = cit.table.CreateIndex(index)
 	if err != nil {
 		log.Error("IndexCoord create index fail", zap.Int64("collectionID", cit.req.CollectionID),
 			zap.Int64("fieldID", cit.req.FieldID), zap.String("indexName", cit.req.IndexName), zap.Error(err))
@@ -195,37 +205,34 @@ func (cit *CreateIndexTask) Execute(ctx context.Context) error {
 	cit.indexCoordClient.indexGCLock.RLock()
 	defer cit.indexCoordClient.indexGCLock.RUnlock()
 
-	// Get flushed segments
-	flushedSegments, err := cit.dataCoordClient.GetFlushedSegments(cit.ctx, &datapb.GetFlushedSegmentsRequest{
-		Base: commonpbutil.NewMsgBase(
-			commonpbutil.WithMsgType(0),
-			commonpbutil.WithMsgID(cit.indexID),
-			commonpbutil.WithTimeStamp(cit.req.Timestamp),
-			commonpbutil.WithSourceID(paramtable.GetNodeID()),
-		),
-		CollectionID: cit.req.CollectionID,
-		PartitionID:  -1,
-	})
-	if err != nil {
-		log.Error("IndexCoord get flushed segments from datacoord fail", zap.Int64("collectionID", cit.req.CollectionID),
-			zap.Int64("fieldID", cit.req.FieldID), zap.String("indexName", cit.req.IndexName), zap.Error(err))
-		return err
-	}
-
-	log.Debug("IndexCoord get flushed segment from DataCoord success", zap.Int64("collectionID", cit.req.CollectionID),
-		zap.Int64s("flushed segments", flushedSegments.Segments))
-	segmentsInfo, err := cit.dataCoordClient.GetSegmentInfo(cit.ctx, &datapb.GetSegmentInfoRequest{
-		SegmentIDs:       flushedSegments.Segments,
-		IncludeUnHealthy: true,
-	})
-
-	if err != nil {
-		log.Error("IndexCoord get segment info from DataCoord fail", zap.Int64s("segIDs", flushedSegments.Segments),
-			zap.Error(err))
-		return err
+	// Get the flushed segments page by page
+	walkFlushedSegments := func(fn func(segmentsInfo []*datapb.SegmentInfo) error) error {
+		return segmentutil.WalkFlushedSegments(cit.ctx, cit.dataCoordClient, &datapb.GetFlushedSegmentsRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(0),
+				commonpbutil.WithMsgID(cit.indexID),
+				commonpbutil.WithTimeStamp(cit.req.Timestamp),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			CollectionID: cit.req.CollectionID,
+			PartitionID:  -1,
+		}, segmentutil.DefaultFlushedSegmentsPageSize, func(flushedSegments []int64) error {
+			log.Debug("IndexCoord get flushed segment from DataCoord success", zap.Int64("collectionID", cit.req.CollectionID),
+				zap.Int64s("flushed segments", flushedSegments))
+			segmentsInfo, err := cit.dataCoordClient.GetSegmentInfo(cit.ctx, &datapb.GetSegmentInfoRequest{
+				SegmentIDs:       flushedSegments,
+				IncludeUnHealthy: true,
+			})
+			if err != nil {
+				log.Error("IndexCoord get segment info from DataCoord fail", zap.Int64s("segIDs", flushedSegments),
+					zap.Error(err))
+				return err
+			}
+			return fn(segmentsInfo.GetInfos())
+		})
 	}
 
-	buildIDs, segments, err := cit.createIndexAtomic(index, segmentsInfo.GetInfos())
+	buildIDs, segments, err := cit.createIndexAtomic(index, walkFlushedSegments)
 	if err != nil {
 		log.Error("IndexCoord create index fail", zap.Int64("collectionID", cit.req.CollectionID),
 			zap.Int64("fieldID", cit.req.FieldID), zap.String("indexName", cit.req.IndexName), zap.Error(err))
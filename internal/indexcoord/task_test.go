@@ -136,7 +136,9 @@ func Test_createIndexAtomic(t *testing.T) {
 		},
 	}
 
-	buildIDs, segs, err := cit.createIndexAtomic(index, segmentsInfo)
+	buildIDs, segs, err := cit.createIndexAtomic(index, func(fn func(segmentsInfo []*datapb.SegmentInfo) error) error {
+		return fn(segmentsInfo)
+	})
 	// index already exist
 	assert.Equal(t, 0, len(buildIDs))
 	assert.Equal(t, 0, len(segs))
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	buildEventEnqueued = internalpb.IndexBuildEventType_BuildEventEnqueued
+	buildEventStarted  = internalpb.IndexBuildEventType_BuildEventStarted
+	buildEventPhase    = internalpb.IndexBuildEventType_BuildEventPhase
+	buildEventFinished = internalpb.IndexBuildEventType_BuildEventFinished
+	buildEventFailed   = internalpb.IndexBuildEventType_BuildEventFailed
+
+	buildEventBufferSize = 1024
+)
+
+// buildEventPublisher publishes the build events to the msgstream asynchronously,
+// events are dropped if the buffer is full so that index building is never blocked by the msgstream.
+type buildEventPublisher struct {
+	stream msgstream.MsgStream
+	events chan *internalpb.IndexBuildEvent
+
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newBuildEventPublisher(ctx context.Context, factory msgstream.Factory) (*buildEventPublisher, error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	channel := Params.CommonCfg.IndexNodeBuildEvent.GetValue()
+	stream.AsProducer([]string{channel})
+	log.Info("IndexNode publishes build events", zap.String("channel", channel))
+
+	p := &buildEventPublisher{
+		stream: stream,
+		events: make(chan *internalpb.IndexBuildEvent, buildEventBufferSize),
+	}
+	p.wg.Add(1)
+	go p.work()
+	return p, nil
+}
+
+// Publish enqueues the event without blocking.
+func (p *buildEventPublisher) Publish(event *internalpb.IndexBuildEvent) {
+	select {
+	case p.events <- event:
+	default:
+		log.RatedWarn(10, "build event buffer is full, drop the event",
+			zap.Int64("buildID", event.GetBuildID()), zap.String("type", event.GetEventType().String()))
+	}
+}
+
+func (p *buildEventPublisher) work() {
+	defer p.wg.Done()
+	for event := range p.events {
+		if err := p.produce(event); err != nil {
+			log.Warn("failed to publish build event", zap.Int64("buildID", event.GetBuildID()),
+				zap.String("type", event.GetEventType().String()), zap.Error(err))
+		}
+	}
+}
+
+func (p *buildEventPublisher) produce(event *internalpb.IndexBuildEvent) error {
+	ts := event.GetBase().GetTimestamp()
+	msg := &msgstream.IndexBuildEventMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            context.Background(),
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			HashValues:     []uint32{0},
+		},
+		IndexBuildEvent: *event,
+	}
+	return p.stream.Produce(&msgstream.MsgPack{
+		BeginTs: ts,
+		EndTs:   ts,
+		Msgs:    []msgstream.TsMsg{msg},
+	})
+}
+
+// Close flushes the buffered events and closes the msgstream.
+func (p *buildEventPublisher) Close() {
+	p.closeOnce.Do(func() {
+		close(p.events)
+		p.wg.Wait()
+		p.stream.Close()
+	})
+}
+
+// publishEvent publishes a lifecycle event of the task if build events are enabled.
+func (it *indexBuildTask) publishEvent(eventType internalpb.IndexBuildEventType, phase string, state commonpb.IndexState, failReason string) {
+	if it.node == nil || it.node.buildEvents == nil {
+		return
+	}
+	now := time.Now()
+	event := &internalpb.IndexBuildEvent{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(msgstream.MsgTypeIndexBuildEvent),
+			commonpbutil.WithMsgID(it.BuildID),
+			commonpbutil.WithTimeStamp(tsoutil.ComposeTSByTime(now, 0)),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		EventType:    eventType,
+		ClusterID:    it.ClusterID,
+		BuildID:      it.BuildID,
+		NodeID:       it.nodeID,
+		CollectionID: it.collectionID,
+		SegmentID:    it.segmentID,
+		FieldID:      it.fieldID,
+		Phase:        phase,
+		State:        state,
+		FailReason:   failReason,
+	}
+	if failReason != "" {
+		class, _, _ := common.ParseIndexFailReason(failReason)
+		event.FailClass = string(class)
+	}
+	if eventType == buildEventFinished {
+		event.SerializedSize = it.serializedSize
+	}
+	if !it.lastEventAt.IsZero() {
+		event.DurationMs = now.Sub(it.lastEventAt).Milliseconds()
+	}
+	if it.statistic.StartTime > 0 {
+		event.ElapsedMs = now.Sub(time.UnixMicro(it.statistic.StartTime)).Milliseconds()
+	}
+	it.lastEventAt = now
+	it.node.buildEvents.Publish(event)
+}
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+)
+
+type buildEventStream struct {
+	*msgstream.MockMsgStream
+
+	mu       sync.Mutex
+	channels []string
+	packs    []*msgstream.MsgPack
+	closed   bool
+}
+
+func (s *buildEventStream) AsProducer(channels []string) {
+	s.channels = channels
+}
+
+func (s *buildEventStream) Produce(pack *msgstream.MsgPack) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.packs = append(s.packs, pack)
+	return nil
+}
+
+func (s *buildEventStream) Close() {
+	s.closed = true
+}
+
+func TestBuildEventPublisher(t *testing.T) {
+	stream := &buildEventStream{MockMsgStream: msgstream.NewMockMsgStream()}
+	factory := msgstream.NewMockMqFactory()
+	factory.NewMsgStreamFunc = func(ctx context.Context) (msgstream.MsgStream, error) {
+		return stream, nil
+	}
+	publisher, err := newBuildEventPublisher(context.Background(), factory)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{Params.CommonCfg.IndexNodeBuildEvent.GetValue()}, stream.channels)
+
+	node := &IndexNode{buildEvents: publisher}
+	task := &indexBuildTask{
+		BuildID:      100,
+		ClusterID:    "cluster",
+		collectionID: 1,
+		segmentID:    2,
+		fieldID:      3,
+		nodeID:       4,
+		node:         node,
+	}
+	task.publishEvent(buildEventEnqueued, "", commonpb.IndexState_Unissued, "")
+	task.publishEvent(buildEventPhase, "build_index", commonpb.IndexState_InProgress, "")
+	task.serializedSize = 1024
+	task.publishEvent(buildEventFinished, "", commonpb.IndexState_Finished, "")
+	publisher.Close()
+	assert.True(t, stream.closed)
+
+	assert.Equal(t, 3, len(stream.packs))
+	events := make([]*msgstream.IndexBuildEventMsg, 0, len(stream.packs))
+	for _, pack := range stream.packs {
+		assert.Equal(t, 1, len(pack.Msgs))
+		msg, ok := pack.Msgs[0].(*msgstream.IndexBuildEventMsg)
+		assert.True(t, ok)
+		assert.Equal(t, msgstream.MsgTypeIndexBuildEvent, msg.Type())
+		assert.Equal(t, int64(100), msg.ID())
+		assert.Equal(t, msg.GetBase().GetTimestamp(), msg.BeginTs())
+		events = append(events, msg)
+	}
+	assert.Equal(t, buildEventEnqueued, events[0].GetEventType())
+	assert.Equal(t, commonpb.IndexState_Unissued, events[0].GetState())
+	assert.Equal(t, "build_index", events[1].GetPhase())
+	assert.Equal(t, buildEventFinished, events[2].GetEventType())
+	assert.Equal(t, uint64(1024), events[2].GetSerializedSize())
+	assert.Equal(t, int64(2), events[2].GetSegmentID())
+	assert.Equal(t, "cluster", events[2].GetClusterID())
+
+	t.Run("disabled", func(t *testing.T) {
+		task := &indexBuildTask{BuildID: 101, node: &IndexNode{}}
+		task.publishEvent(buildEventEnqueued, "", commonpb.IndexState_Unissued, "")
+		assert.True(t, task.lastEventAt.IsZero())
+	})
+}
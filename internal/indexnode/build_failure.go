@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+const (
+	buildPhasePrepare        = "prepare"
+	buildPhaseLoadData       = "load_data"
+	buildPhaseBuildIndex     = "build_index"
+	buildPhaseSaveIndexFiles = "save_index_files"
+)
+
+// oomMessages are the messages of the engine errors caused by running out of memory.
+var oomMessages = []string{"bad_alloc", "out of memory", "memory is not enough"}
+
+// classifyBuildFailure classifies the error failed the build phase. The errors already classified are kept,
+// the others are classified by the phase: invalid params in prepare, storage IO in loading data and saving
+// index files, engine errors in building, and the engine error code is parsed from the "[code] message" of cgo.
+func classifyBuildFailure(phase string, err error) *common.IndexFailure {
+	var failure *common.IndexFailure
+	if errors.As(err, &failure) {
+		return failure
+	}
+	if err == errCancel || errors.Is(err, context.Canceled) {
+		return common.NewIndexFailure(common.IndexFailureCancelled, "", err)
+	}
+	if errors.Is(err, ErrNoSuchKey) {
+		return common.NewIndexFailure(common.IndexFailureStorageIO, ErrNoSuchKey.Error(), err)
+	}
+
+	msg := err.Error()
+	for _, oomMsg := range oomMessages {
+		if strings.Contains(msg, oomMsg) {
+			return common.NewIndexFailure(common.IndexFailureOOM, parseEngineErrorCode(msg), err)
+		}
+	}
+	switch phase {
+	case buildPhasePrepare:
+		return common.NewIndexFailure(common.IndexFailureInvalidParams, "", err)
+	case buildPhaseLoadData, buildPhaseSaveIndexFiles:
+		return common.NewIndexFailure(common.IndexFailureStorageIO, "", err)
+	case buildPhaseBuildIndex:
+		return common.NewIndexFailure(common.IndexFailureEngine, parseEngineErrorCode(msg), err)
+	default:
+		return common.NewIndexFailure(common.IndexFailureUnknown, "", err)
+	}
+}
+
+// parseEngineErrorCode returns the code of the "[code] message" errors returned by cgo.
+func parseEngineErrorCode(msg string) string {
+	if !strings.HasPrefix(msg, "[") {
+		return ""
+	}
+	end := strings.Index(msg, "]")
+	if end < 0 {
+		return ""
+	}
+	return msg[1:end]
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+func TestClassifyBuildFailure(t *testing.T) {
+	cases := []struct {
+		phase string
+		err   error
+		class common.IndexFailureClass
+		code  string
+	}{
+		{buildPhaseLoadData, errCancel, common.IndexFailureCancelled, ""},
+		{buildPhaseBuildIndex, fmt.Errorf("wrapped: %w", context.Canceled), common.IndexFailureCancelled, ""},
+		{buildPhaseLoadData, ErrNoSuchKey, common.IndexFailureStorageIO, "NoSuchKey"},
+		{buildPhasePrepare, errors.New("invalid composite fields"), common.IndexFailureInvalidParams, ""},
+		{buildPhaseLoadData, errors.New("connection reset"), common.IndexFailureStorageIO, ""},
+		{buildPhaseSaveIndexFiles, errors.New("auth failed"), common.IndexFailureStorageIO, ""},
+		{buildPhaseBuildIndex, errors.New("[UnexpectedError] std::bad_alloc"), common.IndexFailureOOM, "UnexpectedError"},
+		{buildPhaseBuildIndex, errors.New("[IllegalArgument] invalid nlist"), common.IndexFailureEngine, "IllegalArgument"},
+		{buildPhaseBuildIndex, errors.New("index node don't support build disk index"), common.IndexFailureEngine, ""},
+		{"", errors.New("unknown"), common.IndexFailureUnknown, ""},
+		// classified by the phase
+		{buildPhaseLoadData, common.NewIndexFailure(common.IndexFailureCorruptBinlog, "", errors.New("bad magic")),
+			common.IndexFailureCorruptBinlog, ""},
+	}
+	for _, c := range cases {
+		failure := classifyBuildFailure(c.phase, c.err)
+		assert.Equal(t, c.class, failure.Class, c.err.Error())
+		assert.Equal(t, c.code, failure.Code, c.err.Error())
+		assert.True(t, errors.Is(failure, c.err))
+	}
+}
+
+func TestIndexNode_StoreTaskFailClass(t *testing.T) {
+	node := &IndexNode{tasks: make(map[taskKey]*taskInfo)}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{state: commonpb.IndexState_InProgress})
+	failure := common.NewIndexFailure(common.IndexFailureStorageIO, "", errors.New("auth failed"))
+	node.storeTaskState("cluster", 1, commonpb.IndexState_Retry, failure.Error())
+	assert.Equal(t, common.IndexFailureStorageIO, node.tasks[taskKey{ClusterID: "cluster", BuildID: 1}].failClass)
+
+	node.storeTaskState("cluster", 1, commonpb.IndexState_Finished, "")
+	assert.Equal(t, common.IndexFailureClass(""), node.tasks[taskKey{ClusterID: "cluster", BuildID: 1}].failClass)
+}
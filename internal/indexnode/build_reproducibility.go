@@ -0,0 +1,333 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// buildSeed returns the deterministic seed of the builds of the index, it's the same for all the segments.
+func buildSeed(indexID int64) int64 {
+	h := fnv.New32a()
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(indexID))
+	_, _ = h.Write(b)
+	return int64(h.Sum32() & math.MaxInt32)
+}
+
+// simdFeatures are the cpu flags of the simd instructions which may change the index built.
+var simdFeatures = []string{"sse4_2", "avx", "avx2", "fma", "avx512f", "avx512dq", "avx512bw", "avx512vl", "asimd", "sve"}
+
+// cpuFeatures returns the simd features of the cpu which may change the index built.
+func cpuFeatures() []string {
+	flags := typeutil.NewSet(hardware.GetCPUFlags()...)
+	features := make([]string, 0)
+	for _, feature := range simdFeatures {
+		if flags.Contain(feature) {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// captureBuildEnvironment returns the software and hardware the indexes are built with on this node.
+func captureBuildEnvironment() storage.IndexBuildEnvironment {
+	return storage.IndexBuildEnvironment{
+		KnowhereVersion: common.KnowhereVersion,
+		MilvusVersion:   os.Getenv(metricsinfo.GitBuildTagsEnvKey),
+		GitCommit:       os.Getenv(metricsinfo.GitCommitEnvKey),
+		GoVersion:       runtime.Version(),
+		OS:              runtime.GOOS,
+		Arch:            runtime.GOARCH,
+		NumCPU:          runtime.NumCPU(),
+		CPUFeatures:     cpuFeatures(),
+		SimdType:        Params.CommonCfg.SimdType.GetValue(),
+	}
+}
+
+// diffBuildEnvironment returns the differences of the environment b from a, one per field.
+func diffBuildEnvironment(a, b storage.IndexBuildEnvironment) []string {
+	diffs := make([]string, 0)
+	diff := func(name string, x, y interface{}) {
+		if fmt.Sprint(x) != fmt.Sprint(y) {
+			diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", name, x, y))
+		}
+	}
+	diff("knowhere_version", a.KnowhereVersion, b.KnowhereVersion)
+	diff("milvus_version", a.MilvusVersion, b.MilvusVersion)
+	diff("git_commit", a.GitCommit, b.GitCommit)
+	diff("go_version", a.GoVersion, b.GoVersion)
+	diff("os", a.OS, b.OS)
+	diff("arch", a.Arch, b.Arch)
+	diff("num_cpu", a.NumCPU, b.NumCPU)
+	diff("cpu_features", a.CPUFeatures, b.CPUFeatures)
+	diff("simd_type", a.SimdType, b.SimdType)
+	return diffs
+}
+
+// setBuildSeed sets the seed of the build in the index params, the seed given by the index params is kept.
+func (it *indexBuildTask) setBuildSeed(indexParams map[string]string) error {
+	if value, ok := indexParams[common.IndexBuildSeedKey]; ok {
+		seed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s: %w", common.IndexBuildSeedKey, value, err)
+		}
+		it.seed = seed
+		return nil
+	}
+	if it.seed == 0 {
+		it.seed = buildSeed(it.req.GetIndexID())
+	}
+	indexParams[common.IndexBuildSeedKey] = strconv.FormatInt(it.seed, 10)
+	return nil
+}
+
+// recordChecksums records the sha256 of the index blobs if the build is reproducible.
+func (it *indexBuildTask) recordChecksums(indexBlobs []*storage.Blob) {
+	if !it.reproducible {
+		return
+	}
+	it.checksums = make(map[string]string, len(indexBlobs))
+	for _, blob := range indexBlobs {
+		sum := sha256.Sum256(blob.Value)
+		it.checksums[blob.Key] = hex.EncodeToString(sum[:])
+	}
+}
+
+// buildRecord returns the record of the build saved in the index manifest.
+func (it *indexBuildTask) buildRecord() *storage.IndexBuildRecord {
+	return &storage.IndexBuildRecord{
+		CollectionID: it.collectionID,
+		PartitionID:  it.partitionID,
+		SegmentID:    it.segmentID,
+		FieldID:      it.fieldID,
+		IndexID:      it.req.GetIndexID(),
+		IndexName:    it.req.GetIndexName(),
+		NumRows:      it.req.GetNumRows(),
+		DataPaths:    it.req.GetDataPaths(),
+		TypeParams:   funcutil.KeyValuePair2Map(it.req.GetTypeParams()),
+		IndexParams:  funcutil.KeyValuePair2Map(it.req.GetIndexParams()),
+		Seed:         it.seed,
+		Environment:  captureBuildEnvironment(),
+		Checksums:    it.checksums,
+	}
+}
+
+// indexFileVerification is the recorded and the rebuilt checksums of an index blob, empty if missing.
+type indexFileVerification struct {
+	Key      string
+	Expected string
+	Actual   string
+	Match    bool
+}
+
+// indexBuildVerification is the result of rebuilding a recorded index.
+type indexBuildVerification struct {
+	BuildID          int64
+	IndexVersion     int64
+	Match            bool
+	Files            []*indexFileVerification
+	EnvironmentDiffs []string
+}
+
+func compareChecksums(expected, actual map[string]string) ([]*indexFileVerification, bool) {
+	keys := make(map[string]struct{})
+	for key := range expected {
+		keys[key] = struct{}{}
+	}
+	for key := range actual {
+		keys[key] = struct{}{}
+	}
+	files := make([]*indexFileVerification, 0, len(keys))
+	match := true
+	for key := range keys {
+		file := &indexFileVerification{Key: key, Expected: expected[key], Actual: actual[key]}
+		file.Match = file.Expected != "" && file.Expected == file.Actual
+		match = match && file.Match
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Key < files[j].Key })
+	return files, match
+}
+
+// storageConfigFromParams returns the object storage config of this node.
+func storageConfigFromParams() *indexpb.StorageConfig {
+	if Params.CommonCfg.StorageType.GetValue() == "local" {
+		return &indexpb.StorageConfig{
+			RootPath:    Params.LocalStorageCfg.Path.GetValue(),
+			StorageType: Params.CommonCfg.StorageType.GetValue(),
+		}
+	}
+	return &indexpb.StorageConfig{
+		Address:         Params.MinioCfg.Address.GetValue(),
+		AccessKeyID:     Params.MinioCfg.AccessKeyID.GetValue(),
+		SecretAccessKey: Params.MinioCfg.SecretAccessKey.GetValue(),
+		UseSSL:          Params.MinioCfg.UseSSL.GetAsBool(),
+		BucketName:      Params.MinioCfg.BucketName.GetValue(),
+		RootPath:        Params.MinioCfg.RootPath.GetValue(),
+		UseIAM:          Params.MinioCfg.UseIAM.GetAsBool(),
+		IAMEndpoint:     Params.MinioCfg.IAMEndpoint.GetValue(),
+		StorageType:     Params.CommonCfg.StorageType.GetValue(),
+	}
+}
+
+// verifyIndexBuild rebuilds the index recorded in the manifest with the recorded params and seed, and compares the
+// checksums of the rebuilt index blobs with the recorded ones. The index files saved are left untouched.
+// The rebuild runs in the caller outside the task scheduler, the diskann indexes can't be verified.
+func (i *IndexNode) verifyIndexBuild(ctx context.Context, manifestPath string) (*indexBuildVerification, error) {
+	storageConfig := storageConfigFromParams()
+	cm, err := i.storageFactory.NewChunkManager(ctx, storageConfig)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := storage.ReadIndexManifest(ctx, cm, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	record := manifest.Build
+	if record == nil {
+		return nil, fmt.Errorf("index build %d is not recorded, it's not built in reproducibility mode", manifest.BuildID)
+	}
+	if record.IndexParams[common.IndexTypeKey] == indexparamcheck.IndexDISKANN || len(record.Checksums) == 0 {
+		return nil, fmt.Errorf("index build %d has no checksums to verify", manifest.BuildID)
+	}
+
+	req := &indexpb.CreateJobRequest{
+		ClusterID:     Params.CommonCfg.ClusterPrefix.GetValue(),
+		BuildID:       manifest.BuildID,
+		IndexVersion:  manifest.IndexVersion,
+		IndexID:       record.IndexID,
+		IndexName:     record.IndexName,
+		NumRows:       record.NumRows,
+		DataPaths:     record.DataPaths,
+		TypeParams:    funcutil.Map2KeyValuePair(record.TypeParams),
+		IndexParams:   funcutil.Map2KeyValuePair(record.IndexParams),
+		StorageConfig: storageConfig,
+	}
+	task := &indexBuildTask{
+		ident:        fmt.Sprintf("verify/%d", manifest.BuildID),
+		ctx:          ctx,
+		BuildID:      manifest.BuildID,
+		ClusterID:    req.GetClusterID(),
+		req:          req,
+		cm:           cm,
+		nodeID:       i.GetNodeID(),
+		tr:           timerecord.NewTimeRecorder(fmt.Sprintf("verify IndexBuildID: %d", manifest.BuildID)),
+		reproducible: true,
+		seed:         record.Seed,
+	}
+	if err := task.Prepare(ctx); err != nil {
+		return nil, err
+	}
+	if err := task.LoadData(ctx); err != nil {
+		return nil, err
+	}
+	if err := task.BuildIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	files, match := compareChecksums(record.Checksums, task.checksums)
+	ret := &indexBuildVerification{
+		BuildID:          manifest.BuildID,
+		IndexVersion:     manifest.IndexVersion,
+		Match:            match,
+		Files:            files,
+		EnvironmentDiffs: diffBuildEnvironment(record.Environment, captureBuildEnvironment()),
+	}
+	log.Ctx(ctx).Info("index build verified", zap.Int64("buildID", manifest.BuildID), zap.Bool("match", match),
+		zap.Strings("environmentDiffs", ret.EnvironmentDiffs))
+	return ret, nil
+}
+
+// VerifyIndexBuild rebuilds the index recorded in the manifest given and returns whether the checksums match.
+func (i *IndexNode) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	if !commonpbutil.IsHealthy(i.stateCode) {
+		stateCode := i.stateCode.Load().(commonpb.StateCode)
+		log.Ctx(ctx).Warn("index node not ready", zap.Int32("state", int32(stateCode)))
+		return &indexpb.VerifyIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "state code is not healthy",
+			},
+		}, nil
+	}
+	if req.GetManifestPath() == "" {
+		return &indexpb.VerifyIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "manifest_path is required",
+			},
+		}, nil
+	}
+	ret, err := i.verifyIndexBuild(ctx, req.GetManifestPath())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to verify index build", zap.String("manifestPath", req.GetManifestPath()), zap.Error(err))
+		code := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, storage.ErrIndexManifestNotFound) {
+			code = commonpb.ErrorCode_IllegalArgument
+		}
+		return &indexpb.VerifyIndexBuildResponse{
+			Status: &commonpb.Status{
+				ErrorCode: code,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	files := make([]*indexpb.IndexFileVerification, 0, len(ret.Files))
+	for _, file := range ret.Files {
+		files = append(files, &indexpb.IndexFileVerification{
+			Key:      file.Key,
+			Expected: file.Expected,
+			Actual:   file.Actual,
+			Match:    file.Match,
+		})
+	}
+	return &indexpb.VerifyIndexBuildResponse{
+		Status:           &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		BuildID:          ret.BuildID,
+		IndexVersion:     ret.IndexVersion,
+		Match:            ret.Match,
+		Files:            files,
+		EnvironmentDiffs: ret.EnvironmentDiffs,
+	}, nil
+}
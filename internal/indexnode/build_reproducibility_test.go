@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+type localStorageFactory struct {
+	cm storage.ChunkManager
+}
+
+func (f *localStorageFactory) NewChunkManager(context.Context, *indexpb.StorageConfig) (storage.ChunkManager, error) {
+	return f.cm, nil
+}
+
+func TestBuildSeed(t *testing.T) {
+	assert.Equal(t, buildSeed(1), buildSeed(1))
+	assert.NotEqual(t, buildSeed(1), buildSeed(2))
+	assert.GreaterOrEqual(t, buildSeed(-1), int64(0))
+
+	it := &indexBuildTask{req: &indexpb.CreateJobRequest{IndexID: 1}}
+	params := map[string]string{}
+	require.NoError(t, it.setBuildSeed(params))
+	assert.Equal(t, buildSeed(1), it.seed)
+	assert.NotEmpty(t, params[common.IndexBuildSeedKey])
+
+	// the seed given by the index params is kept
+	it = &indexBuildTask{req: &indexpb.CreateJobRequest{IndexID: 1}}
+	params = map[string]string{common.IndexBuildSeedKey: "42"}
+	require.NoError(t, it.setBuildSeed(params))
+	assert.Equal(t, int64(42), it.seed)
+	assert.Equal(t, "42", params[common.IndexBuildSeedKey])
+	assert.Error(t, it.setBuildSeed(map[string]string{common.IndexBuildSeedKey: "x"}))
+}
+
+func TestBuildEnvironment(t *testing.T) {
+	Params.Init()
+	env := captureBuildEnvironment()
+	assert.Equal(t, common.KnowhereVersion, env.KnowhereVersion)
+	assert.NotEmpty(t, env.Arch)
+	assert.Empty(t, diffBuildEnvironment(env, env))
+
+	other := env
+	other.Arch = "other"
+	other.CPUFeatures = append([]string{"other"}, env.CPUFeatures...)
+	diffs := diffBuildEnvironment(env, other)
+	assert.Equal(t, 2, len(diffs))
+	assert.True(t, strings.HasPrefix(diffs[0], "arch: "))
+}
+
+func TestCompareChecksums(t *testing.T) {
+	files, match := compareChecksums(map[string]string{"a": "1", "b": "2"}, map[string]string{"a": "1", "b": "2"})
+	assert.True(t, match)
+	assert.Equal(t, 2, len(files))
+
+	files, match = compareChecksums(map[string]string{"a": "1", "b": "2"}, map[string]string{"a": "1", "c": "3"})
+	assert.False(t, match)
+	require.Equal(t, 3, len(files))
+	assert.True(t, files[0].Match)
+	assert.Equal(t, &indexFileVerification{Key: "b", Expected: "2"}, files[1])
+	assert.Equal(t, &indexFileVerification{Key: "c", Actual: "3"}, files[2])
+}
+
+func TestIndexNode_VerifyIndexBuild(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+
+	// an int32 field with an inverted index, built in Go without knowhere
+	const fieldID = 102
+	insertCodec := &storage.InsertCodec{Schema: &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{{FieldID: fieldID, Name: "int32", DataType: schemapb.DataType_Int32}},
+		},
+	}}
+	blobs, _, err := insertCodec.Serialize(2, 3, &storage.InsertData{Data: map[int64]storage.FieldData{
+		fieldID: &storage.Int32FieldData{Data: []int32{7, -1, 7, 3}},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blobs))
+	dataPath := path.Join(cm.RootPath(), "insert_log", "1", "2", "3", "102", "1")
+	require.NoError(t, cm.Write(ctx, dataPath, blobs[0].Value))
+
+	req := &indexpb.CreateJobRequest{
+		BuildID:      10,
+		IndexVersion: 1,
+		IndexID:      100,
+		IndexName:    "idx",
+		NumRows:      4,
+		DataPaths:    []string{dataPath},
+		IndexParams:  []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexINVERTED}},
+	}
+	it := &indexBuildTask{
+		ctx:          ctx,
+		BuildID:      req.GetBuildID(),
+		req:          req,
+		cm:           cm,
+		tr:           timerecord.NewTimeRecorder("test"),
+		reproducible: true,
+	}
+	require.NoError(t, it.Prepare(ctx))
+	assert.Equal(t, buildSeed(100), it.seed)
+	require.NoError(t, it.LoadData(ctx))
+	require.NoError(t, it.BuildIndex(ctx))
+	require.NotEmpty(t, it.checksums)
+	manifestPath, err := it.saveIndexManifest(ctx, []string{"index"}, []int64{1})
+	require.NoError(t, err)
+
+	manifest, err := storage.ReadIndexManifest(ctx, cm, manifestPath)
+	require.NoError(t, err)
+	require.NotNil(t, manifest.Build)
+	assert.Equal(t, int64(3), manifest.Build.SegmentID)
+	assert.Equal(t, int64(fieldID), manifest.Build.FieldID)
+	assert.Equal(t, buildSeed(100), manifest.Build.Seed)
+	assert.Equal(t, it.checksums, manifest.Build.Checksums)
+	assert.Equal(t, common.KnowhereVersion, manifest.Build.Environment.KnowhereVersion)
+
+	node := &IndexNode{storageFactory: &localStorageFactory{cm: cm}}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	resp, err := node.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{ManifestPath: manifestPath})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode(), resp.GetStatus().GetReason())
+	assert.True(t, resp.GetMatch())
+	assert.Equal(t, int64(10), resp.GetBuildID())
+	assert.Equal(t, len(it.checksums), len(resp.GetFiles()))
+	for _, file := range resp.GetFiles() {
+		assert.True(t, file.GetMatch())
+		assert.Equal(t, it.checksums[file.GetKey()], file.GetActual())
+	}
+	assert.Empty(t, resp.GetEnvironmentDiffs())
+
+	// a different checksum recorded fails the verification
+	for key := range manifest.Build.Checksums {
+		manifest.Build.Checksums[key] = "corrupted"
+		break
+	}
+	data, err := manifest.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, cm.Write(ctx, manifestPath, data))
+	verification, err := node.verifyIndexBuild(ctx, manifestPath)
+	require.NoError(t, err)
+	assert.False(t, verification.Match)
+
+	// the builds not recorded can't be verified
+	manifest.Build = nil
+	data, err = manifest.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, cm.Write(ctx, manifestPath, data))
+	resp, err = node.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{ManifestPath: manifestPath})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	missingPath := metautil.BuildSegmentIndexFilePath(cm.RootPath(), 11, 1, 2, 3, storage.IndexManifestKey)
+	resp, err = node.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{ManifestPath: missingPath})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	resp, err = node.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.VerifyIndexBuild(ctx, &indexpb.VerifyIndexBuildRequest{ManifestPath: manifestPath})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"runtime"
+	"sync"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// buildWorkerPool is a pool of standby workers running the index build tasks. Each worker is locked to its own
+// os thread for its lifetime, so that the state segcore keeps per calling thread, e.g. the openmp thread pools
+// and the thread-local caches of the memory allocator, is reused by the next task instead of being set up again.
+// onIdle is called when the last running task finishes, the workers stop when the pool is closed.
+type buildWorkerPool struct {
+	size   int
+	tasks  chan func()
+	onIdle func()
+
+	running   atomic.Int32
+	wg        sync.WaitGroup
+	startOnce sync.Once
+	closeOnce sync.Once
+}
+
+func newBuildWorkerPool(size int, onIdle func()) *buildWorkerPool {
+	if size <= 0 {
+		size = 1
+	}
+	return &buildWorkerPool{
+		size:   size,
+		tasks:  make(chan func()),
+		onIdle: onIdle,
+	}
+}
+
+// start starts the workers, they wait for the tasks in standby.
+func (p *buildWorkerPool) start() {
+	p.startOnce.Do(func() {
+		for i := 0; i < p.size; i++ {
+			p.wg.Add(1)
+			go p.work()
+		}
+		log.Info("IndexNode build worker pool started", zap.Int("workers", p.size))
+	})
+}
+
+func (p *buildWorkerPool) work() {
+	defer p.wg.Done()
+	// the thread is never unlocked, it exits with the worker instead of going back to the go scheduler
+	runtime.LockOSThread()
+	for fn := range p.tasks {
+		fn()
+		if p.running.Dec() == 0 && p.onIdle != nil {
+			p.onIdle()
+		}
+	}
+}
+
+// submit runs fn on a standby worker, it blocks until a worker is free.
+func (p *buildWorkerPool) submit(fn func()) {
+	p.running.Inc()
+	p.tasks <- fn
+}
+
+// close stops the workers after the submitted tasks finish.
+func (p *buildWorkerPool) close() {
+	p.closeOnce.Do(func() {
+		close(p.tasks)
+	})
+	p.wg.Wait()
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestBuildWorkerPool(t *testing.T) {
+	idle := atomic.NewInt32(0)
+	pool := newBuildWorkerPool(2, func() { idle.Inc() })
+	pool.start()
+	// start is idempotent
+	pool.start()
+
+	var wg sync.WaitGroup
+	done := atomic.NewInt32(0)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		pool.submit(func() {
+			defer wg.Done()
+			done.Inc()
+		})
+	}
+	wg.Wait()
+	pool.close()
+	pool.close()
+	assert.Equal(t, int32(10), done.Load())
+	assert.Equal(t, int32(0), pool.running.Load())
+	assert.GreaterOrEqual(t, idle.Load(), int32(1))
+
+	assert.Equal(t, 1, newBuildWorkerPool(0, nil).size)
+}
+
+func TestIndexTaskScheduler_WarmWorkers(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.IndexNodeCfg.WarmWorkersEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.WarmWorkersEnabled.Key)
+
+	scheduler, err := NewTaskScheduler(context.TODO())
+	assert.NoError(t, err)
+	assert.NotNil(t, scheduler.workerPool)
+	scheduler.Start()
+
+	tasks := make([]task, 0, 16)
+	for i := 0; i < 16; i++ {
+		tasks = append(tasks, newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished))
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(tasks[i]))
+	}
+	_taskwg.Wait()
+	scheduler.Close()
+	for _, task := range tasks {
+		assert.Equal(t, commonpb.IndexState_Finished, task.GetState())
+	}
+}
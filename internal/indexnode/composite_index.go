@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	// compositeIndexMetaKey is the blob key of the composite index meta.
+	compositeIndexMetaKey = "composite_index_meta"
+	// compositeIndexOffsetsKey is the blob key of the row offsets sorted by the composite key.
+	compositeIndexOffsetsKey = "composite_index_offsets"
+)
+
+type compositeIndexMeta struct {
+	FieldIDs []int64 `json:"field_ids"`
+	NumRows  int     `json:"num_rows"`
+}
+
+// rowComparator compares two rows of one field, returns negative, zero or positive.
+type rowComparator func(i, j int) int
+
+func compareOrdered[T int8 | int16 | int32 | int64 | float32 | float64 | string](data []T) rowComparator {
+	return func(i, j int) int {
+		switch {
+		case data[i] < data[j]:
+			return -1
+		case data[i] > data[j]:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+func newRowComparator(data storage.FieldData) (rowComparator, error) {
+	switch fd := data.(type) {
+	case *storage.BoolFieldData:
+		return func(i, j int) int {
+			switch {
+			case fd.Data[i] == fd.Data[j]:
+				return 0
+			case !fd.Data[i]:
+				return -1
+			default:
+				return 1
+			}
+		}, nil
+	case *storage.Int8FieldData:
+		return compareOrdered(fd.Data), nil
+	case *storage.Int16FieldData:
+		return compareOrdered(fd.Data), nil
+	case *storage.Int32FieldData:
+		return compareOrdered(fd.Data), nil
+	case *storage.Int64FieldData:
+		return compareOrdered(fd.Data), nil
+	case *storage.FloatFieldData:
+		return compareOrdered(fd.Data), nil
+	case *storage.DoubleFieldData:
+		return compareOrdered(fd.Data), nil
+	case *storage.StringFieldData:
+		return compareOrdered(fd.Data), nil
+	default:
+		return nil, fmt.Errorf("unsupported field data type %T for composite index", data)
+	}
+}
+
+// buildCompositeIndex sorts the row offsets of the segment by the tuple of the covered fields in order,
+// so that the rows matching a prefix of the tuple are continuous in the sorted offsets. The distinct
+// tuples are saved as the keys of the index, QueryNode looks them up to skip the segments without
+// any row matching the filter.
+func buildCompositeIndex(fieldIDs []int64, fieldData map[storage.FieldID]storage.FieldData) ([]*storage.Blob, error) {
+	if len(fieldIDs) == 0 {
+		return nil, fmt.Errorf("no field to build composite index")
+	}
+	comparators := make([]rowComparator, 0, len(fieldIDs))
+	columns := make([]storage.FieldData, 0, len(fieldIDs))
+	numRows := -1
+	for _, fieldID := range fieldIDs {
+		data, ok := fieldData[fieldID]
+		if !ok {
+			return nil, fmt.Errorf("data of field %d not found for composite index", fieldID)
+		}
+		if numRows >= 0 && data.RowNum() != numRows {
+			return nil, fmt.Errorf("row number mismatch in composite index, field %d has %d rows, expected %d",
+				fieldID, data.RowNum(), numRows)
+		}
+		numRows = data.RowNum()
+		cmp, err := newRowComparator(data)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, cmp)
+		columns = append(columns, data)
+	}
+
+	offsets := make([]int64, numRows)
+	for i := range offsets {
+		offsets[i] = int64(i)
+	}
+	compareRows := func(i, j int) int {
+		for _, cmp := range comparators {
+			if ret := cmp(i, j); ret != 0 {
+				return ret
+			}
+		}
+		return 0
+	}
+	sort.SliceStable(offsets, func(a, b int) bool {
+		return compareRows(int(offsets[a]), int(offsets[b])) < 0
+	})
+
+	keys := &storage.CompositeIndexKeys{
+		FieldIDs: fieldIDs,
+		Columns:  make([]*storage.CompositeKeyColumn, len(fieldIDs)),
+	}
+	for i := range keys.Columns {
+		keys.Columns[i] = &storage.CompositeKeyColumn{}
+	}
+	for i, offset := range offsets {
+		if i > 0 && compareRows(int(offsets[i-1]), int(offset)) == 0 {
+			continue
+		}
+		for j, data := range columns {
+			if err := storage.AppendCompositeKeyValue(keys.Columns[j], data, int(offset)); err != nil {
+				return nil, err
+			}
+		}
+		keys.NumKeys++
+	}
+	keysBlob, err := storage.SerializeCompositeIndexKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBytes, err := json.Marshal(&compositeIndexMeta{FieldIDs: fieldIDs, NumRows: numRows})
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, common.Endian, offsets); err != nil {
+		return nil, err
+	}
+	return []*storage.Blob{
+		{Key: compositeIndexMetaKey, Value: metaBytes},
+		{Key: compositeIndexOffsetsKey, Value: buf.Bytes()},
+		keysBlob,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestBuildCompositeIndex(t *testing.T) {
+	fieldData := map[storage.FieldID]storage.FieldData{
+		100: &storage.Int8FieldData{Data: []int8{2, 1, 2, 1}},
+		101: &storage.Int64FieldData{Data: []int64{10, 40, 5, 30}},
+		102: &storage.StringFieldData{Data: []string{"a", "b", "c", "d"}},
+	}
+
+	t.Run("normal", func(t *testing.T) {
+		blobs, err := buildCompositeIndex([]int64{100, 101}, fieldData)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(blobs))
+
+		meta := &compositeIndexMeta{}
+		assert.Equal(t, compositeIndexMetaKey, blobs[0].Key)
+		assert.NoError(t, json.Unmarshal(blobs[0].Value, meta))
+		assert.Equal(t, []int64{100, 101}, meta.FieldIDs)
+		assert.Equal(t, 4, meta.NumRows)
+
+		offsets := make([]int64, meta.NumRows)
+		assert.Equal(t, compositeIndexOffsetsKey, blobs[1].Key)
+		assert.NoError(t, binary.Read(bytes.NewReader(blobs[1].Value), common.Endian, offsets))
+		assert.Equal(t, []int64{3, 1, 2, 0}, offsets)
+
+		assert.Equal(t, storage.CompositeIndexKeysKey, blobs[2].Key)
+		keys, err := storage.DeserializeCompositeIndexKeys(blobs[2].Value)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, keys.NumKeys)
+		assert.Equal(t, []int64{1, 1, 2, 2}, keys.Columns[0].Ints)
+		assert.Equal(t, []int64{30, 40, 5, 10}, keys.Columns[1].Ints)
+	})
+
+	t.Run("distinct keys", func(t *testing.T) {
+		blobs, err := buildCompositeIndex([]int64{100, 102}, map[storage.FieldID]storage.FieldData{
+			100: &storage.Int8FieldData{Data: []int8{2, 1, 2, 1}},
+			102: &storage.StringFieldData{Data: []string{"a", "b", "a", "b"}},
+		})
+		assert.NoError(t, err)
+		keys, err := storage.DeserializeCompositeIndexKeys(blobs[2].Value)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, keys.NumKeys)
+		assert.Equal(t, []int64{1, 2}, keys.Columns[0].Ints)
+		assert.Equal(t, []string{"b", "a"}, keys.Columns[1].Strings)
+	})
+
+	t.Run("field not found", func(t *testing.T) {
+		_, err := buildCompositeIndex([]int64{100, 103}, fieldData)
+		assert.Error(t, err)
+	})
+
+	t.Run("row num mismatch", func(t *testing.T) {
+		_, err := buildCompositeIndex([]int64{100, 103}, map[storage.FieldID]storage.FieldData{
+			100: &storage.Int8FieldData{Data: []int8{2, 1, 2, 1}},
+			103: &storage.Int64FieldData{Data: []int64{1}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := buildCompositeIndex([]int64{100, 103}, map[storage.FieldID]storage.FieldData{
+			100: &storage.Int8FieldData{Data: []int8{2}},
+			103: &storage.FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// downloadParallel returns the concurrent binlog download streams of a build task,
+// the cpu quota of the container is respected if not configured.
+func downloadParallel() int {
+	if parallel := Params.IndexNodeCfg.DownloadParallel.GetAsInt(); parallel > 0 {
+		return parallel
+	}
+	// Use runtime.GOMAXPROCS(0) instead of runtime.NumCPU()
+	// to respect CPU quota of container/pod
+	// gomaxproc will be set by `automaxproc`, passing 0 will just retrieve the value
+	return runtime.GOMAXPROCS(0)
+}
+
+// downloadLimiter caps the aggregate binlog download bandwidth of the node, it is shared by the download streams
+// of all the build tasks. A stream is paced after each download, so the downloads already issued are not delayed.
+type downloadLimiter struct {
+	mu sync.Mutex
+	// the time the bytes downloaded so far are paid off by the bandwidth
+	paidOff time.Time
+
+	now func() time.Time
+}
+
+func newDownloadLimiter() *downloadLimiter {
+	return &downloadLimiter{now: time.Now}
+}
+
+// wait blocks the download stream which started downloading n bytes at start until the downloads fit
+// the bandwidth cap, it returns immediately if the bandwidth is unlimited.
+func (l *downloadLimiter) wait(ctx context.Context, start time.Time, n int) error {
+	delay := l.reserve(start, n)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve accounts the n bytes to the bandwidth and returns how long the stream should wait.
+func (l *downloadLimiter) reserve(start time.Time, n int) time.Duration {
+	if l == nil || n <= 0 {
+		return 0
+	}
+	bandwidth := Params.IndexNodeCfg.DownloadMaxBandwidth.GetAsFloat() * 1024 * 1024
+	if bandwidth <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.paidOff.Before(start) {
+		l.paidOff = start
+	}
+	l.paidOff = l.paidOff.Add(time.Duration(float64(n) / bandwidth * float64(time.Second)))
+	return l.paidOff.Sub(l.now())
+}
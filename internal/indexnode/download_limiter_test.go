@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestDownloadParallel(t *testing.T) {
+	Params.Init()
+	assert.Equal(t, runtime.GOMAXPROCS(0), downloadParallel())
+
+	paramtable.Get().Save(Params.IndexNodeCfg.DownloadParallel.Key, "16")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.DownloadParallel.Key)
+	assert.Equal(t, 16, downloadParallel())
+}
+
+func TestDownloadLimiter(t *testing.T) {
+	Params.Init()
+	now := time.Now()
+	l := newDownloadLimiter()
+	l.now = func() time.Time { return now }
+
+	// unlimited
+	assert.Equal(t, time.Duration(0), l.reserve(now, 1024*1024))
+
+	paramtable.Get().Save(Params.IndexNodeCfg.DownloadMaxBandwidth.Key, "1")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.DownloadMaxBandwidth.Key)
+
+	// 1MB downloaded in no time is paid off in 1 second
+	assert.Equal(t, time.Second, l.reserve(now, 1024*1024))
+	// the bandwidth is shared by the streams
+	assert.Equal(t, 2*time.Second, l.reserve(now, 1024*1024))
+
+	// a download slower than the bandwidth is not delayed
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, -500*time.Millisecond, l.reserve(now.Add(-time.Second), 512*1024))
+	assert.Equal(t, time.Duration(0), l.reserve(now, 0))
+
+	// canceled while waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, l.wait(ctx, now, 1024*1024))
+
+	var nilLimiter *downloadLimiter
+	assert.NoError(t, nilLimiter.wait(context.Background(), now, 1024*1024))
+}
@@ -98,6 +98,11 @@ type IndexNode struct {
 	initOnce  sync.Once
 	stateLock sync.Mutex
 	tasks     map[taskKey]*taskInfo
+
+	buildEvents     *buildEventPublisher
+	memAdmitter     *memoryAdmitter
+	downloadLimiter *downloadLimiter
+	bundles         *segmentBundles
 }
 
 // NewIndexNode creates a new IndexNode component.
@@ -106,11 +111,14 @@ func NewIndexNode(ctx context.Context, factory dependency.Factory) (*IndexNode,
 	rand.Seed(time.Now().UnixNano())
 	ctx1, cancel := context.WithCancel(ctx)
 	b := &IndexNode{
-		loopCtx:        ctx1,
-		loopCancel:     cancel,
-		factory:        factory,
-		storageFactory: &chunkMgr{},
-		tasks:          map[taskKey]*taskInfo{},
+		loopCtx:         ctx1,
+		loopCancel:      cancel,
+		factory:         factory,
+		storageFactory:  &chunkMgr{},
+		tasks:           map[taskKey]*taskInfo{},
+		memAdmitter:     newMemoryAdmitter(),
+		downloadLimiter: newDownloadLimiter(),
+		bundles:         newSegmentBundles(),
 	}
 	b.UpdateStateCode(commonpb.StateCode_Abnormal)
 	sc, err := NewTaskScheduler(b.loopCtx)
@@ -198,6 +206,15 @@ func (i *IndexNode) Init() error {
 		i.closer = trace.InitTracing("index_node")
 
 		i.initKnowhere()
+
+		if Params.IndexNodeCfg.BuildEventEnabled.GetAsBool() {
+			i.buildEvents, err = newBuildEventPublisher(i.loopCtx, i.factory)
+			if err != nil {
+				log.Error("IndexNode failed to create build event publisher", zap.Error(err))
+				initErr = err
+				return
+			}
+		}
 	})
 
 	log.Info("Init IndexNode finished", zap.Error(initErr))
@@ -245,6 +262,9 @@ func (i *IndexNode) Stop() error {
 		if i.sched != nil {
 			i.sched.Close()
 		}
+		if i.buildEvents != nil {
+			i.buildEvents.Close()
+		}
 		i.session.Revoke(time.Second)
 
 		log.Info("Index node stopped.")
@@ -333,14 +353,7 @@ func (i *IndexNode) ShowConfigurations(ctx context.Context, req *internalpb.Show
 		}, nil
 	}
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "indexnode", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "indexnode", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -47,10 +47,11 @@ type Mock struct {
 	CallSetEtcdClient   func(etcdClient *clientv3.Client)
 	CallUpdateStateCode func(stateCode commonpb.StateCode)
 
-	CallCreateJob   func(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error)
-	CallQueryJobs   func(ctx context.Context, in *indexpb.QueryJobsRequest) (*indexpb.QueryJobsResponse, error)
-	CallDropJobs    func(ctx context.Context, in *indexpb.DropJobsRequest) (*commonpb.Status, error)
-	CallGetJobStats func(ctx context.Context, in *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	CallCreateJob        func(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error)
+	CallQueryJobs        func(ctx context.Context, in *indexpb.QueryJobsRequest) (*indexpb.QueryJobsResponse, error)
+	CallDropJobs         func(ctx context.Context, in *indexpb.DropJobsRequest) (*commonpb.Status, error)
+	CallGetJobStats      func(ctx context.Context, in *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	CallVerifyIndexBuild func(ctx context.Context, in *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error)
 
 	CallGetMetrics         func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	CallShowConfigurations func(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
@@ -146,6 +147,14 @@ func NewIndexNodeMock() *Mock {
 		CallGetMetrics: func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 			return getMockSystemInfoMetrics(ctx, req, nil)
 		},
+		CallVerifyIndexBuild: func(ctx context.Context, in *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+			return &indexpb.VerifyIndexBuildResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				Match: true,
+			}, nil
+		},
 		CallShowConfigurations: func(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 			return &internalpb.ShowConfigurationsResponse{
 				Status: &commonpb.Status{
@@ -205,6 +214,10 @@ func (m *Mock) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsRequest)
 	return m.CallGetJobStats(ctx, req)
 }
 
+func (m *Mock) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	return m.CallVerifyIndexBuild(ctx, req)
+}
+
 func (m *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.CallGetMetrics(ctx, req)
 }
@@ -62,6 +62,17 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 	sp.SetTag("ClusterID", req.ClusterID)
 	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.TotalLabel).Inc()
 
+	// reject the job instead of OOM-killing the node in the middle of builds, DataCoord retries it later
+	key := taskKey{ClusterID: req.ClusterID, BuildID: req.BuildID}
+	estimate := estimateIndexMemory(req)
+	if err := i.memAdmitter.admit(key, estimate); err != nil {
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
 	taskCtx, taskCancel := context.WithCancel(i.loopCtx)
 	if oldInfo := i.loadOrStoreTask(req.ClusterID, req.BuildID, &taskInfo{
 		cancel: taskCancel,
@@ -77,6 +88,7 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 		log.Ctx(ctx).Error("create chunk manager failed", zap.String("Bucket", req.StorageConfig.BucketName),
 			zap.String("AccessKey", req.StorageConfig.AccessKeyID),
 			zap.String("ClusterID", req.ClusterID), zap.Int64("IndexBuildID", req.BuildID))
+		i.memAdmitter.release(key)
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_BuildIndexError,
 			Reason:    "create chunk manager failed",
@@ -94,6 +106,10 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 		nodeID:         i.GetNodeID(),
 		tr:             timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildID: %d, ClusterID: %s", req.BuildID, req.ClusterID)),
 		serializedSize: 0,
+		reproducible:   Params.IndexNodeCfg.ReproducibilityEnabled.GetAsBool(),
+	}
+	if Params.IndexNodeCfg.BundleEnabled.GetAsBool() {
+		task.bundle = i.bundles.join(req.ClusterID, req.BuildID, req.GetDataPaths())
 	}
 	ret := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -101,12 +117,15 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 	}
 	if err := i.sched.IndexBuildQueue.Enqueue(task); err != nil {
 		log.Ctx(ctx).Warn("IndexNode failed to schedule", zap.Int64("IndexBuildID", req.BuildID), zap.String("ClusterID", req.ClusterID), zap.Error(err))
+		i.memAdmitter.release(key)
+		i.bundles.leave(task.bundle, req.BuildID)
 		ret.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		ret.Reason = err.Error()
 		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.FailLabel).Inc()
 		return ret, nil
 	}
-	log.Ctx(ctx).Info("IndexNode successfully scheduled", zap.Int64("IndexBuildID", req.BuildID), zap.String("ClusterID", req.ClusterID), zap.String("indexName", req.IndexName))
+	log.Ctx(ctx).Info("IndexNode successfully scheduled", zap.Int64("IndexBuildID", req.BuildID), zap.String("ClusterID", req.ClusterID),
+		zap.String("indexName", req.IndexName), zap.Uint64("estimatedMemory", estimate))
 	return ret, nil
 }
 
@@ -129,6 +148,8 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 				fileKeys:       common.CloneStringList(info.fileKeys),
 				serializedSize: info.serializedSize,
 				failReason:     info.failReason,
+				failClass:      info.failClass,
+				resourceUsage:  info.resourceUsage,
 			}
 		}
 	})
@@ -140,23 +161,31 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 		ClusterID:  req.ClusterID,
 		IndexInfos: make([]*indexpb.IndexTaskInfo, 0, len(req.BuildIDs)),
 	}
+	usages := make(map[string]*taskResourceUsage)
 	for i, buildID := range req.BuildIDs {
 		ret.IndexInfos = append(ret.IndexInfos, &indexpb.IndexTaskInfo{
 			BuildID:        buildID,
 			State:          commonpb.IndexState_IndexStateNone,
 			IndexFileKeys:  nil,
 			SerializedSize: 0,
+			EngineVersion:  common.KnowhereVersion,
 		})
 		if info, ok := infos[buildID]; ok {
 			ret.IndexInfos[i].State = info.state
 			ret.IndexInfos[i].IndexFileKeys = info.fileKeys
 			ret.IndexInfos[i].SerializedSize = info.serializedSize
 			ret.IndexInfos[i].FailReason = info.failReason
+			if info.resourceUsage != nil {
+				usages[strconv.FormatInt(buildID, 10)] = info.resourceUsage
+			}
 			log.RatedDebug(5, "querying index build task", zap.String("ClusterID", req.ClusterID),
 				zap.Int64("IndexBuildID", buildID), zap.String("state", info.state.String()),
-				zap.String("fail reason", info.failReason))
+				zap.String("fail reason", info.failReason), zap.String("fail class", string(info.failClass)))
 		}
 	}
+	if len(usages) > 0 {
+		setResourceUsageTrailer(ctx, taskUsageTrailer, usages)
+	}
 	return ret, nil
 }
 
@@ -201,16 +230,29 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 	}
 	unissued, active := i.sched.IndexBuildQueue.GetTaskNum()
 	jobInfos := make([]*indexpb.JobInfo, 0)
+	usages := make([]*taskResourceUsage, 0)
+	hasUsage := false
 	i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
 		if info.statistic != nil {
 			jobInfos = append(jobInfos, proto.Clone(info.statistic).(*indexpb.JobInfo))
+			usages = append(usages, info.resourceUsage)
+			hasUsage = hasUsage || info.resourceUsage != nil
 		}
 	})
 	slots := 0
 	if i.sched.buildParallel > unissued+active {
 		slots = i.sched.buildParallel - unissued - active
 	}
-	log.Ctx(ctx).Info("Get Index Job Stats", zap.Int("Unissued", unissued), zap.Int("Active", active), zap.Int("Slot", slots))
+	// no slots are offered if the memory is exhausted, so that DataCoord peeks other nodes
+	available := i.memAdmitter.available()
+	if available == 0 {
+		slots = 0
+	}
+	if hasUsage {
+		setResourceUsageTrailer(ctx, jobUsageTrailer, usages)
+	}
+	log.Ctx(ctx).Info("Get Index Job Stats", zap.Int("Unissued", unissued), zap.Int("Active", active), zap.Int("Slot", slots),
+		zap.Uint64("AvailableMemory", available))
 	return &indexpb.GetJobStatsResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
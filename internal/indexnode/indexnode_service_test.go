@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/stretchr/testify/assert"
@@ -140,6 +141,7 @@ func TestIndexNodeSimple(t *testing.T) {
 		}
 
 		assert.NotNil(t, idxInfo)
+		assert.Contains(t, idxInfo.IndexFileKeys, storage.IndexManifestKey)
 		for _, idxFileID := range idxInfo.IndexFileKeys {
 			idxFile := metautil.BuildSegmentIndexFilePath(mockChunkMgr.RootPath(), buildID, 0,
 				partID, segID, idxFileID)
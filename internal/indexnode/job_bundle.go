@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	bundleSourceDownload = "download"
+	bundleSourceShared   = "shared"
+)
+
+// bundleBlob is a binlog downloaded once for the builds of a segment bundle.
+type bundleBlob struct {
+	done  chan struct{}
+	value []byte
+	err   error
+	// refs is the number of the members which haven't read the blob yet
+	refs int
+}
+
+// segmentBundle bundles the index builds of the same segment on the node, e.g. a vector index and the scalar
+// indexes requested together. A binlog needed by several members is downloaded once, and kept until all of them
+// have read it. Every member still builds and reports its own index.
+type segmentBundle struct {
+	key string
+
+	mu sync.Mutex
+	// members are the builds in the bundle and the data paths each of them hasn't read yet
+	members map[UniqueID]map[string]struct{}
+	blobs   map[string]*bundleBlob
+
+	downloadedBytes int64
+	sharedBytes     int64
+}
+
+// segmentBundleKey is the insert log dir of the segment, i.e. the data path without the field id and log id.
+func segmentBundleKey(clusterID string, dataPaths []string) string {
+	if len(dataPaths) == 0 {
+		return ""
+	}
+	return clusterID + "/" + path.Dir(path.Dir(dataPaths[0]))
+}
+
+// read returns the blob of the data path for the member, it's downloaded by load if no other member has done.
+func (b *segmentBundle) read(ctx context.Context, buildID UniqueID, dataPath string, load func(string) ([]byte, error)) ([]byte, error) {
+	b.mu.Lock()
+	blob, shared := b.blobs[dataPath]
+	if !shared {
+		blob = &bundleBlob{done: make(chan struct{})}
+		for _, pending := range b.members {
+			if _, ok := pending[dataPath]; ok {
+				blob.refs++
+			}
+		}
+		b.blobs[dataPath] = blob
+	}
+	b.mu.Unlock()
+
+	if !shared {
+		blob.value, blob.err = load(dataPath)
+		close(blob.done)
+	} else {
+		select {
+		case <-blob.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	b.mu.Lock()
+	b.releaseLocked(buildID, dataPath)
+	if blob.err != nil {
+		// the failed download is not shared, the members waiting for it download it by themselves
+		if b.blobs[dataPath] == blob {
+			delete(b.blobs, dataPath)
+		}
+		b.mu.Unlock()
+		if !shared {
+			return nil, blob.err
+		}
+		return load(dataPath)
+	}
+	defer b.mu.Unlock()
+	source := bundleSourceDownload
+	if shared {
+		b.sharedBytes += int64(len(blob.value))
+		source = bundleSourceShared
+	} else {
+		b.downloadedBytes += int64(len(blob.value))
+	}
+	metrics.IndexNodeBundleLoadBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), source).Add(float64(len(blob.value)))
+	return blob.value, nil
+}
+
+// releaseLocked drops the reference of the member to the blob of the data path.
+func (b *segmentBundle) releaseLocked(buildID UniqueID, dataPath string) {
+	pending, ok := b.members[buildID]
+	if !ok {
+		return
+	}
+	if _, ok := pending[dataPath]; !ok {
+		return
+	}
+	delete(pending, dataPath)
+	if blob, ok := b.blobs[dataPath]; ok {
+		blob.refs--
+		if blob.refs <= 0 {
+			delete(b.blobs, dataPath)
+		}
+	}
+}
+
+// segmentBundles keeps the segment bundles of the builds on the node.
+type segmentBundles struct {
+	mu      sync.Mutex
+	bundles map[string]*segmentBundle
+}
+
+func newSegmentBundles() *segmentBundles {
+	return &segmentBundles{
+		bundles: make(map[string]*segmentBundle),
+	}
+}
+
+// join adds the build to the bundle of its segment, nil is returned if the build has no data path.
+func (s *segmentBundles) join(clusterID string, buildID UniqueID, dataPaths []string) *segmentBundle {
+	key := segmentBundleKey(clusterID, dataPaths)
+	if s == nil || key == "" {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bundle, ok := s.bundles[key]
+	if !ok {
+		bundle = &segmentBundle{
+			key:     key,
+			members: make(map[UniqueID]map[string]struct{}),
+			blobs:   make(map[string]*bundleBlob),
+		}
+		s.bundles[key] = bundle
+	}
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+	pending := make(map[string]struct{}, len(dataPaths))
+	for _, dataPath := range dataPaths {
+		pending[dataPath] = struct{}{}
+		// the blob being downloaded or not read by all the members yet is kept for the new member as well
+		if blob, ok := bundle.blobs[dataPath]; ok {
+			blob.refs++
+		}
+	}
+	bundle.members[buildID] = pending
+	if len(bundle.members) > 1 {
+		log.Info("IndexNode build joins segment bundle", zap.String("bundle", key), zap.Int64("buildID", buildID),
+			zap.Int("members", len(bundle.members)))
+	}
+	return bundle
+}
+
+// leave removes the build from the bundle, the blobs it hasn't read are released.
+func (s *segmentBundles) leave(bundle *segmentBundle, buildID UniqueID) {
+	if s == nil || bundle == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+	pending, ok := bundle.members[buildID]
+	if !ok {
+		return
+	}
+	for dataPath := range pending {
+		bundle.releaseLocked(buildID, dataPath)
+	}
+	delete(bundle.members, buildID)
+	if len(bundle.members) == 0 {
+		delete(s.bundles, bundle.key)
+		log.Info("IndexNode segment bundle done", zap.String("bundle", bundle.key),
+			zap.Int64("downloadedBytes", bundle.downloadedBytes), zap.Int64("sharedBytes", bundle.sharedBytes))
+	}
+}
+
+func (s *segmentBundles) size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.bundles)
+}
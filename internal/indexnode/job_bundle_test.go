@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentBundles(t *testing.T) {
+	Params.Init()
+	var downloads int32
+	load := func(p string) ([]byte, error) {
+		atomic.AddInt32(&downloads, 1)
+		return []byte(p), nil
+	}
+	vectorPaths := []string{"insert_log/1/2/3/101/1", "insert_log/1/2/3/101/2"}
+	compositePaths := []string{"insert_log/1/2/3/101/1", "insert_log/1/2/3/101/2", "insert_log/1/2/3/102/1"}
+
+	s := newSegmentBundles()
+	assert.Nil(t, s.join("cluster", 1, nil))
+	b1 := s.join("cluster", 1, vectorPaths)
+	b2 := s.join("cluster", 2, compositePaths)
+	assert.Same(t, b1, b2)
+	assert.Equal(t, "cluster/insert_log/1/2/3", b1.key)
+	// another segment
+	b3 := s.join("cluster", 3, []string{"insert_log/1/2/4/101/1"})
+	assert.NotSame(t, b1, b3)
+	assert.Equal(t, 2, s.size())
+
+	ctx := context.Background()
+	wg := sync.WaitGroup{}
+	for _, m := range []struct {
+		buildID UniqueID
+		paths   []string
+	}{{1, vectorPaths}, {2, compositePaths}} {
+		m := m
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, p := range m.paths {
+				value, err := b1.read(ctx, m.buildID, p, load)
+				assert.NoError(t, err)
+				assert.Equal(t, p, string(value))
+			}
+		}()
+	}
+	wg.Wait()
+	// the shared binlogs are downloaded once
+	assert.Equal(t, int32(3), atomic.LoadInt32(&downloads))
+	assert.Empty(t, b1.blobs)
+	assert.Equal(t, b1.downloadedBytes, int64(3*len(vectorPaths[0])))
+	assert.Equal(t, b1.sharedBytes, int64(2*len(vectorPaths[0])))
+
+	s.leave(b1, 1)
+	s.leave(b1, 2)
+	s.leave(b3, 3)
+	s.leave(b3, 3)
+	s.leave(nil, 4)
+	assert.Equal(t, 0, s.size())
+
+	var nilBundles *segmentBundles
+	assert.Nil(t, nilBundles.join("cluster", 1, vectorPaths))
+	nilBundles.leave(b1, 1)
+}
+
+func TestSegmentBundle_Release(t *testing.T) {
+	Params.Init()
+	load := func(p string) ([]byte, error) {
+		return []byte(p), nil
+	}
+	paths := []string{"insert_log/1/2/3/101/1"}
+	ctx := context.Background()
+
+	t.Run("kept for the members not read yet", func(t *testing.T) {
+		s := newSegmentBundles()
+		b := s.join("cluster", 1, paths)
+		_, err := b.read(ctx, 1, paths[0], load)
+		assert.NoError(t, err)
+		// read by all the members
+		assert.Empty(t, b.blobs)
+
+		s.join("cluster", 2, paths)
+		s.join("cluster", 3, paths)
+		_, err = b.read(ctx, 2, paths[0], load)
+		assert.NoError(t, err)
+		assert.Len(t, b.blobs, 1)
+		// the member leaving without reading releases the blob
+		s.leave(b, 3)
+		assert.Empty(t, b.blobs)
+	})
+
+	t.Run("failed download", func(t *testing.T) {
+		s := newSegmentBundles()
+		b := s.join("cluster", 1, paths)
+		s.join("cluster", 2, paths)
+
+		loadErr := errors.New("mock")
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+		go func() {
+			_, err := b.read(ctx, 1, paths[0], func(string) ([]byte, error) {
+				close(started)
+				<-unblock
+				return nil, loadErr
+			})
+			assert.ErrorIs(t, err, loadErr)
+		}()
+		<-started
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			// the waiting member downloads by itself
+			value, err := b.read(ctx, 2, paths[0], load)
+			assert.NoError(t, err)
+			assert.Equal(t, paths[0], string(value))
+		}()
+		close(unblock)
+		<-done
+		assert.Empty(t, b.blobs)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		s := newSegmentBundles()
+		b := s.join("cluster", 1, paths)
+		s.join("cluster", 2, paths)
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+		go func() {
+			_, _ = b.read(ctx, 1, paths[0], func(p string) ([]byte, error) {
+				close(started)
+				<-unblock
+				return []byte(p), nil
+			})
+		}()
+		<-started
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := b.read(cancelCtx, 2, paths[0], load)
+		assert.Error(t, err)
+		close(unblock)
+		s.leave(b, 1)
+		s.leave(b, 2)
+		assert.Equal(t, 0, s.size())
+	})
+}
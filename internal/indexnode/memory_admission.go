@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+const (
+	// defaultIndexMemoryFactor is the factor of the index types not listed in indexMemoryFactors
+	defaultIndexMemoryFactor = 2.0
+	// scalarRowSize is the estimated bytes per row of the scalar fields
+	scalarRowSize = 64
+)
+
+// indexMemoryFactors are the ratios of the peak memory building an index to the size of the raw vectors,
+// the raw vectors are loaded in memory and the index is built aside them.
+var indexMemoryFactors = map[indexparamcheck.IndexType]float64{
+	indexparamcheck.IndexFaissIDMap:      1.0,
+	indexparamcheck.IndexFaissBinIDMap:   1.0,
+	indexparamcheck.IndexFaissIvfFlat:    2.0,
+	indexparamcheck.IndexFaissBinIvfFlat: 2.0,
+	indexparamcheck.IndexFaissIvfPQ:      1.5,
+	indexparamcheck.IndexFaissIvfSQ8:     1.5,
+	indexparamcheck.IndexFaissIvfSQ8H:    1.5,
+	indexparamcheck.IndexDISKANN:         1.5,
+	indexparamcheck.IndexHNSW:            2.5,
+	indexparamcheck.IndexRHNSWFlat:       2.5,
+	indexparamcheck.IndexRHNSWPQ:         2.5,
+	indexparamcheck.IndexRHNSWSQ:         2.5,
+	indexparamcheck.IndexNSG:             3.0,
+	indexparamcheck.IndexANNOY:           3.0,
+	indexparamcheck.IndexNGTPANNG:        3.0,
+	indexparamcheck.IndexNGTONNG:         3.0,
+	// the raw values, the rows grouped by value and the postings or bitmaps
+	indexparamcheck.IndexINVERTED: 3.0,
+	indexparamcheck.IndexBITMAP:   2.0,
+}
+
+// estimateIndexMemory estimates the peak memory in bytes to build the index of the job by rows × dim × index type factor,
+// 0 means the memory can't be estimated.
+func estimateIndexMemory(req *indexpb.CreateJobRequest) uint64 {
+	if req.GetNumRows() <= 0 {
+		return 0
+	}
+	indexType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, req.GetIndexParams())
+	rowSize := float64(scalarRowSize)
+	if dimStr, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DimKey, req.GetTypeParams()); err == nil {
+		dim, err := strconv.ParseInt(dimStr, 10, 64)
+		if err == nil && dim > 0 {
+			if strings.HasPrefix(indexType, "BIN_") {
+				rowSize = float64(dim) / 8
+			} else {
+				rowSize = float64(dim) * 4
+			}
+		}
+	}
+	if indexType == indexparamcheck.IndexBITMAP {
+		// a bitmap of all the rows for each distinct value, up to the cardinality limit
+		limit, err := indexparamcheck.GetBitmapCardinalityLimit(funcutil.KeyValuePair2Map(req.GetIndexParams()))
+		if err != nil {
+			limit = indexparamcheck.DefaultBitmapCardinalityLimit
+		}
+		rowSize += float64(limit) / 8
+	}
+	factor, ok := indexMemoryFactors[indexparamcheck.IndexType(indexType)]
+	if !ok {
+		factor = defaultIndexMemoryFactor
+	}
+	return uint64(float64(req.GetNumRows()) * rowSize * factor)
+}
+
+// memoryAdmitter admits the index build jobs whose estimated peak memory fits in the memory of the host or container,
+// so that a node is not OOM-killed in the middle of builds. The estimates of the admitted jobs are reserved until
+// the jobs finish, a job is admitted only if both the reserved memory and the used memory leave room for it, or if no
+// other job is building.
+type memoryAdmitter struct {
+	mu       sync.Mutex
+	reserved map[taskKey]uint64
+
+	totalMemory func() uint64
+	usedMemory  func() uint64
+}
+
+func newMemoryAdmitter() *memoryAdmitter {
+	return &memoryAdmitter{
+		reserved:    make(map[taskKey]uint64),
+		totalMemory: hardware.GetMemoryCount,
+		usedMemory:  hardware.GetUsedMemoryCount,
+	}
+}
+
+// budget returns the memory the jobs are admitted to use, the memory limit of container is respected.
+func (a *memoryAdmitter) budget() uint64 {
+	return uint64(float64(a.totalMemory()) * Params.IndexNodeCfg.MaxMemoryUsagePercentage.GetAsFloat())
+}
+
+func (a *memoryAdmitter) reservedLocked() uint64 {
+	var reserved uint64
+	for _, size := range a.reserved {
+		reserved += size
+	}
+	return reserved
+}
+
+// admit reserves the estimated memory of the job, returns error if the memory is not enough,
+// admitting a job already admitted is a no-op.
+func (a *memoryAdmitter) admit(key taskKey, estimate uint64) error {
+	if !Params.IndexNodeCfg.MemoryAdmissionEnabled.GetAsBool() || estimate == 0 {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.reserved[key]; ok {
+		return nil
+	}
+
+	budget, used, reserved := a.budget(), a.usedMemory(), a.reservedLocked()
+	if budget == 0 {
+		// the memory can't be detected, don't block the builds
+		a.reserved[key] = estimate
+		return nil
+	}
+	if len(a.reserved) == 0 {
+		// no other job is building, the job is admitted even if the estimate is beyond the budget, otherwise it would
+		// be rejected forever. The reservation is capped at the budget so that no other job is admitted aside it.
+		if estimate > budget {
+			estimate = budget
+		}
+		a.reserved[key] = estimate
+		return nil
+	}
+	if estimate+used > budget || estimate+reserved > budget {
+		log.Warn("IndexNode memory is not enough to build index", zap.String("ClusterID", key.ClusterID),
+			zap.Int64("buildID", key.BuildID), zap.Uint64("estimate", estimate), zap.Uint64("budget", budget),
+			zap.Uint64("used", used), zap.Uint64("reserved", reserved))
+		return fmt.Errorf("index node memory is not enough to build index, estimated %d bytes, budget %d bytes, used %d bytes, reserved %d bytes",
+			estimate, budget, used, reserved)
+	}
+	a.reserved[key] = estimate
+	return nil
+}
+
+// release releases the memory reserved for the job.
+func (a *memoryAdmitter) release(key taskKey) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.reserved, key)
+}
+
+// available returns the memory left for the new jobs, which is the budget minus the larger of the used
+// and the reserved memory, the memory is unlimited if the admission is disabled or the memory can't be detected.
+func (a *memoryAdmitter) available() uint64 {
+	if !Params.IndexNodeCfg.MemoryAdmissionEnabled.GetAsBool() {
+		return math.MaxUint64
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	budget, occupied := a.budget(), a.usedMemory()
+	if budget == 0 {
+		return math.MaxUint64
+	}
+	if reserved := a.reservedLocked(); reserved > occupied {
+		occupied = reserved
+	}
+	if occupied >= budget {
+		return 0
+	}
+	return budget - occupied
+}
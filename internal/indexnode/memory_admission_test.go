@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestEstimateIndexMemory(t *testing.T) {
+	newReq := func(numRows int64, dim string, indexType string) *indexpb.CreateJobRequest {
+		return &indexpb.CreateJobRequest{
+			NumRows:     numRows,
+			TypeParams:  []*commonpb.KeyValuePair{{Key: common.DimKey, Value: dim}},
+			IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexType}},
+		}
+	}
+
+	assert.Equal(t, uint64(1000*128*4*2.5), estimateIndexMemory(newReq(1000, "128", "HNSW")))
+	assert.Equal(t, uint64(1000*128/8*2), estimateIndexMemory(newReq(1000, "128", "BIN_IVF_FLAT")))
+	assert.Equal(t, uint64(1000*128*4*defaultIndexMemoryFactor), estimateIndexMemory(newReq(1000, "128", "UNKNOWN")))
+	assert.Equal(t, uint64(1000*scalarRowSize*defaultIndexMemoryFactor), estimateIndexMemory(newReq(1000, "invalid", "STL_SORT")))
+	assert.Equal(t, uint64(0), estimateIndexMemory(newReq(0, "128", "HNSW")))
+
+	assert.Equal(t, uint64(1000*scalarRowSize*3), estimateIndexMemory(newReq(1000, "invalid", "INVERTED")))
+	assert.Equal(t, uint64(1000*(scalarRowSize+100.0/8)*2), estimateIndexMemory(newReq(1000, "invalid", "BITMAP")))
+	req := newReq(1000, "invalid", "BITMAP")
+	req.IndexParams = append(req.IndexParams, &commonpb.KeyValuePair{Key: common.BitmapCardinalityLimitKey, Value: "800"})
+	assert.Equal(t, uint64(1000*(scalarRowSize+100)*2), estimateIndexMemory(req))
+}
+
+func TestMemoryAdmitter(t *testing.T) {
+	Params.Init()
+	paramtable.Get().Save(Params.IndexNodeCfg.MemoryAdmissionEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.MemoryAdmissionEnabled.Key)
+
+	var total, used uint64 = 1000, 100
+	a := newMemoryAdmitter()
+	a.totalMemory = func() uint64 { return total }
+	a.usedMemory = func() uint64 { return used }
+	// budget is 900 by default
+	key1 := taskKey{ClusterID: "cluster", BuildID: 1}
+	key2 := taskKey{ClusterID: "cluster", BuildID: 2}
+	key3 := taskKey{ClusterID: "cluster", BuildID: 3}
+
+	assert.NoError(t, a.admit(key1, 500))
+	assert.NoError(t, a.admit(key1, 500))
+	assert.Equal(t, uint64(400), a.available())
+	// the reserved memory is not enough
+	assert.Error(t, a.admit(key2, 500))
+	// the used memory is not enough
+	used = 600
+	assert.NoError(t, a.admit(key2, 200))
+	assert.Error(t, a.admit(key3, 300))
+	assert.NoError(t, a.admit(key3, 0))
+	a.release(key1)
+	a.release(key2)
+
+	// a job is admitted if no other job is building, its reservation is capped at the budget
+	assert.NoError(t, a.admit(key1, 5000))
+	assert.Equal(t, uint64(900), a.reserved[key1])
+	assert.Equal(t, uint64(0), a.available())
+	assert.Error(t, a.admit(key2, 100))
+	a.release(key1)
+
+	used = 1000
+	assert.Equal(t, uint64(0), a.available())
+
+	// the memory can't be detected
+	total = 0
+	assert.NoError(t, a.admit(key2, 500))
+	assert.Equal(t, uint64(math.MaxUint64), a.available())
+	a.release(key2)
+
+	total = 1000
+	paramtable.Get().Save(Params.IndexNodeCfg.MemoryAdmissionEnabled.Key, "false")
+	assert.NoError(t, a.admit(key1, 5000))
+	assert.NoError(t, a.admit(key2, 5000))
+	assert.Equal(t, uint64(math.MaxUint64), a.available())
+}
+
+func TestIndexBuildTask_ResetReleaseMemory(t *testing.T) {
+	node := &IndexNode{memAdmitter: newMemoryAdmitter()}
+	node.memAdmitter.totalMemory = func() uint64 { return 0 }
+	assert.NoError(t, node.memAdmitter.admit(taskKey{ClusterID: "cluster", BuildID: 1}, 100))
+	task := &indexBuildTask{ClusterID: "cluster", BuildID: 1, node: node}
+	task.Reset()
+	assert.Empty(t, node.memAdmitter.reserved)
+}
@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+const (
+	// scalarIndexMetaKey is the blob key of the meta of an inverted or bitmap index.
+	scalarIndexMetaKey = "scalar_index_meta"
+	// scalarIndexValuesKey is the blob key of the distinct values in ascending order, the integers are encoded
+	// as int64, the strings are prefixed by their uint32 length, the bools are one byte each.
+	scalarIndexValuesKey = "scalar_index_values"
+	// invertedIndexPostingOffsetsKey is the blob key of the int64 offsets of the posting list of each value
+	// in invertedIndexPostingsKey, plus the total length.
+	invertedIndexPostingOffsetsKey = "inverted_index_posting_offsets"
+	// invertedIndexPostingsKey is the blob key of the int64 row offsets of all the values, grouped by value.
+	invertedIndexPostingsKey = "inverted_index_postings"
+	// bitmapIndexBitmapsKey is the blob key of the bitmaps of all the values, each bitmap has a bit per row,
+	// the bit of row i is bit i%8 of byte i/8.
+	bitmapIndexBitmapsKey = "bitmap_index_bitmaps"
+)
+
+type scalarIndexMeta struct {
+	IndexType string `json:"index_type"`
+	DataType  string `json:"data_type"`
+	NumRows   int    `json:"num_rows"`
+	NumValues int    `json:"num_values"`
+}
+
+// groupRows returns the distinct values of data in ascending order and the row offsets of each value.
+func groupRows[T int8 | int16 | int32 | int64 | string](data []T) ([]T, [][]int64) {
+	rows := make(map[T][]int64)
+	for i, v := range data {
+		rows[v] = append(rows[v], int64(i))
+	}
+	values := make([]T, 0, len(rows))
+	for v := range rows {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	postings := make([][]int64, 0, len(values))
+	for _, v := range values {
+		postings = append(postings, rows[v])
+	}
+	return values, postings
+}
+
+func encodeIntegers[T int8 | int16 | int32 | int64](values []T) []byte {
+	buf := make([]byte, 8*len(values))
+	for i, v := range values {
+		common.Endian.PutUint64(buf[8*i:], uint64(int64(v)))
+	}
+	return buf
+}
+
+func encodeStrings(values []string) []byte {
+	buf := new(bytes.Buffer)
+	lenBuf := make([]byte, 4)
+	for _, v := range values {
+		common.Endian.PutUint32(lenBuf, uint32(len(v)))
+		buf.Write(lenBuf)
+		buf.WriteString(v)
+	}
+	return buf.Bytes()
+}
+
+// groupScalarRows returns the data type, the encoded distinct values in ascending order and the row offsets of each value.
+func groupScalarRows(data storage.FieldData) (schemapb.DataType, []byte, [][]int64, error) {
+	switch fd := data.(type) {
+	case *storage.BoolFieldData:
+		var values []byte
+		var postings [][]int64
+		for _, v := range []bool{false, true} {
+			rows := make([]int64, 0)
+			for i, b := range fd.Data {
+				if b == v {
+					rows = append(rows, int64(i))
+				}
+			}
+			if len(rows) > 0 {
+				if v {
+					values = append(values, 1)
+				} else {
+					values = append(values, 0)
+				}
+				postings = append(postings, rows)
+			}
+		}
+		return schemapb.DataType_Bool, values, postings, nil
+	case *storage.Int8FieldData:
+		values, postings := groupRows(fd.Data)
+		return schemapb.DataType_Int8, encodeIntegers(values), postings, nil
+	case *storage.Int16FieldData:
+		values, postings := groupRows(fd.Data)
+		return schemapb.DataType_Int16, encodeIntegers(values), postings, nil
+	case *storage.Int32FieldData:
+		values, postings := groupRows(fd.Data)
+		return schemapb.DataType_Int32, encodeIntegers(values), postings, nil
+	case *storage.Int64FieldData:
+		values, postings := groupRows(fd.Data)
+		return schemapb.DataType_Int64, encodeIntegers(values), postings, nil
+	case *storage.StringFieldData:
+		values, postings := groupRows(fd.Data)
+		return schemapb.DataType_VarChar, encodeStrings(values), postings, nil
+	default:
+		return schemapb.DataType_None, nil, nil, fmt.Errorf("unsupported field data type %T for scalar index", data)
+	}
+}
+
+// buildScalarIndex builds the inverted or bitmap index of the field data, the index params are validated
+// against the data type of the field.
+func buildScalarIndex(indexType string, data storage.FieldData, indexParams map[string]string) ([]*storage.Blob, error) {
+	dType, values, postings, err := groupScalarRows(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := indexparamcheck.CheckIndexValid(dType, indexType, indexParams); err != nil {
+		return nil, err
+	}
+	numRows := data.RowNum()
+	metaBytes, err := json.Marshal(&scalarIndexMeta{
+		IndexType: indexType,
+		DataType:  dType.String(),
+		NumRows:   numRows,
+		NumValues: len(postings),
+	})
+	if err != nil {
+		return nil, err
+	}
+	blobs := []*storage.Blob{
+		{Key: scalarIndexMetaKey, Value: metaBytes},
+		{Key: scalarIndexValuesKey, Value: values},
+	}
+
+	switch indexType {
+	case indexparamcheck.IndexINVERTED:
+		offsets := make([]int64, 0, len(postings)+1)
+		rows := make([]int64, 0, numRows)
+		for _, posting := range postings {
+			offsets = append(offsets, int64(len(rows)))
+			rows = append(rows, posting...)
+		}
+		offsets = append(offsets, int64(len(rows)))
+		offsetsBuf := new(bytes.Buffer)
+		if err := binary.Write(offsetsBuf, common.Endian, offsets); err != nil {
+			return nil, err
+		}
+		rowsBuf := new(bytes.Buffer)
+		if err := binary.Write(rowsBuf, common.Endian, rows); err != nil {
+			return nil, err
+		}
+		blobs = append(blobs,
+			&storage.Blob{Key: invertedIndexPostingOffsetsKey, Value: offsetsBuf.Bytes()},
+			&storage.Blob{Key: invertedIndexPostingsKey, Value: rowsBuf.Bytes()})
+	case indexparamcheck.IndexBITMAP:
+		limit, err := indexparamcheck.GetBitmapCardinalityLimit(indexParams)
+		if err != nil {
+			return nil, err
+		}
+		if len(postings) > limit {
+			return nil, fmt.Errorf("the field has %d distinct values, more than the %s %d of index type %s",
+				len(postings), common.BitmapCardinalityLimitKey, limit, indexparamcheck.IndexBITMAP)
+		}
+		bitmapSize := (numRows + 7) / 8
+		bitmaps := make([]byte, bitmapSize*len(postings))
+		for i, posting := range postings {
+			bitmap := bitmaps[i*bitmapSize : (i+1)*bitmapSize]
+			for _, row := range posting {
+				bitmap[row/8] |= 1 << (row % 8)
+			}
+		}
+		blobs = append(blobs, &storage.Blob{Key: bitmapIndexBitmapsKey, Value: bitmaps})
+	default:
+		return nil, fmt.Errorf("unsupported scalar index type %s", indexType)
+	}
+	return blobs, nil
+}
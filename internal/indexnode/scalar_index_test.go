@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func blobsOf(blobs []*storage.Blob) map[string][]byte {
+	ret := make(map[string][]byte, len(blobs))
+	for _, blob := range blobs {
+		ret[blob.Key] = blob.Value
+	}
+	return ret
+}
+
+func decodeInt64s(data []byte) []int64 {
+	ret := make([]int64, len(data)/8)
+	for i := range ret {
+		ret[i] = int64(common.Endian.Uint64(data[8*i:]))
+	}
+	return ret
+}
+
+func TestBuildScalarIndex_Inverted(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		blobs, err := buildScalarIndex(indexparamcheck.IndexINVERTED, &storage.Int32FieldData{Data: []int32{7, -1, 7, 3}}, nil)
+		require.NoError(t, err)
+		files := blobsOf(blobs)
+
+		meta := &scalarIndexMeta{}
+		require.NoError(t, json.Unmarshal(files[scalarIndexMetaKey], meta))
+		assert.Equal(t, &scalarIndexMeta{IndexType: indexparamcheck.IndexINVERTED, DataType: "Int32", NumRows: 4, NumValues: 3}, meta)
+		assert.Equal(t, []int64{-1, 3, 7}, decodeInt64s(files[scalarIndexValuesKey]))
+		assert.Equal(t, []int64{0, 1, 2, 4}, decodeInt64s(files[invertedIndexPostingOffsetsKey]))
+		assert.Equal(t, []int64{1, 3, 0, 2}, decodeInt64s(files[invertedIndexPostingsKey]))
+	})
+
+	t.Run("string", func(t *testing.T) {
+		blobs, err := buildScalarIndex(indexparamcheck.IndexINVERTED, &storage.StringFieldData{Data: []string{"b", "a", "b"}}, nil)
+		require.NoError(t, err)
+		files := blobsOf(blobs)
+		values := files[scalarIndexValuesKey]
+		assert.Equal(t, uint32(1), binary.LittleEndian.Uint32(values))
+		assert.Equal(t, "a", string(values[4:5]))
+		assert.Equal(t, "b", string(values[9:10]))
+		assert.Equal(t, []int64{1, 0, 2}, decodeInt64s(files[invertedIndexPostingsKey]))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := buildScalarIndex(indexparamcheck.IndexINVERTED, &storage.BoolFieldData{Data: []bool{true}}, nil)
+		assert.Error(t, err)
+		_, err = buildScalarIndex(indexparamcheck.IndexINVERTED, &storage.FloatFieldData{Data: []float32{1}}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildScalarIndex_Bitmap(t *testing.T) {
+	data := &storage.BoolFieldData{Data: []bool{true, false, true, true, false, false, false, false, true}}
+	blobs, err := buildScalarIndex(indexparamcheck.IndexBITMAP, data, nil)
+	require.NoError(t, err)
+	files := blobsOf(blobs)
+	assert.Equal(t, []byte{0, 1}, files[scalarIndexValuesKey])
+	// false: rows 1, 4, 5, 6, 7; true: rows 0, 2, 3, 8
+	assert.Equal(t, []byte{0xf2, 0x00, 0x0d, 0x01}, files[bitmapIndexBitmapsKey])
+
+	params := map[string]string{common.BitmapCardinalityLimitKey: "2"}
+	_, err = buildScalarIndex(indexparamcheck.IndexBITMAP, &storage.Int64FieldData{Data: []int64{1, 2, 1}}, params)
+	assert.NoError(t, err)
+	_, err = buildScalarIndex(indexparamcheck.IndexBITMAP, &storage.Int64FieldData{Data: []int64{1, 2, 3}}, params)
+	assert.Error(t, err)
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -57,9 +58,13 @@ type taskInfo struct {
 	fileKeys       []string
 	serializedSize uint64
 	failReason     string
+	// failClass is the class of failReason, empty if not failed
+	failClass common.IndexFailureClass
 
 	// task statistics
 	statistic *indexpb.JobInfo
+	// resourceUsage is the resources consumed by the task, nil until it's done or if not accounted
+	resourceUsage *taskResourceUsage
 }
 
 type task interface {
@@ -100,9 +105,32 @@ type indexBuildTask struct {
 	tr             *timerecord.TimeRecorder
 	statistic      indexpb.JobInfo
 	node           *IndexNode
+
+	// fields covered by a composite scalar index, the leading one is fieldID
+	compositeFieldIDs []int64
+	compositeData     map[storage.FieldID]storage.FieldData
+
+	// time of the last build event published
+	lastEventAt time.Time
+
+	// bundle of the builds of the same segment sharing the downloaded binlogs, nil if not bundled
+	bundle *segmentBundle
+
+	// reproducible builds with a deterministic seed and records the build in the index manifest
+	reproducible bool
+	seed         int64
+	// sha256 of the index blobs before encoding, by blob key, only if reproducible
+	checksums map[string]string
 }
 
 func (it *indexBuildTask) Reset() {
+	if it.node != nil && it.node.memAdmitter != nil {
+		it.node.memAdmitter.release(taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID})
+	}
+	if it.node != nil && it.node.bundles != nil {
+		it.node.bundles.leave(it.bundle, it.BuildID)
+	}
+	it.bundle = nil
 	it.ident = ""
 	it.cancel = nil
 	it.ctx = nil
@@ -111,6 +139,12 @@ func (it *indexBuildTask) Reset() {
 	it.savePaths = nil
 	it.req = nil
 	it.fieldData = nil
+	it.compositeFieldIDs = nil
+	it.compositeData = nil
+	it.lastEventAt = time.Time{}
+	it.reproducible = false
+	it.seed = 0
+	it.checksums = nil
 	it.indexBlobs = nil
 	it.newTypeParams = nil
 	it.newIndexParams = nil
@@ -128,8 +162,22 @@ func (it *indexBuildTask) Name() string {
 	return it.ident
 }
 
+// SetResourceUsage keeps the resources consumed by the task in its task info.
+func (it *indexBuildTask) SetResourceUsage(usage *taskResourceUsage) {
+	if it.node == nil {
+		return
+	}
+	it.node.storeTaskResourceUsage(it.ClusterID, it.BuildID, usage)
+}
+
 func (it *indexBuildTask) SetState(state commonpb.IndexState, failReason string) {
 	it.node.storeTaskState(it.ClusterID, it.BuildID, state, failReason)
+	switch state {
+	case commonpb.IndexState_Finished:
+		it.publishEvent(buildEventFinished, "", state, "")
+	case commonpb.IndexState_Failed, commonpb.IndexState_Retry:
+		it.publishEvent(buildEventFailed, "", state, failReason)
+	}
 }
 
 func (it *indexBuildTask) GetState() commonpb.IndexState {
@@ -141,10 +189,12 @@ func (it *indexBuildTask) OnEnqueue(ctx context.Context) error {
 	it.statistic.StartTime = time.Now().UnixMicro()
 	it.statistic.PodID = it.node.GetNodeID()
 	log.Ctx(ctx).Info("IndexNode IndexBuilderTask Enqueue", zap.Int64("buildID", it.BuildID), zap.Int64("segID", it.segmentID))
+	it.publishEvent(buildEventEnqueued, "", commonpb.IndexState_Unissued, "")
 	return nil
 }
 
 func (it *indexBuildTask) Prepare(ctx context.Context) error {
+	it.publishEvent(buildEventStarted, buildPhasePrepare, commonpb.IndexState_InProgress, "")
 	log.Ctx(ctx).Info("Begin to prepare indexBuildTask", zap.Int64("buildID", it.BuildID),
 		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
 	typeParams := make(map[string]string)
@@ -161,9 +211,24 @@ func (it *indexBuildTask) Prepare(ctx context.Context) error {
 		key, value := kvPair.GetKey(), kvPair.GetValue()
 		indexParams[key] = value
 	}
+	if it.reproducible {
+		if err := it.setBuildSeed(indexParams); err != nil {
+			return err
+		}
+	}
 	it.newTypeParams = typeParams
 	it.newIndexParams = indexParams
 	it.statistic.IndexParams = it.req.GetIndexParams()
+	if indexParams[common.IndexTypeKey] == indexparamcheck.IndexComposite {
+		fieldIDs, err := common.ParseCompositeFieldIDs(indexParams[common.CompositeFieldIDsKey])
+		if err != nil {
+			return err
+		}
+		if len(fieldIDs) == 0 {
+			return fmt.Errorf("%s is required by index type %s", common.CompositeFieldIDsKey, indexparamcheck.IndexComposite)
+		}
+		it.compositeFieldIDs = fieldIDs
+	}
 	// ugly codes to get dimension
 	if dimStr, ok := typeParams["dim"]; ok {
 		var err error
@@ -179,7 +244,13 @@ func (it *indexBuildTask) Prepare(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) LoadData(ctx context.Context) error {
+	it.publishEvent(buildEventPhase, buildPhaseLoadData, commonpb.IndexState_InProgress, "")
+	var limiter *downloadLimiter
+	if it.node != nil {
+		limiter = it.node.downloadLimiter
+	}
 	getValueByPath := func(path string) ([]byte, error) {
+		start := time.Now()
 		data, err := it.cm.Read(ctx, path)
 		if err != nil {
 			if errors.Is(err, ErrNoSuchKey) {
@@ -187,8 +258,17 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 			}
 			return nil, err
 		}
+		if err := limiter.wait(ctx, start, len(data)); err != nil {
+			return nil, err
+		}
 		return data, nil
 	}
+	if it.bundle != nil {
+		download := getValueByPath
+		getValueByPath = func(path string) ([]byte, error) {
+			return it.bundle.read(ctx, it.BuildID, path, download)
+		}
+	}
 	getBlobByPath := func(path string) (*Blob, error) {
 		value, err := getValueByPath(path)
 		if err != nil {
@@ -213,10 +293,7 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 		blobs[idx] = blob
 		return nil
 	}
-	// Use runtime.GOMAXPROCS(0) instead of runtime.NumCPU()
-	// to respect CPU quota of container/pod
-	// gomaxproc will be set by `automaxproc`, passing 0 will just retrieve the value
-	err := funcutil.ProcessFuncParallel(len(toLoadDataPaths), runtime.GOMAXPROCS(0), loadKey, "loadKey")
+	err := funcutil.ProcessFuncParallel(len(toLoadDataPaths), downloadParallel(), loadKey, "loadKey")
 	if err != nil {
 		log.Ctx(ctx).Warn("loadKey failed", zap.Error(err))
 		return err
@@ -229,6 +306,7 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 	if err != nil {
 		log.Ctx(ctx).Info("failed to decode blobs", zap.Int64("buildID", it.BuildID),
 			zap.Int64("Collection", it.collectionID), zap.Int64("SegmentIf", it.segmentID), zap.Error(err))
+		err = common.NewIndexFailure(common.IndexFailureCorruptBinlog, "", err)
 	} else {
 		log.Ctx(ctx).Info("Successfully load data", zap.Int64("buildID", it.BuildID),
 			zap.Int64("Collection", it.collectionID), zap.Int64("SegmentIf", it.segmentID))
@@ -237,11 +315,18 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
+	it.publishEvent(buildEventPhase, buildPhaseBuildIndex, commonpb.IndexState_InProgress, "")
 	// support build diskann index
 	indexType := it.newIndexParams["index_type"]
 	if indexType == indexparamcheck.IndexDISKANN {
 		return it.BuildDiskAnnIndex(ctx)
 	}
+	if indexType == indexparamcheck.IndexComposite {
+		return it.BuildCompositeIndex(ctx)
+	}
+	if indexparamcheck.IsInvertedOrBitmapIndex(indexType) {
+		return it.BuildScalarIndex(ctx)
+	}
 
 	dataset := indexcgowrapper.GenDataset(it.fieldData)
 	dType := dataset.DType
@@ -249,7 +334,11 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	if dType != schemapb.DataType_None {
 		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig())
 		if err == nil {
-			err = it.index.Build(dataset)
+			if it.reproducible {
+				err = indexcgowrapper.BuildSingleThreaded(it.index, dataset)
+			} else {
+				err = it.index.Build(dataset)
+			}
 		}
 
 		if err != nil {
@@ -267,6 +356,7 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 		return err
 	}
 	it.tr.Record("index serialize done")
+	it.recordChecksums(indexBlobs)
 
 	// use serialized size before encoding
 	it.serializedSize = 0
@@ -304,6 +394,73 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	return nil
 }
 
+// BuildCompositeIndex builds the composite scalar index which covers multiple fields.
+func (it *indexBuildTask) BuildCompositeIndex(ctx context.Context) error {
+	indexBlobs, err := buildCompositeIndex(it.compositeFieldIDs, it.compositeData)
+	if err != nil {
+		log.Ctx(ctx).Error("failed to build composite index", zap.Int64("buildID", it.BuildID), zap.Error(err))
+		return err
+	}
+	if err := it.encodeIndexBlobs(indexBlobs); err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("Successfully build composite index", zap.Int64("buildID", it.BuildID),
+		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID),
+		zap.Int64s("fieldIDs", it.compositeFieldIDs))
+	return nil
+}
+
+// BuildScalarIndex builds the inverted or bitmap index of the scalar field.
+func (it *indexBuildTask) BuildScalarIndex(ctx context.Context) error {
+	indexType := it.newIndexParams[common.IndexTypeKey]
+	indexBlobs, err := buildScalarIndex(indexType, it.fieldData, it.newIndexParams)
+	if err != nil {
+		log.Ctx(ctx).Error("failed to build scalar index", zap.Int64("buildID", it.BuildID),
+			zap.String("indexType", indexType), zap.Error(err))
+		return err
+	}
+	if err := it.encodeIndexBlobs(indexBlobs); err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("Successfully build scalar index", zap.Int64("buildID", it.BuildID),
+		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID),
+		zap.String("indexType", indexType))
+	return nil
+}
+
+// encodeIndexBlobs encodes the index blobs built in Go into the index files to save.
+func (it *indexBuildTask) encodeIndexBlobs(indexBlobs []*storage.Blob) error {
+	buildIndexLatency := it.tr.Record("build index done")
+	metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(buildIndexLatency.Milliseconds()))
+
+	it.serializedSize = 0
+	for _, blob := range indexBlobs {
+		it.serializedSize += uint64(len(blob.Value))
+	}
+	it.recordChecksums(indexBlobs)
+
+	codec := storage.NewIndexFileBinlogCodec()
+	serializedIndexBlobs, err := codec.Serialize(
+		it.req.BuildID,
+		it.req.IndexVersion,
+		it.collectionID,
+		it.partitionID,
+		it.segmentID,
+		it.fieldID,
+		it.newIndexParams,
+		it.req.IndexName,
+		it.req.IndexID,
+		indexBlobs,
+	)
+	if err != nil {
+		return err
+	}
+	encodeIndexFileDur := it.tr.Record("index codec serialize done")
+	metrics.IndexNodeEncodeIndexFileLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(encodeIndexFileDur.Milliseconds()))
+	it.indexBlobs = serializedIndexBlobs
+	return nil
+}
+
 func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 	// check index node support disk index
 	if !Params.IndexNodeCfg.EnableDisk.GetAsBool() {
@@ -405,6 +562,7 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
+	it.publishEvent(buildEventPhase, buildPhaseSaveIndexFiles, commonpb.IndexState_InProgress, "")
 	// support build diskann index
 	indexType := it.newIndexParams["index_type"]
 	if indexType == indexparamcheck.IndexDISKANN {
@@ -436,6 +594,18 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 		log.Ctx(ctx).Error("saveIndexFile fail")
 		return err
 	}
+	if Params.IndexNodeCfg.ArtifactLayoutVersion.GetAsInt() >= storage.IndexManifestVersion {
+		fileSizes := make([]int64, blobCnt)
+		for i, blob := range it.indexBlobs {
+			fileSizes[i] = int64(len(blob.Value))
+		}
+		manifestPath, err := it.saveIndexManifest(ctx, saveFileKeys, fileSizes)
+		if err != nil {
+			return err
+		}
+		savePaths = append(savePaths, manifestPath)
+		saveFileKeys = append(saveFileKeys, storage.IndexManifestKey)
+	}
 	it.savePaths = savePaths
 	it.statistic.EndTime = time.Now().UnixMicro()
 	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, it.serializedSize, &it.statistic)
@@ -495,6 +665,19 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 
 	saveFileKeys = append(saveFileKeys, indexParamBlob.Key)
 	savePaths = append(savePaths, indexParamPath)
+	if Params.IndexNodeCfg.ArtifactLayoutVersion.GetAsInt() >= storage.IndexManifestVersion {
+		fileSizes := make([]int64, 0, len(saveFileKeys))
+		for _, blob := range it.indexBlobs {
+			fileSizes = append(fileSizes, blob.Size)
+		}
+		fileSizes = append(fileSizes, int64(len(indexParamBlob.Value)))
+		manifestPath, err := it.saveIndexManifest(ctx, saveFileKeys, fileSizes)
+		if err != nil {
+			return err
+		}
+		savePaths = append(savePaths, manifestPath)
+		saveFileKeys = append(saveFileKeys, storage.IndexManifestKey)
+	}
 	it.savePaths = savePaths
 
 	it.statistic.EndTime = time.Now().UnixMicro()
@@ -508,6 +691,32 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 	return nil
 }
 
+// saveIndexManifest saves the manifest listing the saved index files, it must be saved after all of them
+// so that the readers never see a manifest of an incomplete build.
+func (it *indexBuildTask) saveIndexManifest(ctx context.Context, fileKeys []string, fileSizes []int64) (string, error) {
+	manifest := storage.NewIndexManifest(it.req.GetBuildID(), it.req.GetIndexVersion())
+	for i, fileKey := range fileKeys {
+		manifest.AddFile(fileKey, fileSizes[i])
+	}
+	if it.reproducible {
+		manifest.Build = it.buildRecord()
+	}
+	data, err := manifest.Marshal()
+	if err != nil {
+		return "", err
+	}
+	manifestPath := metautil.BuildSegmentIndexFilePath(it.cm.RootPath(), it.req.BuildID, it.req.IndexVersion,
+		it.partitionID, it.segmentID, storage.IndexManifestKey)
+	saveFn := func() error {
+		return it.cm.Write(ctx, manifestPath, data)
+	}
+	if err := retry.Do(ctx, saveFn, retry.Attempts(5)); err != nil {
+		log.Ctx(ctx).Warn("index node save index manifest failed", zap.Error(err), zap.String("savePath", manifestPath))
+		return "", err
+	}
+	return manifestPath, nil
+}
+
 func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob) error {
 	var insertCodec storage.InsertCodec
 	collectionID, partitionID, segmentID, insertData, err2 := insertCodec.DeserializeAll(blobs)
@@ -517,7 +726,12 @@ func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob
 	decodeDuration := it.tr.RecordSpan().Milliseconds()
 	metrics.IndexNodeDecodeFieldLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(decodeDuration))
 
-	if len(insertData.Data) != 1 {
+	if len(it.compositeFieldIDs) > 0 {
+		if len(insertData.Data) != len(it.compositeFieldIDs) {
+			return fmt.Errorf("we expect %d fields in deserialized insert data for composite index, got %d",
+				len(it.compositeFieldIDs), len(insertData.Data))
+		}
+	} else if len(insertData.Data) != 1 {
 		return errors.New("we expect only one field in deserialized insert data")
 	}
 	it.collectionID = collectionID
@@ -533,6 +747,19 @@ func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob
 
 	it.tr.Record("deserialize vector data done")
 
+	if len(it.compositeFieldIDs) > 0 {
+		leading := it.compositeFieldIDs[0]
+		data, ok := insertData.Data[leading]
+		if !ok {
+			return fmt.Errorf("data of leading field %d not found for composite index", leading)
+		}
+		it.compositeData = insertData.Data
+		it.statistic.NumRows = int64(data.RowNum())
+		it.fieldID = leading
+		it.fieldData = data
+		return nil
+	}
+
 	// we can ensure that there blobs are in one Field
 	var data storage.FieldData
 	var fieldID storage.FieldID
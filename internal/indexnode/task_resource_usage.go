@@ -0,0 +1,212 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// The grpc response trailers of the resource usages of the build tasks in json.
+const (
+	// taskUsageTrailer maps the requested build ids to the usages of their finished tasks in QueryJobs.
+	taskUsageTrailer = "milvus-index-task-usage"
+	// jobUsageTrailer lists the usages at the positions of the job infos in GetJobStats, null if unknown.
+	jobUsageTrailer = "milvus-index-job-usage"
+)
+
+// taskResourceUsage is the resources consumed by a build task.
+type taskResourceUsage struct {
+	CPUSeconds   float64 `json:"cpu_seconds"`
+	PeakRSSBytes uint64  `json:"peak_rss_bytes"`
+	ReadBytes    uint64  `json:"read_bytes"`
+	WriteBytes   uint64  `json:"write_bytes"`
+}
+
+// resourceUsageRecorder is implemented by the tasks keeping their resource usages.
+type resourceUsageRecorder interface {
+	SetResourceUsage(usage *taskResourceUsage)
+}
+
+// processStats is the accumulated cpu time and io bytes, and the current rss of the process.
+type processStats struct {
+	cpuSeconds float64
+	rss        uint64
+	readBytes  uint64
+	writeBytes uint64
+}
+
+// readProcessStats reads the stats of the process, the io bytes are the rchar and wchar of /proc/self/io,
+// which unlike the block device io include the object storage traffic. They are zero if it doesn't exist.
+func readProcessStats() (processStats, error) {
+	stats := processStats{}
+	proc, err := process.NewProcess(int32(os.Getpid()))
+	if err != nil {
+		return stats, err
+	}
+	times, err := proc.Times()
+	if err != nil {
+		return stats, err
+	}
+	stats.cpuSeconds = times.User + times.System
+	mem, err := proc.MemoryInfo()
+	if err != nil {
+		return stats, err
+	}
+	stats.rss = mem.RSS
+
+	content, err := os.ReadFile("/proc/self/io")
+	if os.IsNotExist(err) {
+		return stats, nil
+	}
+	if err != nil {
+		return stats, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := bytes.Fields(scanner.Bytes())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(string(fields[1]), 10, 64)
+		if err != nil {
+			return stats, err
+		}
+		switch string(fields[0]) {
+		case "rchar:":
+			stats.readBytes = value
+		case "wchar:":
+			stats.writeBytes = value
+		}
+	}
+	return stats, nil
+}
+
+// resourceAccountant attributes the process stats to the running build tasks, since the index engine builds on its
+// own threads which can't be told apart. The cpu time and the io bytes consumed between two samples are split evenly
+// among the tasks running in between, the peak rss of a task is the max process rss sampled while it runs.
+type resourceAccountant struct {
+	read func() (processStats, error)
+
+	mu      sync.Mutex
+	last    processStats
+	running map[string]*taskResourceUsage
+}
+
+func newResourceAccountant(read func() (processStats, error)) *resourceAccountant {
+	return &resourceAccountant{
+		read:    read,
+		running: make(map[string]*taskResourceUsage),
+	}
+}
+
+func counterDelta(current, last uint64) uint64 {
+	if current < last {
+		return 0
+	}
+	return current - last
+}
+
+// sampleLocked attributes the usage since the last sample to the running tasks, the caller must hold the lock.
+func (a *resourceAccountant) sampleLocked() {
+	stats, err := a.read()
+	if err != nil {
+		log.RatedWarn(60, "failed to read the process stats for resource accounting", zap.Error(err))
+		return
+	}
+	if n := len(a.running); n > 0 {
+		cpuSeconds := (stats.cpuSeconds - a.last.cpuSeconds) / float64(n)
+		if cpuSeconds < 0 {
+			cpuSeconds = 0
+		}
+		readBytes := counterDelta(stats.readBytes, a.last.readBytes) / uint64(n)
+		writeBytes := counterDelta(stats.writeBytes, a.last.writeBytes) / uint64(n)
+		for _, usage := range a.running {
+			usage.CPUSeconds += cpuSeconds
+			usage.ReadBytes += readBytes
+			usage.WriteBytes += writeBytes
+			if stats.rss > usage.PeakRSSBytes {
+				usage.PeakRSSBytes = stats.rss
+			}
+		}
+	}
+	a.last = stats
+}
+
+// start starts accounting the task.
+func (a *resourceAccountant) start(name string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sampleLocked()
+	a.running[name] = &taskResourceUsage{PeakRSSBytes: a.last.rss}
+}
+
+// finish stops accounting the task and returns its usage, nil if it's not started.
+func (a *resourceAccountant) finish(name string) *taskResourceUsage {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sampleLocked()
+	usage, ok := a.running[name]
+	if !ok {
+		return nil
+	}
+	delete(a.running, name)
+	return usage
+}
+
+// run samples the process stats every interval until the ctx is done.
+func (a *resourceAccountant) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.mu.Lock()
+			if len(a.running) > 0 {
+				a.sampleLocked()
+			}
+			a.mu.Unlock()
+		}
+	}
+}
+
+// setResourceUsageTrailer sets the usages in json in the grpc response trailer.
+func setResourceUsageTrailer(ctx context.Context, key string, usages interface{}) {
+	value, err := json.Marshal(usages)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to marshal the resource usages", zap.Error(err))
+		return
+	}
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(key, string(value))); err != nil {
+		log.Ctx(ctx).RatedDebug(60, "failed to set the resource usage trailer", zap.String("key", key), zap.Error(err))
+	}
+}
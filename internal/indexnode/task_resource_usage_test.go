@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+type fakeProcessStats struct {
+	stats processStats
+	err   error
+}
+
+func (f *fakeProcessStats) read() (processStats, error) {
+	return f.stats, f.err
+}
+
+func TestResourceAccountant(t *testing.T) {
+	f := &fakeProcessStats{stats: processStats{cpuSeconds: 10, rss: 100, readBytes: 1000, writeBytes: 1000}}
+	a := newResourceAccountant(f.read)
+
+	a.start("t1")
+	f.stats = processStats{cpuSeconds: 12, rss: 300, readBytes: 1400, writeBytes: 1100}
+	// the usage between two samples is split between the running tasks
+	a.start("t2")
+	f.stats = processStats{cpuSeconds: 16, rss: 200, readBytes: 2400, writeBytes: 1300}
+	usage := a.finish("t1")
+	require.NotNil(t, usage)
+	assert.Equal(t, &taskResourceUsage{CPUSeconds: 4, PeakRSSBytes: 300, ReadBytes: 900, WriteBytes: 200}, usage)
+
+	// the failed samples are skipped
+	f.err = errors.New("mock error")
+	a.mu.Lock()
+	a.sampleLocked()
+	a.mu.Unlock()
+	f.err = nil
+	f.stats = processStats{cpuSeconds: 17, rss: 150, readBytes: 2500, writeBytes: 1300}
+	usage = a.finish("t2")
+	require.NotNil(t, usage)
+	assert.Equal(t, &taskResourceUsage{CPUSeconds: 3, PeakRSSBytes: 300, ReadBytes: 600, WriteBytes: 100}, usage)
+
+	assert.Nil(t, a.finish("t3"))
+}
+
+func TestReadProcessStats(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("process stats are read from procfs")
+	}
+	stats, err := readProcessStats()
+	require.NoError(t, err)
+	assert.Greater(t, stats.rss, uint64(0))
+	assert.GreaterOrEqual(t, stats.cpuSeconds, float64(0))
+}
+
+type usageTask struct {
+	*fakeTask
+	usage *taskResourceUsage
+}
+
+func (t *usageTask) Reset() {}
+
+func (t *usageTask) SetResourceUsage(usage *taskResourceUsage) {
+	t.usage = usage
+}
+
+func (t *usageTask) SetState(state commonpb.IndexState, failReason string) {
+	// the usage is recorded before the final state
+	if t.usage == nil {
+		panic("resource usage is not recorded before the state")
+	}
+	t.fakeTask.SetState(state, failReason)
+}
+
+func TestTaskScheduler_processTaskResourceUsage(t *testing.T) {
+	Params.Init()
+	sched, err := NewTaskScheduler(context.TODO())
+	require.NoError(t, err)
+	f := &fakeProcessStats{stats: processStats{cpuSeconds: 1}}
+	sched.accountant = newResourceAccountant(f.read)
+
+	ut := &usageTask{fakeTask: newTask(-1, nil, commonpb.IndexState_Finished).(*fakeTask)}
+	sched.processTask(ut, sched.IndexBuildQueue)
+	assert.Equal(t, commonpb.IndexState_Finished, ut.GetState())
+	assert.NotNil(t, ut.usage)
+}
+
+type trailerStreamMock struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerStreamMock) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestIndexNode_ResourceUsageTrailers(t *testing.T) {
+	Params.Init()
+	in, err := NewIndexNode(context.Background(), nil)
+	require.NoError(t, err)
+	in.UpdateStateCode(commonpb.StateCode_Healthy)
+	in.loadOrStoreTask("c1", 1, &taskInfo{state: commonpb.IndexState_Finished, statistic: &indexpb.JobInfo{NumRows: 10}})
+	in.loadOrStoreTask("c1", 2, &taskInfo{state: commonpb.IndexState_InProgress})
+	in.storeTaskResourceUsage("c1", 1, &taskResourceUsage{CPUSeconds: 1.5, PeakRSSBytes: 1024, ReadBytes: 10, WriteBytes: 20})
+
+	stream := &trailerStreamMock{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	resp, err := in.QueryJobs(ctx, &indexpb.QueryJobsRequest{ClusterID: "c1", BuildIDs: []int64{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	values := stream.trailer.Get(taskUsageTrailer)
+	require.Equal(t, 1, len(values))
+	usages := make(map[string]*taskResourceUsage)
+	require.NoError(t, json.Unmarshal([]byte(values[0]), &usages))
+	assert.Equal(t, map[string]*taskResourceUsage{"1": {CPUSeconds: 1.5, PeakRSSBytes: 1024, ReadBytes: 10, WriteBytes: 20}}, usages)
+
+	stream = &trailerStreamMock{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	statsResp, err := in.GetJobStats(ctx, &indexpb.GetJobStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(statsResp.GetJobInfos()))
+	values = stream.trailer.Get(jobUsageTrailer)
+	require.Equal(t, 1, len(values))
+	jobUsages := make([]*taskResourceUsage, 0)
+	require.NoError(t, json.Unmarshal([]byte(values[0]), &jobUsages))
+	require.Equal(t, 1, len(jobUsages))
+	assert.Equal(t, 1.5, jobUsages[0].CPUSeconds)
+
+	// no trailer without usage
+	stream = &trailerStreamMock{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err = in.QueryJobs(ctx, &indexpb.QueryJobsRequest{ClusterID: "c1", BuildIDs: []int64{2}})
+	require.NoError(t, err)
+	assert.Empty(t, stream.trailer.Get(taskUsageTrailer))
+}
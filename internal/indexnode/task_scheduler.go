@@ -22,6 +22,7 @@ import (
 	"errors"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -169,6 +170,11 @@ type TaskScheduler struct {
 	wg            sync.WaitGroup
 	ctx           context.Context
 	cancel        context.CancelFunc
+
+	// workerPool runs the tasks on warm standby workers if enabled, nil means a new goroutine per task
+	workerPool *buildWorkerPool
+	// accountant attributes the process stats to the running tasks if enabled
+	accountant *resourceAccountant
 }
 
 // NewTaskScheduler creates a new task scheduler of indexing tasks.
@@ -180,6 +186,12 @@ func NewTaskScheduler(ctx context.Context) (*TaskScheduler, error) {
 		buildParallel: Params.IndexNodeCfg.BuildParallel.GetAsInt(),
 	}
 	s.IndexBuildQueue = NewIndexBuildTaskQueue(s)
+	if Params.IndexNodeCfg.WarmWorkersEnabled.GetAsBool() {
+		s.workerPool = newBuildWorkerPool(s.buildParallel, s.releaseMemoryIfIdle)
+	}
+	if Params.IndexNodeCfg.ResourceUsageSampleInterval.GetAsInt() > 0 {
+		s.accountant = newResourceAccountant(readProcessStats)
+	}
 
 	return s, nil
 }
@@ -208,26 +220,51 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 
 	defer func() {
 		t.Reset()
-		debug.FreeOSMemory()
+		// the warm workers keep the memory across the tasks, it's released once the node is idle
+		if sched.workerPool == nil {
+			debug.FreeOSMemory()
+		}
 	}()
 	sched.IndexBuildQueue.AddActiveTask(t)
 	defer sched.IndexBuildQueue.PopActiveTask(t.Name())
+	// the usage is recorded before the final state, so it's there once the task is queried as done
+	setState := t.SetState
+	if sched.accountant != nil {
+		sched.accountant.start(t.Name())
+		setState = func(state commonpb.IndexState, failReason string) {
+			usage := sched.accountant.finish(t.Name())
+			if recorder, ok := t.(resourceUsageRecorder); ok && usage != nil {
+				recorder.SetResourceUsage(usage)
+			}
+			t.SetState(state, failReason)
+		}
+	}
 	log.Ctx(t.Ctx()).Debug("process task", zap.String("task", t.Name()))
-	pipelines := []func(context.Context) error{t.Prepare, t.LoadData, t.BuildIndex, t.SaveIndexFiles}
-	for _, fn := range pipelines {
-		if err := wrap(fn); err != nil {
+	pipelines := []struct {
+		phase string
+		fn    func(context.Context) error
+	}{
+		{buildPhasePrepare, t.Prepare},
+		{buildPhaseLoadData, t.LoadData},
+		{buildPhaseBuildIndex, t.BuildIndex},
+		{buildPhaseSaveIndexFiles, t.SaveIndexFiles},
+	}
+	for _, pipeline := range pipelines {
+		if err := wrap(pipeline.fn); err != nil {
+			failure := classifyBuildFailure(pipeline.phase, err)
 			if err == errCancel {
 				log.Ctx(t.Ctx()).Warn("index build task canceled", zap.String("task", t.Name()))
-				t.SetState(commonpb.IndexState_Failed, err.Error())
-			} else if errors.Is(err, ErrNoSuchKey) {
-				t.SetState(commonpb.IndexState_Failed, err.Error())
+			}
+			// the failures not retryable and the missing binlogs fail the task, the others are retried
+			if !failure.Class.Retryable() || errors.Is(err, ErrNoSuchKey) {
+				setState(commonpb.IndexState_Failed, failure.Error())
 			} else {
-				t.SetState(commonpb.IndexState_Retry, err.Error())
+				setState(commonpb.IndexState_Retry, failure.Error())
 			}
 			return
 		}
 	}
-	t.SetState(commonpb.IndexState_Finished, "")
+	setState(commonpb.IndexState_Finished, "")
 }
 
 func (sched *TaskScheduler) indexBuildLoop() {
@@ -242,20 +279,44 @@ func (sched *TaskScheduler) indexBuildLoop() {
 			var wg sync.WaitGroup
 			for _, t := range tasks {
 				wg.Add(1)
-				go func(group *sync.WaitGroup, t task) {
-					defer group.Done()
-					sched.processTask(t, sched.IndexBuildQueue)
+				fn := func(group *sync.WaitGroup, t task) func() {
+					return func() {
+						defer group.Done()
+						sched.processTask(t, sched.IndexBuildQueue)
+					}
 				}(&wg, t)
+				if sched.workerPool != nil {
+					sched.workerPool.submit(fn)
+				} else {
+					go fn()
+				}
 			}
 			wg.Wait()
 		}
 	}
 }
 
+// releaseMemoryIfIdle releases the memory to the os if there is no task waiting.
+func (sched *TaskScheduler) releaseMemoryIfIdle() {
+	if unissued, _ := sched.IndexBuildQueue.GetTaskNum(); unissued == 0 {
+		debug.FreeOSMemory()
+	}
+}
+
 // Start stats the task scheduler of indexing tasks.
 func (sched *TaskScheduler) Start() error {
+	if sched.workerPool != nil {
+		sched.workerPool.start()
+	}
 	sched.wg.Add(1)
 	go sched.indexBuildLoop()
+	if sched.accountant != nil {
+		sched.wg.Add(1)
+		go func() {
+			defer sched.wg.Done()
+			sched.accountant.run(sched.ctx, Params.IndexNodeCfg.ResourceUsageSampleInterval.GetAsDuration(time.Millisecond))
+		}()
+	}
 	return nil
 }
 
@@ -263,4 +324,7 @@ func (sched *TaskScheduler) Start() error {
 func (sched *TaskScheduler) Close() {
 	sched.cancel()
 	sched.wg.Wait()
+	if sched.workerPool != nil {
+		sched.workerPool.close()
+	}
 }
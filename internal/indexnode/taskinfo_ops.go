@@ -43,6 +43,10 @@ func (i *IndexNode) storeTaskState(ClusterID string, buildID UniqueID, state com
 			zap.String("state", state.String()), zap.String("fail reason", failReason))
 		task.state = state
 		task.failReason = failReason
+		task.failClass = ""
+		if failReason != "" {
+			task.failClass, _, _ = common.ParseIndexFailReason(failReason)
+		}
 	}
 }
 
@@ -66,6 +70,15 @@ func (i *IndexNode) storeIndexFilesAndStatistic(ClusterID string, buildID Unique
 	}
 }
 
+func (i *IndexNode) storeTaskResourceUsage(ClusterID string, buildID UniqueID, usage *taskResourceUsage) {
+	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if info, ok := i.tasks[key]; ok {
+		info.resourceUsage = usage
+	}
+}
+
 func (i *IndexNode) deleteTaskInfos(keys []taskKey) []*taskInfo {
 	i.stateLock.Lock()
 	defer i.stateLock.Unlock()
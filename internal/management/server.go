@@ -17,13 +17,18 @@
 package management
 
 import (
+	"crypto/subtle"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/management/healthz"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.uber.org/zap"
 )
 
@@ -51,16 +56,56 @@ func registerDefaults() {
 	})
 }
 
+// Register registers the handler to the management http server,
+// the requests other than GET and HEAD must be authorized by the management token.
 func Register(h *HTTPHandler) {
 	if h.HandlerFunc != nil {
-		http.HandleFunc(h.Path, h.HandlerFunc)
+		http.Handle(h.Path, authorizeMutation(h.HandlerFunc))
 		return
 	}
 	if h.Handler != nil {
-		http.Handle(h.Path, h.Handler)
+		http.Handle(h.Path, authorizeMutation(h.Handler))
 	}
 }
 
+// authorizeMutation rejects the requests which may change the state of the component unless they carry
+// the bearer token configured by common.security.managementToken. If no token is set, they are only
+// accepted from the loopback addresses, e.g. kubectl exec or a sidecar.
+func authorizeMutation(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet || req.Method == http.MethodHead {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		token := paramtable.Get().CommonCfg.ManagementToken.GetValue()
+		if token == "" {
+			if isLoopback(req.RemoteAddr) {
+				handler.ServeHTTP(w, req)
+				return
+			}
+			WriteError(w, http.StatusForbidden, errors.New("mutating management apis are only served to the loopback addresses, set common.security.managementToken to serve the remote ones"))
+			return
+		}
+		auth := req.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			WriteError(w, http.StatusUnauthorized, errors.New("invalid management token"))
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+func isLoopback(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func ServeHTTP() {
 	registerDefaults()
 	go func() {
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/management/healthz"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -40,15 +42,61 @@ func TestGetHTTPAddr(t *testing.T) {
 	assert.Equal(t, getHTTPAddr(), ":"+testPort)
 }
 
+func TestWriteError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteError(rec, http.StatusBadRequest, errors.New("bad request"))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	resp := map[string]string{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "bad request", resp["error"])
+}
+
+func TestAuthorizeMutation(t *testing.T) {
+	paramtable.Init()
+	handler := authorizeMutation(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		WriteJSON(w, http.StatusOK, map[string]string{"method": req.Method})
+	}))
+	serveFrom := func(remoteAddr, method, auth string) int {
+		req := httptest.NewRequest(method, "/", nil)
+		req.RemoteAddr = remoteAddr
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	serve := func(method, auth string) int {
+		return serveFrom("192.0.2.1:1234", method, auth)
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, ""))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, ""))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "Bearer "))
+	assert.Equal(t, http.StatusOK, serveFrom("127.0.0.1:1234", http.MethodPut, ""))
+	assert.Equal(t, http.StatusOK, serveFrom("[::1]:1234", http.MethodPut, ""))
+
+	paramtable.Get().Save(paramtable.Get().CommonCfg.ManagementToken.Key, "secret")
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.ManagementToken.Key)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, ""))
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, ""))
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, "Bearer wrong"))
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, "secret"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "Bearer secret"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodDelete, "Bearer secret"))
+	assert.Equal(t, http.StatusUnauthorized, serveFrom("127.0.0.1:1234", http.MethodPut, ""))
+}
+
 type HTTPServerTestSuite struct {
 	suite.Suite
 	server *httptest.Server
 }
 
 func (suite *HTTPServerTestSuite) SetupSuite() {
+	paramtable.Init()
 	suite.server = httptest.NewServer(nil)
 	registerDefaults()
-
 }
 
 func (suite *HTTPServerTestSuite) TearDownSuite() {
@@ -103,12 +103,12 @@ type DataCoordCatalog interface {
 	AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error
 	// TODO Remove this later, we should update flush segments info for each segment separately, so far we still need transaction
 	AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo) error
-	// AlterSegmentsAndAddNewSegment for transaction
-	AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error
+	// AlterSegmentsAndAddNewSegment for transaction, a split compaction adds several new segments
+	AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegments ...*datapb.SegmentInfo) error
 	AlterSegment(ctx context.Context, newSegment *datapb.SegmentInfo, oldSegment *datapb.SegmentInfo) error
 	SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error
 	DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error
-	RevertAlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, removalSegment *datapb.SegmentInfo) error
+	RevertAlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, removalSegments ...*datapb.SegmentInfo) error
 
 	MarkChannelDeleted(ctx context.Context, channel string) error
 	IsChannelDropped(ctx context.Context, channel string) bool
@@ -116,6 +116,7 @@ type DataCoordCatalog interface {
 
 	ListChannelCheckpoint(ctx context.Context) (map[string]*internalpb.MsgPosition, error)
 	SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *internalpb.MsgPosition) error
+	SaveChannelCheckpoints(ctx context.Context, positions map[string]*internalpb.MsgPosition) error
 	DropChannelCheckpoint(ctx context.Context, vChannel string) error
 
 	CreateIndex(ctx context.Context, index *model.Index) error
@@ -129,6 +130,26 @@ type DataCoordCatalog interface {
 	AlterSegmentIndex(ctx context.Context, newSegIndex *model.SegmentIndex) error
 	AlterSegmentIndexes(ctx context.Context, newSegIdxes []*model.SegmentIndex) error
 	DropSegmentIndex(ctx context.Context, collID, partID, segID, buildID typeutil.UniqueID) error
+
+	SaveSegmentIndexVersion(ctx context.Context, version *model.SegmentIndexVersion) error
+	ListSegmentIndexVersions(ctx context.Context) ([]*model.SegmentIndexVersion, error)
+	DropSegmentIndexVersion(ctx context.Context, buildID typeutil.UniqueID) error
+
+	SaveSegmentHistories(ctx context.Context, histories []*model.SegmentHistory) error
+	ListSegmentHistories(ctx context.Context) ([]*model.SegmentHistory, error)
+	DropSegmentHistories(ctx context.Context, segmentIDs []typeutil.UniqueID) error
+
+	SaveDeleteSLAMarker(ctx context.Context, marker *model.DeleteSLAMarker) error
+	ListDeleteSLAMarkers(ctx context.Context) ([]*model.DeleteSLAMarker, error)
+	DropDeleteSLAMarker(ctx context.Context, markerID string) error
+
+	SaveCollectionPause(ctx context.Context, pause *model.CollectionPause) error
+	ListCollectionPauses(ctx context.Context) ([]*model.CollectionPause, error)
+	DropCollectionPause(ctx context.Context, collectionID typeutil.UniqueID) error
+
+	SaveFreezeWindow(ctx context.Context, window *model.FreezeWindow) error
+	ListFreezeWindows(ctx context.Context) ([]*model.FreezeWindow, error)
+	DropFreezeWindow(ctx context.Context, collectionID typeutil.UniqueID) error
 }
 
 type IndexCoordCatalog interface {
@@ -24,6 +24,10 @@ const (
 	SegmentStatslogPathPrefix = MetaPrefix + "/statslog"
 	ChannelRemovePrefix       = MetaPrefix + "/channel-removal"
 	ChannelCheckpointPrefix   = MetaPrefix + "/channel-cp"
+	SegmentHistoryPrefix      = MetaPrefix + "/history"
+	DeleteSLAMarkerPrefix     = MetaPrefix + "/delete-sla"
+	CollectionPausePrefix     = MetaPrefix + "/collection-pause"
+	FreezeWindowPrefix        = MetaPrefix + "/freeze-window"
 
 	RemoveFlagTomestone = "removed"
 )
@@ -199,7 +199,7 @@ func (kc *Catalog) hasBinlogPrefix(segment *datapb.SegmentInfo) (bool, error) {
 	return true, nil
 }
 
-func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegments ...*datapb.SegmentInfo) error {
 	kvs := make(map[string]string)
 
 	for _, s := range segments {
@@ -232,8 +232,8 @@ func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments [
 		kvs[k] = v
 	}
 
-	if newSegment != nil {
-		if newSegment.GetNumOfRows() > 0 {
+	for _, newSegment := range newSegments {
+		if newSegment != nil && newSegment.GetNumOfRows() > 0 {
 			segmentKvs, err := buildSegmentAndBinlogsKvs(newSegment)
 			if err != nil {
 				return err
@@ -245,7 +245,7 @@ func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments [
 }
 
 // RevertAlterSegmentsAndAddNewSegment reverts the metastore operation of AlterSegmentsAndAddNewSegment
-func (kc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegment *datapb.SegmentInfo) error {
+func (kc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegments ...*datapb.SegmentInfo) error {
 	var (
 		kvs      = make(map[string]string)
 		removals []string
@@ -260,7 +260,10 @@ func (kc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldS
 		maps.Copy(kvs, segmentKvs)
 	}
 
-	if removeSegment != nil {
+	for _, removeSegment := range removeSegments {
+		if removeSegment == nil {
+			continue
+		}
 		segKey := buildSegmentPath(removeSegment.GetCollectionID(), removeSegment.GetPartitionID(), removeSegment.GetID())
 		removals = append(removals, segKey)
 		binlogKeys := buildBinlogKeys(removeSegment)
@@ -370,6 +373,19 @@ func (kc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, p
 	return kc.Txn.Save(k, string(v))
 }
 
+// SaveChannelCheckpoints saves the checkpoints of several vchannels in one transaction.
+func (kc *Catalog) SaveChannelCheckpoints(ctx context.Context, positions map[string]*internalpb.MsgPosition) error {
+	kvs := make(map[string]string, len(positions))
+	for vChannel, pos := range positions {
+		v, err := proto.Marshal(pos)
+		if err != nil {
+			return err
+		}
+		kvs[buildChannelCPKey(vChannel)] = string(v)
+	}
+	return kc.Txn.MultiSave(kvs)
+}
+
 func (kc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
 	k := buildChannelCPKey(vChannel)
 	return kc.Txn.Remove(k)
@@ -553,6 +569,223 @@ func (kc *Catalog) DropSegmentIndex(ctx context.Context, collID, partID, segID,
 	return nil
 }
 
+func (kc *Catalog) SaveSegmentIndexVersion(ctx context.Context, version *model.SegmentIndexVersion) error {
+	value, err := model.MarshalSegmentIndexVersion(version)
+	if err != nil {
+		return err
+	}
+	err = kc.Txn.Save(BuildIndexVersionKey(version.BuildID), value)
+	if err != nil {
+		log.Error("failed to save segment index version in etcd", zap.Int64("buildID", version.BuildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListSegmentIndexVersions(ctx context.Context) ([]*model.SegmentIndexVersion, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(util.IndexVersionPrefix)
+	if err != nil {
+		log.Error("list segment index version fail", zap.String("prefix", util.IndexVersionPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	versions := make([]*model.SegmentIndexVersion, 0, len(values))
+	for _, value := range values {
+		version, err := model.UnmarshalSegmentIndexVersion(value)
+		if err != nil {
+			log.Warn("unmarshal segment index version failed", zap.Error(err))
+			return versions, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+func (kc *Catalog) DropSegmentIndexVersion(ctx context.Context, buildID typeutil.UniqueID) error {
+	err := kc.Txn.Remove(BuildIndexVersionKey(buildID))
+	if err != nil {
+		log.Error("drop segment index version fail", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) SaveSegmentHistories(ctx context.Context, histories []*model.SegmentHistory) error {
+	kvs := make(map[string]string, len(histories))
+	for _, history := range histories {
+		value, err := model.MarshalSegmentHistory(history)
+		if err != nil {
+			return err
+		}
+		kvs[buildSegmentHistoryKey(history.SegmentID)] = value
+	}
+	saveFn := func(partialKvs map[string]string) error {
+		return kc.Txn.MultiSave(partialKvs)
+	}
+	if err := etcd.SaveByBatch(kvs, saveFn); err != nil {
+		log.Error("failed to save segment histories", zap.Int("count", len(histories)), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListSegmentHistories(ctx context.Context) ([]*model.SegmentHistory, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(SegmentHistoryPrefix)
+	if err != nil {
+		log.Error("list segment histories fail", zap.String("prefix", SegmentHistoryPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	histories := make([]*model.SegmentHistory, 0, len(values))
+	for _, value := range values {
+		history, err := model.UnmarshalSegmentHistory(value)
+		if err != nil {
+			log.Warn("unmarshal segment history failed", zap.Error(err))
+			return histories, err
+		}
+		histories = append(histories, history)
+	}
+	return histories, nil
+}
+
+func (kc *Catalog) DropSegmentHistories(ctx context.Context, segmentIDs []typeutil.UniqueID) error {
+	keys := make([]string, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		keys = append(keys, buildSegmentHistoryKey(segmentID))
+	}
+	removeFn := func(partialKeys []string) error {
+		return kc.Txn.MultiRemove(partialKeys)
+	}
+	if err := etcd.RemoveByBatch(keys, removeFn); err != nil {
+		log.Error("failed to drop segment histories", zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) SaveDeleteSLAMarker(ctx context.Context, marker *model.DeleteSLAMarker) error {
+	value, err := model.MarshalDeleteSLAMarker(marker)
+	if err != nil {
+		return err
+	}
+	err = kc.Txn.Save(buildDeleteSLAMarkerKey(marker.ID), value)
+	if err != nil {
+		log.Error("failed to save delete sla marker", zap.String("marker", marker.ID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListDeleteSLAMarkers(ctx context.Context) ([]*model.DeleteSLAMarker, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(DeleteSLAMarkerPrefix)
+	if err != nil {
+		log.Error("list delete sla markers fail", zap.String("prefix", DeleteSLAMarkerPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	markers := make([]*model.DeleteSLAMarker, 0, len(values))
+	for _, value := range values {
+		marker, err := model.UnmarshalDeleteSLAMarker(value)
+		if err != nil {
+			log.Warn("unmarshal delete sla marker failed", zap.Error(err))
+			return markers, err
+		}
+		markers = append(markers, marker)
+	}
+	return markers, nil
+}
+
+func (kc *Catalog) DropDeleteSLAMarker(ctx context.Context, markerID string) error {
+	err := kc.Txn.Remove(buildDeleteSLAMarkerKey(markerID))
+	if err != nil {
+		log.Error("drop delete sla marker fail", zap.String("marker", markerID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) SaveCollectionPause(ctx context.Context, pause *model.CollectionPause) error {
+	value, err := model.MarshalCollectionPause(pause)
+	if err != nil {
+		return err
+	}
+	err = kc.Txn.Save(buildCollectionPauseKey(pause.CollectionID), value)
+	if err != nil {
+		log.Error("failed to save collection pause", zap.Int64("collectionID", pause.CollectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListCollectionPauses(ctx context.Context) ([]*model.CollectionPause, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(CollectionPausePrefix)
+	if err != nil {
+		log.Error("list collection pauses fail", zap.String("prefix", CollectionPausePrefix), zap.Error(err))
+		return nil, err
+	}
+
+	pauses := make([]*model.CollectionPause, 0, len(values))
+	for _, value := range values {
+		pause, err := model.UnmarshalCollectionPause(value)
+		if err != nil {
+			log.Warn("unmarshal collection pause failed", zap.Error(err))
+			return pauses, err
+		}
+		pauses = append(pauses, pause)
+	}
+	return pauses, nil
+}
+
+func (kc *Catalog) DropCollectionPause(ctx context.Context, collectionID typeutil.UniqueID) error {
+	err := kc.Txn.Remove(buildCollectionPauseKey(collectionID))
+	if err != nil {
+		log.Error("drop collection pause fail", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) SaveFreezeWindow(ctx context.Context, window *model.FreezeWindow) error {
+	value, err := model.MarshalFreezeWindow(window)
+	if err != nil {
+		return err
+	}
+	err = kc.Txn.Save(buildFreezeWindowKey(window.CollectionID), value)
+	if err != nil {
+		log.Error("failed to save freeze window", zap.Int64("collectionID", window.CollectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListFreezeWindows(ctx context.Context) ([]*model.FreezeWindow, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(FreezeWindowPrefix)
+	if err != nil {
+		log.Error("list freeze windows fail", zap.String("prefix", FreezeWindowPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	windows := make([]*model.FreezeWindow, 0, len(values))
+	for _, value := range values {
+		window, err := model.UnmarshalFreezeWindow(value)
+		if err != nil {
+			log.Warn("unmarshal freeze window failed", zap.Error(err))
+			return windows, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func (kc *Catalog) DropFreezeWindow(ctx context.Context, collectionID typeutil.UniqueID) error {
+	err := kc.Txn.Remove(buildFreezeWindowKey(collectionID))
+	if err != nil {
+		log.Error("drop freeze window fail", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID, fieldBinlog *datapb.FieldBinlog) error {
 	for _, binlog := range fieldBinlog.Binlogs {
@@ -817,10 +1050,30 @@ func buildChannelCPKey(vChannel string) string {
 	return fmt.Sprintf("%s/%s", ChannelCheckpointPrefix, vChannel)
 }
 
+func buildSegmentHistoryKey(segmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", SegmentHistoryPrefix, segmentID)
+}
+
+func buildDeleteSLAMarkerKey(markerID string) string {
+	return fmt.Sprintf("%s/%s", DeleteSLAMarkerPrefix, markerID)
+}
+
+func buildCollectionPauseKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", CollectionPausePrefix, collectionID)
+}
+
+func buildFreezeWindowKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", FreezeWindowPrefix, collectionID)
+}
+
 func BuildIndexKey(collectionID, indexID int64) string {
 	return fmt.Sprintf("%s/%d/%d", util.FieldIndexPrefix, collectionID, indexID)
 }
 
+func BuildIndexVersionKey(buildID int64) string {
+	return fmt.Sprintf("%s/%d", util.IndexVersionPrefix, buildID)
+}
+
 func BuildSegmentIndexKey(collectionID, partitionID, segmentID, buildID int64) string {
 	return fmt.Sprintf("%s/%d/%d/%d/%d", util.SegmentIndexPrefix, collectionID, partitionID, segmentID, buildID)
 }
@@ -29,6 +29,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -696,6 +697,28 @@ func TestChannelCP(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("SaveChannelCheckpoints", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		txn.EXPECT().MultiSave(mock.Anything).Run(func(kvs map[string]string) {
+			assert.Equal(t, 2, len(kvs))
+			assert.Equal(t, string(v), kvs[k])
+		}).Return(nil)
+		catalog := &Catalog{txn, ""}
+		err := catalog.SaveChannelCheckpoints(context.TODO(), map[string]*internalpb.MsgPosition{
+			mockVChannel:       pos,
+			mockVChannel + "1": pos,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("SaveChannelCheckpoints failed", func(t *testing.T) {
+		txn := &mocks.TxnKV{}
+		catalog := &Catalog{txn, ""}
+		txn.EXPECT().MultiSave(mock.Anything).Return(errors.New("mock error"))
+		err = catalog.SaveChannelCheckpoints(context.TODO(), map[string]*internalpb.MsgPosition{mockVChannel: pos})
+		assert.Error(t, err)
+	})
+
 	t.Run("DropChannelCheckpoint", func(t *testing.T) {
 		txn := &mocks.TxnKV{}
 		txn.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
@@ -1172,3 +1195,285 @@ func TestCatalog_DropSegmentIndex(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCatalog_SegmentIndexVersion(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		saved := make(map[string]string)
+		txn := &MockedTxnKV{
+			save: func(key, value string) error {
+				saved[key] = value
+				return nil
+			},
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				keys := make([]string, 0, len(saved))
+				values := make([]string, 0, len(saved))
+				for k, v := range saved {
+					keys = append(keys, k)
+					values = append(values, v)
+				}
+				return keys, values, nil
+			},
+			remove: func(key string) error {
+				delete(saved, key)
+				return nil
+			},
+		}
+		catalog := &Catalog{
+			Txn: txn,
+		}
+
+		version := &model.SegmentIndexVersion{BuildID: 1, EngineVersion: "v1.3.6", FormatVersion: 1}
+		err := catalog.SaveSegmentIndexVersion(context.Background(), version)
+		assert.NoError(t, err)
+		assert.Contains(t, saved, BuildIndexVersionKey(1))
+
+		versions, err := catalog.ListSegmentIndexVersions(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.SegmentIndexVersion{version}, versions)
+
+		err = catalog.DropSegmentIndexVersion(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Empty(t, saved)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		txn := &MockedTxnKV{
+			save: func(key, value string) error {
+				return errors.New("error")
+			},
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				return nil, nil, errors.New("error")
+			},
+			remove: func(key string) error {
+				return errors.New("error")
+			},
+		}
+		catalog := &Catalog{
+			Txn: txn,
+		}
+
+		err := catalog.SaveSegmentIndexVersion(context.Background(), &model.SegmentIndexVersion{BuildID: 1})
+		assert.Error(t, err)
+		_, err = catalog.ListSegmentIndexVersions(context.Background())
+		assert.Error(t, err)
+		err = catalog.DropSegmentIndexVersion(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		txn := &MockedTxnKV{
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				return []string{"key"}, []string{"invalid"}, nil
+			},
+		}
+		catalog := &Catalog{
+			Txn: txn,
+		}
+
+		_, err := catalog.ListSegmentIndexVersions(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_SegmentHistory(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		txn := memkv.NewMemoryKV()
+		catalog := &Catalog{Txn: txn}
+		// the segment metas must not list the histories
+		assert.False(t, strings.HasPrefix(SegmentHistoryPrefix, SegmentPrefix))
+
+		histories := []*model.SegmentHistory{
+			{SegmentID: 1, CollectionID: 100, Events: []*model.SegmentStateEvent{{From: "SegmentStateNone", To: "Growing"}}},
+			{SegmentID: 2, CollectionID: 100, Events: []*model.SegmentStateEvent{}},
+		}
+		err := catalog.SaveSegmentHistories(context.Background(), histories)
+		assert.NoError(t, err)
+
+		ret, err := catalog.ListSegmentHistories(context.Background())
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, histories, ret)
+
+		err = catalog.DropSegmentHistories(context.Background(), []int64{1, 3})
+		assert.NoError(t, err)
+		ret, err = catalog.ListSegmentHistories(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.SegmentHistory{histories[1]}, ret)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		txn := &MockedTxnKV{
+			multiSave: func(kvs map[string]string) error {
+				return errors.New("error")
+			},
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				return nil, nil, errors.New("error")
+			},
+			multiRemove: func(keys []string) error {
+				return errors.New("error")
+			},
+		}
+		catalog := &Catalog{Txn: txn}
+
+		err := catalog.SaveSegmentHistories(context.Background(), []*model.SegmentHistory{{SegmentID: 1}})
+		assert.Error(t, err)
+		_, err = catalog.ListSegmentHistories(context.Background())
+		assert.Error(t, err)
+		err = catalog.DropSegmentHistories(context.Background(), []int64{1})
+		assert.Error(t, err)
+
+		txn.loadWithPrefix = func(key string) ([]string, []string, error) {
+			return []string{"key"}, []string{"invalid"}, nil
+		}
+		_, err = catalog.ListSegmentHistories(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_DeleteSLAMarker(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		txn := memkv.NewMemoryKV()
+		catalog := &Catalog{Txn: txn}
+		// the segment metas must not list the markers
+		assert.False(t, strings.HasPrefix(DeleteSLAMarkerPrefix, SegmentPrefix))
+
+		marker := &model.DeleteSLAMarker{ID: "1-100", CollectionID: 100, SegmentID: 1, DeleteTs: 100}
+		err := catalog.SaveDeleteSLAMarker(context.Background(), marker)
+		assert.NoError(t, err)
+
+		ret, err := catalog.ListDeleteSLAMarkers(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.DeleteSLAMarker{marker}, ret)
+
+		err = catalog.DropDeleteSLAMarker(context.Background(), marker.ID)
+		assert.NoError(t, err)
+		ret, err = catalog.ListDeleteSLAMarkers(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(ret))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		txn := &MockedTxnKV{
+			save: func(key, value string) error {
+				return errors.New("error")
+			},
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				return nil, nil, errors.New("error")
+			},
+			remove: func(key string) error {
+				return errors.New("error")
+			},
+		}
+		catalog := &Catalog{Txn: txn}
+
+		err := catalog.SaveDeleteSLAMarker(context.Background(), &model.DeleteSLAMarker{ID: "1-100"})
+		assert.Error(t, err)
+		_, err = catalog.ListDeleteSLAMarkers(context.Background())
+		assert.Error(t, err)
+		err = catalog.DropDeleteSLAMarker(context.Background(), "1-100")
+		assert.Error(t, err)
+
+		txn.loadWithPrefix = func(key string) ([]string, []string, error) {
+			return []string{"key"}, []string{"invalid"}, nil
+		}
+		_, err = catalog.ListDeleteSLAMarkers(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_CollectionPause(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		txn := memkv.NewMemoryKV()
+		catalog := &Catalog{Txn: txn}
+
+		pause := &model.CollectionPause{CollectionID: 100, Compaction: true, Reason: "backup"}
+		err := catalog.SaveCollectionPause(context.Background(), pause)
+		assert.NoError(t, err)
+
+		ret, err := catalog.ListCollectionPauses(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.CollectionPause{pause}, ret)
+
+		err = catalog.DropCollectionPause(context.Background(), pause.CollectionID)
+		assert.NoError(t, err)
+		ret, err = catalog.ListCollectionPauses(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(ret))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		txn := &MockedTxnKV{
+			save: func(key, value string) error {
+				return errors.New("error")
+			},
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				return nil, nil, errors.New("error")
+			},
+			remove: func(key string) error {
+				return errors.New("error")
+			},
+		}
+		catalog := &Catalog{Txn: txn}
+
+		err := catalog.SaveCollectionPause(context.Background(), &model.CollectionPause{CollectionID: 100})
+		assert.Error(t, err)
+		_, err = catalog.ListCollectionPauses(context.Background())
+		assert.Error(t, err)
+		err = catalog.DropCollectionPause(context.Background(), 100)
+		assert.Error(t, err)
+
+		txn.loadWithPrefix = func(key string) ([]string, []string, error) {
+			return []string{"key"}, []string{"invalid"}, nil
+		}
+		_, err = catalog.ListCollectionPauses(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_FreezeWindow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		txn := memkv.NewMemoryKV()
+		catalog := &Catalog{Txn: txn}
+
+		window := &model.FreezeWindow{CollectionID: 100, IndexedSegments: []int64{1, 2}}
+		err := catalog.SaveFreezeWindow(context.Background(), window)
+		assert.NoError(t, err)
+
+		ret, err := catalog.ListFreezeWindows(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.FreezeWindow{window}, ret)
+
+		err = catalog.DropFreezeWindow(context.Background(), window.CollectionID)
+		assert.NoError(t, err)
+		ret, err = catalog.ListFreezeWindows(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(ret))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		txn := &MockedTxnKV{
+			save: func(key, value string) error {
+				return errors.New("error")
+			},
+			loadWithPrefix: func(key string) ([]string, []string, error) {
+				return nil, nil, errors.New("error")
+			},
+			remove: func(key string) error {
+				return errors.New("error")
+			},
+		}
+		catalog := &Catalog{Txn: txn}
+
+		err := catalog.SaveFreezeWindow(context.Background(), &model.FreezeWindow{CollectionID: 100})
+		assert.Error(t, err)
+		_, err = catalog.ListFreezeWindows(context.Background())
+		assert.Error(t, err)
+		err = catalog.DropFreezeWindow(context.Background(), 100)
+		assert.Error(t, err)
+
+		txn.loadWithPrefix = func(key string) ([]string, []string, error) {
+			return []string{"key"}, []string{"invalid"}, nil
+		}
+		_, err = catalog.ListFreezeWindows(context.Background())
+		assert.Error(t, err)
+	})
+}
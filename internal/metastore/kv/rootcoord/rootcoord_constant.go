@@ -38,4 +38,19 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// RoleQuotaPrefix prefix for the rate limiting quota of role
+	RoleQuotaPrefix = ComponentPrefix + CommonCredentialPrefix + "/role-quota"
+
+	// DatabaseQuotaPrefix prefix for the rate limiting quota of database
+	DatabaseQuotaPrefix = ComponentPrefix + "/database-quota"
+
+	// ReadOnlyPrefix prefix for the read-only modes of the cluster and of databases
+	ReadOnlyPrefix = ComponentPrefix + "/read-only"
+
+	// PartitionRolloverPrefix prefix for the partitions created by the partition rollover
+	PartitionRolloverPrefix = ComponentPrefix + "/partition-rollover"
+
+	// DdlJournalPrefix prefix for the journal of ddl operations
+	DdlJournalPrefix = ComponentPrefix + "/ddl-journal"
 )
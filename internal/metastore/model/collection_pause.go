@@ -0,0 +1,33 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CollectionPause is the compaction and the binlog gc of a collection paused by the admin.
+type CollectionPause struct {
+	CollectionID int64     `json:"collection_id"`
+	Compaction   bool      `json:"compaction"`
+	GC           bool      `json:"gc"`
+	Reason       string    `json:"reason,omitempty"`
+	PausedAt     time.Time `json:"paused_at"`
+}
+
+// MarshalCollectionPause encodes the collection pause into json.
+func MarshalCollectionPause(pause *CollectionPause) (string, error) {
+	bs, err := json.Marshal(pause)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalCollectionPause decodes the collection pause from json.
+func UnmarshalCollectionPause(value string) (*CollectionPause, error) {
+	pause := &CollectionPause{}
+	if err := json.Unmarshal([]byte(value), pause); err != nil {
+		return nil, err
+	}
+	return pause, nil
+}
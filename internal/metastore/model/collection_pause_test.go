@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionPause(t *testing.T) {
+	pause := &CollectionPause{
+		CollectionID: 100,
+		Compaction:   true,
+		Reason:       "backup",
+		PausedAt:     time.Unix(1, 0).UTC(),
+	}
+	value, err := MarshalCollectionPause(pause)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalCollectionPause(value)
+	assert.NoError(t, err)
+	assert.Equal(t, pause, ret)
+
+	_, err = UnmarshalCollectionPause(`invalid`)
+	assert.Error(t, err)
+}
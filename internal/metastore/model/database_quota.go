@@ -0,0 +1,85 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+// DatabaseQuota is the request rate quota of a database, the requests to the database share the rates,
+// and the requests to each collection of the database are limited by the collection rates.
+type DatabaseQuota struct {
+	DbName string `json:"db_name"`
+	// Rates maps the rate type names, such as DMLInsert and DQLSearch, to the rates of the database.
+	Rates map[string]float64 `json:"rates"`
+	// CollectionRates maps the rate type names to the default rates of each collection in the database.
+	CollectionRates map[string]float64 `json:"collection_rates,omitempty"`
+}
+
+// GetRateTypes returns the rates of the database keyed by rate type.
+func (q *DatabaseQuota) GetRateTypes() (map[internalpb.RateType]float64, error) {
+	return parseRateTypes(q.Rates, "database "+q.DbName)
+}
+
+// GetCollectionRateTypes returns the rates of each collection in the database keyed by rate type.
+func (q *DatabaseQuota) GetCollectionRateTypes() (map[internalpb.RateType]float64, error) {
+	return parseRateTypes(q.CollectionRates, "collections of database "+q.DbName)
+}
+
+// Empty returns true if the quota limits neither the database nor its collections.
+func (q *DatabaseQuota) Empty() bool {
+	return len(q.Rates) == 0 && len(q.CollectionRates) == 0
+}
+
+// MarshalDatabaseQuota encodes the database quota into json.
+func MarshalDatabaseQuota(quota *DatabaseQuota) (string, error) {
+	bs, err := json.Marshal(quota)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalDatabaseQuota decodes the database quota from json, and validates its rates.
+func UnmarshalDatabaseQuota(value string) (*DatabaseQuota, error) {
+	quota := &DatabaseQuota{}
+	if err := json.Unmarshal([]byte(value), quota); err != nil {
+		return nil, err
+	}
+	if quota.DbName == "" {
+		return nil, fmt.Errorf("empty db name in the database quota")
+	}
+	if _, err := quota.GetRateTypes(); err != nil {
+		return nil, err
+	}
+	if _, err := quota.GetCollectionRateTypes(); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// MarshalDatabaseQuotaModel converts the database quota into the proto carried by the database quota rpcs.
+func MarshalDatabaseQuotaModel(quota *DatabaseQuota) *proxypb.DatabaseQuota {
+	if quota == nil {
+		return nil
+	}
+	return &proxypb.DatabaseQuota{
+		DbName:          quota.DbName,
+		Rates:           quota.Rates,
+		CollectionRates: quota.CollectionRates,
+	}
+}
+
+// UnmarshalDatabaseQuotaModel converts the proto carried by the database quota rpcs into the database quota.
+func UnmarshalDatabaseQuotaModel(quota *proxypb.DatabaseQuota) *DatabaseQuota {
+	if quota == nil {
+		return nil
+	}
+	return &DatabaseQuota{
+		DbName:          quota.GetDbName(),
+		Rates:           quota.GetRates(),
+		CollectionRates: quota.GetCollectionRates(),
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestDatabaseQuota(t *testing.T) {
+	quota := &DatabaseQuota{
+		DbName: "tenant1",
+		Rates: map[string]float64{
+			internalpb.RateType_DQLSearch.String(): 100,
+		},
+		CollectionRates: map[string]float64{
+			internalpb.RateType_DMLInsert.String(): 10,
+		},
+	}
+	assert.False(t, quota.Empty())
+	value, err := MarshalDatabaseQuota(quota)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalDatabaseQuota(value)
+	assert.NoError(t, err)
+	assert.Equal(t, quota, ret)
+	rates, err := ret.GetRateTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, map[internalpb.RateType]float64{internalpb.RateType_DQLSearch: 100}, rates)
+	rates, err = ret.GetCollectionRateTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, map[internalpb.RateType]float64{internalpb.RateType_DMLInsert: 10}, rates)
+
+	assert.True(t, (&DatabaseQuota{DbName: "tenant1"}).Empty())
+	_, err = UnmarshalDatabaseQuota(`{"db_name":"tenant1","rates":{"Unknown":1}}`)
+	assert.Error(t, err)
+	_, err = UnmarshalDatabaseQuota(`{"db_name":"tenant1","collection_rates":{"DQLSearch":-1}}`)
+	assert.Error(t, err)
+	_, err = UnmarshalDatabaseQuota(`{"rates":{"DQLSearch":1}}`)
+	assert.Error(t, err)
+	_, err = UnmarshalDatabaseQuota(`invalid`)
+	assert.Error(t, err)
+}
+
+func TestDatabaseQuotaModel(t *testing.T) {
+	quota := &DatabaseQuota{
+		DbName:          "tenant1",
+		Rates:           map[string]float64{"DQLSearch": 100},
+		CollectionRates: map[string]float64{"DMLInsert": 10},
+	}
+	pb := MarshalDatabaseQuotaModel(quota)
+	assert.Equal(t, "tenant1", pb.GetDbName())
+	assert.Equal(t, quota, UnmarshalDatabaseQuotaModel(pb))
+
+	assert.Nil(t, MarshalDatabaseQuotaModel(nil))
+	assert.Nil(t, UnmarshalDatabaseQuotaModel(nil))
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DeleteSLAMarker is a sampled deltalog, marking the deletes up to DeleteTs of the channel.
+// SegmentID follows the compactions not applying the delete, the deltalog is carried over to the compacted segment.
+type DeleteSLAMarker struct {
+	ID               string     `json:"id"`
+	CollectionID     int64      `json:"collection_id"`
+	PartitionID      int64      `json:"partition_id"`
+	SegmentID        int64      `json:"segment_id"`
+	Channel          string     `json:"channel"`
+	DeleteTs         uint64     `json:"delete_ts"`
+	ProducedTime     time.Time  `json:"produced_time"`
+	PersistedTime    time.Time  `json:"persisted_time"`
+	CheckpointedTime *time.Time `json:"checkpointed_time,omitempty"`
+	CompactedTime    *time.Time `json:"compacted_time,omitempty"`
+}
+
+// Completed returns whether the marker reaches all the stages.
+func (marker *DeleteSLAMarker) Completed() bool {
+	return marker.CheckpointedTime != nil && marker.CompactedTime != nil
+}
+
+// CompletedTime is the time the marker reaches its last stage, the marker must be completed.
+func (marker *DeleteSLAMarker) CompletedTime() time.Time {
+	if marker.CheckpointedTime.After(*marker.CompactedTime) {
+		return *marker.CheckpointedTime
+	}
+	return *marker.CompactedTime
+}
+
+// Clone returns a deep copy of the marker.
+func (marker *DeleteSLAMarker) Clone() *DeleteSLAMarker {
+	ret := *marker
+	if marker.CheckpointedTime != nil {
+		t := *marker.CheckpointedTime
+		ret.CheckpointedTime = &t
+	}
+	if marker.CompactedTime != nil {
+		t := *marker.CompactedTime
+		ret.CompactedTime = &t
+	}
+	return &ret
+}
+
+// MarshalDeleteSLAMarker encodes the delete sla marker into json.
+func MarshalDeleteSLAMarker(marker *DeleteSLAMarker) (string, error) {
+	bs, err := json.Marshal(marker)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalDeleteSLAMarker decodes the delete sla marker from json.
+func UnmarshalDeleteSLAMarker(value string) (*DeleteSLAMarker, error) {
+	marker := &DeleteSLAMarker{}
+	if err := json.Unmarshal([]byte(value), marker); err != nil {
+		return nil, err
+	}
+	return marker, nil
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteSLAMarker(t *testing.T) {
+	checkpointed := time.Unix(3, 0).UTC()
+	marker := &DeleteSLAMarker{
+		ID:               "1-100",
+		CollectionID:     100,
+		SegmentID:        1,
+		Channel:          "ch1",
+		DeleteTs:         100,
+		ProducedTime:     time.Unix(1, 0).UTC(),
+		PersistedTime:    time.Unix(2, 0).UTC(),
+		CheckpointedTime: &checkpointed,
+	}
+	value, err := MarshalDeleteSLAMarker(marker)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalDeleteSLAMarker(value)
+	assert.NoError(t, err)
+	assert.Equal(t, marker, ret)
+
+	_, err = UnmarshalDeleteSLAMarker(`invalid`)
+	assert.Error(t, err)
+
+	assert.False(t, marker.Completed())
+	compacted := time.Unix(4, 0).UTC()
+	marker.CompactedTime = &compacted
+	assert.True(t, marker.Completed())
+	assert.Equal(t, compacted, marker.CompletedTime())
+
+	cloned := marker.Clone()
+	assert.Equal(t, marker, cloned)
+	*cloned.CompactedTime = time.Unix(5, 0).UTC()
+	assert.Equal(t, compacted, *marker.CompactedTime)
+}
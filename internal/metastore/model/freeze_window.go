@@ -0,0 +1,33 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// FreezeWindow is a window freezing the index completion driven handoffs and the compaction of a collection,
+// with the segments indexed when frozen.
+type FreezeWindow struct {
+	CollectionID    int64     `json:"collection_id"`
+	FrozenAt        time.Time `json:"frozen_at"`
+	ExpireAt        time.Time `json:"expire_at"`
+	IndexedSegments []int64   `json:"indexed_segments,omitempty"`
+}
+
+// MarshalFreezeWindow encodes the freeze window into json.
+func MarshalFreezeWindow(window *FreezeWindow) (string, error) {
+	bs, err := json.Marshal(window)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalFreezeWindow decodes the freeze window from json.
+func UnmarshalFreezeWindow(value string) (*FreezeWindow, error) {
+	window := &FreezeWindow{}
+	if err := json.Unmarshal([]byte(value), window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
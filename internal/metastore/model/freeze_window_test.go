@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreezeWindow(t *testing.T) {
+	window := &FreezeWindow{
+		CollectionID:    100,
+		FrozenAt:        time.Unix(1, 0).UTC(),
+		ExpireAt:        time.Unix(61, 0).UTC(),
+		IndexedSegments: []int64{1, 2},
+	}
+	value, err := MarshalFreezeWindow(window)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalFreezeWindow(value)
+	assert.NoError(t, err)
+	assert.Equal(t, window, ret)
+
+	_, err = UnmarshalFreezeWindow(`invalid`)
+	assert.Error(t, err)
+}
@@ -1,8 +1,11 @@
 package model
 
 import (
+	"fmt"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 )
 
@@ -20,6 +23,65 @@ type Index struct {
 	UserIndexParams []*commonpb.KeyValuePair
 }
 
+// FieldIDs returns all the fields covered by the index, the leading field comes first.
+// Composite scalar indexes persist their field ids in index params, others only cover FieldID.
+func (index *Index) FieldIDs() []int64 {
+	for _, kv := range index.IndexParams {
+		if kv.GetKey() != common.CompositeFieldIDsKey {
+			continue
+		}
+		fieldIDs, err := common.ParseCompositeFieldIDs(kv.GetValue())
+		if err == nil && len(fieldIDs) > 0 {
+			return fieldIDs
+		}
+	}
+	return []int64{index.FieldID}
+}
+
+// SameParams reports whether the index has exactly the given type params and index params, in any order.
+func (index *Index) SameParams(typeParams, indexParams []*commonpb.KeyValuePair) bool {
+	return sameKeyValuePairs(index.TypeParams, typeParams) && sameKeyValuePairs(index.IndexParams, indexParams)
+}
+
+func sameKeyValuePairs(kvs1, kvs2 []*commonpb.KeyValuePair) bool {
+	if len(kvs1) != len(kvs2) {
+		return false
+	}
+	for _, kv1 := range kvs1 {
+		exist := false
+		for _, kv2 := range kvs2 {
+			if kv2.GetKey() == kv1.GetKey() && kv2.GetValue() == kv1.GetValue() {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckCreateIndex checks an index to create against the existing indexes of the collection.
+// It returns the id of the existing index if the same index is created again, or 0 if a new index is needed.
+func CheckCreateIndex(indexes []*Index, fieldID int64, indexName string, typeParams, indexParams []*commonpb.KeyValuePair) (int64, error) {
+	for _, index := range indexes {
+		if index.IsDeleted {
+			continue
+		}
+		if indexName == index.IndexName {
+			if fieldID == index.FieldID && index.SameParams(typeParams, indexParams) {
+				return index.IndexID, nil
+			}
+			return 0, fmt.Errorf("CreateIndex failed: at most one distinct index is allowed per field")
+		}
+		if fieldID == index.FieldID {
+			return 0, fmt.Errorf("CreateIndex failed: creating multiple indexes on same field is not supported")
+		}
+	}
+	return 0, nil
+}
+
 func UnmarshalIndexModel(indexInfo *datapb.FieldIndex) *Index {
 	if indexInfo == nil {
 		return nil
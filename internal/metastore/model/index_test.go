@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 )
 
@@ -52,3 +53,40 @@ func TestUnmarshalIndexModel(t *testing.T) {
 	assert.Equal(t, indexModel.IndexID, ret.IndexID)
 	assert.Nil(t, UnmarshalIndexModel(nil))
 }
+
+func TestIndex_FieldIDs(t *testing.T) {
+	index := &Index{FieldID: fieldID}
+	assert.Equal(t, []int64{fieldID}, index.FieldIDs())
+
+	index.IndexParams = []*commonpb.KeyValuePair{
+		{Key: common.IndexTypeKey, Value: "COMPOSITE"},
+		{Key: common.CompositeFieldIDsKey, Value: "101,102"},
+	}
+	assert.Equal(t, []int64{101, 102}, index.FieldIDs())
+
+	index.IndexParams[1].Value = "invalid"
+	assert.Equal(t, []int64{fieldID}, index.FieldIDs())
+}
+
+func TestCheckCreateIndex(t *testing.T) {
+	typeParams := []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}}
+	indexParams := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}, {Key: "M", Value: "16"}}
+	indexes := []*Index{
+		{FieldID: 100, IndexID: 1, IndexName: "idx", TypeParams: typeParams, IndexParams: indexParams},
+		{FieldID: 101, IndexID: 2, IndexName: "dropped", IsDeleted: true},
+	}
+
+	indexID, err := CheckCreateIndex(indexes, 100, "idx", typeParams, []*commonpb.KeyValuePair{indexParams[1], indexParams[0]})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), indexID)
+
+	_, err = CheckCreateIndex(indexes, 100, "idx", typeParams, indexParams[:1])
+	assert.Error(t, err)
+
+	_, err = CheckCreateIndex(indexes, 100, "other", typeParams, indexParams)
+	assert.Error(t, err)
+
+	indexID, err = CheckCreateIndex(indexes, 101, "dropped", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), indexID)
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+// ReadOnlyMode puts the cluster, or the database if DbName is set, into read-only mode, in which the proxies reject
+// the dml and ddl requests while serving the reads.
+type ReadOnlyMode struct {
+	DbName   string `json:"db_name,omitempty"`
+	ReadOnly bool   `json:"read_only"`
+	Reason   string `json:"reason,omitempty"`
+	// Since is the unix seconds when the read-only mode is entered
+	Since int64 `json:"since,omitempty"`
+}
+
+// MarshalReadOnlyMode encodes the read-only mode into json.
+func MarshalReadOnlyMode(mode *ReadOnlyMode) (string, error) {
+	bs, err := json.Marshal(mode)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalReadOnlyMode decodes the read-only mode from json.
+func UnmarshalReadOnlyMode(value string) (*ReadOnlyMode, error) {
+	mode := &ReadOnlyMode{}
+	if err := json.Unmarshal([]byte(value), mode); err != nil {
+		return nil, err
+	}
+	return mode, nil
+}
+
+// MarshalReadOnlyModeModel converts the read-only mode into the proto carried by the read-only mode rpcs.
+func MarshalReadOnlyModeModel(mode *ReadOnlyMode) *proxypb.ReadOnlyMode {
+	if mode == nil {
+		return nil
+	}
+	return &proxypb.ReadOnlyMode{
+		DbName:   mode.DbName,
+		ReadOnly: mode.ReadOnly,
+		Reason:   mode.Reason,
+		Since:    mode.Since,
+	}
+}
+
+// UnmarshalReadOnlyModeModel converts the proto carried by the read-only mode rpcs into the read-only mode.
+func UnmarshalReadOnlyModeModel(mode *proxypb.ReadOnlyMode) *ReadOnlyMode {
+	if mode == nil {
+		return nil
+	}
+	return &ReadOnlyMode{
+		DbName:   mode.GetDbName(),
+		ReadOnly: mode.GetReadOnly(),
+		Reason:   mode.GetReason(),
+		Since:    mode.GetSince(),
+	}
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyMode(t *testing.T) {
+	mode := &ReadOnlyMode{
+		DbName:   "tenant1",
+		ReadOnly: true,
+		Reason:   "migration",
+		Since:    1,
+	}
+	value, err := MarshalReadOnlyMode(mode)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalReadOnlyMode(value)
+	assert.NoError(t, err)
+	assert.Equal(t, mode, ret)
+
+	ret, err = UnmarshalReadOnlyMode(`{"read_only":true}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "", ret.DbName)
+	_, err = UnmarshalReadOnlyMode(`invalid`)
+	assert.Error(t, err)
+}
+
+func TestReadOnlyModeModel(t *testing.T) {
+	mode := &ReadOnlyMode{
+		DbName:   "tenant1",
+		ReadOnly: true,
+		Reason:   "migration",
+		Since:    1,
+	}
+	pb := MarshalReadOnlyModeModel(mode)
+	assert.Equal(t, "tenant1", pb.GetDbName())
+	assert.Equal(t, mode, UnmarshalReadOnlyModeModel(pb))
+
+	assert.Nil(t, MarshalReadOnlyModeModel(nil))
+	assert.Nil(t, UnmarshalReadOnlyModeModel(nil))
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+// RoleQuota is the request rate quota bound to a role, the users granted the role are limited
+// by the strictest rates among the quotas of their roles.
+type RoleQuota struct {
+	RoleName string `json:"role_name"`
+	// Rates maps the rate type names, such as DMLInsert and DQLSearch, to the rates per user.
+	Rates map[string]float64 `json:"rates"`
+}
+
+// GetRateTypes returns the rates of the quota keyed by rate type.
+func (q *RoleQuota) GetRateTypes() (map[internalpb.RateType]float64, error) {
+	return parseRateTypes(q.Rates, "role "+q.RoleName)
+}
+
+// parseRateTypes converts the rates keyed by rate type name, the owner names the quota in the errors.
+func parseRateTypes(rates map[string]float64, owner string) (map[internalpb.RateType]float64, error) {
+	ret := make(map[internalpb.RateType]float64, len(rates))
+	for name, rate := range rates {
+		rt, ok := internalpb.RateType_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid rate type %s in the quota of %s", name, owner)
+		}
+		if rate < 0 {
+			return nil, fmt.Errorf("invalid rate %v of %s in the quota of %s", rate, name, owner)
+		}
+		ret[internalpb.RateType(rt)] = rate
+	}
+	return ret, nil
+}
+
+// MarshalRoleQuota encodes the role quota into json.
+func MarshalRoleQuota(quota *RoleQuota) (string, error) {
+	bs, err := json.Marshal(quota)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalRoleQuota decodes the role quota from json, and validates its rates.
+func UnmarshalRoleQuota(value string) (*RoleQuota, error) {
+	quota := &RoleQuota{}
+	if err := json.Unmarshal([]byte(value), quota); err != nil {
+		return nil, err
+	}
+	if quota.RoleName == "" {
+		return nil, fmt.Errorf("empty role name in the role quota")
+	}
+	if _, err := quota.GetRateTypes(); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// MarshalRoleQuotaModel converts the role quota into the proto carried by the role quota rpcs.
+func MarshalRoleQuotaModel(quota *RoleQuota) *proxypb.RoleQuota {
+	if quota == nil {
+		return nil
+	}
+	return &proxypb.RoleQuota{
+		RoleName: quota.RoleName,
+		Rates:    quota.Rates,
+	}
+}
+
+// UnmarshalRoleQuotaModel converts the proto carried by the role quota rpcs into the role quota.
+func UnmarshalRoleQuotaModel(quota *proxypb.RoleQuota) *RoleQuota {
+	if quota == nil {
+		return nil
+	}
+	return &RoleQuota{
+		RoleName: quota.GetRoleName(),
+		Rates:    quota.GetRates(),
+	}
+}
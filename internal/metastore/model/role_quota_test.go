@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestRoleQuota(t *testing.T) {
+	quota := &RoleQuota{
+		RoleName: "readonly",
+		Rates: map[string]float64{
+			internalpb.RateType_DQLSearch.String(): 10,
+			internalpb.RateType_DMLInsert.String(): 0,
+		},
+	}
+	value, err := MarshalRoleQuota(quota)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalRoleQuota(value)
+	assert.NoError(t, err)
+	assert.Equal(t, quota, ret)
+	rates, err := ret.GetRateTypes()
+	assert.NoError(t, err)
+	assert.Equal(t, map[internalpb.RateType]float64{
+		internalpb.RateType_DQLSearch: 10,
+		internalpb.RateType_DMLInsert: 0,
+	}, rates)
+
+	_, err = UnmarshalRoleQuota(`{"role_name":"readonly","rates":{"Unknown":1}}`)
+	assert.Error(t, err)
+	_, err = UnmarshalRoleQuota(`{"role_name":"readonly","rates":{"DQLSearch":-1}}`)
+	assert.Error(t, err)
+	_, err = UnmarshalRoleQuota(`{"rates":{"DQLSearch":1}}`)
+	assert.Error(t, err)
+	_, err = UnmarshalRoleQuota(`invalid`)
+	assert.Error(t, err)
+}
+
+func TestRoleQuotaModel(t *testing.T) {
+	quota := &RoleQuota{RoleName: "readonly", Rates: map[string]float64{"DQLSearch": 10}}
+	pb := MarshalRoleQuotaModel(quota)
+	assert.Equal(t, "readonly", pb.GetRoleName())
+	assert.Equal(t, quota, UnmarshalRoleQuotaModel(pb))
+
+	assert.Nil(t, MarshalRoleQuotaModel(nil))
+	assert.Nil(t, UnmarshalRoleQuotaModel(nil))
+}
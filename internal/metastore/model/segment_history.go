@@ -0,0 +1,52 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SegmentStateEvent is a state transition of a segment, From is SegmentStateNone when the segment is created.
+type SegmentStateEvent struct {
+	From  string    `json:"from"`
+	To    string    `json:"to"`
+	Cause string    `json:"cause"`
+	Time  time.Time `json:"time"`
+}
+
+// SegmentHistory is the state transitions of a segment, the oldest first.
+type SegmentHistory struct {
+	CollectionID int64                `json:"collection_id"`
+	PartitionID  int64                `json:"partition_id"`
+	SegmentID    int64                `json:"segment_id"`
+	Channel      string               `json:"channel"`
+	Events       []*SegmentStateEvent `json:"events"`
+}
+
+// Clone returns a deep copy of the history.
+func (h *SegmentHistory) Clone() *SegmentHistory {
+	ret := *h
+	ret.Events = make([]*SegmentStateEvent, 0, len(h.Events))
+	for _, event := range h.Events {
+		e := *event
+		ret.Events = append(ret.Events, &e)
+	}
+	return &ret
+}
+
+// MarshalSegmentHistory encodes the segment history into json.
+func MarshalSegmentHistory(history *SegmentHistory) (string, error) {
+	bs, err := json.Marshal(history)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalSegmentHistory decodes the segment history from json.
+func UnmarshalSegmentHistory(value string) (*SegmentHistory, error) {
+	history := &SegmentHistory{}
+	if err := json.Unmarshal([]byte(value), history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentHistory(t *testing.T) {
+	history := &SegmentHistory{
+		CollectionID: 100,
+		PartitionID:  10,
+		SegmentID:    1,
+		Channel:      "ch1",
+		Events: []*SegmentStateEvent{
+			{From: "SegmentStateNone", To: "Growing", Cause: "allocation", Time: time.Unix(1, 0).UTC()},
+		},
+	}
+	value, err := MarshalSegmentHistory(history)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalSegmentHistory(value)
+	assert.NoError(t, err)
+	assert.Equal(t, history, ret)
+
+	_, err = UnmarshalSegmentHistory(`invalid`)
+	assert.Error(t, err)
+
+	cloned := history.Clone()
+	assert.Equal(t, history, cloned)
+	cloned.Events[0].Cause = "import"
+	assert.Equal(t, "allocation", history.Events[0].Cause)
+}
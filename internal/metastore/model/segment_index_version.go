@@ -0,0 +1,56 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+)
+
+// SegmentIndexVersion records the engine version, i.e. the knowhere version, and the file format version producing
+// a segment index, it's persisted beside the segment index meta and removed along with it.
+type SegmentIndexVersion struct {
+	BuildID       int64  `json:"build_id"`
+	EngineVersion string `json:"engine_version"`
+	FormatVersion int32  `json:"format_version"`
+}
+
+// IsDeprecated returns whether the index is produced by an engine older than minEngineVersion or written in a file
+// format older than minFormatVersion, an empty minEngineVersion doesn't limit the engine version. The versions are
+// compared as semvers, the leading v of the knowhere versions is optional.
+func (v *SegmentIndexVersion) IsDeprecated(minEngineVersion string, minFormatVersion int32) (bool, error) {
+	if v.FormatVersion < minFormatVersion {
+		return true, nil
+	}
+	if minEngineVersion == "" {
+		return false, nil
+	}
+	minVersion, err := semver.ParseTolerant(minEngineVersion)
+	if err != nil {
+		return false, fmt.Errorf("invalid min engine version %s: %w", minEngineVersion, err)
+	}
+	version, err := semver.ParseTolerant(v.EngineVersion)
+	if err != nil {
+		// the engine version unknown can't be proven compatible
+		return true, nil
+	}
+	return version.LT(minVersion), nil
+}
+
+// MarshalSegmentIndexVersion encodes the segment index version into json.
+func MarshalSegmentIndexVersion(version *SegmentIndexVersion) (string, error) {
+	bs, err := json.Marshal(version)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalSegmentIndexVersion decodes the segment index version from json.
+func UnmarshalSegmentIndexVersion(value string) (*SegmentIndexVersion, error) {
+	version := &SegmentIndexVersion{}
+	if err := json.Unmarshal([]byte(value), version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentIndexVersion(t *testing.T) {
+	version := &SegmentIndexVersion{
+		BuildID:       1,
+		EngineVersion: "v1.3.6",
+		FormatVersion: 1,
+	}
+	value, err := MarshalSegmentIndexVersion(version)
+	assert.NoError(t, err)
+
+	ret, err := UnmarshalSegmentIndexVersion(value)
+	assert.NoError(t, err)
+	assert.Equal(t, version, ret)
+
+	_, err = UnmarshalSegmentIndexVersion(`invalid`)
+	assert.Error(t, err)
+
+	deprecated, err := version.IsDeprecated("", 0)
+	assert.NoError(t, err)
+	assert.False(t, deprecated)
+	deprecated, err = version.IsDeprecated("v1.3.6", 1)
+	assert.NoError(t, err)
+	assert.False(t, deprecated)
+	deprecated, err = version.IsDeprecated("1.4.0", 1)
+	assert.NoError(t, err)
+	assert.True(t, deprecated)
+	deprecated, err = version.IsDeprecated("", 2)
+	assert.NoError(t, err)
+	assert.True(t, deprecated)
+	_, err = version.IsDeprecated("invalid", 0)
+	assert.Error(t, err)
+
+	unknown := &SegmentIndexVersion{BuildID: 2, EngineVersion: "unknown"}
+	deprecated, err = unknown.IsDeprecated("v1.3.0", 0)
+	assert.NoError(t, err)
+	assert.True(t, deprecated)
+}
@@ -95,6 +95,15 @@ var (
 			Help:      "binlog size of segments",
 		}, []string{segmentStateLabelName})
 
+	DataCoordDeleteSLALatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "delete_sla_latency",
+			Help:      "seconds from producing the sampled deletes to reaching each stage",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 20, 50, 100, 250, 500, 1000, 3600, 5000, 10000, 86400, 604800}, // unit seconds
+		}, []string{deleteStageLabelName})
+
 	/* hard to implement, commented now
 	DataCoordSegmentSizeRatio = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -151,4 +160,5 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordNumStoredRowsCounter)
 	registry.MustRegister(DataCoordConsumeDataNodeTimeTickLag)
 	registry.MustRegister(DataCoordStoredBinlogSize)
+	registry.MustRegister(DataCoordDeleteSLALatency)
 }
@@ -197,6 +197,74 @@ var (
 			Help:      "forward delete message time taken",
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName})
+
+	DataNodeValidatedDeleteRowsCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "validated_delete_rows_count",
+			Help:      "count of delete rows checked against segment bloom filters, by whether any segment matched",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			statusLabelName,
+		})
+
+	DataNodeUnmatchedDeleteRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "unmatched_delete_ratio",
+			Help:      "fraction of validated delete rows which match no segment",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataNodeFlowGraphNodeLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "flowgraph_node_latency",
+			Help:      "latency of a flowgraph node processing one message pack",
+			Buckets:   buckets, // unit: ms
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeFlowGraphNodeQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "flowgraph_node_queue_length",
+			Help:      "number of message packs waiting in the input queue of a flowgraph node",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeClockSkew = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "clock_skew",
+			Help:      "local wall clock minus the physical time of the allocated TSO, in milliseconds",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	DataNodeClockSkewDegraded = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "clock_skew_degraded",
+			Help:      "1 if the clock skew or the wall clock jump exceeds the threshold, otherwise 0",
+		}, []string{
+			nodeIDLabelName,
+		})
 )
 
 // RegisterDataNode registers DataNode metrics
@@ -217,6 +285,12 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeProduceTimeTickLag)
 	registry.MustRegister(DataNodeConsumeBytesCount)
 	registry.MustRegister(DataNodeForwardDeleteMsgTimeTaken)
+	registry.MustRegister(DataNodeValidatedDeleteRowsCount)
+	registry.MustRegister(DataNodeUnmatchedDeleteRatio)
+	registry.MustRegister(DataNodeFlowGraphNodeLatency)
+	registry.MustRegister(DataNodeFlowGraphNodeQueueLength)
+	registry.MustRegister(DataNodeClockSkew)
+	registry.MustRegister(DataNodeClockSkewDegraded)
 }
 
 func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel string) {
@@ -246,3 +320,11 @@ func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel
 				})
 	}
 }
+
+// CleanupDataNodeDeleteValidationMetrics removes the delete validation metrics of a released channel.
+func CleanupDataNodeDeleteValidationMetrics(nodeID int64, channel string) {
+	for _, label := range []string{MatchedDeleteLabel, UnmatchedDeleteLabel} {
+		DataNodeValidatedDeleteRowsCount.DeleteLabelValues(fmt.Sprint(nodeID), channel, label)
+	}
+	DataNodeUnmatchedDeleteRatio.DeleteLabelValues(fmt.Sprint(nodeID), channel)
+}
@@ -48,6 +48,42 @@ var (
 			Name:      "index_node_num",
 			Help:      "number of IndexNodes managed by IndexCoord",
 		}, []string{})
+
+	// IndexCoordWatchRestartCounter records the number of the etcd watches restarted by IndexCoord.
+	IndexCoordWatchRestartCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "watch_restart_count",
+			Help:      "number of etcd watches restarted, by the reason of the restart",
+		}, []string{watchReasonLabelName})
+
+	// IndexCoordIndexedRowsRatio records the ratio of the rows indexed of each collection.
+	IndexCoordIndexedRowsRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "indexed_rows_ratio",
+			Help:      "ratio of the rows of the segment indexes finished to the rows of all the segment indexes of the collection",
+		}, []string{collectionIDLabelName})
+
+	// IndexCoordUnindexedSegmentNum records the number of the flushed segments not fully indexed of each collection.
+	IndexCoordUnindexedSegmentNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "unindexed_segment_num",
+			Help:      "number of flushed segments with a segment index not finished",
+		}, []string{collectionIDLabelName})
+
+	// IndexCoordOldestUnindexedSegmentAge records the age of the oldest flushed segment not fully indexed of each collection.
+	IndexCoordOldestUnindexedSegmentAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexCoordRole,
+			Name:      "oldest_unindexed_segment_age_seconds",
+			Help:      "seconds since the oldest segment index not finished of the collection was created, 0 if all finished",
+		}, []string{collectionIDLabelName})
 )
 
 //RegisterIndexCoord registers IndexCoord metrics
@@ -55,4 +91,8 @@ func RegisterIndexCoord(registry *prometheus.Registry) {
 	registry.MustRegister(IndexCoordIndexRequestCounter)
 	registry.MustRegister(IndexCoordIndexTaskNum)
 	registry.MustRegister(IndexCoordIndexNodeNum)
+	registry.MustRegister(IndexCoordWatchRestartCounter)
+	registry.MustRegister(IndexCoordIndexedRowsRatio)
+	registry.MustRegister(IndexCoordUnindexedSegmentNum)
+	registry.MustRegister(IndexCoordOldestUnindexedSegmentAge)
 }
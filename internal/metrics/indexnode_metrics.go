@@ -74,6 +74,14 @@ var (
 			Help:      "latency of saving the index file",
 			Buckets:   buckets,
 		}, []string{nodeIDLabelName})
+
+	IndexNodeBundleLoadBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "bundle_load_bytes",
+			Help:      "binlog bytes read by the bundled index builds, downloaded or shared with the other builds of the segment",
+		}, []string{nodeIDLabelName, "source"})
 )
 
 //RegisterIndexNode registers IndexNode metrics
@@ -84,4 +92,5 @@ func RegisterIndexNode(registry *prometheus.Registry) {
 	registry.MustRegister(IndexNodeKnowhereBuildIndexLatency)
 	registry.MustRegister(IndexNodeEncodeIndexFileLatency)
 	registry.MustRegister(IndexNodeSaveIndexFileLatency)
+	registry.MustRegister(IndexNodeBundleLoadBytes)
 }
@@ -49,6 +49,25 @@ const (
 	FailedIndexTaskLabel     = "failed"
 	RecycledIndexTaskLabel   = "recycled"
 
+	MatchedDeleteLabel   = "matched"
+	UnmatchedDeleteLabel = "unmatched"
+
+	UnsolvedQueueLabel = "unsolved"
+	ReadyQueueLabel    = "ready"
+
+	RetryLabel           = "retry"
+	RecoveredLabel       = "recovered"
+	BudgetExhaustedLabel = "budget_exhausted"
+
+	LocalZoneLabel   = "local"
+	RemoteZoneLabel  = "remote"
+	UnknownZoneLabel = "unknown"
+
+	WatchCompactedLabel    = "compacted"
+	WatchErrorLabel        = "error"
+	WatchClosedLabel       = "closed"
+	WatchRelistFailedLabel = "relist_failed"
+
 	// Note: below must matchcommonpb.SegmentState_name fields.
 	SealedSegmentLabel   = "Sealed"
 	GrowingSegmentLabel  = "Growing"
@@ -76,6 +95,13 @@ const (
 	cacheStateLabelName      = "cache_state"
 	indexCountLabelName      = "indexed_field_count"
 	requestScope             = "scope"
+	flowGraphNodeLabelName   = "flowgraph_node"
+	searchVariantLabelName   = "search_variant"
+	readQueueLabelName       = "read_queue"
+	deleteStageLabelName     = "delete_stage"
+	zoneLabelName            = "zone"
+	localityLabelName        = "locality"
+	watchReasonLabelName     = "watch_restart_reason"
 )
 
 var (
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestRegisterMetrics(t *testing.T) {
@@ -36,3 +38,14 @@ func TestRegisterMetrics(t *testing.T) {
 	RegisterEtcdMetrics(r)
 	Register(r)
 }
+
+func TestCleanupDataNodeDeleteValidationMetrics(t *testing.T) {
+	DataNodeValidatedDeleteRowsCount.WithLabelValues("1", "ch-1", MatchedDeleteLabel).Add(1)
+	DataNodeValidatedDeleteRowsCount.WithLabelValues("1", "ch-1", UnmatchedDeleteLabel).Add(1)
+	DataNodeValidatedDeleteRowsCount.WithLabelValues("1", "ch-2", MatchedDeleteLabel).Add(1)
+	DataNodeUnmatchedDeleteRatio.WithLabelValues("1", "ch-1").Set(0.5)
+
+	CleanupDataNodeDeleteValidationMetrics(1, "ch-1")
+	assert.Equal(t, 1, testutil.CollectAndCount(DataNodeValidatedDeleteRowsCount))
+	assert.Equal(t, 0, testutil.CollectAndCount(DataNodeUnmatchedDeleteRatio))
+}
@@ -64,6 +64,16 @@ var (
 			Buckets:   buckets,
 		}, []string{nodeIDLabelName, queryTypeLabelName, collectionName})
 
+	// ProxySearchVariantLatency record the latency of search successfully, per collection and search variant.
+	ProxySearchVariantLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_variant_latency",
+			Help:      "latency of search successfully, per collection and search variant",
+			Buckets:   buckets,
+		}, []string{nodeIDLabelName, collectionName, searchVariantLabelName})
+
 	// ProxyMutationLatency record the latency that mutate successfully.
 	ProxyMutationLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -216,6 +226,54 @@ var (
 			Help:      "count of bytes sent back to sdk",
 		}, []string{nodeIDLabelName})
 
+	// ProxyReadRetryCount records the retries of the search and query shards failed on a shard leader,
+	// the reads recovered by the retries and the retries rejected for the exhausted retry budget.
+	ProxyReadRetryCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "read_retry_count",
+			Help:      "count of the retries of search and query on the other replicas",
+		}, []string{nodeIDLabelName, queryTypeLabelName, statusLabelName})
+
+	// ProxyZoneReadLatency records the latency of the search and query sent to the shard leaders per zone,
+	// whether the zone is the zone of the Proxy, and whether the request succeeded.
+	ProxyZoneReadLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "zone_read_latency",
+			Help:      "latency of search and query sent to the shard leaders of each zone",
+			Buckets:   buckets, // unit: ms
+		}, []string{nodeIDLabelName, zoneLabelName, localityLabelName, statusLabelName})
+
+	// ProxySLOBurnRate records the burn rate of the latency slo error budget of each request type in the long window.
+	ProxySLOBurnRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slo_burn_rate",
+			Help:      "burn rate of the latency slo error budget of each request type",
+		}, []string{nodeIDLabelName, msgTypeLabelName})
+
+	// ProxySLOShedTier records the lowest tier shed to protect the latency slo, 0 means nothing is shed.
+	ProxySLOShedTier = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slo_shed_tier",
+			Help:      "the requests of this tier and above are shed to protect the latency slo, 0 means none",
+		}, []string{nodeIDLabelName})
+
+	// ProxySLOShedCount records the requests shed to protect the latency slo.
+	ProxySLOShedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slo_shed_count",
+			Help:      "count of the requests shed to protect the latency slo",
+		}, []string{nodeIDLabelName, msgTypeLabelName})
+
 	// ProxyLimiterRate records rates of rateLimiter in Proxy.
 	ProxyLimiterRate = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -233,6 +291,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxySQLatency)
 	registry.MustRegister(ProxyCollectionSQLatency)
+	registry.MustRegister(ProxySearchVariantLatency)
 	registry.MustRegister(ProxyMutationLatency)
 	registry.MustRegister(ProxyCollectionMutationLatency)
 
@@ -257,6 +316,11 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyReceiveBytes)
 	registry.MustRegister(ProxyReadReqSendBytes)
 
+	registry.MustRegister(ProxyReadRetryCount)
+	registry.MustRegister(ProxyZoneReadLatency)
+	registry.MustRegister(ProxySLOBurnRate)
+	registry.MustRegister(ProxySLOShedTier)
+	registry.MustRegister(ProxySLOShedCount)
 	registry.MustRegister(ProxyLimiterRate)
 }
 
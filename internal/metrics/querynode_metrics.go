@@ -217,6 +217,18 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeReadTaskQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "read_task_queue_depth",
+			Help:      "number of read tasks of a collection in unsolvedQueue or readyQueue",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			readQueueLabelName,
+		})
+
 	QueryNodeReadTaskConcurrency = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -326,6 +338,28 @@ var (
 			indexCountLabelName,
 		})
 
+	QueryNodePlanCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "plan_cache_count",
+			Help:      "count of search / query plan cache hits/miss",
+		}, []string{
+			nodeIDLabelName,
+			queryTypeLabelName,
+			cacheStateLabelName,
+		})
+
+	QueryNodePlanCacheNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "plan_cache_num",
+			Help:      "number of cached search / query plans",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	// QueryNodeConsumeCounter counts the bytes QueryNode consumed from message storage.
 	QueryNodeConsumeCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -362,6 +396,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeLoadSegmentLatency)
 	registry.MustRegister(QueryNodeReadTaskUnsolveLen)
 	registry.MustRegister(QueryNodeReadTaskReadyLen)
+	registry.MustRegister(QueryNodeReadTaskQueueDepth)
 	registry.MustRegister(QueryNodeReadTaskConcurrency)
 	registry.MustRegister(QueryNodeEstimateCPUUsage)
 	registry.MustRegister(QueryNodeSearchGroupNQ)
@@ -376,6 +411,8 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeExecuteCounter)
 	registry.MustRegister(QueryNodeConsumerMsgCount)
 	registry.MustRegister(QueryNodeConsumeTimeTickLag)
+	registry.MustRegister(QueryNodePlanCacheCounter)
+	registry.MustRegister(QueryNodePlanCacheNum)
 }
 
 func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
@@ -123,6 +123,53 @@ func (_c *DataNode_Compaction_Call) Return(_a0 *commonpb.Status, _a1 error) *Dat
 	return _c
 }
 
+// Decommission provides a mock function with given fields: ctx, req
+func (_m *DataNode) Decommission(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.DecommissionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DecommissionRequest) *datapb.DecommissionResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.DecommissionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DecommissionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataNode_Decommission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decommission'
+type DataNode_Decommission_Call struct {
+	*mock.Call
+}
+
+// Decommission is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.DecommissionRequest
+func (_e *DataNode_Expecter) Decommission(ctx interface{}, req interface{}) *DataNode_Decommission_Call {
+	return &DataNode_Decommission_Call{Call: _e.mock.On("Decommission", ctx, req)}
+}
+
+func (_c *DataNode_Decommission_Call) Run(run func(ctx context.Context, req *datapb.DecommissionRequest)) *DataNode_Decommission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.DecommissionRequest))
+	})
+	return _c
+}
+
+func (_c *DataNode_Decommission_Call) Return(_a0 *datapb.DecommissionResponse, _a1 error) *DataNode_Decommission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // FlushSegments provides a mock function with given fields: ctx, req
 func (_m *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
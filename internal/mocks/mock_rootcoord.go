@@ -736,6 +736,53 @@ func (_c *RootCoord_DropCollection_Call) Return(_a0 *commonpb.Status, _a1 error)
 	return _c
 }
 
+// DropDatabaseQuota provides a mock function with given fields: ctx, req
+func (_m *RootCoord) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropDatabaseQuotaRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropDatabaseQuotaRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropDatabaseQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabaseQuota'
+type RootCoord_DropDatabaseQuota_Call struct {
+	*mock.Call
+}
+
+// DropDatabaseQuota is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.DropDatabaseQuotaRequest
+func (_e *RootCoord_Expecter) DropDatabaseQuota(ctx interface{}, req interface{}) *RootCoord_DropDatabaseQuota_Call {
+	return &RootCoord_DropDatabaseQuota_Call{Call: _e.mock.On("DropDatabaseQuota", ctx, req)}
+}
+
+func (_c *RootCoord_DropDatabaseQuota_Call) Run(run func(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest)) *RootCoord_DropDatabaseQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DropDatabaseQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropDatabaseQuota_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropDatabaseQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DropPartition provides a mock function with given fields: ctx, req
 func (_m *RootCoord) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -830,6 +877,100 @@ func (_c *RootCoord_DropRole_Call) Return(_a0 *commonpb.Status, _a1 error) *Root
 	return _c
 }
 
+// DropRoleQuota provides a mock function with given fields: ctx, req
+func (_m *RootCoord) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropRoleQuotaRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropRoleQuotaRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropRoleQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRoleQuota'
+type RootCoord_DropRoleQuota_Call struct {
+	*mock.Call
+}
+
+// DropRoleQuota is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.DropRoleQuotaRequest
+func (_e *RootCoord_Expecter) DropRoleQuota(ctx interface{}, req interface{}) *RootCoord_DropRoleQuota_Call {
+	return &RootCoord_DropRoleQuota_Call{Call: _e.mock.On("DropRoleQuota", ctx, req)}
+}
+
+func (_c *RootCoord_DropRoleQuota_Call) Run(run func(ctx context.Context, req *proxypb.DropRoleQuotaRequest)) *RootCoord_DropRoleQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DropRoleQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropRoleQuota_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropRoleQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// EnterReadOnly provides a mock function with given fields: ctx, req
+func (_m *RootCoord) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.EnterReadOnlyRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.EnterReadOnlyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_EnterReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnterReadOnly'
+type RootCoord_EnterReadOnly_Call struct {
+	*mock.Call
+}
+
+// EnterReadOnly is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.EnterReadOnlyRequest
+func (_e *RootCoord_Expecter) EnterReadOnly(ctx interface{}, req interface{}) *RootCoord_EnterReadOnly_Call {
+	return &RootCoord_EnterReadOnly_Call{Call: _e.mock.On("EnterReadOnly", ctx, req)}
+}
+
+func (_c *RootCoord_EnterReadOnly_Call) Run(run func(ctx context.Context, req *proxypb.EnterReadOnlyRequest)) *RootCoord_EnterReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.EnterReadOnlyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_EnterReadOnly_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_EnterReadOnly_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *RootCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
@@ -923,6 +1064,53 @@ func (_c *RootCoord_GetCredential_Call) Return(_a0 *rootcoordpb.GetCredentialRes
 	return _c
 }
 
+// GetDdlOperationState provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.GetDdlOperationStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetDdlOperationStateRequest) *proxypb.GetDdlOperationStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.GetDdlOperationStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.GetDdlOperationStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetDdlOperationState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDdlOperationState'
+type RootCoord_GetDdlOperationState_Call struct {
+	*mock.Call
+}
+
+// GetDdlOperationState is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.GetDdlOperationStateRequest
+func (_e *RootCoord_Expecter) GetDdlOperationState(ctx interface{}, req interface{}) *RootCoord_GetDdlOperationState_Call {
+	return &RootCoord_GetDdlOperationState_Call{Call: _e.mock.On("GetDdlOperationState", ctx, req)}
+}
+
+func (_c *RootCoord_GetDdlOperationState_Call) Run(run func(ctx context.Context, req *proxypb.GetDdlOperationStateRequest)) *RootCoord_GetDdlOperationState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.GetDdlOperationStateRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetDdlOperationState_Call) Return(_a0 *proxypb.GetDdlOperationStateResponse, _a1 error) *RootCoord_GetDdlOperationState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetImportState provides a mock function with given fields: ctx, req
 func (_m *RootCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1333,6 +1521,53 @@ func (_c *RootCoord_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Sta
 	return _c
 }
 
+// LeaveReadOnly provides a mock function with given fields: ctx, req
+func (_m *RootCoord) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.LeaveReadOnlyRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.LeaveReadOnlyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_LeaveReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveReadOnly'
+type RootCoord_LeaveReadOnly_Call struct {
+	*mock.Call
+}
+
+// LeaveReadOnly is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.LeaveReadOnlyRequest
+func (_e *RootCoord_Expecter) LeaveReadOnly(ctx interface{}, req interface{}) *RootCoord_LeaveReadOnly_Call {
+	return &RootCoord_LeaveReadOnly_Call{Call: _e.mock.On("LeaveReadOnly", ctx, req)}
+}
+
+func (_c *RootCoord_LeaveReadOnly_Call) Run(run func(ctx context.Context, req *proxypb.LeaveReadOnlyRequest)) *RootCoord_LeaveReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.LeaveReadOnlyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_LeaveReadOnly_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_LeaveReadOnly_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1380,6 +1615,53 @@ func (_c *RootCoord_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersRespon
 	return _c
 }
 
+// ListDatabaseQuotas provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.ListDatabaseQuotasResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListDatabaseQuotasRequest) *proxypb.ListDatabaseQuotasResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListDatabaseQuotasResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListDatabaseQuotasRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDatabaseQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabaseQuotas'
+type RootCoord_ListDatabaseQuotas_Call struct {
+	*mock.Call
+}
+
+// ListDatabaseQuotas is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.ListDatabaseQuotasRequest
+func (_e *RootCoord_Expecter) ListDatabaseQuotas(ctx interface{}, req interface{}) *RootCoord_ListDatabaseQuotas_Call {
+	return &RootCoord_ListDatabaseQuotas_Call{Call: _e.mock.On("ListDatabaseQuotas", ctx, req)}
+}
+
+func (_c *RootCoord_ListDatabaseQuotas_Call) Run(run func(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest)) *RootCoord_ListDatabaseQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ListDatabaseQuotasRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDatabaseQuotas_Call) Return(_a0 *proxypb.ListDatabaseQuotasResponse, _a1 error) *RootCoord_ListDatabaseQuotas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1474,6 +1756,100 @@ func (_c *RootCoord_ListPolicy_Call) Return(_a0 *internalpb.ListPolicyResponse,
 	return _c
 }
 
+// ListReadOnlyModes provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.ListReadOnlyModesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListReadOnlyModesRequest) *proxypb.ListReadOnlyModesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListReadOnlyModesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListReadOnlyModesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListReadOnlyModes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReadOnlyModes'
+type RootCoord_ListReadOnlyModes_Call struct {
+	*mock.Call
+}
+
+// ListReadOnlyModes is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.ListReadOnlyModesRequest
+func (_e *RootCoord_Expecter) ListReadOnlyModes(ctx interface{}, req interface{}) *RootCoord_ListReadOnlyModes_Call {
+	return &RootCoord_ListReadOnlyModes_Call{Call: _e.mock.On("ListReadOnlyModes", ctx, req)}
+}
+
+func (_c *RootCoord_ListReadOnlyModes_Call) Run(run func(ctx context.Context, req *proxypb.ListReadOnlyModesRequest)) *RootCoord_ListReadOnlyModes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ListReadOnlyModesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListReadOnlyModes_Call) Return(_a0 *proxypb.ListReadOnlyModesResponse, _a1 error) *RootCoord_ListReadOnlyModes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListRoleQuotas provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.ListRoleQuotasResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListRoleQuotasRequest) *proxypb.ListRoleQuotasResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListRoleQuotasResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListRoleQuotasRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListRoleQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleQuotas'
+type RootCoord_ListRoleQuotas_Call struct {
+	*mock.Call
+}
+
+// ListRoleQuotas is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.ListRoleQuotasRequest
+func (_e *RootCoord_Expecter) ListRoleQuotas(ctx interface{}, req interface{}) *RootCoord_ListRoleQuotas_Call {
+	return &RootCoord_ListRoleQuotas_Call{Call: _e.mock.On("ListRoleQuotas", ctx, req)}
+}
+
+func (_c *RootCoord_ListRoleQuotas_Call) Run(run func(ctx context.Context, req *proxypb.ListRoleQuotasRequest)) *RootCoord_ListRoleQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ListRoleQuotasRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListRoleQuotas_Call) Return(_a0 *proxypb.ListRoleQuotasResponse, _a1 error) *RootCoord_ListRoleQuotas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: ctx, req
 func (_m *RootCoord) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -1651,6 +2027,100 @@ func (_c *RootCoord_ReportImport_Call) Return(_a0 *commonpb.Status, _a1 error) *
 	return _c
 }
 
+// SaveDatabaseQuota provides a mock function with given fields: ctx, req
+func (_m *RootCoord) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SaveDatabaseQuotaRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SaveDatabaseQuotaRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_SaveDatabaseQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDatabaseQuota'
+type RootCoord_SaveDatabaseQuota_Call struct {
+	*mock.Call
+}
+
+// SaveDatabaseQuota is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.SaveDatabaseQuotaRequest
+func (_e *RootCoord_Expecter) SaveDatabaseQuota(ctx interface{}, req interface{}) *RootCoord_SaveDatabaseQuota_Call {
+	return &RootCoord_SaveDatabaseQuota_Call{Call: _e.mock.On("SaveDatabaseQuota", ctx, req)}
+}
+
+func (_c *RootCoord_SaveDatabaseQuota_Call) Run(run func(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest)) *RootCoord_SaveDatabaseQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.SaveDatabaseQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_SaveDatabaseQuota_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_SaveDatabaseQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// SaveRoleQuota provides a mock function with given fields: ctx, req
+func (_m *RootCoord) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SaveRoleQuotaRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SaveRoleQuotaRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_SaveRoleQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRoleQuota'
+type RootCoord_SaveRoleQuota_Call struct {
+	*mock.Call
+}
+
+// SaveRoleQuota is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *proxypb.SaveRoleQuotaRequest
+func (_e *RootCoord_Expecter) SaveRoleQuota(ctx interface{}, req interface{}) *RootCoord_SaveRoleQuota_Call {
+	return &RootCoord_SaveRoleQuota_Call{Call: _e.mock.On("SaveRoleQuota", ctx, req)}
+}
+
+func (_c *RootCoord_SaveRoleQuota_Call) Run(run func(ctx context.Context, req *proxypb.SaveRoleQuotaRequest)) *RootCoord_SaveRoleQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.SaveRoleQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_SaveRoleQuota_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_SaveRoleQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: ctx, req
 func (_m *RootCoord) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -712,3 +712,62 @@ func (m *DataNodeTtMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 		DataNodeTtMsg: msg,
 	}, nil
 }
+
+/////////////////////////////////////////IndexBuildEventMsg//////////////////////////////////////////
+
+// MsgTypeIndexBuildEvent is the message type of IndexBuildEventMsg. The build events are only produced to
+// their dedicated channel, the value is out of the range of the common message types so that it never
+// collides with them.
+const MsgTypeIndexBuildEvent MsgType = 1300
+
+// IndexBuildEventMsg is a message pack that contains a lifecycle event of an index build task on IndexNode
+type IndexBuildEventMsg struct {
+	BaseMsg
+	internalpb.IndexBuildEvent
+}
+
+// interface implementation validation
+var _ TsMsg = &IndexBuildEventMsg{}
+
+// ID returns the ID of this message pack
+func (m *IndexBuildEventMsg) ID() UniqueID {
+	return m.Base.MsgID
+}
+
+// Type returns the type of this message pack
+func (m *IndexBuildEventMsg) Type() MsgType {
+	return m.Base.MsgType
+}
+
+// SourceID indicates which component generated this message
+func (m *IndexBuildEventMsg) SourceID() int64 {
+	return m.Base.SourceID
+}
+
+// Marshal is used to serializing a message pack to byte array
+func (m *IndexBuildEventMsg) Marshal(input TsMsg) (MarshalType, error) {
+	msg := input.(*IndexBuildEventMsg)
+	t, err := proto.Marshal(&msg.IndexBuildEvent)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Unmarshal is used to deserializing a message pack from byte array
+func (m *IndexBuildEventMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	msg := internalpb.IndexBuildEvent{}
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &msg)
+	if err != nil {
+		return nil, err
+	}
+	eventMsg := &IndexBuildEventMsg{IndexBuildEvent: msg}
+	eventMsg.BeginTimestamp = eventMsg.Base.Timestamp
+	eventMsg.EndTimestamp = eventMsg.Base.Timestamp
+
+	return eventMsg, nil
+}
@@ -603,3 +603,43 @@ func TestDataNodeTtMsg_Unmarshal_IllegalParameter(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, tsMsg)
 }
+
+func TestIndexBuildEventMsg(t *testing.T) {
+	eventMsg := &IndexBuildEventMsg{
+		BaseMsg: generateBaseMsg(),
+		IndexBuildEvent: internalpb.IndexBuildEvent{
+			Base: &commonpb.MsgBase{
+				MsgType:   MsgTypeIndexBuildEvent,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			EventType:    internalpb.IndexBuildEventType_BuildEventFinished,
+			BuildID:      1,
+			CollectionID: 4,
+			FieldID:      5,
+		},
+	}
+
+	assert.Equal(t, int64(1), eventMsg.ID())
+	assert.Equal(t, MsgTypeIndexBuildEvent, eventMsg.Type())
+	assert.Equal(t, int64(3), eventMsg.SourceID())
+
+	bytes, err := eventMsg.Marshal(eventMsg)
+	assert.Nil(t, err)
+
+	tsMsg, err := (&ProtoUDFactory{}).NewUnmarshalDispatcher().Unmarshal(bytes, MsgTypeIndexBuildEvent)
+	assert.Nil(t, err)
+
+	eventMsg2, ok := tsMsg.(*IndexBuildEventMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), eventMsg2.ID())
+	assert.Equal(t, MsgTypeIndexBuildEvent, eventMsg2.Type())
+	assert.Equal(t, uint64(2), eventMsg2.BeginTs())
+	assert.Equal(t, int64(4), eventMsg2.GetCollectionID())
+	assert.Equal(t, internalpb.IndexBuildEventType_BuildEventFinished, eventMsg2.GetEventType())
+
+	tsMsg, err = eventMsg.Unmarshal(10)
+	assert.NotNil(t, err)
+	assert.Nil(t, tsMsg)
+}
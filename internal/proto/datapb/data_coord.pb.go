@@ -130,6 +130,68 @@ func (CompactionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{2}
 }
 
+// MaintenanceScope is the maintenance of a collection which can be paused
+type MaintenanceScope int32
+
+const (
+	MaintenanceScope_MaintenanceAll        MaintenanceScope = 0
+	MaintenanceScope_MaintenanceCompaction MaintenanceScope = 1
+	// the gc of the binlogs of the dropped and compacted segments
+	MaintenanceScope_MaintenanceGC MaintenanceScope = 2
+)
+
+var MaintenanceScope_name = map[int32]string{
+	0: "MaintenanceAll",
+	1: "MaintenanceCompaction",
+	2: "MaintenanceGC",
+}
+
+var MaintenanceScope_value = map[string]int32{
+	"MaintenanceAll":        0,
+	"MaintenanceCompaction": 1,
+	"MaintenanceGC":         2,
+}
+
+func (x MaintenanceScope) String() string {
+	return proto.EnumName(MaintenanceScope_name, int32(x))
+}
+
+func (MaintenanceScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{3}
+}
+
+type DecommissionState int32
+
+const (
+	DecommissionState_DecommissionNone     DecommissionState = 0
+	DecommissionState_DecommissionDraining DecommissionState = 1
+	// all the vchannels of the DataNode are drained and reassigned, the DataNode is ready to terminate
+	DecommissionState_DecommissionReady  DecommissionState = 2
+	DecommissionState_DecommissionFailed DecommissionState = 3
+)
+
+var DecommissionState_name = map[int32]string{
+	0: "DecommissionNone",
+	1: "DecommissionDraining",
+	2: "DecommissionReady",
+	3: "DecommissionFailed",
+}
+
+var DecommissionState_value = map[string]int32{
+	"DecommissionNone":     0,
+	"DecommissionDraining": 1,
+	"DecommissionReady":    2,
+	"DecommissionFailed":   3,
+}
+
+func (x DecommissionState) String() string {
+	return proto.EnumName(DecommissionState_name, int32(x))
+}
+
+func (DecommissionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{4}
+}
+
 // TODO: import google/protobuf/empty.proto
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1256,13 +1318,14 @@ func (m *AcquireSegmentLockRequest) GetTaskID() int64 {
 }
 
 type ReleaseSegmentLockRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	SegmentIDs           []int64           `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	TaskID               int64             `protobuf:"varint,4,opt,name=taskID,proto3" json:"taskID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// all the segment locks of the task are released if empty
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	TaskID               int64    `protobuf:"varint,4,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ReleaseSegmentLockRequest) Reset()         { *m = ReleaseSegmentLockRequest{} }
@@ -1318,6 +1381,219 @@ func (m *ReleaseSegmentLockRequest) GetTaskID() int64 {
 	return 0
 }
 
+type RenewSegmentLockRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	TaskID               int64             `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RenewSegmentLockRequest) Reset()         { *m = RenewSegmentLockRequest{} }
+func (m *RenewSegmentLockRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewSegmentLockRequest) ProtoMessage()    {}
+func (*RenewSegmentLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{21}
+}
+
+func (m *RenewSegmentLockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewSegmentLockRequest.Unmarshal(m, b)
+}
+func (m *RenewSegmentLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewSegmentLockRequest.Marshal(b, m, deterministic)
+}
+func (m *RenewSegmentLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewSegmentLockRequest.Merge(m, src)
+}
+func (m *RenewSegmentLockRequest) XXX_Size() int {
+	return xxx_messageInfo_RenewSegmentLockRequest.Size(m)
+}
+func (m *RenewSegmentLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewSegmentLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewSegmentLockRequest proto.InternalMessageInfo
+
+func (m *RenewSegmentLockRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RenewSegmentLockRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *RenewSegmentLockRequest) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type InspectSegmentLocksRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InspectSegmentLocksRequest) Reset()         { *m = InspectSegmentLocksRequest{} }
+func (m *InspectSegmentLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectSegmentLocksRequest) ProtoMessage()    {}
+func (*InspectSegmentLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{22}
+}
+
+func (m *InspectSegmentLocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InspectSegmentLocksRequest.Unmarshal(m, b)
+}
+func (m *InspectSegmentLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InspectSegmentLocksRequest.Marshal(b, m, deterministic)
+}
+func (m *InspectSegmentLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectSegmentLocksRequest.Merge(m, src)
+}
+func (m *InspectSegmentLocksRequest) XXX_Size() int {
+	return xxx_messageInfo_InspectSegmentLocksRequest.Size(m)
+}
+func (m *InspectSegmentLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectSegmentLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectSegmentLocksRequest proto.InternalMessageInfo
+
+func (m *InspectSegmentLocksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type SegmentLockLease struct {
+	NodeID     int64   `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	TaskID     int64   `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	SegmentIDs []int64 `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// unix time in milliseconds
+	AcquiredAt           int64    `protobuf:"varint,4,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	ExpireAt             int64    `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentLockLease) Reset()         { *m = SegmentLockLease{} }
+func (m *SegmentLockLease) String() string { return proto.CompactTextString(m) }
+func (*SegmentLockLease) ProtoMessage()    {}
+func (*SegmentLockLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{23}
+}
+
+func (m *SegmentLockLease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentLockLease.Unmarshal(m, b)
+}
+func (m *SegmentLockLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentLockLease.Marshal(b, m, deterministic)
+}
+func (m *SegmentLockLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentLockLease.Merge(m, src)
+}
+func (m *SegmentLockLease) XXX_Size() int {
+	return xxx_messageInfo_SegmentLockLease.Size(m)
+}
+func (m *SegmentLockLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentLockLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentLockLease proto.InternalMessageInfo
+
+func (m *SegmentLockLease) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SegmentLockLease) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *SegmentLockLease) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *SegmentLockLease) GetAcquiredAt() int64 {
+	if m != nil {
+		return m.AcquiredAt
+	}
+	return 0
+}
+
+func (m *SegmentLockLease) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+type InspectSegmentLocksResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Leases               []*SegmentLockLease `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *InspectSegmentLocksResponse) Reset()         { *m = InspectSegmentLocksResponse{} }
+func (m *InspectSegmentLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*InspectSegmentLocksResponse) ProtoMessage()    {}
+func (*InspectSegmentLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{24}
+}
+
+func (m *InspectSegmentLocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InspectSegmentLocksResponse.Unmarshal(m, b)
+}
+func (m *InspectSegmentLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InspectSegmentLocksResponse.Marshal(b, m, deterministic)
+}
+func (m *InspectSegmentLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectSegmentLocksResponse.Merge(m, src)
+}
+func (m *InspectSegmentLocksResponse) XXX_Size() int {
+	return xxx_messageInfo_InspectSegmentLocksResponse.Size(m)
+}
+func (m *InspectSegmentLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectSegmentLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectSegmentLocksResponse proto.InternalMessageInfo
+
+func (m *InspectSegmentLocksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *InspectSegmentLocksResponse) GetLeases() []*SegmentLockLease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
 type VchannelInfo struct {
 	CollectionID         int64                   `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	ChannelName          string                  `protobuf:"bytes,2,opt,name=channelName,proto3" json:"channelName,omitempty"`
@@ -1339,7 +1615,7 @@ func (m *VchannelInfo) Reset()         { *m = VchannelInfo{} }
 func (m *VchannelInfo) String() string { return proto.CompactTextString(m) }
 func (*VchannelInfo) ProtoMessage()    {}
 func (*VchannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{21}
+	return fileDescriptor_82cd95f524594f49, []int{25}
 }
 
 func (m *VchannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1449,7 +1725,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{22}
+	return fileDescriptor_82cd95f524594f49, []int{26}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1498,7 +1774,7 @@ func (m *FlushSegmentsRequest) Reset()         { *m = FlushSegmentsRequest{} }
 func (m *FlushSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushSegmentsRequest) ProtoMessage()    {}
 func (*FlushSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{23}
+	return fileDescriptor_82cd95f524594f49, []int{27}
 }
 
 func (m *FlushSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1559,7 +1835,7 @@ func (m *SegmentMsg) Reset()         { *m = SegmentMsg{} }
 func (m *SegmentMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentMsg) ProtoMessage()    {}
 func (*SegmentMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{24}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *SegmentMsg) XXX_Unmarshal(b []byte) error {
@@ -1627,7 +1903,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{25}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1786,7 +2062,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{26}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1842,7 +2118,7 @@ func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{}
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1953,7 +2229,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -2010,7 +2286,7 @@ func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
 func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
 func (*DeltaLogInfo) ProtoMessage()    {}
 func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
@@ -2080,7 +2356,7 @@ func (m *DataNodeTtMsg) Reset()         { *m = DataNodeTtMsg{} }
 func (m *DataNodeTtMsg) String() string { return proto.CompactTextString(m) }
 func (*DataNodeTtMsg) ProtoMessage()    {}
 func (*DataNodeTtMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *DataNodeTtMsg) XXX_Unmarshal(b []byte) error {
@@ -2141,7 +2417,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2189,7 +2465,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -2244,7 +2520,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -2302,7 +2578,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2377,7 +2653,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2429,7 +2705,7 @@ func (m *Binlog) Reset()         { *m = Binlog{} }
 func (m *Binlog) String() string { return proto.CompactTextString(m) }
 func (*Binlog) ProtoMessage()    {}
 func (*Binlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *Binlog) XXX_Unmarshal(b []byte) error {
@@ -2493,19 +2769,23 @@ func (m *Binlog) GetLogID() int64 {
 }
 
 type GetRecoveryInfoResponse struct {
-	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
-	Binlogs              []*SegmentBinlogs `protobuf:"bytes,3,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Status   *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Channels []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	Binlogs  []*SegmentBinlogs `protobuf:"bytes,3,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
+	// the version of the response, absent if DataCoord doesn't cache the recovery info
+	Version *RecoveryInfoVersion `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// the binlogs are of the segments changed after the asked version only
+	Delta                bool     `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse{} }
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2547,20 +2827,37 @@ func (m *GetRecoveryInfoResponse) GetBinlogs() []*SegmentBinlogs {
 	return nil
 }
 
+func (m *GetRecoveryInfoResponse) GetVersion() *RecoveryInfoVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *GetRecoveryInfoResponse) GetDelta() bool {
+	if m != nil {
+		return m.Delta
+	}
+	return false
+}
+
 type GetRecoveryInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// the version of the recovery info kept by the client, DataCoord answers the segments changed after it only if the
+	// version is its own one
+	Since                *RecoveryInfoVersion `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{} }
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2602,6 +2899,61 @@ func (m *GetRecoveryInfoRequest) GetPartitionID() int64 {
 	return 0
 }
 
+func (m *GetRecoveryInfoRequest) GetSince() *RecoveryInfoVersion {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+// RecoveryInfoVersion identifies a GetRecoveryInfo response, the versions of a DataCoord are meaningless to the others
+type RecoveryInfoVersion struct {
+	ServerID             int64    `protobuf:"varint,1,opt,name=serverID,proto3" json:"serverID,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoveryInfoVersion) Reset()         { *m = RecoveryInfoVersion{} }
+func (m *RecoveryInfoVersion) String() string { return proto.CompactTextString(m) }
+func (*RecoveryInfoVersion) ProtoMessage()    {}
+func (*RecoveryInfoVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{43}
+}
+
+func (m *RecoveryInfoVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoveryInfoVersion.Unmarshal(m, b)
+}
+func (m *RecoveryInfoVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoveryInfoVersion.Marshal(b, m, deterministic)
+}
+func (m *RecoveryInfoVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryInfoVersion.Merge(m, src)
+}
+func (m *RecoveryInfoVersion) XXX_Size() int {
+	return xxx_messageInfo_RecoveryInfoVersion.Size(m)
+}
+func (m *RecoveryInfoVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryInfoVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryInfoVersion proto.InternalMessageInfo
+
+func (m *RecoveryInfoVersion) GetServerID() int64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *RecoveryInfoVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type GetSegmentsByStatesRequest struct {
 	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64                   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -2616,7 +2968,7 @@ func (m *GetSegmentsByStatesRequest) Reset()         { *m = GetSegmentsByStatesR
 func (m *GetSegmentsByStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentsByStatesRequest) ProtoMessage()    {}
 func (*GetSegmentsByStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{44}
 }
 
 func (m *GetSegmentsByStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -2677,7 +3029,7 @@ func (m *GetSegmentsByStatesResponse) Reset()         { *m = GetSegmentsByStates
 func (m *GetSegmentsByStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentsByStatesResponse) ProtoMessage()    {}
 func (*GetSegmentsByStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
 func (m *GetSegmentsByStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -2713,20 +3065,24 @@ func (m *GetSegmentsByStatesResponse) GetSegments() []int64 {
 }
 
 type GetFlushedSegmentsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	IncludeUnhealthy     bool              `protobuf:"varint,4,opt,name=includeUnhealthy,proto3" json:"includeUnhealthy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base             *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID     int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID      int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	IncludeUnhealthy bool              `protobuf:"varint,4,opt,name=includeUnhealthy,proto3" json:"includeUnhealthy,omitempty"`
+	// asks for a page of at most page_size segment ids in ascending order, 0 asks for all the segment ids
+	PageSize int64 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the page starts at the first segment id not less than the cursor
+	Cursor               int64    `protobuf:"varint,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsRequest{} }
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2775,19 +3131,35 @@ func (m *GetFlushedSegmentsRequest) GetIncludeUnhealthy() bool {
 	return false
 }
 
+func (m *GetFlushedSegmentsRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetFlushedSegmentsRequest) GetCursor() int64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
 type GetFlushedSegmentsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Segments             []int64          `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments []int64          `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	// more segment ids follow the page, the next page starts after its last segment id
+	HasMore              bool     `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsResponse{} }
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{47}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2822,6 +3194,13 @@ func (m *GetFlushedSegmentsResponse) GetSegments() []int64 {
 	return nil
 }
 
+func (m *GetFlushedSegmentsResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
 type SegmentFlushCompletedMsg struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Segment              *SegmentInfo      `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
@@ -2834,7 +3213,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2887,7 +3266,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{49}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2954,7 +3333,7 @@ func (m *CompactionStateRequest) Reset()         { *m = CompactionStateRequest{}
 func (m *CompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateRequest) ProtoMessage()    {}
 func (*CompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{45}
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
 func (m *CompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2997,7 +3376,7 @@ func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
 func (m *SyncSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncSegmentsRequest) ProtoMessage()    {}
 func (*SyncSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{46}
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
 func (m *SyncSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -3068,7 +3447,7 @@ func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlo
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{47}
+	return fileDescriptor_82cd95f524594f49, []int{52}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -3143,7 +3522,7 @@ func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{48}
+	return fileDescriptor_82cd95f524594f49, []int{53}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -3244,7 +3623,7 @@ func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{49}
+	return fileDescriptor_82cd95f524594f49, []int{54}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -3327,7 +3706,7 @@ func (m *CompactionStateResult) Reset()         { *m = CompactionStateResult{} }
 func (m *CompactionStateResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResult) ProtoMessage()    {}
 func (*CompactionStateResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{50}
+	return fileDescriptor_82cd95f524594f49, []int{55}
 }
 
 func (m *CompactionStateResult) XXX_Unmarshal(b []byte) error {
@@ -3381,7 +3760,7 @@ func (m *CompactionStateResponse) Reset()         { *m = CompactionStateResponse
 func (m *CompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResponse) ProtoMessage()    {}
 func (*CompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{51}
+	return fileDescriptor_82cd95f524594f49, []int{56}
 }
 
 func (m *CompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3429,7 +3808,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{52}
+	return fileDescriptor_82cd95f524594f49, []int{57}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -3478,7 +3857,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{53}
+	return fileDescriptor_82cd95f524594f49, []int{58}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -3538,7 +3917,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{54}
+	return fileDescriptor_82cd95f524594f49, []int{59}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -3579,7 +3958,7 @@ func (m *SetSegmentStateRequest) Reset()         { *m = SetSegmentStateRequest{}
 func (m *SetSegmentStateRequest) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateRequest) ProtoMessage()    {}
 func (*SetSegmentStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{55}
+	return fileDescriptor_82cd95f524594f49, []int{60}
 }
 
 func (m *SetSegmentStateRequest) XXX_Unmarshal(b []byte) error {
@@ -3632,7 +4011,7 @@ func (m *SetSegmentStateResponse) Reset()         { *m = SetSegmentStateResponse
 func (m *SetSegmentStateResponse) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateResponse) ProtoMessage()    {}
 func (*SetSegmentStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{56}
+	return fileDescriptor_82cd95f524594f49, []int{61}
 }
 
 func (m *SetSegmentStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3673,7 +4052,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{57}
+	return fileDescriptor_82cd95f524594f49, []int{62}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3733,7 +4112,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{58}
+	return fileDescriptor_82cd95f524594f49, []int{63}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -3821,7 +4200,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{59}
+	return fileDescriptor_82cd95f524594f49, []int{64}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -3867,7 +4246,7 @@ func (m *ImportTask) Reset()         { *m = ImportTask{} }
 func (m *ImportTask) String() string { return proto.CompactTextString(m) }
 func (*ImportTask) ProtoMessage()    {}
 func (*ImportTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{60}
+	return fileDescriptor_82cd95f524594f49, []int{65}
 }
 
 func (m *ImportTask) XXX_Unmarshal(b []byte) error {
@@ -3959,7 +4338,7 @@ func (m *ImportTaskState) Reset()         { *m = ImportTaskState{} }
 func (m *ImportTaskState) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskState) ProtoMessage()    {}
 func (*ImportTaskState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{61}
+	return fileDescriptor_82cd95f524594f49, []int{66}
 }
 
 func (m *ImportTaskState) XXX_Unmarshal(b []byte) error {
@@ -4040,7 +4419,7 @@ func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
 func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskInfo) ProtoMessage()    {}
 func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{62}
+	return fileDescriptor_82cd95f524594f49, []int{67}
 }
 
 func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
@@ -4179,7 +4558,7 @@ func (m *ImportTaskResponse) Reset()         { *m = ImportTaskResponse{} }
 func (m *ImportTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskResponse) ProtoMessage()    {}
 func (*ImportTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{63}
+	return fileDescriptor_82cd95f524594f49, []int{68}
 }
 
 func (m *ImportTaskResponse) XXX_Unmarshal(b []byte) error {
@@ -4227,7 +4606,7 @@ func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
 func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskRequest) ProtoMessage()    {}
 func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{64}
+	return fileDescriptor_82cd95f524594f49, []int{69}
 }
 
 func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -4269,6 +4648,246 @@ func (m *ImportTaskRequest) GetWorkingNodes() []int64 {
 	return nil
 }
 
+type PreImportRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// the collection_name is resolved to the collectionID by the proxy
+	CollectionName string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID   int64    `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Files          []string `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	// the options of the import request, e.g. backup
+	Options              []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PreImportRequest) Reset()         { *m = PreImportRequest{} }
+func (m *PreImportRequest) String() string { return proto.CompactTextString(m) }
+func (*PreImportRequest) ProtoMessage()    {}
+func (*PreImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{70}
+}
+
+func (m *PreImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreImportRequest.Unmarshal(m, b)
+}
+func (m *PreImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreImportRequest.Marshal(b, m, deterministic)
+}
+func (m *PreImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreImportRequest.Merge(m, src)
+}
+func (m *PreImportRequest) XXX_Size() int {
+	return xxx_messageInfo_PreImportRequest.Size(m)
+}
+func (m *PreImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreImportRequest proto.InternalMessageInfo
+
+func (m *PreImportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PreImportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *PreImportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *PreImportRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PreImportRequest) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *PreImportRequest) GetOptions() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type PreImportFileReport struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	EstimatedRows        int64    `protobuf:"varint,3,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreImportFileReport) Reset()         { *m = PreImportFileReport{} }
+func (m *PreImportFileReport) String() string { return proto.CompactTextString(m) }
+func (*PreImportFileReport) ProtoMessage()    {}
+func (*PreImportFileReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{71}
+}
+
+func (m *PreImportFileReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreImportFileReport.Unmarshal(m, b)
+}
+func (m *PreImportFileReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreImportFileReport.Marshal(b, m, deterministic)
+}
+func (m *PreImportFileReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreImportFileReport.Merge(m, src)
+}
+func (m *PreImportFileReport) XXX_Size() int {
+	return xxx_messageInfo_PreImportFileReport.Size(m)
+}
+func (m *PreImportFileReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreImportFileReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreImportFileReport proto.InternalMessageInfo
+
+func (m *PreImportFileReport) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PreImportFileReport) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PreImportFileReport) GetEstimatedRows() int64 {
+	if m != nil {
+		return m.EstimatedRows
+	}
+	return 0
+}
+
+func (m *PreImportFileReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PreImportResponse struct {
+	Status            *commonpb.Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RowBased          bool                   `protobuf:"varint,2,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	Backup            bool                   `protobuf:"varint,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Files             []*PreImportFileReport `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	EstimatedRows     int64                  `protobuf:"varint,5,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	EstimatedBytes    int64                  `protobuf:"varint,6,opt,name=estimated_bytes,json=estimatedBytes,proto3" json:"estimated_bytes,omitempty"`
+	EstimatedSegments int64                  `protobuf:"varint,7,opt,name=estimated_segments,json=estimatedSegments,proto3" json:"estimated_segments,omitempty"`
+	// the import is expected to fail if there is any error
+	Errors               []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreImportResponse) Reset()         { *m = PreImportResponse{} }
+func (m *PreImportResponse) String() string { return proto.CompactTextString(m) }
+func (*PreImportResponse) ProtoMessage()    {}
+func (*PreImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{72}
+}
+
+func (m *PreImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreImportResponse.Unmarshal(m, b)
+}
+func (m *PreImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreImportResponse.Marshal(b, m, deterministic)
+}
+func (m *PreImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreImportResponse.Merge(m, src)
+}
+func (m *PreImportResponse) XXX_Size() int {
+	return xxx_messageInfo_PreImportResponse.Size(m)
+}
+func (m *PreImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreImportResponse proto.InternalMessageInfo
+
+func (m *PreImportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PreImportResponse) GetRowBased() bool {
+	if m != nil {
+		return m.RowBased
+	}
+	return false
+}
+
+func (m *PreImportResponse) GetBackup() bool {
+	if m != nil {
+		return m.Backup
+	}
+	return false
+}
+
+func (m *PreImportResponse) GetFiles() []*PreImportFileReport {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *PreImportResponse) GetEstimatedRows() int64 {
+	if m != nil {
+		return m.EstimatedRows
+	}
+	return 0
+}
+
+func (m *PreImportResponse) GetEstimatedBytes() int64 {
+	if m != nil {
+		return m.EstimatedBytes
+	}
+	return 0
+}
+
+func (m *PreImportResponse) GetEstimatedSegments() int64 {
+	if m != nil {
+		return m.EstimatedSegments
+	}
+	return 0
+}
+
+func (m *PreImportResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 type UpdateSegmentStatisticsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Stats                []*SegmentStats   `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
@@ -4281,7 +4900,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{65}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -4329,7 +4948,7 @@ func (m *UpdateChannelCheckpointRequest) Reset()         { *m = UpdateChannelChe
 func (m *UpdateChannelCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateChannelCheckpointRequest) ProtoMessage()    {}
 func (*UpdateChannelCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{66}
+	return fileDescriptor_82cd95f524594f49, []int{74}
 }
 
 func (m *UpdateChannelCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -4382,7 +5001,7 @@ func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsReq
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{67}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4422,7 +5041,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{68}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4474,7 +5093,7 @@ func (m *AddImportSegmentRequest) Reset()         { *m = AddImportSegmentRequest
 func (m *AddImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentRequest) ProtoMessage()    {}
 func (*AddImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
 
 func (m *AddImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4556,7 +5175,7 @@ func (m *AddImportSegmentResponse) Reset()         { *m = AddImportSegmentRespon
 func (m *AddImportSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentResponse) ProtoMessage()    {}
 func (*AddImportSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{78}
 }
 
 func (m *AddImportSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -4609,7 +5228,7 @@ func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentReque
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{79}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4698,7 +5317,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{80}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4745,7 +5364,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{73}
+	return fileDescriptor_82cd95f524594f49, []int{81}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4793,7 +5412,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{82}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4850,7 +5469,7 @@ func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{}
 func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterCollectionRequest) ProtoMessage()    {}
 func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{75}
+	return fileDescriptor_82cd95f524594f49, []int{83}
 }
 
 func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -4931,7 +5550,7 @@ func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
 func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexInfo) ProtoMessage()    {}
 func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{76}
+	return fileDescriptor_82cd95f524594f49, []int{84}
 }
 
 func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
@@ -5049,7 +5668,7 @@ func (m *FieldIndex) Reset()         { *m = FieldIndex{} }
 func (m *FieldIndex) String() string { return proto.CompactTextString(m) }
 func (*FieldIndex) ProtoMessage()    {}
 func (*FieldIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{77}
+	return fileDescriptor_82cd95f524594f49, []int{85}
 }
 
 func (m *FieldIndex) XXX_Unmarshal(b []byte) error {
@@ -5116,7 +5735,7 @@ func (m *SegmentIndex) Reset()         { *m = SegmentIndex{} }
 func (m *SegmentIndex) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndex) ProtoMessage()    {}
 func (*SegmentIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{78}
+	return fileDescriptor_82cd95f524594f49, []int{86}
 }
 
 func (m *SegmentIndex) XXX_Unmarshal(b []byte) error {
@@ -5254,7 +5873,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{79}
+	return fileDescriptor_82cd95f524594f49, []int{87}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -5302,7 +5921,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{80}
+	return fileDescriptor_82cd95f524594f49, []int{88}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -5357,7 +5976,7 @@ func (m *GetSegmentIndexStateRequest) Reset()         { *m = GetSegmentIndexStat
 func (m *GetSegmentIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentIndexStateRequest) ProtoMessage()    {}
 func (*GetSegmentIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{81}
+	return fileDescriptor_82cd95f524594f49, []int{89}
 }
 
 func (m *GetSegmentIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -5412,7 +6031,7 @@ func (m *SegmentIndexState) Reset()         { *m = SegmentIndexState{} }
 func (m *SegmentIndexState) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexState) ProtoMessage()    {}
 func (*SegmentIndexState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{82}
+	return fileDescriptor_82cd95f524594f49, []int{90}
 }
 
 func (m *SegmentIndexState) XXX_Unmarshal(b []byte) error {
@@ -5466,7 +6085,7 @@ func (m *GetSegmentIndexStateResponse) Reset()         { *m = GetSegmentIndexSta
 func (m *GetSegmentIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentIndexStateResponse) ProtoMessage()    {}
 func (*GetSegmentIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{83}
+	return fileDescriptor_82cd95f524594f49, []int{91}
 }
 
 func (m *GetSegmentIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -5519,7 +6138,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{84}
+	return fileDescriptor_82cd95f524594f49, []int{92}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -5609,7 +6228,7 @@ func (m *GetIndexInfoRequest) Reset()         { *m = GetIndexInfoRequest{} }
 func (m *GetIndexInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexInfoRequest) ProtoMessage()    {}
 func (*GetIndexInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{85}
+	return fileDescriptor_82cd95f524594f49, []int{93}
 }
 
 func (m *GetIndexInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -5671,7 +6290,7 @@ func (m *IndexFilePathInfo) Reset()         { *m = IndexFilePathInfo{} }
 func (m *IndexFilePathInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexFilePathInfo) ProtoMessage()    {}
 func (*IndexFilePathInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{86}
+	return fileDescriptor_82cd95f524594f49, []int{94}
 }
 
 func (m *IndexFilePathInfo) XXX_Unmarshal(b []byte) error {
@@ -5776,7 +6395,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{87}
+	return fileDescriptor_82cd95f524594f49, []int{95}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -5837,7 +6456,7 @@ func (m *GetIndexInfoResponse) Reset()         { *m = GetIndexInfoResponse{} }
 func (m *GetIndexInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexInfoResponse) ProtoMessage()    {}
 func (*GetIndexInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{88}
+	return fileDescriptor_82cd95f524594f49, []int{96}
 }
 
 func (m *GetIndexInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -5886,7 +6505,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{89}
+	return fileDescriptor_82cd95f524594f49, []int{97}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -5947,7 +6566,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{90}
+	return fileDescriptor_82cd95f524594f49, []int{98}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -5994,7 +6613,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{91}
+	return fileDescriptor_82cd95f524594f49, []int{99}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -6041,7 +6660,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{92}
+	return fileDescriptor_82cd95f524594f49, []int{100}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -6089,7 +6708,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{93}
+	return fileDescriptor_82cd95f524594f49, []int{101}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -6131,1741 +6750,5719 @@ func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
-	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterMapType((map[string]*internalpb.MsgPosition)(nil), "milvus.proto.data.GetSegmentInfoResponse.ChannelCheckpointEntry")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*AcquireSegmentLockRequest)(nil), "milvus.proto.data.AcquireSegmentLockRequest")
-	proto.RegisterType((*ReleaseSegmentLockRequest)(nil), "milvus.proto.data.ReleaseSegmentLockRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetSegmentsByStatesRequest)(nil), "milvus.proto.data.GetSegmentsByStatesRequest")
-	proto.RegisterType((*GetSegmentsByStatesResponse)(nil), "milvus.proto.data.GetSegmentsByStatesResponse")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*CompactionStateRequest)(nil), "milvus.proto.data.CompactionStateRequest")
-	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
-	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
-	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
-	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
-	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
-	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
-	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
-	proto.RegisterType((*SetSegmentStateRequest)(nil), "milvus.proto.data.SetSegmentStateRequest")
-	proto.RegisterType((*SetSegmentStateResponse)(nil), "milvus.proto.data.SetSegmentStateResponse")
-	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
-	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
-	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
-	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
-	proto.RegisterType((*ImportTaskState)(nil), "milvus.proto.data.ImportTaskState")
-	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
-	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
-	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
-	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
-	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
-	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
-	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
-	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
-	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
-	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
-	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
-	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
-	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
-	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
-	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.data.IndexInfo")
-	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.data.FieldIndex")
-	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.data.SegmentIndex")
-	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.data.GetIndexStateRequest")
-	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.data.GetIndexStateResponse")
-	proto.RegisterType((*GetSegmentIndexStateRequest)(nil), "milvus.proto.data.GetSegmentIndexStateRequest")
-	proto.RegisterType((*SegmentIndexState)(nil), "milvus.proto.data.SegmentIndexState")
-	proto.RegisterType((*GetSegmentIndexStateResponse)(nil), "milvus.proto.data.GetSegmentIndexStateResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.data.CreateIndexRequest")
-	proto.RegisterType((*GetIndexInfoRequest)(nil), "milvus.proto.data.GetIndexInfoRequest")
-	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.data.IndexFilePathInfo")
-	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.data.SegmentIndexInfo")
-	proto.RegisterType((*GetIndexInfoResponse)(nil), "milvus.proto.data.GetIndexInfoResponse")
-	proto.RegisterMapType((map[int64]*SegmentIndexInfo)(nil), "milvus.proto.data.GetIndexInfoResponse.SegmentInfoEntry")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.data.DropIndexRequest")
-	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.data.DescribeIndexRequest")
-	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.data.DescribeIndexResponse")
-	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.data.GetIndexBuildProgressRequest")
-	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.data.GetIndexBuildProgressResponse")
+// DeprecatedSegmentIndex is a finished segment index built by a deprecated engine or file format version.
+type DeprecatedSegmentIndex struct {
+	CollectionID int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID    int64 `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	IndexID      int64 `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID      int64 `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	// the knowhere version building the index, empty if not recorded
+	EngineVersion        string   `protobuf:"bytes,6,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	FormatVersion        int32    `protobuf:"varint,7,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
-
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 5429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x49, 0x8c, 0x1b, 0x57,
-	0x7a, 0xb0, 0x8a, 0x5b, 0x93, 0x1f, 0xd9, 0x6c, 0xf6, 0x93, 0xdc, 0xa2, 0x68, 0x6b, 0x2b, 0xad,
-	0xd6, 0xd8, 0x92, 0x2d, 0xff, 0xc6, 0x68, 0x46, 0xb6, 0xe7, 0x57, 0xab, 0x25, 0x99, 0x19, 0xb5,
-	0xdc, 0x53, 0xdd, 0xb2, 0x91, 0x71, 0x80, 0x42, 0x35, 0xeb, 0x75, 0x77, 0x4d, 0x17, 0xab, 0xa8,
-	0xaa, 0x62, 0xb7, 0xda, 0x09, 0x30, 0xce, 0x0a, 0x38, 0x99, 0x24, 0x93, 0x20, 0xeb, 0x21, 0x40,
-	0x10, 0xe4, 0x30, 0x99, 0x60, 0x92, 0x00, 0x83, 0x5c, 0x72, 0x48, 0xae, 0x46, 0x72, 0x18, 0x04,
-	0x01, 0x72, 0xcc, 0x31, 0xc9, 0x3d, 0x87, 0x5c, 0x72, 0x08, 0xde, 0x52, 0xaf, 0xb6, 0x47, 0xb2,
-	0x48, 0xb6, 0xec, 0x20, 0xb9, 0xf1, 0x7d, 0xfc, 0xde, 0xfa, 0xed, 0xdf, 0xfb, 0x5e, 0x41, 0xcb,
-	0x34, 0x02, 0x43, 0xef, 0xb9, 0xae, 0x67, 0xde, 0x1c, 0x78, 0x6e, 0xe0, 0xa2, 0xe5, 0xbe, 0x65,
-	0x1f, 0x0c, 0x7d, 0xd6, 0xba, 0x49, 0xfe, 0xee, 0x34, 0x7a, 0x6e, 0xbf, 0xef, 0x3a, 0x0c, 0xd4,
-	0x69, 0x5a, 0x4e, 0x80, 0x3d, 0xc7, 0xb0, 0x79, 0xbb, 0x11, 0xef, 0xd0, 0x69, 0xf8, 0xbd, 0x3d,
-	0xdc, 0x37, 0x58, 0x4b, 0x5d, 0x80, 0xf2, 0x83, 0xfe, 0x20, 0x38, 0x52, 0xff, 0x40, 0x81, 0xc6,
-	0x43, 0x7b, 0xe8, 0xef, 0x69, 0xf8, 0xd9, 0x10, 0xfb, 0x01, 0x7a, 0x03, 0x4a, 0xdb, 0x86, 0x8f,
-	0xdb, 0xca, 0x05, 0xe5, 0x7a, 0xfd, 0xf6, 0x2b, 0x37, 0x13, 0xb3, 0xf2, 0xf9, 0xd6, 0xfd, 0xdd,
-	0x55, 0xc3, 0xc7, 0x1a, 0xc5, 0x44, 0x08, 0x4a, 0xe6, 0x76, 0x77, 0xad, 0x5d, 0xb8, 0xa0, 0x5c,
-	0x2f, 0x6a, 0xf4, 0x37, 0x3a, 0x07, 0xe0, 0xe3, 0xdd, 0x3e, 0x76, 0x82, 0xee, 0x9a, 0xdf, 0x2e,
-	0x5e, 0x28, 0x5e, 0x2f, 0x6a, 0x31, 0x08, 0x52, 0xa1, 0xd1, 0x73, 0x6d, 0x1b, 0xf7, 0x02, 0xcb,
-	0x75, 0xba, 0x6b, 0xed, 0x12, 0xed, 0x9b, 0x80, 0xa9, 0xff, 0xaa, 0xc0, 0x22, 0x5f, 0x9a, 0x3f,
-	0x70, 0x1d, 0x1f, 0xa3, 0xb7, 0xa0, 0xe2, 0x07, 0x46, 0x30, 0xf4, 0xf9, 0xea, 0x5e, 0x96, 0xae,
-	0x6e, 0x93, 0xa2, 0x68, 0x1c, 0x55, 0xba, 0xbc, 0xf4, 0xf4, 0xc5, 0xec, 0xf4, 0xa9, 0x2d, 0x94,
-	0x32, 0x5b, 0xb8, 0x0e, 0x4b, 0x3b, 0x64, 0x75, 0x9b, 0x11, 0x52, 0x99, 0x22, 0xa5, 0xc1, 0x64,
-	0xa4, 0xc0, 0xea, 0xe3, 0x0f, 0x76, 0x36, 0xb1, 0x61, 0xb7, 0x2b, 0x74, 0xae, 0x18, 0x44, 0xfd,
-	0x47, 0x05, 0x5a, 0x02, 0x3d, 0xa4, 0xc3, 0x29, 0x28, 0xf7, 0xdc, 0xa1, 0x13, 0xd0, 0xad, 0x2e,
-	0x6a, 0xac, 0x81, 0x2e, 0x42, 0xa3, 0xb7, 0x67, 0x38, 0x0e, 0xb6, 0x75, 0xc7, 0xe8, 0x63, 0xba,
-	0xa9, 0x9a, 0x56, 0xe7, 0xb0, 0x27, 0x46, 0x1f, 0xe7, 0xda, 0xdb, 0x05, 0xa8, 0x0f, 0x0c, 0x2f,
-	0xb0, 0x12, 0xa7, 0x1f, 0x07, 0xa1, 0x0e, 0x54, 0x2d, 0xbf, 0xdb, 0x1f, 0xb8, 0x5e, 0xd0, 0x2e,
-	0x5f, 0x50, 0xae, 0x57, 0x35, 0xd1, 0x26, 0x33, 0x58, 0xf4, 0xd7, 0x96, 0xe1, 0xef, 0x77, 0xd7,
-	0xf8, 0x8e, 0x12, 0x30, 0xf5, 0x8f, 0x15, 0x58, 0xb9, 0xe7, 0xfb, 0xd6, 0xae, 0x93, 0xd9, 0xd9,
-	0x0a, 0x54, 0x1c, 0xd7, 0xc4, 0xdd, 0x35, 0xba, 0xb5, 0xa2, 0xc6, 0x5b, 0xe8, 0x65, 0xa8, 0x0d,
-	0x30, 0xf6, 0x74, 0xcf, 0xb5, 0xc3, 0x8d, 0x55, 0x09, 0x40, 0x73, 0x6d, 0x8c, 0xbe, 0x05, 0xcb,
-	0x7e, 0x6a, 0x20, 0xc6, 0x57, 0xf5, 0xdb, 0x97, 0x6e, 0x66, 0x24, 0xe3, 0x66, 0x7a, 0x52, 0x2d,
-	0xdb, 0x5b, 0xfd, 0xb4, 0x00, 0x27, 0x05, 0x1e, 0x5b, 0x2b, 0xf9, 0x4d, 0x4e, 0xde, 0xc7, 0xbb,
-	0x62, 0x79, 0xac, 0x91, 0xe7, 0xe4, 0x05, 0xc9, 0x8a, 0x71, 0x92, 0xe5, 0x60, 0xf5, 0x34, 0x3d,
-	0xca, 0x59, 0x7a, 0x9c, 0x87, 0x3a, 0x7e, 0x3e, 0xb0, 0x3c, 0xac, 0x13, 0xc6, 0xa1, 0x47, 0x5e,
-	0xd2, 0x80, 0x81, 0xb6, 0xac, 0x7e, 0x5c, 0x36, 0x16, 0x72, 0xcb, 0x86, 0xfa, 0x27, 0x0a, 0x9c,
-	0xce, 0x50, 0x89, 0x0b, 0x9b, 0x06, 0x2d, 0xba, 0xf3, 0xe8, 0x64, 0x88, 0xd8, 0x91, 0x03, 0xbf,
-	0x3a, 0xee, 0xc0, 0x23, 0x74, 0x2d, 0xd3, 0x3f, 0xb6, 0xc8, 0x42, 0xfe, 0x45, 0xee, 0xc3, 0xe9,
-	0x47, 0x38, 0xe0, 0x13, 0x90, 0xff, 0xb0, 0x3f, 0xbb, 0xb2, 0x4a, 0x4a, 0x75, 0x21, 0x2d, 0xd5,
-	0xea, 0x5f, 0x15, 0x84, 0x2c, 0xd2, 0xa9, 0xba, 0xce, 0x8e, 0x8b, 0x5e, 0x81, 0x9a, 0x40, 0xe1,
-	0x5c, 0x11, 0x01, 0xd0, 0x57, 0xa1, 0x4c, 0x56, 0xca, 0x58, 0xa2, 0x79, 0xfb, 0xa2, 0x7c, 0x4f,
-	0xb1, 0x31, 0x35, 0x86, 0x8f, 0xba, 0xd0, 0xf4, 0x03, 0xc3, 0x0b, 0xf4, 0x81, 0xeb, 0x53, 0x3a,
-	0x53, 0xc6, 0xa9, 0xdf, 0x56, 0x93, 0x23, 0x08, 0xb5, 0xbe, 0xee, 0xef, 0x6e, 0x70, 0x4c, 0x6d,
-	0x91, 0xf6, 0x0c, 0x9b, 0xe8, 0x01, 0x34, 0xb0, 0x63, 0x46, 0x03, 0x95, 0x72, 0x0f, 0x54, 0xc7,
-	0x8e, 0x29, 0x86, 0x89, 0xe8, 0x53, 0xce, 0x4f, 0x9f, 0xef, 0x29, 0xd0, 0xce, 0x12, 0x68, 0x1e,
-	0x95, 0x7d, 0x97, 0x75, 0xc2, 0x8c, 0x40, 0x63, 0x25, 0x5c, 0x10, 0x49, 0xe3, 0x5d, 0xd4, 0xdf,
-	0x55, 0xe0, 0xa5, 0x68, 0x39, 0xf4, 0xaf, 0x17, 0xc5, 0x2d, 0xe8, 0x06, 0xb4, 0x2c, 0xa7, 0x67,
-	0x0f, 0x4d, 0xfc, 0xd4, 0x79, 0x1f, 0x1b, 0x76, 0xb0, 0x77, 0x44, 0x69, 0x58, 0xd5, 0x32, 0x70,
-	0xf5, 0x5f, 0x0a, 0xb0, 0x92, 0x5e, 0xd7, 0x3c, 0x87, 0xf4, 0xff, 0xa0, 0x6c, 0x39, 0x3b, 0x6e,
-	0x78, 0x46, 0xe7, 0xc6, 0x08, 0x25, 0x99, 0x8b, 0x21, 0x23, 0x17, 0x50, 0xa8, 0xc6, 0x7a, 0x7b,
-	0xb8, 0xb7, 0x3f, 0x70, 0x2d, 0xaa, 0xb0, 0xc8, 0x10, 0xff, 0x5f, 0x32, 0x84, 0x7c, 0xc5, 0x37,
-	0xef, 0xb3, 0x31, 0xee, 0x8b, 0x21, 0x1e, 0x38, 0x81, 0x77, 0xa4, 0x2d, 0xf7, 0xd2, 0xf0, 0xce,
-	0x1e, 0xac, 0xc8, 0x91, 0x51, 0x0b, 0x8a, 0xfb, 0xf8, 0x88, 0x6e, 0xb9, 0xa6, 0x91, 0x9f, 0xe8,
-	0x0e, 0x94, 0x0f, 0x0c, 0x7b, 0x88, 0xb9, 0x76, 0xc8, 0xc3, 0xbe, 0xac, 0xc3, 0xd7, 0x0b, 0x77,
-	0x14, 0xb5, 0x0f, 0x2f, 0x3f, 0xc2, 0x41, 0xd7, 0xf1, 0xb1, 0x17, 0xac, 0x5a, 0x8e, 0xed, 0xee,
-	0x6e, 0x18, 0xc1, 0xde, 0x1c, 0xba, 0x22, 0x21, 0xf6, 0x85, 0x94, 0xd8, 0xab, 0x3f, 0x50, 0xe0,
-	0x15, 0xf9, 0x7c, 0x9c, 0xaa, 0x1d, 0xa8, 0xee, 0x58, 0xd8, 0x36, 0x09, 0xeb, 0x28, 0x94, 0x75,
-	0x44, 0x9b, 0xe8, 0x8c, 0x01, 0x41, 0xe6, 0xc4, 0xbb, 0x38, 0x62, 0xa7, 0x9b, 0x81, 0x67, 0x39,
-	0xbb, 0x8f, 0x2d, 0x3f, 0xd0, 0x18, 0x7e, 0x8c, 0x55, 0x8a, 0xf9, 0x25, 0xf4, 0x57, 0x15, 0x38,
-	0xf7, 0x08, 0x07, 0xf7, 0x85, 0xc9, 0x21, 0xff, 0x5b, 0x7e, 0x60, 0xf5, 0xfc, 0xe3, 0x75, 0xfb,
-	0x72, 0xf8, 0x1e, 0xea, 0x6f, 0x2a, 0x70, 0x7e, 0xe4, 0x62, 0xf8, 0xd1, 0x71, 0x95, 0x1a, 0x1a,
-	0x1c, 0xb9, 0x4a, 0xfd, 0x26, 0x3e, 0xfa, 0x90, 0x10, 0x7f, 0xc3, 0xb0, 0x3c, 0xa6, 0x52, 0x67,
-	0x34, 0x30, 0x3f, 0x52, 0xe0, 0xec, 0x23, 0x1c, 0x6c, 0x84, 0xe6, 0xf6, 0x4b, 0x3c, 0x1d, 0x82,
-	0x13, 0x33, 0xfb, 0xa1, 0xdf, 0x99, 0x80, 0xa9, 0xbf, 0xc1, 0xc8, 0x29, 0x5d, 0xef, 0x97, 0x72,
-	0x80, 0xe7, 0xa8, 0x24, 0xc4, 0xf4, 0x04, 0x97, 0x78, 0x7e, 0x7c, 0xea, 0x1f, 0x29, 0x70, 0xe6,
-	0x5e, 0xef, 0xd9, 0xd0, 0xf2, 0x30, 0x47, 0x7a, 0xec, 0xf6, 0xf6, 0x67, 0x3f, 0xdc, 0xc8, 0x83,
-	0x2c, 0x24, 0x3c, 0xc8, 0x49, 0x51, 0xc7, 0x0a, 0x54, 0x02, 0xe6, 0xb2, 0x32, 0x27, 0x8c, 0xb7,
-	0xe8, 0xfa, 0x34, 0x6c, 0x63, 0xc3, 0xff, 0x9f, 0xb9, 0xbe, 0xcf, 0xca, 0xd0, 0xf8, 0x90, 0xab,
-	0x56, 0xea, 0x90, 0xa4, 0x39, 0x49, 0x91, 0xfb, 0x94, 0x31, 0xe7, 0x54, 0xe6, 0xaf, 0x3e, 0x82,
-	0x45, 0x1f, 0xe3, 0xfd, 0x59, 0xdc, 0x8f, 0x06, 0xe9, 0x28, 0xdc, 0x86, 0xc7, 0xb0, 0x3c, 0x74,
-	0x68, 0xd4, 0x83, 0x4d, 0x7e, 0x80, 0x8c, 0x73, 0x27, 0x9b, 0xa5, 0x6c, 0x47, 0xf4, 0x3e, 0x0f,
-	0xac, 0x62, 0x63, 0x95, 0x73, 0x8d, 0x95, 0xee, 0x86, 0xba, 0xd0, 0x32, 0x3d, 0x77, 0x30, 0xc0,
-	0xa6, 0xee, 0x87, 0x43, 0x55, 0xf2, 0x0d, 0xc5, 0xfb, 0x89, 0xa1, 0xde, 0x80, 0x93, 0xe9, 0x95,
-	0x76, 0x4d, 0xe2, 0x6b, 0x13, 0x1a, 0xca, 0xfe, 0x42, 0xaf, 0xc1, 0x72, 0x16, 0xbf, 0x4a, 0xf1,
-	0xb3, 0x7f, 0xa0, 0xd7, 0x01, 0xa5, 0x96, 0x4a, 0xd0, 0x6b, 0x0c, 0x3d, 0xb9, 0x18, 0x8e, 0x6e,
-	0x39, 0x26, 0x7e, 0x9e, 0x44, 0x07, 0x86, 0xce, 0xff, 0x89, 0xa1, 0x77, 0x89, 0x9f, 0x92, 0x40,
-	0xf7, 0xdb, 0xf5, 0x7c, 0x07, 0x91, 0x1c, 0xcc, 0x57, 0x3f, 0x53, 0x60, 0xe5, 0x23, 0x23, 0xe8,
-	0xed, 0xad, 0xf5, 0xb9, 0x94, 0xcf, 0xa1, 0x25, 0xdf, 0x85, 0xda, 0x01, 0xe7, 0xc8, 0xd0, 0x14,
-	0x9e, 0x97, 0x2c, 0x28, 0xce, 0xfb, 0x5a, 0xd4, 0x83, 0x04, 0x99, 0xa7, 0x1e, 0xc6, 0x82, 0xed,
-	0x2f, 0x41, 0x5f, 0x4f, 0xc8, 0x12, 0xa8, 0xcf, 0x01, 0xf8, 0xe2, 0xd6, 0xfd, 0xdd, 0x19, 0xd6,
-	0x75, 0x07, 0x16, 0xf8, 0x68, 0x5c, 0x21, 0x4f, 0x22, 0x58, 0x88, 0xae, 0xfe, 0xb0, 0x02, 0xf5,
-	0xd8, 0x1f, 0xa8, 0x09, 0x05, 0xa1, 0x29, 0x0a, 0x92, 0xdd, 0x15, 0x26, 0xc7, 0xa5, 0xc5, 0x6c,
-	0x5c, 0x7a, 0x05, 0x9a, 0x16, 0xf5, 0x80, 0x74, 0x4e, 0x15, 0xaa, 0xba, 0x6a, 0xda, 0x22, 0x83,
-	0x72, 0x16, 0x41, 0xe7, 0xa0, 0xee, 0x0c, 0xfb, 0xba, 0xbb, 0xa3, 0x7b, 0xee, 0xa1, 0xcf, 0x03,
-	0xdc, 0x9a, 0x33, 0xec, 0x7f, 0xb0, 0xa3, 0xb9, 0x87, 0x7e, 0x14, 0x43, 0x55, 0xa6, 0x8c, 0xa1,
-	0xce, 0x41, 0xbd, 0x6f, 0x3c, 0x27, 0xa3, 0xea, 0xce, 0xb0, 0x4f, 0x63, 0xdf, 0xa2, 0x56, 0xeb,
-	0x1b, 0xcf, 0x35, 0xf7, 0xf0, 0xc9, 0xb0, 0x8f, 0xae, 0x43, 0xcb, 0x36, 0xfc, 0x40, 0x8f, 0x07,
-	0xcf, 0x55, 0x1a, 0x3c, 0x37, 0x09, 0xfc, 0x41, 0x14, 0x40, 0x67, 0xa3, 0xb1, 0xda, 0x1c, 0xd1,
-	0x98, 0xd9, 0xb7, 0xa3, 0x81, 0x20, 0x7f, 0x34, 0x66, 0xf6, 0x6d, 0x31, 0xcc, 0x1d, 0x58, 0xd8,
-	0xa6, 0x7e, 0xe5, 0x38, 0x61, 0x7d, 0x48, 0x5c, 0x4a, 0xe6, 0x7e, 0x6a, 0x21, 0x3a, 0x7a, 0x07,
-	0x6a, 0xd4, 0x9c, 0xd3, 0xbe, 0x8d, 0x5c, 0x7d, 0xa3, 0x0e, 0xa4, 0xb7, 0x89, 0xed, 0xc0, 0xa0,
-	0xbd, 0x17, 0xf3, 0xf5, 0x16, 0x1d, 0x88, 0xa6, 0xec, 0x79, 0xd8, 0x08, 0xb0, 0xb9, 0x7a, 0x74,
-	0xdf, 0xed, 0x0f, 0x0c, 0xca, 0x4c, 0xed, 0x26, 0x0d, 0x8b, 0x64, 0x7f, 0xa1, 0xab, 0xd0, 0xec,
-	0x89, 0xd6, 0x43, 0xcf, 0xed, 0xb7, 0x97, 0xa8, 0x1c, 0xa5, 0xa0, 0xe8, 0x2c, 0x40, 0xa8, 0x23,
-	0x8d, 0xa0, 0xdd, 0xa2, 0x54, 0xac, 0x71, 0xc8, 0x3d, 0x9a, 0x1b, 0xb3, 0x7c, 0x9d, 0x65, 0xa1,
-	0x2c, 0x67, 0xb7, 0xbd, 0x4c, 0x67, 0xac, 0x87, 0x69, 0x2b, 0xcb, 0xd9, 0x45, 0xa7, 0x61, 0xc1,
-	0xf2, 0xf5, 0x1d, 0x63, 0x1f, 0xb7, 0x11, 0xfd, 0xb7, 0x62, 0xf9, 0x0f, 0x8d, 0x7d, 0xac, 0x7e,
-	0x17, 0x4e, 0x45, 0xdc, 0x15, 0xa3, 0x64, 0x96, 0x29, 0x94, 0x59, 0x99, 0x62, 0x7c, 0x34, 0xf1,
-	0x93, 0x12, 0xac, 0x6c, 0x1a, 0x07, 0xf8, 0xc5, 0x07, 0x2e, 0xb9, 0xd4, 0xda, 0x63, 0x58, 0xa6,
-	0xb1, 0xca, 0xed, 0xd8, 0x7a, 0xc6, 0x58, 0xf4, 0x38, 0x2b, 0x64, 0x3b, 0xa2, 0x6f, 0x10, 0x57,
-	0x04, 0xf7, 0xf6, 0x37, 0x48, 0xf0, 0x17, 0x5a, 0xf3, 0xb3, 0x92, 0x71, 0xee, 0x0b, 0x2c, 0x2d,
-	0xde, 0x03, 0x6d, 0xc0, 0x52, 0x92, 0x0c, 0xa1, 0x1d, 0xbf, 0x36, 0x36, 0x33, 0x10, 0x9d, 0xbe,
-	0xd6, 0x4c, 0x10, 0xc3, 0x47, 0x6d, 0x58, 0xe0, 0x46, 0x98, 0xea, 0x8c, 0xaa, 0x16, 0x36, 0xd1,
-	0x06, 0x9c, 0x64, 0x3b, 0xd8, 0xe4, 0x02, 0xc1, 0x36, 0x5f, 0xcd, 0xb5, 0x79, 0x59, 0xd7, 0xa4,
-	0x3c, 0xd5, 0xa6, 0x95, 0xa7, 0x36, 0x2c, 0x70, 0x1e, 0xa7, 0x7a, 0xa4, 0xaa, 0x85, 0x4d, 0x42,
-	0xe6, 0x88, 0xdb, 0xeb, 0xf4, 0xbf, 0x08, 0x40, 0x82, 0x3e, 0x88, 0xce, 0x73, 0x42, 0x0e, 0xeb,
-	0x3d, 0xa8, 0x0a, 0x0e, 0xcf, 0x1f, 0x7c, 0x8b, 0x3e, 0x69, 0xfd, 0x5e, 0x4c, 0xe9, 0x77, 0xf5,
-	0x1f, 0x14, 0x68, 0xac, 0x91, 0x2d, 0x3d, 0x76, 0x77, 0xa9, 0x35, 0xba, 0x02, 0x4d, 0x0f, 0xf7,
-	0x5c, 0xcf, 0xd4, 0xb1, 0x13, 0x78, 0x16, 0x66, 0xa9, 0x8f, 0x92, 0xb6, 0xc8, 0xa0, 0x0f, 0x18,
-	0x90, 0xa0, 0x11, 0x95, 0xed, 0x07, 0x46, 0x7f, 0xa0, 0xef, 0x10, 0xd5, 0x50, 0x60, 0x68, 0x02,
-	0x4a, 0x35, 0xc3, 0x45, 0x68, 0x44, 0x68, 0x81, 0x4b, 0xe7, 0x2f, 0x69, 0x75, 0x01, 0xdb, 0x72,
-	0xd1, 0x65, 0x68, 0xd2, 0x33, 0xd5, 0x6d, 0x77, 0x57, 0x27, 0xb1, 0x34, 0x37, 0x54, 0x0d, 0x93,
-	0x2f, 0x8b, 0xd0, 0x2a, 0x89, 0xe5, 0x5b, 0x9f, 0x60, 0x6e, 0xaa, 0x04, 0xd6, 0xa6, 0xf5, 0x09,
-	0x56, 0xff, 0x5e, 0x81, 0xc5, 0x35, 0x23, 0x30, 0x9e, 0xb8, 0x26, 0xde, 0x9a, 0xd1, 0xb0, 0xe7,
-	0xc8, 0x27, 0xbf, 0x02, 0x35, 0xb1, 0x03, 0xbe, 0xa5, 0x08, 0x80, 0x1e, 0x42, 0x33, 0xf4, 0xe5,
-	0x74, 0x16, 0xeb, 0x95, 0x46, 0x3a, 0x50, 0x31, 0xcb, 0xe9, 0x6b, 0x8b, 0x61, 0x37, 0xda, 0x54,
-	0x1f, 0x42, 0x23, 0xfe, 0x37, 0x99, 0x75, 0x33, 0xcd, 0x28, 0x02, 0x40, 0xb8, 0xf1, 0xc9, 0xb0,
-	0x4f, 0x68, 0xca, 0x15, 0x4b, 0xd8, 0x54, 0x7f, 0x51, 0x81, 0x45, 0x6e, 0xee, 0x37, 0xc5, 0xcd,
-	0x0b, 0xdd, 0x1a, 0xcb, 0xf0, 0xd0, 0xdf, 0xe8, 0xeb, 0xc9, 0x64, 0xe9, 0x65, 0xa9, 0x12, 0xa0,
-	0x83, 0x50, 0x27, 0x33, 0x61, 0xeb, 0xf3, 0x64, 0x17, 0x3e, 0x25, 0x8c, 0xc6, 0x49, 0x43, 0x19,
-	0xad, 0x0d, 0x0b, 0x86, 0x69, 0x7a, 0xd8, 0xf7, 0xf9, 0x3a, 0xc2, 0x26, 0xf9, 0xe7, 0x00, 0x7b,
-	0x7e, 0xc8, 0xf2, 0x45, 0x2d, 0x6c, 0xa2, 0x77, 0xa0, 0x2a, 0xbc, 0x52, 0x96, 0x1a, 0xbb, 0x30,
-	0x7a, 0x9d, 0x3c, 0x16, 0x16, 0x3d, 0xd4, 0xbf, 0x2e, 0x40, 0x93, 0x1f, 0xd8, 0x2a, 0xb7, 0xc7,
-	0xe3, 0x85, 0x6f, 0x15, 0x1a, 0x3b, 0x91, 0xec, 0x8f, 0x4b, 0xe8, 0xc5, 0x55, 0x44, 0xa2, 0xcf,
-	0x24, 0x01, 0x4c, 0x7a, 0x04, 0xa5, 0xb9, 0x3c, 0x82, 0xf2, 0xb4, 0x1a, 0x2c, 0xeb, 0x23, 0x56,
-	0x24, 0x3e, 0xa2, 0xfa, 0x33, 0x50, 0x8f, 0x0d, 0x40, 0x35, 0x34, 0x4b, 0x97, 0xf1, 0x13, 0x0b,
-	0x9b, 0xe8, 0xad, 0xc8, 0x2f, 0x62, 0x47, 0x75, 0x46, 0xb2, 0x96, 0x94, 0x4b, 0xa4, 0xfe, 0x9d,
-	0x02, 0x15, 0x3e, 0xf2, 0x79, 0xa8, 0x73, 0xa5, 0x43, 0x7d, 0x46, 0x36, 0x3a, 0x70, 0x10, 0x71,
-	0x1a, 0x8f, 0x4f, 0xeb, 0x9c, 0x81, 0x6a, 0x4a, 0xdf, 0x2c, 0x70, 0xb3, 0x10, 0xfe, 0x15, 0x53,
-	0x32, 0xe4, 0x2f, 0xa2, 0x5f, 0xd0, 0x29, 0x28, 0xdb, 0xee, 0xae, 0xb8, 0x59, 0x63, 0x0d, 0xf5,
-	0x73, 0x85, 0x5e, 0x84, 0x68, 0xb8, 0xe7, 0x1e, 0x60, 0xef, 0x68, 0xfe, 0x0c, 0xf2, 0xdd, 0x18,
-	0x9b, 0xe7, 0x0c, 0xbe, 0x44, 0x07, 0x74, 0x37, 0x22, 0x42, 0x51, 0x96, 0x63, 0x8a, 0xeb, 0x1d,
-	0xce, 0xa4, 0x11, 0x31, 0xbe, 0xaf, 0xd0, 0x5c, 0x78, 0x72, 0x2b, 0xb3, 0x7a, 0x3b, 0xc7, 0x12,
-	0xc8, 0xa8, 0x3f, 0x51, 0xa0, 0x13, 0x25, 0xb1, 0xfc, 0xd5, 0xa3, 0x79, 0x6f, 0x9a, 0x8e, 0x27,
-	0xbe, 0xfa, 0x9a, 0xb8, 0x0a, 0x21, 0x42, 0x9b, 0x2b, 0x32, 0x0a, 0x2f, 0x42, 0x1c, 0x9a, 0x0f,
-	0xcf, 0x6e, 0x68, 0x1e, 0x96, 0xe9, 0x40, 0x55, 0x24, 0x10, 0xd8, 0x75, 0x88, 0x68, 0x13, 0x09,
-	0x3b, 0xf3, 0x08, 0x07, 0x0f, 0x93, 0x49, 0x98, 0x2f, 0xfb, 0x00, 0xe3, 0x57, 0x34, 0x7b, 0xfc,
-	0x8a, 0xa6, 0x94, 0xba, 0xa2, 0xe1, 0x70, 0xb5, 0x4f, 0x59, 0x20, 0xb3, 0x81, 0x17, 0x75, 0x60,
-	0xbf, 0xa2, 0x40, 0x9b, 0xcf, 0x42, 0xe7, 0x24, 0x21, 0x91, 0x8d, 0x03, 0x6c, 0x7e, 0xd1, 0xa9,
-	0x82, 0xff, 0x52, 0xa0, 0x15, 0xb7, 0xba, 0xd4, 0x70, 0xbe, 0x0d, 0x65, 0x9a, 0x69, 0xe1, 0x2b,
-	0x98, 0xa8, 0x1a, 0x18, 0x36, 0x51, 0xdb, 0xd4, 0xd5, 0xde, 0x12, 0x0e, 0x02, 0x6f, 0x46, 0xa6,
-	0xbf, 0x38, 0xbd, 0xe9, 0xe7, 0xae, 0x90, 0x3b, 0x24, 0xe3, 0xb2, 0xe4, 0x68, 0x04, 0x40, 0xef,
-	0x42, 0x85, 0x55, 0xb7, 0xf0, 0x6b, 0xcb, 0x2b, 0xc9, 0xa1, 0x79, 0xe5, 0x4b, 0xec, 0xc6, 0x81,
-	0x02, 0x34, 0xde, 0x49, 0xfd, 0x29, 0x58, 0x89, 0xa2, 0x51, 0x36, 0xed, 0xac, 0x4c, 0xab, 0xfe,
-	0xb3, 0x02, 0x27, 0x37, 0x8f, 0x9c, 0x5e, 0x9a, 0xfd, 0x57, 0xa0, 0x32, 0xb0, 0x8d, 0x28, 0x57,
-	0xcb, 0x5b, 0xd4, 0x0d, 0x64, 0x73, 0x63, 0x93, 0xd8, 0x10, 0x76, 0x66, 0x75, 0x01, 0xdb, 0x72,
-	0x27, 0x9a, 0xf6, 0x2b, 0x22, 0x7c, 0xc6, 0x26, 0xb3, 0x56, 0x2c, 0x0d, 0xb5, 0x28, 0xa0, 0xd4,
-	0x5a, 0xbd, 0x0b, 0x40, 0x0d, 0xba, 0x3e, 0x8d, 0x11, 0xa7, 0x3d, 0x1e, 0x13, 0x95, 0xfd, 0xe3,
-	0x02, 0xb4, 0x63, 0xa7, 0xf4, 0x45, 0xfb, 0x37, 0x23, 0xa2, 0xb2, 0xe2, 0x31, 0x45, 0x65, 0xa5,
-	0xf9, 0x7d, 0x9a, 0xb2, 0xcc, 0xa7, 0xf9, 0xf9, 0x22, 0x34, 0xa3, 0x53, 0xdb, 0xb0, 0x0d, 0x67,
-	0x24, 0x27, 0x6c, 0x0a, 0x7f, 0x3e, 0x79, 0x4e, 0x5f, 0x91, 0xc9, 0xc9, 0x08, 0x42, 0x68, 0xa9,
-	0x21, 0xd0, 0x59, 0x4a, 0x74, 0x2f, 0x60, 0x89, 0x2f, 0x1e, 0x43, 0x30, 0x81, 0xb4, 0xfa, 0x18,
-	0xbd, 0x06, 0x88, 0x4b, 0x91, 0x6e, 0x39, 0xba, 0x8f, 0x7b, 0xae, 0x63, 0x32, 0xf9, 0x2a, 0x6b,
-	0x2d, 0xfe, 0x4f, 0xd7, 0xd9, 0x64, 0x70, 0xf4, 0x36, 0x94, 0x82, 0xa3, 0x01, 0xf3, 0x56, 0x9a,
-	0x52, 0x7b, 0x1f, 0xad, 0x6b, 0xeb, 0x68, 0x80, 0x35, 0x8a, 0x1e, 0x96, 0x3f, 0x05, 0x9e, 0x71,
-	0xc0, 0x5d, 0xbf, 0x92, 0x16, 0x83, 0x10, 0x8d, 0x11, 0x9e, 0xe1, 0x02, 0x73, 0x91, 0x78, 0x93,
-	0x71, 0x76, 0x28, 0xb4, 0x7a, 0x10, 0xd8, 0x34, 0x75, 0x47, 0x39, 0x3b, 0x84, 0x6e, 0x05, 0x36,
-	0xd9, 0x64, 0xe0, 0x06, 0x86, 0xcd, 0xe4, 0xa3, 0xc6, 0xb5, 0x03, 0x81, 0xd0, 0xc0, 0xe4, 0x9f,
-	0x0a, 0xd0, 0x8a, 0x16, 0xa6, 0x61, 0x7f, 0x68, 0x8f, 0x96, 0xc7, 0xf1, 0xa9, 0x93, 0x49, 0xa2,
-	0xf8, 0x0d, 0xa8, 0x73, 0xae, 0x98, 0x82, 0xab, 0x80, 0x75, 0x79, 0x3c, 0x86, 0xcd, 0xcb, 0xc7,
-	0xc4, 0xe6, 0x95, 0x19, 0x92, 0x0f, 0x72, 0xda, 0xa8, 0x3f, 0x50, 0xe0, 0xa5, 0x8c, 0xd6, 0x1c,
-	0x7b, 0xb4, 0xe3, 0x43, 0x3f, 0xae, 0x4d, 0xd3, 0x43, 0x72, 0xfd, 0x7f, 0x17, 0x2a, 0x1e, 0x1d,
-	0x9d, 0xdf, 0x51, 0x5d, 0x1a, 0xcb, 0x7c, 0x6c, 0x21, 0x1a, 0xef, 0xa2, 0xfe, 0xb6, 0x02, 0xa7,
-	0xb3, 0x4b, 0x9d, 0xc3, 0xa8, 0xaf, 0xc2, 0x02, 0x1b, 0x3a, 0x94, 0xd1, 0xeb, 0xe3, 0x65, 0x34,
-	0x3a, 0x1c, 0x2d, 0xec, 0xa8, 0x6e, 0xc2, 0x4a, 0x68, 0xfb, 0xa3, 0xa3, 0x5f, 0xc7, 0x81, 0x31,
-	0x26, 0xf0, 0x39, 0x0f, 0x75, 0xe6, 0x41, 0xb3, 0x80, 0x82, 0xa5, 0x0c, 0x60, 0x5b, 0x64, 0xda,
-	0xd4, 0x7f, 0x57, 0xe0, 0x14, 0x35, 0x9e, 0xe9, 0xab, 0x99, 0x3c, 0x17, 0x86, 0xaa, 0xc8, 0x48,
-	0x3c, 0x31, 0xfa, 0xbc, 0xf6, 0xa6, 0xa6, 0x25, 0x60, 0xa8, 0x9b, 0x4d, 0xc4, 0x49, 0x03, 0xe4,
-	0xe8, 0x86, 0x99, 0x04, 0xe3, 0xf4, 0x82, 0x39, 0x9d, 0x81, 0x8b, 0x8c, 0x76, 0x69, 0x16, 0xa3,
-	0xfd, 0x18, 0x5e, 0x4a, 0xed, 0x74, 0x0e, 0x8a, 0xaa, 0x7f, 0xa6, 0x10, 0x72, 0x24, 0x6a, 0x98,
-	0x66, 0x77, 0x5c, 0xcf, 0x8a, 0x3b, 0x21, 0xdd, 0x32, 0xd3, 0x4a, 0xc4, 0x44, 0xef, 0x41, 0xcd,
-	0xc1, 0x87, 0x7a, 0xdc, 0x17, 0xca, 0xe1, 0xd5, 0x57, 0x1d, 0x7c, 0x48, 0x7f, 0xa9, 0x4f, 0xe0,
-	0x74, 0x66, 0xa9, 0xf3, 0xec, 0xfd, 0x6f, 0x14, 0x38, 0xb3, 0xe6, 0xb9, 0x83, 0x0f, 0x2d, 0x2f,
-	0x18, 0x1a, 0x76, 0xf2, 0xee, 0xfe, 0xc5, 0x64, 0xb6, 0xde, 0x8f, 0x79, 0xc5, 0x8c, 0x7f, 0x5e,
-	0x93, 0x48, 0x50, 0x76, 0x51, 0x7c, 0xd3, 0x31, 0x1f, 0xfa, 0xdf, 0x8a, 0xb2, 0xc5, 0x73, 0xbc,
-	0x09, 0x7e, 0x49, 0x9e, 0x00, 0x43, 0x9a, 0x08, 0x2f, 0xce, 0x9a, 0x08, 0x1f, 0xa1, 0xde, 0x4b,
-	0xc7, 0xa4, 0xde, 0xa7, 0xce, 0xcc, 0xbc, 0x0f, 0xc9, 0x4b, 0x0a, 0x6a, 0x9d, 0x67, 0xba, 0xdd,
-	0x58, 0x05, 0x88, 0x12, 0xf6, 0xbc, 0x04, 0x35, 0xcf, 0x30, 0xb1, 0x5e, 0x84, 0x5a, 0xc2, 0x94,
-	0x72, 0x4b, 0x1f, 0x4b, 0x21, 0x7f, 0x0b, 0x3a, 0x32, 0x2e, 0x9d, 0x87, 0xf3, 0x7f, 0x5c, 0x00,
-	0xe8, 0x8a, 0xaa, 0xe5, 0xd9, 0x6c, 0xc1, 0x25, 0x88, 0x79, 0x23, 0x91, 0xbc, 0xc7, 0xb9, 0xc8,
-	0x24, 0x22, 0x21, 0x62, 0x52, 0x82, 0x93, 0x89, 0x53, 0x4d, 0x3a, 0x4e, 0x4c, 0x6a, 0x18, 0x53,
-	0xa4, 0xd5, 0xef, 0xcb, 0x50, 0xf3, 0xdc, 0x43, 0x9d, 0x88, 0x99, 0x19, 0x96, 0x65, 0x7b, 0xee,
-	0x21, 0x11, 0x3e, 0x13, 0x9d, 0x86, 0x85, 0xc0, 0xf0, 0xf7, 0xc9, 0xf8, 0x95, 0x58, 0xf9, 0x88,
-	0x89, 0x4e, 0x41, 0x79, 0xc7, 0xb2, 0x31, 0xab, 0x56, 0xa8, 0x69, 0xac, 0x81, 0xbe, 0x1a, 0xd6,
-	0x0f, 0x56, 0x73, 0x97, 0x08, 0x51, 0x7c, 0xf5, 0x73, 0x05, 0x96, 0xa2, 0x53, 0xa3, 0x0a, 0x88,
-	0xe8, 0x34, 0xaa, 0xcf, 0xee, 0xbb, 0x26, 0x53, 0x15, 0xcd, 0x11, 0x16, 0x81, 0x75, 0x64, 0x5a,
-	0x2b, 0xea, 0x32, 0x2e, 0x4c, 0x26, 0xfb, 0x22, 0x9b, 0xb6, 0xcc, 0xb0, 0x64, 0xa6, 0xe2, 0xb9,
-	0x87, 0x5d, 0x53, 0x9c, 0x06, 0xab, 0xb9, 0x66, 0x41, 0x21, 0x39, 0x8d, 0xfb, 0xb4, 0xec, 0xfa,
-	0x12, 0x2c, 0x62, 0xcf, 0x73, 0x3d, 0xbd, 0x8f, 0x7d, 0xdf, 0xd8, 0xc5, 0xdc, 0x3f, 0x6f, 0x50,
-	0xe0, 0x3a, 0x83, 0xa9, 0xbf, 0x5f, 0x82, 0x66, 0xb4, 0x95, 0xf0, 0x9a, 0xdc, 0x32, 0xc3, 0x6b,
-	0x72, 0x8b, 0x90, 0x0e, 0x3c, 0xa6, 0x0a, 0x05, 0x71, 0x57, 0x0b, 0x6d, 0x45, 0xab, 0x71, 0x68,
-	0xd7, 0x24, 0x66, 0x99, 0x08, 0x99, 0xe3, 0x9a, 0x38, 0x22, 0x2e, 0x84, 0x20, 0x4e, 0xdb, 0x04,
-	0x8f, 0x94, 0x72, 0xf0, 0x48, 0x39, 0x07, 0x8f, 0x54, 0x24, 0x3c, 0xb2, 0x02, 0x95, 0xed, 0x61,
-	0x6f, 0x1f, 0x07, 0xdc, 0x63, 0xe3, 0xad, 0x24, 0xef, 0x54, 0x53, 0xbc, 0x23, 0x58, 0xa4, 0x16,
-	0x67, 0x91, 0x97, 0xa1, 0xc6, 0xee, 0x6b, 0xf5, 0xc0, 0xa7, 0x97, 0x4f, 0x45, 0xad, 0xca, 0x00,
-	0x5b, 0x3e, 0xba, 0x13, 0xba, 0x73, 0x75, 0x99, 0xb0, 0x53, 0xad, 0x93, 0xe2, 0x92, 0xd0, 0x99,
-	0xbb, 0x06, 0x4b, 0xb1, 0xe3, 0xa0, 0x36, 0xa2, 0x41, 0x97, 0x1a, 0xf3, 0xf6, 0xa9, 0x99, 0xb8,
-	0x02, 0xcd, 0xe8, 0x48, 0x28, 0xde, 0x22, 0x0b, 0xb2, 0x04, 0x94, 0xa2, 0x09, 0x4e, 0x6e, 0x4e,
-	0xc7, 0xc9, 0xe8, 0x0c, 0x54, 0x79, 0x74, 0xe4, 0xb7, 0x97, 0x12, 0xc9, 0x0a, 0xf5, 0x3b, 0x80,
-	0xa2, 0xd5, 0xcf, 0xe7, 0x2d, 0xa6, 0xd8, 0xa3, 0x90, 0x66, 0x0f, 0xf5, 0x87, 0x0a, 0x2c, 0xc7,
-	0x27, 0x9b, 0xd5, 0xf0, 0xbe, 0x07, 0x75, 0x76, 0xfd, 0xa7, 0x13, 0xc1, 0xe7, 0x49, 0xa0, 0xb3,
-	0x63, 0xe9, 0xa2, 0x41, 0xf4, 0x6a, 0x83, 0xb0, 0xd7, 0xa1, 0xeb, 0xed, 0x5b, 0xce, 0xae, 0x4e,
-	0x56, 0x16, 0x8a, 0x5b, 0x83, 0x03, 0x9f, 0x10, 0x98, 0xfa, 0x99, 0x02, 0xe7, 0x9e, 0x0e, 0x4c,
-	0x23, 0xc0, 0x31, 0x0f, 0x64, 0xde, 0x6a, 0xc9, 0xb7, 0xc3, 0x72, 0xc5, 0x42, 0xbe, 0x2b, 0x2c,
-	0x86, 0xad, 0xfe, 0x85, 0x58, 0x4b, 0xa6, 0xc4, 0x78, 0xf6, 0xb5, 0x74, 0xa0, 0x7a, 0xc0, 0x87,
-	0x0b, 0x5f, 0xa1, 0x84, 0xed, 0xc4, 0x35, 0x69, 0x71, 0xfa, 0x6b, 0x52, 0x75, 0x1d, 0xce, 0x68,
-	0xd8, 0xc7, 0x8e, 0x99, 0xd8, 0xcd, 0xcc, 0xc9, 0xa6, 0x01, 0x74, 0x64, 0xc3, 0xcd, 0xc3, 0xac,
-	0xcc, 0x77, 0xd5, 0x3d, 0x32, 0x6c, 0xc0, 0x55, 0x31, 0x71, 0x99, 0xe8, 0x3c, 0x81, 0xfa, 0xe7,
-	0x05, 0x38, 0x7d, 0xcf, 0x34, 0xb9, 0x16, 0xe7, 0xde, 0xd8, 0x8b, 0x72, 0x94, 0xd3, 0x8e, 0x64,
-	0x31, 0xeb, 0x48, 0x1e, 0x97, 0x66, 0xe5, 0x36, 0xc6, 0x19, 0xf6, 0x43, 0xdb, 0xe9, 0xb1, 0xfa,
-	0xa1, 0xbb, 0xfc, 0xde, 0x8c, 0x04, 0xf4, 0xd4, 0x7e, 0x4e, 0xf6, 0xaf, 0xaa, 0x61, 0xd2, 0x4c,
-	0x1d, 0x40, 0x3b, 0x7b, 0x58, 0x73, 0xaa, 0x92, 0xf0, 0x44, 0x06, 0x2e, 0x4b, 0xb0, 0x36, 0x88,
-	0x0b, 0x45, 0x41, 0x1b, 0xae, 0xaf, 0xfe, 0x47, 0x01, 0xda, 0x9b, 0xc6, 0x01, 0xfe, 0xbf, 0x43,
-	0xa0, 0x6f, 0xc3, 0x29, 0xdf, 0x38, 0xc0, 0x7a, 0x2c, 0x30, 0xd6, 0x3d, 0xfc, 0x8c, 0xbb, 0xa0,
-	0xaf, 0xca, 0x34, 0x89, 0xb4, 0xcc, 0x46, 0x5b, 0xf6, 0x13, 0x70, 0x0d, 0x3f, 0x43, 0x57, 0x61,
-	0x29, 0x5e, 0xc7, 0x45, 0x96, 0x56, 0xa5, 0x47, 0xbe, 0x18, 0x2b, 0xd3, 0xea, 0x9a, 0xea, 0x33,
-	0x78, 0xe5, 0xa9, 0xe3, 0xe3, 0xa0, 0x1b, 0x95, 0x1a, 0xcd, 0x19, 0x42, 0x9e, 0x87, 0x7a, 0x74,
-	0xf0, 0x99, 0x97, 0x27, 0xa6, 0xaf, 0xba, 0xd0, 0x59, 0x37, 0xbc, 0xfd, 0x30, 0xcd, 0xbc, 0xc6,
-	0x4a, 0x42, 0x5e, 0xe0, 0x84, 0x3b, 0xa2, 0x42, 0x4a, 0xc3, 0x3b, 0xd8, 0xc3, 0x4e, 0x0f, 0x3f,
-	0x76, 0x7b, 0xfb, 0xb1, 0x9a, 0x65, 0x25, 0x5e, 0xb3, 0x3c, 0x6b, 0x0d, 0xb4, 0xfa, 0xa3, 0x02,
-	0xac, 0xdc, 0xb3, 0x03, 0xec, 0x45, 0x91, 0xff, 0x34, 0x49, 0x8c, 0x28, 0xab, 0x50, 0x98, 0x21,
-	0xab, 0x90, 0x29, 0xbf, 0x2f, 0x66, 0xcb, 0xef, 0x65, 0x39, 0x90, 0xd2, 0x8c, 0x39, 0x90, 0x7b,
-	0x00, 0x03, 0xcf, 0x1d, 0x60, 0x2f, 0xb0, 0x70, 0x18, 0xbe, 0xe5, 0x70, 0x5f, 0x62, 0x9d, 0xd4,
-	0xbf, 0x2c, 0x41, 0xad, 0xeb, 0x98, 0xf8, 0x79, 0xee, 0xc2, 0xf0, 0x58, 0x7e, 0xa9, 0x90, 0xcc,
-	0x2f, 0x9d, 0x05, 0xa0, 0xe5, 0xbe, 0x71, 0x69, 0xae, 0x51, 0x08, 0x95, 0xe5, 0x36, 0x2c, 0xd0,
-	0x86, 0xa8, 0x4f, 0x0f, 0x9b, 0x68, 0x15, 0xea, 0xc1, 0xd1, 0x00, 0xeb, 0x03, 0xc3, 0x33, 0xfa,
-	0xd3, 0x6c, 0x84, 0xf4, 0xda, 0xa0, 0x9d, 0xd0, 0x1a, 0x34, 0xd8, 0xe4, 0x7c, 0x90, 0x4a, 0xde,
-	0x41, 0xea, 0xb4, 0x1b, 0x1f, 0xe5, 0x22, 0x1f, 0x05, 0x9b, 0x2c, 0x45, 0xcb, 0x0a, 0x42, 0xeb,
-	0x1c, 0x46, 0x93, 0xb4, 0xc9, 0x74, 0x71, 0x35, 0x95, 0x2e, 0x0e, 0x7d, 0x11, 0x4c, 0x13, 0xc9,
-	0xcd, 0xb4, 0x2f, 0x12, 0x86, 0x31, 0x64, 0xbc, 0x84, 0x53, 0xfb, 0x36, 0x9c, 0x66, 0xcb, 0xa7,
-	0x4d, 0x7d, 0xc7, 0xb0, 0x6c, 0xdd, 0xc3, 0x86, 0xcf, 0xcb, 0x3f, 0x6b, 0xda, 0x29, 0x4b, 0xf4,
-	0x79, 0x68, 0x58, 0xb6, 0x46, 0xff, 0x43, 0x2a, 0x2c, 0x5a, 0xbe, 0x6e, 0x0c, 0x03, 0x57, 0xa7,
-	0xff, 0xf3, 0x3a, 0xae, 0xba, 0xe5, 0xdf, 0x1b, 0x06, 0x2e, 0x9d, 0x06, 0xad, 0xc3, 0xf2, 0xd0,
-	0xc7, 0x9e, 0x9e, 0x38, 0x9e, 0x46, 0xde, 0xe3, 0x59, 0x22, 0x7d, 0xbb, 0xd1, 0x11, 0xa9, 0xbf,
-	0xa4, 0x00, 0x50, 0x7b, 0xc5, 0x46, 0xbf, 0x1b, 0x12, 0x9d, 0xf8, 0xc4, 0x72, 0x8d, 0xc1, 0x9c,
-	0xc6, 0x90, 0xc9, 0x38, 0x4b, 0x84, 0xd5, 0x35, 0x26, 0xa6, 0x77, 0x96, 0x94, 0x97, 0xaa, 0x5a,
-	0xd8, 0xa4, 0xa6, 0x8a, 0xc7, 0x0e, 0xd1, 0xd5, 0x03, 0xf0, 0xe8, 0xc1, 0xea, 0x63, 0xf5, 0x97,
-	0x4b, 0xa2, 0xf0, 0x88, 0x2d, 0x24, 0xe7, 0xa3, 0x86, 0xf8, 0x7d, 0x6f, 0x21, 0x7b, 0xdf, 0x9b,
-	0x48, 0xf9, 0x14, 0xd3, 0x29, 0x9f, 0x33, 0x50, 0x75, 0x86, 0x7d, 0x46, 0x79, 0xce, 0xc3, 0x0e,
-	0xab, 0x5f, 0x8a, 0x73, 0x77, 0x39, 0xc9, 0xdd, 0x6d, 0x58, 0xd8, 0x1e, 0x5a, 0x54, 0x60, 0x98,
-	0xed, 0x09, 0x9b, 0x31, 0x25, 0xb7, 0x90, 0x50, 0x72, 0x97, 0x60, 0x91, 0x9d, 0x69, 0x58, 0x60,
-	0x54, 0xe5, 0x4f, 0xa4, 0x09, 0xf0, 0x43, 0x5e, 0x65, 0x34, 0x23, 0xa3, 0x9d, 0x87, 0x7a, 0x96,
-	0xb9, 0x60, 0x27, 0x62, 0xa9, 0xab, 0xc0, 0x8a, 0xf6, 0x75, 0x12, 0xc4, 0xe9, 0xfb, 0xf8, 0x88,
-	0x95, 0x0f, 0xd3, 0xbb, 0x29, 0x13, 0x3f, 0x7f, 0x68, 0xd9, 0xf8, 0x9b, 0xf8, 0xc8, 0x8f, 0xd3,
-	0xae, 0x31, 0x96, 0x76, 0x8b, 0x69, 0xda, 0x91, 0xc0, 0xcc, 0xc7, 0x9e, 0x65, 0xd8, 0xd6, 0x27,
-	0x98, 0x55, 0xb0, 0x34, 0x59, 0x81, 0x8c, 0x80, 0xd2, 0x3a, 0x16, 0x12, 0x50, 0x78, 0x56, 0x80,
-	0xf5, 0x3d, 0xc3, 0x31, 0xdd, 0x9d, 0x1d, 0x1a, 0x64, 0x55, 0xb5, 0x06, 0x05, 0xbe, 0xcf, 0x60,
-	0xea, 0x4f, 0xc3, 0x29, 0xfa, 0x8c, 0x4e, 0xec, 0x73, 0x0a, 0x6d, 0x9f, 0x54, 0x58, 0x85, 0x94,
-	0xc2, 0x52, 0xff, 0x94, 0x3d, 0x05, 0x8d, 0x8f, 0x3d, 0x8f, 0xf7, 0xf5, 0x76, 0xf2, 0x02, 0x63,
-	0x46, 0x82, 0x15, 0xd3, 0x04, 0x53, 0x3f, 0x55, 0xe2, 0x95, 0x1a, 0x2f, 0xe2, 0x24, 0x26, 0x5a,
-	0xdd, 0xcf, 0x14, 0x58, 0xce, 0xcc, 0x3f, 0x21, 0x7d, 0xfa, 0xa2, 0x8e, 0xe3, 0xb7, 0x94, 0xe4,
-	0x73, 0xb2, 0xe3, 0x21, 0xde, 0x3b, 0xa9, 0x37, 0xc5, 0x97, 0xc7, 0x15, 0x47, 0x88, 0x29, 0xc3,
-	0x5a, 0x9a, 0xef, 0x15, 0x01, 0xdd, 0xa7, 0xfc, 0x4f, 0xff, 0x9c, 0x86, 0x32, 0x33, 0x9b, 0xdb,
-	0x94, 0x51, 0x2d, 0x1d, 0x87, 0x51, 0x2d, 0xcf, 0x64, 0x54, 0x13, 0x85, 0xa8, 0x95, 0x74, 0x21,
-	0x6a, 0xc6, 0x84, 0x2d, 0xe4, 0x34, 0x61, 0xd5, 0x99, 0x4d, 0xd8, 0x73, 0x38, 0x19, 0xca, 0x75,
-	0xbc, 0x76, 0x2c, 0x0f, 0x39, 0x26, 0x3d, 0xe9, 0x1e, 0x4f, 0x14, 0xf5, 0x3f, 0x0b, 0xb0, 0xdc,
-	0x0d, 0xd5, 0x28, 0x89, 0x13, 0x72, 0x7c, 0x20, 0x60, 0x34, 0x07, 0xc4, 0x6c, 0x4e, 0x71, 0xa4,
-	0xcd, 0x29, 0x25, 0x6d, 0x4e, 0x72, 0x81, 0xe5, 0x34, 0xd7, 0x1c, 0x8f, 0x1b, 0x75, 0x9d, 0x3f,
-	0x18, 0x63, 0x36, 0x84, 0x3d, 0x55, 0x66, 0xd9, 0xe3, 0xa6, 0x15, 0xdf, 0xbd, 0x8f, 0xae, 0xc1,
-	0x92, 0x50, 0xfa, 0x26, 0xb3, 0x05, 0xfc, 0x7d, 0x4d, 0x04, 0x0e, 0x8d, 0x41, 0xd2, 0x26, 0xd6,
-	0x24, 0x36, 0x31, 0x6e, 0x9f, 0x21, 0x61, 0x9f, 0xd5, 0xbf, 0x8d, 0x7d, 0x25, 0x65, 0x2a, 0x7f,
-	0x77, 0xfc, 0x95, 0xfe, 0x45, 0x68, 0x60, 0xc7, 0xd8, 0xb6, 0x31, 0x67, 0x5e, 0xf6, 0x7c, 0xbf,
-	0xce, 0x60, 0x8c, 0x79, 0x1f, 0x40, 0x3d, 0xf2, 0x90, 0x42, 0x41, 0xbc, 0x3c, 0xca, 0x45, 0x8a,
-	0x33, 0x86, 0x06, 0xc2, 0x55, 0xf2, 0xd5, 0x5f, 0x2f, 0x44, 0x96, 0x6e, 0xfe, 0xe2, 0xcd, 0x8f,
-	0xa1, 0x21, 0x02, 0x36, 0xe2, 0xb8, 0x31, 0xad, 0x76, 0x47, 0xfe, 0x84, 0x3f, 0x33, 0x67, 0xbc,
-	0x0e, 0x8c, 0x3d, 0xdd, 0x0f, 0xc3, 0x3f, 0x02, 0xe9, 0xf4, 0x62, 0x47, 0xcd, 0x11, 0xe2, 0xcf,
-	0xf5, 0x8b, 0xec, 0xb9, 0xfe, 0xd7, 0x92, 0xcf, 0xf5, 0x2f, 0x4d, 0xd0, 0xa8, 0xbc, 0x4a, 0x4c,
-	0xbc, 0xd7, 0xff, 0x1d, 0x05, 0x5a, 0x24, 0x6e, 0x9d, 0x5a, 0xa3, 0xa6, 0x83, 0xb4, 0x82, 0x24,
-	0x48, 0x9b, 0xa0, 0x5b, 0xcf, 0x40, 0xd5, 0xf4, 0xdc, 0x81, 0x6e, 0xd8, 0x36, 0xaf, 0x06, 0xa4,
-	0xaf, 0x2a, 0xee, 0xd9, 0x36, 0xf1, 0x47, 0xd6, 0xb0, 0xdf, 0xf3, 0xac, 0xed, 0xe9, 0x75, 0xfd,
-	0x04, 0x7f, 0xe4, 0xd7, 0x14, 0x78, 0x29, 0x35, 0xf6, 0x3c, 0x2c, 0xf0, 0x6e, 0x92, 0x2f, 0x19,
-	0x07, 0x8c, 0x77, 0xdd, 0xe3, 0xfc, 0x68, 0xf0, 0xef, 0x17, 0x98, 0xf8, 0xf9, 0x2a, 0xd1, 0x2d,
-	0x1b, 0x9e, 0xbb, 0xeb, 0x61, 0xdf, 0x3f, 0xc6, 0x0d, 0xff, 0x1e, 0x7b, 0x59, 0x2f, 0x9b, 0x63,
-	0x9e, 0x8d, 0xa7, 0x83, 0xbc, 0xc2, 0xa4, 0x20, 0xaf, 0x98, 0x0a, 0xf2, 0x6e, 0xbc, 0x27, 0xde,
-	0x46, 0x6e, 0x1d, 0x0d, 0x30, 0x5a, 0x80, 0xe2, 0x13, 0x7c, 0xd8, 0x3a, 0x81, 0x00, 0x2a, 0x4f,
-	0x5c, 0xaf, 0x6f, 0xd8, 0x2d, 0x05, 0xd5, 0x61, 0x81, 0x97, 0x82, 0xb6, 0x0a, 0x68, 0x11, 0x6a,
-	0xf7, 0xc3, 0x72, 0xba, 0x56, 0xf1, 0xc6, 0x1f, 0x2a, 0xb0, 0x9c, 0x29, 0x56, 0x44, 0x4d, 0x80,
-	0xa7, 0x4e, 0x8f, 0x57, 0x71, 0xb6, 0x4e, 0xa0, 0x06, 0x54, 0xc3, 0x9a, 0x4e, 0x36, 0xde, 0x96,
-	0x4b, 0xb1, 0x5b, 0x05, 0xd4, 0x82, 0x06, 0xeb, 0x38, 0xec, 0xf5, 0xb0, 0xef, 0xb7, 0x8a, 0x02,
-	0x42, 0x82, 0xc3, 0xa1, 0x87, 0x5b, 0x25, 0x32, 0xe7, 0x96, 0xcb, 0xdf, 0xa5, 0xb7, 0xca, 0x08,
-	0x41, 0x33, 0x7c, 0xa4, 0xce, 0x3b, 0x55, 0x62, 0xb0, 0xb0, 0xdb, 0xc2, 0x8d, 0x8f, 0xe2, 0x25,
-	0x67, 0x74, 0x7b, 0xa7, 0xe1, 0xe4, 0x53, 0xc7, 0xc4, 0x3b, 0x96, 0x83, 0xcd, 0xe8, 0xaf, 0xd6,
-	0x09, 0x74, 0x12, 0x96, 0xd6, 0xb1, 0xb7, 0x8b, 0x63, 0xc0, 0x02, 0x5a, 0x86, 0xc5, 0x75, 0xeb,
-	0x79, 0x0c, 0x54, 0x54, 0x4b, 0x55, 0xa5, 0xa5, 0xdc, 0xfe, 0xfe, 0x45, 0xa8, 0xad, 0x19, 0x81,
-	0x71, 0xdf, 0x75, 0x3d, 0x13, 0xd9, 0x80, 0xe8, 0x67, 0x1c, 0xfa, 0x03, 0xd7, 0x11, 0xdf, 0x7d,
-	0x41, 0x37, 0x93, 0xf4, 0xe3, 0x8d, 0x2c, 0x22, 0x67, 0xb2, 0xce, 0x65, 0x29, 0x7e, 0x0a, 0x59,
-	0x3d, 0x81, 0xfa, 0x74, 0x36, 0x12, 0x7c, 0x6c, 0x59, 0xbd, 0xfd, 0x30, 0x1f, 0xff, 0xc6, 0x88,
-	0xec, 0x7b, 0x16, 0x35, 0x9c, 0xef, 0x92, 0x74, 0x3e, 0xf6, 0x9d, 0x8d, 0x90, 0x29, 0xd5, 0x13,
-	0xe8, 0x19, 0x55, 0xd5, 0xd1, 0xd5, 0x46, 0x38, 0xe1, 0xed, 0xd1, 0x13, 0x66, 0x90, 0xa7, 0x9c,
-	0xf2, 0x31, 0x94, 0x29, 0xbb, 0x21, 0xd9, 0xed, 0x47, 0xfc, 0x13, 0x6d, 0x9d, 0x0b, 0xa3, 0x11,
-	0xc4, 0x68, 0xdf, 0x81, 0xa5, 0xd4, 0x87, 0x9d, 0x90, 0x2c, 0x17, 0x2a, 0xff, 0x44, 0x57, 0xe7,
-	0x46, 0x1e, 0x54, 0x31, 0xd7, 0x2e, 0x34, 0x93, 0x9f, 0x7f, 0x40, 0xd7, 0x73, 0x7c, 0x49, 0x86,
-	0xcd, 0xf4, 0x6a, 0xee, 0x6f, 0xce, 0x50, 0x26, 0x68, 0xa5, 0x3f, 0x34, 0x84, 0x6e, 0x8c, 0x1d,
-	0x20, 0xc9, 0x6c, 0x5f, 0xc9, 0x85, 0x2b, 0xa6, 0x3b, 0xe2, 0xf6, 0x3a, 0xf5, 0x81, 0x97, 0x34,
-	0x8f, 0x47, 0x46, 0x56, 0xfe, 0xe5, 0x99, 0xce, 0xad, 0xdc, 0xf8, 0x62, 0xea, 0x5f, 0x60, 0x6f,
-	0x3d, 0x64, 0x1f, 0x49, 0x41, 0x6f, 0xca, 0x87, 0x1b, 0xf3, 0x75, 0x97, 0xce, 0xed, 0x69, 0xba,
-	0x88, 0x45, 0x7c, 0x97, 0x3e, 0xd2, 0x90, 0x7c, 0x66, 0x24, 0x2d, 0x77, 0xe1, 0x78, 0xa3, 0xbf,
-	0xa0, 0xd2, 0x79, 0x73, 0x8a, 0x1e, 0x62, 0x01, 0x6e, 0xfa, 0x4b, 0x4e, 0xa1, 0x18, 0xde, 0x9a,
-	0xc8, 0x35, 0xb3, 0xc9, 0xe0, 0xc7, 0xb0, 0x94, 0xba, 0x1d, 0x40, 0xf9, 0x6f, 0x10, 0x3a, 0xe3,
-	0x6c, 0x17, 0x13, 0xc9, 0xd4, 0x9b, 0x17, 0x34, 0x82, 0xfb, 0x25, 0xef, 0x62, 0x3a, 0x37, 0xf2,
-	0xa0, 0x8a, 0x8d, 0xf8, 0x54, 0x5d, 0xa6, 0x5e, 0x32, 0xa0, 0xd7, 0xe4, 0x63, 0xc8, 0x5f, 0x6c,
-	0x74, 0x5e, 0xcf, 0x89, 0x2d, 0x26, 0x3d, 0xa0, 0x51, 0x59, 0xfa, 0xc1, 0x09, 0x7a, 0x7d, 0x2c,
-	0xb1, 0xd2, 0x2f, 0x6d, 0x3a, 0x37, 0xf3, 0xa2, 0x8b, 0x79, 0x7f, 0x16, 0xd0, 0xe6, 0x9e, 0x7b,
-	0x78, 0xdf, 0x75, 0x76, 0xac, 0xdd, 0xa1, 0x67, 0xb0, 0xdc, 0xfa, 0x28, 0xdb, 0x90, 0x45, 0x1d,
-	0xc1, 0xa3, 0x63, 0x7b, 0x88, 0xc9, 0x75, 0x80, 0x47, 0x38, 0x58, 0xc7, 0x81, 0x47, 0x04, 0xe3,
-	0xea, 0x28, 0xf3, 0xc7, 0x11, 0xc2, 0xa9, 0xae, 0x4d, 0xc4, 0x8b, 0x99, 0xa2, 0xd6, 0xba, 0xe1,
-	0x0c, 0x0d, 0x3b, 0xf6, 0x62, 0xfe, 0x35, 0x69, 0xf7, 0x34, 0xda, 0x08, 0x42, 0x8e, 0xc4, 0x16,
-	0x53, 0x1e, 0x0a, 0xd3, 0x1e, 0x2b, 0x60, 0x1d, 0x6f, 0xda, 0xb3, 0x8f, 0x27, 0xd2, 0x6a, 0x6f,
-	0x0c, 0xbe, 0x98, 0x98, 0x67, 0xc2, 0x52, 0x08, 0x1f, 0x59, 0xc1, 0xde, 0x86, 0x6d, 0x38, 0x7e,
-	0x9e, 0x25, 0x50, 0xc4, 0x29, 0x96, 0xc0, 0xf1, 0xc5, 0x12, 0x4c, 0x58, 0x4c, 0xd4, 0x95, 0x22,
-	0xd9, 0x13, 0x73, 0x59, 0x8d, 0x6d, 0xe7, 0xfa, 0x64, 0x44, 0x31, 0xcb, 0x1e, 0x2c, 0x86, 0xa2,
-	0xc4, 0x0e, 0xf7, 0xd5, 0x51, 0x2b, 0x8d, 0x70, 0x46, 0x68, 0x02, 0x39, 0x6a, 0x5c, 0x13, 0x64,
-	0xcb, 0xe6, 0x50, 0xbe, 0x72, 0xcb, 0x71, 0x9a, 0x60, 0x74, 0x2d, 0x1e, 0x53, 0x75, 0xa9, 0x12,
-	0x55, 0xb9, 0x1e, 0x95, 0x56, 0xdc, 0x4a, 0x55, 0xdd, 0x88, 0x8a, 0x57, 0xf5, 0x04, 0xfa, 0x08,
-	0x2a, 0xfc, 0xbb, 0xa4, 0x97, 0xc7, 0x97, 0xba, 0xf0, 0xd1, 0xaf, 0x4c, 0xc0, 0x12, 0x03, 0xef,
-	0xc3, 0xe9, 0x11, 0x85, 0x2e, 0x52, 0x13, 0x3c, 0xbe, 0x28, 0x66, 0x92, 0x71, 0x10, 0x93, 0x65,
-	0x2a, 0x59, 0xc6, 0x4c, 0x36, 0xaa, 0xea, 0x65, 0xd2, 0x64, 0x3a, 0x2c, 0x67, 0x8a, 0x04, 0xd0,
-	0x57, 0x46, 0x18, 0x3a, 0x59, 0x29, 0xc1, 0xa4, 0x09, 0x76, 0xe1, 0x25, 0xe9, 0x85, 0xb8, 0xd4,
-	0x70, 0x8f, 0xbb, 0x3a, 0x9f, 0x34, 0x51, 0x0f, 0x4e, 0x4a, 0xae, 0xc1, 0xa5, 0x26, 0x67, 0xf4,
-	0x75, 0xf9, 0xa4, 0x49, 0x76, 0xa0, 0xb3, 0xea, 0xb9, 0x86, 0xd9, 0x33, 0xfc, 0x80, 0x5e, 0x4d,
-	0x93, 0x28, 0x2a, 0xf4, 0x9c, 0xe4, 0x6e, 0xb5, 0xf4, 0x02, 0x7b, 0xd2, 0x3c, 0xdb, 0x50, 0xa7,
-	0xa4, 0x64, 0x5f, 0x8c, 0x44, 0x72, 0x1b, 0x11, 0xc3, 0x18, 0xa1, 0x78, 0x64, 0x88, 0x82, 0xa9,
-	0x37, 0xa1, 0x1e, 0xcb, 0x63, 0x23, 0x99, 0x30, 0x64, 0xf3, 0xdc, 0x93, 0x16, 0x6e, 0x52, 0x6d,
-	0x16, 0xbb, 0x38, 0xb8, 0x36, 0x26, 0x0d, 0x95, 0x20, 0xef, 0xf5, 0xc9, 0x88, 0x29, 0x77, 0x3c,
-	0x7b, 0x4b, 0x71, 0x73, 0x82, 0x33, 0x98, 0x9e, 0xf3, 0x56, 0x6e, 0x7c, 0x31, 0xf5, 0x76, 0xb4,
-	0x41, 0x9a, 0x3b, 0x41, 0x57, 0x27, 0xe6, 0xd9, 0xa4, 0x76, 0x7e, 0x64, 0x3e, 0x4e, 0x3d, 0x81,
-	0x3e, 0x80, 0x9a, 0xc8, 0x86, 0xa1, 0x4b, 0x23, 0x34, 0xee, 0x94, 0x54, 0x49, 0x24, 0x9b, 0xa4,
-	0x54, 0x91, 0xa5, 0xba, 0xa4, 0x54, 0x91, 0xe6, 0xad, 0xd4, 0x13, 0xe8, 0xe7, 0xa2, 0x2b, 0xb6,
-	0x44, 0x86, 0x07, 0xdd, 0x1a, 0xb3, 0x75, 0x59, 0xbe, 0xa9, 0xf3, 0x46, 0xfe, 0x0e, 0xe1, 0xec,
-	0xb7, 0x3f, 0xaf, 0x41, 0x35, 0xfc, 0xdc, 0xc3, 0x17, 0x9c, 0x91, 0xf8, 0x12, 0x52, 0x04, 0x1f,
-	0xc3, 0x52, 0xea, 0xd3, 0x6b, 0x52, 0xed, 0x23, 0xff, 0x3c, 0xdb, 0x24, 0x76, 0xf9, 0x88, 0x7f,
-	0x6d, 0x5d, 0x44, 0x0b, 0xd7, 0x46, 0xa5, 0x19, 0xd2, 0x81, 0xc2, 0x84, 0x81, 0xff, 0x77, 0xbb,
-	0xe7, 0x4f, 0x00, 0x62, 0x8e, 0xf9, 0xf8, 0x47, 0x91, 0xc4, 0xd7, 0x9c, 0x74, 0x5a, 0x7d, 0xa9,
-	0xef, 0xfd, 0x6a, 0x9e, 0x07, 0x66, 0xa3, 0xbd, 0xa7, 0xd1, 0x1e, 0xf7, 0x53, 0x68, 0xc4, 0x9f,
-	0x2b, 0x4b, 0x15, 0x9b, 0xe4, 0x3d, 0xf3, 0xa4, 0x5d, 0xac, 0x4f, 0xe9, 0x94, 0x4d, 0x18, 0xce,
-	0x07, 0x94, 0x2d, 0x74, 0x95, 0x3a, 0xb1, 0x23, 0xcb, 0x6b, 0xa5, 0x4e, 0xec, 0xe8, 0xea, 0x59,
-	0x96, 0x6d, 0x4a, 0x57, 0x6f, 0x4a, 0xb3, 0x4d, 0x23, 0xea, 0x61, 0xa5, 0xd9, 0xa6, 0x51, 0xe5,
-	0xa0, 0xea, 0x89, 0xd5, 0xb7, 0xbe, 0xfd, 0xe6, 0xae, 0x15, 0xec, 0x0d, 0xb7, 0xc9, 0xee, 0x6f,
-	0xb1, 0xae, 0xaf, 0x5b, 0x2e, 0xff, 0x75, 0x2b, 0x64, 0xf7, 0x5b, 0x74, 0xb4, 0x5b, 0x64, 0xb4,
-	0xc1, 0xf6, 0x76, 0x85, 0xb6, 0xde, 0xfa, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x38, 0x8a,
-	0x8a, 0x2f, 0x62, 0x00, 0x00,
+func (m *DeprecatedSegmentIndex) Reset()         { *m = DeprecatedSegmentIndex{} }
+func (m *DeprecatedSegmentIndex) String() string { return proto.CompactTextString(m) }
+func (*DeprecatedSegmentIndex) ProtoMessage()    {}
+func (*DeprecatedSegmentIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{102}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
-	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
-	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
-	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
-	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
-	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
-	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
-	GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error)
-	GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+func (m *DeprecatedSegmentIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeprecatedSegmentIndex.Unmarshal(m, b)
 }
-
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *DeprecatedSegmentIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeprecatedSegmentIndex.Marshal(b, m, deterministic)
 }
-
-func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
-	return &dataCoordClient{cc}
+func (m *DeprecatedSegmentIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecatedSegmentIndex.Merge(m, src)
+}
+func (m *DeprecatedSegmentIndex) XXX_Size() int {
+	return xxx_messageInfo_DeprecatedSegmentIndex.Size(m)
+}
+func (m *DeprecatedSegmentIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecatedSegmentIndex.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
-	out := new(milvuspb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeprecatedSegmentIndex proto.InternalMessageInfo
+
+func (m *DeprecatedSegmentIndex) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeprecatedSegmentIndex) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeprecatedSegmentIndex) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeprecatedSegmentIndex) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeprecatedSegmentIndex) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
-	out := new(GetSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeprecatedSegmentIndex) GetEngineVersion() string {
+	if m != nil {
+		return m.EngineVersion
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
-	out := new(GetSegmentStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeprecatedSegmentIndex) GetFormatVersion() int32 {
+	if m != nil {
+		return m.FormatVersion
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
-	out := new(GetInsertBinlogPathsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RebuildDeprecatedIndexesRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the collection to rebuild the indexes of, 0 means all the collections
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// overrides dataCoord.index.minEngineVersion if not empty, e.g. to check against the release to upgrade to
+	MinEngineVersion string `protobuf:"bytes,3,opt,name=min_engine_version,json=minEngineVersion,proto3" json:"min_engine_version,omitempty"`
+	// overrides dataCoord.index.minFormatVersion if positive
+	MinFormatVersion int32 `protobuf:"varint,4,opt,name=min_format_version,json=minFormatVersion,proto3" json:"min_format_version,omitempty"`
+	// bounds the number of the indexes rebuilt at once, 0 means no limit
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// lists the deprecated indexes without rebuilding them
+	DryRun               bool     `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebuildDeprecatedIndexesRequest) Reset()         { *m = RebuildDeprecatedIndexesRequest{} }
+func (m *RebuildDeprecatedIndexesRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildDeprecatedIndexesRequest) ProtoMessage()    {}
+func (*RebuildDeprecatedIndexesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{103}
 }
 
-func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
-	out := new(GetPartitionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebuildDeprecatedIndexesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildDeprecatedIndexesRequest.Unmarshal(m, b)
+}
+func (m *RebuildDeprecatedIndexesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildDeprecatedIndexesRequest.Marshal(b, m, deterministic)
+}
+func (m *RebuildDeprecatedIndexesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildDeprecatedIndexesRequest.Merge(m, src)
+}
+func (m *RebuildDeprecatedIndexesRequest) XXX_Size() int {
+	return xxx_messageInfo_RebuildDeprecatedIndexesRequest.Size(m)
+}
+func (m *RebuildDeprecatedIndexesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildDeprecatedIndexesRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RebuildDeprecatedIndexesRequest proto.InternalMessageInfo
+
+func (m *RebuildDeprecatedIndexesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
-	out := new(GetRecoveryInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesRequest) GetMinEngineVersion() string {
+	if m != nil {
+		return m.MinEngineVersion
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
-	out := new(GetFlushedSegmentsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesRequest) GetMinFormatVersion() int32 {
+	if m != nil {
+		return m.MinFormatVersion
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error) {
-	out := new(GetSegmentsByStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentsByStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
-	out := new(internalpb.ShowConfigurationsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ShowConfigurations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return out, nil
+	return false
 }
 
-func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RebuildDeprecatedIndexesResponse struct {
+	Status     *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Deprecated []*DeprecatedSegmentIndex `protobuf:"bytes,2,rep,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// the builds reset and enqueued to rebuild, the ones with running tasks are left to the next call
+	RebuiltBuildIDs      []int64  `protobuf:"varint,3,rep,packed,name=rebuilt_buildIDs,json=rebuiltBuildIDs,proto3" json:"rebuilt_buildIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
-	out := new(milvuspb.ManualCompactionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebuildDeprecatedIndexesResponse) Reset()         { *m = RebuildDeprecatedIndexesResponse{} }
+func (m *RebuildDeprecatedIndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*RebuildDeprecatedIndexesResponse) ProtoMessage()    {}
+func (*RebuildDeprecatedIndexesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{104}
 }
 
-func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
-	out := new(milvuspb.GetCompactionStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebuildDeprecatedIndexesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildDeprecatedIndexesResponse.Unmarshal(m, b)
+}
+func (m *RebuildDeprecatedIndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildDeprecatedIndexesResponse.Marshal(b, m, deterministic)
+}
+func (m *RebuildDeprecatedIndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildDeprecatedIndexesResponse.Merge(m, src)
+}
+func (m *RebuildDeprecatedIndexesResponse) XXX_Size() int {
+	return xxx_messageInfo_RebuildDeprecatedIndexesResponse.Size(m)
+}
+func (m *RebuildDeprecatedIndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildDeprecatedIndexesResponse.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
-	out := new(milvuspb.GetCompactionPlansResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RebuildDeprecatedIndexesResponse proto.InternalMessageInfo
+
+func (m *RebuildDeprecatedIndexesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
-	out := new(WatchChannelsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesResponse) GetDeprecated() []*DeprecatedSegmentIndex {
+	if m != nil {
+		return m.Deprecated
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
-	out := new(milvuspb.GetFlushStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebuildDeprecatedIndexesResponse) GetRebuiltBuildIDs() []int64 {
+	if m != nil {
+		return m.RebuiltBuildIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error) {
-	out := new(DropVirtualChannelResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropVirtualChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateChannelCheckpointsRequest struct {
+	Base                 *commonpb.MsgBase                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Checkpoints          []*UpdateChannelCheckpointRequest `protobuf:"bytes,2,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (c *dataCoordClient) SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error) {
-	out := new(SetSegmentStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetSegmentState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateChannelCheckpointsRequest) Reset()         { *m = UpdateChannelCheckpointsRequest{} }
+func (m *UpdateChannelCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateChannelCheckpointsRequest) ProtoMessage()    {}
+func (*UpdateChannelCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{105}
 }
 
-func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error) {
-	out := new(ImportTaskResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateChannelCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChannelCheckpointsRequest.Unmarshal(m, b)
+}
+func (m *UpdateChannelCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChannelCheckpointsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateChannelCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChannelCheckpointsRequest.Merge(m, src)
+}
+func (m *UpdateChannelCheckpointsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateChannelCheckpointsRequest.Size(m)
+}
+func (m *UpdateChannelCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChannelCheckpointsRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateChannelCheckpointsRequest proto.InternalMessageInfo
+
+func (m *UpdateChannelCheckpointsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateChannelCheckpointsRequest) GetCheckpoints() []*UpdateChannelCheckpointRequest {
+	if m != nil {
+		return m.Checkpoints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveImportSegment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateChannelCheckpointsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the result of each vchannel, the failed ones can be retried
+	ChannelStatuses      map[string]*commonpb.Status `protobuf:"bytes,2,rep,name=channel_statuses,json=channelStatuses,proto3" json:"channel_statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (c *dataCoordClient) UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnsetIsImportingState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateChannelCheckpointsResponse) Reset()         { *m = UpdateChannelCheckpointsResponse{} }
+func (m *UpdateChannelCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateChannelCheckpointsResponse) ProtoMessage()    {}
+func (*UpdateChannelCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{106}
 }
 
-func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MarkSegmentsDropped", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateChannelCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChannelCheckpointsResponse.Unmarshal(m, b)
+}
+func (m *UpdateChannelCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChannelCheckpointsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateChannelCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChannelCheckpointsResponse.Merge(m, src)
+}
+func (m *UpdateChannelCheckpointsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateChannelCheckpointsResponse.Size(m)
+}
+func (m *UpdateChannelCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChannelCheckpointsResponse.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/BroadcastAlteredCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateChannelCheckpointsResponse proto.InternalMessageInfo
+
+func (m *UpdateChannelCheckpointsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
-	out := new(milvuspb.CheckHealthResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CheckHealth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateChannelCheckpointsResponse) GetChannelStatuses() map[string]*commonpb.Status {
+	if m != nil {
+		return m.ChannelStatuses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CreateIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetTimeTravelWatermarksRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *dataCoordClient) GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error) {
-	out := new(GetIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTimeTravelWatermarksRequest) Reset()         { *m = GetTimeTravelWatermarksRequest{} }
+func (m *GetTimeTravelWatermarksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTimeTravelWatermarksRequest) ProtoMessage()    {}
+func (*GetTimeTravelWatermarksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{107}
 }
 
-func (c *dataCoordClient) GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error) {
-	out := new(GetSegmentIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTimeTravelWatermarksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTimeTravelWatermarksRequest.Unmarshal(m, b)
+}
+func (m *GetTimeTravelWatermarksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTimeTravelWatermarksRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTimeTravelWatermarksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimeTravelWatermarksRequest.Merge(m, src)
+}
+func (m *GetTimeTravelWatermarksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTimeTravelWatermarksRequest.Size(m)
+}
+func (m *GetTimeTravelWatermarksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimeTravelWatermarksRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error) {
-	out := new(GetIndexInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTimeTravelWatermarksRequest proto.InternalMessageInfo
+
+func (m *GetTimeTravelWatermarksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GetTimeTravelWatermarksResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the retention duration the compactions keep the history for
+	RetentionSeconds int64 `protobuf:"varint,2,opt,name=retention_seconds,json=retentionSeconds,proto3" json:"retention_seconds,omitempty"`
+	// the max travel timestamp of the compactions completed since DataCoord started, by collection
+	CompactionWatermarks map[int64]uint64 `protobuf:"bytes,3,rep,name=compaction_watermarks,json=compactionWatermarks,proto3" json:"compaction_watermarks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetTimeTravelWatermarksResponse) Reset()         { *m = GetTimeTravelWatermarksResponse{} }
+func (m *GetTimeTravelWatermarksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTimeTravelWatermarksResponse) ProtoMessage()    {}
+func (*GetTimeTravelWatermarksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{108}
+}
+
+func (m *GetTimeTravelWatermarksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTimeTravelWatermarksResponse.Unmarshal(m, b)
+}
+func (m *GetTimeTravelWatermarksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTimeTravelWatermarksResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTimeTravelWatermarksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimeTravelWatermarksResponse.Merge(m, src)
+}
+func (m *GetTimeTravelWatermarksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTimeTravelWatermarksResponse.Size(m)
+}
+func (m *GetTimeTravelWatermarksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimeTravelWatermarksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimeTravelWatermarksResponse proto.InternalMessageInfo
+
+func (m *GetTimeTravelWatermarksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error) {
-	out := new(DescribeIndexResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DescribeIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTimeTravelWatermarksResponse) GetRetentionSeconds() int64 {
+	if m != nil {
+		return m.RetentionSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error) {
-	out := new(GetIndexBuildProgressResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexBuildProgress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTimeTravelWatermarksResponse) GetCompactionWatermarks() map[int64]uint64 {
+	if m != nil {
+		return m.CompactionWatermarks
 	}
-	return out, nil
+	return nil
 }
 
-// DataCoordServer is the server API for DataCoord service.
-type DataCoordServer interface {
-	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
-	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
-	AssignSegmentID(context.Context, *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(context.Context, *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(context.Context, *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(context.Context, *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
-	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
-	GetSegmentsByStates(context.Context, *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error)
-	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
-	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
-	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
-	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
-	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
-	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
-	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
-	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
-	SaveImportSegment(context.Context, *SaveImportSegmentRequest) (*commonpb.Status, error)
-	UnsetIsImportingState(context.Context, *UnsetIsImportingStateRequest) (*commonpb.Status, error)
-	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
-	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
-	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
-	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
-	GetSegmentIndexState(context.Context, *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error)
-	GetIndexInfos(context.Context, *GetIndexInfoRequest) (*GetIndexInfoResponse, error)
-	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
-	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
+// SegmentHeat is the query heat of a sealed segment, the number of the searches and queries on it decayed by half
+// every half life.
+type SegmentHeat struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Heat                 float64  `protobuf:"fixed64,3,opt,name=heat,proto3" json:"heat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
-type UnimplementedDataCoordServer struct {
+func (m *SegmentHeat) Reset()         { *m = SegmentHeat{} }
+func (m *SegmentHeat) String() string { return proto.CompactTextString(m) }
+func (*SegmentHeat) ProtoMessage()    {}
+func (*SegmentHeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{109}
 }
 
-func (*UnimplementedDataCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+func (m *SegmentHeat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHeat.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+func (m *SegmentHeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHeat.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+func (m *SegmentHeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHeat.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+func (m *SegmentHeat) XXX_Size() int {
+	return xxx_messageInfo_SegmentHeat.Size(m)
 }
-func (*UnimplementedDataCoordServer) AssignSegmentID(ctx context.Context, req *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignSegmentID not implemented")
+func (m *SegmentHeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHeat.DiscardUnknown(m)
 }
-func (*UnimplementedDataCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+
+var xxx_messageInfo_SegmentHeat proto.InternalMessageInfo
+
+func (m *SegmentHeat) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetSegmentStates(ctx context.Context, req *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentStates not implemented")
+
+func (m *SegmentHeat) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetInsertBinlogPaths(ctx context.Context, req *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInsertBinlogPaths not implemented")
+
+func (m *SegmentHeat) GetHeat() float64 {
+	if m != nil {
+		return m.Heat
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetCollectionStatistics(ctx context.Context, req *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatistics not implemented")
+
+type ReportSegmentHeatsRequest struct {
+	// the source id is the QueryNode reporting the heats
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the heats of the sealed segments served by the QueryNode, they replace the ones it reported before
+	Segments             []*SegmentHeat `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (*UnimplementedDataCoordServer) GetPartitionStatistics(ctx context.Context, req *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStatistics not implemented")
+
+func (m *ReportSegmentHeatsRequest) Reset()         { *m = ReportSegmentHeatsRequest{} }
+func (m *ReportSegmentHeatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportSegmentHeatsRequest) ProtoMessage()    {}
+func (*ReportSegmentHeatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{110}
 }
-func (*UnimplementedDataCoordServer) GetSegmentInfoChannel(ctx context.Context, req *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfoChannel not implemented")
+
+func (m *ReportSegmentHeatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportSegmentHeatsRequest.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) SaveBinlogPaths(ctx context.Context, req *SaveBinlogPathsRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveBinlogPaths not implemented")
+func (m *ReportSegmentHeatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportSegmentHeatsRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
+func (m *ReportSegmentHeatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportSegmentHeatsRequest.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
+func (m *ReportSegmentHeatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportSegmentHeatsRequest.Size(m)
 }
-func (*UnimplementedDataCoordServer) GetSegmentsByStates(ctx context.Context, req *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsByStates not implemented")
+func (m *ReportSegmentHeatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportSegmentHeatsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedDataCoordServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
+
+var xxx_messageInfo_ReportSegmentHeatsRequest proto.InternalMessageInfo
+
+func (m *ReportSegmentHeatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+
+func (m *ReportSegmentHeatsRequest) GetSegments() []*SegmentHeat {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+
+type GetSegmentHeatsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 for all the collections
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+
+func (m *GetSegmentHeatsRequest) Reset()         { *m = GetSegmentHeatsRequest{} }
+func (m *GetSegmentHeatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentHeatsRequest) ProtoMessage()    {}
+func (*GetSegmentHeatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{111}
 }
-func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+
+func (m *GetSegmentHeatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentHeatsRequest.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
+func (m *GetSegmentHeatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentHeatsRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlushState not implemented")
+func (m *GetSegmentHeatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentHeatsRequest.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
+func (m *GetSegmentHeatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentHeatsRequest.Size(m)
 }
-func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *SetSegmentStateRequest) (*SetSegmentStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSegmentState not implemented")
+func (m *GetSegmentHeatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentHeatsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+
+var xxx_messageInfo_GetSegmentHeatsRequest proto.InternalMessageInfo
+
+func (m *GetSegmentHeatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
+
+func (m *GetSegmentHeatsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) UpdateChannelCheckpoint(ctx context.Context, req *UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelCheckpoint not implemented")
+
+type GetSegmentHeatsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments             []*SegmentHeat   `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (*UnimplementedDataCoordServer) SaveImportSegment(ctx context.Context, req *SaveImportSegmentRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveImportSegment not implemented")
+
+func (m *GetSegmentHeatsResponse) Reset()         { *m = GetSegmentHeatsResponse{} }
+func (m *GetSegmentHeatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentHeatsResponse) ProtoMessage()    {}
+func (*GetSegmentHeatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{112}
 }
-func (*UnimplementedDataCoordServer) UnsetIsImportingState(ctx context.Context, req *UnsetIsImportingStateRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsetIsImportingState not implemented")
+
+func (m *GetSegmentHeatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentHeatsResponse.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) MarkSegmentsDropped(ctx context.Context, req *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
+func (m *GetSegmentHeatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentHeatsResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) BroadcastAlteredCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
+func (m *GetSegmentHeatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentHeatsResponse.Merge(m, src)
+}
+func (m *GetSegmentHeatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentHeatsResponse.Size(m)
+}
+func (m *GetSegmentHeatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentHeatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentHeatsResponse proto.InternalMessageInfo
+
+func (m *GetSegmentHeatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentHeatsResponse) GetSegments() []*SegmentHeat {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type GetSegmentHistoryRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID            int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSegmentHistoryRequest) Reset()         { *m = GetSegmentHistoryRequest{} }
+func (m *GetSegmentHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentHistoryRequest) ProtoMessage()    {}
+func (*GetSegmentHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{113}
+}
+
+func (m *GetSegmentHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentHistoryRequest.Merge(m, src)
+}
+func (m *GetSegmentHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentHistoryRequest.Size(m)
+}
+func (m *GetSegmentHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentHistoryRequest proto.InternalMessageInfo
+
+func (m *GetSegmentHistoryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentHistoryRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+// SegmentStateEvent is a state transition of a segment, from is SegmentStateNone when the segment is created
+type SegmentStateEvent struct {
+	From commonpb.SegmentState `protobuf:"varint,1,opt,name=from,proto3,enum=milvus.proto.common.SegmentState" json:"from,omitempty"`
+	To   commonpb.SegmentState `protobuf:"varint,2,opt,name=to,proto3,enum=milvus.proto.common.SegmentState" json:"to,omitempty"`
+	// e.g. flush rpc, seal policy, import, compaction
+	Cause string `protobuf:"bytes,3,opt,name=cause,proto3" json:"cause,omitempty"`
+	// unix time in milliseconds
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentStateEvent) Reset()         { *m = SegmentStateEvent{} }
+func (m *SegmentStateEvent) String() string { return proto.CompactTextString(m) }
+func (*SegmentStateEvent) ProtoMessage()    {}
+func (*SegmentStateEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{114}
+}
+
+func (m *SegmentStateEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentStateEvent.Unmarshal(m, b)
+}
+func (m *SegmentStateEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentStateEvent.Marshal(b, m, deterministic)
+}
+func (m *SegmentStateEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentStateEvent.Merge(m, src)
+}
+func (m *SegmentStateEvent) XXX_Size() int {
+	return xxx_messageInfo_SegmentStateEvent.Size(m)
+}
+func (m *SegmentStateEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentStateEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentStateEvent proto.InternalMessageInfo
+
+func (m *SegmentStateEvent) GetFrom() commonpb.SegmentState {
+	if m != nil {
+		return m.From
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentStateEvent) GetTo() commonpb.SegmentState {
+	if m != nil {
+		return m.To
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentStateEvent) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *SegmentStateEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetSegmentHistoryResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64            `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID    int64            `protobuf:"varint,4,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Channel      string           `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the oldest first
+	Events               []*SegmentStateEvent `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetSegmentHistoryResponse) Reset()         { *m = GetSegmentHistoryResponse{} }
+func (m *GetSegmentHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentHistoryResponse) ProtoMessage()    {}
+func (*GetSegmentHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{115}
+}
+
+func (m *GetSegmentHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentHistoryResponse.Merge(m, src)
+}
+func (m *GetSegmentHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentHistoryResponse.Size(m)
+}
+func (m *GetSegmentHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentHistoryResponse proto.InternalMessageInfo
+
+func (m *GetSegmentHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentHistoryResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetSegmentHistoryResponse) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *GetSegmentHistoryResponse) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *GetSegmentHistoryResponse) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *GetSegmentHistoryResponse) GetEvents() []*SegmentStateEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type PauseCollectionMaintenanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Scope                MaintenanceScope  `protobuf:"varint,3,opt,name=scope,proto3,enum=milvus.proto.data.MaintenanceScope" json:"scope,omitempty"`
+	Reason               string            `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PauseCollectionMaintenanceRequest) Reset()         { *m = PauseCollectionMaintenanceRequest{} }
+func (m *PauseCollectionMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseCollectionMaintenanceRequest) ProtoMessage()    {}
+func (*PauseCollectionMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{116}
+}
+
+func (m *PauseCollectionMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseCollectionMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *PauseCollectionMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseCollectionMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseCollectionMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseCollectionMaintenanceRequest.Merge(m, src)
+}
+func (m *PauseCollectionMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseCollectionMaintenanceRequest.Size(m)
+}
+func (m *PauseCollectionMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseCollectionMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseCollectionMaintenanceRequest proto.InternalMessageInfo
+
+func (m *PauseCollectionMaintenanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PauseCollectionMaintenanceRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PauseCollectionMaintenanceRequest) GetScope() MaintenanceScope {
+	if m != nil {
+		return m.Scope
+	}
+	return MaintenanceScope_MaintenanceAll
+}
+
+func (m *PauseCollectionMaintenanceRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// CollectionMaintenancePause is the paused maintenance of a collection
+type CollectionMaintenancePause struct {
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Compaction   bool   `protobuf:"varint,2,opt,name=compaction,proto3" json:"compaction,omitempty"`
+	Gc           bool   `protobuf:"varint,3,opt,name=gc,proto3" json:"gc,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// unix time in milliseconds
+	PausedAt             int64    `protobuf:"varint,5,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionMaintenancePause) Reset()         { *m = CollectionMaintenancePause{} }
+func (m *CollectionMaintenancePause) String() string { return proto.CompactTextString(m) }
+func (*CollectionMaintenancePause) ProtoMessage()    {}
+func (*CollectionMaintenancePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{117}
+}
+
+func (m *CollectionMaintenancePause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionMaintenancePause.Unmarshal(m, b)
+}
+func (m *CollectionMaintenancePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionMaintenancePause.Marshal(b, m, deterministic)
+}
+func (m *CollectionMaintenancePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionMaintenancePause.Merge(m, src)
+}
+func (m *CollectionMaintenancePause) XXX_Size() int {
+	return xxx_messageInfo_CollectionMaintenancePause.Size(m)
+}
+func (m *CollectionMaintenancePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionMaintenancePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionMaintenancePause proto.InternalMessageInfo
+
+func (m *CollectionMaintenancePause) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionMaintenancePause) GetCompaction() bool {
+	if m != nil {
+		return m.Compaction
+	}
+	return false
+}
+
+func (m *CollectionMaintenancePause) GetGc() bool {
+	if m != nil {
+		return m.Gc
+	}
+	return false
+}
+
+func (m *CollectionMaintenancePause) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CollectionMaintenancePause) GetPausedAt() int64 {
+	if m != nil {
+		return m.PausedAt
+	}
+	return 0
+}
+
+type PauseCollectionMaintenanceResponse struct {
+	Status               *commonpb.Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Pause                *CollectionMaintenancePause `protobuf:"bytes,2,opt,name=pause,proto3" json:"pause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PauseCollectionMaintenanceResponse) Reset()         { *m = PauseCollectionMaintenanceResponse{} }
+func (m *PauseCollectionMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseCollectionMaintenanceResponse) ProtoMessage()    {}
+func (*PauseCollectionMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{118}
+}
+
+func (m *PauseCollectionMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseCollectionMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *PauseCollectionMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseCollectionMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseCollectionMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseCollectionMaintenanceResponse.Merge(m, src)
+}
+func (m *PauseCollectionMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseCollectionMaintenanceResponse.Size(m)
+}
+func (m *PauseCollectionMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseCollectionMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseCollectionMaintenanceResponse proto.InternalMessageInfo
+
+func (m *PauseCollectionMaintenanceResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PauseCollectionMaintenanceResponse) GetPause() *CollectionMaintenancePause {
+	if m != nil {
+		return m.Pause
+	}
+	return nil
+}
+
+type ResumeCollectionMaintenanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Scope                MaintenanceScope  `protobuf:"varint,3,opt,name=scope,proto3,enum=milvus.proto.data.MaintenanceScope" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeCollectionMaintenanceRequest) Reset()         { *m = ResumeCollectionMaintenanceRequest{} }
+func (m *ResumeCollectionMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeCollectionMaintenanceRequest) ProtoMessage()    {}
+func (*ResumeCollectionMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{119}
+}
+
+func (m *ResumeCollectionMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeCollectionMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *ResumeCollectionMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeCollectionMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeCollectionMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeCollectionMaintenanceRequest.Merge(m, src)
+}
+func (m *ResumeCollectionMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeCollectionMaintenanceRequest.Size(m)
+}
+func (m *ResumeCollectionMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeCollectionMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeCollectionMaintenanceRequest proto.InternalMessageInfo
+
+func (m *ResumeCollectionMaintenanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ResumeCollectionMaintenanceRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ResumeCollectionMaintenanceRequest) GetScope() MaintenanceScope {
+	if m != nil {
+		return m.Scope
+	}
+	return MaintenanceScope_MaintenanceAll
+}
+
+type GetCollectionMaintenancePausesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCollectionMaintenancePausesRequest) Reset()         { *m = GetCollectionMaintenancePausesRequest{} }
+func (m *GetCollectionMaintenancePausesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionMaintenancePausesRequest) ProtoMessage()    {}
+func (*GetCollectionMaintenancePausesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{120}
+}
+
+func (m *GetCollectionMaintenancePausesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionMaintenancePausesRequest.Unmarshal(m, b)
+}
+func (m *GetCollectionMaintenancePausesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionMaintenancePausesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionMaintenancePausesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionMaintenancePausesRequest.Merge(m, src)
+}
+func (m *GetCollectionMaintenancePausesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionMaintenancePausesRequest.Size(m)
+}
+func (m *GetCollectionMaintenancePausesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionMaintenancePausesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionMaintenancePausesRequest proto.InternalMessageInfo
+
+func (m *GetCollectionMaintenancePausesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetCollectionMaintenancePausesResponse struct {
+	Status               *commonpb.Status              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Pauses               []*CollectionMaintenancePause `protobuf:"bytes,2,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *GetCollectionMaintenancePausesResponse) Reset() {
+	*m = GetCollectionMaintenancePausesResponse{}
+}
+func (m *GetCollectionMaintenancePausesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionMaintenancePausesResponse) ProtoMessage()    {}
+func (*GetCollectionMaintenancePausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{121}
+}
+
+func (m *GetCollectionMaintenancePausesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionMaintenancePausesResponse.Unmarshal(m, b)
+}
+func (m *GetCollectionMaintenancePausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionMaintenancePausesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionMaintenancePausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionMaintenancePausesResponse.Merge(m, src)
+}
+func (m *GetCollectionMaintenancePausesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionMaintenancePausesResponse.Size(m)
+}
+func (m *GetCollectionMaintenancePausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionMaintenancePausesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionMaintenancePausesResponse proto.InternalMessageInfo
+
+func (m *GetCollectionMaintenancePausesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCollectionMaintenancePausesResponse) GetPauses() []*CollectionMaintenancePause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
+type DecommissionRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the DataNode to decommission, DataCoord forwards the request to it
+	NodeID int64 `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// false only returns the decommission state
+	Start bool `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	// wait blocks the request until the decommission is finished or failed
+	Wait                 bool     `protobuf:"varint,4,opt,name=wait,proto3" json:"wait,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecommissionRequest) Reset()         { *m = DecommissionRequest{} }
+func (m *DecommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*DecommissionRequest) ProtoMessage()    {}
+func (*DecommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{122}
+}
+
+func (m *DecommissionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionRequest.Unmarshal(m, b)
+}
+func (m *DecommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionRequest.Marshal(b, m, deterministic)
+}
+func (m *DecommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionRequest.Merge(m, src)
+}
+func (m *DecommissionRequest) XXX_Size() int {
+	return xxx_messageInfo_DecommissionRequest.Size(m)
+}
+func (m *DecommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionRequest proto.InternalMessageInfo
+
+func (m *DecommissionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DecommissionRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *DecommissionRequest) GetStart() bool {
+	if m != nil {
+		return m.Start
+	}
+	return false
+}
+
+func (m *DecommissionRequest) GetWait() bool {
+	if m != nil {
+		return m.Wait
+	}
+	return false
+}
+
+// ChannelDecommission is the drain state of a vchannel of the decommissioned DataNode
+type ChannelDecommission struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// one of none, syncing, updating, drained and failed
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	CheckpointTs         uint64   `protobuf:"varint,3,opt,name=checkpoint_ts,json=checkpointTs,proto3" json:"checkpoint_ts,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelDecommission) Reset()         { *m = ChannelDecommission{} }
+func (m *ChannelDecommission) String() string { return proto.CompactTextString(m) }
+func (*ChannelDecommission) ProtoMessage()    {}
+func (*ChannelDecommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{123}
+}
+
+func (m *ChannelDecommission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelDecommission.Unmarshal(m, b)
+}
+func (m *ChannelDecommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelDecommission.Marshal(b, m, deterministic)
+}
+func (m *ChannelDecommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDecommission.Merge(m, src)
+}
+func (m *ChannelDecommission) XXX_Size() int {
+	return xxx_messageInfo_ChannelDecommission.Size(m)
+}
+func (m *ChannelDecommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDecommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDecommission proto.InternalMessageInfo
+
+func (m *ChannelDecommission) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelDecommission) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ChannelDecommission) GetCheckpointTs() uint64 {
+	if m != nil {
+		return m.CheckpointTs
+	}
+	return 0
+}
+
+func (m *ChannelDecommission) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DecommissionResponse struct {
+	Status *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State  DecommissionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.DecommissionState" json:"state,omitempty"`
+	// start_time is in milliseconds, 0 if the decommission is not started
+	StartTime            int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Error                string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Channels             []*ChannelDecommission `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	ReleasedFlowgraphs   int64                  `protobuf:"varint,6,opt,name=released_flowgraphs,json=releasedFlowgraphs,proto3" json:"released_flowgraphs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DecommissionResponse) Reset()         { *m = DecommissionResponse{} }
+func (m *DecommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*DecommissionResponse) ProtoMessage()    {}
+func (*DecommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{124}
+}
+
+func (m *DecommissionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionResponse.Unmarshal(m, b)
+}
+func (m *DecommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionResponse.Marshal(b, m, deterministic)
+}
+func (m *DecommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionResponse.Merge(m, src)
+}
+func (m *DecommissionResponse) XXX_Size() int {
+	return xxx_messageInfo_DecommissionResponse.Size(m)
+}
+func (m *DecommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionResponse proto.InternalMessageInfo
+
+func (m *DecommissionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DecommissionResponse) GetState() DecommissionState {
+	if m != nil {
+		return m.State
+	}
+	return DecommissionState_DecommissionNone
+}
+
+func (m *DecommissionResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *DecommissionResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DecommissionResponse) GetChannels() []*ChannelDecommission {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *DecommissionResponse) GetReleasedFlowgraphs() int64 {
+	if m != nil {
+		return m.ReleasedFlowgraphs
+	}
+	return 0
+}
+
+type LocatePrimaryKeysRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the primary keys in text, parsed in the data type of the primary field
+	Pks                  []string `protobuf:"bytes,3,rep,name=pks,proto3" json:"pks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocatePrimaryKeysRequest) Reset()         { *m = LocatePrimaryKeysRequest{} }
+func (m *LocatePrimaryKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*LocatePrimaryKeysRequest) ProtoMessage()    {}
+func (*LocatePrimaryKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{125}
+}
+
+func (m *LocatePrimaryKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocatePrimaryKeysRequest.Unmarshal(m, b)
+}
+func (m *LocatePrimaryKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocatePrimaryKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *LocatePrimaryKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocatePrimaryKeysRequest.Merge(m, src)
+}
+func (m *LocatePrimaryKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_LocatePrimaryKeysRequest.Size(m)
+}
+func (m *LocatePrimaryKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocatePrimaryKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocatePrimaryKeysRequest proto.InternalMessageInfo
+
+func (m *LocatePrimaryKeysRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LocatePrimaryKeysRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LocatePrimaryKeysRequest) GetPks() []string {
+	if m != nil {
+		return m.Pks
+	}
+	return nil
+}
+
+// PrimaryKeyLocation is the flushed segments which may contain a primary key
+type PrimaryKeyLocation struct {
+	Pk                   string   `protobuf:"bytes,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrimaryKeyLocation) Reset()         { *m = PrimaryKeyLocation{} }
+func (m *PrimaryKeyLocation) String() string { return proto.CompactTextString(m) }
+func (*PrimaryKeyLocation) ProtoMessage()    {}
+func (*PrimaryKeyLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{126}
+}
+
+func (m *PrimaryKeyLocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimaryKeyLocation.Unmarshal(m, b)
+}
+func (m *PrimaryKeyLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimaryKeyLocation.Marshal(b, m, deterministic)
+}
+func (m *PrimaryKeyLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimaryKeyLocation.Merge(m, src)
+}
+func (m *PrimaryKeyLocation) XXX_Size() int {
+	return xxx_messageInfo_PrimaryKeyLocation.Size(m)
+}
+func (m *PrimaryKeyLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimaryKeyLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimaryKeyLocation proto.InternalMessageInfo
+
+func (m *PrimaryKeyLocation) GetPk() string {
+	if m != nil {
+		return m.Pk
+	}
+	return ""
+}
+
+func (m *PrimaryKeyLocation) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type LocatePrimaryKeysResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// in the order of the pks of the request
+	Locations []*PrimaryKeyLocation `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	// the segments not flushed yet, which may contain any primary key
+	UnflushedSegmentIDs  []int64  `protobuf:"varint,4,rep,packed,name=unflushedSegmentIDs,proto3" json:"unflushedSegmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocatePrimaryKeysResponse) Reset()         { *m = LocatePrimaryKeysResponse{} }
+func (m *LocatePrimaryKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*LocatePrimaryKeysResponse) ProtoMessage()    {}
+func (*LocatePrimaryKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{127}
+}
+
+func (m *LocatePrimaryKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocatePrimaryKeysResponse.Unmarshal(m, b)
+}
+func (m *LocatePrimaryKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocatePrimaryKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *LocatePrimaryKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocatePrimaryKeysResponse.Merge(m, src)
+}
+func (m *LocatePrimaryKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_LocatePrimaryKeysResponse.Size(m)
+}
+func (m *LocatePrimaryKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocatePrimaryKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocatePrimaryKeysResponse proto.InternalMessageInfo
+
+func (m *LocatePrimaryKeysResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *LocatePrimaryKeysResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LocatePrimaryKeysResponse) GetLocations() []*PrimaryKeyLocation {
+	if m != nil {
+		return m.Locations
+	}
+	return nil
+}
+
+func (m *LocatePrimaryKeysResponse) GetUnflushedSegmentIDs() []int64 {
+	if m != nil {
+		return m.UnflushedSegmentIDs
+	}
+	return nil
+}
+
+type CompactSegmentsRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the flushed segments of the same channel and partition to compact into one
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactSegmentsRequest) Reset()         { *m = CompactSegmentsRequest{} }
+func (m *CompactSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactSegmentsRequest) ProtoMessage()    {}
+func (*CompactSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{128}
+}
+
+func (m *CompactSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactSegmentsRequest.Unmarshal(m, b)
+}
+func (m *CompactSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *CompactSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactSegmentsRequest.Merge(m, src)
+}
+func (m *CompactSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_CompactSegmentsRequest.Size(m)
+}
+func (m *CompactSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactSegmentsRequest proto.InternalMessageInfo
+
+func (m *CompactSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CompactSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CompactSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type GetHandoffGateRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 for all the collections
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHandoffGateRequest) Reset()         { *m = GetHandoffGateRequest{} }
+func (m *GetHandoffGateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHandoffGateRequest) ProtoMessage()    {}
+func (*GetHandoffGateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{129}
+}
+
+func (m *GetHandoffGateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHandoffGateRequest.Unmarshal(m, b)
+}
+func (m *GetHandoffGateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHandoffGateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHandoffGateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHandoffGateRequest.Merge(m, src)
+}
+func (m *GetHandoffGateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHandoffGateRequest.Size(m)
+}
+func (m *GetHandoffGateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHandoffGateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHandoffGateRequest proto.InternalMessageInfo
+
+func (m *GetHandoffGateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetHandoffGateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// HandoffGateSegment is the handoff gating state of a flushing or flushed segment
+type HandoffGateSegment struct {
+	SegmentID    int64  `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID int64  `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64  `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Channel      string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	NumRows      int64  `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// one of indexed, waiting, released and frozen
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// flushed_at is in milliseconds, 0 if the segment has no position
+	FlushedAt     int64   `protobuf:"varint,7,opt,name=flushed_at,json=flushedAt,proto3" json:"flushed_at,omitempty"`
+	WaitedSeconds float64 `protobuf:"fixed64,8,opt,name=waited_seconds,json=waitedSeconds,proto3" json:"waited_seconds,omitempty"`
+	// the time left before a waiting segment is released, 0 if it waits for its index without limit
+	RemainingSeconds     float64  `protobuf:"fixed64,9,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffGateSegment) Reset()         { *m = HandoffGateSegment{} }
+func (m *HandoffGateSegment) String() string { return proto.CompactTextString(m) }
+func (*HandoffGateSegment) ProtoMessage()    {}
+func (*HandoffGateSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{130}
+}
+
+func (m *HandoffGateSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffGateSegment.Unmarshal(m, b)
+}
+func (m *HandoffGateSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffGateSegment.Marshal(b, m, deterministic)
+}
+func (m *HandoffGateSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffGateSegment.Merge(m, src)
+}
+func (m *HandoffGateSegment) XXX_Size() int {
+	return xxx_messageInfo_HandoffGateSegment.Size(m)
+}
+func (m *HandoffGateSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffGateSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffGateSegment proto.InternalMessageInfo
+
+func (m *HandoffGateSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *HandoffGateSegment) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *HandoffGateSegment) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *HandoffGateSegment) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *HandoffGateSegment) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *HandoffGateSegment) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *HandoffGateSegment) GetFlushedAt() int64 {
+	if m != nil {
+		return m.FlushedAt
+	}
+	return 0
+}
+
+func (m *HandoffGateSegment) GetWaitedSeconds() float64 {
+	if m != nil {
+		return m.WaitedSeconds
+	}
+	return 0
+}
+
+func (m *HandoffGateSegment) GetRemainingSeconds() float64 {
+	if m != nil {
+		return m.RemainingSeconds
+	}
+	return 0
+}
+
+type GetHandoffGateResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Enabled              bool                  `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxWaitSeconds       int64                 `protobuf:"varint,3,opt,name=max_wait_seconds,json=maxWaitSeconds,proto3" json:"max_wait_seconds,omitempty"`
+	Segments             []*HandoffGateSegment `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetHandoffGateResponse) Reset()         { *m = GetHandoffGateResponse{} }
+func (m *GetHandoffGateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHandoffGateResponse) ProtoMessage()    {}
+func (*GetHandoffGateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{131}
+}
+
+func (m *GetHandoffGateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHandoffGateResponse.Unmarshal(m, b)
+}
+func (m *GetHandoffGateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHandoffGateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetHandoffGateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHandoffGateResponse.Merge(m, src)
+}
+func (m *GetHandoffGateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHandoffGateResponse.Size(m)
+}
+func (m *GetHandoffGateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHandoffGateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHandoffGateResponse proto.InternalMessageInfo
+
+func (m *GetHandoffGateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetHandoffGateResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GetHandoffGateResponse) GetMaxWaitSeconds() int64 {
+	if m != nil {
+		return m.MaxWaitSeconds
+	}
+	return 0
+}
+
+func (m *GetHandoffGateResponse) GetSegments() []*HandoffGateSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type GetSegmentAnomaliesRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 for the abnormal collections only
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentAnomaliesRequest) Reset()         { *m = GetSegmentAnomaliesRequest{} }
+func (m *GetSegmentAnomaliesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentAnomaliesRequest) ProtoMessage()    {}
+func (*GetSegmentAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{132}
+}
+
+func (m *GetSegmentAnomaliesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentAnomaliesRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentAnomaliesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentAnomaliesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentAnomaliesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentAnomaliesRequest.Merge(m, src)
+}
+func (m *GetSegmentAnomaliesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentAnomaliesRequest.Size(m)
+}
+func (m *GetSegmentAnomaliesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentAnomaliesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentAnomaliesRequest proto.InternalMessageInfo
+
+func (m *GetSegmentAnomaliesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentAnomaliesRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// SegmentAnomaly is an abnormal pattern of the segments of a collection
+type SegmentAnomaly struct {
+	// one of tiny_segments, giant_segments and stalled_sealing
+	Kind        string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	NumSegments int64  `protobuf:"varint,2,opt,name=num_segments,json=numSegments,proto3" json:"num_segments,omitempty"`
+	// at most 10 of the segments, the smallest ids first
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	Recommendation       string   `protobuf:"bytes,4,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentAnomaly) Reset()         { *m = SegmentAnomaly{} }
+func (m *SegmentAnomaly) String() string { return proto.CompactTextString(m) }
+func (*SegmentAnomaly) ProtoMessage()    {}
+func (*SegmentAnomaly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{133}
+}
+
+func (m *SegmentAnomaly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentAnomaly.Unmarshal(m, b)
+}
+func (m *SegmentAnomaly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentAnomaly.Marshal(b, m, deterministic)
+}
+func (m *SegmentAnomaly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentAnomaly.Merge(m, src)
+}
+func (m *SegmentAnomaly) XXX_Size() int {
+	return xxx_messageInfo_SegmentAnomaly.Size(m)
+}
+func (m *SegmentAnomaly) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentAnomaly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentAnomaly proto.InternalMessageInfo
+
+func (m *SegmentAnomaly) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *SegmentAnomaly) GetNumSegments() int64 {
+	if m != nil {
+		return m.NumSegments
+	}
+	return 0
+}
+
+func (m *SegmentAnomaly) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *SegmentAnomaly) GetRecommendation() string {
+	if m != nil {
+		return m.Recommendation
+	}
+	return ""
+}
+
+// CollectionSegmentReport is the segment count and size of a collection with the anomalies detected
+type CollectionSegmentReport struct {
+	CollectionID         int64             `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NumSegments          int64             `protobuf:"varint,2,opt,name=num_segments,json=numSegments,proto3" json:"num_segments,omitempty"`
+	NumFlushed           int64             `protobuf:"varint,3,opt,name=num_flushed,json=numFlushed,proto3" json:"num_flushed,omitempty"`
+	NumRows              int64             `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	BinlogSize           int64             `protobuf:"varint,5,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	Anomalies            []*SegmentAnomaly `protobuf:"bytes,6,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CollectionSegmentReport) Reset()         { *m = CollectionSegmentReport{} }
+func (m *CollectionSegmentReport) String() string { return proto.CompactTextString(m) }
+func (*CollectionSegmentReport) ProtoMessage()    {}
+func (*CollectionSegmentReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{134}
+}
+
+func (m *CollectionSegmentReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionSegmentReport.Unmarshal(m, b)
+}
+func (m *CollectionSegmentReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionSegmentReport.Marshal(b, m, deterministic)
+}
+func (m *CollectionSegmentReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionSegmentReport.Merge(m, src)
+}
+func (m *CollectionSegmentReport) XXX_Size() int {
+	return xxx_messageInfo_CollectionSegmentReport.Size(m)
+}
+func (m *CollectionSegmentReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionSegmentReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionSegmentReport proto.InternalMessageInfo
+
+func (m *CollectionSegmentReport) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionSegmentReport) GetNumSegments() int64 {
+	if m != nil {
+		return m.NumSegments
+	}
+	return 0
+}
+
+func (m *CollectionSegmentReport) GetNumFlushed() int64 {
+	if m != nil {
+		return m.NumFlushed
+	}
+	return 0
+}
+
+func (m *CollectionSegmentReport) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *CollectionSegmentReport) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *CollectionSegmentReport) GetAnomalies() []*SegmentAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
+type GetSegmentAnomaliesResponse struct {
+	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reports              []*CollectionSegmentReport `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetSegmentAnomaliesResponse) Reset()         { *m = GetSegmentAnomaliesResponse{} }
+func (m *GetSegmentAnomaliesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentAnomaliesResponse) ProtoMessage()    {}
+func (*GetSegmentAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{135}
+}
+
+func (m *GetSegmentAnomaliesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentAnomaliesResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentAnomaliesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentAnomaliesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentAnomaliesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentAnomaliesResponse.Merge(m, src)
+}
+func (m *GetSegmentAnomaliesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentAnomaliesResponse.Size(m)
+}
+func (m *GetSegmentAnomaliesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentAnomaliesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentAnomaliesResponse proto.InternalMessageInfo
+
+func (m *GetSegmentAnomaliesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentAnomaliesResponse) GetReports() []*CollectionSegmentReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type MergeTinySegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MergeTinySegmentsRequest) Reset()         { *m = MergeTinySegmentsRequest{} }
+func (m *MergeTinySegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeTinySegmentsRequest) ProtoMessage()    {}
+func (*MergeTinySegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{136}
+}
+
+func (m *MergeTinySegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeTinySegmentsRequest.Unmarshal(m, b)
+}
+func (m *MergeTinySegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeTinySegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *MergeTinySegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeTinySegmentsRequest.Merge(m, src)
+}
+func (m *MergeTinySegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeTinySegmentsRequest.Size(m)
+}
+func (m *MergeTinySegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeTinySegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeTinySegmentsRequest proto.InternalMessageInfo
+
+func (m *MergeTinySegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MergeTinySegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetSegmentAllocHintsRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// 0 for all the partitions of the collection
+	PartitionID          int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentAllocHintsRequest) Reset()         { *m = GetSegmentAllocHintsRequest{} }
+func (m *GetSegmentAllocHintsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentAllocHintsRequest) ProtoMessage()    {}
+func (*GetSegmentAllocHintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{137}
+}
+
+func (m *GetSegmentAllocHintsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentAllocHintsRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentAllocHintsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentAllocHintsRequest.Merge(m, src)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentAllocHintsRequest.Size(m)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentAllocHintsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentAllocHintsRequest proto.InternalMessageInfo
+
+func (m *GetSegmentAllocHintsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentAllocHintsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetSegmentAllocHintsRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+// ChannelAllocHint is the allocation hint of a vchannel for the new segments of a partition
+type ChannelAllocHint struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	NumRows int64  `protobuf:"varint,2,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the rows of the vchannel over the mean rows of the partition's vchannels
+	Skew float64 `protobuf:"fixed64,3,opt,name=skew,proto3" json:"skew,omitempty"`
+	// the ratio applied to the max rows of the new segments of the vchannel
+	RowLimitRatio        float64  `protobuf:"fixed64,4,opt,name=row_limit_ratio,json=rowLimitRatio,proto3" json:"row_limit_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelAllocHint) Reset()         { *m = ChannelAllocHint{} }
+func (m *ChannelAllocHint) String() string { return proto.CompactTextString(m) }
+func (*ChannelAllocHint) ProtoMessage()    {}
+func (*ChannelAllocHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{138}
+}
+
+func (m *ChannelAllocHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelAllocHint.Unmarshal(m, b)
+}
+func (m *ChannelAllocHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelAllocHint.Marshal(b, m, deterministic)
+}
+func (m *ChannelAllocHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelAllocHint.Merge(m, src)
+}
+func (m *ChannelAllocHint) XXX_Size() int {
+	return xxx_messageInfo_ChannelAllocHint.Size(m)
+}
+func (m *ChannelAllocHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelAllocHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelAllocHint proto.InternalMessageInfo
+
+func (m *ChannelAllocHint) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelAllocHint) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *ChannelAllocHint) GetSkew() float64 {
+	if m != nil {
+		return m.Skew
+	}
+	return 0
+}
+
+func (m *ChannelAllocHint) GetRowLimitRatio() float64 {
+	if m != nil {
+		return m.RowLimitRatio
+	}
+	return 0
+}
+
+// PartitionAllocHints is the row distribution of a partition over the vchannels of its collection
+type PartitionAllocHints struct {
+	CollectionID int64               `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64               `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows      int64               `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	Channels     []*ChannelAllocHint `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	// compute_time is in milliseconds
+	ComputeTime          int64    `protobuf:"varint,5,opt,name=compute_time,json=computeTime,proto3" json:"compute_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionAllocHints) Reset()         { *m = PartitionAllocHints{} }
+func (m *PartitionAllocHints) String() string { return proto.CompactTextString(m) }
+func (*PartitionAllocHints) ProtoMessage()    {}
+func (*PartitionAllocHints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{139}
+}
+
+func (m *PartitionAllocHints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionAllocHints.Unmarshal(m, b)
+}
+func (m *PartitionAllocHints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionAllocHints.Marshal(b, m, deterministic)
+}
+func (m *PartitionAllocHints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionAllocHints.Merge(m, src)
+}
+func (m *PartitionAllocHints) XXX_Size() int {
+	return xxx_messageInfo_PartitionAllocHints.Size(m)
+}
+func (m *PartitionAllocHints) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionAllocHints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionAllocHints proto.InternalMessageInfo
+
+func (m *PartitionAllocHints) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PartitionAllocHints) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionAllocHints) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *PartitionAllocHints) GetChannels() []*ChannelAllocHint {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *PartitionAllocHints) GetComputeTime() int64 {
+	if m != nil {
+		return m.ComputeTime
+	}
+	return 0
+}
+
+type GetSegmentAllocHintsResponse struct {
+	Status               *commonpb.Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Partitions           []*PartitionAllocHints `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetSegmentAllocHintsResponse) Reset()         { *m = GetSegmentAllocHintsResponse{} }
+func (m *GetSegmentAllocHintsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentAllocHintsResponse) ProtoMessage()    {}
+func (*GetSegmentAllocHintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{140}
+}
+
+func (m *GetSegmentAllocHintsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentAllocHintsResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentAllocHintsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentAllocHintsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentAllocHintsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentAllocHintsResponse.Merge(m, src)
+}
+func (m *GetSegmentAllocHintsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentAllocHintsResponse.Size(m)
+}
+func (m *GetSegmentAllocHintsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentAllocHintsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentAllocHintsResponse proto.InternalMessageInfo
+
+func (m *GetSegmentAllocHintsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentAllocHintsResponse) GetPartitions() []*PartitionAllocHints {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+type FreezeHandoffsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 freezes the whole cluster
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// 0 for dataCoord.freezeWindow.maxTTL
+	TtlSeconds           int64    `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeHandoffsRequest) Reset()         { *m = FreezeHandoffsRequest{} }
+func (m *FreezeHandoffsRequest) String() string { return proto.CompactTextString(m) }
+func (*FreezeHandoffsRequest) ProtoMessage()    {}
+func (*FreezeHandoffsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{141}
+}
+
+func (m *FreezeHandoffsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeHandoffsRequest.Unmarshal(m, b)
+}
+func (m *FreezeHandoffsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeHandoffsRequest.Marshal(b, m, deterministic)
+}
+func (m *FreezeHandoffsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeHandoffsRequest.Merge(m, src)
+}
+func (m *FreezeHandoffsRequest) XXX_Size() int {
+	return xxx_messageInfo_FreezeHandoffsRequest.Size(m)
+}
+func (m *FreezeHandoffsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeHandoffsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeHandoffsRequest proto.InternalMessageInfo
+
+func (m *FreezeHandoffsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *FreezeHandoffsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *FreezeHandoffsRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+// FreezeWindow is the window the handoffs and the compaction of a collection, or the whole cluster, are frozen in
+type FreezeWindow struct {
+	// 0 for the whole cluster
+	CollectionID int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// unix time in milliseconds
+	FrozenAt int64 `protobuf:"varint,2,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	// unix time in milliseconds
+	ExpireAt             int64    `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeWindow) Reset()         { *m = FreezeWindow{} }
+func (m *FreezeWindow) String() string { return proto.CompactTextString(m) }
+func (*FreezeWindow) ProtoMessage()    {}
+func (*FreezeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{142}
+}
+
+func (m *FreezeWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeWindow.Unmarshal(m, b)
+}
+func (m *FreezeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeWindow.Marshal(b, m, deterministic)
+}
+func (m *FreezeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeWindow.Merge(m, src)
+}
+func (m *FreezeWindow) XXX_Size() int {
+	return xxx_messageInfo_FreezeWindow.Size(m)
+}
+func (m *FreezeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeWindow proto.InternalMessageInfo
+
+func (m *FreezeWindow) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *FreezeWindow) GetFrozenAt() int64 {
+	if m != nil {
+		return m.FrozenAt
+	}
+	return 0
+}
+
+func (m *FreezeWindow) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+type FreezeHandoffsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Window               *FreezeWindow    `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *FreezeHandoffsResponse) Reset()         { *m = FreezeHandoffsResponse{} }
+func (m *FreezeHandoffsResponse) String() string { return proto.CompactTextString(m) }
+func (*FreezeHandoffsResponse) ProtoMessage()    {}
+func (*FreezeHandoffsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{143}
+}
+
+func (m *FreezeHandoffsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeHandoffsResponse.Unmarshal(m, b)
+}
+func (m *FreezeHandoffsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeHandoffsResponse.Marshal(b, m, deterministic)
+}
+func (m *FreezeHandoffsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeHandoffsResponse.Merge(m, src)
+}
+func (m *FreezeHandoffsResponse) XXX_Size() int {
+	return xxx_messageInfo_FreezeHandoffsResponse.Size(m)
+}
+func (m *FreezeHandoffsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeHandoffsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeHandoffsResponse proto.InternalMessageInfo
+
+func (m *FreezeHandoffsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FreezeHandoffsResponse) GetWindow() *FreezeWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type UnfreezeHandoffsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnfreezeHandoffsRequest) Reset()         { *m = UnfreezeHandoffsRequest{} }
+func (m *UnfreezeHandoffsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeHandoffsRequest) ProtoMessage()    {}
+func (*UnfreezeHandoffsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{144}
+}
+
+func (m *UnfreezeHandoffsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeHandoffsRequest.Unmarshal(m, b)
+}
+func (m *UnfreezeHandoffsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeHandoffsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeHandoffsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeHandoffsRequest.Merge(m, src)
+}
+func (m *UnfreezeHandoffsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeHandoffsRequest.Size(m)
+}
+func (m *UnfreezeHandoffsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeHandoffsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeHandoffsRequest proto.InternalMessageInfo
+
+func (m *UnfreezeHandoffsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnfreezeHandoffsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetFreezeWindowsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetFreezeWindowsRequest) Reset()         { *m = GetFreezeWindowsRequest{} }
+func (m *GetFreezeWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFreezeWindowsRequest) ProtoMessage()    {}
+func (*GetFreezeWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{145}
+}
+
+func (m *GetFreezeWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFreezeWindowsRequest.Unmarshal(m, b)
+}
+func (m *GetFreezeWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFreezeWindowsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFreezeWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFreezeWindowsRequest.Merge(m, src)
+}
+func (m *GetFreezeWindowsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFreezeWindowsRequest.Size(m)
+}
+func (m *GetFreezeWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFreezeWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFreezeWindowsRequest proto.InternalMessageInfo
+
+func (m *GetFreezeWindowsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetFreezeWindowsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Windows              []*FreezeWindow  `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetFreezeWindowsResponse) Reset()         { *m = GetFreezeWindowsResponse{} }
+func (m *GetFreezeWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFreezeWindowsResponse) ProtoMessage()    {}
+func (*GetFreezeWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{146}
+}
+
+func (m *GetFreezeWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFreezeWindowsResponse.Unmarshal(m, b)
+}
+func (m *GetFreezeWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFreezeWindowsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFreezeWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFreezeWindowsResponse.Merge(m, src)
+}
+func (m *GetFreezeWindowsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFreezeWindowsResponse.Size(m)
+}
+func (m *GetFreezeWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFreezeWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFreezeWindowsResponse proto.InternalMessageInfo
+
+func (m *GetFreezeWindowsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetFreezeWindowsResponse) GetWindows() []*FreezeWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+type GetDeleteSLARequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 for all the collections
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeleteSLARequest) Reset()         { *m = GetDeleteSLARequest{} }
+func (m *GetDeleteSLARequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteSLARequest) ProtoMessage()    {}
+func (*GetDeleteSLARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{147}
+}
+
+func (m *GetDeleteSLARequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteSLARequest.Unmarshal(m, b)
+}
+func (m *GetDeleteSLARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteSLARequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteSLARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteSLARequest.Merge(m, src)
+}
+func (m *GetDeleteSLARequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteSLARequest.Size(m)
+}
+func (m *GetDeleteSLARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteSLARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteSLARequest proto.InternalMessageInfo
+
+func (m *GetDeleteSLARequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDeleteSLARequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// DeleteSLAMarker is a sampled deltalog, marking the deletes up to delete_ts of the channel
+type DeleteSLAMarker struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionID int64  `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64  `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID    int64  `protobuf:"varint,4,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Channel      string `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	DeleteTs     uint64 `protobuf:"varint,6,opt,name=delete_ts,json=deleteTs,proto3" json:"delete_ts,omitempty"`
+	// unix time in milliseconds, 0 if the stage is not reached yet
+	ProducedTime         int64    `protobuf:"varint,7,opt,name=produced_time,json=producedTime,proto3" json:"produced_time,omitempty"`
+	PersistedTime        int64    `protobuf:"varint,8,opt,name=persisted_time,json=persistedTime,proto3" json:"persisted_time,omitempty"`
+	CheckpointedTime     int64    `protobuf:"varint,9,opt,name=checkpointed_time,json=checkpointedTime,proto3" json:"checkpointed_time,omitempty"`
+	CompactedTime        int64    `protobuf:"varint,10,opt,name=compacted_time,json=compactedTime,proto3" json:"compacted_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSLAMarker) Reset()         { *m = DeleteSLAMarker{} }
+func (m *DeleteSLAMarker) String() string { return proto.CompactTextString(m) }
+func (*DeleteSLAMarker) ProtoMessage()    {}
+func (*DeleteSLAMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{148}
+}
+
+func (m *DeleteSLAMarker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSLAMarker.Unmarshal(m, b)
+}
+func (m *DeleteSLAMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSLAMarker.Marshal(b, m, deterministic)
+}
+func (m *DeleteSLAMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSLAMarker.Merge(m, src)
+}
+func (m *DeleteSLAMarker) XXX_Size() int {
+	return xxx_messageInfo_DeleteSLAMarker.Size(m)
+}
+func (m *DeleteSLAMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSLAMarker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSLAMarker proto.InternalMessageInfo
+
+func (m *DeleteSLAMarker) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteSLAMarker) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *DeleteSLAMarker) GetDeleteTs() uint64 {
+	if m != nil {
+		return m.DeleteTs
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetProducedTime() int64 {
+	if m != nil {
+		return m.ProducedTime
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetPersistedTime() int64 {
+	if m != nil {
+		return m.PersistedTime
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetCheckpointedTime() int64 {
+	if m != nil {
+		return m.CheckpointedTime
+	}
+	return 0
+}
+
+func (m *DeleteSLAMarker) GetCompactedTime() int64 {
+	if m != nil {
+		return m.CompactedTime
+	}
+	return 0
+}
+
+// DeleteSLAStage is the latencies of the markers reaching a stage, from the produced time
+type DeleteSLAStage struct {
+	// persisted, checkpointed or compacted
+	Stage      string  `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Reached    int64   `protobuf:"varint,2,opt,name=reached,proto3" json:"reached,omitempty"`
+	AvgSeconds float64 `protobuf:"fixed64,3,opt,name=avg_seconds,json=avgSeconds,proto3" json:"avg_seconds,omitempty"`
+	MaxSeconds float64 `protobuf:"fixed64,4,opt,name=max_seconds,json=maxSeconds,proto3" json:"max_seconds,omitempty"`
+	// the markers not reaching the stage yet, and the age of the oldest one
+	Pending                 int64    `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	OldestPendingAgeSeconds float64  `protobuf:"fixed64,6,opt,name=oldest_pending_age_seconds,json=oldestPendingAgeSeconds,proto3" json:"oldest_pending_age_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *DeleteSLAStage) Reset()         { *m = DeleteSLAStage{} }
+func (m *DeleteSLAStage) String() string { return proto.CompactTextString(m) }
+func (*DeleteSLAStage) ProtoMessage()    {}
+func (*DeleteSLAStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{149}
+}
+
+func (m *DeleteSLAStage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSLAStage.Unmarshal(m, b)
+}
+func (m *DeleteSLAStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSLAStage.Marshal(b, m, deterministic)
+}
+func (m *DeleteSLAStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSLAStage.Merge(m, src)
+}
+func (m *DeleteSLAStage) XXX_Size() int {
+	return xxx_messageInfo_DeleteSLAStage.Size(m)
+}
+func (m *DeleteSLAStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSLAStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSLAStage proto.InternalMessageInfo
+
+func (m *DeleteSLAStage) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *DeleteSLAStage) GetReached() int64 {
+	if m != nil {
+		return m.Reached
+	}
+	return 0
+}
+
+func (m *DeleteSLAStage) GetAvgSeconds() float64 {
+	if m != nil {
+		return m.AvgSeconds
+	}
+	return 0
+}
+
+func (m *DeleteSLAStage) GetMaxSeconds() float64 {
+	if m != nil {
+		return m.MaxSeconds
+	}
+	return 0
+}
+
+func (m *DeleteSLAStage) GetPending() int64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *DeleteSLAStage) GetOldestPendingAgeSeconds() float64 {
+	if m != nil {
+		return m.OldestPendingAgeSeconds
+	}
+	return 0
+}
+
+type GetDeleteSLAResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// in the order of the stages
+	Stages               []*DeleteSLAStage  `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"`
+	Markers              []*DeleteSLAMarker `protobuf:"bytes,3,rep,name=markers,proto3" json:"markers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetDeleteSLAResponse) Reset()         { *m = GetDeleteSLAResponse{} }
+func (m *GetDeleteSLAResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteSLAResponse) ProtoMessage()    {}
+func (*GetDeleteSLAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{150}
+}
+
+func (m *GetDeleteSLAResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteSLAResponse.Unmarshal(m, b)
+}
+func (m *GetDeleteSLAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteSLAResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteSLAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteSLAResponse.Merge(m, src)
+}
+func (m *GetDeleteSLAResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteSLAResponse.Size(m)
+}
+func (m *GetDeleteSLAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteSLAResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteSLAResponse proto.InternalMessageInfo
+
+func (m *GetDeleteSLAResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDeleteSLAResponse) GetStages() []*DeleteSLAStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *GetDeleteSLAResponse) GetMarkers() []*DeleteSLAMarker {
+	if m != nil {
+		return m.Markers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterEnum("milvus.proto.data.MaintenanceScope", MaintenanceScope_name, MaintenanceScope_value)
+	proto.RegisterEnum("milvus.proto.data.DecommissionState", DecommissionState_name, DecommissionState_value)
+	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterMapType((map[string]*internalpb.MsgPosition)(nil), "milvus.proto.data.GetSegmentInfoResponse.ChannelCheckpointEntry")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*AcquireSegmentLockRequest)(nil), "milvus.proto.data.AcquireSegmentLockRequest")
+	proto.RegisterType((*ReleaseSegmentLockRequest)(nil), "milvus.proto.data.ReleaseSegmentLockRequest")
+	proto.RegisterType((*RenewSegmentLockRequest)(nil), "milvus.proto.data.RenewSegmentLockRequest")
+	proto.RegisterType((*InspectSegmentLocksRequest)(nil), "milvus.proto.data.InspectSegmentLocksRequest")
+	proto.RegisterType((*SegmentLockLease)(nil), "milvus.proto.data.SegmentLockLease")
+	proto.RegisterType((*InspectSegmentLocksResponse)(nil), "milvus.proto.data.InspectSegmentLocksResponse")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*RecoveryInfoVersion)(nil), "milvus.proto.data.RecoveryInfoVersion")
+	proto.RegisterType((*GetSegmentsByStatesRequest)(nil), "milvus.proto.data.GetSegmentsByStatesRequest")
+	proto.RegisterType((*GetSegmentsByStatesResponse)(nil), "milvus.proto.data.GetSegmentsByStatesResponse")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionStateRequest)(nil), "milvus.proto.data.CompactionStateRequest")
+	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
+	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
+	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+	proto.RegisterType((*SetSegmentStateRequest)(nil), "milvus.proto.data.SetSegmentStateRequest")
+	proto.RegisterType((*SetSegmentStateResponse)(nil), "milvus.proto.data.SetSegmentStateResponse")
+	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
+	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
+	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportTaskState)(nil), "milvus.proto.data.ImportTaskState")
+	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
+	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
+	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*PreImportRequest)(nil), "milvus.proto.data.PreImportRequest")
+	proto.RegisterType((*PreImportFileReport)(nil), "milvus.proto.data.PreImportFileReport")
+	proto.RegisterType((*PreImportResponse)(nil), "milvus.proto.data.PreImportResponse")
+	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
+	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
+	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
+	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
+	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
+	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
+	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
+	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
+	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
+	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
+	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.data.IndexInfo")
+	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.data.FieldIndex")
+	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.data.SegmentIndex")
+	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.data.GetIndexStateRequest")
+	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.data.GetIndexStateResponse")
+	proto.RegisterType((*GetSegmentIndexStateRequest)(nil), "milvus.proto.data.GetSegmentIndexStateRequest")
+	proto.RegisterType((*SegmentIndexState)(nil), "milvus.proto.data.SegmentIndexState")
+	proto.RegisterType((*GetSegmentIndexStateResponse)(nil), "milvus.proto.data.GetSegmentIndexStateResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.data.CreateIndexRequest")
+	proto.RegisterType((*GetIndexInfoRequest)(nil), "milvus.proto.data.GetIndexInfoRequest")
+	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.data.IndexFilePathInfo")
+	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.data.SegmentIndexInfo")
+	proto.RegisterType((*GetIndexInfoResponse)(nil), "milvus.proto.data.GetIndexInfoResponse")
+	proto.RegisterMapType((map[int64]*SegmentIndexInfo)(nil), "milvus.proto.data.GetIndexInfoResponse.SegmentInfoEntry")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.data.DropIndexRequest")
+	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.data.DescribeIndexRequest")
+	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.data.DescribeIndexResponse")
+	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.data.GetIndexBuildProgressRequest")
+	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.data.GetIndexBuildProgressResponse")
+	proto.RegisterType((*DeprecatedSegmentIndex)(nil), "milvus.proto.data.DeprecatedSegmentIndex")
+	proto.RegisterType((*RebuildDeprecatedIndexesRequest)(nil), "milvus.proto.data.RebuildDeprecatedIndexesRequest")
+	proto.RegisterType((*RebuildDeprecatedIndexesResponse)(nil), "milvus.proto.data.RebuildDeprecatedIndexesResponse")
+	proto.RegisterType((*UpdateChannelCheckpointsRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointsRequest")
+	proto.RegisterType((*UpdateChannelCheckpointsResponse)(nil), "milvus.proto.data.UpdateChannelCheckpointsResponse")
+	proto.RegisterMapType((map[string]*commonpb.Status)(nil), "milvus.proto.data.UpdateChannelCheckpointsResponse.ChannelStatusesEntry")
+	proto.RegisterType((*GetTimeTravelWatermarksRequest)(nil), "milvus.proto.data.GetTimeTravelWatermarksRequest")
+	proto.RegisterType((*GetTimeTravelWatermarksResponse)(nil), "milvus.proto.data.GetTimeTravelWatermarksResponse")
+	proto.RegisterMapType((map[int64]uint64)(nil), "milvus.proto.data.GetTimeTravelWatermarksResponse.CompactionWatermarksEntry")
+	proto.RegisterType((*SegmentHeat)(nil), "milvus.proto.data.SegmentHeat")
+	proto.RegisterType((*ReportSegmentHeatsRequest)(nil), "milvus.proto.data.ReportSegmentHeatsRequest")
+	proto.RegisterType((*GetSegmentHeatsRequest)(nil), "milvus.proto.data.GetSegmentHeatsRequest")
+	proto.RegisterType((*GetSegmentHeatsResponse)(nil), "milvus.proto.data.GetSegmentHeatsResponse")
+	proto.RegisterType((*GetSegmentHistoryRequest)(nil), "milvus.proto.data.GetSegmentHistoryRequest")
+	proto.RegisterType((*SegmentStateEvent)(nil), "milvus.proto.data.SegmentStateEvent")
+	proto.RegisterType((*GetSegmentHistoryResponse)(nil), "milvus.proto.data.GetSegmentHistoryResponse")
+	proto.RegisterType((*PauseCollectionMaintenanceRequest)(nil), "milvus.proto.data.PauseCollectionMaintenanceRequest")
+	proto.RegisterType((*CollectionMaintenancePause)(nil), "milvus.proto.data.CollectionMaintenancePause")
+	proto.RegisterType((*PauseCollectionMaintenanceResponse)(nil), "milvus.proto.data.PauseCollectionMaintenanceResponse")
+	proto.RegisterType((*ResumeCollectionMaintenanceRequest)(nil), "milvus.proto.data.ResumeCollectionMaintenanceRequest")
+	proto.RegisterType((*GetCollectionMaintenancePausesRequest)(nil), "milvus.proto.data.GetCollectionMaintenancePausesRequest")
+	proto.RegisterType((*GetCollectionMaintenancePausesResponse)(nil), "milvus.proto.data.GetCollectionMaintenancePausesResponse")
+	proto.RegisterType((*DecommissionRequest)(nil), "milvus.proto.data.DecommissionRequest")
+	proto.RegisterType((*ChannelDecommission)(nil), "milvus.proto.data.ChannelDecommission")
+	proto.RegisterType((*DecommissionResponse)(nil), "milvus.proto.data.DecommissionResponse")
+	proto.RegisterType((*LocatePrimaryKeysRequest)(nil), "milvus.proto.data.LocatePrimaryKeysRequest")
+	proto.RegisterType((*PrimaryKeyLocation)(nil), "milvus.proto.data.PrimaryKeyLocation")
+	proto.RegisterType((*LocatePrimaryKeysResponse)(nil), "milvus.proto.data.LocatePrimaryKeysResponse")
+	proto.RegisterType((*CompactSegmentsRequest)(nil), "milvus.proto.data.CompactSegmentsRequest")
+	proto.RegisterType((*GetHandoffGateRequest)(nil), "milvus.proto.data.GetHandoffGateRequest")
+	proto.RegisterType((*HandoffGateSegment)(nil), "milvus.proto.data.HandoffGateSegment")
+	proto.RegisterType((*GetHandoffGateResponse)(nil), "milvus.proto.data.GetHandoffGateResponse")
+	proto.RegisterType((*GetSegmentAnomaliesRequest)(nil), "milvus.proto.data.GetSegmentAnomaliesRequest")
+	proto.RegisterType((*SegmentAnomaly)(nil), "milvus.proto.data.SegmentAnomaly")
+	proto.RegisterType((*CollectionSegmentReport)(nil), "milvus.proto.data.CollectionSegmentReport")
+	proto.RegisterType((*GetSegmentAnomaliesResponse)(nil), "milvus.proto.data.GetSegmentAnomaliesResponse")
+	proto.RegisterType((*MergeTinySegmentsRequest)(nil), "milvus.proto.data.MergeTinySegmentsRequest")
+	proto.RegisterType((*GetSegmentAllocHintsRequest)(nil), "milvus.proto.data.GetSegmentAllocHintsRequest")
+	proto.RegisterType((*ChannelAllocHint)(nil), "milvus.proto.data.ChannelAllocHint")
+	proto.RegisterType((*PartitionAllocHints)(nil), "milvus.proto.data.PartitionAllocHints")
+	proto.RegisterType((*GetSegmentAllocHintsResponse)(nil), "milvus.proto.data.GetSegmentAllocHintsResponse")
+	proto.RegisterType((*FreezeHandoffsRequest)(nil), "milvus.proto.data.FreezeHandoffsRequest")
+	proto.RegisterType((*FreezeWindow)(nil), "milvus.proto.data.FreezeWindow")
+	proto.RegisterType((*FreezeHandoffsResponse)(nil), "milvus.proto.data.FreezeHandoffsResponse")
+	proto.RegisterType((*UnfreezeHandoffsRequest)(nil), "milvus.proto.data.UnfreezeHandoffsRequest")
+	proto.RegisterType((*GetFreezeWindowsRequest)(nil), "milvus.proto.data.GetFreezeWindowsRequest")
+	proto.RegisterType((*GetFreezeWindowsResponse)(nil), "milvus.proto.data.GetFreezeWindowsResponse")
+	proto.RegisterType((*GetDeleteSLARequest)(nil), "milvus.proto.data.GetDeleteSLARequest")
+	proto.RegisterType((*DeleteSLAMarker)(nil), "milvus.proto.data.DeleteSLAMarker")
+	proto.RegisterType((*DeleteSLAStage)(nil), "milvus.proto.data.DeleteSLAStage")
+	proto.RegisterType((*GetDeleteSLAResponse)(nil), "milvus.proto.data.GetDeleteSLAResponse")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 8060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5b, 0x6c, 0x1c, 0xd9,
+	0x75, 0xe0, 0x54, 0x3f, 0xd8, 0xdd, 0xa7, 0x9b, 0x64, 0xb3, 0x44, 0x91, 0xad, 0xd6, 0xcc, 0x48,
+	0xaa, 0x19, 0xcd, 0x68, 0x34, 0x1a, 0x69, 0x46, 0xe3, 0x81, 0xe7, 0x3d, 0x26, 0x45, 0x3d, 0xb8,
+	0x96, 0x64, 0xb9, 0xc8, 0x19, 0xed, 0xda, 0x6b, 0xf4, 0x16, 0xbb, 0x2e, 0xc9, 0x32, 0xbb, 0xab,
+	0x7a, 0xaa, 0xaa, 0x49, 0x71, 0xbc, 0x58, 0xbf, 0xd6, 0x83, 0xf5, 0x63, 0xd7, 0xd8, 0x85, 0xbd,
+	0xf6, 0xc2, 0xd8, 0xc0, 0x08, 0x1c, 0x20, 0xb1, 0xe1, 0x38, 0x86, 0x91, 0x7c, 0xe4, 0x23, 0xf9,
+	0x09, 0x90, 0x60, 0x82, 0xc4, 0x79, 0x21, 0x40, 0x82, 0xc0, 0x9f, 0x49, 0x80, 0x7c, 0x26, 0x40,
+	0x7e, 0x82, 0x24, 0xb8, 0xcf, 0xba, 0x55, 0x75, 0xab, 0xbb, 0xc8, 0xa6, 0xa4, 0x3c, 0xf8, 0xc5,
+	0x7b, 0xfb, 0xdc, 0xd7, 0xb9, 0xe7, 0x9e, 0x7b, 0x5e, 0xf7, 0x14, 0x34, 0x6d, 0x2b, 0xb4, 0x3a,
+	0x5d, 0xcf, 0xf3, 0xed, 0x8b, 0x03, 0xdf, 0x0b, 0x3d, 0x7d, 0xae, 0xef, 0xf4, 0x76, 0x87, 0x01,
+	0x2d, 0x5d, 0xc4, 0x3f, 0xb7, 0x1b, 0x5d, 0xaf, 0xdf, 0xf7, 0x5c, 0x5a, 0xd5, 0x9e, 0x71, 0xdc,
+	0x10, 0xf9, 0xae, 0xd5, 0x63, 0xe5, 0x86, 0xdc, 0xa0, 0xdd, 0x08, 0xba, 0xdb, 0xa8, 0x6f, 0xd1,
+	0x92, 0x51, 0x81, 0xf2, 0xd5, 0xfe, 0x20, 0xdc, 0x37, 0xbe, 0xad, 0x41, 0xe3, 0x5a, 0x6f, 0x18,
+	0x6c, 0x9b, 0xe8, 0xdd, 0x21, 0x0a, 0x42, 0xfd, 0x79, 0x28, 0x6d, 0x58, 0x01, 0x6a, 0x69, 0xa7,
+	0xb5, 0x73, 0xf5, 0xcb, 0x8f, 0x5e, 0x8c, 0x8d, 0xca, 0xc6, 0xbb, 0x15, 0x6c, 0x2d, 0x5b, 0x01,
+	0x32, 0x09, 0xa4, 0xae, 0x43, 0xc9, 0xde, 0x58, 0x5d, 0x69, 0x15, 0x4e, 0x6b, 0xe7, 0x8a, 0x26,
+	0xf9, 0x5f, 0x7f, 0x1c, 0x20, 0x40, 0x5b, 0x7d, 0xe4, 0x86, 0xab, 0x2b, 0x41, 0xab, 0x78, 0xba,
+	0x78, 0xae, 0x68, 0x4a, 0x35, 0xba, 0x01, 0x8d, 0xae, 0xd7, 0xeb, 0xa1, 0x6e, 0xe8, 0x78, 0xee,
+	0xea, 0x4a, 0xab, 0x44, 0xda, 0xc6, 0xea, 0x8c, 0xbf, 0xd4, 0x60, 0x9a, 0x4d, 0x2d, 0x18, 0x78,
+	0x6e, 0x80, 0xf4, 0x17, 0x61, 0x2a, 0x08, 0xad, 0x70, 0x18, 0xb0, 0xd9, 0x9d, 0x54, 0xce, 0x6e,
+	0x8d, 0x80, 0x98, 0x0c, 0x54, 0x39, 0xbd, 0xe4, 0xf0, 0xc5, 0xf4, 0xf0, 0x89, 0x25, 0x94, 0x52,
+	0x4b, 0x38, 0x07, 0xb3, 0x9b, 0x78, 0x76, 0x6b, 0x11, 0x50, 0x99, 0x00, 0x25, 0xab, 0x71, 0x4f,
+	0xa1, 0xd3, 0x47, 0x1f, 0xdb, 0x5c, 0x43, 0x56, 0xaf, 0x35, 0x45, 0xc6, 0x92, 0x6a, 0x8c, 0x3f,
+	0xd4, 0xa0, 0x29, 0xc0, 0xf9, 0x3e, 0xcc, 0x43, 0xb9, 0xeb, 0x0d, 0xdd, 0x90, 0x2c, 0x75, 0xda,
+	0xa4, 0x05, 0xfd, 0x0c, 0x34, 0xba, 0xdb, 0x96, 0xeb, 0xa2, 0x5e, 0xc7, 0xb5, 0xfa, 0x88, 0x2c,
+	0xaa, 0x66, 0xd6, 0x59, 0xdd, 0x6d, 0xab, 0x8f, 0x72, 0xad, 0xed, 0x34, 0xd4, 0x07, 0x96, 0x1f,
+	0x3a, 0x31, 0xec, 0xcb, 0x55, 0x7a, 0x1b, 0xaa, 0x4e, 0xb0, 0xda, 0x1f, 0x78, 0x7e, 0xd8, 0x2a,
+	0x9f, 0xd6, 0xce, 0x55, 0x4d, 0x51, 0xc6, 0x23, 0x38, 0xe4, 0xbf, 0x75, 0x2b, 0xd8, 0x59, 0x5d,
+	0x61, 0x2b, 0x8a, 0xd5, 0x19, 0xdf, 0xd5, 0x60, 0x61, 0x29, 0x08, 0x9c, 0x2d, 0x37, 0xb5, 0xb2,
+	0x05, 0x98, 0x72, 0x3d, 0x1b, 0xad, 0xae, 0x90, 0xa5, 0x15, 0x4d, 0x56, 0xd2, 0x4f, 0x42, 0x6d,
+	0x80, 0x90, 0xdf, 0xf1, 0xbd, 0x1e, 0x5f, 0x58, 0x15, 0x57, 0x98, 0x5e, 0x0f, 0xe9, 0x1f, 0x87,
+	0xb9, 0x20, 0xd1, 0x11, 0xa5, 0xab, 0xfa, 0xe5, 0x27, 0x2e, 0xa6, 0x4e, 0xc6, 0xc5, 0xe4, 0xa0,
+	0x66, 0xba, 0xb5, 0xf1, 0xb9, 0x02, 0x1c, 0x13, 0x70, 0x74, 0xae, 0xf8, 0x7f, 0x8c, 0xf9, 0x00,
+	0x6d, 0x89, 0xe9, 0xd1, 0x42, 0x1e, 0xcc, 0x8b, 0x2d, 0x2b, 0xca, 0x5b, 0x96, 0x83, 0xd4, 0x93,
+	0xfb, 0x51, 0x4e, 0xef, 0xc7, 0x29, 0xa8, 0xa3, 0x7b, 0x03, 0xc7, 0x47, 0x1d, 0x4c, 0x38, 0x04,
+	0xe5, 0x25, 0x13, 0x68, 0xd5, 0xba, 0xd3, 0x97, 0xcf, 0x46, 0x25, 0xf7, 0xd9, 0x30, 0x7e, 0x5e,
+	0x83, 0xc5, 0xd4, 0x2e, 0xb1, 0xc3, 0x66, 0x42, 0x93, 0xac, 0x3c, 0xc2, 0x0c, 0x3e, 0x76, 0x18,
+	0xe1, 0x4f, 0x8d, 0x42, 0x78, 0x04, 0x6e, 0xa6, 0xda, 0x4b, 0x93, 0x2c, 0xe4, 0x9f, 0xe4, 0x0e,
+	0x2c, 0x5e, 0x47, 0x21, 0x1b, 0x00, 0xff, 0x86, 0x82, 0xc3, 0x33, 0xab, 0xf8, 0xa9, 0x2e, 0x24,
+	0x4f, 0xb5, 0xf1, 0x2b, 0x05, 0x68, 0xca, 0x43, 0xad, 0xba, 0x9b, 0x9e, 0xfe, 0x28, 0xd4, 0x04,
+	0x08, 0xa3, 0x8a, 0xa8, 0x42, 0xff, 0x30, 0x94, 0xf1, 0x4c, 0x29, 0x49, 0xcc, 0x5c, 0x3e, 0xa3,
+	0x5e, 0x93, 0xd4, 0xa7, 0x49, 0xe1, 0xf5, 0x55, 0x98, 0x09, 0x42, 0xcb, 0x0f, 0x3b, 0x03, 0x2f,
+	0x20, 0xfb, 0x4c, 0x08, 0xa7, 0x7e, 0xd9, 0x88, 0xf7, 0x20, 0xd8, 0xfa, 0xad, 0x60, 0xeb, 0x0e,
+	0x83, 0x34, 0xa7, 0x49, 0x4b, 0x5e, 0xd4, 0xaf, 0x42, 0x03, 0xb9, 0x76, 0xd4, 0x51, 0x29, 0x77,
+	0x47, 0x75, 0xe4, 0xda, 0xa2, 0x9b, 0x68, 0x7f, 0xca, 0xf9, 0xf7, 0xe7, 0x6b, 0x1a, 0xb4, 0xd2,
+	0x1b, 0x34, 0x09, 0xcb, 0x7e, 0x8d, 0x36, 0x42, 0x74, 0x83, 0x46, 0x9e, 0x70, 0xb1, 0x49, 0x26,
+	0x6b, 0x62, 0x7c, 0x53, 0x83, 0xe3, 0xd1, 0x74, 0xc8, 0x4f, 0xf7, 0x8b, 0x5a, 0xf4, 0xf3, 0xd0,
+	0x74, 0xdc, 0x6e, 0x6f, 0x68, 0xa3, 0xb7, 0xdd, 0x1b, 0xc8, 0xea, 0x85, 0xdb, 0xfb, 0x64, 0x0f,
+	0xab, 0x66, 0xaa, 0xde, 0xf8, 0x59, 0x01, 0x16, 0x92, 0xf3, 0x9a, 0x04, 0x49, 0x1f, 0x82, 0xb2,
+	0xe3, 0x6e, 0x7a, 0x1c, 0x47, 0x8f, 0x8f, 0x38, 0x94, 0x78, 0x2c, 0x0a, 0xac, 0x7b, 0xa0, 0x73,
+	0x36, 0xd6, 0xdd, 0x46, 0xdd, 0x9d, 0x81, 0xe7, 0x10, 0x86, 0x85, 0xbb, 0xf8, 0x88, 0xa2, 0x0b,
+	0xf5, 0x8c, 0x2f, 0x5e, 0xa1, 0x7d, 0x5c, 0x11, 0x5d, 0x5c, 0x75, 0x43, 0x7f, 0xdf, 0x9c, 0xeb,
+	0x26, 0xeb, 0xdb, 0xdb, 0xb0, 0xa0, 0x06, 0xd6, 0x9b, 0x50, 0xdc, 0x41, 0xfb, 0x64, 0xc9, 0x35,
+	0x13, 0xff, 0xab, 0xbf, 0x0c, 0xe5, 0x5d, 0xab, 0x37, 0x44, 0xad, 0x42, 0x6e, 0xf2, 0xa5, 0x0d,
+	0x5e, 0x2d, 0xbc, 0xac, 0x19, 0x7d, 0x38, 0x79, 0x1d, 0x85, 0xab, 0x6e, 0x80, 0xfc, 0x70, 0xd9,
+	0x71, 0x7b, 0xde, 0xd6, 0x1d, 0x2b, 0xdc, 0x9e, 0x80, 0x57, 0xc4, 0x8e, 0x7d, 0x21, 0x71, 0xec,
+	0x8d, 0x5f, 0xd4, 0xe0, 0x51, 0xf5, 0x78, 0x6c, 0x57, 0xdb, 0x50, 0xdd, 0x74, 0x50, 0xcf, 0x5e,
+	0x5d, 0xa1, 0x8c, 0xb3, 0x68, 0x8a, 0x32, 0xe6, 0x19, 0x03, 0x0c, 0xcc, 0x36, 0xef, 0x4c, 0xc6,
+	0x4a, 0xd7, 0x42, 0xdf, 0x71, 0xb7, 0x6e, 0x3a, 0x41, 0x68, 0x52, 0x78, 0x89, 0x54, 0x8a, 0xf9,
+	0x4f, 0xe8, 0x57, 0x34, 0x78, 0xfc, 0x3a, 0x0a, 0xaf, 0x88, 0x2b, 0x07, 0xff, 0xee, 0x04, 0xa1,
+	0xd3, 0x0d, 0x8e, 0x56, 0xec, 0xcb, 0x21, 0x7b, 0x18, 0x5f, 0xd7, 0xe0, 0x54, 0xe6, 0x64, 0x18,
+	0xea, 0x18, 0x4b, 0xe5, 0x17, 0x8e, 0x9a, 0xa5, 0x7e, 0x14, 0xed, 0xbf, 0x83, 0x37, 0xff, 0x8e,
+	0xe5, 0xf8, 0x94, 0xa5, 0x1e, 0xf2, 0x82, 0xf9, 0xa1, 0x06, 0x8f, 0x5d, 0x47, 0xe1, 0x1d, 0x7e,
+	0xdd, 0x3e, 0x44, 0xec, 0x60, 0x18, 0xe9, 0xda, 0xe7, 0x72, 0x67, 0xac, 0xce, 0xf8, 0x5f, 0x74,
+	0x3b, 0x95, 0xf3, 0x7d, 0x28, 0x08, 0x7c, 0x1c, 0x1e, 0x8d, 0xf3, 0x09, 0x76, 0xe2, 0x19, 0xfa,
+	0x8c, 0xff, 0xaf, 0xc1, 0x89, 0xa5, 0xee, 0xbb, 0x43, 0xc7, 0x47, 0x0c, 0xe8, 0xa6, 0xd7, 0xdd,
+	0x39, 0x3c, 0x72, 0x23, 0x09, 0xb2, 0x10, 0x93, 0x20, 0xc7, 0x69, 0x1d, 0x0b, 0x30, 0x15, 0x52,
+	0x91, 0x95, 0x0a, 0x61, 0xac, 0x44, 0xe6, 0x67, 0xa2, 0x1e, 0xb2, 0x82, 0x7f, 0x99, 0xf3, 0xfb,
+	0x0c, 0x2c, 0x9a, 0xc8, 0x45, 0x7b, 0xf7, 0x75, 0x72, 0xd1, 0xe0, 0xc5, 0xd8, 0xe0, 0xff, 0x05,
+	0xda, 0xab, 0x6e, 0x30, 0x40, 0xdd, 0x50, 0x1a, 0xfe, 0xf0, 0x27, 0xe3, 0xd5, 0xe6, 0x07, 0x6f,
+	0x4e, 0x57, 0xb5, 0xd6, 0x3f, 0xf1, 0x3f, 0x0d, 0xeb, 0x0a, 0x4d, 0xa9, 0xef, 0x9b, 0x28, 0x3e,
+	0x4d, 0x2d, 0x63, 0x9a, 0x05, 0x79, 0x9a, 0x63, 0x71, 0x7b, 0x0a, 0xea, 0x16, 0x25, 0x41, 0xbb,
+	0x63, 0x85, 0x0c, 0xc1, 0xc0, 0xab, 0x96, 0x42, 0xac, 0x7e, 0x30, 0x09, 0xdb, 0x0a, 0x99, 0x04,
+	0x5e, 0xa5, 0x15, 0x4b, 0x21, 0x66, 0x5a, 0x27, 0x95, 0x58, 0x98, 0x50, 0xcc, 0x21, 0x34, 0x97,
+	0x43, 0xcc, 0x11, 0x78, 0x31, 0x59, 0x13, 0xe3, 0xcb, 0x65, 0x68, 0xbc, 0xc3, 0xae, 0x5b, 0x22,
+	0xa4, 0x26, 0xb9, 0x8b, 0xa6, 0xd6, 0x33, 0x24, 0x85, 0x45, 0xa5, 0xc3, 0x5c, 0x87, 0xe9, 0x00,
+	0xa1, 0x9d, 0xc3, 0x88, 0xa4, 0x0d, 0xdc, 0x90, 0x97, 0xf4, 0x9b, 0x30, 0x37, 0x74, 0x89, 0x26,
+	0x8c, 0x6c, 0xb6, 0x08, 0xca, 0xcd, 0xc6, 0x8b, 0x2a, 0xe9, 0x86, 0xfa, 0x0d, 0x98, 0x4d, 0x54,
+	0xb5, 0xca, 0xb9, 0xfa, 0x4a, 0x36, 0xd3, 0x57, 0xa1, 0x69, 0xfb, 0xde, 0x60, 0x80, 0xec, 0x4e,
+	0xc0, 0xbb, 0x9a, 0xca, 0xd7, 0x15, 0x6b, 0x27, 0xba, 0x7a, 0x1e, 0x8e, 0x25, 0x67, 0xba, 0x6a,
+	0x63, 0xfd, 0x0b, 0xd3, 0x9e, 0xea, 0x27, 0xfd, 0x02, 0xcc, 0xa5, 0xe1, 0xab, 0x04, 0x3e, 0xfd,
+	0x83, 0xfe, 0x1c, 0xe8, 0x89, 0xa9, 0x62, 0xf0, 0x1a, 0x05, 0x8f, 0x4f, 0x86, 0x81, 0x3b, 0xae,
+	0x8d, 0xee, 0xc5, 0xc1, 0x81, 0x82, 0xb3, 0x5f, 0x24, 0xf0, 0x55, 0x68, 0xb2, 0xca, 0x08, 0x11,
+	0xf5, 0x7c, 0x88, 0x88, 0x77, 0x16, 0x18, 0x5f, 0xd6, 0x60, 0xe1, 0xae, 0x15, 0x76, 0xb7, 0x57,
+	0xfa, 0x8c, 0xf3, 0x4f, 0x70, 0x73, 0xbe, 0x01, 0xb5, 0x5d, 0x46, 0x91, 0xfc, 0x60, 0x9c, 0x52,
+	0x4c, 0x48, 0xa6, 0x7d, 0x33, 0x6a, 0x81, 0x99, 0xc9, 0xfc, 0x35, 0xc9, 0x00, 0xf3, 0x10, 0xee,
+	0xf0, 0x31, 0x96, 0x23, 0xe3, 0x1e, 0x00, 0x9b, 0xdc, 0xad, 0x60, 0xeb, 0x10, 0xf3, 0x7a, 0x19,
+	0x2a, 0xac, 0x37, 0x76, 0x49, 0x8f, 0xdb, 0x30, 0x0e, 0x6e, 0x7c, 0x7f, 0x0a, 0xea, 0xd2, 0x0f,
+	0xfa, 0x0c, 0x14, 0x04, 0xa7, 0x28, 0x28, 0x56, 0x57, 0x18, 0x6f, 0xab, 0x28, 0xa6, 0x6d, 0x15,
+	0x67, 0x61, 0xc6, 0x21, 0x52, 0x71, 0x87, 0xed, 0x0a, 0xe1, 0xb6, 0x35, 0x73, 0x9a, 0xd6, 0x32,
+	0x12, 0xd1, 0x1f, 0x87, 0xba, 0x3b, 0xec, 0x77, 0xbc, 0xcd, 0x8e, 0xef, 0xed, 0x05, 0x8c, 0xe5,
+	0xd6, 0xdc, 0x61, 0xff, 0x63, 0x9b, 0xa6, 0xb7, 0x17, 0x44, 0x7a, 0xf5, 0xd4, 0x01, 0xf5, 0xea,
+	0xc7, 0xa1, 0xde, 0xb7, 0xee, 0xe1, 0x5e, 0x3b, 0xee, 0xb0, 0x4f, 0xec, 0x21, 0x45, 0xb3, 0xd6,
+	0xb7, 0xee, 0x99, 0xde, 0xde, 0xed, 0x61, 0x5f, 0x3f, 0x07, 0xcd, 0x9e, 0x15, 0x84, 0x1d, 0xd9,
+	0xa0, 0x52, 0x25, 0x06, 0x95, 0x19, 0x5c, 0x7f, 0x35, 0x32, 0xaa, 0xa4, 0x35, 0xf4, 0xda, 0x04,
+	0x1a, 0xba, 0xdd, 0xef, 0x45, 0x1d, 0x41, 0x7e, 0x0d, 0xdd, 0xee, 0xf7, 0x44, 0x37, 0x2f, 0x43,
+	0x65, 0x83, 0xe8, 0x1a, 0xa3, 0x0e, 0xeb, 0x35, 0xac, 0x66, 0x50, 0x95, 0xc4, 0xe4, 0xe0, 0xfa,
+	0xeb, 0x50, 0x23, 0x22, 0x1e, 0x69, 0xdb, 0xc8, 0xd5, 0x36, 0x6a, 0x80, 0x5b, 0xdb, 0xa8, 0x17,
+	0x5a, 0xa4, 0xf5, 0x74, 0xbe, 0xd6, 0xa2, 0x01, 0xe6, 0x94, 0x5d, 0x1f, 0x59, 0x21, 0xb2, 0x97,
+	0xf7, 0xaf, 0x78, 0xfd, 0x81, 0x45, 0x88, 0xa9, 0x35, 0x43, 0x54, 0x65, 0xd5, 0x4f, 0xfa, 0x53,
+	0x30, 0xd3, 0x15, 0xa5, 0x6b, 0xbe, 0xd7, 0x6f, 0xcd, 0x92, 0x73, 0x94, 0xa8, 0xd5, 0x1f, 0x03,
+	0xe0, 0x3c, 0xd2, 0x0a, 0x5b, 0x4d, 0xb2, 0x8b, 0x35, 0x56, 0xb3, 0x44, 0xec, 0xa5, 0x4e, 0xd0,
+	0xa1, 0x96, 0x49, 0xc7, 0xdd, 0x6a, 0xcd, 0x91, 0x11, 0xeb, 0xdc, 0x94, 0xe9, 0xb8, 0x5b, 0xfa,
+	0x22, 0x54, 0x9c, 0xa0, 0xb3, 0x69, 0xed, 0xa0, 0x96, 0x4e, 0x7e, 0x9d, 0x72, 0x82, 0x6b, 0xd6,
+	0x0e, 0x32, 0x3e, 0x0b, 0xf3, 0x11, 0x75, 0x49, 0x3b, 0x99, 0x26, 0x0a, 0xed, 0xb0, 0x44, 0x31,
+	0x5a, 0xc3, 0xfc, 0x69, 0x09, 0x16, 0xd6, 0xac, 0x5d, 0x74, 0xff, 0x95, 0xd9, 0x5c, 0x6c, 0xed,
+	0x26, 0xcc, 0x11, 0xfd, 0xf5, 0xb2, 0x34, 0x9f, 0x56, 0x29, 0x17, 0x29, 0xa4, 0x1b, 0xea, 0x6f,
+	0x61, 0x51, 0x04, 0x75, 0x77, 0xee, 0x78, 0x4e, 0x74, 0x9b, 0x3f, 0xa6, 0xe8, 0xe7, 0x8a, 0x80,
+	0x32, 0xe5, 0x16, 0xfa, 0x1d, 0x98, 0x8d, 0x6f, 0x03, 0xbf, 0xc7, 0x9f, 0x1e, 0x69, 0x2d, 0x8a,
+	0xb0, 0x6f, 0xce, 0xc4, 0x36, 0x23, 0xd0, 0x5b, 0x50, 0x61, 0x97, 0x30, 0xe1, 0x19, 0x55, 0x93,
+	0x17, 0xf5, 0x3b, 0x70, 0x8c, 0xae, 0x60, 0x8d, 0x1d, 0x08, 0xba, 0xf8, 0x6a, 0xae, 0xc5, 0xab,
+	0x9a, 0xc6, 0xcf, 0x53, 0xed, 0xa0, 0xe7, 0xa9, 0x05, 0x15, 0x46, 0xe3, 0x84, 0x8f, 0x54, 0x4d,
+	0x5e, 0xc4, 0xdb, 0x1c, 0x51, 0x7b, 0x9d, 0xfc, 0x16, 0x55, 0x60, 0x43, 0x00, 0x44, 0xf8, 0x1c,
+	0x63, 0xd7, 0x7c, 0x13, 0xaa, 0x82, 0xc2, 0xf3, 0x1b, 0x64, 0x44, 0x9b, 0x24, 0x7f, 0x2f, 0x26,
+	0xf8, 0xbb, 0xf1, 0xbb, 0x1a, 0x34, 0x56, 0xf0, 0x92, 0x6e, 0x7a, 0x5b, 0xe4, 0x36, 0x3a, 0x0b,
+	0x33, 0x3e, 0xea, 0x7a, 0xbe, 0xdd, 0x41, 0x6e, 0xe8, 0x3b, 0x88, 0x0a, 0xd3, 0x25, 0x73, 0x9a,
+	0xd6, 0x5e, 0xa5, 0x95, 0x18, 0x0c, 0xb3, 0xec, 0x20, 0xb4, 0xfa, 0x83, 0xce, 0x26, 0x66, 0x0d,
+	0x05, 0x0a, 0x26, 0x6a, 0x09, 0x67, 0x38, 0x03, 0x8d, 0x08, 0x2c, 0xf4, 0xc8, 0xf8, 0x25, 0xb3,
+	0x2e, 0xea, 0xd6, 0x3d, 0xfd, 0x49, 0x98, 0x21, 0x38, 0xed, 0xf4, 0xbc, 0xad, 0x0e, 0xb6, 0xaf,
+	0xb0, 0x8b, 0xaa, 0x61, 0xb3, 0x69, 0xe1, 0xbd, 0x8a, 0x43, 0x05, 0xce, 0x7b, 0x88, 0x5d, 0x55,
+	0x02, 0x6a, 0xcd, 0x79, 0x0f, 0x19, 0x1f, 0x68, 0x30, 0xbd, 0x62, 0x85, 0xd6, 0x6d, 0xcf, 0x46,
+	0xeb, 0x87, 0xbc, 0xd8, 0x73, 0xf8, 0x18, 0x1e, 0x85, 0x9a, 0x58, 0x01, 0x5b, 0x52, 0x54, 0xa1,
+	0x5f, 0x83, 0x19, 0x2e, 0xcb, 0x75, 0xa8, 0xfe, 0x5f, 0xca, 0x14, 0xa0, 0xa4, 0x9b, 0x33, 0x30,
+	0xa7, 0x79, 0x33, 0x52, 0x34, 0xae, 0x41, 0x43, 0xfe, 0x19, 0x8f, 0xba, 0x96, 0x24, 0x14, 0x51,
+	0x81, 0xa9, 0xf1, 0xf6, 0xb0, 0x8f, 0xf7, 0x94, 0x31, 0x16, 0x5e, 0x34, 0xbe, 0xa8, 0xc1, 0x34,
+	0xbb, 0xee, 0xd7, 0x84, 0x37, 0x8e, 0x2c, 0x8d, 0x5a, 0xfd, 0xc8, 0xff, 0xfa, 0xab, 0x71, 0x03,
+	0xfa, 0x93, 0x4a, 0x26, 0x40, 0x3a, 0x21, 0x42, 0x66, 0xec, 0xae, 0xcf, 0x63, 0x71, 0xfa, 0x1c,
+	0x26, 0x34, 0xb6, 0x35, 0x84, 0xd0, 0x5a, 0x50, 0xb1, 0x6c, 0xdb, 0x47, 0x41, 0xc0, 0xe6, 0xc1,
+	0x8b, 0xf8, 0x97, 0x5d, 0xe4, 0x07, 0x9c, 0xe4, 0x8b, 0x26, 0x2f, 0xea, 0xaf, 0x43, 0x55, 0x48,
+	0xa5, 0xd4, 0x5c, 0x7a, 0x3a, 0x7b, 0x9e, 0x4c, 0xd1, 0x13, 0x2d, 0x8c, 0x5f, 0x2d, 0xc0, 0x0c,
+	0x43, 0xd8, 0x32, 0xbb, 0x8f, 0x47, 0x1f, 0xbe, 0x65, 0x68, 0x6c, 0x46, 0x67, 0x7f, 0x94, 0x91,
+	0x57, 0x66, 0x11, 0xb1, 0x36, 0xe3, 0x0e, 0x60, 0x5c, 0x22, 0x28, 0x4d, 0x24, 0x11, 0x94, 0x0f,
+	0xca, 0xc1, 0xd2, 0x32, 0xe2, 0x94, 0x42, 0x46, 0x34, 0xfe, 0x33, 0xd4, 0xa5, 0x0e, 0x08, 0x87,
+	0xa6, 0x26, 0x54, 0x86, 0x31, 0x5e, 0xd4, 0x5f, 0x8c, 0xe4, 0x22, 0x8a, 0xaa, 0x13, 0x8a, 0xb9,
+	0x24, 0x44, 0x22, 0xe3, 0x37, 0x35, 0x98, 0x62, 0x3d, 0x63, 0xff, 0x1a, 0xe5, 0x2f, 0x44, 0x66,
+	0xa4, 0xbd, 0x03, 0xab, 0xc2, 0x42, 0xe3, 0xd1, 0x71, 0x9d, 0x13, 0x50, 0x4d, 0xf0, 0x9b, 0x0a,
+	0xbb, 0x16, 0xf8, 0x4f, 0x12, 0x93, 0xa9, 0xf4, 0x28, 0x7f, 0xc1, 0xce, 0xc5, 0x9e, 0xb7, 0x25,
+	0xbc, 0xad, 0xb4, 0x60, 0x7c, 0xb7, 0x40, 0x9c, 0x63, 0x26, 0xea, 0x7a, 0xbb, 0xc8, 0xdf, 0x9f,
+	0xdc, 0xab, 0xf0, 0x9a, 0x44, 0xe6, 0x39, 0x95, 0x2f, 0xd1, 0x40, 0x7f, 0x2d, 0xda, 0x84, 0xa2,
+	0xca, 0xee, 0x28, 0xf3, 0x1d, 0x46, 0xa4, 0x91, 0x7c, 0xfa, 0x91, 0xe8, 0xe8, 0x51, 0xef, 0x95,
+	0xca, 0xcd, 0x28, 0x2f, 0xf4, 0x1d, 0x0a, 0x1d, 0x1d, 0xd1, 0x79, 0x28, 0x13, 0x02, 0x63, 0x0e,
+	0x6b, 0x5a, 0x30, 0x7e, 0x5f, 0x23, 0x7e, 0x97, 0x38, 0x8a, 0x0e, 0x2b, 0x45, 0x1d, 0x8d, 0x82,
+	0xf4, 0x3a, 0x94, 0x03, 0xc7, 0xed, 0xa2, 0x03, 0x2e, 0x94, 0x36, 0x32, 0x3e, 0x0a, 0xc7, 0x14,
+	0xbf, 0x62, 0x77, 0x43, 0x80, 0xfc, 0x5d, 0xe4, 0x8b, 0xc3, 0x21, 0xca, 0xd9, 0x6c, 0xcd, 0xf8,
+	0xa9, 0x06, 0xed, 0xc8, 0x76, 0x1b, 0x2c, 0xef, 0x4f, 0xea, 0x60, 0x3d, 0x1a, 0x0c, 0xbd, 0x22,
+	0x3c, 0x80, 0x98, 0x2f, 0xe5, 0x52, 0xfe, 0x58, 0x03, 0xc3, 0x25, 0x6e, 0xa0, 0xf4, 0x82, 0x26,
+	0x39, 0x15, 0x04, 0xb7, 0xb4, 0x43, 0xe6, 0x05, 0x14, 0x65, 0xe3, 0xef, 0x34, 0x38, 0x71, 0x1d,
+	0x85, 0xd7, 0xe2, 0x76, 0xa6, 0x87, 0x8d, 0x40, 0xd9, 0x33, 0xb9, 0xcd, 0x3c, 0x93, 0xa5, 0x84,
+	0x67, 0x92, 0xd5, 0x93, 0xc0, 0x0b, 0x6b, 0x0b, 0xc9, 0x6c, 0xa7, 0x8a, 0x2b, 0x08, 0xdf, 0x59,
+	0x80, 0xa9, 0xee, 0xd0, 0x0f, 0x3c, 0x9f, 0x31, 0x1e, 0x56, 0x32, 0xfe, 0x07, 0x25, 0x9c, 0xd4,
+	0xb2, 0xef, 0x13, 0x9a, 0x31, 0x6b, 0xdc, 0xb6, 0x82, 0x4e, 0xdf, 0xf3, 0x11, 0x73, 0xb1, 0x56,
+	0xb6, 0xad, 0xe0, 0x96, 0xe7, 0x23, 0xe3, 0x7d, 0x0d, 0x5a, 0x6c, 0x02, 0x64, 0x3a, 0x58, 0x8d,
+	0xec, 0xa1, 0x10, 0xd9, 0x0f, 0xda, 0xbc, 0xf2, 0x0f, 0x1a, 0x34, 0x65, 0x49, 0x05, 0xff, 0xaa,
+	0xbf, 0x04, 0x65, 0x62, 0x9d, 0x62, 0x33, 0x18, 0xcb, 0x4e, 0x29, 0x34, 0x3e, 0xb2, 0x44, 0x3d,
+	0x59, 0x17, 0x42, 0x15, 0x2b, 0x46, 0xe2, 0x52, 0xf1, 0xe0, 0xe2, 0x12, 0x13, 0x1f, 0xbd, 0x21,
+	0xee, 0x97, 0xda, 0xc0, 0xa3, 0x0a, 0xfd, 0x0d, 0x98, 0xa2, 0x51, 0x62, 0xcc, 0xfd, 0x7f, 0x36,
+	0xde, 0x35, 0xfd, 0xed, 0xa2, 0xe4, 0xb9, 0x23, 0x15, 0x26, 0x6b, 0x64, 0xfc, 0x07, 0x58, 0x88,
+	0x34, 0x78, 0x3a, 0xec, 0x61, 0x4f, 0x81, 0xf1, 0xa7, 0x1a, 0x1c, 0x5b, 0xdb, 0x77, 0xbb, 0xc9,
+	0xf3, 0xb4, 0x00, 0x53, 0x83, 0x9e, 0x15, 0xd9, 0xb7, 0x59, 0x89, 0x88, 0xce, 0x74, 0x6c, 0x64,
+	0xe3, 0x7b, 0x97, 0xe2, 0xac, 0x2e, 0xea, 0xd6, 0xbd, 0xb1, 0xe2, 0xd0, 0x59, 0x61, 0x72, 0x40,
+	0x36, 0xbd, 0xe1, 0xa9, 0xe9, 0x6e, 0x5a, 0xd4, 0x92, 0x1b, 0xfe, 0x0d, 0x00, 0x22, 0x04, 0x75,
+	0x0e, 0x22, 0xf8, 0x90, 0x16, 0x37, 0xb1, 0xcc, 0xf1, 0x93, 0x02, 0xb4, 0x24, 0x2c, 0x3d, 0x68,
+	0x99, 0x30, 0x43, 0x93, 0x2d, 0x1e, 0x91, 0x26, 0x5b, 0x9a, 0x5c, 0x0e, 0x2c, 0xab, 0xe4, 0xc0,
+	0xcf, 0x17, 0x61, 0x26, 0xc2, 0xda, 0x9d, 0x9e, 0xe5, 0x66, 0x52, 0xc2, 0x9a, 0xd0, 0x81, 0xe2,
+	0x78, 0x7a, 0x56, 0x75, 0x4e, 0x32, 0x36, 0xc2, 0x4c, 0x74, 0x81, 0xcd, 0x4c, 0xd4, 0xd8, 0x40,
+	0x8c, 0x85, 0x4c, 0xef, 0xa2, 0x07, 0x12, 0xdb, 0x09, 0x2f, 0x80, 0xce, 0x4e, 0x51, 0xc7, 0x71,
+	0x3b, 0x01, 0xea, 0x7a, 0xae, 0x4d, 0xcf, 0x57, 0xd9, 0x6c, 0xb2, 0x5f, 0x56, 0xdd, 0x35, 0x5a,
+	0xaf, 0xbf, 0x04, 0xa5, 0x70, 0x7f, 0x40, 0x59, 0xed, 0xcc, 0xe5, 0x33, 0x23, 0xe7, 0xb5, 0xbe,
+	0x3f, 0x40, 0x26, 0x01, 0xe7, 0x61, 0x84, 0xa1, 0x6f, 0xed, 0x32, 0x71, 0xb9, 0x64, 0x4a, 0x35,
+	0x98, 0x63, 0x70, 0x1c, 0x56, 0xa8, 0x58, 0xc9, 0x8a, 0x94, 0xb2, 0xf9, 0xa1, 0xed, 0x84, 0x61,
+	0x8f, 0x98, 0x3b, 0x09, 0x65, 0xf3, 0xda, 0xf5, 0xb0, 0x87, 0x17, 0x19, 0x7a, 0xa1, 0xd5, 0xa3,
+	0xe7, 0xa3, 0xc6, 0xb8, 0x03, 0xae, 0x21, 0xca, 0xdc, 0x1f, 0x17, 0xa0, 0x19, 0x4d, 0xcc, 0x44,
+	0xc1, 0xb0, 0x97, 0x7d, 0x1e, 0x47, 0x9b, 0x9b, 0xc6, 0x1d, 0xc5, 0xb7, 0xa0, 0xce, 0xa8, 0xe2,
+	0x00, 0x54, 0x05, 0xb4, 0xc9, 0xcd, 0x11, 0x64, 0x5e, 0x3e, 0x22, 0x32, 0x9f, 0x3a, 0x84, 0xc1,
+	0x46, 0xbd, 0x37, 0x38, 0x8c, 0xe4, 0x78, 0x8a, 0x6b, 0x8e, 0x44, 0xed, 0x68, 0x75, 0x99, 0x71,
+	0xd3, 0x64, 0x97, 0xb4, 0x09, 0x76, 0x39, 0xfa, 0xa4, 0x77, 0xe6, 0xd7, 0x7b, 0x62, 0x24, 0xf1,
+	0xd1, 0x89, 0x98, 0xac, 0x89, 0xf1, 0x7f, 0x34, 0x58, 0x4c, 0x4f, 0x75, 0x82, 0xfb, 0x7e, 0x19,
+	0x2a, 0xb4, 0x6b, 0x7e, 0x46, 0xcf, 0x8d, 0x3e, 0xa3, 0x11, 0x72, 0x4c, 0xde, 0xd0, 0x58, 0x83,
+	0x05, 0x7e, 0xf7, 0x47, 0xa8, 0xbf, 0x85, 0x42, 0x6b, 0x84, 0xb2, 0x78, 0x0a, 0xea, 0x54, 0xeb,
+	0xa0, 0x4a, 0x18, 0x35, 0xb3, 0xc0, 0x86, 0xb0, 0x4e, 0x1a, 0x7f, 0xad, 0xc1, 0x3c, 0xb9, 0x3c,
+	0x93, 0xee, 0xac, 0x3c, 0x4e, 0x56, 0x03, 0x1a, 0x92, 0xc5, 0x86, 0x2e, 0xad, 0x66, 0xc6, 0xea,
+	0xf4, 0xd5, 0xb4, 0xf1, 0x52, 0x69, 0x54, 0x88, 0x22, 0x35, 0xb0, 0x01, 0x83, 0x04, 0x6a, 0x24,
+	0xad, 0x96, 0xd1, 0xa5, 0x5d, 0x3a, 0xcc, 0xa5, 0x7d, 0x13, 0x8e, 0x27, 0x56, 0x3a, 0xc1, 0x8e,
+	0x1a, 0xbf, 0xa4, 0xe1, 0xed, 0x88, 0xc5, 0x02, 0x1e, 0x5e, 0x12, 0x7e, 0x4c, 0xf8, 0xd1, 0x3a,
+	0x8e, 0x9d, 0x64, 0x22, 0xb6, 0xfe, 0x26, 0xd4, 0x5c, 0xb4, 0xd7, 0x91, 0x65, 0xa1, 0x1c, 0x6a,
+	0x42, 0x15, 0xc7, 0x51, 0xe0, 0xff, 0x8c, 0xdb, 0xb0, 0x98, 0x9a, 0xea, 0x24, 0x6b, 0xff, 0x75,
+	0x0d, 0x4e, 0xac, 0xf8, 0xde, 0xe0, 0x1d, 0xc7, 0x0f, 0x87, 0x56, 0x2f, 0x1e, 0x03, 0x73, 0x7f,
+	0xac, 0x81, 0x37, 0x24, 0x81, 0x99, 0xd2, 0xcf, 0x05, 0xc5, 0x09, 0x4a, 0x4f, 0x8a, 0x2d, 0x5a,
+	0xd2, 0x62, 0xfe, 0xaa, 0x08, 0x27, 0x32, 0xe1, 0xc6, 0xc8, 0x25, 0x79, 0x34, 0x16, 0xa5, 0xf3,
+	0xa0, 0x78, 0x58, 0xe7, 0x41, 0x06, 0x7b, 0x2f, 0x1d, 0x11, 0x7b, 0x3f, 0xb0, 0x35, 0xeb, 0x06,
+	0xc4, 0x1d, 0x3b, 0xad, 0xa9, 0xdc, 0xf6, 0xf2, 0x78, 0x43, 0x7d, 0x19, 0x20, 0x72, 0x72, 0xb4,
+	0x2a, 0xb9, 0xbb, 0x91, 0x5a, 0xe1, 0xdd, 0x12, 0x57, 0x29, 0xbb, 0xe9, 0xa3, 0x0a, 0xe3, 0xe3,
+	0xd0, 0x56, 0x51, 0xe9, 0x24, 0x94, 0xff, 0x93, 0x02, 0xc0, 0xaa, 0x88, 0xfe, 0x3f, 0xdc, 0x5d,
+	0xf0, 0x04, 0x48, 0xd2, 0x48, 0x74, 0xde, 0x65, 0x2a, 0xb2, 0xf1, 0x91, 0x10, 0x4a, 0x2e, 0x86,
+	0x49, 0x29, 0xbe, 0x36, 0xe9, 0x47, 0x3a, 0x35, 0x94, 0x28, 0x92, 0xec, 0xf7, 0x24, 0xd4, 0xb0,
+	0x77, 0x18, 0x1f, 0x33, 0x9b, 0x3f, 0x6f, 0xf0, 0xbd, 0x3d, 0x7c, 0xf8, 0x6c, 0xec, 0x10, 0xc4,
+	0x31, 0x45, 0xb8, 0xff, 0x29, 0x29, 0xc4, 0xc8, 0xc6, 0xf6, 0xa5, 0x4d, 0xa7, 0x87, 0x68, 0x84,
+	0x47, 0xcd, 0xa4, 0x05, 0xec, 0xa6, 0xa6, 0x71, 0xb8, 0xd5, 0xdc, 0xa1, 0x76, 0x04, 0xde, 0xf8,
+	0x1d, 0x0d, 0x66, 0x23, 0xac, 0x11, 0x06, 0x84, 0x79, 0x1a, 0xe1, 0x67, 0x57, 0x3c, 0x9b, 0xb2,
+	0x8a, 0x99, 0x8c, 0x1b, 0x81, 0x36, 0x24, 0x8d, 0xcc, 0xa8, 0xc9, 0x48, 0x0d, 0x7a, 0x11, 0x2a,
+	0x78, 0xd1, 0x8e, 0xcd, 0xc3, 0xa3, 0xa6, 0x7c, 0x6f, 0x6f, 0xd5, 0x16, 0xd8, 0xa0, 0x6f, 0x17,
+	0xa8, 0x52, 0x88, 0xb1, 0x71, 0x05, 0x97, 0x31, 0x3e, 0x91, 0xef, 0x7b, 0x7e, 0xa7, 0x8f, 0x82,
+	0xc0, 0xda, 0x42, 0x4c, 0x3e, 0x6f, 0x90, 0xca, 0x5b, 0xb4, 0xce, 0xf8, 0x56, 0x09, 0x66, 0xa2,
+	0xa5, 0xf0, 0xd0, 0x02, 0xc7, 0xe6, 0xa1, 0x05, 0x0e, 0xde, 0x3a, 0xf0, 0x29, 0x2b, 0x14, 0x9b,
+	0xbb, 0x5c, 0x68, 0x69, 0x66, 0x8d, 0xd5, 0xae, 0xda, 0xf8, 0x5a, 0xc6, 0x87, 0xcc, 0xf5, 0x6c,
+	0x14, 0x6d, 0x2e, 0xf0, 0x2a, 0xb6, 0xb7, 0x31, 0x1a, 0x29, 0xe5, 0xa0, 0x91, 0x72, 0x0e, 0x1a,
+	0x99, 0x52, 0xd0, 0xc8, 0x02, 0x4c, 0x6d, 0x0c, 0xbb, 0x3b, 0x28, 0x64, 0x12, 0x1b, 0x2b, 0xc5,
+	0x69, 0xa7, 0x9a, 0xa0, 0x1d, 0x41, 0x22, 0x35, 0x99, 0x44, 0x4e, 0x42, 0x8d, 0xfa, 0xb8, 0x3b,
+	0x61, 0x40, 0x1c, 0x76, 0x45, 0xb3, 0x4a, 0x2b, 0xd6, 0x03, 0x1c, 0xf4, 0x4c, 0xaf, 0xb0, 0xba,
+	0xea, 0xb0, 0x13, 0xae, 0x93, 0xa0, 0x12, 0x2e, 0xcc, 0x3d, 0x0d, 0xb3, 0x12, 0x3a, 0xc8, 0x1d,
+	0xd1, 0x20, 0x53, 0x95, 0xa4, 0x7d, 0x72, 0x4d, 0x9c, 0x85, 0x99, 0x08, 0x25, 0x04, 0x6e, 0x9a,
+	0x2a, 0x59, 0xa2, 0x96, 0x80, 0x09, 0x4a, 0x9e, 0x39, 0x18, 0x25, 0x63, 0xdb, 0x0c, 0xd3, 0x8e,
+	0x82, 0xd6, 0x6c, 0xcc, 0x58, 0x61, 0x7c, 0x1a, 0xf4, 0x68, 0xf6, 0x93, 0x49, 0x8b, 0x09, 0xf2,
+	0x28, 0x24, 0xc9, 0xc3, 0xf8, 0xbe, 0x06, 0x73, 0xf2, 0x60, 0x87, 0xbd, 0x78, 0xdf, 0x84, 0x3a,
+	0x75, 0x99, 0x76, 0xf0, 0xc1, 0x67, 0x46, 0xa0, 0xc7, 0x46, 0xee, 0x8b, 0x09, 0xd1, 0xeb, 0x27,
+	0x4c, 0x5e, 0x7b, 0x9e, 0xbf, 0xe3, 0xb8, 0x5b, 0x1d, 0x3c, 0x33, 0x7e, 0xdc, 0x1a, 0xac, 0x12,
+	0xbb, 0xa1, 0x02, 0xe3, 0xfd, 0x02, 0x34, 0xef, 0xf8, 0x88, 0x76, 0x71, 0xf8, 0xb9, 0x2e, 0x42,
+	0xc5, 0xde, 0x90, 0xe5, 0x83, 0x29, 0x7b, 0x83, 0x6c, 0xa6, 0x82, 0x38, 0x8a, 0x4a, 0xe2, 0xc8,
+	0xf3, 0x3e, 0x49, 0x90, 0x75, 0x59, 0x26, 0xeb, 0xd7, 0xa0, 0xe2, 0x0d, 0x64, 0xcf, 0x7b, 0x0e,
+	0x8a, 0xe1, 0x2d, 0x5e, 0xad, 0x7c, 0xf0, 0x66, 0xa9, 0xa9, 0xb7, 0x8a, 0xc6, 0x7b, 0x70, 0x4c,
+	0xe0, 0xe1, 0x9a, 0xd3, 0x43, 0x26, 0xc2, 0xff, 0x61, 0x47, 0x21, 0x11, 0xce, 0x99, 0xa3, 0x10,
+	0xff, 0x8f, 0xeb, 0x88, 0x8d, 0x92, 0x05, 0x64, 0xe1, 0xff, 0x31, 0x6d, 0xa3, 0x20, 0x74, 0xfa,
+	0x16, 0xb6, 0xda, 0x48, 0xda, 0xe4, 0xb4, 0xa8, 0x25, 0x1a, 0xe5, 0x3c, 0x94, 0x09, 0xc7, 0x62,
+	0x1e, 0x17, 0x5a, 0x30, 0xfe, 0xa8, 0x00, 0x73, 0xd2, 0x26, 0x4c, 0x42, 0x9d, 0x31, 0xb6, 0x50,
+	0x48, 0xb0, 0x05, 0xcc, 0x4b, 0xac, 0xee, 0xce, 0x70, 0xc0, 0x4c, 0x97, 0xac, 0x84, 0x1d, 0x01,
+	0x14, 0xaf, 0xa5, 0xcc, 0x87, 0x55, 0x0a, 0xdc, 0x70, 0xfc, 0xa7, 0x97, 0x5e, 0x56, 0x2d, 0xfd,
+	0x69, 0x98, 0x8d, 0xc0, 0x36, 0xf6, 0x43, 0xc2, 0xef, 0x30, 0x5c, 0xd4, 0x7a, 0x19, 0xd7, 0xe2,
+	0x00, 0xc2, 0x08, 0x50, 0x5c, 0x23, 0x34, 0x7c, 0x6a, 0x4e, 0xfc, 0x22, 0xc2, 0x1f, 0x17, 0x60,
+	0x8a, 0x60, 0x91, 0xde, 0x7c, 0x35, 0x93, 0x95, 0x70, 0x34, 0xe0, 0xe3, 0x6f, 0x0f, 0x6c, 0x2b,
+	0x44, 0x92, 0x6c, 0x3d, 0x69, 0x3c, 0xfd, 0x4b, 0x3c, 0xa0, 0xbd, 0x90, 0xcf, 0xa1, 0x4d, 0xa1,
+	0x8d, 0x5f, 0x16, 0x73, 0x49, 0x3d, 0x42, 0x39, 0xfc, 0x5c, 0xda, 0x50, 0xdd, 0x65, 0xdd, 0xf1,
+	0x77, 0x8a, 0xbc, 0x1c, 0x0b, 0x9a, 0x28, 0x1e, 0x3c, 0x68, 0xc2, 0xb8, 0x85, 0x23, 0xd1, 0x03,
+	0xe4, 0xda, 0xb1, 0xd5, 0x1c, 0xda, 0x8c, 0x3a, 0x80, 0xb6, 0xaa, 0xbb, 0x49, 0x08, 0x9d, 0x6a,
+	0x65, 0x1d, 0x1f, 0x05, 0xd4, 0x42, 0x5e, 0x64, 0xca, 0x00, 0x19, 0x27, 0x34, 0x7e, 0x50, 0x80,
+	0xc5, 0x25, 0xdb, 0x66, 0xf2, 0x09, 0x1d, 0xf5, 0xbe, 0xa9, 0x80, 0x49, 0x15, 0xa9, 0x98, 0x56,
+	0x91, 0x8e, 0x4a, 0x66, 0x60, 0xd2, 0x13, 0x76, 0x0e, 0x33, 0xa9, 0xd0, 0xa7, 0xd1, 0x84, 0xaf,
+	0x31, 0x2f, 0x3a, 0x36, 0x55, 0xb5, 0x2a, 0xb9, 0x34, 0x87, 0x2a, 0x37, 0x07, 0x1b, 0x03, 0x68,
+	0xa5, 0x91, 0x35, 0xe1, 0x25, 0xc9, 0x31, 0x32, 0xf0, 0xa8, 0xeb, 0xa0, 0x61, 0x02, 0xab, 0xba,
+	0xe3, 0x05, 0xc6, 0xdf, 0x16, 0xa0, 0x85, 0x83, 0xca, 0xfe, 0xfd, 0x6c, 0xd0, 0x27, 0x60, 0x3e,
+	0xb0, 0x76, 0x51, 0x47, 0x32, 0xf9, 0x74, 0x7c, 0xf4, 0x2e, 0x53, 0xae, 0x9e, 0x51, 0x71, 0x12,
+	0x65, 0xd0, 0x9d, 0x39, 0x17, 0xc4, 0xea, 0x4d, 0xf4, 0xae, 0xfe, 0x14, 0xcc, 0xca, 0x51, 0x9d,
+	0x1d, 0x87, 0x8a, 0x84, 0x0d, 0x73, 0x5a, 0x0a, 0xda, 0x5c, 0xb5, 0x8d, 0x77, 0xe1, 0xd1, 0xb7,
+	0xdd, 0x00, 0x85, 0xab, 0x51, 0xe0, 0xe1, 0x84, 0xc6, 0x91, 0x53, 0x50, 0x8f, 0x10, 0x9f, 0x7a,
+	0x9b, 0x68, 0x07, 0x86, 0x07, 0xed, 0x5b, 0x96, 0xbf, 0xc3, 0xd9, 0xf5, 0x0a, 0x0d, 0x10, 0xbb,
+	0x8f, 0x03, 0x6e, 0x8a, 0x78, 0x49, 0x13, 0x6d, 0x22, 0x1f, 0xb9, 0x5d, 0x84, 0x9f, 0x2d, 0x48,
+	0x2f, 0x36, 0xb4, 0xd8, 0x8b, 0x8d, 0x43, 0xbe, 0x92, 0x31, 0x7e, 0x58, 0x80, 0x85, 0xa5, 0x5e,
+	0x88, 0xfc, 0xc8, 0xa6, 0x75, 0x10, 0xf3, 0x5c, 0x64, 0x2f, 0x2b, 0x1c, 0xc2, 0x5e, 0x96, 0x7a,
+	0xa0, 0x55, 0x4c, 0x3f, 0xd0, 0x52, 0x59, 0xf7, 0x4a, 0x87, 0xb4, 0xee, 0x2d, 0x01, 0x0c, 0x7c,
+	0x6f, 0x80, 0xfc, 0xd0, 0x41, 0xdc, 0x30, 0x91, 0x43, 0xcc, 0x92, 0x1a, 0x19, 0x3f, 0x2a, 0x41,
+	0x6d, 0x15, 0x47, 0xec, 0xe7, 0x7e, 0x26, 0x22, 0x59, 0x4e, 0x0b, 0x71, 0xcb, 0xe9, 0x63, 0x00,
+	0x24, 0xf8, 0x5f, 0x3e, 0xcd, 0x35, 0x52, 0x43, 0xce, 0x72, 0x0b, 0x2a, 0xa4, 0x20, 0xc4, 0x48,
+	0x5e, 0xd4, 0x97, 0xa1, 0x8e, 0x9d, 0x18, 0x9d, 0x81, 0xe5, 0x5b, 0xfd, 0x83, 0x2c, 0x04, 0xb7,
+	0xba, 0x43, 0x1a, 0xe9, 0x2b, 0xd0, 0xa0, 0x83, 0xb3, 0x4e, 0x72, 0x0b, 0x9d, 0x75, 0xd2, 0x8c,
+	0xf5, 0x72, 0x86, 0xf5, 0xc2, 0x65, 0x26, 0x2a, 0xdf, 0xd4, 0x59, 0x1d, 0x91, 0x98, 0xe2, 0x8e,
+	0x90, 0x6a, 0xc2, 0x11, 0xc2, 0x65, 0x11, 0x44, 0x5c, 0x24, 0x33, 0x97, 0x4f, 0x29, 0x27, 0x40,
+	0x30, 0x1e, 0x53, 0xd7, 0x5e, 0x82, 0x45, 0x3a, 0x7d, 0x52, 0xec, 0x6c, 0x5a, 0x4e, 0xaf, 0xe3,
+	0x23, 0x2b, 0x60, 0xc1, 0xe0, 0x35, 0x73, 0xde, 0x11, 0x6d, 0xae, 0x59, 0x4e, 0xcf, 0x24, 0xbf,
+	0xe9, 0x06, 0x4c, 0x3b, 0x41, 0xc7, 0x1a, 0x86, 0x5e, 0x87, 0xfc, 0xce, 0xa2, 0x3a, 0xeb, 0x4e,
+	0xb0, 0x34, 0x0c, 0x3d, 0x32, 0x8c, 0x7e, 0x0b, 0xe6, 0x86, 0x01, 0xf2, 0x3b, 0x31, 0xf4, 0x34,
+	0xf2, 0xa2, 0x67, 0x16, 0xb7, 0x5d, 0x8d, 0x50, 0x64, 0xfc, 0x77, 0x0d, 0x80, 0xdc, 0x57, 0xb4,
+	0xf7, 0xd7, 0xf8, 0xa6, 0x63, 0x6d, 0x4f, 0xcd, 0x31, 0xa8, 0x3a, 0xc4, 0x89, 0x8c, 0x91, 0x04,
+	0x8f, 0xb5, 0xb3, 0x11, 0xf1, 0xc6, 0x33, 0xa9, 0x98, 0x17, 0xc9, 0x55, 0xc5, 0xb4, 0xe2, 0xc8,
+	0xa9, 0x06, 0x4c, 0x2f, 0x76, 0xfa, 0xc8, 0xf8, 0x52, 0x49, 0x84, 0x21, 0xd2, 0x89, 0xe4, 0x7c,
+	0xe2, 0x24, 0x87, 0x46, 0x14, 0xd2, 0xa1, 0x11, 0x31, 0x63, 0x66, 0x31, 0x69, 0xcc, 0x3c, 0x01,
+	0x55, 0xec, 0x9a, 0x22, 0x3b, 0xcf, 0x68, 0xd8, 0xa5, 0xd1, 0x8c, 0x32, 0x75, 0x97, 0xe3, 0xd4,
+	0xdd, 0x82, 0xca, 0xc6, 0xd0, 0x21, 0x07, 0x86, 0xde, 0x3d, 0xbc, 0x28, 0x31, 0xb9, 0x4a, 0x8c,
+	0xc9, 0x3d, 0x01, 0xd3, 0x14, 0xa7, 0x3c, 0x2e, 0x87, 0x52, 0x19, 0x25, 0x4d, 0x1e, 0xd2, 0x73,
+	0x48, 0x42, 0x3b, 0x05, 0xf5, 0x34, 0x71, 0xc1, 0x66, 0x44, 0x52, 0x4f, 0x01, 0x7d, 0xc2, 0xd3,
+	0xc1, 0x7a, 0x44, 0x67, 0x07, 0xed, 0xd3, 0xc7, 0x04, 0xc4, 0xeb, 0x6a, 0xa3, 0x7b, 0x58, 0xd3,
+	0xf8, 0x28, 0xda, 0x0f, 0xe4, 0xbd, 0x6b, 0x8c, 0xdc, 0xbb, 0xe9, 0xe4, 0xde, 0x61, 0xdd, 0x24,
+	0x40, 0xbe, 0x63, 0xf5, 0x9c, 0xf7, 0x58, 0x60, 0xc9, 0x0c, 0x0d, 0x97, 0x13, 0xb5, 0x24, 0xba,
+	0x04, 0xab, 0xca, 0xbe, 0x13, 0xa2, 0xce, 0xb6, 0xe5, 0xda, 0xde, 0xe6, 0x26, 0x31, 0x1f, 0x54,
+	0xcd, 0x06, 0xa9, 0xbc, 0x41, 0xeb, 0x8c, 0xff, 0x04, 0xf3, 0xe4, 0xa1, 0xb5, 0x58, 0xe7, 0x01,
+	0xb8, 0x7d, 0x9c, 0x61, 0x15, 0x12, 0x0c, 0xcb, 0xf8, 0x1e, 0x4d, 0x16, 0x20, 0xf7, 0x3d, 0x89,
+	0xf4, 0xf5, 0x52, 0xdc, 0x35, 0x77, 0xc8, 0x0d, 0x2b, 0x26, 0x37, 0x0c, 0x47, 0xb0, 0x9e, 0x94,
+	0x5f, 0xd8, 0x1e, 0x3d, 0x26, 0xc6, 0xde, 0xba, 0x5f, 0xd6, 0x60, 0x2e, 0x35, 0xfe, 0x18, 0xc7,
+	0xc0, 0xfd, 0x42, 0xc7, 0xff, 0xd6, 0xe2, 0x0f, 0x8e, 0x8f, 0x66, 0xf3, 0x5e, 0x4f, 0x64, 0x9d,
+	0x78, 0x72, 0x54, 0xd8, 0x8f, 0x18, 0x92, 0xb5, 0x31, 0xbe, 0x56, 0x04, 0xfd, 0x0a, 0xa1, 0x7f,
+	0xf2, 0xe3, 0x41, 0x76, 0xe6, 0xd0, 0xd7, 0x6d, 0xe2, 0x52, 0x2d, 0x1d, 0xc5, 0xa5, 0x5a, 0x3e,
+	0xd4, 0xa5, 0x1a, 0x0b, 0x4b, 0x9f, 0x4a, 0x86, 0xa5, 0xa7, 0xae, 0xb0, 0x4a, 0xce, 0x2b, 0xac,
+	0x7a, 0xe8, 0x2b, 0xec, 0x1e, 0x1c, 0xe3, 0xe7, 0x5a, 0x8e, 0xf8, 0xcc, 0xb3, 0x1d, 0xe3, 0x92,
+	0x7e, 0x8c, 0xde, 0x14, 0xe3, 0xef, 0x0b, 0x30, 0xb7, 0xca, 0xd9, 0x28, 0xd6, 0x13, 0x72, 0xa4,
+	0x90, 0xc9, 0xa6, 0x00, 0xe9, 0xce, 0x29, 0x66, 0xde, 0x39, 0xa5, 0xf8, 0x9d, 0x13, 0x9f, 0x60,
+	0x39, 0x49, 0x35, 0x47, 0x23, 0x46, 0x9d, 0x83, 0xa6, 0x74, 0x87, 0xd0, 0x64, 0x16, 0xd4, 0x2f,
+	0x32, 0xe3, 0xc8, 0xab, 0x27, 0xf6, 0x27, 0xc1, 0xf4, 0x6d, 0x7a, 0x17, 0xb0, 0xd7, 0x76, 0x51,
+	0x35, 0xbf, 0x0c, 0xe2, 0x77, 0x62, 0x4d, 0x71, 0x27, 0xca, 0xf7, 0x33, 0xc4, 0xee, 0x67, 0xe3,
+	0x37, 0xa4, 0x3c, 0x5a, 0x07, 0x92, 0x77, 0x47, 0x07, 0xab, 0x9c, 0x81, 0x06, 0x72, 0xad, 0x8d,
+	0x1e, 0x62, 0xc4, 0x4b, 0x4d, 0x78, 0x75, 0x5a, 0x47, 0x89, 0xf7, 0x2a, 0xd4, 0x23, 0x09, 0x89,
+	0x1f, 0xc4, 0x27, 0xb3, 0x44, 0x24, 0x99, 0x30, 0x4c, 0x10, 0xa2, 0x52, 0x60, 0xfc, 0xcf, 0x42,
+	0x74, 0xd3, 0x4d, 0x1e, 0xca, 0xfd, 0x49, 0x68, 0x08, 0x85, 0x0d, 0x0b, 0x6e, 0x94, 0xab, 0xbd,
+	0xac, 0x4e, 0xf2, 0x92, 0x1a, 0x53, 0x8e, 0x70, 0xa4, 0xc9, 0x5d, 0xea, 0x41, 0x54, 0xd3, 0xee,
+	0x42, 0x33, 0x09, 0x20, 0x27, 0x74, 0x29, 0xd2, 0x84, 0x2e, 0xaf, 0xc4, 0x13, 0xba, 0x3c, 0x31,
+	0x86, 0xa3, 0xb2, 0xf8, 0x47, 0x91, 0xd1, 0xe5, 0x1b, 0x1a, 0x34, 0xb1, 0xde, 0x7a, 0x60, 0x8e,
+	0x9a, 0x54, 0xd2, 0x0a, 0x0a, 0x25, 0x6d, 0x0c, 0x6f, 0x3d, 0x01, 0x55, 0xfc, 0xa6, 0xaa, 0x63,
+	0xf5, 0x7a, 0xad, 0x52, 0xf4, 0xc6, 0x6a, 0xa9, 0xd7, 0xc3, 0xf2, 0xc8, 0x0a, 0x0a, 0xba, 0xbe,
+	0xb3, 0x71, 0x70, 0x5e, 0x3f, 0x46, 0x1e, 0xf9, 0xaa, 0x06, 0xc7, 0x13, 0x7d, 0x4f, 0x42, 0x02,
+	0x6f, 0xc4, 0xe9, 0x92, 0x52, 0xc0, 0x68, 0xd1, 0x5d, 0xa6, 0x47, 0x8b, 0x65, 0xb8, 0xb1, 0xd1,
+	0xbd, 0x65, 0xcc, 0x5b, 0xee, 0xf8, 0xde, 0x96, 0x8f, 0x82, 0xe0, 0x08, 0x17, 0xfc, 0x7f, 0x69,
+	0xee, 0x15, 0xd5, 0x18, 0x93, 0x2c, 0x3c, 0xa9, 0xe4, 0x15, 0xc6, 0x29, 0x79, 0xc5, 0x64, 0xb4,
+	0xdb, 0x3f, 0x6a, 0xb0, 0xb0, 0x82, 0x06, 0x3e, 0xea, 0x4a, 0x46, 0xef, 0x07, 0xa7, 0x86, 0x64,
+	0x6b, 0xd2, 0x12, 0xdf, 0x2f, 0xc7, 0xf9, 0x3e, 0xf6, 0x07, 0xb8, 0x5b, 0x8e, 0x8b, 0x04, 0x03,
+	0x65, 0x6f, 0x6a, 0x68, 0x2d, 0xe7, 0xa0, 0x67, 0x61, 0x66, 0xd3, 0xf3, 0xfb, 0x56, 0x28, 0xc0,
+	0x2a, 0x24, 0x50, 0x71, 0x9a, 0xd6, 0x32, 0x30, 0xe3, 0x1b, 0x05, 0x38, 0x65, 0x22, 0xd2, 0x77,
+	0x84, 0x07, 0x82, 0x80, 0xfb, 0xfd, 0x3c, 0xe0, 0x02, 0xe8, 0x7d, 0xc7, 0xed, 0x24, 0xd6, 0x42,
+	0x4f, 0x68, 0xb3, 0xef, 0xb8, 0x57, 0x63, 0xcb, 0x61, 0xd0, 0x89, 0x25, 0xb1, 0xd8, 0xcb, 0xbe,
+	0xe3, 0x5e, 0x93, 0x57, 0x45, 0x9e, 0xd1, 0x38, 0x7d, 0x87, 0x67, 0xf8, 0xa0, 0x05, 0xe2, 0x45,
+	0xf3, 0xf7, 0x3b, 0xfe, 0x90, 0xa2, 0xac, 0x6a, 0x4e, 0xd9, 0xfe, 0xbe, 0x39, 0x74, 0x15, 0xc9,
+	0x4a, 0x7e, 0x4f, 0x83, 0xd3, 0xd9, 0x68, 0x99, 0x84, 0x66, 0x57, 0x01, 0x6c, 0xd1, 0x23, 0x3b,
+	0xab, 0x2a, 0xeb, 0xa4, 0x9a, 0x2a, 0x4d, 0xa9, 0xb1, 0xfe, 0x0c, 0x34, 0x7d, 0x32, 0xc7, 0xb0,
+	0xc3, 0x88, 0x83, 0x8b, 0xf4, 0xb3, 0xac, 0x7e, 0x99, 0x55, 0xe3, 0xf8, 0xc3, 0x53, 0x19, 0x1e,
+	0x92, 0x09, 0xb6, 0x79, 0x8d, 0xbd, 0xee, 0xa5, 0xfd, 0xb0, 0xc5, 0xbc, 0xa0, 0x58, 0xcc, 0x68,
+	0xe7, 0x8c, 0x29, 0xf7, 0x82, 0x0d, 0x7f, 0xa7, 0xb3, 0xa7, 0x3a, 0x09, 0xea, 0x03, 0x68, 0x72,
+	0x33, 0x35, 0xad, 0x11, 0x4a, 0xc0, 0x8d, 0xfc, 0x73, 0x0e, 0x92, 0xd9, 0xd1, 0xd6, 0x58, 0x57,
+	0xf4, 0xfa, 0x9c, 0xed, 0xc6, 0x6b, 0xdb, 0x1d, 0x98, 0x57, 0x01, 0x2a, 0xf2, 0xa2, 0xbd, 0x10,
+	0xbf, 0x46, 0x47, 0x2e, 0x49, 0xba, 0x3e, 0x4d, 0x92, 0x26, 0x0a, 0xab, 0xe3, 0xeb, 0x24, 0x42,
+	0xf8, 0xae, 0x15, 0x22, 0xbf, 0x6f, 0xf9, 0x13, 0x64, 0xef, 0x31, 0xfe, 0xa0, 0x00, 0xa7, 0x32,
+	0x3b, 0x9d, 0x64, 0x0b, 0x9e, 0x85, 0x39, 0x1f, 0x85, 0xc8, 0x25, 0x66, 0x74, 0x1e, 0x41, 0x4d,
+	0xb9, 0x43, 0x53, 0xfc, 0xc0, 0x23, 0xa8, 0x3f, 0xaf, 0xc1, 0xf1, 0x28, 0x11, 0x40, 0x67, 0x4f,
+	0xcc, 0x81, 0x85, 0x94, 0xdd, 0x54, 0x0b, 0x39, 0xa3, 0x66, 0x2d, 0xc5, 0x99, 0x46, 0x3f, 0xd2,
+	0x9d, 0x9b, 0xef, 0x2a, 0x7e, 0x6a, 0x5f, 0x87, 0x13, 0x99, 0x4d, 0x14, 0xa2, 0xd0, 0xbc, 0xbc,
+	0x87, 0x25, 0x79, 0x9b, 0xba, 0x22, 0x27, 0xc7, 0x0d, 0x64, 0x1d, 0x45, 0xac, 0x9d, 0x0e, 0xa5,
+	0x6d, 0x64, 0xd1, 0x10, 0x5f, 0xcd, 0x24, 0xff, 0x63, 0xf5, 0xfd, 0x04, 0xf5, 0x1e, 0x4b, 0x63,
+	0x4d, 0x70, 0xc0, 0x5f, 0x4d, 0x04, 0x1a, 0x8d, 0x7c, 0x25, 0x83, 0xc7, 0x92, 0x62, 0x0d, 0xbf,
+	0xa0, 0xc9, 0x99, 0x10, 0x27, 0x9c, 0x48, 0x0e, 0x84, 0xbc, 0xaa, 0x7f, 0xf0, 0xe6, 0x6c, 0x55,
+	0x6b, 0x16, 0x65, 0x3e, 0xfe, 0x15, 0x0d, 0x16, 0x53, 0x93, 0x98, 0x84, 0x80, 0x27, 0xc1, 0xc8,
+	0x7f, 0x93, 0x33, 0x68, 0xde, 0x70, 0x82, 0xd0, 0xf3, 0xf7, 0xef, 0x53, 0xaa, 0x07, 0x25, 0x32,
+	0x7e, 0x1c, 0x19, 0x77, 0xf0, 0xaa, 0xd0, 0xd5, 0x5d, 0xe4, 0x86, 0xf8, 0x9d, 0x02, 0x79, 0x06,
+	0xa3, 0xe5, 0x8d, 0xad, 0x25, 0xe0, 0xfa, 0x0b, 0x50, 0x60, 0x0f, 0x70, 0x72, 0x35, 0x2a, 0x84,
+	0x1e, 0xc9, 0x9c, 0x6b, 0x0d, 0x03, 0x2e, 0x86, 0xd3, 0x42, 0xdc, 0xa8, 0x20, 0x3d, 0x56, 0x22,
+	0x15, 0xc6, 0xd7, 0x0a, 0xe4, 0xdd, 0x5d, 0x12, 0x69, 0x93, 0x6c, 0xe1, 0xd1, 0x3c, 0xbd, 0x8b,
+	0xa1, 0xbf, 0xa4, 0x10, 0xec, 0xe2, 0x2f, 0x5d, 0x78, 0x11, 0xdb, 0x9f, 0xd0, 0xae, 0x94, 0x8f,
+	0xea, 0xc9, 0x31, 0x59, 0x4f, 0xc9, 0x26, 0x99, 0xac, 0x8d, 0xf1, 0x33, 0x0d, 0xce, 0xdc, 0xc1,
+	0x68, 0x8b, 0x3c, 0x57, 0xb7, 0x2c, 0xc7, 0x0d, 0x91, 0x6b, 0xb9, 0x5d, 0x74, 0x7f, 0x05, 0xb6,
+	0x57, 0xa0, 0x1c, 0x74, 0xbd, 0x01, 0x8f, 0xc2, 0x56, 0xa9, 0x79, 0xd2, 0x5c, 0xd6, 0x30, 0xa8,
+	0x49, 0x5b, 0x60, 0xfb, 0x38, 0x33, 0xf3, 0xd1, 0xc0, 0x1c, 0x56, 0x52, 0x08, 0x5e, 0xbf, 0xa0,
+	0x41, 0x5b, 0xb9, 0x36, 0xb2, 0xea, 0xbc, 0x96, 0x9d, 0x88, 0x95, 0x33, 0x77, 0x84, 0x54, 0x83,
+	0x63, 0x16, 0xb7, 0xba, 0x4c, 0xbf, 0x2f, 0x6c, 0x75, 0xb3, 0x26, 0x47, 0x1f, 0x4c, 0x0e, 0x03,
+	0x9a, 0x73, 0x46, 0x3c, 0x98, 0xc4, 0x15, 0x4b, 0xa1, 0xf1, 0x73, 0x1a, 0x18, 0xa3, 0x36, 0x62,
+	0x12, 0x02, 0xbd, 0x82, 0xd3, 0x86, 0xe2, 0x73, 0x42, 0x05, 0x81, 0xe7, 0x94, 0xcf, 0x25, 0xb2,
+	0x50, 0x64, 0xd2, 0xb6, 0xc6, 0x6f, 0x6b, 0x60, 0x98, 0x28, 0x18, 0xf6, 0xff, 0x75, 0x91, 0x8a,
+	0x82, 0x24, 0x76, 0xe0, 0x6c, 0x2c, 0x93, 0x68, 0x72, 0xc5, 0x47, 0x9a, 0xa5, 0xf0, 0x7b, 0x1a,
+	0x3c, 0x35, 0x6e, 0xb4, 0x49, 0xf6, 0xf6, 0x2a, 0x4c, 0x91, 0xfd, 0xe1, 0xb7, 0xc7, 0x01, 0x37,
+	0x97, 0x35, 0x36, 0xbe, 0xa3, 0xc1, 0xb1, 0x15, 0x84, 0x87, 0x70, 0x82, 0x40, 0x72, 0x8d, 0x1f,
+	0x5d, 0xa2, 0xc8, 0x79, 0x62, 0xd5, 0xf7, 0x43, 0x76, 0x50, 0x68, 0x01, 0x0b, 0x1d, 0x7b, 0x96,
+	0x13, 0x32, 0x5b, 0x09, 0xf9, 0x5f, 0x81, 0xc4, 0xcf, 0x69, 0x70, 0x8c, 0x09, 0xbd, 0xf2, 0x24,
+	0x65, 0xae, 0xa8, 0xc5, 0xb9, 0xe2, 0xbc, 0xec, 0x43, 0xa8, 0x71, 0x17, 0x01, 0x89, 0xe0, 0xe5,
+	0x82, 0x77, 0x27, 0x0c, 0x98, 0xf7, 0xb0, 0x11, 0x55, 0xae, 0x67, 0xc5, 0xfc, 0xfd, 0xb8, 0x00,
+	0xf3, 0xf2, 0xd8, 0x93, 0xde, 0xfa, 0x63, 0x73, 0x97, 0xc8, 0x83, 0xc5, 0xfc, 0x1c, 0xe9, 0x47,
+	0x85, 0x45, 0xf9, 0x51, 0xa1, 0x72, 0xfa, 0xfa, 0xb2, 0x94, 0xa0, 0xa1, 0x9c, 0x19, 0x35, 0xa8,
+	0xc0, 0xb1, 0x94, 0xa7, 0xe1, 0x12, 0x1c, 0xf3, 0x69, 0xba, 0x53, 0xbb, 0xb3, 0xd9, 0xf3, 0xf6,
+	0xb6, 0x7c, 0x6b, 0xb0, 0xcd, 0xa3, 0x02, 0x75, 0xfe, 0xd3, 0x35, 0xf1, 0x0b, 0xb6, 0xd2, 0xb4,
+	0x6e, 0x7a, 0x58, 0xb7, 0xbc, 0xe3, 0x3b, 0x7d, 0xcb, 0xdf, 0xc7, 0xee, 0xc1, 0xfb, 0xcb, 0x28,
+	0x9a, 0x50, 0x1c, 0x30, 0x79, 0xbe, 0x66, 0xe2, 0x7f, 0x95, 0x82, 0xcb, 0x0a, 0xe8, 0xd1, 0x8c,
+	0xc8, 0x0c, 0x19, 0x1f, 0x1f, 0xec, 0x30, 0x42, 0x2a, 0x0c, 0x76, 0xc6, 0x26, 0x7d, 0xff, 0x1b,
+	0x0d, 0x4e, 0x28, 0x96, 0x77, 0xbf, 0x45, 0x89, 0x2b, 0x50, 0xeb, 0xb1, 0x29, 0x73, 0xc5, 0xe5,
+	0xac, 0x32, 0x02, 0x34, 0xb9, 0x40, 0x33, 0x6a, 0xa7, 0x4c, 0x42, 0x29, 0xb2, 0x0e, 0xaa, 0x7e,
+	0xc2, 0xe9, 0x83, 0xf9, 0x3b, 0xed, 0x07, 0x93, 0xad, 0x60, 0x9c, 0x6b, 0xb1, 0x4f, 0x7c, 0xb0,
+	0xcc, 0xdb, 0x7b, 0x7d, 0xa2, 0xa8, 0xa8, 0x1c, 0xd3, 0x31, 0x7e, 0xad, 0x00, 0xba, 0x34, 0xd8,
+	0xd1, 0xbd, 0x71, 0x1a, 0x2f, 0x1a, 0x4a, 0x6c, 0xae, 0x14, 0x67, 0x73, 0xb2, 0x5b, 0xa3, 0x1c,
+	0x0f, 0x3b, 0x98, 0x97, 0xf3, 0x20, 0xd6, 0x24, 0xe6, 0xc1, 0xb6, 0x16, 0x0b, 0x21, 0x2c, 0xc7,
+	0x21, 0xab, 0x59, 0x0a, 0xb1, 0x91, 0x0f, 0xb3, 0x60, 0x12, 0xc8, 0x4b, 0x75, 0xe9, 0x2a, 0xd1,
+	0x06, 0xa7, 0x69, 0x2d, 0x57, 0xa4, 0x89, 0xd6, 0xdd, 0xb7, 0x1c, 0x17, 0x07, 0xab, 0x73, 0xc8,
+	0x1a, 0x81, 0x6c, 0x8a, 0x1f, 0x18, 0xb0, 0xf1, 0x27, 0x54, 0x6f, 0x8b, 0x6d, 0xd4, 0x24, 0x67,
+	0xa4, 0x05, 0x15, 0xea, 0x44, 0x11, 0xa1, 0x21, 0xac, 0x88, 0x9d, 0x4b, 0x38, 0x83, 0x23, 0x9e,
+	0xab, 0x98, 0x15, 0x45, 0xe7, 0x4c, 0xdf, 0xba, 0x77, 0xd7, 0x72, 0x42, 0xbe, 0x80, 0x25, 0x49,
+	0xeb, 0x2a, 0x65, 0x1e, 0xa1, 0xf4, 0x76, 0x4b, 0xca, 0xd7, 0xfb, 0xb1, 0x14, 0x28, 0x4b, 0xae,
+	0xd7, 0xb7, 0x7a, 0x0e, 0x7a, 0x08, 0x2a, 0xe9, 0xd7, 0x35, 0x98, 0x89, 0xcd, 0x62, 0x1f, 0xdf,
+	0xaa, 0x3b, 0x8e, 0x6b, 0xf3, 0x28, 0x78, 0xfc, 0x3f, 0xb6, 0x6d, 0x63, 0x52, 0x91, 0x94, 0x4d,
+	0x42, 0x67, 0xee, 0xb0, 0x2f, 0x42, 0xb3, 0xc7, 0x9c, 0x38, 0x9c, 0x5d, 0xd1, 0x27, 0x57, 0x03,
+	0x72, 0x6d, 0x4b, 0x7c, 0x30, 0xa2, 0x66, 0x26, 0x6a, 0xb1, 0x11, 0x7c, 0x51, 0x8a, 0x84, 0x63,
+	0xa8, 0x43, 0xfc, 0x2b, 0x30, 0x63, 0x05, 0xee, 0x1c, 0x53, 0x3d, 0x45, 0x9f, 0x82, 0xf3, 0xc4,
+	0x7b, 0x74, 0x97, 0xc1, 0x1d, 0xf6, 0x59, 0x66, 0x91, 0x51, 0x01, 0x39, 0xd1, 0x3b, 0x5e, 0x29,
+	0x75, 0x09, 0x7b, 0xc7, 0x4b, 0x3c, 0x8a, 0x6f, 0x41, 0xcd, 0xe2, 0xfb, 0xa9, 0xf6, 0x73, 0xca,
+	0x5a, 0x15, 0x43, 0xba, 0x19, 0xb5, 0x31, 0xbe, 0x1d, 0x0b, 0xbc, 0x90, 0x68, 0x63, 0x12, 0xba,
+	0x5f, 0xc1, 0xcf, 0x9e, 0x31, 0x0e, 0xb9, 0xa8, 0x77, 0x7e, 0xa4, 0xa8, 0x17, 0x43, 0xbb, 0xc9,
+	0x9b, 0xe2, 0xd0, 0xe1, 0x5b, 0xc8, 0xdf, 0x42, 0xeb, 0x8e, 0xbb, 0xff, 0x40, 0xf8, 0xb8, 0xf1,
+	0xcd, 0x38, 0x32, 0x7a, 0x3d, 0xaf, 0x7b, 0xc3, 0x79, 0xf8, 0xb9, 0x6e, 0x8c, 0x2f, 0x46, 0x69,
+	0x57, 0xc4, 0xa4, 0x46, 0x48, 0x94, 0x32, 0x41, 0x15, 0xe2, 0x04, 0x85, 0x1f, 0x98, 0xec, 0xa0,
+	0x3d, 0x6e, 0x39, 0xc3, 0xff, 0xe3, 0x60, 0x29, 0x1c, 0x57, 0x4c, 0x1c, 0x05, 0x1d, 0x1f, 0x9f,
+	0x0b, 0x42, 0x86, 0x9a, 0x39, 0xed, 0x7b, 0x7b, 0x37, 0x71, 0xad, 0x89, 0x2b, 0x8d, 0x3f, 0xd7,
+	0xe0, 0x98, 0x48, 0xc9, 0x1f, 0x21, 0xe7, 0x88, 0xbc, 0x45, 0xf2, 0xa4, 0x8b, 0xf1, 0x49, 0xbf,
+	0x25, 0x49, 0x84, 0xa5, 0xcc, 0x44, 0xe2, 0x49, 0x04, 0x49, 0xe2, 0x20, 0xcb, 0x9d, 0x32, 0xe4,
+	0xd1, 0x5c, 0xe5, 0x28, 0x77, 0xca, 0x90, 0x85, 0xe2, 0x7d, 0x27, 0x16, 0x71, 0x23, 0x6f, 0xfd,
+	0x24, 0x07, 0xe1, 0x1a, 0x80, 0x58, 0x23, 0x3f, 0x0b, 0xca, 0x37, 0x30, 0x69, 0xb4, 0x9a, 0x52,
+	0x4b, 0x9c, 0xf3, 0xf9, 0xf8, 0x35, 0x1f, 0xa1, 0xf7, 0x78, 0xc8, 0xd8, 0x7d, 0x26, 0xc9, 0x53,
+	0x50, 0x0f, 0xc3, 0x5e, 0xe2, 0x66, 0x82, 0x30, 0xec, 0xb1, 0x5b, 0x49, 0xa1, 0xf8, 0xf4, 0xa0,
+	0x41, 0x67, 0x78, 0xd7, 0x71, 0x6d, 0x6f, 0x2f, 0x17, 0x55, 0x9c, 0x84, 0xda, 0xa6, 0xef, 0xbd,
+	0x87, 0x5c, 0x7c, 0xc3, 0xd3, 0x79, 0x54, 0x69, 0x45, 0x32, 0x5d, 0x7d, 0x31, 0x91, 0xae, 0xfe,
+	0x7d, 0x0d, 0x16, 0x92, 0x08, 0x99, 0x64, 0xa3, 0x3e, 0x0c, 0x53, 0x7b, 0x64, 0xde, 0xcc, 0xf0,
+	0xa0, 0x7a, 0x7e, 0x23, 0x2f, 0xcf, 0x64, 0xe0, 0xc6, 0xe7, 0x35, 0x58, 0x7c, 0xdb, 0xdd, 0x7c,
+	0x70, 0x7b, 0xa3, 0x40, 0xfd, 0xa7, 0x88, 0xa1, 0x57, 0x9e, 0xde, 0x91, 0xda, 0x05, 0xbe, 0x42,
+	0x3f, 0x7f, 0x94, 0xe8, 0x7f, 0x12, 0x6c, 0xbf, 0x02, 0x15, 0x8a, 0xbe, 0x51, 0xaf, 0x9d, 0x62,
+	0xe8, 0xe6, 0xf0, 0xc6, 0x67, 0x49, 0xd8, 0xd3, 0x0a, 0x89, 0xd2, 0x5c, 0xbb, 0xb9, 0xf4, 0xe0,
+	0x65, 0x98, 0xbf, 0x28, 0xc0, 0xac, 0x18, 0x1e, 0xbf, 0x3f, 0x40, 0xbe, 0xf4, 0x14, 0xb8, 0x46,
+	0x9e, 0x02, 0x3f, 0x5c, 0x33, 0xeb, 0x49, 0xf2, 0xd2, 0x1f, 0xd1, 0xc7, 0xb8, 0x34, 0x54, 0xad,
+	0x4a, 0x2b, 0xd6, 0xc9, 0x2b, 0xf4, 0x81, 0xef, 0xd9, 0xc3, 0x2e, 0xb2, 0x29, 0x2f, 0xa4, 0x82,
+	0x75, 0x83, 0x57, 0xf2, 0xd8, 0xd6, 0x01, 0xf2, 0x03, 0x27, 0x08, 0x39, 0x14, 0x4b, 0xa7, 0x23,
+	0x6a, 0x09, 0xd8, 0xb3, 0x30, 0x17, 0x99, 0x23, 0x38, 0x24, 0x0d, 0x69, 0x6a, 0xca, 0x3f, 0xf0,
+	0x3e, 0xa5, 0xfc, 0x55, 0x18, 0x12, 0x78, 0x8a, 0x1e, 0x56, 0x4b, 0xf8, 0xf0, 0x9f, 0x69, 0x30,
+	0x23, 0xd0, 0xbb, 0x16, 0x5a, 0x5b, 0x88, 0xa9, 0x07, 0x5b, 0x3c, 0xa5, 0x2a, 0x2d, 0xe0, 0xf5,
+	0xfb, 0xc8, 0xea, 0x6e, 0x23, 0xfe, 0x28, 0x87, 0x17, 0xc9, 0x87, 0x30, 0x76, 0xb7, 0x62, 0xcc,
+	0x4b, 0x33, 0xc1, 0xda, 0xe5, 0x62, 0x3e, 0x06, 0xc0, 0xc2, 0xb7, 0x9c, 0xc5, 0x48, 0x33, 0xa1,
+	0x6f, 0xdd, 0xe3, 0x00, 0x2d, 0xa8, 0x0c, 0x90, 0x6b, 0xe3, 0x0c, 0xc3, 0x4c, 0x55, 0x61, 0x45,
+	0xfd, 0x35, 0x68, 0x7b, 0x3d, 0x1b, 0x05, 0x61, 0x87, 0xd5, 0x74, 0x48, 0x5e, 0x39, 0xd6, 0xd3,
+	0x14, 0xe9, 0x69, 0x91, 0x42, 0xdc, 0xa1, 0x00, 0x4b, 0x5b, 0x88, 0xab, 0x17, 0xbf, 0xa5, 0x91,
+	0xe8, 0x27, 0x89, 0x78, 0x27, 0x3b, 0x44, 0x53, 0x04, 0x13, 0x19, 0x9f, 0x58, 0x62, 0x96, 0x19,
+	0x19, 0x93, 0x26, 0x6b, 0xa0, 0xbf, 0x0e, 0x95, 0x3e, 0xa1, 0x5c, 0xae, 0x95, 0x1b, 0xa3, 0xda,
+	0x52, 0x22, 0x37, 0x79, 0x93, 0xf3, 0x6f, 0x0a, 0x77, 0x1e, 0xce, 0xdd, 0xa4, 0x57, 0xa0, 0x78,
+	0x1b, 0xed, 0x35, 0x1f, 0xd1, 0x01, 0xa6, 0x6e, 0x7b, 0x7e, 0xdf, 0xea, 0x35, 0x35, 0xbd, 0x0e,
+	0x15, 0x26, 0xde, 0x36, 0x0b, 0xfa, 0x34, 0xd4, 0xae, 0xf0, 0x4d, 0x6e, 0x16, 0xcf, 0xff, 0x3f,
+	0x0d, 0xe6, 0x52, 0xf9, 0xdb, 0xf4, 0x19, 0x80, 0xb7, 0xdd, 0x2e, 0x4b, 0x6c, 0xd7, 0x7c, 0x44,
+	0x6f, 0x40, 0x95, 0xa7, 0xb9, 0xa3, 0xfd, 0xad, 0x7b, 0x04, 0xba, 0x59, 0xd0, 0x9b, 0xd0, 0xa0,
+	0x0d, 0x87, 0xdd, 0x2e, 0x0a, 0x82, 0x66, 0x51, 0xd4, 0xe0, 0x57, 0x05, 0x43, 0x1f, 0x35, 0x4b,
+	0x78, 0xcc, 0x75, 0x8f, 0x7d, 0xf2, 0xa6, 0x59, 0xd6, 0x75, 0x98, 0x61, 0x05, 0xde, 0x68, 0x4a,
+	0xaa, 0xe3, 0xcd, 0x2a, 0xe7, 0xef, 0xca, 0x59, 0xb8, 0xc8, 0xf2, 0x16, 0xe1, 0xd8, 0xdb, 0xae,
+	0x8d, 0x36, 0x1d, 0x17, 0xd9, 0xd1, 0x4f, 0xcd, 0x47, 0xf4, 0x63, 0x30, 0x4b, 0xc4, 0x53, 0xa9,
+	0xb2, 0xa0, 0xcf, 0xc1, 0xf4, 0x2d, 0xe7, 0x9e, 0x54, 0x55, 0x34, 0x4a, 0x55, 0xad, 0xa9, 0x9d,
+	0x5f, 0x87, 0x66, 0xd2, 0xec, 0x8b, 0x27, 0x20, 0xd5, 0x2d, 0xf5, 0x7a, 0xcd, 0x47, 0xf4, 0x13,
+	0x70, 0x5c, 0xaa, 0x93, 0x3a, 0xd2, 0x48, 0xdf, 0xd1, 0x4f, 0xd7, 0xaf, 0x34, 0x0b, 0xe7, 0x7d,
+	0x98, 0x4b, 0x19, 0xdf, 0xf4, 0x79, 0x68, 0xca, 0x95, 0xb7, 0x3d, 0x17, 0xe3, 0xb3, 0x15, 0x37,
+	0x0a, 0xae, 0xf8, 0x54, 0xf5, 0x6d, 0x6a, 0xfa, 0xf1, 0x78, 0x27, 0x26, 0xb2, 0xec, 0xfd, 0x66,
+	0x41, 0x5f, 0x00, 0x5d, 0xae, 0xc6, 0x38, 0xc2, 0xdb, 0x77, 0xf9, 0x47, 0x1f, 0x86, 0x1a, 0x7e,
+	0xcd, 0x73, 0xc5, 0xf3, 0x7c, 0x5b, 0xef, 0x81, 0x4e, 0x6c, 0xc6, 0xfd, 0x81, 0xe7, 0x8a, 0x8f,
+	0xe3, 0xe9, 0x17, 0xe3, 0xf4, 0xc4, 0x0a, 0x69, 0x40, 0xc6, 0xbe, 0xdb, 0x4f, 0x2a, 0xe1, 0x13,
+	0xc0, 0xc6, 0x23, 0x7a, 0x9f, 0x8c, 0x46, 0xbc, 0xdc, 0x4e, 0x77, 0x87, 0x3f, 0x49, 0x7d, 0x3e,
+	0xe3, 0x01, 0x6a, 0x1a, 0x94, 0x8f, 0xf7, 0x84, 0x72, 0x3c, 0xfa, 0x31, 0x32, 0x7e, 0x2a, 0x8d,
+	0x47, 0xf4, 0x77, 0xc9, 0x79, 0x8d, 0x5e, 0xf7, 0xf2, 0x01, 0x2f, 0x67, 0x0f, 0x98, 0x02, 0x3e,
+	0xe0, 0x90, 0x37, 0xa1, 0x4c, 0x0e, 0x8e, 0xae, 0xbc, 0x12, 0xa5, 0xef, 0xd8, 0xb6, 0x4f, 0x67,
+	0x03, 0x88, 0xde, 0x3e, 0x0d, 0xb3, 0x89, 0xaf, 0x5f, 0xea, 0xaa, 0x80, 0x1b, 0xf5, 0x77, 0x4c,
+	0xdb, 0xe7, 0xf3, 0x80, 0x8a, 0xb1, 0xb6, 0x60, 0x26, 0xfe, 0x8d, 0x2c, 0xfd, 0x5c, 0x8e, 0xcf,
+	0xed, 0xd1, 0x91, 0x9e, 0xc9, 0xfd, 0x61, 0x3e, 0x42, 0x04, 0xcd, 0xe4, 0xd7, 0x18, 0xf5, 0xf3,
+	0x23, 0x3b, 0x88, 0x13, 0xdb, 0xb3, 0xb9, 0x60, 0xc5, 0x70, 0xfb, 0x2c, 0x64, 0x35, 0xf1, 0x15,
+	0x3c, 0xfd, 0xa2, 0xba, 0x9b, 0xac, 0xcf, 0xf3, 0xb5, 0x2f, 0xe5, 0x86, 0x17, 0x43, 0x7f, 0x81,
+	0xba, 0xf0, 0x55, 0x5f, 0x92, 0xd3, 0x5f, 0x50, 0x77, 0x37, 0xe2, 0x13, 0x78, 0xed, 0xcb, 0x07,
+	0x69, 0x22, 0x26, 0xf1, 0x59, 0x62, 0x13, 0x53, 0x7c, 0x8b, 0x4d, 0x7f, 0x5e, 0xdd, 0x5f, 0xf6,
+	0x67, 0xe6, 0xda, 0x2f, 0x1c, 0xa0, 0x85, 0x98, 0x80, 0x97, 0xfc, 0xdc, 0x25, 0x3f, 0x86, 0x97,
+	0xc6, 0x52, 0xcd, 0xe1, 0xce, 0xe0, 0x27, 0x61, 0x36, 0xf1, 0x40, 0x56, 0xcf, 0xff, 0x88, 0xb6,
+	0x3d, 0xea, 0xf2, 0xa6, 0x47, 0x32, 0x91, 0xac, 0x59, 0xcf, 0xa0, 0x7e, 0x45, 0x42, 0xe7, 0xf6,
+	0xf9, 0x3c, 0xa0, 0x62, 0x21, 0x01, 0x61, 0x97, 0x89, 0x0c, 0xb6, 0xfa, 0x05, 0x75, 0x1f, 0xea,
+	0xfc, 0xbe, 0xed, 0xe7, 0x72, 0x42, 0x8b, 0x41, 0x77, 0x89, 0x84, 0x9e, 0x4c, 0x4f, 0xac, 0x3f,
+	0x37, 0x72, 0xb3, 0x92, 0x79, 0x99, 0xdb, 0x17, 0xf3, 0x82, 0x8b, 0x71, 0x3f, 0x03, 0xfa, 0xda,
+	0x36, 0x4e, 0xea, 0xe3, 0x6e, 0x3a, 0x5b, 0x43, 0x9f, 0x7b, 0x0f, 0xb2, 0x3e, 0x3c, 0x99, 0x02,
+	0xcd, 0xa0, 0xd1, 0x91, 0x2d, 0xc4, 0xe0, 0x1d, 0x80, 0xeb, 0x28, 0xbc, 0x85, 0x42, 0x1f, 0x1f,
+	0x8c, 0xa7, 0xb2, 0xae, 0x3f, 0x06, 0xc0, 0x87, 0x7a, 0x7a, 0x2c, 0x9c, 0x74, 0x15, 0x35, 0x6f,
+	0x59, 0x2e, 0xce, 0x67, 0x15, 0x79, 0xf3, 0x2f, 0x28, 0x9b, 0x27, 0xc1, 0x32, 0x36, 0x32, 0x13,
+	0x5a, 0x0c, 0xb9, 0x27, 0xae, 0x76, 0x29, 0x3b, 0xe1, 0xe8, 0xab, 0x3d, 0x9d, 0x19, 0xb7, 0x7d,
+	0x29, 0x37, 0xbc, 0x18, 0x98, 0x3d, 0x06, 0x4b, 0x00, 0xdc, 0x75, 0xc2, 0x6d, 0x9c, 0x17, 0x35,
+	0xc8, 0x33, 0x05, 0x02, 0x78, 0x80, 0x29, 0x30, 0x78, 0x31, 0x05, 0x1b, 0xa6, 0x63, 0x49, 0x03,
+	0x75, 0xd5, 0x37, 0x57, 0x54, 0x09, 0x14, 0xdb, 0xe7, 0xc6, 0x03, 0x8a, 0x51, 0xb6, 0x61, 0x9a,
+	0x1f, 0x25, 0x8a, 0xdc, 0x67, 0xb2, 0x66, 0x1a, 0xc1, 0x64, 0x70, 0x02, 0x35, 0xa8, 0xcc, 0x09,
+	0xd2, 0x39, 0xd1, 0xf4, 0x7c, 0xb9, 0xf4, 0x46, 0x71, 0x82, 0xec, 0x44, 0x6b, 0x94, 0xd5, 0x25,
+	0xf2, 0x0f, 0xaa, 0xf9, 0xa8, 0x32, 0x9d, 0x62, 0xfb, 0x7c, 0x1e, 0x50, 0x31, 0xd6, 0x5d, 0x98,
+	0x62, 0x1f, 0x6f, 0x7f, 0x72, 0x74, 0x1e, 0x23, 0xd6, 0xfb, 0xd9, 0x31, 0x50, 0xa2, 0xe3, 0xff,
+	0x08, 0x35, 0x91, 0xa1, 0x46, 0x7f, 0x62, 0x54, 0xfe, 0x9a, 0x0c, 0x61, 0x36, 0x09, 0x24, 0x7a,
+	0xde, 0x81, 0xc5, 0x8c, 0x2c, 0x32, 0x7a, 0x76, 0x20, 0x71, 0x56, 0xc6, 0x99, 0x71, 0xd7, 0x8e,
+	0x18, 0x2c, 0x15, 0xd5, 0xab, 0x1f, 0x3c, 0x6a, 0x79, 0xdc, 0x60, 0x1d, 0x98, 0x4b, 0x65, 0xe0,
+	0xd0, 0x9f, 0xcd, 0xb8, 0x42, 0x55, 0x79, 0x3a, 0xc6, 0x0d, 0xb0, 0x05, 0xc7, 0x95, 0xd9, 0x26,
+	0x94, 0x22, 0xc1, 0xa8, 0xbc, 0x14, 0xe3, 0x06, 0xea, 0xc2, 0x31, 0x45, 0x8e, 0x09, 0xe5, 0x65,
+	0x96, 0x9d, 0x8b, 0x62, 0xdc, 0x20, 0x9b, 0xd0, 0x5e, 0xf6, 0x3d, 0xcb, 0xee, 0x5a, 0x41, 0x48,
+	0xf2, 0x3e, 0x20, 0x3b, 0x92, 0xc9, 0xd4, 0x02, 0xbb, 0x32, 0x3b, 0xc4, 0xb8, 0x71, 0x36, 0xa0,
+	0x4e, 0xb6, 0x92, 0x7e, 0xb0, 0x5b, 0x57, 0xdf, 0x3e, 0x12, 0x44, 0x06, 0x4b, 0x53, 0x01, 0x0a,
+	0xa2, 0x5e, 0x83, 0xba, 0xf4, 0x48, 0x54, 0x57, 0x1d, 0xb3, 0xf4, 0x23, 0xd2, 0x71, 0x13, 0xb7,
+	0x09, 0x9f, 0x94, 0x5e, 0xe5, 0x3e, 0x3d, 0xe2, 0x8d, 0x57, 0x6c, 0x7b, 0xcf, 0x8d, 0x07, 0x4c,
+	0x08, 0xfa, 0xe9, 0x27, 0xc0, 0x17, 0xc7, 0x88, 0x99, 0xc9, 0x31, 0x2f, 0xe5, 0x86, 0x17, 0x43,
+	0x6f, 0x44, 0x0b, 0x24, 0x0f, 0x93, 0xf4, 0xa7, 0xc6, 0x3e, 0x62, 0x53, 0x4a, 0x10, 0x99, 0x8f,
+	0xdd, 0x8c, 0x47, 0xf4, 0x8f, 0x41, 0x4d, 0x3c, 0x35, 0x53, 0x32, 0xb2, 0xe4, 0x43, 0xb4, 0x1c,
+	0xbb, 0x12, 0x7b, 0xc9, 0xa5, 0xdc, 0x15, 0xd5, 0x3b, 0xb2, 0xf6, 0xb9, 0xf1, 0x80, 0x62, 0xda,
+	0xff, 0x35, 0x7a, 0xbf, 0x1e, 0x7b, 0x3e, 0xa5, 0x5f, 0x1a, 0xb1, 0x74, 0xd5, 0x63, 0xae, 0xf6,
+	0xf3, 0xf9, 0x1b, 0x88, 0xd1, 0xbf, 0xa4, 0x41, 0x2b, 0xeb, 0x31, 0x8c, 0x7e, 0x59, 0xf9, 0x59,
+	0x93, 0x91, 0x0f, 0x8a, 0xda, 0x2f, 0x1e, 0xa8, 0x4d, 0x6c, 0x1e, 0x59, 0xaf, 0x32, 0x94, 0xf3,
+	0x18, 0xf3, 0xe2, 0xa5, 0xfd, 0xe2, 0x81, 0xda, 0x24, 0x35, 0x52, 0xd5, 0x3b, 0x83, 0x2c, 0x8d,
+	0x74, 0xc4, 0xf3, 0x8c, 0xf6, 0xe5, 0x83, 0x34, 0x11, 0x93, 0xb0, 0x40, 0x4f, 0x47, 0xfa, 0x2b,
+	0x85, 0x99, 0xcc, 0x07, 0x01, 0xe3, 0x68, 0x7b, 0x00, 0x73, 0xa9, 0xd0, 0x6b, 0x7d, 0xb4, 0xe1,
+	0x20, 0x1e, 0xd5, 0xde, 0xbe, 0x90, 0x0f, 0x58, 0x2c, 0xea, 0xab, 0x1a, 0xb4, 0xb3, 0xa3, 0x6a,
+	0xf5, 0x0f, 0x29, 0xbd, 0x86, 0x63, 0xa2, 0xa1, 0xdb, 0x2f, 0x1d, 0xb0, 0x95, 0x24, 0x2f, 0x9e,
+	0x1c, 0x11, 0x41, 0xab, 0xbf, 0xa4, 0xc4, 0xf5, 0xb8, 0x88, 0xdb, 0x71, 0x48, 0xff, 0x56, 0xf2,
+	0x2b, 0xfe, 0xa9, 0x00, 0x54, 0xfd, 0xe5, 0x71, 0x26, 0x8c, 0xac, 0x08, 0xd9, 0xf6, 0x2b, 0x87,
+	0x68, 0x29, 0xd0, 0xe1, 0x24, 0x8c, 0xa7, 0xec, 0x5b, 0x6b, 0x4a, 0x36, 0xad, 0x88, 0x4d, 0x6d,
+	0x3f, 0x3d, 0x16, 0x4e, 0x0c, 0x35, 0x80, 0xb9, 0x54, 0xa4, 0x9e, 0x92, 0xf2, 0xb2, 0xc2, 0x15,
+	0xdb, 0x17, 0xf2, 0x01, 0xcb, 0xc7, 0x29, 0xfd, 0xed, 0x7a, 0xe5, 0x71, 0xca, 0xfc, 0xc4, 0xfd,
+	0xb8, 0x9d, 0xfd, 0x14, 0x34, 0x93, 0xdf, 0x77, 0x57, 0x9a, 0xec, 0x32, 0x3e, 0x02, 0x3f, 0xae,
+	0x7b, 0xc2, 0x10, 0x92, 0x5f, 0xb7, 0xcf, 0x60, 0x08, 0x19, 0x1f, 0xc1, 0x1f, 0x37, 0xc4, 0x2e,
+	0x1c, 0x53, 0x7c, 0x1e, 0x5d, 0x29, 0x08, 0x66, 0x7f, 0x4c, 0xbe, 0x7d, 0x31, 0x2f, 0xb8, 0x64,
+	0xec, 0x9c, 0x4d, 0x84, 0x32, 0x2a, 0x05, 0x42, 0x75, 0xb8, 0xe3, 0xc1, 0x75, 0x7e, 0x6a, 0xc4,
+	0x95, 0xa2, 0xc9, 0xb2, 0x8c, 0xb8, 0xe9, 0x60, 0xc6, 0xf6, 0x33, 0x39, 0x20, 0xd5, 0x56, 0x22,
+	0x11, 0x76, 0x34, 0xc6, 0x4a, 0x94, 0x0c, 0x5d, 0x6b, 0x5f, 0xcc, 0x0b, 0x2e, 0xd9, 0x51, 0xe6,
+	0x52, 0x41, 0x45, 0xca, 0xe3, 0x95, 0x15, 0x7a, 0x74, 0x70, 0x9c, 0xc6, 0xe4, 0x4a, 0x29, 0x6e,
+	0x66, 0xcc, 0xe4, 0x93, 0xd1, 0x47, 0xed, 0x4b, 0xb9, 0xe1, 0x65, 0x0d, 0x3c, 0xf1, 0x04, 0x4c,
+	0x1f, 0x6d, 0x6a, 0x8f, 0xdd, 0x91, 0xe7, 0xf3, 0x80, 0xca, 0xa4, 0x13, 0x8f, 0xc8, 0x50, 0x92,
+	0x8e, 0x32, 0x8a, 0xa5, 0xfd, 0x4c, 0x0e, 0x48, 0x31, 0xd0, 0xa7, 0xa0, 0x99, 0x8c, 0xb8, 0x50,
+	0x32, 0x93, 0x8c, 0xb0, 0x8c, 0x71, 0x27, 0x9d, 0xba, 0x17, 0x62, 0xd1, 0x0e, 0x59, 0xee, 0x05,
+	0x55, 0xc8, 0x45, 0xfb, 0xd9, 0x5c, 0xb0, 0x12, 0xf7, 0x6d, 0xc8, 0x3e, 0xe1, 0x2c, 0xc9, 0x3f,
+	0x19, 0xf1, 0xd0, 0x7e, 0x7a, 0x2c, 0x1c, 0x1f, 0xe2, 0xf2, 0x0f, 0x00, 0xaa, 0xe2, 0xca, 0x7a,
+	0xb0, 0x0e, 0xbb, 0x87, 0xe0, 0x41, 0xfb, 0x24, 0xcc, 0x26, 0x3e, 0xd5, 0xaf, 0xa4, 0x79, 0xf5,
+	0xe7, 0xfc, 0xc7, 0x11, 0xc7, 0x5d, 0x98, 0x8e, 0x7d, 0x7b, 0x5f, 0xa9, 0xf3, 0xa8, 0xbe, 0xce,
+	0x3f, 0xae, 0xe3, 0x7f, 0xdb, 0xd6, 0xeb, 0xdb, 0x00, 0x92, 0xdd, 0x7a, 0xf4, 0x07, 0xa1, 0xb0,
+	0x29, 0x76, 0xfc, 0x19, 0x55, 0x99, 0xa6, 0x9f, 0xc9, 0xf3, 0x71, 0x9d, 0x6c, 0xd6, 0x96, 0x6d,
+	0x90, 0x7e, 0x1b, 0x1a, 0xf2, 0xa7, 0xda, 0x94, 0x67, 0x54, 0xf1, 0x2d, 0xb7, 0x71, 0xab, 0xb8,
+	0x75, 0x40, 0x9b, 0xe5, 0x98, 0xee, 0x02, 0xd0, 0xd3, 0xa9, 0x90, 0x33, 0xa4, 0xa0, 0x8c, 0x04,
+	0xcc, 0xed, 0xe7, 0x72, 0x42, 0xcb, 0xce, 0xd8, 0x64, 0x7e, 0x5f, 0x25, 0xb7, 0xcc, 0xc8, 0x98,
+	0xdc, 0x7e, 0x36, 0x17, 0xac, 0xcc, 0x2d, 0x63, 0xcf, 0xaa, 0x8e, 0x5e, 0x00, 0x5f, 0x7e, 0xf1,
+	0x13, 0x2f, 0x6c, 0x39, 0xe1, 0xf6, 0x70, 0x03, 0x23, 0xf8, 0x12, 0x6d, 0xf6, 0x9c, 0xe3, 0xb1,
+	0xff, 0x2e, 0xf1, 0x13, 0x75, 0x89, 0xf4, 0x74, 0x09, 0xf7, 0x34, 0xd8, 0xd8, 0x98, 0x22, 0xa5,
+	0x17, 0xff, 0x79, 0x00, 0x01, 0xe0, 0x53, 0xf4, 0xd6, 0x96, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
+	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
+	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
+	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
+	// PreImport checks the files of an import against the collection schema and estimates the rows and the segments
+	// the import produces, without scheduling any import task
+	PreImport(ctx context.Context, in *PreImportRequest, opts ...grpc.CallOption) (*PreImportResponse, error)
+	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
+	GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error)
+	GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+	RebuildDeprecatedIndexes(ctx context.Context, in *RebuildDeprecatedIndexesRequest, opts ...grpc.CallOption) (*RebuildDeprecatedIndexesResponse, error)
+	UpdateChannelCheckpoints(ctx context.Context, in *UpdateChannelCheckpointsRequest, opts ...grpc.CallOption) (*UpdateChannelCheckpointsResponse, error)
+	GetTimeTravelWatermarks(ctx context.Context, in *GetTimeTravelWatermarksRequest, opts ...grpc.CallOption) (*GetTimeTravelWatermarksResponse, error)
+	ReportSegmentHeats(ctx context.Context, in *ReportSegmentHeatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// GetSegmentHistory returns the state transitions of a segment with their causes
+	GetSegmentHistory(ctx context.Context, in *GetSegmentHistoryRequest, opts ...grpc.CallOption) (*GetSegmentHistoryResponse, error)
+	// PauseCollectionMaintenance pauses the compaction and/or the binlog gc of a collection until it's resumed
+	PauseCollectionMaintenance(ctx context.Context, in *PauseCollectionMaintenanceRequest, opts ...grpc.CallOption) (*PauseCollectionMaintenanceResponse, error)
+	ResumeCollectionMaintenance(ctx context.Context, in *ResumeCollectionMaintenanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCollectionMaintenancePauses(ctx context.Context, in *GetCollectionMaintenancePausesRequest, opts ...grpc.CallOption) (*GetCollectionMaintenancePausesResponse, error)
+	DecommissionDataNode(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionResponse, error)
+	// LocatePrimaryKeys returns the segments of a collection which may contain the primary keys
+	LocatePrimaryKeys(ctx context.Context, in *LocatePrimaryKeysRequest, opts ...grpc.CallOption) (*LocatePrimaryKeysResponse, error)
+	// AcquireSegmentLock, RenewSegmentLock and ReleaseSegmentLock acquire, renew and release the lease of the segment
+	// locks held by a task of a node, the locked segments are not recycled by GC until the lease expires
+	AcquireSegmentLock(ctx context.Context, in *AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenewSegmentLock(ctx context.Context, in *RenewSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseSegmentLock(ctx context.Context, in *ReleaseSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// InspectSegmentLocks returns the segment lock leases not expired yet
+	InspectSegmentLocks(ctx context.Context, in *InspectSegmentLocksRequest, opts ...grpc.CallOption) (*InspectSegmentLocksResponse, error)
+	// CompactSegments triggers a manual compaction of the given segments of a collection only
+	CompactSegments(ctx context.Context, in *CompactSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	// GetHandoffGate returns the handoff gating states of the flushing and flushed segments
+	GetHandoffGate(ctx context.Context, in *GetHandoffGateRequest, opts ...grpc.CallOption) (*GetHandoffGateResponse, error)
+	// GetSegmentAnomalies returns the segment reports of the collections with the anomalies detected
+	GetSegmentAnomalies(ctx context.Context, in *GetSegmentAnomaliesRequest, opts ...grpc.CallOption) (*GetSegmentAnomaliesResponse, error)
+	// MergeTinySegments triggers a compaction of a collection which merges its small segments
+	MergeTinySegments(ctx context.Context, in *MergeTinySegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	// GetSegmentAllocHints returns the row distribution of the partitions over the vchannels and the allocation hints
+	GetSegmentAllocHints(ctx context.Context, in *GetSegmentAllocHintsRequest, opts ...grpc.CallOption) (*GetSegmentAllocHintsResponse, error)
+	// GetSegmentHeats returns the query heats of the healthy segments from the hottest, the cold segments are left out
+	GetSegmentHeats(ctx context.Context, in *GetSegmentHeatsRequest, opts ...grpc.CallOption) (*GetSegmentHeatsResponse, error)
+	// FreezeHandoffs, UnfreezeHandoffs and GetFreezeWindows freeze, unfreeze and list the index completion driven
+	// handoffs and the compaction of a collection or the whole cluster
+	FreezeHandoffs(ctx context.Context, in *FreezeHandoffsRequest, opts ...grpc.CallOption) (*FreezeHandoffsResponse, error)
+	UnfreezeHandoffs(ctx context.Context, in *UnfreezeHandoffsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetFreezeWindows(ctx context.Context, in *GetFreezeWindowsRequest, opts ...grpc.CallOption) (*GetFreezeWindowsResponse, error)
+	// GetDeleteSLA returns the sampled delete markers with the time they take to be persisted, checkpointed and compacted away
+	GetDeleteSLA(ctx context.Context, in *GetDeleteSLARequest, opts ...grpc.CallOption) (*GetDeleteSLAResponse, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
+	return &dataCoordClient{cc}
+}
+
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
+	out := new(GetSegmentStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
+	out := new(GetInsertBinlogPathsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
+	out := new(GetPartitionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
+	out := new(GetFlushedSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error) {
+	out := new(GetSegmentsByStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentsByStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+	out := new(internalpb.ShowConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ShowConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
+	out := new(milvuspb.GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
+	out := new(milvuspb.GetCompactionPlansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
+	out := new(WatchChannelsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
+	out := new(milvuspb.GetFlushStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error) {
+	out := new(DropVirtualChannelResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropVirtualChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error) {
+	out := new(SetSegmentStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetSegmentState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error) {
+	out := new(ImportTaskResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PreImport(ctx context.Context, in *PreImportRequest, opts ...grpc.CallOption) (*PreImportResponse, error) {
+	out := new(PreImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PreImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveImportSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnsetIsImportingState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MarkSegmentsDropped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/BroadcastAlteredCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+	out := new(milvuspb.CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error) {
+	out := new(GetIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error) {
+	out := new(GetSegmentIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error) {
+	out := new(GetIndexInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error) {
+	out := new(DescribeIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DescribeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error) {
+	out := new(GetIndexBuildProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexBuildProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RebuildDeprecatedIndexes(ctx context.Context, in *RebuildDeprecatedIndexesRequest, opts ...grpc.CallOption) (*RebuildDeprecatedIndexesResponse, error) {
+	out := new(RebuildDeprecatedIndexesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RebuildDeprecatedIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UpdateChannelCheckpoints(ctx context.Context, in *UpdateChannelCheckpointsRequest, opts ...grpc.CallOption) (*UpdateChannelCheckpointsResponse, error) {
+	out := new(UpdateChannelCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateChannelCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTravelWatermarks(ctx context.Context, in *GetTimeTravelWatermarksRequest, opts ...grpc.CallOption) (*GetTimeTravelWatermarksResponse, error) {
+	out := new(GetTimeTravelWatermarksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTravelWatermarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportSegmentHeats(ctx context.Context, in *ReportSegmentHeatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportSegmentHeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentHistory(ctx context.Context, in *GetSegmentHistoryRequest, opts ...grpc.CallOption) (*GetSegmentHistoryResponse, error) {
+	out := new(GetSegmentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PauseCollectionMaintenance(ctx context.Context, in *PauseCollectionMaintenanceRequest, opts ...grpc.CallOption) (*PauseCollectionMaintenanceResponse, error) {
+	out := new(PauseCollectionMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PauseCollectionMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ResumeCollectionMaintenance(ctx context.Context, in *ResumeCollectionMaintenanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ResumeCollectionMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCollectionMaintenancePauses(ctx context.Context, in *GetCollectionMaintenancePausesRequest, opts ...grpc.CallOption) (*GetCollectionMaintenancePausesResponse, error) {
+	out := new(GetCollectionMaintenancePausesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionMaintenancePauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DecommissionDataNode(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionResponse, error) {
+	out := new(DecommissionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DecommissionDataNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) LocatePrimaryKeys(ctx context.Context, in *LocatePrimaryKeysRequest, opts ...grpc.CallOption) (*LocatePrimaryKeysResponse, error) {
+	out := new(LocatePrimaryKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/LocatePrimaryKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AcquireSegmentLock(ctx context.Context, in *AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AcquireSegmentLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RenewSegmentLock(ctx context.Context, in *RenewSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RenewSegmentLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReleaseSegmentLock(ctx context.Context, in *ReleaseSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReleaseSegmentLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) InspectSegmentLocks(ctx context.Context, in *InspectSegmentLocksRequest, opts ...grpc.CallOption) (*InspectSegmentLocksResponse, error) {
+	out := new(InspectSegmentLocksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/InspectSegmentLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CompactSegments(ctx context.Context, in *CompactSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompactSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetHandoffGate(ctx context.Context, in *GetHandoffGateRequest, opts ...grpc.CallOption) (*GetHandoffGateResponse, error) {
+	out := new(GetHandoffGateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetHandoffGate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentAnomalies(ctx context.Context, in *GetSegmentAnomaliesRequest, opts ...grpc.CallOption) (*GetSegmentAnomaliesResponse, error) {
+	out := new(GetSegmentAnomaliesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentAnomalies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MergeTinySegments(ctx context.Context, in *MergeTinySegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MergeTinySegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentAllocHints(ctx context.Context, in *GetSegmentAllocHintsRequest, opts ...grpc.CallOption) (*GetSegmentAllocHintsResponse, error) {
+	out := new(GetSegmentAllocHintsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentAllocHints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentHeats(ctx context.Context, in *GetSegmentHeatsRequest, opts ...grpc.CallOption) (*GetSegmentHeatsResponse, error) {
+	out := new(GetSegmentHeatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentHeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) FreezeHandoffs(ctx context.Context, in *FreezeHandoffsRequest, opts ...grpc.CallOption) (*FreezeHandoffsResponse, error) {
+	out := new(FreezeHandoffsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/FreezeHandoffs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnfreezeHandoffs(ctx context.Context, in *UnfreezeHandoffsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnfreezeHandoffs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFreezeWindows(ctx context.Context, in *GetFreezeWindowsRequest, opts ...grpc.CallOption) (*GetFreezeWindowsResponse, error) {
+	out := new(GetFreezeWindowsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFreezeWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetDeleteSLA(ctx context.Context, in *GetDeleteSLARequest, opts ...grpc.CallOption) (*GetDeleteSLAResponse, error) {
+	out := new(GetDeleteSLAResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetDeleteSLA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataCoordServer is the server API for DataCoord service.
+type DataCoordServer interface {
+	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
+	AssignSegmentID(context.Context, *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(context.Context, *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(context.Context, *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(context.Context, *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
+	GetSegmentsByStates(context.Context, *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error)
+	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
+	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
+	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
+	// PreImport checks the files of an import against the collection schema and estimates the rows and the segments
+	// the import produces, without scheduling any import task
+	PreImport(context.Context, *PreImportRequest) (*PreImportResponse, error)
+	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
+	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
+	SaveImportSegment(context.Context, *SaveImportSegmentRequest) (*commonpb.Status, error)
+	UnsetIsImportingState(context.Context, *UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
+	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
+	GetSegmentIndexState(context.Context, *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error)
+	GetIndexInfos(context.Context, *GetIndexInfoRequest) (*GetIndexInfoResponse, error)
+	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
+	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
+	RebuildDeprecatedIndexes(context.Context, *RebuildDeprecatedIndexesRequest) (*RebuildDeprecatedIndexesResponse, error)
+	UpdateChannelCheckpoints(context.Context, *UpdateChannelCheckpointsRequest) (*UpdateChannelCheckpointsResponse, error)
+	GetTimeTravelWatermarks(context.Context, *GetTimeTravelWatermarksRequest) (*GetTimeTravelWatermarksResponse, error)
+	ReportSegmentHeats(context.Context, *ReportSegmentHeatsRequest) (*commonpb.Status, error)
+	// GetSegmentHistory returns the state transitions of a segment with their causes
+	GetSegmentHistory(context.Context, *GetSegmentHistoryRequest) (*GetSegmentHistoryResponse, error)
+	// PauseCollectionMaintenance pauses the compaction and/or the binlog gc of a collection until it's resumed
+	PauseCollectionMaintenance(context.Context, *PauseCollectionMaintenanceRequest) (*PauseCollectionMaintenanceResponse, error)
+	ResumeCollectionMaintenance(context.Context, *ResumeCollectionMaintenanceRequest) (*commonpb.Status, error)
+	GetCollectionMaintenancePauses(context.Context, *GetCollectionMaintenancePausesRequest) (*GetCollectionMaintenancePausesResponse, error)
+	DecommissionDataNode(context.Context, *DecommissionRequest) (*DecommissionResponse, error)
+	// LocatePrimaryKeys returns the segments of a collection which may contain the primary keys
+	LocatePrimaryKeys(context.Context, *LocatePrimaryKeysRequest) (*LocatePrimaryKeysResponse, error)
+	// AcquireSegmentLock, RenewSegmentLock and ReleaseSegmentLock acquire, renew and release the lease of the segment
+	// locks held by a task of a node, the locked segments are not recycled by GC until the lease expires
+	AcquireSegmentLock(context.Context, *AcquireSegmentLockRequest) (*commonpb.Status, error)
+	RenewSegmentLock(context.Context, *RenewSegmentLockRequest) (*commonpb.Status, error)
+	ReleaseSegmentLock(context.Context, *ReleaseSegmentLockRequest) (*commonpb.Status, error)
+	// InspectSegmentLocks returns the segment lock leases not expired yet
+	InspectSegmentLocks(context.Context, *InspectSegmentLocksRequest) (*InspectSegmentLocksResponse, error)
+	// CompactSegments triggers a manual compaction of the given segments of a collection only
+	CompactSegments(context.Context, *CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// GetHandoffGate returns the handoff gating states of the flushing and flushed segments
+	GetHandoffGate(context.Context, *GetHandoffGateRequest) (*GetHandoffGateResponse, error)
+	// GetSegmentAnomalies returns the segment reports of the collections with the anomalies detected
+	GetSegmentAnomalies(context.Context, *GetSegmentAnomaliesRequest) (*GetSegmentAnomaliesResponse, error)
+	// MergeTinySegments triggers a compaction of a collection which merges its small segments
+	MergeTinySegments(context.Context, *MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// GetSegmentAllocHints returns the row distribution of the partitions over the vchannels and the allocation hints
+	GetSegmentAllocHints(context.Context, *GetSegmentAllocHintsRequest) (*GetSegmentAllocHintsResponse, error)
+	// GetSegmentHeats returns the query heats of the healthy segments from the hottest, the cold segments are left out
+	GetSegmentHeats(context.Context, *GetSegmentHeatsRequest) (*GetSegmentHeatsResponse, error)
+	// FreezeHandoffs, UnfreezeHandoffs and GetFreezeWindows freeze, unfreeze and list the index completion driven
+	// handoffs and the compaction of a collection or the whole cluster
+	FreezeHandoffs(context.Context, *FreezeHandoffsRequest) (*FreezeHandoffsResponse, error)
+	UnfreezeHandoffs(context.Context, *UnfreezeHandoffsRequest) (*commonpb.Status, error)
+	GetFreezeWindows(context.Context, *GetFreezeWindowsRequest) (*GetFreezeWindowsResponse, error)
+	// GetDeleteSLA returns the sampled delete markers with the time they take to be persisted, checkpointed and compacted away
+	GetDeleteSLA(context.Context, *GetDeleteSLARequest) (*GetDeleteSLAResponse, error)
+}
+
+// UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
+type UnimplementedDataCoordServer struct {
+}
+
+func (*UnimplementedDataCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedDataCoordServer) AssignSegmentID(ctx context.Context, req *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignSegmentID not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentStates(ctx context.Context, req *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetInsertBinlogPaths(ctx context.Context, req *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInsertBinlogPaths not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCollectionStatistics(ctx context.Context, req *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetPartitionStatistics(ctx context.Context, req *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentInfoChannel(ctx context.Context, req *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfoChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) SaveBinlogPaths(ctx context.Context, req *SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBinlogPaths not implemented")
+}
+func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentsByStates(ctx context.Context, req *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsByStates not implemented")
+}
+func (*UnimplementedDataCoordServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
+}
+func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+}
+func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushState not implemented")
+}
+func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *SetSegmentStateRequest) (*SetSegmentStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSegmentState not implemented")
+}
+func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedDataCoordServer) PreImport(ctx context.Context, req *PreImportRequest) (*PreImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreImport not implemented")
+}
+func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) UpdateChannelCheckpoint(ctx context.Context, req *UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelCheckpoint not implemented")
+}
+func (*UnimplementedDataCoordServer) SaveImportSegment(ctx context.Context, req *SaveImportSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveImportSegment not implemented")
+}
+func (*UnimplementedDataCoordServer) UnsetIsImportingState(ctx context.Context, req *UnsetIsImportingStateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetIsImportingState not implemented")
+}
+func (*UnimplementedDataCoordServer) MarkSegmentsDropped(ctx context.Context, req *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
+}
+func (*UnimplementedDataCoordServer) BroadcastAlteredCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
+}
+func (*UnimplementedDataCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (*UnimplementedDataCoordServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexState(ctx context.Context, req *GetIndexStateRequest) (*GetIndexStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentIndexState(ctx context.Context, req *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIndexState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexInfos(ctx context.Context, req *GetIndexInfoRequest) (*GetIndexInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexInfos not implemented")
+}
+func (*UnimplementedDataCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) DescribeIndex(ctx context.Context, req *DescribeIndexRequest) (*DescribeIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexBuildProgress(ctx context.Context, req *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
+}
+func (*UnimplementedDataCoordServer) RebuildDeprecatedIndexes(ctx context.Context, req *RebuildDeprecatedIndexesRequest) (*RebuildDeprecatedIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildDeprecatedIndexes not implemented")
+}
+func (*UnimplementedDataCoordServer) UpdateChannelCheckpoints(ctx context.Context, req *UpdateChannelCheckpointsRequest) (*UpdateChannelCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelCheckpoints not implemented")
+}
+func (*UnimplementedDataCoordServer) GetTimeTravelWatermarks(ctx context.Context, req *GetTimeTravelWatermarksRequest) (*GetTimeTravelWatermarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTravelWatermarks not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportSegmentHeats(ctx context.Context, req *ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSegmentHeats not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentHistory(ctx context.Context, req *GetSegmentHistoryRequest) (*GetSegmentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentHistory not implemented")
+}
+func (*UnimplementedDataCoordServer) PauseCollectionMaintenance(ctx context.Context, req *PauseCollectionMaintenanceRequest) (*PauseCollectionMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCollectionMaintenance not implemented")
+}
+func (*UnimplementedDataCoordServer) ResumeCollectionMaintenance(ctx context.Context, req *ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCollectionMaintenance not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCollectionMaintenancePauses(ctx context.Context, req *GetCollectionMaintenancePausesRequest) (*GetCollectionMaintenancePausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionMaintenancePauses not implemented")
+}
+func (*UnimplementedDataCoordServer) DecommissionDataNode(ctx context.Context, req *DecommissionRequest) (*DecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionDataNode not implemented")
+}
+func (*UnimplementedDataCoordServer) LocatePrimaryKeys(ctx context.Context, req *LocatePrimaryKeysRequest) (*LocatePrimaryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocatePrimaryKeys not implemented")
+}
+func (*UnimplementedDataCoordServer) AcquireSegmentLock(ctx context.Context, req *AcquireSegmentLockRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireSegmentLock not implemented")
+}
+func (*UnimplementedDataCoordServer) RenewSegmentLock(ctx context.Context, req *RenewSegmentLockRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewSegmentLock not implemented")
+}
+func (*UnimplementedDataCoordServer) ReleaseSegmentLock(ctx context.Context, req *ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSegmentLock not implemented")
+}
+func (*UnimplementedDataCoordServer) InspectSegmentLocks(ctx context.Context, req *InspectSegmentLocksRequest) (*InspectSegmentLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectSegmentLocks not implemented")
+}
+func (*UnimplementedDataCoordServer) CompactSegments(ctx context.Context, req *CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) GetHandoffGate(ctx context.Context, req *GetHandoffGateRequest) (*GetHandoffGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandoffGate not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentAnomalies(ctx context.Context, req *GetSegmentAnomaliesRequest) (*GetSegmentAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentAnomalies not implemented")
+}
+func (*UnimplementedDataCoordServer) MergeTinySegments(ctx context.Context, req *MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTinySegments not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentAllocHints(ctx context.Context, req *GetSegmentAllocHintsRequest) (*GetSegmentAllocHintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentAllocHints not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentHeats(ctx context.Context, req *GetSegmentHeatsRequest) (*GetSegmentHeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentHeats not implemented")
+}
+func (*UnimplementedDataCoordServer) FreezeHandoffs(ctx context.Context, req *FreezeHandoffsRequest) (*FreezeHandoffsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeHandoffs not implemented")
+}
+func (*UnimplementedDataCoordServer) UnfreezeHandoffs(ctx context.Context, req *UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeHandoffs not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFreezeWindows(ctx context.Context, req *GetFreezeWindowsRequest) (*GetFreezeWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreezeWindows not implemented")
+}
+func (*UnimplementedDataCoordServer) GetDeleteSLA(ctx context.Context, req *GetDeleteSLARequest) (*GetDeleteSLAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteSLA not implemented")
+}
+
+func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
+	s.RegisterService(&_DataCoord_serviceDesc, srv)
+}
+
+func _DataCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetTimeTickChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetTimeTickChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTickChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetStatisticsChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Flush(ctx, req.(*FlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_AssignSegmentID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignSegmentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).AssignSegmentID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/AssignSegmentID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).AssignSegmentID(ctx, req.(*AssignSegmentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentStates(ctx, req.(*GetSegmentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetInsertBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInsertBinlogPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetInsertBinlogPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, req.(*GetInsertBinlogPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentInfoChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfoChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, req.(*GetSegmentInfoChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_SaveBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBinlogPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).SaveBinlogPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SaveBinlogPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SaveBinlogPaths(ctx, req.(*SaveBinlogPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetRecoveryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlushedSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushedSegments(ctx, req.(*GetFlushedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentsByStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsByStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentsByStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentsByStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentsByStates(ctx, req.(*GetSegmentsByStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ShowConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ShowConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ShowConfigurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+
+func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+
+func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ManualCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) GetIndexState(ctx context.Context, req *GetIndexStateRequest) (*GetIndexStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexState not implemented")
+
+func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) GetSegmentIndexState(ctx context.Context, req *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIndexState not implemented")
+
+func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, req.(*milvuspb.GetCompactionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) GetIndexInfos(ctx context.Context, req *GetIndexInfoRequest) (*GetIndexInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexInfos not implemented")
+
+func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).WatchChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/WatchChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).WatchChannels(ctx, req.(*WatchChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+
+func _DataCoord_GetFlushState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetFlushStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushState(ctx, req.(*milvuspb.GetFlushStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) DescribeIndex(ctx context.Context, req *DescribeIndexRequest) (*DescribeIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeIndex not implemented")
+
+func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropVirtualChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).DropVirtualChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/DropVirtualChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).DropVirtualChannel(ctx, req.(*DropVirtualChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDataCoordServer) GetIndexBuildProgress(ctx context.Context, req *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
+
+func _DataCoord_SetSegmentState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSegmentStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).SetSegmentState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SetSegmentState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SetSegmentState(ctx, req.(*SetSegmentStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
-	s.RegisterService(&_DataCoord_serviceDesc, srv)
+func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Import(ctx, req.(*ImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetComponentStatesRequest)
+func _DataCoord_PreImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetComponentStates(ctx, in)
+		return srv.(DataCoordServer).PreImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetComponentStates",
+		FullMethod: "/milvus.proto.data.DataCoord/PreImport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+		return srv.(DataCoordServer).PreImport(ctx, req.(*PreImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetTimeTickChannelRequest)
+func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetTimeTickChannel(ctx, in)
+		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTickChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/UpdateSegmentStatistics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, req.(*UpdateSegmentStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetStatisticsChannelRequest)
+func _DataCoord_UpdateChannelCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelCheckpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetStatisticsChannel(ctx, in)
+		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetStatisticsChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, req.(*UpdateChannelCheckpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushRequest)
+func _DataCoord_SaveImportSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveImportSegmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).Flush(ctx, in)
+		return srv.(DataCoordServer).SaveImportSegment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/Flush",
+		FullMethod: "/milvus.proto.data.DataCoord/SaveImportSegment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).Flush(ctx, req.(*FlushRequest))
+		return srv.(DataCoordServer).SaveImportSegment(ctx, req.(*SaveImportSegmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_AssignSegmentID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignSegmentIDRequest)
+func _DataCoord_UnsetIsImportingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsetIsImportingStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).AssignSegmentID(ctx, in)
+		return srv.(DataCoordServer).UnsetIsImportingState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/AssignSegmentID",
+		FullMethod: "/milvus.proto.data.DataCoord/UnsetIsImportingState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).AssignSegmentID(ctx, req.(*AssignSegmentIDRequest))
+		return srv.(DataCoordServer).UnsetIsImportingState(ctx, req.(*UnsetIsImportingStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentInfoRequest)
+func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSegmentsDroppedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentInfo(ctx, in)
+		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfo",
+		FullMethod: "/milvus.proto.data.DataCoord/MarkSegmentsDropped",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, req.(*MarkSegmentsDroppedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentStatesRequest)
+func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentStates(ctx, in)
+		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentStates",
+		FullMethod: "/milvus.proto.data.DataCoord/BroadcastAlteredCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentStates(ctx, req.(*GetSegmentStatesRequest))
+		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, req.(*AlterCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetInsertBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInsertBinlogPathsRequest)
+func _DataCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CheckHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, in)
+		return srv.(DataCoordServer).CheckHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetInsertBinlogPaths",
+		FullMethod: "/milvus.proto.data.DataCoord/CheckHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, req.(*GetInsertBinlogPathsRequest))
+		return srv.(DataCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _DataCoord_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCollectionStatistics(ctx, in)
+		return srv.(DataCoordServer).CreateIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/CreateIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(DataCoordServer).CreateIndex(ctx, req.(*CreateIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _DataCoord_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetPartitionStatistics(ctx, in)
+		return srv.(DataCoordServer).GetIndexState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(DataCoordServer).GetIndexState(ctx, req.(*GetIndexStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentInfoChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentInfoChannelRequest)
+func _DataCoord_GetSegmentIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentIndexStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, in)
+		return srv.(DataCoordServer).GetSegmentIndexState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfoChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentIndexState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, req.(*GetSegmentInfoChannelRequest))
+		return srv.(DataCoordServer).GetSegmentIndexState(ctx, req.(*GetSegmentIndexStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SaveBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveBinlogPathsRequest)
+func _DataCoord_GetIndexInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SaveBinlogPaths(ctx, in)
+		return srv.(DataCoordServer).GetIndexInfos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SaveBinlogPaths",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexInfos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SaveBinlogPaths(ctx, req.(*SaveBinlogPathsRequest))
+		return srv.(DataCoordServer).GetIndexInfos(ctx, req.(*GetIndexInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecoveryInfoRequest)
+func _DataCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetRecoveryInfo(ctx, in)
+		return srv.(DataCoordServer).DropIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfo",
+		FullMethod: "/milvus.proto.data.DataCoord/DropIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+		return srv.(DataCoordServer).DropIndex(ctx, req.(*DropIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFlushedSegmentsRequest)
+func _DataCoord_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetFlushedSegments(ctx, in)
+		return srv.(DataCoordServer).DescribeIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegments",
+		FullMethod: "/milvus.proto.data.DataCoord/DescribeIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetFlushedSegments(ctx, req.(*GetFlushedSegmentsRequest))
+		return srv.(DataCoordServer).DescribeIndex(ctx, req.(*DescribeIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentsByStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentsByStatesRequest)
+func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexBuildProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentsByStates(ctx, in)
+		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentsByStates",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexBuildProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentsByStates(ctx, req.(*GetSegmentsByStatesRequest))
+		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, req.(*GetIndexBuildProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.ShowConfigurationsRequest)
+func _DataCoord_RebuildDeprecatedIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildDeprecatedIndexesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).ShowConfigurations(ctx, in)
+		return srv.(DataCoordServer).RebuildDeprecatedIndexes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/ShowConfigurations",
+		FullMethod: "/milvus.proto.data.DataCoord/RebuildDeprecatedIndexes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+		return srv.(DataCoordServer).RebuildDeprecatedIndexes(ctx, req.(*RebuildDeprecatedIndexesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetMetricsRequest)
+func _DataCoord_UpdateChannelCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelCheckpointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetMetrics(ctx, in)
+		return srv.(DataCoordServer).UpdateChannelCheckpoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetMetrics",
+		FullMethod: "/milvus.proto.data.DataCoord/UpdateChannelCheckpoints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+		return srv.(DataCoordServer).UpdateChannelCheckpoints(ctx, req.(*UpdateChannelCheckpointsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ManualCompactionRequest)
+func _DataCoord_GetTimeTravelWatermarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeTravelWatermarksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+		return srv.(DataCoordServer).GetTimeTravelWatermarks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTravelWatermarks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+		return srv.(DataCoordServer).GetTimeTravelWatermarks(ctx, req.(*GetTimeTravelWatermarksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetCompactionStateRequest)
+func _DataCoord_ReportSegmentHeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSegmentHeatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+		return srv.(DataCoordServer).ReportSegmentHeats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+		FullMethod: "/milvus.proto.data.DataCoord/ReportSegmentHeats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+		return srv.(DataCoordServer).ReportSegmentHeats(ctx, req.(*ReportSegmentHeatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetCompactionPlansRequest)
+func _DataCoord_GetSegmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, in)
+		return srv.(DataCoordServer).GetSegmentHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, req.(*milvuspb.GetCompactionPlansRequest))
+		return srv.(DataCoordServer).GetSegmentHistory(ctx, req.(*GetSegmentHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WatchChannelsRequest)
+func _DataCoord_PauseCollectionMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCollectionMaintenanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).WatchChannels(ctx, in)
+		return srv.(DataCoordServer).PauseCollectionMaintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/WatchChannels",
+		FullMethod: "/milvus.proto.data.DataCoord/PauseCollectionMaintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).WatchChannels(ctx, req.(*WatchChannelsRequest))
+		return srv.(DataCoordServer).PauseCollectionMaintenance(ctx, req.(*PauseCollectionMaintenanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetFlushState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetFlushStateRequest)
+func _DataCoord_ResumeCollectionMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCollectionMaintenanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetFlushState(ctx, in)
+		return srv.(DataCoordServer).ResumeCollectionMaintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetFlushState",
+		FullMethod: "/milvus.proto.data.DataCoord/ResumeCollectionMaintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetFlushState(ctx, req.(*milvuspb.GetFlushStateRequest))
+		return srv.(DataCoordServer).ResumeCollectionMaintenance(ctx, req.(*ResumeCollectionMaintenanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropVirtualChannelRequest)
+func _DataCoord_GetCollectionMaintenancePauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionMaintenancePausesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).DropVirtualChannel(ctx, in)
+		return srv.(DataCoordServer).GetCollectionMaintenancePauses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/DropVirtualChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionMaintenancePauses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).DropVirtualChannel(ctx, req.(*DropVirtualChannelRequest))
+		return srv.(DataCoordServer).GetCollectionMaintenancePauses(ctx, req.(*GetCollectionMaintenancePausesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SetSegmentState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetSegmentStateRequest)
+func _DataCoord_DecommissionDataNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SetSegmentState(ctx, in)
+		return srv.(DataCoordServer).DecommissionDataNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SetSegmentState",
+		FullMethod: "/milvus.proto.data.DataCoord/DecommissionDataNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SetSegmentState(ctx, req.(*SetSegmentStateRequest))
+		return srv.(DataCoordServer).DecommissionDataNode(ctx, req.(*DecommissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportTaskRequest)
+func _DataCoord_LocatePrimaryKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocatePrimaryKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).Import(ctx, in)
+		return srv.(DataCoordServer).LocatePrimaryKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/Import",
+		FullMethod: "/milvus.proto.data.DataCoord/LocatePrimaryKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).Import(ctx, req.(*ImportTaskRequest))
+		return srv.(DataCoordServer).LocatePrimaryKeys(ctx, req.(*LocatePrimaryKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSegmentStatisticsRequest)
+func _DataCoord_AcquireSegmentLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireSegmentLockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, in)
+		return srv.(DataCoordServer).AcquireSegmentLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/UpdateSegmentStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/AcquireSegmentLock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, req.(*UpdateSegmentStatisticsRequest))
+		return srv.(DataCoordServer).AcquireSegmentLock(ctx, req.(*AcquireSegmentLockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_UpdateChannelCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateChannelCheckpointRequest)
+func _DataCoord_RenewSegmentLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSegmentLockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, in)
+		return srv.(DataCoordServer).RenewSegmentLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint",
+		FullMethod: "/milvus.proto.data.DataCoord/RenewSegmentLock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, req.(*UpdateChannelCheckpointRequest))
+		return srv.(DataCoordServer).RenewSegmentLock(ctx, req.(*RenewSegmentLockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SaveImportSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveImportSegmentRequest)
+func _DataCoord_ReleaseSegmentLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSegmentLockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SaveImportSegment(ctx, in)
+		return srv.(DataCoordServer).ReleaseSegmentLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SaveImportSegment",
+		FullMethod: "/milvus.proto.data.DataCoord/ReleaseSegmentLock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SaveImportSegment(ctx, req.(*SaveImportSegmentRequest))
+		return srv.(DataCoordServer).ReleaseSegmentLock(ctx, req.(*ReleaseSegmentLockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_UnsetIsImportingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsetIsImportingStateRequest)
+func _DataCoord_InspectSegmentLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectSegmentLocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).UnsetIsImportingState(ctx, in)
+		return srv.(DataCoordServer).InspectSegmentLocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/UnsetIsImportingState",
+		FullMethod: "/milvus.proto.data.DataCoord/InspectSegmentLocks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).UnsetIsImportingState(ctx, req.(*UnsetIsImportingStateRequest))
+		return srv.(DataCoordServer).InspectSegmentLocks(ctx, req.(*InspectSegmentLocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkSegmentsDroppedRequest)
+func _DataCoord_CompactSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactSegmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, in)
+		return srv.(DataCoordServer).CompactSegments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/MarkSegmentsDropped",
+		FullMethod: "/milvus.proto.data.DataCoord/CompactSegments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, req.(*MarkSegmentsDroppedRequest))
+		return srv.(DataCoordServer).CompactSegments(ctx, req.(*CompactSegmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlterCollectionRequest)
+func _DataCoord_GetHandoffGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandoffGateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, in)
+		return srv.(DataCoordServer).GetHandoffGate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/BroadcastAlteredCollection",
+		FullMethod: "/milvus.proto.data.DataCoord/GetHandoffGate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, req.(*AlterCollectionRequest))
+		return srv.(DataCoordServer).GetHandoffGate(ctx, req.(*GetHandoffGateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CheckHealthRequest)
+func _DataCoord_GetSegmentAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentAnomaliesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).CheckHealth(ctx, in)
+		return srv.(DataCoordServer).GetSegmentAnomalies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/CheckHealth",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentAnomalies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
+		return srv.(DataCoordServer).GetSegmentAnomalies(ctx, req.(*GetSegmentAnomaliesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIndexRequest)
+func _DataCoord_MergeTinySegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTinySegmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).CreateIndex(ctx, in)
+		return srv.(DataCoordServer).MergeTinySegments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/CreateIndex",
+		FullMethod: "/milvus.proto.data.DataCoord/MergeTinySegments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+		return srv.(DataCoordServer).MergeTinySegments(ctx, req.(*MergeTinySegmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexStateRequest)
+func _DataCoord_GetSegmentAllocHints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentAllocHintsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexState(ctx, in)
+		return srv.(DataCoordServer).GetSegmentAllocHints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexState",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentAllocHints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexState(ctx, req.(*GetIndexStateRequest))
+		return srv.(DataCoordServer).GetSegmentAllocHints(ctx, req.(*GetSegmentAllocHintsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentIndexStateRequest)
+func _DataCoord_GetSegmentHeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentHeatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentIndexState(ctx, in)
+		return srv.(DataCoordServer).GetSegmentHeats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentIndexState",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentHeats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentIndexState(ctx, req.(*GetSegmentIndexStateRequest))
+		return srv.(DataCoordServer).GetSegmentHeats(ctx, req.(*GetSegmentHeatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexInfoRequest)
+func _DataCoord_FreezeHandoffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeHandoffsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexInfos(ctx, in)
+		return srv.(DataCoordServer).FreezeHandoffs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexInfos",
+		FullMethod: "/milvus.proto.data.DataCoord/FreezeHandoffs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexInfos(ctx, req.(*GetIndexInfoRequest))
+		return srv.(DataCoordServer).FreezeHandoffs(ctx, req.(*FreezeHandoffsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropIndexRequest)
+func _DataCoord_UnfreezeHandoffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeHandoffsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).DropIndex(ctx, in)
+		return srv.(DataCoordServer).UnfreezeHandoffs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/DropIndex",
+		FullMethod: "/milvus.proto.data.DataCoord/UnfreezeHandoffs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).DropIndex(ctx, req.(*DropIndexRequest))
+		return srv.(DataCoordServer).UnfreezeHandoffs(ctx, req.(*UnfreezeHandoffsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeIndexRequest)
+func _DataCoord_GetFreezeWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreezeWindowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).DescribeIndex(ctx, in)
+		return srv.(DataCoordServer).GetFreezeWindows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/DescribeIndex",
+		FullMethod: "/milvus.proto.data.DataCoord/GetFreezeWindows",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).DescribeIndex(ctx, req.(*DescribeIndexRequest))
+		return srv.(DataCoordServer).GetFreezeWindows(ctx, req.(*GetFreezeWindowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexBuildProgressRequest)
+func _DataCoord_GetDeleteSLA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeleteSLARequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, in)
+		return srv.(DataCoordServer).GetDeleteSLA(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexBuildProgress",
+		FullMethod: "/milvus.proto.data.DataCoord/GetDeleteSLA",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, req.(*GetIndexBuildProgressRequest))
+		return srv.(DataCoordServer).GetDeleteSLA(ctx, req.(*GetDeleteSLARequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7974,6 +12571,10 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataCoord_Import_Handler,
 		},
+		{
+			MethodName: "PreImport",
+			Handler:    _DataCoord_PreImport_Handler,
+		},
 		{
 			MethodName: "UpdateSegmentStatistics",
 			Handler:    _DataCoord_UpdateSegmentStatistics_Handler,
@@ -8030,6 +12631,102 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetIndexBuildProgress",
 			Handler:    _DataCoord_GetIndexBuildProgress_Handler,
 		},
+		{
+			MethodName: "RebuildDeprecatedIndexes",
+			Handler:    _DataCoord_RebuildDeprecatedIndexes_Handler,
+		},
+		{
+			MethodName: "UpdateChannelCheckpoints",
+			Handler:    _DataCoord_UpdateChannelCheckpoints_Handler,
+		},
+		{
+			MethodName: "GetTimeTravelWatermarks",
+			Handler:    _DataCoord_GetTimeTravelWatermarks_Handler,
+		},
+		{
+			MethodName: "ReportSegmentHeats",
+			Handler:    _DataCoord_ReportSegmentHeats_Handler,
+		},
+		{
+			MethodName: "GetSegmentHistory",
+			Handler:    _DataCoord_GetSegmentHistory_Handler,
+		},
+		{
+			MethodName: "PauseCollectionMaintenance",
+			Handler:    _DataCoord_PauseCollectionMaintenance_Handler,
+		},
+		{
+			MethodName: "ResumeCollectionMaintenance",
+			Handler:    _DataCoord_ResumeCollectionMaintenance_Handler,
+		},
+		{
+			MethodName: "GetCollectionMaintenancePauses",
+			Handler:    _DataCoord_GetCollectionMaintenancePauses_Handler,
+		},
+		{
+			MethodName: "DecommissionDataNode",
+			Handler:    _DataCoord_DecommissionDataNode_Handler,
+		},
+		{
+			MethodName: "LocatePrimaryKeys",
+			Handler:    _DataCoord_LocatePrimaryKeys_Handler,
+		},
+		{
+			MethodName: "AcquireSegmentLock",
+			Handler:    _DataCoord_AcquireSegmentLock_Handler,
+		},
+		{
+			MethodName: "RenewSegmentLock",
+			Handler:    _DataCoord_RenewSegmentLock_Handler,
+		},
+		{
+			MethodName: "ReleaseSegmentLock",
+			Handler:    _DataCoord_ReleaseSegmentLock_Handler,
+		},
+		{
+			MethodName: "InspectSegmentLocks",
+			Handler:    _DataCoord_InspectSegmentLocks_Handler,
+		},
+		{
+			MethodName: "CompactSegments",
+			Handler:    _DataCoord_CompactSegments_Handler,
+		},
+		{
+			MethodName: "GetHandoffGate",
+			Handler:    _DataCoord_GetHandoffGate_Handler,
+		},
+		{
+			MethodName: "GetSegmentAnomalies",
+			Handler:    _DataCoord_GetSegmentAnomalies_Handler,
+		},
+		{
+			MethodName: "MergeTinySegments",
+			Handler:    _DataCoord_MergeTinySegments_Handler,
+		},
+		{
+			MethodName: "GetSegmentAllocHints",
+			Handler:    _DataCoord_GetSegmentAllocHints_Handler,
+		},
+		{
+			MethodName: "GetSegmentHeats",
+			Handler:    _DataCoord_GetSegmentHeats_Handler,
+		},
+		{
+			MethodName: "FreezeHandoffs",
+			Handler:    _DataCoord_FreezeHandoffs_Handler,
+		},
+		{
+			MethodName: "UnfreezeHandoffs",
+			Handler:    _DataCoord_UnfreezeHandoffs_Handler,
+		},
+		{
+			MethodName: "GetFreezeWindows",
+			Handler:    _DataCoord_GetFreezeWindows_Handler,
+		},
+		{
+			MethodName: "GetDeleteSLA",
+			Handler:    _DataCoord_GetDeleteSLA_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -8053,6 +12750,7 @@ type DataNodeClient interface {
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
+	Decommission(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionResponse, error)
 }
 
 type dataNodeClient struct {
@@ -8171,6 +12869,15 @@ func (c *dataNodeClient) AddImportSegment(ctx context.Context, in *AddImportSegm
 	return out, nil
 }
 
+func (c *dataNodeClient) Decommission(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionResponse, error) {
+	out := new(DecommissionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Decommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -8187,6 +12894,7 @@ type DataNodeServer interface {
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
+	Decommission(context.Context, *DecommissionRequest) (*DecommissionResponse, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -8229,6 +12937,9 @@ func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req
 func (*UnimplementedDataNodeServer) AddImportSegment(ctx context.Context, req *AddImportSegmentRequest) (*AddImportSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddImportSegment not implemented")
 }
+func (*UnimplementedDataNodeServer) Decommission(ctx context.Context, req *DecommissionRequest) (*DecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decommission not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -8450,6 +13161,24 @@ func _DataNode_AddImportSegment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Decommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Decommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Decommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Decommission(ctx, req.(*DecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -8502,6 +13231,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddImportSegment",
 			Handler:    _DataNode_AddImportSegment_Handler,
 		},
+		{
+			MethodName: "Decommission",
+			Handler:    _DataNode_Decommission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
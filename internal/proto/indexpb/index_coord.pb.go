@@ -27,6 +27,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type GCScope int32
+
+const (
+	// GCAll runs the passes of all the other scopes
+	GCScope_GCAll     GCScope = 0
+	GCScope_GCIndexes GCScope = 1
+	GCScope_GCMeta    GCScope = 2
+	GCScope_GCFiles   GCScope = 3
+)
+
+var GCScope_name = map[int32]string{
+	0: "GCAll",
+	1: "GCIndexes",
+	2: "GCMeta",
+	3: "GCFiles",
+}
+
+var GCScope_value = map[string]int32{
+	"GCAll":     0,
+	"GCIndexes": 1,
+	"GCMeta":    2,
+	"GCFiles":   3,
+}
+
+func (x GCScope) String() string {
+	return proto.EnumName(GCScope_name, int32(x))
+}
+
+func (GCScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{0}
+}
+
 type IndexInfo struct {
 	CollectionID int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	FieldID      int64                    `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -1624,14 +1656,16 @@ func (m *QueryJobsRequest) GetBuildIDs() []int64 {
 }
 
 type IndexTaskInfo struct {
-	BuildID              int64               `protobuf:"varint,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	IndexFileKeys        []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
-	SerializedSize       uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
-	FailReason           string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	BuildID        int64               `protobuf:"varint,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	State          commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	IndexFileKeys  []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	SerializedSize uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	FailReason     string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	// the knowhere version of the IndexNode building the index
+	EngineVersion        string   `protobuf:"bytes,6,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexTaskInfo) Reset()         { *m = IndexTaskInfo{} }
@@ -1694,6 +1728,13 @@ func (m *IndexTaskInfo) GetFailReason() string {
 	return ""
 }
 
+func (m *IndexTaskInfo) GetEngineVersion() string {
+	if m != nil {
+		return m.EngineVersion
+	}
+	return ""
+}
+
 type QueryJobsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	ClusterID            string           `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
@@ -1993,367 +2034,1961 @@ func (m *GetJobStatsResponse) GetEnableDisk() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
-	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
-	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.index.SegmentIndex")
-	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
-	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
-	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.index.GetIndexStateRequest")
-	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.index.GetIndexStateResponse")
-	proto.RegisterType((*GetSegmentIndexStateRequest)(nil), "milvus.proto.index.GetSegmentIndexStateRequest")
-	proto.RegisterType((*SegmentIndexState)(nil), "milvus.proto.index.SegmentIndexState")
-	proto.RegisterType((*GetSegmentIndexStateResponse)(nil), "milvus.proto.index.GetSegmentIndexStateResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.index.CreateIndexRequest")
-	proto.RegisterType((*GetIndexInfoRequest)(nil), "milvus.proto.index.GetIndexInfoRequest")
-	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.index.SegmentInfo")
-	proto.RegisterType((*GetIndexInfoResponse)(nil), "milvus.proto.index.GetIndexInfoResponse")
-	proto.RegisterMapType((map[int64]*SegmentInfo)(nil), "milvus.proto.index.GetIndexInfoResponse.SegmentInfoEntry")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
-	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.index.DescribeIndexRequest")
-	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.index.DescribeIndexResponse")
-	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.index.GetIndexBuildProgressRequest")
-	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.index.GetIndexBuildProgressResponse")
-	proto.RegisterType((*StorageConfig)(nil), "milvus.proto.index.StorageConfig")
-	proto.RegisterType((*CreateJobRequest)(nil), "milvus.proto.index.CreateJobRequest")
-	proto.RegisterType((*QueryJobsRequest)(nil), "milvus.proto.index.QueryJobsRequest")
-	proto.RegisterType((*IndexTaskInfo)(nil), "milvus.proto.index.IndexTaskInfo")
-	proto.RegisterType((*QueryJobsResponse)(nil), "milvus.proto.index.QueryJobsResponse")
-	proto.RegisterType((*DropJobsRequest)(nil), "milvus.proto.index.DropJobsRequest")
-	proto.RegisterType((*JobInfo)(nil), "milvus.proto.index.JobInfo")
-	proto.RegisterType((*GetJobStatsRequest)(nil), "milvus.proto.index.GetJobStatsRequest")
-	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
+type CheckIndexConsistencyRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the collection to check the indexes of, 0 means all the collections and the orphan index files
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// rebuilds the segment indexes missing files or with drifted row counts, and removes the orphan index files
+	Repair               bool     `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
+func (m *CheckIndexConsistencyRequest) Reset()         { *m = CheckIndexConsistencyRequest{} }
+func (m *CheckIndexConsistencyRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckIndexConsistencyRequest) ProtoMessage()    {}
+func (*CheckIndexConsistencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{29}
+}
 
-var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8e, 0xdb, 0xc8,
-	0xf1, 0x37, 0x45, 0xcd, 0x8c, 0x58, 0x94, 0xe6, 0xa3, 0xed, 0xfd, 0xff, 0x65, 0xd9, 0x8e, 0xc7,
-	0xf4, 0xda, 0x56, 0x02, 0xec, 0xd8, 0x99, 0xcd, 0x06, 0x9b, 0x20, 0x09, 0x30, 0x9e, 0x59, 0xdb,
-	0xb2, 0xd7, 0xc6, 0x84, 0x32, 0x16, 0xc8, 0x22, 0x80, 0x42, 0x89, 0xad, 0x99, 0xde, 0xa1, 0xd8,
-	0x32, 0xbb, 0x69, 0x7b, 0x1c, 0x20, 0xc8, 0x65, 0x0f, 0x59, 0x2c, 0x10, 0x20, 0x08, 0x92, 0x17,
-	0xc8, 0x69, 0x73, 0xc8, 0x3d, 0x97, 0xbc, 0x40, 0x4e, 0x79, 0x84, 0xbc, 0x44, 0xae, 0x41, 0x7f,
-	0x90, 0x22, 0x29, 0x6a, 0xa4, 0xf9, 0xc8, 0x25, 0xb9, 0xa9, 0x8b, 0xd5, 0x5f, 0x55, 0xbf, 0xaa,
-	0xfa, 0x55, 0x0b, 0x36, 0x48, 0xe8, 0xe3, 0xb7, 0xbd, 0x01, 0xa5, 0x91, 0xbf, 0x35, 0x8e, 0x28,
-	0xa7, 0x08, 0x8d, 0x48, 0xf0, 0x3a, 0x66, 0x6a, 0xb4, 0x25, 0xbf, 0xb7, 0xea, 0x03, 0x3a, 0x1a,
-	0xd1, 0x50, 0xc9, 0x5a, 0xab, 0x24, 0xe4, 0x38, 0x0a, 0xbd, 0x40, 0x8f, 0xeb, 0xd9, 0x19, 0xce,
-	0x5f, 0xaa, 0x60, 0x75, 0xc4, 0xac, 0x4e, 0x38, 0xa4, 0xc8, 0x81, 0xfa, 0x80, 0x06, 0x01, 0x1e,
-	0x70, 0x42, 0xc3, 0xce, 0x5e, 0xd3, 0xd8, 0x34, 0xda, 0xa6, 0x9b, 0x93, 0xa1, 0x26, 0xac, 0x0c,
-	0x09, 0x0e, 0xfc, 0xce, 0x5e, 0xb3, 0x22, 0x3f, 0x27, 0x43, 0x74, 0x03, 0x40, 0x1d, 0x30, 0xf4,
-	0x46, 0xb8, 0x69, 0x6e, 0x1a, 0x6d, 0xcb, 0xb5, 0xa4, 0xe4, 0x85, 0x37, 0xc2, 0x62, 0xa2, 0x1c,
-	0x74, 0xf6, 0x9a, 0x55, 0x35, 0x51, 0x0f, 0xd1, 0x43, 0xb0, 0xf9, 0xf1, 0x18, 0xf7, 0xc6, 0x5e,
-	0xe4, 0x8d, 0x58, 0x73, 0x69, 0xd3, 0x6c, 0xdb, 0xdb, 0xb7, 0xb6, 0x72, 0x57, 0xd3, 0x77, 0x7a,
-	0x86, 0x8f, 0x3f, 0xf3, 0x82, 0x18, 0xef, 0x7b, 0x24, 0x72, 0x41, 0xcc, 0xda, 0x97, 0x93, 0xd0,
-	0x1e, 0xd4, 0xd5, 0xe6, 0x7a, 0x91, 0xe5, 0x45, 0x17, 0xb1, 0xe5, 0x34, 0xbd, 0xca, 0x2d, 0xbd,
-	0x0a, 0xf6, 0x7b, 0x11, 0x7d, 0xc3, 0x9a, 0x2b, 0xf2, 0xa0, 0xb6, 0x96, 0xb9, 0xf4, 0x0d, 0x13,
-	0xb7, 0xe4, 0x94, 0x7b, 0x81, 0x52, 0xa8, 0x49, 0x05, 0x4b, 0x4a, 0xe4, 0xe7, 0x8f, 0x60, 0x89,
-	0x71, 0x8f, 0xe3, 0xa6, 0xb5, 0x69, 0xb4, 0x57, 0xb7, 0x6f, 0x96, 0x1e, 0x40, 0x5a, 0xbc, 0x2b,
-	0xd4, 0x5c, 0xa5, 0x8d, 0x3e, 0x82, 0xff, 0x57, 0xc7, 0x97, 0xc3, 0xde, 0xd0, 0x23, 0x41, 0x2f,
-	0xc2, 0x1e, 0xa3, 0x61, 0x13, 0xa4, 0x21, 0xaf, 0x90, 0x74, 0xce, 0x23, 0x8f, 0x04, 0xae, 0xfc,
-	0x86, 0x1c, 0x68, 0x10, 0xd6, 0xf3, 0x62, 0x4e, 0x7b, 0xf2, 0x7b, 0xd3, 0xde, 0x34, 0xda, 0x35,
-	0xd7, 0x26, 0x6c, 0x27, 0xe6, 0x54, 0x6e, 0x83, 0x9e, 0xc3, 0x46, 0xcc, 0x70, 0xd4, 0xcb, 0x99,
-	0xa7, 0xbe, 0xa8, 0x79, 0xd6, 0xc4, 0xdc, 0xce, 0xc4, 0x44, 0xce, 0x97, 0x06, 0xc0, 0x23, 0xe9,
-	0x71, 0xb9, 0xfa, 0x8f, 0x12, 0xa7, 0x93, 0x70, 0x48, 0x25, 0x60, 0xec, 0xed, 0x1b, 0x5b, 0xd3,
-	0xa8, 0xdc, 0x4a, 0x51, 0xa6, 0x31, 0x21, 0x01, 0xd7, 0x84, 0x15, 0x1f, 0x07, 0x98, 0x63, 0x5f,
-	0x82, 0xa9, 0xe6, 0x26, 0x43, 0x74, 0x13, 0xec, 0x41, 0x84, 0x85, 0x2d, 0x38, 0xd1, 0x68, 0xaa,
-	0xba, 0xa0, 0x44, 0x2f, 0xc9, 0x08, 0x3b, 0x5f, 0x56, 0xa1, 0xde, 0xc5, 0x07, 0x23, 0x1c, 0x72,
-	0x75, 0x92, 0x45, 0xc0, 0xbb, 0x09, 0xf6, 0xd8, 0x8b, 0x38, 0xd1, 0x2a, 0x0a, 0xc0, 0x59, 0x11,
-	0xba, 0x0e, 0x16, 0xd3, 0xab, 0xee, 0xc9, 0x5d, 0x4d, 0x77, 0x22, 0x40, 0x57, 0xa1, 0x16, 0xc6,
-	0x23, 0xe5, 0x7a, 0x0d, 0xe2, 0x30, 0x1e, 0x49, 0xc7, 0x67, 0xe0, 0xbd, 0x94, 0x87, 0x77, 0x13,
-	0x56, 0xfa, 0x31, 0x91, 0x11, 0xb3, 0xac, 0xbe, 0xe8, 0x21, 0xfa, 0x3f, 0x58, 0x0e, 0xa9, 0x8f,
-	0x3b, 0x7b, 0x1a, 0x68, 0x7a, 0x84, 0x6e, 0x43, 0x43, 0x19, 0xf5, 0x35, 0x8e, 0x18, 0xa1, 0xa1,
-	0x86, 0x99, 0xc2, 0xe6, 0x67, 0x4a, 0x76, 0x56, 0xa4, 0xdd, 0x04, 0x7b, 0x1a, 0x5d, 0x30, 0x9c,
-	0x60, 0xea, 0x2e, 0xac, 0xa9, 0xcd, 0x87, 0x24, 0xc0, 0xbd, 0x23, 0x7c, 0xcc, 0x9a, 0xf6, 0xa6,
-	0xd9, 0xb6, 0x5c, 0x75, 0xa6, 0x47, 0x24, 0xc0, 0xcf, 0xf0, 0x31, 0xcb, 0xfa, 0xae, 0x7e, 0xa2,
-	0xef, 0x1a, 0x45, 0xdf, 0xa1, 0x3b, 0xb0, 0xca, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc3, 0x3d, 0x46,
-	0xde, 0xe1, 0xe6, 0xaa, 0xd4, 0x69, 0xa4, 0xd2, 0x2e, 0x79, 0x87, 0x85, 0x19, 0xde, 0x44, 0x84,
-	0xe3, 0xde, 0xa1, 0x17, 0xfa, 0x74, 0x38, 0x6c, 0xae, 0xc9, 0x7d, 0xea, 0x52, 0xf8, 0x44, 0xc9,
-	0x9c, 0x3f, 0x1a, 0x70, 0xd9, 0xc5, 0x07, 0x84, 0x71, 0x1c, 0xbd, 0xa0, 0x3e, 0x76, 0xf1, 0xab,
-	0x18, 0x33, 0x8e, 0x1e, 0x40, 0xb5, 0xef, 0x31, 0xac, 0x21, 0x79, 0xbd, 0xd4, 0x3a, 0xcf, 0xd9,
-	0xc1, 0x43, 0x8f, 0x61, 0x57, 0x6a, 0xa2, 0xef, 0xc3, 0x8a, 0xe7, 0xfb, 0x11, 0x66, 0x4c, 0x02,
-	0x63, 0xd6, 0xa4, 0x1d, 0xa5, 0xe3, 0x26, 0xca, 0x19, 0x2f, 0x9a, 0x59, 0x2f, 0x3a, 0xbf, 0x35,
-	0xe0, 0x4a, 0xfe, 0x64, 0x6c, 0x4c, 0x43, 0x86, 0xd1, 0x87, 0xb0, 0x2c, 0x7c, 0x11, 0x33, 0x7d,
-	0xb8, 0x6b, 0xa5, 0xfb, 0x74, 0xa5, 0x8a, 0xab, 0x55, 0x45, 0x92, 0x24, 0x21, 0xe1, 0x49, 0x00,
-	0xab, 0x13, 0xde, 0x2a, 0x46, 0x9a, 0x4e, 0xf5, 0x9d, 0x90, 0x70, 0x15, 0xaf, 0x2e, 0x90, 0xf4,
-	0xb7, 0xf3, 0x33, 0xb8, 0xf2, 0x18, 0xf3, 0x0c, 0x26, 0xb4, 0xad, 0x16, 0x09, 0x9d, 0x7c, 0x76,
-	0xaf, 0x14, 0xb2, 0xbb, 0xf3, 0x27, 0x03, 0xde, 0x2b, 0xac, 0x7d, 0x9e, 0xdb, 0xa6, 0xe0, 0xae,
-	0x9c, 0x07, 0xdc, 0x66, 0x11, 0xdc, 0xce, 0xaf, 0x0d, 0xb8, 0xf6, 0x18, 0xf3, 0x6c, 0xe2, 0xb8,
-	0x60, 0x4b, 0xa0, 0x6f, 0x01, 0xa4, 0x09, 0x83, 0x35, 0xcd, 0x4d, 0xb3, 0x6d, 0xba, 0x19, 0x89,
-	0xf3, 0x1b, 0x03, 0x36, 0xa6, 0xf6, 0xcf, 0xe7, 0x1d, 0xa3, 0x98, 0x77, 0xfe, 0x53, 0xe6, 0xf8,
-	0x9d, 0x01, 0xd7, 0xcb, 0xcd, 0x71, 0x1e, 0xe7, 0xfd, 0x58, 0x4d, 0xc2, 0x02, 0xa5, 0xa2, 0xcc,
-	0xdc, 0x29, 0xab, 0x07, 0xd3, 0x7b, 0xea, 0x49, 0xce, 0xd7, 0x26, 0xa0, 0x5d, 0x99, 0x2c, 0xe4,
-	0xc7, 0xd3, 0xb8, 0xe6, 0xcc, 0xe4, 0xa4, 0x40, 0x41, 0xaa, 0x17, 0x41, 0x41, 0x96, 0xce, 0x44,
-	0x41, 0xae, 0x83, 0x25, 0xb2, 0x26, 0xe3, 0xde, 0x68, 0x2c, 0xeb, 0x45, 0xd5, 0x9d, 0x08, 0xa6,
-	0x0b, 0xfe, 0xca, 0x82, 0x05, 0xbf, 0x76, 0xe6, 0x82, 0xff, 0x16, 0x2e, 0x27, 0x81, 0x2d, 0xcb,
-	0xf7, 0x29, 0xdc, 0x91, 0x0f, 0x85, 0x4a, 0x31, 0x14, 0xe6, 0x38, 0xc5, 0xf9, 0x57, 0x05, 0x36,
-	0x3a, 0x49, 0xcd, 0xd9, 0xf7, 0xf8, 0xa1, 0xe4, 0x0c, 0x27, 0x47, 0xca, 0x6c, 0x04, 0x64, 0x0a,
-	0xb4, 0x39, 0xb3, 0x40, 0x57, 0xf3, 0x05, 0x3a, 0x7f, 0xc0, 0xa5, 0x22, 0x6a, 0x2e, 0x86, 0x74,
-	0xb6, 0x61, 0x3d, 0x53, 0x70, 0xc7, 0x1e, 0x3f, 0x14, 0xc4, 0x53, 0x54, 0xdc, 0x55, 0x92, 0xbd,
-	0x3d, 0x43, 0xf7, 0x60, 0x2d, 0xad, 0x90, 0xbe, 0x2a, 0x9c, 0x35, 0x89, 0x90, 0x49, 0x39, 0xf5,
-	0x93, 0xca, 0x99, 0x27, 0x10, 0x56, 0x09, 0x81, 0xc8, 0x92, 0x19, 0xc8, 0x91, 0x19, 0xe7, 0xaf,
-	0x06, 0xd8, 0x69, 0x80, 0x2e, 0xd8, 0x18, 0xe4, 0xfc, 0x52, 0x29, 0xfa, 0xe5, 0x16, 0xd4, 0x71,
-	0xe8, 0xf5, 0x03, 0xac, 0x71, 0x6b, 0x2a, 0xdc, 0x2a, 0x99, 0xc2, 0xed, 0x23, 0xb0, 0x27, 0x54,
-	0x32, 0x89, 0xc1, 0x3b, 0x33, 0xb9, 0x64, 0x16, 0x14, 0x2e, 0xa4, 0x9c, 0x92, 0x39, 0x5f, 0x55,
-	0x26, 0x65, 0x4e, 0x21, 0xf6, 0x3c, 0xc9, 0xec, 0xe7, 0x50, 0xd7, 0xb7, 0x50, 0x14, 0x57, 0xa5,
-	0xb4, 0x1f, 0x94, 0x1d, 0xab, 0x6c, 0xd3, 0xad, 0x8c, 0x19, 0x3f, 0x09, 0x79, 0x74, 0xec, 0xda,
-	0x6c, 0x22, 0x69, 0xf5, 0x60, 0xbd, 0xa8, 0x80, 0xd6, 0xc1, 0x3c, 0xc2, 0xc7, 0xda, 0xc6, 0xe2,
-	0xa7, 0x48, 0xff, 0xaf, 0x05, 0x76, 0x74, 0xd5, 0xbf, 0x79, 0x62, 0x3e, 0x1d, 0x52, 0x57, 0x69,
-	0xff, 0xb0, 0xf2, 0xb1, 0xe1, 0xfc, 0xde, 0x80, 0xf5, 0xbd, 0x88, 0x8e, 0x4f, 0x9d, 0x4a, 0x1d,
-	0xa8, 0x67, 0x78, 0x71, 0x12, 0xbd, 0x39, 0xd9, 0xbc, 0xa4, 0x7a, 0x15, 0x6a, 0x7e, 0x44, 0xc7,
-	0x3d, 0x2f, 0x08, 0x64, 0x60, 0x09, 0x8a, 0x18, 0xd1, 0xf1, 0x4e, 0x10, 0x08, 0x26, 0xb2, 0x87,
-	0xd9, 0x20, 0x22, 0xfd, 0xd3, 0x27, 0xf9, 0x39, 0x4c, 0xe4, 0x6b, 0x03, 0xde, 0x2b, 0xac, 0x7d,
-	0x1e, 0xff, 0xff, 0x24, 0x8f, 0x4a, 0xe5, 0xfe, 0x39, 0x1d, 0x4e, 0x16, 0x8d, 0x9e, 0xac, 0xb0,
-	0xf2, 0xdb, 0x43, 0x91, 0x55, 0xf6, 0x23, 0x7a, 0x20, 0xf9, 0xe3, 0xc5, 0xdd, 0xf8, 0x0f, 0x06,
-	0xdc, 0x98, 0xb1, 0xc7, 0x79, 0x6e, 0x5e, 0x6c, 0x86, 0x2b, 0xf3, 0x9a, 0x61, 0xb3, 0xd0, 0x0c,
-	0x3b, 0x7f, 0xae, 0x40, 0xa3, 0xcb, 0x69, 0xe4, 0x1d, 0xe0, 0x5d, 0x1a, 0x0e, 0xc9, 0x81, 0x48,
-	0xb5, 0x09, 0xc7, 0x36, 0xe4, 0x35, 0x52, 0x16, 0x7d, 0x0b, 0xea, 0xde, 0x60, 0x80, 0x19, 0x13,
-	0x2d, 0x87, 0xce, 0x20, 0x96, 0x6b, 0x2b, 0xd9, 0x33, 0x21, 0x42, 0xdf, 0x81, 0x0d, 0x86, 0x07,
-	0x11, 0xe6, 0xbd, 0x89, 0xa6, 0x46, 0xdd, 0x9a, 0xfa, 0xb0, 0x93, 0x68, 0x0b, 0x52, 0x1e, 0x33,
-	0xdc, 0xed, 0x7e, 0xaa, 0x91, 0xa7, 0x47, 0x82, 0x12, 0xf5, 0xe3, 0xc1, 0x11, 0xe6, 0xd9, 0x94,
-	0x0e, 0x4a, 0x24, 0x41, 0x7b, 0x0d, 0xac, 0x88, 0x52, 0x2e, 0xf3, 0xb0, 0xac, 0xbf, 0x96, 0x5b,
-	0x13, 0x02, 0x91, 0x6a, 0xf4, 0xaa, 0x9d, 0x9d, 0xe7, 0xba, 0xee, 0xea, 0x91, 0xe8, 0x2b, 0x3b,
-	0x3b, 0xcf, 0x3f, 0x09, 0xfd, 0x31, 0x25, 0x21, 0x97, 0x49, 0xd9, 0x72, 0xb3, 0x22, 0x71, 0x3d,
-	0xa6, 0x2c, 0xd1, 0x13, 0x94, 0x41, 0x26, 0x64, 0xcb, 0xb5, 0xb5, 0xec, 0xe5, 0xf1, 0x18, 0x3b,
-	0xff, 0x34, 0x61, 0x5d, 0xf1, 0x9e, 0xa7, 0xb4, 0x9f, 0xc0, 0xe3, 0x3a, 0x58, 0x83, 0x20, 0x16,
-	0x2d, 0x84, 0xc6, 0x86, 0xe5, 0x4e, 0x04, 0xc2, 0x22, 0xd9, 0xd2, 0x11, 0xe1, 0x21, 0x79, 0xab,
-	0x2d, 0xb7, 0x36, 0xa9, 0x1d, 0x52, 0x9c, 0xad, 0x72, 0xe6, 0x54, 0x95, 0xf3, 0x3d, 0xee, 0xe9,
-	0xd2, 0x53, 0x95, 0xa5, 0xc7, 0x12, 0x12, 0x55, 0x75, 0xa6, 0x8a, 0xc9, 0x52, 0x49, 0x31, 0xc9,
-	0x54, 0xd7, 0xe5, 0x7c, 0x75, 0xcd, 0x83, 0x77, 0xa5, 0x98, 0x24, 0x9e, 0xc0, 0x6a, 0x62, 0x98,
-	0x81, 0xc4, 0x88, 0xb4, 0x5e, 0x49, 0x6b, 0x23, 0x93, 0x5c, 0x16, 0x4c, 0x6e, 0x83, 0xe5, 0xb0,
-	0x55, 0xac, 0xc6, 0xd6, 0x99, 0xaa, 0x71, 0x81, 0x09, 0xc2, 0x59, 0x98, 0x60, 0xb6, 0xb2, 0xda,
-	0xf9, 0xca, 0xfa, 0x29, 0xac, 0xff, 0x34, 0xc6, 0xd1, 0xf1, 0x53, 0xda, 0x67, 0x8b, 0xf9, 0xb8,
-	0x05, 0x35, 0xed, 0xa8, 0x24, 0x09, 0xa7, 0x63, 0xe7, 0x1f, 0x06, 0x34, 0x64, 0xd8, 0xbf, 0xf4,
-	0xd8, 0x51, 0xf2, 0xa2, 0x92, 0x78, 0xd9, 0xc8, 0x7b, 0xf9, 0x8c, 0x3d, 0x44, 0xc9, 0x73, 0x80,
-	0x59, 0xf6, 0x1c, 0x50, 0xc2, 0x4d, 0xaa, 0xa5, 0xdc, 0xa4, 0xd0, 0x94, 0x2c, 0x4d, 0x35, 0x25,
-	0xdf, 0x18, 0xb0, 0x91, 0xb1, 0xd1, 0x79, 0x52, 0x58, 0xce, 0xb2, 0x95, 0xa2, 0x65, 0x1f, 0xe6,
-	0x53, 0xbb, 0x59, 0xe6, 0xea, 0x4c, 0x6a, 0x4f, 0x6c, 0x9c, 0x4b, 0xef, 0xcf, 0x60, 0x4d, 0x94,
-	0xd7, 0x8b, 0x71, 0xe7, 0xdf, 0x0d, 0x58, 0x79, 0x4a, 0xfb, 0xd2, 0x91, 0x59, 0x0c, 0x19, 0xf9,
-	0xa7, 0xa6, 0x75, 0x30, 0x7d, 0x32, 0xd2, 0xf9, 0x58, 0xfc, 0x14, 0x31, 0xc6, 0xb8, 0x17, 0xf1,
-	0xc9, 0x63, 0x99, 0x20, 0x5f, 0x42, 0x22, 0xdf, 0x5b, 0xae, 0x42, 0x0d, 0x87, 0xbe, 0xfa, 0xa8,
-	0x19, 0x2e, 0x0e, 0x7d, 0xf9, 0xe9, 0x62, 0x9a, 0x96, 0x2b, 0xb0, 0x34, 0xa6, 0x93, 0x07, 0x2e,
-	0x35, 0x70, 0xae, 0x00, 0x7a, 0x8c, 0xf9, 0x53, 0xda, 0x17, 0x5e, 0x49, 0xcc, 0xe3, 0xfc, 0xad,
-	0x22, 0x1b, 0x8a, 0x89, 0xf8, 0x3c, 0x0e, 0x76, 0xa0, 0xa1, 0x0a, 0xd0, 0x17, 0xb4, 0xdf, 0x0b,
-	0xe3, 0xc4, 0x28, 0xb6, 0x14, 0x3e, 0xa5, 0xfd, 0x17, 0xf1, 0x08, 0x7d, 0x00, 0x97, 0x49, 0xd8,
-	0x1b, 0xeb, 0x9a, 0x98, 0x6a, 0x2a, 0x2b, 0xad, 0x93, 0x30, 0xa9, 0x96, 0x5a, 0xfd, 0x2e, 0xac,
-	0xe1, 0xf0, 0x55, 0x8c, 0x63, 0x9c, 0xaa, 0x2a, 0x9b, 0x35, 0xb4, 0x58, 0xeb, 0x89, 0xda, 0xe7,
-	0xb1, 0xa3, 0x1e, 0x0b, 0x28, 0x67, 0x3a, 0x27, 0x5a, 0x42, 0xd2, 0x15, 0x02, 0xf4, 0x31, 0x58,
-	0x62, 0xba, 0x82, 0x96, 0x6a, 0x0c, 0xae, 0x95, 0x41, 0x4b, 0xfb, 0xdb, 0xad, 0x7d, 0xa1, 0x7e,
-	0x30, 0x11, 0x20, 0x9a, 0x2a, 0xfb, 0x84, 0x1d, 0xe9, 0x4a, 0x03, 0x4a, 0xb4, 0x47, 0xd8, 0xd1,
-	0xf6, 0x57, 0x00, 0x20, 0x11, 0xb9, 0x4b, 0x69, 0xe4, 0xa3, 0x40, 0x9a, 0x79, 0x97, 0x8e, 0xc6,
-	0x34, 0xc4, 0x21, 0x97, 0xd1, 0xcb, 0xd0, 0x56, 0x7e, 0x33, 0x3d, 0x98, 0x56, 0xd4, 0x6e, 0x69,
-	0xbd, 0x5f, 0xaa, 0x5f, 0x50, 0x76, 0x2e, 0xa1, 0x57, 0x92, 0x5c, 0x8b, 0x21, 0x61, 0x9c, 0x0c,
-	0xd8, 0xee, 0xa1, 0x17, 0x86, 0x38, 0x40, 0xdb, 0x33, 0x9e, 0xa2, 0xca, 0x94, 0x93, 0x3d, 0x6f,
-	0x97, 0xee, 0xd9, 0xe5, 0x11, 0x09, 0x0f, 0x12, 0x5c, 0x38, 0x97, 0xd0, 0x4b, 0xb0, 0x33, 0xef,
-	0x01, 0xe8, 0x6e, 0x99, 0x19, 0xa7, 0x1f, 0x0c, 0x5a, 0x27, 0x01, 0xc8, 0xb9, 0x84, 0x86, 0xd0,
-	0xc8, 0x3d, 0x58, 0xa1, 0xf6, 0x49, 0x9c, 0x3e, 0xfb, 0x4a, 0xd4, 0xfa, 0xf6, 0x02, 0x9a, 0xe9,
-	0xe9, 0x7f, 0xa9, 0x0c, 0x36, 0xf5, 0xe2, 0x73, 0x7f, 0xc6, 0x22, 0xb3, 0xde, 0xa6, 0x5a, 0x0f,
-	0x16, 0x9f, 0x90, 0x6e, 0xee, 0x4f, 0x2e, 0xa9, 0xc0, 0x75, 0x6f, 0x7e, 0xe3, 0xa2, 0x76, 0x6b,
-	0x2f, 0xda, 0xe1, 0x38, 0x97, 0xd0, 0x3e, 0x58, 0x69, 0x8f, 0x81, 0xde, 0x2f, 0x9b, 0x58, 0x6c,
-	0x41, 0x16, 0x70, 0x4e, 0x8e, 0xc3, 0x97, 0x3b, 0xa7, 0xac, 0x85, 0x28, 0x77, 0x4e, 0x69, 0x43,
-	0xe0, 0x5c, 0x42, 0xbf, 0x9a, 0xbc, 0x5a, 0xe6, 0x98, 0x33, 0x7a, 0x70, 0xd2, 0xf5, 0xcb, 0x88,
-	0x7c, 0xeb, 0xbb, 0xa7, 0x98, 0x91, 0x01, 0x07, 0xea, 0x1e, 0xd2, 0x37, 0x8a, 0xc1, 0xc4, 0x91,
-	0x27, 0x08, 0x7f, 0xc9, 0xe6, 0x3a, 0x96, 0xa6, 0x55, 0x67, 0x6e, 0x7e, 0xc2, 0x8c, 0x74, 0xf3,
-	0x1e, 0xc0, 0x63, 0xcc, 0x9f, 0x63, 0x1e, 0x91, 0x01, 0x2b, 0x86, 0xd5, 0x24, 0x61, 0x68, 0x85,
-	0x64, 0xab, 0x7b, 0x73, 0xf5, 0xd2, 0x0d, 0xfa, 0x60, 0xef, 0x1e, 0xe2, 0xc1, 0xd1, 0x13, 0xec,
-	0x05, 0xfc, 0x10, 0x95, 0xcf, 0xcc, 0x68, 0xcc, 0xc0, 0x5e, 0x99, 0x62, 0xb2, 0xc7, 0xf6, 0x37,
-	0xcb, 0xfa, 0x1f, 0xcc, 0x17, 0xd4, 0xc7, 0xff, 0xfd, 0xb9, 0x70, 0x1f, 0xac, 0xb4, 0x47, 0x28,
-	0x0f, 0xb5, 0x62, 0x0b, 0x31, 0x2f, 0xd4, 0x3e, 0x07, 0x2b, 0x65, 0x5b, 0xe5, 0x2b, 0x16, 0x09,
-	0x6b, 0xeb, 0xce, 0x1c, 0xad, 0xf4, 0xb4, 0x2f, 0xa0, 0x96, 0xb0, 0x23, 0x74, 0x7b, 0x56, 0x5e,
-	0xc8, 0xae, 0x3c, 0xe7, 0xac, 0xbf, 0x00, 0x3b, 0x43, 0x1d, 0xca, 0x2b, 0xc1, 0x34, 0xe5, 0x68,
-	0xdd, 0x9b, 0xab, 0xf7, 0xbf, 0x11, 0x90, 0x0f, 0xbf, 0xf7, 0xf9, 0xf6, 0x01, 0xe1, 0x87, 0x71,
-	0x5f, 0x58, 0xf6, 0xbe, 0xd2, 0xfc, 0x80, 0x50, 0xfd, 0xeb, 0x7e, 0x72, 0xca, 0xfb, 0x72, 0xa5,
-	0xfb, 0xd2, 0x4e, 0xe3, 0x7e, 0x7f, 0x59, 0x0e, 0x3f, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xde, 0x9f, 0x9a, 0x80, 0x20, 0x00, 0x00,
+func (m *CheckIndexConsistencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckIndexConsistencyRequest.Unmarshal(m, b)
+}
+func (m *CheckIndexConsistencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckIndexConsistencyRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckIndexConsistencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckIndexConsistencyRequest.Merge(m, src)
+}
+func (m *CheckIndexConsistencyRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckIndexConsistencyRequest.Size(m)
+}
+func (m *CheckIndexConsistencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckIndexConsistencyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_CheckIndexConsistencyRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CheckIndexConsistencyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
 
-// IndexCoordClient is the client API for IndexCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type IndexCoordClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
-	GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error)
-	GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
-	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+func (m *CheckIndexConsistencyRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
 
-type indexCoordClient struct {
-	cc *grpc.ClientConn
+func (m *CheckIndexConsistencyRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
 }
 
-func NewIndexCoordClient(cc *grpc.ClientConn) IndexCoordClient {
-	return &indexCoordClient{cc}
+// IndexInconsistency is a mismatch between the index meta of IndexCoord, the segments of DataCoord and the index files
+type IndexInconsistency struct {
+	// meta_without_files, files_without_meta or row_count_drift
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID            int64    `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	BuildID              int64    `protobuf:"varint,4,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	Detail               string   `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	Repaired             bool     `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *indexCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
-	out := new(milvuspb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *IndexInconsistency) Reset()         { *m = IndexInconsistency{} }
+func (m *IndexInconsistency) String() string { return proto.CompactTextString(m) }
+func (*IndexInconsistency) ProtoMessage()    {}
+func (*IndexInconsistency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{30}
 }
 
-func (c *indexCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *IndexInconsistency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexInconsistency.Unmarshal(m, b)
+}
+func (m *IndexInconsistency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexInconsistency.Marshal(b, m, deterministic)
+}
+func (m *IndexInconsistency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexInconsistency.Merge(m, src)
+}
+func (m *IndexInconsistency) XXX_Size() int {
+	return xxx_messageInfo_IndexInconsistency.Size(m)
+}
+func (m *IndexInconsistency) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexInconsistency.DiscardUnknown(m)
 }
 
-func (c *indexCoordClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CreateIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IndexInconsistency proto.InternalMessageInfo
+
+func (m *IndexInconsistency) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ""
 }
 
-func (c *indexCoordClient) GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error) {
-	out := new(GetIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IndexInconsistency) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *indexCoordClient) GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error) {
-	out := new(GetSegmentIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetSegmentIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IndexInconsistency) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *indexCoordClient) GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error) {
-	out := new(GetIndexInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IndexInconsistency) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *indexCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DropIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IndexInconsistency) GetDetail() string {
+	if m != nil {
+		return m.Detail
 	}
-	return out, nil
+	return ""
 }
 
-func (c *indexCoordClient) DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error) {
-	out := new(DescribeIndexResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DescribeIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IndexInconsistency) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
 	}
-	return out, nil
+	return false
 }
 
-func (c *indexCoordClient) GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error) {
-	out := new(GetIndexBuildProgressResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexBuildProgress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CheckIndexConsistencyResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Inconsistencies      []*IndexInconsistency `protobuf:"bytes,2,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (c *indexCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
-	out := new(internalpb.ShowConfigurationsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ShowConfigurations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CheckIndexConsistencyResponse) Reset()         { *m = CheckIndexConsistencyResponse{} }
+func (m *CheckIndexConsistencyResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckIndexConsistencyResponse) ProtoMessage()    {}
+func (*CheckIndexConsistencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{31}
 }
 
-func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckIndexConsistencyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckIndexConsistencyResponse.Unmarshal(m, b)
+}
+func (m *CheckIndexConsistencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckIndexConsistencyResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckIndexConsistencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckIndexConsistencyResponse.Merge(m, src)
+}
+func (m *CheckIndexConsistencyResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckIndexConsistencyResponse.Size(m)
+}
+func (m *CheckIndexConsistencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckIndexConsistencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckIndexConsistencyResponse proto.InternalMessageInfo
+
+func (m *CheckIndexConsistencyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *indexCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
-	out := new(milvuspb.CheckHealthResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CheckHealth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckIndexConsistencyResponse) GetInconsistencies() []*IndexInconsistency {
+	if m != nil {
+		return m.Inconsistencies
 	}
-	return out, nil
+	return nil
 }
 
-// IndexCoordServer is the server API for IndexCoord service.
-type IndexCoordServer interface {
-	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
-	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
-	GetSegmentIndexState(context.Context, *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error)
-	GetIndexInfos(context.Context, *GetIndexInfoRequest) (*GetIndexInfoResponse, error)
-	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
-	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
-	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
-	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+type GetIndexStatisticsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 means all the collections
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// 0 means all the indexes of the collections
+	IndexID int64 `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	// the days reported, indexCoord.statistics.retentionDays if 0
+	Days                 int32    `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedIndexCoordServer can be embedded to have forward compatible implementations.
-type UnimplementedIndexCoordServer struct {
+func (m *GetIndexStatisticsRequest) Reset()         { *m = GetIndexStatisticsRequest{} }
+func (m *GetIndexStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStatisticsRequest) ProtoMessage()    {}
+func (*GetIndexStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{32}
 }
 
-func (*UnimplementedIndexCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+func (m *GetIndexStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Unmarshal(m, b)
 }
-func (*UnimplementedIndexCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+func (m *GetIndexStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedIndexCoordServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+func (m *GetIndexStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStatisticsRequest.Merge(m, src)
 }
-func (*UnimplementedIndexCoordServer) GetIndexState(ctx context.Context, req *GetIndexStateRequest) (*GetIndexStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexState not implemented")
+func (m *GetIndexStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStatisticsRequest.Size(m)
 }
-func (*UnimplementedIndexCoordServer) GetSegmentIndexState(ctx context.Context, req *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIndexState not implemented")
+func (m *GetIndexStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStatisticsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedIndexCoordServer) GetIndexInfos(ctx context.Context, req *GetIndexInfoRequest) (*GetIndexInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexInfos not implemented")
+
+var xxx_messageInfo_GetIndexStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetIndexStatisticsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetIndexStatisticsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetIndexStatisticsRequest) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *GetIndexStatisticsRequest) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+// DailyIndexStatistics is the builds of an index finished in a day (UTC)
+type DailyIndexStatistics struct {
+	// the date in the format of 2006-01-02
+	Date                 string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Completed            int64    `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed               int64    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	FailureRate          float64  `protobuf:"fixed64,4,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	AvgBuildLatencyMs    float64  `protobuf:"fixed64,5,opt,name=avg_build_latency_ms,json=avgBuildLatencyMs,proto3" json:"avg_build_latency_ms,omitempty"`
+	IndexBytes           uint64   `protobuf:"varint,6,opt,name=index_bytes,json=indexBytes,proto3" json:"index_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DailyIndexStatistics) Reset()         { *m = DailyIndexStatistics{} }
+func (m *DailyIndexStatistics) String() string { return proto.CompactTextString(m) }
+func (*DailyIndexStatistics) ProtoMessage()    {}
+func (*DailyIndexStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{33}
+}
+
+func (m *DailyIndexStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DailyIndexStatistics.Unmarshal(m, b)
+}
+func (m *DailyIndexStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DailyIndexStatistics.Marshal(b, m, deterministic)
+}
+func (m *DailyIndexStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyIndexStatistics.Merge(m, src)
+}
+func (m *DailyIndexStatistics) XXX_Size() int {
+	return xxx_messageInfo_DailyIndexStatistics.Size(m)
+}
+func (m *DailyIndexStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyIndexStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyIndexStatistics proto.InternalMessageInfo
+
+func (m *DailyIndexStatistics) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *DailyIndexStatistics) GetCompleted() int64 {
+	if m != nil {
+		return m.Completed
+	}
+	return 0
+}
+
+func (m *DailyIndexStatistics) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *DailyIndexStatistics) GetFailureRate() float64 {
+	if m != nil {
+		return m.FailureRate
+	}
+	return 0
+}
+
+func (m *DailyIndexStatistics) GetAvgBuildLatencyMs() float64 {
+	if m != nil {
+		return m.AvgBuildLatencyMs
+	}
+	return 0
+}
+
+func (m *DailyIndexStatistics) GetIndexBytes() uint64 {
+	if m != nil {
+		return m.IndexBytes
+	}
+	return 0
+}
+
+// IndexStatistics is the build statistics of an index over the days requested
+type IndexStatistics struct {
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IndexID      int64  `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexName    string `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// the size of the index files of the segment indexes currently finished
+	TotalIndexBytes      uint64                  `protobuf:"varint,4,opt,name=total_index_bytes,json=totalIndexBytes,proto3" json:"total_index_bytes,omitempty"`
+	Completed            int64                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed               int64                   `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	FailureRate          float64                 `protobuf:"fixed64,7,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	AvgBuildLatencyMs    float64                 `protobuf:"fixed64,8,opt,name=avg_build_latency_ms,json=avgBuildLatencyMs,proto3" json:"avg_build_latency_ms,omitempty"`
+	Daily                []*DailyIndexStatistics `protobuf:"bytes,9,rep,name=daily,proto3" json:"daily,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *IndexStatistics) Reset()         { *m = IndexStatistics{} }
+func (m *IndexStatistics) String() string { return proto.CompactTextString(m) }
+func (*IndexStatistics) ProtoMessage()    {}
+func (*IndexStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{34}
+}
+
+func (m *IndexStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexStatistics.Unmarshal(m, b)
+}
+func (m *IndexStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexStatistics.Marshal(b, m, deterministic)
+}
+func (m *IndexStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexStatistics.Merge(m, src)
+}
+func (m *IndexStatistics) XXX_Size() int {
+	return xxx_messageInfo_IndexStatistics.Size(m)
+}
+func (m *IndexStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexStatistics proto.InternalMessageInfo
+
+func (m *IndexStatistics) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexStatistics) GetTotalIndexBytes() uint64 {
+	if m != nil {
+		return m.TotalIndexBytes
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetCompleted() int64 {
+	if m != nil {
+		return m.Completed
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetFailureRate() float64 {
+	if m != nil {
+		return m.FailureRate
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetAvgBuildLatencyMs() float64 {
+	if m != nil {
+		return m.AvgBuildLatencyMs
+	}
+	return 0
+}
+
+func (m *IndexStatistics) GetDaily() []*DailyIndexStatistics {
+	if m != nil {
+		return m.Daily
+	}
+	return nil
+}
+
+type GetIndexStatisticsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the statistics sorted by index id
+	Statistics           []*IndexStatistics `protobuf:"bytes,2,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetIndexStatisticsResponse) Reset()         { *m = GetIndexStatisticsResponse{} }
+func (m *GetIndexStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStatisticsResponse) ProtoMessage()    {}
+func (*GetIndexStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{35}
+}
+
+func (m *GetIndexStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetIndexStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIndexStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStatisticsResponse.Merge(m, src)
+}
+func (m *GetIndexStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStatisticsResponse.Size(m)
+}
+func (m *GetIndexStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetIndexStatisticsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetIndexStatisticsResponse) GetStatistics() []*IndexStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
+type CordonIndexNodeRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// true cordons the IndexNode, false uncordons it
+	Cordon               bool     `protobuf:"varint,3,opt,name=cordon,proto3" json:"cordon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CordonIndexNodeRequest) Reset()         { *m = CordonIndexNodeRequest{} }
+func (m *CordonIndexNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*CordonIndexNodeRequest) ProtoMessage()    {}
+func (*CordonIndexNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{36}
+}
+
+func (m *CordonIndexNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonIndexNodeRequest.Unmarshal(m, b)
+}
+func (m *CordonIndexNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonIndexNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *CordonIndexNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonIndexNodeRequest.Merge(m, src)
+}
+func (m *CordonIndexNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_CordonIndexNodeRequest.Size(m)
+}
+func (m *CordonIndexNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonIndexNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonIndexNodeRequest proto.InternalMessageInfo
+
+func (m *CordonIndexNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CordonIndexNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *CordonIndexNodeRequest) GetCordon() bool {
+	if m != nil {
+		return m.Cordon
+	}
+	return false
+}
+
+// IndexNodeState is the maintenance state of an IndexNode
+type IndexNodeState struct {
+	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// a cordoned IndexNode receives no new builds, the builds assigned already run to the end
+	Cordoned             bool     `protobuf:"varint,2,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	Stopping             bool     `protobuf:"varint,3,opt,name=stopping,proto3" json:"stopping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexNodeState) Reset()         { *m = IndexNodeState{} }
+func (m *IndexNodeState) String() string { return proto.CompactTextString(m) }
+func (*IndexNodeState) ProtoMessage()    {}
+func (*IndexNodeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{37}
+}
+
+func (m *IndexNodeState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexNodeState.Unmarshal(m, b)
+}
+func (m *IndexNodeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexNodeState.Marshal(b, m, deterministic)
+}
+func (m *IndexNodeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexNodeState.Merge(m, src)
+}
+func (m *IndexNodeState) XXX_Size() int {
+	return xxx_messageInfo_IndexNodeState.Size(m)
+}
+func (m *IndexNodeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexNodeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexNodeState proto.InternalMessageInfo
+
+func (m *IndexNodeState) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *IndexNodeState) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
+func (m *IndexNodeState) GetStopping() bool {
+	if m != nil {
+		return m.Stopping
+	}
+	return false
+}
+
+type CordonIndexNodeResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the states of the online IndexNodes after the request
+	States               []*IndexNodeState `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CordonIndexNodeResponse) Reset()         { *m = CordonIndexNodeResponse{} }
+func (m *CordonIndexNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*CordonIndexNodeResponse) ProtoMessage()    {}
+func (*CordonIndexNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{38}
+}
+
+func (m *CordonIndexNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonIndexNodeResponse.Unmarshal(m, b)
+}
+func (m *CordonIndexNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonIndexNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *CordonIndexNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonIndexNodeResponse.Merge(m, src)
+}
+func (m *CordonIndexNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_CordonIndexNodeResponse.Size(m)
+}
+func (m *CordonIndexNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonIndexNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonIndexNodeResponse proto.InternalMessageInfo
+
+func (m *CordonIndexNodeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CordonIndexNodeResponse) GetStates() []*IndexNodeState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type TriggerGCRequest struct {
+	Base  *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Scope GCScope           `protobuf:"varint,2,opt,name=scope,proto3,enum=milvus.proto.index.GCScope" json:"scope,omitempty"`
+	// nothing is removed in a dry run, the candidates are reported only
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerGCRequest) Reset()         { *m = TriggerGCRequest{} }
+func (m *TriggerGCRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerGCRequest) ProtoMessage()    {}
+func (*TriggerGCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{39}
+}
+
+func (m *TriggerGCRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerGCRequest.Unmarshal(m, b)
+}
+func (m *TriggerGCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerGCRequest.Marshal(b, m, deterministic)
+}
+func (m *TriggerGCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerGCRequest.Merge(m, src)
+}
+func (m *TriggerGCRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerGCRequest.Size(m)
+}
+func (m *TriggerGCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerGCRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerGCRequest proto.InternalMessageInfo
+
+func (m *TriggerGCRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TriggerGCRequest) GetScope() GCScope {
+	if m != nil {
+		return m.Scope
+	}
+	return GCScope_GCAll
+}
+
+func (m *TriggerGCRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// GCRun is the summary of a gc pass of a scope
+type GCRun struct {
+	Scope     string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Trigger   string `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	DryRun    bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	ElapsedMs int64  `protobuf:"varint,5,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	Scanned   int64  `protobuf:"varint,6,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Recycled  int64  `protobuf:"varint,7,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	Failed    int64  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error     string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// the first candidates of the pass, at most 100 are kept
+	Candidates           []string `protobuf:"bytes,10,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCRun) Reset()         { *m = GCRun{} }
+func (m *GCRun) String() string { return proto.CompactTextString(m) }
+func (*GCRun) ProtoMessage()    {}
+func (*GCRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{40}
+}
+
+func (m *GCRun) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCRun.Unmarshal(m, b)
+}
+func (m *GCRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCRun.Marshal(b, m, deterministic)
+}
+func (m *GCRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCRun.Merge(m, src)
+}
+func (m *GCRun) XXX_Size() int {
+	return xxx_messageInfo_GCRun.Size(m)
+}
+func (m *GCRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCRun proto.InternalMessageInfo
+
+func (m *GCRun) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *GCRun) GetTrigger() string {
+	if m != nil {
+		return m.Trigger
+	}
+	return ""
+}
+
+func (m *GCRun) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *GCRun) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GCRun) GetElapsedMs() int64 {
+	if m != nil {
+		return m.ElapsedMs
+	}
+	return 0
+}
+
+func (m *GCRun) GetScanned() int64 {
+	if m != nil {
+		return m.Scanned
+	}
+	return 0
+}
+
+func (m *GCRun) GetRecycled() int64 {
+	if m != nil {
+		return m.Recycled
+	}
+	return 0
+}
+
+func (m *GCRun) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *GCRun) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *GCRun) GetCandidates() []string {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type TriggerGCResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Runs                 []*GCRun         `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TriggerGCResponse) Reset()         { *m = TriggerGCResponse{} }
+func (m *TriggerGCResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerGCResponse) ProtoMessage()    {}
+func (*TriggerGCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{41}
+}
+
+func (m *TriggerGCResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerGCResponse.Unmarshal(m, b)
+}
+func (m *TriggerGCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerGCResponse.Marshal(b, m, deterministic)
+}
+func (m *TriggerGCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerGCResponse.Merge(m, src)
+}
+func (m *TriggerGCResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerGCResponse.Size(m)
+}
+func (m *TriggerGCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerGCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerGCResponse proto.InternalMessageInfo
+
+func (m *TriggerGCResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *TriggerGCResponse) GetRuns() []*GCRun {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
+}
+
+// IndexTTLState is the ttl state of an index
+type IndexTTLState struct {
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IndexID      int64  `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexName    string `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	TtlDays      int64  `protobuf:"varint,4,opt,name=ttl_days,json=ttlDays,proto3" json:"ttl_days,omitempty"`
+	// last_used_at is in milliseconds
+	LastUsedAt int64   `protobuf:"varint,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	IdleDays   float64 `protobuf:"fixed64,6,opt,name=idle_days,json=idleDays,proto3" json:"idle_days,omitempty"`
+	// one of active, candidate and dropped
+	State string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	// candidate_since is in milliseconds, 0 if the index is not a drop candidate
+	CandidateSince       int64    `protobuf:"varint,8,opt,name=candidate_since,json=candidateSince,proto3" json:"candidate_since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexTTLState) Reset()         { *m = IndexTTLState{} }
+func (m *IndexTTLState) String() string { return proto.CompactTextString(m) }
+func (*IndexTTLState) ProtoMessage()    {}
+func (*IndexTTLState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{42}
+}
+
+func (m *IndexTTLState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexTTLState.Unmarshal(m, b)
+}
+func (m *IndexTTLState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexTTLState.Marshal(b, m, deterministic)
+}
+func (m *IndexTTLState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexTTLState.Merge(m, src)
+}
+func (m *IndexTTLState) XXX_Size() int {
+	return xxx_messageInfo_IndexTTLState.Size(m)
+}
+func (m *IndexTTLState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexTTLState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexTTLState proto.InternalMessageInfo
+
+func (m *IndexTTLState) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *IndexTTLState) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *IndexTTLState) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexTTLState) GetTtlDays() int64 {
+	if m != nil {
+		return m.TtlDays
+	}
+	return 0
+}
+
+func (m *IndexTTLState) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+func (m *IndexTTLState) GetIdleDays() float64 {
+	if m != nil {
+		return m.IdleDays
+	}
+	return 0
+}
+
+func (m *IndexTTLState) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *IndexTTLState) GetCandidateSince() int64 {
+	if m != nil {
+		return m.CandidateSince
+	}
+	return 0
+}
+
+type ListIndexTTLsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 for all the collections
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListIndexTTLsRequest) Reset()         { *m = ListIndexTTLsRequest{} }
+func (m *ListIndexTTLsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIndexTTLsRequest) ProtoMessage()    {}
+func (*ListIndexTTLsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{43}
+}
+
+func (m *ListIndexTTLsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexTTLsRequest.Unmarshal(m, b)
+}
+func (m *ListIndexTTLsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexTTLsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListIndexTTLsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexTTLsRequest.Merge(m, src)
+}
+func (m *ListIndexTTLsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIndexTTLsRequest.Size(m)
+}
+func (m *ListIndexTTLsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexTTLsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexTTLsRequest proto.InternalMessageInfo
+
+func (m *ListIndexTTLsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListIndexTTLsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ListIndexTTLsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	States               []*IndexTTLState `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListIndexTTLsResponse) Reset()         { *m = ListIndexTTLsResponse{} }
+func (m *ListIndexTTLsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIndexTTLsResponse) ProtoMessage()    {}
+func (*ListIndexTTLsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{44}
+}
+
+func (m *ListIndexTTLsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexTTLsResponse.Unmarshal(m, b)
+}
+func (m *ListIndexTTLsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexTTLsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListIndexTTLsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexTTLsResponse.Merge(m, src)
+}
+func (m *ListIndexTTLsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIndexTTLsResponse.Size(m)
+}
+func (m *ListIndexTTLsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexTTLsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexTTLsResponse proto.InternalMessageInfo
+
+func (m *ListIndexTTLsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListIndexTTLsResponse) GetStates() []*IndexTTLState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type SetIndexTTLRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IndexID      int64             `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	// 0 removes the ttl of the index
+	TtlDays              int64    `protobuf:"varint,4,opt,name=ttl_days,json=ttlDays,proto3" json:"ttl_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetIndexTTLRequest) Reset()         { *m = SetIndexTTLRequest{} }
+func (m *SetIndexTTLRequest) String() string { return proto.CompactTextString(m) }
+func (*SetIndexTTLRequest) ProtoMessage()    {}
+func (*SetIndexTTLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{45}
+}
+
+func (m *SetIndexTTLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetIndexTTLRequest.Unmarshal(m, b)
+}
+func (m *SetIndexTTLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetIndexTTLRequest.Marshal(b, m, deterministic)
+}
+func (m *SetIndexTTLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIndexTTLRequest.Merge(m, src)
+}
+func (m *SetIndexTTLRequest) XXX_Size() int {
+	return xxx_messageInfo_SetIndexTTLRequest.Size(m)
+}
+func (m *SetIndexTTLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIndexTTLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIndexTTLRequest proto.InternalMessageInfo
+
+func (m *SetIndexTTLRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetIndexTTLRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SetIndexTTLRequest) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *SetIndexTTLRequest) GetTtlDays() int64 {
+	if m != nil {
+		return m.TtlDays
+	}
+	return 0
+}
+
+type CheckIndexTTLRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CheckIndexTTLRequest) Reset()         { *m = CheckIndexTTLRequest{} }
+func (m *CheckIndexTTLRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckIndexTTLRequest) ProtoMessage()    {}
+func (*CheckIndexTTLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{46}
+}
+
+func (m *CheckIndexTTLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckIndexTTLRequest.Unmarshal(m, b)
+}
+func (m *CheckIndexTTLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckIndexTTLRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckIndexTTLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckIndexTTLRequest.Merge(m, src)
+}
+func (m *CheckIndexTTLRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckIndexTTLRequest.Size(m)
+}
+func (m *CheckIndexTTLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckIndexTTLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckIndexTTLRequest proto.InternalMessageInfo
+
+func (m *CheckIndexTTLRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type CheckIndexTTLResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the drop candidates and the indexes dropped in the check
+	States               []*IndexTTLState `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CheckIndexTTLResponse) Reset()         { *m = CheckIndexTTLResponse{} }
+func (m *CheckIndexTTLResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckIndexTTLResponse) ProtoMessage()    {}
+func (*CheckIndexTTLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{47}
+}
+
+func (m *CheckIndexTTLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckIndexTTLResponse.Unmarshal(m, b)
+}
+func (m *CheckIndexTTLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckIndexTTLResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckIndexTTLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckIndexTTLResponse.Merge(m, src)
+}
+func (m *CheckIndexTTLResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckIndexTTLResponse.Size(m)
+}
+func (m *CheckIndexTTLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckIndexTTLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckIndexTTLResponse proto.InternalMessageInfo
+
+func (m *CheckIndexTTLResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CheckIndexTTLResponse) GetStates() []*IndexTTLState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type VerifyIndexBuildRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the IndexNode to rebuild the index on, ignored by the IndexNode itself
+	NodeID int64 `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// the path of the manifest of the index build in the object storage
+	ManifestPath         string   `protobuf:"bytes,3,opt,name=manifest_path,json=manifestPath,proto3" json:"manifest_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyIndexBuildRequest) Reset()         { *m = VerifyIndexBuildRequest{} }
+func (m *VerifyIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyIndexBuildRequest) ProtoMessage()    {}
+func (*VerifyIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{48}
+}
+
+func (m *VerifyIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *VerifyIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyIndexBuildRequest.Merge(m, src)
+}
+func (m *VerifyIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyIndexBuildRequest.Size(m)
+}
+func (m *VerifyIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyIndexBuildRequest proto.InternalMessageInfo
+
+func (m *VerifyIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *VerifyIndexBuildRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *VerifyIndexBuildRequest) GetManifestPath() string {
+	if m != nil {
+		return m.ManifestPath
+	}
+	return ""
+}
+
+// IndexFileVerification is the recorded and the rebuilt checksums of an index file, empty if missing
+type IndexFileVerification struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Expected             string   `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual               string   `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	Match                bool     `protobuf:"varint,4,opt,name=match,proto3" json:"match,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexFileVerification) Reset()         { *m = IndexFileVerification{} }
+func (m *IndexFileVerification) String() string { return proto.CompactTextString(m) }
+func (*IndexFileVerification) ProtoMessage()    {}
+func (*IndexFileVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{49}
+}
+
+func (m *IndexFileVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexFileVerification.Unmarshal(m, b)
+}
+func (m *IndexFileVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexFileVerification.Marshal(b, m, deterministic)
+}
+func (m *IndexFileVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexFileVerification.Merge(m, src)
+}
+func (m *IndexFileVerification) XXX_Size() int {
+	return xxx_messageInfo_IndexFileVerification.Size(m)
+}
+func (m *IndexFileVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexFileVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexFileVerification proto.InternalMessageInfo
+
+func (m *IndexFileVerification) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *IndexFileVerification) GetExpected() string {
+	if m != nil {
+		return m.Expected
+	}
+	return ""
+}
+
+func (m *IndexFileVerification) GetActual() string {
+	if m != nil {
+		return m.Actual
+	}
+	return ""
+}
+
+func (m *IndexFileVerification) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
+}
+
+type VerifyIndexBuildResponse struct {
+	Status       *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	BuildID      int64                    `protobuf:"varint,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexVersion int64                    `protobuf:"varint,3,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	Match        bool                     `protobuf:"varint,4,opt,name=match,proto3" json:"match,omitempty"`
+	Files        []*IndexFileVerification `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	// the differences of the build environment from the recorded one
+	EnvironmentDiffs     []string `protobuf:"bytes,6,rep,name=environment_diffs,json=environmentDiffs,proto3" json:"environment_diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyIndexBuildResponse) Reset()         { *m = VerifyIndexBuildResponse{} }
+func (m *VerifyIndexBuildResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyIndexBuildResponse) ProtoMessage()    {}
+func (*VerifyIndexBuildResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{50}
+}
+
+func (m *VerifyIndexBuildResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyIndexBuildResponse.Unmarshal(m, b)
+}
+func (m *VerifyIndexBuildResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyIndexBuildResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyIndexBuildResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyIndexBuildResponse.Merge(m, src)
+}
+func (m *VerifyIndexBuildResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyIndexBuildResponse.Size(m)
+}
+func (m *VerifyIndexBuildResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyIndexBuildResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyIndexBuildResponse proto.InternalMessageInfo
+
+func (m *VerifyIndexBuildResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *VerifyIndexBuildResponse) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+func (m *VerifyIndexBuildResponse) GetIndexVersion() int64 {
+	if m != nil {
+		return m.IndexVersion
+	}
+	return 0
+}
+
+func (m *VerifyIndexBuildResponse) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
+}
+
+func (m *VerifyIndexBuildResponse) GetFiles() []*IndexFileVerification {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *VerifyIndexBuildResponse) GetEnvironmentDiffs() []string {
+	if m != nil {
+		return m.EnvironmentDiffs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.index.GCScope", GCScope_name, GCScope_value)
+	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
+	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
+	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.index.SegmentIndex")
+	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
+	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
+	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.index.GetIndexStateRequest")
+	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.index.GetIndexStateResponse")
+	proto.RegisterType((*GetSegmentIndexStateRequest)(nil), "milvus.proto.index.GetSegmentIndexStateRequest")
+	proto.RegisterType((*SegmentIndexState)(nil), "milvus.proto.index.SegmentIndexState")
+	proto.RegisterType((*GetSegmentIndexStateResponse)(nil), "milvus.proto.index.GetSegmentIndexStateResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.index.CreateIndexRequest")
+	proto.RegisterType((*GetIndexInfoRequest)(nil), "milvus.proto.index.GetIndexInfoRequest")
+	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.index.SegmentInfo")
+	proto.RegisterType((*GetIndexInfoResponse)(nil), "milvus.proto.index.GetIndexInfoResponse")
+	proto.RegisterMapType((map[int64]*SegmentInfo)(nil), "milvus.proto.index.GetIndexInfoResponse.SegmentInfoEntry")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
+	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.index.DescribeIndexRequest")
+	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.index.DescribeIndexResponse")
+	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.index.GetIndexBuildProgressRequest")
+	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.index.GetIndexBuildProgressResponse")
+	proto.RegisterType((*StorageConfig)(nil), "milvus.proto.index.StorageConfig")
+	proto.RegisterType((*CreateJobRequest)(nil), "milvus.proto.index.CreateJobRequest")
+	proto.RegisterType((*QueryJobsRequest)(nil), "milvus.proto.index.QueryJobsRequest")
+	proto.RegisterType((*IndexTaskInfo)(nil), "milvus.proto.index.IndexTaskInfo")
+	proto.RegisterType((*QueryJobsResponse)(nil), "milvus.proto.index.QueryJobsResponse")
+	proto.RegisterType((*DropJobsRequest)(nil), "milvus.proto.index.DropJobsRequest")
+	proto.RegisterType((*JobInfo)(nil), "milvus.proto.index.JobInfo")
+	proto.RegisterType((*GetJobStatsRequest)(nil), "milvus.proto.index.GetJobStatsRequest")
+	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
+	proto.RegisterType((*CheckIndexConsistencyRequest)(nil), "milvus.proto.index.CheckIndexConsistencyRequest")
+	proto.RegisterType((*IndexInconsistency)(nil), "milvus.proto.index.IndexInconsistency")
+	proto.RegisterType((*CheckIndexConsistencyResponse)(nil), "milvus.proto.index.CheckIndexConsistencyResponse")
+	proto.RegisterType((*GetIndexStatisticsRequest)(nil), "milvus.proto.index.GetIndexStatisticsRequest")
+	proto.RegisterType((*DailyIndexStatistics)(nil), "milvus.proto.index.DailyIndexStatistics")
+	proto.RegisterType((*IndexStatistics)(nil), "milvus.proto.index.IndexStatistics")
+	proto.RegisterType((*GetIndexStatisticsResponse)(nil), "milvus.proto.index.GetIndexStatisticsResponse")
+	proto.RegisterType((*CordonIndexNodeRequest)(nil), "milvus.proto.index.CordonIndexNodeRequest")
+	proto.RegisterType((*IndexNodeState)(nil), "milvus.proto.index.IndexNodeState")
+	proto.RegisterType((*CordonIndexNodeResponse)(nil), "milvus.proto.index.CordonIndexNodeResponse")
+	proto.RegisterType((*TriggerGCRequest)(nil), "milvus.proto.index.TriggerGCRequest")
+	proto.RegisterType((*GCRun)(nil), "milvus.proto.index.GCRun")
+	proto.RegisterType((*TriggerGCResponse)(nil), "milvus.proto.index.TriggerGCResponse")
+	proto.RegisterType((*IndexTTLState)(nil), "milvus.proto.index.IndexTTLState")
+	proto.RegisterType((*ListIndexTTLsRequest)(nil), "milvus.proto.index.ListIndexTTLsRequest")
+	proto.RegisterType((*ListIndexTTLsResponse)(nil), "milvus.proto.index.ListIndexTTLsResponse")
+	proto.RegisterType((*SetIndexTTLRequest)(nil), "milvus.proto.index.SetIndexTTLRequest")
+	proto.RegisterType((*CheckIndexTTLRequest)(nil), "milvus.proto.index.CheckIndexTTLRequest")
+	proto.RegisterType((*CheckIndexTTLResponse)(nil), "milvus.proto.index.CheckIndexTTLResponse")
+	proto.RegisterType((*VerifyIndexBuildRequest)(nil), "milvus.proto.index.VerifyIndexBuildRequest")
+	proto.RegisterType((*IndexFileVerification)(nil), "milvus.proto.index.IndexFileVerification")
+	proto.RegisterType((*VerifyIndexBuildResponse)(nil), "milvus.proto.index.VerifyIndexBuildResponse")
+}
+
+func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
+
+var fileDescriptor_f9e019eb3fda53c2 = []byte{
+	// 3295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0xf2, 0x87, 0xc4, 0x7d, 0x14, 0x25, 0x6a, 0x2c, 0xdb, 0x34, 0x6d, 0x7f, 0x2d, 0xaf,
+	0x63, 0x5b, 0x76, 0xbe, 0x96, 0x13, 0xa7, 0x29, 0x12, 0x23, 0x75, 0x21, 0x4b, 0xb1, 0x22, 0xdb,
+	0x32, 0xdc, 0x95, 0x1b, 0xa0, 0x46, 0x01, 0x66, 0xb9, 0x3b, 0x94, 0x26, 0x5a, 0xee, 0x32, 0x3b,
+	0xb3, 0xb6, 0x99, 0x02, 0x45, 0x2f, 0x41, 0xd1, 0x20, 0x40, 0x81, 0xa0, 0x68, 0x81, 0x16, 0xe8,
+	0xa1, 0x28, 0x7a, 0x68, 0x0f, 0x45, 0x6e, 0x45, 0x2f, 0xbd, 0x07, 0xbd, 0xf6, 0x1f, 0x08, 0xfa,
+	0x3f, 0x14, 0x3d, 0xb5, 0x98, 0x1f, 0xbb, 0xdc, 0x5d, 0x2e, 0x29, 0x5a, 0x94, 0x1b, 0xa0, 0xd5,
+	0x89, 0xf3, 0xf6, 0xcd, 0xbc, 0x99, 0xf7, 0x3e, 0xef, 0xc7, 0xbc, 0x11, 0x2c, 0x12, 0xcf, 0xc1,
+	0xcf, 0x5b, 0xb6, 0xef, 0x07, 0xce, 0x6a, 0x2f, 0xf0, 0x99, 0x8f, 0x50, 0x97, 0xb8, 0x4f, 0x43,
+	0x2a, 0x47, 0xab, 0xe2, 0x7b, 0x73, 0xce, 0xf6, 0xbb, 0x5d, 0xdf, 0x93, 0xb4, 0xe6, 0x3c, 0xf1,
+	0x18, 0x0e, 0x3c, 0xcb, 0x55, 0xe3, 0xb9, 0xe4, 0x0c, 0xe3, 0x8f, 0x25, 0xd0, 0xb7, 0xf8, 0xac,
+	0x2d, 0xaf, 0xe3, 0x23, 0x03, 0xe6, 0x6c, 0xdf, 0x75, 0xb1, 0xcd, 0x88, 0xef, 0x6d, 0x6d, 0x34,
+	0xb4, 0x65, 0x6d, 0xa5, 0x68, 0xa6, 0x68, 0xa8, 0x01, 0xb3, 0x1d, 0x82, 0x5d, 0x67, 0x6b, 0xa3,
+	0x51, 0x10, 0x9f, 0xa3, 0x21, 0x3a, 0x07, 0x20, 0x37, 0xe8, 0x59, 0x5d, 0xdc, 0x28, 0x2e, 0x6b,
+	0x2b, 0xba, 0xa9, 0x0b, 0xca, 0x43, 0xab, 0x8b, 0xf9, 0x44, 0x31, 0xd8, 0xda, 0x68, 0x94, 0xe4,
+	0x44, 0x35, 0x44, 0x77, 0xa0, 0xca, 0xfa, 0x3d, 0xdc, 0xea, 0x59, 0x81, 0xd5, 0xa5, 0x8d, 0xf2,
+	0x72, 0x71, 0xa5, 0x7a, 0xf3, 0xc2, 0x6a, 0xea, 0x68, 0xea, 0x4c, 0xf7, 0x71, 0xff, 0x7d, 0xcb,
+	0x0d, 0xf1, 0x23, 0x8b, 0x04, 0x26, 0xf0, 0x59, 0x8f, 0xc4, 0x24, 0xb4, 0x01, 0x73, 0x52, 0xb8,
+	0x5a, 0x64, 0x66, 0xd2, 0x45, 0xaa, 0x62, 0x9a, 0x5a, 0xe5, 0x82, 0x5a, 0x05, 0x3b, 0xad, 0xc0,
+	0x7f, 0x46, 0x1b, 0xb3, 0x62, 0xa3, 0x55, 0x45, 0x33, 0xfd, 0x67, 0x94, 0x9f, 0x92, 0xf9, 0xcc,
+	0x72, 0x25, 0x43, 0x45, 0x30, 0xe8, 0x82, 0x22, 0x3e, 0xbf, 0x09, 0x65, 0xca, 0x2c, 0x86, 0x1b,
+	0xfa, 0xb2, 0xb6, 0x32, 0x7f, 0xf3, 0x7c, 0xee, 0x06, 0x84, 0xc6, 0x77, 0x38, 0x9b, 0x29, 0xb9,
+	0xd1, 0x9b, 0x70, 0x4a, 0x6e, 0x5f, 0x0c, 0x5b, 0x1d, 0x8b, 0xb8, 0xad, 0x00, 0x5b, 0xd4, 0xf7,
+	0x1a, 0x20, 0x14, 0xb9, 0x44, 0xe2, 0x39, 0x77, 0x2d, 0xe2, 0x9a, 0xe2, 0x1b, 0x32, 0xa0, 0x46,
+	0x68, 0xcb, 0x0a, 0x99, 0xdf, 0x12, 0xdf, 0x1b, 0xd5, 0x65, 0x6d, 0xa5, 0x62, 0x56, 0x09, 0x5d,
+	0x0b, 0x99, 0x2f, 0xc4, 0xa0, 0x6d, 0x58, 0x0c, 0x29, 0x0e, 0x5a, 0x29, 0xf5, 0xcc, 0x4d, 0xaa,
+	0x9e, 0x05, 0x3e, 0x77, 0x6b, 0xa0, 0x22, 0xe3, 0x13, 0x0d, 0xe0, 0xae, 0xb0, 0xb8, 0x58, 0xfd,
+	0x9d, 0xc8, 0xe8, 0xc4, 0xeb, 0xf8, 0x02, 0x30, 0xd5, 0x9b, 0xe7, 0x56, 0x87, 0x51, 0xb9, 0x1a,
+	0xa3, 0x4c, 0x61, 0x82, 0xff, 0xe4, 0x98, 0x70, 0xb0, 0x8b, 0x19, 0x76, 0x04, 0x98, 0x2a, 0x66,
+	0x34, 0x44, 0xe7, 0xa1, 0x6a, 0x07, 0x98, 0xeb, 0x82, 0x11, 0x85, 0xa6, 0x92, 0x09, 0x92, 0xf4,
+	0x98, 0x74, 0xb1, 0xf1, 0x49, 0x09, 0xe6, 0x76, 0xf0, 0x6e, 0x17, 0x7b, 0x4c, 0xee, 0x64, 0x12,
+	0xf0, 0x2e, 0x43, 0xb5, 0x67, 0x05, 0x8c, 0x28, 0x16, 0x09, 0xe0, 0x24, 0x09, 0x9d, 0x05, 0x9d,
+	0xaa, 0x55, 0x37, 0x84, 0xd4, 0xa2, 0x39, 0x20, 0xa0, 0xd3, 0x50, 0xf1, 0xc2, 0xae, 0x34, 0xbd,
+	0x02, 0xb1, 0x17, 0x76, 0x85, 0xe1, 0x13, 0xf0, 0x2e, 0xa7, 0xe1, 0xdd, 0x80, 0xd9, 0x76, 0x48,
+	0x84, 0xc7, 0xcc, 0xc8, 0x2f, 0x6a, 0x88, 0x4e, 0xc2, 0x8c, 0xe7, 0x3b, 0x78, 0x6b, 0x43, 0x01,
+	0x4d, 0x8d, 0xd0, 0x45, 0xa8, 0x49, 0xa5, 0x3e, 0xc5, 0x01, 0x25, 0xbe, 0xa7, 0x60, 0x26, 0xb1,
+	0xf9, 0xbe, 0xa4, 0x1d, 0x16, 0x69, 0xe7, 0xa1, 0x3a, 0x8c, 0x2e, 0xe8, 0x0c, 0x30, 0x75, 0x19,
+	0x16, 0xa4, 0xf0, 0x0e, 0x71, 0x71, 0x6b, 0x1f, 0xf7, 0x69, 0xa3, 0xba, 0x5c, 0x5c, 0xd1, 0x4d,
+	0xb9, 0xa7, 0xbb, 0xc4, 0xc5, 0xf7, 0x71, 0x9f, 0x26, 0x6d, 0x37, 0x37, 0xd6, 0x76, 0xb5, 0xac,
+	0xed, 0xd0, 0x25, 0x98, 0xa7, 0x38, 0x20, 0x96, 0x4b, 0x3e, 0xc6, 0x2d, 0x4a, 0x3e, 0xc6, 0x8d,
+	0x79, 0xc1, 0x53, 0x8b, 0xa9, 0x3b, 0xe4, 0x63, 0xcc, 0xd5, 0xf0, 0x2c, 0x20, 0x0c, 0xb7, 0xf6,
+	0x2c, 0xcf, 0xf1, 0x3b, 0x9d, 0xc6, 0x82, 0x90, 0x33, 0x27, 0x88, 0xef, 0x49, 0x9a, 0xf1, 0x0b,
+	0x0d, 0x8e, 0x9b, 0x78, 0x97, 0x50, 0x86, 0x83, 0x87, 0xbe, 0x83, 0x4d, 0xfc, 0x51, 0x88, 0x29,
+	0x43, 0xaf, 0x41, 0xa9, 0x6d, 0x51, 0xac, 0x20, 0x79, 0x36, 0x57, 0x3b, 0xdb, 0x74, 0xf7, 0x8e,
+	0x45, 0xb1, 0x29, 0x38, 0xd1, 0x37, 0x61, 0xd6, 0x72, 0x9c, 0x00, 0x53, 0xda, 0x28, 0x8c, 0x99,
+	0xb4, 0x26, 0x79, 0xcc, 0x88, 0x39, 0x61, 0xc5, 0x62, 0xd2, 0x8a, 0xc6, 0x4f, 0x35, 0x58, 0x4a,
+	0xef, 0x8c, 0xf6, 0x7c, 0x8f, 0x62, 0xf4, 0x06, 0xcc, 0x70, 0x5b, 0x84, 0x54, 0x6d, 0xee, 0x4c,
+	0xae, 0x9c, 0x1d, 0xc1, 0x62, 0x2a, 0x56, 0x1e, 0x24, 0x89, 0x47, 0x58, 0xe4, 0xc0, 0x72, 0x87,
+	0x17, 0xb2, 0x9e, 0xa6, 0x42, 0xfd, 0x96, 0x47, 0x98, 0xf4, 0x57, 0x13, 0x48, 0xfc, 0xdb, 0xf8,
+	0x1e, 0x2c, 0x6d, 0x62, 0x96, 0xc0, 0x84, 0xd2, 0xd5, 0x24, 0xae, 0x93, 0x8e, 0xee, 0x85, 0x4c,
+	0x74, 0x37, 0x7e, 0xab, 0xc1, 0x89, 0xcc, 0xda, 0xd3, 0x9c, 0x36, 0x06, 0x77, 0x61, 0x1a, 0x70,
+	0x17, 0xb3, 0xe0, 0x36, 0x7e, 0xa4, 0xc1, 0x99, 0x4d, 0xcc, 0x92, 0x81, 0xe3, 0x88, 0x35, 0x81,
+	0xfe, 0x0f, 0x20, 0x0e, 0x18, 0xb4, 0x51, 0x5c, 0x2e, 0xae, 0x14, 0xcd, 0x04, 0xc5, 0xf8, 0x89,
+	0x06, 0x8b, 0x43, 0xf2, 0xd3, 0x71, 0x47, 0xcb, 0xc6, 0x9d, 0x97, 0xa5, 0x8e, 0xcf, 0x35, 0x38,
+	0x9b, 0xaf, 0x8e, 0x69, 0x8c, 0xf7, 0x2d, 0x39, 0x09, 0x73, 0x94, 0xf2, 0x34, 0x73, 0x29, 0x2f,
+	0x1f, 0x0c, 0xcb, 0x54, 0x93, 0x8c, 0xcf, 0x8a, 0x80, 0xd6, 0x45, 0xb0, 0x10, 0x1f, 0x5f, 0xc4,
+	0x34, 0x87, 0x2e, 0x4e, 0x32, 0x25, 0x48, 0xe9, 0x28, 0x4a, 0x90, 0xf2, 0xa1, 0x4a, 0x90, 0xb3,
+	0xa0, 0xf3, 0xa8, 0x49, 0x99, 0xd5, 0xed, 0x89, 0x7c, 0x51, 0x32, 0x07, 0x84, 0xe1, 0x84, 0x3f,
+	0x3b, 0x61, 0xc2, 0xaf, 0x1c, 0x3a, 0xe1, 0x3f, 0x87, 0xe3, 0x91, 0x63, 0x8b, 0xf4, 0xfd, 0x02,
+	0xe6, 0x48, 0xbb, 0x42, 0x21, 0xeb, 0x0a, 0x07, 0x18, 0xc5, 0xf8, 0x47, 0x01, 0x16, 0xb7, 0xa2,
+	0x9c, 0xf3, 0xc8, 0x62, 0x7b, 0xa2, 0x66, 0x18, 0xef, 0x29, 0xa3, 0x11, 0x90, 0x48, 0xd0, 0xc5,
+	0x91, 0x09, 0xba, 0x94, 0x4e, 0xd0, 0xe9, 0x0d, 0x96, 0xb3, 0xa8, 0x39, 0x9a, 0xa2, 0x73, 0x05,
+	0xea, 0x89, 0x84, 0xdb, 0xb3, 0xd8, 0x1e, 0x2f, 0x3c, 0x79, 0xc6, 0x9d, 0x27, 0xc9, 0xd3, 0x53,
+	0x74, 0x05, 0x16, 0xe2, 0x0c, 0xe9, 0xc8, 0xc4, 0x59, 0x11, 0x08, 0x19, 0xa4, 0x53, 0x27, 0xca,
+	0x9c, 0xe9, 0x02, 0x42, 0xcf, 0x29, 0x20, 0x92, 0xc5, 0x0c, 0xa4, 0x8a, 0x19, 0xe3, 0xcf, 0x1a,
+	0x54, 0x63, 0x07, 0x9d, 0xf0, 0x62, 0x90, 0xb2, 0x4b, 0x21, 0x6b, 0x97, 0x0b, 0x30, 0x87, 0x3d,
+	0xab, 0xed, 0x62, 0x85, 0xdb, 0xa2, 0xc4, 0xad, 0xa4, 0x49, 0xdc, 0xde, 0x85, 0xea, 0xa0, 0x94,
+	0x8c, 0x7c, 0xf0, 0xd2, 0xc8, 0x5a, 0x32, 0x09, 0x0a, 0x13, 0xe2, 0x9a, 0x92, 0x1a, 0x9f, 0x16,
+	0x06, 0x69, 0x4e, 0x7c, 0x9c, 0x2a, 0x98, 0x7d, 0x1f, 0xe6, 0xd4, 0x29, 0x64, 0x89, 0x2b, 0x43,
+	0xda, 0xdb, 0x79, 0xdb, 0xca, 0x13, 0xba, 0x9a, 0x50, 0xe3, 0xbb, 0x1e, 0x0b, 0xfa, 0x66, 0x95,
+	0x0e, 0x28, 0xcd, 0x16, 0xd4, 0xb3, 0x0c, 0xa8, 0x0e, 0xc5, 0x7d, 0xdc, 0x57, 0x3a, 0xe6, 0x3f,
+	0x79, 0xf8, 0x7f, 0xca, 0xb1, 0xa3, 0xb2, 0xfe, 0xf9, 0xb1, 0xf1, 0xb4, 0xe3, 0x9b, 0x92, 0xfb,
+	0x56, 0xe1, 0x2d, 0xcd, 0xf8, 0x99, 0x06, 0xf5, 0x8d, 0xc0, 0xef, 0xbd, 0x70, 0x28, 0x35, 0x60,
+	0x2e, 0x51, 0x17, 0x47, 0xde, 0x9b, 0xa2, 0x1d, 0x14, 0x54, 0x4f, 0x43, 0xc5, 0x09, 0xfc, 0x5e,
+	0xcb, 0x72, 0xdd, 0x46, 0x49, 0x95, 0x88, 0x81, 0xdf, 0x5b, 0x73, 0x5d, 0x5e, 0x89, 0x6c, 0x60,
+	0x6a, 0x07, 0xa4, 0xfd, 0xe2, 0x41, 0xfe, 0x80, 0x4a, 0xe4, 0x33, 0x0d, 0x4e, 0x64, 0xd6, 0x9e,
+	0xc6, 0xfe, 0xb7, 0xd3, 0xa8, 0x94, 0xe6, 0x3f, 0xe0, 0x86, 0x93, 0x44, 0xa3, 0x25, 0x32, 0xac,
+	0xf8, 0x76, 0x87, 0x47, 0x95, 0x47, 0x81, 0xbf, 0x2b, 0xea, 0xc7, 0xa3, 0x3b, 0xf1, 0xcf, 0x35,
+	0x38, 0x37, 0x42, 0xc6, 0x34, 0x27, 0xcf, 0x5e, 0x86, 0x0b, 0x07, 0x5d, 0x86, 0x8b, 0x99, 0xcb,
+	0xb0, 0xf1, 0x87, 0x02, 0xd4, 0x76, 0x98, 0x1f, 0x58, 0xbb, 0x78, 0xdd, 0xf7, 0x3a, 0x64, 0x97,
+	0x87, 0xda, 0xa8, 0xc6, 0xd6, 0xc4, 0x31, 0xa2, 0x21, 0x97, 0x66, 0xd9, 0x36, 0xa6, 0x94, 0x5f,
+	0x39, 0x54, 0x04, 0xd1, 0xcd, 0xaa, 0xa4, 0xdd, 0xe7, 0x24, 0x74, 0x0d, 0x16, 0x29, 0xb6, 0x03,
+	0xcc, 0x5a, 0x03, 0x4e, 0x85, 0xba, 0x05, 0xf9, 0x61, 0x2d, 0xe2, 0xe6, 0x45, 0x79, 0x48, 0xf1,
+	0xce, 0xce, 0x03, 0x85, 0x3c, 0x35, 0xe2, 0x25, 0x51, 0x3b, 0xb4, 0xf7, 0x31, 0x4b, 0x86, 0x74,
+	0x90, 0x24, 0x01, 0xda, 0x33, 0xa0, 0x07, 0xbe, 0xcf, 0x44, 0x1c, 0x16, 0xf9, 0x57, 0x37, 0x2b,
+	0x9c, 0xc0, 0x43, 0x8d, 0x5a, 0x75, 0x6b, 0x6d, 0x5b, 0xe5, 0x5d, 0x35, 0xe2, 0xf7, 0xca, 0xad,
+	0xb5, 0xed, 0x77, 0x3d, 0xa7, 0xe7, 0x13, 0x8f, 0x89, 0xa0, 0xac, 0x9b, 0x49, 0x12, 0x3f, 0x1e,
+	0x95, 0x9a, 0x68, 0xf1, 0x92, 0x41, 0x04, 0x64, 0xdd, 0xac, 0x2a, 0xda, 0xe3, 0x7e, 0x0f, 0x1b,
+	0x5f, 0x15, 0xa1, 0x2e, 0xeb, 0x9e, 0x7b, 0x7e, 0x3b, 0x82, 0xc7, 0x59, 0xd0, 0x6d, 0x37, 0xa4,
+	0x0c, 0x07, 0x0a, 0x1b, 0xba, 0x39, 0x20, 0x70, 0x8d, 0x24, 0x53, 0x47, 0x80, 0x3b, 0xe4, 0xb9,
+	0xd2, 0xdc, 0xc2, 0x20, 0x77, 0x08, 0x72, 0x32, 0xcb, 0x15, 0x87, 0xb2, 0x9c, 0x63, 0x31, 0x4b,
+	0xa5, 0x9e, 0x92, 0x48, 0x3d, 0x3a, 0xa7, 0xc8, 0xac, 0x33, 0x94, 0x4c, 0xca, 0x39, 0xc9, 0x24,
+	0x91, 0x5d, 0x67, 0xd2, 0xd9, 0x35, 0x0d, 0xde, 0xd9, 0x6c, 0x90, 0x78, 0x0f, 0xe6, 0x23, 0xc5,
+	0xd8, 0x02, 0x23, 0x42, 0x7b, 0x39, 0x57, 0x1b, 0x11, 0xe4, 0x92, 0x60, 0x32, 0x6b, 0x34, 0x39,
+	0x1c, 0xca, 0xc6, 0xfa, 0xa1, 0xb2, 0x71, 0xa6, 0x12, 0x84, 0xc3, 0x54, 0x82, 0xc9, 0xcc, 0x5a,
+	0x4d, 0x67, 0xd6, 0x07, 0x50, 0xff, 0x4e, 0x88, 0x83, 0xfe, 0x3d, 0xbf, 0x4d, 0x27, 0xb3, 0x71,
+	0x13, 0x2a, 0xca, 0x50, 0x51, 0x10, 0x8e, 0xc7, 0xc6, 0x3f, 0x35, 0xa8, 0x09, 0xb7, 0x7f, 0x6c,
+	0xd1, 0xfd, 0xa8, 0xa3, 0x12, 0x59, 0x59, 0x4b, 0x5b, 0xf9, 0x90, 0x77, 0x88, 0x9c, 0x76, 0x40,
+	0x31, 0xaf, 0x1d, 0x90, 0x53, 0x9b, 0x94, 0x72, 0x6b, 0x93, 0xcc, 0xa5, 0xa4, 0x3c, 0xd4, 0x80,
+	0xb8, 0x04, 0xf3, 0xd8, 0xdb, 0x25, 0x1e, 0x8e, 0x01, 0x27, 0xdd, 0xb0, 0x26, 0xa9, 0x0a, 0x71,
+	0xc6, 0xef, 0x35, 0x58, 0x4c, 0xa8, 0x72, 0x9a, 0x48, 0x97, 0x32, 0x40, 0x21, 0x6b, 0x80, 0x3b,
+	0xe9, 0x0c, 0x50, 0xcc, 0x43, 0x44, 0x22, 0x03, 0x44, 0xa6, 0x48, 0x65, 0x81, 0xfb, 0xb0, 0xc0,
+	0xb3, 0xf0, 0xd1, 0x58, 0xfd, 0xaf, 0x1a, 0xcc, 0xde, 0xf3, 0xdb, 0xc2, 0xde, 0x49, 0xa8, 0x69,
+	0xe9, 0x8e, 0x54, 0x1d, 0x8a, 0x0e, 0xe9, 0xaa, 0xb0, 0xcd, 0x7f, 0x72, 0x57, 0xa4, 0xcc, 0x0a,
+	0xd8, 0xa0, 0xa7, 0xc6, 0x6b, 0x34, 0x4e, 0x11, 0x6d, 0x99, 0xd3, 0x50, 0xc1, 0x9e, 0x23, 0x3f,
+	0xaa, 0x42, 0x18, 0x7b, 0x8e, 0xf8, 0x74, 0x34, 0x77, 0x9b, 0x25, 0x28, 0xf7, 0xfc, 0x41, 0x1f,
+	0x4c, 0x0e, 0x8c, 0x25, 0x40, 0x9b, 0x98, 0xdd, 0xf3, 0xdb, 0xdc, 0x2a, 0x91, 0x7a, 0x8c, 0xbf,
+	0x14, 0xe0, 0x78, 0x8a, 0x3c, 0x8d, 0x81, 0x0d, 0xa8, 0xc9, 0x3c, 0xf5, 0xa1, 0xdf, 0x6e, 0x79,
+	0x61, 0xa4, 0x94, 0xaa, 0x20, 0xde, 0xf3, 0xdb, 0x0f, 0xc3, 0x2e, 0xba, 0x0e, 0xc7, 0x89, 0xd7,
+	0xea, 0xa9, 0xd4, 0x19, 0x73, 0x4a, 0x2d, 0xd5, 0x89, 0x17, 0x25, 0x55, 0xc5, 0x7e, 0x19, 0x16,
+	0xb0, 0xf7, 0x51, 0x88, 0x43, 0x1c, 0xb3, 0x4a, 0x9d, 0xd5, 0x14, 0x59, 0xf1, 0xf1, 0x14, 0x69,
+	0xd1, 0xfd, 0x16, 0x75, 0x7d, 0x46, 0x55, 0xe8, 0xd4, 0x39, 0x65, 0x87, 0x13, 0xd0, 0x5b, 0xa0,
+	0xf3, 0xe9, 0x12, 0x5a, 0xf2, 0xfe, 0x70, 0x26, 0x0f, 0x5a, 0xca, 0xde, 0x66, 0xe5, 0x43, 0xf9,
+	0x83, 0x72, 0x3f, 0x52, 0x15, 0xb5, 0x43, 0xe8, 0xbe, 0x4a, 0x48, 0x20, 0x49, 0x1b, 0x84, 0xee,
+	0x1b, 0xbf, 0xd6, 0xe0, 0xec, 0xfa, 0x1e, 0xb6, 0xf7, 0x05, 0x2c, 0xd7, 0x7d, 0x8f, 0x12, 0xca,
+	0xb0, 0x67, 0xf7, 0x0f, 0xdf, 0x22, 0xcb, 0x16, 0x2b, 0x85, 0x9c, 0x62, 0xe5, 0x24, 0xcc, 0x04,
+	0xb8, 0x67, 0x91, 0x40, 0xd5, 0xf8, 0x6a, 0x74, 0xab, 0xfe, 0xe5, 0xed, 0x5a, 0x45, 0x6b, 0xfc,
+	0x2b, 0xfa, 0xd3, 0x8c, 0x3f, 0x69, 0x80, 0x54, 0xd1, 0x64, 0x0f, 0x76, 0x87, 0x10, 0x94, 0x44,
+	0x8a, 0x94, 0x3e, 0x21, 0x7e, 0x4f, 0x24, 0x78, 0x7c, 0xeb, 0x76, 0xf4, 0x25, 0xef, 0x24, 0xcc,
+	0x38, 0x98, 0x59, 0xc4, 0x55, 0xb1, 0x48, 0x8d, 0xb8, 0x0b, 0xca, 0xad, 0x63, 0x47, 0x00, 0xb6,
+	0x62, 0xc6, 0x63, 0xe3, 0x77, 0x1a, 0x9c, 0x1b, 0xa1, 0xdb, 0x69, 0x70, 0xfa, 0x88, 0x07, 0xdb,
+	0x81, 0x2e, 0x48, 0xdc, 0x42, 0xb9, 0x3c, 0xa6, 0xe0, 0x4c, 0xe8, 0xce, 0xcc, 0x4e, 0x37, 0xbe,
+	0xd0, 0xe0, 0x74, 0xb2, 0x2f, 0x47, 0x28, 0x23, 0x36, 0x7d, 0xb9, 0x08, 0x18, 0x7d, 0xd3, 0x46,
+	0x50, 0x72, 0xac, 0xbe, 0xec, 0x9d, 0x97, 0x4d, 0xf1, 0x3b, 0x07, 0x17, 0x7f, 0xd3, 0x60, 0x69,
+	0xc3, 0x22, 0x6e, 0x3f, 0xb3, 0x6b, 0x39, 0x9d, 0xc5, 0xc8, 0x70, 0x54, 0xe3, 0xcc, 0xf6, 0xbb,
+	0xbd, 0xc1, 0x23, 0x42, 0xd1, 0x1c, 0x10, 0xb8, 0x6d, 0x79, 0x66, 0xc1, 0x4e, 0xd4, 0x9b, 0x95,
+	0x23, 0x5e, 0x8e, 0xf1, 0x5f, 0x61, 0x80, 0x5b, 0x01, 0x5f, 0x91, 0x6f, 0x48, 0x33, 0xab, 0x8a,
+	0x66, 0xf2, 0x85, 0x6f, 0xc0, 0x92, 0xf5, 0x74, 0xb7, 0x25, 0x50, 0xd2, 0x72, 0x2d, 0xa1, 0xdf,
+	0x56, 0x57, 0xba, 0xb0, 0x66, 0x2e, 0x5a, 0x4f, 0x77, 0x45, 0xad, 0xfd, 0x40, 0x7e, 0xd9, 0x16,
+	0x0e, 0x29, 0x63, 0x64, 0xbb, 0xcf, 0x30, 0x55, 0xbd, 0x1b, 0x99, 0x04, 0xee, 0x70, 0x8a, 0xf1,
+	0xf7, 0x02, 0x2c, 0x64, 0x8f, 0x34, 0x61, 0x57, 0x2b, 0xd2, 0x67, 0x61, 0x5c, 0x6d, 0x35, 0x74,
+	0x01, 0xbb, 0x06, 0x8b, 0x32, 0xec, 0x25, 0xf7, 0x25, 0xb3, 0xf2, 0x82, 0xf8, 0xb0, 0x15, 0x6f,
+	0x2e, 0xad, 0xc7, 0xf2, 0x68, 0x3d, 0xce, 0x8c, 0xd5, 0xe3, 0xec, 0xe4, 0x7a, 0xac, 0x8c, 0xd2,
+	0xe3, 0x6d, 0x28, 0x3b, 0xdc, 0xfa, 0xaa, 0x80, 0x5b, 0xc9, 0x83, 0x7e, 0x1e, 0x3c, 0x4c, 0x39,
+	0x8d, 0x5f, 0x87, 0x9a, 0x79, 0x90, 0x9f, 0xc6, 0x31, 0xd7, 0x45, 0xe6, 0x54, 0x4b, 0x29, 0x9f,
+	0xbc, 0x38, 0xd2, 0x27, 0x13, 0x52, 0x13, 0xd3, 0xf8, 0xcd, 0xf4, 0xe4, 0xba, 0x1f, 0x38, 0xbe,
+	0x27, 0xb8, 0xa6, 0x7b, 0xad, 0x18, 0xbc, 0x3a, 0x14, 0x52, 0x6f, 0x47, 0x27, 0x61, 0xc6, 0x16,
+	0x32, 0xa2, 0xf0, 0x2b, 0x47, 0x39, 0x6e, 0xf6, 0x01, 0xcc, 0xc7, 0xfb, 0x90, 0x4d, 0xe8, 0xc1,
+	0x9a, 0x5a, 0x6a, 0xcd, 0x26, 0x54, 0xe4, 0x2a, 0xf1, 0x3b, 0x5d, 0x3c, 0xe6, 0xdf, 0x28, 0xf3,
+	0x7b, 0x3d, 0xe2, 0xed, 0x2a, 0x89, 0xf1, 0xd8, 0xf8, 0x54, 0x83, 0x53, 0x43, 0x07, 0x9e, 0xc6,
+	0x0c, 0xb7, 0x32, 0x9d, 0x65, 0x63, 0xa4, 0x09, 0xe2, 0x43, 0xc5, 0x6d, 0xe5, 0xdf, 0x68, 0x50,
+	0x7f, 0x1c, 0x90, 0xdd, 0x5d, 0x1c, 0x6c, 0xae, 0x1f, 0x5e, 0xef, 0xaf, 0x43, 0x99, 0xda, 0x7e,
+	0x2f, 0x2a, 0xa3, 0x73, 0x93, 0xf5, 0xe6, 0xfa, 0x0e, 0x67, 0x31, 0x25, 0x27, 0x3a, 0x05, 0xb3,
+	0x4e, 0xd0, 0x6f, 0x05, 0x61, 0x6c, 0x13, 0x27, 0xe8, 0x9b, 0x61, 0x9e, 0x4d, 0x3e, 0x2f, 0x40,
+	0x79, 0x73, 0xdd, 0x0c, 0x3d, 0x5e, 0x2b, 0x49, 0x39, 0x32, 0xd8, 0xa9, 0xa5, 0x1a, 0x30, 0xcb,
+	0xe4, 0x19, 0x54, 0x9d, 0x1a, 0x0d, 0x47, 0x0a, 0xc9, 0x14, 0x7d, 0x25, 0x19, 0x23, 0x06, 0x45,
+	0xdf, 0x39, 0x00, 0xec, 0x5a, 0x3d, 0x8a, 0x9d, 0x28, 0xb8, 0x15, 0x4d, 0x5d, 0x51, 0xb6, 0xc5,
+	0x2b, 0x1f, 0xb5, 0x2d, 0xcf, 0x8b, 0x3d, 0x3f, 0x1a, 0xca, 0xf4, 0x68, 0xf7, 0x6d, 0x1e, 0x14,
+	0xe4, 0xf3, 0x65, 0x3c, 0x4e, 0x84, 0x8b, 0x4a, 0x2a, 0x5c, 0x2c, 0x41, 0x19, 0x07, 0x81, 0x1f,
+	0xa8, 0xeb, 0xaf, 0x1c, 0xf0, 0x36, 0xb1, 0x6d, 0x79, 0x0e, 0x71, 0x84, 0x65, 0x41, 0xdc, 0x2e,
+	0x12, 0x14, 0xe3, 0x19, 0x2c, 0x26, 0x0c, 0x37, 0x0d, 0x7e, 0xae, 0x43, 0x29, 0x08, 0xbd, 0x08,
+	0x3d, 0xa7, 0xf3, 0x6d, 0x67, 0x86, 0x9e, 0x29, 0xd8, 0x8c, 0x4f, 0x0a, 0xd1, 0xf5, 0xea, 0xf1,
+	0x03, 0xe9, 0x21, 0x2f, 0x35, 0x5c, 0x9f, 0x86, 0x0a, 0x63, 0x6e, 0x2b, 0xce, 0x90, 0x45, 0x73,
+	0x96, 0x31, 0x77, 0xc3, 0xea, 0x53, 0xb4, 0x0c, 0x73, 0xae, 0x45, 0x59, 0x2b, 0xe4, 0x76, 0xb2,
+	0x98, 0xb2, 0x13, 0x70, 0xda, 0x77, 0x29, 0x76, 0xd6, 0x18, 0xef, 0x5b, 0x10, 0xc7, 0xc5, 0x72,
+	0xf6, 0x8c, 0x88, 0xad, 0x15, 0x4e, 0x10, 0xd3, 0x97, 0xa2, 0xbb, 0xdf, 0xac, 0x02, 0x93, 0x38,
+	0xcc, 0x15, 0x58, 0x88, 0xb5, 0xdc, 0xa2, 0xc4, 0xb3, 0xb1, 0x32, 0xd7, 0x7c, 0x4c, 0xde, 0xe1,
+	0x54, 0xfe, 0x6a, 0xb6, 0xf4, 0x80, 0x50, 0x16, 0xe9, 0xe2, 0xe5, 0xd6, 0x0f, 0xb7, 0xd0, 0x97,
+	0xb7, 0x17, 0x2a, 0x5a, 0xbd, 0x98, 0x74, 0x8c, 0x1f, 0x6b, 0x70, 0x22, 0xb3, 0x85, 0x69, 0x80,
+	0xf0, 0x76, 0x26, 0x90, 0x8c, 0xb9, 0xce, 0x29, 0xd3, 0xc7, 0x71, 0xe4, 0x0b, 0x0d, 0xd0, 0x0e,
+	0x8e, 0x37, 0xf2, 0x75, 0x95, 0x52, 0xa3, 0xc1, 0x92, 0x13, 0x56, 0x9e, 0xc0, 0xd2, 0xa0, 0x5a,
+	0x9d, 0x66, 0xd3, 0x39, 0x6b, 0x73, 0xcb, 0x64, 0x16, 0xff, 0x9a, 0x2c, 0xf3, 0x4b, 0x0d, 0x4e,
+	0xbd, 0x8f, 0x03, 0xd2, 0xe9, 0x0f, 0x5a, 0xa1, 0x47, 0x9f, 0x60, 0x2f, 0x42, 0xad, 0x6b, 0x79,
+	0xa4, 0x83, 0xa9, 0x6a, 0x12, 0x4a, 0x3f, 0x9e, 0x8b, 0x88, 0xbc, 0x69, 0x96, 0xa3, 0x26, 0x0a,
+	0x27, 0xe2, 0x67, 0x0b, 0xb1, 0x49, 0x62, 0x5b, 0xdc, 0xc8, 0xc9, 0x76, 0xbf, 0x2e, 0xdb, 0xfd,
+	0x4d, 0xa8, 0xe0, 0xe7, 0x3d, 0x6c, 0x47, 0x15, 0xad, 0x6e, 0xc6, 0x63, 0xbe, 0x2b, 0xcb, 0x66,
+	0xa1, 0xe5, 0x2a, 0xb1, 0x6a, 0xc4, 0x3d, 0xbc, 0x6b, 0x31, 0x7b, 0x4f, 0xb5, 0x3b, 0xe5, 0x80,
+	0xa7, 0x93, 0xc6, 0xb0, 0x46, 0xa6, 0x31, 0x4f, 0xe2, 0x1a, 0x55, 0x48, 0x5f, 0xa3, 0x86, 0xda,
+	0x84, 0xc5, 0x9c, 0x36, 0x61, 0xee, 0x36, 0xd1, 0xb7, 0xa1, 0xcc, 0xbb, 0x4b, 0x51, 0x5b, 0xe1,
+	0xea, 0xd8, 0x37, 0x9f, 0xa4, 0xf2, 0x4c, 0x39, 0x0f, 0xbd, 0x0a, 0x8b, 0xd8, 0x7b, 0x4a, 0x02,
+	0xdf, 0x13, 0x0f, 0x35, 0x0e, 0xe9, 0x74, 0xe4, 0x6d, 0x5a, 0x37, 0xeb, 0x89, 0x0f, 0x1b, 0x9c,
+	0x7e, 0xed, 0x1d, 0x98, 0x55, 0x09, 0x1a, 0xe9, 0x3c, 0xdb, 0xae, 0xb9, 0x6e, 0xfd, 0x18, 0xaa,
+	0x81, 0xbe, 0xb9, 0x2e, 0x84, 0x60, 0x5a, 0xd7, 0x10, 0xc0, 0xcc, 0xe6, 0xfa, 0x36, 0x66, 0x56,
+	0xbd, 0x80, 0xaa, 0x7c, 0x02, 0x17, 0x4d, 0xeb, 0xc5, 0x9b, 0x5f, 0xd5, 0x01, 0xd4, 0xa5, 0xcf,
+	0x0f, 0x1c, 0xe4, 0x8a, 0xe6, 0xc5, 0xba, 0xdf, 0xed, 0xf9, 0x1e, 0xf6, 0x98, 0x00, 0x24, 0x45,
+	0xab, 0xe9, 0x13, 0xa8, 0xc1, 0x30, 0xa3, 0x42, 0x67, 0xf3, 0x95, 0x5c, 0xfe, 0x0c, 0xb3, 0x71,
+	0x0c, 0x7d, 0x24, 0x5e, 0xb6, 0x06, 0xe5, 0xe5, 0xfa, 0x1e, 0xcf, 0xc5, 0x2e, 0xba, 0x39, 0xe2,
+	0xff, 0x40, 0xf2, 0x98, 0x23, 0x99, 0x17, 0x73, 0x65, 0xee, 0xb0, 0x80, 0x78, 0xbb, 0x11, 0x46,
+	0x8c, 0x63, 0xe8, 0x31, 0x54, 0x13, 0x8f, 0xf1, 0x28, 0xf7, 0x22, 0x3a, 0xfc, 0x5a, 0xdf, 0x1c,
+	0x07, 0x26, 0xe3, 0x18, 0xea, 0x40, 0x2d, 0xf5, 0xdf, 0x22, 0x68, 0x65, 0xdc, 0x83, 0x5a, 0xf2,
+	0x5f, 0x34, 0x9a, 0x57, 0x27, 0xe0, 0x8c, 0x77, 0xff, 0x03, 0xa9, 0xb0, 0xa1, 0x7f, 0xb7, 0xb8,
+	0x31, 0x62, 0x91, 0x51, 0xff, 0x18, 0xd2, 0x7c, 0x6d, 0xf2, 0x09, 0xb1, 0x70, 0x67, 0x70, 0x48,
+	0xd9, 0xb2, 0xb9, 0x72, 0xf0, 0xab, 0xa1, 0x94, 0xb6, 0x32, 0xe9, 0xf3, 0xa2, 0x71, 0x0c, 0x3d,
+	0x02, 0x3d, 0x7e, 0xe0, 0x43, 0xaf, 0xe4, 0x5e, 0x96, 0x32, 0xef, 0x7f, 0x13, 0x18, 0x27, 0xf5,
+	0x80, 0x96, 0x6f, 0x9c, 0xbc, 0xf7, 0xbb, 0xe6, 0xd5, 0x09, 0x38, 0xe3, 0x9d, 0xff, 0x70, 0xf0,
+	0x2f, 0x43, 0xa9, 0x67, 0x2b, 0xf4, 0xda, 0xb8, 0xe3, 0xe7, 0xbd, 0xa2, 0x35, 0x5f, 0x7f, 0x81,
+	0x19, 0x09, 0x70, 0xa0, 0x9d, 0x3d, 0xff, 0x99, 0x7c, 0x3e, 0x08, 0x03, 0x11, 0x51, 0x72, 0x84,
+	0x2b, 0x5f, 0x1a, 0x66, 0x1d, 0x29, 0x7c, 0xcc, 0x8c, 0x58, 0x78, 0x0b, 0x60, 0x13, 0xb3, 0x6d,
+	0xcc, 0x02, 0xde, 0x06, 0xb8, 0x3c, 0x2a, 0x60, 0x28, 0x86, 0x48, 0xd4, 0x95, 0x03, 0xf9, 0x62,
+	0x01, 0x6d, 0xa8, 0x8a, 0xb4, 0xfc, 0x1e, 0xb6, 0x5c, 0xb6, 0x87, 0xf2, 0x67, 0x26, 0x38, 0x46,
+	0x60, 0x2f, 0x8f, 0x31, 0x69, 0xc1, 0xdc, 0x2e, 0x58, 0xbe, 0x05, 0xc7, 0x35, 0x23, 0x9b, 0xaf,
+	0xbf, 0xc0, 0x8c, 0x58, 0x7e, 0x28, 0xa2, 0x6f, 0xb6, 0xa7, 0x72, 0xfd, 0xa0, 0x08, 0x91, 0x6a,
+	0x82, 0x35, 0x57, 0x27, 0x65, 0x8f, 0xc5, 0xba, 0xb0, 0x90, 0xb9, 0xd6, 0xa2, 0x6b, 0xb9, 0xdb,
+	0xcf, 0xbd, 0xec, 0x37, 0x5f, 0x9d, 0x88, 0x37, 0x96, 0xf6, 0x04, 0xf4, 0xf8, 0xfa, 0x93, 0xef,
+	0xe0, 0xd9, 0x6b, 0x6d, 0xf3, 0xd2, 0x01, 0x5c, 0xf1, 0xda, 0x1d, 0xa8, 0xa5, 0xaa, 0xea, 0x7c,
+	0x57, 0xcf, 0xab, 0xfd, 0x9b, 0x57, 0x27, 0xe0, 0x4c, 0x66, 0x91, 0x44, 0xcd, 0x9c, 0x9f, 0x45,
+	0x86, 0x8b, 0xea, 0x09, 0x02, 0x55, 0xaa, 0xf2, 0xcc, 0xdf, 0x7d, 0x5e, 0xe5, 0xdb, 0xbc, 0x3a,
+	0x01, 0x67, 0xbc, 0x7b, 0x1f, 0xea, 0xd9, 0x2a, 0x0a, 0xe5, 0x1a, 0x71, 0x44, 0xf5, 0xd9, 0xfc,
+	0xff, 0xc9, 0x98, 0x23, 0x81, 0x37, 0x7f, 0x35, 0x0b, 0x7a, 0x0c, 0x85, 0xff, 0xfe, 0x1a, 0xe3,
+	0x11, 0xe8, 0xf1, 0xc3, 0x77, 0x3e, 0xc2, 0xb3, 0xef, 0xe2, 0x07, 0x21, 0xe3, 0x09, 0xe8, 0xf1,
+	0xdb, 0x60, 0xfe, 0x8a, 0xd9, 0x57, 0xd8, 0xe6, 0xa5, 0x03, 0xb8, 0xe2, 0xdd, 0x3e, 0x84, 0x4a,
+	0xf4, 0x96, 0x87, 0x2e, 0x8e, 0xca, 0xb7, 0xc9, 0x95, 0x0f, 0xd8, 0xeb, 0x07, 0x50, 0x4d, 0x3c,
+	0x74, 0xe5, 0xfb, 0xc6, 0xf0, 0x03, 0x59, 0xf3, 0xca, 0x81, 0x7c, 0xff, 0x23, 0x89, 0xee, 0x3f,
+	0xed, 0x9d, 0x77, 0xbe, 0xf1, 0xe4, 0xe6, 0x2e, 0x61, 0x7b, 0x61, 0x9b, 0x9b, 0xf2, 0x86, 0x9c,
+	0x7b, 0x9d, 0xf8, 0xea, 0xd7, 0x8d, 0x48, 0x2d, 0x37, 0xc4, 0x72, 0x37, 0xc4, 0x72, 0xbd, 0x76,
+	0x7b, 0x46, 0x0c, 0xdf, 0xf8, 0xf7, 0x00, 0x02, 0x71, 0x84, 0x24, 0xc6, 0x33, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexCoordClient is the client API for IndexCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
+	GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error)
+	GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	CheckIndexConsistency(ctx context.Context, in *CheckIndexConsistencyRequest, opts ...grpc.CallOption) (*CheckIndexConsistencyResponse, error)
+	GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error)
+	CordonIndexNode(ctx context.Context, in *CordonIndexNodeRequest, opts ...grpc.CallOption) (*CordonIndexNodeResponse, error)
+	TriggerGC(ctx context.Context, in *TriggerGCRequest, opts ...grpc.CallOption) (*TriggerGCResponse, error)
+	// ListIndexTTLs, SetIndexTTL and CheckIndexTTL list and set the ttl of the indexes unused while their collections
+	// are not loaded, and check their usage right away
+	ListIndexTTLs(ctx context.Context, in *ListIndexTTLsRequest, opts ...grpc.CallOption) (*ListIndexTTLsResponse, error)
+	SetIndexTTL(ctx context.Context, in *SetIndexTTLRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CheckIndexTTL(ctx context.Context, in *CheckIndexTTLRequest, opts ...grpc.CallOption) (*CheckIndexTTLResponse, error)
+	// VerifyIndexBuild forwards the request to the IndexNode given by the nodeID
+	VerifyIndexBuild(ctx context.Context, in *VerifyIndexBuildRequest, opts ...grpc.CallOption) (*VerifyIndexBuildResponse, error)
+}
+
+type indexCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIndexCoordClient(cc *grpc.ClientConn) IndexCoordClient {
+	return &indexCoordClient{cc}
+}
+
+func (c *indexCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error) {
+	out := new(GetIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error) {
+	out := new(GetSegmentIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetSegmentIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error) {
+	out := new(GetIndexInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error) {
+	out := new(DescribeIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/DescribeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error) {
+	out := new(GetIndexBuildProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexBuildProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+	out := new(internalpb.ShowConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ShowConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+	out := new(milvuspb.CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CheckIndexConsistency(ctx context.Context, in *CheckIndexConsistencyRequest, opts ...grpc.CallOption) (*CheckIndexConsistencyResponse, error) {
+	out := new(CheckIndexConsistencyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CheckIndexConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error) {
+	out := new(GetIndexStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetIndexStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CordonIndexNode(ctx context.Context, in *CordonIndexNodeRequest, opts ...grpc.CallOption) (*CordonIndexNodeResponse, error) {
+	out := new(CordonIndexNodeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CordonIndexNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) TriggerGC(ctx context.Context, in *TriggerGCRequest, opts ...grpc.CallOption) (*TriggerGCResponse, error) {
+	out := new(TriggerGCResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/TriggerGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) ListIndexTTLs(ctx context.Context, in *ListIndexTTLsRequest, opts ...grpc.CallOption) (*ListIndexTTLsResponse, error) {
+	out := new(ListIndexTTLsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ListIndexTTLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) SetIndexTTL(ctx context.Context, in *SetIndexTTLRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/SetIndexTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CheckIndexTTL(ctx context.Context, in *CheckIndexTTLRequest, opts ...grpc.CallOption) (*CheckIndexTTLResponse, error) {
+	out := new(CheckIndexTTLResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CheckIndexTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) VerifyIndexBuild(ctx context.Context, in *VerifyIndexBuildRequest, opts ...grpc.CallOption) (*VerifyIndexBuildResponse, error) {
+	out := new(VerifyIndexBuildResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/VerifyIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexCoordServer is the server API for IndexCoord service.
+type IndexCoordServer interface {
+	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
+	GetSegmentIndexState(context.Context, *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error)
+	GetIndexInfos(context.Context, *GetIndexInfoRequest) (*GetIndexInfoResponse, error)
+	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
+	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
+	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	CheckIndexConsistency(context.Context, *CheckIndexConsistencyRequest) (*CheckIndexConsistencyResponse, error)
+	GetIndexStatistics(context.Context, *GetIndexStatisticsRequest) (*GetIndexStatisticsResponse, error)
+	CordonIndexNode(context.Context, *CordonIndexNodeRequest) (*CordonIndexNodeResponse, error)
+	TriggerGC(context.Context, *TriggerGCRequest) (*TriggerGCResponse, error)
+	// ListIndexTTLs, SetIndexTTL and CheckIndexTTL list and set the ttl of the indexes unused while their collections
+	// are not loaded, and check their usage right away
+	ListIndexTTLs(context.Context, *ListIndexTTLsRequest) (*ListIndexTTLsResponse, error)
+	SetIndexTTL(context.Context, *SetIndexTTLRequest) (*commonpb.Status, error)
+	CheckIndexTTL(context.Context, *CheckIndexTTLRequest) (*CheckIndexTTLResponse, error)
+	// VerifyIndexBuild forwards the request to the IndexNode given by the nodeID
+	VerifyIndexBuild(context.Context, *VerifyIndexBuildRequest) (*VerifyIndexBuildResponse, error)
+}
+
+// UnimplementedIndexCoordServer can be embedded to have forward compatible implementations.
+type UnimplementedIndexCoordServer struct {
+}
+
+func (*UnimplementedIndexCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedIndexCoordServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetIndexState(ctx context.Context, req *GetIndexStateRequest) (*GetIndexStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexState not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetSegmentIndexState(ctx context.Context, req *GetSegmentIndexStateRequest) (*GetSegmentIndexStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIndexState not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetIndexInfos(ctx context.Context, req *GetIndexInfoRequest) (*GetIndexInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexInfos not implemented")
 }
 func (*UnimplementedIndexCoordServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
@@ -2373,6 +4008,30 @@ func (*UnimplementedIndexCoordServer) GetMetrics(ctx context.Context, req *milvu
 func (*UnimplementedIndexCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
 }
+func (*UnimplementedIndexCoordServer) CheckIndexConsistency(ctx context.Context, req *CheckIndexConsistencyRequest) (*CheckIndexConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndexConsistency not implemented")
+}
+func (*UnimplementedIndexCoordServer) GetIndexStatistics(ctx context.Context, req *GetIndexStatisticsRequest) (*GetIndexStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatistics not implemented")
+}
+func (*UnimplementedIndexCoordServer) CordonIndexNode(ctx context.Context, req *CordonIndexNodeRequest) (*CordonIndexNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonIndexNode not implemented")
+}
+func (*UnimplementedIndexCoordServer) TriggerGC(ctx context.Context, req *TriggerGCRequest) (*TriggerGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerGC not implemented")
+}
+func (*UnimplementedIndexCoordServer) ListIndexTTLs(ctx context.Context, req *ListIndexTTLsRequest) (*ListIndexTTLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexTTLs not implemented")
+}
+func (*UnimplementedIndexCoordServer) SetIndexTTL(ctx context.Context, req *SetIndexTTLRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexTTL not implemented")
+}
+func (*UnimplementedIndexCoordServer) CheckIndexTTL(ctx context.Context, req *CheckIndexTTLRequest) (*CheckIndexTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndexTTL not implemented")
+}
+func (*UnimplementedIndexCoordServer) VerifyIndexBuild(ctx context.Context, req *VerifyIndexBuildRequest) (*VerifyIndexBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIndexBuild not implemented")
+}
 
 func RegisterIndexCoordServer(s *grpc.Server, srv IndexCoordServer) {
 	s.RegisterService(&_IndexCoord_serviceDesc, srv)
@@ -2594,6 +4253,150 @@ func _IndexCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexCoord_CheckIndexConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIndexConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CheckIndexConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CheckIndexConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CheckIndexConsistency(ctx, req.(*CheckIndexConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_GetIndexStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).GetIndexStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/GetIndexStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).GetIndexStatistics(ctx, req.(*GetIndexStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_CordonIndexNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonIndexNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CordonIndexNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CordonIndexNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CordonIndexNode(ctx, req.(*CordonIndexNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_TriggerGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).TriggerGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/TriggerGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).TriggerGC(ctx, req.(*TriggerGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_ListIndexTTLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexTTLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ListIndexTTLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ListIndexTTLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ListIndexTTLs(ctx, req.(*ListIndexTTLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_SetIndexTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIndexTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).SetIndexTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/SetIndexTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).SetIndexTTL(ctx, req.(*SetIndexTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_CheckIndexTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIndexTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CheckIndexTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CheckIndexTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CheckIndexTTL(ctx, req.(*CheckIndexTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_VerifyIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).VerifyIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/VerifyIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).VerifyIndexBuild(ctx, req.(*VerifyIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.index.IndexCoord",
 	HandlerType: (*IndexCoordServer)(nil),
@@ -2646,6 +4449,38 @@ var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _IndexCoord_CheckHealth_Handler,
 		},
+		{
+			MethodName: "CheckIndexConsistency",
+			Handler:    _IndexCoord_CheckIndexConsistency_Handler,
+		},
+		{
+			MethodName: "GetIndexStatistics",
+			Handler:    _IndexCoord_GetIndexStatistics_Handler,
+		},
+		{
+			MethodName: "CordonIndexNode",
+			Handler:    _IndexCoord_CordonIndexNode_Handler,
+		},
+		{
+			MethodName: "TriggerGC",
+			Handler:    _IndexCoord_TriggerGC_Handler,
+		},
+		{
+			MethodName: "ListIndexTTLs",
+			Handler:    _IndexCoord_ListIndexTTLs_Handler,
+		},
+		{
+			MethodName: "SetIndexTTL",
+			Handler:    _IndexCoord_SetIndexTTL_Handler,
+		},
+		{
+			MethodName: "CheckIndexTTL",
+			Handler:    _IndexCoord_CheckIndexTTL_Handler,
+		},
+		{
+			MethodName: "VerifyIndexBuild",
+			Handler:    _IndexCoord_VerifyIndexBuild_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "index_coord.proto",
@@ -2664,6 +4499,8 @@ type IndexNodeClient interface {
 	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	// VerifyIndexBuild rebuilds an index recorded in reproducibility mode and compares the checksums of the index files
+	VerifyIndexBuild(ctx context.Context, in *VerifyIndexBuildRequest, opts ...grpc.CallOption) (*VerifyIndexBuildResponse, error)
 }
 
 type indexNodeClient struct {
@@ -2746,6 +4583,15 @@ func (c *indexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *indexNodeClient) VerifyIndexBuild(ctx context.Context, in *VerifyIndexBuildRequest, opts ...grpc.CallOption) (*VerifyIndexBuildResponse, error) {
+	out := new(VerifyIndexBuildResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/VerifyIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexNodeServer is the server API for IndexNode service.
 type IndexNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -2757,6 +4603,8 @@ type IndexNodeServer interface {
 	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	// VerifyIndexBuild rebuilds an index recorded in reproducibility mode and compares the checksums of the index files
+	VerifyIndexBuild(context.Context, *VerifyIndexBuildRequest) (*VerifyIndexBuildResponse, error)
 }
 
 // UnimplementedIndexNodeServer can be embedded to have forward compatible implementations.
@@ -2787,6 +4635,9 @@ func (*UnimplementedIndexNodeServer) ShowConfigurations(ctx context.Context, req
 func (*UnimplementedIndexNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedIndexNodeServer) VerifyIndexBuild(ctx context.Context, req *VerifyIndexBuildRequest) (*VerifyIndexBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIndexBuild not implemented")
+}
 
 func RegisterIndexNodeServer(s *grpc.Server, srv IndexNodeServer) {
 	s.RegisterService(&_IndexNode_serviceDesc, srv)
@@ -2936,6 +4787,24 @@ func _IndexNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_VerifyIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).VerifyIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/VerifyIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).VerifyIndexBuild(ctx, req.(*VerifyIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _IndexNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.index.IndexNode",
 	HandlerType: (*IndexNodeServer)(nil),
@@ -2972,6 +4841,10 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _IndexNode_GetMetrics_Handler,
 		},
+		{
+			MethodName: "VerifyIndexBuild",
+			Handler:    _IndexNode_VerifyIndexBuild_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "index_coord.proto",
@@ -97,6 +97,71 @@ func (RateType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_41f4a519b878ee3b, []int{1}
 }
 
+type IndexBuildEventType int32
+
+const (
+	IndexBuildEventType_BuildEventNone     IndexBuildEventType = 0
+	IndexBuildEventType_BuildEventEnqueued IndexBuildEventType = 1
+	IndexBuildEventType_BuildEventStarted  IndexBuildEventType = 2
+	IndexBuildEventType_BuildEventPhase    IndexBuildEventType = 3
+	IndexBuildEventType_BuildEventFinished IndexBuildEventType = 4
+	IndexBuildEventType_BuildEventFailed   IndexBuildEventType = 5
+)
+
+var IndexBuildEventType_name = map[int32]string{
+	0: "BuildEventNone",
+	1: "BuildEventEnqueued",
+	2: "BuildEventStarted",
+	3: "BuildEventPhase",
+	4: "BuildEventFinished",
+	5: "BuildEventFailed",
+}
+
+var IndexBuildEventType_value = map[string]int32{
+	"BuildEventNone":     0,
+	"BuildEventEnqueued": 1,
+	"BuildEventStarted":  2,
+	"BuildEventPhase":    3,
+	"BuildEventFinished": 4,
+	"BuildEventFailed":   5,
+}
+
+func (x IndexBuildEventType) String() string {
+	return proto.EnumName(IndexBuildEventType_name, int32(x))
+}
+
+func (IndexBuildEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{2}
+}
+
+type ResultPrecision int32
+
+const (
+	ResultPrecision_Float32  ResultPrecision = 0
+	ResultPrecision_Float16  ResultPrecision = 1
+	ResultPrecision_BFloat16 ResultPrecision = 2
+)
+
+var ResultPrecision_name = map[int32]string{
+	0: "Float32",
+	1: "Float16",
+	2: "BFloat16",
+}
+
+var ResultPrecision_value = map[string]int32{
+	"Float32":  0,
+	"Float16":  1,
+	"BFloat16": 2,
+}
+
+func (x ResultPrecision) String() string {
+	return proto.EnumName(ResultPrecision_name, int32(x))
+}
+
+func (ResultPrecision) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{3}
+}
+
 type GetTimeTickChannelRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1311,19 +1376,23 @@ type SearchRequest struct {
 	PartitionIDs []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl          string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Nq                   int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
-	Topk                 int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Nq                 int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk               int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType         string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
+	// the precision of the scores returned to proxy
+	ResultPrecision ResultPrecision `protobuf:"varint,17,opt,name=result_precision,json=resultPrecision,proto3,enum=milvus.proto.internal.ResultPrecision" json:"result_precision,omitempty"`
+	// count the filter execution statistics of the search
+	FilterStats          bool     `protobuf:"varint,18,opt,name=filter_stats,json=filterStats,proto3" json:"filter_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -1463,6 +1532,20 @@ func (m *SearchRequest) GetMetricType() string {
 	return ""
 }
 
+func (m *SearchRequest) GetResultPrecision() ResultPrecision {
+	if m != nil {
+		return m.ResultPrecision
+	}
+	return ResultPrecision_Float32
+}
+
+func (m *SearchRequest) GetFilterStats() bool {
+	if m != nil {
+		return m.FilterStats
+	}
+	return false
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1474,12 +1557,18 @@ type SearchResults struct {
 	ChannelIDsSearched       []string          `protobuf:"bytes,8,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64           `protobuf:"varint,9,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SlicedBlob      []byte          `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount  int64           `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset    int64           `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	ResultPrecision ResultPrecision `protobuf:"varint,13,opt,name=result_precision,json=resultPrecision,proto3,enum=milvus.proto.internal.ResultPrecision" json:"result_precision,omitempty"`
+	// the scores of sliced_blob encoded in result_precision, set when it's not Float32. The scores too large for
+	// Float16 are sent in Float32.
+	SlicedScores []byte `protobuf:"bytes,14,opt,name=sliced_scores,json=slicedScores,proto3" json:"sliced_scores,omitempty"`
+	// set when the request asks for filter_stats
+	FilterStats          *FilterStats `protobuf:"bytes,15,opt,name=filter_stats,json=filterStats,proto3" json:"filter_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
@@ -1591,21 +1680,46 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetResultPrecision() ResultPrecision {
+	if m != nil {
+		return m.ResultPrecision
+	}
+	return ResultPrecision_Float32
+}
+
+func (m *SearchResults) GetSlicedScores() []byte {
+	if m != nil {
+		return m.SlicedScores
+	}
+	return nil
+}
+
+func (m *SearchResults) GetFilterStats() *FilterStats {
+	if m != nil {
+		return m.FilterStats
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ReqID                int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Limit                int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReqID              int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Limit              int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	// count the filter execution statistics of the query
+	FilterStats bool `protobuf:"varint,12,opt,name=filter_stats,json=filterStats,proto3" json:"filter_stats,omitempty"`
+	// the precision of the float vectors returned to proxy
+	ResultPrecision      ResultPrecision `protobuf:"varint,13,opt,name=result_precision,json=resultPrecision,proto3,enum=milvus.proto.internal.ResultPrecision" json:"result_precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
@@ -1710,6 +1824,20 @@ func (m *RetrieveRequest) GetLimit() int64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetFilterStats() bool {
+	if m != nil {
+		return m.FilterStats
+	}
+	return false
+}
+
+func (m *RetrieveRequest) GetResultPrecision() ResultPrecision {
+	if m != nil {
+		return m.ResultPrecision
+	}
+	return ResultPrecision_Float32
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1719,9 +1847,15 @@ type RetrieveResults struct {
 	SealedSegmentIDsRetrieved []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
 	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
 	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}              `json:"-"`
-	XXX_unrecognized          []byte                `json:"-"`
-	XXX_sizecache             int32                 `json:"-"`
+	// set when the request asks for filter_stats
+	FilterStats     *FilterStats    `protobuf:"bytes,9,opt,name=filter_stats,json=filterStats,proto3" json:"filter_stats,omitempty"`
+	ResultPrecision ResultPrecision `protobuf:"varint,10,opt,name=result_precision,json=resultPrecision,proto3,enum=milvus.proto.internal.ResultPrecision" json:"result_precision,omitempty"`
+	// the float vectors of fields_data encoded in result_precision by field id, the vectors of a field too large for
+	// the precision are kept in fields_data
+	ReducedVectors       map[int64][]byte `protobuf:"bytes,11,rep,name=reduced_vectors,json=reducedVectors,proto3" json:"reduced_vectors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
@@ -1805,6 +1939,109 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *RetrieveResults) GetFilterStats() *FilterStats {
+	if m != nil {
+		return m.FilterStats
+	}
+	return nil
+}
+
+func (m *RetrieveResults) GetResultPrecision() ResultPrecision {
+	if m != nil {
+		return m.ResultPrecision
+	}
+	return ResultPrecision_Float32
+}
+
+func (m *RetrieveResults) GetReducedVectors() map[int64][]byte {
+	if m != nil {
+		return m.ReducedVectors
+	}
+	return nil
+}
+
+// FilterStats is the filter execution statistics of a search or query, summed over the segments searched.
+// A segment filtered on scalar indexes takes the index branch, otherwise the brute force branch,
+// rows_passed is counted by query only since the filtered rows of a search are not visible outside segcore.
+type FilterStats struct {
+	SegmentsScanned      int64    `protobuf:"varint,1,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	SegmentsPruned       int64    `protobuf:"varint,2,opt,name=segments_pruned,json=segmentsPruned,proto3" json:"segments_pruned,omitempty"`
+	RowsScanned          int64    `protobuf:"varint,3,opt,name=rows_scanned,json=rowsScanned,proto3" json:"rows_scanned,omitempty"`
+	RowsPassed           int64    `protobuf:"varint,4,opt,name=rows_passed,json=rowsPassed,proto3" json:"rows_passed,omitempty"`
+	IndexSegments        int64    `protobuf:"varint,5,opt,name=index_segments,json=indexSegments,proto3" json:"index_segments,omitempty"`
+	BruteForceSegments   int64    `protobuf:"varint,6,opt,name=brute_force_segments,json=bruteForceSegments,proto3" json:"brute_force_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilterStats) Reset()         { *m = FilterStats{} }
+func (m *FilterStats) String() string { return proto.CompactTextString(m) }
+func (*FilterStats) ProtoMessage()    {}
+func (*FilterStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
+}
+
+func (m *FilterStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterStats.Unmarshal(m, b)
+}
+func (m *FilterStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterStats.Marshal(b, m, deterministic)
+}
+func (m *FilterStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterStats.Merge(m, src)
+}
+func (m *FilterStats) XXX_Size() int {
+	return xxx_messageInfo_FilterStats.Size(m)
+}
+func (m *FilterStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterStats proto.InternalMessageInfo
+
+func (m *FilterStats) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *FilterStats) GetSegmentsPruned() int64 {
+	if m != nil {
+		return m.SegmentsPruned
+	}
+	return 0
+}
+
+func (m *FilterStats) GetRowsScanned() int64 {
+	if m != nil {
+		return m.RowsScanned
+	}
+	return 0
+}
+
+func (m *FilterStats) GetRowsPassed() int64 {
+	if m != nil {
+		return m.RowsPassed
+	}
+	return 0
+}
+
+func (m *FilterStats) GetIndexSegments() int64 {
+	if m != nil {
+		return m.IndexSegments
+	}
+	return 0
+}
+
+func (m *FilterStats) GetBruteForceSegments() int64 {
+	if m != nil {
+		return m.BruteForceSegments
+	}
+	return 0
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1827,7 +2064,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1948,7 +2185,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -2023,7 +2260,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2071,7 +2308,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2127,7 +2364,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2190,7 +2427,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2253,7 +2490,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2319,7 +2556,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -2387,7 +2624,7 @@ func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
 func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyRequest) ProtoMessage()    {}
 func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
@@ -2429,7 +2666,7 @@ func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
 func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyResponse) ProtoMessage()    {}
 func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
@@ -2483,7 +2720,7 @@ func (m *ShowConfigurationsRequest) Reset()         { *m = ShowConfigurationsReq
 func (m *ShowConfigurationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsRequest) ProtoMessage()    {}
 func (*ShowConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *ShowConfigurationsRequest) XXX_Unmarshal(b []byte) error {
@@ -2530,7 +2767,7 @@ func (m *ShowConfigurationsResponse) Reset()         { *m = ShowConfigurationsRe
 func (m *ShowConfigurationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsResponse) ProtoMessage()    {}
 func (*ShowConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{33}
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
 }
 
 func (m *ShowConfigurationsResponse) XXX_Unmarshal(b []byte) error {
@@ -2577,7 +2814,7 @@ func (m *Rate) Reset()         { *m = Rate{} }
 func (m *Rate) String() string { return proto.CompactTextString(m) }
 func (*Rate) ProtoMessage()    {}
 func (*Rate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{34}
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
 }
 
 func (m *Rate) XXX_Unmarshal(b []byte) error {
@@ -2612,9 +2849,169 @@ func (m *Rate) GetR() float64 {
 	return 0
 }
 
+// IndexBuildEvent is a lifecycle transition of an index build task,
+// published by IndexNode to the indexNodeBuildEvent channel.
+type IndexBuildEvent struct {
+	Base         *commonpb.MsgBase   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	EventType    IndexBuildEventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=milvus.proto.internal.IndexBuildEventType" json:"event_type,omitempty"`
+	ClusterID    string              `protobuf:"bytes,3,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	BuildID      int64               `protobuf:"varint,4,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	NodeID       int64               `protobuf:"varint,5,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID int64               `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID    int64               `protobuf:"varint,7,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID      int64               `protobuf:"varint,8,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	// the build phase entered, for the phase events
+	Phase      string              `protobuf:"bytes,9,opt,name=phase,proto3" json:"phase,omitempty"`
+	State      commonpb.IndexState `protobuf:"varint,10,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason string              `protobuf:"bytes,11,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	// the class of the fail reason, for the failed events
+	FailClass string `protobuf:"bytes,12,opt,name=fail_class,json=failClass,proto3" json:"fail_class,omitempty"`
+	// the size of the index files, for the finished events
+	SerializedSize uint64 `protobuf:"varint,13,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	// the time spent since the previous event of the task
+	DurationMs int64 `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// the time spent since the task was enqueued
+	ElapsedMs            int64    `protobuf:"varint,15,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexBuildEvent) Reset()         { *m = IndexBuildEvent{} }
+func (m *IndexBuildEvent) String() string { return proto.CompactTextString(m) }
+func (*IndexBuildEvent) ProtoMessage()    {}
+func (*IndexBuildEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{36}
+}
+
+func (m *IndexBuildEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexBuildEvent.Unmarshal(m, b)
+}
+func (m *IndexBuildEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexBuildEvent.Marshal(b, m, deterministic)
+}
+func (m *IndexBuildEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexBuildEvent.Merge(m, src)
+}
+func (m *IndexBuildEvent) XXX_Size() int {
+	return xxx_messageInfo_IndexBuildEvent.Size(m)
+}
+func (m *IndexBuildEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexBuildEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexBuildEvent proto.InternalMessageInfo
+
+func (m *IndexBuildEvent) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *IndexBuildEvent) GetEventType() IndexBuildEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return IndexBuildEventType_BuildEventNone
+}
+
+func (m *IndexBuildEvent) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *IndexBuildEvent) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *IndexBuildEvent) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *IndexBuildEvent) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *IndexBuildEvent) GetFailClass() string {
+	if m != nil {
+		return m.FailClass
+	}
+	return ""
+}
+
+func (m *IndexBuildEvent) GetSerializedSize() uint64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *IndexBuildEvent) GetElapsedMs() int64 {
+	if m != nil {
+		return m.ElapsedMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.InsertDataVersion", InsertDataVersion_name, InsertDataVersion_value)
 	proto.RegisterEnum("milvus.proto.internal.RateType", RateType_name, RateType_value)
+	proto.RegisterEnum("milvus.proto.internal.IndexBuildEventType", IndexBuildEventType_name, IndexBuildEventType_value)
+	proto.RegisterEnum("milvus.proto.internal.ResultPrecision", ResultPrecision_name, ResultPrecision_value)
 	proto.RegisterType((*GetTimeTickChannelRequest)(nil), "milvus.proto.internal.GetTimeTickChannelRequest")
 	proto.RegisterType((*GetStatisticsChannelRequest)(nil), "milvus.proto.internal.GetStatisticsChannelRequest")
 	proto.RegisterType((*GetDdChannelRequest)(nil), "milvus.proto.internal.GetDdChannelRequest")
@@ -2637,6 +3034,8 @@ func init() {
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
+	proto.RegisterMapType((map[int64][]byte)(nil), "milvus.proto.internal.RetrieveResults.ReducedVectorsEntry")
+	proto.RegisterType((*FilterStats)(nil), "milvus.proto.internal.FilterStats")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
 	proto.RegisterType((*LoadIndex)(nil), "milvus.proto.internal.LoadIndex")
 	proto.RegisterType((*IndexStats)(nil), "milvus.proto.internal.IndexStats")
@@ -2650,148 +3049,184 @@ func init() {
 	proto.RegisterType((*ShowConfigurationsRequest)(nil), "milvus.proto.internal.ShowConfigurationsRequest")
 	proto.RegisterType((*ShowConfigurationsResponse)(nil), "milvus.proto.internal.ShowConfigurationsResponse")
 	proto.RegisterType((*Rate)(nil), "milvus.proto.internal.Rate")
+	proto.RegisterType((*IndexBuildEvent)(nil), "milvus.proto.internal.IndexBuildEvent")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x5f, 0x8a, 0x92, 0x25, 0x3d, 0xc9, 0x0a, 0x3d, 0x71, 0xb2, 0x8c, 0x93, 0x6c, 0x1c, 0xf6,
-	0xcb, 0x4d, 0xba, 0x49, 0xea, 0xdd, 0x4d, 0x0a, 0xb4, 0xe8, 0x22, 0xb6, 0xb2, 0x81, 0x11, 0x3b,
-	0x75, 0xe8, 0x20, 0x40, 0x7b, 0x21, 0x46, 0xe2, 0x58, 0x9a, 0x86, 0xe4, 0x30, 0x33, 0x43, 0x3b,
-	0xca, 0xa9, 0x87, 0x9e, 0xba, 0x68, 0x6f, 0xbd, 0x14, 0x68, 0xcf, 0x45, 0x81, 0x02, 0xbd, 0xed,
-	0xb1, 0x40, 0x4f, 0x3d, 0xf5, 0xd4, 0xbf, 0xa6, 0xe8, 0xa1, 0x98, 0x19, 0x92, 0xfa, 0xb0, 0xe2,
-	0xd8, 0x0e, 0x76, 0x37, 0x05, 0xf6, 0xc6, 0x79, 0xef, 0xcd, 0xd7, 0xef, 0xfd, 0xde, 0xe3, 0x7b,
-	0x24, 0x74, 0x68, 0x22, 0x09, 0x4f, 0x70, 0x74, 0x2b, 0xe5, 0x4c, 0x32, 0x74, 0x21, 0xa6, 0xd1,
-	0x41, 0x26, 0xcc, 0xe8, 0x56, 0xa1, 0x5c, 0x69, 0xf7, 0x59, 0x1c, 0xb3, 0xc4, 0x88, 0x57, 0xda,
-	0xa2, 0x3f, 0x24, 0x31, 0x36, 0x23, 0xef, 0x32, 0x5c, 0x7a, 0x48, 0xe4, 0x53, 0x1a, 0x93, 0xa7,
-	0xb4, 0xff, 0x7c, 0x73, 0x88, 0x93, 0x84, 0x44, 0x3e, 0x79, 0x91, 0x11, 0x21, 0xbd, 0xab, 0x70,
-	0xf9, 0x21, 0x91, 0x7b, 0x12, 0x4b, 0x2a, 0x24, 0xed, 0x8b, 0x19, 0xf5, 0x05, 0x38, 0xff, 0x90,
-	0xc8, 0x6e, 0x38, 0x23, 0x7e, 0x06, 0x8d, 0xc7, 0x2c, 0x24, 0x5b, 0xc9, 0x3e, 0x43, 0x77, 0xa1,
-	0x8e, 0xc3, 0x90, 0x13, 0x21, 0x5c, 0x6b, 0xd5, 0x5a, 0x6b, 0xad, 0x5f, 0xb9, 0x35, 0x75, 0xc6,
-	0xfc, 0x64, 0xf7, 0x8d, 0x8d, 0x5f, 0x18, 0x23, 0x04, 0x55, 0xce, 0x22, 0xe2, 0x56, 0x56, 0xad,
-	0xb5, 0xa6, 0xaf, 0x9f, 0xbd, 0x5f, 0x02, 0x6c, 0x25, 0x54, 0xee, 0x62, 0x8e, 0x63, 0x81, 0x2e,
-	0xc2, 0x42, 0xa2, 0x76, 0xe9, 0xea, 0x85, 0x6d, 0x3f, 0x1f, 0xa1, 0x2e, 0xb4, 0x85, 0xc4, 0x5c,
-	0x06, 0xa9, 0xb6, 0x73, 0x2b, 0xab, 0xf6, 0x5a, 0x6b, 0xfd, 0xfa, 0xdc, 0x6d, 0x1f, 0x91, 0xd1,
-	0x33, 0x1c, 0x65, 0x64, 0x17, 0x53, 0xee, 0xb7, 0xf4, 0x34, 0xb3, 0xba, 0xf7, 0x73, 0x80, 0x3d,
-	0xc9, 0x69, 0x32, 0xd8, 0xa6, 0x42, 0xaa, 0xbd, 0x0e, 0x94, 0x9d, 0xba, 0x84, 0xbd, 0xd6, 0xf4,
-	0xf3, 0x11, 0xfa, 0x08, 0x16, 0x84, 0xc4, 0x32, 0x13, 0xfa, 0x9c, 0xad, 0xf5, 0xcb, 0x73, 0x77,
-	0xd9, 0xd3, 0x26, 0x7e, 0x6e, 0xea, 0x7d, 0x0a, 0xad, 0x02, 0xee, 0x1d, 0x31, 0x40, 0x77, 0xa0,
-	0xda, 0xc3, 0x82, 0x1c, 0x0b, 0xcf, 0x8e, 0x18, 0x6c, 0x60, 0x41, 0x7c, 0x6d, 0xe9, 0xfd, 0xb5,
-	0x02, 0xcb, 0x53, 0x6e, 0xc9, 0x81, 0x3f, 0xfd, 0x52, 0x0a, 0xe6, 0xb0, 0xb7, 0xd5, 0xd5, 0xc7,
-	0xb7, 0x7d, 0xfd, 0x8c, 0x3c, 0x68, 0xf7, 0x59, 0x14, 0x91, 0xbe, 0xa4, 0x2c, 0xd9, 0xea, 0xba,
-	0xb6, 0xd6, 0x4d, 0xc9, 0x94, 0x4d, 0x8a, 0xb9, 0xa4, 0x66, 0x28, 0xdc, 0xea, 0xaa, 0xad, 0x6c,
-	0x26, 0x65, 0xe8, 0xfb, 0xe0, 0x48, 0x8e, 0x0f, 0x48, 0x14, 0x48, 0x1a, 0x13, 0x21, 0x71, 0x9c,
-	0xba, 0xb5, 0x55, 0x6b, 0xad, 0xea, 0x9f, 0x33, 0xf2, 0xa7, 0x85, 0x18, 0xdd, 0x86, 0xf3, 0x83,
-	0x0c, 0x73, 0x9c, 0x48, 0x42, 0x26, 0xac, 0x17, 0xb4, 0x35, 0x2a, 0x55, 0xe3, 0x09, 0x37, 0x61,
-	0x49, 0x99, 0xb1, 0x4c, 0x4e, 0x98, 0xd7, 0xb5, 0xb9, 0x93, 0x2b, 0x4a, 0x63, 0xef, 0x0b, 0x0b,
-	0x2e, 0xcc, 0xe0, 0x25, 0x52, 0x96, 0x08, 0x72, 0x06, 0xc0, 0xce, 0xe2, 0x71, 0x74, 0x0f, 0x6a,
-	0xea, 0x49, 0xb8, 0xf6, 0x49, 0xb9, 0x68, 0xec, 0xbd, 0xdf, 0xd8, 0xf0, 0xfe, 0x26, 0x27, 0x58,
-	0x92, 0xcd, 0x12, 0xfd, 0xb3, 0x3b, 0xfb, 0x7d, 0xa8, 0x87, 0xbd, 0x20, 0xc1, 0x71, 0x11, 0x56,
-	0x0b, 0x61, 0xef, 0x31, 0x8e, 0x09, 0xfa, 0x2e, 0x74, 0xc6, 0xde, 0x55, 0x12, 0xed, 0xf3, 0xa6,
-	0x3f, 0x23, 0x45, 0xdf, 0x86, 0xc5, 0xd2, 0xc3, 0xda, 0xac, 0xaa, 0xcd, 0xa6, 0x85, 0x25, 0xa7,
-	0x6a, 0xc7, 0x70, 0x6a, 0x61, 0x0e, 0xa7, 0x56, 0xa1, 0x35, 0xc1, 0x1f, 0xed, 0x4d, 0xdb, 0x9f,
-	0x14, 0xa9, 0x30, 0x34, 0xb9, 0xcb, 0x6d, 0xac, 0x5a, 0x6b, 0x6d, 0x3f, 0x1f, 0xa1, 0x3b, 0x70,
-	0xfe, 0x80, 0x72, 0x99, 0xe1, 0x28, 0xcf, 0x44, 0xea, 0x1c, 0xc2, 0x6d, 0xea, 0x58, 0x9d, 0xa7,
-	0x42, 0xeb, 0xb0, 0x9c, 0x0e, 0x47, 0x82, 0xf6, 0x67, 0xa6, 0x80, 0x9e, 0x32, 0x57, 0xe7, 0xfd,
-	0xc3, 0x82, 0x0b, 0x5d, 0xce, 0xd2, 0x77, 0xc2, 0x15, 0x05, 0xc8, 0xd5, 0x63, 0x40, 0xae, 0x1d,
-	0x05, 0xd9, 0xfb, 0x6d, 0x05, 0x2e, 0x1a, 0x46, 0xed, 0x16, 0xc0, 0x7e, 0x09, 0xb7, 0xf8, 0x1e,
-	0x9c, 0x1b, 0xef, 0x6a, 0x0c, 0xe6, 0x5f, 0xe3, 0x3b, 0xd0, 0x29, 0x1d, 0x6c, 0xec, 0xbe, 0x5a,
-	0x4a, 0x79, 0x9f, 0x57, 0x60, 0x59, 0x39, 0xf5, 0x1b, 0x34, 0x14, 0x1a, 0x7f, 0xb2, 0x00, 0x19,
-	0x76, 0xdc, 0x8f, 0x28, 0x16, 0x5f, 0x27, 0x16, 0xcb, 0x50, 0xc3, 0xea, 0x0c, 0x39, 0x04, 0x66,
-	0xe0, 0x09, 0x70, 0x94, 0xb7, 0xbe, 0xac, 0xd3, 0x95, 0x9b, 0xda, 0x93, 0x9b, 0xfe, 0xd1, 0x82,
-	0xa5, 0xfb, 0x91, 0x24, 0xfc, 0x1d, 0x05, 0xe5, 0xef, 0x95, 0xc2, 0x6b, 0x5b, 0x49, 0x48, 0x5e,
-	0x7e, 0x9d, 0x07, 0xbc, 0x0a, 0xb0, 0x4f, 0x49, 0x14, 0x4e, 0xb2, 0xb7, 0xa9, 0x25, 0x6f, 0xc5,
-	0x5c, 0x17, 0xea, 0x7a, 0x91, 0x92, 0xb5, 0xc5, 0x50, 0x55, 0x7b, 0xe4, 0xa5, 0xe4, 0xb8, 0xa8,
-	0xf6, 0x1a, 0x27, 0xae, 0xf6, 0xf4, 0xb4, 0xbc, 0xda, 0xfb, 0x57, 0x15, 0x16, 0xb7, 0x12, 0x41,
-	0xb8, 0x3c, 0x3b, 0x78, 0x57, 0xa0, 0x29, 0x86, 0x98, 0xeb, 0x8b, 0xe6, 0xf0, 0x8d, 0x05, 0x93,
-	0xd0, 0xda, 0x6f, 0x82, 0xb6, 0x7a, 0xc2, 0xe4, 0x50, 0x3b, 0x2e, 0x39, 0x2c, 0x1c, 0x03, 0x71,
-	0xfd, 0xcd, 0xc9, 0xa1, 0x71, 0xf4, 0xed, 0xab, 0x2e, 0x48, 0x06, 0x31, 0x49, 0xe4, 0x56, 0xd7,
-	0x6d, 0x6a, 0xfd, 0x58, 0x80, 0x3e, 0x00, 0x28, 0x2b, 0x31, 0xf3, 0x1e, 0xad, 0xfa, 0x13, 0x12,
-	0xf5, 0xee, 0xe6, 0xec, 0x50, 0xd5, 0x8a, 0x2d, 0x5d, 0x2b, 0xe6, 0x23, 0xf4, 0x31, 0x34, 0x38,
-	0x3b, 0x0c, 0x42, 0x2c, 0xb1, 0xdb, 0xd6, 0xce, 0xbb, 0x34, 0x17, 0xec, 0x8d, 0x88, 0xf5, 0xfc,
-	0x3a, 0x67, 0x87, 0x5d, 0x2c, 0x31, 0xfa, 0x14, 0x5a, 0x9a, 0x01, 0xc2, 0x4c, 0x5c, 0xd4, 0x13,
-	0x3f, 0x98, 0x9e, 0x98, 0xb7, 0x39, 0x9f, 0x29, 0x3b, 0x35, 0xc9, 0x37, 0xd4, 0x14, 0x7a, 0x81,
-	0x4b, 0xd0, 0x48, 0xb2, 0x38, 0xe0, 0xec, 0x50, 0xb8, 0x1d, 0x5d, 0x37, 0xd6, 0x93, 0x2c, 0xf6,
-	0xd9, 0xa1, 0x40, 0x1b, 0x50, 0x3f, 0x20, 0x5c, 0x50, 0x96, 0xb8, 0xe7, 0x56, 0xad, 0xb5, 0xce,
-	0xfa, 0xda, 0xad, 0xb9, 0x6d, 0xd5, 0x2d, 0xc3, 0x18, 0xb5, 0xdc, 0x33, 0x63, 0xef, 0x17, 0x13,
-	0xbd, 0x7f, 0x57, 0x61, 0x71, 0x8f, 0x60, 0xde, 0x1f, 0x9e, 0x9d, 0x50, 0xcb, 0x50, 0xe3, 0xe4,
-	0x45, 0x59, 0x9c, 0x9b, 0x41, 0xe9, 0x5f, 0xfb, 0x18, 0xff, 0x56, 0x4f, 0x50, 0xb1, 0xd7, 0xe6,
-	0x54, 0xec, 0x0e, 0xd8, 0xa1, 0x88, 0x34, 0x75, 0x9a, 0xbe, 0x7a, 0x54, 0x75, 0x76, 0x1a, 0xe1,
-	0x3e, 0x19, 0xb2, 0x28, 0x24, 0x3c, 0x18, 0x70, 0x96, 0x99, 0x3a, 0xbb, 0xed, 0x3b, 0x13, 0x8a,
-	0x87, 0x4a, 0x8e, 0xee, 0x41, 0x23, 0x14, 0x51, 0x20, 0x47, 0x29, 0xd1, 0xfc, 0xe9, 0xbc, 0xe6,
-	0x9a, 0x5d, 0x11, 0x3d, 0x1d, 0xa5, 0xc4, 0xaf, 0x87, 0xe6, 0x01, 0xdd, 0x81, 0x65, 0x41, 0x38,
-	0xc5, 0x11, 0x7d, 0x45, 0xc2, 0x80, 0xbc, 0x4c, 0x79, 0x90, 0x46, 0x38, 0xd1, 0x24, 0x6b, 0xfb,
-	0x68, 0xac, 0x7b, 0xf0, 0x32, 0xe5, 0xbb, 0x11, 0x4e, 0xd0, 0x1a, 0x38, 0x2c, 0x93, 0x69, 0x26,
-	0x83, 0x9c, 0x06, 0x34, 0xd4, 0x9c, 0xb3, 0xfd, 0x8e, 0x91, 0x6b, 0xaf, 0x8b, 0xad, 0x70, 0x6e,
-	0x17, 0xd2, 0x3a, 0x55, 0x17, 0xd2, 0x3e, 0x5d, 0x17, 0xb2, 0x38, 0xbf, 0x0b, 0x41, 0x1d, 0xa8,
-	0x24, 0x2f, 0x34, 0xd7, 0x6c, 0xbf, 0x92, 0xbc, 0x50, 0x8e, 0x94, 0x2c, 0x7d, 0xae, 0x39, 0x66,
-	0xfb, 0xfa, 0x59, 0x05, 0x51, 0x4c, 0x24, 0xa7, 0x7d, 0x05, 0x8b, 0xeb, 0x68, 0x3f, 0x4c, 0x48,
-	0xbc, 0xff, 0xda, 0x63, 0x5a, 0x89, 0x2c, 0x92, 0xe2, 0xab, 0xea, 0x60, 0x4a, 0x2e, 0xda, 0x93,
-	0x5c, 0xbc, 0x06, 0x2d, 0x73, 0x38, 0xe3, 0xf3, 0xea, 0xec, 0x79, 0x95, 0x81, 0x8a, 0xb2, 0x17,
-	0x19, 0xe1, 0x94, 0x88, 0x3c, 0xed, 0x43, 0x92, 0xc5, 0x4f, 0x8c, 0x04, 0x9d, 0x87, 0x9a, 0x64,
-	0x69, 0xf0, 0xbc, 0x48, 0x57, 0x92, 0xa5, 0x8f, 0xd0, 0x4f, 0x60, 0x45, 0x10, 0x1c, 0x91, 0x30,
-	0x28, 0xd3, 0x8b, 0x08, 0x84, 0xbe, 0x36, 0x09, 0xdd, 0xba, 0x76, 0xb3, 0x6b, 0x2c, 0xf6, 0x4a,
-	0x83, 0xbd, 0x5c, 0xaf, 0xbc, 0xd8, 0x37, 0x65, 0xfb, 0xd4, 0xb4, 0x86, 0xae, 0xec, 0xd1, 0x58,
-	0x55, 0x4e, 0xf8, 0x11, 0xb8, 0x83, 0x88, 0xf5, 0x70, 0x14, 0x1c, 0xd9, 0x55, 0xb7, 0x10, 0xb6,
-	0x7f, 0xd1, 0xe8, 0xf7, 0x66, 0xb6, 0x54, 0xd7, 0x13, 0x11, 0xed, 0x93, 0x30, 0xe8, 0x45, 0xac,
-	0xe7, 0x82, 0xa6, 0x2b, 0x18, 0x91, 0xca, 0x57, 0x8a, 0xa6, 0xb9, 0x81, 0x82, 0xa1, 0xcf, 0xb2,
-	0x44, 0x6a, 0xf2, 0xd9, 0x7e, 0xc7, 0xc8, 0x1f, 0x67, 0xf1, 0xa6, 0x92, 0xa2, 0x6f, 0xc1, 0x62,
-	0x6e, 0xc9, 0xf6, 0xf7, 0x05, 0x91, 0x9a, 0x75, 0xb6, 0xdf, 0x36, 0xc2, 0x9f, 0x69, 0x99, 0xf7,
-	0x37, 0x1b, 0xce, 0xf9, 0x0a, 0x5d, 0x72, 0x40, 0xfe, 0x9f, 0xf2, 0xca, 0xeb, 0xe2, 0x7b, 0xe1,
-	0x54, 0xf1, 0x5d, 0x3f, 0x71, 0x7c, 0x37, 0x4e, 0x15, 0xdf, 0xcd, 0xd3, 0xc5, 0x37, 0xbc, 0x26,
-	0xbe, 0x97, 0xa1, 0x16, 0xd1, 0x98, 0x16, 0x0e, 0x36, 0x03, 0xef, 0xcf, 0x53, 0x2e, 0x7b, 0x07,
-	0x62, 0xf6, 0x06, 0xd8, 0x34, 0x34, 0x05, 0x64, 0x6b, 0xdd, 0x9d, 0xfb, 0xc6, 0xdc, 0xea, 0x0a,
-	0x5f, 0x19, 0xcd, 0xbe, 0x65, 0x6b, 0xa7, 0x7e, 0xcb, 0xfe, 0x14, 0x2e, 0x1f, 0x8d, 0x64, 0x9e,
-	0xc3, 0x11, 0xba, 0x0b, 0xda, 0xa3, 0x97, 0x66, 0x43, 0xb9, 0xc0, 0x2b, 0x44, 0x3f, 0x84, 0xe5,
-	0x89, 0x58, 0x1e, 0x4f, 0xac, 0x9b, 0xce, 0x7e, 0xac, 0x1b, 0x4f, 0x39, 0x2e, 0x9a, 0x1b, 0xc7,
-	0x45, 0xb3, 0xf7, 0x4f, 0x1b, 0x16, 0xbb, 0x24, 0x22, 0x92, 0x7c, 0x53, 0x04, 0xbe, 0xb6, 0x08,
-	0xfc, 0x01, 0x20, 0x9a, 0xc8, 0xbb, 0x1f, 0x07, 0x29, 0xa7, 0x31, 0xe6, 0xa3, 0xe0, 0x39, 0x19,
-	0x15, 0x69, 0xd2, 0xd1, 0x9a, 0x5d, 0xa3, 0x78, 0x44, 0x46, 0xe2, 0x8d, 0x45, 0xe1, 0x64, 0x15,
-	0x66, 0xc2, 0xa6, 0xac, 0xc2, 0x7e, 0x0c, 0xed, 0xa9, 0x2d, 0xda, 0x6f, 0x20, 0x6c, 0x2b, 0x1d,
-	0xef, 0xeb, 0xfd, 0xc7, 0x82, 0xe6, 0x36, 0xc3, 0xa1, 0xee, 0x87, 0xce, 0xe8, 0xc6, 0xb2, 0xd4,
-	0xad, 0xcc, 0x96, 0xba, 0x57, 0x60, 0xdc, 0xd2, 0xe4, 0x8e, 0x9c, 0xe8, 0x71, 0x26, 0x7a, 0x95,
-	0xea, 0x74, 0xaf, 0x72, 0x0d, 0x5a, 0x54, 0x1d, 0x28, 0x48, 0xb1, 0x1c, 0x9a, 0x4c, 0xd9, 0xf4,
-	0x41, 0x8b, 0x76, 0x95, 0x44, 0x35, 0x33, 0x85, 0x81, 0x6e, 0x66, 0x16, 0x4e, 0xdc, 0xcc, 0xe4,
-	0x8b, 0xe8, 0x66, 0xe6, 0xd7, 0x16, 0x80, 0xbe, 0xb8, 0xca, 0x07, 0x47, 0x17, 0xb5, 0xce, 0xb2,
-	0xa8, 0x4a, 0xe1, 0xda, 0x53, 0x24, 0xc2, 0x72, 0x1c, 0x54, 0x22, 0x07, 0x07, 0x29, 0xaf, 0x19,
-	0x55, 0x1e, 0x50, 0xc2, 0xfb, 0x9d, 0x05, 0xa0, 0xb3, 0x82, 0x39, 0xc6, 0x2c, 0xfd, 0xac, 0xe3,
-	0xdb, 0xbc, 0xca, 0x34, 0x74, 0x1b, 0x05, 0x74, 0xc7, 0x7c, 0x47, 0x9d, 0xa8, 0xcb, 0x8b, 0xcb,
-	0xe7, 0xe8, 0xea, 0x67, 0xef, 0xf7, 0x16, 0xb4, 0xf3, 0xd3, 0x99, 0x23, 0x4d, 0x79, 0xd9, 0x9a,
-	0xf5, 0xb2, 0x2e, 0x6e, 0x62, 0xc6, 0x47, 0x81, 0xa0, 0xaf, 0x48, 0x7e, 0x20, 0x30, 0xa2, 0x3d,
-	0xfa, 0x8a, 0x4c, 0x91, 0xd7, 0x9e, 0x26, 0xef, 0x4d, 0x58, 0xe2, 0xa4, 0x4f, 0x12, 0x19, 0x8d,
-	0x82, 0x98, 0x85, 0x74, 0x9f, 0x92, 0x50, 0xb3, 0xa1, 0xe1, 0x3b, 0x85, 0x62, 0x27, 0x97, 0x7b,
-	0xbf, 0xb2, 0xa0, 0xb5, 0x23, 0x06, 0xbb, 0x4c, 0xe8, 0x20, 0x43, 0xd7, 0xa1, 0x9d, 0x27, 0x36,
-	0x13, 0xe1, 0x96, 0x66, 0x58, 0xab, 0x3f, 0xfe, 0x16, 0xa9, 0x52, 0x7b, 0x2c, 0x06, 0x39, 0x4c,
-	0x6d, 0xdf, 0x0c, 0xd0, 0x0a, 0x34, 0x62, 0x31, 0xd0, 0xb5, 0x78, 0x4e, 0xcb, 0x72, 0xac, 0xee,
-	0x3a, 0x7e, 0x85, 0x55, 0xf5, 0x2b, 0x6c, 0x2c, 0xf0, 0xbe, 0xb0, 0x00, 0xe5, 0xdf, 0x3a, 0xdf,
-	0xea, 0xd7, 0x84, 0xf6, 0xf2, 0xe4, 0xf7, 0xd4, 0x8a, 0xe6, 0xf8, 0x94, 0x6c, 0x26, 0x29, 0xd8,
-	0x47, 0x92, 0xc2, 0x4d, 0x58, 0x0a, 0xc9, 0x3e, 0xce, 0xa2, 0xc9, 0xb7, 0xae, 0x39, 0xb2, 0x93,
-	0x2b, 0xa6, 0xbe, 0xed, 0x77, 0x36, 0x39, 0x09, 0x49, 0x22, 0x29, 0x8e, 0xf4, 0x2f, 0xa7, 0x15,
-	0x68, 0x64, 0x42, 0x31, 0xa1, 0xc4, 0xae, 0x1c, 0xa3, 0x0f, 0x01, 0x91, 0xa4, 0xcf, 0x47, 0xa9,
-	0x22, 0x71, 0x8a, 0x85, 0x38, 0x64, 0x3c, 0xcc, 0x13, 0xf5, 0x52, 0xa9, 0xd9, 0xcd, 0x15, 0xaa,
-	0x69, 0x95, 0x24, 0xc1, 0x89, 0x2c, 0xf2, 0xb5, 0x19, 0x29, 0xd7, 0x53, 0x11, 0x88, 0x2c, 0x25,
-	0x3c, 0x77, 0x6b, 0x9d, 0x8a, 0x3d, 0x35, 0x54, 0xa9, 0x5c, 0x0c, 0xf1, 0xfa, 0x27, 0x77, 0xc7,
-	0xcb, 0x9b, 0x14, 0xdd, 0x31, 0xe2, 0x62, 0x6d, 0xef, 0x01, 0x2c, 0x6d, 0x53, 0x21, 0x77, 0x59,
-	0x44, 0xfb, 0xa3, 0x33, 0xbf, 0x71, 0xbc, 0xcf, 0x2d, 0x40, 0x93, 0xeb, 0xe4, 0x7f, 0x36, 0xc6,
-	0x15, 0x83, 0x75, 0xf2, 0x8a, 0xe1, 0x3a, 0xb4, 0x53, 0xbd, 0x4c, 0x40, 0x93, 0x7d, 0x56, 0x78,
-	0xaf, 0x65, 0x64, 0x0a, 0x5b, 0x81, 0xae, 0x02, 0x28, 0x30, 0x03, 0xce, 0x22, 0x62, 0x9c, 0xd7,
-	0xf4, 0x9b, 0x4a, 0xe2, 0x2b, 0x81, 0x37, 0x80, 0x4b, 0x7b, 0x43, 0x76, 0xb8, 0xc9, 0x92, 0x7d,
-	0x3a, 0xc8, 0x38, 0x56, 0x84, 0x7e, 0x8b, 0x2f, 0x66, 0x2e, 0xd4, 0x53, 0x2c, 0x55, 0x58, 0xe7,
-	0x3e, 0x2a, 0x86, 0xde, 0x1f, 0x2c, 0x58, 0x99, 0xb7, 0xd3, 0xdb, 0x5c, 0xff, 0x21, 0x2c, 0xf6,
-	0xcd, 0x72, 0x66, 0xb5, 0x93, 0xff, 0x3a, 0x9c, 0x9e, 0xe7, 0x3d, 0x80, 0xaa, 0x8f, 0x25, 0x41,
-	0xb7, 0xa1, 0xc2, 0xa5, 0x3e, 0x41, 0x67, 0xfd, 0xda, 0x6b, 0x92, 0x95, 0x32, 0xd4, 0xdd, 0x70,
-	0x85, 0x4b, 0xd4, 0x06, 0x8b, 0xeb, 0x9b, 0x5a, 0xbe, 0xc5, 0x6f, 0xac, 0xc3, 0xd2, 0x91, 0x4f,
-	0x0c, 0xa8, 0x0d, 0x0d, 0x9f, 0x1d, 0x2a, 0x8c, 0x42, 0xe7, 0x3d, 0x74, 0x0e, 0x5a, 0x9b, 0x2c,
-	0xca, 0xe2, 0xc4, 0x08, 0xac, 0x1b, 0x7f, 0xb1, 0xa0, 0x51, 0x2c, 0x89, 0x96, 0x60, 0xb1, 0xdb,
-	0xdd, 0x1e, 0xff, 0xaf, 0x70, 0xde, 0x43, 0x0e, 0xb4, 0xbb, 0xdd, 0xed, 0xf2, 0x6b, 0xb7, 0x63,
-	0xa9, 0x05, 0xbb, 0xdd, 0x6d, 0x9d, 0x33, 0x9d, 0x4a, 0x3e, 0xfa, 0x2c, 0xca, 0xc4, 0xd0, 0xb1,
-	0xcb, 0x05, 0xe2, 0x14, 0x9b, 0x05, 0xaa, 0x68, 0x11, 0x9a, 0xdd, 0x9d, 0x6d, 0x73, 0x2e, 0xa7,
-	0x96, 0x0f, 0x4d, 0xd9, 0xe4, 0x2c, 0xa8, 0xf3, 0x74, 0x77, 0xb6, 0x37, 0xb2, 0xe8, 0xb9, 0x7a,
-	0xfd, 0x3a, 0x75, 0xad, 0x7f, 0xb2, 0x6d, 0x7a, 0x2d, 0xa7, 0xa1, 0x97, 0x7f, 0xb2, 0xad, 0xba,
-	0xbf, 0x91, 0xd3, 0xdc, 0xb8, 0xf7, 0x8b, 0x4f, 0x06, 0x54, 0x0e, 0xb3, 0x9e, 0x02, 0xf5, 0xb6,
-	0xc1, 0xe7, 0x43, 0xca, 0xf2, 0xa7, 0xdb, 0x05, 0x46, 0xb7, 0x35, 0x64, 0xe5, 0x30, 0xed, 0xf5,
-	0x16, 0xb4, 0xe4, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe9, 0xa6, 0xf9, 0x00, 0x1f,
-	0x00, 0x00,
+	// 2759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xd7, 0xec, 0xec, 0x72, 0x77, 0x6b, 0x97, 0xcb, 0x61, 0x93, 0x92, 0x57, 0x94, 0x6d, 0xd1,
+	0xf3, 0x7d, 0x9f, 0x3f, 0x46, 0x8e, 0x25, 0x9b, 0xb6, 0x65, 0xc7, 0x09, 0x62, 0x88, 0x5c, 0x4a,
+	0x20, 0x4c, 0x2a, 0xd4, 0xac, 0x20, 0x20, 0xb9, 0x0c, 0x9a, 0x33, 0x4d, 0xee, 0x44, 0xf3, 0x52,
+	0x77, 0x0f, 0xa9, 0xf5, 0x29, 0x87, 0x9c, 0x62, 0x24, 0xb7, 0x5c, 0x12, 0x24, 0xc7, 0x1c, 0x02,
+	0x04, 0xc8, 0x2d, 0x87, 0x1c, 0x02, 0xe4, 0x14, 0xe4, 0x90, 0x3f, 0x24, 0x7f, 0x42, 0x0e, 0x41,
+	0xd0, 0x8f, 0x79, 0xec, 0x72, 0x49, 0x91, 0x14, 0xfc, 0x08, 0xe0, 0xdb, 0xd6, 0xaf, 0xab, 0x1f,
+	0x53, 0xf5, 0xeb, 0xea, 0xaa, 0xee, 0x85, 0x5e, 0x10, 0x73, 0x42, 0x63, 0x1c, 0xde, 0x4e, 0x69,
+	0xc2, 0x13, 0x74, 0x35, 0x0a, 0xc2, 0xa3, 0x8c, 0x29, 0xe9, 0x76, 0xde, 0xb8, 0xd2, 0xf5, 0x92,
+	0x28, 0x4a, 0x62, 0x05, 0xaf, 0x74, 0x99, 0x37, 0x22, 0x11, 0x56, 0x92, 0x7d, 0x03, 0xae, 0x3f,
+	0x20, 0xfc, 0x71, 0x10, 0x91, 0xc7, 0x81, 0xf7, 0x74, 0x73, 0x84, 0xe3, 0x98, 0x84, 0x0e, 0x79,
+	0x96, 0x11, 0xc6, 0xed, 0xd7, 0xe0, 0xc6, 0x03, 0xc2, 0x87, 0x1c, 0xf3, 0x80, 0xf1, 0xc0, 0x63,
+	0x53, 0xcd, 0x57, 0x61, 0xe9, 0x01, 0xe1, 0x03, 0x7f, 0x0a, 0x7e, 0x02, 0xad, 0x87, 0x89, 0x4f,
+	0xb6, 0xe3, 0x83, 0x04, 0xdd, 0x85, 0x26, 0xf6, 0x7d, 0x4a, 0x18, 0xeb, 0x1b, 0xab, 0xc6, 0x5a,
+	0x67, 0xfd, 0xd5, 0xdb, 0x13, 0x6b, 0xd4, 0x2b, 0xbb, 0xa7, 0x74, 0x9c, 0x5c, 0x19, 0x21, 0xa8,
+	0xd3, 0x24, 0x24, 0xfd, 0xda, 0xaa, 0xb1, 0xd6, 0x76, 0xe4, 0x6f, 0xfb, 0xc7, 0x00, 0xdb, 0x71,
+	0xc0, 0xf7, 0x30, 0xc5, 0x11, 0x43, 0xd7, 0x60, 0x2e, 0x16, 0xb3, 0x0c, 0xe4, 0xc0, 0xa6, 0xa3,
+	0x25, 0x34, 0x80, 0x2e, 0xe3, 0x98, 0x72, 0x37, 0x95, 0x7a, 0xfd, 0xda, 0xaa, 0xb9, 0xd6, 0x59,
+	0x7f, 0x63, 0xe6, 0xb4, 0x9f, 0x92, 0xf1, 0x13, 0x1c, 0x66, 0x64, 0x0f, 0x07, 0xd4, 0xe9, 0xc8,
+	0x6e, 0x6a, 0x74, 0xfb, 0x87, 0x00, 0x43, 0x4e, 0x83, 0xf8, 0x70, 0x27, 0x60, 0x5c, 0xcc, 0x75,
+	0x24, 0xf4, 0xc4, 0x47, 0x98, 0x6b, 0x6d, 0x47, 0x4b, 0xe8, 0x3d, 0x98, 0x63, 0x1c, 0xf3, 0x8c,
+	0xc9, 0x75, 0x76, 0xd6, 0x6f, 0xcc, 0x9c, 0x65, 0x28, 0x55, 0x1c, 0xad, 0x6a, 0x7f, 0x02, 0x9d,
+	0xdc, 0xdc, 0xbb, 0xec, 0x10, 0xbd, 0x03, 0xf5, 0x7d, 0xcc, 0xc8, 0x99, 0xe6, 0xd9, 0x65, 0x87,
+	0x1b, 0x98, 0x11, 0x47, 0x6a, 0xda, 0x7f, 0xa8, 0xc1, 0xf2, 0x84, 0x5b, 0xb4, 0xe1, 0x2f, 0x3e,
+	0x94, 0x30, 0xb3, 0xbf, 0xbf, 0x3d, 0x90, 0xcb, 0x37, 0x1d, 0xf9, 0x1b, 0xd9, 0xd0, 0xf5, 0x92,
+	0x30, 0x24, 0x1e, 0x0f, 0x92, 0x78, 0x7b, 0xd0, 0x37, 0x65, 0xdb, 0x04, 0x26, 0x74, 0x52, 0x4c,
+	0x79, 0xa0, 0x44, 0xd6, 0xaf, 0xaf, 0x9a, 0x42, 0xa7, 0x8a, 0xa1, 0x6f, 0x81, 0xc5, 0x29, 0x3e,
+	0x22, 0xa1, 0xcb, 0x83, 0x88, 0x30, 0x8e, 0xa3, 0xb4, 0xdf, 0x58, 0x35, 0xd6, 0xea, 0xce, 0x82,
+	0xc2, 0x1f, 0xe7, 0x30, 0xba, 0x03, 0x4b, 0x87, 0x19, 0xa6, 0x38, 0xe6, 0x84, 0x54, 0xb4, 0xe7,
+	0xa4, 0x36, 0x2a, 0x9a, 0xca, 0x0e, 0x6f, 0xc1, 0xa2, 0x50, 0x4b, 0x32, 0x5e, 0x51, 0x6f, 0x4a,
+	0x75, 0x4b, 0x37, 0x14, 0xca, 0xf6, 0x9f, 0x0c, 0xb8, 0x3a, 0x65, 0x2f, 0x96, 0x26, 0x31, 0x23,
+	0x97, 0x30, 0xd8, 0x65, 0x3c, 0x8e, 0x3e, 0x84, 0x86, 0xf8, 0xc5, 0xfa, 0xe6, 0x79, 0xb9, 0xa8,
+	0xf4, 0xed, 0x9f, 0x99, 0xf0, 0xca, 0x26, 0x25, 0x98, 0x93, 0xcd, 0xc2, 0xfa, 0x97, 0x77, 0xf6,
+	0x2b, 0xd0, 0xf4, 0xf7, 0xdd, 0x18, 0x47, 0xf9, 0xb6, 0x9a, 0xf3, 0xf7, 0x1f, 0xe2, 0x88, 0xa0,
+	0x37, 0xa1, 0x57, 0x7a, 0x57, 0x20, 0xd2, 0xe7, 0x6d, 0x67, 0x0a, 0x45, 0xff, 0x0b, 0xf3, 0x85,
+	0x87, 0xa5, 0x5a, 0x5d, 0xaa, 0x4d, 0x82, 0x05, 0xa7, 0x1a, 0x67, 0x70, 0x6a, 0x6e, 0x06, 0xa7,
+	0x56, 0xa1, 0x53, 0xe1, 0x8f, 0xf4, 0xa6, 0xe9, 0x54, 0x21, 0xb1, 0x0d, 0x55, 0xec, 0xea, 0xb7,
+	0x56, 0x8d, 0xb5, 0xae, 0xa3, 0x25, 0xf4, 0x0e, 0x2c, 0x1d, 0x05, 0x94, 0x67, 0x38, 0xd4, 0x91,
+	0x48, 0xac, 0x83, 0xf5, 0xdb, 0x72, 0xaf, 0xce, 0x6a, 0x42, 0xeb, 0xb0, 0x9c, 0x8e, 0xc6, 0x2c,
+	0xf0, 0xa6, 0xba, 0x80, 0xec, 0x32, 0xb3, 0xcd, 0xfe, 0xab, 0x01, 0x57, 0x07, 0x34, 0x49, 0xbf,
+	0x16, 0xae, 0xc8, 0x8d, 0x5c, 0x3f, 0xc3, 0xc8, 0x8d, 0x93, 0x46, 0xb6, 0x7f, 0x5e, 0x83, 0x6b,
+	0x8a, 0x51, 0x7b, 0xb9, 0x61, 0xbf, 0x80, 0xaf, 0xf8, 0x7f, 0x58, 0x28, 0x67, 0x75, 0xe3, 0xd3,
+	0x3f, 0xe3, 0xff, 0xa0, 0x57, 0x38, 0x58, 0xe9, 0x7d, 0xb9, 0x94, 0xb2, 0x3f, 0xaf, 0xc1, 0xb2,
+	0x70, 0xea, 0x37, 0xd6, 0x10, 0xd6, 0xf8, 0xad, 0x01, 0x48, 0xb1, 0xe3, 0x5e, 0x18, 0x60, 0xf6,
+	0x55, 0xda, 0x62, 0x19, 0x1a, 0x58, 0xac, 0x41, 0x9b, 0x40, 0x09, 0x36, 0x03, 0x4b, 0x78, 0xeb,
+	0x8b, 0x5a, 0x5d, 0x31, 0xa9, 0x59, 0x9d, 0xf4, 0x37, 0x06, 0x2c, 0xde, 0x0b, 0x39, 0xa1, 0x5f,
+	0x53, 0xa3, 0xfc, 0xa5, 0x96, 0x7b, 0x6d, 0x3b, 0xf6, 0xc9, 0xf3, 0xaf, 0x72, 0x81, 0xaf, 0x01,
+	0x1c, 0x04, 0x24, 0xf4, 0xab, 0xec, 0x6d, 0x4b, 0xe4, 0xa5, 0x98, 0xdb, 0x87, 0xa6, 0x1c, 0xa4,
+	0x60, 0x6d, 0x2e, 0x8a, 0x6c, 0x8f, 0x3c, 0xe7, 0x14, 0xe7, 0xd9, 0x5e, 0xeb, 0xdc, 0xd9, 0x9e,
+	0xec, 0xa6, 0xb3, 0xbd, 0x7f, 0xd4, 0x61, 0x7e, 0x3b, 0x66, 0x84, 0xf2, 0xcb, 0x1b, 0xef, 0x55,
+	0x68, 0xb3, 0x11, 0xa6, 0xfe, 0xc3, 0xd2, 0x7c, 0x25, 0x50, 0x35, 0xad, 0xf9, 0x22, 0xd3, 0xd6,
+	0xcf, 0x19, 0x1c, 0x1a, 0x67, 0x05, 0x87, 0xb9, 0x33, 0x4c, 0xdc, 0x7c, 0x71, 0x70, 0x68, 0x9d,
+	0x3c, 0x7d, 0xc5, 0x07, 0x92, 0xc3, 0x88, 0xc4, 0x7c, 0x7b, 0xd0, 0x6f, 0xcb, 0xf6, 0x12, 0x40,
+	0xaf, 0x03, 0x14, 0x99, 0x98, 0x3a, 0x47, 0xeb, 0x4e, 0x05, 0x11, 0x67, 0x37, 0x4d, 0x8e, 0x45,
+	0xae, 0xd8, 0x91, 0xb9, 0xa2, 0x96, 0xd0, 0xfb, 0xd0, 0xa2, 0xc9, 0xb1, 0xeb, 0x63, 0x8e, 0xfb,
+	0x5d, 0xe9, 0xbc, 0xeb, 0x33, 0x8d, 0xbd, 0x11, 0x26, 0xfb, 0x4e, 0x93, 0x26, 0xc7, 0x03, 0xcc,
+	0x31, 0xfa, 0x04, 0x3a, 0x92, 0x01, 0x4c, 0x75, 0x9c, 0x97, 0x1d, 0x5f, 0x9f, 0xec, 0xa8, 0xcb,
+	0x9c, 0xfb, 0x42, 0x4f, 0x74, 0x72, 0x14, 0x35, 0x99, 0x1c, 0xe0, 0x3a, 0xb4, 0xe2, 0x2c, 0x72,
+	0x69, 0x72, 0xcc, 0xfa, 0x3d, 0x99, 0x37, 0x36, 0xe3, 0x2c, 0x72, 0x92, 0x63, 0x86, 0x36, 0xa0,
+	0x79, 0x44, 0x28, 0x0b, 0x92, 0xb8, 0xbf, 0xb0, 0x6a, 0xac, 0xf5, 0xd6, 0xd7, 0x6e, 0xcf, 0x2c,
+	0xab, 0x6e, 0x2b, 0xc6, 0x88, 0xe1, 0x9e, 0x28, 0x7d, 0x27, 0xef, 0x68, 0xff, 0xbd, 0x01, 0xf3,
+	0x43, 0x82, 0xa9, 0x37, 0xba, 0x3c, 0xa1, 0x96, 0xa1, 0x41, 0xc9, 0xb3, 0x22, 0x39, 0x57, 0x42,
+	0xe1, 0x5f, 0xf3, 0x0c, 0xff, 0xd6, 0xcf, 0x91, 0xb1, 0x37, 0x66, 0x64, 0xec, 0x16, 0x98, 0x3e,
+	0x0b, 0x25, 0x75, 0xda, 0x8e, 0xf8, 0x29, 0xf2, 0xec, 0x34, 0xc4, 0x1e, 0x19, 0x25, 0xa1, 0x4f,
+	0xa8, 0x7b, 0x48, 0x93, 0x4c, 0xe5, 0xd9, 0x5d, 0xc7, 0xaa, 0x34, 0x3c, 0x10, 0x38, 0xfa, 0x10,
+	0x5a, 0x3e, 0x0b, 0x5d, 0x3e, 0x4e, 0x89, 0xe4, 0x4f, 0xef, 0x94, 0xcf, 0x1c, 0xb0, 0xf0, 0xf1,
+	0x38, 0x25, 0x4e, 0xd3, 0x57, 0x3f, 0xd0, 0x3b, 0xb0, 0xcc, 0x08, 0x0d, 0x70, 0x18, 0x7c, 0x46,
+	0x7c, 0x97, 0x3c, 0x4f, 0xa9, 0x9b, 0x86, 0x38, 0x96, 0x24, 0xeb, 0x3a, 0xa8, 0x6c, 0xdb, 0x7a,
+	0x9e, 0xd2, 0xbd, 0x10, 0xc7, 0x68, 0x0d, 0xac, 0x24, 0xe3, 0x69, 0xc6, 0x5d, 0x4d, 0x83, 0xc0,
+	0x97, 0x9c, 0x33, 0x9d, 0x9e, 0xc2, 0xa5, 0xd7, 0xd9, 0xb6, 0x3f, 0xb3, 0x0a, 0xe9, 0x5c, 0xa8,
+	0x0a, 0xe9, 0x5e, 0xac, 0x0a, 0x99, 0x9f, 0x5d, 0x85, 0xa0, 0x1e, 0xd4, 0xe2, 0x67, 0x92, 0x6b,
+	0xa6, 0x53, 0x8b, 0x9f, 0x09, 0x47, 0xf2, 0x24, 0x7d, 0x2a, 0x39, 0x66, 0x3a, 0xf2, 0xb7, 0xd8,
+	0x44, 0x11, 0xe1, 0x34, 0xf0, 0x84, 0x59, 0xfa, 0x96, 0xf4, 0x43, 0x05, 0x41, 0x8f, 0xc0, 0xa2,
+	0x84, 0x65, 0x21, 0x77, 0x53, 0x4a, 0xbc, 0x40, 0x72, 0x74, 0x51, 0x5a, 0xfa, 0xcd, 0x53, 0x38,
+	0xea, 0x48, 0xf5, 0xbd, 0x5c, 0xdb, 0x59, 0xa0, 0x93, 0x00, 0x7a, 0x03, 0xba, 0x07, 0x81, 0x38,
+	0xdc, 0x5c, 0x55, 0xa2, 0xa0, 0x55, 0x63, 0xad, 0xe5, 0x74, 0x14, 0x36, 0x94, 0x55, 0xc8, 0x9f,
+	0x2b, 0x64, 0x16, 0x9d, 0xd9, 0x97, 0x55, 0x37, 0x15, 0x3b, 0xc0, 0xac, 0xee, 0x80, 0x9b, 0xd0,
+	0x51, 0x26, 0x51, 0x4c, 0xab, 0x9f, 0xb0, 0xd2, 0x4d, 0xe8, 0x88, 0xbd, 0xfd, 0x2c, 0x23, 0x34,
+	0x20, 0x4c, 0x1f, 0x36, 0x10, 0x67, 0xd1, 0x23, 0x85, 0xa0, 0x25, 0x68, 0xf0, 0x24, 0x75, 0x9f,
+	0xe6, 0x41, 0x92, 0x27, 0xe9, 0xa7, 0xe8, 0x7b, 0xb0, 0xc2, 0x08, 0x0e, 0x89, 0xef, 0x16, 0x41,
+	0x8d, 0xb9, 0x4c, 0x7e, 0x36, 0xf1, 0xfb, 0x4d, 0x49, 0xae, 0xbe, 0xd2, 0x18, 0x16, 0x0a, 0x43,
+	0xdd, 0x2e, 0xb8, 0xe3, 0xa9, 0x62, 0x61, 0xa2, 0x5b, 0x4b, 0xd6, 0x13, 0xa8, 0x6c, 0x2a, 0x3a,
+	0x7c, 0x04, 0xfd, 0xc3, 0x30, 0xd9, 0xc7, 0xa1, 0x7b, 0x62, 0x56, 0x59, 0xb8, 0x98, 0xce, 0x35,
+	0xd5, 0x3e, 0x9c, 0x9a, 0x52, 0x7c, 0x1e, 0x0b, 0x03, 0x8f, 0xf8, 0xee, 0x7e, 0x98, 0xec, 0xf7,
+	0x41, 0x6e, 0x12, 0x50, 0x90, 0x88, 0x92, 0x62, 0x73, 0x68, 0x05, 0x61, 0x06, 0x2f, 0xc9, 0x62,
+	0x2e, 0x29, 0x6f, 0x3a, 0x3d, 0x85, 0x3f, 0xcc, 0xa2, 0x4d, 0x81, 0xa2, 0xff, 0x81, 0x79, 0xad,
+	0x99, 0x1c, 0x1c, 0x30, 0xc2, 0x25, 0xd7, 0x4d, 0xa7, 0xab, 0xc0, 0x1f, 0x48, 0x6c, 0x26, 0xe9,
+	0xe6, 0x5f, 0x8e, 0x74, 0xe5, 0xbc, 0xcc, 0x4b, 0x28, 0x51, 0x21, 0xb8, 0x9b, 0xcf, 0x3b, 0x94,
+	0x18, 0xda, 0x9a, 0x62, 0xe6, 0x82, 0x24, 0x8e, 0x7d, 0xca, 0x9c, 0xf7, 0x4b, 0xc2, 0x4e, 0xb2,
+	0xf7, 0x77, 0x75, 0x58, 0x70, 0x04, 0x39, 0xc8, 0x11, 0xf9, 0x6f, 0x0a, 0xc6, 0xa7, 0x05, 0xc5,
+	0xb9, 0x0b, 0x05, 0xc5, 0xe6, 0xb9, 0x83, 0x62, 0xeb, 0x42, 0x41, 0xb1, 0x7d, 0xb1, 0xa0, 0x08,
+	0xa7, 0x04, 0xc5, 0x65, 0x68, 0x84, 0x41, 0x14, 0xe4, 0xfc, 0x54, 0xc2, 0x89, 0x98, 0xd4, 0x3d,
+	0x11, 0x93, 0xbe, 0x00, 0x52, 0xda, 0xff, 0x6c, 0x54, 0x89, 0xf2, 0x35, 0x08, 0x74, 0xb7, 0xc0,
+	0x0c, 0x7c, 0x95, 0xeb, 0x77, 0xd6, 0xfb, 0x33, 0x93, 0x9b, 0xed, 0x01, 0x73, 0x84, 0xd2, 0x74,
+	0x42, 0xd4, 0xb8, 0x70, 0x42, 0xf4, 0x7d, 0xb8, 0x71, 0x32, 0xfc, 0x51, 0x6d, 0x0e, 0xbf, 0x3f,
+	0x27, 0x79, 0x74, 0x7d, 0x3a, 0xfe, 0xe5, 0xf6, 0xf2, 0xd1, 0xbb, 0xb0, 0x5c, 0x09, 0x80, 0x65,
+	0xc7, 0xa6, 0xba, 0x84, 0x29, 0xdb, 0xca, 0x2e, 0x67, 0x85, 0xc0, 0xd6, 0x99, 0x21, 0x70, 0x3a,
+	0x34, 0xb4, 0x2f, 0x15, 0x1a, 0x66, 0x92, 0x08, 0x5e, 0x2e, 0xb2, 0x79, 0xb0, 0x40, 0x89, 0x9f,
+	0x89, 0xd0, 0x76, 0x44, 0x3c, 0x9e, 0x50, 0x95, 0xef, 0x76, 0xd6, 0x3f, 0x3e, 0x75, 0xc4, 0x09,
+	0xc6, 0xdd, 0x76, 0x54, 0xef, 0x27, 0xaa, 0xf3, 0x56, 0xcc, 0xe9, 0xd8, 0xe9, 0xd1, 0x09, 0x70,
+	0xe5, 0x1e, 0x2c, 0xcd, 0x50, 0x13, 0xe9, 0xdb, 0x53, 0x32, 0xd6, 0xd7, 0xe1, 0xe2, 0xa7, 0xe0,
+	0x95, 0xbc, 0xa9, 0x96, 0x5c, 0xec, 0x3a, 0x4a, 0xf8, 0xb8, 0xf6, 0x91, 0x61, 0xff, 0xdb, 0x80,
+	0x4e, 0xc5, 0x2e, 0x22, 0x22, 0x68, 0xeb, 0x33, 0x97, 0x79, 0xc2, 0x57, 0xbe, 0x1e, 0x68, 0x21,
+	0xc7, 0x87, 0x0a, 0x16, 0x15, 0x4b, 0xa1, 0x9a, 0xd2, 0x4c, 0x68, 0xd6, 0xf4, 0xe9, 0xa2, 0xe1,
+	0x3d, 0x89, 0x8a, 0x6d, 0x2c, 0xf2, 0xeb, 0x62, 0x3c, 0x45, 0xee, 0x8e, 0xc0, 0xf2, 0xb1, 0x6e,
+	0x82, 0x14, 0xdd, 0x14, 0x33, 0x46, 0x7c, 0x1d, 0x2b, 0x41, 0x40, 0x7b, 0x12, 0x11, 0x55, 0x4f,
+	0x20, 0x8a, 0xda, 0x9c, 0x1b, 0xf9, 0x71, 0x3e, 0x2f, 0x51, 0x4d, 0x09, 0x19, 0x2c, 0xf7, 0x69,
+	0xc6, 0x89, 0x7b, 0x90, 0x50, 0x8f, 0x94, 0xca, 0xea, 0x80, 0x47, 0xb2, 0xed, 0xbe, 0x68, 0xca,
+	0x7b, 0xd8, 0x7f, 0x33, 0x61, 0x7e, 0x40, 0x42, 0xc2, 0xc9, 0x37, 0x25, 0xdf, 0xa9, 0x25, 0xdf,
+	0xb7, 0x01, 0x05, 0x31, 0xbf, 0xfb, 0xbe, 0x9b, 0xd2, 0x20, 0xc2, 0x74, 0xec, 0x3e, 0x25, 0xe3,
+	0x3c, 0x3d, 0xb1, 0x64, 0xcb, 0x9e, 0x6a, 0xf8, 0x94, 0x8c, 0xd9, 0x0b, 0x4b, 0xc0, 0x6a, 0xcd,
+	0xa5, 0xe2, 0x7d, 0x51, 0x73, 0x7d, 0x17, 0xba, 0x13, 0x53, 0x74, 0x5f, 0x10, 0xf3, 0x3a, 0x69,
+	0x39, 0xaf, 0xfd, 0x2f, 0x03, 0xda, 0x3b, 0x09, 0xf6, 0xe5, 0xed, 0xc7, 0x25, 0xdd, 0x58, 0x14,
+	0xb6, 0xb5, 0xe9, 0xc2, 0xf6, 0x55, 0x28, 0x2f, 0x30, 0xb4, 0x23, 0x4b, 0xa0, 0x7a, 0x33, 0x51,
+	0x9f, 0xbc, 0x99, 0xb8, 0x09, 0x1d, 0xc5, 0xdc, 0x14, 0xf3, 0x91, 0x3a, 0xe2, 0xdb, 0x0e, 0x48,
+	0x68, 0x4f, 0x20, 0xe2, 0xea, 0x22, 0x57, 0x90, 0x57, 0x17, 0x73, 0xe7, 0xbe, 0xba, 0xd0, 0x83,
+	0xc8, 0xab, 0x8b, 0x9f, 0x1a, 0xe2, 0x55, 0x4c, 0xec, 0x05, 0xb9, 0x8f, 0xa7, 0x07, 0x35, 0x2e,
+	0x33, 0xa8, 0xd8, 0x4e, 0xd2, 0x53, 0x24, 0xc4, 0xbc, 0x8c, 0xcb, 0x4c, 0x1b, 0x07, 0x09, 0xaf,
+	0xa9, 0xa6, 0x62, 0x3b, 0xfd, 0xc2, 0x00, 0x90, 0x07, 0x8b, 0x5a, 0xc6, 0x34, 0xfd, 0x8c, 0xb3,
+	0x2f, 0x75, 0x6a, 0x93, 0xa6, 0xdb, 0xc8, 0x4d, 0x77, 0xc6, 0xab, 0x49, 0xa5, 0x0a, 0xcf, 0x3f,
+	0x5e, 0x5b, 0x57, 0xfe, 0xb6, 0x7f, 0x69, 0x40, 0x57, 0xaf, 0x4e, 0x2d, 0x69, 0xc2, 0xcb, 0xc6,
+	0xb4, 0x97, 0x65, 0x51, 0x11, 0x25, 0x74, 0xec, 0xb2, 0xe0, 0x33, 0xa2, 0x17, 0x04, 0x0a, 0x1a,
+	0x06, 0x9f, 0x91, 0x09, 0xf2, 0x9a, 0x93, 0xe4, 0x7d, 0x0b, 0x16, 0x29, 0xf1, 0x48, 0xcc, 0xc3,
+	0xb1, 0x1b, 0x25, 0x7e, 0x70, 0x10, 0xe8, 0x50, 0xd6, 0x72, 0xac, 0xbc, 0x61, 0x57, 0xe3, 0xf6,
+	0x4f, 0x0c, 0xe8, 0xec, 0xb2, 0xc3, 0xbd, 0x84, 0xc9, 0x4d, 0x26, 0x82, 0xa4, 0x3e, 0x1b, 0xd5,
+	0x0e, 0x37, 0x24, 0xc3, 0x3a, 0x5e, 0xf9, 0xf2, 0x20, 0xa2, 0x78, 0xc4, 0x0e, 0xb5, 0x99, 0xba,
+	0x8e, 0x12, 0xd0, 0x0a, 0xb4, 0x22, 0x76, 0x28, 0x2b, 0x6f, 0x4d, 0xcb, 0x42, 0x16, 0xdf, 0x5a,
+	0xe6, 0x5e, 0x75, 0x99, 0x7b, 0xb5, 0x79, 0xf5, 0x3d, 0x0c, 0xe9, 0x97, 0x8d, 0x97, 0x7a, 0x88,
+	0x94, 0x5e, 0xae, 0xbe, 0x9e, 0xd4, 0x24, 0xc7, 0x27, 0xb0, 0xa9, 0xa0, 0x60, 0x9e, 0x08, 0x0a,
+	0x6f, 0xc1, 0xa2, 0x4f, 0x0e, 0xb0, 0x38, 0x84, 0xa7, 0x97, 0x6c, 0xe9, 0x86, 0x89, 0x97, 0xbc,
+	0xde, 0x26, 0x25, 0x3e, 0x89, 0x79, 0x80, 0x43, 0xf9, 0xc0, 0xbc, 0x02, 0xad, 0x8c, 0x11, 0x5a,
+	0xb1, 0x5d, 0x21, 0xa3, 0xb7, 0x01, 0x91, 0xd8, 0xa3, 0xe3, 0x54, 0x90, 0x58, 0x1c, 0x31, 0xc7,
+	0x09, 0xf5, 0x75, 0xa0, 0x5e, 0x2c, 0x5a, 0xf6, 0x74, 0x83, 0xb8, 0xa2, 0xe2, 0x24, 0xc6, 0x31,
+	0xcf, 0xe3, 0xb5, 0x92, 0x84, 0xeb, 0x03, 0xe6, 0xb2, 0x2c, 0x25, 0x54, 0xbb, 0xb5, 0x19, 0xb0,
+	0xa1, 0x10, 0xe5, 0x59, 0x38, 0xc2, 0xeb, 0x1f, 0xdc, 0x2d, 0x87, 0x57, 0x21, 0xba, 0xa7, 0xe0,
+	0x7c, 0x6c, 0x7b, 0x0b, 0x16, 0xc5, 0x4b, 0xf2, 0x5e, 0x12, 0x06, 0xde, 0xf8, 0xd2, 0x27, 0x8e,
+	0xfd, 0xb9, 0x01, 0xa8, 0x3a, 0x8e, 0x7e, 0xc7, 0x2c, 0x93, 0x4e, 0xe3, 0xfc, 0x49, 0xe7, 0x1b,
+	0xd0, 0x4d, 0xe5, 0x30, 0x6e, 0x10, 0x1f, 0x24, 0xb9, 0xf7, 0x3a, 0x0a, 0x13, 0xb6, 0x65, 0xe2,
+	0x3a, 0x57, 0x18, 0xd3, 0xa5, 0x49, 0x48, 0x94, 0xf3, 0xda, 0x4e, 0x5b, 0x20, 0x8e, 0x00, 0xec,
+	0x43, 0xb8, 0x3e, 0x1c, 0x25, 0xc7, 0x9b, 0x49, 0x7c, 0x10, 0x1c, 0x66, 0x14, 0x0b, 0x42, 0xbf,
+	0xc4, 0xfd, 0x78, 0x1f, 0x9a, 0x29, 0xe6, 0x62, 0x5b, 0x6b, 0x1f, 0xe5, 0xa2, 0xfd, 0x2b, 0x03,
+	0x56, 0x66, 0xcd, 0xf4, 0x32, 0x9f, 0xff, 0x00, 0xe6, 0x3d, 0x35, 0x9c, 0x1a, 0xed, 0xfc, 0x7f,
+	0x14, 0x98, 0xec, 0x67, 0x6f, 0x41, 0xdd, 0xc1, 0x9c, 0xa0, 0x3b, 0x50, 0xa3, 0x5c, 0xae, 0xa0,
+	0xb7, 0x7e, 0xf3, 0xb4, 0x6c, 0x0f, 0x73, 0x22, 0xef, 0xbe, 0x6a, 0x94, 0xa3, 0x2e, 0x18, 0x54,
+	0x7e, 0xa9, 0xe1, 0x18, 0xd4, 0xfe, 0x63, 0x1d, 0x16, 0x64, 0x2c, 0xdb, 0xc8, 0x82, 0xd0, 0xdf,
+	0x3a, 0x22, 0xf1, 0x65, 0x6c, 0xb8, 0x0d, 0x40, 0x44, 0x57, 0x75, 0x37, 0x52, 0x93, 0x8b, 0xb9,
+	0x75, 0x56, 0xe4, 0x2c, 0x67, 0x93, 0xeb, 0x6a, 0x93, 0xfc, 0xa7, 0x08, 0x22, 0x5e, 0x98, 0x31,
+	0x4e, 0xa8, 0x2e, 0x4c, 0xda, 0x4e, 0x09, 0x08, 0x67, 0xed, 0x67, 0x41, 0xf5, 0xe0, 0xd3, 0x62,
+	0xe5, 0x8f, 0x19, 0x8d, 0x89, 0x3f, 0x66, 0x9c, 0xe7, 0xa2, 0x7f, 0x22, 0x48, 0x37, 0xa7, 0x83,
+	0x74, 0xe5, 0xc4, 0x68, 0x4d, 0x9e, 0x18, 0xcb, 0xd0, 0x48, 0x47, 0xc2, 0x52, 0x6d, 0xf5, 0x30,
+	0x22, 0x05, 0xf4, 0x81, 0x7a, 0x77, 0x27, 0x7d, 0x98, 0xe5, 0x14, 0x6d, 0xbf, 0xe2, 0xfc, 0x20,
+	0xea, 0xd5, 0x5d, 0xde, 0x1f, 0x1d, 0xe0, 0x20, 0x74, 0x29, 0xc1, 0x2c, 0x89, 0x65, 0xaa, 0xd2,
+	0x76, 0x40, 0x40, 0x8e, 0x44, 0xe4, 0x2b, 0x87, 0x50, 0xf0, 0x42, 0xcc, 0x54, 0xae, 0x22, 0x72,
+	0x02, 0x1c, 0x84, 0x9b, 0x02, 0x50, 0x09, 0x72, 0x51, 0xb9, 0xcb, 0xf3, 0x44, 0x5d, 0x0a, 0xf6,
+	0x4a, 0x58, 0x9e, 0x29, 0x37, 0xa1, 0xe3, 0x6b, 0x32, 0xbb, 0x11, 0xd3, 0x77, 0x83, 0x90, 0x43,
+	0xbb, 0x72, 0xff, 0x91, 0x10, 0xa7, 0x8c, 0xf8, 0x6e, 0xa4, 0x2e, 0x40, 0x4c, 0xa7, 0xad, 0x91,
+	0x5d, 0x76, 0x6b, 0x1d, 0x16, 0x4f, 0xdc, 0x41, 0xa3, 0x2e, 0xb4, 0x9c, 0xe4, 0x58, 0x50, 0xc2,
+	0xb7, 0xae, 0xa0, 0x05, 0xe8, 0x6c, 0x26, 0x61, 0x16, 0xc5, 0x0a, 0x30, 0x6e, 0xfd, 0xde, 0x80,
+	0x56, 0xce, 0x42, 0xb4, 0x08, 0xf3, 0x83, 0xc1, 0x4e, 0xf9, 0xa0, 0x6d, 0x5d, 0x41, 0x16, 0x74,
+	0x07, 0x83, 0x9d, 0xe2, 0x39, 0xd4, 0x32, 0xc4, 0x80, 0x83, 0xc1, 0x8e, 0x34, 0x93, 0x55, 0xd3,
+	0xd2, 0xfd, 0x30, 0x63, 0x23, 0xcb, 0x2c, 0x06, 0x88, 0x52, 0xac, 0x06, 0xa8, 0xa3, 0x79, 0x68,
+	0x0f, 0x76, 0x77, 0xd4, 0xba, 0xac, 0x86, 0x16, 0x55, 0xa6, 0x6d, 0xcd, 0x89, 0xf5, 0x0c, 0x76,
+	0x77, 0x36, 0xb2, 0xf0, 0xa9, 0xc8, 0xd8, 0xac, 0xa6, 0x6c, 0x7f, 0xb4, 0xa3, 0xae, 0xc5, 0xac,
+	0x96, 0x1c, 0xfe, 0xd1, 0x8e, 0xb8, 0xa8, 0x1b, 0x5b, 0xed, 0x5b, 0xbf, 0x36, 0x60, 0x69, 0x06,
+	0x4b, 0x11, 0x82, 0x5e, 0x89, 0x3c, 0x4c, 0x62, 0x62, 0x5d, 0x41, 0xd7, 0x00, 0x95, 0xd8, 0x56,
+	0xfc, 0x2c, 0x23, 0x99, 0xf8, 0x60, 0x74, 0x15, 0x16, 0x4b, 0x7c, 0xc8, 0x31, 0xe5, 0xc4, 0xb7,
+	0x6a, 0x68, 0x09, 0x16, 0x4a, 0x78, 0x4f, 0xd0, 0xc5, 0x32, 0x27, 0xc7, 0xb8, 0x1f, 0xc4, 0x01,
+	0x1b, 0x11, 0xdf, 0xaa, 0xa3, 0x65, 0xb0, 0x2a, 0x38, 0x0e, 0x42, 0xe2, 0x5b, 0x8d, 0x5b, 0xdf,
+	0x81, 0x05, 0x55, 0xb5, 0x95, 0xe5, 0x5f, 0x07, 0x9a, 0xf7, 0xc3, 0x04, 0xf3, 0xf7, 0xd6, 0xad,
+	0x2b, 0x85, 0xf0, 0xee, 0x5d, 0x65, 0xc5, 0x8d, 0x5c, 0xaa, 0x6d, 0x7c, 0xf8, 0xa3, 0x0f, 0x0e,
+	0x03, 0x3e, 0xca, 0xf6, 0x05, 0x0b, 0xef, 0x28, 0x5a, 0xbe, 0x1d, 0x24, 0xfa, 0xd7, 0x9d, 0x7c,
+	0x8b, 0xde, 0x91, 0x4c, 0x2d, 0xc4, 0x74, 0x7f, 0x7f, 0x4e, 0x22, 0xef, 0xfd, 0x67, 0x00, 0x99,
+	0x19, 0x47, 0xbb, 0xfa, 0x25, 0x00, 0x00,
 }
@@ -9,6 +9,9 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	schemapb "github.com/milvus-io/milvus-proto/go-api/schemapb"
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+	indexpb "github.com/milvus-io/milvus/internal/proto/indexpb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,6 +30,98 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type DdlOperationState int32
+
+const (
+	DdlOperationState_DdlOperationRunning   DdlOperationState = 0
+	DdlOperationState_DdlOperationCompleted DdlOperationState = 1
+	DdlOperationState_DdlOperationFailed    DdlOperationState = 2
+	// the operation was running when RootCoord stopped, the collections and partitions left dropping are recycled by
+	// the garbage collector
+	DdlOperationState_DdlOperationInterrupted DdlOperationState = 3
+)
+
+var DdlOperationState_name = map[int32]string{
+	0: "DdlOperationRunning",
+	1: "DdlOperationCompleted",
+	2: "DdlOperationFailed",
+	3: "DdlOperationInterrupted",
+}
+
+var DdlOperationState_value = map[string]int32{
+	"DdlOperationRunning":     0,
+	"DdlOperationCompleted":   1,
+	"DdlOperationFailed":      2,
+	"DdlOperationInterrupted": 3,
+}
+
+func (x DdlOperationState) String() string {
+	return proto.EnumName(DdlOperationState_name, int32(x))
+}
+
+func (DdlOperationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{0}
+}
+
+type DdlStepState int32
+
+const (
+	DdlStepState_DdlStepPending   DdlStepState = 0
+	DdlStepState_DdlStepRunning   DdlStepState = 1
+	DdlStepState_DdlStepRetrying  DdlStepState = 2
+	DdlStepState_DdlStepCompleted DdlStepState = 3
+	DdlStepState_DdlStepFailed    DdlStepState = 4
+)
+
+var DdlStepState_name = map[int32]string{
+	0: "DdlStepPending",
+	1: "DdlStepRunning",
+	2: "DdlStepRetrying",
+	3: "DdlStepCompleted",
+	4: "DdlStepFailed",
+}
+
+var DdlStepState_value = map[string]int32{
+	"DdlStepPending":   0,
+	"DdlStepRunning":   1,
+	"DdlStepRetrying":  2,
+	"DdlStepCompleted": 3,
+	"DdlStepFailed":    4,
+}
+
+func (x DdlStepState) String() string {
+	return proto.EnumName(DdlStepState_name, int32(x))
+}
+
+func (DdlStepState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{1}
+}
+
+type ProxyTaskState int32
+
+const (
+	ProxyTaskState_ProxyTaskQueued    ProxyTaskState = 0
+	ProxyTaskState_ProxyTaskExecuting ProxyTaskState = 1
+)
+
+var ProxyTaskState_name = map[int32]string{
+	0: "ProxyTaskQueued",
+	1: "ProxyTaskExecuting",
+}
+
+var ProxyTaskState_value = map[string]int32{
+	"ProxyTaskQueued":    0,
+	"ProxyTaskExecuting": 1,
+}
+
+func (x ProxyTaskState) String() string {
+	return proto.EnumName(ProxyTaskState_name, int32(x))
+}
+
+func (ProxyTaskState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+
 type InvalidateCollMetaCacheRequest struct {
 	// MsgType:
 	//  DropCollection    ->  {meta cache, dml channels}
@@ -314,422 +409,5841 @@ func (m *SetRatesRequest) GetStateReasons() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
-	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
-	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
-	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
-	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
+type DryRunCreateCollectionResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the schema with the field ids RootCoord would assign
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum            int32                      `protobuf:"varint,3,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel  `protobuf:"varint,4,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
-
-var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x51, 0x4f, 0x13, 0x4d,
-	0x14, 0x65, 0x29, 0x2d, 0x70, 0xe9, 0x07, 0xc9, 0x84, 0x0f, 0x6b, 0x11, 0x6d, 0x16, 0x23, 0x0d,
-	0x89, 0xad, 0x54, 0x13, 0xdf, 0x29, 0x49, 0x43, 0x0c, 0x04, 0xa7, 0xfa, 0xe2, 0x0b, 0x99, 0xdd,
-	0xbd, 0xd0, 0x21, 0xdb, 0x99, 0x65, 0x67, 0x8a, 0xf6, 0xc9, 0xc4, 0x7f, 0xe4, 0x9b, 0x3f, 0xc4,
-	0x1f, 0x64, 0x76, 0x66, 0xbb, 0xd0, 0xba, 0x65, 0xa3, 0xc4, 0xb7, 0x3d, 0x77, 0xce, 0x9d, 0x73,
-	0xef, 0xec, 0xbd, 0x07, 0xd6, 0xa2, 0x58, 0x7e, 0x19, 0xb7, 0xa2, 0x58, 0x6a, 0x49, 0xc8, 0x90,
-	0x87, 0x37, 0x23, 0x65, 0x51, 0xcb, 0x9c, 0xd4, 0xab, 0xbe, 0x1c, 0x0e, 0xa5, 0xb0, 0xb1, 0xfa,
-	0x3a, 0x17, 0x1a, 0x63, 0xc1, 0xc2, 0x14, 0x57, 0xef, 0x66, 0xb8, 0x3f, 0x1c, 0x78, 0x7a, 0x2c,
-	0x6e, 0x58, 0xc8, 0x03, 0xa6, 0xb1, 0x2b, 0xc3, 0xf0, 0x04, 0x35, 0xeb, 0x32, 0x7f, 0x80, 0x14,
-	0xaf, 0x47, 0xa8, 0x34, 0x79, 0x05, 0x4b, 0x1e, 0x53, 0x58, 0x73, 0x1a, 0x4e, 0x73, 0xad, 0xf3,
-	0xa4, 0x35, 0xa5, 0x98, 0x4a, 0x9d, 0xa8, 0xcb, 0x43, 0xa6, 0x90, 0x1a, 0x26, 0x79, 0x04, 0xcb,
-	0x81, 0x77, 0x2e, 0xd8, 0x10, 0x6b, 0x8b, 0x0d, 0xa7, 0xb9, 0x4a, 0x2b, 0x81, 0x77, 0xca, 0x86,
-	0x48, 0xf6, 0x60, 0xc3, 0x97, 0x61, 0x88, 0xbe, 0xe6, 0x52, 0x58, 0x42, 0xc9, 0x10, 0xd6, 0x6f,
-	0xc3, 0x86, 0xe8, 0x42, 0xf5, 0x36, 0x72, 0x7c, 0x54, 0x5b, 0x6a, 0x38, 0xcd, 0x12, 0x9d, 0x8a,
-	0xb9, 0x57, 0x50, 0xbf, 0x53, 0x79, 0x8c, 0xc1, 0x03, 0xab, 0xae, 0xc3, 0xca, 0x48, 0x25, 0x2f,
-	0x95, 0x95, 0x9d, 0x61, 0xf7, 0x9b, 0x03, 0x5b, 0x1f, 0xa3, 0x7f, 0x2f, 0x94, 0x9c, 0x45, 0x4c,
-	0xa9, 0xcf, 0x32, 0x0e, 0xd2, 0xa7, 0xc9, 0xb0, 0xfb, 0x15, 0x76, 0x28, 0x5e, 0xc4, 0xa8, 0x06,
-	0x67, 0x32, 0xe4, 0xfe, 0xf8, 0x58, 0x5c, 0xc8, 0x07, 0x96, 0xb2, 0x05, 0x15, 0x19, 0x7d, 0x18,
-	0x47, 0xb6, 0x90, 0x32, 0x4d, 0x11, 0xd9, 0x84, 0xb2, 0x8c, 0xde, 0xe1, 0x38, 0xad, 0xc1, 0x02,
-	0xf7, 0xa7, 0x03, 0x1b, 0x7d, 0xd4, 0x94, 0x69, 0x54, 0x7f, 0xaf, 0x79, 0x00, 0xe5, 0x38, 0xb9,
-	0xa1, 0xb6, 0xd8, 0x28, 0x35, 0xd7, 0x3a, 0xdb, 0xd3, 0x29, 0xd9, 0xb4, 0x26, 0x2a, 0xd4, 0x32,
-	0xc9, 0x5b, 0xa8, 0x28, 0x6d, 0x72, 0x4a, 0x8d, 0x52, 0x73, 0xbd, 0xf3, 0x6c, 0x3a, 0x27, 0x05,
-	0xef, 0x47, 0x52, 0xb3, 0x7e, 0xc2, 0xa3, 0x29, 0x9d, 0xec, 0xc2, 0x7f, 0xe6, 0xeb, 0x3c, 0x46,
-	0xa6, 0xa4, 0x50, 0xb5, 0xa5, 0x46, 0xa9, 0xb9, 0x4a, 0xab, 0x26, 0x48, 0x6d, 0xac, 0xf3, 0x7d,
-	0x19, 0xca, 0x67, 0xc9, 0xe6, 0x90, 0x10, 0x48, 0x0f, 0x75, 0x57, 0x0e, 0x23, 0x29, 0x50, 0xe8,
-	0xbe, 0xbd, 0xa4, 0x95, 0xab, 0xf6, 0x3b, 0x31, 0x7d, 0x92, 0xfa, 0xf3, 0x5c, 0xfe, 0x0c, 0xd9,
-	0x5d, 0x20, 0xd7, 0xb0, 0xd9, 0x43, 0x03, 0xb9, 0xd2, 0xdc, 0x57, 0xdd, 0x01, 0x13, 0x02, 0x43,
-	0xd2, 0x99, 0xf3, 0x22, 0x79, 0xe4, 0x89, 0xe6, 0x6e, 0xae, 0x66, 0x5f, 0xc7, 0x5c, 0x5c, 0x52,
-	0x54, 0x91, 0x14, 0x0a, 0xdd, 0x05, 0x12, 0xc3, 0xce, 0xf4, 0xb6, 0xdb, 0x6d, 0xca, 0x76, 0x7e,
-	0x56, 0xdb, 0x5a, 0xcd, 0xfd, 0x06, 0x51, 0xdf, 0xce, 0xfd, 0xe9, 0x49, 0xa9, 0xa3, 0xa4, 0x4d,
-	0x06, 0xd5, 0x1e, 0xea, 0xa3, 0x60, 0xd2, 0xde, 0xfe, 0xfc, 0xf6, 0x32, 0xd2, 0x1f, 0xb6, 0x75,
-	0x05, 0x8f, 0xa7, 0xad, 0x00, 0x85, 0xe6, 0x2c, 0xb4, 0x2d, 0xb5, 0x0a, 0x5a, 0x9a, 0x59, 0xe8,
-	0xa2, 0x76, 0x3c, 0xf8, 0xff, 0xd6, 0x09, 0xee, 0xea, 0xec, 0xe7, 0xe9, 0xe4, 0x9b, 0x46, 0x91,
-	0xc6, 0x15, 0x6c, 0xe5, 0x6f, 0x3a, 0x39, 0xc8, 0x13, 0xb9, 0xd7, 0x15, 0x8a, 0xb4, 0x02, 0xd8,
-	0xe8, 0xa1, 0x36, 0xf3, 0x7f, 0x82, 0x3a, 0xe6, 0xbe, 0x22, 0x2f, 0xe6, 0x0d, 0x7c, 0x4a, 0x98,
-	0xdc, 0xbc, 0x57, 0xc8, 0xcb, 0xfe, 0xd0, 0x29, 0xac, 0x4c, 0x9c, 0x83, 0xec, 0xe6, 0xf5, 0x30,
-	0xe3, 0x2b, 0x05, 0x55, 0x1f, 0xbe, 0xf9, 0xd4, 0xb9, 0xe4, 0x7a, 0x30, 0xf2, 0x92, 0x93, 0xb6,
-	0xa5, 0xbe, 0xe4, 0x32, 0xfd, 0x6a, 0x4f, 0x86, 0xaa, 0x6d, 0xb2, 0xdb, 0x46, 0x22, 0xf2, 0xbc,
-	0x8a, 0x81, 0xaf, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x36, 0x94, 0x65, 0x38, 0x43, 0x07, 0x00,
-	0x00,
+func (m *DryRunCreateCollectionResponse) Reset()         { *m = DryRunCreateCollectionResponse{} }
+func (m *DryRunCreateCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunCreateCollectionResponse) ProtoMessage()    {}
+func (*DryRunCreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{5}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *DryRunCreateCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunCreateCollectionResponse.Unmarshal(m, b)
+}
+func (m *DryRunCreateCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunCreateCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *DryRunCreateCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunCreateCollectionResponse.Merge(m, src)
+}
+func (m *DryRunCreateCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunCreateCollectionResponse.Size(m)
+}
+func (m *DryRunCreateCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunCreateCollectionResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_DryRunCreateCollectionResponse proto.InternalMessageInfo
 
-// ProxyClient is the client API for Proxy service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProxyClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	UpdateCredentialCache(ctx context.Context, in *UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetProxyMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	SetRates(ctx context.Context, in *SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+func (m *DryRunCreateCollectionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
 
-type proxyClient struct {
-	cc *grpc.ClientConn
+func (m *DryRunCreateCollectionResponse) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
 }
 
-func NewProxyClient(cc *grpc.ClientConn) ProxyClient {
-	return &proxyClient{cc}
+func (m *DryRunCreateCollectionResponse) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
 }
 
-func (c *proxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
-	out := new(milvuspb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
 	}
-	return out, nil
+	return commonpb.ConsistencyLevel_Strong
 }
 
-func (c *proxyClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateCollectionResponse) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
 	}
-	return out, nil
+	return nil
 }
 
-func (c *proxyClient) InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DryRunCreateIndexResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	FieldID      int64            `protobuf:"varint,3,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	FieldName    string           `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName    string           `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IsAutoIndex  bool             `protobuf:"varint,6,opt,name=is_auto_index,json=isAutoIndex,proto3" json:"is_auto_index,omitempty"`
+	// the index params after the auto index and the default metric type are applied
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *proxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetDdChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateIndexResponse) Reset()         { *m = DryRunCreateIndexResponse{} }
+func (m *DryRunCreateIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunCreateIndexResponse) ProtoMessage()    {}
+func (*DryRunCreateIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{6}
+}
+
+func (m *DryRunCreateIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunCreateIndexResponse.Unmarshal(m, b)
+}
+func (m *DryRunCreateIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunCreateIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *DryRunCreateIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunCreateIndexResponse.Merge(m, src)
+}
+func (m *DryRunCreateIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunCreateIndexResponse.Size(m)
+}
+func (m *DryRunCreateIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunCreateIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunCreateIndexResponse proto.InternalMessageInfo
+
+func (m *DryRunCreateIndexResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *proxyClient) InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCredentialCache", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateIndexResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *proxyClient) UpdateCredentialCache(ctx context.Context, in *UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/UpdateCredentialCache", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateIndexResponse) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *proxyClient) RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateIndexResponse) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *proxyClient) GetProxyMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetProxyMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateIndexResponse) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *proxyClient) SetRates(ctx context.Context, in *SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/SetRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DryRunCreateIndexResponse) GetIsAutoIndex() bool {
+	if m != nil {
+		return m.IsAutoIndex
 	}
-	return out, nil
+	return false
 }
 
-// ProxyServer is the server API for Proxy service.
-type ProxyServer interface {
-	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
-	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	InvalidateCollectionMetaCache(context.Context, *InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
-	GetDdChannel(context.Context, *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error)
-	InvalidateCredentialCache(context.Context, *InvalidateCredCacheRequest) (*commonpb.Status, error)
-	UpdateCredentialCache(context.Context, *UpdateCredCacheRequest) (*commonpb.Status, error)
-	RefreshPolicyInfoCache(context.Context, *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
-	GetProxyMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
-	SetRates(context.Context, *SetRatesRequest) (*commonpb.Status, error)
+func (m *DryRunCreateIndexResponse) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
 }
 
-// UnimplementedProxyServer can be embedded to have forward compatible implementations.
-type UnimplementedProxyServer struct {
+type ReducedPrecisionSearchResults struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the results with the scores left out if they are sent in scores
+	Results        *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	CollectionName string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Precision      internalpb.ResultPrecision `protobuf:"varint,4,opt,name=precision,proto3,enum=milvus.proto.internal.ResultPrecision" json:"precision,omitempty"`
+	// the scores encoded in precision, set when it's not Float32. The scores too large for Float16 are sent in Float32.
+	Scores               []byte   `protobuf:"bytes,5,opt,name=scores,proto3" json:"scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedProxyServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+func (m *ReducedPrecisionSearchResults) Reset()         { *m = ReducedPrecisionSearchResults{} }
+func (m *ReducedPrecisionSearchResults) String() string { return proto.CompactTextString(m) }
+func (*ReducedPrecisionSearchResults) ProtoMessage()    {}
+func (*ReducedPrecisionSearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{7}
 }
-func (*UnimplementedProxyServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+
+func (m *ReducedPrecisionSearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReducedPrecisionSearchResults.Unmarshal(m, b)
 }
-func (*UnimplementedProxyServer) InvalidateCollectionMetaCache(ctx context.Context, req *InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCollectionMetaCache not implemented")
+func (m *ReducedPrecisionSearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReducedPrecisionSearchResults.Marshal(b, m, deterministic)
 }
-func (*UnimplementedProxyServer) GetDdChannel(ctx context.Context, req *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDdChannel not implemented")
+func (m *ReducedPrecisionSearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReducedPrecisionSearchResults.Merge(m, src)
 }
-func (*UnimplementedProxyServer) InvalidateCredentialCache(ctx context.Context, req *InvalidateCredCacheRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCredentialCache not implemented")
+func (m *ReducedPrecisionSearchResults) XXX_Size() int {
+	return xxx_messageInfo_ReducedPrecisionSearchResults.Size(m)
 }
-func (*UnimplementedProxyServer) UpdateCredentialCache(ctx context.Context, req *UpdateCredCacheRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialCache not implemented")
+func (m *ReducedPrecisionSearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReducedPrecisionSearchResults.DiscardUnknown(m)
 }
-func (*UnimplementedProxyServer) RefreshPolicyInfoCache(ctx context.Context, req *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshPolicyInfoCache not implemented")
+
+var xxx_messageInfo_ReducedPrecisionSearchResults proto.InternalMessageInfo
+
+func (m *ReducedPrecisionSearchResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
-func (*UnimplementedProxyServer) GetProxyMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProxyMetrics not implemented")
+
+func (m *ReducedPrecisionSearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
-func (*UnimplementedProxyServer) SetRates(ctx context.Context, req *SetRatesRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRates not implemented")
+
+func (m *ReducedPrecisionSearchResults) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
-	s.RegisterService(&_Proxy_serviceDesc, srv)
+func (m *ReducedPrecisionSearchResults) GetPrecision() internalpb.ResultPrecision {
+	if m != nil {
+		return m.Precision
+	}
+	return internalpb.ResultPrecision_Float32
 }
 
-func _Proxy_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetComponentStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReducedPrecisionSearchResults) GetScores() []byte {
+	if m != nil {
+		return m.Scores
 	}
-	if interceptor == nil {
-		return srv.(ProxyServer).GetComponentStates(ctx, in)
+	return nil
+}
+
+type ReducedPrecisionQueryResults struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the fields with the float vectors left out if they are sent in reduced_vectors
+	FieldsData     []*schemapb.FieldData      `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	CollectionName string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Precision      internalpb.ResultPrecision `protobuf:"varint,4,opt,name=precision,proto3,enum=milvus.proto.internal.ResultPrecision" json:"precision,omitempty"`
+	// the float vectors encoded in precision by field id, the vectors of a field too large for the precision are kept
+	// in fields_data
+	ReducedVectors       map[int64][]byte `protobuf:"bytes,5,rep,name=reduced_vectors,json=reducedVectors,proto3" json:"reduced_vectors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReducedPrecisionQueryResults) Reset()         { *m = ReducedPrecisionQueryResults{} }
+func (m *ReducedPrecisionQueryResults) String() string { return proto.CompactTextString(m) }
+func (*ReducedPrecisionQueryResults) ProtoMessage()    {}
+func (*ReducedPrecisionQueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *ReducedPrecisionQueryResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReducedPrecisionQueryResults.Unmarshal(m, b)
+}
+func (m *ReducedPrecisionQueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReducedPrecisionQueryResults.Marshal(b, m, deterministic)
+}
+func (m *ReducedPrecisionQueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReducedPrecisionQueryResults.Merge(m, src)
+}
+func (m *ReducedPrecisionQueryResults) XXX_Size() int {
+	return xxx_messageInfo_ReducedPrecisionQueryResults.Size(m)
+}
+func (m *ReducedPrecisionQueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReducedPrecisionQueryResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReducedPrecisionQueryResults proto.InternalMessageInfo
+
+func (m *ReducedPrecisionQueryResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/GetComponentStates",
+	return nil
+}
+
+func (m *ReducedPrecisionQueryResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	return nil
+}
+
+func (m *ReducedPrecisionQueryResults) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Proxy_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func (m *ReducedPrecisionQueryResults) GetPrecision() internalpb.ResultPrecision {
+	if m != nil {
+		return m.Precision
+	}
+	return internalpb.ResultPrecision_Float32
+}
+
+func (m *ReducedPrecisionQueryResults) GetReducedVectors() map[int64][]byte {
+	if m != nil {
+		return m.ReducedVectors
+	}
+	return nil
+}
+
+// RoleQuota limits the request rates of the users granted the role, rates are keyed by internal.RateType names
+type RoleQuota struct {
+	RoleName             string             `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Rates                map[string]float64 `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RoleQuota) Reset()         { *m = RoleQuota{} }
+func (m *RoleQuota) String() string { return proto.CompactTextString(m) }
+func (*RoleQuota) ProtoMessage()    {}
+func (*RoleQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *RoleQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleQuota.Unmarshal(m, b)
+}
+func (m *RoleQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleQuota.Marshal(b, m, deterministic)
+}
+func (m *RoleQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleQuota.Merge(m, src)
+}
+func (m *RoleQuota) XXX_Size() int {
+	return xxx_messageInfo_RoleQuota.Size(m)
+}
+func (m *RoleQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleQuota proto.InternalMessageInfo
+
+func (m *RoleQuota) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *RoleQuota) GetRates() map[string]float64 {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type RefreshRoleQuotaRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// a quota without rates removes the limits of the role
+	Quota                *RoleQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RefreshRoleQuotaRequest) Reset()         { *m = RefreshRoleQuotaRequest{} }
+func (m *RefreshRoleQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshRoleQuotaRequest) ProtoMessage()    {}
+func (*RefreshRoleQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+
+func (m *RefreshRoleQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshRoleQuotaRequest.Unmarshal(m, b)
+}
+func (m *RefreshRoleQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshRoleQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshRoleQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshRoleQuotaRequest.Merge(m, src)
+}
+func (m *RefreshRoleQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshRoleQuotaRequest.Size(m)
+}
+func (m *RefreshRoleQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshRoleQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshRoleQuotaRequest proto.InternalMessageInfo
+
+func (m *RefreshRoleQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshRoleQuotaRequest) GetQuota() *RoleQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type ListRoleQuotasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListRoleQuotasRequest) Reset()         { *m = ListRoleQuotasRequest{} }
+func (m *ListRoleQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoleQuotasRequest) ProtoMessage()    {}
+func (*ListRoleQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{11}
+}
+
+func (m *ListRoleQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoleQuotasRequest.Unmarshal(m, b)
+}
+func (m *ListRoleQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoleQuotasRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRoleQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleQuotasRequest.Merge(m, src)
+}
+func (m *ListRoleQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRoleQuotasRequest.Size(m)
+}
+func (m *ListRoleQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRoleQuotasRequest proto.InternalMessageInfo
+
+func (m *ListRoleQuotasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListRoleQuotasResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Quotas               []*RoleQuota     `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListRoleQuotasResponse) Reset()         { *m = ListRoleQuotasResponse{} }
+func (m *ListRoleQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRoleQuotasResponse) ProtoMessage()    {}
+func (*ListRoleQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{12}
+}
+
+func (m *ListRoleQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoleQuotasResponse.Unmarshal(m, b)
+}
+func (m *ListRoleQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoleQuotasResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRoleQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleQuotasResponse.Merge(m, src)
+}
+func (m *ListRoleQuotasResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRoleQuotasResponse.Size(m)
+}
+func (m *ListRoleQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRoleQuotasResponse proto.InternalMessageInfo
+
+func (m *ListRoleQuotasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListRoleQuotasResponse) GetQuotas() []*RoleQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+type SaveRoleQuotaRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quota                *RoleQuota        `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SaveRoleQuotaRequest) Reset()         { *m = SaveRoleQuotaRequest{} }
+func (m *SaveRoleQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveRoleQuotaRequest) ProtoMessage()    {}
+func (*SaveRoleQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{13}
+}
+
+func (m *SaveRoleQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveRoleQuotaRequest.Unmarshal(m, b)
+}
+func (m *SaveRoleQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveRoleQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *SaveRoleQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveRoleQuotaRequest.Merge(m, src)
+}
+func (m *SaveRoleQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveRoleQuotaRequest.Size(m)
+}
+func (m *SaveRoleQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveRoleQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveRoleQuotaRequest proto.InternalMessageInfo
+
+func (m *SaveRoleQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SaveRoleQuotaRequest) GetQuota() *RoleQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type DropRoleQuotaRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropRoleQuotaRequest) Reset()         { *m = DropRoleQuotaRequest{} }
+func (m *DropRoleQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleQuotaRequest) ProtoMessage()    {}
+func (*DropRoleQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{14}
+}
+
+func (m *DropRoleQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleQuotaRequest.Unmarshal(m, b)
+}
+func (m *DropRoleQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleQuotaRequest.Merge(m, src)
+}
+func (m *DropRoleQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleQuotaRequest.Size(m)
+}
+func (m *DropRoleQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleQuotaRequest proto.InternalMessageInfo
+
+func (m *DropRoleQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropRoleQuotaRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+// DatabaseQuota limits the request rates of a database and of each collection in it, rates are keyed by
+// internal.RateType names
+type DatabaseQuota struct {
+	DbName               string             `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Rates                map[string]float64 `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	CollectionRates      map[string]float64 `protobuf:"bytes,3,rep,name=collection_rates,json=collectionRates,proto3" json:"collection_rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DatabaseQuota) Reset()         { *m = DatabaseQuota{} }
+func (m *DatabaseQuota) String() string { return proto.CompactTextString(m) }
+func (*DatabaseQuota) ProtoMessage()    {}
+func (*DatabaseQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{15}
+}
+
+func (m *DatabaseQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseQuota.Unmarshal(m, b)
+}
+func (m *DatabaseQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseQuota.Marshal(b, m, deterministic)
+}
+func (m *DatabaseQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseQuota.Merge(m, src)
+}
+func (m *DatabaseQuota) XXX_Size() int {
+	return xxx_messageInfo_DatabaseQuota.Size(m)
+}
+func (m *DatabaseQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseQuota proto.InternalMessageInfo
+
+func (m *DatabaseQuota) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DatabaseQuota) GetRates() map[string]float64 {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *DatabaseQuota) GetCollectionRates() map[string]float64 {
+	if m != nil {
+		return m.CollectionRates
+	}
+	return nil
+}
+
+type RefreshDatabaseQuotaRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// a quota without rates removes the limits of the database
+	Quota                *DatabaseQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RefreshDatabaseQuotaRequest) Reset()         { *m = RefreshDatabaseQuotaRequest{} }
+func (m *RefreshDatabaseQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshDatabaseQuotaRequest) ProtoMessage()    {}
+func (*RefreshDatabaseQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{16}
+}
+
+func (m *RefreshDatabaseQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshDatabaseQuotaRequest.Unmarshal(m, b)
+}
+func (m *RefreshDatabaseQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshDatabaseQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshDatabaseQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshDatabaseQuotaRequest.Merge(m, src)
+}
+func (m *RefreshDatabaseQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshDatabaseQuotaRequest.Size(m)
+}
+func (m *RefreshDatabaseQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshDatabaseQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshDatabaseQuotaRequest proto.InternalMessageInfo
+
+func (m *RefreshDatabaseQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshDatabaseQuotaRequest) GetQuota() *DatabaseQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type ListDatabaseQuotasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDatabaseQuotasRequest) Reset()         { *m = ListDatabaseQuotasRequest{} }
+func (m *ListDatabaseQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabaseQuotasRequest) ProtoMessage()    {}
+func (*ListDatabaseQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{17}
+}
+
+func (m *ListDatabaseQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabaseQuotasRequest.Unmarshal(m, b)
+}
+func (m *ListDatabaseQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabaseQuotasRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabaseQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabaseQuotasRequest.Merge(m, src)
+}
+func (m *ListDatabaseQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabaseQuotasRequest.Size(m)
+}
+func (m *ListDatabaseQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabaseQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabaseQuotasRequest proto.InternalMessageInfo
+
+func (m *ListDatabaseQuotasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListDatabaseQuotasResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Quotas               []*DatabaseQuota `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListDatabaseQuotasResponse) Reset()         { *m = ListDatabaseQuotasResponse{} }
+func (m *ListDatabaseQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabaseQuotasResponse) ProtoMessage()    {}
+func (*ListDatabaseQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{18}
+}
+
+func (m *ListDatabaseQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabaseQuotasResponse.Unmarshal(m, b)
+}
+func (m *ListDatabaseQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabaseQuotasResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabaseQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabaseQuotasResponse.Merge(m, src)
+}
+func (m *ListDatabaseQuotasResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabaseQuotasResponse.Size(m)
+}
+func (m *ListDatabaseQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabaseQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabaseQuotasResponse proto.InternalMessageInfo
+
+func (m *ListDatabaseQuotasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDatabaseQuotasResponse) GetQuotas() []*DatabaseQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+type SaveDatabaseQuotaRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quota                *DatabaseQuota    `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SaveDatabaseQuotaRequest) Reset()         { *m = SaveDatabaseQuotaRequest{} }
+func (m *SaveDatabaseQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveDatabaseQuotaRequest) ProtoMessage()    {}
+func (*SaveDatabaseQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{19}
+}
+
+func (m *SaveDatabaseQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveDatabaseQuotaRequest.Unmarshal(m, b)
+}
+func (m *SaveDatabaseQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveDatabaseQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *SaveDatabaseQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveDatabaseQuotaRequest.Merge(m, src)
+}
+func (m *SaveDatabaseQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveDatabaseQuotaRequest.Size(m)
+}
+func (m *SaveDatabaseQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveDatabaseQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveDatabaseQuotaRequest proto.InternalMessageInfo
+
+func (m *SaveDatabaseQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SaveDatabaseQuotaRequest) GetQuota() *DatabaseQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type DropDatabaseQuotaRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropDatabaseQuotaRequest) Reset()         { *m = DropDatabaseQuotaRequest{} }
+func (m *DropDatabaseQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseQuotaRequest) ProtoMessage()    {}
+func (*DropDatabaseQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{20}
+}
+
+func (m *DropDatabaseQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseQuotaRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseQuotaRequest.Merge(m, src)
+}
+func (m *DropDatabaseQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseQuotaRequest.Size(m)
+}
+func (m *DropDatabaseQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDatabaseQuotaRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropDatabaseQuotaRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+// ReadOnlyMode denies the dml and ddl requests to the cluster, or to the database if db_name is set
+type ReadOnlyMode struct {
+	DbName   string `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ReadOnly bool   `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the unix seconds when the read-only mode is entered
+	Since                int64    `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadOnlyMode) Reset()         { *m = ReadOnlyMode{} }
+func (m *ReadOnlyMode) String() string { return proto.CompactTextString(m) }
+func (*ReadOnlyMode) ProtoMessage()    {}
+func (*ReadOnlyMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{21}
+}
+
+func (m *ReadOnlyMode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadOnlyMode.Unmarshal(m, b)
+}
+func (m *ReadOnlyMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadOnlyMode.Marshal(b, m, deterministic)
+}
+func (m *ReadOnlyMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadOnlyMode.Merge(m, src)
+}
+func (m *ReadOnlyMode) XXX_Size() int {
+	return xxx_messageInfo_ReadOnlyMode.Size(m)
+}
+func (m *ReadOnlyMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadOnlyMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadOnlyMode proto.InternalMessageInfo
+
+func (m *ReadOnlyMode) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ReadOnlyMode) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *ReadOnlyMode) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ReadOnlyMode) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type RefreshReadOnlyModeRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// a mode with read_only unset leaves the read-only mode
+	Mode                 *ReadOnlyMode `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RefreshReadOnlyModeRequest) Reset()         { *m = RefreshReadOnlyModeRequest{} }
+func (m *RefreshReadOnlyModeRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshReadOnlyModeRequest) ProtoMessage()    {}
+func (*RefreshReadOnlyModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{22}
+}
+
+func (m *RefreshReadOnlyModeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshReadOnlyModeRequest.Unmarshal(m, b)
+}
+func (m *RefreshReadOnlyModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshReadOnlyModeRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshReadOnlyModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshReadOnlyModeRequest.Merge(m, src)
+}
+func (m *RefreshReadOnlyModeRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshReadOnlyModeRequest.Size(m)
+}
+func (m *RefreshReadOnlyModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshReadOnlyModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshReadOnlyModeRequest proto.InternalMessageInfo
+
+func (m *RefreshReadOnlyModeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RefreshReadOnlyModeRequest) GetMode() *ReadOnlyMode {
+	if m != nil {
+		return m.Mode
+	}
+	return nil
+}
+
+type ListReadOnlyModesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListReadOnlyModesRequest) Reset()         { *m = ListReadOnlyModesRequest{} }
+func (m *ListReadOnlyModesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReadOnlyModesRequest) ProtoMessage()    {}
+func (*ListReadOnlyModesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{23}
+}
+
+func (m *ListReadOnlyModesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReadOnlyModesRequest.Unmarshal(m, b)
+}
+func (m *ListReadOnlyModesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReadOnlyModesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListReadOnlyModesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReadOnlyModesRequest.Merge(m, src)
+}
+func (m *ListReadOnlyModesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReadOnlyModesRequest.Size(m)
+}
+func (m *ListReadOnlyModesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReadOnlyModesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReadOnlyModesRequest proto.InternalMessageInfo
+
+func (m *ListReadOnlyModesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListReadOnlyModesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Modes                []*ReadOnlyMode  `protobuf:"bytes,2,rep,name=modes,proto3" json:"modes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListReadOnlyModesResponse) Reset()         { *m = ListReadOnlyModesResponse{} }
+func (m *ListReadOnlyModesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReadOnlyModesResponse) ProtoMessage()    {}
+func (*ListReadOnlyModesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{24}
+}
+
+func (m *ListReadOnlyModesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReadOnlyModesResponse.Unmarshal(m, b)
+}
+func (m *ListReadOnlyModesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReadOnlyModesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListReadOnlyModesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReadOnlyModesResponse.Merge(m, src)
+}
+func (m *ListReadOnlyModesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReadOnlyModesResponse.Size(m)
+}
+func (m *ListReadOnlyModesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReadOnlyModesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReadOnlyModesResponse proto.InternalMessageInfo
+
+func (m *ListReadOnlyModesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListReadOnlyModesResponse) GetModes() []*ReadOnlyMode {
+	if m != nil {
+		return m.Modes
+	}
+	return nil
+}
+
+type EnterReadOnlyRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the cluster enters the read-only mode if db_name is empty
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnterReadOnlyRequest) Reset()         { *m = EnterReadOnlyRequest{} }
+func (m *EnterReadOnlyRequest) String() string { return proto.CompactTextString(m) }
+func (*EnterReadOnlyRequest) ProtoMessage()    {}
+func (*EnterReadOnlyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{25}
+}
+
+func (m *EnterReadOnlyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnterReadOnlyRequest.Unmarshal(m, b)
+}
+func (m *EnterReadOnlyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnterReadOnlyRequest.Marshal(b, m, deterministic)
+}
+func (m *EnterReadOnlyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnterReadOnlyRequest.Merge(m, src)
+}
+func (m *EnterReadOnlyRequest) XXX_Size() int {
+	return xxx_messageInfo_EnterReadOnlyRequest.Size(m)
+}
+func (m *EnterReadOnlyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnterReadOnlyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnterReadOnlyRequest proto.InternalMessageInfo
+
+func (m *EnterReadOnlyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *EnterReadOnlyRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *EnterReadOnlyRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type LeaveReadOnlyRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the cluster leaves the read-only mode if db_name is empty
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveReadOnlyRequest) Reset()         { *m = LeaveReadOnlyRequest{} }
+func (m *LeaveReadOnlyRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaveReadOnlyRequest) ProtoMessage()    {}
+func (*LeaveReadOnlyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{26}
+}
+
+func (m *LeaveReadOnlyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveReadOnlyRequest.Unmarshal(m, b)
+}
+func (m *LeaveReadOnlyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveReadOnlyRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaveReadOnlyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveReadOnlyRequest.Merge(m, src)
+}
+func (m *LeaveReadOnlyRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaveReadOnlyRequest.Size(m)
+}
+func (m *LeaveReadOnlyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveReadOnlyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveReadOnlyRequest proto.InternalMessageInfo
+
+func (m *LeaveReadOnlyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LeaveReadOnlyRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type GetSegmentLifecycleRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// the persistent states listed, Growing, Sealed, Flushing and Flushed if empty
+	States               []commonpb.SegmentState `protobuf:"varint,5,rep,packed,name=states,proto3,enum=milvus.proto.common.SegmentState" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetSegmentLifecycleRequest) Reset()         { *m = GetSegmentLifecycleRequest{} }
+func (m *GetSegmentLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentLifecycleRequest) ProtoMessage()    {}
+func (*GetSegmentLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{27}
+}
+
+func (m *GetSegmentLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentLifecycleRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentLifecycleRequest.Merge(m, src)
+}
+func (m *GetSegmentLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentLifecycleRequest.Size(m)
+}
+func (m *GetSegmentLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentLifecycleRequest proto.InternalMessageInfo
+
+func (m *GetSegmentLifecycleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentLifecycleRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetSegmentLifecycleRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetSegmentLifecycleRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *GetSegmentLifecycleRequest) GetStates() []commonpb.SegmentState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type SegmentIndexLifecycle struct {
+	IndexName            string              `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SegmentIndexLifecycle) Reset()         { *m = SegmentIndexLifecycle{} }
+func (m *SegmentIndexLifecycle) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndexLifecycle) ProtoMessage()    {}
+func (*SegmentIndexLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{28}
+}
+
+func (m *SegmentIndexLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentIndexLifecycle.Unmarshal(m, b)
+}
+func (m *SegmentIndexLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentIndexLifecycle.Marshal(b, m, deterministic)
+}
+func (m *SegmentIndexLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndexLifecycle.Merge(m, src)
+}
+func (m *SegmentIndexLifecycle) XXX_Size() int {
+	return xxx_messageInfo_SegmentIndexLifecycle.Size(m)
+}
+func (m *SegmentIndexLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndexLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentIndexLifecycle proto.InternalMessageInfo
+
+func (m *SegmentIndexLifecycle) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *SegmentIndexLifecycle) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *SegmentIndexLifecycle) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+// SegmentLifecycle is the merged view of a segment, a segment loaded but already recycled by DataCoord is in state
+// NotExist
+type SegmentLifecycle struct {
+	SegmentID            int64                    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	InsertChannel        string                   `protobuf:"bytes,4,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	NumRows              int64                    `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	State                commonpb.SegmentState    `protobuf:"varint,6,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	CompactionFrom       []int64                  `protobuf:"varint,7,rep,packed,name=compaction_from,json=compactionFrom,proto3" json:"compaction_from,omitempty"`
+	Indexes              []*SegmentIndexLifecycle `protobuf:"bytes,8,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Loaded               bool                     `protobuf:"varint,9,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	LoadedState          commonpb.SegmentState    `protobuf:"varint,10,opt,name=loaded_state,json=loadedState,proto3,enum=milvus.proto.common.SegmentState" json:"loaded_state,omitempty"`
+	LoadedNodeIDs        []int64                  `protobuf:"varint,11,rep,packed,name=loaded_nodeIDs,json=loadedNodeIDs,proto3" json:"loaded_nodeIDs,omitempty"`
+	MemSize              int64                    `protobuf:"varint,12,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SegmentLifecycle) Reset()         { *m = SegmentLifecycle{} }
+func (m *SegmentLifecycle) String() string { return proto.CompactTextString(m) }
+func (*SegmentLifecycle) ProtoMessage()    {}
+func (*SegmentLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{29}
+}
+
+func (m *SegmentLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentLifecycle.Unmarshal(m, b)
+}
+func (m *SegmentLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentLifecycle.Marshal(b, m, deterministic)
+}
+func (m *SegmentLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentLifecycle.Merge(m, src)
+}
+func (m *SegmentLifecycle) XXX_Size() int {
+	return xxx_messageInfo_SegmentLifecycle.Size(m)
+}
+func (m *SegmentLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentLifecycle proto.InternalMessageInfo
+
+func (m *SegmentLifecycle) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentLifecycle) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SegmentLifecycle) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentLifecycle) GetInsertChannel() string {
+	if m != nil {
+		return m.InsertChannel
+	}
+	return ""
+}
+
+func (m *SegmentLifecycle) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *SegmentLifecycle) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentLifecycle) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
+func (m *SegmentLifecycle) GetIndexes() []*SegmentIndexLifecycle {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *SegmentLifecycle) GetLoaded() bool {
+	if m != nil {
+		return m.Loaded
+	}
+	return false
+}
+
+func (m *SegmentLifecycle) GetLoadedState() commonpb.SegmentState {
+	if m != nil {
+		return m.LoadedState
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentLifecycle) GetLoadedNodeIDs() []int64 {
+	if m != nil {
+		return m.LoadedNodeIDs
+	}
+	return nil
+}
+
+func (m *SegmentLifecycle) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+type GetSegmentLifecycleResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the segments sorted by segment id
+	Segments             []*SegmentLifecycle `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetSegmentLifecycleResponse) Reset()         { *m = GetSegmentLifecycleResponse{} }
+func (m *GetSegmentLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentLifecycleResponse) ProtoMessage()    {}
+func (*GetSegmentLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{30}
+}
+
+func (m *GetSegmentLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentLifecycleResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentLifecycleResponse.Merge(m, src)
+}
+func (m *GetSegmentLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentLifecycleResponse.Size(m)
+}
+func (m *GetSegmentLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentLifecycleResponse proto.InternalMessageInfo
+
+func (m *GetSegmentLifecycleResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentLifecycleResponse) GetSegments() []*SegmentLifecycle {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type GetDeleteTombstonesRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the primary key is parsed by the data type of the primary field
+	PrimaryKey           string   `protobuf:"bytes,4,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeleteTombstonesRequest) Reset()         { *m = GetDeleteTombstonesRequest{} }
+func (m *GetDeleteTombstonesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteTombstonesRequest) ProtoMessage()    {}
+func (*GetDeleteTombstonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{31}
+}
+
+func (m *GetDeleteTombstonesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteTombstonesRequest.Unmarshal(m, b)
+}
+func (m *GetDeleteTombstonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteTombstonesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteTombstonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteTombstonesRequest.Merge(m, src)
+}
+func (m *GetDeleteTombstonesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteTombstonesRequest.Size(m)
+}
+func (m *GetDeleteTombstonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteTombstonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteTombstonesRequest proto.InternalMessageInfo
+
+func (m *GetDeleteTombstonesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDeleteTombstonesRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetDeleteTombstonesRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetDeleteTombstonesRequest) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+// DeleteTombstone is a delete of the primary key persisted in the delta logs of a segment
+type DeleteTombstone struct {
+	SegmentID    int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID  int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Channel      string                `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	SegmentState commonpb.SegmentState `protobuf:"varint,4,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.common.SegmentState" json:"segment_state,omitempty"`
+	Timestamp    uint64                `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DeltaLogPath string                `protobuf:"bytes,6,opt,name=delta_log_path,json=deltaLogPath,proto3" json:"delta_log_path,omitempty"`
+	// the segment the dropped segment is compacted to, the delete is applied by the compaction
+	CompactedTo          int64    `protobuf:"varint,7,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	AppliedByCompaction  bool     `protobuf:"varint,8,opt,name=applied_by_compaction,json=appliedByCompaction,proto3" json:"applied_by_compaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTombstone) Reset()         { *m = DeleteTombstone{} }
+func (m *DeleteTombstone) String() string { return proto.CompactTextString(m) }
+func (*DeleteTombstone) ProtoMessage()    {}
+func (*DeleteTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{32}
+}
+
+func (m *DeleteTombstone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTombstone.Unmarshal(m, b)
+}
+func (m *DeleteTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTombstone.Marshal(b, m, deterministic)
+}
+func (m *DeleteTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTombstone.Merge(m, src)
+}
+func (m *DeleteTombstone) XXX_Size() int {
+	return xxx_messageInfo_DeleteTombstone.Size(m)
+}
+func (m *DeleteTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTombstone proto.InternalMessageInfo
+
+func (m *DeleteTombstone) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *DeleteTombstone) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DeleteTombstone) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *DeleteTombstone) GetSegmentState() commonpb.SegmentState {
+	if m != nil {
+		return m.SegmentState
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *DeleteTombstone) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DeleteTombstone) GetDeltaLogPath() string {
+	if m != nil {
+		return m.DeltaLogPath
+	}
+	return ""
+}
+
+func (m *DeleteTombstone) GetCompactedTo() int64 {
+	if m != nil {
+		return m.CompactedTo
+	}
+	return 0
+}
+
+func (m *DeleteTombstone) GetAppliedByCompaction() bool {
+	if m != nil {
+		return m.AppliedByCompaction
+	}
+	return false
+}
+
+// GetDeleteTombstonesResponse lists the tombstones sorted by timestamp. The deletes not flushed yet are buffered in
+// the DataNodes and not listed, and the tombstones of the segments garbage collected are gone.
+type GetDeleteTombstonesResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PrimaryKey   string           `protobuf:"bytes,3,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// the vchannel the deletes of the primary key are written to
+	Channel              string             `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Tombstones           []*DeleteTombstone `protobuf:"bytes,5,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetDeleteTombstonesResponse) Reset()         { *m = GetDeleteTombstonesResponse{} }
+func (m *GetDeleteTombstonesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteTombstonesResponse) ProtoMessage()    {}
+func (*GetDeleteTombstonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{33}
+}
+
+func (m *GetDeleteTombstonesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteTombstonesResponse.Unmarshal(m, b)
+}
+func (m *GetDeleteTombstonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteTombstonesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteTombstonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteTombstonesResponse.Merge(m, src)
+}
+func (m *GetDeleteTombstonesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteTombstonesResponse.Size(m)
+}
+func (m *GetDeleteTombstonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteTombstonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteTombstonesResponse proto.InternalMessageInfo
+
+func (m *GetDeleteTombstonesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDeleteTombstonesResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetDeleteTombstonesResponse) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *GetDeleteTombstonesResponse) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *GetDeleteTombstonesResponse) GetTombstones() []*DeleteTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+type GetDdlOperationStateRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the operation is the ddl task, the operations on collection_name are returned if 0
+	OperationID int64 `protobuf:"varint,2,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	// all the operations in the journal are returned if both operationID and collection_name are empty
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDdlOperationStateRequest) Reset()         { *m = GetDdlOperationStateRequest{} }
+func (m *GetDdlOperationStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDdlOperationStateRequest) ProtoMessage()    {}
+func (*GetDdlOperationStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{34}
+}
+
+func (m *GetDdlOperationStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDdlOperationStateRequest.Unmarshal(m, b)
+}
+func (m *GetDdlOperationStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDdlOperationStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDdlOperationStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDdlOperationStateRequest.Merge(m, src)
+}
+func (m *GetDdlOperationStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDdlOperationStateRequest.Size(m)
+}
+func (m *GetDdlOperationStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDdlOperationStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDdlOperationStateRequest proto.InternalMessageInfo
+
+func (m *GetDdlOperationStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDdlOperationStateRequest) GetOperationID() int64 {
+	if m != nil {
+		return m.OperationID
+	}
+	return 0
+}
+
+func (m *GetDdlOperationStateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type DdlStep struct {
+	Desc string `protobuf:"bytes,1,opt,name=desc,proto3" json:"desc,omitempty"`
+	// one of meta_write, channel_watch, broadcast and data_cleanup
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// the undo steps are run when the operation failed
+	Undo   bool         `protobuf:"varint,3,opt,name=undo,proto3" json:"undo,omitempty"`
+	State  DdlStepState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.proxy.DdlStepState" json:"state,omitempty"`
+	Reason string       `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the unix milliseconds when the state is updated
+	UpdateTime           int64    `protobuf:"varint,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DdlStep) Reset()         { *m = DdlStep{} }
+func (m *DdlStep) String() string { return proto.CompactTextString(m) }
+func (*DdlStep) ProtoMessage()    {}
+func (*DdlStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{35}
+}
+
+func (m *DdlStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DdlStep.Unmarshal(m, b)
+}
+func (m *DdlStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DdlStep.Marshal(b, m, deterministic)
+}
+func (m *DdlStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DdlStep.Merge(m, src)
+}
+func (m *DdlStep) XXX_Size() int {
+	return xxx_messageInfo_DdlStep.Size(m)
+}
+func (m *DdlStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_DdlStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DdlStep proto.InternalMessageInfo
+
+func (m *DdlStep) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *DdlStep) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *DdlStep) GetUndo() bool {
+	if m != nil {
+		return m.Undo
+	}
+	return false
+}
+
+func (m *DdlStep) GetState() DdlStepState {
+	if m != nil {
+		return m.State
+	}
+	return DdlStepState_DdlStepPending
+}
+
+func (m *DdlStep) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DdlStep) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+// DdlOperation is completed once its asynchronous steps, such as deleting the data of a dropped collection, are
+// completed
+type DdlOperation struct {
+	OperationID    int64             `protobuf:"varint,1,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	Type           string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DbName         string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,5,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Alias          string            `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	Ts             uint64            `protobuf:"varint,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	State          DdlOperationState `protobuf:"varint,8,opt,name=state,proto3,enum=milvus.proto.proxy.DdlOperationState" json:"state,omitempty"`
+	Reason         string            `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Steps          []*DdlStep        `protobuf:"bytes,10,rep,name=steps,proto3" json:"steps,omitempty"`
+	// the unix milliseconds when the operation is created and updated
+	CreateTime           int64    `protobuf:"varint,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime           int64    `protobuf:"varint,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DdlOperation) Reset()         { *m = DdlOperation{} }
+func (m *DdlOperation) String() string { return proto.CompactTextString(m) }
+func (*DdlOperation) ProtoMessage()    {}
+func (*DdlOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{36}
+}
+
+func (m *DdlOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DdlOperation.Unmarshal(m, b)
+}
+func (m *DdlOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DdlOperation.Marshal(b, m, deterministic)
+}
+func (m *DdlOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DdlOperation.Merge(m, src)
+}
+func (m *DdlOperation) XXX_Size() int {
+	return xxx_messageInfo_DdlOperation.Size(m)
+}
+func (m *DdlOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DdlOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DdlOperation proto.InternalMessageInfo
+
+func (m *DdlOperation) GetOperationID() int64 {
+	if m != nil {
+		return m.OperationID
+	}
+	return 0
+}
+
+func (m *DdlOperation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DdlOperation) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DdlOperation) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DdlOperation) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DdlOperation) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *DdlOperation) GetTs() uint64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *DdlOperation) GetState() DdlOperationState {
+	if m != nil {
+		return m.State
+	}
+	return DdlOperationState_DdlOperationRunning
+}
+
+func (m *DdlOperation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DdlOperation) GetSteps() []*DdlStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *DdlOperation) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *DdlOperation) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+type GetDdlOperationStateResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the operations, the latest first
+	Operations           []*DdlOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetDdlOperationStateResponse) Reset()         { *m = GetDdlOperationStateResponse{} }
+func (m *GetDdlOperationStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDdlOperationStateResponse) ProtoMessage()    {}
+func (*GetDdlOperationStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{37}
+}
+
+func (m *GetDdlOperationStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDdlOperationStateResponse.Unmarshal(m, b)
+}
+func (m *GetDdlOperationStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDdlOperationStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDdlOperationStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDdlOperationStateResponse.Merge(m, src)
+}
+func (m *GetDdlOperationStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDdlOperationStateResponse.Size(m)
+}
+func (m *GetDdlOperationStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDdlOperationStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDdlOperationStateResponse proto.InternalMessageInfo
+
+func (m *GetDdlOperationStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDdlOperationStateResponse) GetOperations() []*DdlOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// ProxyTask is a task queued or executing in the task scheduler of a proxy
+type ProxyTask struct {
+	// the task queue, dd, dm or dq
+	Queue          string           `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	TaskID         int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Name           string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type           commonpb.MsgType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.common.MsgType" json:"type,omitempty"`
+	CollectionName string           `protobuf:"bytes,5,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// unix time in milliseconds
+	EnqueueTime          int64          `protobuf:"varint,6,opt,name=enqueue_time,json=enqueueTime,proto3" json:"enqueue_time,omitempty"`
+	State                ProxyTaskState `protobuf:"varint,7,opt,name=state,proto3,enum=milvus.proto.proxy.ProxyTaskState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ProxyTask) Reset()         { *m = ProxyTask{} }
+func (m *ProxyTask) String() string { return proto.CompactTextString(m) }
+func (*ProxyTask) ProtoMessage()    {}
+func (*ProxyTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{38}
+}
+
+func (m *ProxyTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTask.Unmarshal(m, b)
+}
+func (m *ProxyTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTask.Marshal(b, m, deterministic)
+}
+func (m *ProxyTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTask.Merge(m, src)
+}
+func (m *ProxyTask) XXX_Size() int {
+	return xxx_messageInfo_ProxyTask.Size(m)
+}
+func (m *ProxyTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTask proto.InternalMessageInfo
+
+func (m *ProxyTask) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *ProxyTask) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ProxyTask) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTask) GetType() commonpb.MsgType {
+	if m != nil {
+		return m.Type
+	}
+	return commonpb.MsgType_Undefined
+}
+
+func (m *ProxyTask) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ProxyTask) GetEnqueueTime() int64 {
+	if m != nil {
+		return m.EnqueueTime
+	}
+	return 0
+}
+
+func (m *ProxyTask) GetState() ProxyTaskState {
+	if m != nil {
+		return m.State
+	}
+	return ProxyTaskState_ProxyTaskQueued
+}
+
+type ListProxyTasksRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListProxyTasksRequest) Reset()         { *m = ListProxyTasksRequest{} }
+func (m *ListProxyTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProxyTasksRequest) ProtoMessage()    {}
+func (*ListProxyTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{39}
+}
+
+func (m *ListProxyTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProxyTasksRequest.Unmarshal(m, b)
+}
+func (m *ListProxyTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProxyTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProxyTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProxyTasksRequest.Merge(m, src)
+}
+func (m *ListProxyTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProxyTasksRequest.Size(m)
+}
+func (m *ListProxyTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProxyTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProxyTasksRequest proto.InternalMessageInfo
+
+func (m *ListProxyTasksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListProxyTasksResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Draining             bool             `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
+	Queued               int64            `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	Executing            int64            `protobuf:"varint,4,opt,name=executing,proto3" json:"executing,omitempty"`
+	Tasks                []*ProxyTask     `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListProxyTasksResponse) Reset()         { *m = ListProxyTasksResponse{} }
+func (m *ListProxyTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProxyTasksResponse) ProtoMessage()    {}
+func (*ListProxyTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{40}
+}
+
+func (m *ListProxyTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProxyTasksResponse.Unmarshal(m, b)
+}
+func (m *ListProxyTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProxyTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListProxyTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProxyTasksResponse.Merge(m, src)
+}
+func (m *ListProxyTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProxyTasksResponse.Size(m)
+}
+func (m *ListProxyTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProxyTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProxyTasksResponse proto.InternalMessageInfo
+
+func (m *ListProxyTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListProxyTasksResponse) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+func (m *ListProxyTasksResponse) GetQueued() int64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *ListProxyTasksResponse) GetExecuting() int64 {
+	if m != nil {
+		return m.Executing
+	}
+	return 0
+}
+
+func (m *ListProxyTasksResponse) GetTasks() []*ProxyTask {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type DrainProxyRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// true stops accepting new dml and dql requests, false accepts them again
+	Drain                bool     `protobuf:"varint,2,opt,name=drain,proto3" json:"drain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainProxyRequest) Reset()         { *m = DrainProxyRequest{} }
+func (m *DrainProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainProxyRequest) ProtoMessage()    {}
+func (*DrainProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{41}
+}
+
+func (m *DrainProxyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainProxyRequest.Unmarshal(m, b)
+}
+func (m *DrainProxyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainProxyRequest.Marshal(b, m, deterministic)
+}
+func (m *DrainProxyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainProxyRequest.Merge(m, src)
+}
+func (m *DrainProxyRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainProxyRequest.Size(m)
+}
+func (m *DrainProxyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainProxyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainProxyRequest proto.InternalMessageInfo
+
+func (m *DrainProxyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DrainProxyRequest) GetDrain() bool {
+	if m != nil {
+		return m.Drain
+	}
+	return false
+}
+
+type GetLoadProgressDetailRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the progress of the whole collection is returned if empty
+	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoadProgressDetailRequest) Reset()         { *m = GetLoadProgressDetailRequest{} }
+func (m *GetLoadProgressDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadProgressDetailRequest) ProtoMessage()    {}
+func (*GetLoadProgressDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{42}
+}
+
+func (m *GetLoadProgressDetailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadProgressDetailRequest.Unmarshal(m, b)
+}
+func (m *GetLoadProgressDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadProgressDetailRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadProgressDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadProgressDetailRequest.Merge(m, src)
+}
+func (m *GetLoadProgressDetailRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadProgressDetailRequest.Size(m)
+}
+func (m *GetLoadProgressDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadProgressDetailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadProgressDetailRequest proto.InternalMessageInfo
+
+func (m *GetLoadProgressDetailRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadProgressDetailRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetLoadProgressDetailRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetLoadProgressDetailRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+type SegmentLoadProgress struct {
+	SegmentID                 int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID              int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID               int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeID                    int64    `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Phase                     string   `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	TotalFiles                int64    `protobuf:"varint,6,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
+	DownloadedFiles           int64    `protobuf:"varint,7,opt,name=downloaded_files,json=downloadedFiles,proto3" json:"downloaded_files,omitempty"`
+	DownloadPercent           float64  `protobuf:"fixed64,8,opt,name=download_percent,json=downloadPercent,proto3" json:"download_percent,omitempty"`
+	StartTime                 string   `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	ElapsedSeconds            float64  `protobuf:"fixed64,10,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	EstimatedRemainingSeconds float64  `protobuf:"fixed64,11,opt,name=estimated_remaining_seconds,json=estimatedRemainingSeconds,proto3" json:"estimated_remaining_seconds,omitempty"`
+	FailedReason              string   `protobuf:"bytes,12,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *SegmentLoadProgress) Reset()         { *m = SegmentLoadProgress{} }
+func (m *SegmentLoadProgress) String() string { return proto.CompactTextString(m) }
+func (*SegmentLoadProgress) ProtoMessage()    {}
+func (*SegmentLoadProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{43}
+}
+
+func (m *SegmentLoadProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentLoadProgress.Unmarshal(m, b)
+}
+func (m *SegmentLoadProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentLoadProgress.Marshal(b, m, deterministic)
+}
+func (m *SegmentLoadProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentLoadProgress.Merge(m, src)
+}
+func (m *SegmentLoadProgress) XXX_Size() int {
+	return xxx_messageInfo_SegmentLoadProgress.Size(m)
+}
+func (m *SegmentLoadProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentLoadProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentLoadProgress proto.InternalMessageInfo
+
+func (m *SegmentLoadProgress) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *SegmentLoadProgress) GetTotalFiles() int64 {
+	if m != nil {
+		return m.TotalFiles
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetDownloadedFiles() int64 {
+	if m != nil {
+		return m.DownloadedFiles
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetDownloadPercent() float64 {
+	if m != nil {
+		return m.DownloadPercent
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *SegmentLoadProgress) GetElapsedSeconds() float64 {
+	if m != nil {
+		return m.ElapsedSeconds
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetEstimatedRemainingSeconds() float64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
+	}
+	return 0
+}
+
+func (m *SegmentLoadProgress) GetFailedReason() string {
+	if m != nil {
+		return m.FailedReason
+	}
+	return ""
+}
+
+type GetLoadProgressDetailResponse struct {
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress int64            `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	// the longest estimated remaining time of the segments being loaded, -1 means it's unknown yet. The segments not
+	// dispatched to the QueryNodes yet are not counted in.
+	EstimatedRemainingSeconds float64                `protobuf:"fixed64,3,opt,name=estimated_remaining_seconds,json=estimatedRemainingSeconds,proto3" json:"estimated_remaining_seconds,omitempty"`
+	Segments                  []*SegmentLoadProgress `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}               `json:"-"`
+	XXX_unrecognized          []byte                 `json:"-"`
+	XXX_sizecache             int32                  `json:"-"`
+}
+
+func (m *GetLoadProgressDetailResponse) Reset()         { *m = GetLoadProgressDetailResponse{} }
+func (m *GetLoadProgressDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadProgressDetailResponse) ProtoMessage()    {}
+func (*GetLoadProgressDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{44}
+}
+
+func (m *GetLoadProgressDetailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadProgressDetailResponse.Unmarshal(m, b)
+}
+func (m *GetLoadProgressDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadProgressDetailResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadProgressDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadProgressDetailResponse.Merge(m, src)
+}
+func (m *GetLoadProgressDetailResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadProgressDetailResponse.Size(m)
+}
+func (m *GetLoadProgressDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadProgressDetailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadProgressDetailResponse proto.InternalMessageInfo
+
+func (m *GetLoadProgressDetailResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadProgressDetailResponse) GetProgress() int64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *GetLoadProgressDetailResponse) GetEstimatedRemainingSeconds() float64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
+	}
+	return 0
+}
+
+func (m *GetLoadProgressDetailResponse) GetSegments() []*SegmentLoadProgress {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type CompactSegmentsRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the flushed segments of the same channel and partition to compact into one
+	SegmentIDs           []int64  `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactSegmentsRequest) Reset()         { *m = CompactSegmentsRequest{} }
+func (m *CompactSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactSegmentsRequest) ProtoMessage()    {}
+func (*CompactSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{45}
+}
+
+func (m *CompactSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactSegmentsRequest.Unmarshal(m, b)
+}
+func (m *CompactSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *CompactSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactSegmentsRequest.Merge(m, src)
+}
+func (m *CompactSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_CompactSegmentsRequest.Size(m)
+}
+func (m *CompactSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactSegmentsRequest proto.InternalMessageInfo
+
+func (m *CompactSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CompactSegmentsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CompactSegmentsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CompactSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type GetSearchCalibrationRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSearchCalibrationRequest) Reset()         { *m = GetSearchCalibrationRequest{} }
+func (m *GetSearchCalibrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSearchCalibrationRequest) ProtoMessage()    {}
+func (*GetSearchCalibrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{46}
+}
+
+func (m *GetSearchCalibrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSearchCalibrationRequest.Unmarshal(m, b)
+}
+func (m *GetSearchCalibrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSearchCalibrationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSearchCalibrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSearchCalibrationRequest.Merge(m, src)
+}
+func (m *GetSearchCalibrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSearchCalibrationRequest.Size(m)
+}
+func (m *GetSearchCalibrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSearchCalibrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSearchCalibrationRequest proto.InternalMessageInfo
+
+func (m *GetSearchCalibrationRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSearchCalibrationRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetSearchCalibrationRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type SearchCalibrationPoint struct {
+	Value     int64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Samples   int64   `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	LatencyMs float64 `protobuf:"fixed64,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	//-1 if the recall is not calibrated
+	Recall               float64  `protobuf:"fixed64,4,opt,name=recall,proto3" json:"recall,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchCalibrationPoint) Reset()         { *m = SearchCalibrationPoint{} }
+func (m *SearchCalibrationPoint) String() string { return proto.CompactTextString(m) }
+func (*SearchCalibrationPoint) ProtoMessage()    {}
+func (*SearchCalibrationPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{47}
+}
+
+func (m *SearchCalibrationPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCalibrationPoint.Unmarshal(m, b)
+}
+func (m *SearchCalibrationPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCalibrationPoint.Marshal(b, m, deterministic)
+}
+func (m *SearchCalibrationPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCalibrationPoint.Merge(m, src)
+}
+func (m *SearchCalibrationPoint) XXX_Size() int {
+	return xxx_messageInfo_SearchCalibrationPoint.Size(m)
+}
+func (m *SearchCalibrationPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCalibrationPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCalibrationPoint proto.InternalMessageInfo
+
+func (m *SearchCalibrationPoint) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *SearchCalibrationPoint) GetSamples() int64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *SearchCalibrationPoint) GetLatencyMs() float64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *SearchCalibrationPoint) GetRecall() float64 {
+	if m != nil {
+		return m.Recall
+	}
+	return 0
+}
+
+type SearchCalibrationCurve struct {
+	AnnsField            string                    `protobuf:"bytes,1,opt,name=anns_field,json=annsField,proto3" json:"anns_field,omitempty"`
+	IndexID              int64                     `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	Knob                 string                    `protobuf:"bytes,3,opt,name=knob,proto3" json:"knob,omitempty"`
+	Points               []*SearchCalibrationPoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SearchCalibrationCurve) Reset()         { *m = SearchCalibrationCurve{} }
+func (m *SearchCalibrationCurve) String() string { return proto.CompactTextString(m) }
+func (*SearchCalibrationCurve) ProtoMessage()    {}
+func (*SearchCalibrationCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{48}
+}
+
+func (m *SearchCalibrationCurve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCalibrationCurve.Unmarshal(m, b)
+}
+func (m *SearchCalibrationCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCalibrationCurve.Marshal(b, m, deterministic)
+}
+func (m *SearchCalibrationCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCalibrationCurve.Merge(m, src)
+}
+func (m *SearchCalibrationCurve) XXX_Size() int {
+	return xxx_messageInfo_SearchCalibrationCurve.Size(m)
+}
+func (m *SearchCalibrationCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCalibrationCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCalibrationCurve proto.InternalMessageInfo
+
+func (m *SearchCalibrationCurve) GetAnnsField() string {
+	if m != nil {
+		return m.AnnsField
+	}
+	return ""
+}
+
+func (m *SearchCalibrationCurve) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *SearchCalibrationCurve) GetKnob() string {
+	if m != nil {
+		return m.Knob
+	}
+	return ""
+}
+
+func (m *SearchCalibrationCurve) GetPoints() []*SearchCalibrationPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type GetSearchCalibrationResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID         int64                     `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Curves               []*SearchCalibrationCurve `protobuf:"bytes,3,rep,name=curves,proto3" json:"curves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetSearchCalibrationResponse) Reset()         { *m = GetSearchCalibrationResponse{} }
+func (m *GetSearchCalibrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSearchCalibrationResponse) ProtoMessage()    {}
+func (*GetSearchCalibrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{49}
+}
+
+func (m *GetSearchCalibrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSearchCalibrationResponse.Unmarshal(m, b)
+}
+func (m *GetSearchCalibrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSearchCalibrationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSearchCalibrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSearchCalibrationResponse.Merge(m, src)
+}
+func (m *GetSearchCalibrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSearchCalibrationResponse.Size(m)
+}
+func (m *GetSearchCalibrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSearchCalibrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSearchCalibrationResponse proto.InternalMessageInfo
+
+func (m *GetSearchCalibrationResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSearchCalibrationResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetSearchCalibrationResponse) GetCurves() []*SearchCalibrationCurve {
+	if m != nil {
+		return m.Curves
+	}
+	return nil
+}
+
+type SearchRecall struct {
+	Value                int64    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Recall               float64  `protobuf:"fixed64,2,opt,name=recall,proto3" json:"recall,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchRecall) Reset()         { *m = SearchRecall{} }
+func (m *SearchRecall) String() string { return proto.CompactTextString(m) }
+func (*SearchRecall) ProtoMessage()    {}
+func (*SearchRecall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{50}
+}
+
+func (m *SearchRecall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRecall.Unmarshal(m, b)
+}
+func (m *SearchRecall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRecall.Marshal(b, m, deterministic)
+}
+func (m *SearchRecall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRecall.Merge(m, src)
+}
+func (m *SearchRecall) XXX_Size() int {
+	return xxx_messageInfo_SearchRecall.Size(m)
+}
+func (m *SearchRecall) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRecall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRecall proto.InternalMessageInfo
+
+func (m *SearchRecall) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *SearchRecall) GetRecall() float64 {
+	if m != nil {
+		return m.Recall
+	}
+	return 0
+}
+
+type SetSearchRecallsRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	AnnsField      string            `protobuf:"bytes,4,opt,name=anns_field,json=annsField,proto3" json:"anns_field,omitempty"`
+	// the search param the recalls are measured with, e.g. nprobe, ef or search_list
+	Knob                 string          `protobuf:"bytes,5,opt,name=knob,proto3" json:"knob,omitempty"`
+	Recalls              []*SearchRecall `protobuf:"bytes,6,rep,name=recalls,proto3" json:"recalls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetSearchRecallsRequest) Reset()         { *m = SetSearchRecallsRequest{} }
+func (m *SetSearchRecallsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSearchRecallsRequest) ProtoMessage()    {}
+func (*SetSearchRecallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{51}
+}
+
+func (m *SetSearchRecallsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetSearchRecallsRequest.Unmarshal(m, b)
+}
+func (m *SetSearchRecallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetSearchRecallsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetSearchRecallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSearchRecallsRequest.Merge(m, src)
+}
+func (m *SetSearchRecallsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetSearchRecallsRequest.Size(m)
+}
+func (m *SetSearchRecallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSearchRecallsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSearchRecallsRequest proto.InternalMessageInfo
+
+func (m *SetSearchRecallsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetSearchRecallsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *SetSearchRecallsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *SetSearchRecallsRequest) GetAnnsField() string {
+	if m != nil {
+		return m.AnnsField
+	}
+	return ""
+}
+
+func (m *SetSearchRecallsRequest) GetKnob() string {
+	if m != nil {
+		return m.Knob
+	}
+	return ""
+}
+
+func (m *SetSearchRecallsRequest) GetRecalls() []*SearchRecall {
+	if m != nil {
+		return m.Recalls
+	}
+	return nil
+}
+
+type GetHandoffGateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetHandoffGateRequest) Reset()         { *m = GetHandoffGateRequest{} }
+func (m *GetHandoffGateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHandoffGateRequest) ProtoMessage()    {}
+func (*GetHandoffGateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{52}
+}
+
+func (m *GetHandoffGateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHandoffGateRequest.Unmarshal(m, b)
+}
+func (m *GetHandoffGateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHandoffGateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHandoffGateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHandoffGateRequest.Merge(m, src)
+}
+func (m *GetHandoffGateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHandoffGateRequest.Size(m)
+}
+func (m *GetHandoffGateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHandoffGateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHandoffGateRequest proto.InternalMessageInfo
+
+func (m *GetHandoffGateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetHandoffGateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetHandoffGateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type MergeTinySegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MergeTinySegmentsRequest) Reset()         { *m = MergeTinySegmentsRequest{} }
+func (m *MergeTinySegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeTinySegmentsRequest) ProtoMessage()    {}
+func (*MergeTinySegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{53}
+}
+
+func (m *MergeTinySegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeTinySegmentsRequest.Unmarshal(m, b)
+}
+func (m *MergeTinySegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeTinySegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *MergeTinySegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeTinySegmentsRequest.Merge(m, src)
+}
+func (m *MergeTinySegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeTinySegmentsRequest.Size(m)
+}
+func (m *MergeTinySegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeTinySegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeTinySegmentsRequest proto.InternalMessageInfo
+
+func (m *MergeTinySegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MergeTinySegmentsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *MergeTinySegmentsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetSegmentAllocHintsRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// empty for all the partitions of the collection
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentAllocHintsRequest) Reset()         { *m = GetSegmentAllocHintsRequest{} }
+func (m *GetSegmentAllocHintsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentAllocHintsRequest) ProtoMessage()    {}
+func (*GetSegmentAllocHintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{54}
+}
+
+func (m *GetSegmentAllocHintsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentAllocHintsRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentAllocHintsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentAllocHintsRequest.Merge(m, src)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentAllocHintsRequest.Size(m)
+}
+func (m *GetSegmentAllocHintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentAllocHintsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentAllocHintsRequest proto.InternalMessageInfo
+
+func (m *GetSegmentAllocHintsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentAllocHintsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetSegmentAllocHintsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetSegmentAllocHintsRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.proxy.DdlOperationState", DdlOperationState_name, DdlOperationState_value)
+	proto.RegisterEnum("milvus.proto.proxy.DdlStepState", DdlStepState_name, DdlStepState_value)
+	proto.RegisterEnum("milvus.proto.proxy.ProxyTaskState", ProxyTaskState_name, ProxyTaskState_value)
+	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
+	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
+	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
+	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
+	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
+	proto.RegisterType((*DryRunCreateCollectionResponse)(nil), "milvus.proto.proxy.DryRunCreateCollectionResponse")
+	proto.RegisterType((*DryRunCreateIndexResponse)(nil), "milvus.proto.proxy.DryRunCreateIndexResponse")
+	proto.RegisterType((*ReducedPrecisionSearchResults)(nil), "milvus.proto.proxy.ReducedPrecisionSearchResults")
+	proto.RegisterType((*ReducedPrecisionQueryResults)(nil), "milvus.proto.proxy.ReducedPrecisionQueryResults")
+	proto.RegisterMapType((map[int64][]byte)(nil), "milvus.proto.proxy.ReducedPrecisionQueryResults.ReducedVectorsEntry")
+	proto.RegisterType((*RoleQuota)(nil), "milvus.proto.proxy.RoleQuota")
+	proto.RegisterMapType((map[string]float64)(nil), "milvus.proto.proxy.RoleQuota.RatesEntry")
+	proto.RegisterType((*RefreshRoleQuotaRequest)(nil), "milvus.proto.proxy.RefreshRoleQuotaRequest")
+	proto.RegisterType((*ListRoleQuotasRequest)(nil), "milvus.proto.proxy.ListRoleQuotasRequest")
+	proto.RegisterType((*ListRoleQuotasResponse)(nil), "milvus.proto.proxy.ListRoleQuotasResponse")
+	proto.RegisterType((*SaveRoleQuotaRequest)(nil), "milvus.proto.proxy.SaveRoleQuotaRequest")
+	proto.RegisterType((*DropRoleQuotaRequest)(nil), "milvus.proto.proxy.DropRoleQuotaRequest")
+	proto.RegisterType((*DatabaseQuota)(nil), "milvus.proto.proxy.DatabaseQuota")
+	proto.RegisterMapType((map[string]float64)(nil), "milvus.proto.proxy.DatabaseQuota.CollectionRatesEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "milvus.proto.proxy.DatabaseQuota.RatesEntry")
+	proto.RegisterType((*RefreshDatabaseQuotaRequest)(nil), "milvus.proto.proxy.RefreshDatabaseQuotaRequest")
+	proto.RegisterType((*ListDatabaseQuotasRequest)(nil), "milvus.proto.proxy.ListDatabaseQuotasRequest")
+	proto.RegisterType((*ListDatabaseQuotasResponse)(nil), "milvus.proto.proxy.ListDatabaseQuotasResponse")
+	proto.RegisterType((*SaveDatabaseQuotaRequest)(nil), "milvus.proto.proxy.SaveDatabaseQuotaRequest")
+	proto.RegisterType((*DropDatabaseQuotaRequest)(nil), "milvus.proto.proxy.DropDatabaseQuotaRequest")
+	proto.RegisterType((*ReadOnlyMode)(nil), "milvus.proto.proxy.ReadOnlyMode")
+	proto.RegisterType((*RefreshReadOnlyModeRequest)(nil), "milvus.proto.proxy.RefreshReadOnlyModeRequest")
+	proto.RegisterType((*ListReadOnlyModesRequest)(nil), "milvus.proto.proxy.ListReadOnlyModesRequest")
+	proto.RegisterType((*ListReadOnlyModesResponse)(nil), "milvus.proto.proxy.ListReadOnlyModesResponse")
+	proto.RegisterType((*EnterReadOnlyRequest)(nil), "milvus.proto.proxy.EnterReadOnlyRequest")
+	proto.RegisterType((*LeaveReadOnlyRequest)(nil), "milvus.proto.proxy.LeaveReadOnlyRequest")
+	proto.RegisterType((*GetSegmentLifecycleRequest)(nil), "milvus.proto.proxy.GetSegmentLifecycleRequest")
+	proto.RegisterType((*SegmentIndexLifecycle)(nil), "milvus.proto.proxy.SegmentIndexLifecycle")
+	proto.RegisterType((*SegmentLifecycle)(nil), "milvus.proto.proxy.SegmentLifecycle")
+	proto.RegisterType((*GetSegmentLifecycleResponse)(nil), "milvus.proto.proxy.GetSegmentLifecycleResponse")
+	proto.RegisterType((*GetDeleteTombstonesRequest)(nil), "milvus.proto.proxy.GetDeleteTombstonesRequest")
+	proto.RegisterType((*DeleteTombstone)(nil), "milvus.proto.proxy.DeleteTombstone")
+	proto.RegisterType((*GetDeleteTombstonesResponse)(nil), "milvus.proto.proxy.GetDeleteTombstonesResponse")
+	proto.RegisterType((*GetDdlOperationStateRequest)(nil), "milvus.proto.proxy.GetDdlOperationStateRequest")
+	proto.RegisterType((*DdlStep)(nil), "milvus.proto.proxy.DdlStep")
+	proto.RegisterType((*DdlOperation)(nil), "milvus.proto.proxy.DdlOperation")
+	proto.RegisterType((*GetDdlOperationStateResponse)(nil), "milvus.proto.proxy.GetDdlOperationStateResponse")
+	proto.RegisterType((*ProxyTask)(nil), "milvus.proto.proxy.ProxyTask")
+	proto.RegisterType((*ListProxyTasksRequest)(nil), "milvus.proto.proxy.ListProxyTasksRequest")
+	proto.RegisterType((*ListProxyTasksResponse)(nil), "milvus.proto.proxy.ListProxyTasksResponse")
+	proto.RegisterType((*DrainProxyRequest)(nil), "milvus.proto.proxy.DrainProxyRequest")
+	proto.RegisterType((*GetLoadProgressDetailRequest)(nil), "milvus.proto.proxy.GetLoadProgressDetailRequest")
+	proto.RegisterType((*SegmentLoadProgress)(nil), "milvus.proto.proxy.SegmentLoadProgress")
+	proto.RegisterType((*GetLoadProgressDetailResponse)(nil), "milvus.proto.proxy.GetLoadProgressDetailResponse")
+	proto.RegisterType((*CompactSegmentsRequest)(nil), "milvus.proto.proxy.CompactSegmentsRequest")
+	proto.RegisterType((*GetSearchCalibrationRequest)(nil), "milvus.proto.proxy.GetSearchCalibrationRequest")
+	proto.RegisterType((*SearchCalibrationPoint)(nil), "milvus.proto.proxy.SearchCalibrationPoint")
+	proto.RegisterType((*SearchCalibrationCurve)(nil), "milvus.proto.proxy.SearchCalibrationCurve")
+	proto.RegisterType((*GetSearchCalibrationResponse)(nil), "milvus.proto.proxy.GetSearchCalibrationResponse")
+	proto.RegisterType((*SearchRecall)(nil), "milvus.proto.proxy.SearchRecall")
+	proto.RegisterType((*SetSearchRecallsRequest)(nil), "milvus.proto.proxy.SetSearchRecallsRequest")
+	proto.RegisterType((*GetHandoffGateRequest)(nil), "milvus.proto.proxy.GetHandoffGateRequest")
+	proto.RegisterType((*MergeTinySegmentsRequest)(nil), "milvus.proto.proxy.MergeTinySegmentsRequest")
+	proto.RegisterType((*GetSegmentAllocHintsRequest)(nil), "milvus.proto.proxy.GetSegmentAllocHintsRequest")
+}
+
+func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
+
+var fileDescriptor_700b50b08ed8dbaf = []byte{
+	// 4106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x6f, 0x1c, 0xc9,
+	0x75, 0xec, 0x19, 0x7e, 0xcd, 0x9b, 0xe1, 0x70, 0x58, 0xa2, 0xa8, 0xd1, 0x68, 0xa5, 0xa5, 0x5a,
+	0xab, 0x15, 0xc5, 0x5d, 0x51, 0x12, 0x77, 0xd7, 0x5e, 0x2b, 0xb1, 0x12, 0x89, 0x5c, 0x69, 0x89,
+	0xa5, 0xd6, 0xdc, 0x26, 0x77, 0x63, 0x38, 0xc8, 0x8e, 0x8b, 0xd3, 0x45, 0xb2, 0x57, 0xfd, 0xa5,
+	0xae, 0x1a, 0x4a, 0xb3, 0x31, 0xe2, 0x20, 0x88, 0x01, 0x03, 0x0e, 0x9c, 0x8b, 0x03, 0x03, 0x41,
+	0x72, 0xc9, 0x2d, 0x97, 0xdc, 0x6c, 0x04, 0xc9, 0x29, 0x40, 0x4e, 0x0a, 0x92, 0x93, 0xef, 0xb9,
+	0xe5, 0x1f, 0x24, 0xb7, 0xc0, 0x46, 0x7d, 0x74, 0x4f, 0x77, 0x4f, 0xf5, 0xcc, 0x88, 0x94, 0x2c,
+	0xf3, 0x34, 0xf5, 0xfa, 0x55, 0xbd, 0x8f, 0x7a, 0xef, 0xd5, 0x7b, 0x55, 0x8f, 0x50, 0x0d, 0xa3,
+	0xe0, 0x59, 0x6f, 0x2d, 0x8c, 0x02, 0x16, 0x20, 0xe4, 0x39, 0xee, 0x71, 0x97, 0xca, 0xd1, 0x9a,
+	0xf8, 0xd2, 0xaa, 0x75, 0x02, 0xcf, 0x0b, 0x7c, 0x09, 0x6b, 0xd5, 0x1d, 0x9f, 0x91, 0xc8, 0xc7,
+	0xae, 0x1a, 0x37, 0x6c, 0xcc, 0x70, 0xbb, 0x13, 0x04, 0x91, 0xad, 0x20, 0x0b, 0x8e, 0x6f, 0x93,
+	0x67, 0x19, 0x50, 0x2d, 0xbd, 0x6c, 0xab, 0x46, 0x3b, 0x47, 0xc4, 0xc3, 0x72, 0x64, 0xfe, 0xb3,
+	0x01, 0x97, 0xb6, 0xfc, 0x63, 0xec, 0x3a, 0x36, 0x66, 0x64, 0x23, 0x70, 0xdd, 0x47, 0x84, 0xe1,
+	0x0d, 0xdc, 0x39, 0x22, 0x16, 0x79, 0xd2, 0x25, 0x94, 0xa1, 0x5b, 0x30, 0xb9, 0x8f, 0x29, 0x69,
+	0x1a, 0xcb, 0xc6, 0x4a, 0x75, 0xfd, 0x8d, 0xb5, 0x0c, 0x93, 0x8a, 0xbb, 0x47, 0xf4, 0xf0, 0x3e,
+	0xa6, 0xc4, 0x12, 0x98, 0xe8, 0x1c, 0xcc, 0xd8, 0xfb, 0x6d, 0x1f, 0x7b, 0xa4, 0x59, 0x5a, 0x36,
+	0x56, 0x2a, 0xd6, 0xb4, 0xbd, 0xff, 0x29, 0xf6, 0x08, 0xba, 0x06, 0xf3, 0x9d, 0xc0, 0x75, 0x49,
+	0x87, 0x39, 0x81, 0x2f, 0x11, 0xca, 0x02, 0xa1, 0xde, 0x07, 0x0b, 0x44, 0x13, 0x6a, 0x7d, 0xc8,
+	0xd6, 0x66, 0x73, 0x72, 0xd9, 0x58, 0x29, 0x5b, 0x19, 0x98, 0xf9, 0x15, 0xb4, 0x52, 0x9c, 0x47,
+	0xc4, 0x3e, 0x25, 0xd7, 0x2d, 0x98, 0xed, 0x52, 0x12, 0xa5, 0xd8, 0x4e, 0xc6, 0xe6, 0x5f, 0x18,
+	0xb0, 0xf4, 0x79, 0xf8, 0xea, 0x09, 0xf1, 0x6f, 0x21, 0xa6, 0xf4, 0x69, 0x10, 0xd9, 0x4a, 0x35,
+	0xc9, 0xd8, 0xfc, 0x21, 0x5c, 0xb4, 0xc8, 0x41, 0x44, 0xe8, 0xd1, 0x4e, 0xe0, 0x3a, 0x9d, 0xde,
+	0x96, 0x7f, 0x10, 0x9c, 0x92, 0x95, 0x25, 0x98, 0x0e, 0xc2, 0xbd, 0x5e, 0x28, 0x19, 0x99, 0xb2,
+	0xd4, 0x08, 0x2d, 0xc2, 0x54, 0x10, 0x7e, 0x42, 0x7a, 0x8a, 0x07, 0x39, 0x30, 0x7f, 0x65, 0xc0,
+	0xfc, 0x2e, 0x61, 0x16, 0x66, 0x84, 0x9e, 0x9c, 0xe6, 0x6d, 0x98, 0x8a, 0xf8, 0x0a, 0xcd, 0xd2,
+	0x72, 0x79, 0xa5, 0xba, 0x7e, 0x21, 0x3b, 0x25, 0x31, 0x70, 0x4e, 0xc5, 0x92, 0x98, 0xe8, 0x9b,
+	0x30, 0x4d, 0x99, 0x98, 0x53, 0x5e, 0x2e, 0xaf, 0xd4, 0xd7, 0xdf, 0xcc, 0xce, 0x51, 0x83, 0xcf,
+	0xba, 0x01, 0xc3, 0xbb, 0x1c, 0xcf, 0x52, 0xe8, 0xe8, 0x0a, 0xcc, 0x89, 0x5f, 0xed, 0x88, 0x60,
+	0x1a, 0xf8, 0xb4, 0x39, 0xb9, 0x5c, 0x5e, 0xa9, 0x58, 0x35, 0x01, 0xb4, 0x24, 0xcc, 0x7c, 0x5e,
+	0x82, 0x4b, 0x9b, 0x51, 0xcf, 0xea, 0xfa, 0x1b, 0x11, 0x51, 0x5e, 0x20, 0xad, 0xcc, 0x22, 0x34,
+	0x0c, 0x7c, 0x4a, 0xd0, 0x7b, 0x92, 0x81, 0x2e, 0x55, 0x72, 0x5e, 0xd0, 0xca, 0xb9, 0x2b, 0x50,
+	0x2c, 0x85, 0x8a, 0xbe, 0x0d, 0xd3, 0xd2, 0xd7, 0x84, 0x72, 0xab, 0xeb, 0x57, 0xb3, 0x93, 0xe4,
+	0xb7, 0xb5, 0x3e, 0xb5, 0x5d, 0x01, 0xb0, 0xd4, 0x24, 0x74, 0x11, 0x80, 0x1e, 0xe1, 0xc8, 0xa6,
+	0x6d, 0xbf, 0xeb, 0x89, 0x8d, 0x98, 0xb2, 0x2a, 0x12, 0xf2, 0x69, 0xd7, 0x43, 0x16, 0x2c, 0x74,
+	0x02, 0x9f, 0x3a, 0x94, 0x11, 0xbf, 0xd3, 0x6b, 0xbb, 0xe4, 0x98, 0xb8, 0xc2, 0x4f, 0xea, 0xeb,
+	0x57, 0xb5, 0xdc, 0x6d, 0xf4, 0xb1, 0xb7, 0x39, 0xb2, 0xd5, 0xe8, 0xe4, 0x20, 0xe8, 0x1e, 0x40,
+	0x18, 0x05, 0x21, 0x89, 0x98, 0x43, 0x68, 0x73, 0x4a, 0xec, 0xcf, 0x65, 0xed, 0x62, 0x9f, 0x90,
+	0xde, 0x17, 0xd8, 0xed, 0x92, 0x1d, 0xec, 0x44, 0x56, 0x6a, 0x92, 0xf9, 0xcb, 0x12, 0x9c, 0x4f,
+	0x2b, 0x73, 0x8b, 0x87, 0xa3, 0xd3, 0xe9, 0x31, 0x1f, 0x0c, 0x4a, 0x83, 0xc1, 0x00, 0x35, 0x61,
+	0xe6, 0xc0, 0x21, 0xae, 0xbd, 0xb5, 0x29, 0x34, 0x55, 0xb6, 0xe2, 0x21, 0x57, 0xa3, 0xf8, 0x29,
+	0xc3, 0xcd, 0xa4, 0xb0, 0xe7, 0x8a, 0x80, 0x88, 0x48, 0x73, 0x11, 0x40, 0x46, 0x4c, 0xf1, 0x79,
+	0x4a, 0x7e, 0x16, 0x10, 0x15, 0x88, 0xe6, 0x1c, 0xda, 0xc6, 0x5d, 0x16, 0xb4, 0x05, 0xb0, 0x39,
+	0xbd, 0x6c, 0xac, 0xcc, 0x5a, 0x55, 0x87, 0xde, 0xeb, 0xb2, 0x40, 0x08, 0x87, 0x36, 0xa1, 0x26,
+	0x97, 0x08, 0x71, 0x84, 0x3d, 0xda, 0x9c, 0x19, 0x57, 0x6f, 0x55, 0x31, 0x6d, 0x47, 0xcc, 0x32,
+	0xff, 0xae, 0xc4, 0xdd, 0xdb, 0xee, 0x76, 0x88, 0xbd, 0x13, 0x91, 0x8e, 0x43, 0xb9, 0x45, 0x10,
+	0x1c, 0x75, 0x8e, 0x2c, 0x42, 0xbb, 0x2e, 0xa3, 0x27, 0x53, 0xde, 0x1f, 0xc0, 0x4c, 0x24, 0xe7,
+	0x0f, 0xb5, 0xc2, 0x34, 0xa5, 0x4d, 0xcc, 0xb0, 0x15, 0xcf, 0x1a, 0x3f, 0x66, 0x6f, 0x42, 0x25,
+	0x8c, 0x19, 0x57, 0x86, 0xf8, 0x76, 0x91, 0x6f, 0x8b, 0xb5, 0x13, 0x31, 0xad, 0xfe, 0x44, 0x1e,
+	0x91, 0x68, 0x27, 0x88, 0x84, 0xf9, 0x19, 0x2b, 0x35, 0x4b, 0x8d, 0xcc, 0x5f, 0x94, 0xe1, 0x8d,
+	0xbc, 0x7a, 0x3e, 0xeb, 0x92, 0xa8, 0x77, 0x4a, 0xed, 0x54, 0x85, 0x29, 0xd0, 0x36, 0x3f, 0x48,
+	0x55, 0x44, 0xba, 0xa4, 0xd5, 0xd0, 0x03, 0x8e, 0x27, 0x54, 0x23, 0xed, 0x89, 0xf2, 0xdf, 0xbf,
+	0x6d, 0xed, 0x78, 0x30, 0x1f, 0x49, 0x25, 0xb4, 0x8f, 0x49, 0x87, 0x05, 0x51, 0xec, 0xa5, 0x9b,
+	0x6b, 0x83, 0xb9, 0xc3, 0xda, 0x30, 0x7d, 0xc5, 0x1f, 0xbf, 0x90, 0xcb, 0x7c, 0xe4, 0xb3, 0xa8,
+	0x67, 0xd5, 0xa3, 0x0c, 0xb0, 0x75, 0x0f, 0xce, 0x68, 0xd0, 0x50, 0x03, 0xca, 0x8f, 0x49, 0x4f,
+	0xe8, 0xb9, 0x6c, 0xf1, 0x9f, 0xfc, 0xbc, 0x38, 0xe6, 0x66, 0x2d, 0x6c, 0xac, 0x66, 0xc9, 0xc1,
+	0x9d, 0xd2, 0x87, 0x86, 0xf9, 0x0f, 0x06, 0x54, 0xac, 0xc0, 0x25, 0x22, 0x38, 0xa3, 0x0b, 0x50,
+	0x89, 0x02, 0x97, 0x48, 0x45, 0x19, 0xf2, 0x7c, 0xe3, 0x00, 0xa1, 0xa2, 0xbb, 0xd9, 0x83, 0x61,
+	0x45, 0x2b, 0x52, 0xbc, 0x94, 0x38, 0x1f, 0x14, 0xdb, 0x72, 0x5a, 0xeb, 0x43, 0x80, 0x3e, 0x30,
+	0xcd, 0x64, 0x45, 0xc3, 0xa4, 0x91, 0x66, 0xf2, 0xcf, 0x0d, 0x38, 0xa7, 0x8e, 0xd6, 0x84, 0xc0,
+	0xc9, 0x0f, 0xb8, 0xf7, 0x60, 0xea, 0x09, 0x5f, 0x41, 0x39, 0xdc, 0xc5, 0xa1, 0x72, 0x58, 0x12,
+	0xd7, 0xfc, 0x63, 0x38, 0xbb, 0xed, 0x50, 0x96, 0xc0, 0x4f, 0x7e, 0xc0, 0xde, 0x69, 0x3c, 0xbf,
+	0x3b, 0x37, 0x6b, 0x34, 0x7f, 0x1d, 0xff, 0x19, 0xe6, 0x5f, 0x1a, 0xb0, 0x94, 0x5f, 0xfd, 0x34,
+	0x11, 0xf9, 0x03, 0x98, 0x16, 0x5c, 0xc7, 0x5b, 0x35, 0x42, 0x44, 0x85, 0x6c, 0xfe, 0xb5, 0x01,
+	0x8b, 0xbb, 0xf8, 0x98, 0xbc, 0x26, 0x1d, 0x6b, 0x14, 0xf3, 0x14, 0x16, 0x37, 0xa3, 0x20, 0x7c,
+	0x09, 0x0c, 0x65, 0x2c, 0xbb, 0x94, 0xb5, 0x6c, 0x0d, 0xe1, 0xff, 0x2c, 0xc1, 0x1c, 0x0f, 0x20,
+	0x7c, 0xae, 0x74, 0x8d, 0x54, 0xd2, 0x6c, 0x64, 0x92, 0xe6, 0xfb, 0x59, 0xb7, 0x78, 0x57, 0x27,
+	0x6a, 0x66, 0xa9, 0x41, 0xd7, 0x40, 0x18, 0x1a, 0xa9, 0x30, 0x15, 0x25, 0xa9, 0x54, 0x75, 0xfd,
+	0x1b, 0xa3, 0x97, 0x4b, 0xe5, 0x43, 0xfd, 0x85, 0xe7, 0x3b, 0x59, 0xe8, 0xc9, 0xbd, 0xaf, 0x75,
+	0x1f, 0x16, 0x75, 0x24, 0x5e, 0xc8, 0x83, 0x7f, 0x6c, 0xc0, 0x05, 0xe5, 0xc1, 0x19, 0xe6, 0x4f,
+	0xbe, 0xa1, 0xdf, 0xcc, 0x5a, 0xd8, 0xe5, 0x91, 0x7a, 0x8a, 0x3d, 0xb9, 0x0d, 0xe7, 0xb9, 0xaf,
+	0x65, 0xbe, 0xbd, 0x54, 0x6f, 0xfe, 0x2b, 0x03, 0x5a, 0x3a, 0x0a, 0xa7, 0xf1, 0xe8, 0x6f, 0xe5,
+	0x3c, 0x7a, 0x0c, 0x71, 0x63, 0xaf, 0xfe, 0xb9, 0x01, 0x4d, 0xee, 0xd5, 0xaf, 0x59, 0xef, 0x5a,
+	0xef, 0x6e, 0x72, 0xef, 0x7e, 0x49, 0x8c, 0x15, 0x55, 0xb5, 0x1a, 0xc2, 0x11, 0xd4, 0x2c, 0x82,
+	0xed, 0xef, 0xf8, 0x6e, 0xef, 0x51, 0x60, 0x93, 0x62, 0xdf, 0xe6, 0x51, 0x83, 0x60, 0xbb, 0x1d,
+	0xf8, 0x6e, 0x4f, 0xac, 0x3a, 0x6b, 0xcd, 0x46, 0x6a, 0x26, 0x4f, 0x85, 0x64, 0xd9, 0xa2, 0x52,
+	0x0a, 0x35, 0xe2, 0x5e, 0x40, 0x1d, 0xbf, 0x43, 0x54, 0x55, 0x2c, 0x07, 0x3c, 0xc6, 0xb7, 0xe2,
+	0x33, 0x2c, 0x45, 0xfb, 0xe4, 0xf2, 0xbe, 0x0f, 0x93, 0x5e, 0x60, 0x13, 0xb5, 0x0f, 0xcb, 0xfa,
+	0x04, 0x23, 0x45, 0x48, 0x60, 0x9b, 0x5f, 0x42, 0x53, 0x9c, 0x34, 0xa9, 0x2f, 0x2f, 0xd5, 0xf8,
+	0x7f, 0x6c, 0xc0, 0x79, 0x0d, 0x81, 0xd3, 0xd8, 0xfe, 0x37, 0x60, 0x8a, 0xb3, 0x1e, 0x9b, 0xfe,
+	0x68, 0x49, 0x25, 0xba, 0xf9, 0x13, 0x03, 0x16, 0x3f, 0xe2, 0x49, 0x5b, 0xfc, 0xf1, 0x15, 0xdc,
+	0x98, 0x14, 0xd8, 0x80, 0x46, 0x31, 0x14, 0x16, 0xb7, 0x09, 0x3f, 0x5c, 0x5f, 0x19, 0x33, 0x1a,
+	0xa2, 0xff, 0x6f, 0x40, 0xeb, 0x21, 0x61, 0xbb, 0xe4, 0xd0, 0x23, 0x3e, 0xdb, 0x76, 0x0e, 0x48,
+	0xa7, 0xd7, 0x71, 0x5f, 0xeb, 0xd5, 0xd1, 0x35, 0x98, 0x0f, 0x71, 0xc4, 0x9c, 0x04, 0x2f, 0x2e,
+	0xfa, 0xeb, 0x09, 0x98, 0xe3, 0x89, 0x90, 0xa7, 0x2e, 0x15, 0xa6, 0xc4, 0xa5, 0x82, 0xbe, 0x60,
+	0x53, 0xa2, 0x65, 0xae, 0x15, 0xee, 0xcc, 0x3c, 0xbf, 0x3b, 0xd9, 0x80, 0x66, 0xd9, 0xfc, 0xa9,
+	0x01, 0x67, 0x15, 0x86, 0xa8, 0x05, 0x13, 0x0d, 0xe4, 0xea, 0x4a, 0x23, 0x5f, 0x57, 0x7e, 0x00,
+	0x53, 0x62, 0x2d, 0x21, 0xe5, 0xc0, 0x85, 0x86, 0xa2, 0x2d, 0x96, 0x94, 0x94, 0x25, 0x36, 0x7a,
+	0x13, 0xaa, 0x07, 0xd8, 0x71, 0xdb, 0x19, 0x9b, 0x00, 0x0e, 0x92, 0x97, 0x19, 0xe6, 0xaf, 0xcb,
+	0xd0, 0xc8, 0xef, 0x06, 0x7a, 0x03, 0x2a, 0x54, 0x31, 0xb9, 0xa9, 0xb2, 0xf6, 0x3e, 0x60, 0xac,
+	0xf2, 0x7a, 0x19, 0xaa, 0x89, 0xf6, 0x92, 0x12, 0x3b, 0x0d, 0x42, 0x57, 0xa1, 0xee, 0xf8, 0x94,
+	0x44, 0xac, 0xdd, 0x39, 0xc2, 0xbe, 0xaf, 0xee, 0x22, 0x2a, 0xd6, 0x9c, 0x84, 0x6e, 0x48, 0x20,
+	0x3a, 0x0f, 0xb3, 0x7e, 0xd7, 0x6b, 0x47, 0xc1, 0x53, 0x59, 0xe0, 0x95, 0xad, 0x19, 0xbf, 0xeb,
+	0x59, 0xc1, 0x53, 0x7e, 0xc9, 0xa3, 0x54, 0x32, 0xbd, 0x6c, 0x8c, 0xb7, 0x1d, 0x4a, 0x29, 0xc2,
+	0x34, 0xbc, 0x10, 0x4b, 0xd3, 0x38, 0x88, 0x02, 0x4f, 0x94, 0xe0, 0x65, 0xab, 0xde, 0x07, 0x3f,
+	0x88, 0x02, 0x0f, 0x6d, 0xc0, 0x8c, 0xd8, 0x01, 0x42, 0x9b, 0xb3, 0xc2, 0xd5, 0xaf, 0xeb, 0x5c,
+	0x5d, 0xbb, 0x9f, 0x56, 0x3c, 0x93, 0x7b, 0xa4, 0x1b, 0x60, 0x9b, 0xd8, 0xcd, 0x8a, 0x88, 0xd7,
+	0x6a, 0xc4, 0x6f, 0x01, 0xe4, 0xaf, 0xb6, 0x94, 0x02, 0xc6, 0x95, 0xa2, 0x2a, 0xa7, 0x89, 0x01,
+	0x57, 0xa3, 0x5a, 0xc5, 0x0f, 0x6c, 0xb2, 0xb5, 0x49, 0x9b, 0x55, 0x21, 0xca, 0x9c, 0x84, 0x7e,
+	0x2a, 0x81, 0x5c, 0x8d, 0x1e, 0xf1, 0xda, 0xd4, 0xf9, 0x9a, 0x34, 0x6b, 0x52, 0x8d, 0x1e, 0xf1,
+	0x76, 0x9d, 0xaf, 0x89, 0xf9, 0x33, 0x03, 0x2e, 0x68, 0x5d, 0xf2, 0x34, 0x21, 0xf2, 0x0f, 0x61,
+	0x56, 0x19, 0x4c, 0x1c, 0x25, 0xdf, 0x1a, 0xa2, 0xba, 0x3e, 0xd1, 0x64, 0x96, 0xf9, 0x2f, 0x32,
+	0x52, 0x6c, 0x12, 0x97, 0x30, 0xb2, 0x17, 0x78, 0xfb, 0x94, 0x05, 0x3e, 0xa1, 0xaf, 0x33, 0x52,
+	0xbc, 0xc9, 0x6f, 0xdf, 0x1d, 0x0f, 0x47, 0xbd, 0x36, 0xcf, 0x33, 0xa5, 0xbd, 0x82, 0x02, 0x7d,
+	0x42, 0x7a, 0xd2, 0xcd, 0x1b, 0xcd, 0xb2, 0xf9, 0x5f, 0x25, 0x98, 0xcf, 0x71, 0x3e, 0xc2, 0xa9,
+	0x72, 0x0e, 0x53, 0x1a, 0x74, 0x98, 0x26, 0xcc, 0xc4, 0x9e, 0x22, 0xd9, 0x8b, 0x87, 0xe8, 0x01,
+	0xcc, 0xa9, 0x85, 0x94, 0x29, 0x4d, 0x8e, 0x6b, 0x4a, 0x35, 0x9a, 0x1a, 0x71, 0x0e, 0x99, 0xe3,
+	0x11, 0xca, 0xb0, 0x17, 0x0a, 0x67, 0x9b, 0xb4, 0xfa, 0x00, 0xf4, 0x16, 0xd4, 0x6d, 0xe2, 0x32,
+	0xdc, 0x76, 0x83, 0xc3, 0x76, 0x88, 0xd9, 0x91, 0xf0, 0xbb, 0x8a, 0x55, 0x13, 0xd0, 0xed, 0xe0,
+	0x70, 0x07, 0xb3, 0x23, 0x74, 0x19, 0x6a, 0xca, 0x89, 0x88, 0xdd, 0x66, 0x41, 0x73, 0x46, 0x0a,
+	0x92, 0xc0, 0xf6, 0x02, 0xb4, 0x0e, 0x67, 0x71, 0x18, 0xba, 0x0e, 0xb1, 0xdb, 0xfb, 0xbd, 0x76,
+	0xdf, 0xe5, 0x9a, 0xb3, 0xc2, 0x3f, 0xce, 0xa8, 0x8f, 0xf7, 0x7b, 0x1b, 0xc9, 0x27, 0xf3, 0xff,
+	0xa4, 0x91, 0x0e, 0x5a, 0xc3, 0xab, 0xbe, 0x27, 0xcc, 0xed, 0x79, 0x39, 0xbf, 0xe7, 0xe9, 0x6d,
+	0x99, 0xcc, 0x6e, 0xcb, 0x06, 0x00, 0x4b, 0x38, 0x55, 0xd7, 0x2e, 0x57, 0xb4, 0xd9, 0x69, 0x56,
+	0x2a, 0x2b, 0x35, 0xcd, 0xfc, 0x27, 0x25, 0xb8, 0xed, 0x7e, 0x27, 0x24, 0x11, 0x16, 0xd7, 0xbe,
+	0x62, 0xeb, 0x4e, 0xec, 0x07, 0xcb, 0x50, 0x0d, 0xe2, 0xa5, 0xfa, 0x96, 0x96, 0x02, 0x8d, 0xed,
+	0x10, 0x77, 0xd0, 0xf3, 0xbb, 0xf3, 0xb3, 0x46, 0xa3, 0x9c, 0x3e, 0xe1, 0x7f, 0x69, 0xc0, 0xcc,
+	0xa6, 0xed, 0xee, 0x32, 0x12, 0x22, 0x04, 0x93, 0x36, 0xa1, 0x1d, 0x75, 0x9a, 0x89, 0xdf, 0x1c,
+	0xf6, 0xd8, 0xf1, 0x6d, 0xe5, 0x83, 0xe2, 0x37, 0x87, 0x75, 0x7d, 0x3b, 0x10, 0x54, 0x66, 0x2d,
+	0xf1, 0x9b, 0x27, 0x59, 0x69, 0x63, 0xd6, 0x26, 0x59, 0x8a, 0x4e, 0x26, 0xb8, 0xf7, 0x13, 0xa0,
+	0xa9, 0x4c, 0x12, 0xfc, 0x26, 0x54, 0xbb, 0xe2, 0x41, 0xa6, 0xcd, 0x4d, 0x5a, 0xd8, 0x6e, 0xd9,
+	0x02, 0x09, 0xda, 0x73, 0x3c, 0x62, 0xfe, 0x7d, 0x19, 0x6a, 0x69, 0x35, 0xe7, 0x15, 0x65, 0x0c,
+	0x2a, 0x0a, 0xc1, 0x24, 0x8b, 0xdf, 0x42, 0x2a, 0x96, 0xf8, 0x9d, 0x0e, 0x33, 0xe5, 0x51, 0x61,
+	0x66, 0x52, 0x1b, 0x66, 0xae, 0x42, 0x3d, 0x9b, 0x90, 0x28, 0x49, 0xe6, 0x32, 0xf9, 0x08, 0xcf,
+	0xea, 0xb1, 0xeb, 0x60, 0xaa, 0xdc, 0x50, 0x0e, 0x50, 0x1d, 0x4a, 0x8c, 0x0a, 0xaf, 0x9b, 0xb4,
+	0x4a, 0x8c, 0xa2, 0xdf, 0x8b, 0xd5, 0x38, 0xab, 0xbb, 0xe9, 0x4f, 0xd4, 0x98, 0x33, 0xae, 0x01,
+	0x5d, 0x56, 0x32, 0xba, 0xbc, 0xcd, 0x17, 0x25, 0x21, 0x6d, 0x82, 0xee, 0x45, 0x26, 0xb3, 0x37,
+	0x96, 0xc4, 0xe4, 0xea, 0xef, 0x44, 0x24, 0x51, 0x7f, 0x55, 0xaa, 0x5f, 0x82, 0xb8, 0xfa, 0xf3,
+	0xfb, 0x53, 0x1b, 0xd8, 0x9f, 0xbf, 0x31, 0xe0, 0x0d, 0xbd, 0x27, 0x9c, 0xee, 0xa0, 0x82, 0x64,
+	0x47, 0x87, 0x26, 0xf4, 0x69, 0xba, 0x56, 0x6a, 0x8e, 0xf9, 0xa3, 0x12, 0x54, 0x76, 0x38, 0xca,
+	0x1e, 0xa6, 0x8f, 0xf9, 0xae, 0x3c, 0xe9, 0x92, 0x6e, 0x9c, 0xc1, 0xc9, 0x01, 0x57, 0x24, 0xc3,
+	0xf4, 0x71, 0xe2, 0x6e, 0x6a, 0xc4, 0x0d, 0x28, 0x65, 0x29, 0xe2, 0x37, 0xf7, 0x68, 0x61, 0x54,
+	0xd2, 0xee, 0x0b, 0x3d, 0x9a, 0x3f, 0xbb, 0x29, 0x93, 0xd3, 0x58, 0xd6, 0x94, 0xd6, 0xb2, 0x2e,
+	0x43, 0x8d, 0xf8, 0x82, 0xa3, 0xb4, 0x13, 0x54, 0x15, 0x4c, 0x6c, 0xc3, 0x87, 0xb1, 0xbd, 0xcc,
+	0x08, 0xf2, 0xa6, 0x4e, 0x15, 0x89, 0xb4, 0x69, 0x63, 0x89, 0x2f, 0x24, 0x93, 0x8f, 0x2f, 0xb5,
+	0x8a, 0xfb, 0x95, 0xba, 0x90, 0x4c, 0xaf, 0x7e, 0x9a, 0x6d, 0x6f, 0xc1, 0xac, 0x1d, 0x61, 0xc7,
+	0x77, 0xfc, 0xc3, 0xb8, 0x8c, 0x8e, 0xc7, 0x7c, 0xb3, 0x84, 0x3e, 0x6c, 0x95, 0xb6, 0xaa, 0x11,
+	0x3f, 0x1e, 0xc9, 0x33, 0xd2, 0xe9, 0x32, 0x3e, 0x49, 0x96, 0xd2, 0x7d, 0x00, 0xbf, 0x60, 0xe4,
+	0x9b, 0x1a, 0x07, 0xfa, 0x8b, 0x43, 0x15, 0x67, 0x49, 0x5c, 0xd3, 0x83, 0x85, 0x4d, 0x4e, 0x56,
+	0x7c, 0x38, 0x79, 0x48, 0x5f, 0x84, 0x29, 0xc1, 0xbd, 0x12, 0x45, 0x0e, 0x34, 0x5a, 0xfc, 0x77,
+	0xe9, 0x42, 0xdb, 0x01, 0xb6, 0x77, 0xa2, 0xe0, 0x30, 0x22, 0x94, 0x6e, 0x12, 0x26, 0x8a, 0x81,
+	0xdf, 0xfd, 0xfa, 0x4b, 0x66, 0x57, 0x53, 0xcd, 0xb2, 0xf9, 0x1f, 0x65, 0x38, 0x13, 0x67, 0x8e,
+	0x29, 0x51, 0x7e, 0x2b, 0x65, 0xcb, 0x12, 0x4c, 0xcb, 0x44, 0x5b, 0x59, 0x80, 0x1a, 0xf1, 0x2d,
+	0x08, 0x8f, 0x30, 0x8d, 0x3d, 0x4f, 0x0e, 0x78, 0x50, 0x63, 0x01, 0xc3, 0x6e, 0xfb, 0xc0, 0x71,
+	0x09, 0x8d, 0x0f, 0x1d, 0x01, 0x7a, 0xc0, 0x21, 0xe8, 0x3a, 0x34, 0xec, 0xe0, 0xa9, 0xaf, 0x52,
+	0x78, 0x89, 0x25, 0x53, 0xa6, 0xf9, 0x3e, 0x7c, 0x00, 0xb5, 0x1d, 0x92, 0xa8, 0x43, 0x7c, 0x26,
+	0x82, 0xba, 0xd1, 0x47, 0xdd, 0x91, 0x60, 0xf1, 0x12, 0xcc, 0x70, 0xc4, 0xa4, 0x97, 0xcb, 0xd8,
+	0x5d, 0x11, 0x10, 0xe1, 0xe3, 0xd7, 0x60, 0x9e, 0xb8, 0x38, 0xa4, 0xbc, 0xf4, 0x20, 0x9d, 0xc0,
+	0xb7, 0xa9, 0x28, 0x3e, 0x0c, 0xab, 0xae, 0xc0, 0xbb, 0x12, 0x8a, 0xee, 0xc2, 0x05, 0x42, 0x99,
+	0xe3, 0x61, 0x9e, 0xcc, 0x45, 0xc4, 0x93, 0x0e, 0x92, 0x4c, 0xaa, 0x8a, 0x49, 0xe7, 0x13, 0x14,
+	0x2b, 0xc6, 0x88, 0xe7, 0x5f, 0x81, 0x39, 0x5e, 0x6a, 0x8a, 0xc9, 0xe2, 0x18, 0xa9, 0xc9, 0x8c,
+	0x51, 0x02, 0x55, 0x05, 0xfa, 0xbf, 0x06, 0x5c, 0x2c, 0x30, 0xca, 0x53, 0x7a, 0x78, 0xa8, 0x96,
+	0x53, 0x5b, 0x9d, 0x8c, 0x47, 0xc9, 0x55, 0x1e, 0x25, 0xd7, 0x46, 0xaa, 0xba, 0x99, 0x14, 0xee,
+	0x7e, 0x6d, 0x58, 0x75, 0x93, 0x92, 0x2c, 0x55, 0xe0, 0xfc, 0xc2, 0x80, 0x25, 0x95, 0xe1, 0x2a,
+	0xc4, 0xd7, 0x5a, 0xdc, 0x5c, 0x02, 0x48, 0x7c, 0x45, 0x4a, 0x55, 0xb6, 0x52, 0x10, 0xe9, 0x7d,
+	0x33, 0xcd, 0xb2, 0xf9, 0xb7, 0x71, 0xbd, 0xc8, 0x1f, 0x80, 0x37, 0xb0, 0xeb, 0xec, 0xab, 0x43,
+	0xf1, 0xf5, 0x31, 0xdf, 0xbf, 0x5f, 0xf9, 0x21, 0x2c, 0x0d, 0x30, 0xb6, 0x13, 0x38, 0x3e, 0xeb,
+	0x3f, 0x05, 0xc8, 0xc0, 0x20, 0x07, 0x3c, 0x7b, 0xa7, 0xd8, 0x0b, 0x5d, 0x12, 0x1b, 0x49, 0x3c,
+	0xe4, 0x3e, 0xe4, 0x62, 0xd9, 0x2a, 0xe1, 0xc5, 0x26, 0x51, 0x51, 0x90, 0x47, 0x54, 0xa6, 0x46,
+	0x1d, 0xec, 0xca, 0xac, 0xdf, 0xb0, 0xd4, 0xc8, 0xfc, 0x47, 0x43, 0xc3, 0xc1, 0x46, 0x37, 0x3a,
+	0x16, 0x37, 0x3c, 0xd8, 0xf7, 0x69, 0x5b, 0xbc, 0x06, 0xc7, 0x37, 0x3c, 0x1c, 0x22, 0x5e, 0x8a,
+	0x39, 0x2b, 0xe2, 0xca, 0x20, 0x09, 0x4d, 0xf1, 0x50, 0xa4, 0xcc, 0x7e, 0xb0, 0x1f, 0x67, 0x09,
+	0xfc, 0x37, 0xba, 0x0f, 0xd3, 0x21, 0x97, 0x2b, 0x36, 0xc0, 0x55, 0xbd, 0x01, 0xea, 0x54, 0x61,
+	0xa9, 0x99, 0xe6, 0xbf, 0xca, 0xe3, 0x40, 0xb3, 0x93, 0xaf, 0xba, 0xaa, 0xba, 0x0f, 0xd3, 0x1d,
+	0xae, 0x93, 0xf8, 0x51, 0x69, 0x3c, 0xee, 0x85, 0x1a, 0x2d, 0x35, 0xd3, 0xfc, 0x7d, 0xa8, 0xc5,
+	0x4d, 0x08, 0x5c, 0xf3, 0x05, 0x1b, 0xdc, 0xdf, 0xa7, 0x52, 0x66, 0x9f, 0x7e, 0x5a, 0x82, 0x73,
+	0xbb, 0x84, 0xa5, 0x57, 0x78, 0xad, 0xee, 0x97, 0x35, 0x8e, 0xc9, 0xbc, 0x71, 0xc4, 0x26, 0x30,
+	0x95, 0x32, 0x81, 0x3b, 0xbc, 0x53, 0x43, 0x30, 0xde, 0x9c, 0x2e, 0xce, 0x5b, 0xd3, 0x12, 0x5a,
+	0xf1, 0x04, 0x4d, 0x6e, 0xf0, 0x33, 0x03, 0xce, 0x3e, 0x24, 0xec, 0x63, 0xec, 0xdb, 0xc1, 0xc1,
+	0xc1, 0xc3, 0x53, 0x95, 0x98, 0x2f, 0xd1, 0xa1, 0xf9, 0x63, 0xd1, 0x23, 0x12, 0x1d, 0x92, 0x3d,
+	0xc7, 0xef, 0xfd, 0x0e, 0xc4, 0xc9, 0x7e, 0x1c, 0xfc, 0xb7, 0xcc, 0xbd, 0xd9, 0x3d, 0xd7, 0x0d,
+	0x3a, 0x1f, 0x3b, 0xaf, 0x39, 0x88, 0x0f, 0x96, 0x8e, 0x93, 0x9a, 0xd2, 0x31, 0xd1, 0xee, 0xea,
+	0x0f, 0x60, 0x61, 0xa0, 0x9e, 0x42, 0xe7, 0xe0, 0x4c, 0x1a, 0x68, 0x75, 0x7d, 0x7e, 0xf6, 0x35,
+	0x26, 0xd0, 0x79, 0x38, 0x9b, 0xfe, 0xc0, 0x0f, 0x2f, 0x97, 0x30, 0x62, 0x37, 0x0c, 0xb4, 0x04,
+	0x28, 0xfd, 0xe9, 0x81, 0x38, 0xe0, 0x1b, 0x25, 0x74, 0x01, 0xce, 0xa5, 0xe1, 0x5b, 0x3e, 0x23,
+	0x51, 0xd4, 0x0d, 0xf9, 0xa4, 0xf2, 0x2a, 0x83, 0x9a, 0xaa, 0x12, 0x25, 0x61, 0x04, 0x75, 0x35,
+	0xde, 0x21, 0xbe, 0x2d, 0x69, 0xf6, 0x61, 0x31, 0x1f, 0x06, 0x3a, 0x03, 0xf3, 0x31, 0x8c, 0xb0,
+	0xa8, 0xc7, 0x81, 0x25, 0xb4, 0x08, 0x0d, 0x05, 0xec, 0xf3, 0x55, 0x46, 0x0b, 0x30, 0xa7, 0xa0,
+	0x8a, 0xa5, 0xc9, 0xd5, 0x6f, 0x43, 0x3d, 0x5b, 0xc0, 0xf0, 0xf5, 0x12, 0xc8, 0x67, 0x22, 0xd7,
+	0x6f, 0x4c, 0x70, 0x89, 0x12, 0xe0, 0x47, 0x71, 0x96, 0xdf, 0x30, 0xd6, 0xff, 0xbb, 0x02, 0x53,
+	0xe2, 0x03, 0x72, 0x01, 0x3d, 0x24, 0x8c, 0x53, 0x0b, 0xfc, 0xf8, 0x0e, 0x8d, 0xa2, 0x35, 0x6d,
+	0xab, 0xe1, 0x20, 0xa2, 0x32, 0x93, 0xd6, 0x5b, 0x5a, 0xfc, 0x1c, 0xb2, 0x39, 0x81, 0x9e, 0xc0,
+	0x22, 0xb7, 0x36, 0x86, 0x99, 0x43, 0x99, 0xd3, 0xa1, 0xf1, 0x05, 0xf9, 0x7a, 0x41, 0x53, 0x90,
+	0x0e, 0x39, 0xa6, 0x79, 0x45, 0x4b, 0x73, 0x97, 0x45, 0x8e, 0x7f, 0x18, 0x07, 0x7f, 0x73, 0x02,
+	0x45, 0x70, 0x31, 0xdb, 0xea, 0x2b, 0x2d, 0x2d, 0x69, 0xf8, 0x45, 0xeb, 0xba, 0x80, 0x33, 0xbc,
+	0x3b, 0xb8, 0x35, 0xec, 0x0c, 0x31, 0x27, 0x10, 0x86, 0x9a, 0x28, 0xf2, 0x63, 0xf1, 0x56, 0x8b,
+	0xc5, 0x4b, 0x90, 0x5e, 0x50, 0xac, 0xaf, 0xe0, 0x7c, 0xb6, 0x0f, 0x98, 0xf8, 0xcc, 0xc1, 0xae,
+	0x14, 0x69, 0x6d, 0x84, 0x48, 0xb9, 0x6e, 0xde, 0x51, 0xe2, 0xec, 0xc3, 0xd9, 0xcf, 0x43, 0x1d,
+	0x1d, 0xed, 0x89, 0xf7, 0x79, 0x78, 0x12, 0x1a, 0x5f, 0xc1, 0x92, 0xbe, 0xcd, 0x17, 0xdd, 0xd6,
+	0xbf, 0x4c, 0x0e, 0x69, 0x09, 0x1e, 0x45, 0xcb, 0x86, 0xf9, 0x87, 0x44, 0x56, 0xe1, 0x8f, 0x08,
+	0x8b, 0x9c, 0x0e, 0x45, 0x6f, 0x17, 0x19, 0xbc, 0x42, 0x88, 0x57, 0xbe, 0x36, 0x12, 0x2f, 0xd9,
+	0xa1, 0x4f, 0x61, 0x36, 0x6e, 0x1b, 0x46, 0x57, 0xf4, 0x87, 0x5a, 0xa6, 0xa9, 0x78, 0x14, 0xd7,
+	0x5f, 0x42, 0x23, 0xdf, 0xad, 0x85, 0xde, 0x19, 0xa2, 0x9b, 0x7c, 0x7b, 0xcf, 0xa8, 0xf5, 0x0f,
+	0x60, 0x51, 0xd7, 0x4b, 0x82, 0x6e, 0x0e, 0xa1, 0xa1, 0x6b, 0x32, 0x18, 0xad, 0xfd, 0x33, 0x9a,
+	0x17, 0x7b, 0xbd, 0xcd, 0x16, 0x3f, 0xed, 0x8f, 0xa0, 0xb2, 0xfe, 0x3f, 0xd7, 0xa1, 0xf1, 0x48,
+	0x20, 0x7c, 0xf4, 0x8c, 0xed, 0x92, 0xe8, 0xd8, 0xe9, 0x10, 0xf4, 0x03, 0x58, 0xd2, 0xb7, 0x3c,
+	0xa3, 0x77, 0xf5, 0x01, 0x6c, 0xa0, 0x33, 0x5a, 0xd2, 0xd6, 0x86, 0x8c, 0xe1, 0xcd, 0xd4, 0xe6,
+	0x04, 0x12, 0xf7, 0x24, 0xb9, 0x1e, 0x61, 0x74, 0x6d, 0x08, 0x61, 0xd5, 0x45, 0x2c, 0x69, 0xde,
+	0x18, 0x45, 0x33, 0xd3, 0x73, 0x6c, 0x4e, 0xa0, 0x1f, 0x19, 0xd0, 0xb4, 0xc8, 0x7e, 0xd7, 0x71,
+	0xed, 0x4d, 0xc2, 0x9b, 0x29, 0x79, 0x15, 0xb8, 0xa5, 0xde, 0xf3, 0x72, 0x12, 0xd8, 0x98, 0xe1,
+	0xb5, 0x22, 0xe4, 0x98, 0x83, 0xf7, 0x5e, 0x68, 0x4e, 0xc2, 0xc7, 0x93, 0xb8, 0x96, 0xc8, 0x37,
+	0x66, 0x22, 0x53, 0x1f, 0xea, 0x14, 0xb2, 0x24, 0x7a, 0x7b, 0x9c, 0x16, 0xcf, 0x4c, 0xc7, 0xb0,
+	0x39, 0x81, 0x7c, 0x38, 0xab, 0xba, 0x3e, 0x73, 0x14, 0x2f, 0x17, 0xb4, 0xd0, 0x0b, 0x5c, 0x49,
+	0xf0, 0xd6, 0x8b, 0xf6, 0x94, 0x9a, 0x13, 0xc8, 0x81, 0x7a, 0xb6, 0xd1, 0x10, 0x69, 0xdf, 0x58,
+	0xb5, 0xad, 0x8e, 0xad, 0xd5, 0x71, 0x50, 0x13, 0x6d, 0x7e, 0x17, 0xe6, 0x32, 0xcd, 0x84, 0x48,
+	0xdb, 0x30, 0xaa, 0xeb, 0x37, 0x1c, 0xe5, 0x97, 0xdf, 0x85, 0xb9, 0x4c, 0x57, 0xa0, 0x7e, 0x65,
+	0x5d, 0xe3, 0xe0, 0xa8, 0x95, 0xbb, 0x80, 0x06, 0x3b, 0xb7, 0xd0, 0x8d, 0x22, 0xb9, 0xb5, 0x3d,
+	0x64, 0xad, 0xb5, 0x71, 0xd1, 0x13, 0x55, 0x7d, 0x1f, 0x16, 0x06, 0x3a, 0xb4, 0xd0, 0xbb, 0x45,
+	0xea, 0x3a, 0x49, 0x28, 0xfb, 0x3e, 0x2c, 0x0c, 0xb4, 0x5a, 0xe9, 0x29, 0x14, 0x75, 0x64, 0x8d,
+	0xa2, 0x10, 0xc1, 0xc2, 0x40, 0xdf, 0x8f, 0x9e, 0x42, 0x51, 0xff, 0x51, 0xeb, 0xc6, 0x98, 0xd8,
+	0x69, 0x13, 0xcb, 0x34, 0xf8, 0xe8, 0x0d, 0x41, 0xd7, 0x03, 0x34, 0x86, 0x89, 0x65, 0xba, 0x75,
+	0xf4, 0x2b, 0xeb, 0x1a, 0x7a, 0x46, 0xad, 0xfc, 0x0c, 0xce, 0x68, 0x9e, 0xff, 0xf5, 0x87, 0x4a,
+	0x71, 0xeb, 0x4e, 0xeb, 0xe6, 0xd8, 0xf8, 0x89, 0xb6, 0xfe, 0x0c, 0xce, 0x6e, 0x1c, 0x91, 0xce,
+	0x63, 0x11, 0xf8, 0x52, 0xff, 0x6d, 0x82, 0x6e, 0xe5, 0x93, 0x3e, 0x9b, 0x3c, 0x5b, 0xd3, 0xa2,
+	0x16, 0xc4, 0xba, 0xa1, 0x33, 0x12, 0xfa, 0x52, 0xf2, 0xfc, 0x9b, 0x72, 0xa1, 0xe4, 0x05, 0xad,
+	0x08, 0xad, 0x9b, 0x63, 0xe3, 0x27, 0x94, 0xff, 0x54, 0x24, 0xf3, 0x83, 0xa5, 0x57, 0xe1, 0x52,
+	0x05, 0xcf, 0xbf, 0xad, 0x5b, 0xe3, 0x4f, 0x48, 0x88, 0x77, 0x45, 0xdd, 0x92, 0xf4, 0x0a, 0xc9,
+	0x0a, 0x01, 0xdd, 0xd0, 0x69, 0x70, 0x10, 0xaf, 0x20, 0xa6, 0x14, 0xa3, 0xa7, 0x7c, 0xa3, 0xb2,
+	0x13, 0x91, 0x2d, 0x2f, 0x0c, 0x22, 0x86, 0xae, 0x68, 0x0e, 0xc4, 0xe4, 0x6b, 0x41, 0x69, 0x94,
+	0x47, 0x4a, 0x56, 0x76, 0x61, 0x7e, 0x23, 0x88, 0x6c, 0x5e, 0x5e, 0xf2, 0x76, 0x29, 0x9e, 0x12,
+	0xad, 0x6a, 0xed, 0x21, 0x8b, 0x14, 0x93, 0x79, 0x67, 0x2c, 0xdc, 0x84, 0x5a, 0x08, 0x0b, 0x7d,
+	0xb3, 0xfe, 0xd8, 0xa1, 0x2c, 0x88, 0x7a, 0xe8, 0x1d, 0x0d, 0xab, 0x03, 0x58, 0x31, 0xc1, 0x77,
+	0xc7, 0x43, 0x4e, 0x28, 0xfe, 0xc4, 0x80, 0xd6, 0x0e, 0xee, 0xd2, 0x74, 0x0d, 0x86, 0x79, 0x25,
+	0xe4, 0x63, 0xbf, 0x43, 0xd0, 0xfb, 0x3a, 0x35, 0x15, 0xa2, 0xc7, 0x4c, 0x7c, 0xf0, 0x82, 0xb3,
+	0x12, 0x6e, 0x28, 0x6f, 0x9c, 0xa6, 0x5d, 0xaf, 0x80, 0x9b, 0x0f, 0xb4, 0xa9, 0x4e, 0x21, 0xfe,
+	0x98, 0x41, 0xea, 0xe7, 0x06, 0x5c, 0x12, 0x35, 0xb4, 0x66, 0x09, 0xc1, 0x35, 0x45, 0x1f, 0xea,
+	0xb5, 0x3a, 0x64, 0x4a, 0x4c, 0xfb, 0x5b, 0x27, 0x98, 0x99, 0xa8, 0x43, 0x25, 0x30, 0xfd, 0x87,
+	0xc9, 0xe2, 0x04, 0x66, 0xe0, 0x69, 0xb4, 0xb5, 0x3a, 0x0e, 0x6a, 0x42, 0x0a, 0x03, 0xf4, 0x5f,
+	0x0b, 0x91, 0xfe, 0x29, 0x3f, 0xff, 0x9a, 0xf8, 0x82, 0x24, 0xbe, 0x07, 0x95, 0xbd, 0xc8, 0x39,
+	0x3c, 0x24, 0xd1, 0xc3, 0x0d, 0xf4, 0x96, 0xce, 0x31, 0x92, 0xcf, 0x31, 0x81, 0xab, 0x23, 0xb0,
+	0x52, 0x9a, 0x5a, 0xdc, 0x24, 0x7c, 0x63, 0x1d, 0xca, 0x13, 0x41, 0x7e, 0xa6, 0x0b, 0x5f, 0x7d,
+	0x5b, 0xa3, 0xfe, 0x34, 0x62, 0x41, 0x01, 0xa9, 0xc1, 0x4b, 0xfb, 0xe8, 0x76, 0xc0, 0x93, 0xea,
+	0x9d, 0xa4, 0x51, 0x87, 0x6a, 0x7d, 0x74, 0x00, 0x6b, 0x98, 0x8f, 0x6a, 0x90, 0x13, 0x8a, 0xc7,
+	0x70, 0x66, 0xcb, 0xa7, 0x21, 0x49, 0x1e, 0x73, 0xb6, 0x83, 0xce, 0xe3, 0x81, 0xa8, 0x2a, 0x96,
+	0xd1, 0xe0, 0x15, 0x44, 0xd5, 0x62, 0xf4, 0xf4, 0x19, 0xaa, 0x7d, 0x3c, 0x43, 0x45, 0x27, 0x43,
+	0xe1, 0xe3, 0x6f, 0xeb, 0xf6, 0x0b, 0xcc, 0x48, 0xe8, 0xfb, 0x30, 0x9f, 0x7b, 0xc4, 0xd2, 0x5f,
+	0x6d, 0xe8, 0x5f, 0xba, 0xf2, 0x19, 0x96, 0x1a, 0x3c, 0xc2, 0x7e, 0x17, 0xbb, 0xfd, 0xf6, 0xaf,
+	0x81, 0x93, 0x73, 0xe0, 0x69, 0x00, 0x15, 0xa7, 0x1f, 0xfa, 0x67, 0xaa, 0xd6, 0xad, 0xf1, 0x27,
+	0x24, 0xc4, 0xbf, 0xe4, 0xbd, 0xb2, 0xd9, 0x37, 0x03, 0xfd, 0x3d, 0x42, 0xc1, 0xcb, 0xc2, 0xa8,
+	0x28, 0x77, 0x04, 0xf5, 0xec, 0x15, 0xbc, 0x3e, 0x96, 0x68, 0xaf, 0xe9, 0x5b, 0xd7, 0xf5, 0x51,
+	0x2c, 0x83, 0x99, 0x48, 0x72, 0x00, 0x73, 0x3c, 0x06, 0x88, 0xf3, 0x6d, 0x6f, 0x6f, 0x9b, 0xa2,
+	0x15, 0x9d, 0x17, 0x67, 0x50, 0x0a, 0xe8, 0x68, 0x31, 0x13, 0x3a, 0x7b, 0x50, 0xdd, 0x25, 0xc9,
+	0x17, 0xf4, 0xb6, 0x6e, 0x6e, 0x0a, 0x61, 0xec, 0xfb, 0x96, 0xb9, 0x7e, 0x6e, 0xc7, 0xd7, 0x5d,
+	0x19, 0x9e, 0xfe, 0xa5, 0x56, 0xbe, 0x3e, 0x06, 0x66, 0xda, 0xa9, 0x53, 0x37, 0xfc, 0x7e, 0xe0,
+	0x61, 0xd7, 0x21, 0x7a, 0xa7, 0xd6, 0xe0, 0x0d, 0x73, 0x6a, 0x2d, 0x7a, 0xea, 0xe2, 0x75, 0x61,
+	0xe0, 0xcd, 0x43, 0x5f, 0xba, 0x14, 0x3d, 0x8d, 0xbc, 0xb8, 0x63, 0x05, 0xd0, 0xf8, 0x82, 0x44,
+	0xce, 0x41, 0x4f, 0xe8, 0xe1, 0x3e, 0xbf, 0x9a, 0x40, 0xda, 0xcc, 0x28, 0x8f, 0x55, 0x10, 0x31,
+	0x8b, 0x90, 0x13, 0x82, 0x5f, 0x2b, 0x4f, 0xce, 0x3d, 0x9f, 0xa0, 0x11, 0x85, 0xc4, 0xc0, 0x43,
+	0x4b, 0xeb, 0xe6, 0x70, 0xfd, 0xa6, 0xf0, 0x53, 0x57, 0xc0, 0xf3, 0xa9, 0x84, 0x8b, 0x60, 0x36,
+	0x70, 0x6a, 0xe7, 0x93, 0x32, 0x82, 0xfb, 0x04, 0x57, 0xc7, 0x41, 0x4d, 0x68, 0x1d, 0x42, 0xfd,
+	0x41, 0x44, 0xc8, 0xd7, 0x44, 0x79, 0xe2, 0x80, 0xaf, 0x89, 0xf9, 0x59, 0x94, 0x61, 0x3e, 0x9d,
+	0xc7, 0x4c, 0x08, 0xfd, 0x09, 0x34, 0x3e, 0xf7, 0x0f, 0xb2, 0xa4, 0x74, 0xac, 0xe6, 0x91, 0xc6,
+	0x74, 0x3a, 0x0f, 0x1a, 0x0f, 0x09, 0x93, 0xd4, 0xff, 0xc8, 0xf1, 0xed, 0xe0, 0xa9, 0x7e, 0xf9,
+	0x3c, 0x52, 0x41, 0x9a, 0x5d, 0x80, 0x9b, 0x4a, 0x76, 0x6a, 0x49, 0x0d, 0xb5, 0xbb, 0x7d, 0x4f,
+	0x9b, 0x25, 0xa4, 0x11, 0x86, 0x65, 0x09, 0x59, 0xbc, 0x98, 0xc4, 0xfd, 0xf7, 0xbf, 0xb7, 0x7e,
+	0xe8, 0xb0, 0xa3, 0xee, 0x3e, 0x97, 0xf5, 0xa6, 0x9c, 0x76, 0xc3, 0x09, 0xd4, 0xaf, 0x9b, 0xf1,
+	0x5b, 0xc3, 0x4d, 0xb1, 0xd2, 0x4d, 0x61, 0x88, 0xe1, 0xfe, 0xfe, 0xb4, 0x18, 0xbe, 0xf7, 0x9b,
+	0x01, 0x00, 0x19, 0x7c, 0x9f, 0x9f, 0x8a, 0x45, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProxyClient is the client API for Proxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProxyClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredentialCache(ctx context.Context, in *UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetProxyMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	SetRates(ctx context.Context, in *SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RefreshRoleQuota(ctx context.Context, in *RefreshRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RefreshDatabaseQuota(ctx context.Context, in *RefreshDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RefreshReadOnlyMode(ctx context.Context, in *RefreshReadOnlyModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type proxyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProxyClient(cc *grpc.ClientConn) ProxyClient {
+	return &proxyClient{cc}
+}
+
+func (c *proxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetDdChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCredentialCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) UpdateCredentialCache(ctx context.Context, in *UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/UpdateCredentialCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetProxyMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/GetProxyMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SetRates(ctx context.Context, in *SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/SetRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) RefreshRoleQuota(ctx context.Context, in *RefreshRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshRoleQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) RefreshDatabaseQuota(ctx context.Context, in *RefreshDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshDatabaseQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) RefreshReadOnlyMode(ctx context.Context, in *RefreshReadOnlyModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshReadOnlyMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProxyServer is the server API for Proxy service.
+type ProxyServer interface {
+	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	InvalidateCollectionMetaCache(context.Context, *InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
+	GetDdChannel(context.Context, *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error)
+	InvalidateCredentialCache(context.Context, *InvalidateCredCacheRequest) (*commonpb.Status, error)
+	UpdateCredentialCache(context.Context, *UpdateCredCacheRequest) (*commonpb.Status, error)
+	RefreshPolicyInfoCache(context.Context, *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
+	GetProxyMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	SetRates(context.Context, *SetRatesRequest) (*commonpb.Status, error)
+	RefreshRoleQuota(context.Context, *RefreshRoleQuotaRequest) (*commonpb.Status, error)
+	RefreshDatabaseQuota(context.Context, *RefreshDatabaseQuotaRequest) (*commonpb.Status, error)
+	RefreshReadOnlyMode(context.Context, *RefreshReadOnlyModeRequest) (*commonpb.Status, error)
+}
+
+// UnimplementedProxyServer can be embedded to have forward compatible implementations.
+type UnimplementedProxyServer struct {
+}
+
+func (*UnimplementedProxyServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedProxyServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedProxyServer) InvalidateCollectionMetaCache(ctx context.Context, req *InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCollectionMetaCache not implemented")
+}
+func (*UnimplementedProxyServer) GetDdChannel(ctx context.Context, req *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDdChannel not implemented")
+}
+func (*UnimplementedProxyServer) InvalidateCredentialCache(ctx context.Context, req *InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCredentialCache not implemented")
+}
+func (*UnimplementedProxyServer) UpdateCredentialCache(ctx context.Context, req *UpdateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialCache not implemented")
+}
+func (*UnimplementedProxyServer) RefreshPolicyInfoCache(ctx context.Context, req *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPolicyInfoCache not implemented")
+}
+func (*UnimplementedProxyServer) GetProxyMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProxyMetrics not implemented")
+}
+func (*UnimplementedProxyServer) SetRates(ctx context.Context, req *SetRatesRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRates not implemented")
+}
+func (*UnimplementedProxyServer) RefreshRoleQuota(ctx context.Context, req *RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshRoleQuota not implemented")
+}
+func (*UnimplementedProxyServer) RefreshDatabaseQuota(ctx context.Context, req *RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDatabaseQuota not implemented")
+}
+func (*UnimplementedProxyServer) RefreshReadOnlyMode(ctx context.Context, req *RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshReadOnlyMode not implemented")
+}
+
+func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
+	s.RegisterService(&_Proxy_serviceDesc, srv)
+}
+
+func _Proxy_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.GetStatisticsChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).GetStatisticsChannel(ctx, in)
+		return srv.(ProxyServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_InvalidateCollectionMetaCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCollMetaCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidateCollectionMetaCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidateCollectionMetaCache(ctx, req.(*InvalidateCollMetaCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetDdChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetDdChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetDdChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/GetDdChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetDdChannel(ctx, req.(*internalpb.GetDdChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_InvalidateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCredCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCredentialCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, req.(*InvalidateCredCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_UpdateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).UpdateCredentialCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/UpdateCredentialCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).UpdateCredentialCache(ctx, req.(*UpdateCredCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_RefreshPolicyInfoCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshPolicyInfoCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, req.(*RefreshPolicyInfoCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetProxyMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetProxyMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/GetProxyMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetProxyMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SetRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/SetRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SetRates(ctx, req.(*SetRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_RefreshRoleQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRoleQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RefreshRoleQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/RefreshRoleQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RefreshRoleQuota(ctx, req.(*RefreshRoleQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_RefreshDatabaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDatabaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RefreshDatabaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/RefreshDatabaseQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RefreshDatabaseQuota(ctx, req.(*RefreshDatabaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_RefreshReadOnlyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshReadOnlyModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RefreshReadOnlyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/RefreshReadOnlyMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RefreshReadOnlyMode(ctx, req.(*RefreshReadOnlyModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Proxy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.proxy.Proxy",
+	HandlerType: (*ProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetComponentStates",
+			Handler:    _Proxy_GetComponentStates_Handler,
+		},
+		{
+			MethodName: "GetStatisticsChannel",
+			Handler:    _Proxy_GetStatisticsChannel_Handler,
+		},
+		{
+			MethodName: "InvalidateCollectionMetaCache",
+			Handler:    _Proxy_InvalidateCollectionMetaCache_Handler,
+		},
+		{
+			MethodName: "GetDdChannel",
+			Handler:    _Proxy_GetDdChannel_Handler,
+		},
+		{
+			MethodName: "InvalidateCredentialCache",
+			Handler:    _Proxy_InvalidateCredentialCache_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialCache",
+			Handler:    _Proxy_UpdateCredentialCache_Handler,
+		},
+		{
+			MethodName: "RefreshPolicyInfoCache",
+			Handler:    _Proxy_RefreshPolicyInfoCache_Handler,
+		},
+		{
+			MethodName: "GetProxyMetrics",
+			Handler:    _Proxy_GetProxyMetrics_Handler,
+		},
+		{
+			MethodName: "SetRates",
+			Handler:    _Proxy_SetRates_Handler,
+		},
+		{
+			MethodName: "RefreshRoleQuota",
+			Handler:    _Proxy_RefreshRoleQuota_Handler,
+		},
+		{
+			MethodName: "RefreshDatabaseQuota",
+			Handler:    _Proxy_RefreshDatabaseQuota_Handler,
+		},
+		{
+			MethodName: "RefreshReadOnlyMode",
+			Handler:    _Proxy_RefreshReadOnlyMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
+
+// MilvusExtServiceClient is the client API for MilvusExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusExtServiceClient interface {
+	// DryRunCreateCollection validates a CreateCollection request and returns the collection that would be created
+	DryRunCreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*DryRunCreateCollectionResponse, error)
+	// DryRunCreateIndex validates a CreateIndex request and returns the index that would be created
+	DryRunCreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest, opts ...grpc.CallOption) (*DryRunCreateIndexResponse, error)
+	// RebuildDeprecatedIndexes lists the segment indexes built by deprecated engine or file format versions in
+	// DataCoord and rebuilds them, it requires the global PrivilegeAll
+	RebuildDeprecatedIndexes(ctx context.Context, in *datapb.RebuildDeprecatedIndexesRequest, opts ...grpc.CallOption) (*datapb.RebuildDeprecatedIndexesResponse, error)
+	// SearchReducedPrecision searches like Search, and returns the scores in the output_precision of the search params
+	SearchReducedPrecision(ctx context.Context, in *milvuspb.SearchRequest, opts ...grpc.CallOption) (*ReducedPrecisionSearchResults, error)
+	// QueryReducedPrecision queries like Query, and returns the float vectors in the output_precision of the query params
+	QueryReducedPrecision(ctx context.Context, in *milvuspb.QueryRequest, opts ...grpc.CallOption) (*ReducedPrecisionQueryResults, error)
+	// ListRoleQuotas lists the rate limiting quotas of roles in RootCoord, it requires the global PrivilegeAll
+	ListRoleQuotas(ctx context.Context, in *ListRoleQuotasRequest, opts ...grpc.CallOption) (*ListRoleQuotasResponse, error)
+	// SaveRoleQuota saves the rate limiting quota of a role in RootCoord, it requires the global PrivilegeAll
+	SaveRoleQuota(ctx context.Context, in *SaveRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// DropRoleQuota drops the rate limiting quota of a role in RootCoord, it requires the global PrivilegeAll
+	DropRoleQuota(ctx context.Context, in *DropRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// ListDatabaseQuotas lists the rate limiting quotas of databases in RootCoord, it requires the global PrivilegeAll
+	ListDatabaseQuotas(ctx context.Context, in *ListDatabaseQuotasRequest, opts ...grpc.CallOption) (*ListDatabaseQuotasResponse, error)
+	// SaveDatabaseQuota saves the rate limiting quota of a database in RootCoord, it requires the global PrivilegeAll
+	SaveDatabaseQuota(ctx context.Context, in *SaveDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// DropDatabaseQuota drops the rate limiting quota of a database in RootCoord, it requires the global PrivilegeAll
+	DropDatabaseQuota(ctx context.Context, in *DropDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// ListReadOnlyModes lists the read-only modes entered in RootCoord, it requires the global PrivilegeAll
+	ListReadOnlyModes(ctx context.Context, in *ListReadOnlyModesRequest, opts ...grpc.CallOption) (*ListReadOnlyModesResponse, error)
+	// EnterReadOnly enters the read-only mode of the cluster or of a database in RootCoord, it requires the global
+	// PrivilegeAll
+	EnterReadOnly(ctx context.Context, in *EnterReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// LeaveReadOnly leaves the read-only mode of the cluster or of a database in RootCoord, it requires the global
+	// PrivilegeAll
+	LeaveReadOnly(ctx context.Context, in *LeaveReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// GetSegmentLifecycle merges the persistence info from DataCoord, the index states and the load state from
+	// QueryCoord of the segments of a collection
+	GetSegmentLifecycle(ctx context.Context, in *GetSegmentLifecycleRequest, opts ...grpc.CallOption) (*GetSegmentLifecycleResponse, error)
+	// CheckIndexConsistency checks the index meta against the segments and the index files in IndexCoord, and repairs
+	// the inconsistencies if asked, it requires the global PrivilegeAll
+	CheckIndexConsistency(ctx context.Context, in *indexpb.CheckIndexConsistencyRequest, opts ...grpc.CallOption) (*indexpb.CheckIndexConsistencyResponse, error)
+	// GetDeleteTombstones reports the deletes of a primary key persisted in the delta logs of the segments of a
+	// collection, and whether they are applied by compactions
+	GetDeleteTombstones(ctx context.Context, in *GetDeleteTombstonesRequest, opts ...grpc.CallOption) (*GetDeleteTombstonesResponse, error)
+	// GetDdlOperationState returns the ddl operations in the journal of RootCoord with the states of their steps
+	GetDdlOperationState(ctx context.Context, in *GetDdlOperationStateRequest, opts ...grpc.CallOption) (*GetDdlOperationStateResponse, error)
+	// GetIndexStatistics returns the daily build statistics of the indexes in IndexCoord, it requires the global
+	// PrivilegeAll
+	GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)
+	// PreImport checks the files of an import in DataCoord before the import is submitted, it requires the
+	// PrivilegeImport of the collection
+	PreImport(ctx context.Context, in *datapb.PreImportRequest, opts ...grpc.CallOption) (*datapb.PreImportResponse, error)
+	// CordonIndexNode cordons or uncordons an IndexNode in IndexCoord, it requires the global PrivilegeAll
+	CordonIndexNode(ctx context.Context, in *indexpb.CordonIndexNodeRequest, opts ...grpc.CallOption) (*indexpb.CordonIndexNodeResponse, error)
+	// GetSegmentHistory returns the state transitions of a segment in DataCoord
+	GetSegmentHistory(ctx context.Context, in *datapb.GetSegmentHistoryRequest, opts ...grpc.CallOption) (*datapb.GetSegmentHistoryResponse, error)
+	// PauseCollectionMaintenance, ResumeCollectionMaintenance and GetCollectionMaintenancePauses pause, resume and list
+	// the compaction and the binlog gc of collections in DataCoord, they require the global PrivilegeAll
+	PauseCollectionMaintenance(ctx context.Context, in *datapb.PauseCollectionMaintenanceRequest, opts ...grpc.CallOption) (*datapb.PauseCollectionMaintenanceResponse, error)
+	ResumeCollectionMaintenance(ctx context.Context, in *datapb.ResumeCollectionMaintenanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCollectionMaintenancePauses(ctx context.Context, in *datapb.GetCollectionMaintenancePausesRequest, opts ...grpc.CallOption) (*datapb.GetCollectionMaintenancePausesResponse, error)
+	// ListProxyTasks lists the tasks queued or executing in the task scheduler of the proxy serving the request, it
+	// requires the global PrivilegeAll
+	ListProxyTasks(ctx context.Context, in *ListProxyTasksRequest, opts ...grpc.CallOption) (*ListProxyTasksResponse, error)
+	// DrainProxy stops or resumes accepting new dml and dql requests in the proxy serving the request while the tasks
+	// in-flight are still scheduled, e.g. before shutting it down behind a load balancer, it requires the global
+	// PrivilegeAll
+	DrainProxy(ctx context.Context, in *DrainProxyRequest, opts ...grpc.CallOption) (*ListProxyTasksResponse, error)
+	// TriggerGC runs the gc passes of a scope in IndexCoord right away, it requires the global PrivilegeAll
+	TriggerGC(ctx context.Context, in *indexpb.TriggerGCRequest, opts ...grpc.CallOption) (*indexpb.TriggerGCResponse, error)
+	// DecommissionDataNode drains the vchannels of a DataNode through DataCoord before the DataNode is shut down, it
+	// requires the global PrivilegeAll
+	DecommissionDataNode(ctx context.Context, in *datapb.DecommissionRequest, opts ...grpc.CallOption) (*datapb.DecommissionResponse, error)
+	// LocatePrimaryKeys returns the segments of a collection in DataCoord which may contain the primary keys
+	LocatePrimaryKeys(ctx context.Context, in *datapb.LocatePrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.LocatePrimaryKeysResponse, error)
+	// InspectSegmentLocks returns the segment lock leases in DataCoord, it requires the global PrivilegeAll
+	InspectSegmentLocks(ctx context.Context, in *datapb.InspectSegmentLocksRequest, opts ...grpc.CallOption) (*datapb.InspectSegmentLocksResponse, error)
+	// GetLoadProgressDetail returns the load progress of a collection or of its partitions, along with the progress of
+	// the segments being loaded on the QueryNodes
+	GetLoadProgressDetail(ctx context.Context, in *GetLoadProgressDetailRequest, opts ...grpc.CallOption) (*GetLoadProgressDetailResponse, error)
+	// CompactSegments triggers a manual compaction of the given segments of a collection in DataCoord, it requires the
+	// PrivilegeCompaction of the collection, and is denied in read-only mode like ManualCompaction
+	CompactSegments(ctx context.Context, in *CompactSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	// GetSearchCalibration returns the latency calibration curves of the search params of a collection in the proxy
+	// serving the request
+	GetSearchCalibration(ctx context.Context, in *GetSearchCalibrationRequest, opts ...grpc.CallOption) (*GetSearchCalibrationResponse, error)
+	// SetSearchRecalls sets the recalls of the search param values of a field measured by an offline calibration in the
+	// proxy serving the request, it requires the global PrivilegeAll
+	SetSearchRecalls(ctx context.Context, in *SetSearchRecallsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// GetHandoffGate returns the handoff gating states of the flushed segments of a collection in DataCoord, it requires
+	// the PrivilegeGetStatistics of the collection
+	GetHandoffGate(ctx context.Context, in *GetHandoffGateRequest, opts ...grpc.CallOption) (*datapb.GetHandoffGateResponse, error)
+	// ListIndexTTLs lists the ttl states of the indexes in IndexCoord, it requires the global PrivilegeDescribeCollection
+	ListIndexTTLs(ctx context.Context, in *indexpb.ListIndexTTLsRequest, opts ...grpc.CallOption) (*indexpb.ListIndexTTLsResponse, error)
+	// SetIndexTTL sets the ttl of an index in IndexCoord, it requires the global PrivilegeAll
+	SetIndexTTL(ctx context.Context, in *indexpb.SetIndexTTLRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// CheckIndexTTL checks the usage of the indexes with ttl in IndexCoord right away, it requires the global
+	// PrivilegeAll
+	CheckIndexTTL(ctx context.Context, in *indexpb.CheckIndexTTLRequest, opts ...grpc.CallOption) (*indexpb.CheckIndexTTLResponse, error)
+	// GetSegmentAnomalies returns the segment reports of the abnormal collections, or of a collection, in DataCoord, it
+	// requires the global PrivilegeDescribeCollection
+	GetSegmentAnomalies(ctx context.Context, in *datapb.GetSegmentAnomaliesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentAnomaliesResponse, error)
+	// MergeTinySegments triggers a compaction of a collection which merges its small segments in DataCoord, it
+	// requires the PrivilegeCompaction of the collection
+	MergeTinySegments(ctx context.Context, in *MergeTinySegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	// VerifyIndexBuild rebuilds an index recorded in reproducibility mode on an IndexNode through IndexCoord and compares
+	// the checksums of the index files, it requires the global PrivilegeAll
+	VerifyIndexBuild(ctx context.Context, in *indexpb.VerifyIndexBuildRequest, opts ...grpc.CallOption) (*indexpb.VerifyIndexBuildResponse, error)
+	// GetSegmentAllocHints returns the row distribution of the partitions of a collection over the vchannels and the
+	// segment allocation hints in DataCoord, it requires the PrivilegeGetStatistics of the collection
+	GetSegmentAllocHints(ctx context.Context, in *GetSegmentAllocHintsRequest, opts ...grpc.CallOption) (*datapb.GetSegmentAllocHintsResponse, error)
+	// GetSegmentHeats returns the query heats of the segments of all the collections, or of a collection, in DataCoord,
+	// it requires the global PrivilegeDescribeCollection
+	GetSegmentHeats(ctx context.Context, in *datapb.GetSegmentHeatsRequest, opts ...grpc.CallOption) (*datapb.GetSegmentHeatsResponse, error)
+	// FreezeHandoffs, UnfreezeHandoffs and GetFreezeWindows freeze, unfreeze and list the index completion driven
+	// handoffs and the compaction of a collection or the whole cluster in DataCoord, they require the global PrivilegeAll
+	FreezeHandoffs(ctx context.Context, in *datapb.FreezeHandoffsRequest, opts ...grpc.CallOption) (*datapb.FreezeHandoffsResponse, error)
+	UnfreezeHandoffs(ctx context.Context, in *datapb.UnfreezeHandoffsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetFreezeWindows(ctx context.Context, in *datapb.GetFreezeWindowsRequest, opts ...grpc.CallOption) (*datapb.GetFreezeWindowsResponse, error)
+	// GetDeleteSLA returns the sampled delete markers with the time they take to be persisted, checkpointed and compacted
+	// away in DataCoord, it requires the global PrivilegeDescribeCollection
+	GetDeleteSLA(ctx context.Context, in *datapb.GetDeleteSLARequest, opts ...grpc.CallOption) (*datapb.GetDeleteSLAResponse, error)
+}
+
+type milvusExtServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusExtServiceClient(cc *grpc.ClientConn) MilvusExtServiceClient {
+	return &milvusExtServiceClient{cc}
+}
+
+func (c *milvusExtServiceClient) DryRunCreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*DryRunCreateCollectionResponse, error) {
+	out := new(DryRunCreateCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DryRunCreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) DryRunCreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest, opts ...grpc.CallOption) (*DryRunCreateIndexResponse, error) {
+	out := new(DryRunCreateIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DryRunCreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) RebuildDeprecatedIndexes(ctx context.Context, in *datapb.RebuildDeprecatedIndexesRequest, opts ...grpc.CallOption) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	out := new(datapb.RebuildDeprecatedIndexesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/RebuildDeprecatedIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) SearchReducedPrecision(ctx context.Context, in *milvuspb.SearchRequest, opts ...grpc.CallOption) (*ReducedPrecisionSearchResults, error) {
+	out := new(ReducedPrecisionSearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/SearchReducedPrecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) QueryReducedPrecision(ctx context.Context, in *milvuspb.QueryRequest, opts ...grpc.CallOption) (*ReducedPrecisionQueryResults, error) {
+	out := new(ReducedPrecisionQueryResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/QueryReducedPrecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ListRoleQuotas(ctx context.Context, in *ListRoleQuotasRequest, opts ...grpc.CallOption) (*ListRoleQuotasResponse, error) {
+	out := new(ListRoleQuotasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ListRoleQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) SaveRoleQuota(ctx context.Context, in *SaveRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/SaveRoleQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) DropRoleQuota(ctx context.Context, in *DropRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DropRoleQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ListDatabaseQuotas(ctx context.Context, in *ListDatabaseQuotasRequest, opts ...grpc.CallOption) (*ListDatabaseQuotasResponse, error) {
+	out := new(ListDatabaseQuotasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ListDatabaseQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) SaveDatabaseQuota(ctx context.Context, in *SaveDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/SaveDatabaseQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) DropDatabaseQuota(ctx context.Context, in *DropDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DropDatabaseQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ListReadOnlyModes(ctx context.Context, in *ListReadOnlyModesRequest, opts ...grpc.CallOption) (*ListReadOnlyModesResponse, error) {
+	out := new(ListReadOnlyModesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ListReadOnlyModes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) EnterReadOnly(ctx context.Context, in *EnterReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/EnterReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) LeaveReadOnly(ctx context.Context, in *LeaveReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/LeaveReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetSegmentLifecycle(ctx context.Context, in *GetSegmentLifecycleRequest, opts ...grpc.CallOption) (*GetSegmentLifecycleResponse, error) {
+	out := new(GetSegmentLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetSegmentLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CheckIndexConsistency(ctx context.Context, in *indexpb.CheckIndexConsistencyRequest, opts ...grpc.CallOption) (*indexpb.CheckIndexConsistencyResponse, error) {
+	out := new(indexpb.CheckIndexConsistencyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CheckIndexConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetDeleteTombstones(ctx context.Context, in *GetDeleteTombstonesRequest, opts ...grpc.CallOption) (*GetDeleteTombstonesResponse, error) {
+	out := new(GetDeleteTombstonesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetDeleteTombstones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetDdlOperationState(ctx context.Context, in *GetDdlOperationStateRequest, opts ...grpc.CallOption) (*GetDdlOperationStateResponse, error) {
+	out := new(GetDdlOperationStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetDdlOperationState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error) {
+	out := new(indexpb.GetIndexStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetIndexStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) PreImport(ctx context.Context, in *datapb.PreImportRequest, opts ...grpc.CallOption) (*datapb.PreImportResponse, error) {
+	out := new(datapb.PreImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/PreImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CordonIndexNode(ctx context.Context, in *indexpb.CordonIndexNodeRequest, opts ...grpc.CallOption) (*indexpb.CordonIndexNodeResponse, error) {
+	out := new(indexpb.CordonIndexNodeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CordonIndexNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetSegmentHistory(ctx context.Context, in *datapb.GetSegmentHistoryRequest, opts ...grpc.CallOption) (*datapb.GetSegmentHistoryResponse, error) {
+	out := new(datapb.GetSegmentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetSegmentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) PauseCollectionMaintenance(ctx context.Context, in *datapb.PauseCollectionMaintenanceRequest, opts ...grpc.CallOption) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	out := new(datapb.PauseCollectionMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/PauseCollectionMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ResumeCollectionMaintenance(ctx context.Context, in *datapb.ResumeCollectionMaintenanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ResumeCollectionMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetCollectionMaintenancePauses(ctx context.Context, in *datapb.GetCollectionMaintenancePausesRequest, opts ...grpc.CallOption) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	out := new(datapb.GetCollectionMaintenancePausesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetCollectionMaintenancePauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ListProxyTasks(ctx context.Context, in *ListProxyTasksRequest, opts ...grpc.CallOption) (*ListProxyTasksResponse, error) {
+	out := new(ListProxyTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ListProxyTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) DrainProxy(ctx context.Context, in *DrainProxyRequest, opts ...grpc.CallOption) (*ListProxyTasksResponse, error) {
+	out := new(ListProxyTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DrainProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) TriggerGC(ctx context.Context, in *indexpb.TriggerGCRequest, opts ...grpc.CallOption) (*indexpb.TriggerGCResponse, error) {
+	out := new(indexpb.TriggerGCResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/TriggerGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) DecommissionDataNode(ctx context.Context, in *datapb.DecommissionRequest, opts ...grpc.CallOption) (*datapb.DecommissionResponse, error) {
+	out := new(datapb.DecommissionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/DecommissionDataNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) LocatePrimaryKeys(ctx context.Context, in *datapb.LocatePrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.LocatePrimaryKeysResponse, error) {
+	out := new(datapb.LocatePrimaryKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/LocatePrimaryKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) InspectSegmentLocks(ctx context.Context, in *datapb.InspectSegmentLocksRequest, opts ...grpc.CallOption) (*datapb.InspectSegmentLocksResponse, error) {
+	out := new(datapb.InspectSegmentLocksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/InspectSegmentLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetLoadProgressDetail(ctx context.Context, in *GetLoadProgressDetailRequest, opts ...grpc.CallOption) (*GetLoadProgressDetailResponse, error) {
+	out := new(GetLoadProgressDetailResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetLoadProgressDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CompactSegments(ctx context.Context, in *CompactSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CompactSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetSearchCalibration(ctx context.Context, in *GetSearchCalibrationRequest, opts ...grpc.CallOption) (*GetSearchCalibrationResponse, error) {
+	out := new(GetSearchCalibrationResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetSearchCalibration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) SetSearchRecalls(ctx context.Context, in *SetSearchRecallsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/SetSearchRecalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetHandoffGate(ctx context.Context, in *GetHandoffGateRequest, opts ...grpc.CallOption) (*datapb.GetHandoffGateResponse, error) {
+	out := new(datapb.GetHandoffGateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetHandoffGate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ListIndexTTLs(ctx context.Context, in *indexpb.ListIndexTTLsRequest, opts ...grpc.CallOption) (*indexpb.ListIndexTTLsResponse, error) {
+	out := new(indexpb.ListIndexTTLsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ListIndexTTLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) SetIndexTTL(ctx context.Context, in *indexpb.SetIndexTTLRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/SetIndexTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CheckIndexTTL(ctx context.Context, in *indexpb.CheckIndexTTLRequest, opts ...grpc.CallOption) (*indexpb.CheckIndexTTLResponse, error) {
+	out := new(indexpb.CheckIndexTTLResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CheckIndexTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetSegmentAnomalies(ctx context.Context, in *datapb.GetSegmentAnomaliesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentAnomaliesResponse, error) {
+	out := new(datapb.GetSegmentAnomaliesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetSegmentAnomalies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) MergeTinySegments(ctx context.Context, in *MergeTinySegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/MergeTinySegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) VerifyIndexBuild(ctx context.Context, in *indexpb.VerifyIndexBuildRequest, opts ...grpc.CallOption) (*indexpb.VerifyIndexBuildResponse, error) {
+	out := new(indexpb.VerifyIndexBuildResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/VerifyIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetSegmentAllocHints(ctx context.Context, in *GetSegmentAllocHintsRequest, opts ...grpc.CallOption) (*datapb.GetSegmentAllocHintsResponse, error) {
+	out := new(datapb.GetSegmentAllocHintsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetSegmentAllocHints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetSegmentHeats(ctx context.Context, in *datapb.GetSegmentHeatsRequest, opts ...grpc.CallOption) (*datapb.GetSegmentHeatsResponse, error) {
+	out := new(datapb.GetSegmentHeatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetSegmentHeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) FreezeHandoffs(ctx context.Context, in *datapb.FreezeHandoffsRequest, opts ...grpc.CallOption) (*datapb.FreezeHandoffsResponse, error) {
+	out := new(datapb.FreezeHandoffsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/FreezeHandoffs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) UnfreezeHandoffs(ctx context.Context, in *datapb.UnfreezeHandoffsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/UnfreezeHandoffs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetFreezeWindows(ctx context.Context, in *datapb.GetFreezeWindowsRequest, opts ...grpc.CallOption) (*datapb.GetFreezeWindowsResponse, error) {
+	out := new(datapb.GetFreezeWindowsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetFreezeWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetDeleteSLA(ctx context.Context, in *datapb.GetDeleteSLARequest, opts ...grpc.CallOption) (*datapb.GetDeleteSLAResponse, error) {
+	out := new(datapb.GetDeleteSLAResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetDeleteSLA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusExtServiceServer is the server API for MilvusExtService service.
+type MilvusExtServiceServer interface {
+	// DryRunCreateCollection validates a CreateCollection request and returns the collection that would be created
+	DryRunCreateCollection(context.Context, *milvuspb.CreateCollectionRequest) (*DryRunCreateCollectionResponse, error)
+	// DryRunCreateIndex validates a CreateIndex request and returns the index that would be created
+	DryRunCreateIndex(context.Context, *milvuspb.CreateIndexRequest) (*DryRunCreateIndexResponse, error)
+	// RebuildDeprecatedIndexes lists the segment indexes built by deprecated engine or file format versions in
+	// DataCoord and rebuilds them, it requires the global PrivilegeAll
+	RebuildDeprecatedIndexes(context.Context, *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error)
+	// SearchReducedPrecision searches like Search, and returns the scores in the output_precision of the search params
+	SearchReducedPrecision(context.Context, *milvuspb.SearchRequest) (*ReducedPrecisionSearchResults, error)
+	// QueryReducedPrecision queries like Query, and returns the float vectors in the output_precision of the query params
+	QueryReducedPrecision(context.Context, *milvuspb.QueryRequest) (*ReducedPrecisionQueryResults, error)
+	// ListRoleQuotas lists the rate limiting quotas of roles in RootCoord, it requires the global PrivilegeAll
+	ListRoleQuotas(context.Context, *ListRoleQuotasRequest) (*ListRoleQuotasResponse, error)
+	// SaveRoleQuota saves the rate limiting quota of a role in RootCoord, it requires the global PrivilegeAll
+	SaveRoleQuota(context.Context, *SaveRoleQuotaRequest) (*commonpb.Status, error)
+	// DropRoleQuota drops the rate limiting quota of a role in RootCoord, it requires the global PrivilegeAll
+	DropRoleQuota(context.Context, *DropRoleQuotaRequest) (*commonpb.Status, error)
+	// ListDatabaseQuotas lists the rate limiting quotas of databases in RootCoord, it requires the global PrivilegeAll
+	ListDatabaseQuotas(context.Context, *ListDatabaseQuotasRequest) (*ListDatabaseQuotasResponse, error)
+	// SaveDatabaseQuota saves the rate limiting quota of a database in RootCoord, it requires the global PrivilegeAll
+	SaveDatabaseQuota(context.Context, *SaveDatabaseQuotaRequest) (*commonpb.Status, error)
+	// DropDatabaseQuota drops the rate limiting quota of a database in RootCoord, it requires the global PrivilegeAll
+	DropDatabaseQuota(context.Context, *DropDatabaseQuotaRequest) (*commonpb.Status, error)
+	// ListReadOnlyModes lists the read-only modes entered in RootCoord, it requires the global PrivilegeAll
+	ListReadOnlyModes(context.Context, *ListReadOnlyModesRequest) (*ListReadOnlyModesResponse, error)
+	// EnterReadOnly enters the read-only mode of the cluster or of a database in RootCoord, it requires the global
+	// PrivilegeAll
+	EnterReadOnly(context.Context, *EnterReadOnlyRequest) (*commonpb.Status, error)
+	// LeaveReadOnly leaves the read-only mode of the cluster or of a database in RootCoord, it requires the global
+	// PrivilegeAll
+	LeaveReadOnly(context.Context, *LeaveReadOnlyRequest) (*commonpb.Status, error)
+	// GetSegmentLifecycle merges the persistence info from DataCoord, the index states and the load state from
+	// QueryCoord of the segments of a collection
+	GetSegmentLifecycle(context.Context, *GetSegmentLifecycleRequest) (*GetSegmentLifecycleResponse, error)
+	// CheckIndexConsistency checks the index meta against the segments and the index files in IndexCoord, and repairs
+	// the inconsistencies if asked, it requires the global PrivilegeAll
+	CheckIndexConsistency(context.Context, *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error)
+	// GetDeleteTombstones reports the deletes of a primary key persisted in the delta logs of the segments of a
+	// collection, and whether they are applied by compactions
+	GetDeleteTombstones(context.Context, *GetDeleteTombstonesRequest) (*GetDeleteTombstonesResponse, error)
+	// GetDdlOperationState returns the ddl operations in the journal of RootCoord with the states of their steps
+	GetDdlOperationState(context.Context, *GetDdlOperationStateRequest) (*GetDdlOperationStateResponse, error)
+	// GetIndexStatistics returns the daily build statistics of the indexes in IndexCoord, it requires the global
+	// PrivilegeAll
+	GetIndexStatistics(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
+	// PreImport checks the files of an import in DataCoord before the import is submitted, it requires the
+	// PrivilegeImport of the collection
+	PreImport(context.Context, *datapb.PreImportRequest) (*datapb.PreImportResponse, error)
+	// CordonIndexNode cordons or uncordons an IndexNode in IndexCoord, it requires the global PrivilegeAll
+	CordonIndexNode(context.Context, *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error)
+	// GetSegmentHistory returns the state transitions of a segment in DataCoord
+	GetSegmentHistory(context.Context, *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error)
+	// PauseCollectionMaintenance, ResumeCollectionMaintenance and GetCollectionMaintenancePauses pause, resume and list
+	// the compaction and the binlog gc of collections in DataCoord, they require the global PrivilegeAll
+	PauseCollectionMaintenance(context.Context, *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error)
+	ResumeCollectionMaintenance(context.Context, *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error)
+	GetCollectionMaintenancePauses(context.Context, *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error)
+	// ListProxyTasks lists the tasks queued or executing in the task scheduler of the proxy serving the request, it
+	// requires the global PrivilegeAll
+	ListProxyTasks(context.Context, *ListProxyTasksRequest) (*ListProxyTasksResponse, error)
+	// DrainProxy stops or resumes accepting new dml and dql requests in the proxy serving the request while the tasks
+	// in-flight are still scheduled, e.g. before shutting it down behind a load balancer, it requires the global
+	// PrivilegeAll
+	DrainProxy(context.Context, *DrainProxyRequest) (*ListProxyTasksResponse, error)
+	// TriggerGC runs the gc passes of a scope in IndexCoord right away, it requires the global PrivilegeAll
+	TriggerGC(context.Context, *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error)
+	// DecommissionDataNode drains the vchannels of a DataNode through DataCoord before the DataNode is shut down, it
+	// requires the global PrivilegeAll
+	DecommissionDataNode(context.Context, *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error)
+	// LocatePrimaryKeys returns the segments of a collection in DataCoord which may contain the primary keys
+	LocatePrimaryKeys(context.Context, *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error)
+	// InspectSegmentLocks returns the segment lock leases in DataCoord, it requires the global PrivilegeAll
+	InspectSegmentLocks(context.Context, *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error)
+	// GetLoadProgressDetail returns the load progress of a collection or of its partitions, along with the progress of
+	// the segments being loaded on the QueryNodes
+	GetLoadProgressDetail(context.Context, *GetLoadProgressDetailRequest) (*GetLoadProgressDetailResponse, error)
+	// CompactSegments triggers a manual compaction of the given segments of a collection in DataCoord, it requires the
+	// PrivilegeCompaction of the collection, and is denied in read-only mode like ManualCompaction
+	CompactSegments(context.Context, *CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// GetSearchCalibration returns the latency calibration curves of the search params of a collection in the proxy
+	// serving the request
+	GetSearchCalibration(context.Context, *GetSearchCalibrationRequest) (*GetSearchCalibrationResponse, error)
+	// SetSearchRecalls sets the recalls of the search param values of a field measured by an offline calibration in the
+	// proxy serving the request, it requires the global PrivilegeAll
+	SetSearchRecalls(context.Context, *SetSearchRecallsRequest) (*commonpb.Status, error)
+	// GetHandoffGate returns the handoff gating states of the flushed segments of a collection in DataCoord, it requires
+	// the PrivilegeGetStatistics of the collection
+	GetHandoffGate(context.Context, *GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error)
+	// ListIndexTTLs lists the ttl states of the indexes in IndexCoord, it requires the global PrivilegeDescribeCollection
+	ListIndexTTLs(context.Context, *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error)
+	// SetIndexTTL sets the ttl of an index in IndexCoord, it requires the global PrivilegeAll
+	SetIndexTTL(context.Context, *indexpb.SetIndexTTLRequest) (*commonpb.Status, error)
+	// CheckIndexTTL checks the usage of the indexes with ttl in IndexCoord right away, it requires the global
+	// PrivilegeAll
+	CheckIndexTTL(context.Context, *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error)
+	// GetSegmentAnomalies returns the segment reports of the abnormal collections, or of a collection, in DataCoord, it
+	// requires the global PrivilegeDescribeCollection
+	GetSegmentAnomalies(context.Context, *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error)
+	// MergeTinySegments triggers a compaction of a collection which merges its small segments in DataCoord, it
+	// requires the PrivilegeCompaction of the collection
+	MergeTinySegments(context.Context, *MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// VerifyIndexBuild rebuilds an index recorded in reproducibility mode on an IndexNode through IndexCoord and compares
+	// the checksums of the index files, it requires the global PrivilegeAll
+	VerifyIndexBuild(context.Context, *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error)
+	// GetSegmentAllocHints returns the row distribution of the partitions of a collection over the vchannels and the
+	// segment allocation hints in DataCoord, it requires the PrivilegeGetStatistics of the collection
+	GetSegmentAllocHints(context.Context, *GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error)
+	// GetSegmentHeats returns the query heats of the segments of all the collections, or of a collection, in DataCoord,
+	// it requires the global PrivilegeDescribeCollection
+	GetSegmentHeats(context.Context, *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error)
+	// FreezeHandoffs, UnfreezeHandoffs and GetFreezeWindows freeze, unfreeze and list the index completion driven
+	// handoffs and the compaction of a collection or the whole cluster in DataCoord, they require the global PrivilegeAll
+	FreezeHandoffs(context.Context, *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error)
+	UnfreezeHandoffs(context.Context, *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error)
+	GetFreezeWindows(context.Context, *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error)
+	// GetDeleteSLA returns the sampled delete markers with the time they take to be persisted, checkpointed and compacted
+	// away in DataCoord, it requires the global PrivilegeDescribeCollection
+	GetDeleteSLA(context.Context, *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error)
+}
+
+// UnimplementedMilvusExtServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMilvusExtServiceServer struct {
+}
+
+func (*UnimplementedMilvusExtServiceServer) DryRunCreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*DryRunCreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunCreateCollection not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) DryRunCreateIndex(ctx context.Context, req *milvuspb.CreateIndexRequest) (*DryRunCreateIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunCreateIndex not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildDeprecatedIndexes not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) SearchReducedPrecision(ctx context.Context, req *milvuspb.SearchRequest) (*ReducedPrecisionSearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReducedPrecision not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) QueryReducedPrecision(ctx context.Context, req *milvuspb.QueryRequest) (*ReducedPrecisionQueryResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryReducedPrecision not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ListRoleQuotas(ctx context.Context, req *ListRoleQuotasRequest) (*ListRoleQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleQuotas not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) SaveRoleQuota(ctx context.Context, req *SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRoleQuota not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) DropRoleQuota(ctx context.Context, req *DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRoleQuota not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ListDatabaseQuotas(ctx context.Context, req *ListDatabaseQuotasRequest) (*ListDatabaseQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabaseQuotas not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) SaveDatabaseQuota(ctx context.Context, req *SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDatabaseQuota not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) DropDatabaseQuota(ctx context.Context, req *DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabaseQuota not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ListReadOnlyModes(ctx context.Context, req *ListReadOnlyModesRequest) (*ListReadOnlyModesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReadOnlyModes not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) EnterReadOnly(ctx context.Context, req *EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterReadOnly not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) LeaveReadOnly(ctx context.Context, req *LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveReadOnly not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetSegmentLifecycle(ctx context.Context, req *GetSegmentLifecycleRequest) (*GetSegmentLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentLifecycle not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndexConsistency not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetDeleteTombstones(ctx context.Context, req *GetDeleteTombstonesRequest) (*GetDeleteTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteTombstones not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetDdlOperationState(ctx context.Context, req *GetDdlOperationStateRequest) (*GetDdlOperationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDdlOperationState not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatistics not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreImport not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonIndexNode not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentHistory not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCollectionMaintenance not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCollectionMaintenance not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionMaintenancePauses not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ListProxyTasks(ctx context.Context, req *ListProxyTasksRequest) (*ListProxyTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProxyTasks not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) DrainProxy(ctx context.Context, req *DrainProxyRequest) (*ListProxyTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainProxy not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerGC not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionDataNode not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocatePrimaryKeys not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectSegmentLocks not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetLoadProgressDetail(ctx context.Context, req *GetLoadProgressDetailRequest) (*GetLoadProgressDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadProgressDetail not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CompactSegments(ctx context.Context, req *CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactSegments not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetSearchCalibration(ctx context.Context, req *GetSearchCalibrationRequest) (*GetSearchCalibrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchCalibration not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) SetSearchRecalls(ctx context.Context, req *SetSearchRecallsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSearchRecalls not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetHandoffGate(ctx context.Context, req *GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandoffGate not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexTTLs not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexTTL not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndexTTL not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentAnomalies not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) MergeTinySegments(ctx context.Context, req *MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTinySegments not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIndexBuild not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetSegmentAllocHints(ctx context.Context, req *GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentAllocHints not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentHeats not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeHandoffs not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeHandoffs not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreezeWindows not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteSLA not implemented")
+}
+
+func RegisterMilvusExtServiceServer(s *grpc.Server, srv MilvusExtServiceServer) {
+	s.RegisterService(&_MilvusExtService_serviceDesc, srv)
+}
+
+func _MilvusExtService_DryRunCreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DryRunCreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DryRunCreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DryRunCreateCollection(ctx, req.(*milvuspb.CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_DryRunCreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DryRunCreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DryRunCreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DryRunCreateIndex(ctx, req.(*milvuspb.CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_RebuildDeprecatedIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.RebuildDeprecatedIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).RebuildDeprecatedIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/RebuildDeprecatedIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).RebuildDeprecatedIndexes(ctx, req.(*datapb.RebuildDeprecatedIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_SearchReducedPrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).SearchReducedPrecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/SearchReducedPrecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).SearchReducedPrecision(ctx, req.(*milvuspb.SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_QueryReducedPrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).QueryReducedPrecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/QueryReducedPrecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).QueryReducedPrecision(ctx, req.(*milvuspb.QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ListRoleQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ListRoleQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ListRoleQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ListRoleQuotas(ctx, req.(*ListRoleQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_SaveRoleQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRoleQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).SaveRoleQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/SaveRoleQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).SaveRoleQuota(ctx, req.(*SaveRoleQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_DropRoleQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DropRoleQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DropRoleQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DropRoleQuota(ctx, req.(*DropRoleQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ListDatabaseQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabaseQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ListDatabaseQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ListDatabaseQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ListDatabaseQuotas(ctx, req.(*ListDatabaseQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_SaveDatabaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDatabaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).SaveDatabaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/SaveDatabaseQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).SaveDatabaseQuota(ctx, req.(*SaveDatabaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_DropDatabaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DropDatabaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DropDatabaseQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DropDatabaseQuota(ctx, req.(*DropDatabaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ListReadOnlyModes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReadOnlyModesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ListReadOnlyModes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ListReadOnlyModes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ListReadOnlyModes(ctx, req.(*ListReadOnlyModesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_EnterReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnterReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).EnterReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/EnterReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).EnterReadOnly(ctx, req.(*EnterReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_LeaveReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).LeaveReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/LeaveReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).LeaveReadOnly(ctx, req.(*LeaveReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetSegmentLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetSegmentLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetSegmentLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetSegmentLifecycle(ctx, req.(*GetSegmentLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_CheckIndexConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.CheckIndexConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).CheckIndexConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CheckIndexConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).CheckIndexConsistency(ctx, req.(*indexpb.CheckIndexConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetDeleteTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeleteTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetDeleteTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetDeleteTombstones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetDeleteTombstones(ctx, req.(*GetDeleteTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetDdlOperationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDdlOperationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetDdlOperationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetDdlOperationState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetDdlOperationState(ctx, req.(*GetDdlOperationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetIndexStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetIndexStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetIndexStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetIndexStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetIndexStatistics(ctx, req.(*indexpb.GetIndexStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_PreImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.PreImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).PreImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/PreImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).PreImport(ctx, req.(*datapb.PreImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_CordonIndexNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.CordonIndexNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).CordonIndexNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CordonIndexNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).CordonIndexNode(ctx, req.(*indexpb.CordonIndexNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetSegmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.GetSegmentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetSegmentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetSegmentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetSegmentHistory(ctx, req.(*datapb.GetSegmentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_PauseCollectionMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.PauseCollectionMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).PauseCollectionMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/PauseCollectionMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).PauseCollectionMaintenance(ctx, req.(*datapb.PauseCollectionMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ResumeCollectionMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.ResumeCollectionMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ResumeCollectionMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ResumeCollectionMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ResumeCollectionMaintenance(ctx, req.(*datapb.ResumeCollectionMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetCollectionMaintenancePauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.GetCollectionMaintenancePausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetCollectionMaintenancePauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetCollectionMaintenancePauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetCollectionMaintenancePauses(ctx, req.(*datapb.GetCollectionMaintenancePausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ListProxyTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProxyTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ListProxyTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ListProxyTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ListProxyTasks(ctx, req.(*ListProxyTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_DrainProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DrainProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DrainProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DrainProxy(ctx, req.(*DrainProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_TriggerGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.TriggerGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).TriggerGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/TriggerGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).TriggerGC(ctx, req.(*indexpb.TriggerGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_DecommissionDataNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.DecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).DecommissionDataNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/DecommissionDataNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).DecommissionDataNode(ctx, req.(*datapb.DecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_LocatePrimaryKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.LocatePrimaryKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).LocatePrimaryKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/LocatePrimaryKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).LocatePrimaryKeys(ctx, req.(*datapb.LocatePrimaryKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_InspectSegmentLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.InspectSegmentLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).InspectSegmentLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/InspectSegmentLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).InspectSegmentLocks(ctx, req.(*datapb.InspectSegmentLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetLoadProgressDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadProgressDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetLoadProgressDetail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/GetStatisticsChannel",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetLoadProgressDetail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+		return srv.(MilvusExtServiceServer).GetLoadProgressDetail(ctx, req.(*GetLoadProgressDetailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_InvalidateCollectionMetaCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvalidateCollMetaCacheRequest)
+func _MilvusExtService_CompactSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactSegmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).InvalidateCollectionMetaCache(ctx, in)
+		return srv.(MilvusExtServiceServer).CompactSegments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CompactSegments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).InvalidateCollectionMetaCache(ctx, req.(*InvalidateCollMetaCacheRequest))
+		return srv.(MilvusExtServiceServer).CompactSegments(ctx, req.(*CompactSegmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_GetDdChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetDdChannelRequest)
+func _MilvusExtService_GetSearchCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchCalibrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).GetDdChannel(ctx, in)
+		return srv.(MilvusExtServiceServer).GetSearchCalibration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/GetDdChannel",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetSearchCalibration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).GetDdChannel(ctx, req.(*internalpb.GetDdChannelRequest))
+		return srv.(MilvusExtServiceServer).GetSearchCalibration(ctx, req.(*GetSearchCalibrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_InvalidateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvalidateCredCacheRequest)
+func _MilvusExtService_SetSearchRecalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSearchRecallsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).InvalidateCredentialCache(ctx, in)
+		return srv.(MilvusExtServiceServer).SetSearchRecalls(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCredentialCache",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/SetSearchRecalls",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).InvalidateCredentialCache(ctx, req.(*InvalidateCredCacheRequest))
+		return srv.(MilvusExtServiceServer).SetSearchRecalls(ctx, req.(*SetSearchRecallsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_UpdateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredCacheRequest)
+func _MilvusExtService_GetHandoffGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandoffGateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).UpdateCredentialCache(ctx, in)
+		return srv.(MilvusExtServiceServer).GetHandoffGate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/UpdateCredentialCache",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetHandoffGate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).UpdateCredentialCache(ctx, req.(*UpdateCredCacheRequest))
+		return srv.(MilvusExtServiceServer).GetHandoffGate(ctx, req.(*GetHandoffGateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_RefreshPolicyInfoCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshPolicyInfoCacheRequest)
+func _MilvusExtService_ListIndexTTLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.ListIndexTTLsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, in)
+		return srv.(MilvusExtServiceServer).ListIndexTTLs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ListIndexTTLs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, req.(*RefreshPolicyInfoCacheRequest))
+		return srv.(MilvusExtServiceServer).ListIndexTTLs(ctx, req.(*indexpb.ListIndexTTLsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_GetProxyMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetMetricsRequest)
+func _MilvusExtService_SetIndexTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.SetIndexTTLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).GetProxyMetrics(ctx, in)
+		return srv.(MilvusExtServiceServer).SetIndexTTL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/GetProxyMetrics",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/SetIndexTTL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).GetProxyMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+		return srv.(MilvusExtServiceServer).SetIndexTTL(ctx, req.(*indexpb.SetIndexTTLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Proxy_SetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRatesRequest)
+func _MilvusExtService_CheckIndexTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.CheckIndexTTLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProxyServer).SetRates(ctx, in)
+		return srv.(MilvusExtServiceServer).CheckIndexTTL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.proxy.Proxy/SetRates",
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CheckIndexTTL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProxyServer).SetRates(ctx, req.(*SetRatesRequest))
+		return srv.(MilvusExtServiceServer).CheckIndexTTL(ctx, req.(*indexpb.CheckIndexTTLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Proxy_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "milvus.proto.proxy.Proxy",
-	HandlerType: (*ProxyServer)(nil),
+func _MilvusExtService_GetSegmentAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.GetSegmentAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetSegmentAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetSegmentAnomalies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetSegmentAnomalies(ctx, req.(*datapb.GetSegmentAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_MergeTinySegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTinySegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).MergeTinySegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/MergeTinySegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).MergeTinySegments(ctx, req.(*MergeTinySegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_VerifyIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.VerifyIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).VerifyIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/VerifyIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).VerifyIndexBuild(ctx, req.(*indexpb.VerifyIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetSegmentAllocHints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentAllocHintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetSegmentAllocHints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetSegmentAllocHints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetSegmentAllocHints(ctx, req.(*GetSegmentAllocHintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetSegmentHeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.GetSegmentHeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetSegmentHeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetSegmentHeats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetSegmentHeats(ctx, req.(*datapb.GetSegmentHeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_FreezeHandoffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.FreezeHandoffsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).FreezeHandoffs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/FreezeHandoffs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).FreezeHandoffs(ctx, req.(*datapb.FreezeHandoffsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_UnfreezeHandoffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.UnfreezeHandoffsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).UnfreezeHandoffs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/UnfreezeHandoffs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).UnfreezeHandoffs(ctx, req.(*datapb.UnfreezeHandoffsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetFreezeWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.GetFreezeWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetFreezeWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetFreezeWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetFreezeWindows(ctx, req.(*datapb.GetFreezeWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetDeleteSLA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(datapb.GetDeleteSLARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetDeleteSLA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetDeleteSLA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetDeleteSLA(ctx, req.(*datapb.GetDeleteSLARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MilvusExtService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.proxy.MilvusExtService",
+	HandlerType: (*MilvusExtServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetComponentStates",
-			Handler:    _Proxy_GetComponentStates_Handler,
+			MethodName: "DryRunCreateCollection",
+			Handler:    _MilvusExtService_DryRunCreateCollection_Handler,
 		},
 		{
-			MethodName: "GetStatisticsChannel",
-			Handler:    _Proxy_GetStatisticsChannel_Handler,
+			MethodName: "DryRunCreateIndex",
+			Handler:    _MilvusExtService_DryRunCreateIndex_Handler,
 		},
 		{
-			MethodName: "InvalidateCollectionMetaCache",
-			Handler:    _Proxy_InvalidateCollectionMetaCache_Handler,
+			MethodName: "RebuildDeprecatedIndexes",
+			Handler:    _MilvusExtService_RebuildDeprecatedIndexes_Handler,
 		},
 		{
-			MethodName: "GetDdChannel",
-			Handler:    _Proxy_GetDdChannel_Handler,
+			MethodName: "SearchReducedPrecision",
+			Handler:    _MilvusExtService_SearchReducedPrecision_Handler,
 		},
 		{
-			MethodName: "InvalidateCredentialCache",
-			Handler:    _Proxy_InvalidateCredentialCache_Handler,
+			MethodName: "QueryReducedPrecision",
+			Handler:    _MilvusExtService_QueryReducedPrecision_Handler,
 		},
 		{
-			MethodName: "UpdateCredentialCache",
-			Handler:    _Proxy_UpdateCredentialCache_Handler,
+			MethodName: "ListRoleQuotas",
+			Handler:    _MilvusExtService_ListRoleQuotas_Handler,
 		},
 		{
-			MethodName: "RefreshPolicyInfoCache",
-			Handler:    _Proxy_RefreshPolicyInfoCache_Handler,
+			MethodName: "SaveRoleQuota",
+			Handler:    _MilvusExtService_SaveRoleQuota_Handler,
 		},
 		{
-			MethodName: "GetProxyMetrics",
-			Handler:    _Proxy_GetProxyMetrics_Handler,
+			MethodName: "DropRoleQuota",
+			Handler:    _MilvusExtService_DropRoleQuota_Handler,
 		},
 		{
-			MethodName: "SetRates",
-			Handler:    _Proxy_SetRates_Handler,
+			MethodName: "ListDatabaseQuotas",
+			Handler:    _MilvusExtService_ListDatabaseQuotas_Handler,
+		},
+		{
+			MethodName: "SaveDatabaseQuota",
+			Handler:    _MilvusExtService_SaveDatabaseQuota_Handler,
+		},
+		{
+			MethodName: "DropDatabaseQuota",
+			Handler:    _MilvusExtService_DropDatabaseQuota_Handler,
+		},
+		{
+			MethodName: "ListReadOnlyModes",
+			Handler:    _MilvusExtService_ListReadOnlyModes_Handler,
+		},
+		{
+			MethodName: "EnterReadOnly",
+			Handler:    _MilvusExtService_EnterReadOnly_Handler,
+		},
+		{
+			MethodName: "LeaveReadOnly",
+			Handler:    _MilvusExtService_LeaveReadOnly_Handler,
+		},
+		{
+			MethodName: "GetSegmentLifecycle",
+			Handler:    _MilvusExtService_GetSegmentLifecycle_Handler,
+		},
+		{
+			MethodName: "CheckIndexConsistency",
+			Handler:    _MilvusExtService_CheckIndexConsistency_Handler,
+		},
+		{
+			MethodName: "GetDeleteTombstones",
+			Handler:    _MilvusExtService_GetDeleteTombstones_Handler,
+		},
+		{
+			MethodName: "GetDdlOperationState",
+			Handler:    _MilvusExtService_GetDdlOperationState_Handler,
+		},
+		{
+			MethodName: "GetIndexStatistics",
+			Handler:    _MilvusExtService_GetIndexStatistics_Handler,
+		},
+		{
+			MethodName: "PreImport",
+			Handler:    _MilvusExtService_PreImport_Handler,
+		},
+		{
+			MethodName: "CordonIndexNode",
+			Handler:    _MilvusExtService_CordonIndexNode_Handler,
+		},
+		{
+			MethodName: "GetSegmentHistory",
+			Handler:    _MilvusExtService_GetSegmentHistory_Handler,
+		},
+		{
+			MethodName: "PauseCollectionMaintenance",
+			Handler:    _MilvusExtService_PauseCollectionMaintenance_Handler,
+		},
+		{
+			MethodName: "ResumeCollectionMaintenance",
+			Handler:    _MilvusExtService_ResumeCollectionMaintenance_Handler,
+		},
+		{
+			MethodName: "GetCollectionMaintenancePauses",
+			Handler:    _MilvusExtService_GetCollectionMaintenancePauses_Handler,
+		},
+		{
+			MethodName: "ListProxyTasks",
+			Handler:    _MilvusExtService_ListProxyTasks_Handler,
+		},
+		{
+			MethodName: "DrainProxy",
+			Handler:    _MilvusExtService_DrainProxy_Handler,
+		},
+		{
+			MethodName: "TriggerGC",
+			Handler:    _MilvusExtService_TriggerGC_Handler,
+		},
+		{
+			MethodName: "DecommissionDataNode",
+			Handler:    _MilvusExtService_DecommissionDataNode_Handler,
+		},
+		{
+			MethodName: "LocatePrimaryKeys",
+			Handler:    _MilvusExtService_LocatePrimaryKeys_Handler,
+		},
+		{
+			MethodName: "InspectSegmentLocks",
+			Handler:    _MilvusExtService_InspectSegmentLocks_Handler,
+		},
+		{
+			MethodName: "GetLoadProgressDetail",
+			Handler:    _MilvusExtService_GetLoadProgressDetail_Handler,
+		},
+		{
+			MethodName: "CompactSegments",
+			Handler:    _MilvusExtService_CompactSegments_Handler,
+		},
+		{
+			MethodName: "GetSearchCalibration",
+			Handler:    _MilvusExtService_GetSearchCalibration_Handler,
+		},
+		{
+			MethodName: "SetSearchRecalls",
+			Handler:    _MilvusExtService_SetSearchRecalls_Handler,
+		},
+		{
+			MethodName: "GetHandoffGate",
+			Handler:    _MilvusExtService_GetHandoffGate_Handler,
+		},
+		{
+			MethodName: "ListIndexTTLs",
+			Handler:    _MilvusExtService_ListIndexTTLs_Handler,
+		},
+		{
+			MethodName: "SetIndexTTL",
+			Handler:    _MilvusExtService_SetIndexTTL_Handler,
+		},
+		{
+			MethodName: "CheckIndexTTL",
+			Handler:    _MilvusExtService_CheckIndexTTL_Handler,
+		},
+		{
+			MethodName: "GetSegmentAnomalies",
+			Handler:    _MilvusExtService_GetSegmentAnomalies_Handler,
+		},
+		{
+			MethodName: "MergeTinySegments",
+			Handler:    _MilvusExtService_MergeTinySegments_Handler,
+		},
+		{
+			MethodName: "VerifyIndexBuild",
+			Handler:    _MilvusExtService_VerifyIndexBuild_Handler,
+		},
+		{
+			MethodName: "GetSegmentAllocHints",
+			Handler:    _MilvusExtService_GetSegmentAllocHints_Handler,
+		},
+		{
+			MethodName: "GetSegmentHeats",
+			Handler:    _MilvusExtService_GetSegmentHeats_Handler,
+		},
+		{
+			MethodName: "FreezeHandoffs",
+			Handler:    _MilvusExtService_FreezeHandoffs_Handler,
+		},
+		{
+			MethodName: "UnfreezeHandoffs",
+			Handler:    _MilvusExtService_UnfreezeHandoffs_Handler,
+		},
+		{
+			MethodName: "GetFreezeWindows",
+			Handler:    _MilvusExtService_GetFreezeWindows_Handler,
+		},
+		{
+			MethodName: "GetDeleteSLA",
+			Handler:    _MilvusExtService_GetDeleteSLA_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
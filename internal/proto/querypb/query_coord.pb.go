@@ -60,6 +60,38 @@ func (DataScope) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{0}
 }
 
+// SearchPhase is the phase of a follower search reduced in two phases by the shard leader.
+type SearchPhase int32
+
+const (
+	// the full results in one phase
+	SearchPhase_FullResults SearchPhase = 0
+	// the ids and scores of the results only, the full results are cached for the FetchSurviving phase
+	SearchPhase_ScoresOnly SearchPhase = 1
+	// the full results surviving the merge of the ScoresOnly phase, served by the cached results
+	SearchPhase_FetchSurviving SearchPhase = 2
+)
+
+var SearchPhase_name = map[int32]string{
+	0: "FullResults",
+	1: "ScoresOnly",
+	2: "FetchSurviving",
+}
+
+var SearchPhase_value = map[string]int32{
+	"FullResults":    0,
+	"ScoresOnly":     1,
+	"FetchSurviving": 2,
+}
+
+func (x SearchPhase) String() string {
+	return proto.EnumName(SearchPhase_name, int32(x))
+}
+
+func (SearchPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
+}
+
 type PartitionState int32
 
 const (
@@ -97,7 +129,7 @@ func (x PartitionState) String() string {
 }
 
 func (PartitionState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
 }
 
 type TriggerCondition int32
@@ -131,7 +163,7 @@ func (x TriggerCondition) String() string {
 }
 
 func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
 type LoadType int32
@@ -159,7 +191,7 @@ func (x LoadType) String() string {
 }
 
 func (LoadType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
 }
 
 type LoadStatus int32
@@ -187,7 +219,7 @@ func (x LoadStatus) String() string {
 }
 
 func (LoadStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
 }
 
 type SyncType int32
@@ -212,7 +244,7 @@ func (x SyncType) String() string {
 }
 
 func (SyncType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
 }
 
 //--------------------QueryCoord grpc request and response proto------------------
@@ -1914,14 +1946,18 @@ func (m *ReleaseSegmentsRequest) GetNeedTransfer() bool {
 }
 
 type SearchRequest struct {
-	Req                  *internalpb.SearchRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
-	DmlChannels          []string                  `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
-	SegmentIDs           []int64                   `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	FromShardLeader      bool                      `protobuf:"varint,4,opt,name=from_shard_leader,json=fromShardLeader,proto3" json:"from_shard_leader,omitempty"`
-	Scope                DataScope                 `protobuf:"varint,5,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Req             *internalpb.SearchRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmlChannels     []string                  `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	SegmentIDs      []int64                   `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FromShardLeader bool                      `protobuf:"varint,4,opt,name=from_shard_leader,json=fromShardLeader,proto3" json:"from_shard_leader,omitempty"`
+	Scope           DataScope                 `protobuf:"varint,5,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
+	// the phase of the adaptive reduce the shard leader asks the follower for
+	SearchPhase SearchPhase `protobuf:"varint,6,opt,name=search_phase,json=searchPhase,proto3,enum=milvus.proto.query.SearchPhase" json:"search_phase,omitempty"`
+	// the number of the results of every query to fetch in the FetchSurviving phase
+	FetchCounts          []int64  `protobuf:"varint,7,rep,packed,name=fetch_counts,json=fetchCounts,proto3" json:"fetch_counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -1984,6 +2020,20 @@ func (m *SearchRequest) GetScope() DataScope {
 	return DataScope_UnKnown
 }
 
+func (m *SearchRequest) GetSearchPhase() SearchPhase {
+	if m != nil {
+		return m.SearchPhase
+	}
+	return SearchPhase_FullResults
+}
+
+func (m *SearchRequest) GetFetchCounts() []int64 {
+	if m != nil {
+		return m.FetchCounts
+	}
+	return nil
+}
+
 type QueryRequest struct {
 	Req                  *internalpb.RetrieveRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
 	DmlChannels          []string                    `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
@@ -3668,6 +3718,7 @@ func (m *SyncDistributionRequest) GetActions() []*SyncAction {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
+	proto.RegisterEnum("milvus.proto.query.SearchPhase", SearchPhase_name, SearchPhase_value)
 	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
 	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
 	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
@@ -3734,241 +3785,246 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 3736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x49, 0x6c, 0x24, 0x59,
-	0x56, 0x15, 0xb9, 0xd8, 0x99, 0x2f, 0x17, 0x87, 0xbf, 0xab, 0x5c, 0x39, 0x39, 0xd5, 0xd5, 0xee,
-	0xa8, 0xae, 0x6e, 0xe3, 0x9e, 0xb6, 0x7b, 0x5c, 0x33, 0x4d, 0x0d, 0x33, 0xa3, 0xa1, 0xca, 0x9e,
-	0x72, 0x9b, 0xee, 0xf2, 0x98, 0xc8, 0xaa, 0x02, 0xb5, 0x9a, 0xc9, 0x89, 0xcc, 0xf8, 0x99, 0x0e,
-	0x55, 0x2c, 0x59, 0x11, 0x91, 0xae, 0x76, 0x73, 0xe5, 0x32, 0x23, 0x40, 0x82, 0x03, 0x27, 0xc4,
-	0x09, 0x24, 0x90, 0x68, 0xc4, 0x01, 0x6e, 0x1c, 0x90, 0x90, 0xe0, 0x86, 0xb8, 0x71, 0xe4, 0x8a,
-	0x04, 0x12, 0x12, 0xd2, 0x1c, 0x38, 0x20, 0xa1, 0xbf, 0xc5, 0xfa, 0xc3, 0x19, 0x65, 0x77, 0xf5,
-	0x82, 0xe6, 0x96, 0xf1, 0xfe, 0xf2, 0xde, 0x7f, 0xfb, 0x7b, 0xff, 0x27, 0xac, 0x3e, 0x9b, 0x63,
-	0xff, 0x6c, 0x38, 0xf6, 0x3c, 0xdf, 0xdc, 0x9e, 0xf9, 0x5e, 0xe8, 0x21, 0xe4, 0x58, 0xf6, 0xe9,
-	0x3c, 0x60, 0x5f, 0xdb, 0x74, 0xbc, 0xdf, 0x1e, 0x7b, 0x8e, 0xe3, 0xb9, 0x0c, 0xd6, 0x6f, 0x27,
-	0x67, 0xf4, 0xbb, 0x96, 0x1b, 0x62, 0xdf, 0x35, 0x6c, 0x31, 0x1a, 0x8c, 0x4f, 0xb0, 0x63, 0xf0,
-	0x2f, 0xd5, 0x34, 0x42, 0x23, 0xb9, 0xbf, 0xf6, 0x3b, 0x0a, 0xac, 0x0f, 0x4e, 0xbc, 0xe7, 0x7b,
-	0x9e, 0x6d, 0xe3, 0x71, 0x68, 0x79, 0x6e, 0xa0, 0xe3, 0x67, 0x73, 0x1c, 0x84, 0xe8, 0x1d, 0xa8,
-	0x8d, 0x8c, 0x00, 0xf7, 0x94, 0x0d, 0x65, 0xb3, 0xb5, 0x7b, 0x63, 0x3b, 0x45, 0x09, 0x27, 0xe1,
-	0x61, 0x30, 0xbd, 0x6f, 0x04, 0x58, 0xa7, 0x33, 0x11, 0x82, 0x9a, 0x39, 0x3a, 0xdc, 0xef, 0x55,
-	0x36, 0x94, 0xcd, 0xaa, 0x4e, 0x7f, 0xa3, 0xd7, 0xa1, 0x33, 0x8e, 0xf6, 0x3e, 0xdc, 0x0f, 0x7a,
-	0xd5, 0x8d, 0xea, 0x66, 0x55, 0x4f, 0x03, 0xb5, 0x7f, 0x53, 0xe0, 0x7a, 0x8e, 0x8c, 0x60, 0xe6,
-	0xb9, 0x01, 0x46, 0x77, 0x60, 0x29, 0x08, 0x8d, 0x70, 0x1e, 0x70, 0x4a, 0xbe, 0x2e, 0xa5, 0x64,
-	0x40, 0xa7, 0xe8, 0x7c, 0x6a, 0x1e, 0x6d, 0x45, 0x82, 0x16, 0x7d, 0x13, 0xae, 0x5a, 0xee, 0x43,
-	0xec, 0x78, 0xfe, 0xd9, 0x70, 0x86, 0xfd, 0x31, 0x76, 0x43, 0x63, 0x8a, 0x05, 0x8d, 0x6b, 0x62,
-	0xec, 0x38, 0x1e, 0x42, 0xef, 0xc2, 0x75, 0x26, 0xa5, 0x00, 0xfb, 0xa7, 0xd6, 0x18, 0x0f, 0x8d,
-	0x53, 0xc3, 0xb2, 0x8d, 0x91, 0x8d, 0x7b, 0xb5, 0x8d, 0xea, 0x66, 0x43, 0xbf, 0x46, 0x87, 0x07,
-	0x6c, 0xf4, 0x9e, 0x18, 0xd4, 0xfe, 0x4c, 0x81, 0x6b, 0xe4, 0x84, 0xc7, 0x86, 0x1f, 0x5a, 0x2f,
-	0x81, 0xcf, 0x1a, 0xb4, 0x93, 0x67, 0xeb, 0x55, 0xe9, 0x58, 0x0a, 0x46, 0xe6, 0xcc, 0x04, 0x7a,
-	0xc2, 0x93, 0x1a, 0x3d, 0x66, 0x0a, 0xa6, 0xfd, 0x29, 0x57, 0x88, 0x24, 0x9d, 0x97, 0x11, 0x44,
-	0x16, 0x67, 0x25, 0x8f, 0xf3, 0x02, 0x62, 0xd0, 0x7e, 0x56, 0x85, 0x6b, 0x1f, 0x78, 0x86, 0x19,
-	0x2b, 0xcc, 0xe7, 0xcf, 0xce, 0xef, 0xc3, 0x12, 0xb3, 0xae, 0x5e, 0x8d, 0xe2, 0xba, 0x9d, 0xc6,
-	0xc5, 0x2d, 0x2f, 0xa6, 0x70, 0x40, 0x01, 0x3a, 0x5f, 0x84, 0x6e, 0x43, 0xd7, 0xc7, 0x33, 0xdb,
-	0x1a, 0x1b, 0x43, 0x77, 0xee, 0x8c, 0xb0, 0xdf, 0xab, 0x6f, 0x28, 0x9b, 0x75, 0xbd, 0xc3, 0xa1,
-	0x47, 0x14, 0x88, 0x7e, 0x02, 0x9d, 0x89, 0x85, 0x6d, 0x73, 0x68, 0xb9, 0x26, 0xfe, 0xf8, 0x70,
-	0xbf, 0xb7, 0xb4, 0x51, 0xdd, 0x6c, 0xed, 0x7e, 0x77, 0x3b, 0xef, 0x19, 0xb6, 0xa5, 0x1c, 0xd9,
-	0x7e, 0x40, 0x96, 0x1f, 0xb2, 0xd5, 0x3f, 0x74, 0x43, 0xff, 0x4c, 0x6f, 0x4f, 0x12, 0xa0, 0xfe,
-	0x0f, 0x60, 0x35, 0x37, 0x05, 0xa9, 0x50, 0x7d, 0x8a, 0xcf, 0x28, 0x17, 0xab, 0x3a, 0xf9, 0x89,
-	0xae, 0x42, 0xfd, 0xd4, 0xb0, 0xe7, 0x98, 0xf3, 0x89, 0x7d, 0xfc, 0x4a, 0xe5, 0xae, 0xa2, 0xfd,
-	0xb1, 0x02, 0x3d, 0x1d, 0xdb, 0xd8, 0x08, 0xf0, 0x17, 0x29, 0x8f, 0x75, 0x58, 0x72, 0x3d, 0x13,
-	0x1f, 0xee, 0x53, 0x79, 0x54, 0x75, 0xfe, 0xa5, 0xfd, 0x8f, 0x02, 0x57, 0x0f, 0x70, 0x48, 0x14,
-	0xd3, 0x0a, 0x42, 0x6b, 0x1c, 0x59, 0xde, 0xf7, 0xa1, 0xea, 0xe3, 0x67, 0x9c, 0xb2, 0xb7, 0xd2,
-	0x94, 0x45, 0x7e, 0x54, 0xb6, 0x52, 0x27, 0xeb, 0xd0, 0x6b, 0xd0, 0x36, 0x1d, 0x7b, 0x38, 0x3e,
-	0x31, 0x5c, 0x17, 0xdb, 0x4c, 0xb5, 0x9b, 0x7a, 0xcb, 0x74, 0xec, 0x3d, 0x0e, 0x42, 0x37, 0x01,
-	0x02, 0x3c, 0x75, 0xb0, 0x1b, 0xc6, 0xae, 0x2f, 0x01, 0x41, 0x5b, 0xb0, 0x3a, 0xf1, 0x3d, 0x67,
-	0x18, 0x9c, 0x18, 0xbe, 0x39, 0xb4, 0xb1, 0x61, 0x62, 0x9f, 0x52, 0xdf, 0xd0, 0x57, 0xc8, 0xc0,
-	0x80, 0xc0, 0x3f, 0xa0, 0x60, 0x74, 0x07, 0xea, 0xc1, 0xd8, 0x9b, 0x61, 0xaa, 0x26, 0xdd, 0xdd,
-	0x57, 0x64, 0x0a, 0xb0, 0x6f, 0x84, 0xc6, 0x80, 0x4c, 0xd2, 0xd9, 0x5c, 0xed, 0xaf, 0xb8, 0x9d,
-	0x7c, 0xc9, 0xdd, 0x4e, 0xc2, 0x96, 0xea, 0x9f, 0x8d, 0x2d, 0x2d, 0x95, 0xb2, 0xa5, 0xe5, 0xf3,
-	0x6d, 0x29, 0xc7, 0xb5, 0x97, 0x6f, 0x4b, 0x7f, 0x1f, 0xdb, 0xd2, 0x97, 0x5d, 0x66, 0xb1, 0xbd,
-	0xd5, 0x53, 0xf6, 0xf6, 0x17, 0x0a, 0x7c, 0xed, 0x00, 0x87, 0x11, 0xf9, 0xc4, 0x7c, 0xf0, 0x97,
-	0x34, 0xdc, 0x7d, 0xaa, 0x40, 0x5f, 0x46, 0xeb, 0x65, 0x42, 0xde, 0x87, 0xb0, 0x1e, 0xe1, 0x18,
-	0x9a, 0x38, 0x18, 0xfb, 0xd6, 0x8c, 0x8a, 0x91, 0x7a, 0x88, 0xd6, 0xee, 0x2d, 0x99, 0xba, 0x65,
-	0x29, 0xb8, 0x16, 0x6d, 0xb1, 0x9f, 0xd8, 0x41, 0xfb, 0x3d, 0x05, 0xae, 0x11, 0x8f, 0xc4, 0x5d,
-	0x88, 0x3b, 0xf1, 0x2e, 0xce, 0xd7, 0xb4, 0x73, 0xaa, 0xe4, 0x9c, 0x53, 0x09, 0x1e, 0xd3, 0xfc,
-	0x31, 0x4b, 0xcf, 0x65, 0x78, 0xf7, 0x6d, 0xa8, 0x5b, 0xee, 0xc4, 0x13, 0xac, 0x7a, 0x55, 0xc6,
-	0xaa, 0x24, 0x32, 0x36, 0x5b, 0x73, 0x19, 0x15, 0xb1, 0xb7, 0xbc, 0x84, 0xba, 0x65, 0x8f, 0x5d,
-	0x91, 0x1c, 0xfb, 0x77, 0x15, 0xb8, 0x9e, 0x43, 0x78, 0x99, 0x73, 0x7f, 0x0f, 0x96, 0x68, 0x0c,
-	0x10, 0x07, 0x7f, 0x5d, 0x7a, 0xf0, 0x04, 0xba, 0x0f, 0xac, 0x20, 0xd4, 0xf9, 0x1a, 0xcd, 0x03,
-	0x35, 0x3b, 0x46, 0xa2, 0x13, 0x8f, 0x4c, 0x43, 0xd7, 0x70, 0x18, 0x03, 0x9a, 0x7a, 0x8b, 0xc3,
-	0x8e, 0x0c, 0x07, 0xa3, 0xaf, 0x41, 0x83, 0x98, 0xec, 0xd0, 0x32, 0x85, 0xf8, 0x97, 0xa9, 0x09,
-	0x9b, 0x01, 0x7a, 0x05, 0x80, 0x0e, 0x19, 0xa6, 0xe9, 0xb3, 0xc0, 0xd5, 0xd4, 0x9b, 0x04, 0x72,
-	0x8f, 0x00, 0xb4, 0x3f, 0x50, 0xa0, 0x4d, 0x1c, 0xe4, 0x43, 0x1c, 0x1a, 0x44, 0x0e, 0xe8, 0x3b,
-	0xd0, 0xb4, 0x3d, 0xc3, 0x1c, 0x86, 0x67, 0x33, 0x86, 0xaa, 0x9b, 0xe5, 0x75, 0xec, 0x55, 0x1f,
-	0x9d, 0xcd, 0xb0, 0xde, 0xb0, 0xf9, 0xaf, 0x32, 0xfc, 0xce, 0x99, 0x72, 0x55, 0x62, 0xca, 0xff,
-	0x58, 0x87, 0xf5, 0xdf, 0x30, 0xc2, 0xf1, 0xc9, 0xbe, 0x23, 0xe2, 0xef, 0xc5, 0x95, 0x20, 0xf6,
-	0x6d, 0x95, 0xa4, 0x6f, 0xfb, 0xcc, 0x7c, 0x67, 0xa4, 0xe7, 0x75, 0x99, 0x9e, 0x93, 0x32, 0x6d,
-	0xfb, 0x09, 0x17, 0x55, 0x42, 0xcf, 0x13, 0x61, 0x72, 0xe9, 0x22, 0x61, 0x72, 0x0f, 0x3a, 0xf8,
-	0xe3, 0xb1, 0x3d, 0x27, 0x32, 0xa7, 0xd8, 0x59, 0xfc, 0xbb, 0x29, 0xc1, 0x9e, 0x34, 0xb2, 0x36,
-	0x5f, 0x74, 0xc8, 0x69, 0x60, 0xa2, 0x76, 0x70, 0x68, 0xf4, 0x1a, 0x94, 0x8c, 0x8d, 0x22, 0x51,
-	0x0b, 0xfd, 0x60, 0xe2, 0x26, 0x5f, 0xe8, 0x06, 0x34, 0x79, 0x50, 0x3e, 0xdc, 0xef, 0x35, 0x29,
-	0xfb, 0x62, 0x00, 0x32, 0xa0, 0xc3, 0x3d, 0x10, 0xa7, 0x10, 0x28, 0x85, 0xdf, 0x93, 0x21, 0x90,
-	0x0b, 0x3b, 0x49, 0x79, 0xc0, 0x43, 0x74, 0x90, 0x00, 0x91, 0xd2, 0xd0, 0x9b, 0x4c, 0x6c, 0xcb,
-	0xc5, 0x47, 0x4c, 0xc2, 0x2d, 0x4a, 0x44, 0x1a, 0x88, 0x7a, 0xb0, 0x7c, 0x8a, 0xfd, 0xc0, 0xf2,
-	0xdc, 0x5e, 0x9b, 0x8e, 0x8b, 0xcf, 0xfe, 0x10, 0x56, 0x73, 0x28, 0x24, 0x21, 0xfe, 0x5b, 0xc9,
-	0x10, 0xbf, 0x98, 0xc7, 0x89, 0x14, 0xe0, 0xcf, 0x15, 0xb8, 0xf6, 0xd8, 0x0d, 0xe6, 0xa3, 0xe8,
-	0x6c, 0x5f, 0x8c, 0x1e, 0x67, 0x3d, 0x48, 0x2d, 0xe7, 0x41, 0xb4, 0x9f, 0xd6, 0x61, 0x85, 0x9f,
-	0x82, 0x88, 0x9b, 0xba, 0x82, 0x1b, 0xd0, 0x8c, 0x82, 0x08, 0x67, 0x48, 0x0c, 0x40, 0x1b, 0xd0,
-	0x4a, 0x18, 0x02, 0xa7, 0x2a, 0x09, 0x2a, 0x45, 0x9a, 0x48, 0x09, 0x6a, 0x89, 0x94, 0xe0, 0x15,
-	0x80, 0x89, 0x3d, 0x0f, 0x4e, 0x86, 0xa1, 0xe5, 0x60, 0x9e, 0x92, 0x34, 0x29, 0xe4, 0x91, 0xe5,
-	0x60, 0x74, 0x0f, 0xda, 0x23, 0xcb, 0xb5, 0xbd, 0xe9, 0x70, 0x66, 0x84, 0x27, 0x01, 0x2f, 0xa3,
-	0x64, 0x62, 0xa1, 0x09, 0xdc, 0x7d, 0x3a, 0x57, 0x6f, 0xb1, 0x35, 0xc7, 0x64, 0x09, 0xba, 0x09,
-	0x2d, 0x77, 0xee, 0x0c, 0xbd, 0xc9, 0xd0, 0xf7, 0x9e, 0x13, 0xe3, 0xa1, 0x28, 0xdc, 0xb9, 0xf3,
-	0xa3, 0x89, 0xee, 0x3d, 0x27, 0x4e, 0xbc, 0x49, 0xdc, 0x79, 0x60, 0x7b, 0xd3, 0xa0, 0xd7, 0x28,
-	0xb5, 0x7f, 0xbc, 0x80, 0xac, 0x36, 0xb1, 0x1d, 0x1a, 0x74, 0x75, 0xb3, 0xdc, 0xea, 0x68, 0x01,
-	0x7a, 0x03, 0xba, 0x63, 0xcf, 0x99, 0x19, 0x94, 0x43, 0x0f, 0x7c, 0xcf, 0xa1, 0x96, 0x53, 0xd5,
-	0x33, 0x50, 0xb4, 0x07, 0x2d, 0x9a, 0xfc, 0x72, 0xf3, 0x6a, 0x51, 0x3c, 0x9a, 0xcc, 0xbc, 0x12,
-	0x79, 0x2c, 0x51, 0x50, 0xb0, 0xc4, 0xcf, 0x80, 0x68, 0x86, 0xb0, 0xd2, 0xc0, 0xfa, 0x04, 0x73,
-	0x0b, 0x69, 0x71, 0xd8, 0xc0, 0xfa, 0x04, 0x93, 0x8c, 0xdc, 0x72, 0x03, 0xec, 0x87, 0xa2, 0x3e,
-	0xea, 0x75, 0xa8, 0xfa, 0x74, 0x18, 0x94, 0x2b, 0x36, 0x3a, 0x84, 0x6e, 0x10, 0x1a, 0x7e, 0x38,
-	0x9c, 0x79, 0x01, 0x55, 0x80, 0x5e, 0x97, 0xea, 0xb6, 0x56, 0x50, 0x8d, 0x3d, 0x0c, 0xa6, 0xc7,
-	0x7c, 0xa6, 0xde, 0xa1, 0x2b, 0xc5, 0xa7, 0xf6, 0x5f, 0x15, 0xe8, 0xa6, 0x69, 0x26, 0x46, 0xcc,
-	0xb2, 0x73, 0xa1, 0x88, 0xe2, 0x93, 0x9c, 0x00, 0xbb, 0xc6, 0xc8, 0xc6, 0xac, 0x14, 0xa0, 0x7a,
-	0xd8, 0xd0, 0x5b, 0x0c, 0x46, 0x37, 0x20, 0xfa, 0xc4, 0x38, 0x45, 0x95, 0xbf, 0x4a, 0xa9, 0x6f,
-	0x52, 0x08, 0x0d, 0x9e, 0x3d, 0x58, 0x16, 0x55, 0x04, 0xd3, 0x42, 0xf1, 0x49, 0x46, 0x46, 0x73,
-	0x8b, 0x62, 0x65, 0x5a, 0x28, 0x3e, 0xd1, 0x3e, 0xb4, 0xd9, 0x96, 0x33, 0xc3, 0x37, 0x1c, 0xa1,
-	0x83, 0xaf, 0x49, 0xed, 0xf8, 0x7d, 0x7c, 0xf6, 0x84, 0xb8, 0x84, 0x63, 0xc3, 0xf2, 0x75, 0x26,
-	0xb3, 0x63, 0xba, 0x0a, 0x6d, 0x82, 0xca, 0x76, 0x99, 0x58, 0x36, 0xe6, 0xda, 0xbc, 0x4c, 0x23,
-	0x74, 0x97, 0xc2, 0x1f, 0x58, 0x36, 0x66, 0x0a, 0x1b, 0x1d, 0x81, 0x4a, 0xa9, 0xc1, 0xf4, 0x95,
-	0x42, 0xa8, 0x8c, 0x6e, 0x41, 0x87, 0x0d, 0x0b, 0x4f, 0xc7, 0xdc, 0x31, 0xa3, 0xf1, 0x09, 0x83,
-	0xd1, 0x24, 0x61, 0xee, 0x30, 0x8d, 0x07, 0x76, 0x1c, 0x77, 0xee, 0x10, 0x7d, 0xd7, 0xfe, 0xb0,
-	0x06, 0x6b, 0xc4, 0xec, 0xb9, 0x07, 0xb8, 0x44, 0xb8, 0x7d, 0x05, 0xc0, 0x0c, 0xc2, 0x61, 0xca,
-	0x55, 0x35, 0xcd, 0x20, 0xe4, 0xce, 0xf8, 0x3b, 0x22, 0x5a, 0x56, 0x8b, 0x13, 0xe8, 0x8c, 0x1b,
-	0xca, 0x47, 0xcc, 0x0b, 0x35, 0x69, 0x6e, 0x41, 0x27, 0xf0, 0xe6, 0xfe, 0x18, 0x0f, 0x53, 0xa5,
-	0x4e, 0x9b, 0x01, 0x8f, 0xe4, 0xce, 0x74, 0x49, 0xda, 0x2c, 0x4a, 0x44, 0xcd, 0xe5, 0xcb, 0x45,
-	0xcd, 0x46, 0x36, 0x6a, 0xbe, 0x0f, 0x2b, 0xd4, 0x13, 0x44, 0x56, 0x24, 0x1c, 0x48, 0x19, 0x33,
-	0xea, 0xd2, 0xa5, 0xe2, 0x33, 0x48, 0x46, 0x3e, 0x48, 0x45, 0x3e, 0xc2, 0x0c, 0x17, 0x63, 0x73,
-	0x18, 0xfa, 0x86, 0x1b, 0x4c, 0xb0, 0x4f, 0x23, 0x67, 0x43, 0x6f, 0x13, 0xe0, 0x23, 0x0e, 0xd3,
-	0xfe, 0xb9, 0x02, 0xeb, 0xbc, 0x80, 0xbd, 0xbc, 0x5e, 0x14, 0x85, 0x2f, 0xe1, 0xff, 0xab, 0xe7,
-	0x94, 0x84, 0xb5, 0x12, 0xa9, 0x59, 0x5d, 0x92, 0x9a, 0xa5, 0xcb, 0xa2, 0xa5, 0x5c, 0x59, 0x14,
-	0xf5, 0x61, 0x96, 0xcb, 0xf7, 0x61, 0x48, 0xc1, 0x4f, 0x73, 0x75, 0x2a, 0xbb, 0xa6, 0xce, 0x3e,
-	0xca, 0x31, 0xf4, 0x3f, 0x14, 0xe8, 0x0c, 0xb0, 0xe1, 0x8f, 0x4f, 0x04, 0x1f, 0xdf, 0x4d, 0xf6,
-	0xad, 0x5e, 0x2f, 0x10, 0x71, 0x6a, 0xc9, 0x57, 0xa7, 0x61, 0xf5, 0x9f, 0x0a, 0xb4, 0x7f, 0x9d,
-	0x0c, 0x89, 0xc3, 0xde, 0x4d, 0x1e, 0xf6, 0x8d, 0x82, 0xc3, 0xea, 0x38, 0xf4, 0x2d, 0x7c, 0x8a,
-	0xbf, 0x72, 0xc7, 0xfd, 0x27, 0x05, 0xfa, 0x83, 0x33, 0x77, 0xac, 0x33, 0x5b, 0xbe, 0xbc, 0xc5,
-	0xdc, 0x82, 0xce, 0x69, 0x2a, 0x6b, 0xab, 0x50, 0x85, 0x6b, 0x9f, 0x26, 0x0b, 0x3f, 0x1d, 0x54,
-	0xd1, 0x2e, 0xe3, 0x87, 0x15, 0xae, 0xf5, 0x4d, 0x19, 0xd5, 0x19, 0xe2, 0xa8, 0x6b, 0x5a, 0xf1,
-	0xd3, 0x40, 0xed, 0xf7, 0x15, 0x58, 0x93, 0x4c, 0x44, 0xd7, 0x61, 0x99, 0x17, 0x99, 0x3c, 0x06,
-	0x33, 0x1b, 0x36, 0x89, 0x78, 0xe2, 0x36, 0x89, 0x65, 0xe6, 0x53, 0x41, 0x13, 0xbd, 0x0a, 0xad,
-	0xa8, 0x1a, 0x30, 0x73, 0xf2, 0x31, 0x03, 0xd4, 0x87, 0x06, 0x77, 0x4e, 0xa2, 0xcc, 0x8a, 0xbe,
-	0xb5, 0xbf, 0x53, 0x60, 0xfd, 0x3d, 0xc3, 0x35, 0xbd, 0xc9, 0xe4, 0xf2, 0x6c, 0xdd, 0x83, 0x54,
-	0x11, 0x51, 0xb6, 0x3d, 0x91, 0xae, 0x3c, 0xde, 0x82, 0x55, 0x9f, 0x79, 0x46, 0x33, 0xcd, 0xf7,
-	0xaa, 0xae, 0x8a, 0x81, 0x88, 0x9f, 0x7f, 0x59, 0x01, 0x44, 0x82, 0xc1, 0x7d, 0xc3, 0x36, 0xdc,
-	0x31, 0xbe, 0x38, 0xe9, 0xb7, 0xa1, 0x9b, 0x0a, 0x61, 0xd1, 0x5d, 0x58, 0x32, 0x86, 0x05, 0xe8,
-	0x7d, 0xe8, 0x8e, 0x18, 0xaa, 0xa1, 0x8f, 0x8d, 0xc0, 0x73, 0xa9, 0x73, 0xed, 0xca, 0x3b, 0x11,
-	0x8f, 0x7c, 0x6b, 0x3a, 0xc5, 0xfe, 0x9e, 0xe7, 0x9a, 0x3c, 0x17, 0x1b, 0x09, 0x32, 0xc9, 0x52,
-	0x22, 0xb8, 0x38, 0x9e, 0x0b, 0xd1, 0x40, 0x14, 0xd0, 0x29, 0x2b, 0x02, 0x6c, 0xd8, 0x31, 0x23,
-	0x62, 0x6f, 0xac, 0xb2, 0x81, 0x41, 0x71, 0x23, 0x4a, 0x12, 0x5f, 0xb5, 0xbf, 0x51, 0x00, 0x45,
-	0xf5, 0x12, 0xad, 0x0c, 0xa9, 0xf6, 0x65, 0x97, 0x2a, 0x92, 0xa0, 0x70, 0x03, 0x9a, 0xa6, 0x58,
-	0xc9, 0xcd, 0x25, 0x06, 0x50, 0x1f, 0x4d, 0x89, 0x1e, 0x92, 0x60, 0x8c, 0x4d, 0x51, 0x8f, 0x30,
-	0xe0, 0x07, 0x14, 0x96, 0x0e, 0xcf, 0xb5, 0x6c, 0x78, 0x4e, 0xf6, 0x59, 0xea, 0xa9, 0x3e, 0x8b,
-	0xf6, 0x69, 0x05, 0x54, 0xea, 0xee, 0xf6, 0xe2, 0x62, 0xbf, 0x14, 0xd1, 0xb7, 0xa0, 0xc3, 0x6f,
-	0x8b, 0x53, 0x84, 0xb7, 0x9f, 0x25, 0x36, 0x43, 0xef, 0xc0, 0x55, 0x36, 0xc9, 0xc7, 0xc1, 0xdc,
-	0x8e, 0x53, 0x71, 0x96, 0xcc, 0xa2, 0x67, 0xcc, 0xcf, 0x92, 0x21, 0xb1, 0xe2, 0x31, 0xac, 0x4f,
-	0x6d, 0x6f, 0x64, 0xd8, 0xc3, 0xb4, 0x78, 0x98, 0x0c, 0x4b, 0x68, 0xfc, 0x55, 0xb6, 0x7c, 0x90,
-	0x94, 0x61, 0x80, 0x0e, 0x48, 0x59, 0x8f, 0x9f, 0xc6, 0x59, 0x7e, 0xbd, 0x74, 0x96, 0xdf, 0x26,
-	0x0b, 0xa3, 0x24, 0xff, 0x4f, 0x14, 0x58, 0xc9, 0xb4, 0x4a, 0xb3, 0x25, 0xa5, 0x92, 0x2f, 0x29,
-	0xef, 0x42, 0x9d, 0xd4, 0x59, 0xcc, 0x19, 0x76, 0xe5, 0xe5, 0x4e, 0x7a, 0x57, 0x9d, 0x2d, 0x40,
-	0x3b, 0xb0, 0x26, 0xb9, 0x9a, 0xe4, 0x3a, 0x80, 0xf2, 0x37, 0x93, 0xda, 0xcf, 0x6b, 0xd0, 0x4a,
-	0xf0, 0x63, 0x41, 0x35, 0x5c, 0xa6, 0xf7, 0x95, 0x39, 0x5e, 0x35, 0x7f, 0xbc, 0x82, 0x8b, 0x2f,
-	0xa2, 0x77, 0x0e, 0x76, 0x58, 0xf2, 0xcf, 0x2b, 0x11, 0x07, 0x3b, 0x34, 0xf5, 0x4f, 0x66, 0xf5,
-	0x4b, 0xa9, 0xac, 0x3e, 0x53, 0xf7, 0x2c, 0x9f, 0x53, 0xf7, 0x34, 0xd2, 0x75, 0x4f, 0xca, 0x8e,
-	0x9a, 0x59, 0x3b, 0x2a, 0x5b, 0xa0, 0xbe, 0x03, 0x6b, 0x63, 0x1f, 0x1b, 0x21, 0x36, 0xef, 0x9f,
-	0xed, 0x45, 0x43, 0x3c, 0x33, 0x92, 0x0d, 0xa1, 0x07, 0x71, 0xcf, 0x88, 0x49, 0xb9, 0x4d, 0xa5,
-	0x2c, 0x2f, 0xab, 0xb8, 0x6c, 0x98, 0x90, 0x85, 0x7b, 0xa6, 0x5f, 0xd9, 0xd2, 0xb8, 0x73, 0xa1,
-	0xd2, 0xf8, 0x55, 0x68, 0x89, 0xd0, 0x4a, 0xcc, 0xbd, 0xcb, 0x3c, 0x9f, 0xf0, 0x05, 0x66, 0x90,
-	0x72, 0x06, 0x2b, 0xe9, 0xa6, 0x6b, 0xb6, 0x28, 0x55, 0xf3, 0x45, 0xe9, 0x75, 0x58, 0xb6, 0x82,
-	0xe1, 0xc4, 0x78, 0x8a, 0x7b, 0xab, 0x74, 0x74, 0xc9, 0x0a, 0x1e, 0x18, 0x4f, 0xb1, 0xf6, 0x2f,
-	0x55, 0xe8, 0xc6, 0x55, 0x4c, 0x69, 0x37, 0x52, 0xe6, 0x7a, 0xfe, 0x08, 0xd4, 0x38, 0x50, 0x53,
-	0x0e, 0x9f, 0x5b, 0x88, 0x65, 0x6f, 0x32, 0x56, 0x66, 0x19, 0x7b, 0x4d, 0xf5, 0x8a, 0x6b, 0x2f,
-	0xd4, 0x2b, 0xbe, 0xe4, 0x35, 0xe1, 0x1d, 0xb8, 0x16, 0x05, 0xe0, 0xd4, 0xb1, 0x59, 0x96, 0x7f,
-	0x55, 0x0c, 0x1e, 0x27, 0x8f, 0x5f, 0xe0, 0x02, 0x96, 0x8b, 0x5c, 0x40, 0x56, 0x05, 0x1a, 0x39,
-	0x15, 0xc8, 0xdf, 0x56, 0x36, 0x25, 0xb7, 0x95, 0xda, 0x63, 0x58, 0xa3, 0x6d, 0xc0, 0x60, 0xec,
-	0x5b, 0x23, 0x1c, 0xe5, 0xac, 0x65, 0xc4, 0xda, 0x87, 0x46, 0x26, 0xed, 0x8d, 0xbe, 0xb5, 0x9f,
-	0x29, 0xb0, 0x9e, 0xdf, 0x97, 0x6a, 0x4c, 0xec, 0x48, 0x94, 0x94, 0x23, 0xf9, 0x4d, 0x58, 0x8b,
-	0xb7, 0x4f, 0x27, 0xd4, 0x05, 0x29, 0xa3, 0x84, 0x70, 0x1d, 0xc5, 0x7b, 0x08, 0x98, 0xf6, 0x73,
-	0x25, 0xea, 0xa6, 0x12, 0xd8, 0x94, 0xf6, 0x98, 0x49, 0x70, 0xf3, 0x5c, 0xdb, 0x72, 0xa3, 0xaa,
-	0x9b, 0x9f, 0x91, 0x01, 0x79, 0xd5, 0xfd, 0x1e, 0xac, 0xf0, 0x49, 0x51, 0x8c, 0x2a, 0x99, 0x95,
-	0x75, 0xd9, 0xba, 0x28, 0x3a, 0xdd, 0x86, 0x2e, 0x6f, 0xfe, 0x0a, 0x7c, 0x55, 0x59, 0x4b, 0xf8,
-	0xd7, 0x40, 0x15, 0xd3, 0x5e, 0x34, 0x2a, 0xae, 0xf0, 0x85, 0x51, 0x76, 0xf7, 0x53, 0x05, 0x7a,
-	0xe9, 0x18, 0x99, 0x38, 0xfe, 0x8b, 0xe7, 0x78, 0xdf, 0x4d, 0x5f, 0x9b, 0xdd, 0x3e, 0x87, 0x9e,
-	0x18, 0x8f, 0xb8, 0x3c, 0x3b, 0xa2, 0x57, 0xa0, 0xa4, 0x34, 0xd9, 0xb7, 0x82, 0xd0, 0xb7, 0x46,
-	0xf3, 0x4b, 0xbd, 0xdf, 0xd0, 0xfe, 0xb6, 0x02, 0x5f, 0x97, 0x6e, 0x78, 0x99, 0x0b, 0xb2, 0xa2,
-	0x4e, 0xc0, 0x7d, 0x68, 0x64, 0x4a, 0x98, 0x37, 0xce, 0x39, 0x3c, 0x6f, 0x6a, 0xb1, 0xe6, 0x8a,
-	0x58, 0x47, 0xf6, 0x88, 0x74, 0xba, 0x56, 0xbc, 0x07, 0x57, 0xda, 0xd4, 0x1e, 0x62, 0x1d, 0xba,
-	0x07, 0x6d, 0x56, 0x1e, 0x0e, 0x4f, 0x2d, 0xfc, 0x5c, 0xdc, 0xeb, 0xdc, 0x94, 0xfa, 0x35, 0x3a,
-	0xef, 0x89, 0x85, 0x9f, 0xeb, 0x2d, 0x3b, 0xfa, 0x1d, 0x68, 0xff, 0x5d, 0x05, 0x88, 0xc7, 0x48,
-	0x6d, 0x1a, 0x1b, 0x0c, 0xb7, 0x80, 0x04, 0x84, 0x04, 0xe2, 0x74, 0xee, 0x27, 0x3e, 0x91, 0x1e,
-	0xb7, 0x67, 0x4d, 0x2b, 0x08, 0x39, 0x5f, 0x76, 0xce, 0xa7, 0x45, 0xb0, 0x88, 0x88, 0x8c, 0x5d,
-	0x9b, 0x88, 0xda, 0x8b, 0x40, 0xd0, 0xdb, 0x80, 0xa6, 0xbe, 0xf7, 0xdc, 0x72, 0xa7, 0xc9, 0x8c,
-	0x9d, 0x25, 0xf6, 0xab, 0x7c, 0x24, 0x91, 0xb2, 0xff, 0x18, 0xd4, 0xcc, 0x74, 0xc1, 0x92, 0x3b,
-	0x0b, 0xc8, 0x38, 0x48, 0xed, 0xc5, 0x6f, 0x70, 0x56, 0xd2, 0x18, 0x82, 0xfe, 0x10, 0xd4, 0x2c,
-	0xbd, 0x92, 0x3b, 0x98, 0x6f, 0xa7, 0xef, 0x60, 0xce, 0x33, 0x53, 0xb2, 0x4d, 0xe2, 0x12, 0xa6,
-	0x3f, 0x81, 0xab, 0x32, 0x4a, 0x24, 0x48, 0xee, 0xa6, 0x91, 0x94, 0xc9, 0x69, 0x13, 0x97, 0x3d,
-	0x3f, 0x88, 0xd2, 0x45, 0xca, 0xe6, 0x22, 0x0f, 0x9c, 0x68, 0xca, 0x55, 0x52, 0x4d, 0x39, 0xed,
-	0x8f, 0x14, 0x40, 0x79, 0xed, 0x46, 0x5d, 0xa8, 0x44, 0x9b, 0x54, 0x0e, 0xf7, 0x33, 0xda, 0x54,
-	0xc9, 0x69, 0xd3, 0x0d, 0x68, 0x46, 0x11, 0x91, 0xbb, 0xbf, 0x18, 0x90, 0xd4, 0xb5, 0x5a, 0x5a,
-	0xd7, 0x12, 0x84, 0xd5, 0xd3, 0x84, 0x9d, 0x00, 0xca, 0x5b, 0x4c, 0x72, 0x27, 0x25, 0xbd, 0xd3,
-	0x22, 0x0a, 0x13, 0x98, 0xaa, 0x69, 0x4c, 0xff, 0x5e, 0x01, 0x14, 0xc7, 0xfc, 0xe8, 0x22, 0xaa,
-	0x4c, 0xa0, 0xdc, 0x81, 0xb5, 0x7c, 0x46, 0x20, 0xd2, 0x20, 0x94, 0xcb, 0x07, 0x64, 0xb1, 0xbb,
-	0x2a, 0x7b, 0x69, 0xf4, 0x6e, 0xe4, 0xe3, 0x58, 0x82, 0x73, 0xb3, 0x28, 0xc1, 0xc9, 0xb8, 0xb9,
-	0xdf, 0xca, 0xbe, 0x50, 0x62, 0x46, 0x73, 0x57, 0xea, 0x8f, 0x72, 0x47, 0x7e, 0xf9, 0xcf, 0x93,
-	0xfe, 0xb5, 0x02, 0xab, 0x11, 0x37, 0x5e, 0x88, 0xd3, 0x8b, 0x2f, 0xfe, 0x5e, 0x32, 0x6b, 0x3f,
-	0x92, 0xb3, 0xf6, 0x97, 0xcf, 0xcd, 0x61, 0x3f, 0x3f, 0xce, 0x0e, 0x60, 0x99, 0xb7, 0xcf, 0x72,
-	0xb6, 0x5b, 0xa6, 0x4a, 0xbc, 0x0a, 0x75, 0xe2, 0x2a, 0x44, 0x3f, 0x89, 0x7d, 0x68, 0x7f, 0xad,
-	0x00, 0x0c, 0xce, 0xdc, 0xf1, 0x3d, 0x66, 0x42, 0xef, 0x40, 0x6d, 0xd1, 0x03, 0x0d, 0x32, 0x9b,
-	0x26, 0xdd, 0x74, 0x66, 0x09, 0xa9, 0xa5, 0x0a, 0xdc, 0x6a, 0xb6, 0xc0, 0x2d, 0x2a, 0x4d, 0x8b,
-	0xdd, 0xc6, 0x3f, 0x28, 0x70, 0x9d, 0x10, 0xf1, 0x99, 0xe4, 0x22, 0xa5, 0x58, 0x97, 0x70, 0x49,
-	0xd5, 0xb4, 0x4b, 0xba, 0x0b, 0xcb, 0xac, 0xc6, 0x14, 0x79, 0xc1, 0xcd, 0x22, 0x96, 0x31, 0x06,
-	0xeb, 0x62, 0xfa, 0xd6, 0xaf, 0x42, 0x33, 0xea, 0xf5, 0xa2, 0x16, 0x2c, 0x3f, 0x76, 0xdf, 0x77,
-	0xbd, 0xe7, 0xae, 0x7a, 0x05, 0x2d, 0x43, 0xf5, 0x9e, 0x6d, 0xab, 0x0a, 0xea, 0x40, 0x73, 0x10,
-	0xfa, 0xd8, 0x70, 0x2c, 0x77, 0xaa, 0x56, 0x50, 0x17, 0xe0, 0x3d, 0x2b, 0x08, 0x3d, 0xdf, 0x1a,
-	0x1b, 0xb6, 0x5a, 0xdd, 0xfa, 0x04, 0xba, 0xe9, 0x4a, 0x0a, 0xb5, 0xa1, 0x71, 0xe4, 0x85, 0x3f,
-	0xfc, 0xd8, 0x0a, 0x42, 0xf5, 0x0a, 0x99, 0x7f, 0xe4, 0x85, 0xc7, 0x3e, 0x0e, 0xb0, 0x1b, 0xaa,
-	0x0a, 0x02, 0x58, 0xfa, 0x91, 0xbb, 0x6f, 0x05, 0x4f, 0xd5, 0x0a, 0x5a, 0xe3, 0x4d, 0x12, 0xc3,
-	0x3e, 0xe4, 0xe5, 0x89, 0x5a, 0x25, 0xcb, 0xa3, 0xaf, 0x1a, 0x52, 0xa1, 0x1d, 0x4d, 0x39, 0x38,
-	0x7e, 0xac, 0xd6, 0x51, 0x13, 0xea, 0xec, 0xe7, 0xd2, 0x96, 0x09, 0x6a, 0xb6, 0xc3, 0x47, 0xf6,
-	0x64, 0x87, 0x88, 0x40, 0xea, 0x15, 0x72, 0x32, 0xde, 0x62, 0x55, 0x15, 0xb4, 0x02, 0xad, 0x44,
-	0xc3, 0x52, 0xad, 0x10, 0xc0, 0x81, 0x3f, 0x1b, 0x73, 0xe9, 0x31, 0x12, 0x48, 0x2e, 0xbd, 0x4f,
-	0x38, 0x51, 0xdb, 0xba, 0x0f, 0x0d, 0x51, 0xe2, 0x91, 0xa9, 0x9c, 0x45, 0xe4, 0x53, 0xbd, 0x82,
-	0x56, 0xa1, 0x93, 0x7a, 0x81, 0xa9, 0x2a, 0x08, 0x41, 0x37, 0xfd, 0xc0, 0x59, 0xad, 0x6c, 0xed,
-	0x02, 0xc4, 0xa6, 0x4e, 0xc8, 0x39, 0x74, 0x4f, 0x0d, 0xdb, 0x32, 0x19, 0x6d, 0x64, 0x88, 0x70,
-	0x97, 0x72, 0x87, 0xb5, 0xea, 0xd4, 0xca, 0xd6, 0xab, 0xd0, 0x10, 0x5a, 0x4e, 0xe0, 0x3a, 0x76,
-	0xbc, 0x53, 0xcc, 0x24, 0x33, 0xc0, 0xa1, 0xaa, 0xec, 0xfe, 0x6f, 0x07, 0x80, 0x35, 0xe5, 0x3c,
-	0xcf, 0x37, 0x91, 0x0d, 0xe8, 0x00, 0x87, 0x7b, 0x9e, 0x33, 0xf3, 0x5c, 0xd1, 0x2c, 0x08, 0xd0,
-	0x76, 0x5a, 0x15, 0xf8, 0x47, 0x7e, 0x22, 0x3f, 0x7d, 0xff, 0x75, 0xe9, 0xfc, 0xcc, 0x64, 0xed,
-	0x0a, 0x72, 0x28, 0xb6, 0x47, 0x96, 0x83, 0x1f, 0x59, 0xe3, 0xa7, 0x51, 0x27, 0xaf, 0xf8, 0x75,
-	0x72, 0x66, 0xaa, 0xc0, 0x77, 0x4b, 0x8a, 0x6f, 0x10, 0xfa, 0x96, 0x3b, 0x15, 0xa9, 0xb8, 0x76,
-	0x05, 0x3d, 0xcb, 0xbc, 0x8d, 0x16, 0x08, 0x77, 0xcb, 0x3c, 0x87, 0xbe, 0x18, 0x4a, 0x1b, 0x56,
-	0x32, 0xff, 0xf5, 0x40, 0x5b, 0xf2, 0xe7, 0x6e, 0xb2, 0xff, 0xa5, 0xf4, 0xdf, 0x2a, 0x35, 0x37,
-	0xc2, 0x66, 0x41, 0x37, 0xfd, 0x7f, 0x06, 0xf4, 0x4b, 0x45, 0x1b, 0xe4, 0x1e, 0xdc, 0xf6, 0xb7,
-	0xca, 0x4c, 0x8d, 0x50, 0x7d, 0xc8, 0x14, 0x74, 0x11, 0x2a, 0xe9, 0xcb, 0xe2, 0xfe, 0x79, 0x55,
-	0x90, 0x76, 0x05, 0xfd, 0x04, 0x56, 0x73, 0xcf, 0x82, 0xd1, 0x37, 0xe4, 0xb7, 0x35, 0xf2, 0xd7,
-	0xc3, 0x8b, 0x30, 0x7c, 0x98, 0x35, 0xaf, 0x62, 0xea, 0x73, 0xaf, 0xfc, 0xcb, 0x53, 0x9f, 0xd8,
-	0xfe, 0x3c, 0xea, 0x5f, 0x18, 0xc3, 0x9c, 0x9a, 0x4d, 0xb6, 0x35, 0xfc, 0xb6, 0x0c, 0x45, 0xe1,
-	0xdb, 0xe4, 0xfe, 0x76, 0xd9, 0xe9, 0x49, 0xed, 0x4a, 0x3f, 0x7f, 0x95, 0x33, 0x4d, 0xfa, 0x64,
-	0x57, 0xae, 0x5d, 0xf2, 0xd7, 0xb4, 0xda, 0x15, 0xf4, 0x28, 0xe5, 0x5e, 0xd1, 0x1b, 0x45, 0xc2,
-	0x49, 0x5f, 0x18, 0x2d, 0xe2, 0xdb, 0x6f, 0x03, 0x62, 0xb6, 0xe3, 0x4e, 0xac, 0xe9, 0xdc, 0x37,
-	0x98, 0x62, 0x15, 0xb9, 0x9b, 0xfc, 0x54, 0x81, 0xe6, 0x9b, 0x2f, 0xb0, 0x22, 0x3a, 0xd2, 0x10,
-	0xe0, 0x00, 0x87, 0x0f, 0x71, 0xe8, 0x5b, 0xe3, 0x20, 0x7b, 0xa2, 0xd8, 0xa3, 0xf2, 0x09, 0x02,
-	0xd5, 0x9b, 0x0b, 0xe7, 0x45, 0x08, 0x46, 0xd0, 0x3a, 0xc0, 0x21, 0xcf, 0xab, 0x02, 0x54, 0xb8,
-	0x52, 0xcc, 0x10, 0x28, 0x36, 0x17, 0x4f, 0x4c, 0xba, 0xb3, 0xcc, 0x53, 0x60, 0x54, 0x28, 0xd8,
-	0xfc, 0x03, 0x65, 0xb9, 0x3b, 0x2b, 0x78, 0x5b, 0xcc, 0x4e, 0xb4, 0x77, 0x82, 0xc7, 0x4f, 0xdf,
-	0xc3, 0x86, 0x1d, 0x9e, 0x14, 0x9c, 0x28, 0x31, 0xe3, 0xfc, 0x13, 0xa5, 0x26, 0x0a, 0x1c, 0xbb,
-	0x9f, 0x76, 0xa1, 0x49, 0xe3, 0x1f, 0x09, 0xd6, 0xbf, 0x08, 0x7f, 0x9f, 0x71, 0xf8, 0xfb, 0x08,
-	0x56, 0x32, 0x2f, 0x57, 0xe5, 0xfa, 0x22, 0x7f, 0xde, 0x5a, 0xc2, 0x8b, 0xa7, 0xdf, 0x8e, 0xca,
-	0x1d, 0x92, 0xf4, 0x7d, 0xe9, 0xa2, 0xbd, 0x9f, 0xb0, 0x47, 0xdf, 0x51, 0xdf, 0xf4, 0xcd, 0xc2,
-	0xca, 0x2b, 0x7d, 0xdf, 0xfe, 0xc5, 0x47, 0x87, 0x97, 0x1f, 0x3d, 0x3f, 0x82, 0x95, 0xcc, 0xab,
-	0x27, 0xb9, 0x54, 0xe5, 0x4f, 0xa3, 0x16, 0xed, 0xfe, 0x39, 0x86, 0x19, 0x13, 0xd6, 0x24, 0x0f,
-	0x52, 0xd0, 0x76, 0x51, 0xe5, 0x23, 0x7f, 0xb9, 0xb2, 0xf8, 0x40, 0x9d, 0x94, 0x29, 0xa1, 0xcd,
-	0x22, 0x22, 0xb3, 0xff, 0xbd, 0xeb, 0x7f, 0xa3, 0xdc, 0x1f, 0xf5, 0xa2, 0x03, 0x0d, 0x60, 0x89,
-	0xbd, 0x85, 0x42, 0xaf, 0xc9, 0xfb, 0x7f, 0x89, 0x77, 0x52, 0xfd, 0x45, 0xaf, 0xa9, 0x82, 0xb9,
-	0x1d, 0x06, 0x74, 0xd3, 0x3a, 0xf5, 0x90, 0x48, 0xfa, 0x88, 0x2f, 0xf9, 0x80, 0xa9, 0xbf, 0xf8,
-	0xcd, 0x92, 0xd8, 0xf4, 0xff, 0x77, 0x2c, 0xfe, 0x18, 0xd6, 0x24, 0xb7, 0x02, 0xa8, 0x28, 0xe7,
-	0x2a, 0xb8, 0x8f, 0xe8, 0xef, 0x94, 0x9e, 0x1f, 0x61, 0xfe, 0x31, 0xa8, 0xd9, 0x8e, 0x02, 0x7a,
-	0xab, 0x48, 0x9f, 0x65, 0x38, 0xcf, 0x57, 0xe6, 0xfb, 0xdf, 0xfa, 0x70, 0x77, 0x6a, 0x85, 0x27,
-	0xf3, 0x11, 0x19, 0xd9, 0x61, 0x53, 0xdf, 0xb6, 0x3c, 0xfe, 0x6b, 0x47, 0xf0, 0x7f, 0x87, 0xae,
-	0xde, 0xa1, 0xa8, 0x66, 0xa3, 0xd1, 0x12, 0xfd, 0xbc, 0xf3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x34, 0x97, 0xee, 0xf6, 0x3f, 0x00, 0x00,
+	// 3809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x8c, 0x1c, 0x49,
+	0x56, 0xce, 0xfa, 0x74, 0x57, 0xbd, 0xfa, 0x74, 0x76, 0xb4, 0x3f, 0xb5, 0xb5, 0x1e, 0x4f, 0x4f,
+	0x7a, 0x3c, 0xd3, 0xb4, 0x77, 0xda, 0xb3, 0xed, 0xdd, 0xc1, 0xcb, 0xee, 0x6a, 0x71, 0x77, 0xaf,
+	0x7b, 0x9a, 0x19, 0x7b, 0x9a, 0x2c, 0xdb, 0xa0, 0xd1, 0xb0, 0xb5, 0x59, 0x95, 0x51, 0xd5, 0x29,
+	0x67, 0x65, 0x96, 0x33, 0xb2, 0xda, 0xd3, 0xc3, 0x95, 0xcb, 0xae, 0x00, 0x09, 0x0e, 0x9c, 0x10,
+	0x27, 0x90, 0x40, 0x62, 0x10, 0x07, 0xb8, 0x71, 0x40, 0x42, 0x82, 0x1b, 0x42, 0x5c, 0x38, 0x72,
+	0xe5, 0x80, 0x84, 0x84, 0xb4, 0x07, 0x0e, 0x48, 0x28, 0x7e, 0xf9, 0x8d, 0xec, 0x4e, 0xbb, 0xed,
+	0x99, 0x59, 0xb4, 0xb7, 0xca, 0x17, 0x2f, 0xe2, 0xbd, 0x78, 0xf1, 0xfe, 0x11, 0x05, 0xab, 0x4f,
+	0x17, 0x38, 0x38, 0x19, 0x8e, 0x7d, 0x3f, 0xb0, 0xb7, 0xe6, 0x81, 0x1f, 0xfa, 0x08, 0xcd, 0x1c,
+	0xf7, 0x78, 0x41, 0xf8, 0xd7, 0x16, 0x1b, 0xef, 0xb7, 0xc7, 0xfe, 0x6c, 0xe6, 0x7b, 0x1c, 0xd6,
+	0x6f, 0x27, 0x31, 0xfa, 0x5d, 0xc7, 0x0b, 0x71, 0xe0, 0x59, 0xae, 0x1c, 0x25, 0xe3, 0x23, 0x3c,
+	0xb3, 0xc4, 0x97, 0x6e, 0x5b, 0xa1, 0x95, 0x5c, 0xdf, 0xf8, 0x1d, 0x0d, 0x2e, 0x0f, 0x8e, 0xfc,
+	0x67, 0xbb, 0xbe, 0xeb, 0xe2, 0x71, 0xe8, 0xf8, 0x1e, 0x31, 0xf1, 0xd3, 0x05, 0x26, 0x21, 0x7a,
+	0x17, 0x6a, 0x23, 0x8b, 0xe0, 0x9e, 0xb6, 0xae, 0x6d, 0xb4, 0xb6, 0xaf, 0x6e, 0xa5, 0x38, 0x11,
+	0x2c, 0xdc, 0x27, 0xd3, 0x1d, 0x8b, 0x60, 0x93, 0x61, 0x22, 0x04, 0x35, 0x7b, 0x74, 0xb0, 0xd7,
+	0xab, 0xac, 0x6b, 0x1b, 0x55, 0x93, 0xfd, 0x46, 0x6f, 0x42, 0x67, 0x1c, 0xad, 0x7d, 0xb0, 0x47,
+	0x7a, 0xd5, 0xf5, 0xea, 0x46, 0xd5, 0x4c, 0x03, 0x8d, 0x7f, 0xd7, 0xe0, 0x4a, 0x8e, 0x0d, 0x32,
+	0xf7, 0x3d, 0x82, 0xd1, 0x6d, 0x58, 0x22, 0xa1, 0x15, 0x2e, 0x88, 0xe0, 0xe4, 0xeb, 0x4a, 0x4e,
+	0x06, 0x0c, 0xc5, 0x14, 0xa8, 0x79, 0xb2, 0x15, 0x05, 0x59, 0xf4, 0x4d, 0xb8, 0xe8, 0x78, 0xf7,
+	0xf1, 0xcc, 0x0f, 0x4e, 0x86, 0x73, 0x1c, 0x8c, 0xb1, 0x17, 0x5a, 0x53, 0x2c, 0x79, 0x5c, 0x93,
+	0x63, 0x87, 0xf1, 0x10, 0x7a, 0x0f, 0xae, 0xf0, 0x53, 0x22, 0x38, 0x38, 0x76, 0xc6, 0x78, 0x68,
+	0x1d, 0x5b, 0x8e, 0x6b, 0x8d, 0x5c, 0xdc, 0xab, 0xad, 0x57, 0x37, 0x1a, 0xe6, 0x25, 0x36, 0x3c,
+	0xe0, 0xa3, 0x77, 0xe5, 0xa0, 0xf1, 0x67, 0x1a, 0x5c, 0xa2, 0x3b, 0x3c, 0xb4, 0x82, 0xd0, 0x79,
+	0x05, 0x72, 0x36, 0xa0, 0x9d, 0xdc, 0x5b, 0xaf, 0xca, 0xc6, 0x52, 0x30, 0x8a, 0x33, 0x97, 0xe4,
+	0xa9, 0x4c, 0x6a, 0x6c, 0x9b, 0x29, 0x98, 0xf1, 0xa7, 0x42, 0x21, 0x92, 0x7c, 0x9e, 0xe7, 0x20,
+	0xb2, 0x34, 0x2b, 0x79, 0x9a, 0x2f, 0x70, 0x0c, 0xc6, 0x4f, 0xab, 0x70, 0xe9, 0x43, 0xdf, 0xb2,
+	0x63, 0x85, 0xf9, 0xe2, 0xc5, 0xf9, 0x7d, 0x58, 0xe2, 0xd6, 0xd5, 0xab, 0x31, 0x5a, 0x37, 0xd2,
+	0xb4, 0xf8, 0xd8, 0x56, 0xcc, 0xe1, 0x80, 0x01, 0x4c, 0x31, 0x09, 0xdd, 0x80, 0x6e, 0x80, 0xe7,
+	0xae, 0x33, 0xb6, 0x86, 0xde, 0x62, 0x36, 0xc2, 0x41, 0xaf, 0xbe, 0xae, 0x6d, 0xd4, 0xcd, 0x8e,
+	0x80, 0x3e, 0x60, 0x40, 0xf4, 0x63, 0xe8, 0x4c, 0x1c, 0xec, 0xda, 0x43, 0xc7, 0xb3, 0xf1, 0xa7,
+	0x07, 0x7b, 0xbd, 0xa5, 0xf5, 0xea, 0x46, 0x6b, 0xfb, 0xbb, 0x5b, 0x79, 0xcf, 0xb0, 0xa5, 0x94,
+	0xc8, 0xd6, 0x3d, 0x3a, 0xfd, 0x80, 0xcf, 0xfe, 0xa1, 0x17, 0x06, 0x27, 0x66, 0x7b, 0x92, 0x00,
+	0xf5, 0x7f, 0x00, 0xab, 0x39, 0x14, 0xa4, 0x43, 0xf5, 0x09, 0x3e, 0x61, 0x52, 0xac, 0x9a, 0xf4,
+	0x27, 0xba, 0x08, 0xf5, 0x63, 0xcb, 0x5d, 0x60, 0x21, 0x27, 0xfe, 0xf1, 0x2b, 0x95, 0x3b, 0x9a,
+	0xf1, 0xc7, 0x1a, 0xf4, 0x4c, 0xec, 0x62, 0x8b, 0xe0, 0x2f, 0xf3, 0x3c, 0x2e, 0xc3, 0x92, 0xe7,
+	0xdb, 0xf8, 0x60, 0x8f, 0x9d, 0x47, 0xd5, 0x14, 0x5f, 0xc6, 0xff, 0x68, 0x70, 0x71, 0x1f, 0x87,
+	0x54, 0x31, 0x1d, 0x12, 0x3a, 0xe3, 0xc8, 0xf2, 0xbe, 0x0f, 0xd5, 0x00, 0x3f, 0x15, 0x9c, 0xdd,
+	0x4c, 0x73, 0x16, 0xf9, 0x51, 0xd5, 0x4c, 0x93, 0xce, 0x43, 0x6f, 0x40, 0xdb, 0x9e, 0xb9, 0xc3,
+	0xf1, 0x91, 0xe5, 0x79, 0xd8, 0xe5, 0xaa, 0xdd, 0x34, 0x5b, 0xf6, 0xcc, 0xdd, 0x15, 0x20, 0x74,
+	0x0d, 0x80, 0xe0, 0xe9, 0x0c, 0x7b, 0x61, 0xec, 0xfa, 0x12, 0x10, 0xb4, 0x09, 0xab, 0x93, 0xc0,
+	0x9f, 0x0d, 0xc9, 0x91, 0x15, 0xd8, 0x43, 0x17, 0x5b, 0x36, 0x0e, 0x18, 0xf7, 0x0d, 0x73, 0x85,
+	0x0e, 0x0c, 0x28, 0xfc, 0x43, 0x06, 0x46, 0xb7, 0xa1, 0x4e, 0xc6, 0xfe, 0x1c, 0x33, 0x35, 0xe9,
+	0x6e, 0xbf, 0xa6, 0x52, 0x80, 0x3d, 0x2b, 0xb4, 0x06, 0x14, 0xc9, 0xe4, 0xb8, 0xc6, 0x5f, 0x09,
+	0x3b, 0xf9, 0x8a, 0xbb, 0x9d, 0x84, 0x2d, 0xd5, 0x5f, 0x8e, 0x2d, 0x2d, 0x95, 0xb2, 0xa5, 0xe5,
+	0xd3, 0x6d, 0x29, 0x27, 0xb5, 0x57, 0x6f, 0x4b, 0x7f, 0x1f, 0xdb, 0xd2, 0x57, 0xfd, 0xcc, 0x62,
+	0x7b, 0xab, 0xa7, 0xec, 0xed, 0x2f, 0x34, 0xf8, 0xda, 0x3e, 0x0e, 0x23, 0xf6, 0xa9, 0xf9, 0xe0,
+	0xaf, 0x68, 0xb8, 0xfb, 0x5c, 0x83, 0xbe, 0x8a, 0xd7, 0xf3, 0x84, 0xbc, 0x8f, 0xe1, 0x72, 0x44,
+	0x63, 0x68, 0x63, 0x32, 0x0e, 0x9c, 0x39, 0xfd, 0xcd, 0x3d, 0x44, 0x6b, 0xfb, 0xba, 0x4a, 0xdd,
+	0xb2, 0x1c, 0x5c, 0x8a, 0x96, 0xd8, 0x4b, 0xac, 0x60, 0xfc, 0x9e, 0x06, 0x97, 0xa8, 0x47, 0x12,
+	0x2e, 0xc4, 0x9b, 0xf8, 0x2f, 0x2e, 0xd7, 0xb4, 0x73, 0xaa, 0xe4, 0x9c, 0x53, 0x09, 0x19, 0xb3,
+	0xfc, 0x31, 0xcb, 0xcf, 0x79, 0x64, 0xf7, 0x6d, 0xa8, 0x3b, 0xde, 0xc4, 0x97, 0xa2, 0x7a, 0x5d,
+	0x25, 0xaa, 0x24, 0x31, 0x8e, 0x6d, 0x78, 0x9c, 0x8b, 0xd8, 0x5b, 0x9e, 0x43, 0xdd, 0xb2, 0xdb,
+	0xae, 0x28, 0xb6, 0xfd, 0xbb, 0x1a, 0x5c, 0xc9, 0x11, 0x3c, 0xcf, 0xbe, 0xbf, 0x07, 0x4b, 0x2c,
+	0x06, 0xc8, 0x8d, 0xbf, 0xa9, 0xdc, 0x78, 0x82, 0xdc, 0x87, 0x0e, 0x09, 0x4d, 0x31, 0xc7, 0xf0,
+	0x41, 0xcf, 0x8e, 0xd1, 0xe8, 0x24, 0x22, 0xd3, 0xd0, 0xb3, 0x66, 0x5c, 0x00, 0x4d, 0xb3, 0x25,
+	0x60, 0x0f, 0xac, 0x19, 0x46, 0x5f, 0x83, 0x06, 0x35, 0xd9, 0xa1, 0x63, 0xcb, 0xe3, 0x5f, 0x66,
+	0x26, 0x6c, 0x13, 0xf4, 0x1a, 0x00, 0x1b, 0xb2, 0x6c, 0x3b, 0xe0, 0x81, 0xab, 0x69, 0x36, 0x29,
+	0xe4, 0x2e, 0x05, 0x18, 0x7f, 0xa0, 0x41, 0x9b, 0x3a, 0xc8, 0xfb, 0x38, 0xb4, 0xe8, 0x39, 0xa0,
+	0xef, 0x40, 0xd3, 0xf5, 0x2d, 0x7b, 0x18, 0x9e, 0xcc, 0x39, 0xa9, 0xee, 0xf6, 0x55, 0xd5, 0x16,
+	0xe8, 0xa4, 0x87, 0x27, 0x73, 0x6c, 0x36, 0x5c, 0xf1, 0xab, 0x8c, 0xbc, 0x73, 0xa6, 0x5c, 0x55,
+	0x98, 0xf2, 0x3f, 0xd6, 0xe1, 0xf2, 0x6f, 0x58, 0xe1, 0xf8, 0x68, 0x6f, 0x26, 0xe3, 0xef, 0x8b,
+	0x2b, 0x41, 0xec, 0xdb, 0x2a, 0x49, 0xdf, 0xf6, 0xd2, 0x7c, 0x67, 0xa4, 0xe7, 0x75, 0x95, 0x9e,
+	0xd3, 0x32, 0x6d, 0xeb, 0xb1, 0x38, 0xaa, 0x84, 0x9e, 0x27, 0xc2, 0xe4, 0xd2, 0x8b, 0x84, 0xc9,
+	0x5d, 0xe8, 0xe0, 0x4f, 0xc7, 0xee, 0x82, 0x9e, 0x39, 0xa3, 0xce, 0xe3, 0xdf, 0x35, 0x05, 0xf5,
+	0xa4, 0x91, 0xb5, 0xc5, 0xa4, 0x03, 0xc1, 0x03, 0x3f, 0xea, 0x19, 0x0e, 0xad, 0x5e, 0x83, 0xb1,
+	0xb1, 0x5e, 0x74, 0xd4, 0x52, 0x3f, 0xf8, 0x71, 0xd3, 0x2f, 0x74, 0x15, 0x9a, 0x22, 0x28, 0x1f,
+	0xec, 0xf5, 0x9a, 0x4c, 0x7c, 0x31, 0x00, 0x59, 0xd0, 0x11, 0x1e, 0x48, 0x70, 0x08, 0x8c, 0xc3,
+	0xef, 0xa9, 0x08, 0xa8, 0x0f, 0x3b, 0xc9, 0x39, 0x11, 0x21, 0x9a, 0x24, 0x40, 0xb4, 0x34, 0xf4,
+	0x27, 0x13, 0xd7, 0xf1, 0xf0, 0x03, 0x7e, 0xc2, 0x2d, 0xc6, 0x44, 0x1a, 0x88, 0x7a, 0xb0, 0x7c,
+	0x8c, 0x03, 0xe2, 0xf8, 0x5e, 0xaf, 0xcd, 0xc6, 0xe5, 0x67, 0x7f, 0x08, 0xab, 0x39, 0x12, 0x8a,
+	0x10, 0xff, 0xad, 0x64, 0x88, 0x3f, 0x5b, 0xc6, 0x89, 0x14, 0xe0, 0xcf, 0x35, 0xb8, 0xf4, 0xc8,
+	0x23, 0x8b, 0x51, 0xb4, 0xb7, 0x2f, 0x47, 0x8f, 0xb3, 0x1e, 0xa4, 0x96, 0xf3, 0x20, 0xc6, 0x4f,
+	0xea, 0xb0, 0x22, 0x76, 0x41, 0x8f, 0x9b, 0xb9, 0x82, 0xab, 0xd0, 0x8c, 0x82, 0x88, 0x10, 0x48,
+	0x0c, 0x40, 0xeb, 0xd0, 0x4a, 0x18, 0x82, 0xe0, 0x2a, 0x09, 0x2a, 0xc5, 0x9a, 0x4c, 0x09, 0x6a,
+	0x89, 0x94, 0xe0, 0x35, 0x80, 0x89, 0xbb, 0x20, 0x47, 0xc3, 0xd0, 0x99, 0x61, 0x91, 0x92, 0x34,
+	0x19, 0xe4, 0xa1, 0x33, 0xc3, 0xe8, 0x2e, 0xb4, 0x47, 0x8e, 0xe7, 0xfa, 0xd3, 0xe1, 0xdc, 0x0a,
+	0x8f, 0x88, 0x28, 0xa3, 0x54, 0xc7, 0xc2, 0x12, 0xb8, 0x1d, 0x86, 0x6b, 0xb6, 0xf8, 0x9c, 0x43,
+	0x3a, 0x05, 0x5d, 0x83, 0x96, 0xb7, 0x98, 0x0d, 0xfd, 0xc9, 0x30, 0xf0, 0x9f, 0x51, 0xe3, 0x61,
+	0x24, 0xbc, 0xc5, 0xec, 0xa3, 0x89, 0xe9, 0x3f, 0xa3, 0x4e, 0xbc, 0x49, 0xdd, 0x39, 0x71, 0xfd,
+	0x29, 0xe9, 0x35, 0x4a, 0xad, 0x1f, 0x4f, 0xa0, 0xb3, 0x6d, 0xec, 0x86, 0x16, 0x9b, 0xdd, 0x2c,
+	0x37, 0x3b, 0x9a, 0x80, 0xde, 0x82, 0xee, 0xd8, 0x9f, 0xcd, 0x2d, 0x26, 0xa1, 0x7b, 0x81, 0x3f,
+	0x63, 0x96, 0x53, 0x35, 0x33, 0x50, 0xb4, 0x0b, 0x2d, 0x96, 0xfc, 0x0a, 0xf3, 0x6a, 0x31, 0x3a,
+	0x86, 0xca, 0xbc, 0x12, 0x79, 0x2c, 0x55, 0x50, 0x70, 0xe4, 0x4f, 0x42, 0x35, 0x43, 0x5a, 0x29,
+	0x71, 0x3e, 0xc3, 0xc2, 0x42, 0x5a, 0x02, 0x36, 0x70, 0x3e, 0xc3, 0x34, 0x23, 0x77, 0x3c, 0x82,
+	0x83, 0x50, 0xd6, 0x47, 0xbd, 0x0e, 0x53, 0x9f, 0x0e, 0x87, 0x0a, 0xc5, 0x46, 0x07, 0xd0, 0x25,
+	0xa1, 0x15, 0x84, 0xc3, 0xb9, 0x4f, 0x98, 0x02, 0xf4, 0xba, 0xeb, 0x5a, 0x9e, 0xa3, 0xa8, 0x1a,
+	0xbb, 0x4f, 0xa6, 0x87, 0x02, 0xd3, 0xec, 0xb0, 0x99, 0xf2, 0xd3, 0xf8, 0xaf, 0x0a, 0x74, 0xd3,
+	0x3c, 0x53, 0x23, 0xe6, 0xd9, 0xb9, 0x54, 0x44, 0xf9, 0x49, 0x77, 0x80, 0x3d, 0xda, 0x98, 0xe1,
+	0xa5, 0x00, 0xd3, 0xc3, 0x86, 0xd9, 0xe2, 0x30, 0xb6, 0x00, 0xd5, 0x27, 0x2e, 0x29, 0xa6, 0xfc,
+	0x55, 0xc6, 0x7d, 0x93, 0x41, 0x58, 0xf0, 0xec, 0xc1, 0xb2, 0xac, 0x22, 0xb8, 0x16, 0xca, 0x4f,
+	0x3a, 0x32, 0x5a, 0x38, 0x8c, 0x2a, 0xd7, 0x42, 0xf9, 0x89, 0xf6, 0xa0, 0xcd, 0x97, 0x9c, 0x5b,
+	0x81, 0x35, 0x93, 0x3a, 0xf8, 0x86, 0xd2, 0x8e, 0x3f, 0xc0, 0x27, 0x8f, 0xa9, 0x4b, 0x38, 0xb4,
+	0x9c, 0xc0, 0xe4, 0x67, 0x76, 0xc8, 0x66, 0xa1, 0x0d, 0xd0, 0xf9, 0x2a, 0x13, 0xc7, 0xc5, 0x42,
+	0x9b, 0x97, 0x59, 0x84, 0xee, 0x32, 0xf8, 0x3d, 0xc7, 0xc5, 0x5c, 0x61, 0xa3, 0x2d, 0xb0, 0x53,
+	0x6a, 0x70, 0x7d, 0x65, 0x10, 0x76, 0x46, 0xd7, 0xa1, 0xc3, 0x87, 0xa5, 0xa7, 0xe3, 0xee, 0x98,
+	0xf3, 0xf8, 0x98, 0xc3, 0x58, 0x92, 0xb0, 0x98, 0x71, 0x8d, 0x07, 0xbe, 0x1d, 0x6f, 0x31, 0xa3,
+	0xfa, 0x6e, 0xfc, 0x61, 0x0d, 0xd6, 0xa8, 0xd9, 0x0b, 0x0f, 0x70, 0x8e, 0x70, 0xfb, 0x1a, 0x80,
+	0x4d, 0xc2, 0x61, 0xca, 0x55, 0x35, 0x6d, 0x12, 0x0a, 0x67, 0xfc, 0x1d, 0x19, 0x2d, 0xab, 0xc5,
+	0x09, 0x74, 0xc6, 0x0d, 0xe5, 0x23, 0xe6, 0x0b, 0x35, 0x69, 0xae, 0x43, 0x87, 0xf8, 0x8b, 0x60,
+	0x8c, 0x87, 0xa9, 0x52, 0xa7, 0xcd, 0x81, 0x0f, 0xd4, 0xce, 0x74, 0x49, 0xd9, 0x2c, 0x4a, 0x44,
+	0xcd, 0xe5, 0xf3, 0x45, 0xcd, 0x46, 0x36, 0x6a, 0x7e, 0x00, 0x2b, 0xcc, 0x13, 0x44, 0x56, 0x24,
+	0x1d, 0x48, 0x19, 0x33, 0xea, 0xb2, 0xa9, 0xf2, 0x93, 0x24, 0x23, 0x1f, 0xa4, 0x22, 0x1f, 0x15,
+	0x86, 0x87, 0xb1, 0x3d, 0x0c, 0x03, 0xcb, 0x23, 0x13, 0x1c, 0xb0, 0xc8, 0xd9, 0x30, 0xdb, 0x14,
+	0xf8, 0x50, 0xc0, 0x8c, 0x7f, 0xae, 0xc0, 0x65, 0x51, 0xc0, 0x9e, 0x5f, 0x2f, 0x8a, 0xc2, 0x97,
+	0xf4, 0xff, 0xd5, 0x53, 0x4a, 0xc2, 0x5a, 0x89, 0xd4, 0xac, 0xae, 0x48, 0xcd, 0xd2, 0x65, 0xd1,
+	0x52, 0xae, 0x2c, 0x8a, 0xfa, 0x30, 0xcb, 0xe5, 0xfb, 0x30, 0xb4, 0xe0, 0x67, 0xb9, 0x3a, 0x3b,
+	0xbb, 0xa6, 0xc9, 0x3f, 0xca, 0x09, 0xf4, 0x5f, 0x2b, 0xd0, 0x19, 0x60, 0x2b, 0x18, 0x1f, 0x49,
+	0x39, 0xbe, 0x97, 0xec, 0x5b, 0xbd, 0x59, 0x70, 0xc4, 0xa9, 0x29, 0x3f, 0x37, 0x0d, 0x2b, 0xb4,
+	0x43, 0x43, 0x0b, 0xe5, 0x7c, 0x38, 0x3f, 0xa2, 0xba, 0xb2, 0xc4, 0xe6, 0x16, 0xd4, 0x81, 0x14,
+	0xef, 0x90, 0xa2, 0xd1, 0xd8, 0x13, 0x7d, 0xd0, 0x7d, 0x4e, 0x70, 0x38, 0x3e, 0x1a, 0x8e, 0xfd,
+	0x85, 0x17, 0x72, 0xe7, 0x58, 0x35, 0x5b, 0x0c, 0xb6, 0xcb, 0x40, 0xc6, 0x7f, 0x6a, 0xd0, 0xfe,
+	0x75, 0xba, 0x8a, 0x94, 0xe9, 0x9d, 0xa4, 0x4c, 0xdf, 0x2a, 0x90, 0xa9, 0x89, 0xc3, 0xc0, 0xc1,
+	0xc7, 0xf8, 0xe7, 0xae, 0x0d, 0xf8, 0x4f, 0x1a, 0xf4, 0x07, 0x27, 0xde, 0xd8, 0xe4, 0x2e, 0xe3,
+	0xfc, 0x86, 0x79, 0x1d, 0x3a, 0xc7, 0xa9, 0xe4, 0xb0, 0xc2, 0xf4, 0xba, 0x7d, 0x9c, 0xac, 0x2f,
+	0x4d, 0xd0, 0x65, 0x57, 0x4e, 0x6c, 0x56, 0x7a, 0xf0, 0xb7, 0x55, 0x5c, 0x67, 0x98, 0x63, 0x1e,
+	0x70, 0x25, 0x48, 0x03, 0x8d, 0xdf, 0xd7, 0x60, 0x4d, 0x81, 0x88, 0xae, 0xc0, 0xb2, 0xa8, 0x65,
+	0x7b, 0x5a, 0xc2, 0x55, 0xd8, 0xf4, 0x78, 0xe2, 0x6e, 0x8c, 0x63, 0xe7, 0x33, 0x4e, 0x1b, 0xbd,
+	0x0e, 0xad, 0xa8, 0xe8, 0xb0, 0x73, 0xe7, 0x63, 0x13, 0xd4, 0x87, 0x86, 0xf0, 0x81, 0xb2, 0x9a,
+	0x8b, 0xbe, 0x8d, 0xbf, 0xd3, 0xe0, 0xf2, 0xfb, 0x96, 0x67, 0xfb, 0x93, 0xc9, 0xf9, 0xc5, 0xba,
+	0x0b, 0xa9, 0x5a, 0xa5, 0x6c, 0x17, 0x24, 0x35, 0x09, 0xdd, 0x84, 0xd5, 0x80, 0x3b, 0x60, 0x3b,
+	0x2d, 0xf7, 0xaa, 0xa9, 0xcb, 0x81, 0x48, 0x9e, 0x7f, 0x59, 0x01, 0x44, 0x63, 0xce, 0x8e, 0xe5,
+	0x5a, 0xde, 0x18, 0xbf, 0x38, 0xeb, 0x37, 0xa0, 0x9b, 0x8a, 0x94, 0xd1, 0x95, 0x5b, 0x32, 0x54,
+	0x12, 0xf4, 0x01, 0x74, 0x47, 0x9c, 0xd4, 0x30, 0xc0, 0x16, 0xf1, 0x3d, 0xe6, 0xc3, 0xbb, 0xea,
+	0x86, 0xc7, 0xc3, 0xc0, 0x99, 0x4e, 0x71, 0xb0, 0xeb, 0x7b, 0xb6, 0x48, 0xf9, 0x46, 0x92, 0x4d,
+	0x3a, 0x95, 0x1e, 0x5c, 0x9c, 0x36, 0xc8, 0xa3, 0x81, 0x28, 0x6f, 0x60, 0xa2, 0x20, 0xd8, 0x72,
+	0x63, 0x41, 0xc4, 0x4e, 0x5f, 0xe7, 0x03, 0x83, 0xe2, 0x7e, 0x97, 0x22, 0x8c, 0x1b, 0x7f, 0xa3,
+	0x01, 0x8a, 0xca, 0x32, 0x56, 0x80, 0x32, 0xed, 0xcb, 0x4e, 0xd5, 0xf2, 0x53, 0x69, 0x08, 0xb7,
+	0xe5, 0x4c, 0x61, 0x2e, 0x31, 0x80, 0x85, 0x02, 0xc6, 0xf4, 0x90, 0xc6, 0x7c, 0x6c, 0xcb, 0xb2,
+	0x87, 0x03, 0x3f, 0x64, 0xb0, 0x74, 0x16, 0x50, 0xcb, 0x66, 0x01, 0xc9, 0x76, 0x4e, 0x3d, 0xd5,
+	0xce, 0x31, 0x3e, 0xaf, 0x80, 0xce, 0xdc, 0xdd, 0x6e, 0xdc, 0x53, 0x28, 0xc5, 0xf4, 0x75, 0xe8,
+	0x88, 0x4b, 0xe9, 0x14, 0xe3, 0xed, 0xa7, 0x89, 0xc5, 0xd0, 0xbb, 0x70, 0x91, 0x23, 0x05, 0x98,
+	0x2c, 0xdc, 0x38, 0xe3, 0xe7, 0x39, 0x33, 0x7a, 0xca, 0xfd, 0x2c, 0x1d, 0x92, 0x33, 0x1e, 0xc1,
+	0xe5, 0xa9, 0xeb, 0x8f, 0x2c, 0x77, 0x98, 0x3e, 0x1e, 0x7e, 0x86, 0x25, 0x34, 0xfe, 0x22, 0x9f,
+	0x3e, 0x48, 0x9e, 0x21, 0x41, 0xfb, 0xb4, 0x7b, 0x80, 0x9f, 0xc4, 0xc5, 0x44, 0xbd, 0x74, 0x31,
+	0xd1, 0xa6, 0x13, 0xe5, 0x97, 0xf1, 0x27, 0x1a, 0xac, 0x64, 0x3a, 0xb2, 0xd9, 0xca, 0x55, 0xcb,
+	0x57, 0xae, 0x77, 0xa0, 0x4e, 0x28, 0x2e, 0x13, 0x52, 0x57, 0x5d, 0x55, 0xa5, 0x57, 0x35, 0xf9,
+	0x04, 0x74, 0x0b, 0xd6, 0x14, 0x37, 0xa0, 0x42, 0x07, 0x50, 0xfe, 0x02, 0xd4, 0xf8, 0x59, 0x0d,
+	0x5a, 0x09, 0x79, 0x9c, 0x51, 0x74, 0x97, 0x69, 0xb1, 0x65, 0xb6, 0x57, 0xcd, 0x6f, 0xaf, 0xe0,
+	0x7e, 0x8d, 0xea, 0xdd, 0x0c, 0xcf, 0x78, 0x8d, 0x21, 0x0a, 0x9e, 0x19, 0x9e, 0xb1, 0x0a, 0x23,
+	0x59, 0x3c, 0x2c, 0xa5, 0x8a, 0x87, 0x4c, 0x79, 0xb5, 0x7c, 0x4a, 0x79, 0xd5, 0x48, 0x97, 0x57,
+	0x29, 0x3b, 0x6a, 0x66, 0xed, 0xa8, 0x6c, 0x1d, 0xfc, 0x2e, 0xac, 0x8d, 0x03, 0x6c, 0x85, 0xd8,
+	0xde, 0x39, 0xd9, 0x8d, 0x86, 0x44, 0x02, 0xa6, 0x1a, 0x42, 0xf7, 0xe2, 0xd6, 0x14, 0x3f, 0xe5,
+	0x36, 0x3b, 0x65, 0x75, 0xf5, 0x26, 0xce, 0x86, 0x1f, 0x72, 0x9b, 0x24, 0xbe, 0xb2, 0x15, 0x78,
+	0xe7, 0x85, 0x2a, 0xf0, 0xd7, 0xa1, 0x25, 0x43, 0x2b, 0x35, 0xf7, 0x2e, 0xf7, 0x7c, 0x02, 0x44,
+	0x43, 0x56, 0xd2, 0x19, 0xac, 0xa4, 0x7b, 0xbb, 0xd9, 0xda, 0x57, 0xcf, 0xd7, 0xbe, 0x57, 0x60,
+	0xd9, 0x21, 0xc3, 0x89, 0xf5, 0x04, 0xf7, 0x56, 0xd9, 0xe8, 0x92, 0x43, 0xee, 0x59, 0x4f, 0xb0,
+	0xf1, 0x2f, 0x55, 0xe8, 0xc6, 0xc5, 0x52, 0x69, 0x37, 0x52, 0xe6, 0x15, 0xc0, 0x03, 0xd0, 0xe3,
+	0x40, 0xcd, 0x24, 0x7c, 0x6a, 0xbd, 0x97, 0xbd, 0x30, 0x59, 0x99, 0xa7, 0x01, 0xe9, 0x96, 0x74,
+	0xed, 0xb9, 0x5a, 0xd2, 0xe7, 0xbc, 0x8d, 0xbc, 0x0d, 0x97, 0xa2, 0x00, 0x9c, 0xda, 0x36, 0x2f,
+	0x26, 0x2e, 0xca, 0xc1, 0xc3, 0xe4, 0xf6, 0x0b, 0x5c, 0xc0, 0x72, 0x91, 0x0b, 0xc8, 0xaa, 0x40,
+	0x23, 0xa7, 0x02, 0xf9, 0x4b, 0xd1, 0xa6, 0xe2, 0x52, 0xd4, 0x78, 0x04, 0x6b, 0xac, 0xdb, 0x48,
+	0x6f, 0x99, 0x46, 0x38, 0xca, 0x59, 0xcb, 0x1c, 0x6b, 0x1f, 0x1a, 0x99, 0xb4, 0x37, 0xfa, 0x36,
+	0x7e, 0xaa, 0xc1, 0xe5, 0xfc, 0xba, 0x4c, 0x63, 0x62, 0x47, 0xa2, 0xa5, 0x1c, 0xc9, 0x6f, 0xc2,
+	0x5a, 0xbc, 0x7c, 0x3a, 0xa1, 0x2e, 0x48, 0x19, 0x15, 0x8c, 0x9b, 0x28, 0x5e, 0x43, 0xc2, 0x8c,
+	0x9f, 0x69, 0x51, 0xd3, 0x96, 0xc2, 0xa6, 0xac, 0x95, 0x4d, 0x83, 0x9b, 0xef, 0xb9, 0x8e, 0x87,
+	0x87, 0x29, 0x76, 0xda, 0x1c, 0x28, 0x8a, 0xfb, 0xf7, 0x61, 0x45, 0x20, 0x45, 0x31, 0xaa, 0x64,
+	0x56, 0xd6, 0xe5, 0xf3, 0xa2, 0xe8, 0x74, 0x03, 0xba, 0xa2, 0xc7, 0x2c, 0xe9, 0x55, 0x55, 0x9d,
+	0xe7, 0x5f, 0x03, 0x5d, 0xa2, 0x3d, 0x6f, 0x54, 0x5c, 0x11, 0x13, 0xa3, 0xec, 0xee, 0x27, 0x1a,
+	0xf4, 0xd2, 0x31, 0x32, 0xb1, 0xfd, 0xe7, 0xcf, 0xf1, 0xbe, 0x9b, 0xbe, 0x9d, 0xbb, 0x71, 0x0a,
+	0x3f, 0x31, 0x1d, 0x79, 0x47, 0xf7, 0x80, 0xdd, 0xb4, 0xd2, 0xd2, 0x64, 0xcf, 0x21, 0x61, 0xe0,
+	0x8c, 0x16, 0xe7, 0x7a, 0x26, 0x62, 0xfc, 0x6d, 0x05, 0xbe, 0xae, 0x5c, 0xf0, 0x3c, 0xf7, 0x70,
+	0x45, 0x0d, 0x87, 0x1d, 0x68, 0x64, 0x4a, 0x98, 0xb7, 0x4e, 0xd9, 0xbc, 0xe8, 0x9d, 0xf1, 0x1e,
+	0x8e, 0x9c, 0x47, 0xd7, 0x88, 0x74, 0xba, 0x56, 0xbc, 0x86, 0x50, 0xda, 0xd4, 0x1a, 0x72, 0x1e,
+	0xed, 0x62, 0xf3, 0xf2, 0x70, 0x78, 0xec, 0xe0, 0x67, 0xf2, 0xfa, 0xe8, 0x9a, 0xd2, 0xaf, 0x31,
+	0xbc, 0xc7, 0x0e, 0x7e, 0x66, 0xb6, 0xdc, 0xe8, 0x37, 0x31, 0xfe, 0xbb, 0x0a, 0x10, 0x8f, 0xd1,
+	0xda, 0x34, 0x36, 0x18, 0x61, 0x01, 0x09, 0x08, 0x0d, 0xc4, 0xe9, 0xdc, 0x4f, 0x7e, 0x22, 0x33,
+	0xee, 0x02, 0xdb, 0x0e, 0x09, 0x85, 0x5c, 0x6e, 0x9d, 0xce, 0x8b, 0x14, 0x11, 0x3d, 0x32, 0x7e,
+	0x3b, 0xd3, 0x22, 0x31, 0x04, 0xbd, 0x03, 0x68, 0x1a, 0xf8, 0xcf, 0x1c, 0x6f, 0x9a, 0xcc, 0xd8,
+	0x79, 0x62, 0xbf, 0x2a, 0x46, 0x12, 0x29, 0xfb, 0x8f, 0x40, 0xcf, 0xa0, 0x4b, 0x91, 0xdc, 0x3e,
+	0x83, 0x8d, 0xfd, 0xd4, 0x5a, 0xe2, 0xa2, 0x68, 0x25, 0x4d, 0x81, 0xf4, 0x87, 0xa0, 0x67, 0xf9,
+	0x55, 0x5c, 0xf5, 0x7c, 0x3b, 0x7d, 0xd5, 0x73, 0x9a, 0x99, 0xd2, 0x65, 0x12, 0x77, 0x3d, 0xfd,
+	0x09, 0x5c, 0x54, 0x71, 0xa2, 0x20, 0x72, 0x27, 0x4d, 0xa4, 0x4c, 0x4e, 0x1b, 0xd3, 0x31, 0x7e,
+	0x00, 0xad, 0x04, 0x07, 0x85, 0x1e, 0x38, 0xd1, 0xfb, 0xab, 0xa4, 0x7a, 0x7f, 0xc6, 0x1f, 0x69,
+	0x80, 0xf2, 0xda, 0x8d, 0xba, 0x50, 0x89, 0x16, 0xa9, 0x1c, 0xec, 0x65, 0xb4, 0xa9, 0x92, 0xd3,
+	0xa6, 0xab, 0xd0, 0x8c, 0x22, 0xa2, 0x70, 0x7f, 0x31, 0x20, 0xa9, 0x6b, 0xb5, 0xb4, 0xae, 0x25,
+	0x18, 0xab, 0xa7, 0x19, 0x3b, 0x02, 0x94, 0xb7, 0x98, 0xe4, 0x4a, 0x5a, 0x7a, 0xa5, 0xb3, 0x38,
+	0x4c, 0x50, 0xaa, 0xa6, 0x29, 0xfd, 0x47, 0x05, 0x50, 0x1c, 0xf3, 0xa3, 0xfb, 0xae, 0x32, 0x81,
+	0xf2, 0x16, 0xac, 0xe5, 0x33, 0x02, 0x99, 0x06, 0xa1, 0x5c, 0x3e, 0xa0, 0x8a, 0xdd, 0x55, 0xd5,
+	0x83, 0xa6, 0xf7, 0x22, 0x1f, 0xc7, 0x13, 0x9c, 0x6b, 0x45, 0x09, 0x4e, 0xc6, 0xcd, 0xfd, 0x56,
+	0xf6, 0x21, 0x14, 0x37, 0x9a, 0x3b, 0x4a, 0x7f, 0x94, 0xdb, 0xf2, 0xab, 0x7f, 0x05, 0xf5, 0x6f,
+	0x15, 0x58, 0x8d, 0xa4, 0xf1, 0x5c, 0x92, 0x3e, 0xfb, 0x7e, 0xf1, 0x15, 0x8b, 0xf6, 0x13, 0xb5,
+	0x68, 0x7f, 0xf9, 0xd4, 0x1c, 0xf6, 0x8b, 0x93, 0xec, 0x00, 0x96, 0x45, 0xfb, 0x2c, 0x67, 0xbb,
+	0x65, 0xaa, 0xc4, 0x8b, 0x50, 0xa7, 0xae, 0x42, 0xf6, 0x93, 0xf8, 0x87, 0xf1, 0xd7, 0x1a, 0x00,
+	0x6d, 0x2f, 0xde, 0xe5, 0x26, 0xf4, 0x2e, 0xd4, 0xce, 0x7a, 0x07, 0x42, 0xb1, 0x59, 0xd2, 0xcd,
+	0x30, 0x4b, 0x9c, 0x5a, 0xaa, 0xc0, 0xad, 0x66, 0x0b, 0xdc, 0xa2, 0xd2, 0xb4, 0xd8, 0x6d, 0xfc,
+	0x03, 0x7d, 0x71, 0x7e, 0xe2, 0x8d, 0x5f, 0x4a, 0x2e, 0x52, 0x4a, 0x74, 0x09, 0x97, 0x54, 0x4d,
+	0xbb, 0xa4, 0x3b, 0xb0, 0xcc, 0x6b, 0x4c, 0x99, 0x17, 0x5c, 0x2b, 0x12, 0x19, 0x17, 0xb0, 0x29,
+	0xd1, 0x37, 0x7f, 0x15, 0x9a, 0x51, 0xaf, 0x17, 0xb5, 0x60, 0xf9, 0x91, 0xf7, 0x81, 0xe7, 0x3f,
+	0xf3, 0xf4, 0x0b, 0x68, 0x19, 0xaa, 0x77, 0x5d, 0x57, 0xd7, 0x50, 0x07, 0x9a, 0x83, 0x30, 0xc0,
+	0xd6, 0xcc, 0xf1, 0xa6, 0x7a, 0x05, 0x75, 0x01, 0xde, 0x77, 0x48, 0xe8, 0x07, 0xce, 0xd8, 0x72,
+	0xf5, 0xea, 0xe6, 0x0e, 0x0d, 0x0c, 0x71, 0xeb, 0x7c, 0x05, 0x5a, 0xf7, 0x16, 0xae, 0xcb, 0xbb,
+	0x35, 0x44, 0xbf, 0x40, 0xf1, 0x07, 0x63, 0x3f, 0xc0, 0xe4, 0x23, 0xcf, 0x3d, 0xd1, 0x35, 0x84,
+	0xa0, 0x7b, 0x8f, 0xf6, 0xd1, 0x07, 0x8b, 0xe0, 0xd8, 0x39, 0x66, 0x6b, 0x6e, 0x7e, 0x06, 0xdd,
+	0x74, 0x35, 0x86, 0xda, 0xd0, 0x78, 0xe0, 0x87, 0x3f, 0xfc, 0xd4, 0x21, 0x21, 0x5f, 0xe3, 0x81,
+	0x1f, 0x1e, 0x06, 0x98, 0x60, 0x2f, 0xd4, 0x35, 0x04, 0xb0, 0xf4, 0x91, 0xb7, 0xe7, 0x90, 0x27,
+	0x7a, 0x05, 0xad, 0x89, 0x46, 0x8b, 0xe5, 0x1e, 0x88, 0x12, 0x47, 0xaf, 0xd2, 0xe9, 0xd1, 0x57,
+	0x0d, 0xe9, 0xd0, 0x8e, 0x50, 0xf6, 0x0f, 0x1f, 0xe9, 0x75, 0xd4, 0x84, 0x3a, 0xff, 0xb9, 0xb4,
+	0x69, 0x83, 0x9e, 0xed, 0x12, 0xd2, 0x35, 0xb9, 0x20, 0x22, 0x90, 0x7e, 0x81, 0x4a, 0x47, 0xb4,
+	0x69, 0x75, 0x8d, 0x6e, 0x33, 0xd1, 0xf4, 0xd4, 0x2b, 0x14, 0xb0, 0x1f, 0xcc, 0xc7, 0x42, 0x03,
+	0x38, 0x0b, 0x34, 0x1f, 0xdf, 0xa3, 0xd2, 0xac, 0x6d, 0xee, 0x40, 0x43, 0x96, 0x89, 0x14, 0x55,
+	0x88, 0x99, 0x7e, 0xea, 0x17, 0xd0, 0x2a, 0x74, 0x52, 0x8f, 0x45, 0xb9, 0x94, 0xd2, 0x6f, 0xb1,
+	0xf5, 0xca, 0xe6, 0x36, 0x40, 0xec, 0x2e, 0x28, 0x3b, 0x07, 0xde, 0xb1, 0xe5, 0x3a, 0x36, 0xe7,
+	0x8d, 0x0e, 0x51, 0x69, 0x32, 0xe9, 0xf0, 0x76, 0x9f, 0x5e, 0xd9, 0x7c, 0x1d, 0x1a, 0xd2, 0x52,
+	0x28, 0xdc, 0xc4, 0x33, 0xff, 0x18, 0xf3, 0xd3, 0x1d, 0xe0, 0x50, 0xd7, 0xb6, 0xff, 0xb7, 0x03,
+	0xc0, 0x1b, 0x7b, 0xbe, 0x1f, 0xd8, 0xc8, 0x05, 0xb4, 0x8f, 0x43, 0xda, 0xb4, 0xf0, 0x3d, 0xd9,
+	0x70, 0x20, 0x68, 0x2b, 0xad, 0x4e, 0xe2, 0x23, 0x8f, 0x28, 0x76, 0xdf, 0x7f, 0x53, 0x89, 0x9f,
+	0x41, 0x36, 0x2e, 0xa0, 0x19, 0xa3, 0x46, 0x5f, 0x57, 0x3c, 0x74, 0xc6, 0x4f, 0xa2, 0x6e, 0x60,
+	0xf1, 0x43, 0xea, 0x0c, 0xaa, 0xa4, 0x77, 0x5d, 0x49, 0x6f, 0x10, 0x06, 0x8e, 0x37, 0x95, 0xe9,
+	0xbc, 0x71, 0x01, 0x3d, 0xcd, 0x3c, 0xe3, 0x96, 0x04, 0xb7, 0xcb, 0xbc, 0xdc, 0x7e, 0x31, 0x92,
+	0x2e, 0xac, 0x64, 0xfe, 0x96, 0x82, 0x36, 0xd5, 0x2f, 0xf3, 0x54, 0x7f, 0xa1, 0xe9, 0xdf, 0x2c,
+	0x85, 0x1b, 0x51, 0x73, 0xa0, 0x9b, 0xfe, 0xeb, 0x05, 0xfa, 0xa5, 0xa2, 0x05, 0x72, 0x6f, 0x83,
+	0xfb, 0x9b, 0x65, 0x50, 0x23, 0x52, 0x1f, 0x73, 0x05, 0x3d, 0x8b, 0x94, 0xf2, 0x11, 0x74, 0xff,
+	0xb4, 0x4a, 0xca, 0xb8, 0x80, 0x7e, 0x0c, 0xab, 0xb9, 0x17, 0xcc, 0xe8, 0x1b, 0xea, 0x1b, 0x1f,
+	0xf5, 0x43, 0xe7, 0xb3, 0x28, 0x7c, 0x9c, 0x35, 0xaf, 0x62, 0xee, 0x73, 0x7f, 0x48, 0x28, 0xcf,
+	0x7d, 0x62, 0xf9, 0xd3, 0xb8, 0x7f, 0x6e, 0x0a, 0x0b, 0x66, 0x36, 0xd9, 0xf6, 0xf2, 0x3b, 0x2a,
+	0x12, 0x85, 0xcf, 0xa8, 0xfb, 0x5b, 0x65, 0xd1, 0x93, 0xda, 0x95, 0x7e, 0xa9, 0xab, 0x16, 0x9a,
+	0xf2, 0x75, 0x71, 0x7f, 0xb3, 0x0c, 0x6a, 0x44, 0xea, 0x61, 0xca, 0xbd, 0xa2, 0xb7, 0x8a, 0x0e,
+	0x27, 0x7d, 0xe9, 0x74, 0x96, 0xdc, 0x7e, 0x1b, 0x10, 0xb7, 0x1d, 0x6f, 0xe2, 0x4c, 0x17, 0x81,
+	0xc5, 0x15, 0xab, 0xc8, 0xdd, 0xe4, 0x51, 0x25, 0x99, 0x6f, 0x3e, 0xc7, 0x8c, 0x68, 0x4b, 0x43,
+	0x80, 0x7d, 0x1c, 0xde, 0xc7, 0x61, 0xe0, 0x8c, 0x49, 0x76, 0x47, 0xb1, 0x47, 0x15, 0x08, 0x92,
+	0xd4, 0xdb, 0x67, 0xe2, 0x45, 0x04, 0x46, 0xd0, 0xda, 0xc7, 0xa1, 0xc8, 0xcd, 0x08, 0x2a, 0x9c,
+	0x29, 0x31, 0x24, 0x89, 0x8d, 0xb3, 0x11, 0x93, 0xee, 0x2c, 0xf3, 0x6a, 0x19, 0x15, 0x1e, 0x6c,
+	0xfe, 0x2d, 0x75, 0xff, 0x66, 0x29, 0xdc, 0xe4, 0x8e, 0x76, 0x8f, 0xf0, 0xf8, 0xc9, 0xfb, 0xd8,
+	0x72, 0xc3, 0xa3, 0x82, 0x1d, 0x25, 0x30, 0x4e, 0xdf, 0x51, 0x0a, 0x51, 0xd2, 0xd8, 0xfe, 0xbc,
+	0x0b, 0x4d, 0x16, 0xff, 0x68, 0xb0, 0xfe, 0x45, 0xf8, 0x7b, 0xc9, 0xe1, 0xef, 0x13, 0x58, 0xc9,
+	0x3c, 0xb2, 0x55, 0xeb, 0x8b, 0xfa, 0x25, 0x6e, 0x09, 0x2f, 0x9e, 0x7e, 0xe6, 0xaa, 0x76, 0x48,
+	0xca, 0xa7, 0xb0, 0x67, 0xad, 0xfd, 0x98, 0xbf, 0x4f, 0x8f, 0x7a, 0xaf, 0x6f, 0x17, 0x56, 0x6f,
+	0xe9, 0x3b, 0xfb, 0x2f, 0x3f, 0x3a, 0xbc, 0xfa, 0xe8, 0xf9, 0x09, 0xac, 0x64, 0x1e, 0x68, 0xa9,
+	0x4f, 0x55, 0xfd, 0x8a, 0xeb, 0xac, 0xd5, 0xbf, 0xc0, 0x30, 0x63, 0xc3, 0x9a, 0xe2, 0x51, 0x0b,
+	0xda, 0x2a, 0xaa, 0x9e, 0xd4, 0xaf, 0x5f, 0xce, 0xde, 0x50, 0x27, 0x65, 0x4a, 0x68, 0xa3, 0x88,
+	0xc9, 0xec, 0xdf, 0x04, 0xfb, 0xdf, 0x28, 0xf7, 0x9f, 0xc2, 0x68, 0x43, 0x03, 0x58, 0xe2, 0xc5,
+	0x18, 0x7a, 0xa3, 0xf8, 0xc1, 0x53, 0x81, 0x9b, 0xca, 0x3d, 0xfc, 0x62, 0x15, 0x1c, 0x5b, 0xb4,
+	0xce, 0x3c, 0x24, 0x52, 0xbe, 0x37, 0x4c, 0x3e, 0x82, 0xea, 0x9f, 0xfd, 0xee, 0x49, 0x2e, 0xfa,
+	0xff, 0x3b, 0x16, 0x7f, 0x0a, 0x6b, 0x8a, 0x9b, 0x05, 0x54, 0x94, 0x73, 0x15, 0xdc, 0x69, 0xf4,
+	0x6f, 0x95, 0xc6, 0x8f, 0x28, 0xff, 0x08, 0xf4, 0x6c, 0x57, 0x02, 0xdd, 0x2c, 0xd2, 0x67, 0x15,
+	0xcd, 0xd3, 0x95, 0x79, 0xe7, 0x5b, 0x1f, 0x6f, 0x4f, 0x9d, 0xf0, 0x68, 0x31, 0xa2, 0x23, 0xb7,
+	0x38, 0xea, 0x3b, 0x8e, 0x2f, 0x7e, 0xdd, 0x92, 0xf2, 0xbf, 0xc5, 0x66, 0xdf, 0x62, 0xa4, 0xe6,
+	0xa3, 0xd1, 0x12, 0xfb, 0xbc, 0xfd, 0x7f, 0x03, 0x00, 0x75, 0xd9, 0x2f, 0xb5, 0xa1, 0x40, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
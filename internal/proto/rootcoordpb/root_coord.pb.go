@@ -674,105 +674,115 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x93, 0xda, 0x36,
-	0x14, 0x0e, 0x90, 0xbd, 0x1d, 0x58, 0xd8, 0x68, 0x72, 0xa1, 0x24, 0x6d, 0x09, 0x49, 0x1b, 0x72,
-	0x63, 0xd3, 0xcd, 0x4c, 0x9a, 0xe6, 0x2d, 0x0b, 0x99, 0x0d, 0xd3, 0xee, 0x64, 0x6b, 0x92, 0x4e,
-	0x7a, 0xd9, 0xa1, 0xc2, 0x56, 0xc0, 0xb3, 0xc6, 0x22, 0x92, 0xd8, 0xcb, 0xf4, 0xa1, 0xd3, 0x99,
-	0xbe, 0xf7, 0x3f, 0xb5, 0x3f, 0xa5, 0xbf, 0xa1, 0xef, 0x1d, 0x59, 0xb6, 0xb0, 0x8d, 0xcd, 0x7a,
-	0x93, 0xb4, 0x6f, 0x48, 0xfa, 0xfc, 0x7d, 0x47, 0xe7, 0x22, 0x1d, 0x01, 0x1b, 0x8c, 0x52, 0xd1,
-	0x37, 0x29, 0x65, 0x56, 0x6b, 0xc2, 0xa8, 0xa0, 0xe8, 0xf2, 0xd8, 0x76, 0x0e, 0xa7, 0x5c, 0x8d,
-	0x5a, 0x72, 0xd9, 0x5b, 0xad, 0x95, 0x4c, 0x3a, 0x1e, 0x53, 0x57, 0xcd, 0xd7, 0x4a, 0x61, 0x54,
-	0xad, 0x6c, 0xbb, 0x82, 0x30, 0x17, 0x3b, 0xfe, 0xb8, 0x38, 0x61, 0xf4, 0xf8, 0xc4, 0x1f, 0x54,
-	0x88, 0x30, 0xad, 0xfe, 0x98, 0x08, 0xac, 0x26, 0x1a, 0x7d, 0xb8, 0xf4, 0xd4, 0x71, 0xa8, 0xf9,
-	0xd2, 0x1e, 0x13, 0x2e, 0xf0, 0x78, 0x62, 0x90, 0xb7, 0x53, 0xc2, 0x05, 0x7a, 0x00, 0xe7, 0x07,
-	0x98, 0x93, 0x6a, 0xae, 0x9e, 0x6b, 0x16, 0xb7, 0xae, 0xb5, 0x22, 0x96, 0xf8, 0xf2, 0xbb, 0x7c,
-	0xb8, 0x8d, 0x39, 0x31, 0x3c, 0x24, 0xba, 0x08, 0x4b, 0x26, 0x9d, 0xba, 0xa2, 0x5a, 0xa8, 0xe7,
-	0x9a, 0xeb, 0x86, 0x1a, 0x34, 0x7e, 0xcb, 0xc1, 0xe5, 0xb8, 0x02, 0x9f, 0x50, 0x97, 0x13, 0xf4,
-	0x10, 0x96, 0xb9, 0xc0, 0x62, 0xca, 0x7d, 0x91, 0xab, 0x89, 0x22, 0x3d, 0x0f, 0x62, 0xf8, 0x50,
-	0x74, 0x0d, 0xd6, 0x44, 0xc0, 0x54, 0xcd, 0xd7, 0x73, 0xcd, 0xf3, 0xc6, 0x6c, 0x22, 0xc5, 0x86,
-	0xd7, 0x50, 0xf6, 0x4c, 0xe8, 0x76, 0x3e, 0xc0, 0xee, 0xf2, 0x61, 0x66, 0x07, 0x2a, 0x9a, 0xf9,
-	0x7d, 0x76, 0x55, 0x86, 0x7c, 0xb7, 0xe3, 0x51, 0x17, 0x8c, 0x7c, 0xb7, 0x93, 0xb2, 0x8f, 0x3f,
-	0xf3, 0x50, 0xea, 0x8e, 0x27, 0x94, 0x09, 0x83, 0xf0, 0xa9, 0x23, 0xde, 0x4d, 0xeb, 0x0a, 0xac,
-	0x08, 0xcc, 0x0f, 0xfa, 0xb6, 0xe5, 0x0b, 0x2e, 0xcb, 0x61, 0xd7, 0x42, 0x9f, 0x42, 0xd1, 0xc2,
-	0x02, 0xbb, 0xd4, 0x22, 0x72, 0xb1, 0xe0, 0x2d, 0x42, 0x30, 0xd5, 0xb5, 0xd0, 0x23, 0x58, 0x92,
-	0x1c, 0xa4, 0x7a, 0xbe, 0x9e, 0x6b, 0x96, 0xb7, 0xea, 0x89, 0x6a, 0xca, 0x40, 0xa9, 0x49, 0x0c,
-	0x05, 0x47, 0x35, 0x58, 0xe5, 0x64, 0x38, 0x26, 0xae, 0xe0, 0xd5, 0xa5, 0x7a, 0xa1, 0x59, 0x30,
-	0xf4, 0x18, 0x7d, 0x04, 0xab, 0x78, 0x2a, 0x68, 0xdf, 0xb6, 0x78, 0x75, 0xd9, 0x5b, 0x5b, 0x91,
-	0xe3, 0xae, 0xc5, 0xd1, 0x55, 0x58, 0x63, 0xf4, 0xa8, 0xaf, 0x1c, 0xb1, 0xe2, 0x59, 0xb3, 0xca,
-	0xe8, 0x51, 0x5b, 0x8e, 0xd1, 0x97, 0xb0, 0x64, 0xbb, 0x6f, 0x28, 0xaf, 0xae, 0xd6, 0x0b, 0xcd,
-	0xe2, 0xd6, 0xf5, 0x44, 0x5b, 0xbe, 0x26, 0x27, 0xdf, 0x61, 0x67, 0x4a, 0xf6, 0xb0, 0xcd, 0x0c,
-	0x85, 0x6f, 0xfc, 0x91, 0x83, 0x2b, 0x1d, 0xc2, 0x4d, 0x66, 0x0f, 0x48, 0xcf, 0xb7, 0xe2, 0xdd,
-	0xd3, 0xa2, 0x01, 0x25, 0x93, 0x3a, 0x0e, 0x31, 0x85, 0x4d, 0x5d, 0x1d, 0xc2, 0xc8, 0x1c, 0xfa,
-	0x04, 0xc0, 0xdf, 0x6e, 0xb7, 0xc3, 0xab, 0x05, 0x6f, 0x93, 0xa1, 0x99, 0xc6, 0x14, 0x2a, 0xbe,
-	0x21, 0x92, 0xb8, 0xeb, 0xbe, 0xa1, 0x73, 0xb4, 0xb9, 0x04, 0xda, 0x3a, 0x14, 0x27, 0x98, 0x09,
-	0x3b, 0xa2, 0x1c, 0x9e, 0x92, 0xb5, 0xa2, 0x65, 0xfc, 0x70, 0xce, 0x26, 0x1a, 0x7f, 0xe7, 0xa1,
-	0xe4, 0xeb, 0x4a, 0x4d, 0x8e, 0x3a, 0xb0, 0x26, 0xf7, 0xd4, 0x97, 0x7e, 0xf2, 0x5d, 0x70, 0xab,
-	0x95, 0x7c, 0x02, 0xb5, 0x62, 0x06, 0x1b, 0xab, 0x83, 0xc0, 0xf4, 0x0e, 0x14, 0x6d, 0xd7, 0x22,
-	0xc7, 0x7d, 0x15, 0x9e, 0xbc, 0x17, 0x9e, 0x1b, 0x51, 0x1e, 0x79, 0x0a, 0xb5, 0xb4, 0xb6, 0x45,
-	0x8e, 0x3d, 0x0e, 0xb0, 0x83, 0x9f, 0x1c, 0x11, 0xb8, 0x40, 0x8e, 0x05, 0xc3, 0xfd, 0x30, 0x57,
-	0xc1, 0xe3, 0xfa, 0xea, 0x14, 0x9b, 0x3c, 0x82, 0xd6, 0x33, 0xf9, 0xb5, 0xe6, 0xe6, 0xcf, 0x5c,
-	0xc1, 0x4e, 0x8c, 0x0a, 0x89, 0xce, 0xd6, 0x7e, 0x86, 0x8b, 0x49, 0x40, 0xb4, 0x01, 0x85, 0x03,
-	0x72, 0xe2, 0xbb, 0x5d, 0xfe, 0x44, 0x5b, 0xb0, 0x74, 0x28, 0x53, 0xc9, 0xf3, 0xf3, 0x5c, 0x6e,
-	0x78, 0x1b, 0x9a, 0xed, 0x44, 0x41, 0x9f, 0xe4, 0x1f, 0xe7, 0x1a, 0x7f, 0xe5, 0xa1, 0x3a, 0x9f,
-	0x6e, 0xef, 0x73, 0x56, 0x64, 0x49, 0xb9, 0x21, 0xac, 0xfb, 0x81, 0x8e, 0xb8, 0x6e, 0x3b, 0xcd,
-	0x75, 0x69, 0x16, 0x46, 0x7c, 0xaa, 0x7c, 0x58, 0xe2, 0xa1, 0xa9, 0x1a, 0x81, 0x0b, 0x73, 0x90,
-	0x04, 0xef, 0x3d, 0x89, 0x7a, 0xef, 0x66, 0x96, 0x10, 0x86, 0xbd, 0x68, 0xc1, 0xc5, 0x1d, 0x22,
-	0xda, 0x8c, 0x58, 0xc4, 0x15, 0x36, 0x76, 0xde, 0xbd, 0x60, 0x6b, 0xb0, 0x3a, 0xe5, 0xf2, 0x7e,
-	0x1c, 0x2b, 0x63, 0xd6, 0x0c, 0x3d, 0x6e, 0xfc, 0x9e, 0x83, 0x4b, 0x31, 0x99, 0xf7, 0x09, 0xd4,
-	0x02, 0x29, 0xb9, 0x36, 0xc1, 0x9c, 0x1f, 0x51, 0xa6, 0x0e, 0xda, 0x35, 0x43, 0x8f, 0xb7, 0xfe,
-	0xb9, 0x0e, 0x6b, 0x06, 0xa5, 0xa2, 0x2d, 0x5d, 0x82, 0x1c, 0x40, 0xd2, 0x26, 0x3a, 0x9e, 0x50,
-	0x97, 0xb8, 0xea, 0x60, 0xe5, 0xa8, 0x15, 0x35, 0xc0, 0x1f, 0xcc, 0x03, 0x7d, 0x47, 0xd5, 0x6e,
-	0x26, 0xe2, 0x63, 0xe0, 0xc6, 0x39, 0x34, 0xf6, 0xd4, 0xe4, 0x5d, 0xfd, 0xd2, 0x36, 0x0f, 0xda,
-	0x23, 0xec, 0xba, 0xc4, 0x41, 0x0f, 0xa2, 0x5f, 0xeb, 0x0e, 0x63, 0x1e, 0x1a, 0xe8, 0xdd, 0x48,
-	0xd4, 0xeb, 0x09, 0x66, 0xbb, 0xc3, 0xc0, 0xab, 0x8d, 0x73, 0xe8, 0xad, 0x17, 0x57, 0xa9, 0x6e,
-	0x73, 0x61, 0x9b, 0x3c, 0x10, 0xdc, 0x4a, 0x17, 0x9c, 0x03, 0x9f, 0x51, 0xb2, 0x0f, 0x1b, 0x6d,
-	0x46, 0xb0, 0x20, 0x6d, 0x5d, 0x30, 0xe8, 0x5e, 0xb2, 0x77, 0x62, 0xb0, 0x40, 0x68, 0x51, 0xf0,
-	0x1b, 0xe7, 0xd0, 0x8f, 0x50, 0xee, 0x30, 0x3a, 0x09, 0xd1, 0xdf, 0x49, 0xa4, 0x8f, 0x82, 0x32,
-	0x92, 0xf7, 0x61, 0xfd, 0x39, 0xe6, 0x21, 0xee, 0xdb, 0x89, 0xdc, 0x11, 0x4c, 0x40, 0x7d, 0x3d,
-	0x11, 0xba, 0x4d, 0xa9, 0x13, 0x72, 0xcf, 0x11, 0xa0, 0xe0, 0x30, 0x08, 0xa9, 0x24, 0xa7, 0xdb,
-	0x3c, 0x30, 0x90, 0xda, 0xcc, 0x8c, 0xd7, 0xc2, 0xbf, 0x42, 0x6d, 0x7e, 0xbd, 0xeb, 0x07, 0xfe,
-	0xff, 0x30, 0xe0, 0x15, 0x14, 0x55, 0xc4, 0x9f, 0x3a, 0x36, 0xe6, 0xe8, 0xd6, 0x82, 0x9c, 0xf0,
-	0x10, 0x19, 0x23, 0xf6, 0x2d, 0xac, 0xc9, 0x48, 0x2b, 0xd2, 0xcf, 0x52, 0x33, 0xe1, 0x2c, 0x94,
-	0x3d, 0x80, 0xa7, 0x8e, 0x20, 0x4c, 0x71, 0x7e, 0x9e, 0xc8, 0x39, 0x03, 0x64, 0x24, 0x75, 0xa1,
-	0xd2, 0x1b, 0xc9, 0xee, 0x2a, 0x70, 0x0d, 0x47, 0x77, 0x93, 0x2b, 0x2a, 0x8a, 0x0a, 0xe8, 0xef,
-	0x65, 0x03, 0x6b, 0x77, 0xef, 0xcb, 0xd6, 0x59, 0x10, 0x16, 0xca, 0xb2, 0xbb, 0xe9, 0x3b, 0x39,
-	0x73, 0xa1, 0xec, 0x43, 0x45, 0xc5, 0x6a, 0x2f, 0x68, 0x88, 0x52, 0xe8, 0x63, 0xa8, 0x8c, 0xf4,
-	0xdf, 0xc3, 0xba, 0x8c, 0xda, 0x8c, 0xfc, 0x76, 0x6a, 0x64, 0xcf, 0x4a, 0xbd, 0x0f, 0xa5, 0xe7,
-	0x98, 0xcf, 0x98, 0x9b, 0x69, 0x15, 0x3e, 0x47, 0x9c, 0xa9, 0xc0, 0x0f, 0xa0, 0x2c, 0x83, 0xa2,
-	0x3f, 0xe6, 0x29, 0xc7, 0x53, 0x14, 0x14, 0x48, 0xdc, 0xcd, 0x84, 0xd5, 0x62, 0x1c, 0x2e, 0x47,
-	0xd7, 0x74, 0x41, 0xff, 0x87, 0xa2, 0x04, 0x4a, 0x72, 0x2d, 0xe8, 0x65, 0x52, 0x1c, 0x18, 0x86,
-	0x04, 0x42, 0xb7, 0x33, 0x20, 0x43, 0x77, 0x57, 0x39, 0xfa, 0xb0, 0x45, 0xf7, 0xd3, 0xda, 0x9a,
-	0xc4, 0x27, 0x76, 0xad, 0x95, 0x15, 0xae, 0x25, 0x7f, 0x82, 0x15, 0xff, 0xb9, 0x19, 0xaf, 0xfa,
-	0xd8, 0xc7, 0xfa, 0xa5, 0x5b, 0xbb, 0x75, 0x2a, 0x4e, 0xb3, 0x63, 0xb8, 0xf4, 0x6a, 0x62, 0xc9,
-	0x2b, 0x4f, 0x5d, 0xac, 0xc1, 0xd5, 0x1e, 0xcf, 0x6d, 0x7d, 0x1b, 0xc7, 0x70, 0xbb, 0x7c, 0x78,
-	0x5a, 0x6e, 0x33, 0xf8, 0xb8, 0xeb, 0x1e, 0x62, 0xc7, 0xb6, 0x22, 0x37, 0xeb, 0x2e, 0x11, 0xb8,
-	0x8d, 0xcd, 0x11, 0x89, 0x5f, 0xfc, 0xea, 0xbf, 0x8b, 0xe8, 0x27, 0x1a, 0x9c, 0xb1, 0x9e, 0x7e,
-	0x01, 0xa4, 0x4e, 0x21, 0xf7, 0x8d, 0x3d, 0x9c, 0x32, 0xac, 0x92, 0x3e, 0xad, 0xa5, 0x99, 0x87,
-	0x06, 0x32, 0x5f, 0x9c, 0xe1, 0x8b, 0x50, 0xb7, 0x01, 0x3b, 0x44, 0xec, 0x12, 0xc1, 0x6c, 0x33,
-	0xed, 0xa8, 0x9e, 0x01, 0x52, 0x82, 0x96, 0x80, 0xd3, 0x02, 0x3d, 0x58, 0x56, 0x2f, 0x6e, 0xd4,
-	0x48, 0xfc, 0x28, 0xf8, 0xbf, 0x60, 0x51, 0x8f, 0xa4, 0xff, 0x53, 0x08, 0x9d, 0x11, 0x3b, 0x44,
-	0x84, 0x5e, 0xf2, 0x29, 0xe5, 0x1a, 0x05, 0x2d, 0x2e, 0xd7, 0x38, 0x56, 0x8b, 0xb9, 0x50, 0xf9,
-	0xc6, 0xe6, 0xfe, 0xe2, 0x4b, 0xcc, 0x0f, 0xd2, 0x2e, 0x9e, 0x18, 0x6a, 0xf1, 0xc5, 0x33, 0x07,
-	0x0e, 0x79, 0xac, 0x64, 0x10, 0xb9, 0xe0, 0xfb, 0x2d, 0xf5, 0x31, 0x12, 0xfe, 0xab, 0xe5, 0xb4,
-	0x24, 0x7b, 0xad, 0xbb, 0x4a, 0xfd, 0x78, 0x88, 0x5f, 0xf6, 0xb3, 0xb2, 0xd1, 0x10, 0xf9, 0xce,
-	0xc9, 0xc0, 0xec, 0x57, 0xe5, 0x87, 0x66, 0xee, 0xc3, 0x46, 0x87, 0x38, 0x24, 0xc2, 0x7c, 0x2f,
-	0xa5, 0x6f, 0x8a, 0xc2, 0x32, 0x56, 0xde, 0x08, 0xd6, 0x65, 0x18, 0xe4, 0x77, 0xaf, 0x38, 0x61,
-	0x3c, 0xe5, 0x92, 0x8c, 0x60, 0x02, 0xea, 0x3b, 0x59, 0xa0, 0xa1, 0x1c, 0x5a, 0x8f, 0x3c, 0xdc,
-	0xe2, 0xfb, 0x98, 0x05, 0x35, 0xe9, 0x19, 0x59, 0xbb, 0x9f, 0x11, 0x1d, 0xca, 0x21, 0x50, 0xe1,
-	0x36, 0xa8, 0x43, 0x52, 0xca, 0x7a, 0x06, 0xc8, 0xe8, 0xae, 0x17, 0xb0, 0x2a, 0xfb, 0x05, 0x8f,
-	0xf2, 0x66, 0x6a, 0x3b, 0x71, 0x06, 0xc2, 0x7d, 0xa8, 0xbc, 0x98, 0x10, 0x86, 0x05, 0x91, 0xfe,
-	0xf2, 0x78, 0x93, 0x2b, 0x2b, 0x86, 0xca, 0xfc, 0x16, 0x81, 0x1e, 0x91, 0x27, 0xf8, 0x02, 0x27,
-	0xcc, 0x00, 0x8b, 0xcf, 0xb6, 0x30, 0x2e, 0x7c, 0x78, 0xaa, 0x79, 0x69, 0xd8, 0x42, 0x01, 0xcf,
-	0xf2, 0x0c, 0x02, 0x0a, 0x17, 0x7e, 0x0b, 0xfa, 0x5b, 0xdf, 0x63, 0xf6, 0xa1, 0xed, 0x90, 0x21,
-	0x49, 0xa9, 0x80, 0x38, 0x2c, 0xa3, 0x8b, 0x06, 0x50, 0x54, 0xc2, 0x3b, 0x0c, 0xbb, 0x02, 0x2d,
-	0x32, 0xcd, 0x43, 0x04, 0xb4, 0xcd, 0xd3, 0x81, 0x7a, 0x13, 0x26, 0x80, 0x2c, 0x8b, 0x3d, 0xea,
-	0xd8, 0xe6, 0x49, 0xbc, 0xd9, 0xd1, 0x47, 0xc3, 0x0c, 0x92, 0xd2, 0xec, 0x24, 0x22, 0xb5, 0xc8,
-	0x00, 0x8a, 0xed, 0x11, 0x31, 0x0f, 0x9e, 0x13, 0xec, 0x88, 0x51, 0xda, 0xe3, 0x68, 0x86, 0x58,
-	0xbc, 0x91, 0x08, 0x30, 0xd0, 0xd8, 0x7e, 0xfc, 0xc3, 0xa3, 0xa1, 0x2d, 0x46, 0xd3, 0x81, 0x74,
-	0xe3, 0xa6, 0x82, 0xde, 0xb7, 0xa9, 0xff, 0x6b, 0x33, 0x30, 0x70, 0xd3, 0xa3, 0xda, 0xd4, 0x45,
-	0x3a, 0x19, 0x0c, 0x96, 0xbd, 0xa9, 0x87, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x68, 0x68, 0x82,
-	0xf8, 0x42, 0x19, 0x00, 0x00,
+	// 1720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x72, 0xdb, 0xb6,
+	0x16, 0x8d, 0xa4, 0xf8, 0xb6, 0x25, 0x4b, 0x36, 0x26, 0x17, 0x1d, 0x25, 0xe7, 0x1c, 0x45, 0xb9,
+	0xc9, 0x89, 0x2d, 0xe7, 0x38, 0x33, 0x39, 0x69, 0xde, 0x62, 0x29, 0xe3, 0x68, 0x1a, 0x4f, 0x1c,
+	0x2a, 0xe9, 0xb8, 0x17, 0x8f, 0x02, 0x89, 0x88, 0xcc, 0x31, 0x45, 0x28, 0x04, 0xe4, 0xcb, 0xf4,
+	0xa1, 0xd3, 0x99, 0xbe, 0xf7, 0x9f, 0xda, 0x4f, 0xe9, 0x2f, 0xf4, 0x03, 0x3a, 0x20, 0x48, 0x8a,
+	0x10, 0x09, 0x99, 0x4e, 0xd2, 0xbe, 0x11, 0xc0, 0xc2, 0x5a, 0x1b, 0x7b, 0x6f, 0x6c, 0x00, 0x84,
+	0x15, 0x97, 0x52, 0xde, 0xed, 0x53, 0xea, 0x9a, 0x8d, 0x91, 0x4b, 0x39, 0x45, 0xd7, 0x86, 0x96,
+	0x7d, 0x3c, 0x66, 0xb2, 0xd5, 0x10, 0xc3, 0xde, 0x68, 0xa5, 0xd0, 0xa7, 0xc3, 0x21, 0x75, 0x64,
+	0x7f, 0xa5, 0x10, 0x45, 0x55, 0x8a, 0x96, 0xc3, 0x89, 0xeb, 0x60, 0xdb, 0x6f, 0xe7, 0x47, 0x2e,
+	0x3d, 0x3d, 0xf3, 0x1b, 0x25, 0xc2, 0xfb, 0x66, 0x77, 0x48, 0x38, 0x96, 0x1d, 0xb5, 0x2e, 0x5c,
+	0x7d, 0x6e, 0xdb, 0xb4, 0xff, 0xd6, 0x1a, 0x12, 0xc6, 0xf1, 0x70, 0x64, 0x90, 0x8f, 0x63, 0xc2,
+	0x38, 0x7a, 0x04, 0x97, 0x7b, 0x98, 0x91, 0x72, 0xa6, 0x9a, 0xa9, 0xe7, 0xb7, 0x6e, 0x36, 0x14,
+	0x4b, 0x7c, 0xf9, 0x5d, 0x36, 0xd8, 0xc6, 0x8c, 0x18, 0x1e, 0x12, 0x5d, 0x81, 0xb9, 0x3e, 0x1d,
+	0x3b, 0xbc, 0x9c, 0xab, 0x66, 0xea, 0xcb, 0x86, 0x6c, 0xd4, 0x7e, 0xce, 0xc0, 0xb5, 0x69, 0x05,
+	0x36, 0xa2, 0x0e, 0x23, 0xe8, 0x31, 0xcc, 0x33, 0x8e, 0xf9, 0x98, 0xf9, 0x22, 0x37, 0x12, 0x45,
+	0x3a, 0x1e, 0xc4, 0xf0, 0xa1, 0xe8, 0x26, 0x2c, 0xf1, 0x80, 0xa9, 0x9c, 0xad, 0x66, 0xea, 0x97,
+	0x8d, 0x49, 0x87, 0xc6, 0x86, 0x7d, 0x28, 0x7a, 0x26, 0xb4, 0x5b, 0x5f, 0x60, 0x75, 0xd9, 0x28,
+	0xb3, 0x0d, 0xa5, 0x90, 0xf9, 0x73, 0x56, 0x55, 0x84, 0x6c, 0xbb, 0xe5, 0x51, 0xe7, 0x8c, 0x6c,
+	0xbb, 0xa5, 0x59, 0xc7, 0x6f, 0x59, 0x28, 0xb4, 0x87, 0x23, 0xea, 0x72, 0x83, 0xb0, 0xb1, 0xcd,
+	0x3f, 0x4d, 0xeb, 0x3a, 0x2c, 0x70, 0xcc, 0x8e, 0xba, 0x96, 0xe9, 0x0b, 0xce, 0x8b, 0x66, 0xdb,
+	0x44, 0xff, 0x85, 0xbc, 0x89, 0x39, 0x76, 0xa8, 0x49, 0xc4, 0x60, 0xce, 0x1b, 0x84, 0xa0, 0xab,
+	0x6d, 0xa2, 0x27, 0x30, 0x27, 0x38, 0x48, 0xf9, 0x72, 0x35, 0x53, 0x2f, 0x6e, 0x55, 0x13, 0xd5,
+	0xa4, 0x81, 0x42, 0x93, 0x18, 0x12, 0x8e, 0x2a, 0xb0, 0xc8, 0xc8, 0x60, 0x48, 0x1c, 0xce, 0xca,
+	0x73, 0xd5, 0x5c, 0x3d, 0x67, 0x84, 0x6d, 0xf4, 0x2f, 0x58, 0xc4, 0x63, 0x4e, 0xbb, 0x96, 0xc9,
+	0xca, 0xf3, 0xde, 0xd8, 0x82, 0x68, 0xb7, 0x4d, 0x86, 0x6e, 0xc0, 0x92, 0x4b, 0x4f, 0xba, 0xd2,
+	0x11, 0x0b, 0x9e, 0x35, 0x8b, 0x2e, 0x3d, 0x69, 0x8a, 0x36, 0xfa, 0x3f, 0xcc, 0x59, 0xce, 0x07,
+	0xca, 0xca, 0x8b, 0xd5, 0x5c, 0x3d, 0xbf, 0x75, 0x2b, 0xd1, 0x96, 0xaf, 0xc9, 0xd9, 0x37, 0xd8,
+	0x1e, 0x93, 0x3d, 0x6c, 0xb9, 0x86, 0xc4, 0xd7, 0x7e, 0xcd, 0xc0, 0xf5, 0x16, 0x61, 0x7d, 0xd7,
+	0xea, 0x91, 0x8e, 0x6f, 0xc5, 0xa7, 0xa7, 0x45, 0x0d, 0x0a, 0x7d, 0x6a, 0xdb, 0xa4, 0xcf, 0x2d,
+	0xea, 0x84, 0x21, 0x54, 0xfa, 0xd0, 0x7f, 0x00, 0xfc, 0xe5, 0xb6, 0x5b, 0xac, 0x9c, 0xf3, 0x16,
+	0x19, 0xe9, 0xa9, 0x8d, 0xa1, 0xe4, 0x1b, 0x22, 0x88, 0xdb, 0xce, 0x07, 0x1a, 0xa3, 0xcd, 0x24,
+	0xd0, 0x56, 0x21, 0x3f, 0xc2, 0x2e, 0xb7, 0x14, 0xe5, 0x68, 0x97, 0xd8, 0x2b, 0xa1, 0x8c, 0x1f,
+	0xce, 0x49, 0x47, 0xed, 0x8f, 0x2c, 0x14, 0x7c, 0x5d, 0xa1, 0xc9, 0x50, 0x0b, 0x96, 0xc4, 0x9a,
+	0xba, 0xc2, 0x4f, 0xbe, 0x0b, 0xee, 0x37, 0x92, 0x2b, 0x50, 0x63, 0xca, 0x60, 0x63, 0xb1, 0x17,
+	0x98, 0xde, 0x82, 0xbc, 0xe5, 0x98, 0xe4, 0xb4, 0x2b, 0xc3, 0x93, 0xf5, 0xc2, 0x73, 0x5b, 0xe5,
+	0x11, 0x55, 0xa8, 0x11, 0x6a, 0x9b, 0xe4, 0xd4, 0xe3, 0x00, 0x2b, 0xf8, 0x64, 0x88, 0xc0, 0x2a,
+	0x39, 0xe5, 0x2e, 0xee, 0x46, 0xb9, 0x72, 0x1e, 0xd7, 0x57, 0xe7, 0xd8, 0xe4, 0x11, 0x34, 0x5e,
+	0x88, 0xd9, 0x21, 0x37, 0x7b, 0xe1, 0x70, 0xf7, 0xcc, 0x28, 0x11, 0xb5, 0xb7, 0xf2, 0x1e, 0xae,
+	0x24, 0x01, 0xd1, 0x0a, 0xe4, 0x8e, 0xc8, 0x99, 0xef, 0x76, 0xf1, 0x89, 0xb6, 0x60, 0xee, 0x58,
+	0xa4, 0x52, 0x39, 0x9b, 0x94, 0x1b, 0xde, 0x82, 0x26, 0x2b, 0x91, 0xd0, 0x67, 0xd9, 0xa7, 0x99,
+	0xda, 0xef, 0x59, 0x28, 0xc7, 0xd3, 0xed, 0x73, 0x6a, 0x45, 0x9a, 0x94, 0x1b, 0xc0, 0xb2, 0x1f,
+	0x68, 0xc5, 0x75, 0xdb, 0x3a, 0xd7, 0xe9, 0x2c, 0x54, 0x7c, 0x2a, 0x7d, 0x58, 0x60, 0x91, 0xae,
+	0x0a, 0x81, 0xd5, 0x18, 0x24, 0xc1, 0x7b, 0xcf, 0x54, 0xef, 0xdd, 0x49, 0x13, 0xc2, 0xa8, 0x17,
+	0x4d, 0xb8, 0xb2, 0x43, 0x78, 0xd3, 0x25, 0x26, 0x71, 0xb8, 0x85, 0xed, 0x4f, 0xdf, 0xb0, 0x15,
+	0x58, 0x1c, 0x33, 0x71, 0x3e, 0x0e, 0xa5, 0x31, 0x4b, 0x46, 0xd8, 0xae, 0xfd, 0x92, 0x81, 0xab,
+	0x53, 0x32, 0x9f, 0x13, 0xa8, 0x19, 0x52, 0x62, 0x6c, 0x84, 0x19, 0x3b, 0xa1, 0xae, 0x2c, 0xb4,
+	0x4b, 0x46, 0xd8, 0xde, 0xfa, 0x73, 0x0d, 0x96, 0x0c, 0x4a, 0x79, 0x53, 0xb8, 0x04, 0xd9, 0x80,
+	0x84, 0x4d, 0x74, 0x38, 0xa2, 0x0e, 0x71, 0x64, 0x61, 0x65, 0xa8, 0xa1, 0x1a, 0xe0, 0x37, 0xe2,
+	0x40, 0xdf, 0x51, 0x95, 0x3b, 0x89, 0xf8, 0x29, 0x70, 0xed, 0x12, 0x1a, 0x7a, 0x6a, 0xe2, 0xac,
+	0x7e, 0x6b, 0xf5, 0x8f, 0x9a, 0x87, 0xd8, 0x71, 0x88, 0x8d, 0x1e, 0xa9, 0xb3, 0xc3, 0x1b, 0x46,
+	0x1c, 0x1a, 0xe8, 0xdd, 0x4e, 0xd4, 0xeb, 0x70, 0xd7, 0x72, 0x06, 0x81, 0x57, 0x6b, 0x97, 0xd0,
+	0x47, 0x2f, 0xae, 0x42, 0xdd, 0x62, 0xdc, 0xea, 0xb3, 0x40, 0x70, 0x4b, 0x2f, 0x18, 0x03, 0x5f,
+	0x50, 0xb2, 0x0b, 0x2b, 0x4d, 0x97, 0x60, 0x4e, 0x9a, 0xe1, 0x86, 0x41, 0xeb, 0xc9, 0xde, 0x99,
+	0x82, 0x05, 0x42, 0xb3, 0x82, 0x5f, 0xbb, 0x84, 0xbe, 0x87, 0x62, 0xcb, 0xa5, 0xa3, 0x08, 0xfd,
+	0x83, 0x44, 0x7a, 0x15, 0x94, 0x92, 0xbc, 0x0b, 0xcb, 0x2f, 0x31, 0x8b, 0x70, 0xaf, 0x25, 0x72,
+	0x2b, 0x98, 0x80, 0xfa, 0x56, 0x22, 0x74, 0x9b, 0x52, 0x3b, 0xe2, 0x9e, 0x13, 0x40, 0x41, 0x31,
+	0x88, 0xa8, 0x24, 0xa7, 0x5b, 0x1c, 0x18, 0x48, 0x6d, 0xa6, 0xc6, 0x87, 0xc2, 0x3f, 0x41, 0x25,
+	0x3e, 0xde, 0xf6, 0x03, 0xff, 0x4f, 0x18, 0xf0, 0x0e, 0xf2, 0x32, 0xe2, 0xcf, 0x6d, 0x0b, 0x33,
+	0x74, 0x7f, 0x46, 0x4e, 0x78, 0x88, 0x94, 0x11, 0x7b, 0x03, 0x4b, 0x22, 0xd2, 0x92, 0xf4, 0xae,
+	0x36, 0x13, 0x2e, 0x42, 0xd9, 0x01, 0x78, 0x6e, 0x73, 0xe2, 0x4a, 0xce, 0x7b, 0x89, 0x9c, 0x13,
+	0x40, 0x4a, 0x52, 0x07, 0x4a, 0x9d, 0x43, 0x7a, 0x32, 0x71, 0x0d, 0x43, 0x0f, 0x93, 0x77, 0x94,
+	0x8a, 0x0a, 0xe8, 0xd7, 0xd3, 0x81, 0x43, 0x77, 0x1f, 0x88, 0xab, 0x33, 0x27, 0xee, 0x64, 0x54,
+	0xa3, 0x37, 0x85, 0x4a, 0xb9, 0x9c, 0x03, 0x28, 0xc9, 0x58, 0xed, 0x05, 0x17, 0x22, 0x0d, 0xfd,
+	0x14, 0x2a, 0x25, 0xfd, 0xb7, 0xb0, 0x2c, 0xa2, 0x36, 0x21, 0x5f, 0xd3, 0x46, 0xf6, 0xa2, 0xd4,
+	0x07, 0x50, 0x78, 0x89, 0xd9, 0x84, 0xb9, 0xae, 0xdb, 0xe1, 0x31, 0xe2, 0x54, 0x1b, 0xfc, 0x08,
+	0x8a, 0x22, 0x28, 0xe1, 0x64, 0xa6, 0x29, 0x4f, 0x2a, 0x28, 0x90, 0x78, 0x98, 0x0a, 0x1b, 0x8a,
+	0x31, 0xb8, 0xa6, 0x8e, 0x85, 0x1b, 0xfa, 0x6f, 0x14, 0x25, 0x50, 0x10, 0x63, 0xc1, 0x5d, 0x46,
+	0xe3, 0xc0, 0x28, 0x24, 0x10, 0x5a, 0x4b, 0x81, 0x8c, 0x9c, 0x5d, 0x45, 0xf5, 0x61, 0x8b, 0x36,
+	0x74, 0xd7, 0x9a, 0xc4, 0x27, 0x76, 0xa5, 0x91, 0x16, 0x1e, 0x4a, 0xfe, 0x00, 0x0b, 0xfe, 0x73,
+	0x13, 0xdd, 0x9b, 0x39, 0x39, 0x7c, 0xe9, 0x56, 0xee, 0x9f, 0x8b, 0x0b, 0xd9, 0x31, 0x5c, 0x7d,
+	0x37, 0x32, 0xc5, 0x91, 0x27, 0x0f, 0xd6, 0xe0, 0x68, 0x47, 0x6b, 0x9a, 0xd3, 0x78, 0x0a, 0xb7,
+	0xcb, 0x06, 0xe7, 0xe5, 0xb6, 0x0b, 0xff, 0x6e, 0x3b, 0xc7, 0xd8, 0xb6, 0x4c, 0xe5, 0x64, 0xdd,
+	0x25, 0x1c, 0x37, 0x71, 0xff, 0x90, 0x4c, 0x1f, 0xfc, 0xf2, 0xdf, 0x85, 0x3a, 0x25, 0x04, 0xa7,
+	0xdc, 0x4f, 0x3f, 0x02, 0x92, 0x55, 0xc8, 0xf9, 0x60, 0x0d, 0xc6, 0x2e, 0x96, 0x49, 0xaf, 0xbb,
+	0xd2, 0xc4, 0xa1, 0x81, 0xcc, 0xff, 0x2e, 0x30, 0x23, 0x72, 0xdb, 0x80, 0x1d, 0xc2, 0x77, 0x09,
+	0x77, 0xad, 0xbe, 0xae, 0x54, 0x4f, 0x00, 0x9a, 0xa0, 0x25, 0xe0, 0x42, 0x81, 0x0e, 0xcc, 0xcb,
+	0x17, 0x37, 0xaa, 0x25, 0x4e, 0x0a, 0xfe, 0x17, 0xcc, 0xba, 0x23, 0x05, 0x98, 0x68, 0x8d, 0xd8,
+	0x21, 0x3c, 0xf2, 0x92, 0xd7, 0x6c, 0x57, 0x15, 0x34, 0x7b, 0xbb, 0x4e, 0x63, 0x43, 0x31, 0x07,
+	0x4a, 0xaf, 0x2c, 0xe6, 0x0f, 0xbe, 0xc5, 0xec, 0x48, 0x77, 0xf0, 0x4c, 0xa1, 0x66, 0x1f, 0x3c,
+	0x31, 0x70, 0xc4, 0x63, 0x05, 0x83, 0x88, 0x01, 0xdf, 0x6f, 0xda, 0xc7, 0x48, 0xf4, 0x57, 0xcb,
+	0x79, 0x49, 0xb6, 0x1f, 0xde, 0x2a, 0xc3, 0xc7, 0x03, 0xba, 0xab, 0x49, 0x98, 0x09, 0x44, 0xbc,
+	0x73, 0x52, 0x30, 0xfb, 0xbb, 0xf2, 0x4b, 0x33, 0x77, 0x61, 0xa5, 0x45, 0x6c, 0xa2, 0x30, 0xaf,
+	0x6b, 0xee, 0x4d, 0x2a, 0x2c, 0xe5, 0xce, 0x3b, 0x84, 0x65, 0x11, 0x06, 0x31, 0xef, 0x1d, 0x23,
+	0x2e, 0xd3, 0x1c, 0x92, 0x0a, 0x26, 0xa0, 0x7e, 0x90, 0x06, 0x1a, 0xc9, 0xa1, 0x65, 0xe5, 0xe1,
+	0x86, 0xd6, 0x75, 0x41, 0x4d, 0x7a, 0x46, 0x56, 0x36, 0x52, 0xa2, 0x23, 0x39, 0x04, 0x32, 0xdc,
+	0x06, 0xb5, 0x89, 0x66, 0x5b, 0x4f, 0x00, 0x29, 0xdd, 0xf5, 0x1a, 0x16, 0xc5, 0x7d, 0xc1, 0xa3,
+	0xbc, 0xa3, 0xbd, 0x4e, 0x5c, 0x80, 0xf0, 0x00, 0x4a, 0xaf, 0x47, 0xc4, 0xc5, 0x9c, 0x08, 0x7f,
+	0x79, 0xbc, 0xc9, 0x3b, 0x6b, 0x0a, 0x95, 0xfa, 0x2d, 0x02, 0x1d, 0x22, 0x2a, 0xf8, 0x0c, 0x27,
+	0x4c, 0x00, 0xb3, 0x6b, 0x5b, 0x14, 0x17, 0x2d, 0x9e, 0xb2, 0x5f, 0x18, 0x36, 0x53, 0xc0, 0xb3,
+	0x3c, 0x85, 0x80, 0xc4, 0x45, 0xdf, 0x82, 0xfe, 0xd2, 0xf7, 0x5c, 0xeb, 0xd8, 0xb2, 0xc9, 0x80,
+	0x68, 0x76, 0xc0, 0x34, 0x2c, 0xa5, 0x8b, 0x7a, 0x90, 0x97, 0xc2, 0x3b, 0x2e, 0x76, 0x38, 0x9a,
+	0x65, 0x9a, 0x87, 0x08, 0x68, 0xeb, 0xe7, 0x03, 0xc3, 0x45, 0xf4, 0x01, 0xc4, 0xb6, 0xd8, 0xa3,
+	0xb6, 0xd5, 0x3f, 0x43, 0x75, 0x4d, 0x69, 0x98, 0x40, 0x34, 0x97, 0x9d, 0x44, 0x64, 0x28, 0x62,
+	0x41, 0x51, 0xf4, 0x8b, 0x00, 0xbd, 0x19, 0x53, 0x8e, 0x63, 0x7b, 0x59, 0x9e, 0xd4, 0x2a, 0x46,
+	0xb3, 0x97, 0x93, 0xa1, 0xa1, 0xd4, 0x3e, 0x2c, 0x77, 0xf0, 0x31, 0x09, 0xc7, 0x50, 0x3d, 0x69,
+	0xba, 0x02, 0x49, 0x19, 0x8d, 0x7d, 0x79, 0x69, 0x3f, 0x87, 0x59, 0x81, 0xa4, 0x64, 0x1e, 0x03,
+	0x12, 0xeb, 0x69, 0x61, 0x8e, 0xc5, 0x5f, 0x26, 0xdf, 0x45, 0x1b, 0xba, 0x75, 0xab, 0x38, 0xcd,
+	0x7d, 0x50, 0x0f, 0x0f, 0x5d, 0xf5, 0x1e, 0x56, 0x85, 0x1f, 0x94, 0x71, 0xb4, 0x9e, 0x44, 0x13,
+	0x83, 0xa5, 0x5c, 0xd8, 0x7b, 0x58, 0x15, 0xfe, 0x48, 0xa1, 0x10, 0x83, 0xa5, 0x54, 0x70, 0x61,
+	0xd5, 0x4b, 0x05, 0x82, 0xcd, 0xd7, 0x8e, 0x7d, 0xb6, 0x4b, 0x4d, 0xc2, 0x92, 0x15, 0x62, 0x30,
+	0x4d, 0xf9, 0xd6, 0xa2, 0xa3, 0x29, 0xf6, 0x42, 0xa4, 0x7b, 0x30, 0x9e, 0x9c, 0x08, 0x0a, 0x24,
+	0x7d, 0x8a, 0xbd, 0x22, 0x22, 0x35, 0x67, 0x32, 0x2b, 0x90, 0xd4, 0xd7, 0x58, 0xf1, 0xab, 0xac,
+	0x65, 0xda, 0xb2, 0x14, 0x59, 0xd4, 0x91, 0x37, 0xb3, 0xcd, 0x24, 0x81, 0x24, 0x64, 0xa0, 0xf3,
+	0x28, 0xfd, 0x84, 0xd0, 0x61, 0x3d, 0xc8, 0x37, 0x0f, 0x49, 0xff, 0xe8, 0x25, 0xc1, 0x36, 0x3f,
+	0xd4, 0xfd, 0x1b, 0x99, 0x20, 0x66, 0xd7, 0x31, 0x05, 0x18, 0x68, 0x6c, 0x3f, 0xfd, 0xee, 0xc9,
+	0xc0, 0xe2, 0x87, 0xe3, 0x9e, 0x58, 0xfa, 0xa6, 0x84, 0x6e, 0x58, 0xd4, 0xff, 0xda, 0x0c, 0xea,
+	0xd3, 0xa6, 0x47, 0xb5, 0x19, 0x9e, 0xd1, 0xa3, 0x5e, 0x6f, 0xde, 0xeb, 0x7a, 0xfc, 0xd7, 0x00,
+	0xf2, 0x0e, 0x39, 0xfd, 0x41, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -881,6 +891,16 @@ type RootCoordClient interface {
 	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
+	ListRoleQuotas(ctx context.Context, in *proxypb.ListRoleQuotasRequest, opts ...grpc.CallOption) (*proxypb.ListRoleQuotasResponse, error)
+	SaveRoleQuota(ctx context.Context, in *proxypb.SaveRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRoleQuota(ctx context.Context, in *proxypb.DropRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabaseQuotas(ctx context.Context, in *proxypb.ListDatabaseQuotasRequest, opts ...grpc.CallOption) (*proxypb.ListDatabaseQuotasResponse, error)
+	SaveDatabaseQuota(ctx context.Context, in *proxypb.SaveDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabaseQuota(ctx context.Context, in *proxypb.DropDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListReadOnlyModes(ctx context.Context, in *proxypb.ListReadOnlyModesRequest, opts ...grpc.CallOption) (*proxypb.ListReadOnlyModesResponse, error)
+	EnterReadOnly(ctx context.Context, in *proxypb.EnterReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	LeaveReadOnly(ctx context.Context, in *proxypb.LeaveReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetDdlOperationState(ctx context.Context, in *proxypb.GetDdlOperationStateRequest, opts ...grpc.CallOption) (*proxypb.GetDdlOperationStateResponse, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
 }
 
@@ -1270,6 +1290,96 @@ func (c *rootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPol
 	return out, nil
 }
 
+func (c *rootCoordClient) ListRoleQuotas(ctx context.Context, in *proxypb.ListRoleQuotasRequest, opts ...grpc.CallOption) (*proxypb.ListRoleQuotasResponse, error) {
+	out := new(proxypb.ListRoleQuotasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListRoleQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SaveRoleQuota(ctx context.Context, in *proxypb.SaveRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SaveRoleQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRoleQuota(ctx context.Context, in *proxypb.DropRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRoleQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabaseQuotas(ctx context.Context, in *proxypb.ListDatabaseQuotasRequest, opts ...grpc.CallOption) (*proxypb.ListDatabaseQuotasResponse, error) {
+	out := new(proxypb.ListDatabaseQuotasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabaseQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SaveDatabaseQuota(ctx context.Context, in *proxypb.SaveDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SaveDatabaseQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabaseQuota(ctx context.Context, in *proxypb.DropDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabaseQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListReadOnlyModes(ctx context.Context, in *proxypb.ListReadOnlyModesRequest, opts ...grpc.CallOption) (*proxypb.ListReadOnlyModesResponse, error) {
+	out := new(proxypb.ListReadOnlyModesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListReadOnlyModes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) EnterReadOnly(ctx context.Context, in *proxypb.EnterReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/EnterReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) LeaveReadOnly(ctx context.Context, in *proxypb.LeaveReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/LeaveReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetDdlOperationState(ctx context.Context, in *proxypb.GetDdlOperationStateRequest, opts ...grpc.CallOption) (*proxypb.GetDdlOperationStateResponse, error) {
+	out := new(proxypb.GetDdlOperationStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetDdlOperationState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	out := new(milvuspb.CheckHealthResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CheckHealth", in, out, opts...)
@@ -1375,6 +1485,16 @@ type RootCoordServer interface {
 	OperatePrivilege(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+	ListRoleQuotas(context.Context, *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error)
+	SaveRoleQuota(context.Context, *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error)
+	DropRoleQuota(context.Context, *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error)
+	ListDatabaseQuotas(context.Context, *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error)
+	SaveDatabaseQuota(context.Context, *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error)
+	DropDatabaseQuota(context.Context, *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error)
+	ListReadOnlyModes(context.Context, *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error)
+	EnterReadOnly(context.Context, *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error)
+	LeaveReadOnly(context.Context, *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error)
+	GetDdlOperationState(context.Context, *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 }
 
@@ -1508,6 +1628,36 @@ func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvu
 func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
 }
+func (*UnimplementedRootCoordServer) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleQuotas not implemented")
+}
+func (*UnimplementedRootCoordServer) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRoleQuota not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRoleQuota not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabaseQuotas not implemented")
+}
+func (*UnimplementedRootCoordServer) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDatabaseQuota not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabaseQuota not implemented")
+}
+func (*UnimplementedRootCoordServer) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReadOnlyModes not implemented")
+}
+func (*UnimplementedRootCoordServer) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterReadOnly not implemented")
+}
+func (*UnimplementedRootCoordServer) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveReadOnly not implemented")
+}
+func (*UnimplementedRootCoordServer) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDdlOperationState not implemented")
+}
 func (*UnimplementedRootCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
 }
@@ -2272,6 +2422,186 @@ func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_ListRoleQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.ListRoleQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListRoleQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListRoleQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListRoleQuotas(ctx, req.(*proxypb.ListRoleQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SaveRoleQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.SaveRoleQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SaveRoleQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SaveRoleQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SaveRoleQuota(ctx, req.(*proxypb.SaveRoleQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRoleQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.DropRoleQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRoleQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRoleQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRoleQuota(ctx, req.(*proxypb.DropRoleQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabaseQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.ListDatabaseQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabaseQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabaseQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabaseQuotas(ctx, req.(*proxypb.ListDatabaseQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SaveDatabaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.SaveDatabaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SaveDatabaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SaveDatabaseQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SaveDatabaseQuota(ctx, req.(*proxypb.SaveDatabaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.DropDatabaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabaseQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabaseQuota(ctx, req.(*proxypb.DropDatabaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListReadOnlyModes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.ListReadOnlyModesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListReadOnlyModes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListReadOnlyModes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListReadOnlyModes(ctx, req.(*proxypb.ListReadOnlyModesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_EnterReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.EnterReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).EnterReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/EnterReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).EnterReadOnly(ctx, req.(*proxypb.EnterReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_LeaveReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.LeaveReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).LeaveReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/LeaveReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).LeaveReadOnly(ctx, req.(*proxypb.LeaveReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetDdlOperationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.GetDdlOperationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetDdlOperationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetDdlOperationState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetDdlOperationState(ctx, req.(*proxypb.GetDdlOperationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CheckHealthRequest)
 	if err := dec(in); err != nil {
@@ -2462,6 +2792,46 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPolicy",
 			Handler:    _RootCoord_ListPolicy_Handler,
 		},
+		{
+			MethodName: "ListRoleQuotas",
+			Handler:    _RootCoord_ListRoleQuotas_Handler,
+		},
+		{
+			MethodName: "SaveRoleQuota",
+			Handler:    _RootCoord_SaveRoleQuota_Handler,
+		},
+		{
+			MethodName: "DropRoleQuota",
+			Handler:    _RootCoord_DropRoleQuota_Handler,
+		},
+		{
+			MethodName: "ListDatabaseQuotas",
+			Handler:    _RootCoord_ListDatabaseQuotas_Handler,
+		},
+		{
+			MethodName: "SaveDatabaseQuota",
+			Handler:    _RootCoord_SaveDatabaseQuota_Handler,
+		},
+		{
+			MethodName: "DropDatabaseQuota",
+			Handler:    _RootCoord_DropDatabaseQuota_Handler,
+		},
+		{
+			MethodName: "ListReadOnlyModes",
+			Handler:    _RootCoord_ListReadOnlyModes_Handler,
+		},
+		{
+			MethodName: "EnterReadOnly",
+			Handler:    _RootCoord_EnterReadOnly_Handler,
+		},
+		{
+			MethodName: "LeaveReadOnly",
+			Handler:    _RootCoord_LeaveReadOnly_Handler,
+		},
+		{
+			MethodName: "GetDdlOperationState",
+			Handler:    _RootCoord_GetDdlOperationState_Handler,
+		},
 		{
 			MethodName: "CheckHealth",
 			Handler:    _RootCoord_CheckHealth_Handler,
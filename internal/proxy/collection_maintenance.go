@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// PauseCollectionMaintenance forwards the request to DataCoord, which pauses the compaction and/or the binlog gc of a
+// collection. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	if !node.checkHealthy() {
+		return &datapb.PauseCollectionMaintenanceResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "PauseCollectionMaintenance"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("scope", req.GetScope().String()),
+		zap.String("reason", req.GetReason()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.dataCoord.PauseCollectionMaintenance(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.PauseCollectionMaintenanceResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", resp.GetStatus().GetErrorCode().String()))
+	return resp, nil
+}
+
+// ResumeCollectionMaintenance forwards the request to DataCoord, which resumes the compaction and/or the binlog gc of
+// a collection. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "ResumeCollectionMaintenance"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("scope", req.GetScope().String()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.dataCoord.ResumeCollectionMaintenance(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
+
+// GetCollectionMaintenancePauses forwards the request to DataCoord, which returns the collections whose compaction or
+// binlog gc is paused. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	if !node.checkHealthy() {
+		return &datapb.GetCollectionMaintenancePausesResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetCollectionMaintenancePauses"
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole))
+	log.Debug(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.dataCoord.GetCollectionMaintenancePauses(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.GetCollectionMaintenancePausesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("pauses", len(resp.GetPauses())))
+	return resp, nil
+}
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestProxy_CollectionMaintenance(t *testing.T) {
+	ctx := context.Background()
+	dataCoord := NewDataCoordMock()
+	node := &Proxy{dataCoord: dataCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	dataCoord.pauseMaintenanceFunc = func(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &datapb.PauseCollectionMaintenanceResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Pause:  &datapb.CollectionMaintenancePause{CollectionID: req.GetCollectionID(), Compaction: true},
+		}, nil
+	}
+	pauseResp, err := node.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{
+		CollectionID: 1,
+		Scope:        datapb.MaintenanceScope_MaintenanceCompaction,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, pauseResp.GetStatus().GetErrorCode())
+	assert.True(t, pauseResp.GetPause().GetCompaction())
+
+	dataCoord.resumeMaintenanceFunc = func(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+		assert.NotNil(t, req.GetBase())
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	status, err := node.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	listResp, err := node.GetCollectionMaintenancePauses(ctx, &datapb.GetCollectionMaintenancePausesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+
+	dataCoord.pauseMaintenanceFunc = func(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+		return nil, errors.New("mock")
+	}
+	dataCoord.resumeMaintenanceFunc = func(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+		return nil, errors.New("mock")
+	}
+	pauseResp, err = node.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, pauseResp.GetStatus().GetErrorCode())
+	status, err = node.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	for _, req := range []proto.Message{
+		&datapb.PauseCollectionMaintenanceRequest{},
+		&datapb.ResumeCollectionMaintenanceRequest{},
+		&datapb.GetCollectionMaintenancePausesRequest{},
+	} {
+		privilegeExt, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+		assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+	}
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	pauseResp, err = node.PauseCollectionMaintenance(ctx, &datapb.PauseCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, pauseResp.GetStatus().GetErrorCode())
+	status, err = node.ResumeCollectionMaintenance(ctx, &datapb.ResumeCollectionMaintenanceRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	listResp, err = node.GetCollectionMaintenancePauses(ctx, &datapb.GetCollectionMaintenancePausesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+}
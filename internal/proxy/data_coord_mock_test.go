@@ -36,12 +36,34 @@ type DataCoordMock struct {
 	state atomic.Value // internal.StateCode
 
 	getMetricsFunc         getMetricsFuncType
+	getWatermarksFunc      func(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error)
+	preImportFunc          func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error)
+	getSegmentHistoryFunc  func(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error)
+	pauseMaintenanceFunc   func(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error)
+	resumeMaintenanceFunc  func(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error)
+	decommissionFunc       func(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error)
+	getDeleteSLAFunc func(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error)
+	getFreezeWindowsFunc func(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error)
+	unfreezeHandoffsFunc func(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error)
+	freezeHandoffsFunc func(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error)
+	getSegmentHeatsFunc func(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error)
+	getSegmentAllocHintsFunc func(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error)
+	mergeTinySegmentsFunc func(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	getSegmentAnomaliesFunc func(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error)
+	compactSegmentsFunc func(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	getHandoffGateFunc  func(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error)
+	inspectSegmentLocksFunc func(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error)
+	locatePrimaryKeysFunc func(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error)
 	showConfigurationsFunc showConfigurationsFuncType
 	statisticsChannel      string
 	timeTickChannel        string
 	checkHealthFunc        func(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 	GetIndexStateFunc      func(ctx context.Context, request *datapb.GetIndexStateRequest) (*datapb.GetIndexStateResponse, error)
 	DescribeIndexFunc      func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error)
+
+	GetSegmentInfoFunc       func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)
+	GetSegmentsByStatesFunc  func(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)
+	GetSegmentIndexStateFunc func(ctx context.Context, req *datapb.GetSegmentIndexStateRequest) (*datapb.GetSegmentIndexStateResponse, error)
 }
 
 func (coord *DataCoordMock) updateState(state commonpb.StateCode) {
@@ -165,6 +187,9 @@ func (coord *DataCoordMock) GetPartitionStatistics(ctx context.Context, req *dat
 }
 
 func (coord *DataCoordMock) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	if coord.GetSegmentInfoFunc != nil {
+		return coord.GetSegmentInfoFunc(ctx, req)
+	}
 	panic("implement me")
 }
 
@@ -181,6 +206,9 @@ func (coord *DataCoordMock) GetFlushedSegments(ctx context.Context, req *datapb.
 }
 
 func (coord *DataCoordMock) GetSegmentsByStates(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+	if coord.GetSegmentsByStatesFunc != nil {
+		return coord.GetSegmentsByStatesFunc(ctx, req)
+	}
 	panic("implement me")
 }
 
@@ -266,6 +294,17 @@ func (coord *DataCoordMock) Import(ctx context.Context, req *datapb.ImportTaskRe
 	return &datapb.ImportTaskResponse{}, nil
 }
 
+func (coord *DataCoordMock) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	if coord.preImportFunc != nil {
+		return coord.preImportFunc(ctx, req)
+	}
+	return &datapb.PreImportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
 func (coord *DataCoordMock) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -280,6 +319,223 @@ func (coord *DataCoordMock) UpdateChannelCheckpoint(ctx context.Context, req *da
 	}, nil
 }
 
+func (coord *DataCoordMock) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	return &datapb.UpdateChannelCheckpointsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	if coord.getWatermarksFunc != nil {
+		return coord.getWatermarksFunc(ctx, req)
+	}
+	return &datapb.GetTimeTravelWatermarksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (coord *DataCoordMock) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error) {
+	if coord.getSegmentHistoryFunc != nil {
+		return coord.getSegmentHistoryFunc(ctx, req)
+	}
+	return &datapb.GetSegmentHistoryResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	if coord.pauseMaintenanceFunc != nil {
+		return coord.pauseMaintenanceFunc(ctx, req)
+	}
+	return &datapb.PauseCollectionMaintenanceResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error) {
+	if coord.resumeMaintenanceFunc != nil {
+		return coord.resumeMaintenanceFunc(ctx, req)
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (coord *DataCoordMock) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return &datapb.GetCollectionMaintenancePausesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	if coord.decommissionFunc != nil {
+		return coord.decommissionFunc(ctx, req)
+	}
+	return &datapb.DecommissionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+	if coord.locatePrimaryKeysFunc != nil {
+		return coord.locatePrimaryKeysFunc(ctx, req)
+	}
+	return &datapb.LocatePrimaryKeysResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *DataCoordMock) RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *DataCoordMock) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *DataCoordMock) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error) {
+	if coord.inspectSegmentLocksFunc != nil {
+		return coord.inspectSegmentLocksFunc(ctx, req)
+	}
+	return &datapb.InspectSegmentLocksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	if coord.compactSegmentsFunc != nil {
+		return coord.compactSegmentsFunc(ctx, req)
+	}
+	return &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+	if coord.getHandoffGateFunc != nil {
+		return coord.getHandoffGateFunc(ctx, req)
+	}
+	return &datapb.GetHandoffGateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error) {
+	if coord.getSegmentAnomaliesFunc != nil {
+		return coord.getSegmentAnomaliesFunc(ctx, req)
+	}
+	return &datapb.GetSegmentAnomaliesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error) {
+	if coord.mergeTinySegmentsFunc != nil {
+		return coord.mergeTinySegmentsFunc(ctx, req)
+	}
+	return &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	if coord.getSegmentAllocHintsFunc != nil {
+		return coord.getSegmentAllocHintsFunc(ctx, req)
+	}
+	return &datapb.GetSegmentAllocHintsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error) {
+	if coord.getSegmentHeatsFunc != nil {
+		return coord.getSegmentHeatsFunc(ctx, req)
+	}
+	return &datapb.GetSegmentHeatsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	if coord.freezeHandoffsFunc != nil {
+		return coord.freezeHandoffsFunc(ctx, req)
+	}
+	return &datapb.FreezeHandoffsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	if coord.unfreezeHandoffsFunc != nil {
+		return coord.unfreezeHandoffsFunc(ctx, req)
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (coord *DataCoordMock) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	if coord.getFreezeWindowsFunc != nil {
+		return coord.getFreezeWindowsFunc(ctx, req)
+	}
+	return &datapb.GetFreezeWindowsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error) {
+	if coord.getDeleteSLAFunc != nil {
+		return coord.getDeleteSLAFunc(ctx, req)
+	}
+	return &datapb.GetDeleteSLAResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
 func (coord *DataCoordMock) CreateIndex(ctx context.Context, req *datapb.CreateIndexRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -307,6 +563,9 @@ func (coord *DataCoordMock) GetIndexState(ctx context.Context, req *datapb.GetIn
 
 // GetSegmentIndexState gets the index state of the segments in the request from RootCoord.
 func (coord *DataCoordMock) GetSegmentIndexState(ctx context.Context, req *datapb.GetSegmentIndexStateRequest) (*datapb.GetSegmentIndexStateResponse, error) {
+	if coord.GetSegmentIndexStateFunc != nil {
+		return coord.GetSegmentIndexStateFunc(ctx, req)
+	}
 	return &datapb.GetSegmentIndexStateResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -327,6 +586,9 @@ func (coord *DataCoordMock) GetIndexInfos(ctx context.Context, req *datapb.GetIn
 
 // DescribeIndex describe the index info of the collection.
 func (coord *DataCoordMock) DescribeIndex(ctx context.Context, req *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+	if coord.DescribeIndexFunc != nil {
+		return coord.DescribeIndexFunc(ctx, req)
+	}
 	return &datapb.DescribeIndexResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -344,6 +606,14 @@ func (coord *DataCoordMock) GetIndexBuildProgress(ctx context.Context, req *data
 	}, nil
 }
 
+func (coord *DataCoordMock) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return &datapb.RebuildDeprecatedIndexesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
 func NewDataCoordMock() *DataCoordMock {
 	return &DataCoordMock{
 		nodeID:            typeutil.UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
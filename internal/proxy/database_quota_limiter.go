@@ -0,0 +1,266 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// databaseQuotaLimiter limits the request rates per database by the quotas of the databases,
+// each database holds a limiter tree of the database itself and of its collections.
+type databaseQuotaLimiter struct {
+	mu  sync.RWMutex
+	dbs map[string]*databaseRateLimiter // db name -> limiters
+}
+
+// databaseRateLimiter holds the limiters of a database, the limiters of the collections are created
+// from the collection rates of the database quota on their first requests.
+type databaseRateLimiter struct {
+	limiters        map[internalpb.RateType]*ratelimitutil.Limiter
+	collectionRates map[internalpb.RateType]float64
+
+	collectionsMu sync.Mutex
+	collections   map[string]map[internalpb.RateType]*ratelimitutil.Limiter // collection name -> limiters
+}
+
+func newDatabaseQuotaLimiter() *databaseQuotaLimiter {
+	return &databaseQuotaLimiter{
+		dbs: make(map[string]*databaseRateLimiter),
+	}
+}
+
+func newLimiters(rates map[internalpb.RateType]float64) map[internalpb.RateType]*ratelimitutil.Limiter {
+	limiters := make(map[internalpb.RateType]*ratelimitutil.Limiter, len(rates))
+	for rt, r := range rates {
+		// use rate as burst, because Limiter is with punishment mechanism, burst is insignificant.
+		limiters[rt] = ratelimitutil.NewLimiter(ratelimitutil.Limit(r), r)
+	}
+	return limiters
+}
+
+// setQuota replaces the limiters of the database, a quota without rates removes the limits of the database.
+func (l *databaseQuotaLimiter) setQuota(quota *model.DatabaseQuota) error {
+	rates, err := quota.GetRateTypes()
+	if err != nil {
+		return err
+	}
+	collectionRates, err := quota.GetCollectionRateTypes()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if quota.Empty() {
+		delete(l.dbs, quota.DbName)
+	} else {
+		l.dbs[quota.DbName] = &databaseRateLimiter{
+			limiters:        newLimiters(rates),
+			collectionRates: collectionRates,
+			collections:     make(map[string]map[internalpb.RateType]*ratelimitutil.Limiter),
+		}
+	}
+	log.Info("RateLimiter set database quota", zap.String("db_name", quota.DbName),
+		zap.Any("rates", quota.Rates), zap.Any("collection_rates", quota.CollectionRates))
+	return nil
+}
+
+func (l *databaseQuotaLimiter) empty() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.dbs) == 0
+}
+
+// getCollectionLimiter returns the limiter of the collection for the rate type, nil if the collections
+// of the database are not limited on the rate type.
+func (db *databaseRateLimiter) getCollectionLimiter(collectionName string, rt internalpb.RateType) *ratelimitutil.Limiter {
+	if _, ok := db.collectionRates[rt]; !ok || collectionName == "" {
+		return nil
+	}
+	db.collectionsMu.Lock()
+	defer db.collectionsMu.Unlock()
+	limiters, ok := db.collections[collectionName]
+	if !ok {
+		limiters = newLimiters(db.collectionRates)
+		db.collections[collectionName] = limiters
+	}
+	return limiters[rt]
+}
+
+// limit returns true if the request to the collection of the database will be rejected, and the rate limiting
+// the request, the requests without a db name belong to the database util.DefaultDBName.
+func (l *databaseQuotaLimiter) limit(dbName, collectionName string, rt internalpb.RateType, n int) (bool, float64) {
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	l.mu.RLock()
+	db, ok := l.dbs[dbName]
+	l.mu.RUnlock()
+	if !ok {
+		return false, float64(ratelimitutil.Inf)
+	}
+
+	now := time.Now()
+	if limiter := db.getCollectionLimiter(collectionName, rt); limiter != nil && !limiter.AllowN(now, n) {
+		return true, float64(limiter.Limit())
+	}
+	if limiter, ok := db.limiters[rt]; ok && !limiter.AllowN(now, n) {
+		return true, float64(limiter.Limit())
+	}
+	return false, float64(ratelimitutil.Inf)
+}
+
+// initDatabaseQuotas loads the quotas of databases from RootCoord, the later changes are refreshed by RootCoord.
+func (node *Proxy) initDatabaseQuotas() error {
+	resp, err := node.rootCoord.ListDatabaseQuotas(node.ctx, &proxypb.ListDatabaseQuotasRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+	})
+	if funcutil.IsGrpcUnimplemented(err) {
+		// RootCoord of the older versions has no database quotas during a rolling upgrade
+		log.Warn("skip loading database quotas from the RootCoord not serving ListDatabaseQuotas", zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	for _, quota := range resp.GetQuotas() {
+		if err := node.multiRateLimiter.databaseQuotaLimiter.setQuota(model.UnmarshalDatabaseQuotaModel(quota)); err != nil {
+			log.Warn("skip invalid database quota", zap.String("db_name", quota.GetDbName()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// RefreshDatabaseQuota applies the quota of a database saved or dropped in RootCoord.
+func (node *Proxy) RefreshDatabaseQuota(ctx context.Context, req *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	quota := model.UnmarshalDatabaseQuotaModel(req.GetQuota())
+	if quota == nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "database quota is required",
+		}, nil
+	}
+	if err := node.multiRateLimiter.databaseQuotaLimiter.setQuota(quota); err != nil {
+		log.Ctx(ctx).Warn("fail to refresh database quota", zap.String("db_name", quota.DbName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+// ListDatabaseQuotas forwards the request to RootCoord, which lists the rate limiting quotas of databases.
+// The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ListDatabaseQuotasResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "ListDatabaseQuotas"
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole))
+	log.Debug(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.ListDatabaseQuotas(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &proxypb.ListDatabaseQuotasResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("quotas", len(resp.GetQuotas())))
+	return resp, nil
+}
+
+// SaveDatabaseQuota forwards the request to RootCoord, which saves the rate limiting quota of a database and
+// refreshes it in proxies. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "SaveDatabaseQuota"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db_name", req.GetQuota().GetDbName()),
+		zap.Any("rates", req.GetQuota().GetRates()),
+		zap.Any("collection_rates", req.GetQuota().GetCollectionRates()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.SaveDatabaseQuota(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
+
+// DropDatabaseQuota forwards the request to RootCoord, which drops the rate limiting quota of a database and
+// removes its limits in proxies. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "DropDatabaseQuota"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db_name", req.GetDbName()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.DropDatabaseQuota(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+func TestDatabaseQuotaLimiter(t *testing.T) {
+	l := newDatabaseQuotaLimiter()
+	assert.True(t, l.empty())
+
+	err := l.setQuota(&model.DatabaseQuota{
+		DbName:          "tenant1",
+		Rates:           map[string]float64{"DQLSearch": 100, "DMLInsert": 0},
+		CollectionRates: map[string]float64{"DQLSearch": 10},
+	})
+	assert.NoError(t, err)
+	err = l.setQuota(&model.DatabaseQuota{DbName: "tenant1", Rates: map[string]float64{"Unknown": 1}})
+	assert.Error(t, err)
+	assert.False(t, l.empty())
+
+	limit, rate := l.limit("tenant1", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.True(t, limit)
+	assert.Equal(t, float64(0), rate)
+
+	// the collections of the database are limited separately by the collection rates
+	limit, _ = l.limit("tenant1", "c1", internalpb.RateType_DQLSearch, math.MaxInt)
+	assert.False(t, limit)
+	limit, rate = l.limit("tenant1", "c1", internalpb.RateType_DQLSearch, 1)
+	assert.True(t, limit)
+	assert.Equal(t, float64(10), rate)
+	limit, rate = l.limit("tenant1", "c2", internalpb.RateType_DQLSearch, 1)
+	assert.True(t, limit)
+	assert.Equal(t, float64(100), rate)
+
+	// the other databases are not limited
+	limit, rate = l.limit("tenant2", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.False(t, limit)
+	assert.Equal(t, float64(ratelimitutil.Inf), rate)
+	limit, _ = l.limit("", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.False(t, limit)
+
+	err = l.setQuota(&model.DatabaseQuota{DbName: util.DefaultDBName, Rates: map[string]float64{"DMLInsert": 0}})
+	assert.NoError(t, err)
+	limit, _ = l.limit("", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.True(t, limit)
+
+	err = l.setQuota(&model.DatabaseQuota{DbName: "tenant1"})
+	assert.NoError(t, err)
+	limit, rate = l.limit("tenant1", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.False(t, limit)
+	assert.Equal(t, float64(ratelimitutil.Inf), rate)
+}
+
+func TestMultiRateLimiter_CheckDatabase(t *testing.T) {
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	m := NewMultiRateLimiter()
+	assert.NoError(t, m.CheckDatabase("tenant1", "c1", internalpb.RateType_DMLInsert, 1))
+
+	err := m.databaseQuotaLimiter.setQuota(&model.DatabaseQuota{
+		DbName:          "tenant1",
+		Rates:           map[string]float64{"DMLInsert": 0},
+		CollectionRates: map[string]float64{"DQLSearch": 10},
+	})
+	assert.NoError(t, err)
+
+	err = m.CheckDatabase("tenant1", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.True(t, errors.Is(err, ErrForceDeny))
+	assert.NoError(t, m.CheckDatabase("tenant2", "c1", internalpb.RateType_DMLInsert, 1))
+	assert.NoError(t, m.CheckDatabase("tenant1", "c1", internalpb.RateType_DQLSearch, math.MaxInt))
+	err = m.CheckDatabase("tenant1", "c1", internalpb.RateType_DQLSearch, 1)
+	assert.True(t, errors.Is(err, ErrRateLimit))
+
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
+	assert.NoError(t, m.CheckDatabase("tenant1", "c1", internalpb.RateType_DMLInsert, 1))
+}
+
+func TestProxy_RefreshDatabaseQuota(t *testing.T) {
+	node := &Proxy{multiRateLimiter: NewMultiRateLimiter()}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	status, err := node.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{
+		Quota: &proxypb.DatabaseQuota{DbName: "tenant1", Rates: map[string]float64{"DMLInsert": 0}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.False(t, node.multiRateLimiter.databaseQuotaLimiter.empty())
+
+	status, err = node.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{
+		Quota: &proxypb.DatabaseQuota{DbName: "tenant1", Rates: map[string]float64{"Unknown": 1}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = node.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	status, err = node.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{
+		Quota: &proxypb.DatabaseQuota{DbName: "tenant1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.True(t, node.multiRateLimiter.databaseQuotaLimiter.empty())
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	status, err = node.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
+
+func TestProxy_InitDatabaseQuotas(t *testing.T) {
+	rc := NewRootCoordMock()
+	defer rc.Stop()
+	node := &Proxy{ctx: context.Background(), rootCoord: rc, multiRateLimiter: NewMultiRateLimiter()}
+
+	rc.listDatabaseQuotasFunc = func(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+		return &proxypb.ListDatabaseQuotasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Quotas: []*proxypb.DatabaseQuota{
+				{DbName: "tenant1", Rates: map[string]float64{"DMLInsert": 0}},
+				{DbName: "invalid", Rates: map[string]float64{"Unknown": 1}},
+			},
+		}, nil
+	}
+	assert.NoError(t, node.initDatabaseQuotas())
+	limit, _ := node.multiRateLimiter.databaseQuotaLimiter.limit("tenant1", "c1", internalpb.RateType_DMLInsert, 1)
+	assert.True(t, limit)
+
+	// the RootCoord of the older versions doesn't serve ListDatabaseQuotas
+	rc.listDatabaseQuotasFunc = func(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+		return nil, grpcStatus.Error(codes.Unimplemented, "unknown method ListDatabaseQuotas")
+	}
+	assert.NoError(t, node.initDatabaseQuotas())
+	rc.listDatabaseQuotasFunc = func(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+		return nil, errors.New("mock error")
+	}
+	assert.Error(t, node.initDatabaseQuotas())
+}
+
+func TestProxy_DatabaseQuotaAPIs(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	defer rc.Stop()
+	node := &Proxy{rootCoord: rc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := node.ListDatabaseQuotas(ctx, &proxypb.ListDatabaseQuotasRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	status, err := node.SaveDatabaseQuota(ctx, &proxypb.SaveDatabaseQuotaRequest{Quota: &proxypb.DatabaseQuota{DbName: "tenant1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = node.DropDatabaseQuota(ctx, &proxypb.DropDatabaseQuotaRequest{DbName: "tenant1"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	for _, req := range []interface{}{&proxypb.ListDatabaseQuotasRequest{}, &proxypb.SaveDatabaseQuotaRequest{}, &proxypb.DropDatabaseQuotaRequest{}} {
+		_, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+	}
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.ListDatabaseQuotas(ctx, &proxypb.ListDatabaseQuotasRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	status, err = node.SaveDatabaseQuota(ctx, &proxypb.SaveDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	status, err = node.DropDatabaseQuota(ctx, &proxypb.DropDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
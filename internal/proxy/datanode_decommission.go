@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DecommissionDataNode forwards the request to DataCoord, which has the DataNode drain its vchannels before it's
+// shut down. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error) {
+	if !node.checkHealthy() {
+		return &datapb.DecommissionResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "DecommissionDataNode"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("nodeID", req.GetNodeID()),
+		zap.Bool("start", req.GetStart()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.dataCoord.DecommissionDataNode(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.DecommissionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", resp.GetStatus().GetErrorCode().String()),
+		zap.String("state", resp.GetState().String()))
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// popDryRunFlag removes the dry run key from the key-value pairs and reports whether it was enabled.
+// The key is removed in any case so it's never persisted as a property or an index param.
+func popDryRunFlag(kvs []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, bool, error) {
+	dryRun := false
+	ret := make([]*commonpb.KeyValuePair, 0, len(kvs))
+	for _, kv := range kvs {
+		if kv.GetKey() != common.DryRunKey {
+			ret = append(ret, kv)
+			continue
+		}
+		enabled, err := strconv.ParseBool(kv.GetValue())
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid %s value: %s", common.DryRunKey, kv.GetValue())
+		}
+		dryRun = enabled
+	}
+	return ret, dryRun, nil
+}
+
+// newDryRunCollectionPlan fills the defaults RootCoord would derive, such as shards number and field ids.
+func newDryRunCollectionPlan(schema *schemapb.CollectionSchema, shardsNum int32,
+	level commonpb.ConsistencyLevel, properties []*commonpb.KeyValuePair) *proxypb.DryRunCreateCollectionResponse {
+	if shardsNum <= 0 {
+		shardsNum = common.DefaultShardsNum
+	}
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	for idx, field := range schema.GetFields() {
+		field.FieldID = int64(idx + common.StartOfUserFieldID)
+	}
+	return &proxypb.DryRunCreateCollectionResponse{
+		Status:           &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Schema:           schema,
+		ShardsNum:        shardsNum,
+		ConsistencyLevel: level,
+		Properties:       properties,
+	}
+}
+
+// DryRunCreateCollection runs a CreateCollection request as a dry run, whatever its dry run flag is, and returns the
+// collection that would be created.
+func (node *Proxy) DryRunCreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*proxypb.DryRunCreateCollectionResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.DryRunCreateCollectionResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "DryRunCreateCollection"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()))
+	log.Debug(rpcReceived(method))
+
+	cct := &createCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		CreateCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+		dryRun:                  true,
+	}
+	if err := node.sched.ddQueue.Enqueue(cct); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		return &proxypb.DryRunCreateCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if err := cct.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &proxypb.DryRunCreateCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method))
+	return cct.dryRunPlan, nil
+}
+
+// DryRunCreateIndex runs a CreateIndex request as a dry run, whatever its dry run flag is, and returns the index that
+// would be created.
+func (node *Proxy) DryRunCreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*proxypb.DryRunCreateIndexResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.DryRunCreateIndexResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "DryRunCreateIndex"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("field", request.GetFieldName()),
+		zap.String("index name", request.GetIndexName()))
+	log.Debug(rpcReceived(method))
+
+	cit := &createIndexTask{
+		ctx:        ctx,
+		Condition:  NewTaskCondition(ctx),
+		req:        request,
+		rootCoord:  node.rootCoord,
+		datacoord:  node.dataCoord,
+		queryCoord: node.queryCoord,
+		dryRun:     true,
+	}
+	if err := node.sched.ddQueue.Enqueue(cit); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		return &proxypb.DryRunCreateIndexResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if err := cit.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &proxypb.DryRunCreateIndexResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method))
+	return cit.dryRunPlan, nil
+}
@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestProxy_GetDdlOperationState(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	node := &Proxy{rootCoord: rc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	rc.getDdlOperationStateFunc = func(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &proxypb.GetDdlOperationStateResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Operations: []*proxypb.DdlOperation{{OperationID: req.GetOperationID()}},
+		}, nil
+	}
+	resp, err := node.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{OperationID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(1), resp.GetOperations()[0].GetOperationID())
+
+	rc.getDdlOperationStateFunc = func(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+		return nil, errors.New("mock")
+	}
+	resp, err = node.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&proxypb.GetDdlOperationStateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeDescribeCollection, privilegeExt.ObjectPrivilege)
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
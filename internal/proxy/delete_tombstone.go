@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// errInvalidDeleteTombstoneRequest is returned when the collection or the primary key in the request is invalid.
+var errInvalidDeleteTombstoneRequest = errors.New("invalid delete tombstone request")
+
+// tombstoneSegmentStates are the states of the segments whose delta logs are searched for the tombstones,
+// the dropped segments are kept by DataCoord until they are garbage collected.
+var tombstoneSegmentStates = []commonpb.SegmentState{
+	commonpb.SegmentState_Growing,
+	commonpb.SegmentState_Sealed,
+	commonpb.SegmentState_Flushing,
+	commonpb.SegmentState_Flushed,
+	commonpb.SegmentState_Dropped,
+}
+
+// parseTombstonePrimaryKey parses the primary key by the data type of the primary field of the schema.
+func parseTombstonePrimaryKey(schema *schemapb.CollectionSchema, value string) (storage.PrimaryKey, *schemapb.IDs, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errInvalidDeleteTombstoneRequest, err)
+	}
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		pk, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid int64 primary key %s", errInvalidDeleteTombstoneRequest, value)
+		}
+		return storage.NewInt64PrimaryKey(pk), &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{pk}}},
+		}, nil
+	case schemapb.DataType_VarChar:
+		return storage.NewVarCharPrimaryKey(value), &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{value}}},
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: unsupported primary key type %s", errInvalidDeleteTombstoneRequest, pkField.GetDataType())
+	}
+}
+
+// GetDeleteTombstones reports the deletes of a primary key found in the delta logs of the segments of the collection,
+// whether they are applied by compactions, and the vchannel the deletes of the primary key go to, so that
+// "why does my entity still appear" or "why did it disappear" can be investigated.
+func (node *Proxy) GetDeleteTombstones(ctx context.Context, req *proxypb.GetDeleteTombstonesRequest) (*proxypb.GetDeleteTombstonesResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.GetDeleteTombstonesResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetDeleteTombstones"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("pk", req.GetPrimaryKey()))
+	log.Debug(rpcReceived(method))
+
+	resp, err := node.getDeleteTombstones(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		errorCode := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, errInvalidDeleteTombstoneRequest) {
+			errorCode = commonpb.ErrorCode_IllegalArgument
+		}
+		return &proxypb.GetDeleteTombstonesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errorCode,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("tombstones", len(resp.GetTombstones())))
+	return resp, nil
+}
+
+func (node *Proxy) getDeleteTombstones(ctx context.Context, req *proxypb.GetDeleteTombstonesRequest) (*proxypb.GetDeleteTombstonesResponse, error) {
+	if req.GetCollectionName() == "" || req.GetPrimaryKey() == "" {
+		return nil, fmt.Errorf("%w: collection_name and primary_key are required", errInvalidDeleteTombstoneRequest)
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidDeleteTombstoneRequest, err)
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidDeleteTombstoneRequest, err)
+	}
+	pk, ids, err := parseTombstonePrimaryKey(schema, req.GetPrimaryKey())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proxypb.GetDeleteTombstonesResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: collectionID,
+		PrimaryKey:   req.GetPrimaryKey(),
+		Tombstones:   []*proxypb.DeleteTombstone{},
+	}
+	channels, err := node.chMgr.getVChannels(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) > 0 {
+		resp.Channel = channels[typeutil.HashPK2Channels(ids, channels)[0]]
+	}
+
+	statesResp, err := node.dataCoord.GetSegmentsByStates(ctx, &datapb.GetSegmentsByStatesRequest{
+		CollectionID: collectionID,
+		// -1 means list all partition segments
+		PartitionID: -1,
+		States:      tombstoneSegmentStates,
+	})
+	if err = checkLifecycleStatus("dataCoord:GetSegmentsByStates", statesResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	if len(statesResp.GetSegments()) == 0 {
+		return resp, nil
+	}
+	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		SegmentIDs:       statesResp.GetSegments(),
+		IncludeUnHealthy: true,
+	})
+	if err = checkLifecycleStatus("dataCoord:GetSegmentInfo", infoResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+
+	compactedTo := make(map[int64]int64)
+	for _, info := range infoResp.GetInfos() {
+		for _, from := range info.GetCompactionFrom() {
+			compactedTo[from] = info.GetID()
+		}
+	}
+
+	cm, err := node.factory.NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infoResp.GetInfos() {
+		for _, fieldBinlog := range info.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				tss, err := findDeletesInDeltaLog(ctx, cm, binlog.GetLogPath(), pk)
+				if err != nil {
+					return nil, err
+				}
+				for _, ts := range tss {
+					tombstone := &proxypb.DeleteTombstone{
+						SegmentID:    info.GetID(),
+						PartitionID:  info.GetPartitionID(),
+						Channel:      info.GetInsertChannel(),
+						SegmentState: info.GetState(),
+						Timestamp:    ts,
+						DeltaLogPath: binlog.GetLogPath(),
+					}
+					if to, ok := compactedTo[info.GetID()]; ok && info.GetState() == commonpb.SegmentState_Dropped {
+						tombstone.CompactedTo = to
+						tombstone.AppliedByCompaction = true
+					}
+					resp.Tombstones = append(resp.Tombstones, tombstone)
+				}
+			}
+		}
+	}
+	sort.Slice(resp.Tombstones, func(i, j int) bool { return resp.Tombstones[i].Timestamp < resp.Tombstones[j].Timestamp })
+	return resp, nil
+}
+
+// findDeletesInDeltaLog returns the timestamps of the deletes of the primary key in the delta log.
+func findDeletesInDeltaLog(ctx context.Context, cm storage.ChunkManager, path string, pk storage.PrimaryKey) ([]uint64, error) {
+	value, err := cm.Read(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delta log %s, err: %w", path, err)
+	}
+	codec := storage.NewDeleteCodec()
+	_, _, data, err := codec.Deserialize([]*storage.Blob{{Key: path, Value: value}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize delta log %s, err: %w", path, err)
+	}
+	tss := make([]uint64, 0)
+	for i, deleted := range data.Pks {
+		if deleted.EQ(pk) {
+			tss = append(tss, data.Tss[i])
+		}
+	}
+	return tss, nil
+}
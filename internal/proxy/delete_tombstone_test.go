@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type tombstoneTestFactory struct {
+	dependency.Factory
+	cm storage.ChunkManager
+}
+
+func (f *tombstoneTestFactory) NewPersistentStorageChunkManager(ctx context.Context) (storage.ChunkManager, error) {
+	return f.cm, nil
+}
+
+func TestProxy_GetDeleteTombstones(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getIDFunc = func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	}
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		}}, nil
+	})
+	globalMetaCache = mockCache
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	writeDeltaLog := func(path string, segmentID int64, pks []int64, tss []uint64) {
+		data := &storage.DeleteData{}
+		for i, pk := range pks {
+			data.Append(storage.NewInt64PrimaryKey(pk), tss[i])
+		}
+		blob, err := storage.NewDeleteCodec().Serialize(1, 2, segmentID, data)
+		require.NoError(t, err)
+		require.NoError(t, cm.Write(ctx, path, blob.GetValue()))
+	}
+	writeDeltaLog("delta/10", 10, []int64{5, 6}, []uint64{100 << 18, 101 << 18})
+	writeDeltaLog("delta/11", 11, []int64{5}, []uint64{50 << 18})
+	writeDeltaLog("delta/12", 12, []int64{5, 7}, []uint64{50 << 18, 60 << 18})
+	deltalogs := func(path string) []*datapb.FieldBinlog {
+		return []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: path}}}}
+	}
+
+	dc := NewDataCoordMock()
+	dc.GetSegmentsByStatesFunc = func(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+		assert.Contains(t, req.GetStates(), commonpb.SegmentState_Dropped)
+		return &datapb.GetSegmentsByStatesResponse{Status: &commonpb.Status{}, Segments: []int64{10, 11, 12}}, nil
+	}
+	dc.GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+		assert.True(t, req.GetIncludeUnHealthy())
+		return &datapb.GetSegmentInfoResponse{Status: &commonpb.Status{}, Infos: []*datapb.SegmentInfo{
+			{ID: 10, PartitionID: 2, InsertChannel: "ch-0", State: commonpb.SegmentState_Flushed, Deltalogs: deltalogs("delta/10")},
+			// compacted to 12
+			{ID: 11, PartitionID: 2, InsertChannel: "ch-0", State: commonpb.SegmentState_Dropped, Deltalogs: deltalogs("delta/11")},
+			{ID: 12, PartitionID: 2, InsertChannel: "ch-0", State: commonpb.SegmentState_Flushed, Deltalogs: deltalogs("delta/12"), CompactionFrom: []int64{11}},
+		}}, nil
+	}
+	chMgr := newMockChannelsMgr()
+	chMgr.getVChannelsFuncType = func(collectionID UniqueID) ([]vChan, error) {
+		return []vChan{"ch-0", "ch-1"}, nil
+	}
+
+	node := &Proxy{dataCoord: dc, chMgr: chMgr, factory: &tombstoneTestFactory{cm: cm}}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	get := func(collectionName, primaryKey string) *proxypb.GetDeleteTombstonesResponse {
+		resp, err := node.GetDeleteTombstones(ctx, &proxypb.GetDeleteTombstonesRequest{
+			CollectionName: collectionName,
+			PrimaryKey:     primaryKey,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("normal", func(t *testing.T) {
+		resp := get("coll", "5")
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(1), resp.CollectionID)
+		assert.Contains(t, []string{"ch-0", "ch-1"}, resp.Channel)
+		require.Equal(t, 3, len(resp.Tombstones))
+
+		assert.Equal(t, int64(11), resp.Tombstones[0].SegmentID)
+		assert.True(t, resp.Tombstones[0].AppliedByCompaction)
+		assert.Equal(t, int64(12), resp.Tombstones[0].CompactedTo)
+		assert.Equal(t, commonpb.SegmentState_Dropped, resp.Tombstones[0].SegmentState)
+		assert.Equal(t, int64(12), resp.Tombstones[1].SegmentID)
+		assert.False(t, resp.Tombstones[1].AppliedByCompaction)
+		assert.Equal(t, int64(10), resp.Tombstones[2].SegmentID)
+		assert.Equal(t, uint64(100<<18), resp.Tombstones[2].Timestamp)
+		assert.Equal(t, "delta/10", resp.Tombstones[2].DeltaLogPath)
+		assert.Equal(t, "ch-0", resp.Tombstones[2].Channel)
+
+		resp = get("coll", "8")
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.Tombstones)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, get("unknown", "5").GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, get("coll", "abc").GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, get("coll", "").GetStatus().GetErrorCode())
+	})
+
+	t.Run("missing delta log", func(t *testing.T) {
+		require.NoError(t, cm.Remove(ctx, "delta/12"))
+		defer writeDeltaLog("delta/12", 12, []int64{5, 7}, []uint64{50 << 18, 60 << 18})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, get("coll", "5").GetStatus().GetErrorCode())
+	})
+
+	t.Run("privilege", func(t *testing.T) {
+		privilegeExt, err := funcutil.GetPrivilegeExtObj(&proxypb.GetDeleteTombstonesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+		assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeQuery, privilegeExt.ObjectPrivilege)
+		assert.Equal(t, "coll", funcutil.GetObjectName(&proxypb.GetDeleteTombstonesRequest{CollectionName: "coll"}, privilegeExt.ObjectNameIndex))
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer node.stateCode.Store(commonpb.StateCode_Healthy)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, get("coll", "5").GetStatus().GetErrorCode())
+	})
+}
+
+func TestParseTombstonePrimaryKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar},
+	}}
+	pk, ids, err := parseTombstonePrimaryKey(schema, "abc")
+	assert.NoError(t, err)
+	assert.True(t, pk.EQ(storage.NewVarCharPrimaryKey("abc")))
+	assert.Equal(t, []string{"abc"}, ids.GetStrId().GetData())
+
+	_, _, err = parseTombstonePrimaryKey(&schemapb.CollectionSchema{}, "abc")
+	assert.ErrorIs(t, err, errInvalidDeleteTombstoneRequest)
+}
@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// The grpc response headers of the pacing hint of insert and delete,
+// MutationResult has no field for them so SDKs read them from the headers.
+const (
+	quotaUtilizationHeader     = "milvus-quota-utilization"
+	collectionQuotaShareHeader = "milvus-collection-quota-share"
+	suggestedDelayHeader       = "milvus-suggested-delay-ms"
+)
+
+// pacingHint guides the clients to pace the dml requests before they are rate limited.
+type pacingHint struct {
+	// utilization is the occupancy of the token bucket of the rate type, greater than 1 if the bucket is in debt
+	utilization float64
+	// collectionShare is the share of the tokens consumed by the collection recently
+	collectionShare float64
+	// suggestedDelay is the delay suggested before the next request of the collection
+	suggestedDelay time.Duration
+}
+
+func (h *pacingHint) metadata() metadata.MD {
+	return metadata.Pairs(
+		quotaUtilizationHeader, strconv.FormatFloat(h.utilization, 'f', 4, 64),
+		collectionQuotaShareHeader, strconv.FormatFloat(h.collectionShare, 'f', 4, 64),
+		suggestedDelayHeader, strconv.FormatInt(h.suggestedDelay.Milliseconds(), 10),
+	)
+}
+
+// collectionUsage collects the dml tokens consumed by each collection in a sliding window,
+// the collections without requests in the window are removed.
+type collectionUsage struct {
+	mu       sync.Mutex
+	rc       *ratelimitutil.RateCollector
+	lastSeen map[string]time.Time
+}
+
+func newCollectionUsage() (*collectionUsage, error) {
+	rc, err := ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+	if err != nil {
+		return nil, err
+	}
+	return &collectionUsage{
+		rc:       rc,
+		lastSeen: make(map[string]time.Time),
+	}, nil
+}
+
+func (u *collectionUsage) add(collectionName string, n int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	now := time.Now()
+	if _, ok := u.lastSeen[collectionName]; !ok {
+		u.rc.Register(collectionName)
+	}
+	u.lastSeen[collectionName] = now
+	for name, lastSeen := range u.lastSeen {
+		if now.Sub(lastSeen) > ratelimitutil.DefaultWindow {
+			u.rc.Deregister(name)
+			delete(u.lastSeen, name)
+		}
+	}
+	u.rc.Add(collectionName, float64(n))
+}
+
+// share returns the share of the tokens consumed by the collection in the window, 1 if nothing is consumed.
+func (u *collectionUsage) share(collectionName string) float64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	total, own := float64(0), float64(0)
+	for name := range u.lastSeen {
+		rate, err := u.rc.Rate(name, ratelimitutil.DefaultWindow)
+		if err != nil {
+			continue
+		}
+		total += rate
+		if name == collectionName {
+			own = rate
+		}
+	}
+	if total <= 0 {
+		return 1
+	}
+	return own / total
+}
+
+// RecordCollectionUsage records the dml tokens consumed by the collection.
+func (m *MultiRateLimiter) RecordCollectionUsage(collectionName string, n int) {
+	if m.collectionUsage != nil {
+		m.collectionUsage.add(collectionName, n)
+	}
+}
+
+// GetPacingHint returns the pacing hint of the dml request of n tokens on the collection by the occupancy of the global
+// token bucket of the rate type. A delay is suggested once the utilization reaches proxy.pacingHint.softUtilization,
+// it's the time to refill the debt of the bucket and the tokens of the request, weighted by the share of the
+// collection, so that the collections writing most slow down most. nil is returned if the rate is unlimited or denied.
+func (m *MultiRateLimiter) GetPacingHint(rt internalpb.RateType, collectionName string, n int) *pacingHint {
+	limiter, ok := m.globalRateLimiter.limiters[rt]
+	if !ok {
+		return nil
+	}
+	limit := limiter.Limit()
+	burst := limiter.Burst()
+	if limit == ratelimitutil.Inf || limit <= 0 || burst <= 0 {
+		return nil
+	}
+	tokens := limiter.Tokens(time.Now())
+	hint := &pacingHint{
+		utilization:     math.Max(0, 1-tokens/burst),
+		collectionShare: 1,
+	}
+	if m.collectionUsage != nil {
+		hint.collectionShare = m.collectionUsage.share(collectionName)
+	}
+	if hint.utilization >= Params.ProxyCfg.PacingHintSoftUtilization.GetAsFloat() {
+		seconds := (math.Max(0, -tokens) + float64(n)) / float64(limit) * hint.collectionShare
+		hint.suggestedDelay = time.Duration(seconds * float64(time.Second))
+	}
+	return hint
+}
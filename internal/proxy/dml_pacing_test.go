@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+type headerStreamMock struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStreamMock) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestCollectionUsage(t *testing.T) {
+	usage, err := newCollectionUsage()
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), usage.share("coll1"))
+
+	usage.add("coll1", 300)
+	usage.add("coll2", 100)
+	assert.InDelta(t, 0.75, usage.share("coll1"), 1e-9)
+	assert.InDelta(t, 0.25, usage.share("coll2"), 1e-9)
+	assert.Equal(t, float64(0), usage.share("coll3"))
+
+	// idle collections are removed
+	usage.lastSeen["coll2"] = time.Now().Add(-2 * ratelimitutil.DefaultWindow)
+	usage.add("coll1", 100)
+	_, ok := usage.lastSeen["coll2"]
+	assert.False(t, ok)
+	assert.Equal(t, float64(1), usage.share("coll1"))
+}
+
+func TestMultiRateLimiter_GetPacingHint(t *testing.T) {
+	paramtable.Init()
+	rt := internalpb.RateType_DMLInsert
+
+	t.Run("unlimited", func(t *testing.T) {
+		m := NewMultiRateLimiter()
+		require.NoError(t, m.globalRateLimiter.setRates([]*internalpb.Rate{{Rt: rt, R: float64(ratelimitutil.Inf)}}))
+		assert.Nil(t, m.GetPacingHint(rt, "coll", 1))
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		m := NewMultiRateLimiter()
+		require.NoError(t, m.globalRateLimiter.setRates([]*internalpb.Rate{{Rt: rt, R: 0}}))
+		assert.Nil(t, m.GetPacingHint(rt, "coll", 1))
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		m := NewMultiRateLimiter()
+		require.NoError(t, m.globalRateLimiter.setRates([]*internalpb.Rate{{Rt: rt, R: 1000}}))
+		hint := m.GetPacingHint(rt, "coll", 100)
+		require.NotNil(t, hint)
+		assert.Equal(t, float64(0), hint.utilization)
+		assert.Equal(t, float64(1), hint.collectionShare)
+		assert.Equal(t, time.Duration(0), hint.suggestedDelay)
+	})
+
+	t.Run("in debt", func(t *testing.T) {
+		m := NewMultiRateLimiter()
+		require.NoError(t, m.globalRateLimiter.setRates([]*internalpb.Rate{{Rt: rt, R: 1000}}))
+		m.RecordCollectionUsage("coll1", 1500)
+		m.RecordCollectionUsage("coll2", 500)
+		assert.True(t, m.globalRateLimiter.limiters[rt].AllowN(time.Now(), 2000))
+
+		hint := m.GetPacingHint(rt, "coll1", 1500)
+		require.NotNil(t, hint)
+		assert.Greater(t, hint.utilization, 1.9)
+		assert.InDelta(t, 0.75, hint.collectionShare, 1e-9)
+		// (debt 1000 + 1500 tokens) / 1000 per second * 0.75
+		assert.InDelta(t, float64(1875*time.Millisecond), float64(hint.suggestedDelay), float64(10*time.Millisecond))
+
+		md := hint.metadata()
+		assert.Equal(t, []string{"0.7500"}, md.Get(collectionQuotaShareHeader))
+		assert.Equal(t, 1, len(md.Get(quotaUtilizationHeader)))
+		assert.Equal(t, 1, len(md.Get(suggestedDelayHeader)))
+	})
+}
+
+func TestRateLimitInterceptor_PacingHint(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	limiter := NewMultiRateLimiter()
+	require.NoError(t, limiter.globalRateLimiter.setRates([]*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 1000000}}))
+	interceptor := RateLimitInterceptor(limiter)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "MockFullMethod"}
+	req := &milvuspb.InsertRequest{CollectionName: "coll"}
+
+	call := func(status commonpb.ErrorCode) metadata.MD {
+		stream := &headerStreamMock{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &milvuspb.MutationResult{Status: &commonpb.Status{ErrorCode: status}}, nil
+		}
+		_, err := interceptor(ctx, req, serverInfo, handler)
+		require.NoError(t, err)
+		return stream.header
+	}
+
+	header := call(commonpb.ErrorCode_Success)
+	assert.Equal(t, []string{"1.0000"}, header.Get(collectionQuotaShareHeader))
+	assert.Equal(t, []string{"0"}, header.Get(suggestedDelayHeader))
+
+	// no hint for the failed request
+	assert.Empty(t, call(commonpb.ErrorCode_UnexpectedError))
+
+	paramtable.Get().Save(Params.ProxyCfg.PacingHintEnabled.Key, "false")
+	defer paramtable.Get().Reset(Params.ProxyCfg.PacingHintEnabled.Key)
+	assert.Empty(t, call(commonpb.ErrorCode_Success))
+}
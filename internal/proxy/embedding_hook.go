@@ -0,0 +1,271 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// embeddingFailureReject fails the insert if the texts can't be embedded.
+	embeddingFailureReject = "reject"
+	// embeddingFailureZero inserts zero vectors for the texts which can't be embedded.
+	embeddingFailureZero = "zero"
+)
+
+// embeddingSourceField returns the name of the varchar field the vectors of the field are generated from.
+func embeddingSourceField(field *schemapb.FieldSchema) (string, bool) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.EmbeddingSourceKey, field.GetTypeParams())
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// validateFieldEmbedding checks the embedding source type param of the field.
+func validateFieldEmbedding(schema *schemapb.CollectionSchema, field *schemapb.FieldSchema) error {
+	source, ok := embeddingSourceField(field)
+	if !ok {
+		return nil
+	}
+	if field.GetDataType() != schemapb.DataType_FloatVector {
+		return fmt.Errorf("only float vector field can have an embedding source, field %s is %s", field.GetName(), field.GetDataType())
+	}
+	for _, f := range schema.GetFields() {
+		if f.GetName() != source {
+			continue
+		}
+		if f.GetDataType() != schemapb.DataType_VarChar {
+			return fmt.Errorf("embedding source %s of field %s should be a varchar field", source, field.GetName())
+		}
+		return nil
+	}
+	return fmt.Errorf("embedding source %s of field %s not found", source, field.GetName())
+}
+
+// embeddingClient embeds texts into vectors.
+type embeddingClient interface {
+	Embed(ctx context.Context, model string, texts []string) ([][]float32, error)
+}
+
+// httpEmbeddingClient calls an OpenAI compatible embedding service.
+type httpEmbeddingClient struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+type embeddingRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Embedding []float32 `json:"embedding"`
+		Index     int       `json:"index"`
+	} `json:"data"`
+}
+
+// Embed returns the vectors of the texts, in the order of the texts.
+func (c *httpEmbeddingClient) Embed(ctx context.Context, model string, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(&embeddingRequest{Model: model, Input: texts})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := fmt.Errorf("embedding service returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+		// the service won't accept the same request again, except when it is overloaded
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return nil, retry.Unrecoverable(err)
+		}
+		return nil, err
+	}
+	var result embeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid embedding service response: %w", err)
+	}
+	if len(result.Data) != len(texts) {
+		return nil, fmt.Errorf("embedding service returned %d vectors for %d texts", len(result.Data), len(texts))
+	}
+	vectors := make([][]float32, len(texts))
+	for _, data := range result.Data {
+		if data.Index < 0 || data.Index >= len(texts) || vectors[data.Index] != nil {
+			return nil, fmt.Errorf("embedding service returned invalid index %d", data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+	return vectors, nil
+}
+
+// embeddingHook generates the vector fields omitted by the inserted rows from their embedding source fields.
+type embeddingHook struct {
+	client        embeddingClient
+	batchSize     int
+	maxRetries    int
+	timeout       time.Duration
+	failurePolicy string
+}
+
+// newEmbeddingHook returns the hook configured by the proxy params, nil if no embedding service is configured.
+func newEmbeddingHook() *embeddingHook {
+	params := &paramtable.Get().ProxyCfg
+	endpoint := params.EmbeddingEndpoint.GetValue()
+	if endpoint == "" {
+		return nil
+	}
+	return &embeddingHook{
+		client: &httpEmbeddingClient{
+			endpoint: endpoint,
+			apiKey:   params.EmbeddingAPIKey.GetValue(),
+			client:   http.DefaultClient,
+		},
+		batchSize:     params.EmbeddingBatchSize.GetAsInt(),
+		maxRetries:    params.EmbeddingMaxRetries.GetAsInt(),
+		timeout:       params.EmbeddingTimeout.GetAsDuration(time.Millisecond),
+		failurePolicy: params.EmbeddingFailurePolicy.GetValue(),
+	}
+}
+
+// fill appends the data of the vector fields with an embedding source omitted by the inserted rows.
+func (h *embeddingHook) fill(ctx context.Context, schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) error {
+	fieldsData := make(map[string]*schemapb.FieldData, len(insertMsg.GetFieldsData()))
+	for _, fieldData := range insertMsg.GetFieldsData() {
+		fieldsData[fieldData.GetFieldName()] = fieldData
+	}
+	for _, field := range schema.GetFields() {
+		source, ok := embeddingSourceField(field)
+		if !ok {
+			continue
+		}
+		if _, ok := fieldsData[field.GetName()]; ok {
+			continue
+		}
+		sourceData, ok := fieldsData[source]
+		if !ok {
+			return fmt.Errorf("embedding source %s of field %s is missing in the inserted rows", source, field.GetName())
+		}
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return err
+		}
+		model, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.EmbeddingModelKey, field.GetTypeParams())
+		data, err := h.embed(ctx, model, sourceData.GetScalars().GetStringData().GetData(), int(dim))
+		if err != nil {
+			return fmt.Errorf("failed to generate field %s from %s: %w", field.GetName(), source, err)
+		}
+		insertMsg.FieldsData = append(insertMsg.FieldsData, &schemapb.FieldData{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: field.GetName(),
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  dim,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// embed returns the flattened vectors of the texts, embedded by batches.
+func (h *embeddingHook) embed(ctx context.Context, model string, texts []string, dim int) ([]float32, error) {
+	batchSize := h.batchSize
+	if batchSize <= 0 {
+		batchSize = len(texts)
+	}
+	data := make([]float32, 0, len(texts)*dim)
+	for begin := 0; begin < len(texts); begin += batchSize {
+		end := begin + batchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+		vectors, err := h.embedBatch(ctx, model, texts[begin:end], dim)
+		if err != nil {
+			if h.failurePolicy != embeddingFailureZero {
+				return nil, err
+			}
+			log.Ctx(ctx).Warn("embed texts failed, insert zero vectors instead",
+				zap.Int("begin", begin), zap.Int("end", end), zap.Error(err))
+			data = append(data, make([]float32, (end-begin)*dim)...)
+			continue
+		}
+		for _, vector := range vectors {
+			data = append(data, vector...)
+		}
+	}
+	return data, nil
+}
+
+// embedBatch asks the embedding service for the vectors of the texts, retrying the failed requests.
+func (h *embeddingHook) embedBatch(ctx context.Context, model string, texts []string, dim int) ([][]float32, error) {
+	attempts := uint(1)
+	if h.maxRetries > 0 {
+		attempts += uint(h.maxRetries)
+	}
+	var vectors [][]float32
+	err := retry.Do(ctx, func() error {
+		reqCtx, cancel := context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+		var err error
+		vectors, err = h.client.Embed(reqCtx, model, texts)
+		if err != nil {
+			return err
+		}
+		for _, vector := range vectors {
+			if len(vector) != dim {
+				return retry.Unrecoverable(fmt.Errorf("embedding service returned %d dimensional vector, expected %d", len(vector), dim))
+			}
+		}
+		return nil
+	}, retry.Attempts(attempts))
+	if err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
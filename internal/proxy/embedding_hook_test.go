@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+func newEmbeddingTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			newOptionalField("text", schemapb.DataType_VarChar),
+			newOptionalField("vec", schemapb.DataType_FloatVector,
+				&commonpb.KeyValuePair{Key: "dim", Value: "2"},
+				&commonpb.KeyValuePair{Key: common.EmbeddingSourceKey, Value: "text"},
+				&commonpb.KeyValuePair{Key: common.EmbeddingModelKey, Value: "m"}),
+		},
+	}
+}
+
+func newEmbeddingTestInsertMsg(texts ...string) *msgstream.InsertMsg {
+	return &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			NumRows: uint64(len(texts)),
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_VarChar,
+				FieldName: "text",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: texts}},
+				}},
+			}},
+		},
+	}
+}
+
+type mockEmbeddingClient struct {
+	calls int
+	embed func(texts []string) ([][]float32, error)
+}
+
+func (c *mockEmbeddingClient) Embed(ctx context.Context, model string, texts []string) ([][]float32, error) {
+	c.calls++
+	return c.embed(texts)
+}
+
+func TestValidateFieldEmbedding(t *testing.T) {
+	schema := newEmbeddingTestSchema()
+	schema.Fields = append(schema.Fields, newOptionalField("id", schemapb.DataType_Int64))
+	source := func(name string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.EmbeddingSourceKey, Value: name}
+	}
+
+	assert.NoError(t, validateFieldEmbedding(schema, schema.Fields[0]))
+	assert.NoError(t, validateFieldEmbedding(schema, schema.Fields[1]))
+	assert.Error(t, validateFieldEmbedding(schema, newOptionalField("bin", schemapb.DataType_BinaryVector, source("text"))))
+	assert.Error(t, validateFieldEmbedding(schema, newOptionalField("vec2", schemapb.DataType_FloatVector, source("id"))))
+	assert.Error(t, validateFieldEmbedding(schema, newOptionalField("vec2", schemapb.DataType_FloatVector, source("absent"))))
+}
+
+func TestHTTPEmbeddingClient(t *testing.T) {
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		var req embeddingRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "m", req.Model)
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		// answer in the reverse order to check the vectors are sorted by index
+		var data []map[string]interface{}
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]interface{}{"index": i, "embedding": []float32{float32(len(req.Input[i]))}})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+	client := &httpEmbeddingClient{endpoint: server.URL, apiKey: "key", client: server.Client()}
+
+	status = http.StatusOK
+	vectors, err := client.Embed(context.Background(), "m", []string{"a", "bb", "ccc"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{1}, {2}, {3}}, vectors)
+
+	status = http.StatusBadRequest
+	_, err = client.Embed(context.Background(), "m", []string{"a"})
+	assert.Error(t, err)
+	assert.True(t, retry.IsUnRecoverable(err))
+
+	status = http.StatusServiceUnavailable
+	_, err = client.Embed(context.Background(), "m", []string{"a"})
+	assert.Error(t, err)
+	assert.False(t, retry.IsUnRecoverable(err))
+}
+
+func TestEmbeddingHook_Fill(t *testing.T) {
+	ctx := context.Background()
+	schema := newEmbeddingTestSchema()
+	client := &mockEmbeddingClient{embed: func(texts []string) ([][]float32, error) {
+		vectors := make([][]float32, len(texts))
+		for i, text := range texts {
+			vectors[i] = []float32{float32(len(text)), 1}
+		}
+		return vectors, nil
+	}}
+	hook := &embeddingHook{client: client, batchSize: 2, timeout: time.Second, failurePolicy: embeddingFailureReject}
+
+	t.Run("batches", func(t *testing.T) {
+		msg := newEmbeddingTestInsertMsg("a", "bb", "ccc")
+		require.NoError(t, hook.fill(ctx, schema, msg))
+		assert.Equal(t, 2, client.calls)
+		require.Len(t, msg.GetFieldsData(), 2)
+		vec := msg.GetFieldsData()[1]
+		assert.Equal(t, "vec", vec.GetFieldName())
+		assert.Equal(t, int64(2), vec.GetVectors().GetDim())
+		assert.Equal(t, []float32{1, 1, 2, 1, 3, 1}, vec.GetVectors().GetFloatVector().GetData())
+	})
+
+	t.Run("given vectors", func(t *testing.T) {
+		client.calls = 0
+		msg := newEmbeddingTestInsertMsg("a")
+		msg.FieldsData = append(msg.FieldsData, &schemapb.FieldData{FieldName: "vec"})
+		require.NoError(t, hook.fill(ctx, schema, msg))
+		assert.Equal(t, 0, client.calls)
+		assert.Len(t, msg.GetFieldsData(), 2)
+	})
+
+	t.Run("missing source", func(t *testing.T) {
+		msg := newEmbeddingTestInsertMsg()
+		msg.FieldsData = nil
+		assert.Error(t, hook.fill(ctx, schema, msg))
+	})
+
+	failing := &mockEmbeddingClient{embed: func(texts []string) ([][]float32, error) {
+		return nil, errors.New("mock")
+	}}
+	t.Run("reject", func(t *testing.T) {
+		hook := &embeddingHook{client: failing, batchSize: 2, maxRetries: 1, timeout: time.Second, failurePolicy: embeddingFailureReject}
+		assert.Error(t, hook.fill(ctx, schema, newEmbeddingTestInsertMsg("a")))
+		assert.Equal(t, 2, failing.calls)
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		hook := &embeddingHook{client: failing, batchSize: 2, timeout: time.Second, failurePolicy: embeddingFailureZero}
+		msg := newEmbeddingTestInsertMsg("a", "bb", "ccc")
+		require.NoError(t, hook.fill(ctx, schema, msg))
+		assert.Equal(t, make([]float32, 6), msg.GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+	})
+
+	t.Run("wrong dim", func(t *testing.T) {
+		wrongDim := &mockEmbeddingClient{embed: func(texts []string) ([][]float32, error) {
+			return [][]float32{{1, 2, 3}}, nil
+		}}
+		hook := &embeddingHook{client: wrongDim, maxRetries: 3, timeout: time.Second, failurePolicy: embeddingFailureReject}
+		assert.Error(t, hook.fill(ctx, schema, newEmbeddingTestInsertMsg("a")))
+		assert.Equal(t, 1, wrongDim.calls)
+	})
+}
@@ -102,6 +102,22 @@ func wrapRateLimitError() error {
 	return fmt.Errorf("[%w] request is rejected by grpc RateLimiter middleware, please retry later", ErrRateLimit)
 }
 
+func wrapRoleQuotaDenyError(rt internalpb.RateType, username string) error {
+	return fmt.Errorf("[%w] %s requests are denied by the role quotas of user %s", ErrForceDeny, rt.String(), username)
+}
+
+func wrapDatabaseQuotaDenyError(rt internalpb.RateType, dbName string) error {
+	return fmt.Errorf("[%w] %s requests are denied by the quota of database %s", ErrForceDeny, rt.String(), dbName)
+}
+
+func wrapReadOnlyDenyError(scope string, reason string) error {
+	return fmt.Errorf("[%w] dml and ddl requests are denied since %s is in read-only mode, reason: %s", ErrForceDeny, scope, reason)
+}
+
+func wrapSLOShedError(rt internalpb.RateType, tier int) error {
+	return fmt.Errorf("[%w] %s requests of tier %d are shed to protect the latency slo, please retry later", ErrRateLimit, rt.String(), tier)
+}
+
 func wrapForceDenyError(rt internalpb.RateType, limiter types.Limiter) error {
 	switch rt {
 	case internalpb.RateType_DMLInsert, internalpb.RateType_DMLDelete, internalpb.RateType_DMLBulkLoad:
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// exprParamsHeader is the grpc request header of the expression parameters,
+// DeleteRequest has no key-value params so the parameters of delete come from the header.
+const exprParamsHeader = "milvus-expr-params"
+
+// errInvalidExprParams is returned when the expression parameters can't be bound to the expression.
+var errInvalidExprParams = errors.New("invalid expression parameters")
+
+// getExprParams returns the json object of the expression parameters, ExprParamsKey of the request params
+// takes precedence over the exprParamsHeader header, empty if the expression is not parameterized.
+func getExprParams(ctx context.Context, params []*commonpb.KeyValuePair) string {
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(ExprParamsKey, params); err == nil {
+		return value
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(exprParamsHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// bindExprParams binds the parameters to the placeholders of the expression, e.g. `pk in {ids}` with the
+// parameters `{"ids": [1, 2, 3]}` is bound to `pk in [1, 2, 3]`. A placeholder is an identifier in braces
+// outside the string literals. The parameters are decoded from json and rendered as literals only, numbers,
+// strings, bools and arrays of them, so a parameter can never change the structure of the expression.
+// The rendering is canonical, the same parameters always produce the same plan, which keeps the plans cacheable.
+// The expression is returned as is if there are no parameters.
+func bindExprParams(expr string, paramsJSON string) (string, error) {
+	if paramsJSON == "" {
+		return expr, nil
+	}
+	params, err := decodeExprParams(paramsJSON)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	used := make(map[string]struct{}, len(params))
+	inString := false
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case inString && c == '\\' && i+1 < len(expr):
+			builder.WriteByte(c)
+			i++
+			c = expr[i]
+		case c == '"':
+			inString = !inString
+		case !inString && c == '{':
+			end := strings.IndexByte(expr[i:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("%w: unclosed placeholder at %d", errInvalidExprParams, i)
+			}
+			name := expr[i+1 : i+end]
+			if !isExprParamName(name) {
+				return "", fmt.Errorf("%w: invalid placeholder {%s}", errInvalidExprParams, name)
+			}
+			value, ok := params[name]
+			if !ok {
+				return "", fmt.Errorf("%w: no value of placeholder {%s}", errInvalidExprParams, name)
+			}
+			literal, err := renderExprParam(name, value, true)
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString(literal)
+			used[name] = struct{}{}
+			i += end
+			continue
+		}
+		builder.WriteByte(c)
+	}
+
+	unused := make([]string, 0)
+	for name := range params {
+		if _, ok := used[name]; !ok {
+			unused = append(unused, name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return "", fmt.Errorf("%w: parameters %v are not used by the expression", errInvalidExprParams, unused)
+	}
+	return builder.String(), nil
+}
+
+func decodeExprParams(paramsJSON string) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(paramsJSON)))
+	decoder.UseNumber()
+	params := make(map[string]interface{})
+	if err := decoder.Decode(&params); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidExprParams, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("%w: trailing data after the parameters", errInvalidExprParams)
+	}
+	return params, nil
+}
+
+func isExprParamName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// renderExprParam renders the parameter as a literal of the expression, arrays are allowed at the top level only.
+func renderExprParam(name string, value interface{}, allowArray bool) (string, error) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return strconv.FormatInt(i, 10), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return "", fmt.Errorf("%w: number %s of parameter %s is out of range", errInvalidExprParams, v, name)
+		}
+		return strconv.FormatFloat(f, 'e', -1, 64), nil
+	case string:
+		return strconv.Quote(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []interface{}:
+		if !allowArray {
+			return "", fmt.Errorf("%w: nested array of parameter %s", errInvalidExprParams, name)
+		}
+		elements := make([]string, 0, len(v))
+		for _, element := range v {
+			literal, err := renderExprParam(name, element, false)
+			if err != nil {
+				return "", err
+			}
+			elements = append(elements, literal)
+		}
+		return "[" + strings.Join(elements, ", ") + "]", nil
+	default:
+		return "", fmt.Errorf("%w: unsupported value %v of parameter %s", errInvalidExprParams, value, name)
+	}
+}
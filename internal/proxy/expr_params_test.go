@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+)
+
+func TestBindExprParams(t *testing.T) {
+	cases := []struct {
+		expr   string
+		params string
+		bound  string
+	}{
+		{`Int64Field in {ids}`, ``, `Int64Field in {ids}`},
+		{`Int64Field in {ids}`, `{"ids": [1, -2, 3]}`, `Int64Field in [1, -2, 3]`},
+		{`Int64Field in {ids}`, `{"ids": []}`, `Int64Field in []`},
+		{`FloatField > {low} && FloatField < {high}`, `{"low": 0.5, "high": 1e3}`, `FloatField > 5e-01 && FloatField < 1e+03`},
+		{`VarCharField == {name}`, `{"name": "a\" || Int64Field > 0 || \""}`, `VarCharField == "a\" || Int64Field > 0 || \""`},
+		{`VarCharField in {names} && VarCharField != "{names}"`, `{"names": ["x", "y\\"]}`, `VarCharField in ["x", "y\\"] && VarCharField != "{names}"`},
+		{`VarCharField == "\"{" && BoolField == {b}`, `{"b": true}`, `VarCharField == "\"{" && BoolField == true`},
+	}
+	for _, c := range cases {
+		bound, err := bindExprParams(c.expr, c.params)
+		assert.NoError(t, err, c.expr)
+		assert.Equal(t, c.bound, bound)
+	}
+
+	invalids := []struct {
+		expr   string
+		params string
+	}{
+		{`Int64Field in {ids}`, `[1, 2]`},
+		{`Int64Field in {ids}`, `{"ids": [1]} {}`},
+		{`Int64Field in {ids`, `{"ids": [1]}`},
+		{`Int64Field in {1ids}`, `{"1ids": [1]}`},
+		{`Int64Field in {ids}`, `{"id": [1]}`},
+		{`Int64Field in {ids}`, `{"ids": [1], "unused": 2}`},
+		{`Int64Field in {ids}`, `{"ids": [[1]]}`},
+		{`Int64Field in {ids}`, `{"ids": {"a": 1}}`},
+		{`Int64Field == {id}`, `{"id": null}`},
+		{`FloatField > {f}`, `{"f": 1e400}`},
+	}
+	for _, c := range invalids {
+		_, err := bindExprParams(c.expr, c.params)
+		assert.True(t, errors.Is(err, errInvalidExprParams), c.expr)
+	}
+}
+
+func TestBindExprParams_Plan(t *testing.T) {
+	schema := newTestSchema()
+	// the string parameter stays a literal instead of being injected into the expression
+	bound, err := bindExprParams(`VarCharField in {names}`, `{"names": ["a\" || Int64Field > 0 || \"b"]}`)
+	require.NoError(t, err)
+	plan, err := planparserv2.CreateRetrievePlan(schema, bound)
+	require.NoError(t, err)
+	values := plan.GetPredicates().GetTermExpr().GetValues()
+	require.Equal(t, 1, len(values))
+	assert.Equal(t, `a" || Int64Field > 0 || "b`, values[0].GetStringVal())
+
+	bound, err = bindExprParams(`Int64Field in {ids}`, `{"ids": [1, 2, 3]}`)
+	require.NoError(t, err)
+	plan, err = planparserv2.CreateRetrievePlan(schema, bound)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(plan.GetPredicates().GetTermExpr().GetValues()))
+}
+
+func TestGetExprParams(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", getExprParams(ctx, nil))
+
+	params := []*commonpb.KeyValuePair{{Key: ExprParamsKey, Value: `{"ids": [1]}`}}
+	assert.Equal(t, `{"ids": [1]}`, getExprParams(ctx, params))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(exprParamsHeader, `{"ids": [2]}`))
+	assert.Equal(t, `{"ids": [2]}`, getExprParams(ctx, nil))
+	assert.Equal(t, `{"ids": [1]}`, getExprParams(ctx, params))
+}
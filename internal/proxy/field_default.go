@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// isNullableField returns whether the inserted rows may omit the field.
+func isNullableField(field *schemapb.FieldSchema) bool {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.NullableKey, field.GetTypeParams())
+	if err != nil {
+		return false
+	}
+	nullable, err := strconv.ParseBool(value)
+	return err == nil && nullable
+}
+
+// fieldDefaultValue returns the value filled in the inserted rows omitting the field.
+func fieldDefaultValue(field *schemapb.FieldSchema) (string, bool) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DefaultValueKey, field.GetTypeParams())
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// isOptionalField returns whether the field may be omitted by the inserted rows and filled by the proxy.
+func isOptionalField(field *schemapb.FieldSchema) bool {
+	_, ok := fieldDefaultValue(field)
+	return ok || isNullableField(field)
+}
+
+// validateFieldDefaultValue checks the nullable and default value type params of the field.
+func validateFieldDefaultValue(field *schemapb.FieldSchema) error {
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(common.NullableKey, field.GetTypeParams()); err == nil {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("invalid nullable %s of field %s, should be true or false", value, field.GetName())
+		}
+	}
+	if !isOptionalField(field) {
+		return nil
+	}
+	if field.GetIsPrimaryKey() {
+		return fmt.Errorf("primary field %s can not be nullable or have a default value", field.GetName())
+	}
+	if field.GetDataType() == schemapb.DataType_FloatVector || field.GetDataType() == schemapb.DataType_BinaryVector {
+		return fmt.Errorf("vector field %s can not be nullable or have a default value", field.GetName())
+	}
+	_, err := genOptionalFieldData(field, 1)
+	return err
+}
+
+// fillOptionalFieldsData fills the fields omitted by the inserted rows with their default values,
+// so the producers don't break when optional fields are added to the collection schema.
+func fillOptionalFieldsData(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) error {
+	existFields := make(map[string]struct{}, len(insertMsg.GetFieldsData()))
+	for _, fieldData := range insertMsg.GetFieldsData() {
+		existFields[fieldData.GetFieldName()] = struct{}{}
+	}
+	numRows := int(insertMsg.NRows())
+	for _, field := range schema.GetFields() {
+		if _, ok := existFields[field.GetName()]; ok || field.GetAutoID() || !isOptionalField(field) {
+			continue
+		}
+		fieldData, err := genOptionalFieldData(field, numRows)
+		if err != nil {
+			return err
+		}
+		insertMsg.FieldsData = append(insertMsg.FieldsData, fieldData)
+	}
+	return nil
+}
+
+// genOptionalFieldData returns the field data of numRows rows of the default value of the field,
+// or of the zero value if the nullable field has no default value.
+func genOptionalFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	value, hasDefault := fieldDefaultValue(field)
+	invalidErr := func(err error) error {
+		return fmt.Errorf("invalid default value %s of field %s: %w", value, field.GetName(), err)
+	}
+	scalars := &schemapb.ScalarField{}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		v := false
+		if hasDefault {
+			var err error
+			if v, err = strconv.ParseBool(value); err != nil {
+				return nil, invalidErr(err)
+			}
+		}
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		v := int64(0)
+		if hasDefault {
+			bitSize := map[schemapb.DataType]int{
+				schemapb.DataType_Int8:  8,
+				schemapb.DataType_Int16: 16,
+				schemapb.DataType_Int32: 32,
+			}[field.GetDataType()]
+			var err error
+			if v, err = strconv.ParseInt(value, 10, bitSize); err != nil {
+				return nil, invalidErr(err)
+			}
+		}
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = int32(v)
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		v := int64(0)
+		if hasDefault {
+			var err error
+			if v, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return nil, invalidErr(err)
+			}
+		}
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		v := float64(0)
+		if hasDefault {
+			var err error
+			if v, err = strconv.ParseFloat(value, 32); err != nil {
+				return nil, invalidErr(err)
+			}
+		}
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = float32(v)
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		v := float64(0)
+		if hasDefault {
+			var err error
+			if v, err = strconv.ParseFloat(value, 64); err != nil {
+				return nil, invalidErr(err)
+			}
+		}
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		if field.GetDataType() == schemapb.DataType_VarChar {
+			maxLength, err := funcutil.GetAttrByKeyFromRepeatedKV(maxVarCharLengthKey, field.GetTypeParams())
+			if err == nil {
+				if limit, err := strconv.Atoi(maxLength); err == nil && len(value) > limit {
+					return nil, invalidErr(fmt.Errorf("the length %d exceeds max_length %d", len(value), limit))
+				}
+			}
+		}
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	default:
+		return nil, fmt.Errorf("field %s of type %s can not be nullable or have a default value",
+			field.GetName(), field.GetDataType().String())
+	}
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, nil
+}
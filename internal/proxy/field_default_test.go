@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func newOptionalField(name string, dataType schemapb.DataType, params ...*commonpb.KeyValuePair) *schemapb.FieldSchema {
+	return &schemapb.FieldSchema{
+		Name:       name,
+		DataType:   dataType,
+		TypeParams: params,
+	}
+}
+
+func TestValidateFieldDefaultValue(t *testing.T) {
+	nullable := &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"}
+	defaultValue := func(value string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.DefaultValueKey, Value: value}
+	}
+	maxLength := &commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "4"}
+
+	valid := []*schemapb.FieldSchema{
+		newOptionalField("plain", schemapb.DataType_Int64),
+		newOptionalField("nullable", schemapb.DataType_Int64, nullable),
+		newOptionalField("not_nullable", schemapb.DataType_FloatVector, &commonpb.KeyValuePair{Key: common.NullableKey, Value: "false"}),
+		newOptionalField("bool", schemapb.DataType_Bool, defaultValue("true")),
+		newOptionalField("int8", schemapb.DataType_Int8, defaultValue("-128")),
+		newOptionalField("int32", schemapb.DataType_Int32, defaultValue("100")),
+		newOptionalField("float", schemapb.DataType_Float, defaultValue("1.5")),
+		newOptionalField("double", schemapb.DataType_Double, defaultValue("2.5")),
+		newOptionalField("varchar", schemapb.DataType_VarChar, maxLength, defaultValue("abcd")),
+	}
+	for _, field := range valid {
+		assert.NoError(t, validateFieldDefaultValue(field), field.GetName())
+	}
+
+	invalid := []*schemapb.FieldSchema{
+		newOptionalField("nullable", schemapb.DataType_Int64, &commonpb.KeyValuePair{Key: common.NullableKey, Value: "yes"}),
+		{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: []*commonpb.KeyValuePair{nullable}},
+		newOptionalField("vector", schemapb.DataType_FloatVector, nullable),
+		newOptionalField("bool", schemapb.DataType_Bool, defaultValue("1.5")),
+		newOptionalField("int8", schemapb.DataType_Int8, defaultValue("128")),
+		newOptionalField("int64", schemapb.DataType_Int64, defaultValue("abc")),
+		newOptionalField("float", schemapb.DataType_Float, defaultValue("abc")),
+		newOptionalField("varchar", schemapb.DataType_VarChar, maxLength, defaultValue("abcde")),
+	}
+	for _, field := range invalid {
+		assert.Error(t, validateFieldDefaultValue(field), field.GetName())
+	}
+
+	assert.NoError(t, validateMaxLengthPerRow("collection",
+		newOptionalField("varchar", schemapb.DataType_VarChar, maxLength, nullable, defaultValue("abc"))))
+}
+
+func TestFillOptionalFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			newOptionalField("required", schemapb.DataType_Int64),
+			newOptionalField("nullable", schemapb.DataType_VarChar,
+				&commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "16"},
+				&commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"}),
+			newOptionalField("default", schemapb.DataType_Int32,
+				&commonpb.KeyValuePair{Key: common.DefaultValueKey, Value: "7"}),
+			newOptionalField("given", schemapb.DataType_Double,
+				&commonpb.KeyValuePair{Key: common.DefaultValueKey, Value: "1"}),
+		},
+	}
+	insertMsg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			Version: internalpb.InsertDataVersion_ColumnBased,
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(&schemapb.FieldSchema{Name: "required", DataType: schemapb.DataType_Int64}, "required", 2),
+				newScalarFieldData(&schemapb.FieldSchema{Name: "given", DataType: schemapb.DataType_Double}, "given", 2),
+			},
+		},
+	}
+
+	assert.NoError(t, fillOptionalFieldsData(schema, insertMsg))
+	fieldsData := insertMsg.GetFieldsData()
+	assert.Equal(t, 4, len(fieldsData))
+	assert.Equal(t, "nullable", fieldsData[2].GetFieldName())
+	assert.Equal(t, []string{"", ""}, fieldsData[2].GetScalars().GetStringData().GetData())
+	assert.Equal(t, "default", fieldsData[3].GetFieldName())
+	assert.Equal(t, []int32{7, 7}, fieldsData[3].GetScalars().GetIntData().GetData())
+
+	// the omitted required fields are left to be rejected by the insert checks
+	insertMsg.FieldsData = fieldsData[1:2]
+	assert.NoError(t, fillOptionalFieldsData(schema, insertMsg))
+	assert.Equal(t, 3, len(insertMsg.GetFieldsData()))
+}
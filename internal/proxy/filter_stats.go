@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/filterstats"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// parseFilterStats returns true if the search or query asks for the filter execution statistics by FilterStatsKey,
+// the statistics are counted by the QueryNodes and returned in the reason of the response status.
+func parseFilterStats(params []*commonpb.KeyValuePair) (bool, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(FilterStatsKey, params)
+	if err != nil {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s [%s] is invalid, it should be true or false", FilterStatsKey, value)
+	}
+	return enabled, nil
+}
+
+// fillInFilterStats sums the filter statistics of the shard results, and appends them to the reason of the status
+// since the results returned to the client have no field for them.
+func fillInFilterStats(status *commonpb.Status, shardStats []*internalpb.FilterStats) *commonpb.Status {
+	var stats *internalpb.FilterStats
+	for _, s := range shardStats {
+		stats = filterstats.Merge(stats, s)
+	}
+	if stats == nil {
+		return status
+	}
+	if status == nil {
+		status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	filterstats.FillInStatus(status, stats)
+	return status
+}
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/filterstats"
+)
+
+func TestParseFilterStats(t *testing.T) {
+	enabled, err := parseFilterStats(nil)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	enabled, err = parseFilterStats([]*commonpb.KeyValuePair{{Key: FilterStatsKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = parseFilterStats([]*commonpb.KeyValuePair{{Key: FilterStatsKey, Value: "yes"}})
+	assert.Error(t, err)
+}
+
+func TestFillInFilterStats(t *testing.T) {
+	assert.Nil(t, fillInFilterStats(nil, []*internalpb.FilterStats{nil}))
+
+	shardStats := []*internalpb.FilterStats{
+		{SegmentsScanned: 2, RowsScanned: 200, IndexSegments: 2},
+		{SegmentsScanned: 1, SegmentsPruned: 1, RowsScanned: 50, BruteForceSegments: 1},
+	}
+	status := fillInFilterStats(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: "search variant: v1"}, shardStats)
+	assert.Equal(t, "search variant: v1; "+filterstats.ReasonPrefix+
+		`{"segments_scanned":3,"segments_pruned":1,"rows_scanned":250,"index_segments":2,"brute_force_segments":1}`, status.GetReason())
+
+	status = fillInFilterStats(nil, shardStats)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.True(t, strings.HasPrefix(status.GetReason(), filterstats.ReasonPrefix))
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// FreezeHandoffs forwards the request to DataCoord, which freezes the index completion driven handoffs and the
+// compaction of a collection, or the whole cluster. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+	if !node.checkHealthy() {
+		return &datapb.FreezeHandoffsResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "FreezeHandoffs"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("ttlSeconds", req.GetTtlSeconds()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.dataCoord.FreezeHandoffs(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.FreezeHandoffsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", resp.GetStatus().GetErrorCode().String()))
+	return resp, nil
+}
+
+// UnfreezeHandoffs forwards the request to DataCoord, which closes the freeze window of a collection, or the whole
+// cluster. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "UnfreezeHandoffs"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.dataCoord.UnfreezeHandoffs(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
+
+// GetFreezeWindows forwards the request to DataCoord, which returns the freeze windows not expired.
+// The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+	if !node.checkHealthy() {
+		return &datapb.GetFreezeWindowsResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetFreezeWindows"
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole))
+	log.Debug(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.dataCoord.GetFreezeWindows(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.GetFreezeWindowsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("windows", len(resp.GetWindows())))
+	return resp, nil
+}
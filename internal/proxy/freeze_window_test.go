@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestProxy_FreezeHandoffs(t *testing.T) {
+	ctx := context.Background()
+	dataCoord := NewDataCoordMock()
+	node := &Proxy{dataCoord: dataCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	dataCoord.freezeHandoffsFunc = func(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		assert.Equal(t, int64(600), req.GetTtlSeconds())
+		return &datapb.FreezeHandoffsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Window: &datapb.FreezeWindow{CollectionID: req.GetCollectionID()},
+		}, nil
+	}
+	freezeResp, err := node.FreezeHandoffs(ctx, &datapb.FreezeHandoffsRequest{CollectionID: 1, TtlSeconds: 600})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, freezeResp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(1), freezeResp.GetWindow().GetCollectionID())
+
+	dataCoord.unfreezeHandoffsFunc = func(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+		assert.NotNil(t, req.GetBase())
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	status, err := node.UnfreezeHandoffs(ctx, &datapb.UnfreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	dataCoord.getFreezeWindowsFunc = func(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &datapb.GetFreezeWindowsResponse{
+			Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Windows: []*datapb.FreezeWindow{{CollectionID: 1}},
+		}, nil
+	}
+	listResp, err := node.GetFreezeWindows(ctx, &datapb.GetFreezeWindowsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(listResp.GetWindows()))
+
+	dataCoord.freezeHandoffsFunc = func(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error) {
+		return nil, errors.New("mock")
+	}
+	dataCoord.unfreezeHandoffsFunc = func(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error) {
+		return nil, errors.New("mock")
+	}
+	dataCoord.getFreezeWindowsFunc = func(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error) {
+		return nil, errors.New("mock")
+	}
+	freezeResp, err = node.FreezeHandoffs(ctx, &datapb.FreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, freezeResp.GetStatus().GetErrorCode())
+	status, err = node.UnfreezeHandoffs(ctx, &datapb.UnfreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	listResp, err = node.GetFreezeWindows(ctx, &datapb.GetFreezeWindowsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+
+	for _, req := range []proto.Message{
+		&datapb.FreezeHandoffsRequest{},
+		&datapb.UnfreezeHandoffsRequest{},
+		&datapb.GetFreezeWindowsRequest{},
+	} {
+		privilegeExt, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+		assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+	}
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	freezeResp, err = node.FreezeHandoffs(ctx, &datapb.FreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, freezeResp.GetStatus().GetErrorCode())
+	status, err = node.UnfreezeHandoffs(ctx, &datapb.UnfreezeHandoffsRequest{CollectionID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	listResp, err = node.GetFreezeWindows(ctx, &datapb.GetFreezeWindowsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+}
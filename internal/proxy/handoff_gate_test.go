@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestProxy_GetHandoffGate(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getIDFunc = func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 10, nil
+	}
+	globalMetaCache = mockCache
+
+	ctx := context.Background()
+	dataCoord := NewDataCoordMock()
+	node := &Proxy{dataCoord: dataCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	dataCoord.getHandoffGateFunc = func(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		assert.Equal(t, int64(10), req.GetCollectionID())
+		return &datapb.GetHandoffGateResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Enabled:  true,
+			Segments: []*datapb.HandoffGateSegment{{SegmentID: 1, CollectionID: 10, State: "waiting"}},
+		}, nil
+	}
+	resp, err := node.GetHandoffGate(ctx, &proxypb.GetHandoffGateRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.True(t, resp.GetEnabled())
+	assert.Equal(t, 1, len(resp.GetSegments()))
+
+	resp, err = node.GetHandoffGate(ctx, &proxypb.GetHandoffGateRequest{CollectionName: "other"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	dataCoord.getHandoffGateFunc = func(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error) {
+		return nil, errors.New("mock")
+	}
+	resp, err = node.GetHandoffGate(ctx, &proxypb.GetHandoffGateRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&proxypb.GetHandoffGateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeGetStatistics, privilegeExt.ObjectPrivilege)
+	assert.Equal(t, "coll", funcutil.GetObjectName(&proxypb.GetHandoffGateRequest{CollectionName: "coll"}, privilegeExt.ObjectNameIndex))
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.GetHandoffGate(ctx, &proxypb.GetHandoffGateRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -41,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
@@ -127,9 +129,12 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 			aliasName = globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
 		}
 	}
+	node.partitionRouter.Invalidate(collectionID)
+	node.searchTrafficSplitter.Invalidate(collectionID)
 	if request.GetBase().GetMsgType() == commonpb.MsgType_DropCollection {
 		// no need to handle error, since this Proxy may not create dml stream for the collection.
 		node.chMgr.removeDMLStream(request.GetCollectionID())
+		node.searchTuner.Invalidate(collectionID)
 		// clean up collection level metrics
 		metrics.CleanupCollectionMetrics(paramtable.GetNodeID(), collectionName)
 		for _, alias := range aliasName {
@@ -413,6 +418,8 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 			Reason:    err.Error(),
 		}, nil
 	}
+	node.partitionRouter.Invalidate(lct.collectionID)
+	node.searchTrafficSplitter.Invalidate(lct.collectionID)
 
 	log.Debug("LoadCollection done",
 		zap.Uint64("BeginTS", lct.BeginTs()),
@@ -483,6 +490,8 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 			Reason:    err.Error(),
 		}, nil
 	}
+	node.partitionRouter.Invalidate(rct.collectionID)
+	node.searchTrafficSplitter.Invalidate(rct.collectionID)
 
 	log.Debug(
 		rpcDone(method),
@@ -1165,6 +1174,8 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 			Reason:    err.Error(),
 		}, nil
 	}
+	node.partitionRouter.Invalidate(lpt.collectionID)
+	node.searchTrafficSplitter.Invalidate(lpt.collectionID)
 
 	log.Debug(
 		rpcDone(method),
@@ -1240,6 +1251,8 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 			Reason:    err.Error(),
 		}, nil
 	}
+	node.partitionRouter.Invalidate(rpt.collectionID)
+	node.searchTrafficSplitter.Invalidate(rpt.collectionID)
 
 	log.Debug(
 		rpcDone(method),
@@ -2384,10 +2397,14 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			),
 			ReqID: paramtable.GetNodeID(),
 		},
-		request:  request,
-		qc:       node.queryCoord,
-		tr:       timerecord.NewTimeRecorder("search"),
-		shardMgr: node.shardMgr,
+		request:         request,
+		qc:              node.queryCoord,
+		tr:              timerecord.NewTimeRecorder("search"),
+		shardMgr:        node.shardMgr,
+		partitionRouter: node.partitionRouter,
+		trafficSplitter: node.searchTrafficSplitter,
+		travelGuard:     node.timeTravelGuard,
+		tuner:           node.searchTuner,
 	}
 
 	travelTs := request.TravelTimestamp
@@ -2459,6 +2476,10 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		metrics.SearchLabel).Observe(float64(searchDur))
 	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.SearchLabel, request.CollectionName).Observe(float64(searchDur))
+	if qt.searchVariant != "" {
+		metrics.ProxySearchVariantLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+			request.CollectionName, qt.searchVariant).Observe(float64(searchDur))
+	}
 	if qt.result != nil {
 		sentSize := proto.Size(qt.result)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
@@ -2570,8 +2591,9 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		},
 		request:          request,
 		qc:               node.queryCoord,
-		queryShardPolicy: mergeRoundRobinPolicy,
+		queryShardPolicy: newReplicaFailoverPolicy(metrics.QueryLabel),
 		shardMgr:         node.shardMgr,
+		travelGuard:      node.timeTravelGuard,
 	}
 
 	method := "Query"
@@ -2619,9 +2641,13 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
+		errCode := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, errorutil.ErrResultSizeExceeded) {
+			errCode = commonpb.ErrorCode_OutOfMemory
+		}
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errCode,
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -4367,8 +4393,13 @@ func (node *Proxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHealt
 		return fn("querycoord", resp, err)
 	})
 
+	// a healthy DataCoord reports its maintenance states as the reasons, e.g. the freeze windows
+	var dataCoordReasons []string
 	group.Go(func() error {
 		resp, err := node.dataCoord.CheckHealth(ctx, request)
+		if err == nil && resp.GetIsHealthy() {
+			dataCoordReasons = resp.GetReasons()
+		}
 		return fn("datacoord", resp, err)
 	})
 
@@ -4384,6 +4415,13 @@ func (node *Proxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHealt
 	}
 
 	states, reasons := node.multiRateLimiter.GetQuotaStates()
+	if readOnlyReasons := node.multiRateLimiter.GetReadOnlyReasons(); len(readOnlyReasons) > 0 {
+		if !funcutil.SliceContain(states, milvuspb.QuotaState_DenyToWrite) {
+			states = append(states, milvuspb.QuotaState_DenyToWrite)
+		}
+		reasons = append(reasons, readOnlyReasons...)
+	}
+	reasons = append(reasons, dataCoordReasons...)
 	return &milvuspb.CheckHealthResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
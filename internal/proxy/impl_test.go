@@ -141,4 +141,32 @@ func TestProxy_CheckHealth(t *testing.T) {
 		assert.Equal(t, 2, len(resp.GetQuotaStates()))
 		assert.Equal(t, 2, len(resp.GetReasons()))
 	})
+
+	t.Run("datacoord reasons", func(t *testing.T) {
+		dataCoord := NewDataCoordMock()
+		dataCoord.checkHealthFunc = func(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+			return &milvuspb.CheckHealthResponse{
+				IsHealthy: true,
+				Reasons:   []string{"datacoord handoffs and compaction of cluster are frozen"},
+			}, nil
+		}
+		node := &Proxy{
+			rootCoord:  NewRootCoordMock(),
+			dataCoord:  dataCoord,
+			queryCoord: NewQueryCoordMock(),
+		}
+		node.multiRateLimiter = NewMultiRateLimiter()
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := node.CheckHealth(context.Background(), &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, true, resp.IsHealthy)
+		assert.Equal(t, 0, len(resp.GetQuotaStates()))
+		assert.Equal(t, []string{"datacoord handoffs and compaction of cluster are frozen"}, resp.GetReasons())
+
+		node.multiRateLimiter.SetQuotaStates([]milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite}, []string{"memory quota exhausted"})
+		resp, err = node.CheckHealth(context.Background(), &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, true, resp.IsHealthy)
+		assert.Equal(t, []string{"memory quota exhausted", "datacoord handoffs and compaction of cluster are frozen"}, resp.GetReasons())
+	})
 }
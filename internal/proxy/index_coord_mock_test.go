@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// IndexCoordMock is a mock of IndexCoord, the methods not overridden by a func panic.
+type IndexCoordMock struct {
+	types.IndexCoord
+
+	checkIndexConsistencyFunc func(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error)
+	getIndexStatisticsFunc    func(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
+	cordonIndexNodeFunc       func(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error)
+	triggerGCFunc             func(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error)
+	listIndexTTLsFunc         func(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error)
+	setIndexTTLFunc           func(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error)
+	checkIndexTTLFunc         func(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error)
+	verifyIndexBuildFunc      func(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error)
+}
+
+func (m *IndexCoordMock) CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error) {
+	if m.checkIndexConsistencyFunc != nil {
+		return m.checkIndexConsistencyFunc(ctx, req)
+	}
+	return &indexpb.CheckIndexConsistencyResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *IndexCoordMock) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	if m.getIndexStatisticsFunc != nil {
+		return m.getIndexStatisticsFunc(ctx, req)
+	}
+	return &indexpb.GetIndexStatisticsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *IndexCoordMock) CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+	if m.cordonIndexNodeFunc != nil {
+		return m.cordonIndexNodeFunc(ctx, req)
+	}
+	return &indexpb.CordonIndexNodeResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *IndexCoordMock) TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error) {
+	if m.triggerGCFunc != nil {
+		return m.triggerGCFunc(ctx, req)
+	}
+	return &indexpb.TriggerGCResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *IndexCoordMock) ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+	if m.listIndexTTLsFunc != nil {
+		return m.listIndexTTLsFunc(ctx, req)
+	}
+	return &indexpb.ListIndexTTLsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *IndexCoordMock) SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+	if m.setIndexTTLFunc != nil {
+		return m.setIndexTTLFunc(ctx, req)
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *IndexCoordMock) CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+	if m.checkIndexTTLFunc != nil {
+		return m.checkIndexTTLFunc(ctx, req)
+	}
+	return &indexpb.CheckIndexTTLResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func (m *IndexCoordMock) VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error) {
+	if m.verifyIndexBuildFunc != nil {
+		return m.verifyIndexBuildFunc(ctx, req)
+	}
+	return &indexpb.VerifyIndexBuildResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func NewIndexCoordMock() *IndexCoordMock {
+	return &IndexCoordMock{}
+}
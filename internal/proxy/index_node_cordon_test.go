@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestProxy_CordonIndexNode(t *testing.T) {
+	ctx := context.Background()
+	indexCoord := NewIndexCoordMock()
+	node := &Proxy{indexCoord: indexCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	indexCoord.cordonIndexNodeFunc = func(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &indexpb.CordonIndexNodeResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			States: []*indexpb.IndexNodeState{{NodeID: req.GetNodeID(), Cordoned: req.GetCordon()}},
+		}, nil
+	}
+	resp, err := node.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 1, Cordon: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.True(t, resp.GetStates()[0].GetCordoned())
+
+	indexCoord.cordonIndexNodeFunc = func(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error) {
+		return nil, errors.New("mock")
+	}
+	resp, err = node.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&indexpb.CordonIndexNodeRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.CordonIndexNode(ctx, &indexpb.CordonIndexNodeRequest{NodeID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
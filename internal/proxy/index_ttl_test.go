@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestProxy_IndexTTL(t *testing.T) {
+	ctx := context.Background()
+	indexCoord := NewIndexCoordMock()
+	node := &Proxy{indexCoord: indexCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	indexCoord.listIndexTTLsFunc = func(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &indexpb.ListIndexTTLsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			States: []*indexpb.IndexTTLState{{CollectionID: req.GetCollectionID(), IndexID: 1, TtlDays: 7}},
+		}, nil
+	}
+	listResp, err := node.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{CollectionID: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(10), listResp.GetStates()[0].GetCollectionID())
+
+	indexCoord.setIndexTTLFunc = func(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+		assert.NotNil(t, req.GetBase())
+		assert.Equal(t, int64(7), req.GetTtlDays())
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	status, err := node.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{CollectionID: 10, IndexID: 1, TtlDays: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	indexCoord.checkIndexTTLFunc = func(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &indexpb.CheckIndexTTLResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			States: []*indexpb.IndexTTLState{{IndexID: 1, State: "candidate"}},
+		}, nil
+	}
+	checkResp, err := node.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, checkResp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(checkResp.GetStates()))
+
+	indexCoord.listIndexTTLsFunc = func(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error) {
+		return nil, errors.New("mock")
+	}
+	indexCoord.setIndexTTLFunc = func(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error) {
+		return nil, errors.New("mock")
+	}
+	indexCoord.checkIndexTTLFunc = func(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error) {
+		return nil, errors.New("mock")
+	}
+	listResp, err = node.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+	status, err = node.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	checkResp, err = node.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, checkResp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&indexpb.ListIndexTTLsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeDescribeCollection, privilegeExt.ObjectPrivilege)
+	privilegeExt, err = funcutil.GetPrivilegeExtObj(&indexpb.SetIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+	privilegeExt, err = funcutil.GetPrivilegeExtObj(&indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	listResp, err = node.ListIndexTTLs(ctx, &indexpb.ListIndexTTLsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+	status, err = node.SetIndexTTL(ctx, &indexpb.SetIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	checkResp, err = node.CheckIndexTTL(ctx, &indexpb.CheckIndexTTLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, checkResp.GetStatus().GetErrorCode())
+}
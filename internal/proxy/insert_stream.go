@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// ErrStreamingInsertDisabled is returned if a streaming insert is received while proxy.streamingInsert.enabled is false.
+var ErrStreamingInsertDisabled = errors.New("streaming insert is disabled")
+
+// InsertChunkReceiver returns the next chunk of a streaming insert, and io.EOF after the last chunk.
+type InsertChunkReceiver func() (*milvuspb.InsertRequest, error)
+
+// AssembleInsertStream receives the chunks of rows of a streaming insert and assembles them into one insert request.
+// The first chunk names the collection and the partition, the later chunks may leave the names empty but must not
+// name others. Every chunk carries NumRows rows of the same fields as the first chunk. The total size of the chunks is
+// limited to proxy.streamingInsert.maxSize, and the hash keys are dropped since the insert computes them anyway.
+func AssembleInsertStream(recv InsertChunkReceiver) (*milvuspb.InsertRequest, error) {
+	if !Params.ProxyCfg.StreamingInsertEnabled.GetAsBool() {
+		return nil, ErrStreamingInsertDisabled
+	}
+	maxSize := Params.ProxyCfg.StreamingInsertMaxSize.GetAsInt64() * 1024 * 1024
+
+	var (
+		request *milvuspb.InsertRequest
+		fields  map[string]*schemapb.FieldData
+		size    int64
+	)
+	for i := 0; ; i++ {
+		chunk, err := recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		size += int64(proto.Size(chunk))
+		if size > maxSize {
+			return nil, fmt.Errorf("streaming insert exceeds the max size %d bytes at chunk %d", maxSize, i)
+		}
+		if err := validateInsertChunk(request, fields, chunk); err != nil {
+			return nil, fmt.Errorf("invalid chunk %d of streaming insert: %w", i, err)
+		}
+
+		if request == nil {
+			request = chunk
+			request.HashKeys = nil
+			fields = make(map[string]*schemapb.FieldData, len(chunk.GetFieldsData()))
+			for _, fieldData := range chunk.GetFieldsData() {
+				fields[fieldData.GetFieldName()] = fieldData
+			}
+			continue
+		}
+		for _, fieldData := range chunk.GetFieldsData() {
+			typeutil.MergeFieldData([]*schemapb.FieldData{fields[fieldData.GetFieldName()]}, []*schemapb.FieldData{fieldData})
+		}
+		request.NumRows += chunk.GetNumRows()
+	}
+
+	if request == nil {
+		return nil, errors.New("streaming insert received no chunk")
+	}
+	return request, nil
+}
+
+// validateInsertChunk checks the chunk has NumRows rows of every field, and the same collection and fields as the
+// first chunk, the chunk is the first one if first is nil.
+func validateInsertChunk(first *milvuspb.InsertRequest, fields map[string]*schemapb.FieldData, chunk *milvuspb.InsertRequest) error {
+	if chunk.GetNumRows() == 0 {
+		return errors.New("no rows")
+	}
+	names := make(map[string]struct{}, len(chunk.GetFieldsData()))
+	for _, fieldData := range chunk.GetFieldsData() {
+		name := fieldData.GetFieldName()
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("duplicated field %s", name)
+		}
+		names[name] = struct{}{}
+		numRows, err := funcutil.GetNumRowOfFieldData(fieldData)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+		if numRows != uint64(chunk.GetNumRows()) {
+			return fmt.Errorf("field %s has %d rows, expect %d", name, numRows, chunk.GetNumRows())
+		}
+	}
+
+	if first == nil {
+		if chunk.GetCollectionName() == "" {
+			return errors.New("the first chunk must name the collection")
+		}
+		return nil
+	}
+
+	for _, n := range []struct{ name, expect string }{
+		{chunk.GetDbName(), first.GetDbName()},
+		{chunk.GetCollectionName(), first.GetCollectionName()},
+		{chunk.GetPartitionName(), first.GetPartitionName()},
+	} {
+		if n.name != "" && n.name != n.expect {
+			return fmt.Errorf("name %s differs from %s of the first chunk", n.name, n.expect)
+		}
+	}
+	if len(chunk.GetFieldsData()) != len(fields) {
+		return fmt.Errorf("%d fields, expect %d", len(chunk.GetFieldsData()), len(fields))
+	}
+	for _, fieldData := range chunk.GetFieldsData() {
+		expect, ok := fields[fieldData.GetFieldName()]
+		if !ok {
+			return fmt.Errorf("field %s is not in the first chunk", fieldData.GetFieldName())
+		}
+		// the data of another type would replace the merged data instead of being appended
+		if fieldData.GetType() != expect.GetType() || fieldData.GetFieldId() != expect.GetFieldId() ||
+			reflect.TypeOf(fieldData.GetScalars().GetData()) != reflect.TypeOf(expect.GetScalars().GetData()) ||
+			reflect.TypeOf(fieldData.GetVectors().GetData()) != reflect.TypeOf(expect.GetVectors().GetData()) ||
+			fieldData.GetVectors().GetDim() != expect.GetVectors().GetDim() {
+			return fmt.Errorf("field %s differs from the first chunk", fieldData.GetFieldName())
+		}
+	}
+	return nil
+}
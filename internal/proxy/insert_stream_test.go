@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func genInsertChunk(collection string, pks []int64, dim int64) *milvuspb.InsertRequest {
+	vectors := make([]float32, 0, int64(len(pks))*dim)
+	for _, pk := range pks {
+		for i := int64(0); i < dim; i++ {
+			vectors = append(vectors, float32(pk))
+		}
+	}
+	return &milvuspb.InsertRequest{
+		CollectionName: collection,
+		NumRows:        uint32(len(pks)),
+		HashKeys:       make([]uint32, len(pks)),
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "pk",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+				}},
+			},
+			{
+				Type:      schemapb.DataType_FloatVector,
+				FieldName: "vec",
+				Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+					Dim:  dim,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+				}},
+			},
+		},
+	}
+}
+
+func insertChunkReceiver(chunks ...*milvuspb.InsertRequest) InsertChunkReceiver {
+	return func() (*milvuspb.InsertRequest, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		return chunk, nil
+	}
+}
+
+func TestAssembleInsertStream(t *testing.T) {
+	_, err := AssembleInsertStream(insertChunkReceiver(genInsertChunk("c", []int64{1}, 2)))
+	assert.ErrorIs(t, err, ErrStreamingInsertDisabled)
+
+	paramtable.Get().Save(Params.ProxyCfg.StreamingInsertEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.StreamingInsertEnabled.Key)
+
+	t.Run("assemble", func(t *testing.T) {
+		second := genInsertChunk("", []int64{3}, 2)
+		third := genInsertChunk("c", []int64{4, 5}, 2)
+		request, err := AssembleInsertStream(insertChunkReceiver(genInsertChunk("c", []int64{1, 2}, 2), second, third))
+		require.NoError(t, err)
+		assert.Equal(t, "c", request.GetCollectionName())
+		assert.Equal(t, uint32(5), request.GetNumRows())
+		assert.Nil(t, request.GetHashKeys())
+		require.Equal(t, 2, len(request.GetFieldsData()))
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, request.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []float32{1, 1, 2, 2, 3, 3, 4, 4, 5, 5}, request.GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+		assert.Equal(t, int64(2), request.GetFieldsData()[1].GetVectors().GetDim())
+	})
+
+	t.Run("invalid chunks", func(t *testing.T) {
+		noRows := genInsertChunk("c", []int64{2}, 2)
+		noRows.NumRows = 0
+		rowsMismatch := genInsertChunk("c", []int64{2}, 2)
+		rowsMismatch.NumRows = 2
+		otherPartition := genInsertChunk("c", []int64{2}, 2)
+		otherPartition.PartitionName = "p"
+		missingField := genInsertChunk("c", []int64{2}, 2)
+		missingField.FieldsData = missingField.FieldsData[:1]
+		duplicatedField := genInsertChunk("c", []int64{2}, 2)
+		duplicatedField.FieldsData[1] = duplicatedField.FieldsData[0]
+		otherType := genInsertChunk("c", []int64{2}, 2)
+		otherType.FieldsData[0].Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{2}}},
+		}}
+
+		cases := map[string][]*milvuspb.InsertRequest{
+			"no chunk":            {},
+			"no collection":       {genInsertChunk("", []int64{1}, 2)},
+			"no rows":             {genInsertChunk("c", []int64{1}, 2), noRows},
+			"rows mismatch":       {genInsertChunk("c", []int64{1}, 2), rowsMismatch},
+			"other collection":    {genInsertChunk("c", []int64{1}, 2), genInsertChunk("d", []int64{2}, 2)},
+			"other partition":     {genInsertChunk("c", []int64{1}, 2), otherPartition},
+			"missing field":       {genInsertChunk("c", []int64{1}, 2), missingField},
+			"duplicated field":    {genInsertChunk("c", []int64{1}, 2), duplicatedField},
+			"other dim":           {genInsertChunk("c", []int64{1}, 2), genInsertChunk("c", []int64{2}, 4)},
+			"other type of field": {genInsertChunk("c", []int64{1}, 2), otherType},
+		}
+		for name, chunks := range cases {
+			_, err := AssembleInsertStream(insertChunkReceiver(chunks...))
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("max size", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.StreamingInsertMaxSize.Key, "1")
+		defer paramtable.Get().Reset(Params.ProxyCfg.StreamingInsertMaxSize.Key)
+		pks := make([]int64, 1024)
+		_, err := AssembleInsertStream(insertChunkReceiver(genInsertChunk("c", pks, 64), genInsertChunk("c", pks, 64),
+			genInsertChunk("c", pks, 64), genInsertChunk("c", pks, 64), genInsertChunk("c", pks, 64)))
+		assert.Error(t, err)
+		request, err := AssembleInsertStream(insertChunkReceiver(genInsertChunk("c", pks, 64), genInsertChunk("c", pks, 64)))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2048), request.GetNumRows())
+	})
+
+	t.Run("receive error", func(t *testing.T) {
+		errRecv := errors.New("mock recv error")
+		_, err := AssembleInsertStream(func() (*milvuspb.InsertRequest, error) { return nil, errRecv })
+		assert.ErrorIs(t, err, errRecv)
+	})
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"math"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// insertValidationReject fails the inserts with a wrong vector dim or NaN/Inf vector values.
+	insertValidationReject = "reject"
+	// insertValidationSanitize replaces the NaN/Inf vector values with 0 and fails the inserts with a wrong vector dim.
+	insertValidationSanitize = "sanitize"
+
+	// maxReportedInvalidPKs is the max number of primary keys of the invalid rows reported for an insert.
+	maxReportedInvalidPKs = 100
+)
+
+// validateInsertVectors validates the vector fields of the aligned insert message by proxy.insertValidation.policy.
+// A vector field whose dim differs from the schema fails the insert. The float vector rows with NaN/Inf values fail
+// the insert on reject, and have those values replaced with 0 on sanitize. Any other policy skips the validation.
+// ids are the primary keys of the rows, reported in the error.
+func validateInsertVectors(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg, ids *schemapb.IDs) error {
+	policy := Params.ProxyCfg.InsertValidationPolicy.GetValue()
+	if policy != insertValidationReject && policy != insertValidationSanitize {
+		return nil
+	}
+
+	numRows := int64(insertMsg.NRows())
+	for _, field := range schema.GetFields() {
+		if !typeutil.IsVectorType(field.GetDataType()) {
+			continue
+		}
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return err
+		}
+		var vectors *schemapb.VectorField
+		for _, fieldData := range insertMsg.GetFieldsData() {
+			if fieldData.GetFieldId() == field.GetFieldID() {
+				vectors = fieldData.GetVectors()
+			}
+		}
+		if vectors == nil {
+			continue
+		}
+		if vectors.GetDim() != dim {
+			return fmt.Errorf("the dim (%d) of vector field %s mis-match with the dim (%d) of the schema",
+				vectors.GetDim(), field.GetName(), dim)
+		}
+		if field.GetDataType() != schemapb.DataType_FloatVector {
+			continue
+		}
+
+		data := vectors.GetFloatVector().GetData()
+		invalid := make([]interface{}, 0)
+		invalidNum := 0
+		for i := int64(0); i < numRows && (i+1)*dim <= int64(len(data)); i++ {
+			row := data[i*dim : (i+1)*dim]
+			rowInvalid := false
+			for j, v := range row {
+				if !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0) {
+					continue
+				}
+				rowInvalid = true
+				if policy != insertValidationSanitize {
+					break
+				}
+				row[j] = 0
+			}
+			if !rowInvalid {
+				continue
+			}
+			invalidNum++
+			if len(invalid) < maxReportedInvalidPKs {
+				invalid = append(invalid, typeutil.GetPK(ids, i))
+			}
+		}
+		if invalidNum == 0 {
+			continue
+		}
+		if policy == insertValidationSanitize {
+			log.Warn("sanitized NaN/Inf vector values of inserted rows", zap.String("collection", insertMsg.GetCollectionName()),
+				zap.String("field", field.GetName()), zap.Int("rowNum", invalidNum), zap.Any("pks", invalid))
+			continue
+		}
+		return fmt.Errorf("float vector field %s has NaN/Inf values in %d rows, primary keys: %v",
+			field.GetName(), invalidNum, invalid)
+	}
+	return nil
+}
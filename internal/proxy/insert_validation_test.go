@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestValidateInsertVectors(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "fvec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 102, Name: "bvec", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+		},
+	}
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}
+	newMsg := func(floatVectors []float32, floatDim int64) *msgstream.InsertMsg {
+		return &msgstream.InsertMsg{
+			InsertRequest: internalpb.InsertRequest{
+				CollectionName: "coll",
+				NumRows:        3,
+				Version:        internalpb.InsertDataVersion_ColumnBased,
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:    schemapb.DataType_FloatVector,
+						FieldId: 101,
+						Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+							Dim:  floatDim,
+							Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: floatVectors}},
+						}},
+					},
+					{
+						Type:    schemapb.DataType_BinaryVector,
+						FieldId: 102,
+						Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+							Dim:  8,
+							Data: &schemapb.VectorField_BinaryVector{BinaryVector: []byte{1, 2, 3}},
+						}},
+					},
+				},
+			},
+		}
+	}
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+	defer paramtable.Get().Reset(Params.ProxyCfg.InsertValidationPolicy.Key)
+
+	t.Run("none", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.InsertValidationPolicy.Key, "none")
+		msg := newMsg([]float32{1, 2, 3, 4, 5, nan, 7, 8, 9}, 3)
+		assert.NoError(t, validateInsertVectors(schema, msg, ids))
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.InsertValidationPolicy.Key, insertValidationReject)
+		assert.NoError(t, validateInsertVectors(schema, newMsg([]float32{1, 2, 3, 4, 5, 6}, 2), ids))
+
+		err := validateInsertVectors(schema, newMsg([]float32{1, nan, 3, 4, inf, 6}, 2), ids)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "in 2 rows, primary keys: [1 3]")
+	})
+
+	t.Run("sanitize", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.InsertValidationPolicy.Key, insertValidationSanitize)
+		msg := newMsg([]float32{1, nan, 3, 4, inf, nan}, 2)
+		assert.NoError(t, validateInsertVectors(schema, msg, ids))
+		assert.Equal(t, []float32{1, 0, 3, 4, 0, 0}, msg.FieldsData[0].GetVectors().GetFloatVector().GetData())
+	})
+
+	t.Run("wrong dim", func(t *testing.T) {
+		// 3 rows of the passed dim 3, but the dim of the schema is 2
+		for _, policy := range []string{insertValidationReject, insertValidationSanitize} {
+			paramtable.Get().Save(Params.ProxyCfg.InsertValidationPolicy.Key, policy)
+			assert.Error(t, validateInsertVectors(schema, newMsg([]float32{1, 2, 3, 4, 5, 6, 7, 8, 9}, 3), ids))
+		}
+	})
+}
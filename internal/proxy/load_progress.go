@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// errInvalidLoadProgressRequest is returned when the collection or partitions in the request are invalid.
+var errInvalidLoadProgressRequest = errors.New("invalid load progress request")
+
+// loadedSegmentPhase is the phase of the segments loaded on a QueryNode, which are left out of the load progress.
+const loadedSegmentPhase = "loaded"
+
+// GetLoadProgressDetail returns the load progress of the collection or the partitions, along with the progress of
+// every segment being loaded reported by the QueryNodes through QueryCoord.
+func (node *Proxy) GetLoadProgressDetail(ctx context.Context, req *proxypb.GetLoadProgressDetailRequest) (*proxypb.GetLoadProgressDetailResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.GetLoadProgressDetailResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetLoadProgressDetail"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", req.GetCollectionName()))
+	log.Debug(rpcReceived(method))
+
+	resp, err := node.getLoadProgressDetail(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		errorCode := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, errInvalidLoadProgressRequest) {
+			errorCode = commonpb.ErrorCode_IllegalArgument
+		}
+		return &proxypb.GetLoadProgressDetailResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errorCode,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int64("progress", resp.GetProgress()), zap.Int("segments", len(resp.GetSegments())))
+	return resp, nil
+}
+
+func (node *Proxy) getLoadProgressDetail(ctx context.Context, req *proxypb.GetLoadProgressDetailRequest) (*proxypb.GetLoadProgressDetailResponse, error) {
+	if req.GetCollectionName() == "" {
+		return nil, fmt.Errorf("%w: collection_name is required", errInvalidLoadProgressRequest)
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidLoadProgressRequest, err)
+	}
+	var partitionIDs typeutil.UniqueSet
+	if len(req.GetPartitionNames()) > 0 {
+		partitionIDs = typeutil.NewUniqueSet()
+		for _, name := range req.GetPartitionNames() {
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetCollectionName(), name)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", errInvalidLoadProgressRequest, err)
+			}
+			partitionIDs.Insert(partitionID)
+		}
+	}
+
+	msgBase := commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_SystemInfo),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	var progress int64
+	if partitionIDs == nil {
+		progress, err = getCollectionProgress(ctx, node.queryCoord, msgBase, collectionID)
+	} else {
+		progress, err = getPartitionProgress(ctx, node.queryCoord, msgBase, req.GetPartitionNames(), req.GetCollectionName(), collectionID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	metricsReq, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SegmentLoadProgressMetrics)
+	if err != nil {
+		return nil, err
+	}
+	metricsResp, err := node.queryCoord.GetMetrics(ctx, metricsReq)
+	if err = checkLifecycleStatus("queryCoord:GetMetrics", metricsResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	var segments []metricsinfo.SegmentLoadMetrics
+	if err := json.Unmarshal([]byte(metricsResp.GetResponse()), &segments); err != nil {
+		return nil, fmt.Errorf("invalid segment load progress from QueryCoord, err: %w", err)
+	}
+
+	resp := &proxypb.GetLoadProgressDetailResponse{
+		Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Progress:                  progress,
+		EstimatedRemainingSeconds: -1,
+		Segments:                  make([]*proxypb.SegmentLoadProgress, 0),
+	}
+	if progress >= 100 {
+		resp.EstimatedRemainingSeconds = 0
+	}
+	for _, segment := range segments {
+		if segment.CollectionID != collectionID || segment.Phase == loadedSegmentPhase {
+			continue
+		}
+		if partitionIDs != nil && !partitionIDs.Contain(segment.PartitionID) {
+			continue
+		}
+		resp.Segments = append(resp.Segments, &proxypb.SegmentLoadProgress{
+			SegmentID:                 segment.SegmentID,
+			CollectionID:              segment.CollectionID,
+			PartitionID:               segment.PartitionID,
+			NodeID:                    segment.NodeID,
+			Phase:                     segment.Phase,
+			TotalFiles:                int64(segment.TotalFiles),
+			DownloadedFiles:           int64(segment.DownloadedFiles),
+			DownloadPercent:           segment.DownloadPercent,
+			StartTime:                 segment.StartTime,
+			ElapsedSeconds:            segment.ElapsedSeconds,
+			EstimatedRemainingSeconds: segment.EstimatedRemainingSeconds,
+			FailedReason:              segment.FailedReason,
+		})
+		if segment.EstimatedRemainingSeconds > resp.EstimatedRemainingSeconds {
+			resp.EstimatedRemainingSeconds = segment.EstimatedRemainingSeconds
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestProxy_GetLoadProgressDetail(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getIDFunc = func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	}
+	mockCache.getPartitionIDFunc = func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		if partitionName != "p2" {
+			return 0, errors.New("partition not found")
+		}
+		return 2, nil
+	}
+	globalMetaCache = mockCache
+
+	segments := []metricsinfo.SegmentLoadMetrics{
+		{SegmentID: 10, CollectionID: 1, PartitionID: 2, Phase: "loading_index", EstimatedRemainingSeconds: 30},
+		{SegmentID: 11, CollectionID: 1, PartitionID: 3, Phase: "downloading", EstimatedRemainingSeconds: 60},
+		{SegmentID: 12, CollectionID: 1, PartitionID: 2, Phase: "pending", EstimatedRemainingSeconds: -1},
+		{SegmentID: 13, CollectionID: 1, PartitionID: 2, Phase: "loaded"},
+		{SegmentID: 14, CollectionID: 5, PartitionID: 6, Phase: "downloading", EstimatedRemainingSeconds: 90},
+	}
+	qc := NewQueryCoordMock(
+		SetQueryCoordShowCollectionsFunc(func(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+			return &querypb.ShowCollectionsResponse{Status: &commonpb.Status{}, CollectionIDs: []int64{1}, InMemoryPercentages: []int64{40}}, nil
+		}),
+		SetQueryCoordShowPartitionsFunc(func(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+			assert.Equal(t, []int64{2}, req.GetPartitionIDs())
+			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{}, PartitionIDs: []int64{2}, InMemoryPercentages: []int64{50}}, nil
+		}),
+	)
+	qc.updateState(commonpb.StateCode_Healthy)
+	qc.getMetricsFunc = func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+		metricType, err := metricsinfo.ParseMetricType(req.GetRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, metricsinfo.SegmentLoadProgressMetrics, metricType)
+		resp, err := json.Marshal(segments)
+		assert.NoError(t, err)
+		return &milvuspb.GetMetricsResponse{Status: &commonpb.Status{}, Response: string(resp)}, nil
+	}
+
+	node := &Proxy{queryCoord: qc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	t.Run("collection", func(t *testing.T) {
+		resp, err := node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(40), resp.GetProgress())
+		assert.Equal(t, float64(60), resp.GetEstimatedRemainingSeconds())
+		ids := make([]int64, 0)
+		for _, segment := range resp.GetSegments() {
+			ids = append(ids, segment.GetSegmentID())
+		}
+		assert.Equal(t, []int64{10, 11, 12}, ids)
+	})
+
+	t.Run("partition", func(t *testing.T) {
+		resp, err := node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{CollectionName: "coll", PartitionNames: []string{"p2"}})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(50), resp.GetProgress())
+		assert.Equal(t, float64(30), resp.GetEstimatedRemainingSeconds())
+		assert.Equal(t, 2, len(resp.GetSegments()))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		resp, err := node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+		resp, err = node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{CollectionName: "unknown"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+		resp, err = node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{CollectionName: "coll", PartitionNames: []string{"unknown"}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("coord fail", func(t *testing.T) {
+		getMetricsFunc := qc.getMetricsFunc
+		defer func() { qc.getMetricsFunc = getMetricsFunc }()
+		qc.getMetricsFunc = func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			return &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}, nil
+		}
+		resp, err := node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("privilege", func(t *testing.T) {
+		privilegeExt, err := funcutil.GetPrivilegeExtObj(&proxypb.GetLoadProgressDetailRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+		assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeLoad, privilegeExt.ObjectPrivilege)
+		assert.Equal(t, "coll", funcutil.GetObjectName(&proxypb.GetLoadProgressDetailRequest{CollectionName: "coll"}, privilegeExt.ObjectNameIndex))
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer node.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := node.GetLoadProgressDetail(ctx, &proxypb.GetLoadProgressDetailRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
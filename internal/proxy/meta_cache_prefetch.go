@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getDefaultDBPrefetchCollections returns the names of the collections to prefetch, the configured ones,
+// or the loaded ones if none is configured. The meta cache is keyed by the collection name only, so only
+// the collections of util.DefaultDBName are prefetched.
+func (node *Proxy) getDefaultDBPrefetchCollections(ctx context.Context) ([]string, error) {
+	maxNum := Params.ProxyCfg.MetaPrefetchMaxCollections.GetAsInt()
+	limit := func(names []string) []string {
+		if maxNum > 0 && len(names) > maxNum {
+			return names[:maxNum]
+		}
+		return names
+	}
+
+	names := make([]string, 0)
+	for _, name := range strings.Split(Params.ProxyCfg.MetaPrefetchCollections.GetValue(), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		return limit(names), nil
+	}
+
+	collResp, err := node.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName: util.DefaultDBName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if collResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("showCollections failed, reason = %s", collResp.GetStatus().GetReason())
+	}
+	loadResp, err := node.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if loadResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("showCollections failed, reason = %s", loadResp.GetStatus().GetReason())
+	}
+
+	loaded := make(map[UniqueID]struct{}, len(loadResp.GetCollectionIDs()))
+	for _, collectionID := range loadResp.GetCollectionIDs() {
+		loaded[collectionID] = struct{}{}
+	}
+	for i, collectionID := range collResp.GetCollectionIds() {
+		if _, ok := loaded[collectionID]; ok && i < len(collResp.GetCollectionNames()) {
+			names = append(names, collResp.GetCollectionNames()[i])
+		}
+	}
+	return limit(names), nil
+}
+
+// prefetchCollection warms the schema, the partitions and the shard leaders of the collection in globalMetaCache.
+func prefetchCollection(ctx context.Context, collectionName string) error {
+	info, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	if _, err := globalMetaCache.GetPartitions(ctx, collectionName); err != nil {
+		return err
+	}
+	if info.isLoaded {
+		if _, err := globalMetaCache.GetShards(ctx, true, collectionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prefetchMetaCache warms globalMetaCache for the collections of the default database to prefetch, a failed
+// collection is skipped, its first request fetches the meta as before.
+func (node *Proxy) prefetchMetaCache(ctx context.Context) {
+	if !Params.ProxyCfg.MetaPrefetchEnabled.GetAsBool() || globalMetaCache == nil {
+		return
+	}
+	names, err := node.getDefaultDBPrefetchCollections(ctx)
+	if err != nil {
+		log.Warn("failed to get the collections to prefetch meta", zap.Error(err))
+		return
+	}
+
+	parallelism := Params.ProxyCfg.MetaPrefetchParallelism.GetAsInt()
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+	sem := make(chan struct{}, parallelism)
+	wg := &sync.WaitGroup{}
+	failedMut := &sync.Mutex{}
+	failed := make([]string, 0)
+	for _, name := range names {
+		name := name
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := prefetchCollection(ctx, name); err != nil {
+				log.Warn("failed to prefetch collection meta", zap.String("collection", name), zap.Error(err))
+				failedMut.Lock()
+				failed = append(failed, name)
+				failedMut.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	log.Info("meta cache prefetched", zap.Int("collectionNum", len(names)), zap.Strings("failed", failed))
+}
+
+// startMetaPrefetch prefetches the meta cache in background on startup, and again once a new RootCoord comes up.
+func (node *Proxy) startMetaPrefetch() {
+	if node.session == nil {
+		return
+	}
+	_, revision, err := node.session.GetSessions(typeutil.RootCoordRole)
+	if err != nil {
+		log.Warn("failed to get rootcoord sessions, meta cache is not prefetched after rootcoord failover", zap.Error(err))
+	}
+
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+		node.prefetchMetaCache(node.ctx)
+		if err != nil {
+			return
+		}
+
+		eventCh := node.session.WatchServices(typeutil.RootCoordRole, revision+1, nil)
+		for {
+			select {
+			case <-node.ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+				if event.EventType == sessionutil.SessionAddEvent {
+					log.Info("rootcoord comes up, prefetch meta cache", zap.Int64("serverID", event.Session.ServerID))
+					node.prefetchMetaCache(node.ctx)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestProxy_PrefetchMetaCache(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.ProxyCfg.MetaPrefetchEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.MetaPrefetchEnabled.Key)
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	rc.collName2ID["coll1"] = 1
+	rc.collName2ID["coll2"] = 2
+	rc.collName2ID["coll3"] = 3
+	qc := NewQueryCoordMock()
+	qc.Start()
+	qc.showCollectionsFunc = func(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+		return &querypb.ShowCollectionsResponse{
+			Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollectionIDs:       []int64{1, 3},
+			InMemoryPercentages: []int64{100, 100},
+		}, nil
+	}
+	rootCoord := &showCollectionsRecorder{RootCoordMock: rc}
+	node := &Proxy{rootCoord: rootCoord, queryCoord: qc}
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mu := sync.Mutex{}
+	fetched := make(map[string]int)
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetched[name]++
+	}
+	mockCache := newMockCache()
+	mockCache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		record(collectionName)
+		if collectionName == "unknown" {
+			return nil, errors.New("collection not found")
+		}
+		return &collectionInfo{isLoaded: true}, nil
+	})
+	mockCache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		record(collectionName)
+		return map[string]typeutil.UniqueID{}, nil
+	})
+	mockCache.getShardsFunc = func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+		record(collectionName)
+		return map[string][]nodeInfo{}, nil
+	}
+	globalMetaCache = mockCache
+
+	t.Run("loaded collections", func(t *testing.T) {
+		names, err := node.getDefaultDBPrefetchCollections(ctx)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"coll1", "coll3"}, names)
+
+		paramtable.Get().Save(Params.ProxyCfg.MetaPrefetchMaxCollections.Key, "1")
+		defer paramtable.Get().Reset(Params.ProxyCfg.MetaPrefetchMaxCollections.Key)
+		names, err = node.getDefaultDBPrefetchCollections(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(names))
+	})
+
+	t.Run("default database only", func(t *testing.T) {
+		rootCoord.reqs = nil
+		_, err := node.getDefaultDBPrefetchCollections(ctx)
+		assert.NoError(t, err)
+		if assert.Equal(t, 1, len(rootCoord.reqs)) {
+			assert.Equal(t, util.DefaultDBName, rootCoord.reqs[0].GetDbName())
+		}
+	})
+
+	t.Run("configured collections", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.MetaPrefetchCollections.Key, "coll2, unknown,")
+		defer paramtable.Get().Reset(Params.ProxyCfg.MetaPrefetchCollections.Key)
+		names, err := node.getDefaultDBPrefetchCollections(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"coll2", "unknown"}, names)
+
+		fetched = make(map[string]int)
+		node.prefetchMetaCache(ctx)
+		assert.Equal(t, 3, fetched["coll2"])
+		assert.Equal(t, 1, fetched["unknown"])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.MetaPrefetchEnabled.Key, "false")
+		defer paramtable.Get().Save(Params.ProxyCfg.MetaPrefetchEnabled.Key, "true")
+		fetched = make(map[string]int)
+		node.prefetchMetaCache(ctx)
+		assert.Empty(t, fetched)
+	})
+
+	t.Run("coord failed", func(t *testing.T) {
+		qc.showCollectionsFunc = func(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+			return nil, errors.New("mock error")
+		}
+		_, err := node.getDefaultDBPrefetchCollections(ctx)
+		assert.Error(t, err)
+		fetched = make(map[string]int)
+		node.prefetchMetaCache(ctx)
+		assert.Empty(t, fetched)
+
+		rc.updateState(commonpb.StateCode_Abnormal)
+		_, err = node.getDefaultDBPrefetchCollections(ctx)
+		assert.Error(t, err)
+	})
+}
+
+// showCollectionsRecorder records the ShowCollections requests sent to RootCoord.
+type showCollectionsRecorder struct {
+	*RootCoordMock
+	reqs []*milvuspb.ShowCollectionsRequest
+}
+
+func (r *showCollectionsRecorder) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	r.reqs = append(r.reqs, req)
+	return r.RootCoordMock.ShowCollections(ctx, req)
+}
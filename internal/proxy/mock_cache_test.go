@@ -12,6 +12,8 @@ type getCollectionSchemaFunc func(ctx context.Context, collectionName string) (*
 type getCollectionInfoFunc func(ctx context.Context, collectionName string) (*collectionInfo, error)
 type getUserRoleFunc func(username string) []string
 type getPartitionIDFunc func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+type getPartitionsFunc func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+type getShardsFunc func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error)
 
 type mockCache struct {
 	Cache
@@ -20,6 +22,8 @@ type mockCache struct {
 	getInfoFunc        getCollectionInfoFunc
 	getUserRoleFunc    getUserRoleFunc
 	getPartitionIDFunc getPartitionIDFunc
+	getPartitionsFunc  getPartitionsFunc
+	getShardsFunc      getShardsFunc
 }
 
 func (m *mockCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
@@ -53,6 +57,20 @@ func (m *mockCache) GetPartitionID(ctx context.Context, collectionName string, p
 	return 0, nil
 }
 
+func (m *mockCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+	if m.getPartitionsFunc != nil {
+		return m.getPartitionsFunc(ctx, collectionName)
+	}
+	return map[string]typeutil.UniqueID{}, nil
+}
+
+func (m *mockCache) GetShards(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+	if m.getShardsFunc != nil {
+		return m.getShardsFunc(ctx, withCache, collectionName)
+	}
+	return map[string][]nodeInfo{}, nil
+}
+
 func (m *mockCache) GetUserRole(username string) []string {
 	if m.getUserRoleFunc != nil {
 		return m.getUserRoleFunc(username)
@@ -76,6 +94,10 @@ func (m *mockCache) setGetPartitionIDFunc(f getPartitionIDFunc) {
 	m.getPartitionIDFunc = f
 }
 
+func (m *mockCache) setGetPartitionsFunc(f getPartitionsFunc) {
+	m.getPartitionsFunc = f
+}
+
 func newMockCache() *mockCache {
 	return &mockCache{}
 }
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
 )
@@ -36,14 +38,34 @@ import (
 type MultiRateLimiter struct {
 	globalRateLimiter *rateLimiter
 	// TODO: add collection level rateLimiter
-	quotaStatesMu sync.RWMutex
-	quotaStates   map[milvuspb.QuotaState]string
+	roleQuotaLimiter *roleQuotaLimiter
+	quotaStatesMu    sync.RWMutex
+	quotaStates      map[milvuspb.QuotaState]string
+	// quotaStatesSince records when each of the current quota states was entered
+	quotaStatesSince map[milvuspb.QuotaState]time.Time
+	// collectionUsage aggregates the dml tokens per collection for the pacing hints
+	collectionUsage *collectionUsage
+	// databaseQuotaLimiter isolates the request rates of the databases
+	databaseQuotaLimiter *databaseQuotaLimiter
+	// sloShedder sheds the low priority requests while the latency slo burns
+	sloShedder *sloShedder
+	// readOnlyGuard rejects the writes to the cluster or to the databases in read-only mode
+	readOnlyGuard *readOnlyGuard
 }
 
 // NewMultiRateLimiter returns a new MultiRateLimiter.
 func NewMultiRateLimiter() *MultiRateLimiter {
 	m := &MultiRateLimiter{}
 	m.globalRateLimiter = newRateLimiter()
+	m.roleQuotaLimiter = newRoleQuotaLimiter()
+	m.databaseQuotaLimiter = newDatabaseQuotaLimiter()
+	m.sloShedder = newSLOShedder()
+	m.readOnlyGuard = newReadOnlyGuard()
+	usage, err := newCollectionUsage()
+	if err != nil {
+		log.Warn("failed to create the collection usage collector, the pacing hints are not aggregated per collection", zap.Error(err))
+	}
+	m.collectionUsage = usage
 	return m
 }
 
@@ -62,6 +84,58 @@ func (m *MultiRateLimiter) Check(rt internalpb.RateType, n int) error {
 	return nil
 }
 
+// CheckUser checks if request of the user would be limited or denied by the quotas of the user's roles.
+func (m *MultiRateLimiter) CheckUser(username string, rt internalpb.RateType, n int) error {
+	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() || !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil
+	}
+	if username == util.UserRoot || m.roleQuotaLimiter.empty() {
+		return nil
+	}
+	roles, err := GetRole(username)
+	if err != nil {
+		return nil
+	}
+	roles = append(roles, util.RolePublic)
+	limit, rate := m.roleQuotaLimiter.limit(username, roles, rt, n)
+	if rate == 0 {
+		return wrapRoleQuotaDenyError(rt, username)
+	}
+	if limit {
+		return wrapRateLimitError()
+	}
+	return nil
+}
+
+// CheckDatabase checks if request to the collection of the database would be limited or denied
+// by the quota of the database.
+func (m *MultiRateLimiter) CheckDatabase(dbName, collectionName string, rt internalpb.RateType, n int) error {
+	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() || m.databaseQuotaLimiter.empty() {
+		return nil
+	}
+	limit, rate := m.databaseQuotaLimiter.limit(dbName, collectionName, rt, n)
+	if rate == 0 {
+		if dbName == "" {
+			dbName = util.DefaultDBName
+		}
+		return wrapDatabaseQuotaDenyError(rt, dbName)
+	}
+	if limit {
+		return wrapRateLimitError()
+	}
+	return nil
+}
+
+// AdmitSLO checks if request of the user to the collection would be shed to protect the latency slo.
+func (m *MultiRateLimiter) AdmitSLO(username, collectionName string, rt internalpb.RateType) error {
+	return m.sloShedder.admit(username, collectionName, rt)
+}
+
+// ObserveSLO records the latency of a request admitted.
+func (m *MultiRateLimiter) ObserveSLO(rt internalpb.RateType, latency time.Duration) {
+	m.sloShedder.observe(rt, latency)
+}
+
 // GetQuotaStates returns quota states.
 func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 	m.quotaStatesMu.RLock()
@@ -91,10 +165,36 @@ func (m *MultiRateLimiter) GetWriteStateReason() string {
 func (m *MultiRateLimiter) SetQuotaStates(states []milvuspb.QuotaState, reasons []string) {
 	m.quotaStatesMu.Lock()
 	defer m.quotaStatesMu.Unlock()
+	now := time.Now()
+	since := make(map[milvuspb.QuotaState]time.Time, len(states))
 	m.quotaStates = make(map[milvuspb.QuotaState]string, len(states))
 	for i := 0; i < len(states); i++ {
 		m.quotaStates[states[i]] = reasons[i]
+		if t, ok := m.quotaStatesSince[states[i]]; ok {
+			since[states[i]] = t
+		} else {
+			since[states[i]] = now
+		}
+	}
+	m.quotaStatesSince = since
+}
+
+// GetQuotaStateInfos returns the current quota states with their reasons and the time entered, ordered by state.
+func (m *MultiRateLimiter) GetQuotaStateInfos() []quotaStateInfo {
+	m.quotaStatesMu.RLock()
+	defer m.quotaStatesMu.RUnlock()
+	infos := make([]quotaStateInfo, 0, len(m.quotaStates))
+	for state, reason := range m.quotaStates {
+		infos = append(infos, quotaStateInfo{
+			state:  state,
+			reason: reason,
+			since:  m.quotaStatesSince[state],
+		})
 	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].state < infos[j].state
+	})
+	return infos
 }
 
 // rateLimiter implements Limiter.
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// outputAliasSeparator separates the field name and its alias in an output field, e.g. "price AS cost".
+const outputAliasSeparator = " as "
+
+// parseOutputAliases strips the aliases from the output fields and returns the aliases by field name.
+// An output field is aliased by "field AS alias", or by the entry of the comma-separated OutputAliasKey
+// param at the same position, an empty entry leaves the field unaliased.
+func parseOutputAliases(outputFields []string, params []*commonpb.KeyValuePair) ([]string, map[string]string, error) {
+	var aliasList []string
+	if value, err := funcutil.GetAttrByKeyFromRepeatedKV(OutputAliasKey, params); err == nil && value != "" {
+		aliasList = strings.Split(value, ",")
+		if len(aliasList) != len(outputFields) {
+			return nil, nil, fmt.Errorf("%s has %d aliases, but there are %d output fields",
+				OutputAliasKey, len(aliasList), len(outputFields))
+		}
+	}
+
+	fields := make([]string, 0, len(outputFields))
+	aliases := make(map[string]string)
+	for i, outputField := range outputFields {
+		field, alias := strings.TrimSpace(outputField), ""
+		if idx := strings.LastIndex(strings.ToLower(field), outputAliasSeparator); idx >= 0 {
+			field, alias = strings.TrimSpace(field[:idx]), strings.TrimSpace(field[idx+len(outputAliasSeparator):])
+			if alias == "" {
+				return nil, nil, fmt.Errorf("alias of output field %s should not be empty", field)
+			}
+		}
+		if aliasList != nil && strings.TrimSpace(aliasList[i]) != "" {
+			if alias != "" {
+				return nil, nil, fmt.Errorf("output field %s is aliased twice", field)
+			}
+			alias = strings.TrimSpace(aliasList[i])
+		}
+		fields = append(fields, field)
+		if alias == "" {
+			continue
+		}
+		if field == "*" || field == "%" {
+			return nil, nil, fmt.Errorf("wildcard output field %s can't be aliased", field)
+		}
+		if err := validateFieldName(alias); err != nil {
+			return nil, nil, fmt.Errorf("invalid alias of output field %s: %w", field, err)
+		}
+		if existing, ok := aliases[field]; ok && existing != alias {
+			return nil, nil, fmt.Errorf("output field %s is aliased as both %s and %s", field, existing, alias)
+		}
+		aliases[field] = alias
+	}
+	return fields, aliases, nil
+}
+
+// checkOutputAliases checks the aliased fields are output and the response field names are unique,
+// outputFields are the translated output fields.
+func checkOutputAliases(aliases map[string]string, outputFields []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+	names := make(map[string]string, len(outputFields))
+	for _, field := range outputFields {
+		name := field
+		if alias, ok := aliases[field]; ok {
+			name = alias
+		}
+		if other, ok := names[name]; ok {
+			return fmt.Errorf("response field name %s of output field %s conflicts with output field %s", name, field, other)
+		}
+		names[name] = field
+	}
+	for field := range aliases {
+		if !funcutil.SliceContain(outputFields, field) {
+			return fmt.Errorf("aliased field %s is not an output field", field)
+		}
+	}
+	return nil
+}
+
+// applyOutputAliases renames the fields of the results to their aliases.
+func applyOutputAliases(fieldsData []*schemapb.FieldData, aliases map[string]string) {
+	if len(aliases) == 0 {
+		return
+	}
+	for _, fieldData := range fieldsData {
+		if fieldData == nil {
+			continue
+		}
+		if alias, ok := aliases[fieldData.GetFieldName()]; ok {
+			fieldData.FieldName = alias
+		}
+	}
+}
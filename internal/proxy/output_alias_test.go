@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func TestParseOutputAliases(t *testing.T) {
+	fields, aliases, err := parseOutputAliases([]string{"a AS x", " b as y ", "c", "*"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "*"}, fields)
+	assert.Equal(t, map[string]string{"a": "x", "b": "y"}, aliases)
+
+	params := []*commonpb.KeyValuePair{{Key: OutputAliasKey, Value: "x,,z"}}
+	fields, aliases, err = parseOutputAliases([]string{"a", "b", "c"}, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, fields)
+	assert.Equal(t, map[string]string{"a": "x", "c": "z"}, aliases)
+
+	fields, aliases, err = parseOutputAliases([]string{"a", "b"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, fields)
+	assert.Equal(t, 0, len(aliases))
+
+	invalids := []struct {
+		fields []string
+		params []*commonpb.KeyValuePair
+	}{
+		{[]string{"a AS "}, nil},
+		{[]string{"* AS all"}, nil},
+		{[]string{"a AS 1x"}, nil},
+		{[]string{"a AS x", "a AS y"}, nil},
+		{[]string{"a AS x"}, []*commonpb.KeyValuePair{{Key: OutputAliasKey, Value: "y"}}},
+		{[]string{"a", "b"}, []*commonpb.KeyValuePair{{Key: OutputAliasKey, Value: "y"}}},
+	}
+	for _, c := range invalids {
+		_, _, err := parseOutputAliases(c.fields, c.params)
+		assert.Error(t, err, c.fields)
+	}
+}
+
+func TestCheckOutputAliases(t *testing.T) {
+	assert.NoError(t, checkOutputAliases(nil, []string{"a"}))
+	assert.NoError(t, checkOutputAliases(map[string]string{"a": "x"}, []string{"a", "b"}))
+	// swapping the names is allowed
+	assert.NoError(t, checkOutputAliases(map[string]string{"a": "b", "b": "a"}, []string{"a", "b"}))
+	assert.Error(t, checkOutputAliases(map[string]string{"a": "b"}, []string{"a", "b"}))
+	assert.Error(t, checkOutputAliases(map[string]string{"c": "x"}, []string{"a", "b"}))
+}
+
+func TestApplyOutputAliases(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{{FieldName: "a"}, nil, {FieldName: "b"}}
+	applyOutputAliases(fieldsData, map[string]string{"a": "x"})
+	assert.Equal(t, "x", fieldsData[0].GetFieldName())
+	assert.Equal(t, "b", fieldsData[2].GetFieldName())
+}
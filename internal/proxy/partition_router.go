@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type loadedPartitions struct {
+	partitionIDs typeutil.UniqueSet
+	updatedAt    time.Time
+}
+
+// partitionRouter keeps the fully loaded partitions of collections shown by QueryCoord,
+// so that search requests can skip the partitions not loaded yet or released already,
+// e.g. the partitions rolled in or out of a time window, instead of failing as a whole.
+// The cache expires after proxy.partitionRouting.cacheTTL, and is invalidated when
+// the partitions of the collection are loaded or released.
+type partitionRouter struct {
+	mu          sync.RWMutex
+	collections map[UniqueID]*loadedPartitions
+}
+
+func newPartitionRouter() *partitionRouter {
+	return &partitionRouter{
+		collections: make(map[UniqueID]*loadedPartitions),
+	}
+}
+
+func (r *partitionRouter) enabled() bool {
+	return r != nil && Params.ProxyCfg.PartitionRoutingEnabled.GetAsBool()
+}
+
+// getLoadedPartitions returns the partitions of the collection which are fully loaded.
+func (r *partitionRouter) getLoadedPartitions(ctx context.Context, qc types.QueryCoord, collectionID UniqueID) (typeutil.UniqueSet, error) {
+	ttl := Params.ProxyCfg.PartitionRoutingCacheTTL.GetAsDuration(time.Second)
+	r.mu.RLock()
+	cached, ok := r.collections[collectionID]
+	r.mu.RUnlock()
+	if ok && time.Since(cached.updatedAt) < ttl {
+		return cached.partitionIDs, nil
+	}
+
+	resp, err := qc.ShowPartitions(ctx, &querypb.ShowPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("showPartitions failed, collectionID = %d, reason = %s", collectionID, resp.GetStatus().GetReason())
+	}
+
+	partitionIDs := typeutil.NewUniqueSet()
+	for i, partitionID := range resp.GetPartitionIDs() {
+		if i < len(resp.GetInMemoryPercentages()) && resp.GetInMemoryPercentages()[i] >= 100 {
+			partitionIDs.Insert(partitionID)
+		}
+	}
+	r.mu.Lock()
+	r.collections[collectionID] = &loadedPartitions{
+		partitionIDs: partitionIDs,
+		updatedAt:    time.Now(),
+	}
+	r.mu.Unlock()
+	return partitionIDs, nil
+}
+
+// route splits the partitions to search into the loaded and the unloaded ones,
+// all the loaded partitions of the collection are searched if no partition is specified.
+func (r *partitionRouter) route(ctx context.Context, qc types.QueryCoord, collectionID UniqueID, partitionIDs []UniqueID) (loaded []UniqueID, unloaded []UniqueID, err error) {
+	loadedSet, err := r.getLoadedPartitions(ctx, qc, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(partitionIDs) == 0 {
+		return loadedSet.Collect(), nil, nil
+	}
+	for _, partitionID := range partitionIDs {
+		if loadedSet.Contain(partitionID) {
+			loaded = append(loaded, partitionID)
+		} else {
+			unloaded = append(unloaded, partitionID)
+		}
+	}
+	return loaded, unloaded, nil
+}
+
+// Invalidate removes the cached partitions of the collection.
+func (r *partitionRouter) Invalidate(collectionID UniqueID) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.collections[collectionID]; ok {
+		delete(r.collections, collectionID)
+		log.Debug("loaded partitions cache invalidated", zap.Int64("collectionID", collectionID))
+	}
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestProxy_PreImport(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getIDFunc = func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	}
+	globalMetaCache = mockCache
+
+	ctx := context.Background()
+	dataCoord := NewDataCoordMock()
+	node := &Proxy{dataCoord: dataCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	dataCoord.preImportFunc = func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		assert.Equal(t, int64(1), req.GetCollectionID())
+		return &datapb.PreImportResponse{
+			Status:        &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			EstimatedRows: 100,
+		}, nil
+	}
+	resp, err := node.PreImport(ctx, &datapb.PreImportRequest{CollectionName: "coll", Files: []string{"f1.json"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(100), resp.GetEstimatedRows())
+
+	resp, err = node.PreImport(ctx, &datapb.PreImportRequest{CollectionName: "dummy"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	dataCoord.preImportFunc = func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+		return nil, errors.New("mock")
+	}
+	resp, err = node.PreImport(ctx, &datapb.PreImportRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&datapb.PreImportRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeImport, privilegeExt.ObjectPrivilege)
+	assert.Equal(t, int32(3), privilegeExt.ObjectNameIndex)
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.PreImport(ctx, &datapb.PreImportRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -83,6 +83,7 @@ type Proxy struct {
 	rootCoord  types.RootCoord
 	dataCoord  types.DataCoord
 	queryCoord types.QueryCoord
+	indexCoord types.IndexCoord
 
 	multiRateLimiter *MultiRateLimiter
 
@@ -101,6 +102,11 @@ type Proxy struct {
 	session  *sessionutil.Session
 	shardMgr *shardClientMgr
 
+	partitionRouter       *partitionRouter
+	searchTrafficSplitter *searchTrafficSplitter
+	timeTravelGuard       *timeTravelGuard
+	searchTuner           *searchTuner
+
 	factory dependency.Factory
 
 	searchResultCh chan *internalpb.SearchResults
@@ -116,12 +122,14 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	n := 1024 // better to be configurable
 	node := &Proxy{
-		ctx:              ctx1,
-		cancel:           cancel,
-		factory:          factory,
-		searchResultCh:   make(chan *internalpb.SearchResults, n),
-		shardMgr:         newShardClientMgr(),
-		multiRateLimiter: NewMultiRateLimiter(),
+		ctx:                   ctx1,
+		cancel:                cancel,
+		factory:               factory,
+		searchResultCh:        make(chan *internalpb.SearchResults, n),
+		shardMgr:              newShardClientMgr(),
+		multiRateLimiter:      NewMultiRateLimiter(),
+		partitionRouter:       newPartitionRouter(),
+		searchTrafficSplitter: newSearchTrafficSplitter(),
 	}
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -228,6 +236,9 @@ func (node *Proxy) Init() error {
 	node.segAssigner.PeerID = paramtable.GetNodeID()
 	log.Debug("create segment id assigner done", zap.String("role", typeutil.ProxyRole), zap.Int64("ProxyID", paramtable.GetNodeID()))
 
+	node.timeTravelGuard = newTimeTravelGuard(node.dataCoord)
+	node.searchTuner = newSearchTuner(node.dataCoord)
+
 	log.Debug("create channels manager", zap.String("role", typeutil.ProxyRole))
 	dmlChannelsFunc := getDmlChannelsFunc(node.ctx, node.rootCoord)
 	chMgr := newChannelsMgrImpl(dmlChannelsFunc, defaultInsertRepackFunc, node.factory)
@@ -259,6 +270,24 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	if err := node.initRoleQuotas(); err != nil {
+		log.Warn("failed to init role quotas", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("init role quotas done", zap.String("role", typeutil.ProxyRole))
+
+	if err := node.initDatabaseQuotas(); err != nil {
+		log.Warn("failed to init database quotas", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("init database quotas done", zap.String("role", typeutil.ProxyRole))
+
+	if err := node.initReadOnlyModes(); err != nil {
+		log.Warn("failed to init read-only modes", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+	log.Debug("init read-only modes done", zap.String("role", typeutil.ProxyRole))
+
 	return nil
 }
 
@@ -364,6 +393,9 @@ func (node *Proxy) Start() error {
 		cb()
 	}
 
+	node.startMetaPrefetch()
+	node.startQueryNodeZoneWatch()
+
 	log.Debug("update state code", zap.String("role", typeutil.ProxyRole), zap.String("State", commonpb.StateCode_Healthy.String()))
 	node.UpdateStateCode(commonpb.StateCode_Healthy)
 
@@ -458,6 +490,11 @@ func (node *Proxy) SetQueryCoordClient(cli types.QueryCoord) {
 	node.queryCoord = cli
 }
 
+// SetIndexCoordClient sets IndexCoord client for proxy.
+func (node *Proxy) SetIndexCoordClient(cli types.IndexCoord) {
+	node.indexCoord = cli
+}
+
 // GetRateLimiter returns the rateLimiter in Proxy.
 func (node *Proxy) GetRateLimiter() (types.Limiter, error) {
 	if node.multiRateLimiter == nil {
@@ -72,6 +72,8 @@ type QueryCoordMock struct {
 	showCollectionsFunc    queryCoordShowCollectionsFuncType
 	getMetricsFunc         getMetricsFuncType
 	showPartitionsFunc     queryCoordShowPartitionsFuncType
+	getSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+	getReplicasFunc        func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
 	statisticsChannel string
 	timeTickChannel   string
@@ -311,6 +313,9 @@ func (coord *QueryCoordMock) GetSegmentInfo(ctx context.Context, req *querypb.Ge
 		}, nil
 	}
 
+	if coord.getSegmentInfoFunc != nil {
+		return coord.getSegmentInfoFunc(ctx, req)
+	}
 	panic("implement me")
 }
 
@@ -382,6 +387,10 @@ func (coord *QueryCoordMock) GetReplicas(ctx context.Context, req *milvuspb.GetR
 		}, nil
 	}
 
+	if coord.getReplicasFunc != nil {
+		return coord.getReplicasFunc(ctx, req)
+	}
+
 	return &milvuspb.GetReplicasResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// The grpc response trailers of the quota states, each state has a value in every key at the same position,
+// so that SDKs throttle the requests before they are denied.
+const (
+	quotaStatesTrailer       = "milvus-quota-states"
+	quotaStateReasonsTrailer = "milvus-quota-state-reasons"
+	quotaStateSinceTrailer   = "milvus-quota-state-since-ms"
+)
+
+// quotaStateInfo is a quota state the cluster is in, such as DenyToWrite.
+type quotaStateInfo struct {
+	state  milvuspb.QuotaState
+	reason string
+	// since is the time the state was entered
+	since time.Time
+}
+
+// quotaStatesMetadata returns the trailers of the quota states, nil if there is no state.
+func quotaStatesMetadata(infos []quotaStateInfo) metadata.MD {
+	if len(infos) == 0 {
+		return nil
+	}
+	kv := make([]string, 0, len(infos)*6)
+	for _, info := range infos {
+		kv = append(kv,
+			quotaStatesTrailer, info.state.String(),
+			quotaStateReasonsTrailer, info.reason,
+			quotaStateSinceTrailer, strconv.FormatInt(info.since.UnixMilli(), 10),
+		)
+	}
+	return metadata.Pairs(kv...)
+}
+
+// quotaStateReporter reports the quota states of the cluster.
+type quotaStateReporter interface {
+	GetQuotaStateInfos() []quotaStateInfo
+}
+
+// withQuotaStates returns a handler which sets the quota states in the grpc response trailers
+// of the successful requests if the limiter supports.
+func withQuotaStates(limiter types.Limiter, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	reporter, ok := limiter.(quotaStateReporter)
+	if !ok {
+		return handler
+	}
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		rsp, err := handler(ctx, req)
+		if !Params.ProxyCfg.QuotaStateNotifyEnabled.GetAsBool() || !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
+			return rsp, err
+		}
+		if funcutil.VerifyResponse(rsp, err) != nil {
+			return rsp, err
+		}
+		if md := quotaStatesMetadata(reporter.GetQuotaStateInfos()); md != nil {
+			if err := grpc.SetTrailer(ctx, md); err != nil {
+				log.Ctx(ctx).RatedDebug(60, "failed to set the quota state trailers", zap.Error(err))
+			}
+		}
+		return rsp, err
+	}
+}
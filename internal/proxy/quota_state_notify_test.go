@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type trailerStreamMock struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerStreamMock) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestMultiRateLimiter_GetQuotaStateInfos(t *testing.T) {
+	paramtable.Init()
+	m := NewMultiRateLimiter()
+	assert.Empty(t, m.GetQuotaStateInfos())
+
+	m.SetQuotaStates([]milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite}, []string{"memory exhausted"})
+	infos := m.GetQuotaStateInfos()
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, milvuspb.QuotaState_DenyToWrite, infos[0].state)
+	assert.Equal(t, "memory exhausted", infos[0].reason)
+	since := infos[0].since
+	assert.False(t, since.IsZero())
+
+	// the same state set again keeps the time entered
+	time.Sleep(time.Millisecond)
+	m.SetQuotaStates([]milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite}, []string{"memory exhausted"})
+	infos = m.GetQuotaStateInfos()
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, since, infos[0].since)
+
+	// the time entered is kept while the state lasts
+	time.Sleep(time.Millisecond)
+	m.SetQuotaStates([]milvuspb.QuotaState{milvuspb.QuotaState_DenyToRead, milvuspb.QuotaState_DenyToWrite},
+		[]string{"search queue full", "disk exhausted"})
+	infos = m.GetQuotaStateInfos()
+	require.Equal(t, 2, len(infos))
+	assert.Equal(t, milvuspb.QuotaState_DenyToRead, infos[0].state)
+	assert.True(t, infos[0].since.After(since))
+	assert.Equal(t, milvuspb.QuotaState_DenyToWrite, infos[1].state)
+	assert.Equal(t, "disk exhausted", infos[1].reason)
+	assert.Equal(t, since, infos[1].since)
+
+	// the state entered again gets a new time
+	m.SetQuotaStates(nil, nil)
+	assert.Empty(t, m.GetQuotaStateInfos())
+	m.SetQuotaStates([]milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite}, []string{"memory exhausted"})
+	infos = m.GetQuotaStateInfos()
+	require.Equal(t, 1, len(infos))
+	assert.True(t, infos[0].since.After(since))
+}
+
+func TestRateLimitInterceptor_QuotaStates(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+	paramtable.Get().Save(Params.ProxyCfg.QuotaStateNotifyEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.QuotaStateNotifyEnabled.Key)
+
+	limiter := NewMultiRateLimiter()
+	interceptor := RateLimitInterceptor(limiter)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "MockFullMethod"}
+	req := &milvuspb.DescribeCollectionRequest{CollectionName: "coll"}
+
+	call := func(status commonpb.ErrorCode) metadata.MD {
+		stream := &trailerStreamMock{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &milvuspb.DescribeCollectionResponse{Status: &commonpb.Status{ErrorCode: status}}, nil
+		}
+		_, err := interceptor(ctx, req, serverInfo, handler)
+		require.NoError(t, err)
+		return stream.trailer
+	}
+
+	// no trailer without quota states
+	assert.Empty(t, call(commonpb.ErrorCode_Success))
+
+	limiter.SetQuotaStates([]milvuspb.QuotaState{milvuspb.QuotaState_DenyToRead, milvuspb.QuotaState_DenyToWrite},
+		[]string{"search queue full", "memory exhausted"})
+	infos := limiter.GetQuotaStateInfos()
+	trailer := call(commonpb.ErrorCode_Success)
+	assert.Equal(t, []string{"DenyToRead", "DenyToWrite"}, trailer.Get(quotaStatesTrailer))
+	assert.Equal(t, []string{"search queue full", "memory exhausted"}, trailer.Get(quotaStateReasonsTrailer))
+	assert.Equal(t, []string{
+		strconv.FormatInt(infos[0].since.UnixMilli(), 10),
+		strconv.FormatInt(infos[1].since.UnixMilli(), 10),
+	}, trailer.Get(quotaStateSinceTrailer))
+
+	// no trailer for the failed request
+	assert.Empty(t, call(commonpb.ErrorCode_UnexpectedError))
+
+	paramtable.Get().Save(Params.ProxyCfg.QuotaStateNotifyEnabled.Key, "false")
+	assert.Empty(t, call(commonpb.ErrorCode_Success))
+}
@@ -21,24 +21,43 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
 // RateLimitInterceptor returns a new unary server interceptors that performs request rate limiting.
 func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		handler = withQuotaStates(limiter, handler)
+		if err := checkReadOnly(limiter, req); err != nil {
+			if rsp := getFailedResponse(req, commonpb.ErrorCode_ForceDeny, info.FullMethod, err); rsp != nil {
+				return rsp, nil
+			}
+		}
 		rt, n, err := getRequestInfo(req)
 		if err != nil {
 			return handler(ctx, req)
 		}
 		err = limiter.Check(rt, n)
+		if err == nil {
+			err = checkDatabaseRateLimit(limiter, req, rt, n)
+		}
+		if err == nil {
+			err = checkUserRateLimit(ctx, limiter, rt, n)
+		}
+		if err == nil {
+			err = checkSLOShedding(ctx, limiter, req, rt)
+		}
 		if errors.Is(err, ErrForceDeny) {
 			rsp := getFailedResponse(req, commonpb.ErrorCode_ForceDeny, info.FullMethod, err)
 			if rsp != nil {
@@ -51,8 +70,139 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 				return rsp, nil
 			}
 		}
+		if err == nil {
+			start := time.Now()
+			rsp, err := handleWithPacingHint(ctx, limiter, req, rt, n, handler)
+			observeSLO(limiter, rt, time.Since(start))
+			return rsp, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// pacingHinter gives the hints for the clients to pace the dml requests.
+type pacingHinter interface {
+	RecordCollectionUsage(collectionName string, n int)
+	GetPacingHint(rt internalpb.RateType, collectionName string, n int) *pacingHint
+}
+
+// handleWithPacingHint handles the request, and sets the pacing hint in the grpc response headers
+// of the successful insert and delete if the limiter supports.
+func handleWithPacingHint(ctx context.Context, limiter types.Limiter, req interface{}, rt internalpb.RateType, n int,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	hinter, ok := limiter.(pacingHinter)
+	if !ok || !Params.ProxyCfg.PacingHintEnabled.GetAsBool() || !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
 		return handler(ctx, req)
 	}
+	var collectionName string
+	switch r := req.(type) {
+	case *milvuspb.InsertRequest:
+		collectionName = r.GetCollectionName()
+	case *milvuspb.DeleteRequest:
+		collectionName = r.GetCollectionName()
+	default:
+		return handler(ctx, req)
+	}
+
+	hinter.RecordCollectionUsage(collectionName, n)
+	rsp, err := handler(ctx, req)
+	if err != nil {
+		return rsp, err
+	}
+	if result, ok := rsp.(*milvuspb.MutationResult); !ok || result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return rsp, err
+	}
+	if hint := hinter.GetPacingHint(rt, collectionName, n); hint != nil {
+		if err := grpc.SetHeader(ctx, hint.metadata()); err != nil {
+			log.Ctx(ctx).RatedDebug(60, "failed to set the pacing hint headers", zap.Error(err))
+		}
+	}
+	return rsp, nil
+}
+
+// userLimiter limits the requests per user, such as by the quotas bound to the roles of the user.
+type userLimiter interface {
+	CheckUser(username string, rt internalpb.RateType, n int) error
+}
+
+// checkUserRateLimit checks the request against the limits of the current user if the limiter supports.
+func checkUserRateLimit(ctx context.Context, limiter types.Limiter, rt internalpb.RateType, n int) error {
+	ul, ok := limiter.(userLimiter)
+	if !ok {
+		return nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return ul.CheckUser(username, rt, n)
+}
+
+// databaseLimiter limits the requests per database and per collection of the database.
+type databaseLimiter interface {
+	CheckDatabase(dbName, collectionName string, rt internalpb.RateType, n int) error
+}
+
+// checkDatabaseRateLimit checks the request against the limits of its database and collection if the limiter supports.
+func checkDatabaseRateLimit(limiter types.Limiter, req interface{}, rt internalpb.RateType, n int) error {
+	dl, ok := limiter.(databaseLimiter)
+	if !ok {
+		return nil
+	}
+	var dbName, collectionName string
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		dbName = r.GetDbName()
+	}
+	if r, ok := req.(interface{ GetCollectionName() string }); ok {
+		collectionName = r.GetCollectionName()
+	}
+	return dl.CheckDatabase(dbName, collectionName, rt, n)
+}
+
+// readOnlyLimiter rejects the dml and ddl requests to the cluster or to the databases in read-only mode.
+type readOnlyLimiter interface {
+	CheckReadOnly(dbName string) error
+}
+
+// checkReadOnly checks the dml and ddl requests against the read-only modes if the limiter supports,
+// regardless of whether the quotas and limits are enabled.
+func checkReadOnly(limiter types.Limiter, req interface{}) error {
+	rl, ok := limiter.(readOnlyLimiter)
+	if !ok || !isReadOnlyDenied(req) {
+		return nil
+	}
+	var dbName string
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		dbName = r.GetDbName()
+	}
+	return rl.CheckReadOnly(dbName)
+}
+
+// sloLimiter sheds the low priority requests to protect the latency slo of the request types.
+type sloLimiter interface {
+	AdmitSLO(username, collectionName string, rt internalpb.RateType) error
+	ObserveSLO(rt internalpb.RateType, latency time.Duration)
+}
+
+// checkSLOShedding checks if the request would be shed by its user and collection if the limiter supports.
+func checkSLOShedding(ctx context.Context, limiter types.Limiter, req interface{}, rt internalpb.RateType) error {
+	sl, ok := limiter.(sloLimiter)
+	if !ok {
+		return nil
+	}
+	username, _ := GetCurUserFromContext(ctx)
+	var collectionName string
+	if r, ok := req.(interface{ GetCollectionName() string }); ok {
+		collectionName = r.GetCollectionName()
+	}
+	return sl.AdmitSLO(username, collectionName, rt)
+}
+
+// observeSLO records the latency of the request if the limiter supports.
+func observeSLO(limiter types.Limiter, rt internalpb.RateType, latency time.Duration) {
+	if sl, ok := limiter.(sloLimiter); ok {
+		sl.ObserveSLO(rt, latency)
+	}
 }
 
 // getRequestInfo returns rateType of request and return tokens needed.
@@ -80,7 +230,7 @@ func getRequestInfo(req interface{}) (internalpb.RateType, int, error) {
 		return internalpb.RateType_DDLIndex, 1, nil
 	case *milvuspb.FlushRequest:
 		return internalpb.RateType_DDLFlush, 1, nil
-	case *milvuspb.ManualCompactionRequest:
+	case *milvuspb.ManualCompactionRequest, *proxypb.CompactSegmentsRequest, *proxypb.MergeTinySegmentsRequest:
 		return internalpb.RateType_DDLCompaction, 1, nil
 		// TODO: support more request
 	default:
@@ -117,7 +267,7 @@ func failedBoolResponse(code commonpb.ErrorCode, reason string) *milvuspb.BoolRe
 func getFailedResponse(req interface{}, code commonpb.ErrorCode, fullMethod string, err error) interface{} {
 	reason := fmt.Sprintf("%s, req: %s", err, fullMethod)
 	switch req.(type) {
-	case *milvuspb.InsertRequest, *milvuspb.DeleteRequest:
+	case *milvuspb.InsertRequest, *milvuspb.DeleteRequest, *milvuspb.UpsertRequest:
 		return failedMutationResult(code, reason)
 	case *milvuspb.ImportRequest:
 		return &milvuspb.ImportResponse{
@@ -135,13 +285,15 @@ func getFailedResponse(req interface{}, code commonpb.ErrorCode, fullMethod stri
 		*milvuspb.LoadCollectionRequest, *milvuspb.ReleaseCollectionRequest,
 		*milvuspb.CreatePartitionRequest, *milvuspb.DropPartitionRequest,
 		*milvuspb.LoadPartitionsRequest, *milvuspb.ReleasePartitionsRequest,
-		*milvuspb.CreateIndexRequest, *milvuspb.DropIndexRequest:
+		*milvuspb.CreateIndexRequest, *milvuspb.DropIndexRequest,
+		*milvuspb.AlterCollectionRequest, *milvuspb.CreateAliasRequest,
+		*milvuspb.DropAliasRequest, *milvuspb.AlterAliasRequest:
 		return failedStatus(code, reason)
 	case *milvuspb.FlushRequest:
 		return &milvuspb.FlushResponse{
 			Status: failedStatus(code, reason),
 		}
-	case *milvuspb.ManualCompactionRequest:
+	case *milvuspb.ManualCompactionRequest, *proxypb.CompactSegmentsRequest, *proxypb.MergeTinySegmentsRequest:
 		return &milvuspb.ManualCompactionResponse{
 			Status: failedStatus(code, reason),
 		}
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 )
 
 type limiterMock struct {
@@ -116,6 +117,16 @@ func TestRateLimitInterceptor(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLCompaction, rt)
+
+		rt, size, err = getRequestInfo(&proxypb.CompactSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, size)
+		assert.Equal(t, internalpb.RateType_DDLCompaction, rt)
+
+		rt, size, err = getRequestInfo(&proxypb.MergeTinySegmentsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, size)
+		assert.Equal(t, internalpb.RateType_DDLCompaction, rt)
 	})
 
 	t.Run("test getFailedResponse", func(t *testing.T) {
@@ -131,6 +142,8 @@ func TestRateLimitInterceptor(t *testing.T) {
 		testGetFailedResponse(&milvuspb.CreateCollectionRequest{})
 		testGetFailedResponse(&milvuspb.FlushRequest{})
 		testGetFailedResponse(&milvuspb.ManualCompactionRequest{})
+		testGetFailedResponse(&proxypb.CompactSegmentsRequest{})
+		testGetFailedResponse(&proxypb.MergeTinySegmentsRequest{})
 
 		// test illegal
 		rsp := getFailedResponse(&milvuspb.SearchResults{}, commonpb.ErrorCode_UnexpectedError, "method", fmt.Errorf("mock err"))
@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// readOnlyGuard rejects the dml and ddl requests to the cluster or to the databases in read-only mode,
+// the read-only modes are entered and left through RootCoord.
+type readOnlyGuard struct {
+	mu      sync.RWMutex
+	cluster *model.ReadOnlyMode
+	dbs     map[string]*model.ReadOnlyMode // db name -> read-only mode
+}
+
+func newReadOnlyGuard() *readOnlyGuard {
+	return &readOnlyGuard{
+		dbs: make(map[string]*model.ReadOnlyMode),
+	}
+}
+
+// set enters or leaves the read-only mode of the cluster, or of the database if the db name is set.
+func (g *readOnlyGuard) set(mode *model.ReadOnlyMode) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case mode.DbName == "" && mode.ReadOnly:
+		g.cluster = mode
+	case mode.DbName == "":
+		g.cluster = nil
+	case mode.ReadOnly:
+		g.dbs[mode.DbName] = mode
+	default:
+		delete(g.dbs, mode.DbName)
+	}
+	log.Info("RateLimiter set read-only mode", zap.String("db_name", mode.DbName),
+		zap.Bool("read_only", mode.ReadOnly), zap.String("reason", mode.Reason))
+}
+
+// check returns an error if the cluster or the database is in read-only mode, the requests without a db name
+// belong to the database util.DefaultDBName.
+func (g *readOnlyGuard) check(dbName string) error {
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.cluster != nil {
+		return wrapReadOnlyDenyError("the cluster", g.cluster.Reason)
+	}
+	if mode, ok := g.dbs[dbName]; ok {
+		return wrapReadOnlyDenyError("database "+dbName, mode.Reason)
+	}
+	return nil
+}
+
+// reasons returns the reasons of the read-only modes entered, the one of the cluster goes first.
+func (g *readOnlyGuard) reasons() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	describe := func(scope string, mode *model.ReadOnlyMode) string {
+		return fmt.Sprintf("%s is in read-only mode since %s, reason: %s", scope,
+			time.Unix(mode.Since, 0).Format(time.RFC3339), mode.Reason)
+	}
+	reasons := make([]string, 0, len(g.dbs)+1)
+	if g.cluster != nil {
+		reasons = append(reasons, describe("the cluster", g.cluster))
+	}
+	dbNames := make([]string, 0, len(g.dbs))
+	for dbName := range g.dbs {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	for _, dbName := range dbNames {
+		reasons = append(reasons, describe("database "+dbName, g.dbs[dbName]))
+	}
+	return reasons
+}
+
+// isReadOnlyDenied returns true if the request is rejected in read-only mode, i.e. the dml and ddl requests
+// changing the data or the schemas. Loading and releasing collections are allowed to keep serving the reads.
+func isReadOnlyDenied(req interface{}) bool {
+	switch req.(type) {
+	case *milvuspb.InsertRequest, *milvuspb.DeleteRequest, *milvuspb.UpsertRequest, *milvuspb.ImportRequest,
+		*milvuspb.CreateCollectionRequest, *milvuspb.DropCollectionRequest, *milvuspb.AlterCollectionRequest,
+		*milvuspb.CreatePartitionRequest, *milvuspb.DropPartitionRequest,
+		*milvuspb.CreateIndexRequest, *milvuspb.DropIndexRequest,
+		*milvuspb.CreateAliasRequest, *milvuspb.DropAliasRequest, *milvuspb.AlterAliasRequest,
+		*milvuspb.FlushRequest, *milvuspb.ManualCompactionRequest,
+		*proxypb.CompactSegmentsRequest, *proxypb.MergeTinySegmentsRequest:
+		return true
+	}
+	return false
+}
+
+// CheckReadOnly checks if the dml or ddl request to the database would be denied by the read-only modes.
+func (m *MultiRateLimiter) CheckReadOnly(dbName string) error {
+	return m.readOnlyGuard.check(dbName)
+}
+
+// GetReadOnlyReasons returns the reasons of the read-only modes entered.
+func (m *MultiRateLimiter) GetReadOnlyReasons() []string {
+	return m.readOnlyGuard.reasons()
+}
+
+// initReadOnlyModes loads the read-only modes from RootCoord, the later changes are refreshed by RootCoord.
+func (node *Proxy) initReadOnlyModes() error {
+	resp, err := node.rootCoord.ListReadOnlyModes(node.ctx, &proxypb.ListReadOnlyModesRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+	})
+	if funcutil.IsGrpcUnimplemented(err) {
+		// RootCoord of the older versions has no read-only modes during a rolling upgrade
+		log.Warn("skip loading read-only modes from the RootCoord not serving ListReadOnlyModes", zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	for _, mode := range resp.GetModes() {
+		node.multiRateLimiter.readOnlyGuard.set(model.UnmarshalReadOnlyModeModel(mode))
+	}
+	return nil
+}
+
+// RefreshReadOnlyMode applies the read-only mode of the cluster or of a database entered or left in RootCoord.
+func (node *Proxy) RefreshReadOnlyMode(ctx context.Context, req *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	mode := model.UnmarshalReadOnlyModeModel(req.GetMode())
+	if mode == nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "read-only mode is required",
+		}, nil
+	}
+	node.multiRateLimiter.readOnlyGuard.set(mode)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+// ListReadOnlyModes forwards the request to RootCoord, which lists the read-only modes entered.
+// The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ListReadOnlyModesResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "ListReadOnlyModes"
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole))
+	log.Debug(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.ListReadOnlyModes(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &proxypb.ListReadOnlyModesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("modes", len(resp.GetModes())))
+	return resp, nil
+}
+
+// EnterReadOnly forwards the request to RootCoord, which enters the read-only mode of the cluster or of a database
+// and refreshes it in proxies. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "EnterReadOnly"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db_name", req.GetDbName()),
+		zap.String("reason", req.GetReason()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.EnterReadOnly(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
+
+// LeaveReadOnly forwards the request to RootCoord, which leaves the read-only mode of the cluster or of a database
+// and refreshes it in proxies. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "LeaveReadOnly"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db_name", req.GetDbName()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.LeaveReadOnly(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
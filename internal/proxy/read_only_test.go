@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestReadOnlyGuard(t *testing.T) {
+	g := newReadOnlyGuard()
+	assert.NoError(t, g.check("tenant1"))
+	assert.Empty(t, g.reasons())
+
+	g.set(&model.ReadOnlyMode{DbName: "tenant1", ReadOnly: true, Reason: "migration"})
+	err := g.check("tenant1")
+	assert.True(t, errors.Is(err, ErrForceDeny))
+	assert.True(t, strings.Contains(err.Error(), "migration"))
+	assert.NoError(t, g.check("tenant2"))
+	// the requests without a db name belong to the default database
+	assert.NoError(t, g.check(""))
+	g.set(&model.ReadOnlyMode{DbName: util.DefaultDBName, ReadOnly: true})
+	assert.Error(t, g.check(""))
+
+	g.set(&model.ReadOnlyMode{ReadOnly: true, Reason: "incident"})
+	err = g.check("tenant2")
+	assert.True(t, errors.Is(err, ErrForceDeny))
+	assert.True(t, strings.Contains(err.Error(), "incident"))
+	reasons := g.reasons()
+	assert.Equal(t, 3, len(reasons))
+	assert.True(t, strings.HasPrefix(reasons[0], "the cluster"))
+	assert.True(t, strings.HasPrefix(reasons[1], "database "+util.DefaultDBName))
+
+	g.set(&model.ReadOnlyMode{})
+	g.set(&model.ReadOnlyMode{DbName: "tenant1"})
+	g.set(&model.ReadOnlyMode{DbName: util.DefaultDBName})
+	assert.NoError(t, g.check("tenant1"))
+	assert.NoError(t, g.check("tenant2"))
+	assert.Empty(t, g.reasons())
+}
+
+func TestRateLimitInterceptor_ReadOnly(t *testing.T) {
+	m := NewMultiRateLimiter()
+	m.readOnlyGuard.set(&model.ReadOnlyMode{DbName: "tenant1", ReadOnly: true, Reason: "migration"})
+	interceptor := RateLimitInterceptor(m)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.MutationResult{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+	}
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "MockFullMethod"}
+
+	rsp, err := interceptor(context.Background(), &milvuspb.InsertRequest{DbName: "tenant1"}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_ForceDeny, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+	rsp, err = interceptor(context.Background(), &milvuspb.CreateAliasRequest{DbName: "tenant1"}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_ForceDeny, rsp.(*commonpb.Status).GetErrorCode())
+
+	// the other databases and the reads are served
+	rsp, err = interceptor(context.Background(), &milvuspb.InsertRequest{DbName: "tenant2"}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+	rsp, err = interceptor(context.Background(), &milvuspb.SearchRequest{DbName: "tenant1"}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+	rsp, err = interceptor(context.Background(), &milvuspb.LoadCollectionRequest{DbName: "tenant1"}, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
+
+	assert.True(t, isReadOnlyDenied(&milvuspb.UpsertRequest{}))
+	assert.True(t, isReadOnlyDenied(&milvuspb.DropIndexRequest{}))
+	assert.True(t, isReadOnlyDenied(&proxypb.CompactSegmentsRequest{}))
+	assert.True(t, isReadOnlyDenied(&proxypb.MergeTinySegmentsRequest{}))
+	assert.False(t, isReadOnlyDenied(&milvuspb.QueryRequest{}))
+	assert.False(t, isReadOnlyDenied(&milvuspb.ReleaseCollectionRequest{}))
+}
+
+func TestProxy_RefreshReadOnlyMode(t *testing.T) {
+	node := &Proxy{multiRateLimiter: NewMultiRateLimiter()}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	status, err := node.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{
+		Mode: &proxypb.ReadOnlyMode{ReadOnly: true, Reason: "incident"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Error(t, node.multiRateLimiter.CheckReadOnly("tenant1"))
+	assert.Equal(t, 1, len(node.multiRateLimiter.GetReadOnlyReasons()))
+
+	status, err = node.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	status, err = node.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{
+		Mode: &proxypb.ReadOnlyMode{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.NoError(t, node.multiRateLimiter.CheckReadOnly("tenant1"))
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	status, err = node.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
+
+func TestProxy_InitReadOnlyModes(t *testing.T) {
+	rc := NewRootCoordMock()
+	defer rc.Stop()
+	node := &Proxy{ctx: context.Background(), rootCoord: rc, multiRateLimiter: NewMultiRateLimiter()}
+
+	rc.listReadOnlyModesFunc = func(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+		return &proxypb.ListReadOnlyModesResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Modes:  []*proxypb.ReadOnlyMode{{DbName: "tenant1", ReadOnly: true, Reason: "migration"}},
+		}, nil
+	}
+	assert.NoError(t, node.initReadOnlyModes())
+	assert.Error(t, node.multiRateLimiter.CheckReadOnly("tenant1"))
+	assert.NoError(t, node.multiRateLimiter.CheckReadOnly("tenant2"))
+
+	// the RootCoord of the older versions doesn't serve ListReadOnlyModes
+	rc.listReadOnlyModesFunc = func(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+		return nil, grpcStatus.Error(codes.Unimplemented, "unknown method ListReadOnlyModes")
+	}
+	assert.NoError(t, node.initReadOnlyModes())
+	rc.listReadOnlyModesFunc = func(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+		return nil, errors.New("mock error")
+	}
+	assert.Error(t, node.initReadOnlyModes())
+}
+
+func TestProxy_ReadOnlyModeAPIs(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	defer rc.Stop()
+	node := &Proxy{rootCoord: rc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := node.ListReadOnlyModes(ctx, &proxypb.ListReadOnlyModesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	status, err := node.EnterReadOnly(ctx, &proxypb.EnterReadOnlyRequest{DbName: "tenant1", Reason: "migration"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = node.LeaveReadOnly(ctx, &proxypb.LeaveReadOnlyRequest{DbName: "tenant1"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	for _, req := range []interface{}{&proxypb.ListReadOnlyModesRequest{}, &proxypb.EnterReadOnlyRequest{}, &proxypb.LeaveReadOnlyRequest{}} {
+		_, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+	}
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.ListReadOnlyModes(ctx, &proxypb.ListReadOnlyModesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	status, err = node.EnterReadOnly(ctx, &proxypb.EnterReadOnlyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	status, err = node.LeaveReadOnly(ctx, &proxypb.LeaveReadOnlyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
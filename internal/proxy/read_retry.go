@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// retryBudgetSeconds is the seconds of minimum retries the retry budget saves up at most.
+const retryBudgetSeconds = 10
+
+// retryBudget limits the retries of the reads to a ratio of the reads plus a minimum rate, so that the retries
+// never multiply the load of the QueryNodes when all the replicas fail. Every read deposits
+// proxy.readRetry.budgetRatio tokens, proxy.readRetry.minRetriesPerSecond tokens are added every second,
+// and every retry withdraws a token.
+type retryBudget struct {
+	mu         sync.Mutex
+	tokens     float64
+	lastRefill time.Time
+	now        func() time.Time
+}
+
+func newRetryBudget() *retryBudget {
+	return &retryBudget{now: time.Now}
+}
+
+func (b *retryBudget) refill() {
+	now := b.now()
+	minPerSecond := paramtable.Get().ProxyCfg.ReadRetryMinPerSecond.GetAsFloat()
+	if !b.lastRefill.IsZero() {
+		b.tokens += now.Sub(b.lastRefill).Seconds() * minPerSecond
+	} else {
+		b.tokens = minPerSecond
+	}
+	b.lastRefill = now
+	b.tokens = math.Min(b.tokens, math.Max(minPerSecond, 1)*retryBudgetSeconds)
+}
+
+// deposit adds the tokens of a read.
+func (b *retryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.tokens += paramtable.Get().ProxyCfg.ReadRetryBudgetRatio.GetAsFloat()
+}
+
+// withdraw takes the token of a retry, false if the budget is exhausted.
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// globalReadRetryBudget is the retry budget shared by the searches and queries of the Proxy.
+var globalReadRetryBudget = newRetryBudget()
+
+// readRetrier decides whether the shards of a read failed on their shard leaders are retried on the leaders
+// of the other replicas. The retried requests are sent as they are, with the same guarantee timestamp, so a
+// replica lagging behind waits for its tsafe before serving them, and the consistency of the read is kept.
+type readRetrier struct {
+	budget    *retryBudget
+	queryType string
+	retried   bool
+}
+
+func newReadRetrier(queryType string) *readRetrier {
+	return &readRetrier{budget: globalReadRetryBudget, queryType: queryType}
+}
+
+// begin deposits the tokens of the read.
+func (r *readRetrier) begin() {
+	if r == nil {
+		return
+	}
+	r.budget.deposit()
+}
+
+// allow returns true if the failed shards can be retried, a retry is never made if the read is canceled
+// or timed out, and is rejected if the retry budget is exhausted. A nil retrier retries without limit.
+func (r *readRetrier) allow(ctx context.Context) bool {
+	if r == nil {
+		return true
+	}
+	if ctx.Err() != nil {
+		return false
+	}
+	if !r.budget.withdraw() {
+		r.observe(metrics.BudgetExhaustedLabel)
+		return false
+	}
+	r.retried = true
+	r.observe(metrics.RetryLabel)
+	return true
+}
+
+// done records a read recovered by the retries.
+func (r *readRetrier) done() {
+	if r == nil || !r.retried {
+		return
+	}
+	r.observe(metrics.RecoveredLabel)
+}
+
+func (r *readRetrier) observe(status string) {
+	metrics.ProxyReadRetryCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), r.queryType, status).Inc()
+}
+
+// newReplicaFailoverPolicy returns the round-robin policy whose retries on the other replicas are
+// limited by the retry budget, the retries are counted under the query type. The retries are not limited,
+// as mergeRoundRobinPolicy, unless proxy.readRetry.enabled is set.
+func newReplicaFailoverPolicy(queryType string) pickShardPolicy {
+	return func(ctx context.Context, mgr *shardClientMgr, query func(context.Context, UniqueID, types.QueryNode, []string) error,
+		dml2leaders map[string][]nodeInfo) error {
+		var retrier *readRetrier
+		if paramtable.Get().ProxyCfg.ReadRetryEnabled.GetAsBool() {
+			retrier = newReadRetrier(queryType)
+		}
+		return roundRobinWithRetry(ctx, mgr, query, dml2leaders, retrier)
+	}
+}
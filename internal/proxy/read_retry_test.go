@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestRetryBudget(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ReadRetryBudgetRatio.Key, "0.5")
+	defer params.Reset(params.ProxyCfg.ReadRetryBudgetRatio.Key)
+	params.Save(params.ProxyCfg.ReadRetryMinPerSecond.Key, "1")
+	defer params.Reset(params.ProxyCfg.ReadRetryMinPerSecond.Key)
+
+	now := time.Now()
+	b := newRetryBudget()
+	b.now = func() time.Time { return now }
+
+	// the minimum retries of the first second
+	assert.True(t, b.withdraw())
+	assert.False(t, b.withdraw())
+
+	// two reads deposit a retry
+	b.deposit()
+	assert.False(t, b.withdraw())
+	b.deposit()
+	assert.True(t, b.withdraw())
+
+	now = now.Add(2 * time.Second)
+	assert.True(t, b.withdraw())
+	assert.True(t, b.withdraw())
+	assert.False(t, b.withdraw())
+
+	// the saved up retries are capped
+	now = now.Add(time.Hour)
+	for i := 0; i < retryBudgetSeconds; i++ {
+		assert.True(t, b.withdraw())
+	}
+	assert.False(t, b.withdraw())
+}
+
+func TestReadRetrier(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ReadRetryBudgetRatio.Key, "1")
+	defer params.Reset(params.ProxyCfg.ReadRetryBudgetRatio.Key)
+	params.Save(params.ProxyCfg.ReadRetryMinPerSecond.Key, "0")
+	defer params.Reset(params.ProxyCfg.ReadRetryMinPerSecond.Key)
+
+	ctx := context.Background()
+	newRetrier := func() *readRetrier {
+		return &readRetrier{budget: newRetryBudget(), queryType: metrics.SearchLabel}
+	}
+
+	t.Run("nil retrier", func(t *testing.T) {
+		var r *readRetrier
+		r.begin()
+		assert.True(t, r.allow(ctx))
+		r.done()
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		r := newRetrier()
+		assert.False(t, r.allow(ctx))
+		r.begin()
+		assert.True(t, r.allow(ctx))
+		assert.True(t, r.retried)
+		assert.False(t, r.allow(ctx))
+		r.done()
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		r := newRetrier()
+		r.begin()
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.False(t, r.allow(canceled))
+		assert.False(t, r.retried)
+	})
+}
+
+func TestRoundRobinWithRetry(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ReadRetryBudgetRatio.Key, "1")
+	defer params.Reset(params.ProxyCfg.ReadRetryBudgetRatio.Key)
+	params.Save(params.ProxyCfg.ReadRetryMinPerSecond.Key, "0")
+	defer params.Reset(params.ProxyCfg.ReadRetryMinPerSecond.Key)
+
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+		"c1": {{nodeID: 1, address: "fake"}, {nodeID: 0, address: "fake"}},
+	}
+	mgr.UpdateShardLeaders(nil, shard2leaders)
+
+	querier := &mockQuery{}
+	querier.init()
+	mockErr := errors.New("mock query node error")
+	querier.failset[0] = mockErr
+
+	// the read deposits a retry, the shard failed on node 0 is retried on node 1
+	r := &readRetrier{budget: newRetryBudget(), queryType: metrics.QueryLabel}
+	err := roundRobinWithRetry(ctx, mgr, querier.query, shard2leaders, r)
+	assert.NoError(t, err)
+	assert.True(t, r.retried)
+	assert.Equal(t, map[UniqueID][]string{1: {"c0", "c1"}}, querier.records())
+
+	// the budget is exhausted, the read fails without retry
+	params.Save(params.ProxyCfg.ReadRetryBudgetRatio.Key, "0")
+	querier.init()
+	querier.failset[0] = mockErr
+	r = &readRetrier{budget: newRetryBudget(), queryType: metrics.QueryLabel}
+	err = roundRobinWithRetry(ctx, mgr, querier.query, shard2leaders, r)
+	assert.ErrorContains(t, err, mockErr.Error())
+	assert.False(t, r.retried)
+	assert.Equal(t, map[UniqueID][]string{1: {"c1"}}, querier.records())
+}
+
+func TestReplicaFailoverPolicy(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ReadRetryBudgetRatio.Key, "0")
+	defer params.Reset(params.ProxyCfg.ReadRetryBudgetRatio.Key)
+	params.Save(params.ProxyCfg.ReadRetryMinPerSecond.Key, "0")
+	defer params.Reset(params.ProxyCfg.ReadRetryMinPerSecond.Key)
+
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+	}
+	mgr.UpdateShardLeaders(nil, shard2leaders)
+
+	querier := &mockQuery{}
+	querier.init()
+	querier.failset[0] = errors.New("mock query node error")
+
+	// the read retry is disabled by default, the failed shards are retried without the budget
+	assert.False(t, params.ProxyCfg.ReadRetryEnabled.GetAsBool())
+	err := newReplicaFailoverPolicy(metrics.SearchLabel)(ctx, mgr, querier.query, shard2leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID][]string{1: {"c0"}}, querier.records())
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// SearchReducedPrecision searches like Search, and returns the scores in the output_precision of the search params,
+// the scores too large for the precision are returned in float32.
+func (node *Proxy) SearchReducedPrecision(ctx context.Context, req *milvuspb.SearchRequest) (*proxypb.ReducedPrecisionSearchResults, error) {
+	precision, err := parseResultPrecision(req.GetSearchParams())
+	if err != nil {
+		return &proxypb.ReducedPrecisionSearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	resp, err := node.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reduceSearchResultsPrecision(resp, precision), nil
+}
+
+// QueryReducedPrecision queries like Query, and returns the float vectors in the output_precision of the query
+// params, the vectors of a field too large for the precision are returned in float32.
+func (node *Proxy) QueryReducedPrecision(ctx context.Context, req *milvuspb.QueryRequest) (*proxypb.ReducedPrecisionQueryResults, error) {
+	precision, err := parseResultPrecision(req.GetQueryParams())
+	if err != nil {
+		return &proxypb.ReducedPrecisionQueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	resp, err := node.Query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reduceQueryResultsPrecision(resp, precision), nil
+}
+
+func reduceSearchResultsPrecision(resp *milvuspb.SearchResults, precision internalpb.ResultPrecision) *proxypb.ReducedPrecisionSearchResults {
+	ret := &proxypb.ReducedPrecisionSearchResults{
+		Status:         resp.GetStatus(),
+		Results:        resp.GetResults(),
+		CollectionName: resp.GetCollectionName(),
+	}
+	if ret.GetResults() == nil {
+		return ret
+	}
+	if scores, ok := typeutil.EncodeReducedPrecision(ret.Results.GetScores(), precision); ok {
+		ret.Precision = precision
+		ret.Scores = scores
+		ret.Results.Scores = nil
+	}
+	return ret
+}
+
+func reduceQueryResultsPrecision(resp *milvuspb.QueryResults, precision internalpb.ResultPrecision) *proxypb.ReducedPrecisionQueryResults {
+	ret := &proxypb.ReducedPrecisionQueryResults{
+		Status:         resp.GetStatus(),
+		FieldsData:     resp.GetFieldsData(),
+		CollectionName: resp.GetCollectionName(),
+	}
+	ret.ReducedVectors = typeutil.ReduceVectorPrecision(ret.GetFieldsData(), precision)
+	if len(ret.ReducedVectors) > 0 {
+		ret.Precision = precision
+	}
+	return ret
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestProxy_ReducedPrecision(t *testing.T) {
+	ctx := context.Background()
+	node := &Proxy{}
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+
+	invalid := []*commonpb.KeyValuePair{{Key: OutputPrecisionKey, Value: "fp8"}}
+	searchResp, err := node.SearchReducedPrecision(ctx, &milvuspb.SearchRequest{SearchParams: invalid})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, searchResp.GetStatus().GetErrorCode())
+	queryResp, err := node.QueryReducedPrecision(ctx, &milvuspb.QueryRequest{QueryParams: invalid})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, queryResp.GetStatus().GetErrorCode())
+
+	searchResp, err = node.SearchReducedPrecision(ctx, &milvuspb.SearchRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, searchResp.GetStatus().GetErrorCode())
+	queryResp, err = node.QueryReducedPrecision(ctx, &milvuspb.QueryRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, queryResp.GetStatus().GetErrorCode())
+}
+
+func TestReduceSearchResultsPrecision(t *testing.T) {
+	newResults := func(scores []float32) *milvuspb.SearchResults {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Results: &schemapb.SearchResultData{
+				NumQueries: 1,
+				TopK:       2,
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+				Scores:     scores,
+				Topks:      []int64{2},
+			},
+			CollectionName: "coll",
+		}
+	}
+
+	ret := reduceSearchResultsPrecision(newResults([]float32{0.5, 0.25}), internalpb.ResultPrecision_Float32)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, ret.GetPrecision())
+	assert.Equal(t, []float32{0.5, 0.25}, ret.GetResults().GetScores())
+
+	fp32 := newResults([]float32{0.5, 0.25})
+	ret = reduceSearchResultsPrecision(newResults([]float32{0.5, 0.25}), internalpb.ResultPrecision_Float16)
+	assert.Equal(t, internalpb.ResultPrecision_Float16, ret.GetPrecision())
+	assert.Empty(t, ret.GetResults().GetScores())
+	assert.Equal(t, "coll", ret.GetCollectionName())
+	assert.Less(t, proto.Size(ret), proto.Size(fp32))
+	scores, err := typeutil.DecodeReducedPrecision(ret.GetScores(), ret.GetPrecision())
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.5, 0.25}, scores)
+
+	// the scores overflowing float16 are returned in float32
+	ret = reduceSearchResultsPrecision(newResults([]float32{1e5, 1}), internalpb.ResultPrecision_Float16)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, ret.GetPrecision())
+	assert.Equal(t, []float32{1e5, 1}, ret.GetResults().GetScores())
+
+	ret = reduceSearchResultsPrecision(&milvuspb.SearchResults{Status: unhealthyStatus()}, internalpb.ResultPrecision_Float16)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, ret.GetStatus().GetErrorCode())
+	assert.Nil(t, ret.GetScores())
+}
+
+func TestReduceQueryResultsPrecision(t *testing.T) {
+	resp := &milvuspb.QueryResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		FieldsData: []*schemapb.FieldData{
+			getFieldData("int64", 101, schemapb.DataType_Int64, []int64{1, 2}, 1),
+			getFieldData("vec", 102, schemapb.DataType_FloatVector, []float32{0.5, 1, 2, 4, 8, 16, 32, 64}, 2),
+		},
+	}
+	fp32 := proto.Size(resp)
+	ret := reduceQueryResultsPrecision(resp, internalpb.ResultPrecision_BFloat16)
+	assert.Equal(t, internalpb.ResultPrecision_BFloat16, ret.GetPrecision())
+	assert.Len(t, ret.GetReducedVectors(), 1)
+	assert.Less(t, proto.Size(ret), fp32)
+
+	assert.NoError(t, typeutil.FillInReducedVectors(ret.GetFieldsData(), ret.GetPrecision(), ret.GetReducedVectors()))
+	assert.Equal(t, []float32{0.5, 1, 2, 4, 8, 16, 32, 64}, ret.GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+
+	ret = reduceQueryResultsPrecision(&milvuspb.QueryResults{Status: unhealthyStatus()}, internalpb.ResultPrecision_Float16)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, ret.GetPrecision())
+	assert.Empty(t, ret.GetReducedVectors())
+}
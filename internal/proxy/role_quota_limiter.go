@@ -0,0 +1,257 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// roleQuotaLimiter limits the request rates per user by the quotas bound to the roles of the user,
+// a user granted several roles is limited by the strictest rate of each rate type among the quotas.
+type roleQuotaLimiter struct {
+	mu     sync.RWMutex
+	quotas map[string]map[internalpb.RateType]float64 // role name -> rates
+	users  map[string]*userRateLimiter                // username -> limiters
+}
+
+// userRateLimiter holds the limiters of a user derived from the quotas of the roles.
+type userRateLimiter struct {
+	roles    string
+	limiters map[internalpb.RateType]*ratelimitutil.Limiter
+}
+
+func newRoleQuotaLimiter() *roleQuotaLimiter {
+	return &roleQuotaLimiter{
+		quotas: make(map[string]map[internalpb.RateType]float64),
+		users:  make(map[string]*userRateLimiter),
+	}
+}
+
+// setQuota replaces the quota of the role, a quota without rates removes the limits of the role.
+func (l *roleQuotaLimiter) setQuota(quota *model.RoleQuota) error {
+	rates, err := quota.GetRateTypes()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(rates) == 0 {
+		delete(l.quotas, quota.RoleName)
+	} else {
+		l.quotas[quota.RoleName] = rates
+	}
+	// the limiters of users are derived from the new quotas on their next requests
+	l.users = make(map[string]*userRateLimiter)
+	log.Info("RateLimiter set role quota", zap.String("role_name", quota.RoleName), zap.Any("rates", quota.Rates))
+	return nil
+}
+
+func (l *roleQuotaLimiter) empty() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.quotas) == 0
+}
+
+// getUserRateLimiter returns the limiters of the user, which are derived again once the roles of the user change.
+func (l *roleQuotaLimiter) getUserRateLimiter(username string, roles []string) *userRateLimiter {
+	sorted := make([]string, len(roles))
+	copy(sorted, roles)
+	sort.Strings(sorted)
+	key := strings.Join(sorted, ",")
+
+	l.mu.RLock()
+	user, ok := l.users[username]
+	l.mu.RUnlock()
+	if ok && user.roles == key {
+		return user
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if user, ok := l.users[username]; ok && user.roles == key {
+		return user
+	}
+	user = &userRateLimiter{
+		roles:    key,
+		limiters: make(map[internalpb.RateType]*ratelimitutil.Limiter),
+	}
+	strictest := make(map[internalpb.RateType]float64)
+	for _, role := range sorted {
+		for rt, r := range l.quotas[role] {
+			if old, ok := strictest[rt]; !ok || r < old {
+				strictest[rt] = r
+			}
+		}
+	}
+	for rt, r := range strictest {
+		// use rate as burst, because Limiter is with punishment mechanism, burst is insignificant.
+		user.limiters[rt] = ratelimitutil.NewLimiter(ratelimitutil.Limit(r), r)
+	}
+	l.users[username] = user
+	return user
+}
+
+// limit returns true if the request of the user will be rejected, and the rate limiting the user.
+func (l *roleQuotaLimiter) limit(username string, roles []string, rt internalpb.RateType, n int) (bool, float64) {
+	limiter, ok := l.getUserRateLimiter(username, roles).limiters[rt]
+	if !ok {
+		return false, float64(ratelimitutil.Inf)
+	}
+	return !limiter.AllowN(time.Now(), n), float64(limiter.Limit())
+}
+
+// initRoleQuotas loads the quotas of roles from RootCoord, the later changes are refreshed by RootCoord.
+func (node *Proxy) initRoleQuotas() error {
+	resp, err := node.rootCoord.ListRoleQuotas(node.ctx, &proxypb.ListRoleQuotasRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+	})
+	if funcutil.IsGrpcUnimplemented(err) {
+		// RootCoord of the older versions has no role quotas during a rolling upgrade
+		log.Warn("skip loading role quotas from the RootCoord not serving ListRoleQuotas", zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	for _, quota := range resp.GetQuotas() {
+		if err := node.multiRateLimiter.roleQuotaLimiter.setQuota(model.UnmarshalRoleQuotaModel(quota)); err != nil {
+			log.Warn("skip invalid role quota", zap.String("role_name", quota.GetRoleName()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// RefreshRoleQuota applies the quota of a role saved or dropped in RootCoord.
+func (node *Proxy) RefreshRoleQuota(ctx context.Context, req *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	quota := model.UnmarshalRoleQuotaModel(req.GetQuota())
+	if quota == nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "role quota is required",
+		}, nil
+	}
+	if err := node.multiRateLimiter.roleQuotaLimiter.setQuota(quota); err != nil {
+		log.Ctx(ctx).Warn("fail to refresh role quota", zap.String("role_name", quota.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+// ListRoleQuotas forwards the request to RootCoord, which lists the rate limiting quotas of roles.
+// The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ListRoleQuotasResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "ListRoleQuotas"
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole))
+	log.Debug(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.ListRoleQuotas(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &proxypb.ListRoleQuotasResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("quotas", len(resp.GetQuotas())))
+	return resp, nil
+}
+
+// SaveRoleQuota forwards the request to RootCoord, which saves the rate limiting quota of a role and refreshes it
+// in proxies. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "SaveRoleQuota"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("role_name", req.GetQuota().GetRoleName()),
+		zap.Any("rates", req.GetQuota().GetRates()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.SaveRoleQuota(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
+
+// DropRoleQuota forwards the request to RootCoord, which drops the rate limiting quota of a role and removes its
+// limits in proxies. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "DropRoleQuota"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("role_name", req.GetRoleName()))
+	log.Info(rpcReceived(method))
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.DropRoleQuota(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.String("errorCode", status.GetErrorCode().String()))
+	return status, nil
+}
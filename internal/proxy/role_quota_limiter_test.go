@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+func TestRoleQuotaLimiter(t *testing.T) {
+	l := newRoleQuotaLimiter()
+	assert.True(t, l.empty())
+
+	err := l.setQuota(&model.RoleQuota{RoleName: "ingest", Rates: map[string]float64{"DMLInsert": 1000, "DQLSearch": 100}})
+	assert.NoError(t, err)
+	err = l.setQuota(&model.RoleQuota{RoleName: "readonly", Rates: map[string]float64{"DMLInsert": 0, "DQLSearch": 500}})
+	assert.NoError(t, err)
+	err = l.setQuota(&model.RoleQuota{RoleName: "readonly", Rates: map[string]float64{"Unknown": 1}})
+	assert.Error(t, err)
+	assert.False(t, l.empty())
+
+	// the strictest rates of the roles limit the user
+	limit, rate := l.limit("foo", []string{"readonly", "ingest"}, internalpb.RateType_DMLInsert, 1)
+	assert.True(t, limit)
+	assert.Equal(t, float64(0), rate)
+	_, rate = l.limit("foo", []string{"ingest", "readonly"}, internalpb.RateType_DQLSearch, 1)
+	assert.Equal(t, float64(100), rate)
+	limit, _ = l.limit("foo", []string{"ingest", "readonly"}, internalpb.RateType_DQLSearch, math.MaxInt)
+	assert.False(t, limit)
+	limit, _ = l.limit("foo", []string{"ingest", "readonly"}, internalpb.RateType_DQLSearch, 1)
+	assert.True(t, limit)
+	limit, rate = l.limit("foo", []string{"ingest", "readonly"}, internalpb.RateType_DDLIndex, 1)
+	assert.False(t, limit)
+	assert.Equal(t, float64(ratelimitutil.Inf), rate)
+
+	// the limiters are derived again once the roles of user change
+	_, rate = l.limit("foo", []string{"ingest"}, internalpb.RateType_DMLInsert, 1)
+	assert.Equal(t, float64(1000), rate)
+
+	err = l.setQuota(&model.RoleQuota{RoleName: "ingest"})
+	assert.NoError(t, err)
+	_, rate = l.limit("foo", []string{"ingest"}, internalpb.RateType_DMLInsert, 1)
+	assert.Equal(t, float64(ratelimitutil.Inf), rate)
+}
+
+func TestMultiRateLimiter_CheckUser(t *testing.T) {
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getUserRoleFunc = func(username string) []string {
+		return []string{"readonly"}
+	}
+	globalMetaCache = mockCache
+
+	m := NewMultiRateLimiter()
+	assert.NoError(t, m.CheckUser("foo", internalpb.RateType_DMLInsert, 1))
+
+	err := m.roleQuotaLimiter.setQuota(&model.RoleQuota{RoleName: "readonly", Rates: map[string]float64{"DMLInsert": 0}})
+	assert.NoError(t, err)
+	err = m.roleQuotaLimiter.setQuota(&model.RoleQuota{RoleName: util.RolePublic, Rates: map[string]float64{"DQLSearch": 10}})
+	assert.NoError(t, err)
+
+	err = m.CheckUser("foo", internalpb.RateType_DMLInsert, 1)
+	assert.True(t, errors.Is(err, ErrForceDeny))
+	assert.NoError(t, m.CheckUser(util.UserRoot, internalpb.RateType_DMLInsert, 1))
+	assert.NoError(t, m.CheckUser("foo", internalpb.RateType_DQLSearch, math.MaxInt))
+	err = m.CheckUser("foo", internalpb.RateType_DQLSearch, 1)
+	assert.True(t, errors.Is(err, ErrRateLimit))
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+	assert.NoError(t, m.CheckUser("foo", internalpb.RateType_DMLInsert, 1))
+}
+
+func TestProxy_RefreshRoleQuota(t *testing.T) {
+	node := &Proxy{multiRateLimiter: NewMultiRateLimiter()}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	status, err := node.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{
+		Quota: &proxypb.RoleQuota{RoleName: "readonly", Rates: map[string]float64{"DMLInsert": 0}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.False(t, node.multiRateLimiter.roleQuotaLimiter.empty())
+
+	status, err = node.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{
+		Quota: &proxypb.RoleQuota{RoleName: "readonly", Rates: map[string]float64{"Unknown": 1}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = node.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+
+	status, err = node.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{
+		Quota: &proxypb.RoleQuota{RoleName: "readonly"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.True(t, node.multiRateLimiter.roleQuotaLimiter.empty())
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	status, err = node.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
+
+func TestProxy_InitRoleQuotas(t *testing.T) {
+	rc := NewRootCoordMock()
+	defer rc.Stop()
+	node := &Proxy{ctx: context.Background(), rootCoord: rc, multiRateLimiter: NewMultiRateLimiter()}
+
+	rc.listRoleQuotasFunc = func(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+		return &proxypb.ListRoleQuotasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Quotas: []*proxypb.RoleQuota{
+				{RoleName: "readonly", Rates: map[string]float64{"DMLInsert": 0}},
+				{RoleName: "invalid", Rates: map[string]float64{"Unknown": 1}},
+			},
+		}, nil
+	}
+	assert.NoError(t, node.initRoleQuotas())
+	limit, _ := node.multiRateLimiter.roleQuotaLimiter.limit("foo", []string{"readonly"}, internalpb.RateType_DMLInsert, 1)
+	assert.True(t, limit)
+
+	// the RootCoord of the older versions doesn't serve ListRoleQuotas
+	rc.listRoleQuotasFunc = func(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+		return nil, fmt.Errorf("wrap grpc error: %w", grpcStatus.Error(codes.Unimplemented, "unknown method ListRoleQuotas"))
+	}
+	assert.NoError(t, node.initRoleQuotas())
+	rc.listRoleQuotasFunc = func(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+		return nil, errors.New("mock error")
+	}
+	assert.Error(t, node.initRoleQuotas())
+	rc.listRoleQuotasFunc = func(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+		return &proxypb.ListRoleQuotasResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}, nil
+	}
+	assert.Error(t, node.initRoleQuotas())
+}
+
+func TestProxy_RoleQuotaAPIs(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	defer rc.Stop()
+	node := &Proxy{rootCoord: rc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	resp, err := node.ListRoleQuotas(ctx, &proxypb.ListRoleQuotasRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	status, err := node.SaveRoleQuota(ctx, &proxypb.SaveRoleQuotaRequest{Quota: &proxypb.RoleQuota{RoleName: "readonly"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = node.DropRoleQuota(ctx, &proxypb.DropRoleQuotaRequest{RoleName: "readonly"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	for _, req := range []interface{}{&proxypb.ListRoleQuotasRequest{}, &proxypb.SaveRoleQuotaRequest{}, &proxypb.DropRoleQuotaRequest{}} {
+		_, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+	}
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.ListRoleQuotas(ctx, &proxypb.ListRoleQuotasRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	status, err = node.SaveRoleQuota(ctx, &proxypb.SaveRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	status, err = node.DropRoleQuota(ctx, &proxypb.DropRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
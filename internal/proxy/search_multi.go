@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getSearchMultiAnnsField returns the anns field of the collections, they must have the same vector type and dim.
+func getSearchMultiAnnsField(ctx context.Context, collectionNames []string, annsField string) (*schemapb.FieldSchema, int64, error) {
+	var expected *schemapb.FieldSchema
+	var expectedDim int64
+	for _, name := range collectionNames {
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, name)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get schema of collection %s: %w", name, err)
+		}
+		var field *schemapb.FieldSchema
+		for _, f := range schema.GetFields() {
+			if f.GetName() == annsField {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, 0, fmt.Errorf("field %s not exist in collection %s", annsField, name)
+		}
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid field %s of collection %s: %w", annsField, name, err)
+		}
+		if expected == nil {
+			expected, expectedDim = field, dim
+			continue
+		}
+		if field.GetDataType() != expected.GetDataType() || dim != expectedDim {
+			return nil, 0, fmt.Errorf("field %s of collection %s is %s of dim %d, but %s of dim %d in collection %s",
+				annsField, name, field.GetDataType(), dim, expected.GetDataType(), expectedDim, collectionNames[0])
+		}
+	}
+	return expected, expectedDim, nil
+}
+
+// checkSearchMultiVectors decodes the placeholder group shared by the collections once,
+// checks the vectors match the anns field and returns the nq.
+func checkSearchMultiVectors(placeholderGroup []byte, dataType schemapb.DataType, dim int64) (int64, error) {
+	phg := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(placeholderGroup, phg); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal placeholder group: %w", err)
+	}
+	expectedType, expectedSize := commonpb.PlaceholderType_FloatVector, 4*dim
+	if dataType == schemapb.DataType_BinaryVector {
+		expectedType, expectedSize = commonpb.PlaceholderType_BinaryVector, dim/8
+	}
+	nq := int64(0)
+	for _, ph := range phg.GetPlaceholders() {
+		if ph.GetType() != expectedType {
+			return 0, fmt.Errorf("placeholder type %s doesn't match the anns field type %s", ph.GetType(), dataType)
+		}
+		for _, value := range ph.GetValues() {
+			if int64(len(value)) != expectedSize {
+				return 0, fmt.Errorf("vector of %d bytes doesn't match the anns field dim %d", len(value), dim)
+			}
+		}
+		nq += int64(len(ph.GetValues()))
+	}
+	if nq == 0 {
+		return 0, errors.New("no vector to search")
+	}
+	return nq, nil
+}
+
+// SearchMulti does the same search on the collections in parallel, e.g. the collections of the tenants,
+// the partition names of the request are ignored. The anns field of the collections must have the same
+// vector type and dim, the metric type is shared by the search params. The vectors are decoded and checked
+// once, and the encoded placeholder group is shared by the searches of the collections.
+// The results are returned in the order of the collection names, the status of each result tells
+// whether the search on the collection succeeded. An error is returned if the collections can't be searched together.
+func (node *Proxy) SearchMulti(ctx context.Context, collectionNames []string, request *milvuspb.SearchRequest) ([]*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return nil, errProxyIsUnhealthy(paramtable.GetNodeID())
+	}
+
+	if len(collectionNames) == 0 {
+		return nil, errors.New("no collection to search")
+	}
+	maxNum := Params.ProxyCfg.SearchMultiMaxCollections.GetAsInt()
+	if maxNum > 0 && len(collectionNames) > maxNum {
+		return nil, fmt.Errorf("%d collections exceed the max number %d of a multi-collection search", len(collectionNames), maxNum)
+	}
+	names := make(map[string]struct{}, len(collectionNames))
+	for _, name := range collectionNames {
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("duplicated collection %s", name)
+		}
+		names[name] = struct{}{}
+	}
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, request.GetSearchParams())
+	if err != nil {
+		return nil, errors.New(AnnsFieldKey + " not found in search_params")
+	}
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, request.GetSearchParams()); err != nil {
+		return nil, errors.New(common.MetricTypeKey + " not found in search_params")
+	}
+
+	field, dim, err := getSearchMultiAnnsField(ctx, collectionNames, annsField)
+	if err != nil {
+		return nil, err
+	}
+	nq, err := checkSearchMultiVectors(request.GetPlaceholderGroup(), field.GetDataType(), dim)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := Params.ProxyCfg.SearchMultiParallelism.GetAsInt()
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+	log := log.Ctx(ctx).With(zap.Strings("collections", collectionNames), zap.Int64("nq", nq))
+	log.Debug("multi-collection search received", zap.Int("parallelism", parallelism))
+
+	results := make([]*milvuspb.SearchResults, len(collectionNames))
+	sem := make(chan struct{}, parallelism)
+	wg := &sync.WaitGroup{}
+	for i, name := range collectionNames {
+		i, name := i, name
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var base *commonpb.MsgBase
+			if request.GetBase() != nil {
+				base = proto.Clone(request.GetBase()).(*commonpb.MsgBase)
+			}
+			req := &milvuspb.SearchRequest{
+				Base:               base,
+				DbName:             request.GetDbName(),
+				CollectionName:     name,
+				Dsl:                request.GetDsl(),
+				PlaceholderGroup:   request.GetPlaceholderGroup(),
+				DslType:            request.GetDslType(),
+				OutputFields:       append([]string{}, request.GetOutputFields()...),
+				SearchParams:       request.GetSearchParams(),
+				TravelTimestamp:    request.GetTravelTimestamp(),
+				GuaranteeTimestamp: request.GetGuaranteeTimestamp(),
+				Nq:                 nq,
+			}
+			result, err := node.Search(ctx, req)
+			if err != nil {
+				result = &milvuspb.SearchResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_UnexpectedError,
+						Reason:    err.Error(),
+					},
+				}
+			}
+			result.CollectionName = name
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	failed := make([]string, 0)
+	for _, result := range results {
+		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			failed = append(failed, result.GetCollectionName())
+		}
+	}
+	log.Debug("multi-collection search done", zap.Strings("failed", failed))
+	return results, nil
+}
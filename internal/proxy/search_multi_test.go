@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newSearchMultiSchema(dataType schemapb.DataType, dim string) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: dataType, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: dim}}},
+		},
+	}
+}
+
+func newSearchMultiPlaceholderGroup(phType commonpb.PlaceholderType, values ...[]byte) []byte {
+	phg := &commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{Tag: "$0", Type: phType, Values: values}},
+	}
+	bytes, _ := proto.Marshal(phg)
+	return bytes
+}
+
+func TestCheckSearchMultiVectors(t *testing.T) {
+	nq, err := checkSearchMultiVectors(newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_FloatVector,
+		make([]byte, 8), make([]byte, 8)), schemapb.DataType_FloatVector, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), nq)
+
+	nq, err = checkSearchMultiVectors(newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_BinaryVector,
+		make([]byte, 2)), schemapb.DataType_BinaryVector, 16)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), nq)
+
+	_, err = checkSearchMultiVectors(newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_FloatVector,
+		make([]byte, 8), make([]byte, 12)), schemapb.DataType_FloatVector, 2)
+	assert.Error(t, err)
+
+	_, err = checkSearchMultiVectors(newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_BinaryVector,
+		make([]byte, 8)), schemapb.DataType_FloatVector, 2)
+	assert.Error(t, err)
+
+	_, err = checkSearchMultiVectors(newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_FloatVector),
+		schemapb.DataType_FloatVector, 2)
+	assert.Error(t, err)
+
+	_, err = checkSearchMultiVectors([]byte{1, 2, 3}, schemapb.DataType_FloatVector, 2)
+	assert.Error(t, err)
+}
+
+func TestProxy_SearchMulti(t *testing.T) {
+	ctx := context.Background()
+	node := &Proxy{}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	schemas := map[string]*schemapb.CollectionSchema{
+		"tenant1":  newSearchMultiSchema(schemapb.DataType_FloatVector, "2"),
+		"tenant2":  newSearchMultiSchema(schemapb.DataType_FloatVector, "2"),
+		"wrongDim": newSearchMultiSchema(schemapb.DataType_FloatVector, "4"),
+		"binary":   newSearchMultiSchema(schemapb.DataType_BinaryVector, "16"),
+	}
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		schema, ok := schemas[collectionName]
+		if !ok {
+			return nil, errors.New("collection not found")
+		}
+		return schema, nil
+	})
+	globalMetaCache = mockCache
+
+	request := &milvuspb.SearchRequest{
+		Dsl:     "pk > 0",
+		DslType: commonpb.DslType_BoolExprV1,
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: AnnsFieldKey, Value: "vec"},
+			{Key: common.MetricTypeKey, Value: "L2"},
+			{Key: TopKKey, Value: "10"},
+		},
+		PlaceholderGroup: newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_FloatVector, make([]byte, 8)),
+	}
+
+	t.Run("invalid collections", func(t *testing.T) {
+		_, err := node.SearchMulti(ctx, nil, request)
+		assert.Error(t, err)
+
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "tenant1"}, request)
+		assert.Error(t, err)
+
+		paramtable.Get().Save(Params.ProxyCfg.SearchMultiMaxCollections.Key, "1")
+		defer paramtable.Get().Reset(Params.ProxyCfg.SearchMultiMaxCollections.Key)
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "tenant2"}, request)
+		assert.Error(t, err)
+	})
+
+	t.Run("different anns fields", func(t *testing.T) {
+		_, err := node.SearchMulti(ctx, []string{"tenant1", "wrongDim"}, request)
+		assert.Error(t, err)
+
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "binary"}, request)
+		assert.Error(t, err)
+
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "notExist"}, request)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid search params", func(t *testing.T) {
+		req := proto.Clone(request).(*milvuspb.SearchRequest)
+		req.SearchParams = req.SearchParams[1:]
+		_, err := node.SearchMulti(ctx, []string{"tenant1", "tenant2"}, req)
+		assert.Error(t, err)
+
+		req = proto.Clone(request).(*milvuspb.SearchRequest)
+		req.SearchParams = []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "vec"}}
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "tenant2"}, req)
+		assert.Error(t, err)
+
+		req = proto.Clone(request).(*milvuspb.SearchRequest)
+		req.SearchParams = []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "pk"}, {Key: common.MetricTypeKey, Value: "L2"}}
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "tenant2"}, req)
+		assert.Error(t, err)
+
+		req = proto.Clone(request).(*milvuspb.SearchRequest)
+		req.PlaceholderGroup = newSearchMultiPlaceholderGroup(commonpb.PlaceholderType_FloatVector, make([]byte, 16))
+		_, err = node.SearchMulti(ctx, []string{"tenant1", "tenant2"}, req)
+		assert.Error(t, err)
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer node.stateCode.Store(commonpb.StateCode_Healthy)
+		_, err := node.SearchMulti(ctx, []string{"tenant1", "tenant2"}, request)
+		assert.Error(t, err)
+	})
+}
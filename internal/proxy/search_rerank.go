@@ -0,0 +1,475 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"plugin"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// rerankPluginSymbol is the symbol of the Reranker exported by the rerank plugin.
+const rerankPluginSymbol = "MilvusReranker"
+
+// Reranker re-scores the merged top-k hits of a query in the proxy before they return to the client.
+type Reranker interface {
+	// Name is the strategy name the searches pick the reranker by.
+	Name() string
+	// Rerank returns the order of the hits to return as the indexes of the hits, and their new scores.
+	// The hits not in the order are dropped.
+	Rerank(ctx context.Context, hits *RerankHits, params map[string]string) ([]int, []float32, error)
+}
+
+// RerankValidator is implemented by the rerankers checking their params before the search runs,
+// outputFields are the output fields of the search.
+type RerankValidator interface {
+	Validate(params map[string]string, outputFields []string) error
+}
+
+// RerankHits are the merged top-k hits of a query, a larger score is more relevant whatever the metric type is.
+type RerankHits struct {
+	MetricType string
+	Scores     []float32
+
+	fields map[string]*schemapb.FieldData
+	offset int
+}
+
+// Len returns the number of the hits.
+func (h *RerankHits) Len() int {
+	return len(h.Scores)
+}
+
+// Field returns the values of an output field of the hits.
+func (h *RerankHits) Field(name string) ([]interface{}, error) {
+	field, ok := h.fields[name]
+	if !ok {
+		return nil, fmt.Errorf("field %s is not an output field of the search", name)
+	}
+	values := make([]interface{}, 0, h.Len())
+	scalars := field.GetScalars()
+	for i := h.offset; i < h.offset+h.Len(); i++ {
+		switch field.GetType() {
+		case schemapb.DataType_Bool:
+			values = append(values, scalars.GetBoolData().GetData()[i])
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+			values = append(values, int64(scalars.GetIntData().GetData()[i]))
+		case schemapb.DataType_Int64:
+			values = append(values, scalars.GetLongData().GetData()[i])
+		case schemapb.DataType_Float:
+			values = append(values, float64(scalars.GetFloatData().GetData()[i]))
+		case schemapb.DataType_Double:
+			values = append(values, scalars.GetDoubleData().GetData()[i])
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
+			values = append(values, scalars.GetStringData().GetData()[i])
+		default:
+			return nil, fmt.Errorf("field %s of type %s can't be used to re-rank", name, field.GetType().String())
+		}
+	}
+	return values, nil
+}
+
+var (
+	rerankersMu sync.RWMutex
+	rerankers   = map[string]Reranker{}
+
+	loadRerankPluginOnce sync.Once
+)
+
+func init() {
+	RegisterReranker(mmrReranker{})
+	RegisterReranker(recencyReranker{})
+}
+
+// RegisterReranker registers the reranker by its name, a reranker of the same name is replaced.
+func RegisterReranker(reranker Reranker) {
+	rerankersMu.Lock()
+	defer rerankersMu.Unlock()
+	rerankers[strings.ToLower(reranker.Name())] = reranker
+}
+
+func getReranker(name string) (Reranker, bool) {
+	loadRerankPluginOnce.Do(func() {
+		if err := loadRerankPlugin(Params.ProxyCfg.RerankSoPath.GetValue()); err != nil {
+			log.Warn("failed to load the rerank plugin",
+				zap.String("path", Params.ProxyCfg.RerankSoPath.GetValue()), zap.Error(err))
+		}
+	})
+	rerankersMu.RLock()
+	defer rerankersMu.RUnlock()
+	reranker, ok := rerankers[strings.ToLower(name)]
+	return reranker, ok
+}
+
+// loadRerankPlugin registers the Reranker exported by the go plugin at path.
+func loadRerankPlugin(path string) error {
+	if path == "" {
+		return nil
+	}
+	p, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("fail to open the plugin, error: %s", err.Error())
+	}
+	sym, err := p.Lookup(rerankPluginSymbol)
+	if err != nil {
+		return fmt.Errorf("fail to find the '%s' object in the plugin, error: %s", rerankPluginSymbol, err.Error())
+	}
+	switch reranker := sym.(type) {
+	case Reranker:
+		RegisterReranker(reranker)
+	case *Reranker:
+		RegisterReranker(*reranker)
+	default:
+		return fmt.Errorf("fail to convert the '%s' object to Reranker", rerankPluginSymbol)
+	}
+	log.Info("rerank plugin loaded", zap.String("path", path))
+	return nil
+}
+
+// searchRerank is the reranker picked by a search with its params.
+type searchRerank struct {
+	reranker Reranker
+	params   map[string]string
+}
+
+// parseRerank returns the reranker picked by the RerankKey param, nil if the search doesn't re-rank.
+func parseRerank(params []*commonpb.KeyValuePair, outputFields []string) (*searchRerank, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(RerankKey, params)
+	if err != nil || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	if !Params.ProxyCfg.RerankEnabled.GetAsBool() {
+		return nil, fmt.Errorf("%s is disabled by proxy.rerank.enabled", RerankKey)
+	}
+
+	spec := struct {
+		Strategy string                 `json:"strategy"`
+		Params   map[string]interface{} `json:"params"`
+	}{}
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "{") {
+		if err := json.Unmarshal([]byte(value), &spec); err != nil {
+			return nil, fmt.Errorf("%s [%s] is invalid, %w", RerankKey, value, err)
+		}
+	} else {
+		spec.Strategy = value
+	}
+
+	reranker, ok := getReranker(spec.Strategy)
+	if !ok {
+		return nil, fmt.Errorf("%s strategy [%s] is not registered", RerankKey, spec.Strategy)
+	}
+	rerank := &searchRerank{reranker: reranker, params: make(map[string]string, len(spec.Params))}
+	for key, param := range spec.Params {
+		if str, ok := param.(string); ok {
+			rerank.params[key] = str
+		} else {
+			rerank.params[key] = fmt.Sprint(param)
+		}
+	}
+	if validator, ok := reranker.(RerankValidator); ok {
+		if err := validator.Validate(rerank.params, outputFields); err != nil {
+			return nil, fmt.Errorf("invalid %s params of strategy %s: %w", RerankKey, reranker.Name(), err)
+		}
+	}
+	return rerank, nil
+}
+
+// apply re-ranks the hits of every query of the reduced result, the fields data of the result are in the order
+// of the output fields. The hits re-ranked are the reduced page, so they are after the offset of the search.
+func (r *searchRerank) apply(ctx context.Context, result *schemapb.SearchResultData, metricType string, outputFields []string) error {
+	if result == nil || len(result.GetScores()) == 0 {
+		return nil
+	}
+	positive := distance.PositivelyRelated(metricType)
+	fields := make(map[string]*schemapb.FieldData, len(outputFields))
+	if len(result.GetFieldsData()) == len(outputFields) {
+		for i, name := range outputFields {
+			fields[name] = result.GetFieldsData()[i]
+		}
+	}
+
+	ids, err := newIDsLike(result.GetIds())
+	if err != nil {
+		return err
+	}
+	fieldsData := make([]*schemapb.FieldData, len(result.GetFieldsData()))
+	scores := make([]float32, 0, len(result.GetScores()))
+	topks := make([]int64, 0, len(result.GetTopks()))
+
+	offset := 0
+	for _, topk := range result.GetTopks() {
+		hits := &RerankHits{
+			MetricType: metricType,
+			Scores:     make([]float32, topk),
+			fields:     fields,
+			offset:     offset,
+		}
+		for j := range hits.Scores {
+			hits.Scores[j] = result.GetScores()[offset+j]
+			if !positive {
+				hits.Scores[j] *= -1
+			}
+		}
+		order, newScores, err := r.reranker.Rerank(ctx, hits, r.params)
+		if err != nil {
+			return fmt.Errorf("failed to re-rank by strategy %s: %w", r.reranker.Name(), err)
+		}
+		if err := checkRerankOrder(order, newScores, hits.Len()); err != nil {
+			return fmt.Errorf("strategy %s returns an invalid order: %w", r.reranker.Name(), err)
+		}
+		for j, idx := range order {
+			typeutil.AppendPKs(ids, typeutil.GetPK(result.GetIds(), int64(offset+idx)))
+			typeutil.AppendFieldData(fieldsData, result.GetFieldsData(), int64(offset+idx))
+			score := newScores[j]
+			if !positive {
+				score *= -1
+			}
+			scores = append(scores, score)
+		}
+		topks = append(topks, int64(len(order)))
+		offset += int(topk)
+	}
+
+	result.Ids = ids
+	result.FieldsData = fieldsData
+	result.Scores = scores
+	result.Topks = topks
+	result.TopK = topks[len(topks)-1]
+	log.Ctx(ctx).Debug("search results re-ranked", zap.String("strategy", r.reranker.Name()))
+	return nil
+}
+
+func checkRerankOrder(order []int, scores []float32, n int) error {
+	if len(order) != len(scores) {
+		return fmt.Errorf("%d hits but %d scores", len(order), len(scores))
+	}
+	seen := make(map[int]struct{}, len(order))
+	for _, idx := range order {
+		if idx < 0 || idx >= n {
+			return fmt.Errorf("hit index %d out of range [0, %d)", idx, n)
+		}
+		if _, ok := seen[idx]; ok {
+			return fmt.Errorf("hit index %d is duplicated", idx)
+		}
+		seen[idx] = struct{}{}
+	}
+	return nil
+}
+
+// newIDsLike returns empty ids of the same pk type.
+func newIDsLike(ids *schemapb.IDs) (*schemapb.IDs, error) {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}}, nil
+	case *schemapb.IDs_StrId:
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{}}}, nil
+	default:
+		return nil, errors.New("unsupported pk type")
+	}
+}
+
+// checkRerankField checks the field param of a built-in strategy is an output field.
+func checkRerankField(params map[string]string, outputFields []string) error {
+	field := params["field"]
+	if field == "" {
+		return errors.New("field is not set")
+	}
+	for _, outputField := range outputFields {
+		if outputField == field {
+			return nil
+		}
+	}
+	return fmt.Errorf("field %s should be an output field of the search", field)
+}
+
+// getFloatParam returns the float param, defaultValue if it is not set.
+func getFloatParam(params map[string]string, key string, defaultValue float64) (float64, error) {
+	value, ok := params[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	ret, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s [%s] is invalid", key, value)
+	}
+	return ret, nil
+}
+
+// mmrReranker diversifies the hits by maximal marginal relevance over a field, the hits of the same field value
+// are similar, and lambda in [0, 1] trades the relevance off against the diversity. The scores are unchanged.
+type mmrReranker struct{}
+
+func (mmrReranker) Name() string {
+	return "mmr"
+}
+
+func (mmrReranker) Validate(params map[string]string, outputFields []string) error {
+	if err := checkRerankField(params, outputFields); err != nil {
+		return err
+	}
+	lambda, err := getFloatParam(params, "lambda", 0.5)
+	if err != nil {
+		return err
+	}
+	if lambda < 0 || lambda > 1 {
+		return fmt.Errorf("lambda [%v] should be in range [0, 1]", lambda)
+	}
+	return nil
+}
+
+func (m mmrReranker) Rerank(ctx context.Context, hits *RerankHits, params map[string]string) ([]int, []float32, error) {
+	values, err := hits.Field(params["field"])
+	if err != nil {
+		return nil, nil, err
+	}
+	lambda, err := getFloatParam(params, "lambda", 0.5)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// normalize the scores into [0, 1] to be comparable with the similarity
+	minScore, maxScore := math.Inf(1), math.Inf(-1)
+	for _, score := range hits.Scores {
+		minScore = math.Min(minScore, float64(score))
+		maxScore = math.Max(maxScore, float64(score))
+	}
+	relevance := make([]float64, hits.Len())
+	for i, score := range hits.Scores {
+		if maxScore > minScore {
+			relevance[i] = (float64(score) - minScore) / (maxScore - minScore)
+		} else {
+			relevance[i] = 1
+		}
+	}
+
+	order := make([]int, 0, hits.Len())
+	scores := make([]float32, 0, hits.Len())
+	selected := make([]bool, hits.Len())
+	seenValues := make(map[interface{}]struct{})
+	for len(order) < hits.Len() {
+		best, bestScore := -1, math.Inf(-1)
+		for i := range hits.Scores {
+			if selected[i] {
+				continue
+			}
+			similarity := 0.0
+			if _, ok := seenValues[values[i]]; ok {
+				similarity = 1
+			}
+			mmr := lambda*relevance[i] - (1-lambda)*similarity
+			if mmr > bestScore {
+				best, bestScore = i, mmr
+			}
+		}
+		selected[best] = true
+		seenValues[values[best]] = struct{}{}
+		order = append(order, best)
+		scores = append(scores, hits.Scores[best])
+	}
+	return order, scores, nil
+}
+
+// recencyReranker boosts the hits by the age of a timestamp field, the boost is weight * 0.5^(age/half_life),
+// and the hits are sorted by the boosted scores. The timestamps and half_life are in unit, s or ms.
+type recencyReranker struct{}
+
+func (recencyReranker) Name() string {
+	return "recency"
+}
+
+func (recencyReranker) Validate(params map[string]string, outputFields []string) error {
+	if err := checkRerankField(params, outputFields); err != nil {
+		return err
+	}
+	halfLife, err := getFloatParam(params, "half_life", 0)
+	if err != nil {
+		return err
+	}
+	if halfLife <= 0 {
+		return fmt.Errorf("half_life [%v] should be positive", halfLife)
+	}
+	if _, err := getFloatParam(params, "weight", 1); err != nil {
+		return err
+	}
+	if unit, ok := params["unit"]; ok && unit != "s" && unit != "ms" {
+		return fmt.Errorf("unit [%s] should be s or ms", unit)
+	}
+	return nil
+}
+
+func (r recencyReranker) Rerank(ctx context.Context, hits *RerankHits, params map[string]string) ([]int, []float32, error) {
+	if err := r.Validate(params, []string{params["field"]}); err != nil {
+		return nil, nil, err
+	}
+	values, err := hits.Field(params["field"])
+	if err != nil {
+		return nil, nil, err
+	}
+	halfLife, _ := getFloatParam(params, "half_life", 0)
+	weight, _ := getFloatParam(params, "weight", 1)
+	now := float64(time.Now().Unix())
+	if params["unit"] == "ms" {
+		now = float64(time.Now().UnixMilli())
+	}
+	if now, err = getFloatParam(params, "now", now); err != nil {
+		return nil, nil, err
+	}
+
+	scores := make([]float32, hits.Len())
+	for i, value := range values {
+		var ts float64
+		switch v := value.(type) {
+		case int64:
+			ts = float64(v)
+		case float64:
+			ts = v
+		default:
+			return nil, nil, fmt.Errorf("field %s is not a numeric timestamp", params["field"])
+		}
+		age := math.Max(0, now-ts)
+		scores[i] = hits.Scores[i] + float32(weight*math.Pow(0.5, age/halfLife))
+	}
+	order := make([]int, hits.Len())
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+	newScores := make([]float32, len(order))
+	for i, idx := range order {
+		newScores[i] = scores[idx]
+	}
+	return order, newScores, nil
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// reverseReranker returns the hits in the reversed order with the scores doubled
+type reverseReranker struct{}
+
+func (reverseReranker) Name() string {
+	return "reverse"
+}
+
+func (reverseReranker) Rerank(ctx context.Context, hits *RerankHits, params map[string]string) ([]int, []float32, error) {
+	order := make([]int, 0, hits.Len())
+	scores := make([]float32, 0, hits.Len())
+	for i := hits.Len() - 1; i >= 0; i-- {
+		order = append(order, i)
+		scores = append(scores, hits.Scores[i]*2)
+	}
+	return order, scores, nil
+}
+
+// genRerankResult returns the result of 2 queries of 3 hits, with the int64 field "ts" and varchar field "tag"
+func genRerankResult(scores []float32) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Scores:     scores,
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{
+			Data: []int64{1, 2, 3, 4, 5, 6},
+		}}},
+		Topks: []int64{3, 3},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:  schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{100, 200, 300, 100, 200, 300}}}}},
+			},
+			{
+				Type:  schemapb.DataType_VarChar,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "a", "b", "a", "b", "b"}}}}},
+			},
+		},
+	}
+}
+
+func TestParseRerank(t *testing.T) {
+	paramtable.Init()
+	outputFields := []string{"ts", "tag"}
+
+	rerank, err := parseRerank(nil, outputFields)
+	assert.NoError(t, err)
+	assert.Nil(t, rerank)
+
+	rerank, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: `{"strategy": "MMR", "params": {"field": "tag", "lambda": 0.3}}`}}, outputFields)
+	require.NoError(t, err)
+	assert.Equal(t, "mmr", rerank.reranker.Name())
+	assert.Equal(t, map[string]string{"field": "tag", "lambda": "0.3"}, rerank.params)
+
+	_, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: "mmr"}}, outputFields)
+	assert.Error(t, err)
+	_, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: `{"strategy": "mmr", "params": {"field": "other"}}`}}, outputFields)
+	assert.Error(t, err)
+	_, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: `{"strategy": "recency", "params": {"field": "ts"}}`}}, outputFields)
+	assert.Error(t, err)
+	_, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: "unknown"}}, outputFields)
+	assert.Error(t, err)
+	_, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: "{invalid"}}, outputFields)
+	assert.Error(t, err)
+
+	RegisterReranker(reverseReranker{})
+	rerank, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: "reverse"}}, outputFields)
+	require.NoError(t, err)
+	assert.Equal(t, "reverse", rerank.reranker.Name())
+
+	paramtable.Get().Save(Params.ProxyCfg.RerankEnabled.Key, "false")
+	defer paramtable.Get().Reset(Params.ProxyCfg.RerankEnabled.Key)
+	_, err = parseRerank([]*commonpb.KeyValuePair{{Key: RerankKey, Value: "reverse"}}, outputFields)
+	assert.Error(t, err)
+}
+
+func TestSearchRerank_Apply(t *testing.T) {
+	outputFields := []string{"ts", "tag"}
+
+	t.Run("plugin reranker", func(t *testing.T) {
+		result := genRerankResult([]float32{0.9, 0.8, 0.7, 0.6, 0.5, 0.4})
+		rerank := &searchRerank{reranker: reverseReranker{}}
+		require.NoError(t, rerank.apply(context.Background(), result, distance.IP, outputFields))
+		assert.Equal(t, []int64{3, 2, 1, 6, 5, 4}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{1.4, 1.6, 1.8, 0.8, 1.0, 1.2}, result.GetScores())
+		assert.Equal(t, []int64{300, 200, 100, 300, 200, 100}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []string{"b", "a", "a", "b", "b", "a"}, result.GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	})
+
+	t.Run("distance metric", func(t *testing.T) {
+		result := genRerankResult([]float32{1, 2, 3, 1, 2, 3})
+		rerank := &searchRerank{reranker: reverseReranker{}}
+		require.NoError(t, rerank.apply(context.Background(), result, distance.L2, outputFields))
+		// the reranker sees the negated distances, the returned scores are distances again
+		assert.Equal(t, []float32{6, 4, 2, 6, 4, 2}, result.GetScores())
+	})
+
+	t.Run("mmr", func(t *testing.T) {
+		result := genRerankResult([]float32{0.9, 0.8, 0.7, 0.9, 0.8, 0.7})
+		rerank := &searchRerank{reranker: mmrReranker{}, params: map[string]string{"field": "tag", "lambda": "0.5"}}
+		require.NoError(t, rerank.apply(context.Background(), result, distance.IP, outputFields))
+		// the second hit of tag a is behind the first hit of tag b
+		assert.Equal(t, []int64{1, 3, 2, 4, 5, 6}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.9, 0.7, 0.8, 0.9, 0.8, 0.7}, result.GetScores())
+	})
+
+	t.Run("recency", func(t *testing.T) {
+		result := genRerankResult([]float32{0.9, 0.8, 0.7, 0.9, 0.8, 0.7})
+		rerank := &searchRerank{reranker: recencyReranker{}, params: map[string]string{"field": "ts", "half_life": "100", "now": "300"}}
+		require.NoError(t, rerank.apply(context.Background(), result, distance.IP, outputFields))
+		assert.Equal(t, []int64{3, 2, 1, 6, 5, 4}, result.GetIds().GetIntId().GetData())
+		assert.InDeltaSlice(t, []float32{1.7, 1.3, 1.15, 1.7, 1.3, 1.15}, result.GetScores(), 1e-6)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		result := genRerankResult([]float32{0.9, 0.8, 0.7, 0.9, 0.8, 0.7})
+		rerank := &searchRerank{reranker: mmrReranker{}, params: map[string]string{"field": "other"}}
+		assert.Error(t, rerank.apply(context.Background(), result, distance.IP, outputFields))
+
+		assert.Error(t, checkRerankOrder([]int{0, 0}, []float32{1, 1}, 2))
+		assert.Error(t, checkRerankOrder([]int{2}, []float32{1}, 2))
+		assert.Error(t, checkRerankOrder([]int{0}, nil, 2))
+		assert.NoError(t, checkRerankOrder([]int{1}, []float32{1}, 2))
+	})
+}
@@ -0,0 +1,268 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// defaultSearchVariant is the variant of the search traffic not assigned to any experimental variant.
+const defaultSearchVariant = "default"
+
+// searchVariant directs a percentage of the search traffic of a collection to a replica group,
+// e.g. the replicas loaded with an experimental index.
+type searchVariant struct {
+	Name       string  `json:"name"`
+	ReplicaIDs []int64 `json:"replica_ids"`
+	Percentage float64 `json:"percentage"`
+}
+
+// parseSearchTrafficRules parses the json map of collection name to the variants of the collection.
+func parseSearchTrafficRules(value string) (map[string][]*searchVariant, error) {
+	rules := make(map[string][]*searchVariant)
+	if value == "" {
+		return rules, nil
+	}
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, err
+	}
+	for collection, variants := range rules {
+		total := float64(0)
+		names := make(map[string]struct{}, len(variants))
+		for _, variant := range variants {
+			if variant.Name == "" || variant.Name == defaultSearchVariant {
+				return nil, fmt.Errorf("invalid search variant name %q of collection %s", variant.Name, collection)
+			}
+			if _, ok := names[variant.Name]; ok {
+				return nil, fmt.Errorf("duplicated search variant %s of collection %s", variant.Name, collection)
+			}
+			names[variant.Name] = struct{}{}
+			if len(variant.ReplicaIDs) == 0 {
+				return nil, fmt.Errorf("no replica of search variant %s of collection %s", variant.Name, collection)
+			}
+			if variant.Percentage < 0 {
+				return nil, fmt.Errorf("negative percentage of search variant %s of collection %s", variant.Name, collection)
+			}
+			total += variant.Percentage
+		}
+		if total > 100 {
+			return nil, fmt.Errorf("total percentage %v of search variants of collection %s exceeds 100", total, collection)
+		}
+	}
+	return rules, nil
+}
+
+type collectionReplicas struct {
+	nodeReplicas map[UniqueID]UniqueID // query node id -> replica id
+	updatedAt    time.Time
+}
+
+// searchTrafficSplitter splits the search traffic of collections among replica groups by the rules
+// of proxy.searchTrafficSplit.rules, so that indexes can be compared online without separate clusters.
+// A search picks a variant by the percentages and is served by the shard leaders of the replicas of the variant,
+// the traffic not assigned to any variant is served by the replicas not in any variant.
+// The replicas of collections are cached for proxy.searchTrafficSplit.cacheTTL, and invalidated when
+// the collection is loaded or released.
+type searchTrafficSplitter struct {
+	mu          sync.RWMutex
+	rawRules    string
+	rules       map[string][]*searchVariant
+	collections map[UniqueID]*collectionReplicas
+}
+
+func newSearchTrafficSplitter() *searchTrafficSplitter {
+	return &searchTrafficSplitter{
+		rules:       make(map[string][]*searchVariant),
+		collections: make(map[UniqueID]*collectionReplicas),
+	}
+}
+
+func (s *searchTrafficSplitter) enabled() bool {
+	return s != nil && Params.ProxyCfg.SearchTrafficSplitEnabled.GetAsBool()
+}
+
+// getVariants returns the variants of the collection, the rules are parsed again once the config changes.
+func (s *searchTrafficSplitter) getVariants(collectionName string) []*searchVariant {
+	value := Params.ProxyCfg.SearchTrafficSplitRules.GetValue()
+	s.mu.RLock()
+	if s.rawRules == value {
+		defer s.mu.RUnlock()
+		return s.rules[collectionName]
+	}
+	s.mu.RUnlock()
+
+	rules, err := parseSearchTrafficRules(value)
+	if err != nil {
+		log.Warn("invalid search traffic split rules, ignore them", zap.String("rules", value), zap.Error(err))
+		rules = make(map[string][]*searchVariant)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rawRules = value
+	s.rules = rules
+	return s.rules[collectionName]
+}
+
+// getNodeReplicas returns the replica of each query node serving the collection.
+func (s *searchTrafficSplitter) getNodeReplicas(ctx context.Context, qc types.QueryCoord, collectionID UniqueID) (map[UniqueID]UniqueID, error) {
+	ttl := Params.ProxyCfg.SearchTrafficSplitCacheTTL.GetAsDuration(time.Second)
+	s.mu.RLock()
+	cached, ok := s.collections[collectionID]
+	s.mu.RUnlock()
+	if ok && time.Since(cached.updatedAt) < ttl {
+		return cached.nodeReplicas, nil
+	}
+
+	resp, err := qc.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_GetReplicas),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("getReplicas failed, collectionID = %d, reason = %s", collectionID, resp.GetStatus().GetReason())
+	}
+
+	nodeReplicas := make(map[UniqueID]UniqueID)
+	for _, replica := range resp.GetReplicas() {
+		for _, nodeID := range replica.GetNodeIds() {
+			nodeReplicas[nodeID] = replica.GetReplicaID()
+		}
+	}
+	s.mu.Lock()
+	s.collections[collectionID] = &collectionReplicas{
+		nodeReplicas: nodeReplicas,
+		updatedAt:    time.Now(),
+	}
+	s.mu.Unlock()
+	return nodeReplicas, nil
+}
+
+// pickSearchVariant returns a variant by the percentages, nil means the default variant.
+func pickSearchVariant(variants []*searchVariant) *searchVariant {
+	r := rand.Float64() * 100
+	for _, variant := range variants {
+		if r < variant.Percentage {
+			return variant
+		}
+		r -= variant.Percentage
+	}
+	return nil
+}
+
+// filterShardLeaders returns the shard leaders passing the filter, ok is false if any shard has no leader left.
+func filterShardLeaders(shard2Leaders map[string][]nodeInfo, filter func(nodeInfo) bool) (map[string][]nodeInfo, bool) {
+	filtered := make(map[string][]nodeInfo, len(shard2Leaders))
+	for channel, leaders := range shard2Leaders {
+		for _, leader := range leaders {
+			if filter(leader) {
+				filtered[channel] = append(filtered[channel], leader)
+			}
+		}
+		if len(filtered[channel]) == 0 {
+			return nil, false
+		}
+	}
+	return filtered, true
+}
+
+// route picks a variant for a search on the collection and returns the name of the variant with the shard leaders
+// serving it. An experimental variant falls back to the default one if any shard of it has no leader available.
+func (s *searchTrafficSplitter) route(ctx context.Context, qc types.QueryCoord, collectionName string, collectionID UniqueID,
+	shard2Leaders map[string][]nodeInfo) (string, map[string][]nodeInfo, error) {
+	variants := s.getVariants(collectionName)
+	if len(variants) == 0 {
+		return "", shard2Leaders, nil
+	}
+	nodeReplicas, err := s.getNodeReplicas(ctx, qc, collectionID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if variant := pickSearchVariant(variants); variant != nil {
+		replicas := make(map[UniqueID]struct{}, len(variant.ReplicaIDs))
+		for _, replicaID := range variant.ReplicaIDs {
+			replicas[replicaID] = struct{}{}
+		}
+		routed, ok := filterShardLeaders(shard2Leaders, func(leader nodeInfo) bool {
+			replicaID, ok := nodeReplicas[leader.nodeID]
+			if !ok {
+				return false
+			}
+			_, ok = replicas[replicaID]
+			return ok
+		})
+		if ok {
+			return variant.Name, routed, nil
+		}
+		log.Ctx(ctx).Warn("no shard leader available for search variant, fall back to the default one",
+			zap.String("collection", collectionName), zap.String("variant", variant.Name), zap.Int64s("replicas", variant.ReplicaIDs))
+	}
+
+	experimental := make(map[UniqueID]struct{})
+	for _, variant := range variants {
+		for _, replicaID := range variant.ReplicaIDs {
+			experimental[replicaID] = struct{}{}
+		}
+	}
+	routed, ok := filterShardLeaders(shard2Leaders, func(leader nodeInfo) bool {
+		replicaID, ok := nodeReplicas[leader.nodeID]
+		if !ok {
+			// the leader shows up after the replicas are cached, it serves the default traffic until the cache expires
+			return true
+		}
+		_, ok = experimental[replicaID]
+		return !ok
+	})
+	if !ok {
+		log.Ctx(ctx).Warn("no shard leader available out of the search variants, search all the replicas",
+			zap.String("collection", collectionName))
+		return defaultSearchVariant, shard2Leaders, nil
+	}
+	return defaultSearchVariant, routed, nil
+}
+
+// Invalidate removes the cached replicas of the collection.
+func (s *searchTrafficSplitter) Invalidate(collectionID UniqueID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.collections[collectionID]; ok {
+		delete(s.collections, collectionID)
+		log.Debug("replicas cache of search traffic split invalidated", zap.Int64("collectionID", collectionID))
+	}
+}
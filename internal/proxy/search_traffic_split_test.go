@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestParseSearchTrafficRules(t *testing.T) {
+	rules, err := parseSearchTrafficRules(`{"book": [{"name": "hnsw", "replica_ids": [1], "percentage": 20}, {"name": "ivf", "replica_ids": [2], "percentage": 30}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rules["book"]))
+	assert.Equal(t, float64(20), rules["book"][0].Percentage)
+
+	rules, err = parseSearchTrafficRules("")
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+
+	invalids := []string{
+		`invalid`,
+		`{"book": [{"name": "", "replica_ids": [1], "percentage": 20}]}`,
+		`{"book": [{"name": "default", "replica_ids": [1], "percentage": 20}]}`,
+		`{"book": [{"name": "hnsw", "replica_ids": [1], "percentage": 20}, {"name": "hnsw", "replica_ids": [2], "percentage": 20}]}`,
+		`{"book": [{"name": "hnsw", "percentage": 20}]}`,
+		`{"book": [{"name": "hnsw", "replica_ids": [1], "percentage": -1}]}`,
+		`{"book": [{"name": "hnsw", "replica_ids": [1], "percentage": 60}, {"name": "ivf", "replica_ids": [2], "percentage": 60}]}`,
+	}
+	for _, value := range invalids {
+		_, err = parseSearchTrafficRules(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestSearchTrafficSplitter(t *testing.T) {
+	paramtable.Get().Save(Params.ProxyCfg.SearchTrafficSplitEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.SearchTrafficSplitEnabled.Key)
+	defer paramtable.Get().Reset(Params.ProxyCfg.SearchTrafficSplitRules.Key)
+
+	ctx := context.Background()
+	qc := NewQueryCoordMock()
+	qc.updateState(commonpb.StateCode_Healthy)
+	getReplicasCount := 0
+	qc.getReplicasFunc = func(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+		getReplicasCount++
+		return &milvuspb.GetReplicasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Replicas: []*milvuspb.ReplicaInfo{
+				{ReplicaID: 100, NodeIds: []int64{1, 2}},
+				{ReplicaID: 200, NodeIds: []int64{3, 4}},
+			},
+		}, nil
+	}
+	shard2Leaders := map[string][]nodeInfo{
+		"ch-1": {{nodeID: 1}, {nodeID: 3}},
+		"ch-2": {{nodeID: 2}, {nodeID: 4}},
+	}
+
+	splitter := newSearchTrafficSplitter()
+	assert.True(t, splitter.enabled())
+
+	// no rule of the collection
+	variant, routed, err := splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, "", variant)
+	assert.Equal(t, shard2Leaders, routed)
+	assert.Equal(t, 0, getReplicasCount)
+
+	// all the traffic goes to the experimental variant
+	paramtable.Get().Save(Params.ProxyCfg.SearchTrafficSplitRules.Key, `{"book": [{"name": "hnsw", "replica_ids": [200], "percentage": 100}]}`)
+	variant, routed, err = splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, "hnsw", variant)
+	assert.Equal(t, map[string][]nodeInfo{"ch-1": {{nodeID: 3}}, "ch-2": {{nodeID: 4}}}, routed)
+
+	// none of the traffic goes to the experimental variant
+	paramtable.Get().Save(Params.ProxyCfg.SearchTrafficSplitRules.Key, `{"book": [{"name": "hnsw", "replica_ids": [200], "percentage": 0}]}`)
+	variant, routed, err = splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSearchVariant, variant)
+	assert.Equal(t, map[string][]nodeInfo{"ch-1": {{nodeID: 1}}, "ch-2": {{nodeID: 2}}}, routed)
+	assert.Equal(t, 1, getReplicasCount)
+
+	// the variant falls back to the default one if a shard has no leader in the replicas of the variant
+	paramtable.Get().Save(Params.ProxyCfg.SearchTrafficSplitRules.Key, `{"book": [{"name": "hnsw", "replica_ids": [300], "percentage": 100}]}`)
+	variant, routed, err = splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSearchVariant, variant)
+	assert.Equal(t, shard2Leaders, routed)
+
+	// all the replicas are searched if no leader is out of the variants
+	paramtable.Get().Save(Params.ProxyCfg.SearchTrafficSplitRules.Key, `{"book": [{"name": "hnsw", "replica_ids": [100, 200], "percentage": 0}]}`)
+	variant, routed, err = splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSearchVariant, variant)
+	assert.Equal(t, shard2Leaders, routed)
+
+	// the replicas are fetched again once invalidated
+	splitter.Invalidate(1)
+	_, _, err = splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, getReplicasCount)
+
+	qc.updateState(commonpb.StateCode_Abnormal)
+	splitter.Invalidate(1)
+	_, _, err = splitter.route(ctx, qc, "book", 1, shard2Leaders)
+	assert.Error(t, err)
+}
+
+func TestSearchTask_FillInSearchVariant(t *testing.T) {
+	task := &searchTask{
+		result:         &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		routingWarning: "partitions [p1] not loaded, excluded from search",
+		searchVariant:  "hnsw",
+	}
+	task.fillInRoutingWarning()
+	task.fillInSearchVariant()
+	assert.Equal(t, "partitions [p1] not loaded, excluded from search; search variant: hnsw", task.result.GetStatus().GetReason())
+}
@@ -0,0 +1,585 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// searchTunerIndexCacheTTL is the time to cache the indexes of a collection fetched from DataCoord
+	searchTunerIndexCacheTTL = time.Minute
+	// searchTunerLatencyDecay is the weight of the latest search in the latency of a search param value
+	searchTunerLatencyDecay = 0.2
+	// searchTunerRecallPlateau is the recall loss tolerated to use a smaller value within the latency target
+	searchTunerRecallPlateau = 0.001
+	// searchTunerMaxValue is the max value of the search params of the graph indexes
+	searchTunerMaxValue = 32768
+)
+
+// searchKnob is the search param trading the latency for the recall of an index type.
+type searchKnob struct {
+	name string
+	// ladder returns the values tried from the fastest to the most accurate one
+	ladder func(indexParams map[string]string, topK int64) []int64
+}
+
+// powerOfTwoLadder returns min followed by the powers of two in (min, max].
+func powerOfTwoLadder(min, max int64) []int64 {
+	if min < 1 {
+		min = 1
+	}
+	ret := []int64{min}
+	for v := int64(1); v <= max; v <<= 1 {
+		if v > min {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+var (
+	nprobeKnob = &searchKnob{
+		name: "nprobe",
+		ladder: func(indexParams map[string]string, topK int64) []int64 {
+			nlist, err := strconv.ParseInt(indexParams[indexparamcheck.NLIST], 10, 64)
+			if err != nil || nlist <= 0 {
+				nlist = indexparamcheck.MaxNList
+			}
+			return powerOfTwoLadder(1, nlist)
+		},
+	}
+	efKnob = &searchKnob{
+		name: "ef",
+		ladder: func(indexParams map[string]string, topK int64) []int64 {
+			return powerOfTwoLadder(topK, searchTunerMaxValue)
+		},
+	}
+	searchListKnob = &searchKnob{
+		name: "search_list",
+		ladder: func(indexParams map[string]string, topK int64) []int64 {
+			return powerOfTwoLadder(topK, searchTunerMaxValue)
+		},
+	}
+
+	// searchKnobs is the knob of the index types, the index types not listed have no knob to tune
+	searchKnobs = map[string]*searchKnob{
+		string(indexparamcheck.IndexFaissIvfFlat):    nprobeKnob,
+		string(indexparamcheck.IndexFaissIvfPQ):      nprobeKnob,
+		string(indexparamcheck.IndexFaissIvfSQ8):     nprobeKnob,
+		string(indexparamcheck.IndexFaissIvfSQ8H):    nprobeKnob,
+		string(indexparamcheck.IndexFaissBinIvfFlat): nprobeKnob,
+		string(indexparamcheck.IndexHNSW):            efKnob,
+		string(indexparamcheck.IndexRHNSWFlat):       efKnob,
+		string(indexparamcheck.IndexRHNSWPQ):         efKnob,
+		string(indexparamcheck.IndexRHNSWSQ):         efKnob,
+		string(indexparamcheck.IndexDISKANN):         searchListKnob,
+	}
+)
+
+// searchCurvePoint is the calibration of a search param value, the latency is a moving average of the
+// observed searches, the recall is set by an offline calibration if known.
+type searchCurvePoint struct {
+	Value     int64    `json:"value"`
+	Samples   int64    `json:"samples"`
+	LatencyMs float64  `json:"latency_ms"`
+	Recall    *float64 `json:"recall,omitempty"`
+}
+
+// searchCurve is the calibration curve of the knob of the index on a vector field of a collection,
+// the curve is reset when the index is recreated.
+type searchCurve struct {
+	CollectionID UniqueID
+	AnnsField    string
+	IndexID      UniqueID
+	Knob         string
+	Points       map[int64]*searchCurvePoint
+}
+
+type searchCurveKey struct {
+	collectionID UniqueID
+	annsField    string
+}
+
+// searchKnobRef is the knob value a search is served with, to observe the latency of the search.
+type searchKnobRef struct {
+	key     searchCurveKey
+	indexID UniqueID
+	knob    string
+	value   int64
+}
+
+type searchTunerIndexes struct {
+	indexes   []*datapb.IndexInfo
+	updatedAt time.Time
+}
+
+// searchTuner translates the latency target of a search into the knob of the index searched, e.g. nprobe of
+// IVF indexes, ef of HNSW and search_list of DISKANN, so that users express the latency they want instead of
+// the engine-specific params. The latencies of the knob values are observed from the searches of each collection,
+// the largest calibrated value within the target is used, and the next larger value not calibrated yet is tried
+// by proxy.searchTuning.exploreRatio of the searches. The recalls of the values can be set by an offline
+// calibration, a smaller value is used if it reaches the same recall.
+type searchTuner struct {
+	dataCoord types.DataCoord
+	rand      func() float64
+
+	mu      sync.Mutex
+	indexes map[UniqueID]*searchTunerIndexes
+	curves  map[searchCurveKey]*searchCurve
+}
+
+func newSearchTuner(dataCoord types.DataCoord) *searchTuner {
+	return &searchTuner{
+		dataCoord: dataCoord,
+		rand:      rand.Float64,
+		indexes:   make(map[UniqueID]*searchTunerIndexes),
+		curves:    make(map[searchCurveKey]*searchCurve),
+	}
+}
+
+// getIndex returns the index on the field, nil if there is none. The indexes are cached for searchTunerIndexCacheTTL.
+func (st *searchTuner) getIndex(ctx context.Context, collectionID, fieldID UniqueID) (*datapb.IndexInfo, error) {
+	st.mu.Lock()
+	cached, ok := st.indexes[collectionID]
+	st.mu.Unlock()
+	if !ok || time.Since(cached.updatedAt) >= searchTunerIndexCacheTTL {
+		resp, err := st.dataCoord.DescribeIndex(ctx, &datapb.DescribeIndexRequest{CollectionID: collectionID})
+		if err != nil {
+			return nil, err
+		}
+		switch resp.GetStatus().GetErrorCode() {
+		case commonpb.ErrorCode_Success, commonpb.ErrorCode_IndexNotExist:
+		default:
+			return nil, errors.New(resp.GetStatus().GetReason())
+		}
+		cached = &searchTunerIndexes{indexes: resp.GetIndexInfos(), updatedAt: time.Now()}
+		st.mu.Lock()
+		st.indexes[collectionID] = cached
+		st.mu.Unlock()
+	}
+	for _, index := range cached.indexes {
+		if index.GetFieldID() == fieldID {
+			return index, nil
+		}
+	}
+	return nil, nil
+}
+
+// curve returns the curve of the key for the index and the knob, it must be called with the lock held.
+func (st *searchTuner) curve(key searchCurveKey, indexID UniqueID, knob string) *searchCurve {
+	curve, ok := st.curves[key]
+	if !ok || curve.Knob != knob || (curve.IndexID != 0 && curve.IndexID != indexID) {
+		curve = &searchCurve{
+			CollectionID: key.collectionID,
+			AnnsField:    key.annsField,
+			IndexID:      indexID,
+			Knob:         knob,
+			Points:       make(map[int64]*searchCurvePoint),
+		}
+		st.curves[key] = curve
+	}
+	curve.IndexID = indexID
+	return curve
+}
+
+// choose returns the value of the ladder to serve a search with the latency target.
+func (st *searchTuner) choose(curve *searchCurve, ladder []int64, targetMs float64) int64 {
+	minSamples := Params.ProxyCfg.SearchTuningMinSamples.GetAsInt64()
+	calibrated := func(value int64) *searchCurvePoint {
+		point, ok := curve.Points[value]
+		if !ok || point.Samples < minSamples {
+			return nil
+		}
+		return point
+	}
+
+	best := -1
+	for i, value := range ladder {
+		if point := calibrated(value); point != nil && point.LatencyMs <= targetMs {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ladder[0]
+	}
+	if next := best + 1; next < len(ladder) && calibrated(ladder[next]) == nil &&
+		st.rand() < Params.ProxyCfg.SearchTuningExploreRatio.GetAsFloat() {
+		return ladder[next]
+	}
+	// use the smallest value within the target reaching the recall of the best one
+	if bestRecall := curve.Points[ladder[best]].Recall; bestRecall != nil {
+		for _, value := range ladder[:best] {
+			point := calibrated(value)
+			if point != nil && point.LatencyMs <= targetMs && point.Recall != nil &&
+				*point.Recall >= *bestRecall-searchTunerRecallPlateau {
+				return value
+			}
+		}
+	}
+	return ladder[best]
+}
+
+// parseKnobValue parses the value of the knob in the search params, the knob is set if ok.
+func parseKnobValue(searchParams map[string]interface{}, knob string) (int64, bool) {
+	switch value := searchParams[knob].(type) {
+	case float64:
+		return int64(value), true
+	case string:
+		ret, err := strconv.ParseInt(value, 10, 64)
+		return ret, err == nil
+	}
+	return 0, false
+}
+
+// apply sets the knob of the index searched to the value chosen for the latency target of the search if given,
+// the search params are returned as is if the knob is set by the search already. The knob value served is returned
+// to observe the latency of the search, nil if the index has no knob. The latency target is ignored with a warning
+// if it can't be applied.
+func (st *searchTuner) apply(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema, annsField string,
+	searchParamsPair []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, *searchKnobRef, string, error) {
+	targetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LatencyTargetKey, searchParamsPair)
+	hasTarget := err == nil
+	var targetMs float64
+	if hasTarget {
+		targetMs, err = strconv.ParseFloat(targetStr, 64)
+		if err != nil || targetMs <= 0 {
+			return nil, nil, "", fmt.Errorf("%s [%s] is invalid, should be a positive number of milliseconds", LatencyTargetKey, targetStr)
+		}
+	}
+	if st == nil {
+		return searchParamsPair, nil, "", nil
+	}
+	if hasTarget && Params.AutoIndexConfig.Enable.GetAsBool() {
+		return searchParamsPair, nil, fmt.Sprintf("%s is ignored, the search params are decided by the search level with auto index", LatencyTargetKey), nil
+	}
+
+	var fieldID UniqueID = -1
+	for _, field := range schema.GetFields() {
+		if field.GetName() == annsField {
+			fieldID = field.GetFieldID()
+		}
+	}
+	index, err := st.getIndex(ctx, collectionID, fieldID)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get the index to tune search params", zap.Int64("collectionID", collectionID), zap.Error(err))
+		if hasTarget {
+			return searchParamsPair, nil, fmt.Sprintf("%s is ignored, failed to get the index of field %s", LatencyTargetKey, annsField), nil
+		}
+		return searchParamsPair, nil, "", nil
+	}
+	indexParams := funcutil.KeyValuePair2Map(index.GetIndexParams())
+	knob, ok := searchKnobs[indexParams[common.IndexTypeKey]]
+	if !ok {
+		if hasTarget {
+			return searchParamsPair, nil, fmt.Sprintf("%s is ignored, the index of field %s has no search param to tune", LatencyTargetKey, annsField), nil
+		}
+		return searchParamsPair, nil, "", nil
+	}
+
+	searchParams := make(map[string]interface{})
+	if searchParamsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, searchParamsPair); err == nil {
+		if err := json.Unmarshal([]byte(searchParamsStr), &searchParams); err != nil {
+			return nil, nil, "", fmt.Errorf("search params in wrong format:%w", err)
+		}
+		if searchParams == nil {
+			searchParams = make(map[string]interface{})
+		}
+	}
+	ref := &searchKnobRef{
+		key:     searchCurveKey{collectionID: collectionID, annsField: annsField},
+		indexID: index.GetIndexID(),
+		knob:    knob.name,
+	}
+	if value, ok := parseKnobValue(searchParams, knob.name); ok || !hasTarget {
+		if !ok {
+			return searchParamsPair, nil, "", nil
+		}
+		ref.value = value
+		return searchParamsPair, ref, "", nil
+	}
+
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, searchParamsPair)
+	if err != nil {
+		return nil, nil, "", errors.New(TopKKey + " not found in search_params")
+	}
+	topK, err := strconv.ParseInt(topKStr, 0, 64)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("%s [%s] is invalid", TopKKey, topKStr)
+	}
+	ladder := knob.ladder(indexParams, topK)
+	st.mu.Lock()
+	ref.value = st.choose(st.curve(ref.key, ref.indexID, ref.knob), ladder, targetMs)
+	st.mu.Unlock()
+
+	searchParams[knob.name] = ref.value
+	searchParamsBytes, err := json.Marshal(searchParams)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	ret := make([]*commonpb.KeyValuePair, 0, len(searchParamsPair)+1)
+	for _, pair := range searchParamsPair {
+		if pair.GetKey() != SearchParamsKey {
+			ret = append(ret, pair)
+		}
+	}
+	ret = append(ret, &commonpb.KeyValuePair{Key: SearchParamsKey, Value: string(searchParamsBytes)})
+	log.Ctx(ctx).Debug("tune search params for latency target", zap.Int64("collectionID", collectionID),
+		zap.Float64("latencyTargetMs", targetMs), zap.String("knob", knob.name), zap.Int64("value", ref.value))
+	return ret, ref, "", nil
+}
+
+// observe records the latency of a search served with the knob value.
+func (st *searchTuner) observe(ref *searchKnobRef, latency time.Duration) {
+	if st == nil || ref == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	curve := st.curve(ref.key, ref.indexID, ref.knob)
+	point, ok := curve.Points[ref.value]
+	if !ok {
+		point = &searchCurvePoint{Value: ref.value}
+		curve.Points[ref.value] = point
+	}
+	latencyMs := float64(latency) / float64(time.Millisecond)
+	if point.Samples == 0 {
+		point.LatencyMs = latencyMs
+	} else {
+		point.LatencyMs = (1-searchTunerLatencyDecay)*point.LatencyMs + searchTunerLatencyDecay*latencyMs
+	}
+	point.Samples++
+}
+
+// Invalidate removes the cached indexes and the curves of the collection.
+func (st *searchTuner) Invalidate(collectionID UniqueID) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	delete(st.indexes, collectionID)
+	for key := range st.curves {
+		if key.collectionID == collectionID {
+			delete(st.curves, key)
+		}
+	}
+}
+
+// searchCurveView is a curve with its points sorted by the value.
+type searchCurveView struct {
+	CollectionID UniqueID            `json:"collection_id"`
+	AnnsField    string              `json:"anns_field"`
+	IndexID      UniqueID            `json:"index_id"`
+	Knob         string              `json:"knob"`
+	Points       []*searchCurvePoint `json:"points"`
+}
+
+// list returns copies of the curves of the collection, all the collections if collectionID is 0.
+func (st *searchTuner) list(collectionID UniqueID) []*searchCurveView {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	ret := make([]*searchCurveView, 0, len(st.curves))
+	for key, curve := range st.curves {
+		if collectionID != 0 && key.collectionID != collectionID {
+			continue
+		}
+		view := &searchCurveView{
+			CollectionID: curve.CollectionID,
+			AnnsField:    curve.AnnsField,
+			IndexID:      curve.IndexID,
+			Knob:         curve.Knob,
+			Points:       make([]*searchCurvePoint, 0, len(curve.Points)),
+		}
+		for _, point := range curve.Points {
+			p := *point
+			view.Points = append(view.Points, &p)
+		}
+		sort.Slice(view.Points, func(i, j int) bool { return view.Points[i].Value < view.Points[j].Value })
+		ret = append(ret, view)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].CollectionID != ret[j].CollectionID {
+			return ret[i].CollectionID < ret[j].CollectionID
+		}
+		return ret[i].AnnsField < ret[j].AnnsField
+	})
+	return ret
+}
+
+// SearchRecallCalibration is the recalls of the knob values of a field measured by an offline calibration.
+type SearchRecallCalibration struct {
+	CollectionID UniqueID            `json:"collection_id"`
+	AnnsField    string              `json:"anns_field"`
+	Knob         string              `json:"knob"`
+	Recalls      []*searchCurvePoint `json:"recalls"`
+}
+
+// setRecalls sets the recalls of the knob values of the field.
+func (st *searchTuner) setRecalls(calibration *SearchRecallCalibration) error {
+	if calibration.CollectionID == 0 || calibration.AnnsField == "" || calibration.Knob == "" {
+		return errors.New("collection_id, anns_field and knob are required")
+	}
+	for _, point := range calibration.Recalls {
+		if point.Recall == nil || *point.Recall < 0 || *point.Recall > 1 {
+			return fmt.Errorf("invalid recall of value %d, should be in range [0, 1]", point.Value)
+		}
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	key := searchCurveKey{collectionID: calibration.CollectionID, annsField: calibration.AnnsField}
+	indexID := UniqueID(0)
+	if curve, ok := st.curves[key]; ok {
+		indexID = curve.IndexID
+	}
+	curve := st.curve(key, indexID, calibration.Knob)
+	for _, point := range calibration.Recalls {
+		recall := *point.Recall
+		if existing, ok := curve.Points[point.Value]; ok {
+			existing.Recall = &recall
+			continue
+		}
+		curve.Points[point.Value] = &searchCurvePoint{Value: point.Value, Recall: &recall}
+	}
+	return nil
+}
+
+// searchCurveToProto converts the curve to the proto returned by GetSearchCalibration.
+func searchCurveToProto(view *searchCurveView) *proxypb.SearchCalibrationCurve {
+	curve := &proxypb.SearchCalibrationCurve{
+		AnnsField: view.AnnsField,
+		IndexID:   view.IndexID,
+		Knob:      view.Knob,
+		Points:    make([]*proxypb.SearchCalibrationPoint, 0, len(view.Points)),
+	}
+	for _, point := range view.Points {
+		recall := float64(-1)
+		if point.Recall != nil {
+			recall = *point.Recall
+		}
+		curve.Points = append(curve.Points, &proxypb.SearchCalibrationPoint{
+			Value:     point.Value,
+			Samples:   point.Samples,
+			LatencyMs: point.LatencyMs,
+			Recall:    recall,
+		})
+	}
+	return curve
+}
+
+// GetSearchCalibration returns the latency calibration curves of the search params of the collection observed by
+// this proxy, the curves are not shared between proxies.
+func (node *Proxy) GetSearchCalibration(ctx context.Context, req *proxypb.GetSearchCalibrationRequest) (*proxypb.GetSearchCalibrationResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.GetSearchCalibrationResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetSearchCalibration"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", req.GetCollectionName()))
+	log.Debug(rpcReceived(method))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &proxypb.GetSearchCalibrationResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	views := node.searchTuner.list(collectionID)
+	curves := make([]*proxypb.SearchCalibrationCurve, 0, len(views))
+	for _, view := range views {
+		curves = append(curves, searchCurveToProto(view))
+	}
+	log.Debug(rpcDone(method), zap.Int("curves", len(curves)))
+	return &proxypb.GetSearchCalibrationResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CollectionID: collectionID,
+		Curves:       curves,
+	}, nil
+}
+
+// SetSearchRecalls sets the recalls of the search param values of the field measured by an offline calibration in
+// this proxy, the caller should set them in every proxy. The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) SetSearchRecalls(ctx context.Context, req *proxypb.SetSearchRecallsRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	method := "SetSearchRecalls"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("annsField", req.GetAnnsField()),
+		zap.String("knob", req.GetKnob()))
+	log.Info(rpcReceived(method))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	calibration := &SearchRecallCalibration{
+		CollectionID: collectionID,
+		AnnsField:    req.GetAnnsField(),
+		Knob:         req.GetKnob(),
+		Recalls:      make([]*searchCurvePoint, 0, len(req.GetRecalls())),
+	}
+	for _, recall := range req.GetRecalls() {
+		r := recall.GetRecall()
+		calibration.Recalls = append(calibration.Recalls, &searchCurvePoint{Value: recall.GetValue(), Recall: &r})
+	}
+	if err := node.searchTuner.setRecalls(calibration); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info(rpcDone(method), zap.Int("recalls", len(calibration.Recalls)))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
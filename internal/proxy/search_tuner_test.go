@@ -0,0 +1,299 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestPowerOfTwoLadder(t *testing.T) {
+	assert.Equal(t, []int64{1, 2, 4, 8}, powerOfTwoLadder(0, 8))
+	assert.Equal(t, []int64{10, 16, 32}, powerOfTwoLadder(10, 40))
+	assert.Equal(t, []int64{1, 2, 4, 8, 16}, nprobeKnob.ladder(map[string]string{"nlist": "16"}, 10))
+	assert.Equal(t, int64(65536), nprobeKnob.ladder(nil, 10)[16])
+	assert.Equal(t, int64(100), efKnob.ladder(nil, 100)[0])
+}
+
+func TestSearchTuner(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 101, Name: "vec"}, {FieldID: 102, Name: "bvec"}}}
+
+	calls := 0
+	indexType := "IVF_FLAT"
+	dc := NewDataCoordMock()
+	dc.DescribeIndexFunc = func(ctx context.Context, req *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+		calls++
+		return &datapb.DescribeIndexResponse{
+			Status: &commonpb.Status{},
+			IndexInfos: []*datapb.IndexInfo{{
+				FieldID: 101,
+				IndexID: 1000,
+				IndexParams: []*commonpb.KeyValuePair{
+					{Key: common.IndexTypeKey, Value: indexType},
+					{Key: "nlist", Value: "16"},
+				},
+			}},
+		}, nil
+	}
+	st := newSearchTuner(dc)
+	explore := false
+	st.rand = func() float64 {
+		if explore {
+			return 0
+		}
+		return 1
+	}
+
+	searchParams := func(target string, params string) []*commonpb.KeyValuePair {
+		ret := []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}
+		if target != "" {
+			ret = append(ret, &commonpb.KeyValuePair{Key: LatencyTargetKey, Value: target})
+		}
+		if params != "" {
+			ret = append(ret, &commonpb.KeyValuePair{Key: SearchParamsKey, Value: params})
+		}
+		return ret
+	}
+	tunedValue := func(pairs []*commonpb.KeyValuePair) interface{} {
+		paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, pairs)
+		require.NoError(t, err)
+		params := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(paramsStr), &params))
+		return params["nprobe"]
+	}
+	calibrate := func(value int64, latency time.Duration) {
+		ref := &searchKnobRef{key: searchCurveKey{collectionID: 1, annsField: "vec"}, indexID: 1000, knob: "nprobe", value: value}
+		for i := int64(0); i < Params.ProxyCfg.SearchTuningMinSamples.GetAsInt64(); i++ {
+			st.observe(ref, latency)
+		}
+	}
+
+	t.Run("invalid target", func(t *testing.T) {
+		for _, target := range []string{"abc", "0", "-1"} {
+			_, _, _, err := st.apply(ctx, 1, schema, "vec", searchParams(target, ""))
+			assert.Error(t, err)
+		}
+		var nilTuner *searchTuner
+		_, _, _, err := nilTuner.apply(ctx, 1, schema, "vec", searchParams("abc", ""))
+		assert.Error(t, err)
+		pairs, ref, _, err := nilTuner.apply(ctx, 1, schema, "vec", searchParams("10", ""))
+		assert.NoError(t, err)
+		assert.Nil(t, ref)
+		assert.Equal(t, 2, len(pairs))
+	})
+
+	t.Run("uncalibrated", func(t *testing.T) {
+		pairs, ref, warning, err := st.apply(ctx, 1, schema, "vec", searchParams("50", `{"foo": 1}`))
+		assert.NoError(t, err)
+		assert.Empty(t, warning)
+		assert.Equal(t, int64(1), ref.value)
+		assert.Equal(t, float64(1), tunedValue(pairs))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("calibrated", func(t *testing.T) {
+		calibrate(1, 5*time.Millisecond)
+		calibrate(2, 10*time.Millisecond)
+		calibrate(4, 30*time.Millisecond)
+		calibrate(8, 80*time.Millisecond)
+
+		pairs, ref, _, err := st.apply(ctx, 1, schema, "vec", searchParams("50", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), ref.value)
+		assert.Equal(t, float64(4), tunedValue(pairs))
+
+		pairs, _, _, err = st.apply(ctx, 1, schema, "vec", searchParams("1", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1), tunedValue(pairs))
+
+		// the next larger value not calibrated yet is explored
+		explore = true
+		pairs, _, _, err = st.apply(ctx, 1, schema, "vec", searchParams("100", ""))
+		explore = false
+		assert.NoError(t, err)
+		assert.Equal(t, float64(16), tunedValue(pairs))
+		// the indexes are cached
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("recall plateau", func(t *testing.T) {
+		recall := func(v float64) *float64 { return &v }
+		assert.Error(t, st.setRecalls(&SearchRecallCalibration{CollectionID: 1, AnnsField: "vec"}))
+		assert.Error(t, st.setRecalls(&SearchRecallCalibration{CollectionID: 1, AnnsField: "vec", Knob: "nprobe",
+			Recalls: []*searchCurvePoint{{Value: 1, Recall: recall(1.5)}}}))
+		require.NoError(t, st.setRecalls(&SearchRecallCalibration{CollectionID: 1, AnnsField: "vec", Knob: "nprobe",
+			Recalls: []*searchCurvePoint{{Value: 1, Recall: recall(0.8)}, {Value: 2, Recall: recall(0.99)}, {Value: 4, Recall: recall(0.99)}}}))
+		_, ref, _, err := st.apply(ctx, 1, schema, "vec", searchParams("50", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), ref.value)
+	})
+
+	t.Run("explicit knob", func(t *testing.T) {
+		pairs, ref, _, err := st.apply(ctx, 1, schema, "vec", searchParams("50", `{"nprobe": 7}`))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), ref.value)
+		assert.Equal(t, float64(7), tunedValue(pairs))
+
+		pairs, ref, _, err = st.apply(ctx, 1, schema, "vec", searchParams("", `{"nprobe": "3"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), ref.value)
+
+		_, ref, _, err = st.apply(ctx, 1, schema, "vec", searchParams("", `{"foo": 1}`))
+		assert.NoError(t, err)
+		assert.Nil(t, ref)
+
+		_, _, _, err = st.apply(ctx, 1, schema, "vec", searchParams("50", `{`))
+		assert.Error(t, err)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		curves := st.list(1)
+		require.Equal(t, 1, len(curves))
+		assert.Equal(t, "nprobe", curves[0].Knob)
+		assert.Equal(t, int64(1000), curves[0].IndexID)
+		assert.Equal(t, int64(1), curves[0].Points[0].Value)
+		assert.Equal(t, 5.0, curves[0].Points[0].LatencyMs)
+		assert.Empty(t, st.list(2))
+	})
+
+	t.Run("ignored", func(t *testing.T) {
+		// no index on the field
+		_, ref, warning, err := st.apply(ctx, 1, schema, "bvec", searchParams("50", ""))
+		assert.NoError(t, err)
+		assert.Nil(t, ref)
+		assert.NotEmpty(t, warning)
+
+		st.Invalidate(1)
+		assert.Empty(t, st.list(1))
+		indexType = "FLAT"
+		_, ref, warning, err = st.apply(ctx, 1, schema, "vec", searchParams("50", ""))
+		assert.NoError(t, err)
+		assert.Nil(t, ref)
+		assert.True(t, strings.Contains(warning, "no search param"))
+
+		st.Invalidate(1)
+		dc.DescribeIndexFunc = func(ctx context.Context, req *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+			return nil, errors.New("mock")
+		}
+		_, ref, warning, err = st.apply(ctx, 1, schema, "vec", searchParams("50", ""))
+		assert.NoError(t, err)
+		assert.Nil(t, ref)
+		assert.NotEmpty(t, warning)
+		_, _, warning, err = st.apply(ctx, 1, schema, "vec", searchParams("", ""))
+		assert.NoError(t, err)
+		assert.Empty(t, warning)
+
+		paramtable.Get().Save(Params.AutoIndexConfig.Enable.Key, "true")
+		defer paramtable.Get().Reset(Params.AutoIndexConfig.Enable.Key)
+		_, _, warning, err = st.apply(ctx, 1, schema, "vec", searchParams("50", ""))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, warning)
+	})
+}
+
+func TestProxy_SearchCalibration(t *testing.T) {
+	paramtable.Init()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getIDFunc = func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	}
+	globalMetaCache = mockCache
+
+	ctx := context.Background()
+	node := &Proxy{searchTuner: newSearchTuner(NewDataCoordMock())}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	node.searchTuner.observe(&searchKnobRef{key: searchCurveKey{collectionID: 1, annsField: "vec"}, indexID: 1000, knob: "ef", value: 16}, time.Millisecond)
+
+	resp, err := node.GetSearchCalibration(ctx, &proxypb.GetSearchCalibrationRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(resp.GetCurves()))
+	require.Equal(t, 1, len(resp.GetCurves()[0].GetPoints()))
+	assert.Equal(t, float64(-1), resp.GetCurves()[0].GetPoints()[0].GetRecall())
+
+	status, err := node.SetSearchRecalls(ctx, &proxypb.SetSearchRecallsRequest{
+		CollectionName: "coll",
+		AnnsField:      "vec",
+		Knob:           "ef",
+		Recalls:        []*proxypb.SearchRecall{{Value: 16, Recall: 0.9}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	resp, err = node.GetSearchCalibration(ctx, &proxypb.GetSearchCalibrationRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.GetCollectionID())
+	require.Equal(t, 1, len(resp.GetCurves()))
+	assert.Equal(t, int64(1000), resp.GetCurves()[0].GetIndexID())
+	require.Equal(t, 1, len(resp.GetCurves()[0].GetPoints()))
+	assert.Equal(t, int64(1), resp.GetCurves()[0].GetPoints()[0].GetSamples())
+	assert.Equal(t, 0.9, resp.GetCurves()[0].GetPoints()[0].GetRecall())
+
+	for _, req := range []*proxypb.SetSearchRecallsRequest{
+		{CollectionName: "other", AnnsField: "vec", Knob: "ef"},
+		{CollectionName: "coll"},
+		{CollectionName: "coll", AnnsField: "vec", Knob: "ef", Recalls: []*proxypb.SearchRecall{{Value: 16, Recall: 1.5}}},
+	} {
+		status, err = node.SetSearchRecalls(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	}
+	resp, err = node.GetSearchCalibration(ctx, &proxypb.GetSearchCalibrationRequest{CollectionName: "other"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&proxypb.GetSearchCalibrationRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Collection, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeGetStatistics, privilegeExt.ObjectPrivilege)
+	assert.Equal(t, "coll", funcutil.GetObjectName(&proxypb.GetSearchCalibrationRequest{CollectionName: "coll"}, privilegeExt.ObjectNameIndex))
+	privilegeExt, err = funcutil.GetPrivilegeExtObj(&proxypb.SetSearchRecallsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.GetSearchCalibration(ctx, &proxypb.GetSearchCalibrationRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	status, err = node.SetSearchRecalls(ctx, &proxypb.SetSearchRecallsRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// GetSegmentAllocHints resolves the collection and the partition, and forwards the request to DataCoord, which returns
+// the row distribution of the partitions over the vchannels and the segment allocation hints. All the partitions of
+// the collection are returned if the partition name is empty. The privilege interceptor requires the
+// PrivilegeGetStatistics of the collection.
+func (node *Proxy) GetSegmentAllocHints(ctx context.Context, req *proxypb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error) {
+	if !node.checkHealthy() {
+		return &datapb.GetSegmentAllocHintsResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetSegmentAllocHints"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("partition", req.GetPartitionName()))
+	log.Debug(rpcReceived(method))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.GetSegmentAllocHintsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	var partitionID UniqueID
+	if req.GetPartitionName() != "" {
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, req.GetCollectionName(), req.GetPartitionName())
+		if err != nil {
+			log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+			return &datapb.GetSegmentAllocHintsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_IllegalArgument,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
+	resp, err := node.dataCoord.GetSegmentAllocHints(ctx, &datapb.GetSegmentAllocHintsRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+	})
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		return &datapb.GetSegmentAllocHintsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("partitions", len(resp.GetPartitions())))
+	return resp, nil
+}
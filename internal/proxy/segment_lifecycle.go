@@ -0,0 +1,253 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// errInvalidSegmentLifecycleRequest is returned when the collection or partitions in the request are invalid.
+var errInvalidSegmentLifecycleRequest = errors.New("invalid segment lifecycle request")
+
+// defaultLifecycleStates are the persistent states listed if no state filter is given.
+var defaultLifecycleStates = []commonpb.SegmentState{
+	commonpb.SegmentState_Growing,
+	commonpb.SegmentState_Sealed,
+	commonpb.SegmentState_Flushing,
+	commonpb.SegmentState_Flushed,
+}
+
+// GetSegmentLifecycle returns the merged view of GetPersistentSegmentInfo, GetQuerySegmentInfo and the index states
+// of the segments, so that the lifecycle of a segment can be understood at a glance.
+func (node *Proxy) GetSegmentLifecycle(ctx context.Context, req *proxypb.GetSegmentLifecycleRequest) (*proxypb.GetSegmentLifecycleResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.GetSegmentLifecycleResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetSegmentLifecycle"
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collection", req.GetCollectionName()))
+	log.Debug(rpcReceived(method))
+
+	segments, err := node.getSegmentLifecycle(ctx, req)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		errorCode := commonpb.ErrorCode_UnexpectedError
+		if errors.Is(err, errInvalidSegmentLifecycleRequest) {
+			errorCode = commonpb.ErrorCode_IllegalArgument
+		}
+		return &proxypb.GetSegmentLifecycleResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errorCode,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug(rpcDone(method), zap.Int("segments", len(segments)))
+	return &proxypb.GetSegmentLifecycleResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Segments: segments,
+	}, nil
+}
+
+func (node *Proxy) getSegmentLifecycle(ctx context.Context, req *proxypb.GetSegmentLifecycleRequest) ([]*proxypb.SegmentLifecycle, error) {
+	if req.GetCollectionName() == "" {
+		return nil, fmt.Errorf("%w: collection_name is required", errInvalidSegmentLifecycleRequest)
+	}
+	states := make(map[commonpb.SegmentState]struct{})
+	stateList := req.GetStates()
+	if len(stateList) == 0 {
+		stateList = defaultLifecycleStates
+	}
+	for _, state := range stateList {
+		states[state] = struct{}{}
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSegmentLifecycleRequest, err)
+	}
+	var partitionIDs typeutil.UniqueSet
+	if len(req.GetPartitionNames()) > 0 {
+		partitionIDs = typeutil.NewUniqueSet()
+		for _, name := range req.GetPartitionNames() {
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetCollectionName(), name)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", errInvalidSegmentLifecycleRequest, err)
+			}
+			partitionIDs.Insert(partitionID)
+		}
+	}
+
+	// the loaded segments
+	queryResp, err := node.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err = checkLifecycleStatus("queryCoord:GetSegmentInfo", queryResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	loaded := make(map[int64]*querypb.SegmentInfo, len(queryResp.GetInfos()))
+	for _, info := range queryResp.GetInfos() {
+		loaded[info.GetSegmentID()] = info
+	}
+
+	// the segments in the persistent states, and the loaded ones which may be compacted or dropped already
+	statesResp, err := node.dataCoord.GetSegmentsByStates(ctx, &datapb.GetSegmentsByStatesRequest{
+		CollectionID: collectionID,
+		// -1 means list all partition segments
+		PartitionID: -1,
+		States:      stateList,
+	})
+	if err = checkLifecycleStatus("dataCoord:GetSegmentsByStates", statesResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	segmentIDs := typeutil.NewUniqueSet(statesResp.GetSegments()...)
+	for segmentID := range loaded {
+		segmentIDs.Insert(segmentID)
+	}
+	if segmentIDs.Len() == 0 {
+		return []*proxypb.SegmentLifecycle{}, nil
+	}
+
+	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		SegmentIDs:       segmentIDs.Collect(),
+		IncludeUnHealthy: true,
+	})
+	if err = checkLifecycleStatus("dataCoord:GetSegmentInfo", infoResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+
+	segments := make(map[int64]*proxypb.SegmentLifecycle, segmentIDs.Len())
+	for _, info := range infoResp.GetInfos() {
+		segments[info.GetID()] = &proxypb.SegmentLifecycle{
+			SegmentID:      info.GetID(),
+			CollectionID:   info.GetCollectionID(),
+			PartitionID:    info.GetPartitionID(),
+			InsertChannel:  info.GetInsertChannel(),
+			NumRows:        info.GetNumOfRows(),
+			State:          info.GetState(),
+			CompactionFrom: info.GetCompactionFrom(),
+		}
+	}
+	for segmentID, info := range loaded {
+		segment, ok := segments[segmentID]
+		if !ok {
+			segment = &proxypb.SegmentLifecycle{
+				SegmentID:    segmentID,
+				CollectionID: info.GetCollectionID(),
+				PartitionID:  info.GetPartitionID(),
+				NumRows:      info.GetNumRows(),
+				State:        commonpb.SegmentState_NotExist,
+			}
+			segments[segmentID] = segment
+		}
+		segment.Loaded = true
+		segment.LoadedState = info.GetSegmentState()
+		segment.LoadedNodeIDs = info.GetNodeIds()
+		segment.MemSize = info.GetMemSize()
+	}
+
+	ret := make([]*proxypb.SegmentLifecycle, 0, len(segments))
+	for _, segment := range segments {
+		if partitionIDs != nil && !partitionIDs.Contain(segment.GetPartitionID()) {
+			continue
+		}
+		// the loaded segments recycled by DataCoord are always listed
+		if _, ok := states[segment.GetState()]; !ok && segment.GetState() != commonpb.SegmentState_NotExist {
+			continue
+		}
+		ret = append(ret, segment)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].GetSegmentID() < ret[j].GetSegmentID() })
+
+	if err := node.fillSegmentIndexLifecycle(ctx, collectionID, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// fillSegmentIndexLifecycle fills the states of all the indexes of the collection into the segments.
+func (node *Proxy) fillSegmentIndexLifecycle(ctx context.Context, collectionID int64, segments []*proxypb.SegmentLifecycle) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	describeResp, err := node.dataCoord.DescribeIndex(ctx, &datapb.DescribeIndexRequest{CollectionID: collectionID})
+	if err == nil && describeResp.GetStatus().GetErrorCode() == commonpb.ErrorCode_IndexNotExist {
+		return nil
+	}
+	if err = checkLifecycleStatus("dataCoord:DescribeIndex", describeResp.GetStatus(), err); err != nil {
+		return err
+	}
+
+	segmentIDs := make([]int64, 0, len(segments))
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetSegmentID())
+	}
+	indexes := make(map[int64][]*proxypb.SegmentIndexLifecycle, len(segments))
+	for _, index := range describeResp.GetIndexInfos() {
+		stateResp, err := node.dataCoord.GetSegmentIndexState(ctx, &datapb.GetSegmentIndexStateRequest{
+			CollectionID: collectionID,
+			IndexName:    index.GetIndexName(),
+			SegmentIDs:   segmentIDs,
+		})
+		if err = checkLifecycleStatus("dataCoord:GetSegmentIndexState", stateResp.GetStatus(), err); err != nil {
+			return err
+		}
+		for _, state := range stateResp.GetStates() {
+			indexes[state.GetSegmentID()] = append(indexes[state.GetSegmentID()], &proxypb.SegmentIndexLifecycle{
+				IndexName:  index.GetIndexName(),
+				State:      state.GetState(),
+				FailReason: state.GetFailReason(),
+			})
+		}
+	}
+	for _, segment := range segments {
+		segment.Indexes = indexes[segment.GetSegmentID()]
+	}
+	return nil
+}
+
+func checkLifecycleStatus(method string, status *commonpb.Status, err error) error {
+	if err != nil {
+		return fmt.Errorf("%s failed, err: %w", method, err)
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("%s failed, reason: %s", method, status.GetReason())
+	}
+	return nil
+}
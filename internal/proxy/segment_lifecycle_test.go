@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestProxy_GetSegmentLifecycle(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.getIDFunc = func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	}
+	mockCache.getPartitionIDFunc = func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		if partitionName != "p2" {
+			return 0, errors.New("partition not found")
+		}
+		return 2, nil
+	}
+	globalMetaCache = mockCache
+
+	dc := NewDataCoordMock()
+	dc.GetSegmentsByStatesFunc = func(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+		return &datapb.GetSegmentsByStatesResponse{Status: &commonpb.Status{}, Segments: []int64{10, 11, 12}}, nil
+	}
+	dc.GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+		assert.True(t, req.GetIncludeUnHealthy())
+		assert.ElementsMatch(t, []int64{10, 11, 12, 13, 14}, req.GetSegmentIDs())
+		return &datapb.GetSegmentInfoResponse{Status: &commonpb.Status{}, Infos: []*datapb.SegmentInfo{
+			{ID: 10, CollectionID: 1, PartitionID: 2, NumOfRows: 100, State: commonpb.SegmentState_Flushed},
+			{ID: 11, CollectionID: 1, PartitionID: 3, NumOfRows: 100, State: commonpb.SegmentState_Flushed},
+			{ID: 12, CollectionID: 1, PartitionID: 2, NumOfRows: 10, State: commonpb.SegmentState_Growing},
+			{ID: 13, CollectionID: 1, PartitionID: 2, NumOfRows: 100, State: commonpb.SegmentState_Dropped},
+		}}, nil
+	}
+	dc.DescribeIndexFunc = func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+		return &datapb.DescribeIndexResponse{Status: &commonpb.Status{}, IndexInfos: []*datapb.IndexInfo{{IndexName: "idx"}}}, nil
+	}
+	dc.GetSegmentIndexStateFunc = func(ctx context.Context, req *datapb.GetSegmentIndexStateRequest) (*datapb.GetSegmentIndexStateResponse, error) {
+		assert.Equal(t, "idx", req.GetIndexName())
+		return &datapb.GetSegmentIndexStateResponse{Status: &commonpb.Status{}, States: []*datapb.SegmentIndexState{
+			{SegmentID: 10, State: commonpb.IndexState_Finished},
+			{SegmentID: 12, State: commonpb.IndexState_Unissued},
+		}}, nil
+	}
+	qc := NewQueryCoordMock()
+	qc.updateState(commonpb.StateCode_Healthy)
+	qc.getSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+		return &querypb.GetSegmentInfoResponse{Status: &commonpb.Status{}, Infos: []*querypb.SegmentInfo{
+			{SegmentID: 10, CollectionID: 1, PartitionID: 2, NumRows: 100, NodeIds: []int64{1, 2}, SegmentState: commonpb.SegmentState_Sealed},
+			// compacted and dropped, but still loaded
+			{SegmentID: 13, CollectionID: 1, PartitionID: 2, NumRows: 100, NodeIds: []int64{1}, SegmentState: commonpb.SegmentState_Sealed},
+			// recycled by DataCoord
+			{SegmentID: 14, CollectionID: 1, PartitionID: 2, NumRows: 100, NodeIds: []int64{2}, SegmentState: commonpb.SegmentState_Sealed},
+		}}, nil
+	}
+
+	node := &Proxy{dataCoord: dc, queryCoord: qc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	get := func(req *proxypb.GetSegmentLifecycleRequest) *proxypb.GetSegmentLifecycleResponse {
+		resp, err := node.GetSegmentLifecycle(ctx, req)
+		assert.NoError(t, err)
+		return resp
+	}
+	segmentIDs := func(resp *proxypb.GetSegmentLifecycleResponse) []int64 {
+		ids := make([]int64, 0)
+		for _, segment := range resp.GetSegments() {
+			ids = append(ids, segment.GetSegmentID())
+		}
+		return ids
+	}
+
+	t.Run("all", func(t *testing.T) {
+		resp := get(&proxypb.GetSegmentLifecycleRequest{CollectionName: "coll"})
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{10, 11, 12, 14}, segmentIDs(resp))
+
+		segment := resp.GetSegments()[0]
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.True(t, segment.GetLoaded())
+		assert.Equal(t, []int64{1, 2}, segment.GetLoadedNodeIDs())
+		assert.Equal(t, []*proxypb.SegmentIndexLifecycle{{IndexName: "idx", State: commonpb.IndexState_Finished}}, segment.GetIndexes())
+		assert.False(t, resp.GetSegments()[1].GetLoaded())
+		assert.Empty(t, resp.GetSegments()[1].GetIndexes())
+		assert.Equal(t, commonpb.SegmentState_NotExist, resp.GetSegments()[3].GetState())
+		assert.True(t, resp.GetSegments()[3].GetLoaded())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		resp := get(&proxypb.GetSegmentLifecycleRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"p2"},
+			States:         []commonpb.SegmentState{commonpb.SegmentState_Flushed, commonpb.SegmentState_Dropped},
+		})
+		assert.Equal(t, []int64{10, 13, 14}, segmentIDs(resp))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, req := range []*proxypb.GetSegmentLifecycleRequest{
+			{},
+			{CollectionName: "unknown"},
+			{CollectionName: "coll", PartitionNames: []string{"unknown"}},
+		} {
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, get(req).GetStatus().GetErrorCode())
+		}
+	})
+
+	t.Run("no index", func(t *testing.T) {
+		describeIndexFunc := dc.DescribeIndexFunc
+		defer func() { dc.DescribeIndexFunc = describeIndexFunc }()
+		dc.DescribeIndexFunc = func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+			return &datapb.DescribeIndexResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist}}, nil
+		}
+		resp := get(&proxypb.GetSegmentLifecycleRequest{CollectionName: "coll"})
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetSegments()[0].GetIndexes())
+	})
+
+	t.Run("coord fail", func(t *testing.T) {
+		getSegmentsByStatesFunc := dc.GetSegmentsByStatesFunc
+		defer func() { dc.GetSegmentsByStatesFunc = getSegmentsByStatesFunc }()
+		dc.GetSegmentsByStatesFunc = func(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+			return &datapb.GetSegmentsByStatesResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}, nil
+		}
+		resp := get(&proxypb.GetSegmentLifecycleRequest{CollectionName: "coll"})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("privilege", func(t *testing.T) {
+		privilegeExt, err := funcutil.GetPrivilegeExtObj(&proxypb.GetSegmentLifecycleRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, "coll", funcutil.GetObjectName(&proxypb.GetSegmentLifecycleRequest{CollectionName: "coll"}, privilegeExt.ObjectNameIndex))
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer node.stateCode.Store(commonpb.StateCode_Healthy)
+		resp := get(&proxypb.GetSegmentLifecycleRequest{CollectionName: "coll"})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
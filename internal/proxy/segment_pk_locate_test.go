@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestProxy_LocatePrimaryKeys(t *testing.T) {
+	ctx := context.Background()
+	dataCoord := NewDataCoordMock()
+	node := &Proxy{dataCoord: dataCoord}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	dataCoord.locatePrimaryKeysFunc = func(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+		assert.NotNil(t, req.GetBase())
+		return &datapb.LocatePrimaryKeysResponse{
+			Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollectionID: req.GetCollectionID(),
+			Locations:    []*datapb.PrimaryKeyLocation{{Pk: req.GetPks()[0], SegmentIDs: []int64{1}}},
+		}, nil
+	}
+	resp, err := node.LocatePrimaryKeys(ctx, &datapb.LocatePrimaryKeysRequest{CollectionID: 10, Pks: []string{"1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, int64(10), resp.GetCollectionID())
+	assert.Equal(t, 1, len(resp.GetLocations()))
+
+	dataCoord.locatePrimaryKeysFunc = func(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error) {
+		return nil, errors.New("mock")
+	}
+	resp, err = node.LocatePrimaryKeys(ctx, &datapb.LocatePrimaryKeysRequest{CollectionID: 10, Pks: []string{"1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+	privilegeExt, err := funcutil.GetPrivilegeExtObj(&datapb.LocatePrimaryKeysRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeDescribeCollection, privilegeExt.ObjectPrivilege)
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.LocatePrimaryKeys(ctx, &datapb.LocatePrimaryKeysRequest{CollectionID: 10, Pks: []string{"1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -97,6 +97,7 @@ type shardClientMgr struct {
 		data map[UniqueID]*shardClient
 	}
 	clientCreator queryNodeCreatorFunc
+	zones         *queryNodeZones
 }
 
 // SessionOpt provides a way to set params in SessionManager
@@ -118,6 +119,7 @@ func newShardClientMgr(options ...shardClientMgrOpt) *shardClientMgr {
 			data map[UniqueID]*shardClient
 		}{data: make(map[UniqueID]*shardClient)},
 		clientCreator: defaultShardClientCreator,
+		zones:         newQueryNodeZones(),
 	}
 	for _, opt := range options {
 		opt(s)
@@ -0,0 +1,341 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// sloRequestTypes are the request types with latency objectives, only their requests are shed.
+var sloRequestTypes = map[internalpb.RateType]string{
+	internalpb.RateType_DQLSearch: "search",
+	internalpb.RateType_DQLQuery:  "query",
+	internalpb.RateType_DMLInsert: "insert",
+	internalpb.RateType_DMLDelete: "delete",
+}
+
+// sloObjective means Percentile percent of the requests take less than LatencyMs milliseconds,
+// the slower requests consume the error budget.
+type sloObjective struct {
+	LatencyMs  int64   `json:"latency_ms"`
+	Percentile float64 `json:"percentile"`
+}
+
+func (o *sloObjective) budget() float64 {
+	return 1 - o.Percentile/100
+}
+
+// parseSLOObjectives parses the json map of request type to its objective.
+func parseSLOObjectives(value string) (map[string]*sloObjective, error) {
+	objectives := make(map[string]*sloObjective)
+	if value == "" {
+		return objectives, nil
+	}
+	if err := json.Unmarshal([]byte(value), &objectives); err != nil {
+		return nil, err
+	}
+	known := make(map[string]struct{}, len(sloRequestTypes))
+	for _, name := range sloRequestTypes {
+		known[name] = struct{}{}
+	}
+	for name, objective := range objectives {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unknown request type %s of slo objective", name)
+		}
+		if objective == nil || objective.LatencyMs <= 0 {
+			return nil, fmt.Errorf("invalid latency of slo objective of %s", name)
+		}
+		if objective.Percentile <= 0 || objective.Percentile >= 100 {
+			return nil, fmt.Errorf("invalid percentile of slo objective of %s, expect in (0, 100)", name)
+		}
+	}
+	return objectives, nil
+}
+
+// sloTiers maps the users and the collections to their tiers, the larger tier is shed first.
+type sloTiers struct {
+	Users       map[string]int `json:"users"`
+	Collections map[string]int `json:"collections"`
+}
+
+// parseSLOTiers parses the json object of the tiers of the users and the collections.
+func parseSLOTiers(value string) (*sloTiers, error) {
+	tiers := &sloTiers{}
+	if value != "" {
+		if err := json.Unmarshal([]byte(value), tiers); err != nil {
+			return nil, err
+		}
+	}
+	for user, tier := range tiers.Users {
+		if tier < 0 {
+			return nil, fmt.Errorf("negative slo tier of user %s", user)
+		}
+	}
+	for collection, tier := range tiers.Collections {
+		if tier < 0 {
+			return nil, fmt.Errorf("negative slo tier of collection %s", collection)
+		}
+	}
+	return tiers, nil
+}
+
+// tier returns the tier of the request of the user to the collection, which is the larger one of the tiers
+// of the user and the collection, the default tier is used if neither of them has a tier.
+func (t *sloTiers) tier(username, collectionName string, defaultTier int) int {
+	userTier, userOk := t.Users[username]
+	collectionTier, collectionOk := t.Collections[collectionName]
+	switch {
+	case userOk && collectionOk:
+		if userTier > collectionTier {
+			return userTier
+		}
+		return collectionTier
+	case userOk:
+		return userTier
+	case collectionOk:
+		return collectionTier
+	}
+	return defaultTier
+}
+
+// maxTier returns the largest tier of the users, the collections and the default tier.
+func (t *sloTiers) maxTier(defaultTier int) int {
+	ret := defaultTier
+	for _, tier := range t.Users {
+		if tier > ret {
+			ret = tier
+		}
+	}
+	for _, tier := range t.Collections {
+		if tier > ret {
+			ret = tier
+		}
+	}
+	return ret
+}
+
+type sloBucket struct {
+	second int64
+	total  int64
+	slow   int64
+}
+
+// sloCounter counts the requests and the slow ones in per-second buckets of the long window.
+type sloCounter struct {
+	buckets []sloBucket
+}
+
+func newSLOCounter(seconds int) *sloCounter {
+	return &sloCounter{buckets: make([]sloBucket, seconds)}
+}
+
+func (c *sloCounter) add(now time.Time, slow bool) {
+	second := now.Unix()
+	bucket := &c.buckets[second%int64(len(c.buckets))]
+	if bucket.second != second {
+		*bucket = sloBucket{second: second}
+	}
+	bucket.total++
+	if slow {
+		bucket.slow++
+	}
+}
+
+// sum returns the requests and the slow ones in the last seconds.
+func (c *sloCounter) sum(now time.Time, seconds int64) (int64, int64) {
+	var total, slow int64
+	second := now.Unix()
+	for _, bucket := range c.buckets {
+		if bucket.second > second-seconds && bucket.second <= second {
+			total += bucket.total
+			slow += bucket.slow
+		}
+	}
+	return total, slow
+}
+
+func burnRate(total, slow int64, objective *sloObjective) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(slow) / float64(total) / objective.budget()
+}
+
+// sloShedder tracks the latencies of the request types against the objectives of proxy.slo.objectives, and
+// sheds the requests of the largest tier while the error budget of any objective burns faster than
+// proxy.slo.burnRateThreshold times the sustainable rate in both the long window and the last evaluation
+// interval. One more tier is shed every interval while the budget keeps burning, down to tier 1, and a tier is
+// restored every interval once no budget burns faster than the sustainable rate in the last interval.
+type sloShedder struct {
+	mu            sync.Mutex
+	now           func() time.Time
+	rawObjectives string
+	objectives    map[string]*sloObjective
+	rawTiers      string
+	tiers         *sloTiers
+	counters      map[string]*sloCounter // request type -> counter
+	// shedTier is the lowest tier shed, 0 means nothing is shed
+	shedTier      int
+	lastEvaluated time.Time
+}
+
+func newSLOShedder() *sloShedder {
+	return &sloShedder{
+		now:        time.Now,
+		objectives: make(map[string]*sloObjective),
+		tiers:      &sloTiers{},
+		counters:   make(map[string]*sloCounter),
+	}
+}
+
+func (s *sloShedder) enabled() bool {
+	return s != nil && paramtable.Get().ProxyCfg.SLOEnabled.GetAsBool()
+}
+
+// refreshLocked parses the objectives and the tiers again once the config changes.
+func (s *sloShedder) refreshLocked() {
+	if value := paramtable.Get().ProxyCfg.SLOObjectives.GetValue(); value != s.rawObjectives {
+		objectives, err := parseSLOObjectives(value)
+		if err != nil {
+			log.Warn("invalid slo objectives, ignore them", zap.String("objectives", value), zap.Error(err))
+			objectives = make(map[string]*sloObjective)
+		}
+		s.rawObjectives = value
+		s.objectives = objectives
+	}
+	if value := paramtable.Get().ProxyCfg.SLOTiers.GetValue(); value != s.rawTiers {
+		tiers, err := parseSLOTiers(value)
+		if err != nil {
+			log.Warn("invalid slo tiers, ignore them", zap.String("tiers", value), zap.Error(err))
+			tiers = &sloTiers{}
+		}
+		s.rawTiers = value
+		s.tiers = tiers
+	}
+	window := paramtable.Get().ProxyCfg.SLOWindow.GetAsInt()
+	if window < 1 {
+		window = 1
+	}
+	for name, counter := range s.counters {
+		if _, ok := s.objectives[name]; !ok || len(counter.buckets) != window {
+			delete(s.counters, name)
+		}
+	}
+	for name := range s.objectives {
+		if _, ok := s.counters[name]; !ok {
+			s.counters[name] = newSLOCounter(window)
+		}
+	}
+}
+
+// evaluateLocked sheds one more tier or restores one by the burn rates.
+func (s *sloShedder) evaluateLocked(now time.Time) {
+	params := &paramtable.Get().ProxyCfg
+	interval := params.SLOEvaluateInterval.GetAsDuration(time.Second)
+	if now.Sub(s.lastEvaluated) < interval {
+		return
+	}
+	s.lastEvaluated = now
+	s.refreshLocked()
+
+	threshold := params.SLOBurnRateThreshold.GetAsFloat()
+	minRequests := params.SLOMinRequests.GetAsInt64()
+	window := int64(params.SLOWindow.GetAsInt())
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	burning, healthy := false, true
+	for name, objective := range s.objectives {
+		counter := s.counters[name]
+		longTotal, longSlow := counter.sum(now, window)
+		shortTotal, shortSlow := counter.sum(now, int64(interval.Seconds()))
+		longBurn := burnRate(longTotal, longSlow, objective)
+		shortBurn := burnRate(shortTotal, shortSlow, objective)
+		metrics.ProxySLOBurnRate.WithLabelValues(nodeID, name).Set(longBurn)
+		if longTotal >= minRequests && longBurn > threshold && shortBurn > threshold {
+			burning = true
+		}
+		if shortBurn > 1 {
+			healthy = false
+		}
+	}
+
+	defaultTier := params.SLODefaultTier.GetAsInt()
+	maxTier := s.tiers.maxTier(defaultTier)
+	shedTier := s.shedTier
+	switch {
+	case burning && shedTier == 0 && maxTier > 0:
+		shedTier = maxTier
+	case burning && shedTier > 1:
+		shedTier--
+	case healthy && shedTier > 0 && shedTier >= maxTier:
+		shedTier = 0
+	case healthy && shedTier > 0:
+		shedTier++
+	}
+	if shedTier != s.shedTier {
+		log.Info("Proxy change the slo shed tier", zap.Int("from", s.shedTier), zap.Int("to", shedTier),
+			zap.Bool("burning", burning))
+		s.shedTier = shedTier
+	}
+	metrics.ProxySLOShedTier.WithLabelValues(nodeID).Set(float64(s.shedTier))
+}
+
+// admit returns an error if the request is shed to protect the latency slo.
+func (s *sloShedder) admit(username, collectionName string, rt internalpb.RateType) error {
+	name, ok := sloRequestTypes[rt]
+	if !ok || !s.enabled() {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evaluateLocked(s.now())
+	if s.shedTier == 0 {
+		return nil
+	}
+	tier := s.tiers.tier(username, collectionName, paramtable.Get().ProxyCfg.SLODefaultTier.GetAsInt())
+	if tier < s.shedTier {
+		return nil
+	}
+	metrics.ProxySLOShedCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), name).Inc()
+	return wrapSLOShedError(rt, tier)
+}
+
+// observe records the latency of a request admitted.
+func (s *sloShedder) observe(rt internalpb.RateType, latency time.Duration) {
+	name, ok := sloRequestTypes[rt]
+	if !ok || !s.enabled() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshLocked()
+	objective, ok := s.objectives[name]
+	if !ok {
+		return
+	}
+	s.counters[name].add(s.now(), latency.Milliseconds() >= objective.LatencyMs)
+}
@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestParseSLOConfigs(t *testing.T) {
+	objectives, err := parseSLOObjectives(`{"search": {"latency_ms": 100, "percentile": 99}}`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(objectives))
+	assert.InDelta(t, 0.01, objectives["search"].budget(), 1e-9)
+
+	for _, value := range []string{
+		`invalid`,
+		`{"upsert": {"latency_ms": 100, "percentile": 99}}`,
+		`{"search": {"latency_ms": 0, "percentile": 99}}`,
+		`{"search": {"latency_ms": 100, "percentile": 100}}`,
+		`{"search": null}`,
+	} {
+		_, err = parseSLOObjectives(value)
+		assert.Error(t, err, value)
+	}
+
+	tiers, err := parseSLOTiers(`{"users": {"etl": 2, "app": 0}, "collections": {"realtime": 0, "archive": 3}}`)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tiers.tier("etl", "book", 1))
+	assert.Equal(t, 0, tiers.tier("", "realtime", 1))
+	assert.Equal(t, 2, tiers.tier("etl", "realtime", 1))
+	assert.Equal(t, 3, tiers.tier("app", "archive", 1))
+	assert.Equal(t, 1, tiers.tier("", "book", 1))
+	assert.Equal(t, 3, tiers.maxTier(1))
+
+	_, err = parseSLOTiers(`{"users": {"etl": -1}}`)
+	assert.Error(t, err)
+	_, err = parseSLOTiers(`{"collections": {"book": -1}}`)
+	assert.Error(t, err)
+	_, err = parseSLOTiers(`invalid`)
+	assert.Error(t, err)
+}
+
+func TestSLOCounter(t *testing.T) {
+	c := newSLOCounter(10)
+	now := time.Unix(1000, 0)
+	c.add(now, true)
+	c.add(now.Add(time.Second), false)
+	c.add(now.Add(5*time.Second), false)
+
+	total, slow := c.sum(now.Add(5*time.Second), 10)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, int64(1), slow)
+	total, _ = c.sum(now.Add(5*time.Second), 2)
+	assert.Equal(t, int64(1), total)
+
+	// the buckets out of the window are reused
+	c.add(now.Add(10*time.Second), false)
+	total, slow = c.sum(now.Add(10*time.Second), 10)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, int64(0), slow)
+}
+
+func TestSLOShedder(t *testing.T) {
+	params := paramtable.Get()
+	keys := map[string]string{
+		params.ProxyCfg.SLOEnabled.Key:    "true",
+		params.ProxyCfg.SLOObjectives.Key: `{"search": {"latency_ms": 100, "percentile": 99}}`,
+		params.ProxyCfg.SLOTiers.Key:      `{"users": {"etl": 2}, "collections": {"realtime": 0}}`,
+	}
+	for key, value := range keys {
+		params.Save(key, value)
+		defer params.Reset(key)
+	}
+
+	s := newSLOShedder()
+	start := time.Unix(10000, 0)
+	now := start
+	s.now = func() time.Time { return now }
+	observe := func(n int, latency time.Duration) {
+		for i := 0; i < n; i++ {
+			s.observe(internalpb.RateType_DQLSearch, latency)
+		}
+	}
+	admitted := func(username, collectionName string) bool {
+		return s.admit(username, collectionName, internalpb.RateType_DQLSearch) == nil
+	}
+
+	// too few requests to trust the burn rate
+	now = start.Add(5 * time.Second)
+	observe(10, time.Second)
+	now = start.Add(10 * time.Second)
+	assert.True(t, admitted("etl", "book"))
+	assert.Equal(t, 0, s.shedTier)
+
+	// the largest tier is shed first
+	now = start.Add(15 * time.Second)
+	observe(200, time.Second)
+	now = start.Add(20 * time.Second)
+	assert.False(t, admitted("etl", "book"))
+	assert.Equal(t, 2, s.shedTier)
+	err := s.admit("etl", "book", internalpb.RateType_DMLInsert)
+	assert.True(t, errors.Is(err, ErrRateLimit))
+	assert.True(t, admitted("", "book"))
+	assert.Nil(t, s.admit("etl", "book", internalpb.RateType_DDLCollection))
+
+	// one more tier every interval, tier 0 is never shed
+	now = start.Add(25 * time.Second)
+	observe(200, time.Second)
+	now = start.Add(30 * time.Second)
+	assert.False(t, admitted("", "book"))
+	now = start.Add(40 * time.Second)
+	observe(200, time.Second)
+	now = start.Add(45 * time.Second)
+	assert.Equal(t, 1, s.shedTier)
+	assert.True(t, admitted("", "realtime"))
+
+	// a tier is restored every interval once the budget burns no faster than the sustainable rate
+	now = start.Add(55 * time.Second)
+	observe(200, time.Millisecond)
+	now = start.Add(60 * time.Second)
+	assert.True(t, admitted("", "book"))
+	assert.Equal(t, 2, s.shedTier)
+	now = start.Add(70 * time.Second)
+	assert.True(t, admitted("etl", "book"))
+	assert.Equal(t, 0, s.shedTier)
+
+	params.Save(params.ProxyCfg.SLOEnabled.Key, "false")
+	s.shedTier = 2
+	assert.True(t, admitted("etl", "book"))
+}
+
+func TestRateLimitInterceptor_SLO(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SLOEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.SLOEnabled.Key)
+	params.Save(params.ProxyCfg.SLOObjectives.Key, `{"search": {"latency_ms": 100, "percentile": 99}}`)
+	defer params.Reset(params.ProxyCfg.SLOObjectives.Key)
+
+	limiter := NewMultiRateLimiter()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+	}
+	interceptor := RateLimitInterceptor(limiter)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "MockFullMethod"}
+	req := &milvuspb.SearchRequest{CollectionName: "book", Nq: 1}
+
+	rsp, err := interceptor(context.Background(), req, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, rsp.(*milvuspb.SearchResults).GetStatus().GetErrorCode())
+	total, _ := limiter.sloShedder.counters["search"].sum(time.Now(), 60)
+	assert.Equal(t, int64(1), total)
+
+	limiter.sloShedder.mu.Lock()
+	limiter.sloShedder.shedTier = 1
+	limiter.sloShedder.lastEvaluated = time.Now()
+	limiter.sloShedder.mu.Unlock()
+	rsp, err = interceptor(context.Background(), req, serverInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, rsp.(*milvuspb.SearchResults).GetStatus().GetErrorCode())
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
@@ -48,6 +49,15 @@ const (
 	RoundDecimalKey = "round_decimal"
 	OffsetKey       = "offset"
 	LimitKey        = "limit"
+	ExprParamsKey   = "expr_params"
+	OutputAliasKey  = "output_aliases"
+	FilterStatsKey  = "filter_stats"
+	// LatencyTargetKey is the latency target in milliseconds of a search, translated into the search params of the index.
+	LatencyTargetKey = "latency_target_ms"
+	// RerankKey is the re-ranking strategy of a search, a strategy name or {"strategy": name, "params": {...}}.
+	RerankKey = "rerank"
+	// OutputPrecisionKey is the precision of the scores and the float vectors sent from QueryNode, fp32, fp16 or bf16.
+	OutputPrecisionKey = "output_precision"
 
 	InsertTaskName             = "InsertTask"
 	CreateCollectionTaskName   = "CreateCollectionTask"
@@ -107,6 +117,9 @@ type createCollectionTask struct {
 	rootCoord types.RootCoord
 	result    *commonpb.Status
 	schema    *schemapb.CollectionSchema
+	// dryRun validates the request only, the collection that would be created is returned by dryRunPlan
+	dryRun     bool
+	dryRunPlan *proxypb.DryRunCreateCollectionResponse
 }
 
 func (cct *createCollectionTask) TraceCtx() context.Context {
@@ -152,8 +165,16 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 	cct.Base.MsgType = commonpb.MsgType_CreateCollection
 	cct.Base.SourceID = paramtable.GetNodeID()
 
+	var err error
+	var dryRun bool
+	cct.Properties, dryRun, err = popDryRunFlag(cct.Properties)
+	if err != nil {
+		return err
+	}
+	cct.dryRun = cct.dryRun || dryRun
+
 	cct.schema = &schemapb.CollectionSchema{}
-	err := proto.Unmarshal(cct.Schema, cct.schema)
+	err = proto.Unmarshal(cct.Schema, cct.schema)
 	if err != nil {
 		return err
 	}
@@ -212,6 +233,13 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		// validate the nullable and default value of the fields which may be omitted by the inserted rows
+		if err := validateFieldDefaultValue(field); err != nil {
+			return err
+		}
+		if err := validateFieldEmbedding(cct.schema, field); err != nil {
+			return err
+		}
 	}
 
 	if err := validateMultipleVectorFields(cct.schema); err != nil {
@@ -227,11 +255,59 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (cct *createCollectionTask) Execute(ctx context.Context) error {
+	if cct.dryRun {
+		return cct.dryRunExecute(ctx)
+	}
 	var err error
 	cct.result, err = cct.rootCoord.CreateCollection(ctx, cct.CreateCollectionRequest)
 	return err
 }
 
+// dryRunExecute runs the checks which depend on cluster state and returns the collection that would be created.
+func (cct *createCollectionTask) dryRunExecute(ctx context.Context) error {
+	hasResp, err := cct.rootCoord.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_HasCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         cct.GetDbName(),
+		CollectionName: cct.GetCollectionName(),
+	})
+	if err != nil {
+		return err
+	}
+	if hasResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(hasResp.GetStatus().GetReason())
+	}
+	if hasResp.GetValue() {
+		return fmt.Errorf("collection %s already exists", cct.GetCollectionName())
+	}
+
+	if Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
+		showResp, err := cct.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			DbName: cct.GetDbName(),
+		})
+		if err != nil {
+			return err
+		}
+		if showResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(showResp.GetStatus().GetReason())
+		}
+		maxNum := Params.QuotaConfig.MaxCollectionNum.GetAsInt()
+		if len(showResp.GetCollectionNames()) >= maxNum {
+			return fmt.Errorf("failed to create collection, limit={%d}, exceeded the limit number of collections", maxNum)
+		}
+	}
+
+	cct.dryRunPlan = newDryRunCollectionPlan(cct.schema, cct.GetShardsNum(), cct.GetConsistencyLevel(), cct.GetProperties())
+	cct.result = cct.dryRunPlan.GetStatus()
+	return nil
+}
+
 func (cct *createCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -208,6 +208,12 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 	}
 	dt.schema = schema
 
+	dt.deleteExpr, err = bindExprParams(dt.deleteExpr, getExprParams(ctx, nil))
+	if err != nil {
+		log.Info("Failed to bind expr params", zap.Error(err))
+		return err
+	}
+
 	// get delete.primaryKeys from delete expr
 	primaryKeys, numRow, err := getPrimaryKeysFromExpr(schema, dt.deleteExpr)
 	if err != nil {
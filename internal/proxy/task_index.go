@@ -21,8 +21,10 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 
 	"go.uber.org/zap"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -65,6 +68,9 @@ type createIndexTask struct {
 
 	collectionID UniqueID
 	fieldSchema  *schemapb.FieldSchema
+	// dryRun validates the request only, the index that would be created is returned by dryRunPlan
+	dryRun     bool
+	dryRunPlan *proxypb.DryRunCreateIndexResponse
 }
 
 func (cit *createIndexTask) TraceCtx() context.Context {
@@ -129,6 +135,10 @@ func (cit *createIndexTask) parseIndexParams() error {
 		}
 	}
 
+	if err := cit.parseCompositeFields(indexParamsMap); err != nil {
+		return err
+	}
+
 	if isVecIndex {
 		specifyIndexType, exist := indexParamsMap[common.IndexTypeKey]
 		if Params.AutoIndexConfig.Enable.GetAsBool() {
@@ -183,6 +193,46 @@ func (cit *createIndexTask) parseIndexParams() error {
 	return nil
 }
 
+// parseCompositeFields resolves the composite field names into field ids, the indexed field is the leading one.
+func (cit *createIndexTask) parseCompositeFields(indexParamsMap map[string]string) error {
+	names, ok := indexParamsMap[common.CompositeFieldsKey]
+	if !ok {
+		return nil
+	}
+	delete(indexParamsMap, common.CompositeFieldsKey)
+	if typeutil.IsVectorType(cit.fieldSchema.GetDataType()) {
+		return fmt.Errorf("composite index is not supported on vector field: %s", cit.fieldSchema.GetName())
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(cit.ctx, cit.req.GetCollectionName())
+	if err != nil {
+		return fmt.Errorf("failed to get collection schema: %s", err)
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return fmt.Errorf("failed to parse collection schema: %s", err)
+	}
+	fields := []*schemapb.FieldSchema{cit.fieldSchema}
+	for _, name := range strings.Split(names, ",") {
+		field, err := schemaHelper.GetFieldFromName(strings.TrimSpace(name))
+		if err != nil {
+			return fmt.Errorf("cannot create composite index on non-exist field: %s", name)
+		}
+		fields = append(fields, field)
+	}
+	if err := indexparamcheck.CheckCompositeIndexFields(fields); err != nil {
+		return err
+	}
+
+	fieldIDs := make([]int64, 0, len(fields))
+	for _, field := range fields {
+		fieldIDs = append(fieldIDs, field.GetFieldID())
+	}
+	indexParamsMap[common.IndexTypeKey] = indexparamcheck.IndexComposite
+	indexParamsMap[common.CompositeFieldIDsKey] = common.FormatCompositeFieldIDs(fieldIDs)
+	return nil
+}
+
 func (cit *createIndexTask) getIndexedField(ctx context.Context) (*schemapb.FieldSchema, error) {
 	schema, err := globalMetaCache.GetCollectionSchema(ctx, cit.req.GetCollectionName())
 	if err != nil {
@@ -268,6 +318,14 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 	cit.req.Base.MsgType = commonpb.MsgType_CreateIndex
 	cit.req.Base.SourceID = paramtable.GetNodeID()
 
+	var err error
+	var dryRun bool
+	cit.req.ExtraParams, dryRun, err = popDryRunFlag(cit.req.GetExtraParams())
+	if err != nil {
+		return err
+	}
+	cit.dryRun = cit.dryRun || dryRun
+
 	collName := cit.req.GetCollectionName()
 
 	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
@@ -302,6 +360,9 @@ func (cit *createIndexTask) Execute(ctx context.Context) error {
 	if cit.req.GetIndexName() == "" {
 		cit.req.IndexName = Params.CommonCfg.DefaultIndexName.GetValue() + "_" + strconv.FormatInt(cit.fieldSchema.GetFieldID(), 10)
 	}
+	if cit.dryRun {
+		return cit.dryRunExecute(ctx)
+	}
 	var err error
 	req := &datapb.CreateIndexRequest{
 		CollectionID:    cit.collectionID,
@@ -323,6 +384,44 @@ func (cit *createIndexTask) Execute(ctx context.Context) error {
 	return err
 }
 
+// dryRunExecute checks the index against the existing ones and returns the index that would be created.
+func (cit *createIndexTask) dryRunExecute(ctx context.Context) error {
+	resp, err := cit.datacoord.DescribeIndex(ctx, &datapb.DescribeIndexRequest{
+		CollectionID: cit.collectionID,
+	})
+	if err != nil {
+		return err
+	}
+	switch resp.GetStatus().GetErrorCode() {
+	case commonpb.ErrorCode_Success:
+	case commonpb.ErrorCode_IndexNotExist:
+	default:
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	// run the same conflict check as DataCoord does on CreateIndex, so a dry run never
+	// succeeds where the real request fails
+	indexes := make([]*model.Index, 0, len(resp.GetIndexInfos()))
+	for _, info := range resp.GetIndexInfos() {
+		indexes = append(indexes, model.UnmarshalIndexModel(&datapb.FieldIndex{IndexInfo: info}))
+	}
+	if _, err := model.CheckCreateIndex(indexes, cit.fieldSchema.GetFieldID(), cit.req.GetIndexName(),
+		cit.fieldSchema.GetTypeParams(), cit.newIndexParams); err != nil {
+		return err
+	}
+
+	cit.dryRunPlan = &proxypb.DryRunCreateIndexResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: cit.collectionID,
+		FieldID:      cit.fieldSchema.GetFieldID(),
+		FieldName:    cit.fieldSchema.GetName(),
+		IndexName:    cit.req.GetIndexName(),
+		IsAutoIndex:  cit.isAutoIndex,
+		IndexParams:  cit.newIndexParams,
+	}
+	cit.result = cit.dryRunPlan.GetStatus()
+	return nil
+}
+
 func (cit *createIndexTask) PostExecute(ctx context.Context) error {
 	return nil
 }
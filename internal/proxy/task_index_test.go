@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,9 +28,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -262,3 +265,159 @@ func TestCreateIndexTask_PreExecute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestCreateIndexTask_DryRun(t *testing.T) {
+	collectionName := "collection1"
+	collectionID := UniqueID(1)
+	field := newTestSchema().Fields[0]
+
+	dc := NewDataCoordMock()
+	ctx := context.Background()
+
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return collectionID, nil
+	})
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return newTestSchema(), nil
+	})
+	globalMetaCache = mockCache
+
+	newTask := func() *createIndexTask {
+		return &createIndexTask{
+			ctx: ctx,
+			req: &milvuspb.CreateIndexRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_CreateIndex,
+				},
+				CollectionName: collectionName,
+				FieldName:      field.GetName(),
+				ExtraParams: []*commonpb.KeyValuePair{
+					{Key: common.DryRunKey, Value: "true"},
+				},
+			},
+			datacoord: dc,
+		}
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		dc.DescribeIndexFunc = func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+			return &datapb.DescribeIndexResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist},
+			}, nil
+		}
+		cit := newTask()
+		assert.NoError(t, cit.PreExecute(ctx))
+		assert.True(t, cit.dryRun)
+		assert.Empty(t, cit.req.GetExtraParams())
+
+		assert.NoError(t, cit.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, cit.result.GetErrorCode())
+		plan := cit.dryRunPlan
+		assert.Equal(t, field.GetFieldID(), plan.GetFieldID())
+		assert.NotEmpty(t, plan.GetIndexName())
+		assert.Equal(t, DefaultIndexType, funcutil.KeyValuePair2Map(plan.GetIndexParams())[common.IndexTypeKey])
+	})
+
+	t.Run("conflict with existing index", func(t *testing.T) {
+		dc.DescribeIndexFunc = func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+			return &datapb.DescribeIndexResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				IndexInfos: []*datapb.IndexInfo{
+					{FieldID: field.GetFieldID(), IndexName: "other"},
+				},
+			}, nil
+		}
+		cit := newTask()
+		assert.NoError(t, cit.PreExecute(ctx))
+		assert.Error(t, cit.Execute(ctx))
+	})
+
+	t.Run("same name with different params", func(t *testing.T) {
+		dc.DescribeIndexFunc = func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+			return &datapb.DescribeIndexResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				IndexInfos: []*datapb.IndexInfo{
+					{
+						FieldID:     field.GetFieldID(),
+						IndexName:   Params.CommonCfg.DefaultIndexName.GetValue() + "_" + strconv.FormatInt(field.GetFieldID(), 10),
+						TypeParams:  field.GetTypeParams(),
+						IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "IVF_FLAT"}},
+					},
+				},
+			}, nil
+		}
+		cit := newTask()
+		assert.NoError(t, cit.PreExecute(ctx))
+		assert.Error(t, cit.Execute(ctx))
+	})
+
+	t.Run("describe index failed", func(t *testing.T) {
+		dc.DescribeIndexFunc = func(ctx context.Context, request *datapb.DescribeIndexRequest) (*datapb.DescribeIndexResponse, error) {
+			return nil, errors.New("mock")
+		}
+		cit := newTask()
+		assert.NoError(t, cit.PreExecute(ctx))
+		assert.Error(t, cit.Execute(ctx))
+	})
+}
+
+func TestCreateIndexTask_CompositeFields(t *testing.T) {
+	ctx := context.Background()
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+	leading, err := helper.GetFieldFromName("Int8Field")
+	assert.NoError(t, err)
+	second, err := helper.GetFieldFromName("Int64Field")
+	assert.NoError(t, err)
+
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 1, nil
+	})
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return schema, nil
+	})
+	globalMetaCache = mockCache
+
+	newTask := func(fieldName string, compositeFields string) *createIndexTask {
+		return &createIndexTask{
+			ctx: ctx,
+			req: &milvuspb.CreateIndexRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateIndex},
+				CollectionName: "collection1",
+				FieldName:      fieldName,
+				ExtraParams: []*commonpb.KeyValuePair{
+					{Key: common.CompositeFieldsKey, Value: compositeFields},
+				},
+			},
+		}
+	}
+
+	t.Run("normal", func(t *testing.T) {
+		cit := newTask(leading.GetName(), " Int64Field")
+		assert.NoError(t, cit.PreExecute(ctx))
+		params := funcutil.KeyValuePair2Map(cit.newIndexParams)
+		assert.Equal(t, indexparamcheck.IndexComposite, params[common.IndexTypeKey])
+		assert.Equal(t, common.FormatCompositeFieldIDs([]int64{leading.GetFieldID(), second.GetFieldID()}),
+			params[common.CompositeFieldIDsKey])
+		_, ok := params[common.CompositeFieldsKey]
+		assert.False(t, ok)
+	})
+
+	t.Run("field not exist", func(t *testing.T) {
+		cit := newTask(leading.GetName(), "not_exist")
+		assert.Error(t, cit.PreExecute(ctx))
+	})
+
+	t.Run("duplicated field", func(t *testing.T) {
+		cit := newTask(leading.GetName(), leading.GetName())
+		assert.Error(t, cit.PreExecute(ctx))
+	})
+
+	t.Run("vector field", func(t *testing.T) {
+		cit := newTask(leading.GetName(), "FloatVectorField")
+		assert.Error(t, cit.PreExecute(ctx))
+	})
+}
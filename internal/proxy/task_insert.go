@@ -160,11 +160,26 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.result.SuccIndex = sliceIndex
 
+	log := log.Ctx(ctx).With(zap.String("collectionName", collectionName))
+	// generate the vector fields omitted by the rows from their embedding source fields
+	if hook := newEmbeddingHook(); hook != nil {
+		if err := hook.fill(ctx, it.schema, it.insertMsg); err != nil {
+			log.Error("generate the omitted vector fields failed",
+				zap.Error(err))
+			return err
+		}
+	}
+	// fill the nullable fields and the fields with default values omitted by the rows
+	if err := fillOptionalFieldsData(it.schema, it.insertMsg); err != nil {
+		log.Error("fill the omitted optional fields failed",
+			zap.Error(err))
+		return err
+	}
+
 	// check primaryFieldData whether autoID is true or not
 	// set rowIDs as primary data if autoID == true
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
 	it.result.IDs, err = checkPrimaryFieldData(it.schema, it.insertMsg)
-	log := log.Ctx(ctx).With(zap.String("collectionName", collectionName))
 	if err != nil {
 		log.Error("check primary field data and hash primary key failed",
 			zap.Error(err))
@@ -186,6 +201,13 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// keep the rows with a wrong vector dim or NaN/Inf vector values out of the index builds
+	if err = validateInsertVectors(it.schema, it.insertMsg, it.result.IDs); err != nil {
+		log.Warn("invalid vector field data",
+			zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done")
 
 	return nil
@@ -105,6 +105,20 @@ func mergeRoundRobinPolicy(
 	mgr *shardClientMgr,
 	query func(context.Context, UniqueID, types.QueryNode, []string) error,
 	dml2leaders map[string][]nodeInfo) error {
+	return roundRobinWithRetry(ctx, mgr, query, dml2leaders, nil)
+}
+
+// roundRobinWithRetry is mergeRoundRobinPolicy with the retries of the failed dml channels decided by the retrier.
+func roundRobinWithRetry(
+	ctx context.Context,
+	mgr *shardClientMgr,
+	query func(context.Context, UniqueID, types.QueryNode, []string) error,
+	dml2leaders map[string][]nodeInfo,
+	retrier *readRetrier) error {
+	retrier.begin()
+	// the shard leaders in the zone of the Proxy are tried first, the other zones on failure
+	dml2leaders = mgr.preferLocalZone(dml2leaders)
+	query = mgr.observeZoneLatency(query)
 	nexts := make(map[string]int)
 	errSet := make(map[string]error) // record err for dml channels
 	for dml := range dml2leaders {
@@ -144,6 +158,11 @@ func mergeRoundRobinPolicy(
 			}()
 		}
 		wg.Wait()
+		if len(nexts) > 0 && !retrier.allow(ctx) {
+			err := mergeErrSet(errSet)
+			log.Ctx(ctx).Warn("failed to search/query, no retry on the other replicas", zap.Error(err))
+			return err
+		}
 		if len(nexts) > 0 {
 			nextSet := make(map[string]int64)
 			for dml, idx := range nexts {
@@ -156,5 +175,6 @@ func mergeRoundRobinPolicy(
 			log.Ctx(ctx).Warn("retry another query node with round robin", zap.Any("Nexts", nextSet))
 		}
 	}
+	retrier.done()
 	return nil
 }
@@ -10,11 +10,13 @@ import (
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
@@ -56,6 +58,18 @@ type queryTask struct {
 
 	queryShardPolicy pickShardPolicy
 	shardMgr         *shardClientMgr
+
+	// the response names of the aliased output fields
+	outputAliases map[string]string
+	// asks the QueryNodes for the filter execution statistics
+	filterStats bool
+
+	travelGuard *timeTravelGuard
+	// warning about the travel timestamp clamped to the time travel watermark
+	travelWarning string
+
+	// the result size limit exceeded on a QueryNode, the query is not retried once it is set
+	sizeExceeded atomic.Error
 }
 
 type queryParams struct {
@@ -170,7 +184,7 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 
 func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.queryShardPolicy == nil {
-		t.queryShardPolicy = mergeRoundRobinPolicy
+		t.queryShardPolicy = newReplicaFailoverPolicy(metrics.QueryLabel)
 	}
 
 	t.Base.MsgType = commonpb.MsgType_Retrieve
@@ -251,6 +265,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			}
 		}
 		t.request.Expr = IDs2Expr(pkField, t.ids)
+	} else {
+		t.request.Expr, err = bindExprParams(t.request.Expr, getExprParams(ctx, t.request.GetQueryParams()))
+		if err != nil {
+			return err
+		}
 	}
 
 	if t.request.Expr == "" {
@@ -261,10 +280,24 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.request.OutputFields, t.outputAliases, err = parseOutputAliases(t.request.OutputFields, t.request.GetQueryParams())
+	if err != nil {
+		return err
+	}
 	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
 	if err != nil {
 		return err
 	}
+	if err := checkOutputAliases(t.outputAliases, t.request.OutputFields); err != nil {
+		return err
+	}
+	if t.filterStats, err = parseFilterStats(t.request.GetQueryParams()); err != nil {
+		return err
+	}
+	t.RetrieveRequest.FilterStats = t.filterStats
+	if t.RetrieveRequest.ResultPrecision, err = parseResultPrecision(t.request.GetQueryParams()); err != nil {
+		return err
+	}
 	log.Ctx(ctx).Debug("translate output fields",
 		zap.Any("OutputFields", t.request.OutputFields),
 		zap.Any("requestType", "query"))
@@ -288,12 +321,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.request.TravelTimestamp == 0 {
 		t.TravelTimestamp = t.BeginTs()
 	} else {
-		t.TravelTimestamp = t.request.TravelTimestamp
-	}
-
-	err = validateTravelTimestamp(t.TravelTimestamp, t.BeginTs())
-	if err != nil {
-		return err
+		t.TravelTimestamp, t.travelWarning, err = t.travelGuard.check(ctx, t.CollectionID, t.request.TravelTimestamp, t.BeginTs())
+		if err != nil {
+			return err
+		}
 	}
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
@@ -333,6 +364,9 @@ func (t *queryTask) Execute(ctx context.Context) error {
 	}
 
 	err := executeQuery(WithCache)
+	if sizeErr := t.sizeExceeded.Load(); sizeErr != nil {
+		return sizeErr
+	}
 	if err != nil {
 		log.Warn("invalid shard leaders cache, updating shardleader caches and retry query",
 			zap.Error(err))
@@ -340,6 +374,9 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		globalMetaCache.ClearShards(t.collectionName)
 		err = executeQuery(WithoutCache)
 	}
+	if sizeErr := t.sizeExceeded.Load(); sizeErr != nil {
+		return sizeErr
+	}
 	if err != nil {
 		return fmt.Errorf("fail to query on all shard leaders, err=%s", err.Error())
 	}
@@ -390,6 +427,8 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			ErrorCode: commonpb.ErrorCode_EmptyCollection,
 			Reason:    "empty collection", // TODO
 		}
+		t.result.Status = appendStatusWarning(t.result.Status, t.travelWarning)
+		t.fillInFilterStats()
 		return nil
 	}
 
@@ -418,6 +457,9 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			}
 		}
 	}
+	applyOutputAliases(t.result.FieldsData, t.outputAliases)
+	t.result.Status = appendStatusWarning(t.result.Status, t.travelWarning)
+	t.fillInFilterStats()
 	log.Ctx(ctx).Debug("Query PostExecute done",
 		zap.String("requestType", "query"))
 	return nil
@@ -443,6 +485,14 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 		log.Ctx(ctx).Warn("QueryNode is not shardLeader", zap.Int64("nodeID", nodeID), zap.Strings("channels", channelIDs))
 		return errInvalidShardLeaders
 	}
+	if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_OutOfMemory {
+		log.Ctx(ctx).Warn("QueryNode query result size exceeded",
+			zap.Int64("nodeID", nodeID),
+			zap.String("reason", result.GetStatus().GetReason()))
+		err := fmt.Errorf("%w on QueryNode %d, reason=%s", errorutil.ErrResultSizeExceeded, nodeID, result.GetStatus().GetReason())
+		t.sizeExceeded.Store(err)
+		return err
+	}
 	if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 		log.Ctx(ctx).Warn("QueryNode query result error",
 			zap.Int64("nodeID", nodeID),
@@ -457,6 +507,18 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	return nil
 }
 
+// fillInFilterStats appends the filter statistics summed over the shards to the result.
+func (t *queryTask) fillInFilterStats() {
+	if !t.filterStats || t.result == nil {
+		return
+	}
+	shardStats := make([]*internalpb.FilterStats, 0, len(t.toReduceResults))
+	for _, result := range t.toReduceResults {
+		shardStats = append(shardStats, result.GetFilterStats())
+	}
+	t.result.Status = fillInFilterStats(t.result.Status, shardStats)
+}
+
 // IDs2Expr converts ids slices to bool expresion with specified field name
 func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	var idsStr string
@@ -485,6 +547,9 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		if r == nil || len(r.GetFieldsData()) == 0 || size == 0 {
 			continue
 		}
+		if err := typeutil.FillInReducedVectors(r.GetFieldsData(), r.GetResultPrecision(), r.GetReducedVectors()); err != nil {
+			return nil, err
+		}
 		validRetrieveResults = append(validRetrieveResults, r)
 		loopEnd += size
 	}
@@ -497,6 +562,9 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 
+	maxResultSize := Params.ProxyCfg.MaxResultSize.GetAsInt64()
+	var retSize int64
+
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		loopEnd = int(queryParams.limit)
 
@@ -519,6 +587,16 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 
 		pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
 		if _, ok := idSet[pk]; !ok {
+			if maxResultSize > 0 {
+				size, err := typeutil.EstimateEntitySize(validRetrieveResults[sel].GetFieldsData(), int(cursors[sel]))
+				if err != nil {
+					return nil, err
+				}
+				if retSize+int64(size) > maxResultSize {
+					return nil, errorutil.WrapErrResultSizeExceeded(maxResultSize, int64(len(idSet)))
+				}
+				retSize += int64(size)
+			}
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
 		} else {
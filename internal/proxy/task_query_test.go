@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 
+	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -209,6 +210,14 @@ func TestQueryTask_all(t *testing.T) {
 	for i := 0; i < len(task.result.FieldsData); i++ {
 		assert.NotEqual(t, task.result.FieldsData[i].FieldId, common.TimeStampField)
 	}
+
+	qn.withQueryResult = &internalpb.RetrieveResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_OutOfMemory,
+			Reason:    "query result size exceeded",
+		},
+	}
+	assert.ErrorIs(t, task.Execute(ctx), errorutil.ErrResultSizeExceeded)
 }
 
 func Test_translateToOutputFieldIDs(t *testing.T) {
@@ -449,6 +458,28 @@ func TestTaskQuery_functions(t *testing.T) {
 			assert.InDeltaSlice(t, FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 		})
 
+		t.Run("test reduced precision vectors", func(t *testing.T) {
+			fieldsData := []*schemapb.FieldData{
+				getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, Int64Array[0:2], 1),
+				getFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, append([]float32{}, FloatVector[0:16]...), Dim),
+			}
+			result := &internalpb.RetrieveResults{
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{0, 1}}}},
+				FieldsData: fieldsData,
+			}
+			result.ResultPrecision = internalpb.ResultPrecision_Float16
+			result.ReducedVectors = typeutil.ReduceVectorPrecision(fieldsData, internalpb.ResultPrecision_Float16)
+			require.Len(t, result.ReducedVectors, 1)
+
+			ret, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, FloatVector, ret.GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+
+			result.ReducedVectors = map[int64][]byte{FloatVectorFieldID: {0}}
+			_, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result}, nil)
+			assert.Error(t, err)
+		})
+
 		t.Run("test nil results", func(t *testing.T) {
 			ret, err := reduceRetrieveResults(context.Background(), nil, nil)
 			assert.NoError(t, err)
@@ -527,6 +558,25 @@ func TestTaskQuery_functions(t *testing.T) {
 					})
 				}
 			})
+
+			t.Run("test max result size", func(t *testing.T) {
+				// each row takes 8 bytes of int64 and 32 bytes of float vector
+				paramtable.Get().Save(Params.ProxyCfg.MaxResultSize.Key, "100")
+				defer paramtable.Get().Reset(Params.ProxyCfg.MaxResultSize.Key)
+
+				_, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, nil)
+				assert.ErrorIs(t, err, errorutil.ErrResultSizeExceeded)
+				assert.Contains(t, err.Error(), "limit <= 2")
+
+				result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, &queryParams{limit: 2})
+				assert.NoError(t, err)
+				assert.Equal(t, []int64{11, 11}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+
+				paramtable.Get().Save(Params.ProxyCfg.MaxResultSize.Key, "0")
+				result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, 4, len(result.GetFieldsData()[0].GetScalars().GetLongData().Data))
+			})
 		})
 	})
 }
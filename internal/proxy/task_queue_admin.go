@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// errTaskQueueDraining is returned when a task is enqueued to a draining task queue.
+var errTaskQueueDraining = errors.New("proxy is draining, no new dml or dql request is accepted")
+
+// taskCollectionName returns the collection the task works on, or empty if the task has none.
+func taskCollectionName(t task) string {
+	switch t := t.(type) {
+	case *insertTask:
+		return t.insertMsg.GetCollectionName()
+	case *deleteTask:
+		return t.deleteMsg.GetCollectionName()
+	case *upsertTask:
+		return t.req.GetCollectionName()
+	case *searchTask:
+		return t.request.GetCollectionName()
+	case *queryTask:
+		return t.request.GetCollectionName()
+	case *getStatisticsTask:
+		return t.request.GetCollectionName()
+	case interface{ GetCollectionName() string }:
+		return t.GetCollectionName()
+	}
+	return ""
+}
+
+func newProxyTasks(queueName string, unissued []task, active []task) []*proxypb.ProxyTask {
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].ID() < active[j].ID()
+	})
+	tasks := make([]*proxypb.ProxyTask, 0, len(unissued)+len(active))
+	newTask := func(t task, state proxypb.ProxyTaskState) *proxypb.ProxyTask {
+		return &proxypb.ProxyTask{
+			Queue:          queueName,
+			TaskID:         t.ID(),
+			Name:           t.Name(),
+			Type:           t.Type(),
+			CollectionName: taskCollectionName(t),
+			// the begin ts is allocated when the task is enqueued
+			EnqueueTime: tsoutil.PhysicalTime(t.BeginTs()).UnixMilli(),
+			State:       state,
+		}
+	}
+	for _, t := range active {
+		tasks = append(tasks, newTask(t, proxypb.ProxyTaskState_ProxyTaskExecuting))
+	}
+	for _, t := range unissued {
+		tasks = append(tasks, newTask(t, proxypb.ProxyTaskState_ProxyTaskQueued))
+	}
+	return tasks
+}
+
+// listTasks returns the drain mode and the tasks queued or executing in the dd, dm and dq queues.
+func (sched *taskScheduler) listTasks() *proxypb.ListProxyTasksResponse {
+	resp := &proxypb.ListProxyTasksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Draining: sched.isDraining(),
+		Tasks:    make([]*proxypb.ProxyTask, 0),
+	}
+	queues := []struct {
+		name  string
+		queue *baseTaskQueue
+	}{
+		{"dd", sched.ddQueue.baseTaskQueue},
+		{"dm", sched.dmQueue.baseTaskQueue},
+		{"dq", sched.dqQueue.baseTaskQueue},
+	}
+	for _, q := range queues {
+		unissued, active := q.queue.listTasks()
+		resp.Queued += int64(len(unissued))
+		resp.Executing += int64(len(active))
+		resp.Tasks = append(resp.Tasks, newProxyTasks(q.name, unissued, active)...)
+	}
+	return resp
+}
+
+// setDraining stops or resumes accepting new tasks in the dm and dq queues,
+// the tasks already enqueued are still scheduled until they finish.
+func (sched *taskScheduler) setDraining(draining bool) {
+	sched.dmQueue.draining.Store(draining)
+	sched.dqQueue.draining.Store(draining)
+}
+
+func (sched *taskScheduler) isDraining() bool {
+	return sched.dmQueue.draining.Load() && sched.dqQueue.draining.Load()
+}
+
+// ListProxyTasks lists the tasks queued or executing in the task scheduler, and whether the proxy is draining.
+// The privilege interceptor requires the global PrivilegeAll.
+func (node *Proxy) ListProxyTasks(ctx context.Context, req *proxypb.ListProxyTasksRequest) (*proxypb.ListProxyTasksResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ListProxyTasksResponse{Status: unhealthyStatus()}, nil
+	}
+	return node.sched.listTasks(), nil
+}
+
+// DrainProxy stops or resumes accepting new dml and dql requests, the tasks already enqueued are still scheduled
+// until they finish, so that the proxy can be shut down cleanly once the tasks listed are done. The privilege
+// interceptor requires the global PrivilegeAll.
+func (node *Proxy) DrainProxy(ctx context.Context, req *proxypb.DrainProxyRequest) (*proxypb.ListProxyTasksResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ListProxyTasksResponse{Status: unhealthyStatus()}, nil
+	}
+	node.sched.setDraining(req.GetDrain())
+	log.Ctx(ctx).Info("Proxy set task queue drain mode", zap.Bool("draining", req.GetDrain()))
+	return node.sched.listTasks(), nil
+}
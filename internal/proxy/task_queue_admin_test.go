@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestTaskScheduler_listTasks(t *testing.T) {
+	sched, err := newTaskScheduler(context.Background(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+
+	ddTask := newDefaultMockDdlTask()
+	ddTask.tType = commonpb.MsgType_CreateCollection
+	require.NoError(t, sched.ddQueue.Enqueue(ddTask))
+	require.NoError(t, sched.dmQueue.Enqueue(newDefaultMockDmlTask()))
+	dqTask := newDefaultMockDqlTask()
+	require.NoError(t, sched.dqQueue.Enqueue(dqTask))
+	require.NoError(t, sched.dqQueue.Enqueue(newDefaultMockDqlTask()))
+	sched.dqQueue.AddActiveTask(sched.scheduleDqTask())
+
+	resp := sched.listTasks()
+	assert.False(t, resp.GetDraining())
+	assert.Equal(t, int64(3), resp.GetQueued())
+	assert.Equal(t, int64(1), resp.GetExecuting())
+	tasks := resp.GetTasks()
+	require.Equal(t, 4, len(tasks))
+	assert.Equal(t, "dd", tasks[0].GetQueue())
+	assert.Equal(t, commonpb.MsgType_CreateCollection, tasks[0].GetType())
+	assert.Equal(t, proxypb.ProxyTaskState_ProxyTaskQueued, tasks[0].GetState())
+	assert.Equal(t, "dm", tasks[1].GetQueue())
+	assert.Equal(t, "dq", tasks[2].GetQueue())
+	assert.Equal(t, dqTask.ID(), tasks[2].GetTaskID())
+	assert.Equal(t, proxypb.ProxyTaskState_ProxyTaskExecuting, tasks[2].GetState())
+	assert.Equal(t, proxypb.ProxyTaskState_ProxyTaskQueued, tasks[3].GetState())
+
+	sched.dqQueue.PopActiveTask(dqTask.ID())
+	resp = sched.listTasks()
+	assert.Equal(t, int64(0), resp.GetExecuting())
+}
+
+func TestTaskScheduler_setDraining(t *testing.T) {
+	sched, err := newTaskScheduler(context.Background(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+
+	sched.setDraining(true)
+	assert.True(t, sched.isDraining())
+	assert.ErrorIs(t, sched.dmQueue.Enqueue(newDefaultMockDmlTask()), errTaskQueueDraining)
+	assert.ErrorIs(t, sched.dqQueue.Enqueue(newDefaultMockDqlTask()), errTaskQueueDraining)
+	assert.NoError(t, sched.ddQueue.Enqueue(newDefaultMockDdlTask()))
+
+	sched.setDraining(false)
+	assert.False(t, sched.isDraining())
+	assert.NoError(t, sched.dmQueue.Enqueue(newDefaultMockDmlTask()))
+	assert.NoError(t, sched.dqQueue.Enqueue(newDefaultMockDqlTask()))
+}
+
+func TestTaskCollectionName(t *testing.T) {
+	assert.Equal(t, "c1", taskCollectionName(&searchTask{request: &milvuspb.SearchRequest{CollectionName: "c1"}}))
+	assert.Equal(t, "c2", taskCollectionName(&queryTask{request: &milvuspb.QueryRequest{CollectionName: "c2"}}))
+	assert.Equal(t, "c3", taskCollectionName(&createCollectionTask{
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{CollectionName: "c3"},
+	}))
+	assert.Equal(t, "", taskCollectionName(newDefaultMockTask()))
+}
+
+func TestProxy_ListProxyTasks(t *testing.T) {
+	ctx := context.Background()
+	sched, err := newTaskScheduler(ctx, newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	require.NoError(t, err)
+	node := &Proxy{sched: sched}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	require.NoError(t, sched.dmQueue.Enqueue(newDefaultMockDmlTask()))
+
+	resp, err := node.ListProxyTasks(ctx, &proxypb.ListProxyTasksRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.False(t, resp.GetDraining())
+	assert.Equal(t, int64(1), resp.GetQueued())
+
+	resp, err = node.DrainProxy(ctx, &proxypb.DrainProxyRequest{Drain: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.True(t, resp.GetDraining())
+	assert.Equal(t, int64(1), resp.GetQueued())
+	assert.True(t, sched.isDraining())
+
+	resp, err = node.DrainProxy(ctx, &proxypb.DrainProxyRequest{Drain: false})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetDraining())
+	assert.False(t, sched.isDraining())
+
+	for _, req := range []proto.Message{&proxypb.ListProxyTasksRequest{}, &proxypb.DrainProxyRequest{}} {
+		privilegeExt, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ObjectType_Global, privilegeExt.ObjectType)
+		assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeAll, privilegeExt.ObjectPrivilege)
+	}
+
+	node.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = node.ListProxyTasks(ctx, &proxypb.ListProxyTasksRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	resp, err = node.DrainProxy(ctx, &proxypb.DrainProxyRequest{Drain: true})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	assert.False(t, sched.isDraining())
+}
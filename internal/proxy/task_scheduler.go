@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"sync"
 
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -64,6 +65,9 @@ type baseTaskQueue struct {
 	utBufChan chan int // to block scheduler
 
 	tsoAllocatorIns tsoAllocator
+
+	// draining rejects the new tasks while the queued and active ones are still scheduled
+	draining atomic.Bool
 }
 
 func (queue *baseTaskQueue) utChan() <-chan int {
@@ -164,6 +168,9 @@ func (queue *baseTaskQueue) getTaskByReqID(reqID UniqueID) task {
 }
 
 func (queue *baseTaskQueue) Enqueue(t task) error {
+	if queue.draining.Load() {
+		return errTaskQueueDraining
+	}
 	err := t.OnEnqueue()
 	if err != nil {
 		return err
@@ -181,6 +188,22 @@ func (queue *baseTaskQueue) Enqueue(t task) error {
 	return queue.addUnissuedTask(t)
 }
 
+// listTasks returns the unissued tasks in order and the active tasks.
+func (queue *baseTaskQueue) listTasks() (unissued []task, active []task) {
+	queue.utLock.RLock()
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		unissued = append(unissued, e.Value.(task))
+	}
+	queue.utLock.RUnlock()
+
+	queue.atLock.RLock()
+	for _, t := range queue.activeTasks {
+		active = append(active, t)
+	}
+	queue.atLock.RUnlock()
+	return unissued, active
+}
+
 func (queue *baseTaskQueue) setMaxTaskNum(num int64) {
 	queue.maxTaskNumMtx.Lock()
 	defer queue.maxTaskNumMtx.Unlock()
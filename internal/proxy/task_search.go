@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -59,6 +60,32 @@ type searchTask struct {
 
 	searchShardPolicy pickShardPolicy
 	shardMgr          *shardClientMgr
+
+	partitionRouter *partitionRouter
+	// warning about the partitions excluded from search by the partition router
+	routingWarning string
+
+	trafficSplitter *searchTrafficSplitter
+	// the variant serving the search if the search traffic of the collection is split
+	searchVariant string
+
+	// the response names of the aliased output fields
+	outputAliases map[string]string
+	// asks the QueryNodes for the filter execution statistics
+	filterStats bool
+
+	travelGuard *timeTravelGuard
+	// warning about the travel timestamp clamped to the time travel watermark
+	travelWarning string
+
+	tuner *searchTuner
+	// the knob value of the index the search is served with, to calibrate the latency target
+	tunedKnob *searchKnobRef
+	// warning about the latency target not applied
+	tuningWarning string
+
+	// re-ranks the merged top-k before returning, nil if the search doesn't re-rank
+	rerank *searchRerank
 }
 
 func getPartitionIDs(ctx context.Context, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
@@ -218,6 +245,24 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	}, offset, nil
 }
 
+// parseResultPrecision returns the precision of the scores and the float vectors asked by the search or query params,
+// fp32 by default.
+func parseResultPrecision(params []*commonpb.KeyValuePair) (internalpb.ResultPrecision, error) {
+	precision, err := funcutil.GetAttrByKeyFromRepeatedKV(OutputPrecisionKey, params)
+	if err != nil {
+		return internalpb.ResultPrecision_Float32, nil
+	}
+	switch precision {
+	case "fp32":
+		return internalpb.ResultPrecision_Float32, nil
+	case "fp16":
+		return internalpb.ResultPrecision_Float16, nil
+	case "bf16":
+		return internalpb.ResultPrecision_BFloat16, nil
+	}
+	return internalpb.ResultPrecision_Float32, fmt.Errorf("%s [%s] is invalid, it should be fp32, fp16 or bf16", OutputPrecisionKey, precision)
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -262,7 +307,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	defer sp.Finish()
 
 	if t.searchShardPolicy == nil {
-		t.searchShardPolicy = mergeRoundRobinPolicy
+		t.searchShardPolicy = newReplicaFailoverPolicy(metrics.SearchLabel)
 	}
 
 	t.Base.MsgType = commonpb.MsgType_Search
@@ -291,13 +336,35 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("checkIfLoaded failed when search, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
 	if !loaded {
-		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when search", collectionName, t.request.GetPartitionNames())
+		if !t.partitionRouter.enabled() {
+			return fmt.Errorf("collection:%v or partition:%v not loaded into memory when search", collectionName, t.request.GetPartitionNames())
+		}
+		if err := t.routePartitions(ctx); err != nil {
+			return err
+		}
 	}
 
+	t.request.OutputFields, t.outputAliases, err = parseOutputAliases(t.request.OutputFields, t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
 	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, false)
 	if err != nil {
 		return err
 	}
+	if err := checkOutputAliases(t.outputAliases, t.request.OutputFields); err != nil {
+		return err
+	}
+	if t.filterStats, err = parseFilterStats(t.request.GetSearchParams()); err != nil {
+		return err
+	}
+	t.SearchRequest.FilterStats = t.filterStats
+	if t.rerank, err = parseRerank(t.request.GetSearchParams(), t.request.GetOutputFields()); err != nil {
+		return err
+	}
+	if t.SearchRequest.ResultPrecision, err = parseResultPrecision(t.request.GetSearchParams()); err != nil {
+		return err
+	}
 	log.Ctx(ctx).Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
@@ -307,12 +374,22 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(AnnsFieldKey + " not found in search_params")
 		}
 
+		t.request.SearchParams, t.tunedKnob, t.tuningWarning, err = t.tuner.apply(ctx, collID, t.schema, annsField, t.request.GetSearchParams())
+		if err != nil {
+			return err
+		}
+
 		queryInfo, offset, err := parseSearchInfo(t.request.GetSearchParams())
 		if err != nil {
 			return err
 		}
 		t.offset = offset
 
+		t.request.Dsl, err = bindExprParams(t.request.Dsl, getExprParams(ctx, t.request.GetSearchParams()))
+		if err != nil {
+			return err
+		}
+
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to create query plan", zap.Error(err),
@@ -348,10 +425,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	travelTimestamp := t.request.TravelTimestamp
 	if travelTimestamp == 0 {
 		travelTimestamp = typeutil.MaxTimestamp
-	}
-	err = validateTravelTimestamp(travelTimestamp, t.BeginTs())
-	if err != nil {
-		return err
+	} else {
+		travelTimestamp, t.travelWarning, err = t.travelGuard.check(ctx, t.SearchRequest.GetCollectionID(), travelTimestamp, t.BeginTs())
+		if err != nil {
+			return err
+		}
 	}
 	t.SearchRequest.TravelTimestamp = travelTimestamp
 
@@ -397,6 +475,12 @@ func (t *searchTask) Execute(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if t.trafficSplitter.enabled() {
+			t.searchVariant, shard2Leaders, err = t.trafficSplitter.route(ctx, t.qc, t.collectionName, t.GetCollectionID(), shard2Leaders)
+			if err != nil {
+				return err
+			}
+		}
 		t.resultBuf = make(chan *internalpb.SearchResults, len(shard2Leaders))
 		t.toReduceResults = make([]*internalpb.SearchResults, 0, len(shard2Leaders))
 		if err := t.searchShardPolicy(ctx, t.shardMgr, t.searchShard, shard2Leaders); err != nil {
@@ -454,6 +538,11 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		log.Ctx(ctx).Warn("search result is empty")
 
 		t.fillInEmptyResult(Nq)
+		t.fillInRoutingWarning()
+		t.result.Status = appendStatusWarning(t.result.Status, t.travelWarning)
+		t.result.Status = appendStatusWarning(t.result.Status, t.tuningWarning)
+		t.fillInSearchVariant()
+		t.fillInFilterStats()
 		return nil
 	}
 
@@ -470,11 +559,24 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if t.rerank != nil {
+		if err := t.rerank.apply(ctx, t.result.GetResults(), MetricType, t.request.GetOutputFields()); err != nil {
+			return err
+		}
+	}
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
+	t.fillInRoutingWarning()
+	t.result.Status = appendStatusWarning(t.result.Status, t.travelWarning)
+	t.result.Status = appendStatusWarning(t.result.Status, t.tuningWarning)
+	t.fillInSearchVariant()
+	t.fillInFilterStats()
+	if t.tr != nil {
+		t.tuner.observe(t.tunedKnob, t.tr.ElapseSpan())
+	}
 
 	log.Ctx(ctx).Debug("Search post execute done")
 	return nil
@@ -538,7 +640,83 @@ func (t *searchTask) fillInFieldInfo() {
 				}
 			}
 		}
+		applyOutputAliases(t.result.Results.FieldsData, t.outputAliases)
+	}
+}
+
+// routePartitions searches the loaded partitions only, the partitions not loaded are reported by the routing warning.
+func (t *searchTask) routePartitions(ctx context.Context) error {
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, t.collectionName)
+	if err != nil {
+		return err
+	}
+	partitionNames := make(map[UniqueID]string, len(partitionsMap))
+	candidates := t.SearchRequest.GetPartitionIDs()
+	for name, partitionID := range partitionsMap {
+		partitionNames[partitionID] = name
+		if len(t.request.GetPartitionNames()) == 0 {
+			candidates = append(candidates, partitionID)
+		}
+	}
+
+	loaded, unloaded, err := t.partitionRouter.route(ctx, t.qc, t.SearchRequest.GetCollectionID(), candidates)
+	if err != nil {
+		return fmt.Errorf("failed to route partitions when search, collection:%v, err = %s", t.collectionName, err)
+	}
+	if len(loaded) == 0 {
+		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when search", t.collectionName, t.request.GetPartitionNames())
+	}
+	t.SearchRequest.PartitionIDs = loaded
+	if len(unloaded) > 0 {
+		names := make([]string, 0, len(unloaded))
+		for _, partitionID := range unloaded {
+			names = append(names, partitionNames[partitionID])
+		}
+		sort.Strings(names)
+		t.routingWarning = fmt.Sprintf("partitions %v not loaded, excluded from search", names)
+		log.Ctx(ctx).Warn("exclude partitions not loaded from search", zap.String("collection", t.collectionName),
+			zap.Int64s("loaded", loaded), zap.Strings("unloaded", names))
+	}
+	return nil
+}
+
+func (t *searchTask) fillInRoutingWarning() {
+	if t.routingWarning == "" || t.result == nil {
+		return
+	}
+	if t.result.Status == nil {
+		t.result.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	if t.result.Status.Reason != "" {
+		t.result.Status.Reason += "; "
 	}
+	t.result.Status.Reason += t.routingWarning
+}
+
+// fillInSearchVariant tags the result with the variant serving the search, so that clients can tell the variants apart.
+func (t *searchTask) fillInSearchVariant() {
+	if t.searchVariant == "" || t.result == nil {
+		return
+	}
+	if t.result.Status == nil {
+		t.result.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	if t.result.Status.Reason != "" {
+		t.result.Status.Reason += "; "
+	}
+	t.result.Status.Reason += fmt.Sprintf("search variant: %s", t.searchVariant)
+}
+
+// fillInFilterStats appends the filter statistics summed over the shards to the result.
+func (t *searchTask) fillInFilterStats() {
+	if !t.filterStats || t.result == nil {
+		return
+	}
+	shardStats := make([]*internalpb.FilterStats, 0, len(t.toReduceResults))
+	for _, result := range t.toReduceResults {
+		shardStats = append(shardStats, result.GetFilterStats())
+	}
+	t.result.Status = fillInFilterStats(t.result.Status, shardStats)
 }
 
 func (t *searchTask) collectSearchResults(ctx context.Context) error {
@@ -608,6 +786,9 @@ func decodeSearchResults(ctx context.Context, searchResults []*internalpb.Search
 		if err != nil {
 			return nil, err
 		}
+		if err := typeutil.FillInSlicedScores(partialSearchResult, &partialResultData); err != nil {
+			return nil, err
+		}
 
 		results = append(results, &partialResultData)
 	}
@@ -1701,6 +1701,97 @@ func Test_checkIfLoaded(t *testing.T) {
 	})
 }
 
+func TestSearchTask_routePartitions(t *testing.T) {
+	paramtable.Init()
+	cache := newMockCache()
+	cache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]UniqueID, error) {
+		return map[string]UniqueID{"p1": 1, "p2": 2, "p3": 3}, nil
+	})
+	globalMetaCache = cache
+	showCount := 0
+	qc := NewQueryCoordMock()
+	qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+		showCount++
+		return &querypb.ShowPartitionsResponse{
+			Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			PartitionIDs:        []UniqueID{1, 2},
+			InMemoryPercentages: []int64{100, 50},
+		}, nil
+	})
+
+	newTask := func(partitionIDs []UniqueID, partitionNames []string) *searchTask {
+		return &searchTask{
+			SearchRequest:   &internalpb.SearchRequest{CollectionID: 100, PartitionIDs: partitionIDs},
+			request:         &milvuspb.SearchRequest{PartitionNames: partitionNames},
+			qc:              qc,
+			collectionName:  "test",
+			partitionRouter: newPartitionRouter(),
+		}
+	}
+
+	t.Run("exclude partitions not loaded", func(t *testing.T) {
+		task := newTask([]UniqueID{1, 2, 3}, []string{"p1", "p2", "p3"})
+		err := task.routePartitions(context.Background())
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{1}, task.SearchRequest.GetPartitionIDs())
+		assert.Equal(t, "partitions [p2 p3] not loaded, excluded from search", task.routingWarning)
+
+		task.result = &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		task.fillInRoutingWarning()
+		assert.Equal(t, task.routingWarning, task.result.GetStatus().GetReason())
+	})
+
+	t.Run("search loaded partitions of collection", func(t *testing.T) {
+		task := newTask(nil, nil)
+		err := task.routePartitions(context.Background())
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{1}, task.SearchRequest.GetPartitionIDs())
+		assert.Equal(t, "partitions [p2 p3] not loaded, excluded from search", task.routingWarning)
+	})
+
+	t.Run("no partition loaded", func(t *testing.T) {
+		task := newTask([]UniqueID{2, 3}, []string{"p2", "p3"})
+		err := task.routePartitions(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("cache and invalidate", func(t *testing.T) {
+		router := newPartitionRouter()
+		showCount = 0
+		_, err := router.getLoadedPartitions(context.Background(), qc, 100)
+		assert.NoError(t, err)
+		_, err = router.getLoadedPartitions(context.Background(), qc, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, showCount)
+
+		router.Invalidate(100)
+		_, err = router.getLoadedPartitions(context.Background(), qc, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, showCount)
+	})
+
+	t.Run("show partitions failed", func(t *testing.T) {
+		qc := NewQueryCoordMock()
+		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
+			return nil, errors.New("mock")
+		})
+		task := newTask([]UniqueID{1}, []string{"p1"})
+		task.qc = qc
+		err := task.routePartitions(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var router *partitionRouter
+		assert.False(t, router.enabled())
+		router.Invalidate(100)
+
+		paramtable.Get().Save(Params.ProxyCfg.PartitionRoutingEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.ProxyCfg.PartitionRoutingEnabled.Key)
+		assert.True(t, newPartitionRouter().enabled())
+	})
+}
+
 func TestSearchTask_ErrExecute(t *testing.T) {
 
 	var (
@@ -1954,6 +2045,54 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 	})
 }
 
+func TestTaskSearch_parseResultPrecision(t *testing.T) {
+	precision, err := parseResultPrecision(getValidSearchParams())
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, precision)
+
+	precision, err = parseResultPrecision([]*commonpb.KeyValuePair{{Key: OutputPrecisionKey, Value: "fp16"}})
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_Float16, precision)
+
+	precision, err = parseResultPrecision([]*commonpb.KeyValuePair{{Key: OutputPrecisionKey, Value: "fp32"}})
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, precision)
+
+	precision, err = parseResultPrecision([]*commonpb.KeyValuePair{{Key: OutputPrecisionKey, Value: "bf16"}})
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_BFloat16, precision)
+
+	_, err = parseResultPrecision([]*commonpb.KeyValuePair{{Key: OutputPrecisionKey, Value: "fp8"}})
+	assert.Error(t, err)
+}
+
+func TestTaskSearch_decodeFloat16SearchResults(t *testing.T) {
+	data := &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       2,
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+		Topks:      []int64{2},
+	}
+	blob, err := proto.Marshal(data)
+	require.NoError(t, err)
+
+	scores, _ := typeutil.EncodeReducedPrecision([]float32{2.5, 1}, internalpb.ResultPrecision_Float16)
+	results, err := decodeSearchResults(context.TODO(), []*internalpb.SearchResults{{
+		SlicedBlob:      blob,
+		ResultPrecision: internalpb.ResultPrecision_Float16,
+		SlicedScores:    scores,
+	}})
+	assert.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []float32{2.5, 1}, results[0].GetScores())
+
+	_, err = decodeSearchResults(context.TODO(), []*internalpb.SearchResults{{
+		SlicedBlob:      blob,
+		ResultPrecision: internalpb.ResultPrecision_Float16,
+	}})
+	assert.Error(t, err)
+}
+
 func TestTaskSearch_parseSearchParams_AutoIndexEnable(t *testing.T) {
 	oldEnable := Params.AutoIndexConfig.Enable
 	oldIndexType := Params.AutoIndexConfig.IndexType
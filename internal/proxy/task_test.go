@@ -747,6 +747,88 @@ func TestCreateCollectionTask(t *testing.T) {
 	})
 }
 
+func TestCreateCollectionTask_DryRun(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	collectionName := "TestCreateCollectionTask_DryRun" + funcutil.GenRandomStr()
+	int64Field := "int64"
+	floatVecField := "fvec"
+
+	fieldName2Type := make(map[string]schemapb.DataType)
+	fieldName2Type[int64Field] = schemapb.DataType_Int64
+	fieldName2Type[floatVecField] = schemapb.DataType_FloatVector
+	schema := constructCollectionSchemaByDataType(collectionName, fieldName2Type, int64Field, false)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	newTask := func(dryRun string) *createCollectionTask {
+		return &createCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.DryRunKey, Value: dryRun},
+					{Key: common.CollectionTTLConfigKey, Value: "60"},
+				},
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+	}
+
+	t.Run("invalid flag", func(t *testing.T) {
+		task := newTask("not bool")
+		assert.Error(t, task.PreExecute(ctx))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		task := newTask("true")
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.True(t, task.dryRun)
+		assert.Equal(t, 1, len(task.GetProperties()))
+
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+
+		plan := task.dryRunPlan
+		assert.Equal(t, collectionName, plan.GetSchema().GetName())
+		assert.Equal(t, common.DefaultShardsNum, plan.GetShardsNum())
+		assert.Equal(t, 2, len(plan.GetSchema().GetFields()))
+		assert.Equal(t, int64(common.StartOfUserFieldID), plan.GetSchema().GetFields()[0].GetFieldID())
+		assert.Equal(t, int64(0), task.schema.GetFields()[0].GetFieldID())
+		assert.Equal(t, "60", funcutil.KeyValuePair2Map(plan.GetProperties())[common.CollectionTTLConfigKey])
+
+		// nothing persisted
+		hasResp, err := rc.HasCollection(ctx, &milvuspb.HasCollectionRequest{CollectionName: collectionName})
+		assert.NoError(t, err)
+		assert.False(t, hasResp.GetValue())
+	})
+
+	t.Run("dry run rpc", func(t *testing.T) {
+		task := newTask("false")
+		task.dryRun = true
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.True(t, task.dryRun)
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, collectionName, task.dryRunPlan.GetSchema().GetName())
+	})
+
+	t.Run("collection exists", func(t *testing.T) {
+		task := newTask("false")
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.NoError(t, task.Execute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+
+		task = newTask("true")
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Error(t, task.Execute(ctx))
+	})
+}
+
 func TestHasCollectionTask(t *testing.T) {
 	rc := NewRootCoordMock()
 	rc.Start()
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// timeTravelWatermarkTimeout is the timeout of fetching the time travel watermarks from DataCoord.
+const timeTravelWatermarkTimeout = 3 * time.Second
+
+// timeTravelGuard checks the travel timestamps of search and query against the time travel watermark of the collection,
+// which is the later one of the retention horizon and the max travel timestamp of the compactions completed in DataCoord.
+// The history older than the watermark may be merged by compactions already, travelling back beyond it returns wrong
+// or empty results silently. The watermarks, or the error fetching them, are cached for proxy.timeTravel.watermarkCacheTTL.
+type timeTravelGuard struct {
+	dataCoord types.DataCoord
+	group     singleflight.Group
+
+	mu         sync.Mutex
+	watermarks *datapb.GetTimeTravelWatermarksResponse
+	err        error
+	updatedAt  time.Time
+}
+
+func newTimeTravelGuard(dataCoord types.DataCoord) *timeTravelGuard {
+	return &timeTravelGuard{
+		dataCoord: dataCoord,
+	}
+}
+
+// getWatermarks returns the cached watermarks or error, and fetches the watermarks from DataCoord again once expired.
+// The concurrent requests share one fetch, which isn't bound to the context of any of them.
+func (g *timeTravelGuard) getWatermarks() (*datapb.GetTimeTravelWatermarksResponse, error) {
+	ttl := Params.ProxyCfg.TimeTravelWatermarkTTL.GetAsDuration(time.Second)
+	g.mu.Lock()
+	if !g.updatedAt.IsZero() && time.Since(g.updatedAt) < ttl {
+		watermarks, err := g.watermarks, g.err
+		g.mu.Unlock()
+		return watermarks, err
+	}
+	g.mu.Unlock()
+
+	ret, err, _ := g.group.Do("watermarks", func() (interface{}, error) {
+		watermarks, err := g.fetchWatermarks()
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.watermarks, g.err, g.updatedAt = watermarks, err, time.Now()
+		return watermarks, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret.(*datapb.GetTimeTravelWatermarksResponse), nil
+}
+
+func (g *timeTravelGuard) fetchWatermarks() (*datapb.GetTimeTravelWatermarksResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeTravelWatermarkTimeout)
+	defer cancel()
+	resp, err := g.dataCoord.GetTimeTravelWatermarks(ctx, &datapb.GetTimeTravelWatermarksRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to get time travel watermarks, reason = %s", resp.GetStatus().GetReason())
+	}
+	return resp, nil
+}
+
+// watermark returns the earliest timestamp the collection can travel back to at tMax, only the retention of Proxy
+// is checked if DataCoord is unavailable.
+func (g *timeTravelGuard) watermark(ctx context.Context, collectionID UniqueID, tMax typeutil.Timestamp) typeutil.Timestamp {
+	retention := Params.CommonCfg.RetentionDuration.GetAsInt64()
+	var compactionWatermark typeutil.Timestamp
+	watermarks, err := g.getWatermarks()
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get time travel watermarks from DataCoord, check the retention of proxy only",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+	} else {
+		retention = watermarks.GetRetentionSeconds()
+		compactionWatermark = watermarks.GetCompactionWatermarks()[collectionID]
+	}
+	ret := tsoutil.AddPhysicalDurationOnTs(tMax, -time.Duration(retention)*time.Second)
+	if compactionWatermark > ret {
+		ret = compactionWatermark
+	}
+	return ret
+}
+
+// check returns the travel timestamp to serve the request with, a travel timestamp older than the watermark fails
+// the request, or is clamped to the watermark with a warning if proxy.timeTravel.clampExpired is set.
+func (g *timeTravelGuard) check(ctx context.Context, collectionID UniqueID, travelTs, tMax typeutil.Timestamp) (typeutil.Timestamp, string, error) {
+	if g == nil {
+		return travelTs, "", validateTravelTimestamp(travelTs, tMax)
+	}
+	watermark := g.watermark(ctx, collectionID, tMax)
+	if travelTs >= watermark {
+		return travelTs, "", nil
+	}
+	if Params.ProxyCfg.TimeTravelClampExpired.GetAsBool() {
+		warning := fmt.Sprintf("travel timestamp %d is older than the time travel watermark %d of the collection, clamped to the watermark",
+			travelTs, watermark)
+		log.Ctx(ctx).Warn("clamp travel timestamp", zap.Int64("collectionID", collectionID),
+			zap.Uint64("travelTs", travelTs), zap.Uint64("watermark", watermark))
+		return watermark, warning, nil
+	}
+	return 0, "", fmt.Errorf("travel timestamp %d (%v) is older than the time travel watermark %d (%v) of the collection, the history before it may be compacted already",
+		travelTs, tsoutil.PhysicalTime(travelTs), watermark, tsoutil.PhysicalTime(watermark))
+}
+
+// appendStatusWarning appends the warning to the reason of the status of a served request.
+func appendStatusWarning(status *commonpb.Status, warning string) *commonpb.Status {
+	if warning == "" {
+		return status
+	}
+	if status == nil {
+		status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	if status.Reason != "" {
+		status.Reason += "; "
+	}
+	status.Reason += warning
+	return status
+}
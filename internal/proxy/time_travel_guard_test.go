@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestTimeTravelGuard(t *testing.T) {
+	ctx := context.Background()
+	now := tsoutil.GetCurrentTime()
+	hourAgo := tsoutil.AddPhysicalDurationOnTs(now, -time.Hour)
+	dayAgo := tsoutil.AddPhysicalDurationOnTs(now, -24*time.Hour)
+
+	var calls atomic.Int32
+	watermarks := &datapb.GetTimeTravelWatermarksResponse{
+		Status:               &commonpb.Status{},
+		RetentionSeconds:     7200,
+		CompactionWatermarks: map[int64]uint64{1: tsoutil.AddPhysicalDurationOnTs(now, -30*time.Minute)},
+	}
+	dc := NewDataCoordMock()
+	dc.getWatermarksFunc = func(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+		calls.Inc()
+		return watermarks, nil
+	}
+	guard := newTimeTravelGuard(dc)
+
+	t.Run("within watermark", func(t *testing.T) {
+		ts, warning, err := guard.check(ctx, 2, hourAgo, now)
+		assert.NoError(t, err)
+		assert.Equal(t, hourAgo, ts)
+		assert.Empty(t, warning)
+	})
+
+	t.Run("older than retention", func(t *testing.T) {
+		_, _, err := guard.check(ctx, 2, dayAgo, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("older than compaction", func(t *testing.T) {
+		_, _, err := guard.check(ctx, 1, hourAgo, now)
+		assert.Error(t, err)
+		// the watermarks are cached
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("clamp", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.TimeTravelClampExpired.Key, "true")
+		defer paramtable.Get().Reset(Params.ProxyCfg.TimeTravelClampExpired.Key)
+		ts, warning, err := guard.check(ctx, 1, hourAgo, now)
+		assert.NoError(t, err)
+		assert.Equal(t, watermarks.CompactionWatermarks[1], ts)
+		assert.NotEmpty(t, warning)
+	})
+
+	t.Run("datacoord fail", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.TimeTravelWatermarkTTL.Key, "0")
+		dc.getWatermarksFunc = func(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+			calls.Inc()
+			return nil, errors.New("mock")
+		}
+		// only the retention of proxy is checked
+		ts, _, err := guard.check(ctx, 1, hourAgo, now)
+		assert.NoError(t, err)
+		assert.Equal(t, hourAgo, ts)
+		_, _, err = guard.check(ctx, 1, tsoutil.AddPhysicalDurationOnTs(now, -48*time.Hour), now)
+		assert.Error(t, err)
+
+		// the error is cached too
+		paramtable.Get().Reset(Params.ProxyCfg.TimeTravelWatermarkTTL.Key)
+		calls.Store(0)
+		_, err = guard.getWatermarks()
+		assert.Error(t, err)
+		_, err = guard.getWatermarks()
+		assert.Error(t, err)
+		assert.Equal(t, int32(0), calls.Load())
+	})
+
+	t.Run("concurrent fetch", func(t *testing.T) {
+		guard := newTimeTravelGuard(dc)
+		calls.Store(0)
+		block := make(chan struct{})
+		dc.getWatermarksFunc = func(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error) {
+			calls.Inc()
+			<-block
+			return watermarks, nil
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ret, err := guard.getWatermarks()
+				assert.NoError(t, err)
+				assert.Equal(t, watermarks.GetRetentionSeconds(), ret.GetRetentionSeconds())
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(block)
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("nil guard", func(t *testing.T) {
+		var nilGuard *timeTravelGuard
+		ts, _, err := nilGuard.check(ctx, 1, hourAgo, now)
+		assert.NoError(t, err)
+		assert.Equal(t, hourAgo, ts)
+	})
+}
+
+func TestAppendStatusWarning(t *testing.T) {
+	assert.Nil(t, appendStatusWarning(nil, ""))
+	status := appendStatusWarning(nil, "w1")
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, "w1", status.GetReason())
+	status = appendStatusWarning(status, "w2")
+	assert.Equal(t, "w1; w2", status.GetReason())
+}
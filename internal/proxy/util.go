@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
@@ -220,6 +221,9 @@ func validateDimension(field *schemapb.FieldSchema) error {
 func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema) error {
 	exist := false
 	for _, param := range field.TypeParams {
+		if param.Key == common.NullableKey || param.Key == common.DefaultValueKey {
+			continue
+		}
 		if param.Key != maxVarCharLengthKey {
 			return fmt.Errorf("type param key(max_length) should be specified for varChar field, not %s", param.Key)
 		}
@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// queryNodeZones is the zones of the QueryNodes registered in their sessions, see common.session.zone.
+type queryNodeZones struct {
+	mu    sync.RWMutex
+	zones map[UniqueID]string
+}
+
+func newQueryNodeZones() *queryNodeZones {
+	return &queryNodeZones{zones: make(map[UniqueID]string)}
+}
+
+// reset replaces the zones with the zones of the sessions.
+func (z *queryNodeZones) reset(sessions map[string]*sessionutil.Session) {
+	zones := make(map[UniqueID]string, len(sessions))
+	for _, session := range sessions {
+		if session.Zone != "" {
+			zones[session.ServerID] = session.Zone
+		}
+	}
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	z.zones = zones
+}
+
+func (z *queryNodeZones) set(nodeID UniqueID, zone string) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	if zone == "" {
+		delete(z.zones, nodeID)
+		return
+	}
+	z.zones[nodeID] = zone
+}
+
+func (z *queryNodeZones) remove(nodeID UniqueID) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	delete(z.zones, nodeID)
+}
+
+// get returns the zone of the QueryNode, empty if unknown.
+func (z *queryNodeZones) get(nodeID UniqueID) string {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+	return z.zones[nodeID]
+}
+
+// localZone returns the zone of the Proxy, empty if unknown or the zone-aware routing is disabled.
+func localZone() string {
+	if !paramtable.Get().ProxyCfg.ZoneAwareRoutingEnabled.GetAsBool() {
+		return ""
+	}
+	return paramtable.Get().CommonCfg.SessionZone.GetValue()
+}
+
+// preferLocalZone returns the shard leaders of every channel with the leaders in the zone of the Proxy moved
+// ahead, so the leaders in the other zones are only tried when the local ones fail. The order within the local
+// and the remote leaders is kept, the round robin of the meta cache still balances the replicas of a zone.
+func (c *shardClientMgr) preferLocalZone(dml2leaders map[string][]nodeInfo) map[string][]nodeInfo {
+	zone := localZone()
+	if zone == "" {
+		return dml2leaders
+	}
+	ret := make(map[string][]nodeInfo, len(dml2leaders))
+	for dml, leaders := range dml2leaders {
+		ordered := make([]nodeInfo, 0, len(leaders))
+		remote := make([]nodeInfo, 0, len(leaders))
+		for _, leader := range leaders {
+			if c.zones.get(leader.nodeID) == zone {
+				ordered = append(ordered, leader)
+			} else {
+				remote = append(remote, leader)
+			}
+		}
+		ret[dml] = append(ordered, remote...)
+	}
+	return ret
+}
+
+// observeZoneLatency wraps the query to record its latency under the zone of the shard leader.
+func (c *shardClientMgr) observeZoneLatency(
+	query func(context.Context, UniqueID, types.QueryNode, []string) error) func(context.Context, UniqueID, types.QueryNode, []string) error {
+	return func(ctx context.Context, nodeID UniqueID, qn types.QueryNode, channels []string) error {
+		start := time.Now()
+		err := query(ctx, nodeID, qn, channels)
+
+		zone, locality := c.zones.get(nodeID), metrics.RemoteZoneLabel
+		if zone == "" {
+			zone = metrics.UnknownZoneLabel
+		} else if zone == localZone() {
+			locality = metrics.LocalZoneLabel
+		}
+		status := metrics.SuccessLabel
+		if err != nil {
+			status = metrics.FailLabel
+		}
+		metrics.ProxyZoneReadLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), zone, locality, status).
+			Observe(float64(time.Since(start).Milliseconds()))
+		return err
+	}
+}
+
+// startQueryNodeZoneWatch keeps the zones of the QueryNodes up to date from their sessions for the zone-aware
+// routing of search and query.
+func (node *Proxy) startQueryNodeZoneWatch() {
+	if node.session == nil || node.shardMgr == nil {
+		return
+	}
+	zones := node.shardMgr.zones
+	sessions, revision, err := node.session.GetSessions(typeutil.QueryNodeRole)
+	if err != nil {
+		log.Warn("failed to get querynode sessions, search and query are routed regardless of the zones", zap.Error(err))
+		return
+	}
+	zones.reset(sessions)
+
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+		eventCh := node.session.WatchServices(typeutil.QueryNodeRole, revision+1, func(sessions map[string]*sessionutil.Session) error {
+			zones.reset(sessions)
+			return nil
+		})
+		for {
+			select {
+			case <-node.ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+				switch event.EventType {
+				case sessionutil.SessionAddEvent, sessionutil.SessionUpdateEvent:
+					zones.set(event.Session.ServerID, event.Session.Zone)
+				case sessionutil.SessionDelEvent:
+					zones.remove(event.Session.ServerID)
+				}
+			}
+		}
+	}()
+}
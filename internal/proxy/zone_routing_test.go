@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestQueryNodeZones(t *testing.T) {
+	z := newQueryNodeZones()
+	z.reset(map[string]*sessionutil.Session{
+		"querynode-1": {ServerID: 1, Zone: "az1"},
+		"querynode-2": {ServerID: 2},
+	})
+	assert.Equal(t, "az1", z.get(1))
+	assert.Equal(t, "", z.get(2))
+
+	z.set(2, "az2")
+	assert.Equal(t, "az2", z.get(2))
+	z.set(2, "")
+	assert.Equal(t, "", z.get(2))
+	z.remove(1)
+	assert.Equal(t, "", z.get(1))
+}
+
+func TestShardClientMgr_PreferLocalZone(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ZoneAwareRoutingEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.ZoneAwareRoutingEnabled.Key)
+	mgr := newShardClientMgr()
+	mgr.zones.set(1, "az1")
+	mgr.zones.set(2, "az2")
+	mgr.zones.set(3, "az1")
+	dml2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 2}, {nodeID: 1}, {nodeID: 4}, {nodeID: 3}},
+		"c1": {{nodeID: 3}, {nodeID: 2}},
+	}
+
+	// the zone of the proxy is unknown
+	assert.Equal(t, dml2leaders, mgr.preferLocalZone(dml2leaders))
+
+	params.Save(params.CommonCfg.SessionZone.Key, "az1")
+	defer params.Reset(params.CommonCfg.SessionZone.Key)
+	assert.Equal(t, map[string][]nodeInfo{
+		"c0": {{nodeID: 1}, {nodeID: 3}, {nodeID: 2}, {nodeID: 4}},
+		"c1": {{nodeID: 3}, {nodeID: 2}},
+	}, mgr.preferLocalZone(dml2leaders))
+	// the leaders of the meta cache are not modified
+	assert.Equal(t, UniqueID(2), dml2leaders["c0"][0].nodeID)
+
+	params.Save(params.ProxyCfg.ZoneAwareRoutingEnabled.Key, "false")
+	assert.Equal(t, dml2leaders, mgr.preferLocalZone(dml2leaders))
+}
+
+func TestRoundRobinWithRetry_ZoneFallback(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ZoneAwareRoutingEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.ZoneAwareRoutingEnabled.Key)
+	params.Save(params.CommonCfg.SessionZone.Key, "az1")
+	defer params.Reset(params.CommonCfg.SessionZone.Key)
+
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	shard2leaders := map[string][]nodeInfo{
+		"c0": {{nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+		"c1": {{nodeID: 0, address: "fake"}, {nodeID: 1, address: "fake"}},
+	}
+	mgr.UpdateShardLeaders(nil, shard2leaders)
+	mgr.zones.set(0, "az2")
+	mgr.zones.set(1, "az1")
+
+	querier := &mockQuery{}
+	querier.init()
+	err := roundRobinWithRetry(ctx, mgr, querier.query, shard2leaders, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID][]string{1: {"c0", "c1"}}, querier.records())
+
+	// the local leader fails, the read falls back to the other zone
+	querier.init()
+	querier.failset[1] = errors.New("mock query node error")
+	err = roundRobinWithRetry(ctx, mgr, querier.query, shard2leaders, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID][]string{0: {"c0", "c1"}}, querier.records())
+}
@@ -270,7 +270,22 @@ func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []
 	for i := range plans {
 		plans[i].ReplicaID = replica.GetID()
 	}
-	return balance.CreateSegmentTasksFromPlans(ctx, c.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), plans)
+	tasks := balance.CreateSegmentTasksFromPlans(ctx, c.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), plans)
+	for _, t := range tasks {
+		if t, ok := t.(*task.SegmentTask); ok {
+			t.SetHeat(c.getSegmentHeat(t.CollectionID(), t.SegmentID()))
+		}
+	}
+	return tasks
+}
+
+// getSegmentHeat returns the query heat of the segment in the next target, or in the current target
+// if the segment isn't pulled into the next target again, e.g. lost as its QueryNode went down.
+func (c *SegmentChecker) getSegmentHeat(collectionID int64, segmentID int64) float64 {
+	if heat := c.targetMgr.GetSegmentHeat(collectionID, segmentID, meta.NextTarget); heat > 0 {
+		return heat
+	}
+	return c.targetMgr.GetSegmentHeat(collectionID, segmentID, meta.CurrentTarget)
 }
 
 func (c *SegmentChecker) createSegmentReduceTasks(ctx context.Context, segments []*meta.Segment, replicaID int64, scope querypb.DataScope) []task.Task {
@@ -18,8 +18,10 @@ package querycoordv2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -215,6 +217,39 @@ func (s *Server) getSystemInfoMetrics(
 	return resp, nil
 }
 
+// getSegmentLoadProgressMetrics merges the segment load progress reported by all the QueryNodes,
+// the QueryNodes failed to report are skipped.
+func (s *Server) getSegmentLoadProgressMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (string, error) {
+	segments := make([]metricsinfo.SegmentLoadMetrics, 0)
+	for _, metric := range s.tryGetNodesMetrics(ctx, req, s.nodeMgr.GetAll()...) {
+		if metric.resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Warn("failed to get segment load progress of query node",
+				zap.String("component", metric.resp.GetComponentName()),
+				zap.String("reason", metric.resp.GetStatus().GetReason()))
+			continue
+		}
+		var nodeSegments []metricsinfo.SegmentLoadMetrics
+		if err := json.Unmarshal([]byte(metric.resp.GetResponse()), &nodeSegments); err != nil {
+			log.Warn("invalid segment load progress of query node was found",
+				zap.String("component", metric.resp.GetComponentName()),
+				zap.Error(err))
+			continue
+		}
+		segments = append(segments, nodeSegments...)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].SegmentID != segments[j].SegmentID {
+			return segments[i].SegmentID < segments[j].SegmentID
+		}
+		return segments[i].NodeID < segments[j].NodeID
+	})
+	resp, err := json.Marshal(segments)
+	if err != nil {
+		return "", err
+	}
+	return string(resp), nil
+}
+
 func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, nodeMetrics []*metricResp) {
 	for _, metric := range nodeMetrics {
 		if metric.err != nil {
@@ -18,6 +18,7 @@ package meta
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	. "github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -48,10 +50,18 @@ type Broker interface {
 	GetIndexInfo(ctx context.Context, collectionID UniqueID, segmentID UniqueID) ([]*querypb.FieldIndexInfo, error)
 }
 
+// SegmentHeatBroker is implemented by the brokers able to get the query heats of the segments,
+// which are reported to DataCoord by the QueryNodes.
+type SegmentHeatBroker interface {
+	GetSegmentHeats(ctx context.Context, collectionID UniqueID) (map[UniqueID]float64, error)
+}
+
 type CoordinatorBroker struct {
 	dataCoord  types.DataCoord
 	rootCoord  types.RootCoord
 	indexCoord types.IndexCoord
+
+	recoveryInfos *recoveryInfoCache
 }
 
 func NewCoordinatorBroker(
@@ -59,9 +69,10 @@ func NewCoordinatorBroker(
 	rootCoord types.RootCoord,
 	indexCoord types.IndexCoord) *CoordinatorBroker {
 	return &CoordinatorBroker{
-		dataCoord,
-		rootCoord,
-		indexCoord,
+		dataCoord:     dataCoord,
+		rootCoord:     rootCoord,
+		indexCoord:    indexCoord,
+		recoveryInfos: newRecoveryInfoCache(),
 	}
 }
 
@@ -122,19 +133,40 @@ func (broker *CoordinatorBroker) GetRecoveryInfo(ctx context.Context, collection
 		CollectionID: collectionID,
 		PartitionID:  partitionID,
 	}
-	recoveryInfo, err := broker.dataCoord.GetRecoveryInfo(ctx, getRecoveryInfoRequest)
+	// ask for the segments changed since the kept recovery info only
+	base := broker.recoveryInfos.get(collectionID, partitionID)
+	channels, binlogs, ok, err := broker.getRecoveryInfo(ctx, getRecoveryInfoRequest, base)
+	if err == nil && !ok {
+		// the kept recovery info is not known by the answering DataCoord
+		log.Info("ask for the full recovery info", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID))
+		channels, binlogs, _, err = broker.getRecoveryInfo(ctx, getRecoveryInfoRequest, nil)
+	}
 	if err != nil {
 		log.Error("get recovery info failed", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Error(err))
 		return nil, nil, err
 	}
+	return channels, binlogs, nil
+}
 
+// getRecoveryInfo asks for the segments changed since base, all the segments if base is nil.
+func (broker *CoordinatorBroker) getRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest,
+	base *recoveryInfoEntry) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, bool, error) {
+	req.Since = nil
+	if base != nil {
+		req.Since = &datapb.RecoveryInfoVersion{
+			ServerID: base.serverID,
+			Version:  base.version,
+		}
+	}
+	recoveryInfo, err := broker.dataCoord.GetRecoveryInfo(ctx, req)
+	if err != nil {
+		return nil, nil, false, err
+	}
 	if recoveryInfo.Status.ErrorCode != commonpb.ErrorCode_Success {
-		err = errors.New(recoveryInfo.Status.Reason)
-		log.Error("get recovery info failed", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Error(err))
-		return nil, nil, err
+		return nil, nil, false, errors.New(recoveryInfo.Status.Reason)
 	}
-
-	return recoveryInfo.Channels, recoveryInfo.Binlogs, nil
+	binlogs, ok := broker.recoveryInfos.update(req.GetCollectionID(), req.GetPartitionID(), base, recoveryInfo)
+	return recoveryInfo.Channels, binlogs, ok, nil
 }
 
 func (broker *CoordinatorBroker) GetSegmentInfo(ctx context.Context, ids ...UniqueID) (*datapb.GetSegmentInfoResponse, error) {
@@ -201,3 +233,33 @@ func (broker *CoordinatorBroker) GetIndexInfo(ctx context.Context, collectionID
 
 	return indexes, nil
 }
+
+// GetSegmentHeats returns the query heats of the segments of the collection, the cold segments are left out.
+func (broker *CoordinatorBroker) GetSegmentHeats(ctx context.Context, collectionID UniqueID) (map[UniqueID]float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+
+	req, err := metricsinfo.ConstructRequestByBody(&metricsinfo.SegmentHeatRequest{
+		MetricType:   metricsinfo.SegmentHeatMetrics,
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := broker.dataCoord.GetMetrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to get segment heats, reason: %s", resp.GetStatus().GetReason())
+	}
+	var segments []metricsinfo.SegmentHeat
+	if err := json.Unmarshal([]byte(resp.GetResponse()), &segments); err != nil {
+		return nil, err
+	}
+	heats := make(map[UniqueID]float64, len(segments))
+	for _, segment := range segments {
+		heats[segment.SegmentID] = segment.Heat
+	}
+	return heats, nil
+}
@@ -18,6 +18,7 @@ package meta
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -31,6 +32,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 func TestCoordinatorBroker_GetCollectionSchema(t *testing.T) {
@@ -76,3 +79,47 @@ func TestCoordinatorBroker_GetCollectionSchema(t *testing.T) {
 		assert.Equal(t, "test_schema", schema.GetName())
 	})
 }
+
+// segmentHeatDataCoord is a DataCoord answering the segment heat requests only.
+type segmentHeatDataCoord struct {
+	types.DataCoord
+	req  *metricsinfo.SegmentHeatRequest
+	resp *milvuspb.GetMetricsResponse
+	err  error
+}
+
+func (d *segmentHeatDataCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	d.req = &metricsinfo.SegmentHeatRequest{}
+	if err := json.Unmarshal([]byte(req.GetRequest()), d.req); err != nil {
+		return nil, err
+	}
+	return d.resp, d.err
+}
+
+func TestCoordinatorBroker_GetSegmentHeats(t *testing.T) {
+	ctx := context.Background()
+	response, err := json.Marshal([]metricsinfo.SegmentHeat{{SegmentID: 1, CollectionID: 100, Heat: 2.5}})
+	assert.NoError(t, err)
+	dataCoord := &segmentHeatDataCoord{
+		resp: &milvuspb.GetMetricsResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Response: string(response),
+		},
+	}
+	broker := &CoordinatorBroker{dataCoord: dataCoord}
+	heats, err := broker.GetSegmentHeats(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]float64{1: 2.5}, heats)
+	assert.Equal(t, metricsinfo.SegmentHeatMetrics, dataCoord.req.MetricType)
+	assert.Equal(t, int64(100), dataCoord.req.CollectionID)
+
+	dataCoord.resp = &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, Response: "invalid"}
+	_, err = broker.GetSegmentHeats(ctx, 100)
+	assert.Error(t, err)
+	dataCoord.resp = &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}
+	_, err = broker.GetSegmentHeats(ctx, 100)
+	assert.Error(t, err)
+	dataCoord.err = errors.New("mock")
+	_, err = broker.GetSegmentHeats(ctx, 100)
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
+)
+
+// recoveryInfoIdleTTL is the time the last recovery info of a partition is kept after it is asked.
+const recoveryInfoIdleTTL = 10 * time.Minute
+
+// recoveryInfoCache keeps the last GetRecoveryInfo response of each partition with its version and the server id of
+// the DataCoord answering it, the later calls ask the DataCoord for the segments changed after the version only and
+// merge them into the kept ones. A delta answered by another DataCoord is never merged, the full recovery info is
+// asked instead.
+type recoveryInfoCache struct {
+	mu      sync.Mutex
+	entries map[recoveryInfoKey]*recoveryInfoEntry
+	now     func() time.Time
+}
+
+type recoveryInfoKey struct {
+	collectionID int64
+	partitionID  int64
+}
+
+// recoveryInfoEntry is immutable once cached, so that a caller merges a delta into the entry it asked with.
+type recoveryInfoEntry struct {
+	serverID   int64
+	version    int64
+	binlogs    []*datapb.SegmentBinlogs
+	lastAccess time.Time
+}
+
+func newRecoveryInfoCache() *recoveryInfoCache {
+	return &recoveryInfoCache{
+		entries: make(map[recoveryInfoKey]*recoveryInfoEntry),
+		now:     time.Now,
+	}
+}
+
+// get returns the kept recovery info of the partition, nil if not kept,
+// and drops the ones not asked within the idle ttl.
+func (c *recoveryInfoCache) get(collectionID, partitionID int64) *recoveryInfoEntry {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for key, entry := range c.entries {
+		if now.Sub(entry.lastAccess) > recoveryInfoIdleTTL {
+			delete(c.entries, key)
+		}
+	}
+	entry, ok := c.entries[recoveryInfoKey{collectionID: collectionID, partitionID: partitionID}]
+	if !ok {
+		return nil
+	}
+	entry.lastAccess = now
+	return entry
+}
+
+// update merges the response into the entry it is asked with, keeps the result if it is newer than the kept one,
+// and returns the binlogs of all the segments of the partition. ok is false if the response is a delta which can't be
+// merged into the entry, the caller should ask for the full recovery info then.
+func (c *recoveryInfoCache) update(collectionID, partitionID int64, base *recoveryInfoEntry,
+	resp *datapb.GetRecoveryInfoResponse) ([]*datapb.SegmentBinlogs, bool) {
+	binlogs := resp.GetBinlogs()
+	if c == nil {
+		return binlogs, true
+	}
+	key := recoveryInfoKey{collectionID: collectionID, partitionID: partitionID}
+	serverID, version, delta := resp.GetVersion().GetServerID(), resp.GetVersion().GetVersion(), resp.GetDelta()
+	// a delta against unknown segments, or the segments known by another DataCoord
+	mergeable := base != nil && base.serverID == serverID
+	if delta && !mergeable {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+		return nil, false
+	}
+	if delta {
+		binlogs = segmentutil.MergeRecoveryInfo(base.binlogs, resp.GetChannels(), binlogs)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// a DataCoord not versioning the response
+	if resp.GetVersion() == nil {
+		delete(c.entries, key)
+		return binlogs, true
+	}
+	current, exist := c.entries[key]
+	if !exist || current.serverID != serverID || current.version < version {
+		c.entries[key] = &recoveryInfoEntry{
+			serverID:   serverID,
+			version:    version,
+			binlogs:    binlogs,
+			lastAccess: c.now(),
+		}
+	}
+	return binlogs, true
+}
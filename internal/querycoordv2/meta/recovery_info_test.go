@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestRecoveryInfoCache(t *testing.T) {
+	c := newRecoveryInfoCache()
+	channels := []*datapb.VchannelInfo{{ChannelName: "ch1", FlushedSegmentIds: []int64{1, 2}}}
+	full := []*datapb.SegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}}
+	response := func(serverID int64, version int64, delta bool, channels []*datapb.VchannelInfo,
+		binlogs []*datapb.SegmentBinlogs) *datapb.GetRecoveryInfoResponse {
+		return &datapb.GetRecoveryInfoResponse{
+			Channels: channels,
+			Binlogs:  binlogs,
+			Version:  &datapb.RecoveryInfoVersion{ServerID: serverID, Version: version},
+			Delta:    delta,
+		}
+	}
+
+	assert.Nil(t, c.get(1, 2))
+
+	// a DataCoord not versioning the response
+	binlogs, ok := c.update(1, 2, nil, &datapb.GetRecoveryInfoResponse{Channels: channels, Binlogs: full})
+	assert.True(t, ok)
+	assert.Equal(t, full, binlogs)
+	assert.Nil(t, c.get(1, 2))
+
+	// a full response is kept
+	binlogs, ok = c.update(1, 2, nil, response(7, 10, false, channels, full))
+	assert.True(t, ok)
+	assert.Equal(t, full, binlogs)
+	base := c.get(1, 2)
+	assert.Equal(t, int64(7), base.serverID)
+	assert.Equal(t, int64(10), base.version)
+
+	// a delta is merged into the kept one
+	channels = []*datapb.VchannelInfo{{ChannelName: "ch1", FlushedSegmentIds: []int64{1, 3}}}
+	binlogs, ok = c.update(1, 2, base, response(7, 12, true, channels, []*datapb.SegmentBinlogs{{SegmentID: 3}}))
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int64{1, 3}, []int64{binlogs[0].GetSegmentID(), binlogs[1].GetSegmentID()})
+	assert.Equal(t, int64(12), c.get(1, 2).version)
+
+	// an older response doesn't replace the kept one
+	c.update(1, 2, base, response(7, 11, true, channels, nil))
+	assert.Equal(t, int64(12), c.get(1, 2).version)
+
+	// a full response of another DataCoord replaces the kept one even if its version is older
+	c.update(1, 2, nil, response(8, 1, false, channels, full))
+	base = c.get(1, 2)
+	assert.Equal(t, int64(8), base.serverID)
+	assert.Equal(t, int64(1), base.version)
+
+	// a delta of another DataCoord is not merged and drops the kept one
+	binlogs, ok = c.update(1, 2, base, response(9, 13, true, channels, nil))
+	assert.False(t, ok)
+	assert.Nil(t, binlogs)
+	assert.Nil(t, c.get(1, 2))
+
+	// a delta against nothing isn't merged
+	_, ok = c.update(1, 2, nil, response(9, 13, true, channels, nil))
+	assert.False(t, ok)
+	assert.Nil(t, c.get(1, 2))
+
+	// the idle ones are dropped
+	c.update(1, 2, nil, response(9, 14, false, channels, full))
+	now := time.Now()
+	c.now = func() time.Time { return now.Add(recoveryInfoIdleTTL + time.Minute) }
+	assert.Nil(t, c.get(1, 2))
+
+	// nil cache
+	var nilCache *recoveryInfoCache
+	assert.Nil(t, nilCache.get(1, 2))
+	binlogs, ok = nilCache.update(1, 2, nil, response(9, 1, false, channels, full))
+	assert.True(t, ok)
+	assert.Equal(t, full, binlogs)
+}
@@ -26,6 +26,8 @@ import (
 type CollectionTarget struct {
 	segments   map[int64]*datapb.SegmentInfo
 	dmChannels map[string]*DmChannel
+	// query heats of the segments when the target is pulled, the hot segments are loaded first
+	segmentHeats map[int64]float64
 }
 
 func NewCollectionTarget(segments map[int64]*datapb.SegmentInfo, dmChannels map[string]*DmChannel) *CollectionTarget {
@@ -39,6 +41,11 @@ func (p *CollectionTarget) GetAllSegments() map[int64]*datapb.SegmentInfo {
 	return p.segments
 }
 
+// GetSegmentHeat returns the query heat of the segment, 0 if it's cold or unknown.
+func (p *CollectionTarget) GetSegmentHeat(segmentID int64) float64 {
+	return p.segmentHeats[segmentID]
+}
+
 func (p *CollectionTarget) GetAllDmChannels() map[string]*DmChannel {
 	return p.dmChannels
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -178,7 +179,29 @@ func (mgr *TargetManager) PullNextTarget(broker Broker, collectionID int64, part
 		dmChannels[merged.GetChannelName()] = merged
 	}
 
-	return NewCollectionTarget(segments, dmChannels), nil
+	collectionTarget := NewCollectionTarget(segments, dmChannels)
+	collectionTarget.segmentHeats = mgr.pullSegmentHeats(broker, collectionID)
+	return collectionTarget, nil
+}
+
+// pullSegmentHeats returns the query heats of the segments of the collection if the broker supports it,
+// the target is still usable without the heats, so the errors are logged only.
+func (mgr *TargetManager) pullSegmentHeats(broker Broker, collectionID int64) map[int64]float64 {
+	if !Params.QueryCoordCfg.SegmentHeatLoadFirst.GetAsBool() {
+		return nil
+	}
+	heatBroker, ok := broker.(SegmentHeatBroker)
+	if !ok {
+		return nil
+	}
+	heats, err := heatBroker.GetSegmentHeats(context.TODO(), collectionID)
+	if err != nil {
+		log.Warn("failed to get segment heats, load the segments regardless of the heats",
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+		return nil
+	}
+	return heats
 }
 
 func (mgr *TargetManager) mergeDmChannelInfo(infos []*datapb.VchannelInfo) *DmChannel {
@@ -268,7 +291,9 @@ func (mgr *TargetManager) removePartitionFromCollectionTarget(oldTarget *Collect
 		channels[channel.GetChannelName()] = channel
 	}
 
-	return NewCollectionTarget(segments, channels)
+	newTarget := NewCollectionTarget(segments, channels)
+	newTarget.segmentHeats = oldTarget.segmentHeats
+	return newTarget
 }
 
 func (mgr *TargetManager) removePartitionGrowingSegmentFromChannel(partitionIDSet typeutil.UniqueSet,
@@ -395,6 +420,19 @@ func (mgr *TargetManager) GetHistoricalSegment(collectionID int64, id int64, sco
 	return collectionTarget.GetAllSegments()[id]
 }
 
+// GetSegmentHeat returns the query heat of the segment in the target, 0 if it's cold or unknown.
+func (mgr *TargetManager) GetSegmentHeat(collectionID int64, segmentID int64, scope TargetScope) float64 {
+	mgr.rwMutex.RLock()
+	defer mgr.rwMutex.RUnlock()
+	targetMap := mgr.getTarget(scope)
+	collectionTarget := targetMap.getCollectionTarget(collectionID)
+
+	if collectionTarget == nil {
+		return 0
+	}
+	return collectionTarget.GetSegmentHeat(segmentID)
+}
+
 func (mgr *TargetManager) IsCurrentTargetExist(collectionID int64) bool {
 	newChannels := mgr.GetDmChannelsByCollection(collectionID, CurrentTarget)
 
@@ -17,6 +17,8 @@
 package meta
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/samber/lo"
@@ -199,6 +201,45 @@ func (suite *TargetManagerSuite) TestUpdateNextTarget() {
 	suite.assertChannels([]string{}, suite.mgr.GetDmChannelsByCollection(collectionID, CurrentTarget))
 }
 
+// segmentHeatBroker is a broker able to get the segment heats.
+type segmentHeatBroker struct {
+	*MockBroker
+	heats map[int64]float64
+	err   error
+}
+
+func (broker *segmentHeatBroker) GetSegmentHeats(ctx context.Context, collectionID int64) (map[int64]float64, error) {
+	return broker.heats, broker.err
+}
+
+func (suite *TargetManagerSuite) TestSegmentHeats() {
+	collectionID := int64(1000)
+	// the broker without the heats
+	suite.Equal(0.0, suite.mgr.GetSegmentHeat(collectionID, 1, NextTarget))
+
+	broker := &segmentHeatBroker{MockBroker: suite.broker, heats: map[int64]float64{1: 2.5, 3: 1}}
+	suite.mgr.broker = broker
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, suite.partitions[collectionID]...))
+	suite.Equal(2.5, suite.mgr.GetSegmentHeat(collectionID, 1, NextTarget))
+	suite.Equal(0.0, suite.mgr.GetSegmentHeat(collectionID, 2, NextTarget))
+	suite.Equal(0.0, suite.mgr.GetSegmentHeat(collectionID, 1, CurrentTarget))
+	suite.mgr.UpdateCollectionCurrentTarget(collectionID)
+	suite.Equal(2.5, suite.mgr.GetSegmentHeat(collectionID, 1, CurrentTarget))
+	suite.mgr.RemovePartition(collectionID, 100)
+	suite.Equal(1.0, suite.mgr.GetSegmentHeat(collectionID, 3, CurrentTarget))
+
+	// the target is pulled without the heats on failure
+	broker.err = errors.New("mock")
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, suite.partitions[collectionID]...))
+	suite.Equal(0.0, suite.mgr.GetSegmentHeat(collectionID, 1, NextTarget))
+
+	broker.err = nil
+	Params.Save(Params.QueryCoordCfg.SegmentHeatLoadFirst.Key, "false")
+	defer Params.Reset(Params.QueryCoordCfg.SegmentHeatLoadFirst.Key)
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, suite.partitions[collectionID]...))
+	suite.Equal(0.0, suite.mgr.GetSegmentHeat(collectionID, 1, NextTarget))
+}
+
 func (suite *TargetManagerSuite) TestRemovePartition() {
 	collectionID := int64(1000)
 	suite.assertSegments(suite.getAllSegment(collectionID, suite.partitions[collectionID]), suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
@@ -529,14 +529,7 @@ func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowCon
 			Status: utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, ErrNotHealthy),
 		}, nil
 	}
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "querycoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "querycoord", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -575,6 +568,16 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return resp, nil
 	}
 
+	if metricType == metricsinfo.SegmentLoadProgressMetrics {
+		resp.Response, err = s.getSegmentLoadProgressMetrics(ctx, req)
+		if err != nil {
+			msg := "failed to get segment load progress metrics"
+			log.Warn(msg, zap.Error(err))
+			resp.Status = utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, err)
+		}
+		return resp, nil
+	}
+
 	if metricType != metricsinfo.SystemInfoMetrics {
 		msg := "invalid metric type"
 		err := errors.New(metricsinfo.MsgUnimplementedMetric)
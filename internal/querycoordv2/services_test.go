@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -823,6 +824,36 @@ func (suite *ServiceSuite) TestGetMetrics() {
 	suite.Contains(resp.Status.Reason, ErrNotHealthy.Error())
 }
 
+func (suite *ServiceSuite) TestGetSegmentLoadProgressMetrics() {
+	ctx := context.Background()
+	server := suite.server
+
+	for i, node := range suite.nodes {
+		resp := &milvuspb.GetMetricsResponse{
+			Status:        successStatus,
+			ComponentName: "QueryNode",
+			Response:      fmt.Sprintf(`[{"segment_id":%d,"node_id":%d,"phase":"downloading"}]`, len(suite.nodes)-i, node),
+		}
+		// the first node fails to report
+		if i == 0 {
+			resp = &milvuspb.GetMetricsResponse{Status: utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, "mock")}
+		}
+		suite.cluster.EXPECT().GetMetrics(ctx, node, mock.Anything).Return(resp, nil)
+	}
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SegmentLoadProgressMetrics)
+	suite.NoError(err)
+	resp, err := server.GetMetrics(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	var segments []metricsinfo.SegmentLoadMetrics
+	suite.NoError(json.Unmarshal([]byte(resp.GetResponse()), &segments))
+	suite.Len(segments, len(suite.nodes)-1)
+	for i := 1; i < len(segments); i++ {
+		suite.Less(segments[i-1].SegmentID, segments[i].SegmentID)
+	}
+}
+
 func (suite *ServiceSuite) TestGetReplicas() {
 	suite.loadAll()
 	ctx := context.Background()
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -495,9 +496,18 @@ func (scheduler *taskScheduler) schedule(node int64) {
 		return true
 	})
 
+	failCount := atomic.NewInt32(0)
+	// The hot segments are committed to the executors one by one before the others,
+	// so they take the executor slots first
+	hotTasks, toProcess := splitHotSegmentTasks(toProcess)
+	for _, task := range hotTasks {
+		if !scheduler.process(task) {
+			failCount.Inc()
+		}
+	}
+
 	// The scheduler doesn't limit the number of tasks,
 	// to commit tasks to executors as soon as possible, to reach higher merge possibility
-	failCount := atomic.NewInt32(0)
 	funcutil.ProcessFuncParallel(len(toProcess), runtime.GOMAXPROCS(0), func(idx int) error {
 		if !scheduler.process(toProcess[idx]) {
 			failCount.Inc()
@@ -510,6 +520,7 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	}
 
 	log.Info("processed tasks",
+		zap.Int("hotSegmentTaskNum", len(hotTasks)),
 		zap.Int("toProcessNum", len(toProcess)),
 		zap.Int32("failCount", failCount.Load()),
 		zap.Int("toRemoveNum", len(toRemove)),
@@ -523,6 +534,30 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	)
 }
 
+// splitHotSegmentTasks returns the segment tasks with query heats ordered by priority and heat from high to low,
+// and the other tasks
+func splitHotSegmentTasks(tasks []Task) ([]Task, []Task) {
+	hotTasks := make([]Task, 0)
+	others := make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task, ok := task.(*SegmentTask); ok && task.Heat() > 0 {
+			hotTasks = append(hotTasks, task)
+			continue
+		}
+		others = append(others, task)
+	}
+	sort.SliceStable(hotTasks, func(i, j int) bool {
+		if hotTasks[i].Priority() != hotTasks[j].Priority() {
+			return hotTasks[i].Priority() > hotTasks[j].Priority()
+		}
+		if hotTasks[i].(*SegmentTask).Heat() != hotTasks[j].(*SegmentTask).Heat() {
+			return hotTasks[i].(*SegmentTask).Heat() > hotTasks[j].(*SegmentTask).Heat()
+		}
+		return hotTasks[i].ID() < hotTasks[j].ID()
+	})
+	return hotTasks, others
+}
+
 func (scheduler *taskScheduler) isRelated(task Task, node int64) bool {
 	for _, action := range task.Actions() {
 		if action.Node() == node {
@@ -229,6 +229,8 @@ type SegmentTask struct {
 	*baseTask
 
 	segmentID UniqueID
+	// query heat of the segment, the hot segments are loaded first
+	heat float64
 }
 
 // NewSegmentTask creates a SegmentTask with actions,
@@ -275,6 +277,14 @@ func (task *SegmentTask) SegmentID() UniqueID {
 	return task.segmentID
 }
 
+func (task *SegmentTask) Heat() float64 {
+	return task.heat
+}
+
+func (task *SegmentTask) SetHeat(heat float64) {
+	task.heat = heat
+}
+
 func (task *SegmentTask) String() string {
 	return fmt.Sprintf("%s [segmentID=%d]", task.baseTask.String(), task.segmentID)
 }
@@ -35,6 +35,8 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -1269,6 +1271,31 @@ func createReplica(collection int64, nodes ...int64) *meta.Replica {
 	}
 }
 
+func Test_splitHotSegmentTasks(t *testing.T) {
+	ctx := context.Background()
+	newTask := func(segmentID int64, priority Priority, heat float64) *SegmentTask {
+		task, err := NewSegmentTask(ctx, 10*time.Second, 0, 1, 1, NewSegmentAction(1, ActionTypeGrow, "", segmentID))
+		assert.NoError(t, err)
+		task.SetPriority(priority)
+		task.SetHeat(heat)
+		return task
+	}
+	channelTask, err := NewChannelTask(ctx, 10*time.Second, 0, 1, 1, NewChannelAction(1, ActionTypeGrow, "channel"))
+	assert.NoError(t, err)
+
+	hotTasks, others := splitHotSegmentTasks([]Task{
+		newTask(1, TaskPriorityNormal, 0),
+		newTask(2, TaskPriorityNormal, 1),
+		channelTask,
+		newTask(3, TaskPriorityNormal, 5),
+		newTask(4, TaskPriorityHigh, 0.5),
+	})
+	assert.Equal(t, []int64{4, 3, 2}, lo.Map(hotTasks, func(task Task, _ int) int64 {
+		return task.(*SegmentTask).SegmentID()
+	}))
+	assert.Equal(t, 2, len(others))
+}
+
 func TestTask(t *testing.T) {
 	suite.Run(t, new(TaskSuite))
 }
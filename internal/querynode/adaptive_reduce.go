@@ -0,0 +1,336 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// The adaptive reduce searches the followers in two phases. In the ScoresOnly phase a follower returns the ids and
+// the scores of its results only and keeps the full results, the shard leader merges them to find how many results
+// of every query survive on every follower, then fetches the full surviving results in the FetchSurviving phase.
+// A follower not knowing the phases returns the full results in the first phase, which are used as is.
+
+// maxCachedSearchPhaseResults is the max number of the full results kept by a follower for the fetch phase
+const maxCachedSearchPhaseResults = 128
+
+// searchPhaseKey identifies the search of a shard on a follower across the phases.
+func searchPhaseKey(req *querypb.SearchRequest) string {
+	return fmt.Sprintf("%d-%s", req.GetReq().GetBase().GetMsgID(), strings.Join(req.GetDmlChannels(), ","))
+}
+
+type cachedSearchResult struct {
+	data       *schemapb.SearchResultData
+	metricType string
+	expireAt   time.Time
+}
+
+// searchPhaseCache keeps the full results of the scores phase on a follower till the fetch phase,
+// all the methods are no-op on a nil cache.
+type searchPhaseCache struct {
+	mu      sync.Mutex
+	results map[string]*cachedSearchResult
+	keys    []string // in the order of put
+}
+
+func newSearchPhaseCache() *searchPhaseCache {
+	return &searchPhaseCache{
+		results: make(map[string]*cachedSearchResult),
+	}
+}
+
+// put keeps the result till ttl, the expired and then the oldest results are dropped once full.
+func (c *searchPhaseCache) put(key string, data *schemapb.SearchResultData, metricType string, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if _, ok := c.results[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.results[key] = &cachedSearchResult{data: data, metricType: metricType, expireAt: now.Add(ttl)}
+	keys := c.keys[:0]
+	for _, k := range c.keys {
+		result, ok := c.results[k]
+		if !ok {
+			continue
+		}
+		if now.After(result.expireAt) || len(c.results) > maxCachedSearchPhaseResults {
+			delete(c.results, k)
+			continue
+		}
+		keys = append(keys, k)
+	}
+	c.keys = keys
+}
+
+// take removes and returns the unexpired result of the key.
+func (c *searchPhaseCache) take(key string) (*cachedSearchResult, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result, ok := c.results[key]
+	if !ok {
+		return nil, false
+	}
+	delete(c.results, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+	if time.Now().After(result.expireAt) {
+		return nil, false
+	}
+	return result, true
+}
+
+// fetchSearchPhaseResult returns the cached full results of the ScoresOnly phase truncated to the fetch counts.
+// It fails if they are not cached any more, the shard leader then searches the followers in one phase again,
+// since a new search may not return the results merged by the ScoresOnly phase.
+func (node *QueryNode) fetchSearchPhaseResult(req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	key := searchPhaseKey(req)
+	cached, ok := node.searchPhaseCache.take(key)
+	if !ok {
+		return nil, fmt.Errorf("the search results of the scores phase are expired, search %s", key)
+	}
+	return encodeSearchResultData(truncateSearchResultData(cached.data, req.GetFetchCounts()),
+		req.GetReq().GetNq(), req.GetReq().GetTopk(), cached.metricType, internalpb.ResultPrecision_Float32)
+}
+
+// applySearchPhase keeps the ids and scores of the full results only if the shard leader asks for the ScoresOnly
+// phase, the full results are cached for the FetchSurviving phase.
+func (node *QueryNode) applySearchPhase(req *querypb.SearchRequest, ret *internalpb.SearchResults) error {
+	if req.GetSearchPhase() != querypb.SearchPhase_ScoresOnly || ret.GetSlicedBlob() == nil {
+		return nil
+	}
+	data := &schemapb.SearchResultData{}
+	if err := proto.Unmarshal(ret.GetSlicedBlob(), data); err != nil {
+		return err
+	}
+	fieldsData := data.FieldsData
+	data.FieldsData = nil
+	blob, err := proto.Marshal(data)
+	if err != nil {
+		return err
+	}
+	data.FieldsData = fieldsData
+	node.searchPhaseCache.put(searchPhaseKey(req), data, ret.GetMetricType(),
+		Params.QueryNodeCfg.AdaptiveReduceCacheTTL.GetAsDuration(time.Millisecond))
+	ret.SlicedBlob = blob
+	return nil
+}
+
+// truncateSearchResultData returns the first counts[i] results of every query i.
+func truncateSearchResultData(data *schemapb.SearchResultData, counts []int64) *schemapb.SearchResultData {
+	ret := &schemapb.SearchResultData{
+		NumQueries: data.GetNumQueries(),
+		TopK:       data.GetTopK(),
+		FieldsData: make([]*schemapb.FieldData, len(data.GetFieldsData())),
+		Scores:     make([]float32, 0),
+		Ids:        &schemapb.IDs{},
+		Topks:      make([]int64, 0, len(data.GetTopks())),
+	}
+	var offset int64
+	for i, topk := range data.GetTopks() {
+		n := int64(0)
+		if i < len(counts) {
+			n = counts[i]
+		}
+		if n > topk {
+			n = topk
+		}
+		for idx := offset; idx < offset+n; idx++ {
+			typeutil.AppendFieldData(ret.FieldsData, data.GetFieldsData(), idx)
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(data.GetIds(), idx))
+			ret.Scores = append(ret.Scores, data.GetScores()[idx])
+		}
+		ret.Topks = append(ret.Topks, n)
+		offset += topk
+	}
+	return ret
+}
+
+// survivingSearchResultCounts returns the number of the results of every query of every data surviving the merge,
+// which are always a prefix of the results of the query as they are sorted by score.
+func survivingSearchResultCounts(data []*schemapb.SearchResultData, nq int64, topk int64) [][]int64 {
+	counts := make([][]int64, len(data))
+	resultOffsets := make([][]int64, len(data))
+	for i := range data {
+		counts[i] = make([]int64, nq)
+		resultOffsets[i] = make([]int64, len(data[i].GetTopks()))
+		for j := int64(1); j < int64(len(data[i].GetTopks())); j++ {
+			resultOffsets[i][j] = resultOffsets[i][j-1] + data[i].Topks[j-1]
+		}
+	}
+	for i := int64(0); i < nq; i++ {
+		offsets := make([]int64, len(data))
+		idSet := make(map[interface{}]struct{})
+		for j := int64(0); j < topk; {
+			sel := selectSearchResultData(data, resultOffsets, offsets, i)
+			if sel == -1 {
+				break
+			}
+			id := typeutil.GetPK(data[sel].GetIds(), resultOffsets[sel][i]+offsets[sel])
+			if _, ok := idSet[id]; !ok {
+				idSet[id] = struct{}{}
+				j++
+			}
+			offsets[sel]++
+		}
+		for k := range data {
+			counts[k][i] = offsets[k]
+		}
+	}
+	return counts
+}
+
+// useAdaptiveReduce returns whether the search dispatched to the followers is reduced in two phases, which pays off
+// only if there are output fields to save and more than one follower whose results compete.
+func useAdaptiveReduce(req *querypb.SearchRequest, followers int) bool {
+	return Params.QueryNodeCfg.AdaptiveReduceEnabled.GetAsBool() &&
+		followers > 1 &&
+		len(req.GetReq().GetOutputFieldsId()) > 0 &&
+		req.GetReq().GetNq()*req.GetReq().GetTopk() >= Params.QueryNodeCfg.AdaptiveReduceMinEntries.GetAsInt64()
+}
+
+// searchPhaseResult is the result of the ScoresOnly phase of a follower.
+type searchPhaseResult struct {
+	node   *shardNode
+	req    *querypb.SearchRequest
+	result *internalpb.SearchResults
+}
+
+// fetchSurvivingResults merges the results of the ScoresOnly phase, and fetches the full results surviving the merge
+// from the followers. The followers with no surviving result are skipped.
+func fetchSurvivingResults(ctx context.Context, phaseResults []*searchPhaseResult, nq int64, topk int64) ([]*internalpb.SearchResults, error) {
+	ret := make([]*internalpb.SearchResults, 0, len(phaseResults))
+	data := make([]*schemapb.SearchResultData, 0, len(phaseResults))
+	toFetch := make([]*searchPhaseResult, 0, len(phaseResults))
+	for _, phaseResult := range phaseResults {
+		if phaseResult.result.GetSlicedBlob() == nil {
+			ret = append(ret, phaseResult.result)
+			continue
+		}
+		partial := &schemapb.SearchResultData{}
+		if err := proto.Unmarshal(phaseResult.result.GetSlicedBlob(), partial); err != nil {
+			return nil, err
+		}
+		data = append(data, partial)
+		// the full results of a follower not knowing the phases
+		if len(partial.GetFieldsData()) > 0 {
+			ret = append(ret, phaseResult.result)
+			toFetch = append(toFetch, nil)
+			continue
+		}
+		toFetch = append(toFetch, phaseResult)
+	}
+
+	counts := survivingSearchResultCounts(data, nq, topk)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var err error
+	for i, phaseResult := range toFetch {
+		if phaseResult == nil || !hasSurvivingResult(counts[i]) {
+			continue
+		}
+		fetchReq := typeutil.Clone(phaseResult.req)
+		fetchReq.SearchPhase = querypb.SearchPhase_FetchSurviving
+		fetchReq.FetchCounts = counts[i]
+		phaseResult := phaseResult
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fetched, fetchErr := phaseResult.node.client.Search(ctx, fetchReq)
+			mu.Lock()
+			defer mu.Unlock()
+			if fetchErr != nil || fetched.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				if err == nil {
+					err = fmt.Errorf("Search %d fetch phase failed, reason %s err %w", phaseResult.node.nodeID, fetched.GetStatus().GetReason(), fetchErr)
+				}
+				return
+			}
+			// the filter statistics are collected by the ScoresOnly phase
+			fetched.FilterStats = phaseResult.result.GetFilterStats()
+			ret = append(ret, fetched)
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func hasSurvivingResult(counts []int64) bool {
+	for _, count := range counts {
+		if count > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// searchFullResults searches the followers of the ScoresOnly phase again in one phase,
+// which is the fallback if the full results of the ScoresOnly phase can't be fetched.
+func searchFullResults(ctx context.Context, phaseResults []*searchPhaseResult) ([]*internalpb.SearchResults, error) {
+	ret := make([]*internalpb.SearchResults, 0, len(phaseResults))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var err error
+	for _, phaseResult := range phaseResults {
+		searchReq := typeutil.Clone(phaseResult.req)
+		searchReq.SearchPhase = querypb.SearchPhase_FullResults
+		node := phaseResult.node
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, searchErr := node.client.Search(ctx, searchReq)
+			mu.Lock()
+			defer mu.Unlock()
+			if searchErr != nil || result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+				if err == nil {
+					err = fmt.Errorf("Search %d failed, reason %s err %w", node.nodeID, result.GetStatus().GetReason(), searchErr)
+				}
+				return
+			}
+			ret = append(ret, result)
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
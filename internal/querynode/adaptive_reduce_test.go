@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// newPhaseTestData returns the search results of the ids with an output field of ten times the ids.
+func newPhaseTestData(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+	values := make([]int64, len(ids))
+	for i, id := range ids {
+		values[i] = id * 10
+	}
+	return &schemapb.SearchResultData{
+		NumQueries: int64(len(topks)),
+		TopK:       2,
+		FieldsData: []*schemapb.FieldData{{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "f",
+			FieldId:   101,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+			}},
+		}},
+		Scores: scores,
+		Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+		Topks:  topks,
+	}
+}
+
+// phaseFollower serves the search phases like a follower QueryNode whose search returns the full results.
+type phaseFollower struct {
+	mockShardQueryNode
+	node     *QueryNode
+	full     *schemapb.SearchResultData
+	searched int
+	fetched  int
+}
+
+func (f *phaseFollower) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	if req.GetSearchPhase() == querypb.SearchPhase_FetchSurviving {
+		f.fetched++
+		return f.node.fetchSearchPhaseResult(req)
+	}
+	f.searched++
+	ret, err := encodeSearchResultData(f.full, req.GetReq().GetNq(), req.GetReq().GetTopk(), "IP", internalpb.ResultPrecision_Float32)
+	if err != nil {
+		return nil, err
+	}
+	return ret, f.node.applySearchPhase(req, ret)
+}
+
+func TestSearchPhaseCache(t *testing.T) {
+	cache := newSearchPhaseCache()
+	data := &schemapb.SearchResultData{}
+	cache.put("a", data, "L2", time.Minute)
+	cached, ok := cache.take("a")
+	require.True(t, ok)
+	assert.Same(t, data, cached.data)
+	assert.Equal(t, "L2", cached.metricType)
+	_, ok = cache.take("a")
+	assert.False(t, ok)
+
+	cache.put("expired", data, "L2", -time.Second)
+	_, ok = cache.take("expired")
+	assert.False(t, ok)
+
+	for i := 0; i <= maxCachedSearchPhaseResults; i++ {
+		cache.put(string(rune('a'+i)), data, "L2", time.Minute)
+	}
+	assert.Equal(t, maxCachedSearchPhaseResults, len(cache.results))
+	assert.Equal(t, maxCachedSearchPhaseResults, len(cache.keys))
+	// the oldest is dropped
+	_, ok = cache.take("a")
+	assert.False(t, ok)
+	_, ok = cache.take("b")
+	assert.True(t, ok)
+
+	var nilCache *searchPhaseCache
+	nilCache.put("a", data, "L2", time.Minute)
+	_, ok = nilCache.take("a")
+	assert.False(t, ok)
+}
+
+func TestTruncateSearchResultData(t *testing.T) {
+	data := newPhaseTestData([]int64{1, 2, 3, 4}, []float32{0.9, 0.5, 0.8, 0.7}, []int64{2, 2})
+	ret := truncateSearchResultData(data, []int64{1, 5})
+	assert.Equal(t, []int64{1, 2}, ret.GetTopks())
+	assert.Equal(t, []int64{1, 3, 4}, ret.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.9, 0.8, 0.7}, ret.GetScores())
+	assert.Equal(t, []int64{10, 30, 40}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	ret = truncateSearchResultData(data, nil)
+	assert.Equal(t, []int64{0, 0}, ret.GetTopks())
+	assert.Empty(t, ret.GetScores())
+}
+
+func TestFetchSurvivingResults(t *testing.T) {
+	// nq 2, topk 2, the id 3 of the second query is returned by both a and b
+	a := newPhaseTestData([]int64{1, 2, 3, 4}, []float32{0.9, 0.5, 0.8, 0.7}, []int64{2, 2})
+	b := newPhaseTestData([]int64{5, 6, 3, 7}, []float32{0.8, 0.7, 0.9, 0.1}, []int64{2, 2})
+	c := newPhaseTestData([]int64{8, 9}, []float32{0.1, 0.05}, []int64{1, 1})
+	assert.Equal(t, [][]int64{{1, 2}, {1, 1}, {0, 0}}, survivingSearchResultCounts([]*schemapb.SearchResultData{a, b, c}, 2, 2))
+
+	req := &querypb.SearchRequest{
+		Req:         &internalpb.SearchRequest{Base: &commonpb.MsgBase{MsgID: 1}, Nq: 2, Topk: 2, OutputFieldsId: []int64{101}},
+		DmlChannels: []string{"ch"},
+	}
+	followers := []*phaseFollower{
+		{node: &QueryNode{searchPhaseCache: newSearchPhaseCache()}, full: a},
+		{node: &QueryNode{searchPhaseCache: newSearchPhaseCache()}, full: b},
+		{node: &QueryNode{searchPhaseCache: newSearchPhaseCache()}, full: c},
+	}
+
+	ctx := context.Background()
+	scoresReq := proto.Clone(req).(*querypb.SearchRequest)
+	scoresReq.SearchPhase = querypb.SearchPhase_ScoresOnly
+	phaseResults := make([]*searchPhaseResult, 0, len(followers))
+	for i, follower := range followers {
+		result, err := follower.Search(ctx, scoresReq)
+		require.NoError(t, err)
+		// the scores phase carries no output field
+		data := &schemapb.SearchResultData{}
+		require.NoError(t, proto.Unmarshal(result.GetSlicedBlob(), data))
+		assert.Empty(t, data.GetFieldsData())
+		assert.Equal(t, follower.full.GetIds().GetIntId().GetData(), data.GetIds().GetIntId().GetData())
+		phaseResults = append(phaseResults, &searchPhaseResult{
+			node:   &shardNode{nodeID: int64(i), client: follower},
+			req:    scoresReq,
+			result: result,
+		})
+	}
+
+	results, err := fetchSurvivingResults(ctx, phaseResults, 2, 2)
+	require.NoError(t, err)
+	// c has no surviving result
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, 1, followers[0].fetched)
+	assert.Equal(t, 1, followers[1].fetched)
+	assert.Equal(t, 0, followers[2].fetched)
+	// the fetches are served by the cached results
+	for _, follower := range followers {
+		assert.Equal(t, 1, follower.searched)
+	}
+
+	fetched, err := reduceSearchResults(ctx, results, 2, 2, "IP", internalpb.ResultPrecision_Float32)
+	require.NoError(t, err)
+	full, err := reduceSearchResultData(ctx, []*schemapb.SearchResultData{a, b, c}, 2, 2)
+	require.NoError(t, err)
+	fetchedData := &schemapb.SearchResultData{}
+	require.NoError(t, proto.Unmarshal(fetched.GetSlicedBlob(), fetchedData))
+	assert.Equal(t, full.GetIds().GetIntId().GetData(), fetchedData.GetIds().GetIntId().GetData())
+	assert.Equal(t, full.GetScores(), fetchedData.GetScores())
+	assert.Equal(t, full.GetFieldsData()[0].GetScalars().GetLongData().GetData(),
+		fetchedData.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	t.Run("cache missed", func(t *testing.T) {
+		follower := &phaseFollower{node: &QueryNode{}, full: a}
+		result, err := follower.Search(ctx, scoresReq)
+		require.NoError(t, err)
+		phaseResults := []*searchPhaseResult{{node: &shardNode{client: follower}, req: scoresReq, result: result}}
+		// the full results are not cached by a nil cache
+		_, err = fetchSurvivingResults(ctx, phaseResults, 2, 2)
+		assert.Error(t, err)
+		assert.Equal(t, 1, follower.fetched)
+
+		// the fallback searches the followers in one phase
+		results, err := searchFullResults(ctx, phaseResults)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(results))
+		data := &schemapb.SearchResultData{}
+		require.NoError(t, proto.Unmarshal(results[0].GetSlicedBlob(), data))
+		assert.Equal(t, a.GetIds().GetIntId().GetData(), data.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20, 30, 40}, data.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, 2, follower.searched)
+	})
+
+	t.Run("full results", func(t *testing.T) {
+		follower := &phaseFollower{node: &QueryNode{}, full: a}
+		// a follower not knowing the phases
+		result, err := encodeSearchResultData(a, 2, 2, "IP", internalpb.ResultPrecision_Float32)
+		require.NoError(t, err)
+		results, err := fetchSurvivingResults(ctx, []*searchPhaseResult{
+			{node: &shardNode{client: follower}, req: req, result: result},
+			{node: &shardNode{client: follower}, req: req, result: &internalpb.SearchResults{}},
+		}, 2, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(results))
+		assert.Equal(t, 0, follower.fetched)
+	})
+}
+
+func TestUseAdaptiveReduce(t *testing.T) {
+	req := &querypb.SearchRequest{Req: &internalpb.SearchRequest{Nq: 10, Topk: 100, OutputFieldsId: []int64{101}}}
+	assert.False(t, useAdaptiveReduce(req, 2))
+
+	paramtable.Get().Save(Params.QueryNodeCfg.AdaptiveReduceEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.AdaptiveReduceEnabled.Key)
+	assert.True(t, useAdaptiveReduce(req, 2))
+	assert.False(t, useAdaptiveReduce(req, 1))
+	assert.False(t, useAdaptiveReduce(&querypb.SearchRequest{Req: &internalpb.SearchRequest{Nq: 10, Topk: 100}}, 2))
+	assert.False(t, useAdaptiveReduce(&querypb.SearchRequest{Req: &internalpb.SearchRequest{Nq: 1, Topk: 10, OutputFieldsId: []int64{101}}}, 2))
+}
@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// equalPredicates are the `field == value` terms of the top level conjunction of a filter,
+// a row must match all of them to match the filter.
+type equalPredicates map[int64]*planpb.GenericValue
+
+// parseEqualPredicates collects the equal predicates of the filter of a serialized search or retrieve plan.
+// nil is returned if the plan can't be parsed, nothing is pruned then.
+func parseEqualPredicates(serializedPlan []byte) equalPredicates {
+	if len(serializedPlan) == 0 {
+		return nil
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil
+	}
+	expr := plan.GetPredicates()
+	if plan.GetVectorAnns() != nil {
+		expr = plan.GetVectorAnns().GetPredicates()
+	}
+	eqs := make(equalPredicates)
+	eqs.collect(expr)
+	if len(eqs) == 0 {
+		return nil
+	}
+	return eqs
+}
+
+func (eqs equalPredicates) collect(expr *planpb.Expr) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		if e.BinaryExpr.GetOp() == planpb.BinaryExpr_LogicalAnd {
+			eqs.collect(e.BinaryExpr.GetLeft())
+			eqs.collect(e.BinaryExpr.GetRight())
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetOp() == planpb.OpType_Equal {
+			eqs[e.UnaryRangeExpr.GetColumnInfo().GetFieldId()] = e.UnaryRangeExpr.GetValue()
+		}
+	case *planpb.Expr_TermExpr:
+		if len(e.TermExpr.GetValues()) == 1 {
+			eqs[e.TermExpr.GetColumnInfo().GetFieldId()] = e.TermExpr.GetValues()[0]
+		}
+	}
+}
+
+// compositeIndex is the composite scalar index of a sealed segment, made of the distinct key tuples
+// of the segment sorted by the covered fields in order.
+type compositeIndex struct {
+	keys *storage.CompositeIndexKeys
+}
+
+// compareKey compares the value of the column of the i-th key with the value,
+// ok is false if they are not comparable.
+func compareKey(column *storage.CompositeKeyColumn, i int, value *planpb.GenericValue) (ret int, ok bool) {
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		if len(column.Bools) == 0 {
+			return 0, false
+		}
+		switch {
+		case column.Bools[i] == v.BoolVal:
+			return 0, true
+		case !column.Bools[i]:
+			return -1, true
+		default:
+			return 1, true
+		}
+	case *planpb.GenericValue_Int64Val:
+		if len(column.Ints) == 0 {
+			return 0, false
+		}
+		switch {
+		case column.Ints[i] < v.Int64Val:
+			return -1, true
+		case column.Ints[i] > v.Int64Val:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case *planpb.GenericValue_StringVal:
+		if len(column.Strings) == 0 {
+			return 0, false
+		}
+		return strings.Compare(column.Strings[i], v.StringVal), true
+	default:
+		// float values are not compared, segcore compares float fields in their own precision
+		return 0, false
+	}
+}
+
+// mayMatch returns false if no key of the index matches the equal predicates on the leading fields.
+// Only the longest prefix of the covered fields with comparable equal predicates is looked up.
+func (idx *compositeIndex) mayMatch(eqs equalPredicates) bool {
+	keys := idx.keys
+	prefix := make([]*planpb.GenericValue, 0, len(keys.FieldIDs))
+	for i, fieldID := range keys.FieldIDs {
+		value, ok := eqs[fieldID]
+		if !ok {
+			break
+		}
+		if keys.NumKeys > 0 {
+			if _, ok := compareKey(keys.Columns[i], 0, value); !ok {
+				break
+			}
+		}
+		prefix = append(prefix, value)
+	}
+	if len(prefix) == 0 {
+		return true
+	}
+
+	comparePrefix := func(i int) int {
+		for j, value := range prefix {
+			if ret, _ := compareKey(keys.Columns[j], i, value); ret != 0 {
+				return ret
+			}
+		}
+		return 0
+	}
+	i := sort.Search(keys.NumKeys, func(i int) bool {
+		return comparePrefix(i) >= 0
+	})
+	return i < keys.NumKeys && comparePrefix(i) == 0
+}
+
+func (s *Segment) addCompositeIndex(idx *compositeIndex) {
+	s.compositeMut.Lock()
+	defer s.compositeMut.Unlock()
+	s.compositeIndexes = append(s.compositeIndexes, idx)
+}
+
+// mayMatch returns false if a composite index of the segment proves no row matches the equal predicates.
+func (s *Segment) mayMatch(eqs equalPredicates) bool {
+	if len(eqs) == 0 {
+		return true
+	}
+	s.compositeMut.RLock()
+	defer s.compositeMut.RUnlock()
+	for _, idx := range s.compositeIndexes {
+		if !idx.mayMatch(eqs) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func equalExpr(fieldID int64, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+		Op:         planpb.OpType_Equal,
+		Value:      value,
+	}}}
+}
+
+func int64Value(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func stringValue(v string) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+}
+
+func TestParseEqualPredicates(t *testing.T) {
+	and := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+		Op:    planpb.BinaryExpr_LogicalAnd,
+		Left:  equalExpr(100, int64Value(1)),
+		Right: equalExpr(101, stringValue("a")),
+	}}}
+	bs, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_Predicates{Predicates: and}})
+	assert.NoError(t, err)
+	eqs := parseEqualPredicates(bs)
+	assert.Equal(t, 2, len(eqs))
+	assert.Equal(t, int64(1), eqs[100].GetInt64Val())
+	assert.Equal(t, "a", eqs[101].GetStringVal())
+
+	or := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+		Op:    planpb.BinaryExpr_LogicalOr,
+		Left:  equalExpr(100, int64Value(1)),
+		Right: equalExpr(101, stringValue("a")),
+	}}}
+	bs, err = proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{Predicates: or}}})
+	assert.NoError(t, err)
+	assert.Nil(t, parseEqualPredicates(bs))
+
+	assert.Nil(t, parseEqualPredicates(nil))
+	assert.Nil(t, parseEqualPredicates([]byte{0xff}))
+}
+
+func TestCompositeIndex_MayMatch(t *testing.T) {
+	idx := &compositeIndex{keys: &storage.CompositeIndexKeys{
+		FieldIDs: []int64{100, 101},
+		Columns: []*storage.CompositeKeyColumn{
+			{Ints: []int64{1, 1, 2}},
+			{Strings: []string{"a", "c", "b"}},
+		},
+		NumKeys: 3,
+	}}
+
+	assert.True(t, idx.mayMatch(equalPredicates{100: int64Value(1)}))
+	assert.False(t, idx.mayMatch(equalPredicates{100: int64Value(3)}))
+	assert.True(t, idx.mayMatch(equalPredicates{100: int64Value(1), 101: stringValue("c")}))
+	assert.False(t, idx.mayMatch(equalPredicates{100: int64Value(2), 101: stringValue("a")}))
+	// the predicates not on a prefix of the covered fields can't be looked up
+	assert.True(t, idx.mayMatch(equalPredicates{101: stringValue("x")}))
+	// neither can the values of another type
+	assert.True(t, idx.mayMatch(equalPredicates{100: stringValue("x")}))
+
+	seg := &Segment{}
+	assert.True(t, seg.mayMatch(equalPredicates{100: int64Value(3)}))
+	seg.addCompositeIndex(idx)
+	assert.False(t, seg.mayMatch(equalPredicates{100: int64Value(3)}))
+	assert.True(t, seg.mayMatch(nil))
+}
@@ -20,6 +20,9 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
 )
 
 var (
@@ -39,6 +42,14 @@ func WrapErrTsLagTooLarge(duration time.Duration, maxLag time.Duration) error {
 	return fmt.Errorf("%w lag(%s) max(%s)", ErrTsLagTooLarge, duration, maxLag)
 }
 
+// queryErrorCode returns the error code of a failed query, OutOfMemory if its result size exceeded the limit.
+func queryErrorCode(err error) commonpb.ErrorCode {
+	if errors.Is(err, errorutil.ErrResultSizeExceeded) {
+		return commonpb.ErrorCode_OutOfMemory
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
+
 // msgQueryNodeIsUnhealthy is the error msg of unhealthy query node
 func msgQueryNodeIsUnhealthy(nodeID UniqueID) string {
 	return fmt.Sprintf("query node %d is not ready", nodeID)
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// filterStatsCollector counts the filter execution of a search or query on the segments,
+// it's shared by the goroutines searching the segments. A nil collector counts nothing.
+type filterStatsCollector struct {
+	filterFieldIDs []UniqueID
+
+	segmentsScanned    atomic.Int64
+	segmentsPruned     atomic.Int64
+	rowsScanned        atomic.Int64
+	rowsPassed         atomic.Int64
+	indexSegments      atomic.Int64
+	bruteForceSegments atomic.Int64
+}
+
+// newFilterStatsCollector returns a collector for the serialized plan of the request,
+// an invalid plan is counted as a plan without filter.
+func newFilterStatsCollector(serializedPlan []byte) *filterStatsCollector {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return &filterStatsCollector{}
+	}
+	predicates := plan.GetPredicates()
+	if predicates == nil {
+		predicates = plan.GetVectorAnns().GetPredicates()
+	}
+	return &filterStatsCollector{filterFieldIDs: getFilterFieldIDs(predicates)}
+}
+
+// scan counts the segment to filter, the filter takes the index branch
+// only if all the filtered fields of the segment have scalar indexes loaded.
+func (c *filterStatsCollector) scan(seg *Segment) {
+	if c == nil {
+		return
+	}
+	c.segmentsScanned.Inc()
+	if rowCount := seg.getRowCount(); rowCount > 0 {
+		c.rowsScanned.Add(rowCount)
+	}
+	if len(c.filterFieldIDs) == 0 {
+		return
+	}
+	if seg.getType() == segmentTypeSealed {
+		indexed := true
+		for _, fieldID := range c.filterFieldIDs {
+			if !seg.hasLoadIndexForIndexedField(fieldID) {
+				indexed = false
+				break
+			}
+		}
+		if indexed {
+			c.indexSegments.Inc()
+			return
+		}
+	}
+	c.bruteForceSegments.Inc()
+}
+
+// prune counts the segment skipped without filtering.
+func (c *filterStatsCollector) prune() {
+	if c == nil {
+		return
+	}
+	c.segmentsPruned.Inc()
+}
+
+// pass counts the rows passing the filter.
+func (c *filterStatsCollector) pass(rows int64) {
+	if c == nil {
+		return
+	}
+	c.rowsPassed.Add(rows)
+}
+
+func (c *filterStatsCollector) stats() *internalpb.FilterStats {
+	if c == nil {
+		return nil
+	}
+	return &internalpb.FilterStats{
+		SegmentsScanned:    c.segmentsScanned.Load(),
+		SegmentsPruned:     c.segmentsPruned.Load(),
+		RowsScanned:        c.rowsScanned.Load(),
+		RowsPassed:         c.rowsPassed.Load(),
+		IndexSegments:      c.indexSegments.Load(),
+		BruteForceSegments: c.bruteForceSegments.Load(),
+	}
+}
+
+// getFilterFieldIDs returns the distinct fields filtered by the expression.
+func getFilterFieldIDs(expr *planpb.Expr) []UniqueID {
+	fieldIDs := make([]UniqueID, 0)
+	visited := make(map[UniqueID]struct{})
+	addColumn := func(info *planpb.ColumnInfo) {
+		if info == nil {
+			return
+		}
+		if _, ok := visited[info.GetFieldId()]; !ok {
+			visited[info.GetFieldId()] = struct{}{}
+			fieldIDs = append(fieldIDs, info.GetFieldId())
+		}
+	}
+	var visit func(expr *planpb.Expr)
+	visit = func(expr *planpb.Expr) {
+		switch e := expr.GetExpr().(type) {
+		case *planpb.Expr_TermExpr:
+			addColumn(e.TermExpr.GetColumnInfo())
+		case *planpb.Expr_UnaryRangeExpr:
+			addColumn(e.UnaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_BinaryRangeExpr:
+			addColumn(e.BinaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+			addColumn(e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+		case *planpb.Expr_CompareExpr:
+			addColumn(e.CompareExpr.GetLeftColumnInfo())
+			addColumn(e.CompareExpr.GetRightColumnInfo())
+		case *planpb.Expr_ColumnExpr:
+			addColumn(e.ColumnExpr.GetInfo())
+		case *planpb.Expr_UnaryExpr:
+			visit(e.UnaryExpr.GetChild())
+		case *planpb.Expr_BinaryExpr:
+			visit(e.BinaryExpr.GetLeft())
+			visit(e.BinaryExpr.GetRight())
+		case *planpb.Expr_BinaryArithExpr:
+			visit(e.BinaryArithExpr.GetLeft())
+			visit(e.BinaryArithExpr.GetRight())
+		}
+	}
+	visit(expr)
+	return fieldIDs
+}
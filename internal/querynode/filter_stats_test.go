@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+func TestGetFilterFieldIDs(t *testing.T) {
+	column := func(fieldID int64) *planpb.ColumnInfo {
+		return &planpb.ColumnInfo{FieldId: fieldID}
+	}
+	expr := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+		Left: &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{ColumnInfo: column(101)}}},
+		Right: &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{
+			Child: &planpb.Expr{Expr: &planpb.Expr_CompareExpr{CompareExpr: &planpb.CompareExpr{
+				LeftColumnInfo:  column(102),
+				RightColumnInfo: column(101),
+			}}},
+		}}},
+	}}}
+	assert.Equal(t, []UniqueID{101, 102}, getFilterFieldIDs(expr))
+	assert.Equal(t, []UniqueID{}, getFilterFieldIDs(nil))
+
+	bs, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{
+		FieldId:    106,
+		Predicates: &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{ColumnInfo: column(103)}}},
+	}}})
+	require.NoError(t, err)
+	assert.Equal(t, []UniqueID{103}, newFilterStatsCollector(bs).filterFieldIDs)
+	assert.Empty(t, newFilterStatsCollector([]byte("invalid")).filterFieldIDs)
+}
+
+func TestFilterStatsCollector(t *testing.T) {
+	var nilCollector *filterStatsCollector
+	nilCollector.prune()
+	nilCollector.pass(1)
+	assert.Nil(t, nilCollector.stats())
+
+	segment, err := genSimpleSealedSegment(defaultMsgLength)
+	require.NoError(t, err)
+	defer deleteSegment(segment)
+
+	planExpr, err := genSimpleRetrievePlanExpr(genTestCollectionSchema())
+	require.NoError(t, err)
+	collector := newFilterStatsCollector(planExpr)
+	collector.scan(segment)
+	collector.prune()
+	collector.pass(3)
+	assert.Equal(t, &internalpb.FilterStats{
+		SegmentsScanned:    1,
+		SegmentsPruned:     1,
+		RowsScanned:        int64(defaultMsgLength),
+		RowsPassed:         3,
+		BruteForceSegments: 1,
+	}, collector.stats())
+
+	// a plan without filter takes no branch
+	collector = newFilterStatsCollector(nil)
+	collector.scan(segment)
+	assert.Equal(t, int64(0), collector.stats().BruteForceSegments)
+}
+
+func TestReduceFilterStats(t *testing.T) {
+	ctx := context.Background()
+	searchResults := []*internalpb.SearchResults{
+		{FilterStats: &internalpb.FilterStats{SegmentsScanned: 1, RowsScanned: 10}},
+		{FilterStats: &internalpb.FilterStats{SegmentsScanned: 2, RowsScanned: 20}},
+		{},
+	}
+	ret, err := reduceSearchResults(ctx, searchResults, 1, 1, "L2", internalpb.ResultPrecision_Float32)
+	require.NoError(t, err)
+	assert.Equal(t, &internalpb.FilterStats{SegmentsScanned: 3, RowsScanned: 30}, ret.GetFilterStats())
+
+	retrieveResults := []*internalpb.RetrieveResults{
+		{FilterStats: &internalpb.FilterStats{SegmentsScanned: 1, RowsPassed: 5}},
+		{FilterStats: &internalpb.FilterStats{SegmentsPruned: 1}},
+	}
+	merged, err := mergeInternalRetrieveResult(ctx, retrieveResults, -1)
+	require.NoError(t, err)
+	assert.Equal(t, &internalpb.FilterStats{SegmentsScanned: 1, SegmentsPruned: 1, RowsPassed: 5}, merged.GetFilterStats())
+
+	merged, err = mergeInternalRetrieveResult(ctx, retrieveResults[:0], -1)
+	require.NoError(t, err)
+	assert.Nil(t, merged.GetFilterStats())
+}
+
+func TestSearchTask_FilterStats(t *testing.T) {
+	task := &searchTask{
+		baseReadTask: baseReadTask{baseTask: baseTask{ctx: context.Background()}},
+		iReq:         &internalpb.SearchRequest{},
+	}
+	other := &searchTask{
+		baseReadTask: baseReadTask{baseTask: baseTask{ctx: context.Background()}},
+		iReq:         &internalpb.SearchRequest{FilterStats: true},
+	}
+	assert.Nil(t, task.newFilterStatsCollector())
+
+	task.otherTasks = []*searchTask{other}
+	collector := task.newFilterStatsCollector()
+	require.NotNil(t, collector)
+	collector.prune()
+
+	task.Ret = &internalpb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	other.Ret = &internalpb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	task.fillInFilterStats(collector)
+	assert.Nil(t, task.Ret.GetFilterStats())
+	assert.Equal(t, int64(1), other.Ret.GetFilterStats().GetSegmentsPruned())
+}
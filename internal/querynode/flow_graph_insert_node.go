@@ -327,7 +327,8 @@ func processDeleteMessages(replica ReplicaInterface, segType segmentType, msg *m
 		}
 	}
 
-	primaryKeys := storage.ParseIDs2PrimaryKeys(msg.PrimaryKeys)
+	// sort the pks once, so that each segment only checks the pks inside its pk range
+	primaryKeys, timestamps := sortPKsWithTimestamps(storage.ParseIDs2PrimaryKeys(msg.PrimaryKeys), msg.Timestamps)
 	for _, segmentID := range resultSegmentIDs {
 		segment, err := replica.getSegmentByID(segmentID, segType)
 		if err != nil {
@@ -340,7 +341,7 @@ func processDeleteMessages(replica ReplicaInterface, segType segmentType, msg *m
 			}
 			return err
 		}
-		pks, tss, err := filterSegmentsByPKs(primaryKeys, msg.Timestamps, segment)
+		pks, tss, err := filterSegmentsByPKs(primaryKeys, timestamps, segment)
 		if err != nil {
 			return err
 		}
@@ -360,15 +361,57 @@ func filterSegmentsByPKs(pks []primaryKey, timestamps []Timestamp, segment *Segm
 
 	retPks := make([]primaryKey, 0)
 	retTss := make([]Timestamp, 0)
-	for index, pk := range pks {
-		if segment.isPKExist(pk) {
-			retPks = append(retPks, pk)
+	hits := segment.batchPKExist(pks, isPKsSorted(pks))
+	for index, hit := range hits {
+		if hit {
+			retPks = append(retPks, pks[index])
 			retTss = append(retTss, timestamps[index])
 		}
 	}
 	return retPks, retTss, nil
 }
 
+// isPKsSorted checks whether the pks are sorted in ascending order
+func isPKsSorted(pks []primaryKey) bool {
+	for i := 1; i < len(pks); i++ {
+		if pks[i].LT(pks[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortPKsWithTimestamps returns the pks sorted in ascending order together with their timestamps,
+// the order of the same pk is kept and the input slices are not modified
+func sortPKsWithTimestamps(pks []primaryKey, timestamps []Timestamp) ([]primaryKey, []Timestamp) {
+	if len(pks) != len(timestamps) || isPKsSorted(pks) {
+		return pks, timestamps
+	}
+
+	order := make([]int, len(pks))
+	for i := range order {
+		order[i] = i
+	}
+	if pks[0].Type() == schemapb.DataType_Int64 {
+		// compare the values directly instead of through the interface
+		values := make([]int64, len(pks))
+		for i, pk := range pks {
+			values[i] = pk.(*int64PrimaryKey).Value
+		}
+		sort.SliceStable(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+	} else {
+		sort.SliceStable(order, func(i, j int) bool { return pks[order[i]].LT(pks[order[j]]) })
+	}
+
+	sortedPks := make([]primaryKey, len(pks))
+	sortedTss := make([]Timestamp, len(timestamps))
+	for i, index := range order {
+		sortedPks[i] = pks[index]
+		sortedTss[i] = timestamps[index]
+	}
+	return sortedPks, sortedTss
+}
+
 // insert would execute insert operations for specific growing segment
 func (iNode *insertNode) insert(iData *insertData, segmentID UniqueID) error {
 	log := log.With(
@@ -18,6 +18,7 @@ package querynode
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -514,3 +515,96 @@ func TestFilterGrowingSegmentsByPKs(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestSortPKsWithTimestamps(t *testing.T) {
+	t.Run("int64 pks", func(t *testing.T) {
+		pks := []primaryKey{newInt64PrimaryKey(3), newInt64PrimaryKey(1), newInt64PrimaryKey(2), newInt64PrimaryKey(1)}
+		tss := []Timestamp{30, 10, 20, 11}
+		sortedPks, sortedTss := sortPKsWithTimestamps(pks, tss)
+		assert.True(t, isPKsSorted(sortedPks))
+		assert.Equal(t, []Timestamp{10, 11, 20, 30}, sortedTss)
+		// the input is not modified
+		assert.Equal(t, []Timestamp{30, 10, 20, 11}, tss)
+		assert.False(t, isPKsSorted(pks))
+	})
+
+	t.Run("varchar pks", func(t *testing.T) {
+		pks := []primaryKey{newVarCharPrimaryKey("b"), newVarCharPrimaryKey("c"), newVarCharPrimaryKey("a")}
+		tss := []Timestamp{2, 3, 1}
+		sortedPks, sortedTss := sortPKsWithTimestamps(pks, tss)
+		assert.True(t, isPKsSorted(sortedPks))
+		assert.Equal(t, []Timestamp{1, 2, 3}, sortedTss)
+	})
+
+	t.Run("sorted or mismatched", func(t *testing.T) {
+		pks := []primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(2)}
+		sortedPks, _ := sortPKsWithTimestamps(pks, []Timestamp{1, 2})
+		assert.Equal(t, pks, sortedPks)
+		sortedPks, _ = sortPKsWithTimestamps([]primaryKey{newInt64PrimaryKey(2), newInt64PrimaryKey(1)}, []Timestamp{1})
+		assert.False(t, isPKsSorted(sortedPks))
+		sortedPks, _ = sortPKsWithTimestamps(nil, nil)
+		assert.Empty(t, sortedPks)
+	})
+}
+
+// benchDeleteSegments returns 100 sealed segments each holds a disjoint 1% of the pk range,
+// and a million tombstones spread over the pk range
+func benchDeleteSegments(b *testing.B) ([]*Segment, []primaryKey, []Timestamp) {
+	const tombstoneNum = 1000000
+	const segmentNum = 100
+	const rowsPerSegment = 10000
+	segments := make([]*Segment, 0, segmentNum)
+	buf := make([]byte, 8)
+	for i := 0; i < segmentNum; i++ {
+		filter := bloom.NewWithEstimates(storage.BloomFilterSize, storage.MaxBloomFalsePositive)
+		for pk := i * rowsPerSegment; pk < (i+1)*rowsPerSegment; pk++ {
+			common.Endian.PutUint64(buf, uint64(pk))
+			filter.Add(buf)
+		}
+		segment := &Segment{
+			segmentID:   int64(i),
+			segmentType: atomic.NewInt32(0),
+			historyStats: []*storage.PkStatistics{{
+				PkFilter: filter,
+				MinPK:    storage.NewInt64PrimaryKey(int64(i * rowsPerSegment)),
+				MaxPK:    storage.NewInt64PrimaryKey(int64((i+1)*rowsPerSegment - 1)),
+			}},
+		}
+		segment.setType(commonpb.SegmentState_Sealed)
+		segments = append(segments, segment)
+	}
+	pks := make([]primaryKey, tombstoneNum)
+	tss := make([]Timestamp, tombstoneNum)
+	for i := range pks {
+		pks[i] = newInt64PrimaryKey(rand.Int63n(segmentNum * rowsPerSegment))
+		tss[i] = Timestamp(i)
+	}
+	return segments, pks, tss
+}
+
+func BenchmarkFilterSegmentsByPKs_PerPK(b *testing.B) {
+	segments, pks, tss := benchDeleteSegments(b)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, segment := range segments {
+			for i, pk := range pks {
+				if segment.isPKExist(pk) {
+					_ = tss[i]
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkFilterSegmentsByPKs_Batch(b *testing.B) {
+	segments, pks, tss := benchDeleteSegments(b)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		sortedPks, sortedTss := sortPKsWithTimestamps(pks, tss)
+		for _, segment := range segments {
+			if _, _, err := filterSegmentsByPKs(sortedPks, sortedTss, segment); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
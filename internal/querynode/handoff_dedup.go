@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type servedSealedSegmentsKey struct{}
+
+// withServedSealedSegments returns a context carrying the sealed segments of the shard cluster version
+// a read is pinned to, which are searched or queried on the nodes of the allocation.
+func withServedSealedSegments(ctx context.Context, segAllocs map[int64][]int64) context.Context {
+	sealed := typeutil.NewUniqueSet()
+	for _, segmentIDs := range segAllocs {
+		sealed.Insert(segmentIDs...)
+	}
+	return context.WithValue(ctx, servedSealedSegmentsKey{}, sealed)
+}
+
+// servedSealedSegments returns the sealed segments carried by the context, nil if the read isn't dispatched
+// by a shard cluster.
+func servedSealedSegments(ctx context.Context) typeutil.UniqueSet {
+	sealed, _ := ctx.Value(servedSealedSegmentsKey{}).(typeutil.UniqueSet)
+	return sealed
+}
+
+// excludeHandedOffSegments removes the growing segments whose sealed segments are served already.
+// While a segment is handed off, the sealed segment is loaded before the growing one is released,
+// reading both copies of the same rows would return them twice.
+func excludeHandedOffSegments(ctx context.Context, growingIDs []UniqueID) []UniqueID {
+	sealed := servedSealedSegments(ctx)
+	if sealed.Len() == 0 || !Params.QueryNodeCfg.HandoffDedupEnabled.GetAsBool() {
+		return growingIDs
+	}
+	ret := make([]UniqueID, 0, len(growingIDs))
+	var excluded []UniqueID
+	for _, segmentID := range growingIDs {
+		if sealed.Contain(segmentID) {
+			excluded = append(excluded, segmentID)
+			continue
+		}
+		ret = append(ret, segmentID)
+	}
+	if len(excluded) > 0 {
+		log.Ctx(ctx).Debug("skip the growing segments served as sealed segments", zap.Int64s("segmentIDs", excluded))
+	}
+	return ret
+}
+
+// sameServedSealedSegments checks whether two reads are pinned to the same sealed segments,
+// only then they read the same growing segments and can be merged.
+func sameServedSealedSegments(ctx1, ctx2 context.Context) bool {
+	s1, s2 := servedSealedSegments(ctx1), servedSealedSegments(ctx2)
+	if s1.Len() != s2.Len() {
+		return false
+	}
+	for segmentID := range s1 {
+		if !s2.Contain(segmentID) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestExcludeHandedOffSegments(t *testing.T) {
+	paramtable.Get().Save(Params.QueryNodeCfg.HandoffDedupEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.HandoffDedupEnabled.Key)
+	ctx := context.Background()
+	growing := []UniqueID{1, 2, 3}
+	assert.Equal(t, growing, excludeHandedOffSegments(ctx, growing))
+
+	ctx1 := withServedSealedSegments(ctx, map[int64][]int64{1: {2}, 2: {4, 5}})
+	assert.Equal(t, typeutil.NewUniqueSet(2, 4, 5), servedSealedSegments(ctx1))
+	assert.Equal(t, []UniqueID{1, 3}, excludeHandedOffSegments(ctx1, growing))
+
+	paramtable.Get().Save(Params.QueryNodeCfg.HandoffDedupEnabled.Key, "false")
+	assert.Equal(t, growing, excludeHandedOffSegments(ctx1, growing))
+
+	ctx2 := withServedSealedSegments(ctx, map[int64][]int64{3: {5, 4, 2}})
+	ctx3 := withServedSealedSegments(ctx, map[int64][]int64{1: {2, 4}})
+	assert.True(t, sameServedSealedSegments(ctx1, ctx2))
+	assert.False(t, sameServedSealedSegments(ctx1, ctx3))
+	assert.False(t, sameServedSealedSegments(ctx1, ctx))
+	assert.True(t, sameServedSealedSegments(ctx, context.Background()))
+}
+
+func TestShardCluster_ServedSealedSegments(t *testing.T) {
+	vchannelName := "dml_1_1_v0"
+	nodeEvents := []nodeEvent{
+		{nodeID: 1, nodeAddr: "addr_1"},
+		{nodeID: 2, nodeAddr: "addr_2"},
+	}
+	segmentEvents := []segmentEvent{
+		{segmentID: 1, nodeIDs: []int64{1}, state: segmentStateLoaded},
+		{segmentID: 2, nodeIDs: []int64{2}, state: segmentStateLoaded},
+		{segmentID: 3, nodeIDs: []int64{2}, state: segmentStateLoaded},
+	}
+	sc := NewShardCluster(1, 0, vchannelName, 1,
+		&mockNodeDetector{initNodes: nodeEvents}, &mockSegmentDetector{initSegments: segmentEvents}, buildMockQueryNode)
+	defer sc.Close()
+	sc.SetupFirstVersion()
+	setupSegmentForShardCluster(sc, segmentEvents)
+	require.EqualValues(t, available, sc.state.Load())
+
+	var sealed typeutil.UniqueSet
+	withStreaming := func(ctx context.Context) error {
+		sealed = servedSealedSegments(ctx)
+		return nil
+	}
+	ctx := context.Background()
+
+	_, err := sc.Search(ctx, &querypb.SearchRequest{
+		Req:         &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+		DmlChannels: []string{vchannelName},
+	}, withStreaming)
+	require.NoError(t, err)
+	assert.Equal(t, typeutil.NewUniqueSet(1, 2, 3), sealed)
+
+	sealed = nil
+	_, err = sc.Query(ctx, &querypb.QueryRequest{
+		Req:         &internalpb.RetrieveRequest{Base: &commonpb.MsgBase{}},
+		DmlChannels: []string{vchannelName},
+	}, withStreaming)
+	require.NoError(t, err)
+	assert.Equal(t, typeutil.NewUniqueSet(1, 2, 3), sealed)
+
+	sealed = nil
+	_, err = sc.GetStatistics(ctx, &querypb.GetStatisticsRequest{
+		Req:         &internalpb.GetStatisticsRequest{Base: &commonpb.MsgBase{}},
+		DmlChannels: []string{vchannelName},
+	}, withStreaming)
+	require.NoError(t, err)
+	assert.Equal(t, typeutil.NewUniqueSet(1, 2, 3), sealed)
+}
+
+func TestImpl_HandoffDedup(t *testing.T) {
+	paramtable.Get().Save(Params.QueryNodeCfg.HandoffDedupEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.HandoffDedupEnabled.Key)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	// the growing segment is handed off, its sealed segment is served by node 1 already
+	handedOffSegmentID := defaultSegmentID + 1
+	err = node.metaReplica.addSegment(handedOffSegmentID, defaultPartitionID, defaultCollectionID, defaultDMLChannel,
+		defaultSegmentVersion, defaultSegmentStartPosition, segmentTypeGrowing)
+	require.NoError(t, err)
+	col, err := node.metaReplica.getCollectionByID(defaultCollectionID)
+	require.NoError(t, err)
+	col.addVChannels([]Channel{defaultDMLChannel})
+
+	segmentEvents := []segmentEvent{
+		{segmentID: handedOffSegmentID, partitionID: defaultPartitionID, nodeIDs: []int64{1}, state: segmentStateLoaded},
+	}
+	sc := NewShardCluster(defaultCollectionID, defaultReplicaID, defaultDMLChannel, defaultVersion,
+		&mockNodeDetector{initNodes: []nodeEvent{{nodeID: 1, nodeAddr: "addr_1"}}},
+		&mockSegmentDetector{initSegments: segmentEvents}, buildMockQueryNode)
+	defer sc.Close()
+	sc.SetupFirstVersion()
+	setupSegmentForShardCluster(sc, segmentEvents)
+	require.EqualValues(t, available, sc.state.Load())
+	node.queryShardService.addQueryShard(defaultCollectionID, defaultDMLChannel, defaultReplicaID)
+	node.ShardClusterService.clusters.Store(defaultDMLChannel, sc)
+
+	schema := genTestCollectionSchema()
+	// the mocked workers return no filter statistics, the scanned segments are the growing segments searched
+	// or queried by the streaming task of the shard leader
+	scannedGrowingSegments := func() (int64, int64) {
+		searchReq, err := genSearchRequest(defaultNQ, IndexFaissIDMap, schema)
+		require.NoError(t, err)
+		searchReq.FilterStats = true
+		searchRet, err := node.searchWithDmlChannel(ctx, &querypb.SearchRequest{
+			Req:         searchReq,
+			DmlChannels: []string{defaultDMLChannel},
+		}, defaultDMLChannel)
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, searchRet.GetStatus().GetErrorCode())
+
+		queryReq, err := genRetrieveRequest(schema)
+		require.NoError(t, err)
+		queryReq.FilterStats = true
+		queryRet, err := node.queryWithDmlChannel(ctx, &querypb.QueryRequest{
+			Req:         queryReq,
+			DmlChannels: []string{defaultDMLChannel},
+		}, defaultDMLChannel)
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, queryRet.GetStatus().GetErrorCode())
+		return searchRet.GetFilterStats().GetSegmentsScanned(), queryRet.GetFilterStats().GetSegmentsScanned()
+	}
+
+	searched, queried := scannedGrowingSegments()
+	assert.EqualValues(t, 0, searched)
+	assert.EqualValues(t, 0, queried)
+
+	paramtable.Get().Save(Params.QueryNodeCfg.HandoffDedupEnabled.Key, "false")
+	searched, queried = scannedGrowingSegments()
+	assert.EqualValues(t, 1, searched)
+	assert.EqualValues(t, 1, queried)
+}
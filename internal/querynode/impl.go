@@ -745,6 +745,17 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		},
 	}
 
+	// the FetchSurviving phase of the adaptive reduce is served by the cached results of the ScoresOnly phase
+	if req.GetFromShardLeader() && req.GetSearchPhase() == querypb.SearchPhase_FetchSurviving {
+		ret, err := node.fetchSearchPhaseResult(req)
+		if err != nil {
+			failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			failRet.Status.Reason = err.Error()
+			return failRet, nil
+		}
+		return ret, nil
+	}
+
 	tr := timerecord.NewTimeRecorder("Search")
 	if !req.GetFromShardLeader() {
 		log.Ctx(ctx).Debug("Received SearchRequest",
@@ -788,12 +799,22 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		return failRet, nil
 	}
 
-	ret, err := reduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	// only the results returned to proxy are sent in the precision it asks for
+	precision := internalpb.ResultPrecision_Float32
+	if !req.GetFromShardLeader() {
+		precision = req.GetReq().GetResultPrecision()
+	}
+	ret, err := reduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), precision)
 	if err != nil {
 		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		failRet.Status.Reason = err.Error()
 		return failRet, nil
 	}
+	if err := node.applySearchPhase(req, ret); err != nil {
+		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		failRet.Status.Reason = err.Error()
+		return failRet, nil
+	}
 
 	if !req.FromShardLeader {
 		tr.CtxElapse(ctx, "search done in all shards")
@@ -901,13 +922,13 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 	defer cancel()
 
 	withStreaming := func(ctx context.Context) error {
-		streamingTask, err := newSearchTask(searchCtx, req)
+		streamingTask, err := newSearchTask(ctx, req)
 		if err != nil {
 			return err
 		}
 		streamingTask.QS = qs
 		streamingTask.DataScope = querypb.DataScope_Streaming
-		err = node.scheduler.AddReadTask(searchCtx, streamingTask)
+		err = node.scheduler.AddReadTask(ctx, streamingTask)
 		if err != nil {
 			return err
 		}
@@ -934,7 +955,7 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 	tr.CtxElapse(ctx, fmt.Sprintf("do search done in shard cluster, vChannel = %s, segmentIDs = %v", dmlChannel, req.GetSegmentIDs()))
 
 	results = append(results, streamingResult)
-	ret, err2 := reduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	ret, err2 := reduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), internalpb.ResultPrecision_Float32)
 	if err2 != nil {
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
@@ -1012,6 +1033,7 @@ func (node *QueryNode) queryWithDmlChannel(ctx context.Context, req *querypb.Que
 
 		err2 = queryTask.WaitToFinish()
 		if err2 != nil {
+			failRet.Status.ErrorCode = queryErrorCode(err2)
 			failRet.Status.Reason = err2.Error()
 			return failRet, nil
 		}
@@ -1045,10 +1067,10 @@ func (node *QueryNode) queryWithDmlChannel(ctx context.Context, req *querypb.Que
 	var streamingResult *internalpb.RetrieveResults
 
 	withStreaming := func(ctx context.Context) error {
-		streamingTask := newQueryTask(queryCtx, req)
+		streamingTask := newQueryTask(ctx, req)
 		streamingTask.DataScope = querypb.DataScope_Streaming
 		streamingTask.QS = qs
-		err := node.scheduler.AddReadTask(queryCtx, streamingTask)
+		err := node.scheduler.AddReadTask(ctx, streamingTask)
 
 		if err != nil {
 			return err
@@ -1072,6 +1094,7 @@ func (node *QueryNode) queryWithDmlChannel(ctx context.Context, req *querypb.Que
 		log.Ctx(ctx).Warn("failed to query cluster",
 			zap.Int64("collectionID", req.Req.GetCollectionID()),
 			zap.Error(errCluster))
+		failRet.Status.ErrorCode = queryErrorCode(errCluster)
 		failRet.Status.Reason = errCluster.Error()
 		return failRet, nil
 	}
@@ -1082,6 +1105,7 @@ func (node *QueryNode) queryWithDmlChannel(ctx context.Context, req *querypb.Que
 	results = append(results, streamingResult)
 	ret, err2 := mergeInternalRetrieveResultsAndFillIfEmpty(ctx, results, req.Req.GetLimit(), req.GetReq().GetOutputFieldsId(), qs.collection.Schema())
 	if err2 != nil {
+		failRet.Status.ErrorCode = queryErrorCode(err2)
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
 	}
@@ -1165,12 +1189,14 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 	}
 	ret, err := mergeInternalRetrieveResultsAndFillIfEmpty(ctx, toMergeResults, req.GetReq().GetLimit(), req.GetReq().GetOutputFieldsId(), coll.Schema())
 	if err != nil {
-		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		failRet.Status.ErrorCode = queryErrorCode(err)
 		failRet.Status.Reason = err.Error()
 		return failRet, nil
 	}
 
 	if !req.FromShardLeader {
+		// only the results returned to proxy are sent in the precision it asks for
+		encodeRetrieveResultPrecision(ret, req.GetReq().GetResultPrecision())
 		rateCol.Add(metricsinfo.NQPerSecond, 1)
 		metrics.QueryNodeExecuteCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Add(float64(proto.Size(req)))
 	}
@@ -1223,14 +1249,7 @@ func (node *QueryNode) ShowConfigurations(ctx context.Context, req *internalpb.S
 	node.wg.Add(1)
 	defer node.wg.Done()
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "querynode", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "querynode", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -1293,6 +1312,14 @@ func (node *QueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsR
 		return queryNodeMetrics, nil
 	}
 
+	if metricType == metricsinfo.SegmentLoadProgressMetrics {
+		return node.getSegmentLoadProgressMetrics(), nil
+	}
+
+	if metricType == metricsinfo.SegmentHeatMetrics {
+		return node.getSegmentHeatMetrics(), nil
+	}
+
 	log.Ctx(ctx).RatedDebug(60, "QueryNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
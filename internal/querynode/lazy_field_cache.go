@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lazyFieldCache tracks the fields loaded on demand in lazy load mode of all the segments of the node.
+var lazyFieldCache = newLazyLoadCache()
+
+type lazyFieldKey struct {
+	segment *Segment
+	fieldID UniqueID
+}
+
+type lazyCacheEntry struct {
+	key  lazyFieldKey
+	size int64
+	elem *list.Element
+}
+
+// lazyLoadCache evicts the lazy loaded fields in the LRU order once their total size exceeds
+// queryNode.lazyLoad.cacheSize, or when the memory is not enough to load another lazy field. The fields pinned by
+// the ongoing requests are skipped, an evicted field is loaded again by the next request needs it.
+// The lock order is lazyLoadCache.mu before Segment.lazyLoadMut.
+type lazyLoadCache struct {
+	mu      sync.Mutex
+	entries map[lazyFieldKey]*lazyCacheEntry
+	lru     *list.List // front is the most recently used
+	size    int64
+}
+
+func newLazyLoadCache() *lazyLoadCache {
+	return &lazyLoadCache{
+		entries: make(map[lazyFieldKey]*lazyCacheEntry),
+		lru:     list.New(),
+	}
+}
+
+// add records the field just loaded, and evicts the least recently used fields beyond the capacity.
+func (c *lazyLoadCache) add(segment *Segment, fieldID UniqueID, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := lazyFieldKey{segment: segment, fieldID: fieldID}
+	if entry, ok := c.entries[key]; ok {
+		c.removeLocked(entry)
+	}
+	entry := &lazyCacheEntry{key: key, size: size}
+	entry.elem = c.lru.PushFront(entry)
+	c.entries[key] = entry
+	c.size += size
+
+	capacity := Params.QueryNodeCfg.LazyLoadCacheSize.GetAsInt64() * 1024 * 1024
+	if capacity > 0 && c.size > capacity {
+		c.evictLocked(c.size - capacity)
+	}
+}
+
+func (c *lazyLoadCache) touch(segment *Segment, fieldID UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[lazyFieldKey{segment: segment, fieldID: fieldID}]; ok {
+		c.lru.MoveToFront(entry.elem)
+	}
+}
+
+// evict evicts the least recently used fields not pinned until size bytes are freed, returns the freed bytes.
+func (c *lazyLoadCache) evict(size int64) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictLocked(size)
+}
+
+func (c *lazyLoadCache) evictLocked(size int64) int64 {
+	evicted := int64(0)
+	for elem := c.lru.Back(); elem != nil && evicted < size; {
+		prev := elem.Prev()
+		entry := elem.Value.(*lazyCacheEntry)
+		if entry.key.segment.evictLazyField(entry.key.fieldID) {
+			c.removeLocked(entry)
+			evicted += entry.size
+		}
+		elem = prev
+	}
+	return evicted
+}
+
+// remove forgets the fields of the segment, called after the segment is deleted.
+func (c *lazyLoadCache) remove(segment *Segment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if key.segment == segment {
+			c.removeLocked(entry)
+		}
+	}
+}
+
+func (c *lazyLoadCache) removeLocked(entry *lazyCacheEntry) {
+	c.lru.Remove(entry.elem)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func (c *lazyLoadCache) usedSize() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
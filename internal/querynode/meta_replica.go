@@ -236,6 +236,7 @@ func (replica *metaReplica) removeCollectionPrivate(collectionID UniqueID) error
 		_ = replica.removePartitionPrivate(partitionID)
 	}
 
+	planCache.invalidate(collectionID)
 	deleteCollection(collection)
 	delete(replica.collections, collectionID)
 
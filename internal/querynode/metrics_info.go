@@ -18,6 +18,7 @@ package querynode
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
@@ -105,6 +106,7 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 			SimdType: Params.CommonCfg.SimdType.GetValue(),
 		},
 		QuotaMetrics: quotaMetrics,
+		SegmentLoads: node.listSegmentLoads(),
 	}
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
 
@@ -129,3 +131,55 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, paramtable.GetNodeID()),
 	}, nil
 }
+
+// listSegmentLoads returns the progress of the loading and the recently loaded segments.
+func (node *QueryNode) listSegmentLoads() []metricsinfo.SegmentLoadMetrics {
+	if node.loader == nil {
+		return nil
+	}
+	return node.loader.loadTracker.list()
+}
+
+// getSegmentLoadProgressMetrics returns the progress of the loading and the recently loaded segments in json.
+func (node *QueryNode) getSegmentLoadProgressMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, paramtable.GetNodeID())
+	resp, err := json.Marshal(node.listSegmentLoads())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}
+}
+
+// getSegmentHeatMetrics returns the query heats of the sealed segments in json.
+func (node *QueryNode) getSegmentHeatMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, paramtable.GetNodeID())
+	resp, err := json.Marshal(node.listSegmentHeats())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Response:      string(resp),
+		ComponentName: componentName,
+	}
+}
@@ -18,6 +18,7 @@ package querynode
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -64,3 +66,28 @@ func TestGetSystemInfoMetrics(t *testing.T) {
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	rateCol.Register(metricsinfo.NQPerSecond)
 }
+
+func TestGetSegmentLoadProgressMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	node.loader.loadTracker = newSegmentLoadTracker()
+	node.loader.loadTracker.add(&querypb.SegmentLoadInfo{SegmentID: 1, CollectionID: 100})
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SegmentLoadProgressMetrics)
+	require.NoError(t, err)
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	var segments []metricsinfo.SegmentLoadMetrics
+	assert.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &segments))
+	assert.Equal(t, 1, len(segments))
+	assert.Equal(t, segmentLoadPhasePending, segments[0].Phase)
+
+	// no loader yet
+	node.loader = nil
+	assert.Empty(t, node.listSegmentLoads())
+}
@@ -27,6 +27,7 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"unsafe"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -36,6 +37,7 @@ import (
 // SearchPlan is a wrapper of the underlying C-structure C.CSearchPlan
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
+	release     func() // releases the plan shared by planCache instead of deleting it
 }
 
 // createSearchPlan returns a new SearchPlan and error
@@ -74,6 +76,22 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 	return newPlan, nil
 }
 
+// getSearchPlanByExpr returns the search plan of the expr, the plan is shared with the other requests of the same
+// expr by planCache.
+func getSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
+	cached, release, err := planCache.acquire(col, searchPlanKind, expr, func() (interface{}, func(), error) {
+		plan, err := createSearchPlanByExpr(col, expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		return plan, plan.delete, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SearchPlan{cSearchPlan: cached.(*SearchPlan).cSearchPlan, release: release}, nil
+}
+
 func (plan *SearchPlan) getTopK() int64 {
 	topK := C.GetTopK(plan.cSearchPlan)
 	return int64(topK)
@@ -87,6 +105,10 @@ func (plan *SearchPlan) getMetricType() string {
 }
 
 func (plan *SearchPlan) delete() {
+	if plan.release != nil {
+		plan.release()
+		return
+	}
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
 
@@ -96,17 +118,23 @@ type searchRequest struct {
 	timestamp         Timestamp
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	outputFieldIDs    []UniqueID
+	filterStats       *filterStatsCollector // nil unless the filter statistics are asked for
+	equalPredicates   equalPredicates       // used to skip the sealed segments by their composite indexes
+	lazyPins          lazyFieldPins         // the lazy loaded fields used by the request
 }
 
 func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*searchRequest, error) {
 	var err error
 	var plan *SearchPlan
+	var eqs equalPredicates
 	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := req.Req.SerializedExprPlan
-		plan, err = createSearchPlanByExpr(collection, expr)
+		plan, err = getSearchPlanByExpr(collection, expr)
 		if err != nil {
 			return nil, err
 		}
+		eqs = parseEqualPredicates(expr)
 	} else {
 		dsl := req.Req.GetDsl()
 		plan, err = createSearchPlan(collection, dsl)
@@ -143,17 +171,27 @@ func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		timestamp:         req.Req.GetTravelTimestamp(),
 		msgID:             req.GetReq().GetBase().GetMsgID(),
 		searchFieldID:     int64(fieldID),
+		outputFieldIDs:    req.GetReq().GetOutputFieldsId(),
+		equalPredicates:   eqs,
 	}
 
 	return ret, nil
 }
 
+// lazyLoadFieldIDs returns the fields needed by the search, including the vector field to search and the output fields
+func (sr *searchRequest) lazyLoadFieldIDs() []UniqueID {
+	fieldIDs := make([]UniqueID, 0, len(sr.outputFieldIDs)+1)
+	fieldIDs = append(fieldIDs, sr.searchFieldID)
+	return append(fieldIDs, sr.outputFieldIDs...)
+}
+
 func (sr *searchRequest) getNumOfQuery() int64 {
 	numQueries := C.GetNumOfQueries(sr.cPlaceholderGroup)
 	return int64(numQueries)
 }
 
 func (sr *searchRequest) delete() {
+	sr.lazyPins.release()
 	if sr.plan != nil {
 		sr.plan.delete()
 	}
@@ -177,11 +215,38 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*searchRequ
 	return ret, nil
 }
 
+// lazyFieldPins collects the lazy loaded fields pinned by a request, they are unpinned after the request is done,
+// including the reduce which may read the output fields from the segments.
+type lazyFieldPins struct {
+	mu       sync.Mutex
+	releases []func()
+}
+
+func (p *lazyFieldPins) add(release func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.releases = append(p.releases, release)
+}
+
+func (p *lazyFieldPins) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, release := range p.releases {
+		release()
+	}
+	p.releases = nil
+}
+
 // RetrievePlan is a wrapper of the underlying C-structure C.CRetrievePlan
 type RetrievePlan struct {
-	cRetrievePlan C.CRetrievePlan
-	Timestamp     Timestamp
-	msgID         UniqueID // only used to debug.
+	cRetrievePlan   C.CRetrievePlan
+	Timestamp       Timestamp
+	msgID           UniqueID              // only used to debug.
+	outputFieldIDs  []UniqueID            // the fields loaded on demand if they are skipped in lazy load mode
+	release         func()                // releases the plan shared by planCache instead of deleting it
+	filterStats     *filterStatsCollector // nil unless the filter statistics are asked for
+	equalPredicates equalPredicates       // used to skip the sealed segments by their composite indexes
+	lazyPins        lazyFieldPins         // the lazy loaded fields used by the plan
 }
 
 func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
@@ -204,6 +269,33 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp,
 	return newPlan, nil
 }
 
+// getRetrievePlanByExpr returns the retrieve plan of the expr, the plan is shared with the other requests of the same
+// expr by planCache.
+func getRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
+	cached, release, err := planCache.acquire(col, retrievePlanKind, expr, func() (interface{}, func(), error) {
+		plan, err := createRetrievePlanByExpr(col, expr, 0, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return plan, plan.delete, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RetrievePlan{
+		cRetrievePlan:   cached.(*RetrievePlan).cRetrievePlan,
+		Timestamp:       timestamp,
+		msgID:           msgID,
+		release:         release,
+		equalPredicates: parseEqualPredicates(expr),
+	}, nil
+}
+
 func (plan *RetrievePlan) delete() {
+	plan.lazyPins.release()
+	if plan.release != nil {
+		plan.release()
+		return
+	}
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
 }
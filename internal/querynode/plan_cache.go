@@ -0,0 +1,204 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// planCache is the plan cache shared by the search and query tasks of the node.
+var planCache = newExprPlanCache()
+
+type planKind int32
+
+const (
+	searchPlanKind planKind = iota
+	retrievePlanKind
+)
+
+func (k planKind) metricLabel() string {
+	if k == searchPlanKind {
+		return metrics.SearchLabel
+	}
+	return metrics.QueryLabel
+}
+
+type planCacheKey struct {
+	collectionID UniqueID
+	kind         planKind
+	expr         string // the serialized expr plan
+}
+
+type cachedPlan struct {
+	key planCacheKey
+	// collection is the collection the plan is created from, the plan refers to the schema of the collection,
+	// so it's only reused by the same collection object, a reloaded collection gets new plans.
+	collection *Collection
+	plan       interface{}
+	free       func()
+	refs       int
+	evicted    bool
+	elem       *list.Element
+}
+
+// exprPlanCache caches the plans created from the serialized expr plans, so that the templated search and query
+// requests with the same filter are not planned again by segcore. The plans are evicted in the LRU order once the
+// number of plans exceeds queryNode.planCache.capacity, a plan still used by some tasks is freed after all the tasks
+// release it.
+type exprPlanCache struct {
+	mu      sync.Mutex
+	entries map[planCacheKey]*cachedPlan
+	lru     *list.List // front is the most recently used
+}
+
+func newExprPlanCache() *exprPlanCache {
+	return &exprPlanCache{
+		entries: make(map[planCacheKey]*cachedPlan),
+		lru:     list.New(),
+	}
+}
+
+// acquire returns the cached plan of the expr, or the plan made by create if not cached. The plan must be released
+// by the returned release func after use instead of being freed.
+func (c *exprPlanCache) acquire(col *Collection, kind planKind, expr []byte,
+	create func() (interface{}, func(), error)) (interface{}, func(), error) {
+	capacity := Params.QueryNodeCfg.PlanCacheCapacity.GetAsInt()
+	if capacity <= 0 {
+		return create()
+	}
+
+	key := planCacheKey{collectionID: col.ID(), kind: kind, expr: string(expr)}
+	if entry := c.get(key, col); entry != nil {
+		c.recordMetric(kind, metrics.CacheHitLabel)
+		return entry.plan, c.releaseFunc(entry), nil
+	}
+	c.recordMetric(kind, metrics.CacheMissLabel)
+
+	plan, free, err := create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && entry.collection == col {
+		// planned by another task meanwhile
+		entry.refs++
+		c.lru.MoveToFront(entry.elem)
+		c.mu.Unlock()
+		free()
+		return entry.plan, c.releaseFunc(entry), nil
+	}
+	entry := &cachedPlan{
+		key:        key,
+		collection: col,
+		plan:       plan,
+		free:       free,
+		refs:       1,
+	}
+	toFree := make([]func(), 0)
+	if old, ok := c.entries[key]; ok {
+		toFree = append(toFree, c.removeLocked(old)...)
+	}
+	entry.elem = c.lru.PushFront(entry)
+	c.entries[key] = entry
+	for c.lru.Len() > capacity {
+		toFree = append(toFree, c.removeLocked(c.lru.Back().Value.(*cachedPlan))...)
+	}
+	c.setNumMetric()
+	c.mu.Unlock()
+
+	for _, free := range toFree {
+		free()
+	}
+	return entry.plan, c.releaseFunc(entry), nil
+}
+
+// get returns the cached plan of the key created from the collection with the reference count increased.
+func (c *exprPlanCache) get(key planCacheKey, col *Collection) *cachedPlan {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || entry.collection != col {
+		return nil
+	}
+	entry.refs++
+	c.lru.MoveToFront(entry.elem)
+	return entry
+}
+
+func (c *exprPlanCache) releaseFunc(entry *cachedPlan) func() {
+	once := sync.Once{}
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			entry.refs--
+			free := entry.evicted && entry.refs == 0
+			c.mu.Unlock()
+			if free {
+				entry.free()
+			}
+		})
+	}
+}
+
+// removeLocked removes the entry from the cache, returns the free func if the plan is not used by any task,
+// otherwise the plan is freed by the last release.
+func (c *exprPlanCache) removeLocked(entry *cachedPlan) []func() {
+	c.lru.Remove(entry.elem)
+	delete(c.entries, entry.key)
+	entry.evicted = true
+	if entry.refs == 0 {
+		return []func(){entry.free}
+	}
+	return nil
+}
+
+// invalidate removes the plans of the collection, called before the collection is released.
+func (c *exprPlanCache) invalidate(collectionID UniqueID) {
+	c.mu.Lock()
+	toFree := make([]func(), 0)
+	for key, entry := range c.entries {
+		if key.collectionID == collectionID {
+			toFree = append(toFree, c.removeLocked(entry)...)
+		}
+	}
+	c.setNumMetric()
+	c.mu.Unlock()
+
+	for _, free := range toFree {
+		free()
+	}
+}
+
+func (c *exprPlanCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *exprPlanCache) recordMetric(kind planKind, state string) {
+	metrics.QueryNodePlanCacheCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), kind.metricLabel(), state).Inc()
+}
+
+func (c *exprPlanCache) setNumMetric() {
+	metrics.QueryNodePlanCacheNum.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(c.lru.Len()))
+}
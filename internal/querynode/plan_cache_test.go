@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type fakePlan struct {
+	expr  string
+	freed bool
+}
+
+func fakePlanCreator(expr string, created *int) func() (interface{}, func(), error) {
+	return func() (interface{}, func(), error) {
+		*created++
+		plan := &fakePlan{expr: expr}
+		return plan, func() { plan.freed = true }, nil
+	}
+}
+
+func TestExprPlanCache(t *testing.T) {
+	paramtable.Init()
+	col := &Collection{id: defaultCollectionID}
+
+	t.Run("hit", func(t *testing.T) {
+		cache := newExprPlanCache()
+		created := 0
+		plan1, release1, err := cache.acquire(col, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		plan2, release2, err := cache.acquire(col, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		assert.Same(t, plan1, plan2)
+		assert.Equal(t, 1, created)
+
+		// different kind
+		_, release3, err := cache.acquire(col, retrievePlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		assert.Equal(t, 2, created)
+		assert.Equal(t, 2, cache.len())
+
+		release1()
+		release2()
+		release3()
+		assert.False(t, plan1.(*fakePlan).freed)
+	})
+
+	t.Run("evict", func(t *testing.T) {
+		paramtable.Get().Save(Params.QueryNodeCfg.PlanCacheCapacity.Key, "2")
+		defer paramtable.Get().Reset(Params.QueryNodeCfg.PlanCacheCapacity.Key)
+
+		cache := newExprPlanCache()
+		created := 0
+		planA, releaseA, err := cache.acquire(col, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		planB, releaseB, err := cache.acquire(col, searchPlanKind, []byte("b"), fakePlanCreator("b", &created))
+		require.NoError(t, err)
+		releaseB()
+
+		// a is in use, its plan is freed after release
+		_, releaseC, err := cache.acquire(col, searchPlanKind, []byte("c"), fakePlanCreator("c", &created))
+		require.NoError(t, err)
+		_, releaseD, err := cache.acquire(col, searchPlanKind, []byte("d"), fakePlanCreator("d", &created))
+		require.NoError(t, err)
+		assert.Equal(t, 2, cache.len())
+		assert.True(t, planB.(*fakePlan).freed)
+		assert.False(t, planA.(*fakePlan).freed)
+
+		releaseA()
+		releaseA()
+		assert.True(t, planA.(*fakePlan).freed)
+		releaseC()
+		releaseD()
+	})
+
+	t.Run("reloaded collection", func(t *testing.T) {
+		cache := newExprPlanCache()
+		created := 0
+		plan1, release1, err := cache.acquire(col, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		release1()
+
+		reloaded := &Collection{id: defaultCollectionID}
+		plan2, release2, err := cache.acquire(reloaded, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		assert.NotSame(t, plan1, plan2)
+		assert.True(t, plan1.(*fakePlan).freed)
+		assert.Equal(t, 1, cache.len())
+		release2()
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cache := newExprPlanCache()
+		created := 0
+		plan1, release1, err := cache.acquire(col, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		other := &Collection{id: defaultCollectionID + 1}
+		plan2, release2, err := cache.acquire(other, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		release2()
+
+		cache.invalidate(defaultCollectionID)
+		assert.Equal(t, 1, cache.len())
+		assert.False(t, plan1.(*fakePlan).freed)
+		release1()
+		assert.True(t, plan1.(*fakePlan).freed)
+		assert.False(t, plan2.(*fakePlan).freed)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.QueryNodeCfg.PlanCacheCapacity.Key, "0")
+		defer paramtable.Get().Reset(Params.QueryNodeCfg.PlanCacheCapacity.Key)
+
+		cache := newExprPlanCache()
+		created := 0
+		plan, release, err := cache.acquire(col, searchPlanKind, []byte("a"), fakePlanCreator("a", &created))
+		require.NoError(t, err)
+		assert.Equal(t, 0, cache.len())
+		release()
+		assert.True(t, plan.(*fakePlan).freed)
+	})
+
+	t.Run("create failed", func(t *testing.T) {
+		cache := newExprPlanCache()
+		_, _, err := cache.acquire(col, searchPlanKind, []byte("a"), func() (interface{}, func(), error) {
+			return nil, nil, errors.New("mock")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 0, cache.len())
+	})
+}
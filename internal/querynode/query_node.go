@@ -123,6 +123,12 @@ type QueryNode struct {
 
 	// pool for load/release channel
 	taskPool *concurrency.Pool
+
+	// full search results kept for the fetch phase of the adaptive reduce of the shard leaders
+	searchPhaseCache *searchPhaseCache
+
+	// creates the DataCoord client to report the segment heats
+	dataCoordCreator dataCoordCreatorFunc
 }
 
 // NewQueryNode will return a QueryNode with abnormal state.
@@ -132,10 +138,12 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 		queryNodeLoopCtx:    ctx1,
 		queryNodeLoopCancel: cancel,
 		factory:             factory,
+		dataCoordCreator:    defaultDataCoordCreatorFunc,
 	}
 
 	node.tSafeReplica = newTSafeReplica()
 	node.scheduler = newTaskScheduler(ctx1, node.tSafeReplica)
+	node.searchPhaseCache = newSearchPhaseCache()
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 
 	return node
@@ -311,6 +319,8 @@ func (node *QueryNode) Start() error {
 	}
 	node.queryShardService = queryShardService
 
+	node.startSegmentHeatReportLoop(node.queryNodeLoopCtx)
+
 	node.UpdateStateCode(commonpb.StateCode_Healthy)
 	log.Info("query node start successfully",
 		zap.Int64("queryNodeID", paramtable.GetNodeID()),
@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/panjf2000/ants/v2"
+
+	"github.com/milvus-io/milvus/internal/util/concurrency"
+)
+
+// reducePool merges the queries of the search results with large nq in parallel.
+var reducePool *concurrency.Pool
+var reducePoolInitOnce sync.Once
+
+func initReducePool() {
+	// error only happens with negative expiry duration or with negative pre-alloc size.
+	reducePool, _ = concurrency.NewPool(runtime.GOMAXPROCS(0), ants.WithPreAlloc(true))
+}
+
+func getOrCreateReducePool() *concurrency.Pool {
+	reducePoolInitOnce.Do(initReducePool)
+	return reducePool
+}
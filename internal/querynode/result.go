@@ -31,6 +31,9 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/concurrency"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/filterstats"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -77,7 +80,7 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 	return ret, nil
 }
 
-func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string, precision internalpb.ResultPrecision) (*internalpb.SearchResults, error) {
 	searchResultData, err := decodeSearchResults(results)
 	if err != nil {
 		log.Ctx(ctx).Warn("decode search results errors", zap.Error(err))
@@ -91,11 +94,14 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 		log.Ctx(ctx).Warn("reduce search results error", zap.Error(err))
 		return nil, err
 	}
-	searchResults, err := encodeSearchResultData(reducedResultData, nq, topk, metricType)
+	searchResults, err := encodeSearchResultData(reducedResultData, nq, topk, metricType, precision)
 	if err != nil {
 		log.Ctx(ctx).Warn("encode search results error", zap.Error(err))
 		return nil, err
 	}
+	for _, result := range results {
+		searchResults.FilterStats = filterstats.Merge(searchResults.FilterStats, result.GetFilterStats())
+	}
 	//if searchResults.SlicedBlob == nil {
 	//	log.Debug("shard leader send nil results to proxy",
 	//		zap.String("shard", q.channel))
@@ -119,15 +125,6 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 			Topks:      make([]int64, 0),
 		}, nil
 	}
-	ret := &schemapb.SearchResultData{
-		NumQueries: nq,
-		TopK:       topk,
-		FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
-		Scores:     make([]float32, 0),
-		Ids:        &schemapb.IDs{},
-		Topks:      make([]int64, 0),
-	}
-
 	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
 		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
@@ -136,41 +133,51 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		}
 	}
 
-	var skipDupCnt int64
+	// the results of the i-th query are selected into selected[queryOffsets[i]:queryOffsets[i+1]],
+	// which holds at most topk results
+	queryOffsets := make([]int64, nq+1)
 	for i := int64(0); i < nq; i++ {
-		offsets := make([]int64, len(searchResultData))
-
-		var idSet = make(map[interface{}]struct{})
-		var j int64
-		for j = 0; j < topk; {
-			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
-			if sel == -1 {
-				break
-			}
-			idx := resultOffsets[sel][i] + offsets[sel]
-
-			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
-			score := searchResultData[sel].Scores[idx]
-
-			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
-				typeutil.AppendPKs(ret.Ids, id)
-				ret.Scores = append(ret.Scores, score)
-				idSet[id] = struct{}{}
-				j++
-			} else {
-				// skip entity with same id
-				skipDupCnt++
-			}
-			offsets[sel]++
+		var available int64
+		for _, data := range searchResultData {
+			available += data.Topks[i]
 		}
+		if available > topk {
+			available = topk
+		}
+		queryOffsets[i+1] = queryOffsets[i] + available
+	}
+	selected := make([]searchResultRef, queryOffsets[nq])
+	topks := make([]int64, nq)
 
-		// if realTopK != -1 && realTopK != j {
-		// 	log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
-		// 	// return nil, errors.New("the length (topk) between all result of query is different")
-		// }
-		ret.Topks = append(ret.Topks, j)
+	skipDupCnt, err := mergeSearchResultQueries(searchResultData, resultOffsets, queryOffsets, nq, topk, selected, topks)
+	if err != nil {
+		return nil, err
+	}
+
+	total := queryOffsets[nq]
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
+		Scores:     make([]float32, 0, total),
+		Ids:        &schemapb.IDs{},
+		Topks:      topks,
+	}
+	if total > 0 {
+		switch searchResultData[0].GetIds().GetIdField().(type) {
+		case *schemapb.IDs_IntId:
+			ret.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, 0, total)}}
+		case *schemapb.IDs_StrId:
+			ret.Ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: make([]string, 0, total)}}
+		}
+	}
+	for i := int64(0); i < nq; i++ {
+		for _, ref := range selected[queryOffsets[i] : queryOffsets[i]+topks[i]] {
+			data := searchResultData[ref.sel]
+			typeutil.AppendFieldData(ret.FieldsData, data.FieldsData, ref.idx)
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(data.GetIds(), ref.idx))
+			ret.Scores = append(ret.Scores, data.Scores[ref.idx])
+		}
 	}
 
 	if skipDupCnt > 0 {
@@ -179,6 +186,97 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 	return ret, nil
 }
 
+// searchResultRef refers to the idx-th result of the sel-th search result data.
+type searchResultRef struct {
+	sel int
+	idx int64
+}
+
+// mergeSearchResultQueries selects the results of every query into selected and their number into topks, and
+// returns the number of the skipped duplicated results. The queries are split into a chunk per worker of the reduce
+// pool if nq reaches queryNode.grouping.parallelReduce.minNQ, every chunk writes to its own part of the buffers so
+// the result is the same as merging the queries one by one.
+func mergeSearchResultQueries(searchResultData []*schemapb.SearchResultData, resultOffsets [][]int64, queryOffsets []int64,
+	nq int64, topk int64, selected []searchResultRef, topks []int64) (int64, error) {
+	var pool *concurrency.Pool
+	chunks := int64(1)
+	if minNQ := Params.QueryNodeCfg.ParallelReduceMinNQ.GetAsInt64(); minNQ > 0 && nq >= minNQ {
+		pool = getOrCreateReducePool()
+		chunks = int64(pool.Cap())
+		if chunks > nq {
+			chunks = nq
+		}
+	}
+
+	skipped := make([]int64, chunks)
+	mergeChunk := func(chunk int64) {
+		offsets := make([]int64, len(searchResultData))
+		idSet := make(map[interface{}]struct{})
+		for qi := nq * chunk / chunks; qi < nq*(chunk+1)/chunks; qi++ {
+			n, skip := mergeSearchResultQuery(searchResultData, resultOffsets, offsets, idSet, qi, topk,
+				selected[queryOffsets[qi]:queryOffsets[qi+1]])
+			topks[qi] = n
+			skipped[chunk] += skip
+		}
+	}
+
+	if chunks <= 1 {
+		mergeChunk(0)
+	} else {
+		futures := make([]*concurrency.Future, 0, chunks)
+		for chunk := int64(0); chunk < chunks; chunk++ {
+			chunk := chunk
+			futures = append(futures, pool.Submit(func() (interface{}, error) {
+				mergeChunk(chunk)
+				return nil, nil
+			}))
+		}
+		if err := concurrency.AwaitAll(futures...); err != nil {
+			return 0, err
+		}
+	}
+
+	var skipDupCnt int64
+	for _, skip := range skipped {
+		skipDupCnt += skip
+	}
+	return skipDupCnt, nil
+}
+
+// mergeSearchResultQuery selects the deduplicated top results of the qi-th query into selected, and returns the
+// number of the selected and the skipped duplicated results. offsets and idSet are reset and reused across queries.
+func mergeSearchResultQuery(searchResultData []*schemapb.SearchResultData, resultOffsets [][]int64, offsets []int64,
+	idSet map[interface{}]struct{}, qi int64, topk int64, selected []searchResultRef) (int64, int64) {
+	for i := range offsets {
+		offsets[i] = 0
+	}
+	for id := range idSet {
+		delete(idSet, id)
+	}
+
+	var j, skipDupCnt int64
+	for j < topk {
+		sel := selectSearchResultData(searchResultData, resultOffsets, offsets, qi)
+		if sel == -1 {
+			break
+		}
+		idx := resultOffsets[sel][qi] + offsets[sel]
+		id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
+
+		// remove duplicates
+		if _, ok := idSet[id]; !ok {
+			selected[j] = searchResultRef{sel: sel, idx: idx}
+			idSet[id] = struct{}{}
+			j++
+		} else {
+			// skip entity with same id
+			skipDupCnt++
+		}
+		offsets[sel]++
+	}
+	return j, skipDupCnt
+}
+
 func selectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffsets [][]int64, offsets []int64, qi int64) int {
 	var (
 		sel                 = -1
@@ -226,13 +324,18 @@ func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb
 		if err != nil {
 			return nil, err
 		}
+		if err := typeutil.FillInSlicedScores(partialSearchResult, &partialResultData); err != nil {
+			return nil, err
+		}
 
 		results = append(results, &partialResultData)
 	}
 	return results, nil
 }
 
-func encodeSearchResultData(searchResultData *schemapb.SearchResultData, nq int64, topk int64, metricType string) (searchResults *internalpb.SearchResults, err error) {
+// encodeSearchResultData marshals the search result data into the sliced blob, the scores are sent in sliced_scores
+// instead if the precision is reduced.
+func encodeSearchResultData(searchResultData *schemapb.SearchResultData, nq int64, topk int64, metricType string, precision internalpb.ResultPrecision) (searchResults *internalpb.SearchResults, err error) {
 	searchResults = &internalpb.SearchResults{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -242,16 +345,66 @@ func encodeSearchResultData(searchResultData *schemapb.SearchResultData, nq int6
 		MetricType: metricType,
 		SlicedBlob: nil,
 	}
+	if searchResultData == nil || searchResultData.Ids == nil || typeutil.GetSizeOfIDs(searchResultData.Ids) == 0 {
+		return
+	}
+	// the scores overflowing the precision are sent in float32
+	slicedScores, reduced := typeutil.EncodeReducedPrecision(searchResultData.Scores, precision)
+	if reduced {
+		scores := searchResultData.Scores
+		searchResultData.Scores = nil
+		defer func() {
+			searchResultData.Scores = scores
+		}()
+	}
 	slicedBlob, err := proto.Marshal(searchResultData)
 	if err != nil {
 		return nil, err
 	}
-	if searchResultData != nil && searchResultData.Ids != nil && typeutil.GetSizeOfIDs(searchResultData.Ids) != 0 {
-		searchResults.SlicedBlob = slicedBlob
+	searchResults.SlicedBlob = slicedBlob
+	if reduced {
+		searchResults.ResultPrecision = precision
+		searchResults.SlicedScores = slicedScores
 	}
 	return
 }
 
+// encodeRetrieveResultPrecision moves the float vectors of the retrieve results into reduced_vectors encoded in the
+// precision, the vectors of a field overflowing the precision are kept in float32.
+func encodeRetrieveResultPrecision(result *internalpb.RetrieveResults, precision internalpb.ResultPrecision) {
+	result.ReducedVectors = typeutil.ReduceVectorPrecision(result.GetFieldsData(), precision)
+	if len(result.ReducedVectors) > 0 {
+		result.ResultPrecision = precision
+	}
+}
+
+// resultSizeLimiter sums the estimated sizes of the rows appended to a merged query result,
+// the merge fails as soon as the sum exceeds queryNode.maxResultSize.
+type resultSizeLimiter struct {
+	maxSize int64
+	size    int64
+}
+
+func newResultSizeLimiter() *resultSizeLimiter {
+	return &resultSizeLimiter{maxSize: Params.QueryNodeCfg.MaxResultSize.GetAsInt64()}
+}
+
+// add counts the row at offset of the fields data, rows is the number of the rows merged before it.
+func (l *resultSizeLimiter) add(fieldsData []*schemapb.FieldData, offset int64, rows int) error {
+	if l.maxSize <= 0 {
+		return nil
+	}
+	size, err := typeutil.EstimateEntitySize(fieldsData, int(offset))
+	if err != nil {
+		return err
+	}
+	if l.size+int64(size) > l.maxSize {
+		return errorutil.WrapErrResultSizeExceeded(l.maxSize, int64(rows))
+	}
+	l.size += int64(size)
+	return nil
+}
+
 func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, limit int64) (*internalpb.RetrieveResults, error) {
 	log.Ctx(ctx).Debug("mergeInternelRetrieveResults",
 		zap.Int64("limit", limit),
@@ -265,6 +418,10 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		loopEnd    int
 	)
 
+	for _, r := range retrieveResults {
+		ret.FilterStats = filterstats.Merge(ret.FilterStats, r.GetFilterStats())
+	}
+
 	validRetrieveResults := []*internalpb.RetrieveResults{}
 	for _, r := range retrieveResults {
 		size := typeutil.GetSizeOfIDs(r.GetIds())
@@ -286,6 +443,7 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idTsMap := make(map[interface{}]uint64)
 	cursors := make([]int64, len(validRetrieveResults))
+	sizeLimiter := newResultSizeLimiter()
 	for j := 0; j < loopEnd; j++ {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
@@ -295,6 +453,9 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
 		ts := typeutil.GetTS(validRetrieveResults[sel], cursors[sel])
 		if _, ok := idTsMap[pk]; !ok {
+			if err := sizeLimiter.add(validRetrieveResults[sel].GetFieldsData(), cursors[sel], len(idTsMap)); err != nil {
+				return nil, err
+			}
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idTsMap[pk] = ts
@@ -352,6 +513,7 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
+	sizeLimiter := newResultSizeLimiter()
 	for j := 0; j < loopEnd; j++ {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
@@ -360,6 +522,9 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 
 		pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
 		if _, ok := idSet[pk]; !ok {
+			if err := sizeLimiter.add(validRetrieveResults[sel].GetFieldsData(), cursors[sel], len(idSet)); err != nil {
+				return nil, err
+			}
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
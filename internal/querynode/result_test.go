@@ -18,15 +18,23 @@ package querynode
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"math/rand"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -192,6 +200,19 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("test max result size", func(t *testing.T) {
+			// each row takes 8 bytes of int64 and 32 bytes of float vector
+			paramtable.Get().Save(Params.QueryNodeCfg.MaxResultSize.Key, "100")
+			defer paramtable.Get().Reset(Params.QueryNodeCfg.MaxResultSize.Key)
+
+			_, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			assert.ErrorIs(t, err, errorutil.ErrResultSizeExceeded)
+			assert.Equal(t, commonpb.ErrorCode_OutOfMemory, queryErrorCode(err))
+
+			result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(result.GetIds().GetStrId().GetData()))
+		})
 	})
 }
 
@@ -416,6 +437,216 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 	})
 }
 
+// genRandomSearchResultData generates the results of nq queries with at most topk results each. The scores collide
+// often, and the results of a query are sorted by score descending and pk ascending like the segcore outputs.
+func genRandomSearchResultData(r *rand.Rand, nq int64, topk int64, strPK bool) *schemapb.SearchResultData {
+	type result struct {
+		id    int64
+		score float32
+	}
+	var (
+		ids    []int64
+		strIDs []string
+		scores []float32
+		values []int64
+		topks  = make([]int64, nq)
+	)
+	for i := int64(0); i < nq; i++ {
+		results := make([]result, r.Int63n(topk+1))
+		for j, id := range r.Perm(int(topk * 3))[:len(results)] {
+			results[j] = result{id: int64(id), score: float32(r.Intn(8)) / 4}
+		}
+		sort.Slice(results, func(a, b int) bool {
+			if results[a].score != results[b].score {
+				return results[a].score > results[b].score
+			}
+			return results[a].id < results[b].id
+		})
+		for _, res := range results {
+			ids = append(ids, res.id)
+			strIDs = append(strIDs, fmt.Sprintf("%08d", res.id))
+			scores = append(scores, res.score)
+			values = append(values, res.id*10)
+		}
+		topks[i] = int64(len(results))
+	}
+
+	data := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		FieldsData: []*schemapb.FieldData{{
+			Type:    schemapb.DataType_Int64,
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+			}},
+		}},
+		Scores: scores,
+		Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+		Topks:  topks,
+	}
+	if strPK {
+		data.Ids = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: strIDs}}}
+	}
+	return data
+}
+
+// reduceSearchResultDataByScore is the reference of reduceSearchResultData, it sorts all the results of a query by
+// score descending and pk ascending, and keeps the first result of every pk.
+func reduceSearchResultDataByScore(searchResultData []*schemapb.SearchResultData, nq int64, topk int64) ([]interface{}, []float32, []int64) {
+	type result struct {
+		id    interface{}
+		score float32
+	}
+	var (
+		ids    []interface{}
+		scores = make([]float32, 0)
+		topks  = make([]int64, nq)
+	)
+	offsets := make([]int64, len(searchResultData))
+	for i := int64(0); i < nq; i++ {
+		var results []result
+		for k, data := range searchResultData {
+			for j := offsets[k]; j < offsets[k]+data.Topks[i]; j++ {
+				results = append(results, result{id: typeutil.GetPK(data.GetIds(), j), score: data.Scores[j]})
+			}
+			offsets[k] += data.Topks[i]
+		}
+		sort.SliceStable(results, func(a, b int) bool {
+			if results[a].score != results[b].score {
+				return results[a].score > results[b].score
+			}
+			return typeutil.ComparePK(results[a].id, results[b].id)
+		})
+		seen := make(map[interface{}]struct{})
+		for _, res := range results {
+			if topks[i] == topk {
+				break
+			}
+			if _, ok := seen[res.id]; ok {
+				continue
+			}
+			seen[res.id] = struct{}{}
+			ids = append(ids, res.id)
+			scores = append(scores, res.score)
+			topks[i]++
+		}
+	}
+	return ids, scores, topks
+}
+
+func TestResult_reduceSearchResultDataParallel(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %d", seed)
+	r := rand.New(rand.NewSource(seed))
+
+	reduce := func(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, minNQ string) *schemapb.SearchResultData {
+		paramtable.Get().Save(Params.QueryNodeCfg.ParallelReduceMinNQ.Key, minNQ)
+		defer paramtable.Get().Reset(Params.QueryNodeCfg.ParallelReduceMinNQ.Key)
+		res, err := reduceSearchResultData(context.TODO(), searchResultData, nq, topk)
+		assert.NoError(t, err)
+		return res
+	}
+
+	for round := 0; round < 100; round++ {
+		nq := r.Int63n(300)
+		topk := r.Int63n(20) + 1
+		strPK := r.Intn(2) == 0
+		searchResultData := make([]*schemapb.SearchResultData, r.Intn(4)+1)
+		for i := range searchResultData {
+			searchResultData[i] = genRandomSearchResultData(r, nq, topk, strPK)
+		}
+
+		sequential := reduce(searchResultData, nq, topk, "0")
+		parallel := reduce(searchResultData, nq, topk, "1")
+		assert.True(t, proto.Equal(sequential, parallel), "round %d, nq %d, topk %d", round, nq, topk)
+
+		ids, scores, topks := reduceSearchResultDataByScore(searchResultData, nq, topk)
+		assert.Equal(t, topks, parallel.GetTopks())
+		assert.Equal(t, len(ids), typeutil.GetSizeOfIDs(parallel.GetIds()))
+		assert.Equal(t, scores, parallel.GetScores())
+		values := parallel.GetFieldsData()[0].GetScalars().GetLongData().GetData()
+		for i, id := range ids {
+			assert.Equal(t, id, typeutil.GetPK(parallel.GetIds(), int64(i)))
+			if strPK {
+				assert.Equal(t, fmt.Sprintf("%08d", values[i]/10), id)
+			} else {
+				assert.Equal(t, values[i]/10, id)
+			}
+		}
+	}
+}
+
+func TestResult_encodeSearchResultDataFloat16(t *testing.T) {
+	data := &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       2,
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+		Scores:     []float32{0.75, 1.0 / 3},
+		Topks:      []int64{2},
+	}
+	fp32, err := encodeSearchResultData(data, 1, 2, "IP", internalpb.ResultPrecision_Float32)
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, fp32.GetResultPrecision())
+	assert.Nil(t, fp32.GetSlicedScores())
+
+	fp16, err := encodeSearchResultData(data, 1, 2, "IP", internalpb.ResultPrecision_Float16)
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_Float16, fp16.GetResultPrecision())
+	assert.Len(t, fp16.GetSlicedScores(), 4)
+	assert.Less(t, len(fp16.GetSlicedBlob())+len(fp16.GetSlicedScores()), len(fp32.GetSlicedBlob()))
+	// the data is kept as it was
+	assert.Equal(t, []float32{0.75, 1.0 / 3}, data.GetScores())
+
+	decoded, err := decodeSearchResults([]*internalpb.SearchResults{fp32, fp16})
+	assert.NoError(t, err)
+	assert.Len(t, decoded, 2)
+	assert.Equal(t, data.GetScores(), decoded[0].GetScores())
+	assert.Equal(t, []float32{0.75, 0.33325195}, decoded[1].GetScores())
+
+	bf16, err := encodeSearchResultData(data, 1, 2, "IP", internalpb.ResultPrecision_BFloat16)
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_BFloat16, bf16.GetResultPrecision())
+	decoded, err = decodeSearchResults([]*internalpb.SearchResults{bf16})
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.75, 0.33398438}, decoded[0].GetScores())
+
+	// the scores overflowing float16 are sent in float32
+	data.Scores = []float32{1e5, 1}
+	overflow, err := encodeSearchResultData(data, 1, 2, "L2", internalpb.ResultPrecision_Float16)
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ResultPrecision_Float32, overflow.GetResultPrecision())
+	assert.Nil(t, overflow.GetSlicedScores())
+	decoded, err = decodeSearchResults([]*internalpb.SearchResults{overflow})
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1e5, 1}, decoded[0].GetScores())
+
+	empty, err := encodeSearchResultData(&schemapb.SearchResultData{}, 1, 2, "IP", internalpb.ResultPrecision_Float16)
+	assert.NoError(t, err)
+	assert.Nil(t, empty.GetSlicedBlob())
+	assert.Nil(t, empty.GetSlicedScores())
+}
+
+func TestResult_encodeRetrieveResultPrecision(t *testing.T) {
+	newResult := func() *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			FieldsData: []*schemapb.FieldData{
+				genFieldData("vec", 101, schemapb.DataType_FloatVector, []float32{0.5, 1, 2, 4}, 2),
+				genFieldData("int64", 102, schemapb.DataType_Int64, []int64{1, 2}, 1),
+			},
+		}
+	}
+
+	result := newResult()
+	encodeRetrieveResultPrecision(result, internalpb.ResultPrecision_Float32)
+	assert.Equal(t, newResult(), result)
+
+	encodeRetrieveResultPrecision(result, internalpb.ResultPrecision_BFloat16)
+	assert.Equal(t, internalpb.ResultPrecision_BFloat16, result.GetResultPrecision())
+	assert.Len(t, result.GetReducedVectors()[101], 8)
+	assert.Empty(t, result.GetFieldsData()[0].GetVectors().GetFloatVector().GetData())
+}
+
 func TestResult_selectSearchResultData_int(t *testing.T) {
 	type args struct {
 		dataArray     []*schemapb.SearchResultData
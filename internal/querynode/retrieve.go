@@ -19,9 +19,11 @@ package querynode
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // retrieveOnSegments performs retrieve on listed segments
@@ -37,10 +39,31 @@ func retrieveOnSegments(ctx context.Context, replica ReplicaInterface, segType s
 			}
 			return nil, err
 		}
+		// a sealed segment without rows can't contribute to the result
+		if segType == segmentTypeSealed && seg.getRowCount() == 0 {
+			plan.filterStats.prune()
+			continue
+		}
+		// neither can a sealed segment whose composite index has no key matching the filter
+		if segType == segmentTypeSealed && !seg.mayMatch(plan.equalPredicates) {
+			plan.filterStats.prune()
+			continue
+		}
+		plan.filterStats.scan(seg)
+		if segType == segmentTypeSealed {
+			seg.heat.hit(time.Now())
+		}
+		// the vector fields skipped in lazy load mode are loaded by the first retrieve outputs them
+		release, err := seg.loadLazyFields(ctx, plan.outputFieldIDs)
+		if err != nil {
+			return nil, err
+		}
+		plan.lazyPins.add(release)
 		result, err := seg.retrieve(plan)
 		if err != nil {
 			return nil, err
 		}
+		plan.filterStats.pass(int64(typeutil.GetSizeOfIDs(result.GetIds())))
 		if err := seg.fillIndexedFieldsData(ctx, collID, vcm, result); err != nil {
 			return nil, err
 		}
@@ -2,6 +2,12 @@ package querynode
 
 import (
 	"container/list"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
 )
 
 type scheduleReadTaskPolicy func(sqTasks *list.List, targetUsage int32, maxNum int32) ([]readTask, int32)
@@ -25,3 +31,99 @@ func defaultScheduleReadPolicy(sqTasks *list.List, targetUsage int32, maxNum int
 	}
 	return ret, usage
 }
+
+// collectionWeightedScheduleReadPolicy schedules the ready tasks of the collections in weighted round robin,
+// every round a collection takes at most its weight of tasks, so a hot collection can not starve the others.
+// The collections are visited in the order of their oldest ready task.
+func collectionWeightedScheduleReadPolicy(sqTasks *list.List, targetUsage int32, maxNum int32) ([]readTask, int32) {
+	order, queues := groupReadTasksByCollection(sqTasks)
+	weights := getCollectionSchedulingWeights()
+
+	var ret []readTask
+	usage := int32(0)
+	for len(order) > 0 && maxNum > 0 {
+		next := make([]UniqueID, 0, len(order))
+		for _, collectionID := range order {
+			queue := queues[collectionID]
+			for i := 0; i < weights.get(collectionID) && len(queue) > 0 && maxNum > 0; i++ {
+				t := queue[0].Value.(readTask)
+				tUsage := t.CPUUsage()
+				if usage+tUsage > targetUsage {
+					return ret, usage
+				}
+				usage += tUsage
+				sqTasks.Remove(queue[0])
+				rateCol.rtCounter.sub(t, readyQueueType)
+				ret = append(ret, t)
+				maxNum--
+				queue = queue[1:]
+			}
+			queues[collectionID] = queue
+			if len(queue) > 0 {
+				next = append(next, collectionID)
+			}
+		}
+		order = next
+	}
+	return ret, usage
+}
+
+// groupReadTasksByCollection returns the collections in the order of their first task in tasks,
+// and the list elements of the tasks of each collection.
+func groupReadTasksByCollection(tasks *list.List) ([]UniqueID, map[UniqueID][]*list.Element) {
+	var order []UniqueID
+	queues := make(map[UniqueID][]*list.Element)
+	for e := tasks.Front(); e != nil; e = e.Next() {
+		t, ok := e.Value.(readTask)
+		if !ok {
+			continue
+		}
+		collectionID := t.GetCollectionID()
+		if _, ok := queues[collectionID]; !ok {
+			order = append(order, collectionID)
+		}
+		queues[collectionID] = append(queues[collectionID], e)
+	}
+	return order, queues
+}
+
+// collectionWeights is the scheduling weights of collections.
+type collectionWeights map[UniqueID]int
+
+// get returns the weight of the collection, 1 if not configured.
+func (w collectionWeights) get(collectionID UniqueID) int {
+	if weight, ok := w[collectionID]; ok {
+		return weight
+	}
+	return 1
+}
+
+// getCollectionSchedulingWeights parses queryNode.scheduler.collectionIsolation.weights,
+// the invalid pairs are ignored.
+func getCollectionSchedulingWeights() collectionWeights {
+	weights := make(collectionWeights)
+	value := Params.QueryNodeCfg.CollectionIsolationWeights.GetValue()
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.Split(pair, ":")
+		if len(kv) != 2 {
+			log.Warn("invalid collection scheduling weight", zap.String("pair", pair))
+			continue
+		}
+		collectionID, err := strconv.ParseInt(strings.TrimSpace(kv[0]), 10, 64)
+		if err != nil {
+			log.Warn("invalid collection scheduling weight", zap.String("pair", pair), zap.Error(err))
+			continue
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil || weight <= 0 {
+			log.Warn("invalid collection scheduling weight", zap.String("pair", pair), zap.Error(err))
+			continue
+		}
+		weights[collectionID] = weight
+	}
+	return weights
+}
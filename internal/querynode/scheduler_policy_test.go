@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 func TestScheduler_defaultScheduleReadPolicy(t *testing.T) {
@@ -58,3 +60,38 @@ func TestScheduler_defaultScheduleReadPolicy(t *testing.T) {
 	assert.Equal(t, actual, cur)
 	assert.Equal(t, 4, len(tasks))
 }
+
+func TestScheduler_collectionWeightedScheduleReadPolicy(t *testing.T) {
+	paramtable.Get().Save(Params.QueryNodeCfg.CollectionIsolationWeights.Key, "2:2, invalid, 3:0")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.CollectionIsolationWeights.Key)
+
+	readyReadTasks := list.New()
+	// the hot collection 1 queues its tasks before collection 2 and 3
+	for i := 0; i < 6; i++ {
+		readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, collectionID: 1})
+	}
+	for i := 0; i < 3; i++ {
+		readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, collectionID: 2})
+	}
+	readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, collectionID: 3})
+
+	tasks, cur := collectionWeightedScheduleReadPolicy(readyReadTasks, 1000, 6)
+	assert.Equal(t, int32(60), cur)
+	collections := make([]UniqueID, 0, len(tasks))
+	for _, task := range tasks {
+		collections = append(collections, task.GetCollectionID())
+	}
+	// collection 2 has weight 2, the invalid weight of collection 3 is ignored
+	assert.Equal(t, []UniqueID{1, 2, 2, 3, 1, 2}, collections)
+	assert.Equal(t, 4, readyReadTasks.Len())
+
+	// stops at the task exceeding the target usage
+	tasks, cur = collectionWeightedScheduleReadPolicy(readyReadTasks, 25, math.MaxInt32)
+	assert.Equal(t, int32(20), cur)
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, 2, readyReadTasks.Len())
+
+	tasks, cur = collectionWeightedScheduleReadPolicy(readyReadTasks, 1000, 0)
+	assert.Equal(t, int32(0), cur)
+	assert.Equal(t, 0, len(tasks))
+}
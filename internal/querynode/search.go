@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -63,6 +64,28 @@ func searchSegments(ctx context.Context, replica ReplicaInterface, segType segme
 				log.Error(err.Error()) // should not happen but still ignore it since the result is still correct
 				return
 			}
+			// a sealed segment without rows can't contribute to the result
+			if segType == segmentTypeSealed && seg.getRowCount() == 0 {
+				searchReq.filterStats.prune()
+				return
+			}
+			// neither can a sealed segment whose composite index has no key matching the filter
+			if segType == segmentTypeSealed && !seg.mayMatch(searchReq.equalPredicates) {
+				searchReq.filterStats.prune()
+				return
+			}
+			searchReq.filterStats.scan(seg)
+			if segType == segmentTypeSealed {
+				seg.heat.hit(time.Now())
+			}
+
+			// the vector fields skipped in lazy load mode are loaded by the first search needs them
+			release, err := seg.loadLazyFields(ctx, searchReq.lazyLoadFieldIDs())
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			searchReq.lazyPins.add(release)
 
 			if !seg.hasLoadIndexForIndexedField(searchReq.searchFieldID) {
 				mu.Lock()
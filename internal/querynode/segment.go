@@ -39,7 +39,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
-	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 
@@ -74,6 +73,12 @@ type IndexedFieldInfo struct {
 	indexInfo   *querypb.FieldIndexInfo
 }
 
+// lazyLoadedField is a field loaded on demand in lazy load mode
+type lazyLoadedField struct {
+	binlog *datapb.FieldBinlog
+	pins   int // the number of requests using the field, it's not evicted until unpinned
+}
+
 // Segment is a wrapper of the underlying C-structure segment.
 type Segment struct {
 	mut        sync.RWMutex // protects segmentPtr
@@ -97,10 +102,25 @@ type Segment struct {
 
 	indexedFieldInfos *typeutil.ConcurrentMap[UniqueID, *IndexedFieldInfo]
 
+	// only used by sealed segments in lazy load mode, the fields are loaded on the first request needs them
+	lazyLoadMut  sync.Mutex
+	lazyFields   map[UniqueID]*datapb.FieldBinlog // the fields not loaded
+	lazyLoaded   map[UniqueID]*lazyLoadedField    // the fields loaded on demand, which may be evicted
+	lazyLoading  map[UniqueID]chan struct{}       // closed once the ongoing loading of the field is done
+	lazyLoadFunc func(ctx context.Context, field *datapb.FieldBinlog) error
+
 	statLock sync.Mutex
+	// only used by growing segments, updated per insert batch
+	currentStat *storage.PkStatisticsChain
 	// only used by sealed segments
-	currentStat  *storage.PkStatistics
 	historyStats []*storage.PkStatistics
+
+	// query heat, only used by sealed segments
+	heat segmentHeat
+
+	// composite scalar indexes, only used by sealed segments to skip the reads no row can match
+	compositeMut     sync.RWMutex
+	compositeIndexes []*compositeIndex
 }
 
 // ID returns the identity number.
@@ -147,6 +167,145 @@ func (s *Segment) getIndexedFieldInfo(fieldID UniqueID) (*IndexedFieldInfo, erro
 	}, nil
 }
 
+// setLazyFields records the fields not loaded yet, loadFunc is used to load them on demand
+func (s *Segment) setLazyFields(fields []*datapb.FieldBinlog, loadFunc func(ctx context.Context, field *datapb.FieldBinlog) error) {
+	s.lazyLoadMut.Lock()
+	defer s.lazyLoadMut.Unlock()
+	s.lazyFields = make(map[UniqueID]*datapb.FieldBinlog, len(fields))
+	for _, field := range fields {
+		s.lazyFields[field.GetFieldID()] = field
+	}
+	s.lazyLoaded = make(map[UniqueID]*lazyLoadedField)
+	s.lazyLoading = make(map[UniqueID]chan struct{})
+	s.lazyLoadFunc = loadFunc
+}
+
+// hasLazyField returns true if the field is not loaded yet in lazy load mode
+func (s *Segment) hasLazyField(fieldID UniqueID) bool {
+	s.lazyLoadMut.Lock()
+	defer s.lazyLoadMut.Unlock()
+	_, ok := s.lazyFields[fieldID]
+	return ok
+}
+
+// loadLazyFields loads the fields not loaded yet among fieldIDs, the concurrent requests of the same field wait for
+// the same loading while the other fields are loaded in parallel. The loaded fields are pinned against the eviction of
+// lazyFieldCache until the returned release func is called.
+func (s *Segment) loadLazyFields(ctx context.Context, fieldIDs []UniqueID) (func(), error) {
+	pinned := make([]UniqueID, 0)
+	release := func() {
+		s.unpinLazyFields(pinned)
+	}
+	for _, fieldID := range fieldIDs {
+		ok, err := s.loadLazyField(ctx, fieldID)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		if ok {
+			pinned = append(pinned, fieldID)
+		}
+	}
+	return release, nil
+}
+
+// loadLazyField loads the field if it's not loaded yet and pins it, returns false if the field is not a lazy field.
+func (s *Segment) loadLazyField(ctx context.Context, fieldID UniqueID) (bool, error) {
+	for {
+		s.lazyLoadMut.Lock()
+		if loaded, ok := s.lazyLoaded[fieldID]; ok {
+			loaded.pins++
+			s.lazyLoadMut.Unlock()
+			lazyFieldCache.touch(s, fieldID)
+			return true, nil
+		}
+		field, ok := s.lazyFields[fieldID]
+		if !ok {
+			s.lazyLoadMut.Unlock()
+			return false, nil
+		}
+		if loading, ok := s.lazyLoading[fieldID]; ok {
+			s.lazyLoadMut.Unlock()
+			select {
+			case <-loading:
+				// check again, the loading may fail
+				continue
+			case <-ctx.Done():
+				return false, ctx.Err()
+			}
+		}
+		loading := make(chan struct{})
+		s.lazyLoading[fieldID] = loading
+		s.lazyLoadMut.Unlock()
+
+		tr := timerecord.NewTimeRecorder("lazyLoadField")
+		err := s.lazyLoadFunc(ctx, field)
+
+		s.lazyLoadMut.Lock()
+		delete(s.lazyLoading, fieldID)
+		close(loading)
+		if err != nil {
+			s.lazyLoadMut.Unlock()
+			log.Warn("failed to lazy load field",
+				zap.Int64("collectionID", s.collectionID),
+				zap.Int64("segmentID", s.segmentID),
+				zap.Int64("fieldID", fieldID),
+				zap.Error(err))
+			return false, err
+		}
+		delete(s.lazyFields, fieldID)
+		s.lazyLoaded[fieldID] = &lazyLoadedField{binlog: field, pins: 1}
+		s.lazyLoadMut.Unlock()
+
+		lazyFieldCache.add(s, fieldID, funcutil.GetFieldSizeFromFieldBinlog(field))
+		log.Info("lazy load field done",
+			zap.Int64("collectionID", s.collectionID),
+			zap.Int64("segmentID", s.segmentID),
+			zap.Int64("fieldID", fieldID),
+			zap.Duration("duration", tr.ElapseSpan()))
+		return true, nil
+	}
+}
+
+func (s *Segment) unpinLazyFields(fieldIDs []UniqueID) {
+	s.lazyLoadMut.Lock()
+	defer s.lazyLoadMut.Unlock()
+	for _, fieldID := range fieldIDs {
+		if loaded, ok := s.lazyLoaded[fieldID]; ok {
+			loaded.pins--
+		}
+	}
+}
+
+// evictLazyField drops the data of the lazy loaded field, which is loaded again by the next request needs it.
+// It returns false if the field is still pinned by some requests.
+func (s *Segment) evictLazyField(fieldID UniqueID) bool {
+	s.lazyLoadMut.Lock()
+	defer s.lazyLoadMut.Unlock()
+	loaded, ok := s.lazyLoaded[fieldID]
+	if !ok {
+		return true
+	}
+	if loaded.pins > 0 {
+		return false
+	}
+	if err := s.segmentDropFieldData(fieldID); err != nil && !errors.Is(err, ErrSegmentUnhealthy) {
+		log.Warn("failed to evict lazy loaded field",
+			zap.Int64("collectionID", s.collectionID),
+			zap.Int64("segmentID", s.segmentID),
+			zap.Int64("fieldID", fieldID),
+			zap.Error(err))
+		return false
+	}
+	delete(s.lazyLoaded, fieldID)
+	s.lazyFields[fieldID] = loaded.binlog
+	log.Info("lazy loaded field evicted",
+		zap.Int64("collectionID", s.collectionID),
+		zap.Int64("segmentID", s.segmentID),
+		zap.Int64("fieldID", fieldID))
+	return true
+}
+
 func (s *Segment) hasLoadIndexForIndexedField(fieldID int64) bool {
 	fieldInfo, ok := s.indexedFieldInfos.Get(fieldID)
 	if !ok {
@@ -236,6 +395,7 @@ func deleteSegment(segment *Segment) {
 	}
 
 	C.DeleteSegment(cPtr)
+	lazyFieldCache.remove(segment)
 
 	segment.currentStat = nil
 	segment.historyStats = nil
@@ -590,30 +750,29 @@ func (s *Segment) updateBloomFilter(pks []primaryKey) {
 	s.statLock.Lock()
 	defer s.statLock.Unlock()
 	s.InitCurrentStat()
-	buf := make([]byte, 8)
-	for _, pk := range pks {
-		s.currentStat.UpdateMinMax(pk)
-		switch pk.Type() {
-		case schemapb.DataType_Int64:
-			int64Value := pk.(*int64PrimaryKey).Value
-			common.Endian.PutUint64(buf, uint64(int64Value))
-			s.currentStat.PkFilter.Add(buf)
-		case schemapb.DataType_VarChar:
-			stringValue := pk.(*varCharPrimaryKey).Value
-			s.currentStat.PkFilter.AddString(stringValue)
-		default:
-			log.Error("failed to update bloomfilter", zap.Any("PK type", pk.Type()))
-			panic("failed to update bloomfilter")
-		}
+	if err := s.currentStat.Update(pks); err != nil {
+		log.Error("failed to update bloomfilter", zap.Error(err))
+		panic("failed to update bloomfilter")
 	}
 }
 
 func (s *Segment) InitCurrentStat() {
 	if s.currentStat == nil {
-		s.currentStat = &storage.PkStatistics{
-			PkFilter: bloom.NewWithEstimates(storage.BloomFilterSize, storage.MaxBloomFalsePositive),
-		}
+		s.currentStat = storage.NewPkStatisticsChain(uint(Params.QueryNodeCfg.GrowingPkFilterBlockRows.GetAsInt64()))
+	}
+}
+
+// pkStatsDeltas returns all the pk statistics of the segment, the history stats and the blocks of the current stat,
+// so that the segment replacing it merges them instead of loading its stats logs
+func (s *Segment) pkStatsDeltas() []*storage.PkStatistics {
+	s.statLock.Lock()
+	defer s.statLock.Unlock()
+	ret := make([]*storage.PkStatistics, 0, len(s.historyStats)+1)
+	ret = append(ret, s.historyStats...)
+	if s.currentStat != nil {
+		ret = append(ret, s.currentStat.Deltas(0, true)...)
 	}
+	return ret
 }
 
 // check if PK exists is current
@@ -633,6 +792,23 @@ func (s *Segment) isPKExist(pk primaryKey) bool {
 	return false
 }
 
+// batchPKExist checks the pks in one pass under the stat lock, hits[i] is true if pks[i] may exist,
+// sorted means the pks are in ascending order so that the stats only check the pks inside their pk range
+func (s *Segment) batchPKExist(pks []primaryKey, sorted bool) []bool {
+	hits := make([]bool, len(pks))
+	s.statLock.Lock()
+	defer s.statLock.Unlock()
+	if s.currentStat != nil {
+		s.currentStat.BatchPkExist(pks, sorted, hits)
+	}
+
+	// for sealed, if one of the stats shows it exist, then we have to check it
+	for _, historyStat := range s.historyStats {
+		historyStat.BatchPkExist(pks, sorted, hits)
+	}
+	return hits
+}
+
 // -------------------------------------------------------------------------------------- interfaces for growing segment
 func (s *Segment) segmentPreInsert(numOfRecords int) (int64, error) {
 	/*
@@ -829,6 +1005,24 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *sche
 	return nil
 }
 
+func (s *Segment) segmentDropFieldData(fieldID int64) error {
+	/*
+		CStatus
+		DropFieldData(CSegmentInterface c_segment, int64_t field_id);
+	*/
+	if s.getType() != segmentTypeSealed {
+		return fmt.Errorf("segmentDropFieldData failed, illegal segment type %s, segmentID = %d", s.getType().String(), s.ID())
+	}
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	if !s.healthy() {
+		return fmt.Errorf("%w(segmentID=%d)", ErrSegmentUnhealthy, s.segmentID)
+	}
+
+	status := C.DropFieldData(s.segmentPtr, C.int64_t(fieldID))
+	return HandleCStatus(&status, "DropFieldData failed")
+}
+
 func (s *Segment) segmentLoadDeletedRecord(primaryKeys []primaryKey, timestamps []Timestamp, rowCount int64) error {
 	s.mut.RLock()
 	defer s.mut.RUnlock()
@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	datacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// segmentHeat is the query heat of a sealed segment, every search or query on the segment adds 1 to it,
+// and it decays by half every queryNode.segmentHeat.halfLife.
+type segmentHeat struct {
+	mu      sync.Mutex
+	value   float64
+	updated time.Time
+}
+
+func decaySegmentHeat(value float64, elapsed time.Duration) float64 {
+	halfLife := Params.QueryNodeCfg.SegmentHeatHalfLife.GetAsDuration(time.Second)
+	if halfLife <= 0 || elapsed <= 0 {
+		return value
+	}
+	return value * math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+}
+
+// hit records a search or query on the segment.
+func (h *segmentHeat) hit(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.value = decaySegmentHeat(h.value, now.Sub(h.updated)) + 1
+	h.updated = now
+}
+
+// get returns the heat at now.
+func (h *segmentHeat) get(now time.Time) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return decaySegmentHeat(h.value, now.Sub(h.updated))
+}
+
+// listSegmentHeats returns the heats of the sealed segments searched or queried, from the hottest.
+func (node *QueryNode) listSegmentHeats() []metricsinfo.SegmentHeat {
+	now := time.Now()
+	heats := make([]metricsinfo.SegmentHeat, 0)
+	for _, segment := range node.metaReplica.getSealedSegments() {
+		heat := segment.heat.get(now)
+		if heat <= 0 {
+			continue
+		}
+		heats = append(heats, metricsinfo.SegmentHeat{
+			SegmentID:    segment.ID(),
+			CollectionID: segment.collectionID,
+			Heat:         heat,
+		})
+	}
+	sort.Slice(heats, func(i, j int) bool {
+		if heats[i].Heat != heats[j].Heat {
+			return heats[i].Heat > heats[j].Heat
+		}
+		return heats[i].SegmentID < heats[j].SegmentID
+	})
+	return heats
+}
+
+type dataCoordCreatorFunc func(ctx context.Context, metaRootPath string, etcdClient *clientv3.Client) (types.DataCoord, error)
+
+func defaultDataCoordCreatorFunc(ctx context.Context, metaRootPath string, etcdClient *clientv3.Client) (types.DataCoord, error) {
+	dataCoord, err := datacoordclient.NewClient(ctx, metaRootPath, etcdClient)
+	if err != nil {
+		return nil, err
+	}
+	if err := dataCoord.Init(); err != nil {
+		return nil, err
+	}
+	if err := dataCoord.Start(); err != nil {
+		return nil, err
+	}
+	return dataCoord, nil
+}
+
+// reportSegmentHeats sends the heats of the sealed segments to DataCoord.
+func (node *QueryNode) reportSegmentHeats(ctx context.Context, dataCoord types.DataCoord) error {
+	heats := node.listSegmentHeats()
+	segments := make([]*datapb.SegmentHeat, 0, len(heats))
+	for _, heat := range heats {
+		segments = append(segments, &datapb.SegmentHeat{
+			SegmentID:    heat.SegmentID,
+			CollectionID: heat.CollectionID,
+			Heat:         heat.Heat,
+		})
+	}
+	status, err := dataCoord.ReportSegmentHeats(ctx, &datapb.ReportSegmentHeatsRequest{
+		Base:     commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		Segments: segments,
+	})
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to report segment heats, reason: %s", status.GetReason())
+	}
+	return nil
+}
+
+// startSegmentHeatReportLoop reports the heats of the sealed segments to DataCoord periodically, so QueryCoord
+// loads the hot segments first after the QueryNode goes down.
+func (node *QueryNode) startSegmentHeatReportLoop(ctx context.Context) {
+	interval := Params.QueryNodeCfg.SegmentHeatReportInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		log.Info("segment heat report disabled")
+		return
+	}
+	go func() {
+		defer logutil.LogPanic()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var dataCoord types.DataCoord
+		defer func() {
+			if dataCoord != nil {
+				dataCoord.Stop()
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("segment heat report loop shutdown")
+				return
+			case <-ticker.C:
+				if dataCoord == nil {
+					var err error
+					dataCoord, err = node.dataCoordCreator(ctx, Params.EtcdCfg.MetaRootPath.GetValue(), node.etcdCli)
+					if err != nil {
+						log.Warn("failed to create DataCoord client for segment heat report", zap.Error(err))
+						continue
+					}
+				}
+				if err := node.reportSegmentHeats(ctx, dataCoord); err != nil {
+					log.RatedWarn(60, "failed to report segment heats", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
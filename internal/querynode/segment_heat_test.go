@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// segmentHeatDataCoord is a DataCoord answering the segment heat reports only.
+type segmentHeatDataCoord struct {
+	types.DataCoord
+	report *datapb.ReportSegmentHeatsRequest
+	status *commonpb.Status
+	err    error
+}
+
+func (d *segmentHeatDataCoord) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error) {
+	d.report = req
+	return d.status, d.err
+}
+
+func TestSegmentHeat(t *testing.T) {
+	halfLife := Params.QueryNodeCfg.SegmentHeatHalfLife.GetAsDuration(time.Second)
+	now := time.Now()
+	h := &segmentHeat{}
+	assert.Equal(t, 0.0, h.get(now))
+	h.hit(now)
+	h.hit(now)
+	assert.InDelta(t, 2.0, h.get(now), 1e-9)
+	assert.InDelta(t, 1.0, h.get(now.Add(halfLife)), 1e-9)
+	h.hit(now.Add(halfLife))
+	assert.InDelta(t, 2.0, h.get(now.Add(halfLife)), 1e-9)
+}
+
+func TestQueryNode_SegmentHeats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	assert.Empty(t, node.listSegmentHeats())
+	segment, err := node.metaReplica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	require.NoError(t, err)
+	segment.heat.hit(time.Now())
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SegmentHeatMetrics)
+	require.NoError(t, err)
+	resp, err := node.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	var heats []metricsinfo.SegmentHeat
+	require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &heats))
+	require.Equal(t, 1, len(heats))
+	assert.Equal(t, defaultSegmentID, heats[0].SegmentID)
+	assert.Equal(t, defaultCollectionID, heats[0].CollectionID)
+
+	dataCoord := &segmentHeatDataCoord{
+		status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+	assert.NoError(t, node.reportSegmentHeats(ctx, dataCoord))
+	assert.Equal(t, paramtable.GetNodeID(), dataCoord.report.GetBase().GetSourceID())
+	require.Equal(t, 1, len(dataCoord.report.GetSegments()))
+	assert.Equal(t, defaultSegmentID, dataCoord.report.GetSegments()[0].GetSegmentID())
+
+	dataCoord.status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+	assert.Error(t, node.reportSegmentHeats(ctx, dataCoord))
+	dataCoord.err = errors.New("mock")
+	assert.Error(t, node.reportSegmentHeats(ctx, dataCoord))
+}
@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// the phases of loading a segment
+const (
+	segmentLoadPhasePending            = "pending"
+	segmentLoadPhaseDownloading        = "downloading"
+	segmentLoadPhaseLoadingIndex       = "loading_index"
+	segmentLoadPhaseLoadingRawData     = "loading_raw_data"
+	segmentLoadPhaseLoadingBloomFilter = "loading_bloom_filter"
+	segmentLoadPhaseLoadingDelta       = "loading_delta"
+	segmentLoadPhaseLoaded             = "loaded"
+	segmentLoadPhaseFailed             = "failed"
+)
+
+// maxEndedSegmentLoads is the max number of the ended segment loads kept for the metrics
+const maxEndedSegmentLoads = 256
+
+// segmentLoadProgress records the progress of loading a segment, it's safe to be updated by the io workers concurrently.
+type segmentLoadProgress struct {
+	mu        sync.Mutex
+	metrics   metricsinfo.SegmentLoadMetrics
+	startTime time.Time
+	endTime   time.Time
+}
+
+func (p *segmentLoadProgress) snapshot(now time.Time) metricsinfo.SegmentLoadMetrics {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ret := p.metrics
+	if !p.endTime.IsZero() {
+		now = p.endTime
+	}
+	elapsed := now.Sub(p.startTime).Seconds()
+	ret.StartTime = p.startTime.String()
+	ret.ElapsedSeconds = elapsed
+	switch {
+	case ret.TotalFiles > 0:
+		ret.DownloadPercent = float64(ret.DownloadedFiles) * 100 / float64(ret.TotalFiles)
+	case !p.endTime.IsZero():
+		ret.DownloadPercent = 100
+	}
+	switch {
+	case !p.endTime.IsZero():
+		ret.EstimatedRemainingSeconds = 0
+	case ret.TotalFiles == 0 || ret.DownloadedFiles == 0:
+		ret.EstimatedRemainingSeconds = -1
+	default:
+		ret.EstimatedRemainingSeconds = elapsed * float64(ret.TotalFiles-ret.DownloadedFiles) / float64(ret.DownloadedFiles)
+	}
+	return ret
+}
+
+// segmentLoadTracker tracks the loading segments and the recently ended ones of QueryNode,
+// all the methods are no-op on a nil tracker or an untracked segment.
+type segmentLoadTracker struct {
+	mu       sync.RWMutex
+	segments map[UniqueID]*segmentLoadProgress // segment id -> progress
+	ended    []UniqueID                        // the ended segments in the order of end
+}
+
+func newSegmentLoadTracker() *segmentLoadTracker {
+	return &segmentLoadTracker{
+		segments: make(map[UniqueID]*segmentLoadProgress),
+	}
+}
+
+// countBinlogs returns the number of the binlog files of the fields.
+func countBinlogs(fieldBinlogs []*datapb.FieldBinlog) int {
+	count := 0
+	for _, fieldBinlog := range fieldBinlogs {
+		count += len(fieldBinlog.GetBinlogs())
+	}
+	return count
+}
+
+// add begins to track the segment waiting for a load worker, a segment loaded again replaces the ended one.
+func (t *segmentLoadTracker) add(loadInfo *querypb.SegmentLoadInfo) {
+	if t == nil {
+		return
+	}
+	p := &segmentLoadProgress{
+		metrics: metricsinfo.SegmentLoadMetrics{
+			SegmentID:    loadInfo.GetSegmentID(),
+			CollectionID: loadInfo.GetCollectionID(),
+			PartitionID:  loadInfo.GetPartitionID(),
+			NodeID:       paramtable.GetNodeID(),
+			Phase:        segmentLoadPhasePending,
+		},
+		startTime: time.Now(),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.removeEnded(loadInfo.GetSegmentID())
+	t.segments[loadInfo.GetSegmentID()] = p
+}
+
+// get returns the progress of the segment if it's still loading.
+func (t *segmentLoadTracker) get(segmentID UniqueID) *segmentLoadProgress {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	p, ok := t.segments[segmentID]
+	if !ok {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.endTime.IsZero() {
+		return nil
+	}
+	return p
+}
+
+// start marks the segment picked up by a load worker, the elapsed time is counted from now on.
+func (t *segmentLoadTracker) start(segmentID UniqueID) {
+	p := t.get(segmentID)
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.Phase = segmentLoadPhaseDownloading
+	p.startTime = time.Now()
+}
+
+func (t *segmentLoadTracker) setPhase(segmentID UniqueID, phase string) {
+	p := t.get(segmentID)
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.Phase = phase
+}
+
+// setTotalFiles sets the number of the files to download, it's known once the segment loader decides
+// which binlogs and index files to load.
+func (t *segmentLoadTracker) setTotalFiles(segmentID UniqueID, n int) {
+	p := t.get(segmentID)
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.TotalFiles = n
+}
+
+// filesDownloaded adds n files read from the storage to the segment.
+func (t *segmentLoadTracker) filesDownloaded(segmentID UniqueID, n int) {
+	p := t.get(segmentID)
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics.DownloadedFiles += n
+	if p.metrics.DownloadedFiles > p.metrics.TotalFiles {
+		p.metrics.DownloadedFiles = p.metrics.TotalFiles
+	}
+}
+
+// end marks the segment loaded or failed by err, the oldest ended segments are dropped
+// once more than maxEndedSegmentLoads are kept.
+func (t *segmentLoadTracker) end(segmentID UniqueID, err error) {
+	p := t.get(segmentID)
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.endTime = time.Now()
+	if err != nil {
+		p.metrics.Phase = segmentLoadPhaseFailed
+		p.metrics.FailedReason = err.Error()
+	} else {
+		p.metrics.Phase = segmentLoadPhaseLoaded
+		p.metrics.DownloadedFiles = p.metrics.TotalFiles
+	}
+	p.mu.Unlock()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.segments[segmentID] != p {
+		return
+	}
+	t.removeEnded(segmentID)
+	t.ended = append(t.ended, segmentID)
+	for len(t.ended) > maxEndedSegmentLoads {
+		delete(t.segments, t.ended[0])
+		t.ended = t.ended[1:]
+	}
+}
+
+func (t *segmentLoadTracker) removeEnded(segmentID UniqueID) {
+	for i, id := range t.ended {
+		if id == segmentID {
+			t.ended = append(t.ended[:i], t.ended[i+1:]...)
+			return
+		}
+	}
+}
+
+// list returns the progress of the tracked segments sorted by segment id.
+func (t *segmentLoadTracker) list() []metricsinfo.SegmentLoadMetrics {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	now := time.Now()
+	ret := make([]metricsinfo.SegmentLoadMetrics, 0, len(t.segments))
+	for _, p := range t.segments {
+		ret = append(ret, p.snapshot(now))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].SegmentID < ret[j].SegmentID })
+	return ret
+}
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestSegmentLoadTracker(t *testing.T) {
+	tracker := newSegmentLoadTracker()
+	tracker.add(&querypb.SegmentLoadInfo{SegmentID: 2, CollectionID: 100, PartitionID: 101})
+	tracker.add(&querypb.SegmentLoadInfo{SegmentID: 1, CollectionID: 100, PartitionID: 101})
+
+	segments := tracker.list()
+	assert.Equal(t, 2, len(segments))
+	assert.Equal(t, int64(1), segments[0].SegmentID)
+	assert.Equal(t, segmentLoadPhasePending, segments[1].Phase)
+	assert.Equal(t, float64(-1), segments[1].EstimatedRemainingSeconds)
+
+	tracker.start(2)
+	tracker.setTotalFiles(2, 4)
+	tracker.setPhase(2, segmentLoadPhaseLoadingIndex)
+	tracker.filesDownloaded(2, 1)
+	segments = tracker.list()
+	assert.Equal(t, int64(100), segments[1].CollectionID)
+	assert.Equal(t, segmentLoadPhaseLoadingIndex, segments[1].Phase)
+	assert.Equal(t, 4, segments[1].TotalFiles)
+	assert.Equal(t, 1, segments[1].DownloadedFiles)
+	assert.Equal(t, float64(25), segments[1].DownloadPercent)
+	assert.GreaterOrEqual(t, segments[1].EstimatedRemainingSeconds, float64(0))
+
+	// the downloaded files never exceed the total
+	tracker.filesDownloaded(2, 10)
+	assert.Equal(t, 4, tracker.list()[1].DownloadedFiles)
+
+	tracker.end(2, nil)
+	segments = tracker.list()
+	assert.Equal(t, segmentLoadPhaseLoaded, segments[1].Phase)
+	assert.Equal(t, float64(100), segments[1].DownloadPercent)
+	assert.Equal(t, float64(0), segments[1].EstimatedRemainingSeconds)
+	elapsed := segments[1].ElapsedSeconds
+	assert.Equal(t, elapsed, tracker.list()[1].ElapsedSeconds)
+
+	// the ended segments are not updated any more
+	tracker.setPhase(2, segmentLoadPhaseLoadingDelta)
+	tracker.end(2, errors.New("mock"))
+	assert.Equal(t, segmentLoadPhaseLoaded, tracker.list()[1].Phase)
+
+	tracker.end(1, errors.New("mock"))
+	segments = tracker.list()
+	assert.Equal(t, segmentLoadPhaseFailed, segments[0].Phase)
+	assert.Equal(t, "mock", segments[0].FailedReason)
+
+	// the oldest ended segments are dropped
+	for i := 0; i < maxEndedSegmentLoads; i++ {
+		tracker.add(&querypb.SegmentLoadInfo{SegmentID: int64(10 + i)})
+		tracker.end(int64(10+i), nil)
+	}
+	tracker.add(&querypb.SegmentLoadInfo{SegmentID: 3})
+	segments = tracker.list()
+	assert.Equal(t, maxEndedSegmentLoads+1, len(segments))
+	assert.Equal(t, int64(3), segments[0].SegmentID)
+
+	// nil tracker is no-op
+	var nilTracker *segmentLoadTracker
+	nilTracker.add(&querypb.SegmentLoadInfo{SegmentID: 1})
+	nilTracker.start(1)
+	nilTracker.filesDownloaded(1, 1)
+	nilTracker.end(1, nil)
+	assert.Empty(t, nilTracker.list())
+}
+
+func TestCountBinlogs(t *testing.T) {
+	assert.Equal(t, 0, countBinlogs(nil))
+	assert.Equal(t, 3, countBinlogs([]*datapb.FieldBinlog{
+		{FieldID: 1, Binlogs: []*datapb.Binlog{{LogPath: "a"}, {LogPath: "b"}}},
+		{FieldID: 2, Binlogs: []*datapb.Binlog{{LogPath: "c"}}},
+	}))
+}
@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strconv"
+	"sync"
 	"time"
 
 	"go.uber.org/multierr"
@@ -76,6 +77,12 @@ type segmentLoader struct {
 	cpuPool *concurrency.Pool
 
 	factory msgstream.Factory
+
+	loadTracker *segmentLoadTracker
+
+	// the memory reserved by the ongoing loadings of the lazy fields
+	lazyReserveMut sync.Mutex
+	lazyReserved   uint64
 }
 
 func (loader *segmentLoader) getFieldType(segment *Segment, fieldID FieldID) (schemapb.DataType, error) {
@@ -172,7 +179,9 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context, req *querypb.LoadS
 		segment := newSegments[segmentID]
 
 		tr := timerecord.NewTimeRecorder("loadDurationPerSegment")
+		loader.loadTracker.start(segmentID)
 		err := loader.loadFiles(ctx, segment, loadInfo)
+		loader.loadTracker.end(segmentID, err)
 		if err != nil {
 			log.Error("load segment failed when load data into memory",
 				zap.Int64("partitionID", partitionID),
@@ -192,8 +201,17 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context, req *querypb.LoadS
 	log.Info("start to load segments in parallel",
 		zap.Int("segmentNum", segmentNum),
 		zap.Int("concurrencyLevel", concurrencyLevel))
+	for _, info := range req.Infos {
+		loader.loadTracker.add(info)
+	}
 	loadErr := funcutil.ProcessFuncParallel(segmentNum,
 		concurrencyLevel, loadFileFunc, "loadSegmentFunc")
+	// the segments never picked up by a load worker since another one failed
+	for _, info := range req.Infos {
+		if !loadDoneSegmentIDSet.Contain(info.GetSegmentID()) {
+			loader.loadTracker.end(info.GetSegmentID(), loadErr)
+		}
+	}
 	// set segment which has been loaded done to meta replica
 	failedSetMetaSegmentIDs := make([]UniqueID, 0)
 	for _, id := range loadDoneSegmentIDSet.Collect() {
@@ -243,10 +261,22 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 	// for now, there will be multiple copies in the process of data loading into segCore
 	defer debug.FreeOSMemory()
 
+	var pkStatsBinlogs []string
+	if pkFieldID != common.InvalidFieldID {
+		pkStatsBinlogs = loader.filterPKStatsBinlogs(loadInfo.Statslogs, pkFieldID)
+	}
+	// the stats and delta logs are always downloaded
+	downloadFiles := len(pkStatsBinlogs) + countBinlogs(loadInfo.Deltalogs)
+
 	if segment.getType() == segmentTypeSealed {
 		fieldID2IndexInfo := make(map[int64]*querypb.FieldIndexInfo)
+		var compositeIndexInfos []*querypb.FieldIndexInfo
 		for _, indexInfo := range loadInfo.IndexInfos {
-			if len(indexInfo.IndexFilePaths) > 0 {
+			if len(indexInfo.IndexFilePaths) > 0 && isCompositeIndex(indexInfo) {
+				compositeIndexInfos = append(compositeIndexInfos, indexInfo)
+				continue
+			}
+			if len(indexInfo.IndexFilePaths) > 0 && isSegcoreLoadableIndex(indexInfo) {
 				fieldID := indexInfo.FieldID
 				fieldID2IndexInfo[fieldID] = indexInfo
 			}
@@ -269,13 +299,45 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 			}
 		}
 
+		// in lazy load mode, the raw vector fields without index are loaded when a request needs them
+		if Params.QueryNodeCfg.LazyLoadVectorField.GetAsBool() {
+			var lazyFields []*datapb.FieldBinlog
+			fieldBinlogs, lazyFields, err = loader.splitLazyFields(segment, fieldBinlogs)
+			if err != nil {
+				return err
+			}
+			segment.setLazyFields(lazyFields, func(ctx context.Context, field *datapb.FieldBinlog) error {
+				return loader.loadLazyField(ctx, segment, field, loadInfo)
+			})
+		}
+
+		for _, fieldInfo := range indexedFieldInfos {
+			downloadFiles += len(fieldInfo.indexInfo.GetIndexFilePaths())
+		}
+		for _, indexInfo := range compositeIndexInfos {
+			downloadFiles += len(indexInfo.GetIndexFilePaths())
+		}
+		downloadFiles += countBinlogs(fieldBinlogs)
+		loader.loadTracker.setTotalFiles(segmentID, downloadFiles)
+
+		loader.loadTracker.setPhase(segmentID, segmentLoadPhaseLoadingIndex)
 		if err := loader.loadIndexedFieldData(ctx, segment, indexedFieldInfos); err != nil {
 			return err
 		}
+		for _, indexInfo := range compositeIndexInfos {
+			if err := loader.loadCompositeIndex(ctx, segment, indexInfo); err != nil {
+				return err
+			}
+		}
+		loader.loadTracker.setPhase(segmentID, segmentLoadPhaseLoadingRawData)
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo); err != nil {
 			return err
 		}
 	} else {
+		downloadFiles += countBinlogs(loadInfo.BinlogPaths)
+		loader.loadTracker.setTotalFiles(segmentID, downloadFiles)
+
+		loader.loadTracker.setPhase(segmentID, segmentLoadPhaseLoadingRawData)
 		if err := loader.loadGrowingSegmentFields(ctx, segment, loadInfo.BinlogPaths); err != nil {
 			return err
 		}
@@ -285,14 +347,19 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		log.Warn("segment primary key field doesn't exist when load segment")
 	} else {
 		log.Info("loading bloom filter...", zap.Int64("segmentID", segmentID))
-		pkStatsBinlogs := loader.filterPKStatsBinlogs(loadInfo.Statslogs, pkFieldID)
-		err = loader.loadSegmentBloomFilter(ctx, segment, pkStatsBinlogs)
-		if err != nil {
-			return err
+		loader.loadTracker.setPhase(segmentID, segmentLoadPhaseLoadingBloomFilter)
+		if segment.getType() == segmentTypeSealed && loader.adoptGrowingPkStats(segment, loadInfo) {
+			loader.loadTracker.filesDownloaded(segmentID, len(pkStatsBinlogs))
+		} else {
+			err = loader.loadSegmentBloomFilter(ctx, segment, pkStatsBinlogs)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	log.Info("loading delta...", zap.Int64("segmentID", segmentID))
+	loader.loadTracker.setPhase(segmentID, segmentLoadPhaseLoadingDelta)
 	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 	return err
 }
@@ -320,7 +387,7 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 	// change all field bin log loading into concurrent
 	loadFutures := make([]*concurrency.Future, 0, len(fieldBinlogs))
 	for _, fieldBinlog := range fieldBinlogs {
-		futures := loader.loadFieldBinlogsAsync(ctx, fieldBinlog)
+		futures := loader.loadFieldBinlogsAsync(ctx, segment.segmentID, fieldBinlog)
 		loadFutures = append(loadFutures, futures...)
 	}
 
@@ -370,6 +437,30 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 	}
 }
 
+// splitLazyFields splits the vector fields out of fieldBinlogs, which are loaded on demand
+func (loader *segmentLoader) splitLazyFields(segment *Segment, fieldBinlogs []*datapb.FieldBinlog) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
+	loadFields := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	lazyFields := make([]*datapb.FieldBinlog, 0)
+	for _, fieldBinlog := range fieldBinlogs {
+		fieldType, err := loader.getFieldType(segment, fieldBinlog.GetFieldID())
+		if err != nil {
+			return nil, nil, err
+		}
+		if typeutil.IsVectorType(fieldType) {
+			lazyFields = append(lazyFields, fieldBinlog)
+		} else {
+			loadFields = append(loadFields, fieldBinlog)
+		}
+	}
+	if len(lazyFields) > 0 {
+		log.Info("skip loading vector fields until requests need them",
+			zap.Int64("collectionID", segment.collectionID),
+			zap.Int64("segmentID", segment.segmentID),
+			zap.Int("lazyFieldNum", len(lazyFields)))
+	}
+	return loadFields, lazyFields, nil
+}
+
 func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segment *Segment, fields []*datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
 	runningGroup, groupCtx := errgroup.WithContext(ctx)
 	for _, field := range fields {
@@ -399,7 +490,7 @@ func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segme
 
 	// Avoid consuming too much memory if no CPU worker ready,
 	// acquire a CPU worker before load field binlogs
-	futures := loader.loadFieldBinlogsAsync(ctx, field)
+	futures := loader.loadFieldBinlogsAsync(ctx, segment.segmentID, field)
 
 	err := concurrency.AwaitAll(futures...)
 	if err != nil {
@@ -426,7 +517,54 @@ func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segme
 }
 
 // Load binlogs concurrently into memory from KV storage asyncly
-func (loader *segmentLoader) loadFieldBinlogsAsync(ctx context.Context, field *datapb.FieldBinlog) []*concurrency.Future {
+// loadLazyField loads the field skipped in lazy load mode, the memory to load it is reserved first and the lazy
+// loaded fields not used are evicted if the memory is not enough.
+func (loader *segmentLoader) loadLazyField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
+	neededMem := uint64(float64(funcutil.GetFieldSizeFromFieldBinlog(field)) * Params.QueryNodeCfg.LoadMemoryUsageFactor.GetAsFloat())
+	err := loader.reserveLazyFieldMemory(neededMem)
+	if err != nil {
+		evicted := lazyFieldCache.evict(int64(neededMem))
+		log.Info("evict lazy loaded fields for lack of memory",
+			zap.Int64("segmentID", segment.ID()),
+			zap.Int64("fieldID", field.GetFieldID()),
+			zap.Int64("evictedSize", evicted))
+		err = loader.reserveLazyFieldMemory(neededMem)
+	}
+	if err != nil {
+		return err
+	}
+	defer loader.releaseLazyFieldMemory(neededMem)
+	return loader.loadSealedField(ctx, segment, field, loadInfo)
+}
+
+func (loader *segmentLoader) reserveLazyFieldMemory(neededMem uint64) error {
+	loader.lazyReserveMut.Lock()
+	defer loader.lazyReserveMut.Unlock()
+
+	usedMem := hardware.GetUsedMemoryCount()
+	totalMem := hardware.GetMemoryCount()
+	if usedMem == 0 || totalMem == 0 {
+		return fmt.Errorf("get memory failed when loading lazy field")
+	}
+	usedMemAfterLoad := usedMem + loader.lazyReserved + neededMem
+	if usedMemAfterLoad > uint64(float64(totalMem)*Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat()) {
+		return fmt.Errorf("load lazy field failed, OOM if load, neededMem = %v MB, usedMemAfterLoad = %v MB, totalMem = %v MB, thresholdFactor = %f",
+			neededMem/1024/1024,
+			usedMemAfterLoad/1024/1024,
+			totalMem/1024/1024,
+			Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat())
+	}
+	loader.lazyReserved += neededMem
+	return nil
+}
+
+func (loader *segmentLoader) releaseLazyFieldMemory(neededMem uint64) {
+	loader.lazyReserveMut.Lock()
+	defer loader.lazyReserveMut.Unlock()
+	loader.lazyReserved -= neededMem
+}
+
+func (loader *segmentLoader) loadFieldBinlogsAsync(ctx context.Context, segmentID UniqueID, field *datapb.FieldBinlog) []*concurrency.Future {
 	futures := make([]*concurrency.Future, 0, len(field.Binlogs))
 	for i := range field.Binlogs {
 		path := field.Binlogs[i].GetLogPath()
@@ -436,6 +574,7 @@ func (loader *segmentLoader) loadFieldBinlogsAsync(ctx context.Context, field *d
 				log.Warn("failed to load binlog", zap.String("filePath", path), zap.Error(err))
 				return nil, err
 			}
+			loader.loadTracker.filesDownloaded(segmentID, 1)
 			blob := &storage.Blob{
 				Key:   path,
 				Value: binLog,
@@ -470,6 +609,17 @@ func (loader *segmentLoader) loadIndexedFieldData(ctx context.Context, segment *
 
 func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Segment, indexInfo *querypb.FieldIndexInfo) error {
 	log := log.With(zap.Int64("segment", segment.ID()))
+	// the index files published with a manifest are checked against it, a missing manifest means an incomplete build
+	indexFilePaths, err := storage.ResolveIndexFilePaths(ctx, loader.cm, indexInfo.IndexFilePaths)
+	if err != nil {
+		log.Warn("failed to resolve index files", zap.Int64("buildID", indexInfo.GetBuildID()), zap.Error(err))
+		return err
+	}
+	if len(indexFilePaths) != len(indexInfo.IndexFilePaths) {
+		loader.loadTracker.filesDownloaded(segment.segmentID, len(indexInfo.IndexFilePaths)-len(indexFilePaths))
+	}
+	indexInfo.IndexFilePaths = indexFilePaths
+
 	indexBuffer := make([][]byte, 0, len(indexInfo.IndexFilePaths))
 	filteredPaths := make([]string, 0, len(indexInfo.IndexFilePaths))
 	futures := make([]*concurrency.Future, 0, len(indexInfo.IndexFilePaths))
@@ -484,6 +634,7 @@ func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Se
 			if err != nil {
 				return err
 			}
+			loader.loadTracker.filesDownloaded(segment.segmentID, 1)
 
 			// indexParams is small, skip cpu pooling
 			_, indexParams, _, _, err := indexCodec.Deserialize([]*storage.Blob{{Key: storage.IndexParamsKey, Value: indexParamsBlob}})
@@ -513,7 +664,12 @@ func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Se
 	indexParams := funcutil.KeyValuePair2Map(indexInfo.IndexParams)
 	// load on disk index
 	if indexParams["index_type"] == indexparamcheck.IndexDISKANN {
-		return segment.segmentLoadIndexData(nil, indexInfo, fieldType)
+		// the index files are downloaded by segcore
+		err = segment.segmentLoadIndexData(nil, indexInfo, fieldType)
+		if err == nil {
+			loader.loadTracker.filesDownloaded(segment.segmentID, len(indexInfo.IndexFilePaths))
+		}
+		return err
 	}
 	// load in memory index
 	for _, p := range indexInfo.IndexFilePaths {
@@ -528,6 +684,7 @@ func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Se
 				)
 				return nil, err
 			}
+			loader.loadTracker.filesDownloaded(segment.segmentID, 1)
 			result, err := loader.cpuPool.Submit(func() (interface{}, error) {
 				blobs, _, _, _, err := indexCodec.Deserialize([]*storage.Blob{{Key: path.Base(indexPath), Value: data}})
 				if err != nil {
@@ -564,6 +721,42 @@ func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Se
 	return segment.segmentLoadIndexData(indexBuffer, indexInfo, fieldType)
 }
 
+// loadCompositeIndex loads the keys of the composite index, the other files of the index are not used by QueryNode.
+func (loader *segmentLoader) loadCompositeIndex(ctx context.Context, segment *Segment, indexInfo *querypb.FieldIndexInfo) error {
+	log := log.With(zap.Int64("segment", segment.ID()), zap.Int64("buildID", indexInfo.GetBuildID()))
+	indexFilePaths, err := storage.ResolveIndexFilePaths(ctx, loader.cm, indexInfo.IndexFilePaths)
+	if err != nil {
+		log.Warn("failed to resolve composite index files", zap.Error(err))
+		return err
+	}
+	defer loader.loadTracker.filesDownloaded(segment.segmentID, len(indexInfo.IndexFilePaths))
+
+	for _, indexPath := range indexFilePaths {
+		if path.Base(indexPath) != storage.CompositeIndexKeysKey {
+			continue
+		}
+		data, err := loader.cm.Read(ctx, indexPath)
+		if err != nil {
+			log.Warn("failed to load composite index keys", zap.String("path", indexPath), zap.Error(err))
+			return err
+		}
+		blobs, _, _, _, err := storage.NewIndexFileBinlogCodec().Deserialize([]*storage.Blob{{Key: storage.CompositeIndexKeysKey, Value: data}})
+		if err != nil {
+			return err
+		}
+		keys, err := storage.DeserializeCompositeIndexKeys(blobs[0].GetValue())
+		if err != nil {
+			return err
+		}
+		segment.addCompositeIndex(&compositeIndex{keys: keys})
+		log.Info("load composite index done", zap.Int64s("fieldIDs", keys.FieldIDs), zap.Int("numKeys", keys.NumKeys))
+		return nil
+	}
+	// the composite indexes built before the keys were saved can't be used to skip segments
+	log.Warn("composite index keys not found, segment can't be skipped by the index")
+	return nil
+}
+
 func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
 	ids []UniqueID,
 	timestamps []Timestamp,
@@ -651,6 +844,7 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 	if err != nil {
 		return err
 	}
+	loader.loadTracker.filesDownloaded(segment.segmentID, len(binlogPaths))
 	blobs := make([]*storage.Blob, 0)
 	for i := 0; i < len(values); i++ {
 		blobs = append(blobs, &storage.Blob{Value: values[i]})
@@ -675,6 +869,35 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 	return nil
 }
 
+// adoptGrowingPkStats merges the pk statistics of the growing segment handed off to the sealed segment,
+// if the growing segment is on this node and holds all the rows of the sealed one,
+// returns false if the stats logs have to be loaded
+func (loader *segmentLoader) adoptGrowingPkStats(segment *Segment, loadInfo *querypb.SegmentLoadInfo) bool {
+	if !Params.QueryNodeCfg.HandoffReuseGrowingPkStats.GetAsBool() {
+		return false
+	}
+	growing, err := loader.metaReplica.getSegmentByID(segment.segmentID, segmentTypeGrowing)
+	if err != nil {
+		return false
+	}
+	// a growing segment holding more rows only costs false positives, while fewer rows would miss pks
+	if growing.getRowCount() < loadInfo.GetNumOfRows() {
+		return false
+	}
+	deltas := growing.pkStatsDeltas()
+	if len(deltas) == 0 {
+		return false
+	}
+	segment.statLock.Lock()
+	segment.historyStats = append(segment.historyStats, deltas...)
+	segment.statLock.Unlock()
+	log.Info("reuse pk stats of growing segment",
+		zap.Int64("segmentID", segment.segmentID),
+		zap.Int64("numRows", loadInfo.GetNumOfRows()),
+		zap.Int("blocks", len(deltas)))
+	return true
+}
+
 func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment, deltaLogs []*datapb.FieldBinlog) error {
 	dCodec := storage.DeleteCodec{}
 	var blobs []*storage.Blob
@@ -684,6 +907,7 @@ func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment
 			if err != nil {
 				return err
 			}
+			loader.loadTracker.filesDownloaded(segment.segmentID, 1)
 			blob := &storage.Blob{
 				Key:   bLog.GetLogPath(),
 				Value: value,
@@ -863,6 +1087,22 @@ func JoinIDPath(ids ...UniqueID) string {
 	return path.Join(idStr...)
 }
 
+// isSegcoreLoadableIndex returns false for the inverted, bitmap and composite scalar indexes built by IndexNode,
+// segcore can't load their files yet, so the raw data of the fields is loaded instead.
+func isSegcoreLoadableIndex(indexInfo *querypb.FieldIndexInfo) bool {
+	indexType, err := funcutil.GetAttrByKeyFromRepeatedKV("index_type", indexInfo.IndexParams)
+	if err != nil {
+		return true
+	}
+	return !indexparamcheck.IsInvertedOrBitmapIndex(indexType) && indexType != indexparamcheck.IndexComposite
+}
+
+// isCompositeIndex returns true for the composite scalar indexes, which are loaded by QueryNode itself.
+func isCompositeIndex(indexInfo *querypb.FieldIndexInfo) bool {
+	indexType, err := funcutil.GetAttrByKeyFromRepeatedKV("index_type", indexInfo.IndexParams)
+	return err == nil && indexType == indexparamcheck.IndexComposite
+}
+
 func GetStorageSizeByIndexInfo(indexInfo *querypb.FieldIndexInfo) (uint64, uint64, error) {
 	indexType, err := funcutil.GetAttrByKeyFromRepeatedKV("index_type", indexInfo.IndexParams)
 	if err != nil {
@@ -900,7 +1140,12 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 		oldUsedMem := usedMemAfterLoad
 		vecFieldID2IndexInfo := make(map[int64]*querypb.FieldIndexInfo)
 		for _, fieldIndexInfo := range loadInfo.IndexInfos {
-			if fieldIndexInfo.EnableIndex {
+			// the keys of a composite index are loaded besides the raw data of its fields
+			if fieldIndexInfo.EnableIndex && isCompositeIndex(fieldIndexInfo) {
+				usedMemAfterLoad += uint64(fieldIndexInfo.IndexSize)
+				continue
+			}
+			if fieldIndexInfo.EnableIndex && isSegcoreLoadableIndex(fieldIndexInfo) {
 				fieldID := fieldIndexInfo.FieldID
 				vecFieldID2IndexInfo[fieldID] = fieldIndexInfo
 			}
@@ -1017,6 +1262,8 @@ func newSegmentLoader(
 		cpuPool: cpuPool,
 
 		factory: factory,
+
+		loadTracker: newSegmentLoadTracker(),
 	}
 
 	return loader
@@ -19,6 +19,7 @@ package querynode
 import (
 	"context"
 	"errors"
+	"math"
 	"math/rand"
 	"runtime"
 	"testing"
@@ -40,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 func TestSegmentLoader_loadSegment(t *testing.T) {
@@ -270,6 +272,67 @@ func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 	//})
 }
 
+func TestSegmentLoader_lazyLoadVectorField(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	paramtable.Get().Save(Params.QueryNodeCfg.LazyLoadVectorField.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.LazyLoadVectorField.Key)
+
+	schema := genTestCollectionSchema()
+	fieldBinlog, statsLog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
+	assert.NoError(t, err)
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	node.metaReplica.removeSegment(defaultSegmentID, segmentTypeSealed)
+	req := &querypb.LoadSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadSegments,
+			MsgID:   rand.Int63(),
+		},
+		DstNodeID: 0,
+		Schema:    schema,
+		Infos: []*querypb.SegmentLoadInfo{
+			{
+				SegmentID:    defaultSegmentID,
+				PartitionID:  defaultPartitionID,
+				CollectionID: defaultCollectionID,
+				BinlogPaths:  fieldBinlog,
+				Statslogs:    statsLog,
+				NumOfRows:    defaultMsgLength,
+			},
+		},
+	}
+	_, err = node.loader.LoadSegment(ctx, req, segmentTypeSealed)
+	assert.NoError(t, err)
+
+	segment, err := node.metaReplica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	assert.NoError(t, err)
+	assert.True(t, segment.hasLazyField(simpleFloatVecField.id))
+	assert.False(t, segment.hasLazyField(simpleInt64Field.id))
+
+	// the vector field is loaded on demand
+	release, err := segment.loadLazyFields(ctx, []UniqueID{simpleInt64Field.id, simpleFloatVecField.id})
+	assert.NoError(t, err)
+	assert.False(t, segment.hasLazyField(simpleFloatVecField.id))
+
+	// the pinned field is not evicted
+	assert.Equal(t, int64(0), lazyFieldCache.evict(math.MaxInt64))
+	assert.False(t, segment.hasLazyField(simpleFloatVecField.id))
+
+	// the field evicted is loaded again by the next request
+	release()
+	assert.Equal(t, lazyFieldCache.usedSize(), lazyFieldCache.evict(math.MaxInt64))
+	assert.True(t, segment.hasLazyField(simpleFloatVecField.id))
+	release, err = segment.loadLazyFields(ctx, []UniqueID{simpleFloatVecField.id})
+	assert.NoError(t, err)
+	assert.False(t, segment.hasLazyField(simpleFloatVecField.id))
+	release()
+}
+
 func TestSegmentLoader_invalid(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -18,13 +18,16 @@ package querynode
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -213,6 +216,93 @@ func TestSegment_retrieve(t *testing.T) {
 	})
 }
 
+func TestSegment_loadLazyFields(t *testing.T) {
+	segment := &Segment{}
+	// no lazy field
+	release, err := segment.loadLazyFields(context.Background(), []UniqueID{100})
+	assert.NoError(t, err)
+	release()
+
+	loaded := make([]UniqueID, 0)
+	mockErr := errors.New("mock error")
+	segment.setLazyFields([]*datapb.FieldBinlog{{FieldID: 100}, {FieldID: 101}}, func(ctx context.Context, field *datapb.FieldBinlog) error {
+		if field.GetFieldID() == 101 {
+			return mockErr
+		}
+		loaded = append(loaded, field.GetFieldID())
+		return nil
+	})
+	assert.True(t, segment.hasLazyField(100))
+	assert.True(t, segment.hasLazyField(101))
+	assert.False(t, segment.hasLazyField(102))
+	defer lazyFieldCache.remove(segment)
+
+	release, err = segment.loadLazyFields(context.Background(), []UniqueID{100, 102})
+	assert.NoError(t, err)
+	assert.False(t, segment.hasLazyField(100))
+	assert.Equal(t, 1, segment.lazyLoaded[100].pins)
+	// the loaded field is not loaded again
+	release2, err := segment.loadLazyFields(context.Background(), []UniqueID{100})
+	assert.NoError(t, err)
+	assert.Equal(t, []UniqueID{100}, loaded)
+	assert.Equal(t, 2, segment.lazyLoaded[100].pins)
+	release()
+	release2()
+	assert.Equal(t, 0, segment.lazyLoaded[100].pins)
+
+	// the field failed to load is retried by the next request
+	_, err = segment.loadLazyFields(context.Background(), []UniqueID{101})
+	assert.ErrorIs(t, err, mockErr)
+	assert.True(t, segment.hasLazyField(101))
+}
+
+func TestSegment_loadLazyFieldsConcurrently(t *testing.T) {
+	segment := &Segment{}
+	defer lazyFieldCache.remove(segment)
+
+	loading := make(chan struct{})
+	started := make(chan UniqueID, 2)
+	loadCount := atomic.NewInt32(0)
+	segment.setLazyFields([]*datapb.FieldBinlog{{FieldID: 100}, {FieldID: 101}}, func(ctx context.Context, field *datapb.FieldBinlog) error {
+		loadCount.Inc()
+		started <- field.GetFieldID()
+		if field.GetFieldID() == 100 {
+			<-loading
+		}
+		return nil
+	})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := segment.loadLazyFields(context.Background(), []UniqueID{100})
+			assert.NoError(t, err)
+			release()
+		}()
+	}
+	assert.Equal(t, UniqueID(100), <-started)
+
+	// the other fields are not blocked by the ongoing loading
+	release, err := segment.loadLazyFields(context.Background(), []UniqueID{101})
+	assert.NoError(t, err)
+	release()
+	assert.Equal(t, UniqueID(101), <-started)
+
+	// the requests waiting for the loading give up on their contexts
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = segment.loadLazyFields(ctx, []UniqueID{100})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(loading)
+	wg.Wait()
+	// the concurrent requests of the same field share one loading
+	assert.Equal(t, int32(2), loadCount.Load())
+	assert.False(t, segment.hasLazyField(100))
+}
+
 func TestSegment_getDeletedCount(t *testing.T) {
 	collectionID := UniqueID(0)
 	schema := genTestCollectionSchema()
@@ -1028,5 +1118,34 @@ func TestUpdateBloomFilter(t *testing.T) {
 		assert.False(t, seg.isPKExist(storage.NewVarCharPrimaryKey("test2")))
 		assert.False(t, seg.isPKExist(storage.NewVarCharPrimaryKey("test4")))
 	})
+	t.Run("test pk stats deltas", func(t *testing.T) {
+		replica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		err = replica.addSegment(defaultSegmentID,
+			defaultPartitionID,
+			defaultCollectionID,
+			defaultDMLChannel,
+			defaultSegmentVersion,
+			defaultSegmentStartPosition,
+			segmentTypeGrowing)
+		assert.NoError(t, err)
+		seg, err := replica.getSegmentByID(defaultSegmentID, segmentTypeGrowing)
+		assert.Nil(t, err)
+		assert.Empty(t, seg.pkStatsDeltas())
 
+		pkValues := []int64{1, 3}
+		pks := make([]primaryKey, len(pkValues))
+		for index, v := range pkValues {
+			pks[index] = newInt64PrimaryKey(v)
+		}
+		seg.updateBloomFilter(pks)
+		deltas := seg.pkStatsDeltas()
+		assert.Len(t, deltas, 1)
+
+		sealed := &Segment{segmentID: defaultSegmentID, historyStats: deltas}
+		for _, v := range pkValues {
+			assert.True(t, sealed.isPKExist(storage.NewInt64PrimaryKey(v)))
+		}
+		assert.False(t, sealed.isPKExist(storage.NewInt64PrimaryKey(2)))
+	})
 }
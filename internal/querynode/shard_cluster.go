@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -848,7 +849,7 @@ func (sc *ShardCluster) GetStatistics(ctx context.Context, req *querypb.GetStati
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		streamErr := withStreaming(reqCtx)
+		streamErr := withStreaming(withServedSealedSegments(reqCtx, segAllocs))
 		resultMut.Lock()
 		defer resultMut.Unlock()
 		if streamErr != nil {
@@ -935,13 +936,16 @@ func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest,
 	var err error
 	var resultMut sync.Mutex
 	results := make([]*internalpb.SearchResults, 0, len(segAllocs)) // count(nodes) + 1(growing)
+	// the followers return the ids and scores only in the first phase of the adaptive reduce
+	adaptive := useAdaptiveReduce(req, len(segAllocs))
+	var phaseResults []*searchPhaseResult
 
 	// detect corresponding streaming search is done
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 
-		streamErr := withStreaming(reqCtx)
+		streamErr := withStreaming(withServedSealedSegments(reqCtx, segAllocs))
 		resultMut.Lock()
 		defer resultMut.Unlock()
 		if streamErr != nil {
@@ -963,6 +967,9 @@ func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest,
 			Scope:           querypb.DataScope_Historical,
 			SegmentIDs:      segments,
 		}
+		if adaptive {
+			nodeReq.SearchPhase = querypb.SearchPhase_ScoresOnly
+		}
 		node, ok := sc.getNode(nodeID)
 		if !ok { // meta dismatch, report error
 			return nil, fmt.Errorf("%w, node %d not found",
@@ -983,11 +990,25 @@ func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest,
 				cancel()
 				return
 			}
+			if adaptive {
+				phaseResults = append(phaseResults, &searchPhaseResult{node: node, req: nodeReq, result: partialResult})
+				return
+			}
 			results = append(results, partialResult)
 		}()
 	}
 
 	wg.Wait()
+	if err == nil && adaptive {
+		var fetchErr error
+		results, fetchErr = fetchSurvivingResults(reqCtx, phaseResults, req.GetReq().GetNq(), req.GetReq().GetTopk())
+		if fetchErr != nil {
+			log.Warn("failed to fetch the surviving search results, search the followers in one phase",
+				zap.Strings("channels", req.GetDmlChannels()),
+				zap.Error(fetchErr))
+			results, err = searchFullResults(reqCtx, phaseResults)
+		}
+	}
 	if err != nil {
 		log.Warn("failed to do search",
 			zap.Int64("sourceID", req.GetReq().GetBase().GetSourceID()),
@@ -1029,7 +1050,7 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest, wi
 	go func() {
 		defer wg.Done()
 
-		streamErr := withStreaming(reqCtx)
+		streamErr := withStreaming(withServedSealedSegments(reqCtx, segAllocs))
 		if streamErr != nil {
 			if err == nil {
 				err = fmt.Errorf("stream operation failed: %w", streamErr)
@@ -1059,6 +1080,9 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest, wi
 			partialResult, nodeErr := node.client.Query(reqCtx, nodeReq)
 			resultMut.Lock()
 			defer resultMut.Unlock()
+			if nodeErr == nil && partialResult.GetStatus().GetErrorCode() == commonpb.ErrorCode_OutOfMemory {
+				nodeErr = errorutil.ErrResultSizeExceeded
+			}
 			if nodeErr != nil || partialResult.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 				err = fmt.Errorf("Query %d failed, reason %s err %w", node.nodeID, partialResult.GetStatus().GetReason(), nodeErr)
 				cancel()
@@ -72,11 +72,15 @@ func (q *queryTask) queryOnStreaming() error {
 	}
 
 	// deserialize query plan
-	plan, err := createRetrievePlanByExpr(q.QS.collection, q.iReq.GetSerializedExprPlan(), q.TravelTimestamp, q.ID())
+	plan, err := getRetrievePlanByExpr(q.QS.collection, q.iReq.GetSerializedExprPlan(), q.TravelTimestamp, q.ID())
 	if err != nil {
 		return err
 	}
 	defer plan.delete()
+	plan.outputFieldIDs = q.iReq.GetOutputFieldsId()
+	if q.iReq.GetFilterStats() {
+		plan.filterStats = newFilterStatsCollector(q.iReq.GetSerializedExprPlan())
+	}
 
 	sResults, _, _, sErr := retrieveStreaming(ctx, q.QS.metaReplica, plan, q.CollectionID, q.iReq.GetPartitionIDs(), q.QS.channel, q.QS.vectorChunkManager)
 	if sErr != nil {
@@ -90,9 +94,10 @@ func (q *queryTask) queryOnStreaming() error {
 	}
 
 	q.Ret = &internalpb.RetrieveResults{
-		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-		Ids:        mergedResult.Ids,
-		FieldsData: mergedResult.FieldsData,
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:         mergedResult.Ids,
+		FieldsData:  mergedResult.FieldsData,
+		FilterStats: plan.filterStats.stats(),
 	}
 	q.reduceDur = q.tr.RecordSpan()
 	return nil
@@ -117,11 +122,15 @@ func (q *queryTask) queryOnHistorical() error {
 	}
 
 	// deserialize query plan
-	plan, err := createRetrievePlanByExpr(q.QS.collection, q.iReq.GetSerializedExprPlan(), q.TravelTimestamp, q.ID())
+	plan, err := getRetrievePlanByExpr(q.QS.collection, q.iReq.GetSerializedExprPlan(), q.TravelTimestamp, q.ID())
 	if err != nil {
 		return err
 	}
 	defer plan.delete()
+	plan.outputFieldIDs = q.iReq.GetOutputFieldsId()
+	if q.iReq.GetFilterStats() {
+		plan.filterStats = newFilterStatsCollector(q.iReq.GetSerializedExprPlan())
+	}
 	retrieveResults, _, _, err := retrieveHistorical(ctx, q.QS.metaReplica, plan, q.CollectionID, nil, q.req.SegmentIDs, q.QS.vectorChunkManager)
 	if err != nil {
 		return err
@@ -133,9 +142,10 @@ func (q *queryTask) queryOnHistorical() error {
 	}
 
 	q.Ret = &internalpb.RetrieveResults{
-		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-		Ids:        mergedResult.Ids,
-		FieldsData: mergedResult.FieldsData,
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:         mergedResult.Ids,
+		FieldsData:  mergedResult.FieldsData,
+		FilterStats: plan.filterStats.stats(),
 	}
 
 	return nil
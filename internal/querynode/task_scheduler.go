@@ -52,6 +52,8 @@ type taskScheduler struct {
 	tSafeReplica TSafeReplicaInterface
 
 	schedule scheduleReadTaskPolicy
+	// collections with read task queue depth metrics reported
+	queueDepthCollections map[UniqueID]struct{}
 	// for search and query end
 
 	cpuUsage        int32 // 1200 means 1200% 12 cores
@@ -175,6 +177,10 @@ func (s *taskScheduler) tryEvictUnsolvedReadTask(headCount int) {
 	}
 	metrics.QueryNodeEvictedReadReqCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Add(float64(diff))
 	busyErr := fmt.Errorf("server is busy")
+	if Params.QueryNodeCfg.CollectionIsolationEnabled.GetAsBool() {
+		s.evictUnsolvedReadTasksByCollection(diff, busyErr)
+		return
+	}
 	for e := s.unsolvedReadTasks.Front(); e != nil && diff > 0; e = next {
 		next = e.Next()
 		diff--
@@ -187,6 +193,32 @@ func (s *taskScheduler) tryEvictUnsolvedReadTask(headCount int) {
 	}
 }
 
+// evictUnsolvedReadTasksByCollection evicts the oldest unsolved tasks of the collections queuing the most tasks
+// relative to their scheduling weights, so that the tasks of the other collections are kept.
+func (s *taskScheduler) evictUnsolvedReadTasksByCollection(num int32, err error) {
+	order, queues := groupReadTasksByCollection(s.unsolvedReadTasks)
+	weights := getCollectionSchedulingWeights()
+	for ; num > 0; num-- {
+		var victim UniqueID
+		victimDepth := 0.0
+		for _, collectionID := range order {
+			depth := float64(len(queues[collectionID])) / float64(weights.get(collectionID))
+			if depth > victimDepth {
+				victim, victimDepth = collectionID, depth
+			}
+		}
+		if victimDepth == 0 {
+			return
+		}
+		e := queues[victim][0]
+		queues[victim] = queues[victim][1:]
+		s.unsolvedReadTasks.Remove(e)
+		t := e.Value.(readTask)
+		rateCol.rtCounter.sub(t, unsolvedQueueType)
+		t.Notify(err)
+	}
+}
+
 func (s *taskScheduler) scheduleReadTasks() {
 	defer s.wg.Done()
 	l := s.tSafeReplica.Watch()
@@ -267,7 +299,11 @@ func (s *taskScheduler) popAndAddToExecute() {
 		return
 	}
 
-	tasks, deltaUsage := s.schedule(s.readyReadTasks, targetUsage, remain)
+	schedule := s.schedule
+	if Params.QueryNodeCfg.CollectionIsolationEnabled.GetAsBool() {
+		schedule = collectionWeightedScheduleReadPolicy
+	}
+	tasks, deltaUsage := schedule(s.readyReadTasks, targetUsage, remain)
 	atomic.AddInt32(&s.cpuUsage, deltaUsage)
 	for _, t := range tasks {
 		s.executeReadTaskChan <- t
@@ -393,4 +429,37 @@ func (s *taskScheduler) tryMergeReadTasks() {
 	}
 	metrics.QueryNodeReadTaskUnsolveLen.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(s.unsolvedReadTasks.Len()))
 	metrics.QueryNodeReadTaskReadyLen.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(s.readyReadTasks.Len()))
+	s.updateReadTaskQueueDepth()
+}
+
+// updateReadTaskQueueDepth reports the number of unsolved and ready tasks of each collection,
+// the metrics of the collections without queuing tasks are removed.
+func (s *taskScheduler) updateReadTaskQueueDepth() {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	collections := make(map[UniqueID]struct{})
+	for _, q := range []struct {
+		tasks *list.List
+		label string
+	}{
+		{s.unsolvedReadTasks, metrics.UnsolvedQueueLabel},
+		{s.readyReadTasks, metrics.ReadyQueueLabel},
+	} {
+		order, queues := groupReadTasksByCollection(q.tasks)
+		for _, collectionID := range order {
+			collections[collectionID] = struct{}{}
+			metrics.QueryNodeReadTaskQueueDepth.WithLabelValues(nodeID, fmt.Sprint(collectionID), q.label).Set(float64(len(queues[collectionID])))
+		}
+		for collectionID := range s.queueDepthCollections {
+			if _, ok := queues[collectionID]; !ok {
+				metrics.QueryNodeReadTaskQueueDepth.WithLabelValues(nodeID, fmt.Sprint(collectionID), q.label).Set(0)
+			}
+		}
+	}
+	for collectionID := range s.queueDepthCollections {
+		if _, ok := collections[collectionID]; !ok {
+			metrics.QueryNodeReadTaskQueueDepth.DeleteLabelValues(nodeID, fmt.Sprint(collectionID), metrics.UnsolvedQueueLabel)
+			metrics.QueryNodeReadTaskQueueDepth.DeleteLabelValues(nodeID, fmt.Sprint(collectionID), metrics.ReadyQueueLabel)
+		}
+	}
+	s.queueDepthCollections = collections
 }
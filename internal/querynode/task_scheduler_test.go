@@ -182,6 +182,47 @@ func TestTaskScheduler_tryEvictUnsolvedReadTask(t *testing.T) {
 	})
 }
 
+func TestTaskScheduler_evictUnsolvedReadTasksByCollection(t *testing.T) {
+	paramtable.Get().Save(Params.QueryNodeCfg.CollectionIsolationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.CollectionIsolationEnabled.Key)
+	paramtable.Get().Save(Params.QueryNodeCfg.MaxUnsolvedQueueSize.Key, "4")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.MaxUnsolvedQueueSize.Key)
+
+	ts := newTaskScheduler(context.Background(), newTSafeReplica())
+	newTask := func(collectionID UniqueID) *mockReadTask {
+		return &mockReadTask{
+			mockTask: mockTask{
+				baseTask: baseTask{
+					ctx:  context.Background(),
+					done: make(chan error, 1024),
+				},
+			},
+			collectionID: collectionID,
+		}
+	}
+	hot := []*mockReadTask{newTask(1), newTask(1), newTask(1)}
+	cold := newTask(2)
+	ts.unsolvedReadTasks.PushBack(cold)
+	for _, task := range hot {
+		ts.unsolvedReadTasks.PushBack(task)
+	}
+
+	// the oldest tasks of the hot collection are evicted
+	ts.tryEvictUnsolvedReadTask(2)
+	assert.Equal(t, 2, ts.unsolvedReadTasks.Len())
+	assert.Error(t, <-hot[0].done)
+	assert.Error(t, <-hot[1].done)
+	assert.Equal(t, 0, len(cold.done))
+	assert.Equal(t, 0, len(hot[2].done))
+
+	// the queue depths of the collections are reported
+	ts.tryMergeReadTasks()
+	assert.Equal(t, 2, len(ts.queueDepthCollections))
+	ts.unsolvedReadTasks.Init()
+	ts.updateReadTaskQueueDepth()
+	assert.Equal(t, 0, len(ts.queueDepthCollections))
+}
+
 func TestTaskScheduler_executeReadTasks(t *testing.T) {
 	t.Run("execute canceled task", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -114,6 +114,7 @@ func (s *searchTask) searchOnStreaming() error {
 		return err2
 	}
 	defer searchReq.delete()
+	searchReq.filterStats = s.newFilterStatsCollector()
 
 	partResults, _, _, sErr := searchStreaming(ctx, s.QS.metaReplica, searchReq, s.CollectionID, s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0])
 	if sErr != nil {
@@ -122,7 +123,11 @@ func (s *searchTask) searchOnStreaming() error {
 		return sErr
 	}
 	defer deleteSearchResults(partResults)
-	return s.reduceResults(ctx, searchReq, partResults)
+	if err := s.reduceResults(ctx, searchReq, partResults); err != nil {
+		return err
+	}
+	s.fillInFilterStats(searchReq.filterStats)
+	return nil
 }
 
 func (s *searchTask) searchOnHistorical() error {
@@ -150,13 +155,18 @@ func (s *searchTask) searchOnHistorical() error {
 		return err2
 	}
 	defer searchReq.delete()
+	searchReq.filterStats = s.newFilterStatsCollector()
 
 	partResults, _, _, err := searchHistorical(ctx, s.QS.metaReplica, searchReq, s.CollectionID, nil, segmentIDs)
 	if err != nil {
 		return err
 	}
 	defer deleteSearchResults(partResults)
-	return s.reduceResults(ctx, searchReq, partResults)
+	if err := s.reduceResults(ctx, searchReq, partResults); err != nil {
+		return err
+	}
+	s.fillInFilterStats(searchReq.filterStats)
+	return nil
 }
 
 func (s *searchTask) Execute(ctx context.Context) error {
@@ -284,6 +294,31 @@ func (s *searchTask) reduceResults(ctx context.Context, searchReq *searchRequest
 	return nil
 }
 
+// newFilterStatsCollector returns a collector if any of the merged tasks asks for the filter statistics,
+// the merged tasks share the statistics since they search the same segments with the same plan.
+func (s *searchTask) newFilterStatsCollector() *filterStatsCollector {
+	enabled := s.iReq.GetFilterStats()
+	for _, t := range s.otherTasks {
+		enabled = enabled || t.iReq.GetFilterStats()
+	}
+	if !enabled {
+		return nil
+	}
+	return newFilterStatsCollector(s.iReq.GetSerializedExprPlan())
+}
+
+// fillInFilterStats fills the filter statistics in the results of the tasks asking for them.
+func (s *searchTask) fillInFilterStats(collector *filterStatsCollector) {
+	if collector == nil {
+		return
+	}
+	for _, t := range append([]*searchTask{s}, s.otherTasks...) {
+		if t.Ret != nil && t.iReq.GetFilterStats() {
+			t.Ret.FilterStats = collector.stats()
+		}
+	}
+}
+
 func (s *searchTask) CanMergeWith(t readTask) bool {
 	s2, ok := t.(*searchTask)
 	if !ok {
@@ -340,6 +375,10 @@ func (s *searchTask) CanMergeWith(t readTask) bool {
 		return false
 	}
 
+	if !sameServedSealedSegments(s.Ctx(), s2.Ctx()) {
+		return false
+	}
+
 	if s.TravelTimestamp != s2.TravelTimestamp {
 		return false
 	}
@@ -128,5 +128,8 @@ func validateOnStreamReplica(ctx context.Context, replica ReplicaInterface, coll
 	}
 
 	segmentIDs, err = replica.getSegmentIDsByVChannel(searchPartIDs, vChannel, segmentTypeGrowing)
-	return searchPartIDs, segmentIDs, err
+	if err != nil {
+		return searchPartIDs, segmentIDs, err
+	}
+	return searchPartIDs, excludeHandedOffSegments(ctx, segmentIDs), nil
 }
@@ -21,6 +21,10 @@ func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 		return fmt.Errorf("alter collection failed, collection name does not exists")
 	}
 
+	if _, err := parsePartitionRolloverPolicy(a.Req.GetProperties()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
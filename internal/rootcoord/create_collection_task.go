@@ -55,6 +55,10 @@ func (t *createCollectionTask) validate() error {
 		return fmt.Errorf("shard num (%d) exceeds limit (%d)", t.Req.GetShardsNum(), maxShardNum)
 	}
 
+	if _, err := parsePartitionRolloverPolicy(t.Req.GetProperties()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func databaseQuotaKey(dbName string) string {
+	return funcutil.HandleTenantForEtcdKey(rootcoord.DatabaseQuotaPrefix, util.DefaultTenant, dbName)
+}
+
+func (c *Core) initDatabaseQuotaKV() error {
+	metaKV, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.databaseQuotaKV = metaKV
+	return nil
+}
+
+// listDatabaseQuotas returns the rate limiting quotas of databases sorted by db name.
+func (c *Core) listDatabaseQuotas() ([]*model.DatabaseQuota, error) {
+	_, values, err := c.databaseQuotaKV.LoadWithPrefix(databaseQuotaKey(""))
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]*model.DatabaseQuota, 0, len(values))
+	for _, value := range values {
+		quota, err := model.UnmarshalDatabaseQuota(value)
+		if err != nil {
+			log.Warn("skip invalid database quota", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		quotas = append(quotas, quota)
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].DbName < quotas[j].DbName })
+	return quotas, nil
+}
+
+// saveDatabaseQuota persists the quota of a database and refreshes the quota in proxies,
+// the requests without a db name belong to the database util.DefaultDBName.
+func (c *Core) saveDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error {
+	if quota.DbName == "" {
+		return fmt.Errorf("db_name is required")
+	}
+	if _, err := quota.GetRateTypes(); err != nil {
+		return err
+	}
+	if _, err := quota.GetCollectionRateTypes(); err != nil {
+		return err
+	}
+	value, err := model.MarshalDatabaseQuota(quota)
+	if err != nil {
+		return err
+	}
+	if err := c.databaseQuotaKV.Save(databaseQuotaKey(quota.DbName), value); err != nil {
+		return err
+	}
+	log.Info("save database quota", zap.String("db_name", quota.DbName),
+		zap.Any("rates", quota.Rates), zap.Any("collection_rates", quota.CollectionRates))
+	return c.refreshDatabaseQuota(ctx, quota)
+}
+
+// dropDatabaseQuota removes the quota of the database and refreshes the quota in proxies.
+func (c *Core) dropDatabaseQuota(ctx context.Context, dbName string) error {
+	if err := c.databaseQuotaKV.Remove(databaseQuotaKey(dbName)); err != nil {
+		return err
+	}
+	log.Info("drop database quota", zap.String("db_name", dbName))
+	// a quota without rates removes the limits of the database in proxies
+	return c.refreshDatabaseQuota(ctx, &model.DatabaseQuota{DbName: dbName})
+}
+
+func (c *Core) refreshDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error {
+	return c.proxyClientManager.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{
+		Base:  commonpbutil.NewMsgBase(commonpbutil.WithSourceID(c.session.ServerID)),
+		Quota: model.MarshalDatabaseQuotaModel(quota),
+	})
+}
+
+// ListDatabaseQuotas lists the rate limiting quotas of databases sorted by db name.
+func (c *Core) ListDatabaseQuotas(ctx context.Context, in *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.ListDatabaseQuotasResponse{
+			Status: errorutil.UnhealthyStatus(code),
+		}, nil
+	}
+	quotas, err := c.listDatabaseQuotas()
+	if err != nil {
+		log.Warn("fail to list database quotas", zap.Error(err))
+		return &proxypb.ListDatabaseQuotasResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	ret := make([]*proxypb.DatabaseQuota, 0, len(quotas))
+	for _, quota := range quotas {
+		ret = append(ret, model.MarshalDatabaseQuotaModel(quota))
+	}
+	return &proxypb.ListDatabaseQuotasResponse{
+		Status: succStatus(),
+		Quotas: ret,
+	}, nil
+}
+
+// SaveDatabaseQuota saves the rate limiting quota of a database and refreshes it in proxies.
+func (c *Core) SaveDatabaseQuota(ctx context.Context, in *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	quota := model.UnmarshalDatabaseQuotaModel(in.GetQuota())
+	if quota == nil {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "database quota is required"), nil
+	}
+	if err := c.saveDatabaseQuota(ctx, quota); err != nil {
+		log.Warn("fail to save database quota", zap.String("db_name", quota.DbName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
+// DropDatabaseQuota drops the rate limiting quota of a database and removes its limits in proxies.
+func (c *Core) DropDatabaseQuota(ctx context.Context, in *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	if in.GetDbName() == "" {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "db_name is required"), nil
+	}
+	if err := c.dropDatabaseQuota(ctx, in.GetDbName()); err != nil {
+		log.Warn("fail to drop database quota", zap.String("db_name", in.GetDbName()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
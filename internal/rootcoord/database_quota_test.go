@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func TestCore_DatabaseQuota(t *testing.T) {
+	saved := make(map[string]string)
+	metaKV := kvmocks.NewMetaKv(t)
+	metaKV.On("Save", mock.Anything, mock.Anything).Return(func(key, value string) error {
+		saved[key] = value
+		return nil
+	})
+	metaKV.On("Remove", mock.Anything).Return(func(key string) error {
+		delete(saved, key)
+		return nil
+	})
+	metaKV.On("LoadWithPrefix", databaseQuotaKey("")).Return(
+		func(key string) []string { return nil },
+		func(key string) []string {
+			values := make([]string, 0, len(saved))
+			for _, value := range saved {
+				values = append(values, value)
+			}
+			return values
+		},
+		func(key string) error { return nil })
+
+	c := newTestCore(withHealthyCode(), withValidProxyManager())
+	c.databaseQuotaKV = metaKV
+	var refreshed []*proxypb.RefreshDatabaseQuotaRequest
+	c.proxyClientManager.proxyClient[TestProxyID].(*mockProxy).RefreshDatabaseQuotaFunc = func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+		refreshed = append(refreshed, request)
+		return succStatus(), nil
+	}
+
+	ctx := context.Background()
+	save := func(quota *proxypb.DatabaseQuota) *commonpb.Status {
+		status, err := c.SaveDatabaseQuota(ctx, &proxypb.SaveDatabaseQuotaRequest{Quota: quota})
+		assert.NoError(t, err)
+		return status
+	}
+
+	status := save(&proxypb.DatabaseQuota{
+		DbName:          "tenant1",
+		Rates:           map[string]float64{"DQLSearch": 100},
+		CollectionRates: map[string]float64{"DMLInsert": 10},
+	})
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 1, len(refreshed))
+	assert.Equal(t, "tenant1", refreshed[0].GetQuota().GetDbName())
+	assert.Equal(t, float64(10), refreshed[0].GetQuota().GetCollectionRates()["DMLInsert"])
+
+	assert.NotEqual(t, commonpb.ErrorCode_Success, save(&proxypb.DatabaseQuota{Rates: map[string]float64{"DQLSearch": 10}}).GetErrorCode())
+	assert.NotEqual(t, commonpb.ErrorCode_Success, save(&proxypb.DatabaseQuota{DbName: "tenant1", CollectionRates: map[string]float64{"Unknown": 10}}).GetErrorCode())
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, save(nil).GetErrorCode())
+	assert.Equal(t, 1, len(refreshed))
+
+	resp, err := c.ListDatabaseQuotas(ctx, &proxypb.ListDatabaseQuotasRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(resp.GetQuotas()))
+	assert.Equal(t, float64(100), resp.GetQuotas()[0].GetRates()["DQLSearch"])
+
+	status, err = c.DropDatabaseQuota(ctx, &proxypb.DropDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = c.DropDatabaseQuota(ctx, &proxypb.DropDatabaseQuotaRequest{DbName: "tenant1"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Empty(t, saved)
+	assert.Equal(t, 2, len(refreshed))
+	assert.True(t, model.UnmarshalDatabaseQuotaModel(refreshed[1].GetQuota()).Empty())
+
+	c.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = c.ListDatabaseQuotas(ctx, &proxypb.ListDatabaseQuotasRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	status, err = c.SaveDatabaseQuota(ctx, &proxypb.SaveDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = c.DropDatabaseQuota(ctx, &proxypb.DropDatabaseQuotaRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
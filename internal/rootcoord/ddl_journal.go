@@ -0,0 +1,521 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// ddlJournalCleanupInterval is the interval to remove the expired operations from the ddl journal.
+var ddlJournalCleanupInterval = 10 * time.Minute
+
+type ddlOperationState string
+
+const (
+	ddlOperationRunning   ddlOperationState = "Running"
+	ddlOperationCompleted ddlOperationState = "Completed"
+	ddlOperationFailed    ddlOperationState = "Failed"
+	// ddlOperationInterrupted is the state of the operations running when RootCoord stopped,
+	// the collections and partitions left dropping are recycled by the garbage collector.
+	ddlOperationInterrupted ddlOperationState = "Interrupted"
+)
+
+type ddlStepState string
+
+const (
+	ddlStepPending   ddlStepState = "Pending"
+	ddlStepRunning   ddlStepState = "Running"
+	ddlStepRetrying  ddlStepState = "Retrying"
+	ddlStepCompleted ddlStepState = "Completed"
+	ddlStepFailed    ddlStepState = "Failed"
+)
+
+const (
+	ddlStepKindMetaWrite    = "meta_write"
+	ddlStepKindChannelWatch = "channel_watch"
+	ddlStepKindBroadcast    = "broadcast"
+	ddlStepKindDataCleanup  = "data_cleanup"
+)
+
+// ddlStepMode is how a step of a ddl operation is executed, the undo steps are executed asynchronously.
+type ddlStepMode int
+
+const (
+	ddlStepSync ddlStepMode = iota
+	ddlStepAsync
+	ddlStepUndo
+)
+
+// ddlStep is the state of a step of a ddl operation, the undo steps are run when the operation failed.
+type ddlStep struct {
+	Desc       string       `json:"desc"`
+	Kind       string       `json:"kind"`
+	Undo       bool         `json:"undo,omitempty"`
+	State      ddlStepState `json:"state"`
+	Reason     string       `json:"reason,omitempty"`
+	UpdateTime time.Time    `json:"update_time"`
+}
+
+// ddlOperation is a ddl operation in the journal, the ID is the ID of the ddl task. An operation is Completed
+// once its asynchronous steps, such as releasing and deleting the data of a dropped collection, are completed.
+type ddlOperation struct {
+	ID             UniqueID          `json:"id"`
+	Type           string            `json:"type"`
+	DbName         string            `json:"db_name,omitempty"`
+	CollectionName string            `json:"collection_name,omitempty"`
+	PartitionName  string            `json:"partition_name,omitempty"`
+	Alias          string            `json:"alias,omitempty"`
+	Ts             Timestamp         `json:"ts"`
+	State          ddlOperationState `json:"state"`
+	Reason         string            `json:"reason,omitempty"`
+	Steps          []*ddlStep        `json:"steps"`
+	CreateTime     time.Time         `json:"create_time"`
+	UpdateTime     time.Time         `json:"update_time"`
+
+	// executed is true once the task returned, the operation is completed when all its steps are then completed
+	executed bool
+}
+
+func (op *ddlOperation) finished() bool {
+	return op.State != ddlOperationRunning
+}
+
+// stepsCompleted returns whether all the steps but the undo steps are completed.
+func (op *ddlOperation) stepsCompleted() bool {
+	for _, step := range op.Steps {
+		if !step.Undo && step.State != ddlStepCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+func (op *ddlOperation) clone() *ddlOperation {
+	cloned := *op
+	cloned.Steps = make([]*ddlStep, 0, len(op.Steps))
+	for _, step := range op.Steps {
+		s := *step
+		cloned.Steps = append(cloned.Steps, &s)
+	}
+	return &cloned
+}
+
+// newDdlOperation returns the operation of the ddl task, or nil if the task is not a ddl.
+func newDdlOperation(t task) *ddlOperation {
+	op := &ddlOperation{ID: t.GetID(), Ts: t.GetTs(), State: ddlOperationRunning, Steps: []*ddlStep{}}
+	switch t := t.(type) {
+	case *createCollectionTask:
+		op.Type, op.DbName, op.CollectionName = "CreateCollection", t.Req.GetDbName(), t.Req.GetCollectionName()
+	case *dropCollectionTask:
+		op.Type, op.DbName, op.CollectionName = "DropCollection", t.Req.GetDbName(), t.Req.GetCollectionName()
+	case *alterCollectionTask:
+		op.Type, op.DbName, op.CollectionName = "AlterCollection", t.Req.GetDbName(), t.Req.GetCollectionName()
+	case *createPartitionTask:
+		op.Type, op.DbName, op.CollectionName = "CreatePartition", t.Req.GetDbName(), t.Req.GetCollectionName()
+		op.PartitionName = t.Req.GetPartitionName()
+	case *dropPartitionTask:
+		op.Type, op.DbName, op.CollectionName = "DropPartition", t.Req.GetDbName(), t.Req.GetCollectionName()
+		op.PartitionName = t.Req.GetPartitionName()
+	case *createAliasTask:
+		op.Type, op.DbName, op.CollectionName, op.Alias = "CreateAlias", t.Req.GetDbName(), t.Req.GetCollectionName(), t.Req.GetAlias()
+	case *dropAliasTask:
+		op.Type, op.DbName, op.Alias = "DropAlias", t.Req.GetDbName(), t.Req.GetAlias()
+	case *alterAliasTask:
+		op.Type, op.DbName, op.CollectionName, op.Alias = "AlterAlias", t.Req.GetDbName(), t.Req.GetCollectionName(), t.Req.GetAlias()
+	default:
+		return nil
+	}
+	return op
+}
+
+// ddlStepKind classifies the step, the steps of no effect return an empty kind and are not journaled.
+func ddlStepKind(step nestedStep) string {
+	switch step.(type) {
+	case *addCollectionMetaStep, *deleteCollectionMetaStep, *changeCollectionStateStep, *AlterCollectionStep,
+		*addPartitionMetaStep, *changePartitionStateStep, *removePartitionMetaStep:
+		return ddlStepKindMetaWrite
+	case *watchChannelsStep, *unwatchChannelsStep, *removeDmlChannelsStep:
+		return ddlStepKindChannelWatch
+	case *expireCacheStep, *BroadcastAlteredCollectionStep:
+		return ddlStepKindBroadcast
+	case *releaseCollectionStep, *dropIndexStep, *deleteCollectionDataStep, *deletePartitionDataStep:
+		return ddlStepKindDataCleanup
+	default:
+		return ""
+	}
+}
+
+func ddlJournalKey(id UniqueID) string {
+	return path.Join(rootcoord.DdlJournalPrefix, strconv.FormatInt(id, 10))
+}
+
+// ddlJournal persists the ddl operations and the states of their steps, so that the operations can be tracked
+// after the ddl requests returned, and across the restarts of RootCoord.
+type ddlJournal struct {
+	kv kv.BaseKV
+
+	mu         sync.RWMutex
+	operations map[UniqueID]*ddlOperation
+}
+
+func newDdlJournal(kv kv.BaseKV) *ddlJournal {
+	return &ddlJournal{
+		kv:         kv,
+		operations: make(map[UniqueID]*ddlOperation),
+	}
+}
+
+func (c *Core) initDdlJournal() error {
+	metaKV, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.ddlJournal = newDdlJournal(metaKV)
+	return c.ddlJournal.load()
+}
+
+// load loads the operations of the journal, the operations running when RootCoord stopped are marked interrupted.
+func (j *ddlJournal) load() error {
+	_, values, err := j.kv.LoadWithPrefix(rootcoord.DdlJournalPrefix)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, value := range values {
+		op := &ddlOperation{}
+		if err := json.Unmarshal([]byte(value), op); err != nil {
+			log.Warn("skip invalid ddl operation", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		if !op.finished() {
+			op.State = ddlOperationInterrupted
+			op.Reason = "rootcoord stopped before the operation finished"
+			op.UpdateTime = time.Now()
+			if err := j.saveLocked(op); err != nil {
+				return err
+			}
+		}
+		j.operations[op.ID] = op
+	}
+	log.Info("ddl journal loaded", zap.Int("operations", len(j.operations)))
+	return nil
+}
+
+func (j *ddlJournal) saveLocked(op *ddlOperation) error {
+	value, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+	return j.kv.Save(ddlJournalKey(op.ID), string(value))
+}
+
+// persistLocked saves the operation, the journal is best effort and never fails the ddl.
+func (j *ddlJournal) persistLocked(op *ddlOperation) {
+	if err := j.saveLocked(op); err != nil {
+		log.Warn("failed to save ddl operation", zap.Int64("id", op.ID), zap.Error(err))
+	}
+}
+
+type ddlOperationKey struct{}
+
+// begin journals the ddl task, and returns the context carrying the operation so that the steps of
+// the task are journaled. The context is returned as it is if the task is not a ddl.
+func (j *ddlJournal) begin(ctx context.Context, t task) context.Context {
+	if j == nil {
+		return ctx
+	}
+	op := newDdlOperation(t)
+	if op == nil {
+		return ctx
+	}
+	op.CreateTime = time.Now()
+	op.UpdateTime = op.CreateTime
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.operations[op.ID] = op
+	j.persistLocked(op)
+	return context.WithValue(ctx, ddlOperationKey{}, &ddlOperationRef{journal: j, id: op.ID})
+}
+
+// finish records the result of the ddl task, the operation is kept running until its asynchronous steps complete.
+func (j *ddlJournal) finish(ctx context.Context, err error) {
+	ref, ok := ctx.Value(ddlOperationKey{}).(*ddlOperationRef)
+	if !ok {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	op, ok := j.operations[ref.id]
+	if !ok || op.finished() {
+		return
+	}
+	op.executed = true
+	switch {
+	case err != nil:
+		op.State = ddlOperationFailed
+		op.Reason = err.Error()
+	case op.stepsCompleted():
+		op.State = ddlOperationCompleted
+	}
+	op.UpdateTime = time.Now()
+	j.persistLocked(op)
+}
+
+// ddlOperationRef refers to a journaled ddl operation.
+type ddlOperationRef struct {
+	journal *ddlJournal
+	id      UniqueID
+}
+
+// trackDdlSteps journals the steps to the operation of the context, and returns the steps wrapped to
+// update their states. The steps are returned as they are if the context carries no operation.
+func trackDdlSteps(ctx context.Context, steps []nestedStep, mode ddlStepMode) []nestedStep {
+	ref, ok := ctx.Value(ddlOperationKey{}).(*ddlOperationRef)
+	if !ok {
+		return steps
+	}
+	return ref.journal.addSteps(ref.id, steps, mode)
+}
+
+func (j *ddlJournal) addSteps(id UniqueID, steps []nestedStep, mode ddlStepMode) []nestedStep {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	op, ok := j.operations[id]
+	if !ok {
+		return steps
+	}
+	now := time.Now()
+	tracked := make([]nestedStep, 0, len(steps))
+	for _, step := range steps {
+		kind := ddlStepKind(step)
+		if kind == "" {
+			tracked = append(tracked, step)
+			continue
+		}
+		op.Steps = append(op.Steps, &ddlStep{Desc: step.Desc(), Kind: kind, Undo: mode == ddlStepUndo, State: ddlStepPending, UpdateTime: now})
+		tracked = append(tracked, &journaledStep{nestedStep: step, journal: j, id: id, index: len(op.Steps) - 1, mode: mode})
+	}
+	op.UpdateTime = now
+	j.persistLocked(op)
+	return tracked
+}
+
+// updateStep updates the state of the step, the operation is persisted only when the step state changed.
+func (j *ddlJournal) updateStep(id UniqueID, index int, state ddlStepState, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	op, ok := j.operations[id]
+	if !ok || index >= len(op.Steps) {
+		return
+	}
+	step := op.Steps[index]
+	reason := ""
+	if err != nil {
+		reason = err.Error()
+	}
+	// a retrying step keeps retrying until it completes or fails
+	if step.State == state && step.Reason == reason || state == ddlStepRunning && step.State == ddlStepRetrying {
+		return
+	}
+	now := time.Now()
+	step.State, step.Reason, step.UpdateTime = state, reason, now
+	op.UpdateTime = now
+	switch {
+	case op.finished():
+	case state == ddlStepFailed && !step.Undo:
+		op.State = ddlOperationFailed
+		op.Reason = fmt.Sprintf("step failed, step: %s, err: %s", step.Desc, reason)
+	case state == ddlStepCompleted && op.executed && op.stepsCompleted():
+		op.State = ddlOperationCompleted
+	}
+	j.persistLocked(op)
+}
+
+// get returns a copy of the operation.
+func (j *ddlJournal) get(id UniqueID) (*ddlOperation, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	op, ok := j.operations[id]
+	if !ok {
+		return nil, false
+	}
+	return op.clone(), true
+}
+
+// list returns the copies of the operations on the collection, or all the operations if the collection
+// name is empty, the latest first.
+func (j *ddlJournal) list(collectionName string) []*ddlOperation {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	ops := make([]*ddlOperation, 0)
+	for _, op := range j.operations {
+		if collectionName == "" || op.CollectionName == collectionName || op.Alias == collectionName {
+			ops = append(ops, op.clone())
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].ID > ops[j].ID })
+	return ops
+}
+
+// expire removes the operations finished before the retention.
+func (j *ddlJournal) expire() {
+	retention := time.Duration(Params.RootCoordCfg.DdlJournalRetention.GetAsFloat() * float64(time.Second))
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for id, op := range j.operations {
+		if !op.finished() || time.Since(op.UpdateTime) < retention {
+			continue
+		}
+		if err := j.kv.Remove(ddlJournalKey(id)); err != nil {
+			log.Warn("failed to remove expired ddl operation", zap.Int64("id", id), zap.Error(err))
+			continue
+		}
+		delete(j.operations, id)
+	}
+}
+
+func (j *ddlJournal) cleanupLoop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(ddlJournalCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("ddl journal cleanup loop exit")
+			return
+		case <-ticker.C:
+			j.expire()
+		}
+	}
+}
+
+// journaledStep updates the state of the step in the journal when executed. The failed synchronous steps fail
+// the ddl, while the failed asynchronous steps are retried by the step executor unless unrecoverable.
+type journaledStep struct {
+	nestedStep
+	journal *ddlJournal
+	id      UniqueID
+	index   int
+	mode    ddlStepMode
+}
+
+func (s *journaledStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	s.journal.updateStep(s.id, s.index, ddlStepRunning, nil)
+	children, err := s.nestedStep.Execute(ctx)
+	switch {
+	case err == nil:
+		s.journal.updateStep(s.id, s.index, ddlStepCompleted, nil)
+	case s.mode == ddlStepSync || retry.IsUnRecoverable(err):
+		s.journal.updateStep(s.id, s.index, ddlStepFailed, err)
+	default:
+		s.journal.updateStep(s.id, s.index, ddlStepRetrying, err)
+	}
+	return children, err
+}
+
+var (
+	ddlOperationStates = map[ddlOperationState]proxypb.DdlOperationState{
+		ddlOperationRunning:     proxypb.DdlOperationState_DdlOperationRunning,
+		ddlOperationCompleted:   proxypb.DdlOperationState_DdlOperationCompleted,
+		ddlOperationFailed:      proxypb.DdlOperationState_DdlOperationFailed,
+		ddlOperationInterrupted: proxypb.DdlOperationState_DdlOperationInterrupted,
+	}
+	ddlStepStates = map[ddlStepState]proxypb.DdlStepState{
+		ddlStepPending:   proxypb.DdlStepState_DdlStepPending,
+		ddlStepRunning:   proxypb.DdlStepState_DdlStepRunning,
+		ddlStepRetrying:  proxypb.DdlStepState_DdlStepRetrying,
+		ddlStepCompleted: proxypb.DdlStepState_DdlStepCompleted,
+		ddlStepFailed:    proxypb.DdlStepState_DdlStepFailed,
+	}
+)
+
+func (op *ddlOperation) toProto() *proxypb.DdlOperation {
+	steps := make([]*proxypb.DdlStep, 0, len(op.Steps))
+	for _, step := range op.Steps {
+		steps = append(steps, &proxypb.DdlStep{
+			Desc:       step.Desc,
+			Kind:       step.Kind,
+			Undo:       step.Undo,
+			State:      ddlStepStates[step.State],
+			Reason:     step.Reason,
+			UpdateTime: step.UpdateTime.UnixMilli(),
+		})
+	}
+	return &proxypb.DdlOperation{
+		OperationID:    op.ID,
+		Type:           op.Type,
+		DbName:         op.DbName,
+		CollectionName: op.CollectionName,
+		PartitionName:  op.PartitionName,
+		Alias:          op.Alias,
+		Ts:             op.Ts,
+		State:          ddlOperationStates[op.State],
+		Reason:         op.Reason,
+		Steps:          steps,
+		CreateTime:     op.CreateTime.UnixMilli(),
+		UpdateTime:     op.UpdateTime.UnixMilli(),
+	}
+}
+
+// GetDdlOperationState returns the ddl operation of the id, or the operations on the collection, or all the
+// operations in the ddl journal, the latest first.
+func (c *Core) GetDdlOperationState(ctx context.Context, in *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.GetDdlOperationStateResponse{
+			Status: errorutil.UnhealthyStatus(code),
+		}, nil
+	}
+	var ops []*ddlOperation
+	if id := in.GetOperationID(); id != 0 {
+		op, ok := c.ddlJournal.get(id)
+		if !ok {
+			return &proxypb.GetDdlOperationStateResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument, fmt.Sprintf("ddl operation %d not found", id)),
+			}, nil
+		}
+		ops = []*ddlOperation{op}
+	} else {
+		ops = c.ddlJournal.list(in.GetCollectionName())
+	}
+	ret := make([]*proxypb.DdlOperation, 0, len(ops))
+	for _, op := range ops {
+		ret = append(ret, op.toProto())
+	}
+	return &proxypb.GetDdlOperationStateResponse{
+		Status:     succStatus(),
+		Operations: ret,
+	}, nil
+}
@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newDdlJournalTestTask(core *Core, id UniqueID, collectionName string) *dropCollectionTask {
+	task := &dropCollectionTask{
+		baseTask: newBaseTask(context.Background(), core),
+		Req:      &milvuspb.DropCollectionRequest{CollectionName: collectionName},
+	}
+	task.SetID(id)
+	return task
+}
+
+func TestNewDdlOperation(t *testing.T) {
+	op := newDdlOperation(&createPartitionTask{Req: &milvuspb.CreatePartitionRequest{CollectionName: "coll", PartitionName: "p"}})
+	require.NotNil(t, op)
+	assert.Equal(t, "CreatePartition", op.Type)
+	assert.Equal(t, "coll", op.CollectionName)
+	assert.Equal(t, "p", op.PartitionName)
+	assert.Equal(t, ddlOperationRunning, op.State)
+
+	assert.Nil(t, newDdlOperation(&hasCollectionTask{Req: &milvuspb.HasCollectionRequest{CollectionName: "coll"}}))
+}
+
+func TestDdlJournal_RedoTask(t *testing.T) {
+	removeErr := errors.New("mock remove collection error")
+	meta := newMockMetaTable()
+	meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error {
+		return nil
+	}
+	meta.RemoveCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+		return removeErr
+	}
+	core := newTestCore(withMeta(meta))
+	kv := memkv.NewMemoryKV()
+	journal := newDdlJournal(kv)
+	ctx := journal.begin(context.Background(), newDdlJournalTestTask(core, 1, "coll"))
+
+	asyncSteps := make(chan *stepStack, 1)
+	stepExecutor := newMockStepExecutor()
+	stepExecutor.AddStepsFunc = func(s *stepStack) { asyncSteps <- s }
+	redo := newBaseRedoTask(stepExecutor)
+	redo.AddSyncStep(&changeCollectionStateStep{baseStep: baseStep{core: core}, collectionID: 100, state: pb.CollectionState_CollectionDropping})
+	redo.AddAsyncStep(&nullStep{})
+	redo.AddAsyncStep(&deleteCollectionMetaStep{baseStep: baseStep{core: core}, collectionID: 100})
+	require.NoError(t, redo.Execute(ctx))
+	journal.finish(ctx, nil)
+	s := <-asyncSteps
+
+	op, ok := journal.get(1)
+	require.True(t, ok)
+	assert.Equal(t, "DropCollection", op.Type)
+	assert.Equal(t, ddlOperationRunning, op.State)
+	require.Equal(t, 2, len(op.Steps))
+	assert.Equal(t, ddlStepKindMetaWrite, op.Steps[0].Kind)
+	assert.Equal(t, ddlStepCompleted, op.Steps[0].State)
+	assert.Equal(t, ddlStepPending, op.Steps[1].State)
+
+	// the failed async step is retried
+	s = s.Execute(context.Background())
+	require.NotNil(t, s)
+	op, _ = journal.get(1)
+	assert.Equal(t, ddlOperationRunning, op.State)
+	assert.Equal(t, ddlStepRetrying, op.Steps[1].State)
+	assert.Equal(t, removeErr.Error(), op.Steps[1].Reason)
+
+	removeErr = nil
+	assert.Nil(t, s.Execute(context.Background()))
+	op, _ = journal.get(1)
+	assert.Equal(t, ddlOperationCompleted, op.State)
+	assert.Equal(t, ddlStepCompleted, op.Steps[1].State)
+
+	// the journal is persisted
+	loaded := newDdlJournal(kv)
+	require.NoError(t, loaded.load())
+	op, ok = loaded.get(1)
+	require.True(t, ok)
+	assert.Equal(t, ddlOperationCompleted, op.State)
+	assert.Equal(t, 2, len(op.Steps))
+}
+
+func TestDdlJournal_UndoTask(t *testing.T) {
+	meta := newMockMetaTable()
+	meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error {
+		return errors.New("mock change collection state error")
+	}
+	meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
+		return nil
+	}
+	meta.RemoveCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+		return nil
+	}
+	core := newTestCore(withMeta(meta))
+	journal := newDdlJournal(memkv.NewMemoryKV())
+	ctx := journal.begin(context.Background(), newDdlJournalTestTask(core, 1, "coll"))
+
+	undoSteps := make(chan *stepStack, 1)
+	stepExecutor := newMockStepExecutor()
+	stepExecutor.AddStepsFunc = func(s *stepStack) { undoSteps <- s }
+	undo := newBaseUndoTask(stepExecutor)
+	undo.AddStep(&addCollectionMetaStep{baseStep: baseStep{core: core}, coll: &model.Collection{CollectionID: 100}}, &deleteCollectionMetaStep{baseStep: baseStep{core: core}, collectionID: 100})
+	undo.AddStep(&changeCollectionStateStep{baseStep: baseStep{core: core}, collectionID: 100}, &nullStep{})
+	err := undo.Execute(ctx)
+	assert.Error(t, err)
+	journal.finish(ctx, err)
+
+	op, _ := journal.get(1)
+	assert.Equal(t, ddlOperationFailed, op.State)
+	assert.Contains(t, op.Reason, "mock change collection state error")
+	require.Equal(t, 3, len(op.Steps))
+	assert.Equal(t, ddlStepCompleted, op.Steps[0].State)
+	assert.Equal(t, ddlStepKindMetaWrite, op.Steps[0].Kind)
+	assert.Equal(t, ddlStepFailed, op.Steps[1].State)
+	assert.True(t, op.Steps[2].Undo)
+	assert.Equal(t, ddlStepPending, op.Steps[2].State)
+
+	assert.Nil(t, (<-undoSteps).Execute(context.Background()))
+	op, _ = journal.get(1)
+	assert.Equal(t, ddlOperationFailed, op.State)
+	assert.Equal(t, ddlStepCompleted, op.Steps[2].State)
+}
+
+func TestDdlJournal_LoadAndExpire(t *testing.T) {
+	core := newTestCore()
+	kv := memkv.NewMemoryKV()
+	journal := newDdlJournal(kv)
+	journal.finish(journal.begin(context.Background(), newDdlJournalTestTask(core, 1, "coll")), nil)
+	journal.begin(context.Background(), newDdlJournalTestTask(core, 2, "coll"))
+	journal.begin(context.Background(), newDdlJournalTestTask(core, 3, "coll2"))
+	// not a ddl
+	ctx := journal.begin(context.Background(), &hasCollectionTask{baseTask: newBaseTask(context.Background(), core)})
+	assert.Nil(t, ctx.Value(ddlOperationKey{}))
+	assert.Equal(t, 3, len(journal.list("")))
+
+	loaded := newDdlJournal(kv)
+	require.NoError(t, loaded.load())
+	ops := loaded.list("coll")
+	require.Equal(t, 2, len(ops))
+	assert.Equal(t, UniqueID(2), ops[0].ID)
+	assert.Equal(t, ddlOperationInterrupted, ops[0].State)
+	assert.Equal(t, ddlOperationCompleted, ops[1].State)
+
+	// the operations are kept within the retention
+	loaded.expire()
+	assert.Equal(t, 3, len(loaded.list("")))
+
+	paramtable.Get().Save(Params.RootCoordCfg.DdlJournalRetention.Key, "0")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.DdlJournalRetention.Key)
+	journal.expire()
+	assert.Equal(t, 2, len(journal.list("")))
+	loaded = newDdlJournal(kv)
+	require.NoError(t, loaded.load())
+	assert.Equal(t, 2, len(loaded.list("")))
+	_, ok := loaded.get(1)
+	assert.False(t, ok)
+}
+
+func TestCore_GetDdlOperationState(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCore(withHealthyCode())
+	c.ddlJournal = newDdlJournal(memkv.NewMemoryKV())
+	c.ddlJournal.begin(ctx, newDdlJournalTestTask(c, 1, "coll"))
+	c.ddlJournal.begin(ctx, newDdlJournalTestTask(c, 2, "coll2"))
+	c.ddlJournal.finish(context.WithValue(ctx, ddlOperationKey{}, &ddlOperationRef{journal: c.ddlJournal, id: 2}), errors.New("mock"))
+
+	resp, err := c.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{OperationID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 1, len(resp.GetOperations()))
+	assert.Equal(t, "coll", resp.GetOperations()[0].GetCollectionName())
+	assert.Equal(t, "DropCollection", resp.GetOperations()[0].GetType())
+	assert.Equal(t, proxypb.DdlOperationState_DdlOperationRunning, resp.GetOperations()[0].GetState())
+
+	resp, err = c.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{CollectionName: "coll2"})
+	assert.NoError(t, err)
+	require.Equal(t, 1, len(resp.GetOperations()))
+	assert.Equal(t, UniqueID(2), resp.GetOperations()[0].GetOperationID())
+	assert.Equal(t, proxypb.DdlOperationState_DdlOperationFailed, resp.GetOperations()[0].GetState())
+	assert.Equal(t, "mock", resp.GetOperations()[0].GetReason())
+
+	// the latest first
+	resp, err = c.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{})
+	assert.NoError(t, err)
+	require.Equal(t, 2, len(resp.GetOperations()))
+	assert.Equal(t, UniqueID(2), resp.GetOperations()[0].GetOperationID())
+
+	resp, err = c.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{OperationID: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+	c.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = c.GetDdlOperationState(ctx, &proxypb.GetDdlOperationStateRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+}
@@ -512,18 +512,18 @@ func (m *importManager) isRowbased(files []string) (bool, error) {
 	isRowBased := false
 	for _, filePath := range files {
 		_, fileType := importutil.GetFileNameAndExt(filePath)
-		if fileType == importutil.JSONFileExt {
+		if fileType == importutil.JSONFileExt || fileType == importutil.CSVFileExt {
 			isRowBased = true
 		} else if isRowBased {
-			log.Error("row-based data file type must be JSON, mixed file types is not allowed", zap.Strings("files", files))
-			return isRowBased, fmt.Errorf("row-based data file type must be JSON, file type '%s' is not allowed", fileType)
+			log.Error("row-based data file type must be JSON or CSV, mixed file types is not allowed", zap.Strings("files", files))
+			return isRowBased, fmt.Errorf("row-based data file type must be JSON or CSV, file type '%s' is not allowed", fileType)
 		}
 	}
 
 	// for row_based, we only allow one file so that each invocation only generate a task
 	if isRowBased && len(files) > 1 {
-		log.Error("row-based import, only allow one JSON file each time", zap.Strings("files", files))
-		return isRowBased, fmt.Errorf("row-based import, only allow one JSON file each time")
+		log.Error("row-based import, only allow one JSON or CSV file each time", zap.Strings("files", files))
+		return isRowBased, fmt.Errorf("row-based import, only allow one JSON or CSV file each time")
 	}
 
 	return isRowBased, nil
@@ -710,6 +710,8 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 					break
 				} else if kv.GetKey() == importutil.PersistTimeCost {
 					toPersistImportTaskInfo.Infos = append(toPersistImportTaskInfo.Infos, kv)
+				} else if kv.GetKey() == importutil.ProgressPercent {
+					toPersistImportTaskInfo.Infos = updateKeyValuePair(toPersistImportTaskInfo.Infos, kv)
 				}
 			}
 			// Update task in task store.
@@ -1188,6 +1190,17 @@ func tryUpdateErrMsg(errReason string, toPersistImportTaskInfo *datapb.ImportTas
 	}
 }
 
+// updateKeyValuePair returns a copy of pairs in which the pair with the same key is replaced by kv.
+func updateKeyValuePair(pairs []*commonpb.KeyValuePair, kv *commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	updated := make([]*commonpb.KeyValuePair, 0, len(pairs)+1)
+	for _, pair := range pairs {
+		if pair.GetKey() != kv.GetKey() {
+			updated = append(updated, pair)
+		}
+	}
+	return append(updated, kv)
+}
+
 func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo {
 	cloned := &datapb.ImportTaskInfo{
 		Id:             taskInfo.GetId(),
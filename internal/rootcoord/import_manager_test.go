@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -908,6 +909,26 @@ func TestImportManager_TaskState(t *testing.T) {
 	assert.Equal(t, commonpb.ImportState_ImportPersisted, ti.GetState().GetStateCode())
 	assert.Equal(t, int64(1000), ti.GetState().GetRowCount())
 
+	// the progress reported by datanode replaces the previous one
+	for _, progress := range []string{"50", "100"} {
+		ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+			TaskId: 3,
+			State:  commonpb.ImportState_ImportStarted,
+			Infos:  []*commonpb.KeyValuePair{{Key: importutil.ProgressPercent, Value: progress}},
+		})
+		assert.NoError(t, err)
+	}
+	progress, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.ProgressPercent, ti.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "100", progress)
+	progressCount := 0
+	for _, kv := range ti.GetInfos() {
+		if kv.GetKey() == importutil.ProgressPercent {
+			progressCount++
+		}
+	}
+	assert.Equal(t, 1, progressCount)
+
 	resp := mgr.getTaskState(10000)
 	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 
@@ -1228,6 +1249,16 @@ func TestImportManager_isRowbased(t *testing.T) {
 	rb, err = mgr.isRowbased(files)
 	assert.Nil(t, err)
 	assert.False(t, rb)
+
+	files = []string{"1.csv"}
+	rb, err = mgr.isRowbased(files)
+	assert.Nil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.csv", "2.npy"}
+	rb, err = mgr.isRowbased(files)
+	assert.NotNil(t, err)
+	assert.True(t, rb)
 }
 
 func TestImportManager_checkIndexingDone(t *testing.T) {
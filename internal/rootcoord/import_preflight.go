@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// checkImportPreflight checks the files of an import with the PreImport of DataCoord before the import tasks are
+// created, an IllegalArgument status is returned if the check fails. nil is returned if the check passes or can't be
+// done, e.g. DataCoord is an older version, in which case the failures are reported by the import tasks.
+func (c *Core) checkImportPreflight(ctx context.Context, req *milvuspb.ImportRequest, collectionID UniqueID) *commonpb.Status {
+	if !Params.RootCoordCfg.ImportPreflightCheckEnabled.GetAsBool() || len(req.GetFiles()) == 0 {
+		return nil
+	}
+	log := log.Ctx(ctx).With(zap.String("collection name", req.GetCollectionName()), zap.Int64("collection ID", collectionID))
+	resp, err := c.broker.PreImport(ctx, &datapb.PreImportRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		CollectionName: req.GetCollectionName(),
+		CollectionID:   collectionID,
+		Files:          req.GetFiles(),
+		Options:        req.GetOptions(),
+	})
+	if err != nil {
+		log.Warn("skip the pre-flight check of import", zap.Error(err))
+		return nil
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("skip the pre-flight check of import", zap.String("reason", resp.GetStatus().GetReason()))
+		return nil
+	}
+	if len(resp.GetErrors()) > 0 {
+		log.Warn("import rejected by the pre-flight check", zap.Strings("errors", resp.GetErrors()))
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "import pre-flight check failed: "+strings.Join(resp.GetErrors(), "; "))
+	}
+	log.Info("import passed the pre-flight check", zap.Int64("estimated rows", resp.GetEstimatedRows()),
+		zap.Int64("estimated segments", resp.GetEstimatedSegments()))
+	return nil
+}
@@ -264,6 +264,9 @@ type mockProxy struct {
 	InvalidateCollectionMetaCacheFunc func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	InvalidateCredentialCacheFunc     func(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
 	RefreshPolicyInfoCacheFunc        func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
+	RefreshRoleQuotaFunc              func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error)
+	RefreshDatabaseQuotaFunc          func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error)
+	RefreshReadOnlyModeFunc           func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error)
 	GetComponentStatesFunc            func(ctx context.Context) (*milvuspb.ComponentStates, error)
 }
 
@@ -279,6 +282,18 @@ func (m mockProxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.
 	return m.RefreshPolicyInfoCacheFunc(ctx, request)
 }
 
+func (m mockProxy) RefreshRoleQuota(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+	return m.RefreshRoleQuotaFunc(ctx, request)
+}
+
+func (m mockProxy) RefreshDatabaseQuota(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+	return m.RefreshDatabaseQuotaFunc(ctx, request)
+}
+
+func (m mockProxy) RefreshReadOnlyMode(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+	return m.RefreshReadOnlyModeFunc(ctx, request)
+}
+
 func (m mockProxy) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	return m.GetComponentStatesFunc(ctx)
 }
@@ -785,6 +800,7 @@ type mockBroker struct {
 	ReleaseSegRefLockFunc func(ctx context.Context, taskID int64, segIDs []int64) error
 	FlushFunc             func(ctx context.Context, cID int64, segIDs []int64) error
 	ImportFunc            func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
+	PreImportFunc         func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error)
 
 	DropCollectionIndexFunc  func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	DescribeIndexFunc        func(ctx context.Context, colID UniqueID) (*datapb.DescribeIndexResponse, error)
@@ -821,6 +837,10 @@ func (b mockBroker) GetSegmentIndexState(ctx context.Context, collID UniqueID, i
 	return b.GetSegmentIndexStateFunc(ctx, collID, indexName, segIDs)
 }
 
+func (b mockBroker) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+	return b.PreImportFunc(ctx, req)
+}
+
 func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
@@ -0,0 +1,377 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// the periods a time-partitioned collection can roll over its partitions by
+const (
+	rolloverPeriodHourly = "hourly"
+	rolloverPeriodDaily  = "daily"
+)
+
+const (
+	defaultRolloverPartitionPrefix = "p_"
+	// maxRolloverPrecreateNum bounds the upcoming partitions created ahead, since a collection has limited partitions
+	maxRolloverPrecreateNum = 168
+)
+
+// partitionRolloverPolicy makes a collection time-partitioned, a partition named by the partition prefix and the
+// start of its period in UTC is created for each period. The inserts are not routed by time, the clients insert the
+// entities of a period into its partition by name.
+// The policy is given by the collection properties, which are set by the CreateCollection and AlterCollection apis.
+type partitionRolloverPolicy struct {
+	Period          string
+	PartitionPrefix string
+	// PrecreateNum is the number of the upcoming partitions created ahead besides the one of the current period
+	PrecreateNum int
+	// RetentionPeriods is the number of the past periods whose partitions are kept, 0 keeps all of them
+	RetentionPeriods int
+}
+
+// parsePartitionRolloverPolicy returns the rollover policy given by the collection properties, nil if the collection
+// is not time-partitioned.
+func parsePartitionRolloverPolicy(properties []*commonpb.KeyValuePair) (*partitionRolloverPolicy, error) {
+	var policy *partitionRolloverPolicy
+	var precreateNum, retentionPeriods string
+	for _, kv := range properties {
+		switch kv.GetKey() {
+		case common.CollectionPartitionRolloverPeriodKey:
+			if policy == nil {
+				policy = &partitionRolloverPolicy{}
+			}
+			policy.Period = kv.GetValue()
+		case common.CollectionPartitionRolloverPrefixKey:
+			if policy == nil {
+				policy = &partitionRolloverPolicy{}
+			}
+			policy.PartitionPrefix = kv.GetValue()
+		case common.CollectionPartitionRolloverPrecreateKey:
+			precreateNum = kv.GetValue()
+		case common.CollectionPartitionRolloverRetentionKey:
+			retentionPeriods = kv.GetValue()
+		}
+	}
+	if policy == nil {
+		if precreateNum != "" || retentionPeriods != "" {
+			return nil, fmt.Errorf("%s is required by the partition rollover", common.CollectionPartitionRolloverPeriodKey)
+		}
+		return nil, nil
+	}
+	var err error
+	if precreateNum != "" {
+		if policy.PrecreateNum, err = strconv.Atoi(precreateNum); err != nil {
+			return nil, fmt.Errorf("invalid %s %s: %w", common.CollectionPartitionRolloverPrecreateKey, precreateNum, err)
+		}
+	}
+	if retentionPeriods != "" {
+		if policy.RetentionPeriods, err = strconv.Atoi(retentionPeriods); err != nil {
+			return nil, fmt.Errorf("invalid %s %s: %w", common.CollectionPartitionRolloverRetentionKey, retentionPeriods, err)
+		}
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (p *partitionRolloverPolicy) validate() error {
+	if p.Period != rolloverPeriodHourly && p.Period != rolloverPeriodDaily {
+		return fmt.Errorf("invalid %s %s, should be %s or %s", common.CollectionPartitionRolloverPeriodKey, p.Period,
+			rolloverPeriodHourly, rolloverPeriodDaily)
+	}
+	if p.PrecreateNum < 0 || p.PrecreateNum > maxRolloverPrecreateNum {
+		return fmt.Errorf("invalid %s %d, should be in [0, %d]", common.CollectionPartitionRolloverPrecreateKey,
+			p.PrecreateNum, maxRolloverPrecreateNum)
+	}
+	if p.RetentionPeriods < 0 {
+		return fmt.Errorf("invalid %s %d, should not be negative", common.CollectionPartitionRolloverRetentionKey, p.RetentionPeriods)
+	}
+	for i, c := range p.PartitionPrefix {
+		isLetter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !isLetter && (i == 0 || c < '0' || c > '9') {
+			return fmt.Errorf("invalid %s %s, should start with a letter or underscore and contain only letters, numbers and underscores",
+				common.CollectionPartitionRolloverPrefixKey, p.PartitionPrefix)
+		}
+	}
+	return nil
+}
+
+func (p *partitionRolloverPolicy) prefix() string {
+	if p.PartitionPrefix == "" {
+		return defaultRolloverPartitionPrefix
+	}
+	return p.PartitionPrefix
+}
+
+func (p *partitionRolloverPolicy) periodDuration() time.Duration {
+	if p.Period == rolloverPeriodHourly {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+func (p *partitionRolloverPolicy) timeLayout() string {
+	if p.Period == rolloverPeriodHourly {
+		return "2006010215"
+	}
+	return "20060102"
+}
+
+// partitionForTime returns the partition the entities at t are routed to, and the time range [start, end) of the partition.
+func (p *partitionRolloverPolicy) partitionForTime(t time.Time) (name string, start time.Time, end time.Time) {
+	start = t.UTC().Truncate(p.periodDuration())
+	return p.prefix() + start.Format(p.timeLayout()), start, start.Add(p.periodDuration())
+}
+
+// parsePartition returns the start of the period of a partition created by the rollover,
+// false is returned if the partition is not named by the policy.
+func (p *partitionRolloverPolicy) parsePartition(name string) (time.Time, bool) {
+	suffix := strings.TrimPrefix(name, p.prefix())
+	if suffix == name || len(suffix) != len(p.timeLayout()) {
+		return time.Time{}, false
+	}
+	start, err := time.ParseInLocation(p.timeLayout(), suffix, time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// plan returns the partitions to create for the current and the upcoming periods, and the partitions to drop whose
+// periods are beyond the retention. Only the partitions created by the rollover are dropped, the ones named alike by
+// the users are kept.
+func (p *partitionRolloverPolicy) plan(existing []string, created map[string]struct{}, now time.Time) (toCreate []string, toDrop []string) {
+	exists := make(map[string]struct{}, len(existing))
+	for _, name := range existing {
+		exists[name] = struct{}{}
+	}
+	_, current, _ := p.partitionForTime(now)
+	for i := 0; i <= p.PrecreateNum; i++ {
+		name, _, _ := p.partitionForTime(current.Add(time.Duration(i) * p.periodDuration()))
+		if _, ok := exists[name]; !ok {
+			toCreate = append(toCreate, name)
+		}
+	}
+	if p.RetentionPeriods == 0 {
+		return toCreate, nil
+	}
+	oldest := current.Add(-time.Duration(p.RetentionPeriods) * p.periodDuration())
+	for _, name := range existing {
+		if _, ok := created[name]; !ok {
+			continue
+		}
+		if start, ok := p.parsePartition(name); ok && start.Before(oldest) {
+			toDrop = append(toDrop, name)
+		}
+	}
+	sort.Strings(toDrop)
+	return toCreate, toDrop
+}
+
+// rolloverKey returns the key recording a partition created by the rollover, or the prefix of the ones of the
+// collection if the partition name is empty.
+func rolloverKey(collectionID UniqueID, partitionName string) string {
+	return fmt.Sprintf("%s/%d/%s", rootcoord.PartitionRolloverPrefix, collectionID, partitionName)
+}
+
+func (c *Core) initRolloverKV() error {
+	metaKV, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.rolloverKV = metaKV
+	return nil
+}
+
+// listRolloverPartitions returns the partitions created by the rollover of each collection.
+func (c *Core) listRolloverPartitions() (map[UniqueID]map[string]struct{}, error) {
+	keys, values, err := c.rolloverKV.LoadWithPrefix(rootcoord.PartitionRolloverPrefix + "/")
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[UniqueID]map[string]struct{})
+	for i, key := range keys {
+		// the keys are returned with the root path, the collection id is the second to last element
+		elems := strings.Split(key, "/")
+		if len(elems) < 2 {
+			continue
+		}
+		collectionID, err := strconv.ParseInt(elems[len(elems)-2], 10, 64)
+		if err != nil {
+			log.Warn("skip invalid partition rollover record", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if ret[collectionID] == nil {
+			ret[collectionID] = make(map[string]struct{})
+		}
+		ret[collectionID][values[i]] = struct{}{}
+	}
+	return ret, nil
+}
+
+// rolloverStatusError returns the error of a ddl request sent by the rollover.
+func rolloverStatusError(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
+
+// partitionRolloverLoop rolls over the partitions of the time-partitioned collections periodically.
+func (c *Core) partitionRolloverLoop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	interval := Params.RootCoordCfg.PartitionRolloverCheckInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("partition rollover loop exit")
+			return
+		case <-ticker.C:
+			c.rolloverPartitions(ctx)
+		}
+	}
+}
+
+// rolloverPartitions rolls over the partitions of all the time-partitioned collections.
+func (c *Core) rolloverPartitions(ctx context.Context) {
+	colls, err := c.meta.ListCollections(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		log.Warn("failed to list collections for partition rollover", zap.Error(err))
+		return
+	}
+	rolledOver, err := c.listRolloverPartitions()
+	if err != nil {
+		log.Warn("failed to list the partitions created by the rollover", zap.Error(err))
+		return
+	}
+	for _, coll := range colls {
+		created := rolledOver[coll.CollectionID]
+		delete(rolledOver, coll.CollectionID)
+		policy, err := parsePartitionRolloverPolicy(coll.Properties)
+		if err != nil {
+			log.Warn("skip the partition rollover of the collection with invalid properties",
+				zap.Int64("collectionID", coll.CollectionID), zap.Error(err))
+			continue
+		}
+		if policy != nil {
+			c.rolloverCollectionPartitions(ctx, coll, policy, created, time.Now())
+		}
+	}
+	// the records of the dropped collections
+	for collectionID := range rolledOver {
+		if err := c.rolloverKV.RemoveWithPrefix(rolloverKey(collectionID, "")); err != nil {
+			log.Warn("failed to remove the partition rollover records of the dropped collection",
+				zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+}
+
+// rolloverCollectionPartitions creates the partitions of the current and the upcoming periods of the collection,
+// and drops the partitions it created beyond the retention. The partitions are created and dropped by the ddl tasks,
+// in the same way as the requests of the users, and the created ones are recorded in the meta kv.
+func (c *Core) rolloverCollectionPartitions(ctx context.Context, coll *model.Collection, policy *partitionRolloverPolicy,
+	rolledOver map[string]struct{}, now time.Time) {
+	log := log.With(zap.Int64("collectionID", coll.CollectionID), zap.String("collectionName", coll.Name))
+	existing := make([]string, 0, len(coll.Partitions))
+	exists := make(map[string]struct{}, len(coll.Partitions))
+	for _, partition := range coll.Partitions {
+		if partition.Available() {
+			existing = append(existing, partition.PartitionName)
+			exists[partition.PartitionName] = struct{}{}
+		}
+	}
+	// the records of the partitions dropped by the users
+	for name := range rolledOver {
+		if _, ok := exists[name]; !ok {
+			if err := c.rolloverKV.Remove(rolloverKey(coll.CollectionID, name)); err != nil {
+				log.Warn("failed to remove the record of the dropped partition", zap.String("partition", name), zap.Error(err))
+			}
+		}
+	}
+	toCreate, toDrop := policy.plan(existing, rolledOver, now)
+	created := make([]string, 0, len(toCreate))
+	for _, name := range toCreate {
+		status, err := c.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition),
+				commonpbutil.WithSourceID(c.session.ServerID),
+			),
+			CollectionName: coll.Name,
+			PartitionName:  name,
+		})
+		if err = rolloverStatusError(status, err); err != nil {
+			log.Warn("failed to create partition for rollover", zap.String("partition", name), zap.Error(err))
+			return
+		}
+		// a partition missing the record is kept as the ones of the users
+		if err = c.rolloverKV.Save(rolloverKey(coll.CollectionID, name), name); err != nil {
+			log.Warn("failed to record the partition created by rollover", zap.String("partition", name), zap.Error(err))
+		}
+		created = append(created, name)
+	}
+	dropped := make([]string, 0, len(toDrop))
+	for _, name := range toDrop {
+		status, err := c.DropPartition(ctx, &milvuspb.DropPartitionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_DropPartition),
+				commonpbutil.WithSourceID(c.session.ServerID),
+			),
+			CollectionName: coll.Name,
+			PartitionName:  name,
+		})
+		if err = rolloverStatusError(status, err); err != nil {
+			log.Warn("failed to drop expired partition for rollover", zap.String("partition", name), zap.Error(err))
+			return
+		}
+		if err = c.rolloverKV.Remove(rolloverKey(coll.CollectionID, name)); err != nil {
+			log.Warn("failed to remove the record of the expired partition", zap.String("partition", name), zap.Error(err))
+		}
+		dropped = append(dropped, name)
+	}
+	if len(created) > 0 || len(dropped) > 0 {
+		log.Info("partitions rolled over", zap.Strings("created", created), zap.Strings("dropped", dropped))
+	}
+}
@@ -0,0 +1,240 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func rolloverProperties(kvs ...string) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(kvs)/2)
+	for i := 0; i+1 < len(kvs); i += 2 {
+		ret = append(ret, &commonpb.KeyValuePair{Key: kvs[i], Value: kvs[i+1]})
+	}
+	return ret
+}
+
+func TestParsePartitionRolloverPolicy(t *testing.T) {
+	policy, err := parsePartitionRolloverPolicy(rolloverProperties(common.CollectionTTLConfigKey, "10"))
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = parsePartitionRolloverPolicy(rolloverProperties(
+		common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily,
+		common.CollectionPartitionRolloverPrefixKey, "d_",
+		common.CollectionPartitionRolloverPrecreateKey, "2",
+		common.CollectionPartitionRolloverRetentionKey, "7"))
+	require.NoError(t, err)
+	assert.Equal(t, &partitionRolloverPolicy{Period: rolloverPeriodDaily, PartitionPrefix: "d_", PrecreateNum: 2, RetentionPeriods: 7}, policy)
+
+	for _, properties := range [][]*commonpb.KeyValuePair{
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, "weekly"),
+		rolloverProperties(common.CollectionPartitionRolloverPrecreateKey, "1"),
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily, common.CollectionPartitionRolloverPrecreateKey, "x"),
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily, common.CollectionPartitionRolloverPrecreateKey, "-1"),
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily, common.CollectionPartitionRolloverPrecreateKey, "169"),
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily, common.CollectionPartitionRolloverRetentionKey, "-1"),
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily, common.CollectionPartitionRolloverPrefixKey, "1p"),
+		rolloverProperties(common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily, common.CollectionPartitionRolloverPrefixKey, "p-"),
+	} {
+		_, err = parsePartitionRolloverPolicy(properties)
+		assert.Error(t, err)
+	}
+
+	// the properties are validated by the ddl
+	alter := &alterCollectionTask{Req: &milvuspb.AlterCollectionRequest{
+		CollectionName: "coll",
+		Properties:     rolloverProperties(common.CollectionPartitionRolloverPeriodKey, "weekly"),
+	}}
+	assert.Error(t, alter.Prepare(context.Background()))
+	create := &createCollectionTask{Req: &milvuspb.CreateCollectionRequest{
+		Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		Properties: rolloverProperties(common.CollectionPartitionRolloverPeriodKey, "weekly"),
+	}}
+	assert.Error(t, create.validate())
+}
+
+func TestPartitionRolloverPolicy(t *testing.T) {
+	now := time.Date(2023, 1, 2, 15, 30, 0, 0, time.UTC)
+	daily := &partitionRolloverPolicy{Period: rolloverPeriodDaily}
+	name, start, end := daily.partitionForTime(now)
+	assert.Equal(t, "p_20230102", name)
+	assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), end)
+	// the periods are in UTC whatever the location of the time
+	name, _, _ = daily.partitionForTime(now.In(time.FixedZone("UTC+10", 10*3600)))
+	assert.Equal(t, "p_20230102", name)
+
+	hourly := &partitionRolloverPolicy{Period: rolloverPeriodHourly, PartitionPrefix: "h_"}
+	name, start, end = hourly.partitionForTime(now)
+	assert.Equal(t, "h_2023010215", name)
+	assert.Equal(t, time.Hour, end.Sub(start))
+	parsed, ok := hourly.parsePartition(name)
+	assert.True(t, ok)
+	assert.Equal(t, start, parsed)
+	_, ok = hourly.parsePartition("p_2023010215")
+	assert.False(t, ok)
+	_, ok = hourly.parsePartition("h_20230102")
+	assert.False(t, ok)
+	_, ok = hourly.parsePartition("h_abcdefghij")
+	assert.False(t, ok)
+
+	daily.PrecreateNum = 2
+	toCreate, toDrop := daily.plan([]string{"_default", "p_20230101", "p_20230102"}, nil, now)
+	assert.Equal(t, []string{"p_20230103", "p_20230104"}, toCreate)
+	assert.Empty(t, toDrop)
+
+	daily.RetentionPeriods = 1
+	existing := []string{"_default", "p_20200101", "p_20221230", "p_20221231", "p_20230101", "p_20230102", "p_20230103", "p_20230104"}
+	created := map[string]struct{}{"p_20221230": {}, "p_20221231": {}, "p_20230101": {}, "p_20230102": {}}
+	toCreate, toDrop = daily.plan(existing, created, now)
+	assert.Empty(t, toCreate)
+	// p_20200101 is not created by the rollover
+	assert.Equal(t, []string{"p_20221230", "p_20221231"}, toDrop)
+}
+
+func TestCore_RolloverPartitions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	policy := &partitionRolloverPolicy{Period: rolloverPeriodDaily, PrecreateNum: 1, RetentionPeriods: 1}
+	current, _, _ := policy.partitionForTime(now)
+	expired, _, _ := policy.partitionForTime(now.Add(-3 * 24 * time.Hour))
+	userExpired, _, _ := policy.partitionForTime(now.Add(-4 * 24 * time.Hour))
+
+	var created, dropped []string
+	var taskErr error
+	sched := newMockScheduler()
+	sched.AddTaskFunc = func(t task) error {
+		switch tt := t.(type) {
+		case *createPartitionTask:
+			created = append(created, tt.Req.GetPartitionName())
+		case *dropPartitionTask:
+			dropped = append(dropped, tt.Req.GetPartitionName())
+		}
+		t.NotifyDone(taskErr)
+		return nil
+	}
+	meta := newMockMetaTable()
+	meta.ListCollectionsFunc = func(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
+		return []*model.Collection{
+			{
+				CollectionID: 1,
+				Name:         "time_partitioned",
+				Properties: rolloverProperties(
+					common.CollectionPartitionRolloverPeriodKey, rolloverPeriodDaily,
+					common.CollectionPartitionRolloverPrecreateKey, "1",
+					common.CollectionPartitionRolloverRetentionKey, "1"),
+				Partitions: []*model.Partition{
+					{PartitionName: "_default", State: pb.PartitionState_PartitionCreated},
+					{PartitionName: current, State: pb.PartitionState_PartitionCreated},
+					{PartitionName: expired, State: pb.PartitionState_PartitionCreated},
+					{PartitionName: userExpired, State: pb.PartitionState_PartitionCreated},
+				},
+			},
+			{CollectionID: 2, Name: "other"},
+			{
+				CollectionID: 3,
+				Name:         "invalid",
+				Properties:   rolloverProperties(common.CollectionPartitionRolloverPeriodKey, "weekly"),
+			},
+		}, nil
+	}
+	saved := map[string]string{
+		rolloverKey(1, current):  current,
+		rolloverKey(1, expired):  expired,
+		rolloverKey(1, "p_gone"): "p_gone",
+		rolloverKey(4, current):  current,
+	}
+	sortedRolloverKeys := func() []string {
+		keys := make([]string, 0, len(saved))
+		for key := range saved {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	metaKV := kvmocks.NewMetaKv(t)
+	metaKV.On("Save", mock.Anything, mock.Anything).Return(func(key, value string) error {
+		saved[key] = value
+		return nil
+	})
+	metaKV.On("Remove", mock.Anything).Return(func(key string) error {
+		delete(saved, key)
+		return nil
+	})
+	metaKV.On("RemoveWithPrefix", mock.Anything).Return(func(prefix string) error {
+		for key := range saved {
+			if strings.HasPrefix(key, prefix) {
+				delete(saved, key)
+			}
+		}
+		return nil
+	})
+	metaKV.On("LoadWithPrefix", mock.Anything).Return(
+		func(prefix string) []string {
+			keys := make([]string, 0, len(saved))
+			for _, key := range sortedRolloverKeys() {
+				keys = append(keys, "by-dev/meta/"+key)
+			}
+			return keys
+		},
+		func(prefix string) []string {
+			values := make([]string, 0, len(saved))
+			for _, key := range sortedRolloverKeys() {
+				values = append(values, saved[key])
+			}
+			return values
+		},
+		func(prefix string) error { return nil })
+	c := newTestCore(withHealthyCode(), withScheduler(sched), withMeta(meta))
+	c.rolloverKV = metaKV
+
+	c.rolloverPartitions(ctx)
+	upcoming, _, _ := policy.partitionForTime(now.Add(24 * time.Hour))
+	assert.Equal(t, []string{upcoming}, created)
+	assert.Equal(t, []string{expired}, dropped)
+	// the records of the dropped partitions and collections are removed
+	assert.Equal(t, map[string]string{rolloverKey(1, current): current, rolloverKey(1, upcoming): upcoming}, saved)
+
+	// a failed creation stops the rollover of the collection until the next check
+	created, dropped = nil, nil
+	taskErr = errors.New("mock")
+	c.rolloverPartitions(ctx)
+	assert.Equal(t, []string{upcoming}, created)
+	assert.Empty(t, dropped)
+
+	c = newTestCore(withHealthyCode(), withScheduler(sched), withInvalidMeta())
+	created = nil
+	c.rolloverPartitions(ctx)
+	assert.Empty(t, created)
+}
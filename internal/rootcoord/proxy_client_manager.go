@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
@@ -230,6 +231,106 @@ func (p *proxyClientManager) RefreshPolicyInfoCache(ctx context.Context, req *pr
 	return group.Wait()
 }
 
+// RefreshRoleQuota notifies proxies to refresh the rate limiting quota of a role. The proxies of the older
+// versions don't serve the rpc during a rolling upgrade and are skipped, they load the quotas from RootCoord
+// once they are upgraded.
+func (p *proxyClientManager) RefreshRoleQuota(ctx context.Context, req *proxypb.RefreshRoleQuotaRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.proxyClient) == 0 {
+		log.Warn("proxy client is empty, RefreshRoleQuota will not send to any client")
+		return nil
+	}
+
+	group := &errgroup.Group{}
+	for k, v := range p.proxyClient {
+		k, v := k, v
+		group.Go(func() error {
+			status, err := v.RefreshRoleQuota(ctx, req)
+			if funcutil.IsGrpcUnimplemented(err) {
+				log.Warn("skip refreshing the role quota in the proxy not serving RefreshRoleQuota",
+					zap.Int64("proxyID", k), zap.Error(err))
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("RefreshRoleQuota failed, proxyID = %d, err = %s", k, err)
+			}
+			if status.GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("RefreshRoleQuota failed, proxyID = %d, err = %s", k, status.GetReason())
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+// RefreshDatabaseQuota notifies proxies to refresh the rate limiting quota of a database, the proxies of the older
+// versions not serving the rpc are skipped like in RefreshRoleQuota.
+func (p *proxyClientManager) RefreshDatabaseQuota(ctx context.Context, req *proxypb.RefreshDatabaseQuotaRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.proxyClient) == 0 {
+		log.Warn("proxy client is empty, RefreshDatabaseQuota will not send to any client")
+		return nil
+	}
+
+	group := &errgroup.Group{}
+	for k, v := range p.proxyClient {
+		k, v := k, v
+		group.Go(func() error {
+			status, err := v.RefreshDatabaseQuota(ctx, req)
+			if funcutil.IsGrpcUnimplemented(err) {
+				log.Warn("skip refreshing the database quota in the proxy not serving RefreshDatabaseQuota",
+					zap.Int64("proxyID", k), zap.Error(err))
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("RefreshDatabaseQuota failed, proxyID = %d, err = %s", k, err)
+			}
+			if status.GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("RefreshDatabaseQuota failed, proxyID = %d, err = %s", k, status.GetReason())
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+// RefreshReadOnlyMode notifies proxies to enter or leave a read-only mode, the proxies of the older versions not
+// serving the rpc are skipped like in RefreshRoleQuota, they load the read-only modes once upgraded.
+func (p *proxyClientManager) RefreshReadOnlyMode(ctx context.Context, req *proxypb.RefreshReadOnlyModeRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.proxyClient) == 0 {
+		log.Warn("proxy client is empty, RefreshReadOnlyMode will not send to any client")
+		return nil
+	}
+
+	group := &errgroup.Group{}
+	for k, v := range p.proxyClient {
+		k, v := k, v
+		group.Go(func() error {
+			status, err := v.RefreshReadOnlyMode(ctx, req)
+			if funcutil.IsGrpcUnimplemented(err) {
+				log.Warn("skip refreshing the read-only mode in the proxy not serving RefreshReadOnlyMode",
+					zap.Int64("proxyID", k), zap.Error(err))
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("RefreshReadOnlyMode failed, proxyID = %d, err = %s", k, err)
+			}
+			if status.GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("RefreshReadOnlyMode failed, proxyID = %d, err = %s", k, status.GetReason())
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
 // GetProxyMetrics sends requests to proxies to get metrics.
 func (p *proxyClientManager) GetProxyMetrics(ctx context.Context) ([]*milvuspb.GetMetricsResponse, error) {
 	p.lock.Lock()
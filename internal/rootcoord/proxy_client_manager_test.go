@@ -26,6 +26,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
@@ -306,3 +308,109 @@ func TestProxyClientManager_RefreshPolicyInfoCache(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestProxyClientManager_RefreshRoleQuota(t *testing.T) {
+	newManager := func(f func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error)) *proxyClientManager {
+		p1 := newMockProxy()
+		p1.RefreshRoleQuotaFunc = f
+		return &proxyClientManager{proxyClient: map[int64]types.Proxy{
+			TestProxyID: p1,
+		}}
+	}
+	ctx := context.Background()
+
+	pcm := &proxyClientManager{proxyClient: map[int64]types.Proxy{}}
+	assert.NoError(t, pcm.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock RefreshRoleQuota")
+	})
+	assert.Error(t, pcm.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "error mock error code"), nil
+	})
+	assert.Error(t, pcm.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{}))
+
+	// the proxies of the older versions are skipped
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+		err := status.Error(codes.Unimplemented, "unknown method RefreshRoleQuota")
+		return nil, fmt.Errorf("wrap grpc error: %w", err)
+	})
+	assert.NoError(t, pcm.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	})
+	assert.NoError(t, pcm.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{}))
+}
+
+func TestProxyClientManager_RefreshDatabaseQuota(t *testing.T) {
+	newManager := func(f func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error)) *proxyClientManager {
+		p1 := newMockProxy()
+		p1.RefreshDatabaseQuotaFunc = f
+		return &proxyClientManager{proxyClient: map[int64]types.Proxy{
+			TestProxyID: p1,
+		}}
+	}
+	ctx := context.Background()
+
+	pcm := &proxyClientManager{proxyClient: map[int64]types.Proxy{}}
+	assert.NoError(t, pcm.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock RefreshDatabaseQuota")
+	})
+	assert.Error(t, pcm.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "error mock error code"), nil
+	})
+	assert.Error(t, pcm.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{}))
+
+	// the proxies of the older versions are skipped
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+		return nil, status.Error(codes.Unimplemented, "unknown method RefreshDatabaseQuota")
+	})
+	assert.NoError(t, pcm.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	})
+	assert.NoError(t, pcm.RefreshDatabaseQuota(ctx, &proxypb.RefreshDatabaseQuotaRequest{}))
+}
+
+func TestProxyClientManager_RefreshReadOnlyMode(t *testing.T) {
+	newManager := func(f func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error)) *proxyClientManager {
+		p1 := newMockProxy()
+		p1.RefreshReadOnlyModeFunc = f
+		return &proxyClientManager{proxyClient: map[int64]types.Proxy{
+			TestProxyID: p1,
+		}}
+	}
+	ctx := context.Background()
+
+	pcm := &proxyClientManager{proxyClient: map[int64]types.Proxy{}}
+	assert.NoError(t, pcm.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock RefreshReadOnlyMode")
+	})
+	assert.Error(t, pcm.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "error mock error code"), nil
+	})
+	assert.Error(t, pcm.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{}))
+
+	// the proxies of the older versions are skipped
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+		return nil, status.Error(codes.Unimplemented, "unknown method RefreshReadOnlyMode")
+	})
+	assert.NoError(t, pcm.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{}))
+
+	pcm = newManager(func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	})
+	assert.NoError(t, pcm.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{}))
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// readOnlyKey returns the key of the read-only mode of the database, or of the cluster if the db name is empty.
+func readOnlyKey(dbName string) string {
+	if dbName == "" {
+		return rootcoord.ReadOnlyPrefix + "/cluster"
+	}
+	return funcutil.HandleTenantForEtcdKey(rootcoord.ReadOnlyPrefix+"/database", util.DefaultTenant, dbName)
+}
+
+func (c *Core) initReadOnlyKV() error {
+	metaKV, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.readOnlyKV = metaKV
+	return nil
+}
+
+// listReadOnlyModes returns the read-only modes entered, the one of the cluster goes first and then the ones of
+// databases sorted by db name.
+func (c *Core) listReadOnlyModes() ([]*model.ReadOnlyMode, error) {
+	_, values, err := c.readOnlyKV.LoadWithPrefix(rootcoord.ReadOnlyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	modes := make([]*model.ReadOnlyMode, 0, len(values))
+	for _, value := range values {
+		mode, err := model.UnmarshalReadOnlyMode(value)
+		if err != nil {
+			log.Warn("skip invalid read-only mode", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		modes = append(modes, mode)
+	}
+	sort.Slice(modes, func(i, j int) bool { return modes[i].DbName < modes[j].DbName })
+	return modes, nil
+}
+
+// enterReadOnly persists the read-only mode of the cluster or of the database and refreshes it in proxies,
+// the requests without a db name belong to the database util.DefaultDBName.
+func (c *Core) enterReadOnly(ctx context.Context, mode *model.ReadOnlyMode) error {
+	mode.ReadOnly = true
+	if mode.Since == 0 {
+		mode.Since = time.Now().Unix()
+	}
+	value, err := model.MarshalReadOnlyMode(mode)
+	if err != nil {
+		return err
+	}
+	if err := c.readOnlyKV.Save(readOnlyKey(mode.DbName), value); err != nil {
+		return err
+	}
+	log.Info("enter read-only mode", zap.String("db_name", mode.DbName), zap.String("reason", mode.Reason))
+	return c.refreshReadOnly(ctx, mode)
+}
+
+// leaveReadOnly removes the read-only mode of the cluster or of the database and refreshes it in proxies.
+func (c *Core) leaveReadOnly(ctx context.Context, dbName string) error {
+	if err := c.readOnlyKV.Remove(readOnlyKey(dbName)); err != nil {
+		return err
+	}
+	log.Info("leave read-only mode", zap.String("db_name", dbName))
+	return c.refreshReadOnly(ctx, &model.ReadOnlyMode{DbName: dbName})
+}
+
+func (c *Core) refreshReadOnly(ctx context.Context, mode *model.ReadOnlyMode) error {
+	return c.proxyClientManager.RefreshReadOnlyMode(ctx, &proxypb.RefreshReadOnlyModeRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithSourceID(c.session.ServerID)),
+		Mode: model.MarshalReadOnlyModeModel(mode),
+	})
+}
+
+// ListReadOnlyModes lists the read-only modes entered, the one of the cluster goes first.
+func (c *Core) ListReadOnlyModes(ctx context.Context, in *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.ListReadOnlyModesResponse{
+			Status: errorutil.UnhealthyStatus(code),
+		}, nil
+	}
+	modes, err := c.listReadOnlyModes()
+	if err != nil {
+		log.Warn("fail to list read-only modes", zap.Error(err))
+		return &proxypb.ListReadOnlyModesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	ret := make([]*proxypb.ReadOnlyMode, 0, len(modes))
+	for _, mode := range modes {
+		ret = append(ret, model.MarshalReadOnlyModeModel(mode))
+	}
+	return &proxypb.ListReadOnlyModesResponse{
+		Status: succStatus(),
+		Modes:  ret,
+	}, nil
+}
+
+// EnterReadOnly enters the read-only mode of the cluster or of a database and refreshes it in proxies.
+func (c *Core) EnterReadOnly(ctx context.Context, in *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	mode := &model.ReadOnlyMode{DbName: in.GetDbName(), Reason: in.GetReason()}
+	if err := c.enterReadOnly(ctx, mode); err != nil {
+		log.Warn("fail to enter read-only mode", zap.String("db_name", in.GetDbName()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
+// LeaveReadOnly leaves the read-only mode of the cluster or of a database and refreshes it in proxies.
+func (c *Core) LeaveReadOnly(ctx context.Context, in *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	if err := c.leaveReadOnly(ctx, in.GetDbName()); err != nil {
+		log.Warn("fail to leave read-only mode", zap.String("db_name", in.GetDbName()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func TestCore_ReadOnly(t *testing.T) {
+	saved := make(map[string]string)
+	metaKV := kvmocks.NewMetaKv(t)
+	metaKV.On("Save", mock.Anything, mock.Anything).Return(func(key, value string) error {
+		saved[key] = value
+		return nil
+	})
+	metaKV.On("Remove", mock.Anything).Return(func(key string) error {
+		delete(saved, key)
+		return nil
+	})
+	metaKV.On("LoadWithPrefix", rootcoord.ReadOnlyPrefix).Return(
+		func(key string) []string { return nil },
+		func(key string) []string {
+			values := make([]string, 0, len(saved))
+			for _, value := range saved {
+				values = append(values, value)
+			}
+			return values
+		},
+		func(key string) error { return nil })
+
+	c := newTestCore(withHealthyCode(), withValidProxyManager())
+	c.readOnlyKV = metaKV
+	var refreshed []*proxypb.RefreshReadOnlyModeRequest
+	c.proxyClientManager.proxyClient[TestProxyID].(*mockProxy).RefreshReadOnlyModeFunc = func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+		refreshed = append(refreshed, request)
+		return succStatus(), nil
+	}
+
+	ctx := context.Background()
+	status, err := c.EnterReadOnly(ctx, &proxypb.EnterReadOnlyRequest{DbName: "tenant1", Reason: "migration"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 1, len(refreshed))
+	mode := refreshed[0].GetMode()
+	assert.Equal(t, "tenant1", mode.GetDbName())
+	assert.True(t, mode.GetReadOnly())
+	assert.NotZero(t, mode.GetSince())
+
+	// the cluster
+	status, err = c.EnterReadOnly(ctx, &proxypb.EnterReadOnlyRequest{Reason: "incident"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 2, len(saved))
+
+	resp, err := c.ListReadOnlyModes(ctx, &proxypb.ListReadOnlyModesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 2, len(resp.GetModes()))
+	assert.Equal(t, "", resp.GetModes()[0].GetDbName())
+	assert.Equal(t, "incident", resp.GetModes()[0].GetReason())
+	assert.Equal(t, "tenant1", resp.GetModes()[1].GetDbName())
+
+	status, err = c.LeaveReadOnly(ctx, &proxypb.LeaveReadOnlyRequest{DbName: "tenant1"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = c.LeaveReadOnly(ctx, &proxypb.LeaveReadOnlyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Empty(t, saved)
+	assert.Equal(t, 4, len(refreshed))
+	assert.Equal(t, "", refreshed[3].GetMode().GetDbName())
+	assert.False(t, refreshed[3].GetMode().GetReadOnly())
+
+	c.proxyClientManager.proxyClient[TestProxyID].(*mockProxy).RefreshReadOnlyModeFunc = func(ctx context.Context, request *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "error mock RefreshReadOnlyMode"), nil
+	}
+	status, err = c.EnterReadOnly(ctx, &proxypb.EnterReadOnlyRequest{Reason: "upgrade"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	c.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = c.ListReadOnlyModes(ctx, &proxypb.ListReadOnlyModesRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	status, err = c.EnterReadOnly(ctx, &proxypb.EnterReadOnlyRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = c.LeaveReadOnly(ctx, &proxypb.LeaveReadOnlyRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
@@ -40,8 +40,10 @@ func (b *baseRedoTask) redoAsyncSteps() {
 }
 
 func (b *baseRedoTask) Execute(ctx context.Context) error {
-	for i := 0; i < len(b.syncTodoStep); i++ {
-		todo := b.syncTodoStep[i]
+	syncTodoSteps := trackDdlSteps(ctx, b.syncTodoStep, ddlStepSync)
+	b.asyncTodoStep = trackDdlSteps(ctx, b.asyncTodoStep, ddlStepAsync)
+	for i := 0; i < len(syncTodoSteps); i++ {
+		todo := syncTodoSteps[i]
 		// no children step in sync steps.
 		if _, err := todo.Execute(ctx); err != nil {
 			log.Error("failed to execute step", zap.Error(err), zap.String("desc", todo.Desc()))
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func roleQuotaKey(roleName string) string {
+	return funcutil.HandleTenantForEtcdKey(rootcoord.RoleQuotaPrefix, util.DefaultTenant, roleName)
+}
+
+func (c *Core) initRoleQuotaKV() error {
+	metaKV, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.roleQuotaKV = metaKV
+	return nil
+}
+
+// listRoleQuotas returns the rate limiting quotas of roles sorted by role name.
+func (c *Core) listRoleQuotas() ([]*model.RoleQuota, error) {
+	_, values, err := c.roleQuotaKV.LoadWithPrefix(roleQuotaKey(""))
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]*model.RoleQuota, 0, len(values))
+	for _, value := range values {
+		quota, err := model.UnmarshalRoleQuota(value)
+		if err != nil {
+			log.Warn("skip invalid role quota", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		quotas = append(quotas, quota)
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].RoleName < quotas[j].RoleName })
+	return quotas, nil
+}
+
+// saveRoleQuota persists the quota of an existing role and refreshes the quota in proxies.
+func (c *Core) saveRoleQuota(ctx context.Context, quota *model.RoleQuota) error {
+	rates, err := quota.GetRateTypes()
+	if err != nil {
+		return err
+	}
+	// every user is granted the public role, a zero rate on it would reject the requests of all the users
+	if quota.RoleName == util.RolePublic {
+		for rt, rate := range rates {
+			if rate == 0 {
+				return fmt.Errorf("zero rate of %s is not allowed in the quota of role %s", rt.String(), util.RolePublic)
+			}
+		}
+	}
+	if _, err := c.meta.SelectRole(util.DefaultTenant, &milvuspb.RoleEntity{Name: quota.RoleName}, false); err != nil {
+		return fmt.Errorf("not found the role: %s", quota.RoleName)
+	}
+	value, err := model.MarshalRoleQuota(quota)
+	if err != nil {
+		return err
+	}
+	if err := c.roleQuotaKV.Save(roleQuotaKey(quota.RoleName), value); err != nil {
+		return err
+	}
+	log.Info("save role quota", zap.String("role_name", quota.RoleName), zap.Any("rates", quota.Rates))
+	return c.refreshRoleQuota(ctx, quota)
+}
+
+// dropRoleQuota removes the quota of the role and refreshes the quota in proxies.
+func (c *Core) dropRoleQuota(ctx context.Context, roleName string) error {
+	if err := c.roleQuotaKV.Remove(roleQuotaKey(roleName)); err != nil {
+		return err
+	}
+	log.Info("drop role quota", zap.String("role_name", roleName))
+	// a quota without rates removes the limits of the role in proxies
+	return c.refreshRoleQuota(ctx, &model.RoleQuota{RoleName: roleName})
+}
+
+func (c *Core) refreshRoleQuota(ctx context.Context, quota *model.RoleQuota) error {
+	return c.proxyClientManager.RefreshRoleQuota(ctx, &proxypb.RefreshRoleQuotaRequest{
+		Base:  commonpbutil.NewMsgBase(commonpbutil.WithSourceID(c.session.ServerID)),
+		Quota: model.MarshalRoleQuotaModel(quota),
+	})
+}
+
+// ListRoleQuotas lists the rate limiting quotas of roles sorted by role name.
+func (c *Core) ListRoleQuotas(ctx context.Context, in *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &proxypb.ListRoleQuotasResponse{
+			Status: errorutil.UnhealthyStatus(code),
+		}, nil
+	}
+	quotas, err := c.listRoleQuotas()
+	if err != nil {
+		log.Warn("fail to list role quotas", zap.Error(err))
+		return &proxypb.ListRoleQuotasResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	ret := make([]*proxypb.RoleQuota, 0, len(quotas))
+	for _, quota := range quotas {
+		ret = append(ret, model.MarshalRoleQuotaModel(quota))
+	}
+	return &proxypb.ListRoleQuotasResponse{
+		Status: succStatus(),
+		Quotas: ret,
+	}, nil
+}
+
+// SaveRoleQuota saves the rate limiting quota of an existing role and refreshes it in proxies.
+func (c *Core) SaveRoleQuota(ctx context.Context, in *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	quota := model.UnmarshalRoleQuotaModel(in.GetQuota())
+	if quota == nil || quota.RoleName == "" {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "role name is required in the role quota"), nil
+	}
+	if err := c.saveRoleQuota(ctx, quota); err != nil {
+		log.Warn("fail to save role quota", zap.String("role_name", quota.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
+// DropRoleQuota drops the rate limiting quota of a role and removes its limits in proxies.
+func (c *Core) DropRoleQuota(ctx context.Context, in *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+	if in.GetRoleName() == "" {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "role name is required"), nil
+	}
+	if err := c.dropRoleQuota(ctx, in.GetRoleName()); err != nil {
+		log.Warn("fail to drop role quota", zap.String("role_name", in.GetRoleName()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
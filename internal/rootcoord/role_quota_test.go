@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+)
+
+func TestCore_RoleQuota(t *testing.T) {
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.On("SelectRole", mock.Anything, mock.Anything, false).Return(
+		func(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) []*milvuspb.RoleResult {
+			if entity.GetName() == "readonly" {
+				return []*milvuspb.RoleResult{{Role: entity}}
+			}
+			return nil
+		},
+		func(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) error {
+			if entity.GetName() == "readonly" {
+				return nil
+			}
+			return errors.New("not found")
+		})
+
+	saved := make(map[string]string)
+	metaKV := kvmocks.NewMetaKv(t)
+	metaKV.On("Save", mock.Anything, mock.Anything).Return(func(key, value string) error {
+		saved[key] = value
+		return nil
+	})
+	metaKV.On("Remove", mock.Anything).Return(func(key string) error {
+		delete(saved, key)
+		return nil
+	})
+	metaKV.On("LoadWithPrefix", roleQuotaKey("")).Return(
+		func(key string) []string { return nil },
+		func(key string) []string {
+			values := make([]string, 0, len(saved))
+			for _, value := range saved {
+				values = append(values, value)
+			}
+			return values
+		},
+		func(key string) error { return nil })
+
+	c := newTestCore(withHealthyCode(), withMeta(meta), withValidProxyManager())
+	c.roleQuotaKV = metaKV
+	var refreshed []*proxypb.RefreshRoleQuotaRequest
+	c.proxyClientManager.proxyClient[TestProxyID].(*mockProxy).RefreshRoleQuotaFunc = func(ctx context.Context, request *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error) {
+		refreshed = append(refreshed, request)
+		return succStatus(), nil
+	}
+
+	ctx := context.Background()
+	save := func(roleName string, rates map[string]float64) *commonpb.Status {
+		status, err := c.SaveRoleQuota(ctx, &proxypb.SaveRoleQuotaRequest{
+			Quota: &proxypb.RoleQuota{RoleName: roleName, Rates: rates},
+		})
+		assert.NoError(t, err)
+		return status
+	}
+
+	status := save("readonly", map[string]float64{"DQLSearch": 10, "DMLInsert": 0})
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 1, len(refreshed))
+	assert.Equal(t, "readonly", refreshed[0].GetQuota().GetRoleName())
+	assert.Equal(t, 2, len(refreshed[0].GetQuota().GetRates()))
+
+	assert.NotEqual(t, commonpb.ErrorCode_Success, save("unknown", map[string]float64{"DQLSearch": 10}).GetErrorCode())
+	assert.NotEqual(t, commonpb.ErrorCode_Success, save("readonly", map[string]float64{"Unknown": 10}).GetErrorCode())
+	assert.NotEqual(t, commonpb.ErrorCode_Success, save("", map[string]float64{"DQLSearch": 10}).GetErrorCode())
+	assert.NotEqual(t, commonpb.ErrorCode_Success, save("public", map[string]float64{"DQLSearch": 0}).GetErrorCode())
+	assert.Equal(t, 1, len(refreshed))
+
+	resp, err := c.ListRoleQuotas(ctx, &proxypb.ListRoleQuotasRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(resp.GetQuotas()))
+	assert.Equal(t, float64(10), resp.GetQuotas()[0].GetRates()["DQLSearch"])
+
+	status, err = c.DropRoleQuota(ctx, &proxypb.DropRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	status, err = c.DropRoleQuota(ctx, &proxypb.DropRoleQuotaRequest{RoleName: "readonly"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Empty(t, saved)
+	assert.Equal(t, 2, len(refreshed))
+	assert.Empty(t, refreshed[1].GetQuota().GetRates())
+
+	c.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = c.ListRoleQuotas(ctx, &proxypb.ListRoleQuotasRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	status, err = c.SaveRoleQuota(ctx, &proxypb.SaveRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	status, err = c.DropRoleQuota(ctx, &proxypb.DropRoleQuotaRequest{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
@@ -105,7 +105,12 @@ type Core struct {
 	garbageCollector GarbageCollector
 	stepExecutor     StepExecutor
 
-	metaKVCreator metaKVCreator
+	metaKVCreator   metaKVCreator
+	roleQuotaKV     kv.MetaKv
+	databaseQuotaKV kv.MetaKv
+	readOnlyKV      kv.MetaKv
+	rolloverKV      kv.MetaKv
+	ddlJournal      *ddlJournal
 
 	proxyCreator       proxyCreator
 	proxyManager       *proxyManager
@@ -432,6 +437,26 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
+	if err := c.initRoleQuotaKV(); err != nil {
+		return err
+	}
+
+	if err := c.initDatabaseQuotaKV(); err != nil {
+		return err
+	}
+
+	if err := c.initReadOnlyKV(); err != nil {
+		return err
+	}
+
+	if err := c.initRolloverKV(); err != nil {
+		return err
+	}
+
+	if err := c.initDdlJournal(); err != nil {
+		return err
+	}
+
 	if err := c.initIDAllocator(); err != nil {
 		return err
 	}
@@ -440,7 +465,9 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
-	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
+	scheduler := newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
+	scheduler.ddlJournal = c.ddlJournal
+	c.scheduler = scheduler
 
 	c.factory.Init(Params)
 
@@ -638,13 +665,15 @@ func (c *Core) startInternal() error {
 }
 
 func (c *Core) startServerLoop() {
-	c.wg.Add(6)
+	c.wg.Add(8)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.ddlJournal.cleanupLoop(c.ctx, &c.wg)
+	go c.partitionRolloverLoop(c.ctx, &c.wg)
 }
 
 // Start starts RootCoord.
@@ -1437,14 +1466,7 @@ func (c *Core) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfi
 		}, nil
 	}
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations(ctx, "rootcoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
+	configList := Params.ShowComponentConfigurations(ctx, "rootcoord", req.Pattern)
 
 	return &internalpb.ShowConfigurationsResponse{
 		Status: &commonpb.Status{
@@ -1708,6 +1730,11 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 		zap.Int64("partition ID", pID),
 		zap.Int("# of files = ", len(req.GetFiles())),
 	)
+	if status := c.checkImportPreflight(ctx, req, cID); status != nil {
+		return &milvuspb.ImportResponse{
+			Status: status,
+		}, nil
+	}
 	importJobResp := c.importManager.importJob(ctx, req, cID, pID)
 	return importJobResp, nil
 }
@@ -2050,6 +2077,7 @@ func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (
 // - get all role mapping of this role
 // - drop these role mappings
 // - drop the role by the meta api
+// - drop the rate limiting quota of the role
 func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
 	method := "DropRole"
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
@@ -2104,6 +2132,10 @@ func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*com
 		log.Error(errMsg, zap.Any("in", in), zap.Error(err))
 		return failStatus(commonpb.ErrorCode_DropRoleFailure, errMsg), nil
 	}
+	if err = c.dropRoleQuota(ctx, in.RoleName); err != nil {
+		// the quota of a dropped role limits nobody, failing to drop it doesn't fail the DropRole
+		log.Warn("fail to drop the role quota", zap.String("role_name", in.RoleName), zap.Error(err))
+	}
 
 	logger.Debug(method+" success", zap.String("role_name", in.RoleName))
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
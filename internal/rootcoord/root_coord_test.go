@@ -895,6 +895,49 @@ func TestCore_Import(t *testing.T) {
 		})
 		assert.NoError(t, err)
 	})
+
+	t.Run("pre-flight check failed", func(t *testing.T) {
+		ctx := context.Background()
+		broker := newMockBroker()
+		broker.PreImportFunc = func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+			assert.Equal(t, []string{"f1.json"}, req.GetFiles())
+			return &datapb.PreImportResponse{
+				Status: succStatus(),
+				Errors: []string{"file 'f1.json': mock error"},
+			}, nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta), withBroker(broker))
+		resp, err := c.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: "a-good-name",
+			Files:          []string{"f1.json"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+		assert.Contains(t, resp.GetStatus().GetReason(), "mock error")
+	})
+
+	t.Run("pre-flight check not available", func(t *testing.T) {
+		ctx := context.Background()
+		broker := newMockBroker()
+		broker.PreImportFunc = func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+			return nil, errors.New("mock error")
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta), withBroker(broker))
+		status := c.checkImportPreflight(ctx, &milvuspb.ImportRequest{Files: []string{"f1.json"}}, 100)
+		assert.Nil(t, status)
+
+		broker.PreImportFunc = func(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error) {
+			return &datapb.PreImportResponse{Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock")}, nil
+		}
+		c = newTestCore(withHealthyCode(), withMeta(meta), withBroker(broker))
+		assert.Nil(t, c.checkImportPreflight(ctx, &milvuspb.ImportRequest{Files: []string{"f1.json"}}, 100))
+
+		paramtable.Get().Save(Params.RootCoordCfg.ImportPreflightCheckEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.RootCoordCfg.ImportPreflightCheckEnabled.Key)
+		broker.PreImportFunc = nil
+		c = newTestCore(withHealthyCode(), withMeta(meta), withBroker(broker))
+		assert.Nil(t, c.checkImportPreflight(ctx, &milvuspb.ImportRequest{Files: []string{"f1.json"}}, 100))
+	})
 }
 
 func TestCore_GetImportState(t *testing.T) {
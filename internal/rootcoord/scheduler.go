@@ -29,6 +29,7 @@ type scheduler struct {
 
 	idAllocator  allocator.Interface
 	tsoAllocator tso.Allocator
+	ddlJournal   *ddlJournal
 
 	taskChan chan task
 
@@ -63,11 +64,14 @@ func (s *scheduler) Stop() {
 
 func (s *scheduler) execute(task task) {
 	defer s.setMinDdlTs(task.GetTs()) // we should update ts, whatever task succeeds or not.
+	task.SetCtx(s.ddlJournal.begin(task.GetCtx(), task))
 	if err := task.Prepare(task.GetCtx()); err != nil {
+		s.ddlJournal.finish(task.GetCtx(), err)
 		task.NotifyDone(err)
 		return
 	}
 	err := task.Execute(task.GetCtx())
+	s.ddlJournal.finish(task.GetCtx(), err)
 	task.NotifyDone(err)
 }
 
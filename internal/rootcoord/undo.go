@@ -31,12 +31,13 @@ func (b *baseUndoTask) Execute(ctx context.Context) error {
 	if len(b.todoStep) != len(b.undoStep) {
 		return fmt.Errorf("todo step and undo step length not equal")
 	}
-	for i := 0; i < len(b.todoStep); i++ {
-		todoStep := b.todoStep[i]
+	todoSteps := trackDdlSteps(ctx, b.todoStep, ddlStepSync)
+	for i := 0; i < len(todoSteps); i++ {
+		todoStep := todoSteps[i]
 		// no children step in normal case.
 		if _, err := todoStep.Execute(ctx); err != nil {
 			log.Warn("failed to execute step, trying to undo", zap.Error(err), zap.String("desc", todoStep.Desc()))
-			undoSteps := b.undoStep[:i]
+			undoSteps := trackDdlSteps(ctx, b.undoStep[:i], ddlStepUndo)
 			b.undoStep = nil // let baseUndoTask can be collected.
 			go b.stepExecutor.AddSteps(&stepStack{undoSteps})
 			return err
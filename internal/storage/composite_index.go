@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CompositeIndexKeysKey is the blob key of the distinct key tuples of a composite scalar index.
+const CompositeIndexKeysKey = "composite_index_keys"
+
+// CompositeKeyColumn holds the values of one covered field of the composite index keys,
+// only the slice matching the field type is set.
+type CompositeKeyColumn struct {
+	Bools   []bool    `json:"bools,omitempty"`
+	Ints    []int64   `json:"ints,omitempty"`
+	Floats  []float64 `json:"floats,omitempty"`
+	Strings []string  `json:"strings,omitempty"`
+}
+
+// CompositeIndexKeys are the distinct key tuples of a segment sorted by the covered fields in order,
+// stored column by column. The i-th tuple is made of the i-th value of every column.
+type CompositeIndexKeys struct {
+	FieldIDs []int64               `json:"field_ids"`
+	Columns  []*CompositeKeyColumn `json:"columns"`
+	NumKeys  int                   `json:"num_keys"`
+}
+
+// AppendCompositeKeyValue appends the value of the row in data to the column.
+func AppendCompositeKeyValue(column *CompositeKeyColumn, data FieldData, row int) error {
+	switch fd := data.(type) {
+	case *BoolFieldData:
+		column.Bools = append(column.Bools, fd.Data[row])
+	case *Int8FieldData:
+		column.Ints = append(column.Ints, int64(fd.Data[row]))
+	case *Int16FieldData:
+		column.Ints = append(column.Ints, int64(fd.Data[row]))
+	case *Int32FieldData:
+		column.Ints = append(column.Ints, int64(fd.Data[row]))
+	case *Int64FieldData:
+		column.Ints = append(column.Ints, fd.Data[row])
+	case *FloatFieldData:
+		column.Floats = append(column.Floats, float64(fd.Data[row]))
+	case *DoubleFieldData:
+		column.Floats = append(column.Floats, fd.Data[row])
+	case *StringFieldData:
+		column.Strings = append(column.Strings, fd.Data[row])
+	default:
+		return fmt.Errorf("unsupported field data type %T for composite index", data)
+	}
+	return nil
+}
+
+// SerializeCompositeIndexKeys encodes the composite index keys into a blob.
+func SerializeCompositeIndexKeys(keys *CompositeIndexKeys) (*Blob, error) {
+	bs, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{Key: CompositeIndexKeysKey, Value: bs}, nil
+}
+
+// DeserializeCompositeIndexKeys decodes the composite index keys and checks every column has all the keys.
+func DeserializeCompositeIndexKeys(data []byte) (*CompositeIndexKeys, error) {
+	keys := &CompositeIndexKeys{}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return nil, err
+	}
+	if len(keys.Columns) != len(keys.FieldIDs) {
+		return nil, fmt.Errorf("composite index keys have %d columns for %d fields", len(keys.Columns), len(keys.FieldIDs))
+	}
+	for i, column := range keys.Columns {
+		n := len(column.Bools) + len(column.Ints) + len(column.Floats) + len(column.Strings)
+		if n != keys.NumKeys {
+			return nil, fmt.Errorf("composite index keys of field %d have %d values, expected %d", keys.FieldIDs[i], n, keys.NumKeys)
+		}
+	}
+	return keys, nil
+}
@@ -256,6 +256,9 @@ type InsertData struct {
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
 type InsertCodec struct {
 	Schema *etcdpb.CollectionMeta
+	// SortByPK sorts the rows by (primary key, timestamp) instead of row id on serialization,
+	// and writes a sparse primary key index into the binlog of the primary key field
+	SortByPK bool
 }
 
 // NewInsertCodec creates an InsertCodec with provided collection meta
@@ -263,7 +266,7 @@ func NewInsertCodec(schema *etcdpb.CollectionMeta) *InsertCodec {
 	return &InsertCodec{Schema: schema}
 }
 
-// Serialize transfer insert data to blob. It will sort insert data by timestamp.
+// Serialize transfer insert data to blob. It will sort insert data by row id, or by (primary key, timestamp) if SortByPK is set.
 // From schema, it gets all fields.
 // For each field, it will create a binlog writer, and write an event to the binlog.
 // It returns binlog buffer in the end.
@@ -284,10 +287,11 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 	startTs := ts[0]
 	endTs := ts[len(ts)-1]
 
-	// sort insert data by rowID
+	// sort insert data by rowID, or by (pk, ts)
 	dataSorter := &DataSorter{
 		InsertCodec: insertCodec,
 		InsertData:  data,
+		SortByPK:    insertCodec.SortByPK,
 	}
 	sort.Sort(dataSorter)
 
@@ -405,6 +409,18 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		if err != nil {
 			return nil, nil, err
 		}
+		if insertCodec.SortByPK {
+			writer.AddExtra(sortedByPKKey, "true")
+			if field.GetIsPrimaryKey() {
+				pkIndex, err := genPKIndex(singleData, PKIndexStride)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+				writer.AddExtra(pkIndexKey, pkIndex)
+			}
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Finish()
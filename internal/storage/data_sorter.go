@@ -21,10 +21,14 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 )
 
-// DataSorter sorts insert data
+// DataSorter sorts insert data by row id, or by (primary key, timestamp) if SortByPK is set
 type DataSorter struct {
 	InsertCodec *InsertCodec
 	InsertData  *InsertData
+	SortByPK    bool
+
+	pkField FieldData
+	tsField *Int64FieldData
 }
 
 // getRowIDFieldData returns auto generated row id Field
@@ -103,6 +107,9 @@ func (ds *DataSorter) Swap(i, j int) {
 
 // Less returns whether i-th entry is less than j-th entry, using ID field comparison result
 func (ds *DataSorter) Less(i, j int) bool {
+	if ds.SortByPK {
+		return ds.lessByPK(i, j)
+	}
 	idField := ds.getRowIDFieldData()
 	if idField == nil {
 		return true // to skip swap
@@ -119,3 +126,38 @@ func (ds *DataSorter) Less(i, j int) bool {
 	ids := data.Data
 	return ids[i] < ids[j]
 }
+
+// getPKAndTsFieldData returns the primary key field and the timestamp field
+func (ds *DataSorter) getPKAndTsFieldData() (FieldData, *Int64FieldData) {
+	if ds.pkField == nil {
+		for _, field := range ds.InsertCodec.Schema.Schema.Fields {
+			if field.GetIsPrimaryKey() {
+				ds.pkField = ds.InsertData.Data[field.FieldID]
+				break
+			}
+		}
+		ds.tsField, _ = ds.InsertData.Data[common.TimeStampField].(*Int64FieldData)
+	}
+	return ds.pkField, ds.tsField
+}
+
+// lessByPK compares the primary keys of i-th and j-th entry, the timestamps for the same primary key
+func (ds *DataSorter) lessByPK(i, j int) bool {
+	pkField, tsField := ds.getPKAndTsFieldData()
+	if tsField == nil {
+		return true // to skip swap
+	}
+	switch pks := pkField.(type) {
+	case *Int64FieldData:
+		if pks.Data[i] != pks.Data[j] {
+			return pks.Data[i] < pks.Data[j]
+		}
+	case *StringFieldData:
+		if pks.Data[i] != pks.Data[j] {
+			return pks.Data[i] < pks.Data[j]
+		}
+	default:
+		return true // to skip swap
+	}
+	return tsField.Data[i] < tsField.Data[j]
+}
@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+)
+
+const (
+	// IndexManifestKey is the file key of the index manifest, saved after all the other index files of a build
+	IndexManifestKey = "indexManifest"
+	// IndexManifestVersion is the artifact layout version of the index files published with a manifest
+	IndexManifestVersion = 2
+)
+
+// ErrIndexManifestNotFound is returned if the index files of a build list a manifest which is not saved,
+// the build is incomplete and its index files must not be used.
+var ErrIndexManifestNotFound = errors.New("index manifest not found, the index build is incomplete")
+
+// IndexManifestFile is an index file listed in the index manifest.
+type IndexManifestFile struct {
+	Key  string `json:"key"`
+	Size int64  `json:"size"`
+}
+
+// IndexManifest lists all the index files of a build, it is saved last so that the build is published atomically.
+type IndexManifest struct {
+	Version      int                 `json:"version"`
+	BuildID      int64               `json:"build_id"`
+	IndexVersion int64               `json:"index_version"`
+	Files        []IndexManifestFile `json:"files"`
+	// Build records how the index is built, only with indexNode.reproducibility.enabled
+	Build *IndexBuildRecord `json:"build,omitempty"`
+}
+
+// IndexBuildEnvironment is the software and hardware an index is built with.
+type IndexBuildEnvironment struct {
+	KnowhereVersion string   `json:"knowhere_version"`
+	MilvusVersion   string   `json:"milvus_version"`
+	GitCommit       string   `json:"git_commit"`
+	GoVersion       string   `json:"go_version"`
+	OS              string   `json:"os"`
+	Arch            string   `json:"arch"`
+	NumCPU          int      `json:"num_cpu"`
+	CPUFeatures     []string `json:"cpu_features"`
+	SimdType        string   `json:"simd_type"`
+}
+
+// IndexBuildRecord is everything needed to rebuild an index and check the rebuilt one is identical,
+// Checksums are the sha256 of the index blobs before they are encoded into the index files.
+type IndexBuildRecord struct {
+	CollectionID int64                 `json:"collection_id"`
+	PartitionID  int64                 `json:"partition_id"`
+	SegmentID    int64                 `json:"segment_id"`
+	FieldID      int64                 `json:"field_id"`
+	IndexID      int64                 `json:"index_id"`
+	IndexName    string                `json:"index_name"`
+	NumRows      int64                 `json:"num_rows"`
+	DataPaths    []string              `json:"data_paths"`
+	TypeParams   map[string]string     `json:"type_params"`
+	IndexParams  map[string]string     `json:"index_params"`
+	Seed         int64                 `json:"seed"`
+	Environment  IndexBuildEnvironment `json:"environment"`
+	Checksums    map[string]string     `json:"checksums"`
+}
+
+// NewIndexManifest returns an empty index manifest of the build.
+func NewIndexManifest(buildID, indexVersion int64) *IndexManifest {
+	return &IndexManifest{
+		Version:      IndexManifestVersion,
+		BuildID:      buildID,
+		IndexVersion: indexVersion,
+		Files:        make([]IndexManifestFile, 0),
+	}
+}
+
+// AddFile lists the index file of the file key in the manifest.
+func (m *IndexManifest) AddFile(key string, size int64) {
+	m.Files = append(m.Files, IndexManifestFile{Key: key, Size: size})
+}
+
+// Marshal serializes the index manifest.
+func (m *IndexManifest) Marshal() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// UnmarshalIndexManifest deserializes the index manifest.
+func UnmarshalIndexManifest(data []byte) (*IndexManifest, error) {
+	m := &IndexManifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	if m.Version != IndexManifestVersion {
+		return nil, fmt.Errorf("unsupported index manifest version %d", m.Version)
+	}
+	return m, nil
+}
+
+// IsIndexManifestPath returns whether the index file path is the path of an index manifest.
+func IsIndexManifestPath(filePath string) bool {
+	return path.Base(filePath) == IndexManifestKey
+}
+
+// ReadIndexManifest reads the index manifest, ErrIndexManifestNotFound is returned if it is not saved.
+func ReadIndexManifest(ctx context.Context, cm ChunkManager, manifestPath string) (*IndexManifest, error) {
+	exist, err := cm.Exist(ctx, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, fmt.Errorf("%w: %s", ErrIndexManifestNotFound, manifestPath)
+	}
+	data, err := cm.Read(ctx, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalIndexManifest(data)
+}
+
+// ResolveIndexFilePaths checks the index file paths of a build against its manifest and returns the paths
+// without the manifest. The paths of a build saved without a manifest are returned as is.
+// ErrIndexManifestNotFound is returned if the manifest is listed but not saved.
+func ResolveIndexFilePaths(ctx context.Context, cm ChunkManager, indexFilePaths []string) ([]string, error) {
+	manifestPath := ""
+	filePaths := make([]string, 0, len(indexFilePaths))
+	for _, filePath := range indexFilePaths {
+		if IsIndexManifestPath(filePath) {
+			manifestPath = filePath
+			continue
+		}
+		filePaths = append(filePaths, filePath)
+	}
+	if manifestPath == "" {
+		return indexFilePaths, nil
+	}
+
+	manifest, err := ReadIndexManifest(ctx, cm, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	fileKeys := make(map[string]struct{}, len(filePaths))
+	for _, filePath := range filePaths {
+		fileKeys[path.Base(filePath)] = struct{}{}
+	}
+	if len(fileKeys) != len(manifest.Files) {
+		return nil, fmt.Errorf("index manifest %s lists %d files, got %d", manifestPath, len(manifest.Files), len(fileKeys))
+	}
+	for _, file := range manifest.Files {
+		if _, ok := fileKeys[file.Key]; !ok {
+			return nil, fmt.Errorf("index file %s of manifest %s is missing", file.Key, manifestPath)
+		}
+	}
+	return filePaths, nil
+}
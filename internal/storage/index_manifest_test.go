@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexManifest(t *testing.T) {
+	manifest := NewIndexManifest(1, 2)
+	manifest.AddFile("HNSW_0", 100)
+	manifest.AddFile(IndexParamsKey, 10)
+	data, err := manifest.Marshal()
+	assert.NoError(t, err)
+
+	got, err := UnmarshalIndexManifest(data)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, got)
+
+	manifest.Build = &IndexBuildRecord{
+		IndexID:     3,
+		IndexParams: map[string]string{"index_type": "HNSW", "seed": "1"},
+		Seed:        1,
+		Environment: IndexBuildEnvironment{KnowhereVersion: "v1", CPUFeatures: []string{"avx2"}},
+		Checksums:   map[string]string{"HNSW": "sum"},
+	}
+	data, err = manifest.Marshal()
+	assert.NoError(t, err)
+	got, err = UnmarshalIndexManifest(data)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, got)
+
+	_, err = UnmarshalIndexManifest([]byte(`{"version":1}`))
+	assert.Error(t, err)
+	_, err = UnmarshalIndexManifest([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestResolveIndexFilePaths(t *testing.T) {
+	ctx := context.Background()
+	rootPath := path.Join(t.TempDir(), "index_files")
+	cm := NewLocalChunkManager(RootPath(rootPath))
+	defer cm.RemoveWithPrefix(ctx, rootPath)
+
+	filePaths := []string{path.Join(rootPath, "HNSW_0"), path.Join(rootPath, "HNSW_1")}
+	manifestPath := path.Join(rootPath, IndexManifestKey)
+
+	t.Run("without manifest", func(t *testing.T) {
+		paths, err := ResolveIndexFilePaths(ctx, cm, filePaths)
+		assert.NoError(t, err)
+		assert.Equal(t, filePaths, paths)
+	})
+
+	t.Run("manifest not saved", func(t *testing.T) {
+		_, err := ResolveIndexFilePaths(ctx, cm, append(filePaths, manifestPath))
+		assert.True(t, errors.Is(err, ErrIndexManifestNotFound))
+	})
+
+	manifest := NewIndexManifest(1, 1)
+	manifest.AddFile("HNSW_0", 1)
+	manifest.AddFile("HNSW_1", 1)
+	data, err := manifest.Marshal()
+	assert.NoError(t, err)
+	assert.NoError(t, cm.Write(ctx, manifestPath, data))
+
+	t.Run("with manifest", func(t *testing.T) {
+		paths, err := ResolveIndexFilePaths(ctx, cm, append([]string{manifestPath}, filePaths...))
+		assert.NoError(t, err)
+		assert.Equal(t, filePaths, paths)
+	})
+
+	t.Run("files mismatch", func(t *testing.T) {
+		_, err := ResolveIndexFilePaths(ctx, cm, []string{filePaths[0], manifestPath})
+		assert.Error(t, err)
+		_, err = ResolveIndexFilePaths(ctx, cm, []string{filePaths[0], path.Join(rootPath, "HNSW_2"), manifestPath})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// sortedByPKKey is the extra key marking the rows of a binlog are sorted by (primary key, timestamp)
+	sortedByPKKey = "sorted_by_pk"
+	// pkIndexKey is the extra key of the sparse primary key index in the binlog of the primary key field
+	pkIndexKey = "pk_index"
+	// PKIndexStride is the number of rows between two primary keys recorded in the sparse primary key index
+	PKIndexStride = 1024
+)
+
+// PKIndex is the sparse index of a binlog sorted by (primary key, timestamp), it records the primary key of
+// every Stride rows, so that the rows of a primary key are located by a binary search and a short sequential scan.
+type PKIndex struct {
+	Stride int
+	PKs    []PrimaryKey
+}
+
+type pkIndexData struct {
+	Stride    int      `json:"stride"`
+	Int64PKs  []int64  `json:"int64_pks,omitempty"`
+	StringPKs []string `json:"string_pks,omitempty"`
+}
+
+// genPKIndex returns the json of the sparse index of the sorted primary key field data
+func genPKIndex(data FieldData, stride int) (string, error) {
+	index := &pkIndexData{Stride: stride}
+	switch pks := data.(type) {
+	case *Int64FieldData:
+		for i := 0; i < len(pks.Data); i += stride {
+			index.Int64PKs = append(index.Int64PKs, pks.Data[i])
+		}
+	case *StringFieldData:
+		for i := 0; i < len(pks.Data); i += stride {
+			index.StringPKs = append(index.StringPKs, pks.Data[i])
+		}
+	default:
+		return "", fmt.Errorf("unsupported primary key field data %T", data)
+	}
+	bs, err := json.Marshal(index)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// IsBinlogSortedByPK returns whether the rows of the binlog are sorted by (primary key, timestamp)
+func IsBinlogSortedByPK(blob *Blob) (bool, error) {
+	reader, err := NewBinlogReader(blob.GetValue())
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+	sorted, ok := reader.Extras[sortedByPKKey]
+	return ok && sorted == "true", nil
+}
+
+// GetPKIndex returns the sparse primary key index of the binlog of the primary key field,
+// nil if the binlog is not sorted by primary key.
+func GetPKIndex(blob *Blob) (*PKIndex, error) {
+	reader, err := NewBinlogReader(blob.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	value, ok := reader.Extras[pkIndexKey]
+	if !ok {
+		return nil, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("value of %v must in string format", pkIndexKey)
+	}
+	data := &pkIndexData{}
+	if err := json.Unmarshal([]byte(str), data); err != nil {
+		return nil, err
+	}
+	if data.Stride <= 0 {
+		return nil, fmt.Errorf("invalid stride %d of primary key index", data.Stride)
+	}
+
+	index := &PKIndex{Stride: data.Stride}
+	for _, pk := range data.Int64PKs {
+		index.PKs = append(index.PKs, NewInt64PrimaryKey(pk))
+	}
+	for _, pk := range data.StringPKs {
+		index.PKs = append(index.PKs, NewVarCharPrimaryKey(pk))
+	}
+	return index, nil
+}
+
+// Locate returns the row offset range [start, end) which contains all the rows of the primary key,
+// end is -1 if the range reaches the end of the binlog.
+func (idx *PKIndex) Locate(pk PrimaryKey) (int, int) {
+	// the first sampled key not less than pk, the rows of pk start after the previous sampled key
+	first := sort.Search(len(idx.PKs), func(i int) bool { return idx.PKs[i].GE(pk) })
+	start := 0
+	if first > 0 {
+		start = (first - 1) * idx.Stride
+	}
+	// the first sampled key greater than pk, the rows of pk end before it
+	last := sort.Search(len(idx.PKs), func(i int) bool { return idx.PKs[i].GT(pk) })
+	if last >= len(idx.PKs) {
+		return start, -1
+	}
+	return start, last * idx.Stride
+}
+
+// RowRangeFilter tells whether a row offset of a binlog sorted by primary key falls in the row ranges located by
+// LocateAll, the offsets must be asked in the increasing order, so that a scan of the binlog checks them sequentially.
+type RowRangeFilter struct {
+	ranges [][2]int // sorted and disjoint [start, end) ranges
+	cursor int
+}
+
+// LocateAll returns the filter of the rows of the primary keys.
+func (idx *PKIndex) LocateAll(pks []PrimaryKey) *RowRangeFilter {
+	ranges := make([][2]int, 0, len(pks))
+	for _, pk := range pks {
+		start, end := idx.Locate(pk)
+		if end < 0 {
+			end = math.MaxInt
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+
+	merged := make([][2]int, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1] {
+			if r[1] > merged[n-1][1] {
+				merged[n-1][1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return &RowRangeFilter{ranges: merged}
+}
+
+// MayContain returns true if the row offset falls in one of the ranges.
+func (f *RowRangeFilter) MayContain(offset int) bool {
+	for f.cursor < len(f.ranges) && offset >= f.ranges[f.cursor][1] {
+		f.cursor++
+	}
+	return f.cursor < len(f.ranges) && offset >= f.ranges[f.cursor][0]
+}
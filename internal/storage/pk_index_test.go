@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func TestInsertCodec_SortByPK(t *testing.T) {
+	meta := &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: common.TimeStampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "value", DataType: schemapb.DataType_Float},
+			},
+		},
+	}
+	rowNum := 3*PKIndexStride + 10
+	rowIDs := make([]int64, rowNum)
+	tss := make([]int64, rowNum)
+	pks := make([]int64, rowNum)
+	values := make([]float32, rowNum)
+	for i := 0; i < rowNum; i++ {
+		rowIDs[i] = int64(i)
+		// pk decreases with the row id, each pk is inserted twice and the later one has the smaller row id
+		tss[i] = int64(rowNum - i)
+		pks[i] = int64((rowNum - i) / 2)
+		values[i] = float32(i)
+	}
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			common.RowIDField:     &Int64FieldData{NumRows: []int64{int64(rowNum)}, Data: rowIDs},
+			common.TimeStampField: &Int64FieldData{NumRows: []int64{int64(rowNum)}, Data: tss},
+			100:                   &Int64FieldData{NumRows: []int64{int64(rowNum)}, Data: pks},
+			101:                   &FloatFieldData{NumRows: []int64{int64(rowNum)}, Data: values},
+		},
+	}
+
+	codec := NewInsertCodec(meta)
+	codec.SortByPK = true
+	blobs, _, err := codec.Serialize(1, 1, data)
+	assert.NoError(t, err)
+
+	// rows are sorted by (pk, ts) and the other fields move along
+	for i := 1; i < rowNum; i++ {
+		assert.True(t, pks[i-1] < pks[i] || (pks[i-1] == pks[i] && tss[i-1] < tss[i]))
+		assert.Equal(t, float32(rowIDs[i]), values[i])
+	}
+
+	var pkBlob *Blob
+	for _, blob := range blobs {
+		sorted, err := IsBinlogSortedByPK(blob)
+		assert.NoError(t, err)
+		assert.True(t, sorted)
+		if blob.GetKey() == "100" {
+			pkBlob = blob
+		}
+	}
+	assert.NotNil(t, pkBlob)
+
+	index, err := GetPKIndex(pkBlob)
+	assert.NoError(t, err)
+	assert.Equal(t, PKIndexStride, index.Stride)
+	assert.Equal(t, 4, len(index.PKs))
+
+	// every row of a pk is within the located range
+	for _, pk := range []int64{0, pks[PKIndexStride], pks[2*PKIndexStride+1], pks[rowNum-1]} {
+		start, end := index.Locate(NewInt64PrimaryKey(pk))
+		if end == -1 {
+			end = rowNum
+		}
+		for i := 0; i < rowNum; i++ {
+			if pks[i] == pk {
+				assert.True(t, i >= start && i < end)
+			}
+		}
+	}
+
+	// binlogs sorted by row id have no pk index
+	codec = NewInsertCodec(meta)
+	blobs, _, err = codec.Serialize(1, 1, data)
+	assert.NoError(t, err)
+	for _, blob := range blobs {
+		sorted, err := IsBinlogSortedByPK(blob)
+		assert.NoError(t, err)
+		assert.False(t, sorted)
+		index, err := GetPKIndex(blob)
+		assert.NoError(t, err)
+		assert.Nil(t, index)
+	}
+}
+
+func TestPKIndex_Locate(t *testing.T) {
+	data := &StringFieldData{Data: []string{"a", "a", "b", "c", "c", "c", "d", "e"}}
+	value, err := genPKIndex(data, 2)
+	assert.NoError(t, err)
+
+	w := NewInsertBinlogWriter(schemapb.DataType_VarChar, 1, 1, 1, 100)
+	defer w.Close()
+	ew, err := w.NextInsertEventWriter()
+	assert.NoError(t, err)
+	defer ew.Close()
+	assert.NoError(t, ew.AddOneStringToPayload("a"))
+	ew.SetEventTimestamp(1, 1)
+	w.SetEventTimeStamp(1, 1)
+	w.AddExtra(originalSizeKey, "1")
+	w.AddExtra(pkIndexKey, value)
+	assert.NoError(t, w.Finish())
+	buffer, err := w.GetBuffer()
+	assert.NoError(t, err)
+
+	index, err := GetPKIndex(&Blob{Value: buffer})
+	assert.NoError(t, err)
+	// sampled keys: a, b, c, d
+	assert.Equal(t, 4, len(index.PKs))
+	start, end := index.Locate(NewVarCharPrimaryKey("a"))
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 2, end)
+	start, end = index.Locate(NewVarCharPrimaryKey("c"))
+	assert.Equal(t, 2, start)
+	assert.Equal(t, 6, end)
+	start, end = index.Locate(NewVarCharPrimaryKey("e"))
+	assert.Equal(t, 6, start)
+	assert.Equal(t, -1, end)
+
+	filter := index.LocateAll([]PrimaryKey{NewVarCharPrimaryKey("e"), NewVarCharPrimaryKey("a")})
+	mayContain := make([]bool, 0)
+	for i := 0; i < 8; i++ {
+		mayContain = append(mayContain, filter.MayContain(i))
+	}
+	assert.Equal(t, []bool{true, true, false, false, false, false, true, true}, mayContain)
+	// the overlapped ranges are merged
+	filter = index.LocateAll([]PrimaryKey{NewVarCharPrimaryKey("c"), NewVarCharPrimaryKey("a")})
+	assert.Equal(t, [][2]int{{0, 6}}, filter.ranges)
+	assert.True(t, filter.MayContain(5))
+	assert.False(t, filter.MayContain(6))
+
+	_, err = genPKIndex(&FloatFieldData{Data: []float32{1}}, 2)
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ package storage
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -106,3 +107,40 @@ func (st *PkStatistics) PkExist(pk PrimaryKey) bool {
 	// no idea, just make it as false positive
 	return true
 }
+
+// BatchPkExist sets hits[i] to true if pks[i] may exist, the positions already hit are not checked again.
+// If the pks are sorted in ascending order, only the pks inside [MinPK, MaxPK] located by binary search are
+// checked against the bloom filter, otherwise the range is checked one by one.
+func (st *PkStatistics) BatchPkExist(pks []PrimaryKey, sorted bool, hits []bool) {
+	// empty pkStatics
+	if st.MinPK == nil || st.MaxPK == nil || st.PkFilter == nil || len(pks) == 0 {
+		return
+	}
+
+	start, end := 0, len(pks)
+	if sorted {
+		start = sort.Search(len(pks), func(i int) bool { return st.MinPK.LE(pks[i]) })
+		end = sort.Search(len(pks), func(i int) bool { return st.MaxPK.LT(pks[i]) })
+	}
+
+	buf := make([]byte, 8)
+	for i := start; i < end; i++ {
+		if hits[i] {
+			continue
+		}
+		pk := pks[i]
+		if !sorted && (st.MinPK.GT(pk) || st.MaxPK.LT(pk)) {
+			continue
+		}
+		switch pk.Type() {
+		case schemapb.DataType_Int64:
+			common.Endian.PutUint64(buf, uint64(pk.(*Int64PrimaryKey).Value))
+			hits[i] = st.PkFilter.Test(buf)
+		case schemapb.DataType_VarChar:
+			hits[i] = st.PkFilter.TestString(pk.(*VarCharPrimaryKey).Value)
+		default:
+			// no idea, just make it as false positive
+			hits[i] = true
+		}
+	}
+}
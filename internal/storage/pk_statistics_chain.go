@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+// PkStatisticsChain is the pk statistics of a growing segment updated incrementally per insert batch.
+// The pks are added to the active block, which is sealed once it holds blockRows pks and a new block is started,
+// so the false positive rate stays bounded as the segment grows without ever rebuilding the bloom filter.
+// Every block keeps its own pk range, and the sealed blocks never change, so they can be shipped as deltas.
+type PkStatisticsChain struct {
+	blockRows  uint
+	blocks     []*PkStatistics
+	active     *PkStatistics
+	activeRows uint
+}
+
+// NewPkStatisticsChain returns an empty chain whose blocks hold at most blockRows pks.
+func NewPkStatisticsChain(blockRows uint) *PkStatisticsChain {
+	if blockRows == 0 {
+		blockRows = BloomFilterSize
+	}
+	return &PkStatisticsChain{blockRows: blockRows}
+}
+
+// Update adds the pks of an insert batch.
+func (c *PkStatisticsChain) Update(pks []PrimaryKey) error {
+	buf := make([]byte, 8)
+	for _, pk := range pks {
+		if c.active == nil || c.activeRows >= c.blockRows {
+			c.seal()
+		}
+		if err := c.active.UpdateMinMax(pk); err != nil {
+			return err
+		}
+		switch pk.Type() {
+		case schemapb.DataType_Int64:
+			common.Endian.PutUint64(buf, uint64(pk.(*Int64PrimaryKey).Value))
+			c.active.PkFilter.Add(buf)
+		case schemapb.DataType_VarChar:
+			c.active.PkFilter.AddString(pk.(*VarCharPrimaryKey).Value)
+		default:
+			return fmt.Errorf("invalid data type for primary key: %s", pk.Type().String())
+		}
+		c.activeRows++
+	}
+	return nil
+}
+
+// seal seals the active block and starts a new one.
+func (c *PkStatisticsChain) seal() {
+	if c.active != nil {
+		c.blocks = append(c.blocks, c.active)
+	}
+	c.active = &PkStatistics{PkFilter: bloom.NewWithEstimates(c.blockRows, MaxBloomFalsePositive)}
+	c.activeRows = 0
+}
+
+// PkExist returns whether the pk may exist in any block.
+func (c *PkStatisticsChain) PkExist(pk PrimaryKey) bool {
+	if c.active != nil && c.active.PkExist(pk) {
+		return true
+	}
+	for _, block := range c.blocks {
+		if block.PkExist(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchPkExist sets hits[i] to true if pks[i] may exist in any block.
+func (c *PkStatisticsChain) BatchPkExist(pks []PrimaryKey, sorted bool, hits []bool) {
+	if c.active != nil {
+		c.active.BatchPkExist(pks, sorted, hits)
+	}
+	for _, block := range c.blocks {
+		block.BatchPkExist(pks, sorted, hits)
+	}
+}
+
+// NumBlocks returns the number of the sealed blocks.
+func (c *PkStatisticsChain) NumBlocks() int {
+	return len(c.blocks)
+}
+
+// Deltas returns the blocks sealed since the first from sealed blocks are shipped, with a copy of the active block
+// if withActive, so that the receiver merges them into its statistics.
+func (c *PkStatisticsChain) Deltas(from int, withActive bool) []*PkStatistics {
+	if from < 0 || from > len(c.blocks) {
+		from = len(c.blocks)
+	}
+	ret := make([]*PkStatistics, 0, len(c.blocks)-from+1)
+	ret = append(ret, c.blocks[from:]...)
+	if withActive && c.active != nil && c.activeRows > 0 {
+		ret = append(ret, &PkStatistics{
+			PkFilter: c.active.PkFilter.Copy(),
+			MinPK:    c.active.MinPK,
+			MaxPK:    c.active.MaxPK,
+		})
+	}
+	return ret
+}
+
+// Merge merges the blocks shipped as deltas by another chain as sealed blocks, the merge is lossless since
+// every block keeps answering for its own pks.
+func (c *PkStatisticsChain) Merge(deltas []*PkStatistics) {
+	for _, delta := range deltas {
+		if delta.PkFilter == nil || delta.MinPK == nil || delta.MaxPK == nil {
+			continue
+		}
+		c.blocks = append(c.blocks, delta)
+	}
+}
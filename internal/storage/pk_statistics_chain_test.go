@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPkStatisticsChain(t *testing.T) {
+	t.Run("int64 pks", func(t *testing.T) {
+		chain := NewPkStatisticsChain(10)
+		for batch := int64(0); batch < 5; batch++ {
+			pks := make([]PrimaryKey, 0, 7)
+			for i := int64(0); i < 7; i++ {
+				pks = append(pks, NewInt64PrimaryKey(batch*7+i))
+			}
+			require.NoError(t, chain.Update(pks))
+		}
+		// 35 pks fill 3 blocks of 10 pks, the last 5 are in the active block
+		assert.Equal(t, 3, chain.NumBlocks())
+		for i := int64(0); i < 35; i++ {
+			assert.True(t, chain.PkExist(NewInt64PrimaryKey(i)))
+		}
+		assert.False(t, chain.PkExist(NewInt64PrimaryKey(100)))
+
+		pks := []PrimaryKey{NewInt64PrimaryKey(-1), NewInt64PrimaryKey(0), NewInt64PrimaryKey(34), NewInt64PrimaryKey(100)}
+		hits := make([]bool, len(pks))
+		chain.BatchPkExist(pks, true, hits)
+		assert.Equal(t, []bool{false, true, true, false}, hits)
+	})
+
+	t.Run("varchar pks", func(t *testing.T) {
+		chain := NewPkStatisticsChain(2)
+		require.NoError(t, chain.Update([]PrimaryKey{NewVarCharPrimaryKey("a"), NewVarCharPrimaryKey("b"), NewVarCharPrimaryKey("c")}))
+		assert.Equal(t, 1, chain.NumBlocks())
+		assert.True(t, chain.PkExist(NewVarCharPrimaryKey("a")))
+		assert.True(t, chain.PkExist(NewVarCharPrimaryKey("c")))
+		assert.False(t, chain.PkExist(NewVarCharPrimaryKey("z")))
+	})
+
+	t.Run("deltas and merge", func(t *testing.T) {
+		chain := NewPkStatisticsChain(10)
+		pks := make([]PrimaryKey, 0, 25)
+		for i := int64(0); i < 25; i++ {
+			pks = append(pks, NewInt64PrimaryKey(i))
+		}
+		require.NoError(t, chain.Update(pks))
+
+		assert.Len(t, chain.Deltas(0, false), 2)
+		assert.Len(t, chain.Deltas(1, false), 1)
+		assert.Len(t, chain.Deltas(5, false), 0)
+		deltas := chain.Deltas(0, true)
+		assert.Len(t, deltas, 3)
+
+		// the copy of the active block is not changed by the later updates
+		require.NoError(t, chain.Update([]PrimaryKey{NewInt64PrimaryKey(26)}))
+		assert.False(t, deltas[2].PkExist(NewInt64PrimaryKey(26)))
+
+		merged := NewPkStatisticsChain(0)
+		merged.Merge(deltas)
+		merged.Merge([]*PkStatistics{{}})
+		assert.Equal(t, 3, merged.NumBlocks())
+		for _, pk := range pks {
+			assert.True(t, merged.PkExist(pk))
+		}
+	})
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+func newInt64PkStatistics(pks []int64) *PkStatistics {
+	stat := &PkStatistics{PkFilter: bloom.NewWithEstimates(BloomFilterSize, MaxBloomFalsePositive)}
+	buf := make([]byte, 8)
+	for _, pk := range pks {
+		stat.UpdateMinMax(NewInt64PrimaryKey(pk))
+		common.Endian.PutUint64(buf, uint64(pk))
+		stat.PkFilter.Add(buf)
+	}
+	return stat
+}
+
+func TestPkStatistics_BatchPkExist(t *testing.T) {
+	t.Run("int64 pks", func(t *testing.T) {
+		stat := newInt64PkStatistics([]int64{10, 12, 14, 16, 18, 20})
+		pks := make([]PrimaryKey, 0)
+		for i := int64(0); i < 30; i++ {
+			pks = append(pks, NewInt64PrimaryKey(i))
+		}
+		expected := make([]bool, len(pks))
+		for i, pk := range pks {
+			expected[i] = stat.PkExist(pk)
+		}
+
+		hits := make([]bool, len(pks))
+		stat.BatchPkExist(pks, true, hits)
+		assert.Equal(t, expected, hits)
+
+		rand.Shuffle(len(pks), func(i, j int) { pks[i], pks[j] = pks[j], pks[i] })
+		hits = make([]bool, len(pks))
+		stat.BatchPkExist(pks, false, hits)
+		for i, pk := range pks {
+			assert.Equal(t, stat.PkExist(pk), hits[i])
+		}
+	})
+
+	t.Run("varchar pks", func(t *testing.T) {
+		stat := &PkStatistics{PkFilter: bloom.NewWithEstimates(BloomFilterSize, MaxBloomFalsePositive)}
+		for i := 3; i < 6; i++ {
+			pk := fmt.Sprintf("pk%d", i)
+			stat.UpdateMinMax(NewVarCharPrimaryKey(pk))
+			stat.PkFilter.AddString(pk)
+		}
+		pks := make([]PrimaryKey, 0)
+		for i := 0; i < 9; i++ {
+			pks = append(pks, NewVarCharPrimaryKey(fmt.Sprintf("pk%d", i)))
+		}
+		hits := make([]bool, len(pks))
+		stat.BatchPkExist(pks, true, hits)
+		assert.Equal(t, []bool{false, false, false, true, true, true, false, false, false}, hits)
+	})
+
+	t.Run("hits kept", func(t *testing.T) {
+		stat := newInt64PkStatistics([]int64{1})
+		pks := []PrimaryKey{NewInt64PrimaryKey(1), NewInt64PrimaryKey(100)}
+		hits := []bool{false, true}
+		stat.BatchPkExist(pks, true, hits)
+		assert.Equal(t, []bool{true, true}, hits)
+	})
+
+	t.Run("empty stat", func(t *testing.T) {
+		stat := &PkStatistics{}
+		hits := make([]bool, 1)
+		stat.BatchPkExist([]PrimaryKey{NewInt64PrimaryKey(1)}, true, hits)
+		assert.False(t, hits[0])
+	})
+}
+
+// a million tombstones spread over 100 segments, each segment holds a disjoint 1% of the pk range
+const benchTombstoneNum = 1000000
+
+func benchTombstones(sorted bool) []PrimaryKey {
+	values := make([]int64, benchTombstoneNum)
+	for i := range values {
+		values[i] = rand.Int63n(benchTombstoneNum * 10)
+	}
+	if sorted {
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	}
+	pks := make([]PrimaryKey, len(values))
+	for i, v := range values {
+		pks[i] = NewInt64PrimaryKey(v)
+	}
+	return pks
+}
+
+func benchSegmentStat() *PkStatistics {
+	pks := make([]int64, 0, benchTombstoneNum/10)
+	for i := int64(0); i < benchTombstoneNum/10; i++ {
+		pks = append(pks, benchTombstoneNum*5+i)
+	}
+	return newInt64PkStatistics(pks)
+}
+
+func BenchmarkPkStatistics_PkExist(b *testing.B) {
+	stat := benchSegmentStat()
+	pks := benchTombstones(false)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, pk := range pks {
+			stat.PkExist(pk)
+		}
+	}
+}
+
+func BenchmarkPkStatistics_BatchPkExist_Unsorted(b *testing.B) {
+	stat := benchSegmentStat()
+	pks := benchTombstones(false)
+	hits := make([]bool, len(pks))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		stat.BatchPkExist(pks, false, hits)
+	}
+}
+
+func BenchmarkPkStatistics_BatchPkExist_Sorted(b *testing.B) {
+	stat := benchSegmentStat()
+	pks := benchTombstones(true)
+	hits := make([]bool, len(pks))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		stat.BatchPkExist(pks, true, hits)
+	}
+}
@@ -100,6 +100,9 @@ type DataNode interface {
 
 	// AddImportSegment puts the given import segment to current DataNode's flow graph.
 	AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error)
+	// Decommission drains all the vchannels of the DataNode and hands them over to the other DataNodes, the
+	// decommission state is returned. A DataNode being decommissioned rejects the vchannels newly assigned to it.
+	Decommission(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -313,11 +316,78 @@ type DataCoord interface {
 	// the `tasks` in `ImportResponse` return an id list of tasks.
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
+	// PreImport checks the files of an import against the collection schema: the files exist, their types, sizes
+	// and fields are acceptable, and estimates the rows and the segments the import produces. No import task is
+	// scheduled, the import is expected to fail if the response has any error.
+	PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error)
 
 	// UpdateSegmentStatistics updates a segment's stats.
 	UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	// UpdateChannelCheckpoint updates channel checkpoint in dataCoord.
 	UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error)
+	// UpdateChannelCheckpoints updates the checkpoints of several vchannels in dataCoord, the result of each vchannel
+	// is returned in the ChannelStatuses of the response.
+	UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest) (*datapb.UpdateChannelCheckpointsResponse, error)
+	// GetTimeTravelWatermarks returns the retention duration and the max travel timestamps of the completed compactions
+	// of the collections, the history older than them may be compacted already.
+	GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest) (*datapb.GetTimeTravelWatermarksResponse, error)
+	// ReportSegmentHeats records the query heats of the sealed segments served by the QueryNode sending the request,
+	// they replace the heats the QueryNode reported before.
+	ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest) (*commonpb.Status, error)
+	// GetSegmentHistory returns the state transitions of a segment, e.g. Growing to Sealed to Flushed, with the
+	// time and the cause of each transition, at most dataCoord.segment.historyMaxEvents transitions are kept.
+	GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error)
+	// PauseCollectionMaintenance pauses the compaction and/or the binlog gc of a collection, e.g. while debugging
+	// data issues or taking an external backup of its objects. The pause is persisted until it's resumed.
+	PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error)
+	// ResumeCollectionMaintenance resumes the compaction and/or the binlog gc of a collection paused by
+	// PauseCollectionMaintenance.
+	ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error)
+	// GetCollectionMaintenancePauses returns the collections whose compaction or binlog gc is paused.
+	GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error)
+	// DecommissionDataNode forwards the request to the DataNode of the node ID to start its decommission, or to
+	// return its decommission state only.
+	DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error)
+	// LocatePrimaryKeys returns the flushed segments of the collection which may contain the primary keys by
+	// their pk ranges and bloom filters, and the segments not flushed yet, which may contain any primary key.
+	LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error)
+	// AcquireSegmentLock locks the segments for the task of the node so that they are not recycled by GC, the lease
+	// expires if it's not renewed by RenewSegmentLock in time.
+	AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error)
+	// RenewSegmentLock extends the lease of the segment locks held by the task of the node, it fails if the lease
+	// has expired.
+	RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest) (*commonpb.Status, error)
+	// ReleaseSegmentLock releases the given segment locks held by the task of the node, or all of them if no segment
+	// is given.
+	ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error)
+	// InspectSegmentLocks returns the segment lock leases not expired yet, sorted by node and task.
+	InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error)
+	// CompactSegments triggers a manual compaction of the given flushed segments of the collection, they must
+	// belong to the same channel and partition.
+	CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// GetHandoffGate returns the handoff gating states of the flushing and flushed segments of the collection, or of
+	// all the collections if the collectionID is 0.
+	GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error)
+	// GetSegmentAnomalies returns the segment reports of the collections with anomalies, or the report of the
+	// collection if the collectionID is set.
+	GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error)
+	// MergeTinySegments triggers a compaction of the collection which merges its small segments.
+	MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// GetSegmentAllocHints returns the row distribution of the partitions over the vchannels and the allocation hints.
+	GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error)
+	// GetSegmentHeats returns the query heats of the healthy segments of the collection, of all the collections if
+	// the collectionID is 0, from the hottest.
+	GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error)
+	// FreezeHandoffs freezes the index completion driven handoffs and the compaction of the collection, or the
+	// whole cluster if the collectionID is 0, until the window expires or is unfrozen.
+	FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error)
+	// UnfreezeHandoffs closes the freeze window of the collection, IllegalArgument is returned if it's not frozen.
+	UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error)
+	// GetFreezeWindows returns the freeze windows not expired sorted by collection.
+	GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error)
+	// GetDeleteSLA returns the delete sla markers of the collection, of all the collections if the collectionID is 0,
+	// with the latency summaries of the stages.
+	GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error)
 
 	// SaveImportSegment saves the import segment binlog paths data and then looks for the right DataNode to add the
 	// segment to that DataNode.
@@ -355,6 +425,9 @@ type DataCoord interface {
 	// GetIndexBuildProgress get the index building progress by num rows.
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(ctx context.Context, req *datapb.GetIndexBuildProgressRequest) (*datapb.GetIndexBuildProgressResponse, error)
+	// RebuildDeprecatedIndexes lists the finished segment indexes built by the engine or file format versions older
+	// than the limits, and resets and enqueues them to rebuild unless it's a dry run.
+	RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error)
 
 	// DropIndex deletes indexes based on IndexID. One IndexID corresponds to the index of an entire column. A column is
 	// divided into many segments, and each segment corresponds to an IndexBuildID. IndexCoord uses IndexBuildID to record
@@ -395,6 +468,9 @@ type IndexNode interface {
 	DropJobs(context.Context, *indexpb.DropJobsRequest) (*commonpb.Status, error)
 	// GetJobStats returns metrics of indexnode, including available job queue info, available task slots and finished job infos.
 	GetJobStats(context.Context, *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	// VerifyIndexBuild rebuilds an index recorded in reproducibility mode and compares the checksums of the rebuilt
+	// index files with the recorded ones, the index files saved are left untouched.
+	VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error)
 
 	ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 	// GetMetrics gets the metrics about IndexNode.
@@ -461,6 +537,29 @@ type IndexCoord interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	// CheckIndexConsistency checks the finished segment indexes against the segments of DataCoord and the index
+	// files, and repairs the inconsistencies found if asked.
+	CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error)
+	// GetIndexStatistics returns the build statistics of the indexes over the last days: the builds completed and
+	// failed per day, the average build latency and the size of the index files.
+	GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
+	// CordonIndexNode cordons or uncordons an IndexNode: a cordoned IndexNode receives no new builds, the builds
+	// assigned to it already run to the end. The cordon is persisted and survives the restart of IndexCoord.
+	CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error)
+	// TriggerGC runs the gc passes of a scope right away and returns their summaries, nothing is removed in a
+	// dry run. The passes fail without waiting if another pass is running.
+	TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error)
+	// ListIndexTTLs returns the ttl states of the indexes of the collection, or of all the collections if the
+	// collectionID is 0.
+	ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error)
+	// SetIndexTTL sets the ttl of the index, 0 removes the ttl. The index is regarded as used when its ttl is set.
+	SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error)
+	// CheckIndexTTL refreshes the usage of the indexes from QueryCoord and reaps the unused ones right away, it
+	// returns the drop candidates and the indexes dropped in the check.
+	CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error)
+	// VerifyIndexBuild forwards the request to the IndexNode given by the nodeID, which rebuilds an index recorded in
+	// reproducibility mode and compares the checksums of the index files.
+	VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error)
 }
 
 // IndexCoordComponent is used by grpc server of IndexCoord
@@ -473,6 +572,9 @@ type IndexCoordComponent interface {
 
 	SetDataCoord(dataCoord DataCoord) error
 	SetRootCoord(rootCoord RootCoord) error
+	// SetQueryCoord set QueryCoord for IndexCoordComponent
+	// `queryCoord` is a client of query coordinator, used to track the usage of the indexes.
+	SetQueryCoord(queryCoord QueryCoord) error
 
 	// UpdateStateCode updates state code for IndexCoordComponent
 	//  `stateCode` is current statement of this IndexCoordComponent, indicating whether it's healthy.
@@ -808,6 +910,27 @@ type RootCoord interface {
 	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+	// ListRoleQuotas lists the rate limiting quotas of roles sorted by role name.
+	ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error)
+	// SaveRoleQuota saves the rate limiting quota of an existing role and refreshes it in proxies.
+	SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error)
+	// DropRoleQuota drops the rate limiting quota of a role and removes its limits in proxies.
+	DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error)
+	// ListDatabaseQuotas lists the rate limiting quotas of databases sorted by db name.
+	ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error)
+	// SaveDatabaseQuota saves the rate limiting quota of a database and refreshes it in proxies.
+	SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error)
+	// DropDatabaseQuota drops the rate limiting quota of a database and removes its limits in proxies.
+	DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error)
+	// ListReadOnlyModes lists the read-only modes entered, the one of the cluster goes first.
+	ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error)
+	// EnterReadOnly enters the read-only mode of the cluster or of a database and refreshes it in proxies.
+	EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error)
+	// LeaveReadOnly leaves the read-only mode of the cluster or of a database and refreshes it in proxies.
+	LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error)
+	// GetDdlOperationState returns the ddl operation of the id, or the operations on the collection, or all the
+	// operations in the ddl journal, with the states of their steps.
+	GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 }
@@ -878,6 +1001,12 @@ type Proxy interface {
 
 	// SetRates notifies Proxy to limit rates of requests.
 	SetRates(ctx context.Context, req *proxypb.SetRatesRequest) (*commonpb.Status, error)
+	// RefreshRoleQuota notifies Proxy to refresh the rate limiting quota of a role.
+	RefreshRoleQuota(ctx context.Context, req *proxypb.RefreshRoleQuotaRequest) (*commonpb.Status, error)
+	// RefreshDatabaseQuota notifies Proxy to refresh the rate limiting quota of a database.
+	RefreshDatabaseQuota(ctx context.Context, req *proxypb.RefreshDatabaseQuotaRequest) (*commonpb.Status, error)
+	// RefreshReadOnlyMode notifies Proxy to enter or leave the read-only mode of the cluster or of a database.
+	RefreshReadOnlyMode(ctx context.Context, req *proxypb.RefreshReadOnlyModeRequest) (*commonpb.Status, error)
 
 	// GetProxyMetrics gets the metrics of proxy, it's an internal interface which is different from GetMetrics interface,
 	// because it only obtains the metrics of Proxy, not including the topological metrics of Query cluster and Data cluster.
@@ -904,7 +1033,7 @@ type ProxyComponent interface {
 
 	// SetIndexCoordClient set IndexCoord for Proxy
 	//  `indexCoord` is a client of index coordinator.
-	//SetIndexCoordClient(indexCoord IndexCoord)
+	SetIndexCoordClient(indexCoord IndexCoord)
 
 	// SetQueryCoordClient set QueryCoord for Proxy
 	//  `queryCoord` is a client of query coordinator.
@@ -1185,6 +1314,17 @@ type ProxyComponent interface {
 	// error is always nil
 	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
 
+	// SearchMulti notifies Proxy to do the same search on several collections
+	//
+	// ctx is the context to control request deadline and cancellation
+	// collectionNames are the collections to search, the anns field of them must have the same vector type and dim
+	// req is the search shared by the collections, the collection name and partition names of it are ignored
+	//
+	// The results are returned in the order of collectionNames, the `Status` in each `SearchResults` indicates
+	// if the search on the collection is processed successfully or fail cause;
+	// error is returned if the collections can't be searched together
+	SearchMulti(ctx context.Context, collectionNames []string, request *milvuspb.SearchRequest) ([]*milvuspb.SearchResults, error)
+
 	// Flush notifies Proxy to flush buffer into storage
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1346,6 +1486,213 @@ type ProxyComponent interface {
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	// DryRunCreateCollection runs a CreateCollection request as a dry run
+	//
+	// The `Status` in response struct `DryRunCreateCollectionResponse` indicates if the collection could be created;
+	// the other fields describe the collection that would be created, with the defaults RootCoord would derive.
+	// error is always nil
+	DryRunCreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*proxypb.DryRunCreateCollectionResponse, error)
+	// DryRunCreateIndex runs a CreateIndex request as a dry run
+	//
+	// The `Status` in response struct `DryRunCreateIndexResponse` indicates if the index could be created;
+	// the other fields describe the index that would be created.
+	// error is always nil
+	DryRunCreateIndex(ctx context.Context, req *milvuspb.CreateIndexRequest) (*proxypb.DryRunCreateIndexResponse, error)
+	// RebuildDeprecatedIndexes forwards the request to DataCoord to list and rebuild the deprecated segment indexes
+	//
+	// error is always nil
+	RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest) (*datapb.RebuildDeprecatedIndexesResponse, error)
+	// SearchReducedPrecision searches like Search, and returns the scores in the output_precision of the search params
+	//
+	// The scores too large for the precision are returned in float32.
+	SearchReducedPrecision(ctx context.Context, req *milvuspb.SearchRequest) (*proxypb.ReducedPrecisionSearchResults, error)
+	// QueryReducedPrecision queries like Query, and returns the float vectors in the output_precision of the query params
+	//
+	// The vectors of a field too large for the precision are returned in float32.
+	QueryReducedPrecision(ctx context.Context, req *milvuspb.QueryRequest) (*proxypb.ReducedPrecisionQueryResults, error)
+	// ListRoleQuotas forwards the request to RootCoord to list the rate limiting quotas of roles
+	//
+	// error is always nil
+	ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest) (*proxypb.ListRoleQuotasResponse, error)
+	// SaveRoleQuota forwards the request to RootCoord to save the rate limiting quota of a role
+	//
+	// error is always nil
+	SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest) (*commonpb.Status, error)
+	// DropRoleQuota forwards the request to RootCoord to drop the rate limiting quota of a role
+	//
+	// error is always nil
+	DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest) (*commonpb.Status, error)
+	// ListDatabaseQuotas forwards the request to RootCoord to list the rate limiting quotas of databases
+	//
+	// error is always nil
+	ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest) (*proxypb.ListDatabaseQuotasResponse, error)
+	// SaveDatabaseQuota forwards the request to RootCoord to save the rate limiting quota of a database
+	//
+	// error is always nil
+	SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest) (*commonpb.Status, error)
+	// DropDatabaseQuota forwards the request to RootCoord to drop the rate limiting quota of a database
+	//
+	// error is always nil
+	DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest) (*commonpb.Status, error)
+	// ListReadOnlyModes forwards the request to RootCoord to list the read-only modes entered
+	//
+	// error is always nil
+	ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest) (*proxypb.ListReadOnlyModesResponse, error)
+	// EnterReadOnly forwards the request to RootCoord to enter the read-only mode of the cluster or of a database
+	//
+	// error is always nil
+	EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest) (*commonpb.Status, error)
+	// LeaveReadOnly forwards the request to RootCoord to leave the read-only mode of the cluster or of a database
+	//
+	// error is always nil
+	LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest) (*commonpb.Status, error)
+	// GetSegmentLifecycle merges the persistence info from DataCoord, the index states and the load state from
+	// QueryCoord of the segments of a collection
+	//
+	// error is always nil
+	GetSegmentLifecycle(ctx context.Context, req *proxypb.GetSegmentLifecycleRequest) (*proxypb.GetSegmentLifecycleResponse, error)
+	// CheckIndexConsistency forwards the request to IndexCoord to check the index meta against the segments and
+	// the index files, and to repair the inconsistencies if asked
+	//
+	// error is always nil
+	CheckIndexConsistency(ctx context.Context, req *indexpb.CheckIndexConsistencyRequest) (*indexpb.CheckIndexConsistencyResponse, error)
+	// GetDeleteTombstones reports the deletes of a primary key persisted in the delta logs of the segments of a
+	// collection, and whether they are applied by compactions
+	//
+	// error is always nil
+	GetDeleteTombstones(ctx context.Context, req *proxypb.GetDeleteTombstonesRequest) (*proxypb.GetDeleteTombstonesResponse, error)
+	// GetDdlOperationState forwards the request to RootCoord to get the ddl operations in its journal
+	//
+	// error is always nil
+	GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest) (*proxypb.GetDdlOperationStateResponse, error)
+	// GetIndexStatistics forwards the request to IndexCoord to get the daily build statistics of the indexes
+	//
+	// error is always nil
+	GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
+	// PreImport forwards the request to DataCoord to check the files of an import before it is submitted
+	//
+	// error is always nil
+	PreImport(ctx context.Context, req *datapb.PreImportRequest) (*datapb.PreImportResponse, error)
+	// CordonIndexNode forwards the request to IndexCoord to cordon or uncordon an IndexNode
+	//
+	// error is always nil
+	CordonIndexNode(ctx context.Context, req *indexpb.CordonIndexNodeRequest) (*indexpb.CordonIndexNodeResponse, error)
+	// GetSegmentHistory forwards the request to DataCoord to get the state transitions of a segment
+	//
+	// error is always nil
+	GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest) (*datapb.GetSegmentHistoryResponse, error)
+	// PauseCollectionMaintenance forwards the request to DataCoord to pause the compaction and/or the binlog gc of
+	// a collection
+	//
+	// error is always nil
+	PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest) (*datapb.PauseCollectionMaintenanceResponse, error)
+	// ResumeCollectionMaintenance forwards the request to DataCoord to resume the compaction and/or the binlog gc
+	// of a collection
+	//
+	// error is always nil
+	ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest) (*commonpb.Status, error)
+	// GetCollectionMaintenancePauses forwards the request to DataCoord to list the collections whose compaction
+	// or binlog gc is paused
+	//
+	// error is always nil
+	GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest) (*datapb.GetCollectionMaintenancePausesResponse, error)
+	// ListProxyTasks lists the tasks queued or executing in the task scheduler of the proxy
+	//
+	// error is always nil
+	ListProxyTasks(ctx context.Context, req *proxypb.ListProxyTasksRequest) (*proxypb.ListProxyTasksResponse, error)
+	// DrainProxy stops or resumes accepting new dml and dql requests in the proxy, the tasks in-flight are still
+	// scheduled until they finish
+	//
+	// error is always nil
+	DrainProxy(ctx context.Context, req *proxypb.DrainProxyRequest) (*proxypb.ListProxyTasksResponse, error)
+	// TriggerGC forwards the request to IndexCoord to run the gc passes of a scope right away
+	//
+	// error is always nil
+	TriggerGC(ctx context.Context, req *indexpb.TriggerGCRequest) (*indexpb.TriggerGCResponse, error)
+	// ListIndexTTLs forwards the request to IndexCoord to list the ttl states of the indexes
+	//
+	// error is always nil
+	ListIndexTTLs(ctx context.Context, req *indexpb.ListIndexTTLsRequest) (*indexpb.ListIndexTTLsResponse, error)
+	// SetIndexTTL forwards the request to IndexCoord to set the ttl of an index
+	//
+	// error is always nil
+	SetIndexTTL(ctx context.Context, req *indexpb.SetIndexTTLRequest) (*commonpb.Status, error)
+	// CheckIndexTTL forwards the request to IndexCoord to check the usage of the indexes with ttl right away
+	//
+	// error is always nil
+	CheckIndexTTL(ctx context.Context, req *indexpb.CheckIndexTTLRequest) (*indexpb.CheckIndexTTLResponse, error)
+	// VerifyIndexBuild forwards the request to IndexCoord to rebuild an index recorded in reproducibility mode on an
+	// IndexNode
+	//
+	// error is always nil
+	VerifyIndexBuild(ctx context.Context, req *indexpb.VerifyIndexBuildRequest) (*indexpb.VerifyIndexBuildResponse, error)
+	// GetSegmentAllocHints returns the row distribution of the partitions of a collection over the vchannels and the segment allocation hints in DataCoord
+	//
+	// error is always nil
+	GetSegmentAllocHints(ctx context.Context, req *proxypb.GetSegmentAllocHintsRequest) (*datapb.GetSegmentAllocHintsResponse, error)
+	// GetSegmentHeats forwards the request to DataCoord to get the query heats of the segments
+	//
+	// error is always nil
+	GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest) (*datapb.GetSegmentHeatsResponse, error)
+	// FreezeHandoffs forwards the request to DataCoord to freeze the handoffs and the compaction of a collection or the whole cluster
+	//
+	// error is always nil
+	FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest) (*datapb.FreezeHandoffsResponse, error)
+	// UnfreezeHandoffs forwards the request to DataCoord to close the freeze window of a collection or the whole cluster
+	//
+	// error is always nil
+	UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest) (*commonpb.Status, error)
+	// GetFreezeWindows forwards the request to DataCoord to list the freeze windows
+	//
+	// error is always nil
+	GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest) (*datapb.GetFreezeWindowsResponse, error)
+	// GetDeleteSLA forwards the request to DataCoord to get the delete sla markers
+	//
+	// error is always nil
+	GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest) (*datapb.GetDeleteSLAResponse, error)
+	// DecommissionDataNode forwards the request to DataCoord to decommission a DataNode
+	//
+	// error is always nil
+	DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest) (*datapb.DecommissionResponse, error)
+	// LocatePrimaryKeys forwards the request to DataCoord to locate the segments which may contain the primary keys
+	//
+	// error is always nil
+	LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest) (*datapb.LocatePrimaryKeysResponse, error)
+	// InspectSegmentLocks forwards the request to DataCoord to inspect the segment lock leases
+	//
+	// error is always nil
+	InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest) (*datapb.InspectSegmentLocksResponse, error)
+	// GetLoadProgressDetail returns the load progress of a collection or of its partitions, along with the progress of
+	// the segments being loaded on the QueryNodes.
+	//
+	// error is always nil
+	GetLoadProgressDetail(ctx context.Context, req *proxypb.GetLoadProgressDetailRequest) (*proxypb.GetLoadProgressDetailResponse, error)
+	// CompactSegments resolves the collection and forwards the request to DataCoord to compact the given segments
+	//
+	// error is always nil
+	CompactSegments(ctx context.Context, req *proxypb.CompactSegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
+	// GetSearchCalibration returns the latency calibration curves of the search params of a collection in this proxy
+	//
+	// error is always nil
+	GetSearchCalibration(ctx context.Context, req *proxypb.GetSearchCalibrationRequest) (*proxypb.GetSearchCalibrationResponse, error)
+	// SetSearchRecalls sets the recalls of the search param values of a field measured by an offline calibration in
+	// this proxy
+	//
+	// error is always nil
+	SetSearchRecalls(ctx context.Context, req *proxypb.SetSearchRecallsRequest) (*commonpb.Status, error)
+	// GetHandoffGate returns the handoff gating states of the flushed segments of a collection in DataCoord
+	//
+	// error is always nil
+	GetHandoffGate(ctx context.Context, req *proxypb.GetHandoffGateRequest) (*datapb.GetHandoffGateResponse, error)
+	// GetSegmentAnomalies forwards the request to DataCoord to get the segment reports of the abnormal collections
+	//
+	// error is always nil
+	GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest) (*datapb.GetSegmentAnomaliesResponse, error)
+	// MergeTinySegments resolves the collection and forwards the request to DataCoord to merge its small segments
+	//
+	// error is always nil
+	MergeTinySegments(ctx context.Context, req *proxypb.MergeTinySegmentsRequest) (*milvuspb.ManualCompactionResponse, error)
 }
 
 // QueryNode is the interface `querynode` package implements
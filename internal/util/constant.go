@@ -34,11 +34,15 @@ const (
 	FlushedSegmentPrefix = "flushed-segment"
 	// HandoffSegmentPrefix TODO @cai.zhang: remove this
 	HandoffSegmentPrefix = "querycoord-handoff"
+	// HandoffRecordPrefix is the prefix of the versions of the handoff events written by IndexCoord
+	HandoffRecordPrefix = "indexcoord-handoff-record"
 	// SegmentReferPrefix TODO @cai.zhang: remove this
 	SegmentReferPrefix = "segmentRefer"
 
 	SegmentIndexPrefix = "segment-index"
 	FieldIndexPrefix   = "field-index"
+	// IndexVersionPrefix must not share a prefix with SegmentIndexPrefix, or it's listed as segment index meta
+	IndexVersionPrefix = "index-version"
 
 	HeaderAuthorize = "authorization"
 	// HeaderSourceID identify requests from Milvus members and client requests
@@ -51,6 +55,8 @@ const (
 	DefaultTenant       = ""
 	RoleAdmin           = "admin"
 	RolePublic          = "public"
+	// DefaultDBName is the database of the requests without a db name
+	DefaultDBName = "default"
 
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
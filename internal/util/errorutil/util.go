@@ -38,6 +38,16 @@ func UnhealthyStatus(code commonpb.StateCode) *commonpb.Status {
 	}
 }
 
+// ErrResultSizeExceeded is returned when a query result is larger than proxy.maxResultSize or queryNode.maxResultSize.
+var ErrResultSizeExceeded = errors.New("query result size exceeded")
+
+// WrapErrResultSizeExceeded wraps ErrResultSizeExceeded with the max size and the rows merged before it, the rows are
+// suggested as the limit so the client can fetch the result page by page.
+func WrapErrResultSizeExceeded(maxSize int64, rows int64) error {
+	return fmt.Errorf("%w: result exceeds %d bytes after %d rows, paginate the query with limit <= %d and increasing offset",
+		ErrResultSizeExceeded, maxSize, rows, rows)
+}
+
 func UnhealthyError() error {
 	return errors.New("unhealthy node")
 }
@@ -63,5 +73,18 @@ func UnHealthReasonWithComponentStatesOrErr(role string, nodeID typeutil.UniqueI
 		return false, UnHealthReason(role, nodeID, fmt.Sprintf("node is unhealthy, state code: %d", cs.GetState().GetStateCode()))
 	}
 
+	// a node serving with an abnormal subcomponent is degraded
+	for _, sub := range cs.GetSubcomponentStates() {
+		if sub.GetStateCode() != commonpb.StateCode_Healthy {
+			reason := fmt.Sprintf("subcomponent %s is unhealthy, state code: %d", sub.GetRole(), sub.GetStateCode())
+			for _, kv := range sub.GetExtraInfo() {
+				if kv.GetKey() == "reason" {
+					reason += ", reason: " + kv.GetValue()
+				}
+			}
+			return false, UnHealthReason(role, nodeID, reason)
+		}
+	}
+
 	return true, ""
 }
@@ -4,7 +4,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
 
@@ -26,3 +29,31 @@ func TestErrorList_Error_Limit(t *testing.T) {
 	}
 	log.Debug("all errors are", zap.Error(el))
 }
+
+func TestUnHealthReasonWithComponentStatesOrErr(t *testing.T) {
+	healthy, _ := UnHealthReasonWithComponentStatesOrErr("datanode", 1, nil, errors.New("error occur"))
+	assert.False(t, healthy)
+
+	states := &milvuspb.ComponentStates{
+		State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		SubcomponentStates: []*milvuspb.ComponentInfo{
+			{Role: "ClockSkew", StateCode: commonpb.StateCode_Healthy},
+		},
+	}
+	healthy, _ = UnHealthReasonWithComponentStatesOrErr("datanode", 1, states, nil)
+	assert.True(t, healthy)
+
+	states.SubcomponentStates[0].StateCode = commonpb.StateCode_Abnormal
+	states.SubcomponentStates[0].ExtraInfo = []*commonpb.KeyValuePair{{Key: "reason", Value: "clock skews"}}
+	healthy, reason := UnHealthReasonWithComponentStatesOrErr("datanode", 1, states, nil)
+	assert.False(t, healthy)
+	assert.Contains(t, reason, "ClockSkew")
+	assert.Contains(t, reason, "clock skews")
+}
+
+func TestWrapErrResultSizeExceeded(t *testing.T) {
+	err := WrapErrResultSizeExceeded(1024, 10)
+	assert.ErrorIs(t, err, ErrResultSizeExceeded)
+	assert.Contains(t, err.Error(), "limit <= 10")
+}
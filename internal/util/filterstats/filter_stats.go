@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterstats
+
+import (
+	"encoding/json"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// ReasonPrefix prefixes the statistics appended to the reason of the status returned to the client,
+// the search and query results of milvus.proto have no field for them.
+const ReasonPrefix = "filter stats: "
+
+// Merge adds the statistics of src to dst and returns dst, a new one if dst is nil.
+// It returns nil if both of them are nil.
+func Merge(dst, src *internalpb.FilterStats) *internalpb.FilterStats {
+	if src == nil {
+		return dst
+	}
+	if dst == nil {
+		dst = &internalpb.FilterStats{}
+	}
+	dst.SegmentsScanned += src.GetSegmentsScanned()
+	dst.SegmentsPruned += src.GetSegmentsPruned()
+	dst.RowsScanned += src.GetRowsScanned()
+	dst.RowsPassed += src.GetRowsPassed()
+	dst.IndexSegments += src.GetIndexSegments()
+	dst.BruteForceSegments += src.GetBruteForceSegments()
+	return dst
+}
+
+// FillInStatus appends the statistics in json to the reason of the status.
+func FillInStatus(status *commonpb.Status, stats *internalpb.FilterStats) {
+	if status == nil || stats == nil {
+		return
+	}
+	bs, err := json.Marshal(stats)
+	if err != nil {
+		return
+	}
+	if status.Reason != "" {
+		status.Reason += "; "
+	}
+	status.Reason += ReasonPrefix + string(bs)
+}
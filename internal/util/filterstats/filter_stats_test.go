@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestMerge(t *testing.T) {
+	assert.Nil(t, Merge(nil, nil))
+
+	stats := &internalpb.FilterStats{SegmentsScanned: 2, SegmentsPruned: 1, RowsScanned: 300, IndexSegments: 1, BruteForceSegments: 1}
+	merged := Merge(nil, stats)
+	assert.Equal(t, stats, merged)
+	assert.NotSame(t, stats, merged)
+
+	merged = Merge(merged, &internalpb.FilterStats{SegmentsScanned: 1, RowsScanned: 10, RowsPassed: 5, BruteForceSegments: 1})
+	merged = Merge(merged, nil)
+	assert.Equal(t, &internalpb.FilterStats{
+		SegmentsScanned:    3,
+		SegmentsPruned:     1,
+		RowsScanned:        310,
+		RowsPassed:         5,
+		IndexSegments:      1,
+		BruteForceSegments: 2,
+	}, merged)
+}
+
+func TestFillInStatus(t *testing.T) {
+	FillInStatus(nil, &internalpb.FilterStats{})
+
+	status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	FillInStatus(status, nil)
+	assert.Empty(t, status.GetReason())
+
+	FillInStatus(status, &internalpb.FilterStats{SegmentsScanned: 2, RowsScanned: 300, IndexSegments: 2})
+	assert.Equal(t, ReasonPrefix+`{"segments_scanned":2,"rows_scanned":300,"index_segments":2}`, status.GetReason())
+
+	status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: "search variant: default"}
+	FillInStatus(status, &internalpb.FilterStats{SegmentsScanned: 1})
+	assert.Equal(t, "search variant: default; "+ReasonPrefix+`{"segments_scanned":1}`, status.GetReason())
+}
@@ -30,18 +30,50 @@ type TimeTickedFlowGraph struct {
 	stopOnce  sync.Once
 	startOnce sync.Once
 	closeWg   *sync.WaitGroup
+	observer  OperateObserver
 }
 
 // AddNode add Node into flowgraph
 func (fg *TimeTickedFlowGraph) AddNode(node Node) {
 	nodeCtx := nodeCtx{
-		node:    node,
-		closeCh: make(chan struct{}),
-		closeWg: fg.closeWg,
+		node:     node,
+		closeCh:  make(chan struct{}),
+		closeWg:  fg.closeWg,
+		observer: fg.observer,
 	}
 	fg.nodeCtx[node.Name()] = &nodeCtx
 }
 
+// SetOperateObserver sets the observer notified after each node operates, it shall be called before Start.
+func (fg *TimeTickedFlowGraph) SetOperateObserver(observer OperateObserver) {
+	fg.observer = observer
+	for _, v := range fg.nodeCtx {
+		v.observer = observer
+	}
+}
+
+// NodeStats returns the processing statistics of the nodes in pipeline order.
+func (fg *TimeTickedFlowGraph) NodeStats() []NodeStats {
+	downstreams := make(map[*nodeCtx]struct{}, len(fg.nodeCtx))
+	for _, v := range fg.nodeCtx {
+		if v.downstream != nil {
+			downstreams[v.downstream] = struct{}{}
+		}
+	}
+	var head *nodeCtx
+	for _, v := range fg.nodeCtx {
+		if _, ok := downstreams[v]; !ok {
+			head = v
+			break
+		}
+	}
+	stats := make([]NodeStats, 0, len(fg.nodeCtx))
+	for cur := head; cur != nil && len(stats) < len(fg.nodeCtx); cur = cur.downstream {
+		stats = append(stats, cur.stats())
+	}
+	return stats
+}
+
 // SetEdges set directed edges from in nodes to out nodes
 func (fg *TimeTickedFlowGraph) SetEdges(nodeName string, out []string) error {
 	currentNode, ok := fg.nodeCtx[nodeName]
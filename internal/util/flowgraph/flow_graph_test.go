@@ -214,3 +214,30 @@ func TestTimeTickedFlowGraph_Close(t *testing.T) {
 	defer cancel()
 	fg.Close()
 }
+
+func TestTimeTickedFlowGraph_NodeStats(t *testing.T) {
+	fg, _, _, cancel, err := createExampleFlowGraph()
+	assert.NoError(t, err)
+	defer cancel()
+
+	observed := make(map[string]int)
+	fg.SetOperateObserver(func(nodeName string, latency time.Duration, queueLength int) {
+		observed[nodeName]++
+	})
+	nodeB := fg.nodeCtx["NodeB"]
+	nodeB.recordOperate(10 * time.Millisecond)
+	nodeB.recordOperate(30 * time.Millisecond)
+	assert.Equal(t, 2, observed["NodeB"])
+
+	stats := fg.NodeStats()
+	assert.Equal(t, 3, len(stats))
+	assert.Equal(t, "NodeA", stats[0].Name)
+	assert.Equal(t, "NodeB", stats[1].Name)
+	assert.Equal(t, "NodeC", stats[2].Name)
+	assert.Equal(t, int64(2), stats[1].OperateCount)
+	assert.Equal(t, 30*time.Millisecond, stats[1].LastLatency)
+	assert.Equal(t, 20*time.Millisecond, stats[1].AvgLatency)
+	assert.Equal(t, 30*time.Millisecond, stats[1].MaxLatency)
+	assert.Equal(t, 1024, stats[1].QueueCapacity)
+	assert.Equal(t, 0, stats[0].QueueCapacity)
+}
@@ -44,6 +44,21 @@ type Node interface {
 	Close()
 }
 
+// OperateObserver is notified with the latency of each Operate call and the queue length of the node after it.
+type OperateObserver func(nodeName string, latency time.Duration, queueLength int)
+
+// NodeStats is the processing statistics of a flowgraph node,
+// the latency of an input node includes the time waiting for the message stream.
+type NodeStats struct {
+	Name          string        `json:"name"`
+	QueueLength   int           `json:"queue_length"`
+	QueueCapacity int           `json:"queue_capacity"`
+	OperateCount  int64         `json:"operate_count"`
+	LastLatency   time.Duration `json:"last_latency"`
+	AvgLatency    time.Duration `json:"avg_latency"`
+	MaxLatency    time.Duration `json:"max_latency"`
+}
+
 // BaseNode defines some common node attributes and behavior
 type BaseNode struct {
 	maxQueueLength int32
@@ -60,6 +75,13 @@ type nodeCtx struct {
 	closeWg *sync.WaitGroup
 
 	blockMutex sync.RWMutex
+
+	observer     OperateObserver
+	statsMutex   sync.Mutex
+	operateCount int64
+	totalLatency time.Duration
+	lastLatency  time.Duration
+	maxLatency   time.Duration
 }
 
 // Start invoke Node `Start` method and start a worker goroutine
@@ -124,7 +146,9 @@ func (nodeCtx *nodeCtx) work() {
 			if len(output) == 0 {
 				n := nodeCtx.node
 				nodeCtx.blockMutex.RLock()
+				start := time.Now()
 				output = n.Operate(input)
+				nodeCtx.recordOperate(time.Since(start))
 				nodeCtx.blockMutex.RUnlock()
 			}
 			// the output decide whether the node should be closed.
@@ -149,6 +173,48 @@ func (nodeCtx *nodeCtx) work() {
 	}
 }
 
+func (nodeCtx *nodeCtx) queueLength() int {
+	if nodeCtx.inputChannel == nil {
+		return 0
+	}
+	return len(nodeCtx.inputChannel)
+}
+
+func (nodeCtx *nodeCtx) recordOperate(latency time.Duration) {
+	nodeCtx.statsMutex.Lock()
+	nodeCtx.operateCount++
+	nodeCtx.totalLatency += latency
+	nodeCtx.lastLatency = latency
+	if latency > nodeCtx.maxLatency {
+		nodeCtx.maxLatency = latency
+	}
+	nodeCtx.statsMutex.Unlock()
+
+	if nodeCtx.observer != nil {
+		nodeCtx.observer(nodeCtx.node.Name(), latency, nodeCtx.queueLength())
+	}
+}
+
+func (nodeCtx *nodeCtx) stats() NodeStats {
+	nodeCtx.statsMutex.Lock()
+	defer nodeCtx.statsMutex.Unlock()
+
+	stats := NodeStats{
+		Name:         nodeCtx.node.Name(),
+		QueueLength:  nodeCtx.queueLength(),
+		OperateCount: nodeCtx.operateCount,
+		LastLatency:  nodeCtx.lastLatency,
+		MaxLatency:   nodeCtx.maxLatency,
+	}
+	if nodeCtx.inputChannel != nil {
+		stats.QueueCapacity = cap(nodeCtx.inputChannel)
+	}
+	if nodeCtx.operateCount > 0 {
+		stats.AvgLatency = nodeCtx.totalLatency / time.Duration(nodeCtx.operateCount)
+	}
+	return stats
+}
+
 // Close handles cleanup logic and notify worker to quit
 func (nodeCtx *nodeCtx) Close() {
 	if nodeCtx.node.IsInputNode() {
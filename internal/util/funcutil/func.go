@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -376,6 +377,17 @@ func IsGrpcErr(err error) bool {
 	}
 }
 
+// IsGrpcUnimplemented checks whether err is a grpc status error with code Unimplemented, which is returned by
+// the servers of the older versions not serving the called method.
+func IsGrpcUnimplemented(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if s, ok := grpcStatus.FromError(err); ok {
+			return s.Code() == grpcCodes.Unimplemented
+		}
+	}
+	return false
+}
+
 func IsEmptyString(str string) bool {
 	return strings.TrimSpace(str) == ""
 }
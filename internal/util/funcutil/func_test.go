@@ -503,6 +503,16 @@ func TestIsGrpcErr(t *testing.T) {
 	})
 }
 
+func TestIsGrpcUnimplemented(t *testing.T) {
+	assert.False(t, IsGrpcUnimplemented(nil))
+	assert.False(t, IsGrpcUnimplemented(errors.New("error")))
+	assert.False(t, IsGrpcUnimplemented(grpcStatus.Error(grpcCodes.Unavailable, "test")))
+
+	err := grpcStatus.Error(grpcCodes.Unimplemented, "unknown method RefreshRoleQuota")
+	assert.True(t, IsGrpcUnimplemented(err))
+	assert.True(t, IsGrpcUnimplemented(fmt.Errorf("wrap grpc error %w", err)))
+}
+
 func TestIsEmptyString(t *testing.T) {
 	assert.Equal(t, IsEmptyString(""), true)
 	assert.Equal(t, IsEmptyString(" "), true)
@@ -70,6 +70,16 @@ func GetCPUUsage() float64 {
 	return percents[0]
 }
 
+// GetCPUFlags returns the flags of the features of the first cpu, such as avx2, empty if unknown.
+func GetCPUFlags() []string {
+	infos, err := cpu.Info()
+	if err != nil || len(infos) == 0 {
+		log.Warn("failed to get cpu flags", zap.Error(err))
+		return nil
+	}
+	return infos[0].Flags
+}
+
 // GetMemoryCount returns the memory count in bytes.
 func GetMemoryCount() uint64 {
 	icOnce.Do(func() {
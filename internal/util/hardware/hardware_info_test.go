@@ -30,6 +30,11 @@ func Test_GetCPUUsage(t *testing.T) {
 		zap.Float64("CPUUsage", GetCPUUsage()))
 }
 
+func Test_GetCPUFlags(t *testing.T) {
+	log.Info("TestGetCPUFlags",
+		zap.Strings("CPUFlags", GetCPUFlags()))
+}
+
 func Test_GetMemoryCount(t *testing.T) {
 	log.Info("TestGetMemoryCount",
 		zap.Uint64("MemoryCount", GetMemoryCount()))
@@ -0,0 +1,408 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	// keywords of the invalid csv rows skipped in import task informations
+	CSVSkippedRows = "csv_skipped_rows"
+	CSVRowErrors   = "csv_row_errors"
+
+	// max number of the invalid csv rows kept in the import task informations
+	MaxCSVRowErrorSamples = 10
+)
+
+// csvRowErrors records the invalid rows of the csv files of an import task, the rows are skipped until
+// the number of the invalid rows exceeds maxErrors. It is shared by the parsers of the files.
+type csvRowErrors struct {
+	maxErrors int64
+
+	mu      sync.Mutex
+	count   int64
+	samples []string
+}
+
+func newCSVRowErrors(maxErrors int64) *csvRowErrors {
+	return &csvRowErrors{
+		maxErrors: maxErrors,
+		samples:   make([]string, 0),
+	}
+}
+
+// add records an invalid row, an error is returned if the number of the invalid rows exceeds maxErrors.
+func (e *csvRowErrors) add(filePath string, line int, err error) error {
+	msg := fmt.Sprintf("%s:%d: %s", filePath, line, err.Error())
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.count++
+	if len(e.samples) < MaxCSVRowErrorSamples {
+		e.samples = append(e.samples, msg)
+	}
+	if e.count > e.maxErrors {
+		if e.maxErrors == 0 {
+			return fmt.Errorf("invalid row at %s", msg)
+		}
+		return fmt.Errorf("the number of invalid rows exceeds %s %d, the last one at %s", CSVMaxErrors, e.maxErrors, msg)
+	}
+	log.Warn("CSV parser: skip invalid row", zap.String("filePath", filePath), zap.Int("line", line), zap.Error(err))
+	return nil
+}
+
+// skipped returns the number of the invalid rows skipped and the first ones.
+func (e *csvRowErrors) skipped() (int64, []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.count, append([]string{}, e.samples...)
+}
+
+// csvColumn is a column of the csv file mapped to a field by the header.
+type csvColumn struct {
+	fieldID storage.FieldID
+	convert func(value string) (interface{}, error)
+}
+
+// CSVParser parses the row-based csv files. The header row maps the columns to the fields by name, and each value
+// is parsed by the type of its field into the value of the JSON row format, so the rows are consumed by JSONRowConsumer
+// as the rows of the JSON files. A vector is a JSON array in a column.
+type CSVParser struct {
+	ctx       context.Context // for canceling parse process
+	bufSize   int64           // max rows in a buffer
+	options   CSVOptions      // delimiter, quoting and max errors
+	fields    map[string]*schemapb.FieldSchema
+	rowErrors *csvRowErrors // invalid rows skipped
+	filePath  string        // for the row errors
+	columns   []*csvColumn  // columns by the header
+}
+
+// NewCSVParser helper function to create a CSVParser, the zero options are defaults
+func NewCSVParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, options CSVOptions,
+	rowErrors *csvRowErrors, filePath string) *CSVParser {
+	fields := make(map[string]*schemapb.FieldSchema)
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		// RowIDField and TimeStampField is internal field, no need to parse
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		// if primary key field is auto-gernerated, no need to parse
+		if schema.GetAutoID() {
+			continue
+		}
+		fields[schema.GetName()] = schema
+	}
+	if options.Delimiter == 0 {
+		options.Delimiter = DefaultCSVOptions().Delimiter
+	}
+	if rowErrors == nil {
+		rowErrors = newCSVRowErrors(options.MaxErrors)
+	}
+
+	return &CSVParser{
+		ctx:       ctx,
+		bufSize:   estimateBufSize(collectionSchema),
+		options:   options,
+		fields:    fields,
+		rowErrors: rowErrors,
+		filePath:  filePath,
+	}
+}
+
+func (p *CSVParser) newReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = p.options.Delimiter
+	reader.LazyQuotes = p.options.LazyQuotes
+	// the rows with a wrong number of values are reported as invalid rows
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	return reader
+}
+
+// parseHeader maps the columns to the fields by the header, all the fields to parse must be given once.
+func (p *CSVParser) parseHeader(header []string) error {
+	p.columns = make([]*csvColumn, 0, len(header))
+	found := make(map[string]struct{}, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		// the utf-8 byte order mark of the file
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		schema, ok := p.fields[name]
+		if !ok {
+			log.Error("CSV parser: the field is not defined in collection schema", zap.String("fieldName", name))
+			return fmt.Errorf("the field '%s' of the csv header is not defined in collection schema", name)
+		}
+		if _, ok := found[name]; ok {
+			log.Error("CSV parser: duplicated field in the header", zap.String("fieldName", name))
+			return fmt.Errorf("the field '%s' is duplicated in the csv header", name)
+		}
+		found[name] = struct{}{}
+		convert, err := csvConvertFunc(schema)
+		if err != nil {
+			return err
+		}
+		p.columns = append(p.columns, &csvColumn{fieldID: schema.GetFieldID(), convert: convert})
+	}
+	for name := range p.fields {
+		if _, ok := found[name]; !ok {
+			log.Error("CSV parser: a field is missed in the header", zap.String("fieldName", name))
+			return fmt.Errorf("the field '%s' is missed in the csv header", name)
+		}
+	}
+	return nil
+}
+
+// parseRow converts the values of a row by the types of the fields of the columns.
+func (p *CSVParser) parseRow(record []string) (map[storage.FieldID]interface{}, error) {
+	if len(record) != len(p.columns) {
+		return nil, fmt.Errorf("the row has %d values but the header has %d fields", len(record), len(p.columns))
+	}
+	row := make(map[storage.FieldID]interface{}, len(p.columns))
+	for i, column := range p.columns {
+		value, err := column.convert(record[i])
+		if err != nil {
+			return nil, err
+		}
+		row[column.fieldID] = value
+	}
+	return row, nil
+}
+
+// ParseRows parses the csv rows and sends them to the handler, the invalid rows are skipped and recorded until
+// the number of the invalid rows of the import task exceeds the max errors.
+func (p *CSVParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
+	if handler == nil {
+		log.Error("CSV parse handler is nil")
+		return errors.New("CSV parse handler is nil")
+	}
+
+	reader := p.newReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		log.Error("CSV parser: the csv file has no header")
+		return errors.New("the csv file has no header")
+	}
+	if err != nil {
+		log.Error("CSV parser: failed to read the csv header", zap.Error(err))
+		return fmt.Errorf("failed to read the csv header, error: %w", err)
+	}
+	if err := p.parseHeader(header); err != nil {
+		return err
+	}
+
+	isEmpty := true
+	buf := make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var row map[storage.FieldID]interface{}
+		if err == nil {
+			row, err = p.parseRow(record)
+		}
+		if err != nil {
+			// a malformed row is reported by the csv reader with its line, the reader goes on with the next row
+			var line int
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line = parseErr.Line
+			} else {
+				line, _ = reader.FieldPos(0)
+			}
+			if err := p.rowErrors.add(p.filePath, line, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		buf = append(buf, row)
+		if len(buf) >= int(p.bufSize) {
+			isEmpty = false
+			if err = handler.Handle(buf); err != nil {
+				log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+				return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+			}
+			buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+
+			// outside context might be canceled(service stop, or future enhancement for canceling import task)
+			if isCanceled(p.ctx) {
+				log.Error("CSV parser: import task was canceled")
+				return errors.New("import task was canceled")
+			}
+		}
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		isEmpty = false
+		if err = handler.Handle(buf); err != nil {
+			log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+			return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+		}
+	}
+
+	if isCanceled(p.ctx) {
+		log.Error("CSV parser: import task was canceled")
+		return errors.New("import task was canceled")
+	}
+	if isEmpty {
+		log.Error("CSV parser: row count is 0")
+		return errors.New("row count is 0")
+	}
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
+
+// csvConvertFunc returns the function to parse a csv value of the field into the value of the JSON row format,
+// the value is fully validated so that the JSONRowConsumer never fails to convert it.
+func csvConvertFunc(schema *schemapb.FieldSchema) (func(value string) (interface{}, error), error) {
+	name := schema.GetName()
+	parseInt := func(bitSize int) func(value string) (interface{}, error) {
+		return func(value string) (interface{}, error) {
+			value = strings.TrimSpace(value)
+			if _, err := strconv.ParseInt(value, 0, bitSize); err != nil {
+				return nil, fmt.Errorf("failed to parse value '%s' for int%d field '%s', error: %w", value, bitSize, name, err)
+			}
+			return json.Number(value), nil
+		}
+	}
+	parseVector := func(value string) ([]interface{}, error) {
+		dec := json.NewDecoder(strings.NewReader(value))
+		dec.UseNumber()
+		var arr []interface{}
+		if err := dec.Decode(&arr); err != nil {
+			return nil, fmt.Errorf("'%s' is not a JSON array for vector field '%s', error: %w", value, name, err)
+		}
+		return arr, nil
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		return func(value string) (interface{}, error) {
+			b, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("illegal value '%s' for bool type field '%s'", value, name)
+			}
+			return b, nil
+		}, nil
+	case schemapb.DataType_Int8:
+		return parseInt(8), nil
+	case schemapb.DataType_Int16:
+		return parseInt(16), nil
+	case schemapb.DataType_Int32:
+		return parseInt(32), nil
+	case schemapb.DataType_Int64:
+		if schema.GetIsPrimaryKey() {
+			// the primary key is parsed in decimal by the consumer
+			return func(value string) (interface{}, error) {
+				value = strings.TrimSpace(value)
+				if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+					return nil, fmt.Errorf("failed to parse primary key '%s' of field '%s', error: %w", value, name, err)
+				}
+				return json.Number(value), nil
+			}, nil
+		}
+		return parseInt(64), nil
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		bitSize := 64
+		if schema.GetDataType() == schemapb.DataType_Float {
+			bitSize = 32
+		}
+		return func(value string) (interface{}, error) {
+			value = strings.TrimSpace(value)
+			if _, err := parseFloat(value, bitSize, name); err != nil {
+				return nil, err
+			}
+			return json.Number(value), nil
+		}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return func(value string) (interface{}, error) {
+			return value, nil
+		}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		return func(value string) (interface{}, error) {
+			arr, err := parseVector(value)
+			if err != nil {
+				return nil, err
+			}
+			if len(arr) != dim {
+				return nil, fmt.Errorf("array size %d doesn't equal to vector dimension %d of field '%s'", len(arr), dim, name)
+			}
+			for _, v := range arr {
+				num, ok := v.(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("illegal value '%v' for float vector field '%s'", v, name)
+				}
+				if _, err := parseFloat(string(num), 32, name); err != nil {
+					return nil, err
+				}
+			}
+			return arr, nil
+		}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		return func(value string) (interface{}, error) {
+			arr, err := parseVector(value)
+			if err != nil {
+				return nil, err
+			}
+			// each uint8 value represents 8 dimensions
+			if len(arr)*8 != dim {
+				return nil, fmt.Errorf("bit size %d doesn't equal to vector dimension %d of field '%s'", len(arr)*8, dim, name)
+			}
+			for _, v := range arr {
+				num, ok := v.(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("illegal value '%v' for binary vector field '%s'", v, name)
+				}
+				if _, err := strconv.ParseUint(string(num), 0, 8); err != nil {
+					return nil, fmt.Errorf("failed to parse value '%v' for binary vector field '%s', error: %w", num, name, err)
+				}
+			}
+			return arr, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupport data type: %s", getTypeName(schema.GetDataType()))
+	}
+}
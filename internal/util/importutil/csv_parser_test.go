@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const sampleCSVHeader = "FieldBool,FieldInt8,FieldInt16,FieldInt32,FieldInt64,FieldFloat,FieldDouble,FieldString,FieldBinaryVector,FieldFloatVector\n"
+
+func Test_CSVParserParseRows(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+
+	content := sampleCSVHeader +
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n" +
+		"0,-11,0x10,1002,10002,3.15,2.56,\"hello, \"\"world\"\"\",\"[253, 0]\",\"[2.1, 2.2, 2.3, 2.4]\"\n"
+	parser := NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv")
+	consumer := &mockJSONRowConsumer{}
+	err := parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(consumer.rows))
+
+	row := consumer.rows[1]
+	assert.Equal(t, false, row[102])
+	assert.Equal(t, "-11", string(row[103].(json.Number)))
+	assert.Equal(t, "0x10", string(row[104].(json.Number)))
+	assert.Equal(t, "10002", string(row[106].(json.Number)))
+	assert.Equal(t, "hello, \"world\"", row[109])
+	assert.Equal(t, 2, len(row[110].([]interface{})))
+	assert.Equal(t, 4, len(row[111].([]interface{})))
+
+	// the rows are consumed by the JSONRowConsumer
+	validators := make(map[storage.FieldID]*Validator)
+	err = initValidators(schema, validators)
+	assert.NoError(t, err)
+	fieldsData := initSegmentData(schema)
+	for fieldID, value := range row {
+		err = validators[fieldID].convertFunc(value, fieldsData[fieldID])
+		assert.NoError(t, err)
+	}
+
+	// tab delimiter, the columns in any order
+	content = "FieldFloatVector\tFieldBinaryVector\tFieldString\tFieldDouble\tFieldFloat\tFieldInt64\tFieldInt32\tFieldInt16\tFieldInt8\tFieldBool\n" +
+		"[1.1, 1.2, 1.3, 1.4]\t[254, 0]\thello world\t1.56\t3.14\t10001\t1001\t101\t10\ttrue\n"
+	parser = NewCSVParser(ctx, schema, CSVOptions{Delimiter: '\t'}, nil, "a.csv")
+	consumer = &mockJSONRowConsumer{}
+	err = parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(consumer.rows))
+	assert.Equal(t, "hello world", consumer.rows[0][109])
+
+	// lazy quotes
+	content = sampleCSVHeader +
+		"true,10,101,1001,10001,3.14,1.56,say \"hi\",\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n"
+	parser = NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv")
+	err = parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+	assert.Error(t, err)
+	parser = NewCSVParser(ctx, schema, CSVOptions{LazyQuotes: true}, nil, "a.csv")
+	consumer = &mockJSONRowConsumer{}
+	err = parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, "say \"hi\"", consumer.rows[0][109])
+
+	// handler is nil
+	err = parser.ParseRows(strings.NewReader(content), nil)
+	assert.Error(t, err)
+
+	// handler failed
+	err = NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv").ParseRows(strings.NewReader(sampleCSVHeader+
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n"),
+		&mockJSONRowConsumer{handleErr: errors.New("error")})
+	assert.Error(t, err)
+
+	// no header, no row
+	err = NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv").ParseRows(strings.NewReader(""), &mockJSONRowConsumer{})
+	assert.Error(t, err)
+	err = NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv").ParseRows(strings.NewReader(sampleCSVHeader), &mockJSONRowConsumer{})
+	assert.Error(t, err)
+
+	// canceled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = NewCSVParser(cancelCtx, schema, CSVOptions{}, nil, "a.csv").ParseRows(strings.NewReader(sampleCSVHeader+
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n"), &mockJSONRowConsumer{})
+	assert.Error(t, err)
+}
+
+func Test_CSVParserHeader(t *testing.T) {
+	ctx := context.Background()
+	parser := NewCSVParser(ctx, strKeySchema(), CSVOptions{}, nil, "a.csv")
+
+	// the utf-8 byte order mark and spaces are trimmed
+	err := parser.parseHeader([]string{"\ufeffUID", " FieldInt32", "FieldFloat ", "FieldString", "FieldBool", "FieldFloatVector"})
+	assert.NoError(t, err)
+
+	// unknown field
+	err = parser.parseHeader([]string{"UID", "FieldInt32", "FieldFloat", "FieldString", "FieldBool", "FieldFloatVector", "dummy"})
+	assert.Error(t, err)
+
+	// duplicated field
+	err = parser.parseHeader([]string{"UID", "UID", "FieldInt32", "FieldFloat", "FieldString", "FieldBool", "FieldFloatVector"})
+	assert.Error(t, err)
+
+	// missed field
+	err = parser.parseHeader([]string{"UID", "FieldInt32", "FieldFloat", "FieldString", "FieldBool"})
+	assert.Error(t, err)
+
+	// the auto-id primary key is not expected
+	schema := sampleSchema()
+	schema.Fields[4].AutoID = true
+	parser = NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv")
+	err = parser.parseHeader(strings.Split(strings.TrimSpace(sampleCSVHeader), ","))
+	assert.Error(t, err)
+}
+
+func Test_CSVParserRowErrors(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+
+	content := sampleCSVHeader +
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n" +
+		"maybe,10,101,1001,10002,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n" +
+		"true,10,101,1001,10003,3.14,1.56,hello world,\"[254, 0]\"\n" +
+		"true,10,101,1001,10004,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n"
+
+	// fail at the first invalid row by default
+	parser := NewCSVParser(ctx, schema, CSVOptions{}, nil, "a.csv")
+	err := parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "a.csv:3")
+
+	// the invalid rows are shared by the files
+	rowErrors := newCSVRowErrors(3)
+	consumer := &mockJSONRowConsumer{}
+	err = NewCSVParser(ctx, schema, CSVOptions{MaxErrors: 3}, rowErrors, "a.csv").ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(consumer.rows))
+	err = NewCSVParser(ctx, schema, CSVOptions{MaxErrors: 3}, rowErrors, "b.csv").ParseRows(strings.NewReader(content), consumer)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "b.csv:4")
+	count, samples := rowErrors.skipped()
+	assert.Equal(t, int64(4), count)
+	assert.Equal(t, []string{"a.csv:3", "a.csv:4", "b.csv:3", "b.csv:4"}, []string{
+		samples[0][:7], samples[1][:7], samples[2][:7], samples[3][:7]})
+
+	// only the first samples are kept
+	rowErrors = newCSVRowErrors(100)
+	for i := 0; i < MaxCSVRowErrorSamples+5; i++ {
+		assert.NoError(t, rowErrors.add("a.csv", i, errors.New("error")))
+	}
+	count, samples = rowErrors.skipped()
+	assert.Equal(t, int64(MaxCSVRowErrorSamples+5), count)
+	assert.Equal(t, MaxCSVRowErrorSamples, len(samples))
+}
+
+func Test_CSVConvertFunc(t *testing.T) {
+	check := func(schema *schemapb.FieldSchema, value string, ok bool) {
+		convert, err := csvConvertFunc(schema)
+		assert.NoError(t, err)
+		_, err = convert(value)
+		if ok {
+			assert.NoError(t, err, value)
+		} else {
+			assert.Error(t, err, value)
+		}
+	}
+
+	boolSchema := &schemapb.FieldSchema{Name: "b", DataType: schemapb.DataType_Bool}
+	check(boolSchema, "True", true)
+	check(boolSchema, "1", true)
+	check(boolSchema, "yes", false)
+
+	int8Schema := &schemapb.FieldSchema{Name: "i", DataType: schemapb.DataType_Int8}
+	check(int8Schema, " 127 ", true)
+	check(int8Schema, "128", false)
+	check(int8Schema, "1.0", false)
+	check(&schemapb.FieldSchema{Name: "i", DataType: schemapb.DataType_Int16}, "32768", false)
+	check(&schemapb.FieldSchema{Name: "i", DataType: schemapb.DataType_Int32}, "2147483648", false)
+	check(&schemapb.FieldSchema{Name: "i", DataType: schemapb.DataType_Int64}, "0x7fffffffffffffff", true)
+	check(&schemapb.FieldSchema{Name: "i", DataType: schemapb.DataType_Int64}, "", false)
+
+	// the primary key is decimal
+	pkSchema := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	check(pkSchema, "100", true)
+	check(pkSchema, "0x10", false)
+
+	floatSchema := &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Float}
+	check(floatSchema, "3.14", true)
+	check(floatSchema, "1e100", false)
+	check(floatSchema, "NaN", false)
+	check(&schemapb.FieldSchema{Name: "d", DataType: schemapb.DataType_Double}, "1e100", true)
+
+	check(&schemapb.FieldSchema{Name: "s", DataType: schemapb.DataType_VarChar}, "", true)
+
+	floatVectorSchema := &schemapb.FieldSchema{Name: "fv", DataType: schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}}
+	check(floatVectorSchema, "[1, 2.5]", true)
+	check(floatVectorSchema, "[1]", false)
+	check(floatVectorSchema, "[1, \"a\"]", false)
+	check(floatVectorSchema, "1, 2", false)
+	check(floatVectorSchema, "[1, 1e100]", false)
+
+	binaryVectorSchema := &schemapb.FieldSchema{Name: "bv", DataType: schemapb.DataType_BinaryVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}}
+	check(binaryVectorSchema, "[255, 0]", true)
+	check(binaryVectorSchema, "[256, 0]", false)
+	check(binaryVectorSchema, "[255]", false)
+	check(binaryVectorSchema, "[true, 0]", false)
+
+	// dimension is not defined
+	_, err := csvConvertFunc(&schemapb.FieldSchema{Name: "fv", DataType: schemapb.DataType_FloatVector})
+	assert.Error(t, err)
+	_, err = csvConvertFunc(&schemapb.FieldSchema{Name: "bv", DataType: schemapb.DataType_BinaryVector})
+	assert.Error(t, err)
+	_, err = csvConvertFunc(&schemapb.FieldSchema{Name: "n", DataType: schemapb.DataType_None})
+	assert.Error(t, err)
+}
@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	// keyword of the number of the rows skipped by the delete list in import task informations
+	DeleteListSkippedRows = "delete_list_skipped_rows"
+
+	// max length of a line of the delete list file
+	maxDeleteListLineSize = 1024 * 1024
+)
+
+// deleteList is the primary keys of the entities deleted after a backup, the rows of the keys are filtered
+// out before the binlogs are created, so that the restored segments don't need to be deleted again.
+type deleteList struct {
+	pkFieldID  storage.FieldID
+	int64PKs   map[int64]struct{}
+	varCharPKs map[string]struct{}
+	skipped    int64 // number of the rows filtered out, the blocks are flushed one by one so no lock is needed
+}
+
+// loadDeleteList reads the delete list file, each non-empty line of the file is a primary key
+func loadDeleteList(ctx context.Context, cm storage.ChunkManager, collectionSchema *schemapb.CollectionSchema,
+	filePath string) (*deleteList, error) {
+	var pkSchema *schemapb.FieldSchema
+	for _, schema := range collectionSchema.GetFields() {
+		if schema.GetIsPrimaryKey() {
+			pkSchema = schema
+			break
+		}
+	}
+	if pkSchema == nil {
+		return nil, fmt.Errorf("the collection has no primary key to apply the delete list")
+	}
+
+	list := &deleteList{pkFieldID: pkSchema.GetFieldID()}
+	switch pkSchema.GetDataType() {
+	case schemapb.DataType_Int64:
+		list.int64PKs = make(map[int64]struct{})
+	case schemapb.DataType_VarChar:
+		list.varCharPKs = make(map[string]struct{})
+	default:
+		return nil, fmt.Errorf("the primary key type %s is not supported by the delete list", pkSchema.GetDataType().String())
+	}
+
+	size, err := cm.Size(ctx, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the size of the delete list '%s', error: %w", filePath, err)
+	}
+	if size > MaxFileSize {
+		return nil, fmt.Errorf("the delete list '%s' size exceeds the maximum size: %d bytes", filePath, MaxFileSize)
+	}
+	reader, err := cm.Reader(ctx, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the delete list '%s', error: %w", filePath, err)
+	}
+	defer reader.Close()
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxDeleteListLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		value := strings.TrimSpace(scanner.Text())
+		if value == "" {
+			continue
+		}
+		if list.int64PKs != nil {
+			pk, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid int64 primary key '%s' at line %d of the delete list '%s'", value, line, filePath)
+			}
+			list.int64PKs[pk] = struct{}{}
+		} else {
+			list.varCharPKs[value] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the delete list '%s' after line %d, error: %w", filePath, line, err)
+	}
+
+	log.Info("import wrapper: delete list loaded", zap.String("filePath", filePath), zap.Int("count", list.len()))
+	return list, nil
+}
+
+func (d *deleteList) len() int {
+	return len(d.int64PKs) + len(d.varCharPKs)
+}
+
+// filter returns the fields data without the rows of the deleted primary keys, the input is returned
+// if no row is deleted
+func (d *deleteList) filter(fields map[storage.FieldID]storage.FieldData) (map[storage.FieldID]storage.FieldData, error) {
+	pkData, ok := fields[d.pkFieldID]
+	if !ok {
+		return nil, fmt.Errorf("the primary key field %d is not in the fields data to apply the delete list", d.pkFieldID)
+	}
+
+	rowNum := pkData.RowNum()
+	keep := make([]bool, rowNum)
+	kept := 0
+	switch pks := pkData.(type) {
+	case *storage.Int64FieldData:
+		for i, pk := range pks.Data {
+			_, deleted := d.int64PKs[pk]
+			keep[i] = !deleted
+		}
+	case *storage.StringFieldData:
+		for i, pk := range pks.Data {
+			_, deleted := d.varCharPKs[pk]
+			keep[i] = !deleted
+		}
+	default:
+		return nil, fmt.Errorf("the primary key field %d data type %T is not supported by the delete list", d.pkFieldID, pkData)
+	}
+	for _, k := range keep {
+		if k {
+			kept++
+		}
+	}
+	if kept == rowNum {
+		return fields, nil
+	}
+
+	filtered := make(map[storage.FieldID]storage.FieldData, len(fields))
+	for fieldID, data := range fields {
+		if data.RowNum() != rowNum {
+			return nil, fmt.Errorf("the field %d row count %d doesn't equal to the primary key row count %d", fieldID, data.RowNum(), rowNum)
+		}
+		newData, err := keepFieldDataRows(data, keep, kept)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply the delete list to the field %d, error: %w", fieldID, err)
+		}
+		filtered[fieldID] = newData
+	}
+	d.skipped += int64(rowNum - kept)
+	return filtered, nil
+}
+
+// keepFieldDataRows returns a copy of the field data with the rows marked in keep, kept is the number of them
+func keepFieldDataRows(data storage.FieldData, keep []bool, kept int) (storage.FieldData, error) {
+	numRows := []int64{int64(kept)}
+	switch d := data.(type) {
+	case *storage.BoolFieldData:
+		return &storage.BoolFieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.Int8FieldData:
+		return &storage.Int8FieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.Int16FieldData:
+		return &storage.Int16FieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.Int32FieldData:
+		return &storage.Int32FieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.Int64FieldData:
+		return &storage.Int64FieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.FloatFieldData:
+		return &storage.FloatFieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.DoubleFieldData:
+		return &storage.DoubleFieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.StringFieldData:
+		return &storage.StringFieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, 1)}, nil
+	case *storage.BinaryVectorFieldData:
+		return &storage.BinaryVectorFieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, d.Dim/8), Dim: d.Dim}, nil
+	case *storage.FloatVectorFieldData:
+		return &storage.FloatVectorFieldData{NumRows: numRows, Data: keepRows(d.Data, keep, kept, d.Dim), Dim: d.Dim}, nil
+	default:
+		return nil, fmt.Errorf("unsupported field data type %T", data)
+	}
+}
+
+// keepRows returns the rows marked in keep, each row is width elements of data
+func keepRows[T any](data []T, keep []bool, kept int, width int) []T {
+	ret := make([]T, 0, kept*width)
+	for i, k := range keep {
+		if k {
+			ret = append(ret, data[i*width:(i+1)*width]...)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func Test_LoadDeleteList(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	filePath := TempFilesPath + "deleted.txt"
+	err = cm.Write(ctx, filePath, []byte("10001\n\n 10003 \r\n10005"))
+	assert.NoError(t, err)
+
+	list, err := loadDeleteList(ctx, cm, sampleSchema(), filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, list.len())
+	assert.Equal(t, int64(106), list.pkFieldID)
+	assert.Contains(t, list.int64PKs, int64(10003))
+
+	// varchar primary key
+	list, err = loadDeleteList(ctx, cm, strKeySchema(), filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, list.len())
+	assert.Contains(t, list.varCharPKs, "10003")
+
+	// file not found
+	_, err = loadDeleteList(ctx, cm, sampleSchema(), TempFilesPath+"dummy.txt")
+	assert.Error(t, err)
+
+	// invalid int64 primary key
+	err = cm.Write(ctx, filePath, []byte("10001\nabc\n"))
+	assert.NoError(t, err)
+	_, err = loadDeleteList(ctx, cm, sampleSchema(), filePath)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+
+	// no primary key
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 101, Name: "a", DataType: schemapb.DataType_Int64}},
+	}
+	_, err = loadDeleteList(ctx, cm, schema, filePath)
+	assert.Error(t, err)
+}
+
+func Test_DeleteListFilter(t *testing.T) {
+	list := &deleteList{
+		pkFieldID: 106,
+		int64PKs:  map[int64]struct{}{2: {}, 4: {}},
+	}
+
+	fields := initSegmentData(sampleSchema())
+	for i := 0; i < 5; i++ {
+		fields[102].(*storage.BoolFieldData).Data = append(fields[102].(*storage.BoolFieldData).Data, i%2 == 0)
+		fields[103].(*storage.Int8FieldData).Data = append(fields[103].(*storage.Int8FieldData).Data, int8(i))
+		fields[104].(*storage.Int16FieldData).Data = append(fields[104].(*storage.Int16FieldData).Data, int16(i))
+		fields[105].(*storage.Int32FieldData).Data = append(fields[105].(*storage.Int32FieldData).Data, int32(i))
+		fields[106].(*storage.Int64FieldData).Data = append(fields[106].(*storage.Int64FieldData).Data, int64(i))
+		fields[107].(*storage.FloatFieldData).Data = append(fields[107].(*storage.FloatFieldData).Data, float32(i))
+		fields[108].(*storage.DoubleFieldData).Data = append(fields[108].(*storage.DoubleFieldData).Data, float64(i))
+		fields[109].(*storage.StringFieldData).Data = append(fields[109].(*storage.StringFieldData).Data, string(rune('a'+i)))
+		fields[110].(*storage.BinaryVectorFieldData).Data = append(fields[110].(*storage.BinaryVectorFieldData).Data, byte(i), byte(i))
+		fields[111].(*storage.FloatVectorFieldData).Data = append(fields[111].(*storage.FloatVectorFieldData).Data,
+			float32(i), float32(i), float32(i), float32(i))
+	}
+	filtered, err := list.filter(fields)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), list.skipped)
+	for fieldID, data := range filtered {
+		assert.Equal(t, 3, data.RowNum(), fieldID)
+	}
+	assert.Equal(t, []int64{0, 1, 3}, filtered[106].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []string{"a", "b", "d"}, filtered[109].(*storage.StringFieldData).Data)
+	assert.Equal(t, []byte{0, 0, 1, 1, 3, 3}, filtered[110].(*storage.BinaryVectorFieldData).Data)
+	assert.Equal(t, []float32{0, 0, 0, 0, 1, 1, 1, 1, 3, 3, 3, 3}, filtered[111].(*storage.FloatVectorFieldData).Data)
+	assert.Equal(t, 4, filtered[111].(*storage.FloatVectorFieldData).Dim)
+
+	// nothing deleted, the input is returned
+	list.int64PKs = map[int64]struct{}{100: {}}
+	ret, err := list.filter(filtered)
+	assert.NoError(t, err)
+	assert.Equal(t, filtered, ret)
+	assert.Equal(t, int64(2), list.skipped)
+
+	// the primary key field is missing
+	delete(filtered, 106)
+	_, err = list.filter(filtered)
+	assert.Error(t, err)
+}
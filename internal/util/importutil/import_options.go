@@ -18,6 +18,7 @@ package importutil
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -33,15 +34,33 @@ const (
 	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
 	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"csv_delimiter: a single character or \\t, default , \n" +
+		"csv_lazy_quotes: true or false, default false \n" +
+		"csv_max_errors: non-negative integer, default 0 \n" +
+		"delete_list: path of a file listing the primary keys not to import, one per line \n"
 	BackupFlag = "backup"
+	DeleteList = "delete_list" // path of a file in the import storage listing the primary keys of the deleted entities, one per line
+
+	CSVDelimiter  = "csv_delimiter"   // the delimiter of csv files, a single character or "\t", default ","
+	CSVLazyQuotes = "csv_lazy_quotes" // allow a quote in an unquoted field and a non-doubled quote in a quoted field of csv files
+	CSVMaxErrors  = "csv_max_errors"  // the max number of invalid rows of csv files skipped, default 0 fails at the first invalid row
 )
 
 type ImportOptions struct {
 	OnlyValidate bool
 	TsStartPoint uint64
 	TsEndPoint   uint64
-	IsBackup     bool // whether is triggered by backup tool
+	IsBackup     bool       // whether is triggered by backup tool
+	CSV          CSVOptions // options to parse the csv files
+	DeleteList   string     // path of the file listing the primary keys of the entities not to import
+}
+
+// CSVOptions are the options to parse the csv files.
+type CSVOptions struct {
+	Delimiter  rune
+	LazyQuotes bool
+	MaxErrors  int64
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -49,14 +68,58 @@ func DefaultImportOptions() ImportOptions {
 		OnlyValidate: false,
 		TsStartPoint: 0,
 		TsEndPoint:   math.MaxUint64,
+		CSV:          DefaultCSVOptions(),
 	}
 	return options
 }
 
+func DefaultCSVOptions() CSVOptions {
+	return CSVOptions{
+		Delimiter:  ',',
+		LazyQuotes: false,
+		MaxErrors:  0,
+	}
+}
+
+// ParseCSVOptions get the csv options from input options, the options not given are defaults.
+func ParseCSVOptions(options []*commonpb.KeyValuePair) (CSVOptions, error) {
+	csvOptions := DefaultCSVOptions()
+	optionMap := funcutil.KeyValuePair2Map(options)
+	if value, ok := optionMap[CSVDelimiter]; ok {
+		if value == "\\t" {
+			value = "\t"
+		}
+		delimiter := []rune(value)
+		if len(delimiter) != 1 || delimiter[0] == '"' || delimiter[0] == '\r' || delimiter[0] == '\n' {
+			return csvOptions, fmt.Errorf("invalid %s '%s', a single character other than quote and line breaks is expected", CSVDelimiter, value)
+		}
+		csvOptions.Delimiter = delimiter[0]
+	}
+	if value, ok := optionMap[CSVLazyQuotes]; ok {
+		lazyQuotes, err := strconv.ParseBool(value)
+		if err != nil {
+			return csvOptions, fmt.Errorf("invalid %s '%s', error: %w", CSVLazyQuotes, value, err)
+		}
+		csvOptions.LazyQuotes = lazyQuotes
+	}
+	if value, ok := optionMap[CSVMaxErrors]; ok {
+		maxErrors, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || maxErrors < 0 {
+			return csvOptions, fmt.Errorf("invalid %s '%s', a non-negative integer is expected", CSVMaxErrors, value)
+		}
+		csvOptions.MaxErrors = maxErrors
+	}
+	return csvOptions, nil
+}
+
 // ValidateOptions the options is illegal, return nil if illegal, return error if not.
 // Illegal options:
 //     start_ts: 10-digit physical timestamp, e.g. 1665995420
 //     end_ts: 10-digit physical timestamp, e.g. 1665995420
+//     csv_delimiter: a single character other than quote and line breaks
+//     csv_lazy_quotes: true or false
+//     csv_max_errors: non-negative integer
+//     delete_list: non-empty file path
 func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	optionMap := funcutil.KeyValuePair2Map(options)
 	// StartTs should be int
@@ -81,7 +144,11 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
-	return nil
+	if value, ok := optionMap[DeleteList]; ok && strings.TrimSpace(value) == "" {
+		return fmt.Errorf("%s shouldn't be empty", DeleteList)
+	}
+	_, err = ParseCSVOptions(options)
+	return err
 }
 
 // ParseTSFromOptions get (start_ts, end_ts, error) from input options.
@@ -119,3 +186,12 @@ func IsBackup(options []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
+
+// GetDeleteList returns the path of the delete list file, empty if it is not given
+func GetDeleteList(options []*commonpb.KeyValuePair) string {
+	deleteList, err := funcutil.GetAttrByKeyFromRepeatedKV(DeleteList, options)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(deleteList)
+}
@@ -51,6 +51,57 @@ func TestValidateOptions(t *testing.T) {
 		{Key: "start_ts", Value: "3.14"},
 		{Key: "end_ts", Value: "1666007457"},
 	}))
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: ";"},
+		{Key: "csv_lazy_quotes", Value: "true"},
+		{Key: "csv_max_errors", Value: "10"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_max_errors", Value: "-1"},
+	}))
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "delete_list", Value: "a/b/deleted.txt"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "delete_list", Value: " "},
+	}))
+}
+
+func TestParseCSVOptions(t *testing.T) {
+	options, err := ParseCSVOptions([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultCSVOptions(), options)
+
+	options, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: "\\t"},
+		{Key: "csv_lazy_quotes", Value: "True"},
+		{Key: "csv_max_errors", Value: "100"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, '\t', options.Delimiter)
+	assert.True(t, options.LazyQuotes)
+	assert.Equal(t, int64(100), options.MaxErrors)
+
+	options, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: "|"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, '|', options.Delimiter)
+
+	for _, delimiter := range []string{"", ";;", "\"", "\n", "\r"} {
+		_, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+			{Key: "csv_delimiter", Value: delimiter},
+		})
+		assert.Error(t, err, delimiter)
+	}
+	_, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_lazy_quotes", Value: "maybe"},
+	})
+	assert.Error(t, err)
+	_, err = ParseCSVOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_max_errors", Value: "1.5"},
+	})
+	assert.Error(t, err)
 }
 
 func TestParseTSFromOptions(t *testing.T) {
@@ -106,3 +157,10 @@ func TestIsBackup(t *testing.T) {
 	})
 	assert.Equal(t, false, noBackup)
 }
+
+func TestGetDeleteList(t *testing.T) {
+	assert.Equal(t, "", GetDeleteList([]*commonpb.KeyValuePair{}))
+	assert.Equal(t, "a/b/deleted.txt", GetDeleteList([]*commonpb.KeyValuePair{
+		{Key: "delete_list", Value: " a/b/deleted.txt "},
+	}))
+}
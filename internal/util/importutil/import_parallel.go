@@ -0,0 +1,295 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// rowBasedBlock is a block of rows parsed from a row-based file, the rows belong to one shard
+type rowBasedBlock struct {
+	fields  map[storage.FieldID]storage.FieldData
+	shardID int
+}
+
+// rowBasedFileTask is the parse task of a row-based file
+// the parsed blocks are sent to the blocks channel, the channel is closed after err and autoIDs are set
+type rowBasedFileTask struct {
+	filePath string
+	blocks   chan *rowBasedBlock
+	autoIDs  []int64
+	err      error
+}
+
+// SetParseParallelism sets the max number of files parsed concurrently, a non-positive value means GOMAXPROCS
+func (p *ImportWrapper) SetParseParallelism(parallelism int) {
+	p.parseParallelism = parallelism
+}
+
+// SetFileDoneFunc sets the function called after each data file is parsed and consumed, the function may be called
+// concurrently for the column-based files
+func (p *ImportWrapper) SetFileDoneFunc(fileDoneFunc func(filePath string)) {
+	p.fileDoneFunc = fileDoneFunc
+}
+
+// fileDone calls the fileDoneFunc if it is set
+func (p *ImportWrapper) fileDone(filePath string) {
+	if p.fileDoneFunc != nil {
+		p.fileDoneFunc(filePath)
+	}
+}
+
+// rowBasedWorkerMemSize estimates the memory held by a worker parsing a row-based file, the worker fills a block
+// for each shard and one more block waits to be flushed, a block is no more than 2*SingleBlockSize
+func (p *ImportWrapper) rowBasedWorkerMemSize() int64 {
+	return int64(p.shardNum+1) * 2 * SingleBlockSize
+}
+
+// getParseParallelism returns the number of workers to parse the files, GOMAXPROCS by default. The memory held by the
+// workers is kept under MaxTotalSizeInMemory, so the parallelism may be lowered below the configured one, a
+// non-positive workerMemSize means the memory is limited elsewhere
+func (p *ImportWrapper) getParseParallelism(fileCount int, workerMemSize int64) int {
+	parallelism := p.parseParallelism
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	if workerMemSize > 0 && int64(parallelism)*workerMemSize > MaxTotalSizeInMemory {
+		parallelism = int(MaxTotalSizeInMemory / workerMemSize)
+	}
+	if parallelism > fileCount {
+		parallelism = fileCount
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	return parallelism
+}
+
+// parseRowBasedJSONFiles parses the row-based json and csv files by a bounded worker pool
+// the files are parsed/converted concurrently, but the blocks are flushed in the order of files(and the order of blocks
+// in each file) by the caller goroutine, so the segments are assigned as the same as importing the files one by one,
+// no matter which file is parsed faster. A worker waits until the blocks of its file are consumed, so at most
+// parallelism files are in memory.
+func (p *ImportWrapper) parseRowBasedJSONFiles(filePaths []string, onlyValidate bool) error {
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	tasks := make([]*rowBasedFileTask, len(filePaths))
+	taskCh := make(chan *rowBasedFileTask, len(filePaths))
+	for i, filePath := range filePaths {
+		tasks[i] = &rowBasedFileTask{
+			filePath: filePath,
+			blocks:   make(chan *rowBasedBlock, 1),
+		}
+		taskCh <- tasks[i]
+	}
+	close(taskCh)
+
+	parallelism := p.getParseParallelism(len(filePaths), p.rowBasedWorkerMemSize())
+	log.Info("import wrapper: parse row-based files in parallel", zap.Int("fileCount", len(filePaths)),
+		zap.Int("parallelism", parallelism))
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range taskCh {
+				p.runRowBasedFileTask(ctx, task, onlyValidate)
+			}
+		}()
+	}
+	// make sure all workers quit before return
+	defer wg.Wait()
+
+	for i, task := range tasks {
+		var err error
+		for block := range task.blocks {
+			// keep draining the channel after failure so that the worker can quit
+			if err != nil {
+				continue
+			}
+			printFieldsDataInfo(block.fields, "import wrapper: prepare to flush binlogs", []string{task.filePath})
+			err = p.flushFunc(block.fields, block.shardID)
+			if err != nil {
+				cancel()
+			}
+		}
+		if err == nil {
+			err = task.err
+		}
+		if err != nil {
+			log.Error("import wrapper: failed to parse row-based file", zap.Error(err), zap.String("filePath", task.filePath))
+			cancel()
+			// drain the channels of the remaining files, the workers quit soon since the context is canceled
+			for _, t := range tasks[i+1:] {
+				for range t.blocks {
+				}
+			}
+			return err
+		}
+
+		// for row-based files, auto-id is generated within JSONRowConsumer
+		p.importResult.AutoIds = append(p.importResult.AutoIds, task.autoIDs...)
+
+		// trigger gc after each file finished
+		triggerGC()
+
+		if !onlyValidate {
+			p.fileDone(task.filePath)
+			p.reportProgress(i+1, len(tasks))
+		}
+	}
+
+	return nil
+}
+
+// runRowBasedFileTask parses a row-based file and sends the parsed blocks to the task channel
+func (p *ImportWrapper) runRowBasedFileTask(ctx context.Context, task *rowBasedFileTask, onlyValidate bool) {
+	defer close(task.blocks)
+
+	if isCanceled(ctx) {
+		task.err = ctx.Err()
+		return
+	}
+
+	// the consumer replaces a block by a new one after flush, so it is safe to pass the block to another goroutine
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		if onlyValidate {
+			return nil
+		}
+		select {
+		case task.blocks <- &rowBasedBlock{fields: fields, shardID: shardID}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if IsMQSource(task.filePath) {
+		log.Info("import wrapper: message queue source", zap.String("source", task.filePath))
+		task.autoIDs, task.err = p.parseMQSource(ctx, task.filePath, flushFunc)
+		return
+	}
+
+	_, fileType := GetFileNameAndExt(task.filePath)
+	log.Info("import wrapper:  row-based file ", zap.Any("filePath", task.filePath), zap.Any("fileType", fileType))
+	// no need to check else, since the fileValidation() already do this
+	if fileType == JSONFileExt {
+		task.autoIDs, task.err = p.parseRowBasedJSON(ctx, task.filePath, flushFunc)
+	} else if fileType == CSVFileExt {
+		task.autoIDs, task.err = p.parseRowBasedCSV(ctx, task.filePath, flushFunc)
+	}
+}
+
+// parseColumnBasedNumpyFiles parses the column-based numpy files by a bounded worker pool
+// each numpy file is a column, the combineFunc is called one by one to combine the columns
+func (p *ImportWrapper) parseColumnBasedNumpyFiles(filePaths []string, onlyValidate bool,
+	combineFunc func(fields map[storage.FieldID]storage.FieldData) error) error {
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	// the total size of the numpy files read into memory is already limited by MaxTotalSizeInMemory
+	parallelism := p.getParseParallelism(len(filePaths), 0)
+	log.Info("import wrapper: parse column-based files in parallel", zap.Int("fileCount", len(filePaths)),
+		zap.Int("parallelism", parallelism))
+
+	combineMut := &sync.Mutex{}
+	syncCombineFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		combineMut.Lock()
+		defer combineMut.Unlock()
+		return combineFunc(fields)
+	}
+
+	fileCh := make(chan string, len(filePaths))
+	for _, filePath := range filePaths {
+		fileCh <- filePath
+	}
+	close(fileCh)
+
+	var firstErr error
+	errOnce := &sync.Once{}
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for filePath := range fileCh {
+				if isCanceled(ctx) {
+					return
+				}
+				_, fileType := GetFileNameAndExt(filePath)
+				log.Info("import wrapper:  column-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
+				// no need to check else, since the fileValidation() already do this
+				if fileType != NumpyFileExt {
+					continue
+				}
+				err := p.parseColumnBasedNumpy(ctx, filePath, onlyValidate, syncCombineFunc)
+				if err != nil {
+					log.Error("import wrapper: failed to parse column-based numpy file", zap.Error(err), zap.String("filePath", filePath))
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				if !onlyValidate {
+					p.fileDone(filePath)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// outside context might be canceled(service stop, or future enhancement for canceling import task)
+	if firstErr == nil && isCanceled(p.ctx) {
+		log.Error("import wrapper: import task was canceled")
+		return errors.New("import task was canceled")
+	}
+	return firstErr
+}
+
+// reportProgress notify the rootcoord the percentage of parsed files, a failure of report doesn't break the import
+func (p *ImportWrapper) reportProgress(finished int, total int) {
+	progress := strconv.Itoa(finished * 100 / total)
+	found := false
+	for _, kv := range p.importResult.Infos {
+		if kv.GetKey() == ProgressPercent {
+			kv.Value = progress
+			found = true
+			break
+		}
+	}
+	if !found {
+		p.importResult.Infos = append(p.importResult.Infos, &commonpb.KeyValuePair{Key: ProgressPercent, Value: progress})
+	}
+
+	log.Info("import wrapper: report import progress", zap.Int("finishedFiles", finished), zap.Int("totalFiles", total))
+	if err := p.reportFunc(p.importResult); err != nil {
+		log.Warn("import wrapper: fail to report import progress to RootCoord", zap.Error(err))
+	}
+}
@@ -0,0 +1,312 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// PreImportFileReport is the pre-flight check result of an import file.
+type PreImportFileReport struct {
+	Path          string `json:"path"`
+	Size          int64  `json:"size"`
+	EstimatedRows int64  `json:"estimated_rows"`
+	Error         string `json:"error,omitempty"`
+}
+
+// PreImportReport is the pre-flight check result of the files of an import task, the import task is expected
+// to fail if Errors is not empty. The row count of a json file is estimated from its first row, the row count
+// of a numpy file is read from its header, the row count of a csv file is estimated from the row after its header.
+type PreImportReport struct {
+	RowBased          bool                   `json:"row_based"`
+	Backup            bool                   `json:"backup"`
+	Files             []*PreImportFileReport `json:"files"`
+	EstimatedRows     int64                  `json:"estimated_rows"`
+	EstimatedBytes    int64                  `json:"estimated_bytes"`
+	EstimatedSegments int64                  `json:"estimated_segments"`
+	Errors            []string               `json:"errors"`
+}
+
+// Passed returns true if no error is found by the pre-flight check.
+func (r *PreImportReport) Passed() bool {
+	return len(r.Errors) == 0
+}
+
+// PreImportCheck checks the files of an import task before the task is scheduled to a DataNode: the files exist
+// in the bucket, the file types and sizes are acceptable, the fields of the files are compatible with the collection
+// schema, and estimates the rows and the segments the import produces. Only the headers of the numpy files and the
+// first row of the json and csv files are read.
+func PreImportCheck(ctx context.Context, cm storage.ChunkManager, collectionSchema *schemapb.CollectionSchema,
+	shardNum int32, segmentSize int64, filePaths []string, options []*commonpb.KeyValuePair) *PreImportReport {
+	report := &PreImportReport{
+		Files:  make([]*PreImportFileReport, 0, len(filePaths)),
+		Errors: make([]string, 0),
+	}
+	if len(filePaths) == 0 {
+		report.Errors = append(report.Errors, "no file to import")
+		return report
+	}
+	if err := ValidateOptions(options); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+
+	wrapper := &ImportWrapper{ctx: ctx, collectionSchema: collectionSchema, chunkManager: cm}
+	if IsBackup(options) && wrapper.isBinlogImport(filePaths) {
+		report.Backup = true
+		preCheckBinlogPaths(ctx, cm, filePaths, report)
+		return report
+	}
+
+	for _, filePath := range filePaths {
+		fileReport := &PreImportFileReport{Path: filePath}
+		report.Files = append(report.Files, fileReport)
+		// the rows of a message queue source are unknown until it is consumed
+		if IsMQSource(filePath) {
+			if _, err := parseMQSource(filePath); err != nil {
+				fileReport.Error = err.Error()
+			}
+			continue
+		}
+		size, err := cm.Size(ctx, filePath)
+		if err != nil {
+			fileReport.Error = fmt.Sprintf("failed to get file size, the file may not exist, error: %s", err.Error())
+			continue
+		}
+		fileReport.Size = size
+
+		name, fileType := GetFileNameAndExt(filePath)
+		switch fileType {
+		case JSONFileExt:
+			fileReport.EstimatedRows, err = estimateJSONRows(ctx, cm, collectionSchema, filePath, size)
+		case CSVFileExt:
+			fileReport.EstimatedRows, err = estimateCSVRows(ctx, cm, collectionSchema, filePath, size, options)
+		case NumpyFileExt:
+			fileReport.EstimatedRows, err = readNumpyRows(ctx, cm, collectionSchema, filePath, name)
+		}
+		if err != nil {
+			fileReport.Error = err.Error()
+		}
+	}
+	for _, fileReport := range report.Files {
+		if fileReport.Error != "" {
+			report.Errors = append(report.Errors, fmt.Sprintf("file '%s': %s", fileReport.Path, fileReport.Error))
+		}
+	}
+	if !report.Passed() {
+		return report
+	}
+
+	// the file types, sizes and the fields of the column-based files as a whole
+	rowBased, err := wrapper.fileValidation(filePaths)
+	report.RowBased = rowBased
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return report
+	}
+
+	if rowBased {
+		for _, fileReport := range report.Files {
+			report.EstimatedRows += fileReport.EstimatedRows
+		}
+	} else {
+		report.EstimatedRows = report.Files[0].EstimatedRows
+		for _, fileReport := range report.Files[1:] {
+			if fileReport.EstimatedRows != report.EstimatedRows {
+				report.Errors = append(report.Errors, fmt.Sprintf("row count %d of file '%s' is not equal to row count %d of file '%s'",
+					fileReport.EstimatedRows, fileReport.Path, report.EstimatedRows, report.Files[0].Path))
+			}
+		}
+	}
+
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(collectionSchema)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return report
+	}
+	report.EstimatedBytes = report.EstimatedRows * int64(sizePerRecord)
+	if shardNum <= 0 {
+		shardNum = 1
+	}
+	if report.EstimatedBytes > 0 && segmentSize > 0 {
+		// the rows are hashed to the shards, each shard produces its own segments
+		bytesPerShard := (report.EstimatedBytes + int64(shardNum) - 1) / int64(shardNum)
+		report.EstimatedSegments = (bytesPerShard + segmentSize - 1) / segmentSize * int64(shardNum)
+	}
+	return report
+}
+
+// preCheckBinlogPaths checks the insert log path, and the delta log path if given, of a backup import have binlogs.
+func preCheckBinlogPaths(ctx context.Context, cm storage.ChunkManager, filePaths []string, report *PreImportReport) {
+	for i, filePath := range filePaths {
+		// the delta log path is optional
+		if i > 0 && filePath == "" {
+			continue
+		}
+		fileReport := &PreImportFileReport{Path: filePath}
+		report.Files = append(report.Files, fileReport)
+		paths, _, err := cm.ListWithPrefix(ctx, filePath, true)
+		if err != nil {
+			fileReport.Error = err.Error()
+		} else if i == 0 && len(paths) == 0 {
+			fileReport.Error = "no insert log is found under the path"
+		}
+		if fileReport.Error != "" {
+			report.Errors = append(report.Errors, fmt.Sprintf("path '%s': %s", filePath, fileReport.Error))
+		}
+	}
+}
+
+// estimateJSONRows verifies the first row of the json file against the schema,
+// and estimates the row count of the file by the size of the first row.
+func estimateJSONRows(ctx context.Context, cm storage.ChunkManager, collectionSchema *schemapb.CollectionSchema,
+	filePath string, size int64) (int64, error) {
+	reader, err := cm.Reader(ctx, filePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open the file, error: %w", err)
+	}
+	defer reader.Close()
+
+	dec := json.NewDecoder(reader)
+	dec.UseNumber()
+	expectDelim := func(delim json.Delim) error {
+		t, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode the JSON file, error: %w", err)
+		}
+		if t != delim {
+			return fmt.Errorf("invalid JSON format, '%s' is expected", delim)
+		}
+		return nil
+	}
+
+	if err := expectDelim('{'); err != nil {
+		return 0, err
+	}
+	t, err := dec.Token()
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode the JSON file, error: %w", err)
+	}
+	if key, ok := t.(string); !ok || strings.ToLower(key) != RowRootNode {
+		return 0, fmt.Errorf("invalid JSON format, the root key should be '%s', but get '%v'", RowRootNode, t)
+	}
+	if err := expectDelim('['); err != nil {
+		return 0, err
+	}
+	if !dec.More() {
+		return 0, errors.New("row count is 0")
+	}
+
+	start := dec.InputOffset()
+	var row interface{}
+	if err := dec.Decode(&row); err != nil && err != io.EOF {
+		return 0, fmt.Errorf("failed to parse row value, error: %w", err)
+	}
+	if _, err := NewJSONParser(ctx, collectionSchema).verifyRow(row); err != nil {
+		return 0, err
+	}
+	rowSize := dec.InputOffset() - start
+	if rowSize <= 0 {
+		return 1, nil
+	}
+	rows := (size - start) / rowSize
+	if rows < 1 {
+		rows = 1
+	}
+	return rows, nil
+}
+
+// estimateCSVRows verifies the header and the first row of the csv file against the schema,
+// and estimates the row count of the file by the size of the first row.
+func estimateCSVRows(ctx context.Context, cm storage.ChunkManager, collectionSchema *schemapb.CollectionSchema,
+	filePath string, size int64, options []*commonpb.KeyValuePair) (int64, error) {
+	reader, err := cm.Reader(ctx, filePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open the file, error: %w", err)
+	}
+	defer reader.Close()
+
+	// invalid options are already reported by ValidateOptions
+	csvOptions, _ := ParseCSVOptions(options)
+	parser := NewCSVParser(ctx, collectionSchema, csvOptions, nil, filePath)
+	csvReader := parser.newReader(reader)
+	// the size of a record is the size of its values, the delimiters and the line break
+	recordSize := func(record []string) int64 {
+		size := int64(len(record))
+		for _, value := range record {
+			size += int64(len(value))
+		}
+		return size
+	}
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return 0, errors.New("the csv file has no header")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the csv header, error: %w", err)
+	}
+	if err := parser.parseHeader(header); err != nil {
+		return 0, err
+	}
+	headerSize := recordSize(header)
+
+	record, err := csvReader.Read()
+	if err == io.EOF {
+		return 0, errors.New("row count is 0")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse row value, error: %w", err)
+	}
+	if _, err := parser.parseRow(record); err != nil {
+		return 0, err
+	}
+	rows := (size - headerSize) / recordSize(record)
+	if rows < 1 {
+		rows = 1
+	}
+	return rows, nil
+}
+
+// readNumpyRows verifies the header of the numpy file against the field of the schema, and returns its row count.
+func readNumpyRows(ctx context.Context, cm storage.ChunkManager, collectionSchema *schemapb.CollectionSchema,
+	filePath string, fieldName string) (int64, error) {
+	reader, err := cm.Reader(ctx, filePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open the file, error: %w", err)
+	}
+	defer reader.Close()
+
+	adapter, err := NewNumpyAdapter(reader)
+	if err != nil {
+		return 0, err
+	}
+	parser := NewNumpyParser(ctx, collectionSchema, func(field storage.FieldData) error { return nil })
+	if err := parser.validate(adapter, fieldName); err != nil {
+		return 0, err
+	}
+	return int64(adapter.GetShape()[0]), nil
+}
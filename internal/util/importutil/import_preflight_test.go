@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func Test_PreImportCheck(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	require.NoError(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	require.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	t.Run("json", func(t *testing.T) {
+		content := []byte(`{
+		"rows":[
+			{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+			{"FieldBool": false, "FieldInt8": 11, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 10002, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]},
+			{"FieldBool": true, "FieldInt8": 12, "FieldInt16": 103, "FieldInt32": 1003, "FieldInt64": 10003, "FieldFloat": 3.16, "FieldDouble": 3.56, "FieldString": "hello world", "FieldBinaryVector": [252, 0], "FieldFloatVector": [3.1, 3.2, 3.3, 3.4]}
+		]
+	}`)
+		filePath := path.Join(cm.RootPath(), "rows_1.json")
+		require.NoError(t, cm.Write(ctx, filePath, content))
+
+		report := PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, nil)
+		assert.True(t, report.Passed(), report.Errors)
+		assert.True(t, report.RowBased)
+		require.Equal(t, 1, len(report.Files))
+		assert.Equal(t, int64(len(content)), report.Files[0].Size)
+		assert.GreaterOrEqual(t, report.EstimatedRows, int64(2))
+		assert.LessOrEqual(t, report.EstimatedRows, int64(4))
+		assert.Greater(t, report.EstimatedBytes, int64(0))
+		assert.Equal(t, int64(2), report.EstimatedSegments)
+
+		// the first row misses a field
+		filePath = path.Join(cm.RootPath(), "rows_2.json")
+		require.NoError(t, cm.Write(ctx, filePath, []byte(`{"rows":[{"FieldBool": true}]}`)))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, nil)
+		assert.False(t, report.Passed())
+
+		// no row
+		filePath = path.Join(cm.RootPath(), "rows_3.json")
+		require.NoError(t, cm.Write(ctx, filePath, []byte(`{"rows":[]}`)))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, nil)
+		assert.False(t, report.Passed())
+
+		// invalid root key
+		filePath = path.Join(cm.RootPath(), "rows_4.json")
+		require.NoError(t, cm.Write(ctx, filePath, []byte(`{"dummy":[]}`)))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, nil)
+		assert.False(t, report.Passed())
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		content := []byte("FieldBool;FieldInt8;FieldInt16;FieldInt32;FieldInt64;FieldFloat;FieldDouble;FieldString;FieldBinaryVector;FieldFloatVector\n" +
+			"true;10;101;1001;10001;3.14;1.56;hello world;[254, 0];[1.1, 1.2, 1.3, 1.4]\n" +
+			"false;11;102;1002;10002;3.15;2.56;hello world;[253, 0];[2.1, 2.2, 2.3, 2.4]\n" +
+			"true;12;103;1003;10003;3.16;3.56;hello world;[252, 0];[3.1, 3.2, 3.3, 3.4]\n")
+		filePath := path.Join(cm.RootPath(), "rows_1.csv")
+		require.NoError(t, cm.Write(ctx, filePath, content))
+
+		options := []*commonpb.KeyValuePair{{Key: CSVDelimiter, Value: ";"}}
+		report := PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, options)
+		assert.True(t, report.Passed(), report.Errors)
+		assert.True(t, report.RowBased)
+		assert.GreaterOrEqual(t, report.EstimatedRows, int64(2))
+		assert.LessOrEqual(t, report.EstimatedRows, int64(4))
+
+		// the delimiter is not given
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, nil)
+		assert.False(t, report.Passed())
+
+		// the first row is invalid
+		filePath = path.Join(cm.RootPath(), "rows_2.csv")
+		require.NoError(t, cm.Write(ctx, filePath, []byte("FieldBool;FieldInt8;FieldInt16;FieldInt32;FieldInt64;FieldFloat;FieldDouble;FieldString;FieldBinaryVector;FieldFloatVector\n"+
+			"true;1000;101;1001;10001;3.14;1.56;hello world;[254, 0];[1.1, 1.2, 1.3, 1.4]\n")))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, options)
+		assert.False(t, report.Passed())
+
+		// no row
+		filePath = path.Join(cm.RootPath(), "rows_3.csv")
+		require.NoError(t, cm.Write(ctx, filePath, content[:bytes.IndexByte(content, '\n')+1]))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, options)
+		assert.False(t, report.Passed())
+	})
+
+	t.Run("numpy", func(t *testing.T) {
+		files := createSampleNumpyFiles(t, cm)
+		report := PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, files, nil)
+		assert.True(t, report.Passed(), report.Errors)
+		assert.False(t, report.RowBased)
+		assert.Equal(t, int64(5), report.EstimatedRows)
+		assert.Equal(t, len(files), len(report.Files))
+
+		// row count of fields not equal
+		filePath := path.Join(cm.RootPath(), "FieldInt8.npy")
+		content, err := CreateNumpyData([]int8{10})
+		require.NoError(t, err)
+		require.NoError(t, cm.Write(ctx, filePath, content))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, files, nil)
+		assert.False(t, report.Passed())
+
+		// data type mismatch
+		content, err = CreateNumpyData([]float64{10, 11, 12, 13, 14})
+		require.NoError(t, err)
+		require.NoError(t, cm.Write(ctx, filePath, content))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, files, nil)
+		assert.False(t, report.Passed())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		report := PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{}, nil)
+		assert.False(t, report.Passed())
+
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{"/dummy/dummy.json"}, nil)
+		assert.False(t, report.Passed())
+		require.Equal(t, 1, len(report.Files))
+		assert.NotEmpty(t, report.Files[0].Error)
+
+		filePath := path.Join(cm.RootPath(), "dummy.txt")
+		require.NoError(t, cm.Write(ctx, filePath, []byte("dummy")))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, nil)
+		assert.False(t, report.Passed())
+
+		options := []*commonpb.KeyValuePair{{Key: StartTs, Value: "abc"}}
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{filePath}, options)
+		assert.False(t, report.Passed())
+	})
+
+	t.Run("backup", func(t *testing.T) {
+		options := []*commonpb.KeyValuePair{{Key: BackupFlag, Value: "true"}}
+		insertPath := path.Join(cm.RootPath(), "backup", "insert_log")
+		report := PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{insertPath, ""}, options)
+		assert.True(t, report.Backup)
+		assert.False(t, report.Passed())
+
+		require.NoError(t, cm.Write(ctx, path.Join(insertPath, "1", "2", "3", "102", "4"), []byte("dummy")))
+		report = PreImportCheck(ctx, cm, sampleSchema(), 2, 1024*1024, []string{insertPath, ""}, options)
+		assert.True(t, report.Passed(), report.Errors)
+	})
+}
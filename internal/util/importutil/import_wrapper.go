@@ -20,7 +20,9 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -40,6 +42,7 @@ import (
 const (
 	JSONFileExt  = ".json"
 	NumpyFileExt = ".npy"
+	CSVFileExt   = ".csv"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -65,6 +68,7 @@ const (
 	CollectionName  = "collection"
 	PartitionName   = "partition"
 	PersistTimeCost = "persist_cost"
+	ProgressPercent = "progress_percent"
 )
 
 // ReportImportAttempts is the maximum # of attempts to retry when import fails.
@@ -102,7 +106,16 @@ type ImportWrapper struct {
 	reportFunc           func(res *rootcoordpb.ImportResult) error // report import state to rootcoord
 	reportImportAttempts uint                                      // attempts count if report function get error
 
-	workingSegments map[int]*WorkingSegment // a map shard id to working segments
+	workingSegments  map[int]*WorkingSegment // a map shard id to working segments
+	parseParallelism int                     // max number of files parsed concurrently
+	fileDoneFunc     func(filePath string)   // optional, called after each data file is parsed and consumed
+
+	csvOptions   CSVOptions    // delimiter, quoting and max errors of csv files
+	csvRowErrors *csvRowErrors // invalid rows of csv files skipped
+
+	deleteList *deleteList // optional, primary keys of the rows not to import
+
+	mqBrokerAllowlist []string // brokers of the message queue sources allowed to be imported from
 }
 
 func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
@@ -212,33 +225,38 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 }
 
 // fileValidation verify the input paths
-// if all the files are json type, return true
+// if all the files are json or csv type, return true
 // if all the files are numpy type, return false, and not allow duplicate file name
 func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
 	fileNames := make(map[string]struct{})
 
+	// message queue sources are consumed as row-based json files
+	if len(filePaths) > 0 && IsMQSource(filePaths[0]) {
+		return true, mqSourceValidation(filePaths, p.mqBrokerAllowlist)
+	}
+
 	totalSize := int64(0)
 	rowBased := false
 	for i := 0; i < len(filePaths); i++ {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, csv file or numpy file
+		if fileType != JSONFileExt && fileType != CSVFileExt && fileType != NumpyFileExt {
 			log.Error("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		if i == 0 && (fileType == JSONFileExt || fileType == CSVFileExt) {
 			rowBased = true
 		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based only support json and csv type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt {
+			if fileType != JSONFileExt && fileType != CSVFileExt {
 				log.Error("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
@@ -303,9 +321,18 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error {
 	log.Info("import wrapper: begin import", zap.Any("filePaths", filePaths), zap.Any("options", options))
 
+	binlogImport := options.IsBackup && p.isBinlogImport(filePaths)
+	p.deleteList = nil
+	if len(options.DeleteList) > 0 {
+		err := p.loadDeleteList(options.DeleteList, binlogImport)
+		if err != nil {
+			return err
+		}
+	}
+
 	// data restore function to import milvus native binlog files(for backup/restore tools)
 	// the backup/restore tool provide two paths for a partition, the first path is binlog path, the second is deltalog path
-	if options.IsBackup && p.isBinlogImport(filePaths) {
+	if binlogImport {
 		return p.doBinlogImport(filePaths, options.TsStartPoint, options.TsEndPoint)
 	}
 
@@ -317,25 +344,18 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 
 	tr := timerecord.NewTimeRecorder("Import task")
 	if rowBased {
+		// the invalid rows of all the csv files are counted together against the max errors
+		p.csvOptions = options.CSV
+		p.csvRowErrors = newCSVRowErrors(options.CSV.MaxErrors)
+
 		// parse and consume row-based files
 		// for row-based files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
-		// according to shard number, so the flushFunc will be called in the JSONRowConsumer
-		for i := 0; i < len(filePaths); i++ {
-			filePath := filePaths[i]
-			_, fileType := GetFileNameAndExt(filePath)
-			log.Info("import wrapper:  row-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
-
-			if fileType == JSONFileExt {
-				err = p.parseRowBasedJSON(filePath, options.OnlyValidate)
-				if err != nil {
-					log.Error("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
-					return err
-				}
-			} // no need to check else, since the fileValidation() already do this
-
-			// trigger gc after each file finished
-			triggerGC()
+		// according to shard number, the files are parsed concurrently and the blocks are flushed in the order of files
+		err = p.parseRowBasedJSONFiles(filePaths, options.OnlyValidate)
+		if err != nil {
+			return err
 		}
+		p.reportCSVRowErrors()
 	} else {
 		// parse and consume column-based files
 		// for column-based files, the XXXColumnConsumer only output map[string]storage.FieldData
@@ -388,21 +408,10 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			return nil
 		}
 
-		// parse/validate/consume data
-		for i := 0; i < len(filePaths); i++ {
-			filePath := filePaths[i]
-			_, fileType := GetFileNameAndExt(filePath)
-			log.Info("import wrapper:  column-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
-
-			if fileType == NumpyFileExt {
-				err = p.parseColumnBasedNumpy(filePath, options.OnlyValidate, combineFunc)
-
-				if err != nil {
-					log.Error("import wrapper: failed to parse column-based numpy file", zap.Error(err), zap.String("filePath", filePath))
-					return err
-				}
-			}
-			// no need to check else, since the fileValidation() already do this
+		// parse/validate/consume data, the columns are parsed concurrently
+		err = p.parseColumnBasedNumpyFiles(filePaths, options.OnlyValidate, combineFunc)
+		if err != nil {
+			return err
 		}
 
 		// trigger after read finished
@@ -421,6 +430,25 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 	return p.reportPersisted(p.reportImportAttempts, tr)
 }
 
+// loadDeleteList loads the primary keys not to import, the keys generated by auto-id can't be deleted in advance,
+// except the keys restored from binlog files
+func (p *ImportWrapper) loadDeleteList(filePath string, binlogImport bool) error {
+	for _, schema := range p.collectionSchema.GetFields() {
+		if schema.GetIsPrimaryKey() && schema.GetAutoID() && !binlogImport {
+			log.Error("import wrapper: delete list is not allowed for auto-id primary key", zap.String("deleteList", filePath))
+			return fmt.Errorf("the delete list '%s' can't be applied to the auto-id primary key '%s'", filePath, schema.GetName())
+		}
+	}
+
+	list, err := loadDeleteList(p.ctx, p.chunkManager, p.collectionSchema, filePath)
+	if err != nil {
+		log.Error("import wrapper: failed to load delete list", zap.String("deleteList", filePath), zap.Error(err))
+		return err
+	}
+	p.deleteList = list
+	return nil
+}
+
 // reportPersisted notify the rootcoord to mark the task state to be ImportPersisted
 func (p *ImportWrapper) reportPersisted(reportAttempts uint, tr *timerecord.TimeRecorder) error {
 	// force close all segments
@@ -429,6 +457,12 @@ func (p *ImportWrapper) reportPersisted(reportAttempts uint, tr *timerecord.Time
 		return err
 	}
 
+	if p.deleteList != nil {
+		log.Info("import wrapper: rows skipped by delete list", zap.Int64("count", p.deleteList.skipped))
+		p.importResult.Infos = append(p.importResult.Infos,
+			&commonpb.KeyValuePair{Key: DeleteListSkippedRows, Value: strconv.FormatInt(p.deleteList.skipped, 10)})
+	}
+
 	if tr != nil {
 		ts := tr.Elapse("persist finished").Seconds()
 		p.importResult.Infos = append(p.importResult.Infos,
@@ -514,56 +548,88 @@ func (p *ImportWrapper) doBinlogImport(filePaths []string, tsStartPoint uint64,
 }
 
 // parseRowBasedJSON is the entry of row-based json import operation
-func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) error {
+// the flushFunc is called by the JSONRowConsumer for each block, returns the auto-id ranges generated by the consumer
+func (p *ImportWrapper) parseRowBasedJSON(ctx context.Context, filePath string, flushFunc ImportFlushFunc) ([]int64, error) {
 	tr := timerecord.NewTimeRecorder("json row-based parser: " + filePath)
 
 	// for minio storage, chunkManager will download file into local memory
 	// for local storage, chunkManager open the file directly
-	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	file, err := p.chunkManager.Reader(ctx, filePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer file.Close()
 
-	// parse file
-	reader := bufio.NewReader(file)
-	parser := NewJSONParser(p.ctx, p.collectionSchema)
-
-	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
-	var flushFunc ImportFlushFunc
-	if onlyValidate {
-		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
-			return nil
-		}
-	} else {
-		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
-			var filePaths = []string{filePath}
-			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", filePaths)
-			return p.flushFunc(fields, shardID)
-		}
+	ids, err := p.parseRowBasedJSONReader(ctx, file, flushFunc)
+	if err != nil {
+		return nil, err
 	}
 
+	tr.Elapse("parsed")
+	return ids, nil
+}
+
+// parseRowBasedJSONReader parses a row-based json document from the reader
+func (p *ImportWrapper) parseRowBasedJSONReader(ctx context.Context, r io.Reader, flushFunc ImportFlushFunc) ([]int64, error) {
+	reader := bufio.NewReader(r)
+	parser := NewJSONParser(ctx, p.collectionSchema)
+
 	consumer, err := NewJSONRowConsumer(p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, flushFunc)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = parser.ParseRows(reader, consumer)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return consumer.IDRange(), nil
+}
+
+// parseRowBasedCSV is the entry of row-based csv import operation, the csv rows are consumed by the JSONRowConsumer
+// the flushFunc is called by the JSONRowConsumer for each block, returns the auto-id ranges generated by the consumer
+func (p *ImportWrapper) parseRowBasedCSV(ctx context.Context, filePath string, flushFunc ImportFlushFunc) ([]int64, error) {
+	tr := timerecord.NewTimeRecorder("csv row-based parser: " + filePath)
+
+	file, err := p.chunkManager.Reader(ctx, filePath)
+	if err != nil {
+		return nil, err
 	}
+	defer file.Close()
 
-	// for row-based files, auto-id is generated within JSONRowConsumer
-	if consumer != nil {
-		p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
+	parser := NewCSVParser(ctx, p.collectionSchema, p.csvOptions, p.csvRowErrors, filePath)
+	consumer, err := NewJSONRowConsumer(p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, flushFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.ParseRows(bufio.NewReader(file), consumer)
+	if err != nil {
+		return nil, err
 	}
 
 	tr.Elapse("parsed")
-	return nil
+	return consumer.IDRange(), nil
+}
+
+// reportCSVRowErrors records the number of the invalid csv rows skipped and the first ones in the import result
+func (p *ImportWrapper) reportCSVRowErrors() {
+	if p.csvRowErrors == nil {
+		return
+	}
+	count, samples := p.csvRowErrors.skipped()
+	if count == 0 {
+		return
+	}
+	log.Warn("import wrapper: invalid csv rows are skipped", zap.Int64("count", count))
+	p.importResult.Infos = append(p.importResult.Infos,
+		&commonpb.KeyValuePair{Key: CSVSkippedRows, Value: strconv.FormatInt(count, 10)},
+		&commonpb.KeyValuePair{Key: CSVRowErrors, Value: strings.Join(samples, "; ")})
 }
 
 // parseColumnBasedNumpy is the entry of column-based numpy import operation
-func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool,
+func (p *ImportWrapper) parseColumnBasedNumpy(ctx context.Context, filePath string, onlyValidate bool,
 	combineFunc func(fields map[storage.FieldID]storage.FieldData) error) error {
 	tr := timerecord.NewTimeRecorder("numpy parser: " + filePath)
 
@@ -571,7 +637,7 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 
 	// for minio storage, chunkManager will download file into local memory
 	// for local storage, chunkManager open the file directly
-	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	file, err := p.chunkManager.Reader(ctx, filePath)
 	if err != nil {
 		return err
 	}
@@ -600,7 +666,7 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 	}
 
 	// for numpy file, we say the file name(without extension) is the filed name
-	parser := NewNumpyParser(p.ctx, p.collectionSchema, flushFunc)
+	parser := NewNumpyParser(ctx, p.collectionSchema, flushFunc)
 	err = parser.Parse(file, fileName, onlyValidate)
 	if err != nil {
 		return err
@@ -847,6 +913,16 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 
 // flushFunc is the callback function for parsers generate segment and save binlog files
 func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+	// filter out the rows of the deleted entities before the binlogs are created
+	if p.deleteList != nil && len(fields) > 0 {
+		var err error
+		fields, err = p.deleteList.filter(fields)
+		if err != nil {
+			log.Error("import wrapper: failed to apply delete list", zap.Error(err), zap.Int("shardID", shardID))
+			return err
+		}
+	}
+
 	// if fields data is empty, do nothing
 	var rowNum int
 	memSize := 0
@@ -25,7 +25,9 @@ import (
 	"math"
 	"os"
 	"path"
+	"runtime"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -38,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 )
 
@@ -310,6 +313,293 @@ func Test_ImportWrapperRowBased(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_ImportWrapperRowBasedCSV(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	content := []byte("FieldBool,FieldInt8,FieldInt16,FieldInt32,FieldInt64,FieldFloat,FieldDouble,FieldString,FieldBinaryVector,FieldFloatVector\n" +
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n" +
+		"false,11,102,1002,10002,3.15,2.56,\"hello, world\",\"[253, 0]\",\"[2.1, 2.2, 2.3, 2.4]\"\n" +
+		"true,1000,103,1003,10003,3.16,3.56,hello world,\"[252, 0]\",\"[3.1, 3.2, 3.3, 3.4]\"\n" +
+		"false,13,104,1004,10004,3.17,4.56,hello world,\"[251, 0]\",\"[4.1, 4.2, 4.3]\"\n" +
+		"true,14,105,1005,10005,3.18,5.56,hello world,\"[250, 0]\",\"[5.1, 5.2, 5.3, 5.4]\"\n")
+
+	filePath := TempFilesPath + "rows_1.csv"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	// two invalid rows, fail at the first one by default
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, DefaultImportOptions())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "rows_1.csv:4")
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	// the invalid rows exceed the max errors
+	options := DefaultImportOptions()
+	options.CSV.MaxErrors = 1
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "rows_1.csv:5")
+
+	// the invalid rows are skipped and reported
+	options.CSV.MaxErrors = 2
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	skipped, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVSkippedRows, importResult.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "2", skipped)
+	rowErrors, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVRowErrors, importResult.GetInfos())
+	assert.NoError(t, err)
+	assert.Contains(t, rowErrors, "rows_1.csv:4")
+	assert.Contains(t, rowErrors, "rows_1.csv:5")
+
+	// a json file and a csv file
+	jsonContent := []byte(`{"rows":[{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]}]}`)
+	jsonPath := TempFilesPath + "rows_2.json"
+	err = cm.Write(ctx, jsonPath, jsonContent)
+	assert.NoError(t, err)
+	rowCounter.rowCount = 0
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{jsonPath, filePath}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, rowCounter.rowCount)
+}
+
+func Test_ImportWrapperDeleteList(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	content := []byte("FieldBool,FieldInt8,FieldInt16,FieldInt32,FieldInt64,FieldFloat,FieldDouble,FieldString,FieldBinaryVector,FieldFloatVector\n" +
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n" +
+		"false,11,102,1002,10002,3.15,2.56,hello world,\"[253, 0]\",\"[2.1, 2.2, 2.3, 2.4]\"\n" +
+		"true,12,103,1003,10003,3.16,3.56,hello world,\"[252, 0]\",\"[3.1, 3.2, 3.3, 3.4]\"\n")
+	filePath := TempFilesPath + "rows_1.csv"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	deleteListPath := TempFilesPath + "deleted.txt"
+	err = cm.Write(ctx, deleteListPath, []byte("10001\n10003\n20000\n"))
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	// the rows of the deleted primary keys are skipped
+	options := DefaultImportOptions()
+	options.DeleteList = deleteListPath
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	skipped, err := funcutil.GetAttrByKeyFromRepeatedKV(DeleteListSkippedRows, importResult.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "2", skipped)
+
+	// the delete list doesn't exist
+	options.DeleteList = TempFilesPath + "dummy.txt"
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.Error(t, err)
+
+	// the auto-id primary keys can't be deleted in advance
+	schema := sampleSchema()
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			field.AutoID = true
+		}
+	}
+	options.DeleteList = deleteListPath
+	wrapper = NewImportWrapper(ctx, schema, 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, options)
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperRowBasedParallel(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	// each file has different count of rows, so that the files are not parsed in the same speed
+	files := make([]string, 0)
+	for i := 0; i < 6; i++ {
+		content := bytes.NewBufferString(`{"rows":[`)
+		for j := 0; j < (i%3+1)*100; j++ {
+			if j > 0 {
+				content.WriteString(",")
+			}
+			pk := strconv.Itoa(i*10000 + j)
+			content.WriteString(`{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": ` + pk +
+				`, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]}`)
+		}
+		content.WriteString(`]}`)
+
+		filePath := TempFilesPath + "rows_" + strconv.Itoa(i) + ".json"
+		err = cm.Write(ctx, filePath, content.Bytes())
+		assert.NoError(t, err)
+		files = append(files, filePath)
+	}
+
+	// returns the primary keys of each flushed block, in the order of flush
+	importFunc := func(parallelism int, files []string) ([][]int64, *rootcoordpb.ImportResult, error) {
+		flushed := make([][]int64, 0)
+		createBinlogFunc := func(fields map[storage.FieldID]storage.FieldData, segmentID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
+			flushed = append(flushed, fields[106].(*storage.Int64FieldData).Data)
+			return nil, nil, nil
+		}
+		assignSegmentFunc := func(shardID int) (int64, string, error) {
+			return 100, "ch", nil
+		}
+		saveSegmentFunc := func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64) error {
+			return nil
+		}
+
+		importResult := &rootcoordpb.ImportResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			TaskId:     1,
+			DatanodeId: 1,
+			State:      commonpb.ImportState_ImportStarted,
+			Segments:   make([]int64, 0),
+			AutoIds:    make([]int64, 0),
+			RowCount:   0,
+		}
+		reportFunc := func(res *rootcoordpb.ImportResult) error {
+			return nil
+		}
+		wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, createBinlogFunc, saveSegmentFunc)
+		wrapper.SetParseParallelism(parallelism)
+		doneFiles := make([]string, 0)
+		wrapper.SetFileDoneFunc(func(filePath string) {
+			doneFiles = append(doneFiles, filePath)
+		})
+		err := wrapper.Import(files, DefaultImportOptions())
+		if err == nil {
+			assert.Equal(t, files, doneFiles)
+		}
+		return flushed, importResult, err
+	}
+
+	expected, importResult, err := importFunc(1, files)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	rowCount := 0
+	for _, pks := range expected {
+		rowCount += len(pks)
+	}
+	assert.Equal(t, 1200, rowCount)
+
+	// the blocks are flushed in the same order no matter how many files are parsed concurrently
+	for _, parallelism := range []int{0, 3, 6, 10} {
+		flushed, importResult, err := importFunc(parallelism, files)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, flushed)
+		progress, err := funcutil.GetAttrByKeyFromRepeatedKV(ProgressPercent, importResult.GetInfos())
+		assert.NoError(t, err)
+		assert.Equal(t, "100", progress)
+	}
+
+	// parse error in the middle of files
+	filePath := TempFilesPath + "rows_illegal.json"
+	err = cm.Write(ctx, filePath, []byte(`{"rows":[{"FieldBool": true, "FieldInt8": false}]}`))
+	assert.NoError(t, err)
+	illegalFiles := []string{files[0], files[1], filePath, files[2], files[3]}
+	_, importResult, err = importFunc(4, illegalFiles)
+	assert.Error(t, err)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	progress, err := funcutil.GetAttrByKeyFromRepeatedKV(ProgressPercent, importResult.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "40", progress)
+}
+
+func Test_ImportWrapperParseParallelism(t *testing.T) {
+	wrapper := NewImportWrapper(context.Background(), sampleSchema(), 2, 1, nil, nil, nil, nil)
+	assert.Equal(t, runtime.GOMAXPROCS(0), wrapper.getParseParallelism(1024, 0))
+	assert.Equal(t, 1, wrapper.getParseParallelism(1, wrapper.rowBasedWorkerMemSize()))
+
+	wrapper.SetParseParallelism(8)
+	assert.Equal(t, 8, wrapper.getParseParallelism(10, wrapper.rowBasedWorkerMemSize()))
+	assert.Equal(t, 8, wrapper.getParseParallelism(10, 0))
+
+	// the buffers of the workers are kept under MaxTotalSizeInMemory
+	wrapper.shardNum = 16
+	assert.Equal(t, 3, wrapper.getParseParallelism(10, wrapper.rowBasedWorkerMemSize()))
+	wrapper.shardNum = 256
+	assert.Equal(t, 1, wrapper.getParseParallelism(10, wrapper.rowBasedWorkerMemSize()))
+}
+
 func createSampleNumpyFiles(t *testing.T, cm storage.ChunkManager) []string {
 	ctx := context.Background()
 	files := make([]string, 0)
@@ -425,9 +715,14 @@ func Test_ImportWrapperColumnBased_numpy(t *testing.T) {
 	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
 
 	files := createSampleNumpyFiles(t, cm)
+	var doneFiles int32
+	wrapper.SetFileDoneFunc(func(filePath string) {
+		atomic.AddInt32(&doneFiles, 1)
+	})
 	err = wrapper.Import(files, DefaultImportOptions())
 	assert.Nil(t, err)
 	assert.Equal(t, 5, rowCounter.rowCount)
+	assert.Equal(t, int32(len(files)), atomic.LoadInt32(&doneFiles))
 	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
 
 	// row count of fields not equal
@@ -736,6 +1031,17 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, rowBased)
 
+	files = []string{"a/1.csv", "b/2.json"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
+	// unsupported file for row-based csv
+	files = []string{"a/uid.csv", "b/bol.npy"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.True(t, rowBased)
+
 	// empty file
 	cm.size = 0
 	wrapper = NewImportWrapper(ctx, schema, int32(shardNum), int64(segmentSize), idAllocator, cm, nil, nil)
@@ -79,9 +79,14 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 }
 
 func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
+	parser.bufSize = estimateBufSize(collectionSchema)
+}
+
+// estimateBufSize returns the max rows in a buffer of the row-based parsers by the size of the rows.
+func estimateBufSize(collectionSchema *schemapb.CollectionSchema) int64 {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
-		return
+		return MinBufferSize
 	}
 
 	// split the file into no more than MaxBatchCount batches to parse
@@ -95,8 +100,8 @@ func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSche
 		bufSize = MinBufferSize
 	}
 
-	log.Info("JSON parser: reset bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
-	parser.bufSize = int64(bufSize)
+	log.Info("row-based parser: reset bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
+	return int64(bufSize)
 }
 
 func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}, error) {
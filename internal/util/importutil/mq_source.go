@@ -0,0 +1,668 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/linkedin/goavro/v2"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	pulsarmq "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+// message queue import sources are passed in the file list of the import request as URIs:
+//
+//	kafka://broker1:9092,broker2:9092/topic?start=100&end=200&format=json
+//	kafka://broker1:9092/topic?start=0:100,1:120&end=0:200
+//	pulsar://host:6650/tenant/namespace/topic?start=<id>&end=<id>&format=avro&avro_schema=<schema>
+//
+// start and end are inclusive. All the partitions of a kafka topic are consumed, a kafka offset applies to
+// all the partitions and partition:offset pairs set the offsets of the listed partitions, the end offsets are
+// capped by the latest offsets at the time the import begins. Pulsar ids are the serialized message ids
+// encoded by url-safe base64. Without start the topic is consumed from the earliest
+// message, without end the topic is consumed until the latest message at the time the import begins.
+// The brokers of a source must be in the broker allowlist of the data node.
+// A json payload is a row object or an array of row objects, an avro payload is a binary encoded record
+// of the avro_schema whose fields are mapped to the collection fields by name.
+const (
+	MQSourceKafka  = "kafka"
+	MQSourcePulsar = "pulsar"
+
+	MQPayloadJSON = "json"
+	MQPayloadAvro = "avro"
+
+	mqSourceStartKey      = "start"
+	mqSourceEndKey        = "end"
+	mqSourceFormatKey     = "format"
+	mqSourceAvroSchemaKey = "avro_schema"
+
+	pulsarDefaultTenant    = "public"
+	pulsarDefaultNamespace = "default"
+)
+
+// mqSourceIdleTimeout is the max time to wait for the next message before the end position is reached
+var mqSourceIdleTimeout = 30 * time.Second
+
+// kafkaSourceTimeout is the timeout of the kafka metadata requests
+const kafkaSourceTimeout = 10 * time.Second
+
+// newMQSourceClient creates the pulsar client of a source, it is replaced in unittest
+var newMQSourceClient = func(source *mqSource) (mqwrapper.Client, error) {
+	return pulsarmq.NewClient(source.Tenant, source.Namespace, pulsar.ClientOptions{URL: "pulsar://" + source.Address})
+}
+
+// kafkaSourceConsumer consumes the partitions of a kafka topic
+type kafkaSourceConsumer interface {
+	// watermarks returns the low and high watermark offsets of all the partitions of the topic
+	watermarks(topic string) (map[int32][2]int64, error)
+	// assign starts to consume the partitions from the offsets
+	assign(topic string, offsets map[int32]int64) error
+	// poll returns the next message, or nil if no message arrives in the timeout
+	poll(timeout time.Duration) (*kafka.Message, error)
+	close()
+}
+
+// newKafkaSourceConsumer creates the kafka consumer of a source, it is replaced in unittest
+var newKafkaSourceConsumer = func(source *mqSource) (kafkaSourceConsumer, error) {
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  source.Address,
+		"group.id":           fmt.Sprintf("import-%s-%s", source.Topic, funcutil.RandomString(8)),
+		"enable.auto.commit": false,
+		"auto.offset.reset":  "earliest",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &confluentSourceConsumer{c: c}, nil
+}
+
+type confluentSourceConsumer struct {
+	c *kafka.Consumer
+}
+
+func (kc *confluentSourceConsumer) watermarks(topic string) (map[int32][2]int64, error) {
+	metadata, err := kc.c.GetMetadata(&topic, false, int(kafkaSourceTimeout.Milliseconds()))
+	if err != nil {
+		return nil, err
+	}
+	topicMeta, ok := metadata.Topics[topic]
+	if !ok {
+		return nil, fmt.Errorf("topic '%s' not found", topic)
+	}
+	if topicMeta.Error.Code() != kafka.ErrNoError {
+		return nil, topicMeta.Error
+	}
+	ret := make(map[int32][2]int64, len(topicMeta.Partitions))
+	for _, partition := range topicMeta.Partitions {
+		low, high, err := kc.c.QueryWatermarkOffsets(topic, partition.ID, int(kafkaSourceTimeout.Milliseconds()))
+		if err != nil {
+			return nil, err
+		}
+		ret[partition.ID] = [2]int64{low, high}
+	}
+	return ret, nil
+}
+
+func (kc *confluentSourceConsumer) assign(topic string, offsets map[int32]int64) error {
+	partitions := make([]kafka.TopicPartition, 0, len(offsets))
+	for partition, offset := range offsets {
+		partitions = append(partitions, kafka.TopicPartition{Topic: &topic, Partition: partition, Offset: kafka.Offset(offset)})
+	}
+	return kc.c.Assign(partitions)
+}
+
+func (kc *confluentSourceConsumer) poll(timeout time.Duration) (*kafka.Message, error) {
+	switch e := kc.c.Poll(int(timeout.Milliseconds())).(type) {
+	case *kafka.Message:
+		if e.TopicPartition.Error != nil {
+			return nil, e.TopicPartition.Error
+		}
+		return e, nil
+	case kafka.Error:
+		// the client retries the non-fatal errors by itself
+		if e.IsFatal() {
+			return nil, e
+		}
+	}
+	return nil, nil
+}
+
+func (kc *confluentSourceConsumer) close() {
+	kc.c.Close()
+}
+
+// kafkaOffsets are the start or end offsets of a kafka source
+type kafkaOffsets struct {
+	all        int64 // the offset of all the partitions, -1 if not set
+	partitions map[int32]int64
+}
+
+func parseKafkaOffsets(value string) (*kafkaOffsets, error) {
+	offsets := &kafkaOffsets{all: -1, partitions: make(map[int32]int64)}
+	if value == "" {
+		return offsets, nil
+	}
+	if !strings.Contains(value, ":") {
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid kafka offset '%s'", value)
+		}
+		offsets.all = v
+		return offsets, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid kafka partition offset '%s'", pair)
+		}
+		partition, err1 := strconv.ParseInt(kv[0], 10, 32)
+		offset, err2 := strconv.ParseInt(kv[1], 10, 64)
+		if err1 != nil || err2 != nil || partition < 0 || offset < 0 {
+			return nil, fmt.Errorf("invalid kafka partition offset '%s'", pair)
+		}
+		offsets.partitions[int32(partition)] = offset
+	}
+	return offsets, nil
+}
+
+// get returns the offset of a partition, or def if the offset is not set
+func (o *kafkaOffsets) get(partition int32, def int64) int64 {
+	if offset, ok := o.partitions[partition]; ok {
+		return offset
+	}
+	if o.all >= 0 {
+		return o.all
+	}
+	return def
+}
+
+// mqSource describes a range of messages of a topic to be imported
+type mqSource struct {
+	URI        string
+	Scheme     string
+	Address    string
+	Tenant     string
+	Namespace  string
+	Topic      string
+	Start      string
+	End        string
+	Format     string
+	AvroSchema string
+
+	KafkaStart *kafkaOffsets
+	KafkaEnd   *kafkaOffsets
+}
+
+// IsMQSource checks whether the path of an import file is a message queue source
+func IsMQSource(filePath string) bool {
+	return strings.HasPrefix(filePath, MQSourceKafka+"://") || strings.HasPrefix(filePath, MQSourcePulsar+"://")
+}
+
+// parseMQSource parses a message queue source URI
+func parseMQSource(uri string) (*mqSource, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message queue source '%s', error: %w", uri, err)
+	}
+	source := &mqSource{
+		URI:     uri,
+		Scheme:  u.Scheme,
+		Address: u.Host,
+		Start:   u.Query().Get(mqSourceStartKey),
+		End:     u.Query().Get(mqSourceEndKey),
+		Format:  strings.ToLower(u.Query().Get(mqSourceFormatKey)),
+	}
+	if source.Address == "" {
+		return nil, fmt.Errorf("message queue source '%s' has no address", uri)
+	}
+
+	path := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case source.Scheme == MQSourceKafka && len(path) == 1:
+		source.Topic = path[0]
+	case source.Scheme == MQSourcePulsar && len(path) == 1:
+		source.Tenant, source.Namespace, source.Topic = pulsarDefaultTenant, pulsarDefaultNamespace, path[0]
+	case source.Scheme == MQSourcePulsar && len(path) == 3:
+		source.Tenant, source.Namespace, source.Topic = path[0], path[1], path[2]
+	default:
+		return nil, fmt.Errorf("invalid topic path '%s' of message queue source '%s'", u.Path, uri)
+	}
+	if source.Topic == "" {
+		return nil, fmt.Errorf("message queue source '%s' has no topic", uri)
+	}
+
+	if source.Scheme == MQSourceKafka {
+		if source.KafkaStart, err = parseKafkaOffsets(source.Start); err != nil {
+			return nil, fmt.Errorf("invalid start of message queue source '%s', error: %w", uri, err)
+		}
+		if source.KafkaEnd, err = parseKafkaOffsets(source.End); err != nil {
+			return nil, fmt.Errorf("invalid end of message queue source '%s', error: %w", uri, err)
+		}
+	}
+
+	switch source.Format {
+	case "":
+		source.Format = MQPayloadJSON
+	case MQPayloadJSON:
+	case MQPayloadAvro:
+		source.AvroSchema = u.Query().Get(mqSourceAvroSchemaKey)
+		if source.AvroSchema == "" {
+			return nil, fmt.Errorf("avro schema is required by message queue source '%s'", uri)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported payload format '%s' of message queue source '%s'", source.Format, uri)
+	}
+	return source, nil
+}
+
+// checkMQSourceBrokers checks that all the brokers of the source are in the allowlist, an allowlist entry
+// without port allows the host on any port
+func checkMQSourceBrokers(source *mqSource, allowlist []string) error {
+	for _, broker := range strings.Split(source.Address, ",") {
+		broker = strings.TrimSpace(broker)
+		host := broker
+		if h, _, err := net.SplitHostPort(broker); err == nil {
+			host = h
+		}
+		allowed := false
+		for _, entry := range allowlist {
+			entry = strings.TrimSpace(entry)
+			if entry != "" && (entry == broker || entry == host) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("broker '%s' of message queue source '%s' is not in the broker allowlist", broker, source.URI)
+		}
+	}
+	return nil
+}
+
+// mqSourceValidation checks the message queue sources, all the sources of an import must be message queue sources
+// whose brokers are in the allowlist
+func mqSourceValidation(filePaths []string, allowlist []string) error {
+	for _, filePath := range filePaths {
+		if !IsMQSource(filePath) {
+			log.Error("import wrapper: files and message queue sources cannot be imported together", zap.String("filePath", filePath))
+			return fmt.Errorf("files and message queue sources cannot be imported together: '%s'", filePath)
+		}
+		source, err := parseMQSource(filePath)
+		if err != nil {
+			log.Error("import wrapper: invalid message queue source", zap.String("filePath", filePath), zap.Error(err))
+			return err
+		}
+		if err = checkMQSourceBrokers(source, allowlist); err != nil {
+			log.Error("import wrapper: message queue source not allowed", zap.String("filePath", filePath), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// SetMQBrokerAllowlist sets the brokers of the message queue sources allowed to be imported from, an entry is a
+// host:port or a host, the message queue sources are rejected if the allowlist is empty
+func (p *ImportWrapper) SetMQBrokerAllowlist(allowlist []string) {
+	p.mqBrokerAllowlist = allowlist
+}
+
+// toMsgID converts the start/end position of a pulsar source to a message id
+func (s *mqSource) toMsgID(client mqwrapper.Client, position string) (mqwrapper.MessageID, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(position, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pulsar message id '%s', error: %w", position, err)
+	}
+	return client.BytesToMsgID(bs)
+}
+
+// payloadDecoder converts a message payload to the json rows
+type payloadDecoder func(payload []byte) ([]json.RawMessage, error)
+
+func newPayloadDecoder(source *mqSource) (payloadDecoder, error) {
+	if source.Format == MQPayloadJSON {
+		return decodeJSONPayload, nil
+	}
+	codec, err := goavro.NewCodec(source.AvroSchema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid avro schema of message queue source '%s', error: %w", source.URI, err)
+	}
+	return func(payload []byte) ([]json.RawMessage, error) {
+		native, _, err := codec.NativeFromBinary(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode avro payload, error: %w", err)
+		}
+		row, err := json.Marshal(avroNativeToJSON(native))
+		if err != nil {
+			return nil, err
+		}
+		return []json.RawMessage{row}, nil
+	}, nil
+}
+
+// decodeJSONPayload accepts a row object or an array of row objects
+func decodeJSONPayload(payload []byte) ([]json.RawMessage, error) {
+	trimmed := strings.TrimSpace(string(payload))
+	if strings.HasPrefix(trimmed, "[") {
+		rows := make([]json.RawMessage, 0)
+		if err := json.Unmarshal([]byte(trimmed), &rows); err != nil {
+			return nil, fmt.Errorf("failed to decode json payload, error: %w", err)
+		}
+		return rows, nil
+	}
+	if !strings.HasPrefix(trimmed, "{") || !json.Valid([]byte(trimmed)) {
+		return nil, errors.New("json payload must be a row object or an array of row objects")
+	}
+	return []json.RawMessage{json.RawMessage(trimmed)}, nil
+}
+
+// avroNativeToJSON converts the avro bytes to arrays of uint8 which is the json format of binary vector
+func avroNativeToJSON(native interface{}) interface{} {
+	switch v := native.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = avroNativeToJSON(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = avroNativeToJSON(value)
+		}
+		return v
+	case []byte:
+		arr := make([]int, len(v))
+		for i, b := range v {
+			arr[i] = int(b)
+		}
+		return arr
+	}
+	return native
+}
+
+// mqRowWriter writes the rows of the messages as a row-based json document
+type mqRowWriter struct {
+	source   *mqSource
+	writer   io.Writer
+	decode   payloadDecoder
+	messages int
+	rows     int
+}
+
+func (w *mqRowWriter) begin() error {
+	_, err := io.WriteString(w.writer, "{\""+RowRootNode+"\": [")
+	return err
+}
+
+func (w *mqRowWriter) write(payload []byte) error {
+	values, err := w.decode(payload)
+	if err != nil {
+		return fmt.Errorf("invalid message %d of message queue source '%s', error: %w", w.messages, w.source.URI, err)
+	}
+	for _, value := range values {
+		if w.rows > 0 {
+			if _, err = io.WriteString(w.writer, ","); err != nil {
+				return err
+			}
+		}
+		if _, err = w.writer.Write(value); err != nil {
+			return err
+		}
+		w.rows++
+	}
+	w.messages++
+	return nil
+}
+
+func (w *mqRowWriter) end() error {
+	_, err := io.WriteString(w.writer, "]}")
+	log.Info("import wrapper: message queue source consumed", zap.String("source", w.source.URI),
+		zap.Int("messages", w.messages), zap.Int("rows", w.rows))
+	return err
+}
+
+// errEmptyMQSource returns the error of a source without message in its range
+func errEmptyMQSource(source *mqSource) error {
+	log.Warn("import wrapper: no message to import from message queue source", zap.String("source", source.URI))
+	return fmt.Errorf("message queue source '%s' has no message to import", source.URI)
+}
+
+// readMQSource consumes the messages of the source and writes them to the writer as a row-based json document
+func readMQSource(ctx context.Context, source *mqSource, writer io.Writer) error {
+	decode, err := newPayloadDecoder(source)
+	if err != nil {
+		return err
+	}
+	w := &mqRowWriter{source: source, writer: writer, decode: decode}
+	if source.Scheme == MQSourceKafka {
+		return readKafkaSource(ctx, source, w)
+	}
+	return readPulsarSource(ctx, source, w)
+}
+
+// readKafkaSource consumes all the partitions of a kafka topic, each partition from its start offset to its
+// end offset
+func readKafkaSource(ctx context.Context, source *mqSource, w *mqRowWriter) error {
+	consumer, err := newKafkaSourceConsumer(source)
+	if err != nil {
+		return fmt.Errorf("failed to connect message queue source '%s', error: %w", source.URI, err)
+	}
+	defer consumer.close()
+
+	watermarks, err := consumer.watermarks(source.Topic)
+	if err != nil {
+		return fmt.Errorf("failed to get partitions of message queue source '%s', error: %w", source.URI, err)
+	}
+	starts := make(map[int32]int64)
+	ends := make(map[int32]int64)
+	for partition, watermark := range watermarks {
+		low, high := watermark[0], watermark[1]
+		start := source.KafkaStart.get(partition, low)
+		if start < low {
+			log.Warn("import wrapper: start offset of message queue source is out of retention",
+				zap.String("source", source.URI), zap.Int32("partition", partition),
+				zap.Int64("start", start), zap.Int64("low", low))
+			start = low
+		}
+		// the messages produced after the import begins are not imported
+		end := source.KafkaEnd.get(partition, high-1)
+		if end > high-1 {
+			end = high - 1
+		}
+		if start > end {
+			continue
+		}
+		starts[partition], ends[partition] = start, end
+	}
+	if len(starts) == 0 {
+		return errEmptyMQSource(source)
+	}
+	if err = consumer.assign(source.Topic, starts); err != nil {
+		return fmt.Errorf("failed to assign partitions of message queue source '%s', error: %w", source.URI, err)
+	}
+
+	if err = w.begin(); err != nil {
+		return err
+	}
+	lastReceived := time.Now()
+	for len(ends) > 0 {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		msg, err := consumer.poll(100 * time.Millisecond)
+		if err != nil {
+			return fmt.Errorf("failed to consume message queue source '%s', error: %w", source.URI, err)
+		}
+		if msg == nil {
+			if time.Since(lastReceived) > mqSourceIdleTimeout {
+				return fmt.Errorf("no message received from message queue source '%s' in %v, %d messages consumed",
+					source.URI, mqSourceIdleTimeout, w.messages)
+			}
+			continue
+		}
+		lastReceived = time.Now()
+
+		partition, offset := msg.TopicPartition.Partition, int64(msg.TopicPartition.Offset)
+		end, ok := ends[partition]
+		if !ok {
+			continue
+		}
+		// the offsets of a compacted topic have gaps, the end offset may be skipped
+		if offset <= end {
+			if err = w.write(msg.Value); err != nil {
+				return err
+			}
+		}
+		if offset >= end {
+			delete(ends, partition)
+		}
+	}
+	return w.end()
+}
+
+// readPulsarSource consumes a pulsar topic from the start message to the end message
+func readPulsarSource(ctx context.Context, source *mqSource, w *mqRowWriter) error {
+	client, err := newMQSourceClient(source)
+	if err != nil {
+		return fmt.Errorf("failed to connect message queue source '%s', error: %w", source.URI, err)
+	}
+	defer client.Close()
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       source.Topic,
+		SubscriptionName:            fmt.Sprintf("import-%s-%s", source.Topic, funcutil.RandomString(8)),
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe message queue source '%s', error: %w", source.URI, err)
+	}
+	defer consumer.Close()
+
+	if source.Start != "" {
+		startID, err := source.toMsgID(client, source.Start)
+		if err != nil {
+			return err
+		}
+		if err = consumer.Seek(startID, true); err != nil {
+			return fmt.Errorf("failed to seek message queue source '%s', error: %w", source.URI, err)
+		}
+	}
+
+	var endID mqwrapper.MessageID
+	if source.End != "" {
+		endID, err = source.toMsgID(client, source.End)
+	} else {
+		endID, err = consumer.GetLatestMsgID()
+		// the latest message id of an empty topic is at the earliest position
+		if err == nil && endID.AtEarliestPosition() {
+			return errEmptyMQSource(source)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get end position of message queue source '%s', error: %w", source.URI, err)
+	}
+	end := endID.Serialize()
+
+	if err = w.begin(); err != nil {
+		return err
+	}
+	timer := time.NewTimer(mqSourceIdleTimeout)
+	defer timer.Stop()
+	for {
+		var msg mqwrapper.Message
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return fmt.Errorf("no message received from message queue source '%s' in %v, %d messages consumed",
+				source.URI, mqSourceIdleTimeout, w.messages)
+		case msg = <-consumer.Chan():
+		}
+		if msg == nil {
+			return fmt.Errorf("message queue source '%s' is closed, %d messages consumed", source.URI, w.messages)
+		}
+		consumer.Ack(msg)
+		inRange, err := msg.ID().LessOrEqualThan(end)
+		if err != nil {
+			return err
+		}
+		if !inRange {
+			break
+		}
+
+		if err = w.write(msg.Payload()); err != nil {
+			return err
+		}
+
+		if reached, err := msg.ID().Equal(end); err != nil {
+			return err
+		} else if reached {
+			break
+		}
+		if !timer.Stop() {
+			<-timer.C
+		}
+		timer.Reset(mqSourceIdleTimeout)
+	}
+	return w.end()
+}
+
+// parseMQSource is the entry of the message queue import operation, the messages are parsed and consumed
+// as a row-based json file
+func (p *ImportWrapper) parseMQSource(ctx context.Context, uri string, flushFunc ImportFlushFunc) ([]int64, error) {
+	tr := timerecord.NewTimeRecorder("message queue parser: " + uri)
+
+	source, err := parseMQSource(uri)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkMQSourceBrokers(source, p.mqBrokerAllowlist); err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		writer.CloseWithError(readMQSource(ctx, source, writer))
+	}()
+	defer reader.Close()
+
+	ids, err := p.parseRowBasedJSONReader(ctx, reader, flushFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	tr.Elapse("parsed")
+	return ids, nil
+}
@@ -0,0 +1,448 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+type mockMQSourceID int64
+
+func (id mockMQSourceID) Serialize() []byte {
+	bs := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bs, uint64(id))
+	return bs
+}
+
+func (id mockMQSourceID) AtEarliestPosition() bool {
+	return id == 0
+}
+
+func (id mockMQSourceID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return int64(id) <= int64(binary.LittleEndian.Uint64(msgID)), nil
+}
+
+func (id mockMQSourceID) Equal(msgID []byte) (bool, error) {
+	return int64(id) == int64(binary.LittleEndian.Uint64(msgID)), nil
+}
+
+type mockMQSourceMessage struct {
+	id      mockMQSourceID
+	payload []byte
+}
+
+func (m *mockMQSourceMessage) Topic() string                 { return "topic" }
+func (m *mockMQSourceMessage) Properties() map[string]string { return nil }
+func (m *mockMQSourceMessage) Payload() []byte               { return m.payload }
+func (m *mockMQSourceMessage) ID() mqwrapper.MessageID       { return m.id }
+
+type mockMQSourceConsumer struct {
+	mqwrapper.Consumer
+	payloads [][]byte
+	start    int
+	ch       chan mqwrapper.Message
+}
+
+func (c *mockMQSourceConsumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	c.start = int(id.(mockMQSourceID))
+	return nil
+}
+
+func (c *mockMQSourceConsumer) Chan() <-chan mqwrapper.Message {
+	if c.ch == nil {
+		c.ch = make(chan mqwrapper.Message, len(c.payloads))
+		for i := c.start; i < len(c.payloads); i++ {
+			c.ch <- &mockMQSourceMessage{id: mockMQSourceID(i), payload: c.payloads[i]}
+		}
+	}
+	return c.ch
+}
+
+func (c *mockMQSourceConsumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	return mockMQSourceID(len(c.payloads) - 1), nil
+}
+
+func (c *mockMQSourceConsumer) Ack(mqwrapper.Message) {}
+func (c *mockMQSourceConsumer) Close()                {}
+
+type mockMQSourceClient struct {
+	mqwrapper.Client
+	consumer *mockMQSourceConsumer
+}
+
+func (c *mockMQSourceClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	return c.consumer, nil
+}
+
+func (c *mockMQSourceClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	if len(id) != 8 {
+		return nil, errors.New("invalid id")
+	}
+	return mockMQSourceID(binary.LittleEndian.Uint64(id)), nil
+}
+
+func (c *mockMQSourceClient) Close() {}
+
+func mockMQSource(t *testing.T, payloads [][]byte) {
+	origin := newMQSourceClient
+	t.Cleanup(func() { newMQSourceClient = origin })
+	newMQSourceClient = func(source *mqSource) (mqwrapper.Client, error) {
+		return &mockMQSourceClient{consumer: &mockMQSourceConsumer{payloads: payloads}}, nil
+	}
+}
+
+type mockKafkaSourceConsumer struct {
+	partitions map[int32][][]byte
+	lag        int64 // the messages not arrived yet
+	messages   []*kafka.Message
+	assigned   map[int32]int64
+}
+
+func (c *mockKafkaSourceConsumer) watermarks(topic string) (map[int32][2]int64, error) {
+	ret := make(map[int32][2]int64)
+	for partition, payloads := range c.partitions {
+		ret[partition] = [2]int64{0, int64(len(payloads)) + c.lag}
+	}
+	return ret, nil
+}
+
+func (c *mockKafkaSourceConsumer) assign(topic string, offsets map[int32]int64) error {
+	c.assigned = offsets
+	// interleave the messages of the partitions
+	for i := 0; ; i++ {
+		found := false
+		for partition := int32(0); partition < int32(len(c.partitions)); partition++ {
+			offset := offsets[partition] + int64(i)
+			if _, ok := offsets[partition]; !ok || offset >= int64(len(c.partitions[partition])) {
+				continue
+			}
+			found = true
+			c.messages = append(c.messages, &kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: partition, Offset: kafka.Offset(offset)},
+				Value:          c.partitions[partition][offset],
+			})
+		}
+		if !found {
+			return nil
+		}
+	}
+}
+
+func (c *mockKafkaSourceConsumer) poll(timeout time.Duration) (*kafka.Message, error) {
+	if len(c.messages) == 0 {
+		time.Sleep(timeout)
+		return nil, nil
+	}
+	msg := c.messages[0]
+	c.messages = c.messages[1:]
+	return msg, nil
+}
+
+func (c *mockKafkaSourceConsumer) close() {}
+
+func mockKafkaSource(t *testing.T, partitions map[int32][][]byte) *mockKafkaSourceConsumer {
+	origin := newKafkaSourceConsumer
+	t.Cleanup(func() { newKafkaSourceConsumer = origin })
+	consumer := &mockKafkaSourceConsumer{partitions: partitions}
+	newKafkaSourceConsumer = func(source *mqSource) (kafkaSourceConsumer, error) {
+		return consumer, nil
+	}
+	return consumer
+}
+
+func pulsarSourceID(id int64) string {
+	return base64.RawURLEncoding.EncodeToString(mockMQSourceID(id).Serialize())
+}
+
+func Test_ParseMQSource(t *testing.T) {
+	assert.True(t, IsMQSource("kafka://localhost:9092/topic"))
+	assert.True(t, IsMQSource("pulsar://localhost:6650/topic"))
+	assert.False(t, IsMQSource("/tmp/rows.json"))
+
+	source, err := parseMQSource("kafka://broker1:9092,broker2:9092/topic?start=10&end=20")
+	assert.NoError(t, err)
+	assert.Equal(t, MQSourceKafka, source.Scheme)
+	assert.Equal(t, "broker1:9092,broker2:9092", source.Address)
+	assert.Equal(t, "topic", source.Topic)
+	assert.Equal(t, "10", source.Start)
+	assert.Equal(t, "20", source.End)
+	assert.Equal(t, int64(10), source.KafkaStart.get(1, 0))
+	assert.Equal(t, int64(20), source.KafkaEnd.get(1, 0))
+	assert.Equal(t, MQPayloadJSON, source.Format)
+
+	source, err = parseMQSource("kafka://localhost:9092/topic?start=0:10,2:30")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), source.KafkaStart.get(0, 5))
+	assert.Equal(t, int64(5), source.KafkaStart.get(1, 5))
+	assert.Equal(t, int64(30), source.KafkaStart.get(2, 5))
+	assert.Equal(t, int64(7), source.KafkaEnd.get(0, 7))
+
+	source, err = parseMQSource("pulsar://localhost:6650/topic")
+	assert.NoError(t, err)
+	assert.Equal(t, pulsarDefaultTenant, source.Tenant)
+	assert.Equal(t, pulsarDefaultNamespace, source.Namespace)
+
+	source, err = parseMQSource("pulsar://localhost:6650/tenant/ns/topic?format=avro&avro_schema=%22int%22")
+	assert.NoError(t, err)
+	assert.Equal(t, "tenant", source.Tenant)
+	assert.Equal(t, "ns", source.Namespace)
+	assert.Equal(t, MQPayloadAvro, source.Format)
+	assert.Equal(t, `"int"`, source.AvroSchema)
+
+	for _, uri := range []string{
+		"kafka:///topic",
+		"kafka://localhost:9092/",
+		"kafka://localhost:9092/a/b",
+		"kafka://localhost:9092/topic?start=-1",
+		"kafka://localhost:9092/topic?end=abc",
+		"kafka://localhost:9092/topic?start=0:1,2",
+		"kafka://localhost:9092/topic?start=-1:1",
+		"kafka://localhost:9092/topic?format=csv",
+		"kafka://localhost:9092/topic?format=avro",
+		"pulsar://localhost:6650/tenant/topic",
+	} {
+		_, err = parseMQSource(uri)
+		assert.Error(t, err, uri)
+	}
+
+	allowlist := []string{"localhost:9092", "pulsar-host"}
+	assert.NoError(t, mqSourceValidation([]string{"kafka://localhost:9092/a", "kafka://localhost:9092/b"}, allowlist))
+	assert.NoError(t, mqSourceValidation([]string{"pulsar://pulsar-host:6650/a"}, allowlist))
+	assert.Error(t, mqSourceValidation([]string{"kafka://localhost:9092/a", "/tmp/rows.json"}, allowlist))
+	assert.Error(t, mqSourceValidation([]string{"kafka://localhost:9092/a?format=xml"}, allowlist))
+	// brokers not in the allowlist
+	assert.Error(t, mqSourceValidation([]string{"kafka://localhost:9093/a"}, allowlist))
+	assert.Error(t, mqSourceValidation([]string{"kafka://localhost:9092,evil:9092/a"}, allowlist))
+	assert.Error(t, mqSourceValidation([]string{"kafka://localhost:9092/a"}, nil))
+	assert.Error(t, mqSourceValidation([]string{"kafka://localhost:9092/a"}, []string{""}))
+}
+
+func Test_DecodeMQPayload(t *testing.T) {
+	rows, err := decodeJSONPayload([]byte(` {"a": 1} `))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+
+	rows, err = decodeJSONPayload([]byte(`[{"a": 1}, {"a": 2}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rows))
+
+	_, err = decodeJSONPayload([]byte(`1`))
+	assert.Error(t, err)
+	_, err = decodeJSONPayload([]byte(`{"a": `))
+	assert.Error(t, err)
+	_, err = decodeJSONPayload([]byte(`[{"a": 1}`))
+	assert.Error(t, err)
+
+	_, err = newPayloadDecoder(&mqSource{Format: MQPayloadAvro, AvroSchema: "invalid"})
+	assert.Error(t, err)
+
+	schema := `{"type": "record", "name": "row", "fields": [
+		{"name": "FieldInt64", "type": "long"},
+		{"name": "FieldBinaryVector", "type": "bytes"},
+		{"name": "FieldFloatVector", "type": {"type": "array", "items": "float"}}]}`
+	decode, err := newPayloadDecoder(&mqSource{Format: MQPayloadAvro, AvroSchema: schema})
+	assert.NoError(t, err)
+	codec, err := goavro.NewCodec(schema)
+	assert.NoError(t, err)
+	payload, err := codec.BinaryFromNative(nil, map[string]interface{}{
+		"FieldInt64":        int64(100),
+		"FieldBinaryVector": []byte{254, 0},
+		"FieldFloatVector":  []float32{1.5, 2.5},
+	})
+	assert.NoError(t, err)
+	rows, err = decode(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.JSONEq(t, `{"FieldInt64": 100, "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.5, 2.5]}`, string(rows[0]))
+
+	_, err = decode([]byte{0xff})
+	assert.Error(t, err)
+}
+
+func Test_ReadKafkaSource(t *testing.T) {
+	ctx := context.Background()
+	read := func(uri string) (string, error) {
+		source, err := parseMQSource(uri)
+		assert.NoError(t, err)
+		buf := &bytes.Buffer{}
+		err = readMQSource(ctx, source, buf)
+		return buf.String(), err
+	}
+
+	partitions := map[int32][][]byte{
+		0: {[]byte(`{"a": 0}`), []byte(`[{"a": 1}, {"a": 2}]`), []byte(`{"a": 3}`)},
+		1: {[]byte(`{"a": 10}`), []byte(`{"a": 11}`)},
+		2: {},
+	}
+
+	// all the partitions to the latest messages
+	mockKafkaSource(t, partitions)
+	content, err := read("kafka://localhost:9092/topic")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"a": 0}, {"a": 10}, {"a": 1}, {"a": 2}, {"a": 11}, {"a": 3}]}`, content)
+
+	// an offset range of all the partitions, the end offsets are capped by the latest offsets
+	consumer := mockKafkaSource(t, partitions)
+	content, err = read("kafka://localhost:9092/topic?start=1&end=1")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"a": 1}, {"a": 2}, {"a": 11}]}`, content)
+	assert.Equal(t, map[int32]int64{0: 1, 1: 1}, consumer.assigned)
+
+	// offsets of the partitions
+	mockKafkaSource(t, partitions)
+	content, err = read("kafka://localhost:9092/topic?start=0:2,1:1&end=0:10")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"a": 3}, {"a": 11}]}`, content)
+
+	// empty topic and empty range
+	mockKafkaSource(t, map[int32][][]byte{0: {}, 1: {}})
+	_, err = read("kafka://localhost:9092/topic")
+	assert.Error(t, err)
+	mockKafkaSource(t, partitions)
+	_, err = read("kafka://localhost:9092/topic?start=5")
+	assert.Error(t, err)
+
+	// the end position is never reached
+	mqSourceIdleTimeout = 100 * time.Millisecond
+	defer func() { mqSourceIdleTimeout = 30 * time.Second }()
+	consumer = mockKafkaSource(t, partitions)
+	consumer.lag = 1
+	_, err = read("kafka://localhost:9092/topic")
+	assert.Error(t, err)
+
+	// invalid payload
+	mockKafkaSource(t, map[int32][][]byte{0: {[]byte(`abc`)}})
+	_, err = read("kafka://localhost:9092/topic")
+	assert.Error(t, err)
+}
+
+func Test_ReadPulsarSource(t *testing.T) {
+	ctx := context.Background()
+	mockMQSource(t, [][]byte{
+		[]byte(`{"a": 0}`),
+		[]byte(`[{"a": 1}, {"a": 2}]`),
+		[]byte(`{"a": 3}`),
+		[]byte(`{"a": 4}`),
+	})
+
+	read := func(uri string) (string, error) {
+		source, err := parseMQSource(uri)
+		assert.NoError(t, err)
+		buf := &bytes.Buffer{}
+		err = readMQSource(ctx, source, buf)
+		return buf.String(), err
+	}
+
+	// to the latest message
+	content, err := read("pulsar://localhost:6650/topic")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"a": 0}, {"a": 1}, {"a": 2}, {"a": 3}, {"a": 4}]}`, content)
+
+	// message id range
+	content, err = read("pulsar://localhost:6650/topic?start=" + pulsarSourceID(1) + "&end=" + pulsarSourceID(2))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"a": 1}, {"a": 2}, {"a": 3}]}`, content)
+
+	// invalid message id
+	_, err = read("pulsar://localhost:6650/topic?start=abc")
+	assert.Error(t, err)
+
+	// the end position is never reached
+	mqSourceIdleTimeout = 100 * time.Millisecond
+	defer func() { mqSourceIdleTimeout = 30 * time.Second }()
+	_, err = read("pulsar://localhost:6650/topic?start=" + pulsarSourceID(3) + "&end=" + pulsarSourceID(10))
+	assert.Error(t, err)
+
+	// empty topic
+	mockMQSource(t, [][]byte{})
+	_, err = read("pulsar://localhost:6650/topic")
+	assert.Error(t, err)
+
+	// invalid payload
+	mockMQSource(t, [][]byte{[]byte(`abc`)})
+	_, err = read("pulsar://localhost:6650/topic")
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperMQSource(t *testing.T) {
+	ctx := context.Background()
+	mockKafkaSource(t, map[int32][][]byte{0: {
+		[]byte(`{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]}`),
+		[]byte(`[{"FieldBool": false, "FieldInt8": 11, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 10002, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]},
+			{"FieldBool": true, "FieldInt8": 12, "FieldInt16": 103, "FieldInt32": 1003, "FieldInt64": 10003, "FieldFloat": 3.16, "FieldDouble": 3.56, "FieldString": "hello world", "FieldBinaryVector": [252, 0], "FieldFloatVector": [3.1, 3.2, 3.3, 3.4]}]`),
+	}})
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		State:    commonpb.ImportState_ImportStarted,
+		Segments: make([]int64, 0),
+		AutoIds:  make([]int64, 0),
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, nil, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	sources := []string{"kafka://localhost:9092/topic"}
+
+	// the broker is not in the allowlist
+	err := wrapper.Import(sources, ImportOptions{OnlyValidate: true})
+	assert.Error(t, err)
+	_, err = wrapper.parseMQSource(ctx, sources[0], nil)
+	assert.Error(t, err)
+
+	wrapper.SetMQBrokerAllowlist([]string{"localhost:9092"})
+	err = wrapper.Import(sources, ImportOptions{OnlyValidate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	err = wrapper.Import(sources, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// files and message queue sources are not allowed to be imported together
+	err = wrapper.Import([]string{"kafka://localhost:9092/topic", "rows.json"}, DefaultImportOptions())
+	assert.Error(t, err)
+
+	// preflight check skips the size of the message queue sources
+	report := PreImportCheck(ctx, nil, sampleSchema(), 2, 1024, sources, nil)
+	assert.True(t, report.Passed())
+	assert.True(t, report.RowBased)
+	report = PreImportCheck(ctx, nil, sampleSchema(), 2, 1024, []string{"kafka://localhost:9092/"}, nil)
+	assert.False(t, report.Passed())
+}
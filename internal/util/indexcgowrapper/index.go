@@ -153,6 +153,16 @@ func (index *CgoIndex) Build(dataset *Dataset) error {
 	}
 }
 
+// BuildSingleThreaded builds the index with one openmp thread, the indexes built by several threads depend on the
+// order the threads run in. The goroutine is locked to its thread since the thread num is set per thread.
+func BuildSingleThreaded(index CodecIndex, dataset *Dataset) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	prev := C.SetBuildThreadNum(1)
+	defer C.SetBuildThreadNum(prev)
+	return index.Build(dataset)
+}
+
 func (index *CgoIndex) buildFloatVecIndex(dataset *Dataset) error {
 	vectors := dataset.Data[keyRawArr].([]float32)
 	status := C.BuildFloatVecIndex(index.indexPtr, (C.int64_t)(len(vectors)), (*C.float)(&vectors[0]))
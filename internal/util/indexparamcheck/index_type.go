@@ -32,4 +32,11 @@ const (
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
 	IndexDISKANN         IndexType = "DISKANN"
+
+	// IndexComposite is a scalar index covering multiple fields, e.g. (status, timestamp).
+	IndexComposite IndexType = "COMPOSITE"
+	// IndexINVERTED is a scalar index mapping the distinct values of a field to the offsets of their rows.
+	IndexINVERTED IndexType = "INVERTED"
+	// IndexBITMAP is a scalar index keeping a bitmap of the rows of each distinct value, for low cardinality fields.
+	IndexBITMAP IndexType = "BITMAP"
 )
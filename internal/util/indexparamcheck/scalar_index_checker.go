@@ -1,8 +1,84 @@
 package indexparamcheck
 
-import "github.com/milvus-io/milvus-proto/go-api/schemapb"
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// MaxCompositeFieldNum is the maximum number of fields a composite scalar index can cover.
+const MaxCompositeFieldNum = 4
+
+const (
+	// DefaultBitmapCardinalityLimit is the default max number of distinct values of a bitmap index.
+	DefaultBitmapCardinalityLimit = 100
+	// MaxBitmapCardinalityLimit is the upper bound of the bitmap cardinality limit, each distinct value costs a bitmap of all the rows.
+	MaxBitmapCardinalityLimit = 1000
+)
 
 // TODO: check index parameters according to the index type & data type.
 func CheckIndexValid(dType schemapb.DataType, indexType IndexType, indexParams map[string]string) error {
+	switch indexType {
+	case IndexComposite:
+		if _, ok := indexParams[common.CompositeFieldIDsKey]; !ok {
+			return fmt.Errorf("%s is required by index type %s", common.CompositeFieldIDsKey, IndexComposite)
+		}
+	case IndexINVERTED:
+		if !typeutil.IsIntegerType(dType) && !typeutil.IsStringType(dType) {
+			return fmt.Errorf("index type %s only supports integer and string fields, got %s", IndexINVERTED, dType.String())
+		}
+	case IndexBITMAP:
+		if !typeutil.IsIntegerType(dType) && !typeutil.IsStringType(dType) && dType != schemapb.DataType_Bool {
+			return fmt.Errorf("index type %s only supports bool, integer and string fields, got %s", IndexBITMAP, dType.String())
+		}
+		if _, err := GetBitmapCardinalityLimit(indexParams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsInvertedOrBitmapIndex returns true for the scalar index types built by IndexNode with their own file layout.
+func IsInvertedOrBitmapIndex(indexType IndexType) bool {
+	return indexType == IndexINVERTED || indexType == IndexBITMAP
+}
+
+// GetBitmapCardinalityLimit returns the max number of distinct values of a bitmap index,
+// DefaultBitmapCardinalityLimit if common.BitmapCardinalityLimitKey is not given.
+func GetBitmapCardinalityLimit(indexParams map[string]string) (int, error) {
+	value, ok := indexParams[common.BitmapCardinalityLimitKey]
+	if !ok {
+		return DefaultBitmapCardinalityLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > MaxBitmapCardinalityLimit {
+		return 0, fmt.Errorf("%s should be an integer in [1, %d], got %s", common.BitmapCardinalityLimitKey, MaxBitmapCardinalityLimit, value)
+	}
+	return limit, nil
+}
+
+// CheckCompositeIndexFields checks whether the fields can be covered by one composite scalar index,
+// the leading field of the index comes first.
+func CheckCompositeIndexFields(fields []*schemapb.FieldSchema) error {
+	if len(fields) < 2 {
+		return fmt.Errorf("composite index requires at least 2 fields, got %d", len(fields))
+	}
+	if len(fields) > MaxCompositeFieldNum {
+		return fmt.Errorf("composite index supports at most %d fields, got %d", MaxCompositeFieldNum, len(fields))
+	}
+	seen := make(map[int64]struct{}, len(fields))
+	for _, field := range fields {
+		if _, ok := seen[field.GetFieldID()]; ok {
+			return fmt.Errorf("duplicated field %s in composite index", field.GetName())
+		}
+		seen[field.GetFieldID()] = struct{}{}
+		if !typeutil.IsIntegerType(field.GetDataType()) && !typeutil.IsFloatingType(field.GetDataType()) &&
+			!typeutil.IsStringType(field.GetDataType()) && field.GetDataType() != schemapb.DataType_Bool {
+			return fmt.Errorf("field %s of type %s is not supported by composite index", field.GetName(), field.GetDataType().String())
+		}
+	}
 	return nil
 }
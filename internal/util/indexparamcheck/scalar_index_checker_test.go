@@ -4,9 +4,49 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestCheckIndexValid(t *testing.T) {
 	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, "inverted_index", nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexComposite, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, IndexComposite, map[string]string{
+		common.CompositeFieldIDsKey: "100,101",
+	}))
+}
+
+func TestCheckIndexValid_InvertedAndBitmap(t *testing.T) {
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexINVERTED, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int32, IndexINVERTED, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Float, IndexINVERTED, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Bool, IndexINVERTED, nil))
+
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Bool, IndexBITMAP, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexBITMAP, map[string]string{common.BitmapCardinalityLimitKey: "1000"}))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Double, IndexBITMAP, nil))
+	for _, limit := range []string{"0", "1001", "abc"} {
+		assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexBITMAP, map[string]string{common.BitmapCardinalityLimitKey: limit}))
+	}
+
+	limit, err := GetBitmapCardinalityLimit(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultBitmapCardinalityLimit, limit)
+	assert.True(t, IsInvertedOrBitmapIndex(IndexINVERTED))
+	assert.True(t, IsInvertedOrBitmapIndex(IndexBITMAP))
+	assert.False(t, IsInvertedOrBitmapIndex(IndexComposite))
+}
+
+func TestCheckCompositeIndexFields(t *testing.T) {
+	status := &schemapb.FieldSchema{FieldID: 100, Name: "status", DataType: schemapb.DataType_Int8}
+	ts := &schemapb.FieldSchema{FieldID: 101, Name: "timestamp", DataType: schemapb.DataType_Int64}
+	tag := &schemapb.FieldSchema{FieldID: 102, Name: "tag", DataType: schemapb.DataType_VarChar}
+	vec := &schemapb.FieldSchema{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector}
+
+	assert.NoError(t, CheckCompositeIndexFields([]*schemapb.FieldSchema{status, ts}))
+	assert.NoError(t, CheckCompositeIndexFields([]*schemapb.FieldSchema{status, ts, tag}))
+	assert.Error(t, CheckCompositeIndexFields([]*schemapb.FieldSchema{status}))
+	assert.Error(t, CheckCompositeIndexFields([]*schemapb.FieldSchema{status, status}))
+	assert.Error(t, CheckCompositeIndexFields([]*schemapb.FieldSchema{status, vec}))
+	assert.Error(t, CheckCompositeIndexFields([]*schemapb.FieldSchema{status, ts, tag, ts, status}))
 }
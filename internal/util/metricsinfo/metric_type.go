@@ -27,6 +27,21 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// SegmentLocksMetrics means users request for the segment locks held in DataCoord.
+	SegmentLocksMetrics = "segment_locks"
+
+	// StorageUsageMetrics means users request for the object storage usages of the collections in DataCoord.
+	StorageUsageMetrics = "storage_usage"
+
+	// ImportTasksMetrics means users request for the progress of the import tasks in DataNode.
+	ImportTasksMetrics = "import_tasks"
+
+	// SegmentLoadProgressMetrics means users request for the load progress of the segments in QueryNode or QueryCoord.
+	SegmentLoadProgressMetrics = "segment_load_progress"
+
+	// SegmentHeatMetrics means users request for the query heats of the segments in DataCoord, or in QueryNode.
+	SegmentHeatMetrics = "segment_heat"
 )
 
 // ParseMetricType returns the metric type of req
@@ -59,3 +74,18 @@ func ConstructRequestByMetricType(metricType string) (*milvuspb.GetMetricsReques
 		Request: string(binary),
 	}, nil
 }
+
+// ConstructRequestByBody constructs a request of the json encoded body, the body carries the metric type
+// with the params of the request.
+func ConstructRequestByBody(body interface{}) (*milvuspb.GetMetricsRequest, error) {
+	binary, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct request by body: %s", err.Error())
+	}
+	return &milvuspb.GetMetricsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SystemInfo),
+		),
+		Request: string(binary),
+	}, nil
+}
@@ -78,3 +78,20 @@ func Test_ConstructRequestByMetricType(t *testing.T) {
 		}
 	}
 }
+
+func Test_ConstructRequestByBody(t *testing.T) {
+	req, err := ConstructRequestByBody(&SegmentHeatRequest{
+		MetricType:   SegmentHeatMetrics,
+		CollectionID: 10,
+	})
+	assert.NoError(t, err)
+	metricType, err := ParseMetricType(req.GetRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, SegmentHeatMetrics, metricType)
+	var heatReq SegmentHeatRequest
+	assert.NoError(t, json.Unmarshal([]byte(req.GetRequest()), &heatReq))
+	assert.Equal(t, int64(10), heatReq.CollectionID)
+
+	_, err = ConstructRequestByBody(func() {})
+	assert.Error(t, err)
+}
@@ -95,11 +95,45 @@ type QueryNodeConfiguration struct {
 	SimdType string `json:"simd_type"`
 }
 
+// SegmentLoadMetrics records the load progress of a segment on a QueryNode.
+type SegmentLoadMetrics struct {
+	SegmentID    int64  `json:"segment_id"`
+	CollectionID int64  `json:"collection_id"`
+	PartitionID  int64  `json:"partition_id"`
+	NodeID       int64  `json:"node_id"`
+	Phase        string `json:"phase"`
+	// TotalFiles are the binlog, index, stats and delta files to download, DownloadedFiles are the ones read already
+	TotalFiles      int     `json:"total_files"`
+	DownloadedFiles int     `json:"downloaded_files"`
+	DownloadPercent float64 `json:"download_percent"`
+	StartTime       string  `json:"start_time"`
+	ElapsedSeconds  float64 `json:"elapsed_seconds"`
+	// EstimatedRemainingSeconds is estimated by the download rate so far, -1 means it's unknown yet
+	EstimatedRemainingSeconds float64 `json:"estimated_remaining_seconds"`
+	FailedReason              string  `json:"failed_reason,omitempty"`
+}
+
+// SegmentHeat is the query heat of a segment, the number of the searches and queries on it decayed by half
+// every half life.
+type SegmentHeat struct {
+	SegmentID    int64   `json:"segment_id"`
+	CollectionID int64   `json:"collection_id"`
+	Heat         float64 `json:"heat"`
+}
+
+// SegmentHeatRequest is the request of the query heats of the segments, CollectionID 0 means all the collections.
+type SegmentHeatRequest struct {
+	MetricType   string `json:"metric_type"`
+	CollectionID int64  `json:"collection_id,omitempty"`
+}
+
 // QueryNodeInfos implements ComponentInfos
 type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
 	QuotaMetrics         *QueryNodeQuotaMetrics `json:"quota_metrics"`
+	// SegmentLoads are the loading and the recently loaded segments
+	SegmentLoads []SegmentLoadMetrics `json:"segment_loads,omitempty"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
@@ -138,6 +172,8 @@ type IndexNodeConfiguration struct {
 type IndexNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations IndexNodeConfiguration `json:"system_configurations"`
+	// Cordoned is filled by IndexCoord, a cordoned IndexNode receives no new builds
+	Cordoned bool `json:"cordoned"`
 }
 
 // IndexCoordConfiguration records the configuration of IndexCoord.
@@ -149,6 +185,21 @@ type IndexCoordConfiguration struct {
 type IndexCoordInfos struct {
 	BaseComponentInfos
 	SystemConfigurations IndexCoordConfiguration `json:"system_configurations"`
+	GCRuns               []GCRunMetrics          `json:"gc_runs,omitempty"`
+}
+
+// GCRunMetrics records the summary of the last garbage collection pass of a scope in IndexCoord.
+type GCRunMetrics struct {
+	Scope      string   `json:"scope"`
+	Trigger    string   `json:"trigger"`
+	DryRun     bool     `json:"dry_run"`
+	StartTime  string   `json:"start_time"`
+	ElapsedMs  int64    `json:"elapsed_ms"`
+	Scanned    int      `json:"scanned"`
+	Recycled   int      `json:"recycled"`
+	Failed     int      `json:"failed"`
+	Error      string   `json:"error,omitempty"`
+	Candidates []string `json:"candidates,omitempty"`
 }
 
 // DataNodeConfiguration records the configuration of DataNode.
@@ -156,11 +207,68 @@ type DataNodeConfiguration struct {
 	FlushInsertBufferSize int64 `json:"flush_insert_buffer_size"`
 }
 
+// FlowGraphNodeMetrics records the processing statistics of a flowgraph node.
+type FlowGraphNodeMetrics struct {
+	Name          string  `json:"name"`
+	QueueLength   int     `json:"queue_length"`
+	QueueCapacity int     `json:"queue_capacity"`
+	OperateCount  int64   `json:"operate_count"`
+	LastLatencyMs float64 `json:"last_latency_ms"`
+	AvgLatencyMs  float64 `json:"avg_latency_ms"`
+	MaxLatencyMs  float64 `json:"max_latency_ms"`
+}
+
+// ImportTaskMetrics records the progress of an import task on a DataNode.
+type ImportTaskMetrics struct {
+	TaskID          int64  `json:"task_id"`
+	CollectionID    int64  `json:"collection_id"`
+	PartitionID     int64  `json:"partition_id"`
+	Phase           string `json:"phase"`
+	TotalFiles      int    `json:"total_files"`
+	CompletedFiles  int    `json:"completed_files"`
+	RowsParsed      int64  `json:"rows_parsed"`
+	SegmentsCreated int    `json:"segments_created"`
+	// RowsPerSecond is the rows parsed per second since the task started, till the task ended if it's ended
+	RowsPerSecond  float64 `json:"rows_per_second"`
+	StartTime      string  `json:"start_time"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+	FailedReason   string  `json:"failed_reason,omitempty"`
+}
+
+// FlushEfficiencyMetrics records the data buffered and the binlogs written by the flushes of a segment or a collection.
+type FlushEfficiencyMetrics struct {
+	FlushCount    int64 `json:"flush_count"`
+	BufferedRows  int64 `json:"buffered_rows"`
+	BufferedBytes int64 `json:"buffered_bytes"`
+	WrittenBytes  int64 `json:"written_bytes"`
+	BinlogCount   int64 `json:"binlog_count"`
+	// WriteAmplification is the bytes written to the binlogs per byte buffered in memory
+	WriteAmplification float64 `json:"write_amplification"`
+	AvgRowsPerFlush    float64 `json:"avg_rows_per_flush"`
+	AvgBytesPerBinlog  float64 `json:"avg_bytes_per_binlog"`
+}
+
+// SegmentFlushMetrics records the flush efficiency of a segment on a DataNode.
+type SegmentFlushMetrics struct {
+	SegmentID    int64 `json:"segment_id"`
+	CollectionID int64 `json:"collection_id"`
+	FlushEfficiencyMetrics
+	LastFlushTime string `json:"last_flush_time"`
+}
+
 // DataNodeInfos implements ComponentInfos
 type DataNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations DataNodeConfiguration `json:"system_configurations"`
 	QuotaMetrics         *DataNodeQuotaMetrics `json:"quota_metrics"`
+	// FlowGraphs maps the virtual channel to the statistics of its flowgraph nodes in pipeline order
+	FlowGraphs map[string][]FlowGraphNodeMetrics `json:"flow_graphs,omitempty"`
+	// ImportTasks are the running and the recently ended import tasks
+	ImportTasks []ImportTaskMetrics `json:"import_tasks,omitempty"`
+	// SegmentFlushes are the flush efficiency of the recently flushed segments
+	SegmentFlushes []SegmentFlushMetrics `json:"segment_flushes,omitempty"`
+	// CollectionFlushes maps the collection id to the flush efficiency aggregated since DataNode started
+	CollectionFlushes map[int64]FlushEfficiencyMetrics `json:"collection_flushes,omitempty"`
 }
 
 // DataCoordConfiguration records the configuration of DataCoord.
@@ -141,6 +141,10 @@ func (m *GrpcDataCoordClient) Import(ctx context.Context, req *datapb.ImportTask
 	return &datapb.ImportTaskResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) PreImport(ctx context.Context, req *datapb.PreImportRequest, opts ...grpc.CallOption) (*datapb.PreImportResponse, error) {
+	return &datapb.PreImportResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -149,10 +153,94 @@ func (m *GrpcDataCoordClient) UpdateChannelCheckpoint(ctx context.Context, req *
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) UpdateChannelCheckpoints(ctx context.Context, req *datapb.UpdateChannelCheckpointsRequest, opts ...grpc.CallOption) (*datapb.UpdateChannelCheckpointsResponse, error) {
+	return &datapb.UpdateChannelCheckpointsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetTimeTravelWatermarks(ctx context.Context, req *datapb.GetTimeTravelWatermarksRequest, opts ...grpc.CallOption) (*datapb.GetTimeTravelWatermarksResponse, error) {
+	return &datapb.GetTimeTravelWatermarksResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ReportSegmentHeats(ctx context.Context, req *datapb.ReportSegmentHeatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetSegmentHistory(ctx context.Context, req *datapb.GetSegmentHistoryRequest, opts ...grpc.CallOption) (*datapb.GetSegmentHistoryResponse, error) {
+	return &datapb.GetSegmentHistoryResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) PauseCollectionMaintenance(ctx context.Context, req *datapb.PauseCollectionMaintenanceRequest, opts ...grpc.CallOption) (*datapb.PauseCollectionMaintenanceResponse, error) {
+	return &datapb.PauseCollectionMaintenanceResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ResumeCollectionMaintenance(ctx context.Context, req *datapb.ResumeCollectionMaintenanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetCollectionMaintenancePauses(ctx context.Context, req *datapb.GetCollectionMaintenancePausesRequest, opts ...grpc.CallOption) (*datapb.GetCollectionMaintenancePausesResponse, error) {
+	return &datapb.GetCollectionMaintenancePausesResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) DecommissionDataNode(ctx context.Context, req *datapb.DecommissionRequest, opts ...grpc.CallOption) (*datapb.DecommissionResponse, error) {
+	return &datapb.DecommissionResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) LocatePrimaryKeys(ctx context.Context, req *datapb.LocatePrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.LocatePrimaryKeysResponse, error) {
+	return &datapb.LocatePrimaryKeysResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) InspectSegmentLocks(ctx context.Context, req *datapb.InspectSegmentLocksRequest, opts ...grpc.CallOption) (*datapb.InspectSegmentLocksResponse, error) {
+	return &datapb.InspectSegmentLocksResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CompactSegments(ctx context.Context, req *datapb.CompactSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetHandoffGate(ctx context.Context, req *datapb.GetHandoffGateRequest, opts ...grpc.CallOption) (*datapb.GetHandoffGateResponse, error) {
+	return &datapb.GetHandoffGateResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetSegmentAnomalies(ctx context.Context, req *datapb.GetSegmentAnomaliesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentAnomaliesResponse, error) {
+	return &datapb.GetSegmentAnomaliesResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) MergeTinySegments(ctx context.Context, req *datapb.MergeTinySegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetSegmentAllocHints(ctx context.Context, req *datapb.GetSegmentAllocHintsRequest, opts ...grpc.CallOption) (*datapb.GetSegmentAllocHintsResponse, error) {
+	return &datapb.GetSegmentAllocHintsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetSegmentHeats(ctx context.Context, req *datapb.GetSegmentHeatsRequest, opts ...grpc.CallOption) (*datapb.GetSegmentHeatsResponse, error) {
+	return &datapb.GetSegmentHeatsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) FreezeHandoffs(ctx context.Context, req *datapb.FreezeHandoffsRequest, opts ...grpc.CallOption) (*datapb.FreezeHandoffsResponse, error) {
+	return &datapb.FreezeHandoffsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) UnfreezeHandoffs(ctx context.Context, req *datapb.UnfreezeHandoffsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetFreezeWindows(ctx context.Context, req *datapb.GetFreezeWindowsRequest, opts ...grpc.CallOption) (*datapb.GetFreezeWindowsResponse, error) {
+	return &datapb.GetFreezeWindowsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetDeleteSLA(ctx context.Context, req *datapb.GetDeleteSLARequest, opts ...grpc.CallOption) (*datapb.GetDeleteSLAResponse, error) {
+	return &datapb.GetDeleteSLAResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) RenewSegmentLock(ctx context.Context, req *datapb.RenewSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -205,3 +293,7 @@ func (m *GrpcDataCoordClient) DescribeIndex(ctx context.Context, req *datapb.Des
 func (m *GrpcDataCoordClient) GetIndexBuildProgress(ctx context.Context, req *datapb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*datapb.GetIndexBuildProgressResponse, error) {
 	return &datapb.GetIndexBuildProgressResponse{}, m.Err
 }
+
+func (m *GrpcDataCoordClient) RebuildDeprecatedIndexes(ctx context.Context, req *datapb.RebuildDeprecatedIndexesRequest, opts ...grpc.CallOption) (*datapb.RebuildDeprecatedIndexesResponse, error) {
+	return &datapb.RebuildDeprecatedIndexesResponse{}, m.Err
+}
@@ -77,6 +77,10 @@ func (m *GrpcDataNodeClient) AddImportSegment(ctx context.Context, in *datapb.Ad
 	return &datapb.AddImportSegmentResponse{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) Decommission(ctx context.Context, req *datapb.DecommissionRequest, opts ...grpc.CallOption) (*datapb.DecommissionResponse, error) {
+	return &datapb.DecommissionResponse{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) SyncSegments(ctx context.Context, in *datapb.SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -61,6 +61,10 @@ func (m *GrpcIndexNodeClient) GetJobStats(ctx context.Context, in *indexpb.GetJo
 	return &indexpb.GetJobStatsResponse{}, m.Err
 }
 
+func (m *GrpcIndexNodeClient) VerifyIndexBuild(ctx context.Context, in *indexpb.VerifyIndexBuildRequest, opts ...grpc.CallOption) (*indexpb.VerifyIndexBuildResponse, error) {
+	return &indexpb.VerifyIndexBuildResponse{}, m.Err
+}
+
 func (m *GrpcIndexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
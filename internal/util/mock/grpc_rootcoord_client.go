@@ -71,6 +71,46 @@ func (m *GrpcRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Lis
 	return &internalpb.ListPolicyResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) ListRoleQuotas(ctx context.Context, req *proxypb.ListRoleQuotasRequest, opts ...grpc.CallOption) (*proxypb.ListRoleQuotasResponse, error) {
+	return &proxypb.ListRoleQuotasResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) SaveRoleQuota(ctx context.Context, req *proxypb.SaveRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DropRoleQuota(ctx context.Context, req *proxypb.DropRoleQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDatabaseQuotas(ctx context.Context, req *proxypb.ListDatabaseQuotasRequest, opts ...grpc.CallOption) (*proxypb.ListDatabaseQuotasResponse, error) {
+	return &proxypb.ListDatabaseQuotasResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) SaveDatabaseQuota(ctx context.Context, req *proxypb.SaveDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DropDatabaseQuota(ctx context.Context, req *proxypb.DropDatabaseQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListReadOnlyModes(ctx context.Context, req *proxypb.ListReadOnlyModesRequest, opts ...grpc.CallOption) (*proxypb.ListReadOnlyModesResponse, error) {
+	return &proxypb.ListReadOnlyModesResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) EnterReadOnly(ctx context.Context, req *proxypb.EnterReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) LeaveReadOnly(ctx context.Context, req *proxypb.LeaveReadOnlyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetDdlOperationState(ctx context.Context, req *proxypb.GetDdlOperationStateRequest, opts ...grpc.CallOption) (*proxypb.GetDdlOperationStateResponse, error) {
+	return &proxypb.GetDdlOperationStateResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	return &milvuspb.ComponentStates{}, m.Err
 }
@@ -58,7 +58,8 @@ var defaultYaml = DefaultMilvusYaml
 
 // BaseTable the basics of paramtable
 type BaseTable struct {
-	mgr *config.Manager
+	mgr          *config.Manager
+	featureFlags *config.FeatureFlagRegistry
 
 	configDir string
 	YamlFile  string
@@ -73,7 +74,7 @@ func NewBaseTableFromYamlOnly(yaml string) *BaseTable {
 		Filepath:        yaml,
 		RefreshInterval: 10 * time.Second,
 	}))
-	gp := &BaseTable{mgr: mgr, YamlFile: yaml}
+	gp := &BaseTable{mgr: mgr, featureFlags: config.NewFeatureFlagRegistry(mgr), YamlFile: yaml}
 	return gp
 }
 
@@ -96,6 +97,7 @@ func (gp *BaseTable) Init(refreshInterval int) {
 	if err != nil {
 		return
 	}
+	gp.featureFlags = config.NewFeatureFlagRegistry(gp.mgr)
 	gp.initConfigsFromLocal(refreshInterval)
 	gp.initConfigsFromRemote(refreshInterval)
 	gp.InitLogCfg()
@@ -196,7 +198,41 @@ func (gp *BaseTable) GetConfigSubSet(pattern string) map[string]string {
 
 func (gp *BaseTable) GetComponentConfigurations(ctx context.Context, componentName string, sub string) map[string]string {
 	allownPrefixs := append(globalConfigPrefixs(), componentName+".")
-	return gp.mgr.GetBy(config.WithSubstr(sub), config.WithOneOfPrefixs(allownPrefixs...))
+	configs := gp.mgr.GetBy(config.WithSubstr(sub), config.WithOneOfPrefixs(allownPrefixs...))
+	if gp.featureFlags != nil {
+		for key, value := range gp.featureFlags.List(componentName) {
+			if strings.Contains(strings.ToLower(key), strings.ToLower(sub)) {
+				configs[key] = value
+			}
+		}
+	}
+	return configs
+}
+
+// GetComponentConfigurationsWithSource is GetComponentConfigurations with the source of every config,
+// a feature flag not set by any source reports its default value
+func (gp *BaseTable) GetComponentConfigurationsWithSource(ctx context.Context, componentName string, sub string) map[string]config.ConfigValue {
+	allownPrefixs := append(globalConfigPrefixs(), componentName+".")
+	configs := gp.mgr.GetByWithSource(config.WithSubstr(sub), config.WithOneOfPrefixs(allownPrefixs...))
+	if gp.featureFlags != nil {
+		for key, value := range gp.featureFlags.List(componentName) {
+			if !strings.Contains(strings.ToLower(key), strings.ToLower(sub)) {
+				continue
+			}
+			configValue, err := gp.mgr.GetConfigWithSource(key)
+			if err != nil {
+				configValue = config.ConfigValue{Source: config.SourceKindDefault}
+			}
+			configValue.Value = value
+			configs[key] = configValue
+		}
+	}
+	return configs
+}
+
+// FeatureFlags returns the registry of the runtime feature flags
+func (gp *BaseTable) FeatureFlags() *config.FeatureFlagRegistry {
+	return gp.featureFlags
 }
 
 func (gp *BaseTable) GetAll() map[string]string {
@@ -12,11 +12,15 @@
 package paramtable
 
 import (
+	"context"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/config"
 )
 
 var baseParams = BaseTable{}
@@ -106,6 +110,48 @@ func TestBaseTable_Get(t *testing.T) {
 	assert.Equal(t, "", v2)
 }
 
+func TestBaseTable_FeatureFlags(t *testing.T) {
+	flag := &config.FeatureFlag{Component: "queryNode", Name: "testFlag", Type: config.BoolFlag, DefaultValue: "false"}
+	assert.NoError(t, baseParams.FeatureFlags().Register(flag))
+
+	configs := baseParams.GetComponentConfigurations(context.Background(), "querynode", "testflag")
+	assert.Equal(t, map[string]string{flag.Key(): "false"}, configs)
+
+	assert.NoError(t, baseParams.FeatureFlags().Set(flag.Key(), "true"))
+	defer baseParams.FeatureFlags().Reset(flag.Key())
+	configs = baseParams.GetComponentConfigurations(context.Background(), "querynode", "")
+	assert.Equal(t, "true", configs[flag.Key()])
+
+	configs = baseParams.GetComponentConfigurations(context.Background(), "datanode", "")
+	assert.NotContains(t, configs, flag.Key())
+}
+
+func TestBaseTable_ShowComponentConfigurations(t *testing.T) {
+	configList := baseParams.ShowComponentConfigurations(context.Background(), "datacoord", "datacoord.port")
+	assert.Equal(t, 1, len(configList))
+	assert.Equal(t, "datacoord.port", configList[0].Key)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ShowConfigSourcesHeader, "true"))
+	assert.True(t, ConfigSourcesRequested(ctx))
+	assert.False(t, ConfigSourcesRequested(context.Background()))
+	configList = baseParams.ShowComponentConfigurations(ctx, "datacoord", "datacoord.port")
+	assert.Equal(t, 3, len(configList))
+	assert.Equal(t, "datacoord.port"+ConfigSourceKeySuffix, configList[1].Key)
+	assert.Equal(t, config.SourceKindFile, configList[1].Value)
+	assert.Equal(t, "datacoord.port"+ConfigUpdatedKeySuffix, configList[2].Key)
+	assert.Equal(t, "false", configList[2].Value)
+
+	assert.NoError(t, baseParams.Save("datacoord.port", "19999"))
+	defer baseParams.Reset("datacoord.port")
+	configs := baseParams.GetComponentConfigurationsWithSource(ctx, "datacoord", "datacoord.port")
+	assert.Equal(t, config.ConfigValue{Value: "19999", Source: config.SourceKindOverride, Updated: true}, configs["datacoord.port"])
+
+	flag := &config.FeatureFlag{Component: "dataCoord", Name: "testSourceFlag", Type: config.BoolFlag, DefaultValue: "false"}
+	assert.NoError(t, baseParams.FeatureFlags().Register(flag))
+	configs = baseParams.GetComponentConfigurationsWithSource(ctx, "datacoord", "testsourceflag")
+	assert.Equal(t, config.ConfigValue{Value: "false", Source: config.SourceKindDefault}, configs[flag.Key()])
+}
+
 func TestBaseTable_Pulsar(t *testing.T) {
 	//test PULSAR ADDRESS
 	t.Setenv("PULSAR_ADDRESS", "pulsar://localhost:6650")
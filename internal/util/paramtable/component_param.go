@@ -172,6 +172,8 @@ type commonConfig struct {
 	DataCoordWatchSubPath ParamItem `refreshable:"false"`
 	DataNodeSubName       ParamItem `refreshable:"false"`
 
+	IndexNodeBuildEvent ParamItem `refreshable:"false"`
+
 	DefaultPartitionName ParamItem `refreshable:"true"`
 	DefaultIndexName     ParamItem `refreshable:"true"`
 	RetentionDuration    ParamItem `refreshable:"true"`
@@ -199,11 +201,13 @@ type commonConfig struct {
 
 	AuthorizationEnabled ParamItem `refreshable:"false"`
 	SuperUsers           ParamItem `refreshable:"true"`
+	ManagementToken      ParamItem `refreshable:"true"`
 
 	ClusterName ParamItem `refreshable:"false"`
 
 	SessionTTL        ParamItem `refreshable:"false"`
 	SessionRetryTimes ParamItem `refreshable:"false"`
+	SessionZone       ParamItem `refreshable:"false"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -342,6 +346,14 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.DataCoordSegmentInfo.Init(base.mgr)
 
+	p.IndexNodeBuildEvent = ParamItem{
+		Key:          "common.chanNamePrefix.indexNodeBuildEvent",
+		Version:      "2.2.3",
+		DefaultValue: "indexnode-build-event",
+		Formatter:    chanNamePrefix,
+	}
+	p.IndexNodeBuildEvent.Init(base.mgr)
+
 	p.DataCoordSubName = ParamItem{
 		Key:          "common.subNamePrefix.dataCoordSubNamePrefix",
 		Version:      "2.1.0",
@@ -520,6 +532,14 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.ManagementToken = ParamItem{
+		Key:          "common.security.managementToken",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "bearer token required by the mutating management http apis, they are only served to the loopback addresses if not set",
+	}
+	p.ManagementToken.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -541,6 +561,14 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.SessionRetryTimes.Init(base.mgr)
 
+	p.SessionZone = ParamItem{
+		Key:          "common.session.zone",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "the zone the node runs in, registered in its session for the zone-aware read routing, empty if unknown",
+	}
+	p.SessionZone.Init(base.mgr)
+
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -552,7 +580,11 @@ type rootCoordConfig struct {
 	ImportTaskExpiration        ParamItem `refreshable:"true"`
 	ImportTaskRetention         ParamItem `refreshable:"true"`
 	ImportTaskSubPath           ParamItem `refreshable:"true"`
+	ImportPreflightCheckEnabled ParamItem `refreshable:"true"`
 	EnableActiveStandby         ParamItem `refreshable:"false"`
+	DdlJournalRetention         ParamItem `refreshable:"true"`
+
+	PartitionRolloverCheckInterval ParamItem `refreshable:"false"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -598,6 +630,14 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.ImportTaskSubPath.Init(base.mgr)
 
+	p.ImportPreflightCheckEnabled = ParamItem{
+		Key:          "rootCoord.import.preflightCheck.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "check the files of an import with DataCoord before creating the import tasks, and reject the import if the check fails",
+	}
+	p.ImportPreflightCheckEnabled.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "rootCoord.enableActiveStandby",
 		Version:      "2.2.0",
@@ -605,6 +645,22 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.EnableActiveStandby.Init(base.mgr)
 
+	p.DdlJournalRetention = ParamItem{
+		Key:          "rootCoord.ddlJournal.retention",
+		Version:      "2.2.3",
+		DefaultValue: strconv.Itoa(24 * 60 * 60),
+		Doc:          "(in seconds) the finished ddl operations are kept in the ddl journal for at least retention seconds",
+	}
+	p.DdlJournalRetention.Init(base.mgr)
+
+	p.PartitionRolloverCheckInterval = ParamItem{
+		Key:          "rootCoord.partitionRollover.checkInterval",
+		Version:      "2.2.3",
+		DefaultValue: "60",
+		Doc:          "(in seconds) the interval to create the upcoming partitions and drop the expired ones of the time-partitioned collections, 0 disables the rollover",
+	}
+	p.PartitionRolloverCheckInterval.Init(base.mgr)
+
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -634,20 +690,62 @@ type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
 
-	TimeTickInterval         ParamItem `refreshable:"false"`
-	MsgStreamTimeTickBufSize ParamItem `refreshable:"true"`
-	MaxNameLength            ParamItem `refreshable:"true"`
-	MaxUsernameLength        ParamItem `refreshable:"true"`
-	MinPasswordLength        ParamItem `refreshable:"true"`
-	MaxPasswordLength        ParamItem `refreshable:"true"`
-	MaxFieldNum              ParamItem `refreshable:"true"`
-	MaxShardNum              ParamItem `refreshable:"true"`
-	MaxDimension             ParamItem `refreshable:"true"`
-	GinLogging               ParamItem `refreshable:"false"`
-	MaxUserNum               ParamItem `refreshable:"true"`
-	MaxRoleNum               ParamItem `refreshable:"true"`
-	MaxTaskNum               ParamItem `refreshable:"false"`
-	AccessLog                AccessLogConfig
+	TimeTickInterval           ParamItem `refreshable:"false"`
+	MsgStreamTimeTickBufSize   ParamItem `refreshable:"true"`
+	MaxNameLength              ParamItem `refreshable:"true"`
+	MaxUsernameLength          ParamItem `refreshable:"true"`
+	MinPasswordLength          ParamItem `refreshable:"true"`
+	MaxPasswordLength          ParamItem `refreshable:"true"`
+	MaxFieldNum                ParamItem `refreshable:"true"`
+	MaxShardNum                ParamItem `refreshable:"true"`
+	MaxDimension               ParamItem `refreshable:"true"`
+	GinLogging                 ParamItem `refreshable:"false"`
+	MaxUserNum                 ParamItem `refreshable:"true"`
+	MaxRoleNum                 ParamItem `refreshable:"true"`
+	MaxTaskNum                 ParamItem `refreshable:"false"`
+	MaxResultSize              ParamItem `refreshable:"true"`
+	PartitionRoutingEnabled    ParamItem `refreshable:"true"`
+	PartitionRoutingCacheTTL   ParamItem `refreshable:"true"`
+	SearchTrafficSplitEnabled  ParamItem `refreshable:"true"`
+	SearchTrafficSplitRules    ParamItem `refreshable:"true"`
+	SearchTrafficSplitCacheTTL ParamItem `refreshable:"true"`
+	MetaPrefetchEnabled        ParamItem `refreshable:"true"`
+	MetaPrefetchCollections    ParamItem `refreshable:"true"`
+	MetaPrefetchMaxCollections ParamItem `refreshable:"true"`
+	MetaPrefetchParallelism    ParamItem `refreshable:"true"`
+	SearchMultiMaxCollections  ParamItem `refreshable:"true"`
+	SearchMultiParallelism     ParamItem `refreshable:"true"`
+	PacingHintEnabled          ParamItem `refreshable:"true"`
+	PacingHintSoftUtilization  ParamItem `refreshable:"true"`
+	QuotaStateNotifyEnabled    ParamItem `refreshable:"true"`
+	ReadRetryEnabled           ParamItem `refreshable:"true"`
+	ReadRetryBudgetRatio       ParamItem `refreshable:"true"`
+	ReadRetryMinPerSecond      ParamItem `refreshable:"true"`
+	TimeTravelClampExpired     ParamItem `refreshable:"true"`
+	TimeTravelWatermarkTTL     ParamItem `refreshable:"true"`
+	SearchTuningMinSamples     ParamItem `refreshable:"true"`
+	SearchTuningExploreRatio   ParamItem `refreshable:"true"`
+	ZoneAwareRoutingEnabled    ParamItem `refreshable:"true"`
+	RerankEnabled              ParamItem `refreshable:"true"`
+	RerankSoPath               ParamItem `refreshable:"false"`
+	EmbeddingEndpoint          ParamItem `refreshable:"true"`
+	EmbeddingAPIKey            ParamItem `refreshable:"true"`
+	EmbeddingTimeout           ParamItem `refreshable:"true"`
+	EmbeddingBatchSize         ParamItem `refreshable:"true"`
+	EmbeddingMaxRetries        ParamItem `refreshable:"true"`
+	EmbeddingFailurePolicy     ParamItem `refreshable:"true"`
+	SLOEnabled                 ParamItem `refreshable:"true"`
+	SLOObjectives              ParamItem `refreshable:"true"`
+	SLOTiers                   ParamItem `refreshable:"true"`
+	SLODefaultTier             ParamItem `refreshable:"true"`
+	SLOWindow                  ParamItem `refreshable:"true"`
+	SLOEvaluateInterval        ParamItem `refreshable:"true"`
+	SLOBurnRateThreshold       ParamItem `refreshable:"true"`
+	SLOMinRequests             ParamItem `refreshable:"true"`
+	StreamingInsertEnabled     ParamItem `refreshable:"true"`
+	StreamingInsertMaxSize     ParamItem `refreshable:"true"`
+	InsertValidationPolicy     ParamItem `refreshable:"true"`
+	AccessLog                  AccessLogConfig
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -730,6 +828,342 @@ func (p *proxyConfig) init(base *BaseTable) {
 	}
 	p.MaxTaskNum.Init(base.mgr)
 
+	p.MaxResultSize = ParamItem{
+		Key:          "proxy.maxResultSize",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "max estimated size in bytes of a query result, 0 means no limit",
+	}
+	p.MaxResultSize.Init(base.mgr)
+
+	p.PartitionRoutingEnabled = ParamItem{
+		Key:          "proxy.partitionRouting.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "exclude the partitions not loaded from search instead of failing the request",
+	}
+	p.PartitionRoutingEnabled.Init(base.mgr)
+
+	p.PartitionRoutingCacheTTL = ParamItem{
+		Key:          "proxy.partitionRouting.cacheTTL",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "seconds to cache the loaded partitions of a collection",
+	}
+	p.PartitionRoutingCacheTTL.Init(base.mgr)
+
+	p.SearchTrafficSplitEnabled = ParamItem{
+		Key:          "proxy.searchTrafficSplit.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "split the search traffic of collections among replica groups by the rules",
+	}
+	p.SearchTrafficSplitEnabled.Init(base.mgr)
+
+	p.SearchTrafficSplitRules = ParamItem{
+		Key:          "proxy.searchTrafficSplit.rules",
+		Version:      "2.2.3",
+		DefaultValue: "{}",
+		Doc:          "json map of collection name to the variants, each with a name, the replica ids and the percentage of search traffic",
+	}
+	p.SearchTrafficSplitRules.Init(base.mgr)
+
+	p.SearchTrafficSplitCacheTTL = ParamItem{
+		Key:          "proxy.searchTrafficSplit.cacheTTL",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "seconds to cache the replicas of a collection",
+	}
+	p.SearchTrafficSplitCacheTTL.Init(base.mgr)
+
+	p.MetaPrefetchEnabled = ParamItem{
+		Key:          "proxy.metaPrefetch.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "warm the meta cache on startup and after rootcoord failover",
+	}
+	p.MetaPrefetchEnabled.Init(base.mgr)
+
+	p.MetaPrefetchCollections = ParamItem{
+		Key:          "proxy.metaPrefetch.collections",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "comma separated names of the collections of the default database to prefetch, empty means the loaded ones",
+	}
+	p.MetaPrefetchCollections.Init(base.mgr)
+
+	p.MetaPrefetchMaxCollections = ParamItem{
+		Key:          "proxy.metaPrefetch.maxCollections",
+		Version:      "2.2.3",
+		DefaultValue: "100",
+		Doc:          "max number of collections prefetched each time",
+	}
+	p.MetaPrefetchMaxCollections.Init(base.mgr)
+
+	p.MetaPrefetchParallelism = ParamItem{
+		Key:          "proxy.metaPrefetch.parallelism",
+		Version:      "2.2.3",
+		DefaultValue: "8",
+		Doc:          "max number of collections prefetched concurrently",
+	}
+	p.MetaPrefetchParallelism.Init(base.mgr)
+
+	p.SearchMultiMaxCollections = ParamItem{
+		Key:          "proxy.searchMulti.maxCollections",
+		Version:      "2.2.3",
+		DefaultValue: "64",
+		Doc:          "max number of collections searched by a multi-collection search",
+	}
+	p.SearchMultiMaxCollections.Init(base.mgr)
+
+	p.SearchMultiParallelism = ParamItem{
+		Key:          "proxy.searchMulti.parallelism",
+		Version:      "2.2.3",
+		DefaultValue: "8",
+		Doc:          "max number of collections searched concurrently by a multi-collection search",
+	}
+	p.SearchMultiParallelism.Init(base.mgr)
+
+	p.PacingHintEnabled = ParamItem{
+		Key:          "proxy.pacingHint.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "return the quota utilization and a suggested delay in the grpc headers of insert and delete responses",
+	}
+	p.PacingHintEnabled.Init(base.mgr)
+
+	p.PacingHintSoftUtilization = ParamItem{
+		Key:          "proxy.pacingHint.softUtilization",
+		Version:      "2.2.3",
+		DefaultValue: "0.8",
+		Doc:          "a delay is suggested once the utilization of the dml quota reaches the ratio",
+	}
+	p.PacingHintSoftUtilization.Init(base.mgr)
+
+	p.QuotaStateNotifyEnabled = ParamItem{
+		Key:          "proxy.quotaStateNotify.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "return the quota states of the cluster in the grpc trailers of successful responses",
+	}
+	p.QuotaStateNotifyEnabled.Init(base.mgr)
+
+	p.ReadRetryEnabled = ParamItem{
+		Key:          "proxy.readRetry.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "retry the failed shards of search and query on the other replicas within the retry budget",
+	}
+	p.ReadRetryEnabled.Init(base.mgr)
+
+	p.ReadRetryBudgetRatio = ParamItem{
+		Key:          "proxy.readRetry.budgetRatio",
+		Version:      "2.2.3",
+		DefaultValue: "0.1",
+		Doc:          "the retries allowed per search and query, on top of the minimum retries",
+	}
+	p.ReadRetryBudgetRatio.Init(base.mgr)
+
+	p.ReadRetryMinPerSecond = ParamItem{
+		Key:          "proxy.readRetry.minRetriesPerSecond",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "the retries always allowed per second whatever the read traffic",
+	}
+	p.ReadRetryMinPerSecond.Init(base.mgr)
+
+	p.TimeTravelClampExpired = ParamItem{
+		Key:          "proxy.timeTravel.clampExpired",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "clamp the travel timestamps older than the time travel watermark with a warning instead of failing the request",
+	}
+	p.TimeTravelClampExpired.Init(base.mgr)
+
+	p.TimeTravelWatermarkTTL = ParamItem{
+		Key:          "proxy.timeTravel.watermarkCacheTTL",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "seconds to cache the time travel watermarks fetched from DataCoord, or the error fetching them",
+	}
+	p.TimeTravelWatermarkTTL.Init(base.mgr)
+
+	p.SearchTuningMinSamples = ParamItem{
+		Key:          "proxy.searchTuning.minSamples",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "the min number of searches observed with a search param value before its latency is trusted by the latency target",
+	}
+	p.SearchTuningMinSamples.Init(base.mgr)
+
+	p.SearchTuningExploreRatio = ParamItem{
+		Key:          "proxy.searchTuning.exploreRatio",
+		Version:      "2.2.3",
+		DefaultValue: "0.05",
+		Doc:          "the ratio of the searches with a latency target trying the next larger search param value not calibrated yet",
+	}
+	p.SearchTuningExploreRatio.Init(base.mgr)
+
+	p.ZoneAwareRoutingEnabled = ParamItem{
+		Key:          "proxy.zoneAwareRouting.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "send search and query to the shard leaders in the zone of the proxy first, the other zones are tried on failure",
+	}
+	p.ZoneAwareRoutingEnabled.Init(base.mgr)
+
+	p.RerankEnabled = ParamItem{
+		Key:          "proxy.rerank.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "allow the searches to re-rank the merged top-k with the strategy named by the rerank search param",
+	}
+	p.RerankEnabled.Init(base.mgr)
+
+	p.RerankSoPath = ParamItem{
+		Key:          "proxy.rerank.soPath",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "the path of the go plugin exporting MilvusReranker, which is registered besides the built-in strategies",
+	}
+	p.RerankSoPath.Init(base.mgr)
+
+	p.EmbeddingEndpoint = ParamItem{
+		Key:          "proxy.embedding.endpoint",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "the url of an openai compatible embedding service generating the vector fields with an embedding_source type param, empty disables the generation",
+	}
+	p.EmbeddingEndpoint.Init(base.mgr)
+
+	p.EmbeddingAPIKey = ParamItem{
+		Key:          "proxy.embedding.apiKey",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "the bearer token sent to the embedding service, empty sends none",
+	}
+	p.EmbeddingAPIKey.Init(base.mgr)
+
+	p.EmbeddingTimeout = ParamItem{
+		Key:          "proxy.embedding.timeout",
+		Version:      "2.2.3",
+		DefaultValue: "10000",
+		Doc:          "milliseconds, the timeout of a request to the embedding service",
+	}
+	p.EmbeddingTimeout.Init(base.mgr)
+
+	p.EmbeddingBatchSize = ParamItem{
+		Key:          "proxy.embedding.batchSize",
+		Version:      "2.2.3",
+		DefaultValue: "64",
+		Doc:          "the max number of texts embedded by a request to the embedding service",
+	}
+	p.EmbeddingBatchSize.Init(base.mgr)
+
+	p.EmbeddingMaxRetries = ParamItem{
+		Key:          "proxy.embedding.maxRetries",
+		Version:      "2.2.3",
+		DefaultValue: "2",
+		Doc:          "the max times a failed request to the embedding service is retried",
+	}
+	p.EmbeddingMaxRetries.Init(base.mgr)
+
+	p.EmbeddingFailurePolicy = ParamItem{
+		Key:          "proxy.embedding.failurePolicy",
+		Version:      "2.2.3",
+		DefaultValue: "reject",
+		Doc:          "reject fails the insert if the texts can't be embedded, zero inserts zero vectors instead",
+	}
+	p.EmbeddingFailurePolicy.Init(base.mgr)
+
+	p.SLOEnabled = ParamItem{
+		Key:          "proxy.slo.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "shed the requests of the lowest priority tiers while the latency slo error budget burns too fast",
+	}
+	p.SLOEnabled.Init(base.mgr)
+
+	p.SLOObjectives = ParamItem{
+		Key:          "proxy.slo.objectives",
+		Version:      "2.2.3",
+		DefaultValue: "{}",
+		Doc:          "json map of request type (search, query, insert, delete) to the latency objective, each with the latency_ms and the percentile",
+	}
+	p.SLOObjectives.Init(base.mgr)
+
+	p.SLOTiers = ParamItem{
+		Key:          "proxy.slo.tiers",
+		Version:      "2.2.3",
+		DefaultValue: "{}",
+		Doc:          "json object of the users and the collections maps to their tiers, the larger tier is shed first and tier 0 is never shed",
+	}
+	p.SLOTiers.Init(base.mgr)
+
+	p.SLODefaultTier = ParamItem{
+		Key:          "proxy.slo.defaultTier",
+		Version:      "2.2.3",
+		DefaultValue: "1",
+		Doc:          "the tier of the requests whose user and collection have no tier",
+	}
+	p.SLODefaultTier.Init(base.mgr)
+
+	p.SLOWindow = ParamItem{
+		Key:          "proxy.slo.window",
+		Version:      "2.2.3",
+		DefaultValue: "60",
+		Doc:          "seconds, the long window the burn rate is measured in",
+	}
+	p.SLOWindow.Init(base.mgr)
+
+	p.SLOEvaluateInterval = ParamItem{
+		Key:          "proxy.slo.evaluateInterval",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "seconds, the interval to shed or restore a tier, which is also the short window the burn rate is measured in",
+	}
+	p.SLOEvaluateInterval.Init(base.mgr)
+
+	p.SLOBurnRateThreshold = ParamItem{
+		Key:          "proxy.slo.burnRateThreshold",
+		Version:      "2.2.3",
+		DefaultValue: "5",
+		Doc:          "one more tier is shed while the burn rates of both windows exceed the threshold",
+	}
+	p.SLOBurnRateThreshold.Init(base.mgr)
+
+	p.SLOMinRequests = ParamItem{
+		Key:          "proxy.slo.minRequests",
+		Version:      "2.2.3",
+		DefaultValue: "100",
+		Doc:          "the min number of requests in the long window before its burn rate is trusted",
+	}
+	p.SLOMinRequests.Init(base.mgr)
+
+	p.StreamingInsertEnabled = ParamItem{
+		Key:          "proxy.streamingInsert.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "serve the client streaming insert, which sends the rows of one insert in chunks",
+	}
+	p.StreamingInsertEnabled.Init(base.mgr)
+
+	p.StreamingInsertMaxSize = ParamItem{
+		Key:          "proxy.streamingInsert.maxSize",
+		Version:      "2.2.3",
+		DefaultValue: "512",
+		Doc:          "MB, the max total size of the chunks of a streaming insert",
+	}
+	p.StreamingInsertMaxSize.Init(base.mgr)
+
+	p.InsertValidationPolicy = ParamItem{
+		Key:          "proxy.insertValidation.policy",
+		Version:      "2.2.3",
+		DefaultValue: "none",
+		Doc:          "none, reject or sanitize, how the inserted rows with a wrong vector dim or NaN/Inf vector values are handled",
+	}
+	p.InsertValidationPolicy.Init(base.mgr)
+
 	p.GinLogging = ParamItem{
 		Key:          "proxy.ginLogging",
 		Version:      "2.2.0",
@@ -854,6 +1288,9 @@ type queryCoordConfig struct {
 
 	NextTargetSurviveTime    ParamItem `refreshable:"true"`
 	UpdateNextTargetInterval ParamItem `refreshable:"false"`
+
+	// load order of the segments by the query heats in DataCoord
+	SegmentHeatLoadFirst ParamItem `refreshable:"true"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -1004,6 +1441,14 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		PanicIfEmpty: true,
 	}
 	p.UpdateNextTargetInterval.Init(base.mgr)
+
+	p.SegmentHeatLoadFirst = ParamItem{
+		Key:          "queryCoord.segmentHeat.loadFirst",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "load the segments with higher query heats in DataCoord first during collection load or replica creation",
+	}
+	p.SegmentHeatLoadFirst.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1034,6 +1479,20 @@ type queryNodeConfig struct {
 	CacheEnabled     ParamItem `refreshable:"false"`
 	CacheMemoryLimit ParamItem `refreshable:"false"`
 
+	// lazy load
+	LazyLoadVectorField ParamItem `refreshable:"true"`
+	LazyLoadCacheSize   ParamItem `refreshable:"true"`
+
+	// plan cache
+	PlanCacheCapacity ParamItem `refreshable:"true"`
+
+	// growing segment pk statistics
+	GrowingPkFilterBlockRows   ParamItem `refreshable:"false"`
+	HandoffReuseGrowingPkStats ParamItem `refreshable:"true"`
+
+	// skip the growing segments served as sealed segments during handoff
+	HandoffDedupEnabled ParamItem `refreshable:"true"`
+
 	GroupEnabled         ParamItem `refreshable:"true"`
 	MaxReceiveChanSize   ParamItem `refreshable:"false"`
 	MaxUnsolvedQueueSize ParamItem `refreshable:"true"`
@@ -1043,6 +1502,25 @@ type queryNodeConfig struct {
 	CPURatio             ParamItem `refreshable:"true"`
 	MaxTimestampLag      ParamItem `refreshable:"true"`
 
+	// collection isolation of the read task scheduling
+	CollectionIsolationEnabled ParamItem `refreshable:"true"`
+	CollectionIsolationWeights ParamItem `refreshable:"true"`
+
+	// two phase reduce of the follower search results
+	AdaptiveReduceEnabled    ParamItem `refreshable:"true"`
+	AdaptiveReduceMinEntries ParamItem `refreshable:"true"`
+	AdaptiveReduceCacheTTL   ParamItem `refreshable:"true"`
+
+	// merge of the search results across nq on the reduce pool
+	ParallelReduceMinNQ ParamItem `refreshable:"true"`
+
+	// size limit of the query results merged on the node
+	MaxResultSize ParamItem `refreshable:"true"`
+
+	// query heats of the sealed segments reported to DataCoord
+	SegmentHeatHalfLife       ParamItem `refreshable:"true"`
+	SegmentHeatReportInterval ParamItem `refreshable:"false"`
+
 	GCHelperEnabled     ParamItem `refreshable:"false"`
 	MinimumGOGCConfig   ParamItem `refreshable:"false"`
 	MaximumGOGCConfig   ParamItem `refreshable:"false"`
@@ -1262,6 +1740,78 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	}
 	p.MaxTimestampLag.Init(base.mgr)
 
+	p.CollectionIsolationEnabled = ParamItem{
+		Key:          "queryNode.scheduler.collectionIsolation.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "schedule the ready read tasks of the collections in weighted round robin and evict the tasks of the collection queuing the most",
+	}
+	p.CollectionIsolationEnabled.Init(base.mgr)
+
+	p.CollectionIsolationWeights = ParamItem{
+		Key:          "queryNode.scheduler.collectionIsolation.weights",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "comma separated collectionID:weight pairs, the collections not listed have weight 1",
+	}
+	p.CollectionIsolationWeights.Init(base.mgr)
+
+	p.AdaptiveReduceEnabled = ParamItem{
+		Key:          "queryNode.grouping.adaptiveReduce.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "the shard leader asks the followers for the ids and scores of the results first, and fetches the output fields of the results surviving the merge only",
+	}
+	p.AdaptiveReduceEnabled.Init(base.mgr)
+
+	p.AdaptiveReduceMinEntries = ParamItem{
+		Key:          "queryNode.grouping.adaptiveReduce.minEntries",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "the min nq * topk of the searches reduced in two phases",
+	}
+	p.AdaptiveReduceMinEntries.Init(base.mgr)
+
+	p.AdaptiveReduceCacheTTL = ParamItem{
+		Key:          "queryNode.grouping.adaptiveReduce.cacheTTL",
+		Version:      "2.2.3",
+		DefaultValue: "10000",
+		Doc:          "milliseconds, how long a follower keeps the full results of the first phase for the fetch of the shard leader, which searches the followers in one phase again once they expire",
+	}
+	p.AdaptiveReduceCacheTTL.Init(base.mgr)
+
+	p.ParallelReduceMinNQ = ParamItem{
+		Key:          "queryNode.grouping.parallelReduce.minNQ",
+		Version:      "2.2.3",
+		DefaultValue: "64",
+		Doc:          "the min nq of the search results merged query by query on the reduce pool, non-positive merges sequentially",
+	}
+	p.ParallelReduceMinNQ.Init(base.mgr)
+
+	p.MaxResultSize = ParamItem{
+		Key:          "queryNode.maxResultSize",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "max estimated size in bytes of the query result merged on a query node, 0 means no limit",
+	}
+	p.MaxResultSize.Init(base.mgr)
+
+	p.SegmentHeatHalfLife = ParamItem{
+		Key:          "queryNode.segmentHeat.halfLife",
+		Version:      "2.2.3",
+		DefaultValue: "300",
+		Doc:          "seconds, the query heat of a sealed segment counts the searches and queries on it and decays by half every halfLife",
+	}
+	p.SegmentHeatHalfLife.Init(base.mgr)
+
+	p.SegmentHeatReportInterval = ParamItem{
+		Key:          "queryNode.segmentHeat.reportInterval",
+		Version:      "2.2.3",
+		DefaultValue: "30",
+		Doc:          "seconds, the interval to report the query heats of the sealed segments to DataCoord, non-positive disables the reports",
+	}
+	p.SegmentHeatReportInterval.Init(base.mgr)
+
 	p.GCHelperEnabled = ParamItem{
 		Key:          "queryNode.gchelper.enabled",
 		Version:      "2.0.0",
@@ -1289,24 +1839,73 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 		FallbackKeys: []string{"common.gracefulStopTimeout"},
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
-}
-
-// /////////////////////////////////////////////////////////////////////////////
-// --- datacoord ---
-type dataCoordConfig struct {
 
-	// --- CHANNEL ---
-	MaxWatchDuration ParamItem `refreshable:"false"`
+	p.LazyLoadVectorField = ParamItem{
+		Key:          "queryNode.lazyLoad.vectorField",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "skip loading the raw vector fields without index of sealed segments until a search or query needs them",
+	}
+	p.LazyLoadVectorField.Init(base.mgr)
 
-	// --- SEGMENTS ---
-	SegmentMaxSize                 ParamItem `refreshable:"false"`
-	DiskSegmentMaxSize             ParamItem `refreshable:"true"`
-	SegmentSealProportion          ParamItem `refreshable:"false"`
-	SegAssignmentExpiration        ParamItem `refreshable:"true"`
-	SegmentMaxLifetime             ParamItem `refreshable:"false"`
-	SegmentMaxIdleTime             ParamItem `refreshable:"false"`
-	SegmentMinSizeFromIdleToSealed ParamItem `refreshable:"false"`
-	SegmentMaxBinlogFileNumber     ParamItem `refreshable:"false"`
+	p.LazyLoadCacheSize = ParamItem{
+		Key:          "queryNode.lazyLoad.cacheSize",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "the max size in MB of the fields loaded on demand, the least recently used ones are evicted beyond it. 0 means no limit, the fields are evicted only if the memory is not enough to load another one",
+	}
+	p.LazyLoadCacheSize.Init(base.mgr)
+
+	p.PlanCacheCapacity = ParamItem{
+		Key:          "queryNode.planCache.capacity",
+		Version:      "2.2.3",
+		DefaultValue: "1024",
+		Doc:          "max number of the search and query plans cached for reuse by the requests with the same filter, 0 disables the cache",
+	}
+	p.PlanCacheCapacity.Init(base.mgr)
+
+	p.GrowingPkFilterBlockRows = ParamItem{
+		Key:          "queryNode.growingPkFilter.blockRows",
+		Version:      "2.2.3",
+		DefaultValue: "100000",
+		Doc:          "the pks held by a bloom filter block of growing segments, a new block is started once it is full",
+	}
+	p.GrowingPkFilterBlockRows.Init(base.mgr)
+
+	p.HandoffReuseGrowingPkStats = ParamItem{
+		Key:          "queryNode.growingPkFilter.reuseOnHandoff",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "ship the pk filter blocks of the growing segment to the sealed segment handed off on the same node instead of loading its stats logs",
+	}
+	p.HandoffReuseGrowingPkStats.Init(base.mgr)
+
+	p.HandoffDedupEnabled = ParamItem{
+		Key:          "queryNode.handoffDedup.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "skip the growing segments whose sealed segments are served by the shard cluster version a read is pinned to",
+	}
+	p.HandoffDedupEnabled.Init(base.mgr)
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- datacoord ---
+type dataCoordConfig struct {
+
+	// --- CHANNEL ---
+	MaxWatchDuration                  ParamItem `refreshable:"false"`
+	ChannelCheckpointFallbackInterval ParamItem `refreshable:"false"`
+
+	// --- SEGMENTS ---
+	SegmentMaxSize                 ParamItem `refreshable:"false"`
+	DiskSegmentMaxSize             ParamItem `refreshable:"true"`
+	SegmentSealProportion          ParamItem `refreshable:"false"`
+	SegAssignmentExpiration        ParamItem `refreshable:"true"`
+	SegmentMaxLifetime             ParamItem `refreshable:"false"`
+	SegmentMaxIdleTime             ParamItem `refreshable:"false"`
+	SegmentMinSizeFromIdleToSealed ParamItem `refreshable:"false"`
+	SegmentMaxBinlogFileNumber     ParamItem `refreshable:"false"`
 
 	// compaction
 	EnableCompaction     ParamItem `refreshable:"false"`
@@ -1324,6 +1923,9 @@ type dataCoordConfig struct {
 	SingleCompactionExpiredLogMaxSize ParamItem `refreshable:"true"`
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
 	GlobalCompactionInterval          ParamItem `refreshable:"false"`
+	BinlogMergeEnabled                ParamItem `refreshable:"true"`
+	BinlogMergeMinBinlogNum           ParamItem `refreshable:"true"`
+	BinlogMergeSmallBinlogProportion  ParamItem `refreshable:"true"`
 
 	// Garbage Collection
 	EnableGarbageCollection ParamItem `refreshable:"false"`
@@ -1332,6 +1934,51 @@ type dataCoordConfig struct {
 	GCDropTolerance         ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
 
+	// segment lock
+	SegmentLockLeaseTTL ParamItem `refreshable:"true"`
+	FreezeWindowMaxTTL  ParamItem `refreshable:"true"`
+
+	// index compatibility
+	IndexMinEngineVersion ParamItem `refreshable:"true"`
+	IndexMinFormatVersion ParamItem `refreshable:"true"`
+
+	ImportBuildIndexOnSave ParamItem `refreshable:"true"`
+
+	// segment state history
+	SegmentHistoryMaxEvents ParamItem `refreshable:"true"`
+
+	// segment allocation hints
+	SegmentAllocHintEnabled         ParamItem `refreshable:"true"`
+	SegmentAllocHintSkewThreshold   ParamItem `refreshable:"true"`
+	SegmentAllocHintMinRowRatio     ParamItem `refreshable:"true"`
+	SegmentAllocHintRefreshInterval ParamItem `refreshable:"true"`
+
+	// delete sla
+	DeleteSLASampleInterval      ParamItem `refreshable:"true"`
+	DeleteSLAMaxCompletedMarkers ParamItem `refreshable:"true"`
+
+	// handoff gate
+	HandoffGateEnabled ParamItem `refreshable:"true"`
+	HandoffGateMaxWait ParamItem `refreshable:"true"`
+
+	// segment anomaly detection
+	SegmentAnomalyCheckInterval         ParamItem `refreshable:"false"`
+	SegmentAnomalyTinyProportion        ParamItem `refreshable:"true"`
+	SegmentAnomalyTinyNumThreshold      ParamItem `refreshable:"true"`
+	SegmentAnomalyGiantProportion       ParamItem `refreshable:"true"`
+	SegmentAnomalySealingTimeout        ParamItem `refreshable:"true"`
+	SegmentAnomalyAutoMergeTinySegments ParamItem `refreshable:"true"`
+
+	// segment pk range index
+	SegmentPKIndexBloomFilter ParamItem `refreshable:"true"`
+
+	// recovery info cache
+	RecoveryInfoCacheEnabled ParamItem `refreshable:"true"`
+	RecoveryInfoCacheIdleTTL ParamItem `refreshable:"true"`
+
+	// query heats of the segments reported by QueryNodes
+	SegmentHeatHalfLife ParamItem `refreshable:"true"`
+
 	BindIndexNodeMode ParamItem `refreshable:"false"`
 	IndexNodeAddress  ParamItem `refreshable:"false"`
 	WithCredential    ParamItem `refreshable:"false"`
@@ -1349,6 +1996,14 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.MaxWatchDuration.Init(base.mgr)
 
+	p.ChannelCheckpointFallbackInterval = ParamItem{
+		Key:          "dataCoord.channel.checkpointFallbackInterval",
+		Version:      "2.2.3",
+		DefaultValue: "60",
+		Doc:          "seconds, the interval to reconcile the channel checkpoints persisted to object storage by datanodes, 0 disables the reconcile",
+	}
+	p.ChannelCheckpointFallbackInterval.Init(base.mgr)
+
 	p.SegmentMaxSize = ParamItem{
 		Key:          "dataCoord.segment.maxSize",
 		Version:      "2.0.0",
@@ -1503,6 +2158,30 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.GlobalCompactionInterval.Init(base.mgr)
 
+	p.BinlogMergeEnabled = ParamItem{
+		Key:          "dataCoord.compaction.binlogMerge.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "merge the many small insert binlogs of a flushed segment into fewer larger ones",
+	}
+	p.BinlogMergeEnabled.Init(base.mgr)
+
+	p.BinlogMergeMinBinlogNum = ParamItem{
+		Key:          "dataCoord.compaction.binlogMerge.minBinlogNum",
+		Version:      "2.2.3",
+		DefaultValue: "32",
+		Doc:          "min average number of insert binlogs per field of a segment to merge its binlogs",
+	}
+	p.BinlogMergeMinBinlogNum.Init(base.mgr)
+
+	p.BinlogMergeSmallBinlogProportion = ParamItem{
+		Key:          "dataCoord.compaction.binlogMerge.smallBinlogProportion",
+		Version:      "2.2.3",
+		DefaultValue: "0.25",
+		Doc:          "the binlogs of a segment are small if their average size is below the proportion of datanode.segment.binlog.maxsize",
+	}
+	p.BinlogMergeSmallBinlogProportion.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -1538,6 +2217,198 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.EnableActiveStandby.Init(base.mgr)
 
+	p.SegmentLockLeaseTTL = ParamItem{
+		Key:          "dataCoord.segmentLock.leaseTTL",
+		Version:      "2.2.3",
+		DefaultValue: "600",
+		Doc:          "seconds, segment locks not renewed within the ttl are released automatically",
+	}
+	p.SegmentLockLeaseTTL.Init(base.mgr)
+
+	p.FreezeWindowMaxTTL = ParamItem{
+		Key:          "dataCoord.freezeWindow.maxTTL",
+		Version:      "2.2.3",
+		DefaultValue: "7200",
+		Doc:          "seconds, max duration of a freeze window of handoffs and compaction",
+	}
+	p.FreezeWindowMaxTTL.Init(base.mgr)
+
+	p.IndexMinEngineVersion = ParamItem{
+		Key:          "dataCoord.index.minEngineVersion",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "segment indexes built by a knowhere version older than this semver, e.g. v1.3.6, are reported as deprecated, empty means no limit",
+	}
+	p.IndexMinEngineVersion.Init(base.mgr)
+
+	p.IndexMinFormatVersion = ParamItem{
+		Key:          "dataCoord.index.minFormatVersion",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "segment indexes with a file format version older than this are reported as deprecated",
+	}
+	p.IndexMinFormatVersion.Init(base.mgr)
+
+	p.ImportBuildIndexOnSave = ParamItem{
+		Key:          "dataCoord.import.buildIndexOnSave",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "enqueue the index builds of the segments imported by bulk load when they are saved, instead of waiting for the flushed segment notification",
+	}
+	p.ImportBuildIndexOnSave.Init(base.mgr)
+
+	p.SegmentHistoryMaxEvents = ParamItem{
+		Key:          "dataCoord.segment.historyMaxEvents",
+		Version:      "2.2.3",
+		DefaultValue: "32",
+		Doc:          "the max number of state transitions kept in the history of a segment, the oldest ones are dropped first",
+	}
+	p.SegmentHistoryMaxEvents.Init(base.mgr)
+
+	p.SegmentAllocHintEnabled = ParamItem{
+		Key:          "dataCoord.segment.allocHint.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "cap the max rows of the new segments of the vchannels receiving more rows of a partition than the others",
+	}
+	p.SegmentAllocHintEnabled.Init(base.mgr)
+
+	p.SegmentAllocHintSkewThreshold = ParamItem{
+		Key:          "dataCoord.segment.allocHint.skewThreshold",
+		Version:      "2.2.3",
+		DefaultValue: "1.5",
+		Doc:          "a vchannel is skewed when its rows of a partition exceed the threshold times the mean of the vchannels",
+	}
+	p.SegmentAllocHintSkewThreshold.Init(base.mgr)
+
+	p.SegmentAllocHintMinRowRatio = ParamItem{
+		Key:          "dataCoord.segment.allocHint.minRowRatio",
+		Version:      "2.2.3",
+		DefaultValue: "0.25",
+		Doc:          "the lower bound of the max rows of the new segments of a skewed vchannel, relative to the max rows of a segment",
+	}
+	p.SegmentAllocHintMinRowRatio.Init(base.mgr)
+
+	p.SegmentAllocHintRefreshInterval = ParamItem{
+		Key:          "dataCoord.segment.allocHint.refreshInterval",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "seconds, the interval to recompute the row distribution of a partition over its vchannels",
+	}
+	p.SegmentAllocHintRefreshInterval.Init(base.mgr)
+
+	p.DeleteSLASampleInterval = ParamItem{
+		Key:          "dataCoord.deleteSLA.sampleInterval",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "one in every sampleInterval deltalogs saved of a channel is sampled as a delete sla marker, 0 disables the sampling",
+	}
+	p.DeleteSLASampleInterval.Init(base.mgr)
+
+	p.DeleteSLAMaxCompletedMarkers = ParamItem{
+		Key:          "dataCoord.deleteSLA.maxCompletedMarkers",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "the max number of completed delete sla markers kept, the oldest ones are dropped first",
+	}
+	p.DeleteSLAMaxCompletedMarkers.Init(base.mgr)
+
+	p.HandoffGateEnabled = ParamItem{
+		Key:          "dataCoord.handoffGate.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "hold back the handoff of a flushed segment to the QueryNodes until its index is built",
+	}
+	p.HandoffGateEnabled.Init(base.mgr)
+
+	p.HandoffGateMaxWait = ParamItem{
+		Key:          "dataCoord.handoffGate.maxWait",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "seconds, max time a flushed segment is held back waiting for its index, 0 means no limit",
+	}
+	p.HandoffGateMaxWait.Init(base.mgr)
+
+	p.SegmentAnomalyCheckInterval = ParamItem{
+		Key:          "dataCoord.segmentAnomaly.checkInterval",
+		Version:      "2.2.3",
+		DefaultValue: "600",
+		Doc:          "seconds, the interval to detect the collections with abnormal segments, 0 disables the periodic detection",
+	}
+	p.SegmentAnomalyCheckInterval.Init(base.mgr)
+
+	p.SegmentAnomalyTinyProportion = ParamItem{
+		Key:          "dataCoord.segmentAnomaly.tinyProportion",
+		Version:      "2.2.3",
+		DefaultValue: "0.05",
+		Doc:          "a flushed segment with fewer rows than tinyProportion of its max rows is tiny",
+	}
+	p.SegmentAnomalyTinyProportion.Init(base.mgr)
+
+	p.SegmentAnomalyTinyNumThreshold = ParamItem{
+		Key:          "dataCoord.segmentAnomaly.tinyNumThreshold",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "a collection with at least tinyNumThreshold tiny segments is abnormal",
+	}
+	p.SegmentAnomalyTinyNumThreshold.Init(base.mgr)
+
+	p.SegmentAnomalyGiantProportion = ParamItem{
+		Key:          "dataCoord.segmentAnomaly.giantProportion",
+		Version:      "2.2.3",
+		DefaultValue: "2",
+		Doc:          "a segment with more rows than giantProportion of its max rows is giant",
+	}
+	p.SegmentAnomalyGiantProportion.Init(base.mgr)
+
+	p.SegmentAnomalySealingTimeout = ParamItem{
+		Key:          "dataCoord.segmentAnomaly.sealingTimeout",
+		Version:      "2.2.3",
+		DefaultValue: "3600",
+		Doc:          "seconds, a segment sealed longer than sealingTimeout without being flushed is stalled",
+	}
+	p.SegmentAnomalySealingTimeout.Init(base.mgr)
+
+	p.SegmentAnomalyAutoMergeTinySegments = ParamItem{
+		Key:          "dataCoord.segmentAnomaly.autoMergeTinySegments",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "trigger a compaction merging the small segments of the collections with too many tiny segments on detection",
+	}
+	p.SegmentAnomalyAutoMergeTinySegments.Init(base.mgr)
+
+	p.SegmentPKIndexBloomFilter = ParamItem{
+		Key:          "dataCoord.segmentPKIndex.bloomFilter",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "keep the pk bloom filters of the flushed segments besides their pk ranges, to locate the pks more precisely at the cost of memory",
+	}
+	p.SegmentPKIndexBloomFilter.Init(base.mgr)
+
+	p.RecoveryInfoCacheEnabled = ParamItem{
+		Key:          "dataCoord.recoveryInfoCache.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "cache the binlogs of the flushed segments answered by GetRecoveryInfo, and answer the segments changed since a version only",
+	}
+	p.RecoveryInfoCacheEnabled.Init(base.mgr)
+
+	p.RecoveryInfoCacheIdleTTL = ParamItem{
+		Key:          "dataCoord.recoveryInfoCache.idleTTL",
+		Version:      "2.2.3",
+		DefaultValue: "600",
+		Doc:          "seconds, the cached binlogs of a partition are dropped if GetRecoveryInfo is not called for it within idleTTL",
+	}
+	p.RecoveryInfoCacheIdleTTL.Init(base.mgr)
+
+	p.SegmentHeatHalfLife = ParamItem{
+		Key:          "dataCoord.segmentHeat.halfLife",
+		Version:      "2.2.3",
+		DefaultValue: "3600",
+		Doc:          "seconds, the query heat last reported for a segment decays by half every halfLife, so the hot segments of a released collection are still known when it's loaded again",
+	}
+	p.SegmentHeatHalfLife.Init(base.mgr)
+
 	p.MinSegmentNumRowsToEnableIndex = ParamItem{
 		Key:          "dataCoord.segment.minSegmentNumRowsToEnableIndex",
 		Version:      "2.0.0",
@@ -1579,6 +2450,7 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 type dataNodeConfig struct {
 	FlowGraphMaxQueueLength ParamItem `refreshable:"false"`
 	FlowGraphMaxParallelism ParamItem `refreshable:"false"`
+	FlowGraphDMLPipeline    ParamItem `refreshable:"true"`
 
 	// segment
 	FlushInsertBufferSize  ParamItem `refreshable:"true"`
@@ -1588,6 +2460,36 @@ type dataNodeConfig struct {
 
 	// io concurrency to fetch stats logs
 	IOConcurrency ParamItem `refreshable:"false"`
+
+	// delete
+	DeleteValidationEnabled ParamItem `refreshable:"true"`
+
+	// import
+	ImportParseParallelism  ParamItem `refreshable:"true"`
+	ImportMQBrokerAllowlist ParamItem `refreshable:"true"`
+
+	// channel checkpoint
+	ChannelCheckpointBatchWindow       ParamItem `refreshable:"false"`
+	ChannelCheckpointUpdateParallelism ParamItem `refreshable:"true"`
+	ChannelCheckpointFallbackInterval  ParamItem `refreshable:"true"`
+
+	// segment statistics
+	SegmentStatsAggregateInterval ParamItem `refreshable:"false"`
+
+	// clock skew self check
+	ClockSkewCheckInterval ParamItem `refreshable:"false"`
+	ClockSkewThreshold     ParamItem `refreshable:"true"`
+
+	// segment binlog layout
+	FlushSortByPK ParamItem `refreshable:"true"`
+
+	// compaction
+	CompactionSplitOutput     ParamItem `refreshable:"true"`
+	CompactionCollapseDeletes ParamItem `refreshable:"true"`
+	CompactionReadahead       ParamItem `refreshable:"true"`
+
+	// decommission
+	DecommissionTimeout ParamItem `refreshable:"true"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -1605,6 +2507,14 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.FlowGraphMaxParallelism.Init(base.mgr)
 
+	p.FlowGraphDMLPipeline = ParamItem{
+		Key:          "dataNode.dataSync.flowGraph.pipeline.dml",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "the comma-separated nodes of the flowgraphs of dml channels, empty means the built-in nodes only",
+	}
+	p.FlowGraphDMLPipeline.Init(base.mgr)
+
 	p.FlushInsertBufferSize = ParamItem{
 		Key:          "DATA_NODE_IBUFSIZE",
 		Version:      "2.0.0",
@@ -1642,6 +2552,117 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.IOConcurrency.Init(base.mgr)
 
+	p.DeleteValidationEnabled = ParamItem{
+		Key:          "dataNode.delete.validation.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "count the delete rows which match no segment bloom filter",
+	}
+	p.DeleteValidationEnabled.Init(base.mgr)
+
+	p.ImportParseParallelism = ParamItem{
+		Key:          "dataNode.import.parseParallelism",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "max number of files parsed concurrently by an import task, 0 means GOMAXPROCS, it's lowered to keep the parsing buffers under the import memory limit",
+	}
+	p.ImportParseParallelism.Init(base.mgr)
+
+	p.ImportMQBrokerAllowlist = ParamItem{
+		Key:          "dataNode.import.mqBrokerAllowlist",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "comma separated brokers(host:port or host) of the kafka/pulsar import sources, all the message queue sources are rejected if it's empty",
+	}
+	p.ImportMQBrokerAllowlist.Init(base.mgr)
+
+	p.ChannelCheckpointBatchWindow = ParamItem{
+		Key:          "dataNode.channelCheckpoint.batchWindow",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "milliseconds, the channel checkpoint updates of all vchannels within the window are sent in one batch",
+	}
+	p.ChannelCheckpointBatchWindow.Init(base.mgr)
+
+	p.ChannelCheckpointUpdateParallelism = ParamItem{
+		Key:          "dataNode.channelCheckpoint.updateParallelism",
+		Version:      "2.2.3",
+		DefaultValue: "10",
+		Doc:          "max number of channel checkpoint updates sent to datacoord concurrently by a batch, only used if datacoord doesn't support the batched update",
+	}
+	p.ChannelCheckpointUpdateParallelism.Init(base.mgr)
+
+	p.ChannelCheckpointFallbackInterval = ParamItem{
+		Key:          "dataNode.channelCheckpoint.fallbackInterval",
+		Version:      "2.2.3",
+		DefaultValue: "60",
+		Doc:          "seconds, min interval to persist the checkpoint of a vchannel to object storage while it fails to be updated to datacoord, 0 disables the fallback",
+	}
+	p.ChannelCheckpointFallbackInterval.Init(base.mgr)
+
+	p.SegmentStatsAggregateInterval = ParamItem{
+		Key:          "dataNode.segmentStats.aggregateInterval",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "milliseconds, the segment row count updates of all vchannels within the interval are sent to datacoord in one batch, 0 sends them with the time ticks of the vchannels",
+	}
+	p.SegmentStatsAggregateInterval.Init(base.mgr)
+
+	p.ClockSkewCheckInterval = ParamItem{
+		Key:          "dataNode.clockSkew.checkInterval",
+		Version:      "2.2.3",
+		DefaultValue: "30",
+		Doc:          "seconds, the interval of comparing the local clock with the allocated TSO, 0 disables the check",
+	}
+	p.ClockSkewCheckInterval.Init(base.mgr)
+
+	p.ClockSkewThreshold = ParamItem{
+		Key:          "dataNode.clockSkew.threshold",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "milliseconds, the datanode reports degraded health once the clock skew or a wall clock jump exceeds the threshold",
+	}
+	p.ClockSkewThreshold.Init(base.mgr)
+
+	p.FlushSortByPK = ParamItem{
+		Key:          "dataNode.flush.sortByPK",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "sort the rows of flushed and compacted binlogs by (primary key, timestamp) and write a sparse primary key index, which compaction uses to look up only the rows located for the deletes",
+	}
+	p.FlushSortByPK.Init(base.mgr)
+
+	p.CompactionSplitOutput = ParamItem{
+		Key:          "dataNode.compaction.splitOutput",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "split the merged rows of a compaction into several result segments of at most dataCoord.segment.maxSize",
+	}
+	p.CompactionSplitOutput.Init(base.mgr)
+
+	p.CompactionCollapseDeletes = ParamItem{
+		Key:          "dataNode.compaction.collapseDeletes",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "drop the repeated deletes of a primary key from the merged delta log of a compaction if they delete no row",
+	}
+	p.CompactionCollapseDeletes.Init(base.mgr)
+
+	p.CompactionReadahead = ParamItem{
+		Key:          "dataNode.compaction.readahead",
+		Version:      "2.2.3",
+		DefaultValue: "4",
+		Doc:          "the max number of insert binlog groups a compaction downloads in parallel ahead of the merging, 0 disables the readahead",
+	}
+	p.CompactionReadahead.Init(base.mgr)
+
+	p.DecommissionTimeout = ParamItem{
+		Key:          "dataNode.decommission.timeout",
+		Version:      "2.2.3",
+		DefaultValue: "600",
+		Doc:          "seconds, the max time to drain all the vchannels of the node for decommission",
+	}
+	p.DecommissionTimeout.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1655,8 +2676,21 @@ type indexCoordConfig struct {
 	MinSegmentNumRowsToEnableIndex ParamItem `refreshable:"true"`
 
 	GCInterval ParamItem `refreshable:"false"`
+	GCWindows  ParamItem `refreshable:"true"`
 
 	EnableActiveStandby ParamItem `refreshable:"false"`
+
+	HealthCheckEtcdLatencyThreshold ParamItem `refreshable:"true"`
+	HealthCheckBuildInProgressSLA   ParamItem `refreshable:"true"`
+
+	BuildLoadBalanceEnabled    ParamItem `refreshable:"true"`
+	BundleSegmentBuildsEnabled ParamItem `refreshable:"true"`
+	StatisticsRetentionDays    ParamItem `refreshable:"true"`
+
+	IndexTTLCheckInterval ParamItem `refreshable:"false"`
+	IndexTTLDryRun        ParamItem `refreshable:"true"`
+
+	CoverageMetricsInterval ParamItem `refreshable:"false"`
 }
 
 func (p *indexCoordConfig) init(base *BaseTable) {
@@ -1667,6 +2701,14 @@ func (p *indexCoordConfig) init(base *BaseTable) {
 	}
 	p.GCInterval.Init(base.mgr)
 
+	p.GCWindows = ParamItem{
+		Key:          "indexCoord.gc.windows",
+		Version:      "2.2.3",
+		DefaultValue: "",
+		Doc:          "comma separated off-peak windows like 02:00-05:00 in the local time, all the gc passes run inside them at most once every gc interval, empty disables the scheduled gc",
+	}
+	p.GCWindows.Init(base.mgr)
+
 	p.MinSegmentNumRowsToEnableIndex = ParamItem{
 		Key:          "indexCoord.minSegmentNumRowsToEnableIndex",
 		Version:      "2.0.0",
@@ -1708,18 +2750,99 @@ func (p *indexCoordConfig) init(base *BaseTable) {
 		DefaultValue: "false",
 	}
 	p.EnableActiveStandby.Init(base.mgr)
+
+	p.HealthCheckEtcdLatencyThreshold = ParamItem{
+		Key:          "indexCoord.healthCheck.etcdLatencyThreshold",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "milliseconds, indexcoord is unhealthy if an etcd write and read back takes longer",
+	}
+	p.HealthCheckEtcdLatencyThreshold.Init(base.mgr)
+
+	p.HealthCheckBuildInProgressSLA = ParamItem{
+		Key:          "indexCoord.healthCheck.buildInProgressSLA",
+		Version:      "2.2.3",
+		DefaultValue: "10800",
+		Doc:          "seconds, indexcoord is unhealthy if an index build stays in progress longer",
+	}
+	p.HealthCheckBuildInProgressSLA.Init(base.mgr)
+
+	p.BuildLoadBalanceEnabled = ParamItem{
+		Key:          "indexCoord.buildLoadBalance.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "assign index builds to the IndexNode with the least pending build load, and rebalance queued builds when an IndexNode joins",
+	}
+	p.BuildLoadBalanceEnabled.Init(base.mgr)
+
+	p.BundleSegmentBuildsEnabled = ParamItem{
+		Key:          "indexCoord.bundleSegmentBuilds.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "assign the index builds of a segment to the IndexNode already building another index of the segment",
+	}
+	p.BundleSegmentBuildsEnabled.Init(base.mgr)
+
+	p.StatisticsRetentionDays = ParamItem{
+		Key:          "indexCoord.statistics.retentionDays",
+		Version:      "2.2.3",
+		DefaultValue: "30",
+		Doc:          "days, the daily index build statistics older than this are dropped",
+	}
+	p.StatisticsRetentionDays.Init(base.mgr)
+
+	p.IndexTTLCheckInterval = ParamItem{
+		Key:          "indexCoord.indexTTL.checkInterval",
+		Version:      "2.2.3",
+		DefaultValue: "3600",
+		Doc:          "seconds, interval to check the usage of the indexes with a ttl, a drop candidate is dropped no earlier than the next check",
+	}
+	p.IndexTTLCheckInterval.Init(base.mgr)
+
+	p.IndexTTLDryRun = ParamItem{
+		Key:          "indexCoord.indexTTL.dryRun",
+		Version:      "2.2.3",
+		DefaultValue: "true",
+		Doc:          "only report the indexes unused longer than their ttl as drop candidates without dropping them",
+	}
+	p.IndexTTLDryRun.Init(base.mgr)
+
+	p.CoverageMetricsInterval = ParamItem{
+		Key:          "indexCoord.coverageMetrics.interval",
+		Version:      "2.2.3",
+		DefaultValue: "30",
+		Doc:          "seconds, interval to refresh the index coverage metrics of the collections",
+	}
+	p.CoverageMetricsInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
 // --- indexnode ---
 type indexNodeConfig struct {
-	BuildParallel ParamItem `refreshable:"false"`
+	BuildParallel      ParamItem `refreshable:"false"`
+	WarmWorkersEnabled ParamItem `refreshable:"false"`
 	// enable disk
 	EnableDisk             ParamItem `refreshable:"false"`
 	DiskCapacityLimit      ParamItem `refreshable:"true"`
 	MaxDiskUsagePercentage ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"false"`
+
+	BuildEventEnabled ParamItem `refreshable:"false"`
+
+	MemoryAdmissionEnabled   ParamItem `refreshable:"true"`
+	MaxMemoryUsagePercentage ParamItem `refreshable:"true"`
+
+	DownloadParallel     ParamItem `refreshable:"true"`
+	DownloadMaxBandwidth ParamItem `refreshable:"true"`
+
+	BundleEnabled ParamItem `refreshable:"true"`
+
+	ArtifactLayoutVersion ParamItem `refreshable:"true"`
+
+	ReproducibilityEnabled ParamItem `refreshable:"true"`
+
+	ResourceUsageSampleInterval ParamItem `refreshable:"false"`
 }
 
 func (p *indexNodeConfig) init(base *BaseTable) {
@@ -1730,6 +2853,14 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 	}
 	p.BuildParallel.Init(base.mgr)
 
+	p.WarmWorkersEnabled = ParamItem{
+		Key:          "indexNode.scheduler.warmWorkers",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "build the indexes on buildParallel standby workers kept across tasks with their os threads, the memory is released to the os only when the node is idle",
+	}
+	p.WarmWorkersEnabled.Init(base.mgr)
+
 	p.EnableDisk = ParamItem{
 		Key:          "indexNode.enableDisk",
 		Version:      "2.2.0",
@@ -1772,4 +2903,79 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		FallbackKeys: []string{"common.gracefulStopTimeout"},
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.BuildEventEnabled = ParamItem{
+		Key:          "indexNode.buildEvent.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "publish the lifecycle events of index build tasks to the msgstream",
+	}
+	p.BuildEventEnabled.Init(base.mgr)
+
+	p.MemoryAdmissionEnabled = ParamItem{
+		Key:          "indexNode.memoryAdmission.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "reject the index build jobs whose estimated peak memory exceeds the available memory",
+	}
+	p.MemoryAdmissionEnabled.Init(base.mgr)
+
+	p.MaxMemoryUsagePercentage = ParamItem{
+		Key:          "indexNode.memoryAdmission.maxMemoryUsagePercentage",
+		Version:      "2.2.3",
+		DefaultValue: "90",
+		Doc:          "the percentage of the host or container memory the index build jobs are admitted to use",
+		Formatter: func(v string) string {
+			return fmt.Sprintf("%f", getAsFloat(v)/100)
+		},
+	}
+	p.MaxMemoryUsagePercentage.Init(base.mgr)
+
+	p.DownloadParallel = ParamItem{
+		Key:          "indexNode.download.parallel",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "the concurrent binlog download streams per index build task, 0 means the number of cpus",
+	}
+	p.DownloadParallel.Init(base.mgr)
+
+	p.DownloadMaxBandwidth = ParamItem{
+		Key:          "indexNode.download.maxBandwidth",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+		Doc:          "the aggregate binlog download bandwidth of the node in MB/s shared by all tasks, 0 means unlimited",
+	}
+	p.DownloadMaxBandwidth.Init(base.mgr)
+
+	p.BundleEnabled = ParamItem{
+		Key:          "indexNode.bundle.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "download the binlogs shared by the index builds of the same segment on the node once",
+	}
+	p.BundleEnabled.Init(base.mgr)
+
+	p.ArtifactLayoutVersion = ParamItem{
+		Key:          "indexNode.artifactLayout.version",
+		Version:      "2.2.3",
+		DefaultValue: "2",
+		Doc:          "the layout of the saved index files, 2 publishes a manifest of the files after all of them are saved, 1 saves only the loose files",
+	}
+	p.ArtifactLayoutVersion.Init(base.mgr)
+
+	p.ReproducibilityEnabled = ParamItem{
+		Key:          "indexNode.reproducibility.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+		Doc:          "build the indexes single threaded with deterministic seeds and record the params, seed, environment and checksums in the index manifest",
+	}
+	p.ReproducibilityEnabled.Init(base.mgr)
+
+	p.ResourceUsageSampleInterval = ParamItem{
+		Key:          "indexNode.resourceUsage.sampleInterval",
+		Version:      "2.2.3",
+		DefaultValue: "1000",
+		Doc:          "milliseconds, the interval to sample the process stats attributed to the running build tasks, 0 disables the resource accounting",
+	}
+	p.ResourceUsageSampleInterval.Init(base.mgr)
 }
@@ -118,6 +118,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.DataCoordSubName.GetValue(), "by-dev-dataCoord")
 		t.Logf("datacoord subname = %s", Params.DataCoordSubName.GetValue())
 
+		// -- indexnode --
+		assert.Equal(t, Params.IndexNodeBuildEvent.GetValue(), "by-dev-indexnode-build-event")
+
 		assert.Equal(t, Params.DataNodeSubName.GetValue(), "by-dev-dataNode")
 		t.Logf("datanode subname = %s", Params.DataNodeSubName.GetValue())
 
@@ -125,12 +128,15 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("default session TTL time = %d", Params.SessionTTL.GetAsInt64())
 		assert.Equal(t, Params.SessionRetryTimes.GetAsInt64(), int64(DefaultSessionRetryTimes))
 		t.Logf("default session retry times = %d", Params.SessionRetryTimes.GetAsInt64())
+		assert.Equal(t, "", Params.SessionZone.GetValue())
 
 		params.Save("common.security.superUsers", "super1,super2,super3")
 		assert.Equal(t, []string{"super1", "super2", "super3"}, Params.SuperUsers.GetAsStrings())
 
 		params.Save("common.security.superUsers", "")
 		assert.Equal(t, []string{""}, Params.SuperUsers.GetAsStrings())
+
+		assert.Equal(t, "", Params.ManagementToken.GetValue())
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -144,6 +150,9 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention.GetAsFloat())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.True(t, Params.ImportPreflightCheckEnabled.GetAsBool())
+		assert.Equal(t, float64(86400), Params.DdlJournalRetention.GetAsFloat())
+		assert.Equal(t, 60*time.Second, Params.PartitionRolloverCheckInterval.GetAsDuration(time.Second))
 
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
@@ -166,6 +175,48 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum.GetAsInt64())
 
+		assert.Equal(t, int64(0), Params.MaxResultSize.GetAsInt64())
+		assert.False(t, Params.PartitionRoutingEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.PartitionRoutingCacheTTL.GetAsDuration(time.Second))
+		assert.False(t, Params.SearchTrafficSplitEnabled.GetAsBool())
+		assert.Equal(t, "{}", Params.SearchTrafficSplitRules.GetValue())
+		assert.Equal(t, 10*time.Second, Params.SearchTrafficSplitCacheTTL.GetAsDuration(time.Second))
+		assert.False(t, Params.MetaPrefetchEnabled.GetAsBool())
+		assert.Equal(t, "", Params.MetaPrefetchCollections.GetValue())
+		assert.Equal(t, 100, Params.MetaPrefetchMaxCollections.GetAsInt())
+		assert.Equal(t, 8, Params.MetaPrefetchParallelism.GetAsInt())
+		assert.Equal(t, 64, Params.SearchMultiMaxCollections.GetAsInt())
+		assert.Equal(t, 8, Params.SearchMultiParallelism.GetAsInt())
+		assert.True(t, Params.PacingHintEnabled.GetAsBool())
+		assert.Equal(t, 0.8, Params.PacingHintSoftUtilization.GetAsFloat())
+		assert.False(t, Params.QuotaStateNotifyEnabled.GetAsBool())
+		assert.False(t, Params.ReadRetryEnabled.GetAsBool())
+		assert.Equal(t, 0.1, Params.ReadRetryBudgetRatio.GetAsFloat())
+		assert.Equal(t, 10, Params.ReadRetryMinPerSecond.GetAsInt())
+		assert.False(t, Params.TimeTravelClampExpired.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.TimeTravelWatermarkTTL.GetAsDuration(time.Second))
+		assert.Equal(t, int64(10), Params.SearchTuningMinSamples.GetAsInt64())
+		assert.Equal(t, 0.05, Params.SearchTuningExploreRatio.GetAsFloat())
+		assert.False(t, Params.ZoneAwareRoutingEnabled.GetAsBool())
+		assert.True(t, Params.RerankEnabled.GetAsBool())
+		assert.Equal(t, "", Params.RerankSoPath.GetValue())
+		assert.Equal(t, "", Params.EmbeddingEndpoint.GetValue())
+		assert.Equal(t, 10*time.Second, Params.EmbeddingTimeout.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 64, Params.EmbeddingBatchSize.GetAsInt())
+		assert.Equal(t, 2, Params.EmbeddingMaxRetries.GetAsInt())
+		assert.Equal(t, "reject", Params.EmbeddingFailurePolicy.GetValue())
+		assert.False(t, Params.SLOEnabled.GetAsBool())
+		assert.Equal(t, "{}", Params.SLOObjectives.GetValue())
+		assert.Equal(t, "{}", Params.SLOTiers.GetValue())
+		assert.Equal(t, 1, Params.SLODefaultTier.GetAsInt())
+		assert.Equal(t, 60, Params.SLOWindow.GetAsInt())
+		assert.Equal(t, 10, Params.SLOEvaluateInterval.GetAsInt())
+		assert.Equal(t, 5.0, Params.SLOBurnRateThreshold.GetAsFloat())
+		assert.Equal(t, 100, Params.SLOMinRequests.GetAsInt())
+		assert.False(t, Params.StreamingInsertEnabled.GetAsBool())
+		assert.Equal(t, int64(512), Params.StreamingInsertMaxSize.GetAsInt64())
+		assert.Equal(t, "none", Params.InsertValidationPolicy.GetValue())
+
 		t.Logf("AccessLog.Enable: %t", Params.AccessLog.Enable.GetAsBool())
 
 		t.Logf("AccessLog.MaxSize: %d", Params.AccessLog.MaxSize.GetAsInt64())
@@ -242,6 +293,7 @@ func TestComponentParam(t *testing.T) {
 	t.Run("test queryCoordConfig", func(t *testing.T) {
 		Params := params.QueryCoordCfg
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
+		assert.True(t, Params.SegmentHeatLoadFirst.GetAsBool())
 		t.Logf("queryCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
 	})
 
@@ -274,6 +326,20 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(1000), Params.MaxGroupNQ.GetAsInt64())
 		assert.Equal(t, 10.0, Params.TopKMergeRatio.GetAsFloat())
 		assert.Equal(t, 10.0, Params.CPURatio.GetAsFloat())
+		assert.False(t, Params.CollectionIsolationEnabled.GetAsBool())
+		assert.Equal(t, "", Params.CollectionIsolationWeights.GetValue())
+		assert.False(t, Params.AdaptiveReduceEnabled.GetAsBool())
+		assert.Equal(t, int64(1000), Params.AdaptiveReduceMinEntries.GetAsInt64())
+		assert.Equal(t, 10*time.Second, Params.AdaptiveReduceCacheTTL.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 64, Params.ParallelReduceMinNQ.GetAsInt())
+		assert.Equal(t, int64(0), Params.MaxResultSize.GetAsInt64())
+		assert.Equal(t, 5*time.Minute, Params.SegmentHeatHalfLife.GetAsDuration(time.Second))
+		assert.Equal(t, 30*time.Second, Params.SegmentHeatReportInterval.GetAsDuration(time.Second))
+		assert.Equal(t, int64(0), Params.LazyLoadCacheSize.GetAsInt64())
+		assert.Equal(t, 1024, Params.PlanCacheCapacity.GetAsInt())
+		assert.Equal(t, 100000, Params.GrowingPkFilterBlockRows.GetAsInt())
+		assert.True(t, Params.HandoffReuseGrowingPkStats.GetAsBool())
+		assert.False(t, Params.HandoffDedupEnabled.GetAsBool())
 
 		// test small indexNlist/NProbe default
 		params.Remove("queryNode.segcore.smallIndex.nlist")
@@ -309,6 +375,29 @@ func TestComponentParam(t *testing.T) {
 		Params := params.DataCoordCfg
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime.GetAsDuration(time.Second))
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
+		assert.Equal(t, 10*time.Minute, Params.SegmentLockLeaseTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 2*time.Hour, Params.FreezeWindowMaxTTL.GetAsDuration(time.Second))
+		assert.True(t, Params.ImportBuildIndexOnSave.GetAsBool())
+		assert.Equal(t, 32, Params.SegmentHistoryMaxEvents.GetAsInt())
+		assert.False(t, Params.SegmentAllocHintEnabled.GetAsBool())
+		assert.Equal(t, 1.5, Params.SegmentAllocHintSkewThreshold.GetAsFloat())
+		assert.Equal(t, 0.25, Params.SegmentAllocHintMinRowRatio.GetAsFloat())
+		assert.Equal(t, 10*time.Second, Params.SegmentAllocHintRefreshInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10, Params.DeleteSLASampleInterval.GetAsInt())
+		assert.Equal(t, 1000, Params.DeleteSLAMaxCompletedMarkers.GetAsInt())
+		assert.True(t, Params.HandoffGateEnabled.GetAsBool())
+		assert.Equal(t, 0, Params.HandoffGateMaxWait.GetAsInt())
+		assert.Equal(t, 10*time.Minute, Params.SegmentAnomalyCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 0.05, Params.SegmentAnomalyTinyProportion.GetAsFloat())
+		assert.Equal(t, 1000, Params.SegmentAnomalyTinyNumThreshold.GetAsInt())
+		assert.Equal(t, 2.0, Params.SegmentAnomalyGiantProportion.GetAsFloat())
+		assert.Equal(t, time.Hour, Params.SegmentAnomalySealingTimeout.GetAsDuration(time.Second))
+		assert.False(t, Params.SegmentAnomalyAutoMergeTinySegments.GetAsBool())
+		assert.False(t, Params.SegmentPKIndexBloomFilter.GetAsBool())
+		assert.False(t, Params.RecoveryInfoCacheEnabled.GetAsBool())
+		assert.Equal(t, 600*time.Second, Params.RecoveryInfoCacheIdleTTL.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.SegmentHeatHalfLife.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.ChannelCheckpointFallbackInterval.GetAsDuration(time.Second))
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
 	})
@@ -326,6 +415,7 @@ func TestComponentParam(t *testing.T) {
 
 		maxParallelism := Params.FlowGraphMaxParallelism.GetAsInt()
 		t.Logf("flowGraphMaxParallelism: %d", maxParallelism)
+		assert.Equal(t, "", Params.FlowGraphDMLPipeline.GetValue())
 
 		size := Params.FlushInsertBufferSize.GetAsInt()
 		t.Logf("FlushInsertBufferSize: %d", size)
@@ -333,6 +423,18 @@ func TestComponentParam(t *testing.T) {
 		period := Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+
+		assert.False(t, Params.DeleteValidationEnabled.GetAsBool())
+		assert.Equal(t, 0, Params.ImportParseParallelism.GetAsInt())
+		assert.Equal(t, "", Params.ImportMQBrokerAllowlist.GetValue())
+		assert.False(t, Params.CompactionSplitOutput.GetAsBool())
+		assert.False(t, Params.CompactionCollapseDeletes.GetAsBool())
+		assert.Equal(t, 4, Params.CompactionReadahead.GetAsInt())
+		assert.Equal(t, time.Minute, Params.ChannelCheckpointFallbackInterval.GetAsDuration(time.Second))
+		assert.Equal(t, time.Second, Params.SegmentStatsAggregateInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 30*time.Second, Params.ClockSkewCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, time.Second, Params.ClockSkewThreshold.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 10*time.Minute, Params.DecommissionTimeout.GetAsDuration(time.Second))
 	})
 
 	t.Run("test indexCoordConfig", func(t *testing.T) {
@@ -345,12 +447,30 @@ func TestComponentParam(t *testing.T) {
 
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("indexCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+
+		assert.False(t, Params.BuildLoadBalanceEnabled.GetAsBool())
+		assert.False(t, Params.BundleSegmentBuildsEnabled.GetAsBool())
+		assert.Equal(t, 30, Params.StatisticsRetentionDays.GetAsInt())
+		assert.Equal(t, 3600, Params.IndexTTLCheckInterval.GetAsInt())
+		assert.True(t, Params.IndexTTLDryRun.GetAsBool())
+		assert.Equal(t, 30*time.Second, Params.CoverageMetricsInterval.GetAsDuration(time.Second))
+		assert.Equal(t, "", Params.GCWindows.GetValue())
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
 		Params := params.IndexNodeCfg
 		params.Save(Params.GracefulStopTimeout.Key, "50")
 		assert.Equal(t, Params.GracefulStopTimeout.GetAsInt64(), int64(50))
+
+		assert.False(t, Params.BuildEventEnabled.GetAsBool())
+		assert.False(t, Params.MemoryAdmissionEnabled.GetAsBool())
+		assert.False(t, Params.WarmWorkersEnabled.GetAsBool())
+		assert.Equal(t, 0, Params.DownloadParallel.GetAsInt())
+		assert.Equal(t, float64(0), Params.DownloadMaxBandwidth.GetAsFloat())
+		assert.False(t, Params.BundleEnabled.GetAsBool())
+		assert.Equal(t, 2, Params.ArtifactLayoutVersion.GetAsInt())
+		assert.False(t, Params.ReproducibilityEnabled.GetAsBool())
+		assert.Equal(t, time.Second, Params.ResourceUsageSampleInterval.GetAsDuration(time.Millisecond))
 	})
 
 }
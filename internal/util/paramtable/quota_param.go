@@ -90,6 +90,7 @@ type quotaConfig struct {
 	QueryNodeMemoryHighWaterLevel ParamItem `refreshable:"true"`
 	DiskProtectionEnabled         ParamItem `refreshable:"true"`
 	DiskQuota                     ParamItem `refreshable:"true"`
+	DiskQuotaPerCollection        ParamItem `refreshable:"true"`
 
 	// limit reading
 	ForceDenyReading        ParamItem `refreshable:"true"`
@@ -617,6 +618,25 @@ func (p *quotaConfig) init(base *BaseTable) {
 	}
 	p.DiskQuota.Init(base.mgr)
 
+	p.DiskQuotaPerCollection = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.diskProtection.diskQuotaPerCollection",
+		Version:      "2.2.3",
+		DefaultValue: quota,
+		Formatter: func(v string) string {
+			if !p.DiskProtectionEnabled.GetAsBool() {
+				return max
+			}
+			level := getAsFloat(v)
+			// (0, +inf)
+			if level <= 0 {
+				level = getAsFloat(quota)
+			}
+			// megabytes to bytes
+			return fmt.Sprintf("%f", megaBytes2Bytes(level))
+		},
+	}
+	p.DiskQuotaPerCollection.Init(base.mgr)
+
 	// limit reading
 	p.ForceDenyReading = ParamItem{
 		Key:          "quotaAndLimits.limitReading.forceDeny",
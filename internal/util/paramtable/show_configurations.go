@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ShowConfigSourcesHeader is the grpc request header asking ShowConfigurations for the source of every config
+	ShowConfigSourcesHeader = "milvus-show-config-sources"
+	// ConfigSourceKeySuffix is appended to the key of a config for the pair of its source, e.g. file, env, etcd or override
+	ConfigSourceKeySuffix = "@source"
+	// ConfigUpdatedKeySuffix is appended to the key of a config for the pair of whether it is updated since the start
+	ConfigUpdatedKeySuffix = "@updated"
+)
+
+// WithConfigSources returns the context asking ShowConfigurations for the source of every config
+func WithConfigSources(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ShowConfigSourcesHeader, "true")
+}
+
+// ConfigSourcesRequested returns whether the ShowConfigurations request asks for the source of every config
+func ConfigSourcesRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(ShowConfigSourcesHeader)
+	if len(values) == 0 {
+		return false
+	}
+	requested, err := strconv.ParseBool(values[0])
+	return err == nil && requested
+}
+
+// ShowComponentConfigurations returns the configurations of the component matching the pattern as key value pairs,
+// if the request asks for the sources, every config is followed by the pairs of its source and whether it is updated
+func (gp *BaseTable) ShowComponentConfigurations(ctx context.Context, componentName string, pattern string) []*commonpb.KeyValuePair {
+	if !ConfigSourcesRequested(ctx) {
+		configs := gp.GetComponentConfigurations(ctx, componentName, pattern)
+		configList := make([]*commonpb.KeyValuePair, 0, len(configs))
+		for key, value := range configs {
+			configList = append(configList, &commonpb.KeyValuePair{Key: key, Value: value})
+		}
+		return configList
+	}
+
+	configs := gp.GetComponentConfigurationsWithSource(ctx, componentName, pattern)
+	keys := make([]string, 0, len(configs))
+	for key := range configs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	configList := make([]*commonpb.KeyValuePair, 0, len(configs)*3)
+	for _, key := range keys {
+		value := configs[key]
+		configList = append(configList,
+			&commonpb.KeyValuePair{Key: key, Value: value.Value},
+			&commonpb.KeyValuePair{Key: key + ConfigSourceKeySuffix, Value: value.Source},
+			&commonpb.KeyValuePair{Key: key + ConfigUpdatedKeySuffix, Value: strconv.FormatBool(value.Updated)})
+	}
+	return configList
+}
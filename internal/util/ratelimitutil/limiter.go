@@ -68,6 +68,22 @@ func (lim *Limiter) Limit() Limit {
 	return lim.limit
 }
 
+// Burst returns the maximum number of tokens in the bucket.
+func (lim *Limiter) Burst() float64 {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	return lim.burst
+}
+
+// Tokens returns the number of tokens in the bucket at time now,
+// a negative number means the bucket is in debt and the events are punished until it's paid.
+func (lim *Limiter) Tokens(now time.Time) float64 {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	_, _, tokens := lim.advance(now)
+	return tokens
+}
+
 // AllowN reports whether n events may happen at time now.
 func (lim *Limiter) AllowN(now time.Time, n int) bool {
 	lim.mu.Lock()
@@ -344,3 +344,31 @@ func BenchmarkLimiter_AllowN(b *testing.B) {
 		}
 	})
 }
+
+func TestLimiterTokens(t *testing.T) {
+	lim := NewLimiter(10, 10)
+	if b := lim.Burst(); b != 10 {
+		t.Errorf("lim.Burst() = %v want 10", b)
+	}
+	if tokens := lim.Tokens(t0); tokens != 10 {
+		t.Errorf("lim.Tokens(t0) = %v want 10", tokens)
+	}
+
+	if !lim.AllowN(t0, 15) {
+		t.Errorf("lim.AllowN(t0, 15) = false want true")
+	}
+	// refilled at 10 tokens per second, Tokens doesn't consume
+	for _, tt := range []struct {
+		t      time.Time
+		tokens float64
+	}{
+		{t0, -5},
+		{t1, -4},
+		{t0.Add(10 * time.Second), 10},
+		{t0, -5},
+	} {
+		if tokens := lim.Tokens(tt.t); math.Abs(tokens-tt.tokens) > 1e-9 {
+			t.Errorf("lim.Tokens(%v) = %v want %v", tt.t, tokens, tt.tokens)
+		}
+	}
+}
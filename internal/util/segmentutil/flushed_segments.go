@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentutil
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DefaultFlushedSegmentsPageSize is the number of segment ids per page listed by WalkFlushedSegments.
+const DefaultFlushedSegmentsPageSize = 10000
+
+// PageSegmentIDs returns the page of at most pageSize segment ids not less than cursor in ascending order, hasMore is
+// true if more segment ids follow the page.
+func PageSegmentIDs(segmentIDs []int64, pageSize int, cursor int64) (page []int64, hasMore bool) {
+	page = make([]int64, 0)
+	for _, id := range segmentIDs {
+		if id >= cursor {
+			page = append(page, id)
+		}
+	}
+	sort.Slice(page, func(i, j int) bool { return page[i] < page[j] })
+	if len(page) > pageSize {
+		return page[:pageSize], true
+	}
+	return page, false
+}
+
+// FlushedSegmentsGetter is the DataCoord api listing the flushed segments.
+type FlushedSegmentsGetter interface {
+	GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
+}
+
+// WalkFlushedSegments lists the flushed segments page by page and calls fn with the segment ids of every page, so that
+// the segments of a huge collection are never sent in one giant response. A DataCoord not supporting the pagination
+// returns all the segments in the first page.
+func WalkFlushedSegments(ctx context.Context, getter FlushedSegmentsGetter, req *datapb.GetFlushedSegmentsRequest,
+	pageSize int, fn func(segmentIDs []int64) error) error {
+	if pageSize <= 0 {
+		pageSize = DefaultFlushedSegmentsPageSize
+	}
+	req = typeutil.Clone(req)
+	req.PageSize = int64(pageSize)
+	req.Cursor = 0
+	for {
+		resp, err := getter.GetFlushedSegments(ctx, req)
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetStatus().GetReason())
+		}
+		page := resp.GetSegments()
+		if len(page) == 0 {
+			return nil
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+		if !resp.GetHasMore() {
+			return nil
+		}
+		req.Cursor = page[len(page)-1] + 1
+	}
+}
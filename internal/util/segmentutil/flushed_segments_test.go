@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+type mockFlushedSegmentsGetter struct {
+	segmentIDs []int64
+	paged      bool
+	calls      int
+	err        error
+	status     *commonpb.Status
+}
+
+func (m *mockFlushedSegmentsGetter) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.status != nil {
+		return &datapb.GetFlushedSegmentsResponse{Status: m.status}, nil
+	}
+	resp := &datapb.GetFlushedSegmentsResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Segments: m.segmentIDs,
+	}
+	if m.paged {
+		if req.GetPageSize() <= 0 {
+			return nil, errors.New("missing page")
+		}
+		resp.Segments, resp.HasMore = PageSegmentIDs(m.segmentIDs, int(req.GetPageSize()), req.GetCursor())
+	}
+	return resp, nil
+}
+
+func listFlushedSegments(ctx context.Context, getter FlushedSegmentsGetter, req *datapb.GetFlushedSegmentsRequest,
+	pageSize int) ([]int64, error) {
+	segmentIDs := make([]int64, 0)
+	err := WalkFlushedSegments(ctx, getter, req, pageSize, func(page []int64) error {
+		segmentIDs = append(segmentIDs, page...)
+		return nil
+	})
+	return segmentIDs, err
+}
+
+func TestPageSegmentIDs(t *testing.T) {
+	ids := []int64{5, 1, 4, 2, 3}
+	page, hasMore := PageSegmentIDs(ids, 2, 0)
+	assert.Equal(t, []int64{1, 2}, page)
+	assert.True(t, hasMore)
+	page, hasMore = PageSegmentIDs(ids, 2, 3)
+	assert.Equal(t, []int64{3, 4}, page)
+	assert.True(t, hasMore)
+	page, hasMore = PageSegmentIDs(ids, 2, 5)
+	assert.Equal(t, []int64{5}, page)
+	assert.False(t, hasMore)
+	page, hasMore = PageSegmentIDs(ids, 2, 6)
+	assert.Empty(t, page)
+	assert.False(t, hasMore)
+	page, hasMore = PageSegmentIDs(ids[:4], 2, 3)
+	assert.Equal(t, []int64{4, 5}, page)
+	assert.False(t, hasMore)
+}
+
+func TestWalkFlushedSegments(t *testing.T) {
+	ctx := context.Background()
+	req := &datapb.GetFlushedSegmentsRequest{CollectionID: 1, PartitionID: -1}
+
+	t.Run("paged", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{segmentIDs: []int64{7, 3, 1, 6, 2, 5, 4}, paged: true}
+		pages := make([][]int64, 0)
+		err := WalkFlushedSegments(ctx, getter, req, 3, func(segmentIDs []int64) error {
+			pages = append(pages, segmentIDs)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int64{{1, 2, 3}, {4, 5, 6}, {7}}, pages)
+		assert.Equal(t, 3, getter.calls)
+		// the request of the caller is not changed
+		assert.Equal(t, int64(0), req.GetPageSize())
+	})
+
+	t.Run("exact pages", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{segmentIDs: []int64{1, 2, 3, 4}, paged: true}
+		ids, err := listFlushedSegments(ctx, getter, req, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 4}, ids)
+		assert.Equal(t, 2, getter.calls)
+	})
+
+	t.Run("old datacoord", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{segmentIDs: []int64{3, 1, 2, 5, 4}}
+		ids, err := listFlushedSegments(ctx, getter, req, 2)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int64{1, 2, 3, 4, 5}, ids)
+		assert.Equal(t, 1, getter.calls)
+	})
+	t.Run("no segments", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{paged: true}
+		ids, err := listFlushedSegments(ctx, getter, req, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("rpc error", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{err: errors.New("mock")}
+		_, err := listFlushedSegments(ctx, getter, req, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("fail status", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"}}
+		_, err := listFlushedSegments(ctx, getter, req, 2)
+		assert.EqualError(t, err, "mock")
+	})
+
+	t.Run("fn error", func(t *testing.T) {
+		getter := &mockFlushedSegmentsGetter{segmentIDs: []int64{1, 2, 3}, paged: true}
+		err := WalkFlushedSegments(ctx, getter, req, 2, func(segmentIDs []int64) error {
+			return errors.New("mock")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, getter.calls)
+	})
+}
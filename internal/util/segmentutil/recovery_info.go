@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentutil
+
+import "github.com/milvus-io/milvus/internal/proto/datapb"
+
+// MergeRecoveryInfo merges the changed segments of a delta GetRecoveryInfo response into the segments of the previous
+// response. The segments not flushed in the channels any more are removed, the others not changed are kept.
+func MergeRecoveryInfo(previous []*datapb.SegmentBinlogs, channels []*datapb.VchannelInfo,
+	changed []*datapb.SegmentBinlogs) []*datapb.SegmentBinlogs {
+	flushed := make(map[int64]struct{})
+	for _, channel := range channels {
+		for _, id := range channel.GetFlushedSegmentIds() {
+			flushed[id] = struct{}{}
+		}
+	}
+	changedIDs := make(map[int64]struct{}, len(changed))
+	for _, binlogs := range changed {
+		changedIDs[binlogs.GetSegmentID()] = struct{}{}
+	}
+
+	merged := make([]*datapb.SegmentBinlogs, 0, len(previous)+len(changed))
+	for _, binlogs := range previous {
+		if _, ok := changedIDs[binlogs.GetSegmentID()]; ok {
+			continue
+		}
+		if _, ok := flushed[binlogs.GetSegmentID()]; ok {
+			merged = append(merged, binlogs)
+		}
+	}
+	return append(merged, changed...)
+}
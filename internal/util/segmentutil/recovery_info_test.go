@@ -0,0 +1,51 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestMergeRecoveryInfo(t *testing.T) {
+	previous := []*datapb.SegmentBinlogs{
+		{SegmentID: 1, NumOfRows: 10},
+		{SegmentID: 2, NumOfRows: 20},
+		{SegmentID: 3, NumOfRows: 30},
+	}
+	channels := []*datapb.VchannelInfo{
+		{ChannelName: "ch1", FlushedSegmentIds: []int64{1, 2}},
+		{ChannelName: "ch2", FlushedSegmentIds: []int64{4}},
+	}
+	changed := []*datapb.SegmentBinlogs{
+		{SegmentID: 2, NumOfRows: 21},
+		{SegmentID: 4, NumOfRows: 40},
+	}
+
+	merged := MergeRecoveryInfo(previous, channels, changed)
+	rows := make(map[int64]int64)
+	for _, binlogs := range merged {
+		rows[binlogs.GetSegmentID()] = binlogs.GetNumOfRows()
+	}
+	// segment 3 is not flushed any more, segment 2 is changed and segment 4 is new
+	assert.Equal(t, map[int64]int64{1: 10, 2: 21, 4: 40}, rows)
+
+	assert.Empty(t, MergeRecoveryInfo(previous, nil, nil))
+}
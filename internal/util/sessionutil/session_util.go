@@ -93,6 +93,8 @@ type Session struct {
 	Stopping    bool   `json:"Stopping,omitempty"`
 	TriggerKill bool
 	Version     semver.Version `json:"Version,omitempty"`
+	// Zone is the zone the server runs in, from common.session.zone, empty if unknown.
+	Zone string `json:"Zone,omitempty"`
 
 	liveCh  <-chan bool
 	etcdCli *clientv3.Client
@@ -368,6 +370,7 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 	if !s.useCustomConfig {
 		ttl = paramtable.Get().CommonCfg.SessionTTL.GetAsInt64()
 		retryTimes = paramtable.Get().CommonCfg.SessionRetryTimes.GetAsInt64()
+		s.Zone = paramtable.Get().CommonCfg.SessionZone.GetValue()
 	}
 
 	registerFn := func() error {
@@ -0,0 +1,228 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// Float32ToFloat16 returns the bits of the IEEE 754 half precision float nearest to f, ties to even.
+// The values out of the half precision range become infinities, NaN stays NaN, EncodeReducedPrecision checks the range.
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+	if e <= 0 {
+		// subnormal half precision, or zero if even the rounding can't reach the smallest one
+		if e < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - e)
+		half := uint16(mant >> shift)
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	}
+
+	// the carry of the rounding may go into the exponent, up to the infinity
+	half := uint16(e)<<10 | uint16(mant>>13)
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		half++
+	}
+	return sign | half
+}
+
+// Float16ToFloat32 converts the bits of an IEEE 754 half precision float to float32, which is exact.
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		f := float32(mant) / (1 << 24)
+		if sign != 0 {
+			return -f
+		}
+		return f
+	}
+	return math.Float32frombits(sign | (exp+112)<<23 | mant<<13)
+}
+
+// Float32ToBFloat16 returns the bits of the bfloat16 nearest to f, ties to even. The values rounded beyond the
+// largest bfloat16 become infinities, NaN stays NaN.
+func Float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		return uint16(bits>>16) | 0x40
+	}
+	bits += 0x7fff + (bits>>16)&1
+	return uint16(bits >> 16)
+}
+
+// BFloat16ToFloat32 converts the bits of a bfloat16 to float32, which is exact.
+func BFloat16ToFloat32(h uint16) float32 {
+	return math.Float32frombits(uint32(h) << 16)
+}
+
+// EncodeReducedPrecision encodes the floats in the reduced precision, 2 bytes each. It returns false if the precision
+// isn't reduced, or a finite float overflows the precision, the floats must be sent in float32 then.
+func EncodeReducedPrecision(fs []float32, precision internalpb.ResultPrecision) ([]byte, bool) {
+	var convert func(float32) uint16
+	switch precision {
+	case internalpb.ResultPrecision_Float16:
+		convert = Float32ToFloat16
+	case internalpb.ResultPrecision_BFloat16:
+		convert = Float32ToBFloat16
+	default:
+		return nil, false
+	}
+	bytes := make([]byte, len(fs)*2)
+	for i, f := range fs {
+		h := convert(f)
+		if isReducedInf(h, precision) && !math.IsInf(float64(f), 0) {
+			return nil, false
+		}
+		common.Endian.PutUint16(bytes[i*2:], h)
+	}
+	return bytes, true
+}
+
+func isReducedInf(h uint16, precision internalpb.ResultPrecision) bool {
+	if precision == internalpb.ResultPrecision_Float16 {
+		return h&0x7fff == 0x7c00
+	}
+	return h&0x7fff == 0x7f80
+}
+
+// DecodeReducedPrecision decodes the floats encoded by EncodeReducedPrecision.
+func DecodeReducedPrecision(bytes []byte, precision internalpb.ResultPrecision) ([]float32, error) {
+	var convert func(uint16) float32
+	switch precision {
+	case internalpb.ResultPrecision_Float16:
+		convert = Float16ToFloat32
+	case internalpb.ResultPrecision_BFloat16:
+		convert = BFloat16ToFloat32
+	default:
+		return nil, fmt.Errorf("unknown reduced precision %s", precision.String())
+	}
+	if len(bytes)%2 != 0 {
+		return nil, fmt.Errorf("failed to decode %s: invalid data, the length %d is odd", precision.String(), len(bytes))
+	}
+	fs := make([]float32, len(bytes)/2)
+	for i := range fs {
+		fs[i] = convert(common.Endian.Uint16(bytes[i*2:]))
+	}
+	return fs, nil
+}
+
+// FillInSlicedScores decodes the scores of a search result sent in reduced precision into its sliced data.
+func FillInSlicedScores(result *internalpb.SearchResults, data *schemapb.SearchResultData) error {
+	if result.GetResultPrecision() == internalpb.ResultPrecision_Float32 {
+		return nil
+	}
+	scores, err := DecodeReducedPrecision(result.GetSlicedScores(), result.GetResultPrecision())
+	if err != nil {
+		return err
+	}
+	if len(scores) != GetSizeOfIDs(data.GetIds()) {
+		return fmt.Errorf("the number of sliced scores %d mis-match with the number of ids %d", len(scores), GetSizeOfIDs(data.GetIds()))
+	}
+	data.Scores = scores
+	return nil
+}
+
+// ReduceVectorPrecision moves the float vectors of the fields out, and returns them encoded in the precision by
+// field id. The vectors of a field overflowing the precision are kept in the field.
+func ReduceVectorPrecision(fieldsData []*schemapb.FieldData, precision internalpb.ResultPrecision) map[int64][]byte {
+	if precision == internalpb.ResultPrecision_Float32 {
+		return nil
+	}
+	var reduced map[int64][]byte
+	for _, fieldData := range fieldsData {
+		if fieldData.GetType() != schemapb.DataType_FloatVector || len(fieldData.GetVectors().GetFloatVector().GetData()) == 0 {
+			continue
+		}
+		floatVector := fieldData.GetVectors().GetFloatVector()
+		bytes, ok := EncodeReducedPrecision(floatVector.GetData(), precision)
+		if !ok {
+			continue
+		}
+		if reduced == nil {
+			reduced = make(map[int64][]byte)
+		}
+		reduced[fieldData.GetFieldId()] = bytes
+		floatVector.Data = nil
+	}
+	return reduced
+}
+
+// FillInReducedVectors decodes the float vectors sent in reduced precision by ReduceVectorPrecision into the fields.
+func FillInReducedVectors(fieldsData []*schemapb.FieldData, precision internalpb.ResultPrecision, reduced map[int64][]byte) error {
+	if len(reduced) == 0 {
+		return nil
+	}
+	filled := 0
+	for _, fieldData := range fieldsData {
+		bytes, ok := reduced[fieldData.GetFieldId()]
+		if !ok {
+			continue
+		}
+		floatVector := fieldData.GetVectors().GetFloatVector()
+		if floatVector == nil {
+			return fmt.Errorf("field %d of the reduced vectors is not a float vector field", fieldData.GetFieldId())
+		}
+		data, err := DecodeReducedPrecision(bytes, precision)
+		if err != nil {
+			return err
+		}
+		if dim := fieldData.GetVectors().GetDim(); dim <= 0 || len(data)%int(dim) != 0 {
+			return fmt.Errorf("the number of the reduced vector elements %d of field %d mis-match with dim %d",
+				len(data), fieldData.GetFieldId(), dim)
+		}
+		floatVector.Data = data
+		filled++
+	}
+	if filled != len(reduced) {
+		return fmt.Errorf("%d fields of the reduced vectors are not in the fields data", len(reduced)-filled)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat16(t *testing.T) {
+	t.Run("exact values", func(t *testing.T) {
+		for _, f := range []float32{0, 1, -1, 0.5, -2.75, 1024, 65504, -65504, 6.103515625e-05, 5.9604645e-08, -5.9604645e-08} {
+			assert.Equal(t, f, Float16ToFloat32(Float32ToFloat16(f)), f)
+		}
+		assert.Equal(t, uint16(0x3c00), Float32ToFloat16(1))
+		assert.Equal(t, uint16(0x8000), Float32ToFloat16(float32(math.Copysign(0, -1))))
+		assert.Equal(t, uint16(0x0001), Float32ToFloat16(5.9604645e-08))
+	})
+
+	t.Run("rounding", func(t *testing.T) {
+		// ties to even: 1+2^-11 is half way between 1 and 1+2^-10
+		assert.Equal(t, float32(1), Float16ToFloat32(Float32ToFloat16(1+1.0/2048)))
+		assert.Equal(t, float32(1+2.0/1024), Float16ToFloat32(Float32ToFloat16(1+3.0/2048)))
+		assert.Equal(t, float32(0.33325195), Float16ToFloat32(Float32ToFloat16(1.0/3)))
+		// above the largest subnormal, rounding up to the smallest normal
+		assert.Equal(t, uint16(0x0400), Float32ToFloat16(6.102e-05))
+		// below half of the smallest subnormal
+		assert.Equal(t, uint16(0), Float32ToFloat16(2e-08))
+		assert.Equal(t, uint16(0x0001), Float32ToFloat16(3e-08))
+	})
+
+	t.Run("special values", func(t *testing.T) {
+		assert.True(t, math.IsInf(float64(Float16ToFloat32(Float32ToFloat16(float32(math.Inf(1))))), 1))
+		assert.True(t, math.IsInf(float64(Float16ToFloat32(Float32ToFloat16(float32(math.Inf(-1))))), -1))
+		assert.True(t, math.IsInf(float64(Float16ToFloat32(Float32ToFloat16(65520))), 1))
+		assert.True(t, math.IsInf(float64(Float16ToFloat32(Float32ToFloat16(-math.MaxFloat32))), -1))
+		assert.True(t, math.IsNaN(float64(Float16ToFloat32(Float32ToFloat16(float32(math.NaN()))))))
+	})
+
+}
+
+func TestBFloat16(t *testing.T) {
+	for _, f := range []float32{0, 1, -1, 0.5, -2.75, 1024, 65536, float32(math.Ldexp(1, 100)), float32(math.Ldexp(-1, -100))} {
+		assert.Equal(t, f, BFloat16ToFloat32(Float32ToBFloat16(f)), f)
+	}
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(1))
+	// ties to even: 1+2^-8 is half way between 1 and 1+2^-7
+	assert.Equal(t, float32(1), BFloat16ToFloat32(Float32ToBFloat16(1+1.0/256)))
+	assert.Equal(t, float32(1+2.0/128), BFloat16ToFloat32(Float32ToBFloat16(1+3.0/256)))
+	assert.Equal(t, float32(0.33398438), BFloat16ToFloat32(Float32ToBFloat16(1.0/3)))
+	assert.True(t, math.IsInf(float64(BFloat16ToFloat32(Float32ToBFloat16(math.MaxFloat32))), 1))
+	assert.True(t, math.IsNaN(float64(BFloat16ToFloat32(Float32ToBFloat16(float32(math.NaN()))))))
+}
+
+func TestReducedPrecision(t *testing.T) {
+	fs := []float32{0.25, -3, 100, float32(math.Inf(1))}
+	for _, precision := range []internalpb.ResultPrecision{internalpb.ResultPrecision_Float16, internalpb.ResultPrecision_BFloat16} {
+		bytes, ok := EncodeReducedPrecision(fs, precision)
+		assert.True(t, ok)
+		assert.Len(t, bytes, 8)
+		decoded, err := DecodeReducedPrecision(bytes, precision)
+		assert.NoError(t, err)
+		assert.Equal(t, fs, decoded)
+
+		decoded, err = DecodeReducedPrecision(nil, precision)
+		assert.NoError(t, err)
+		assert.Empty(t, decoded)
+
+		_, err = DecodeReducedPrecision([]byte{1, 2, 3}, precision)
+		assert.Error(t, err)
+	}
+
+	// the values overflowing float16 are kept in float32
+	_, ok := EncodeReducedPrecision([]float32{1, 65520}, internalpb.ResultPrecision_Float16)
+	assert.False(t, ok)
+	bytes, ok := EncodeReducedPrecision([]float32{1, 65520}, internalpb.ResultPrecision_BFloat16)
+	assert.True(t, ok)
+	decoded, err := DecodeReducedPrecision(bytes, internalpb.ResultPrecision_BFloat16)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 65536}, decoded)
+	_, ok = EncodeReducedPrecision([]float32{math.MaxFloat32}, internalpb.ResultPrecision_BFloat16)
+	assert.False(t, ok)
+
+	_, ok = EncodeReducedPrecision(fs, internalpb.ResultPrecision_Float32)
+	assert.False(t, ok)
+	_, err = DecodeReducedPrecision(nil, internalpb.ResultPrecision_Float32)
+	assert.Error(t, err)
+}
+func TestFillInSlicedScores(t *testing.T) {
+	data := &schemapb.SearchResultData{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+	}
+
+	assert.NoError(t, FillInSlicedScores(&internalpb.SearchResults{}, data))
+	assert.Nil(t, data.Scores)
+
+	result := &internalpb.SearchResults{
+		ResultPrecision: internalpb.ResultPrecision_Float16,
+	}
+	result.SlicedScores, _ = EncodeReducedPrecision([]float32{0.5, 0.25}, internalpb.ResultPrecision_Float16)
+	assert.NoError(t, FillInSlicedScores(result, data))
+	assert.Equal(t, []float32{0.5, 0.25}, data.Scores)
+
+	result.SlicedScores, _ = EncodeReducedPrecision([]float32{0.5}, internalpb.ResultPrecision_Float16)
+	assert.Error(t, FillInSlicedScores(result, data))
+
+	result.ResultPrecision = 100
+	assert.Error(t, FillInSlicedScores(result, data))
+}
+
+func TestReduceVectorPrecision(t *testing.T) {
+	newFieldsData := func() []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			genFieldData("vec", 101, schemapb.DataType_FloatVector, []float32{0.5, 1, 2, 4}, 2),
+			genFieldData("large", 102, schemapb.DataType_FloatVector, []float32{1, 1e10}, 2),
+			genFieldData("int64", 103, schemapb.DataType_Int64, []int64{1}, 1),
+		}
+	}
+	fieldsData := newFieldsData()
+	assert.Nil(t, ReduceVectorPrecision(fieldsData, internalpb.ResultPrecision_Float32))
+
+	reduced := ReduceVectorPrecision(fieldsData, internalpb.ResultPrecision_Float16)
+	assert.Len(t, reduced, 1)
+	assert.Len(t, reduced[101], 8)
+	assert.Empty(t, fieldsData[0].GetVectors().GetFloatVector().GetData())
+	// the vectors overflowing float16 are kept
+	assert.Equal(t, []float32{1, 1e10}, fieldsData[1].GetVectors().GetFloatVector().GetData())
+
+	assert.NoError(t, FillInReducedVectors(fieldsData, internalpb.ResultPrecision_Float16, reduced))
+	assert.Equal(t, newFieldsData(), fieldsData)
+	assert.NoError(t, FillInReducedVectors(fieldsData, internalpb.ResultPrecision_Float16, nil))
+
+	// the field is missing, or not a float vector field
+	assert.Error(t, FillInReducedVectors(fieldsData, internalpb.ResultPrecision_Float16, map[int64][]byte{104: {}}))
+	assert.Error(t, FillInReducedVectors(fieldsData, internalpb.ResultPrecision_Float16, map[int64][]byte{103: {}}))
+	// the elements are not a multiple of dim
+	assert.Error(t, FillInReducedVectors(fieldsData, internalpb.ResultPrecision_Float16, map[int64][]byte{101: {0, 0}}))
+	assert.Error(t, FillInReducedVectors(fieldsData, internalpb.ResultPrecision_Float32, reduced))
+}